@@ -24,9 +24,13 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sync"
+	"time"
 
 	"github.com/avfs/avfs"
+	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
+	natsclient "github.com/osapi-io/nats-client/pkg/client"
 
 	"github.com/osapi-io/osapi/internal/agent"
 	"github.com/osapi-io/osapi/internal/cli"
@@ -36,26 +40,37 @@ import (
 	"github.com/osapi-io/osapi/internal/provider/command"
 	dockerProv "github.com/osapi-io/osapi/internal/provider/container/docker"
 	fileProv "github.com/osapi-io/osapi/internal/provider/file"
+	firewallProv "github.com/osapi-io/osapi/internal/provider/network/firewall"
 	"github.com/osapi-io/osapi/internal/provider/network/netinfo"
+	"github.com/osapi-io/osapi/internal/provider/network/netplan"
 	"github.com/osapi-io/osapi/internal/provider/network/netplan/dns"
 	ifaceProv "github.com/osapi-io/osapi/internal/provider/network/netplan/iface"
 	routeProv "github.com/osapi-io/osapi/internal/provider/network/netplan/route"
 	"github.com/osapi-io/osapi/internal/provider/network/ping"
+	socketProv "github.com/osapi-io/osapi/internal/provider/network/socket"
 	aptProv "github.com/osapi-io/osapi/internal/provider/node/apt"
+	blockProv "github.com/osapi-io/osapi/internal/provider/node/block"
 	certProv "github.com/osapi-io/osapi/internal/provider/node/certificate"
 	"github.com/osapi-io/osapi/internal/provider/node/disk"
 	nodeHost "github.com/osapi-io/osapi/internal/provider/node/host"
+	hostsProv "github.com/osapi-io/osapi/internal/provider/node/hosts"
+	kernelProv "github.com/osapi-io/osapi/internal/provider/node/kernel"
 	"github.com/osapi-io/osapi/internal/provider/node/load"
 	logProv "github.com/osapi-io/osapi/internal/provider/node/log"
 	"github.com/osapi-io/osapi/internal/provider/node/mem"
+	mountProv "github.com/osapi-io/osapi/internal/provider/node/mount"
 	ntpProv "github.com/osapi-io/osapi/internal/provider/node/ntp"
 	powerProv "github.com/osapi-io/osapi/internal/provider/node/power"
 	processProv "github.com/osapi-io/osapi/internal/provider/node/process"
 	serviceProv "github.com/osapi-io/osapi/internal/provider/node/service"
+	sshdProv "github.com/osapi-io/osapi/internal/provider/node/sshd"
+	sudoersProv "github.com/osapi-io/osapi/internal/provider/node/sudoers"
+	swapProv "github.com/osapi-io/osapi/internal/provider/node/swap"
 	sysctlProv "github.com/osapi-io/osapi/internal/provider/node/sysctl"
 	timezoneProv "github.com/osapi-io/osapi/internal/provider/node/timezone"
 	userProv "github.com/osapi-io/osapi/internal/provider/node/user"
 	cronProv "github.com/osapi-io/osapi/internal/provider/scheduled/cron"
+	timerProv "github.com/osapi-io/osapi/internal/provider/scheduled/timer"
 	"github.com/osapi-io/osapi/internal/telemetry/process"
 	"github.com/osapi-io/osapi/pkg/sdk/platform"
 )
@@ -135,20 +150,25 @@ func setupAgent(
 	}
 
 	// --- Network providers ---
-	var dnsProvider dns.Provider
+	var netinfoProvider netinfo.Provider
 	switch plat {
-	case "debian":
-		if platform.IsContainer() {
-			dnsProvider = dns.NewDebianDockerProvider(log, appFs)
-		} else {
-			dnsProvider = dns.NewDebianProvider(log, appFs, fileStateKV, execManager, hostname)
-		}
 	case "darwin":
-		dnsProvider = dns.NewDarwinProvider(log, execManager)
+		netinfoProvider = netinfo.NewDarwinProvider(execManager)
 	default:
-		dnsProvider = dns.NewLinuxProvider()
+		netinfoProvider = netinfo.NewLinuxProvider()
 	}
 
+	networkRenderer, _ := netinfoProvider.GetNetworkRenderer()
+
+	netplanGuard := createNetplanGuard(
+		log, appFs, fileStateKV, execManager, hostname, networkRenderer,
+	)
+	registerNetplanGuardReconnect(log, b.nc, netplanGuard)
+
+	dnsProvider := createDNSProvider(
+		log, appFs, fileStateKV, execManager, hostname, networkRenderer, netplanGuard,
+	)
+
 	var pingProvider ping.Provider
 	switch plat {
 	case "debian":
@@ -159,14 +179,6 @@ func setupAgent(
 		pingProvider = ping.NewLinuxProvider()
 	}
 
-	var netinfoProvider netinfo.Provider
-	switch plat {
-	case "darwin":
-		netinfoProvider = netinfo.NewDarwinProvider(execManager)
-	default:
-		netinfoProvider = netinfo.NewLinuxProvider()
-	}
-
 	// --- Command provider ---
 	commandProvider := command.New(log, execManager)
 
@@ -188,6 +200,16 @@ func setupAgent(
 	// --- Cron provider ---
 	cronProvider := createCronProvider(log, fileProvider, fileStateKV, hostname)
 
+	// --- Timer provider ---
+	timerProvider := createTimerProvider(
+		log,
+		appFs,
+		fileProvider,
+		fileStateKV,
+		execManager,
+		hostname,
+	)
+
 	// --- Sysctl provider ---
 	sysctlProvider := createSysctlProvider(log, appFs, fileStateKV, execManager, hostname)
 
@@ -201,16 +223,18 @@ func setupAgent(
 	powerProvider := createPowerProvider(log, execManager)
 
 	// --- Process provider ---
-	processProvider := createProcessProvider(log)
+	processProvider := createProcessProvider(log, appFs)
 
 	// --- User provider ---
 	userProvider := createUserProvider(log, appFs, execManager)
 
 	// --- Package provider ---
-	packageProvider := createPackageProvider(log, execManager)
+	packageProvider := createPackageProvider(
+		log, appFs, fileProvider, fileStateKV, execManager, hostname,
+	)
 
 	// --- Log provider ---
-	logProvider := createLogProvider(log, execManager)
+	logProvider := createLogProvider(log, appFs, execManager)
 
 	// --- Certificate provider ---
 	certificateProvider := createCertificateProvider(
@@ -222,17 +246,55 @@ func setupAgent(
 		log, appFs, fileProvider, fileStateKV, execManager, hostname,
 	)
 
+	// --- Mount provider ---
+	mountProvider := createMountProvider(log, appFs, execManager)
+
+	// --- Block device provider ---
+	blockProvider := createBlockProvider(log, execManager)
+
+	// --- Swap provider ---
+	swapProvider := createSwapProvider(
+		log, appFs, fileProvider, fileStateKV, execManager, hostname,
+	)
+
+	// --- Kernel module provider ---
+	kernelProvider := createKernelProvider(
+		log, appFs, fileProvider, fileStateKV, execManager, hostname,
+	)
+
+	// --- Hosts file provider ---
+	hostsProvider := createHostsProvider(log, appFs)
+
+	// --- Sudoers provider ---
+	sudoersProvider := createSudoersProvider(
+		log, appFs, fileProvider, fileStateKV, execManager, hostname,
+	)
+
+	// --- sshd provider ---
+	sshdProvider := createSshdProvider(
+		log, appFs, fileProvider, fileStateKV, execManager, hostname,
+	)
+
 	// --- Netplan providers (interface + route) ---
 	interfaceProvider, routeProvider := createNetplanProviders(
-		log, appFs, fileStateKV, execManager, hostname,
+		log, appFs, fileStateKV, execManager, hostname, networkRenderer, netplanGuard,
+	)
+
+	// --- Firewall provider ---
+	firewallProvider := createFirewallProvider(
+		log, appFs, fileProvider, fileStateKV, execManager, hostname,
 	)
 
+	// --- Socket provider ---
+	socketProvider := createSocketProvider(log, appFs)
+
 	// --- Build registry ---
 	registry := agent.NewProviderRegistry()
 
 	registry.Register(
 		"node",
 		agent.NewNodeProcessor(
+			ctx,
 			hostProvider,
 			diskProvider,
 			memProvider,
@@ -246,6 +308,14 @@ func setupAgent(
 			packageProvider,
 			logProvider,
 			serviceProvider,
+			mountProvider,
+			blockProvider,
+			swapProvider,
+			kernelProvider,
+			hostsProvider,
+			sudoersProvider,
+			sshdProvider,
+			b.nc,
 			appConfig,
 			log,
 		),
@@ -262,6 +332,13 @@ func setupAgent(
 		packageProvider,
 		logProvider,
 		serviceProvider,
+		mountProvider,
+		blockProvider,
+		swapProvider,
+		kernelProvider,
+		hostsProvider,
+		sudoersProvider,
+		sshdProvider,
 	)
 
 	registry.Register(
@@ -269,10 +346,14 @@ func setupAgent(
 		agent.NewNetworkProcessor(
 			dnsProvider, pingProvider,
 			interfaceProvider, routeProvider,
+			firewallProvider,
+			socketProvider,
+			netplanGuard,
 			log,
 		),
 		dnsProvider, pingProvider, netinfoProvider,
-		interfaceProvider, routeProvider,
+		interfaceProvider, routeProvider, firewallProvider,
+		socketProvider,
 	)
 
 	registry.Register(
@@ -295,8 +376,8 @@ func setupAgent(
 
 	registry.Register(
 		"schedule",
-		agent.NewScheduleProcessor(cronProvider, log),
-		cronProvider,
+		agent.NewScheduleProcessor(cronProvider, timerProvider, log),
+		cronProvider, timerProvider,
 	)
 
 	registry.Register(
@@ -427,8 +508,9 @@ func createSysctlProvider(
 }
 
 // createNtpProvider creates a platform-specific NTP provider. On Debian, the
-// NTP provider manages chrony configuration. On other platforms, all operations
-// return ErrUnsupported.
+// NTP provider manages chrony or systemd-timesyncd configuration, depending on
+// which daemon is active. On other platforms, all operations return
+// ErrUnsupported.
 func createNtpProvider(
 	log *slog.Logger,
 	fs avfs.VFS,
@@ -442,6 +524,10 @@ func createNtpProvider(
 			log.Info("running in container, NTP operations disabled")
 			return ntpProv.NewLinuxProvider()
 		}
+		if ntpProv.DetectDaemon(execManager) == ntpProv.DaemonTimesyncd {
+			log.Info("systemd-timesyncd is active, managing NTP via timesyncd")
+			return ntpProv.NewTimesyncdProvider(log, fs, execManager)
+		}
 		return ntpProv.NewDebianProvider(log, fs, execManager)
 	case "darwin":
 		return ntpProv.NewDarwinProvider()
@@ -504,6 +590,7 @@ func createPowerProvider(
 // all operations return ErrUnsupported.
 func createProcessProvider(
 	log *slog.Logger,
+	fs avfs.VFS,
 ) processProv.Provider {
 	plat := platform.Detect()
 
@@ -511,6 +598,7 @@ func createProcessProvider(
 	case "debian":
 		return processProv.NewDebianProvider(
 			log,
+			fs,
 			processProv.NewGopsutilLister(),
 			processProv.NewSyscallSignaler(),
 		)
@@ -546,6 +634,45 @@ func createCronProvider(
 	}
 }
 
+// createTimerProvider creates a platform-specific timer provider. On Debian,
+// the timer provider delegates unit file writes to the file provider for SHA
+// tracking and idempotency. In containers, systemd is not available — returns
+// ErrUnsupported. On other platforms, all operations return ErrUnsupported.
+func createTimerProvider(
+	log *slog.Logger,
+	fs avfs.VFS,
+	fileProvider fileProv.Provider,
+	fileStateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+) timerProv.Provider {
+	plat := platform.Detect()
+
+	switch plat {
+	case "debian":
+		if platform.IsContainer() {
+			log.Info("running in container, timer operations disabled")
+			return timerProv.NewLinuxProvider()
+		}
+		if fileProvider == nil {
+			log.Warn("file provider not available, timer operations disabled")
+			return timerProv.NewLinuxProvider()
+		}
+		return timerProv.NewDebianProvider(
+			log,
+			fs,
+			fileProvider,
+			fileStateKV,
+			execManager,
+			hostname,
+		)
+	case "darwin":
+		return timerProv.NewDarwinProvider()
+	default:
+		return timerProv.NewLinuxProvider()
+	}
+}
+
 // createUserProvider creates a platform-specific user provider. On Debian, the
 // user provider manages system users and groups via useradd/usermod/groupadd.
 // In containers, user management is not meaningful — returns ErrUnsupported.
@@ -568,18 +695,38 @@ func createUserProvider(
 }
 
 // createPackageProvider creates a platform-specific package provider. On Debian,
-// the package provider manages apt packages. In containers, apt operations are
-// disabled because package management is not meaningful. On other platforms, all
-// operations return ErrUnsupported.
+// the package provider manages apt packages and delegates repository source
+// and keyring writes to the file provider. On RHEL, the package provider
+// manages dnf packages. On Alpine, the package provider manages apk packages.
+// On other platforms, all operations return ErrUnsupported.
 func createPackageProvider(
 	log *slog.Logger,
+	fs avfs.VFS,
+	fileProvider fileProv.Provider,
+	fileStateKV jetstream.KeyValue,
 	execManager exec.Manager,
+	hostname string,
 ) aptProv.Provider {
 	plat := platform.Detect()
 
 	switch plat {
 	case "debian":
-		return aptProv.NewDebianProvider(log, execManager)
+		if fileProvider == nil {
+			log.Warn(
+				"file provider not available, package repository and object install operations disabled",
+			)
+		}
+		return aptProv.NewDebianProvider(log, fs, fileProvider, fileStateKV, execManager, hostname)
+	case "rhel":
+		if fileProvider == nil {
+			log.Warn("file provider not available, package object install operations disabled")
+		}
+		return aptProv.NewRHELProvider(log, fs, fileProvider, execManager)
+	case "alpine":
+		if fileProvider == nil {
+			log.Warn("file provider not available, package object install operations disabled")
+		}
+		return aptProv.NewAlpineProvider(log, fs, fileProvider, execManager)
 	case "darwin":
 		return aptProv.NewDarwinProvider()
 	default:
@@ -588,11 +735,13 @@ func createPackageProvider(
 }
 
 // createLogProvider creates a platform-specific log provider. On Debian, the
-// log provider queries journal logs via journalctl. In containers, journalctl
+// log provider queries journal logs via journalctl and reads allow-listed
+// plain log files from the filesystem. In containers, journalctl
 // requires systemd which is not available, so the provider is disabled. On
 // other platforms, all operations return ErrUnsupported.
 func createLogProvider(
 	log *slog.Logger,
+	fs avfs.VFS,
 	execManager exec.Manager,
 ) logProv.Provider {
 	plat := platform.Detect()
@@ -602,7 +751,7 @@ func createLogProvider(
 		if platform.IsContainer() {
 			return logProv.NewLinuxProvider()
 		}
-		return logProv.NewDebianProvider(log, execManager)
+		return logProv.NewDebianProvider(log, fs, execManager)
 	case "darwin":
 		return logProv.NewDarwinProvider()
 	default:
@@ -641,7 +790,8 @@ func createCertificateProvider(
 // createServiceProvider creates a platform-specific service provider. On Debian,
 // the service provider delegates unit file writes to the file provider for SHA
 // tracking and idempotency. In containers, systemd is not available — returns
-// ErrUnsupported. On other platforms, all operations return ErrUnsupported.
+// ErrUnsupported. On Alpine, the service provider manages OpenRC init scripts
+// the same way. On other platforms, all operations return ErrUnsupported.
 func createServiceProvider(
 	log *slog.Logger,
 	fs avfs.VFS,
@@ -670,6 +820,19 @@ func createServiceProvider(
 			execManager,
 			hostname,
 		)
+	case "alpine":
+		if fileProvider == nil {
+			log.Warn("file provider not available, service operations disabled")
+			return serviceProv.NewLinuxProvider()
+		}
+		return serviceProv.NewAlpineProvider(
+			log,
+			fs,
+			fileProvider,
+			fileStateKV,
+			execManager,
+			hostname,
+		)
 	case "darwin":
 		return serviceProv.NewDarwinProvider()
 	default:
@@ -677,19 +840,293 @@ func createServiceProvider(
 	}
 }
 
-// createNetplanProviders creates platform-specific Netplan interface and route
+// createMountProvider creates a platform-specific mount provider. On Debian,
+// the provider manages marked /etc/fstab entries and reads live mounts from
+// /proc/self/mounts. In containers, mounts belong to the host, so the
+// provider is disabled. On other platforms, all operations return
+// ErrUnsupported.
+func createMountProvider(
+	log *slog.Logger,
+	fs avfs.VFS,
+	execManager exec.Manager,
+) mountProv.Provider {
+	plat := platform.Detect()
+
+	switch plat {
+	case "debian":
+		if platform.IsContainer() {
+			log.Info("running in container, mount operations disabled")
+			return mountProv.NewLinuxProvider()
+		}
+		return mountProv.NewDebianProvider(log, fs, execManager)
+	case "darwin":
+		return mountProv.NewDarwinProvider()
+	default:
+		return mountProv.NewLinuxProvider()
+	}
+}
+
+// createBlockProvider creates a platform-specific block device provider. On
+// Debian, the provider reads devices with lsblk and LVM details with the LVM
+// reporting tools. In containers, block devices belong to the host, so the
+// provider is disabled. On other platforms, all operations return
+// ErrUnsupported.
+func createBlockProvider(
+	log *slog.Logger,
+	execManager exec.Manager,
+) blockProv.Provider {
+	plat := platform.Detect()
+
+	switch plat {
+	case "debian":
+		if platform.IsContainer() {
+			log.Info("running in container, block operations disabled")
+			return blockProv.NewLinuxProvider()
+		}
+		return blockProv.NewDebianProvider(log, execManager)
+	case "darwin":
+		return blockProv.NewDarwinProvider()
+	default:
+		return blockProv.NewLinuxProvider()
+	}
+}
+
+// createSwapProvider creates a platform-specific swap provider. On Debian,
+// swap files are enabled through systemd swap units deployed by the file
+// provider. In containers, swap belongs to the host, so the provider is
+// disabled. On other platforms, all operations return ErrUnsupported.
+func createSwapProvider(
+	log *slog.Logger,
+	fs avfs.VFS,
+	fileProvider fileProv.Provider,
+	fileStateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+) swapProv.Provider {
+	plat := platform.Detect()
+
+	switch plat {
+	case "debian":
+		if platform.IsContainer() {
+			log.Info("running in container, swap operations disabled")
+			return swapProv.NewLinuxProvider()
+		}
+		if fileProvider == nil {
+			log.Warn("file provider not available, swap operations disabled")
+			return swapProv.NewLinuxProvider()
+		}
+		return swapProv.NewDebianProvider(
+			log, fs, fileProvider, fileStateKV, execManager, hostname,
+		)
+	case "darwin":
+		return swapProv.NewDarwinProvider()
+	default:
+		return swapProv.NewLinuxProvider()
+	}
+}
+
+// createKernelProvider creates a platform-specific kernel module provider. On
+// Debian, persistent module configuration is written to modules-load.d and
+// modprobe.d through the file provider. In containers, kernel modules belong
+// to the host, so the provider is disabled. On other platforms, all
+// operations return ErrUnsupported.
+func createKernelProvider(
+	log *slog.Logger,
+	fs avfs.VFS,
+	fileProvider fileProv.Provider,
+	fileStateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+) kernelProv.Provider {
+	plat := platform.Detect()
+
+	switch plat {
+	case "debian":
+		if platform.IsContainer() {
+			log.Info("running in container, kernel module operations disabled")
+			return kernelProv.NewLinuxProvider()
+		}
+		if fileProvider == nil {
+			log.Warn("file provider not available, kernel module operations disabled")
+			return kernelProv.NewLinuxProvider()
+		}
+		return kernelProv.NewDebianProvider(
+			log, fs, fileProvider, fileStateKV, execManager, hostname,
+		)
+	case "darwin":
+		return kernelProv.NewDarwinProvider()
+	default:
+		return kernelProv.NewLinuxProvider()
+	}
+}
+
+// createHostsProvider creates a platform-specific /etc/hosts provider. On
+// Debian, the provider manages a marked block of /etc/hosts entries. In
+// containers, /etc/hosts is generated by the container runtime, so the
+// provider is disabled. On other platforms, all operations return
+// ErrUnsupported.
+func createHostsProvider(
+	log *slog.Logger,
+	fs avfs.VFS,
+) hostsProv.Provider {
+	plat := platform.Detect()
+
+	switch plat {
+	case "debian":
+		if platform.IsContainer() {
+			log.Info("running in container, hosts operations disabled")
+			return hostsProv.NewLinuxProvider()
+		}
+		return hostsProv.NewDebianProvider(log, fs)
+	case "darwin":
+		return hostsProv.NewDarwinProvider()
+	default:
+		return hostsProv.NewLinuxProvider()
+	}
+}
+
+// createSudoersProvider creates a platform-specific sudoers provider. On
+// Debian, drop-ins are rendered into /etc/sudoers.d through the file
+// provider and checked with visudo before they are activated. On other
+// platforms, all operations return ErrUnsupported.
+func createSudoersProvider(
+	log *slog.Logger,
+	fs avfs.VFS,
+	fileProvider fileProv.Provider,
+	fileStateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+) sudoersProv.Provider {
+	plat := platform.Detect()
+
+	switch plat {
+	case "debian":
+		if fileProvider == nil {
+			log.Warn("file provider not available, sudoers operations disabled")
+			return sudoersProv.NewLinuxProvider()
+		}
+		return sudoersProv.NewDebianProvider(
+			log, fs, fileProvider, fileStateKV, execManager, hostname,
+		)
+	case "darwin":
+		return sudoersProv.NewDarwinProvider()
+	default:
+		return sudoersProv.NewLinuxProvider()
+	}
+}
+
+// createSshdProvider creates a platform-specific sshd provider. On Debian,
+// drop-ins are staged in /etc/ssh/sshd_config.d through the file provider,
+// checked with `sshd -t -f` and activated with a rename and a reload. On other
+// platforms, all operations return ErrUnsupported.
+func createSshdProvider(
+	log *slog.Logger,
+	fs avfs.VFS,
+	fileProvider fileProv.Provider,
+	fileStateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+) sshdProv.Provider {
+	plat := platform.Detect()
+
+	switch plat {
+	case "debian":
+		if fileProvider == nil {
+			log.Warn("file provider not available, sshd operations disabled")
+			return sshdProv.NewLinuxProvider()
+		}
+		return sshdProv.NewDebianProvider(
+			log, fs, fileProvider, fileStateKV, execManager, hostname,
+		)
+	case "darwin":
+		return sshdProv.NewDarwinProvider()
+	default:
+		return sshdProv.NewLinuxProvider()
+	}
+}
+
+// useNetworkManager reports whether network configuration on this host
+// should go through NetworkManager instead of Netplan, based on the
+// detected network renderer.
+func useNetworkManager(
+	plat string,
+	renderer string,
+) bool {
+	if renderer != netinfo.RendererNetworkManager {
+		return false
+	}
+
+	return plat == "debian" || plat == "rhel"
+}
+
+// createDNSProvider creates a platform-specific DNS provider. On Debian and
+// RHEL hosts whose network is managed by NetworkManager, DNS is set on the
+// active connection profile with nmcli. Otherwise Debian hosts use Netplan
+// (or /etc/resolv.conf inside containers) and macOS uses networksetup. On
+// other platforms, all operations return ErrUnsupported.
+func createDNSProvider(
+	log *slog.Logger,
+	fs avfs.VFS,
+	fileStateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+	renderer string,
+	guard *netplan.Guard,
+) dns.Provider {
+	plat := platform.Detect()
+
+	if useNetworkManager(plat, renderer) && !platform.IsContainer() {
+		if fileStateKV == nil {
+			log.Warn("file state KV not available, networkmanager dns operations disabled")
+			return dns.NewLinuxProvider()
+		}
+		log.Info("using networkmanager dns backend")
+		return dns.NewNetworkManagerProvider(log, fileStateKV, execManager, hostname)
+	}
+
+	switch plat {
+	case "debian":
+		if platform.IsContainer() {
+			return dns.NewDebianDockerProvider(log, fs)
+		}
+		return dns.NewDebianProvider(log, fs, fileStateKV, execManager, hostname, guard)
+	case "darwin":
+		return dns.NewDarwinProvider(log, execManager)
+	default:
+		return dns.NewLinuxProvider()
+	}
+}
+
+// createNetplanProviders creates platform-specific interface and route
 // providers. On Debian, the providers manage /etc/netplan/ configuration files
-// and track state in the file-state KV. On other platforms, all operations
-// return ErrUnsupported.
+// and track state in the file-state KV. On Debian and RHEL hosts whose
+// network is managed by NetworkManager, the providers manage keyfiles in
+// /etc/NetworkManager/system-connections/ and connection profiles via nmcli
+// instead. On other platforms, all operations return ErrUnsupported.
 func createNetplanProviders(
 	log *slog.Logger,
 	fs avfs.VFS,
 	fileStateKV jetstream.KeyValue,
 	execManager exec.Manager,
 	hostname string,
+	renderer string,
+	guard *netplan.Guard,
 ) (ifaceProv.Provider, routeProv.Provider) {
 	plat := platform.Detect()
 
+	if useNetworkManager(plat, renderer) {
+		if fileStateKV == nil {
+			log.Warn("file state KV not available, networkmanager operations disabled")
+			return ifaceProv.NewLinuxProvider(), routeProv.NewLinuxProvider()
+		}
+		log.Info("using networkmanager interface and route backend")
+		return ifaceProv.NewNetworkManagerProvider(
+				log, fs, fileStateKV, execManager, hostname,
+			), routeProv.NewNetworkManagerProvider(
+				log, fileStateKV, execManager, hostname,
+			)
+	}
+
 	switch plat {
 	case "debian":
 		if fileStateKV == nil {
@@ -697,9 +1134,9 @@ func createNetplanProviders(
 			return ifaceProv.NewLinuxProvider(), routeProv.NewLinuxProvider()
 		}
 		return ifaceProv.NewDebianProvider(
-				log, fs, fileStateKV, execManager, hostname,
+				log, fs, fileStateKV, execManager, hostname, guard,
 			), routeProv.NewDebianProvider(
-				log, fs, fileStateKV, execManager, hostname,
+				log, fs, fileStateKV, execManager, hostname, guard,
 			)
 	case "darwin":
 		return ifaceProv.NewDarwinProvider(), routeProv.NewDarwinProvider()
@@ -707,3 +1144,156 @@ func createNetplanProviders(
 		return ifaceProv.NewLinuxProvider(), routeProv.NewLinuxProvider()
 	}
 }
+
+// createNetplanGuard creates the confirm-or-revert guard shared by the
+// Netplan-backed DNS, interface and route providers. It returns nil when
+// agent.network.safe_apply is disabled or the host is not managed through
+// Netplan, in which case changes apply without waiting for confirmation.
+// A change left unconfirmed by a previous run is recovered before the
+// guard is returned.
+func createNetplanGuard(
+	log *slog.Logger,
+	fs avfs.VFS,
+	stateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+	renderer string,
+) *netplan.Guard {
+	cfg := appConfig.Agent.Network.SafeApply
+	if !cfg.Enabled {
+		return nil
+	}
+
+	plat := platform.Detect()
+	if plat != "debian" || platform.IsContainer() || useNetworkManager(plat, renderer) {
+		log.Warn("safe apply requires a netplan host, network changes apply without confirmation")
+		return nil
+	}
+
+	timeout, err := time.ParseDuration(cfg.Timeout)
+	if err != nil {
+		cli.LogFatal(log, "invalid agent.network.safe_apply.timeout", err)
+	}
+
+	if stateKV == nil {
+		log.Warn("file state KV not available, pending netplan changes are not kept across restarts")
+	}
+
+	log.Info("netplan safe apply enabled", slog.Duration("timeout", timeout))
+
+	guard := netplan.NewGuard(log, fs, stateKV, execManager, hostname, timeout)
+	if err := guard.Recover(); err != nil {
+		log.Error(
+			"failed to recover pending netplan change",
+			slog.String("error", err.Error()),
+		)
+	}
+
+	return guard
+}
+
+// registerNetplanGuardReconnect confirms a pending Netplan change when the
+// agent's NATS connection is re-established after dropping during the
+// change, since the reconnect proves the new configuration still reaches
+// the NATS server. Handlers already set on the connection keep running.
+func registerNetplanGuardReconnect(
+	log *slog.Logger,
+	nc NATSClient,
+	guard *netplan.Guard,
+) {
+	if guard == nil {
+		return
+	}
+
+	client, ok := nc.(*natsclient.Client)
+	if !ok {
+		log.Warn("nats connection does not support reconnect handlers, confirm changes explicitly")
+		return
+	}
+
+	wrapper, ok := client.NC.(*natsclient.NATSConnWrapper)
+	if !ok || wrapper.Conn == nil {
+		log.Warn("nats connection does not support reconnect handlers, confirm changes explicitly")
+		return
+	}
+
+	var (
+		mu             sync.Mutex
+		disconnectedAt time.Time
+	)
+
+	conn := wrapper.Conn
+
+	prevDisconnect := conn.DisconnectErrHandler()
+	conn.SetDisconnectErrHandler(func(nc *nats.Conn, err error) {
+		mu.Lock()
+		disconnectedAt = time.Now()
+		mu.Unlock()
+
+		if prevDisconnect != nil {
+			prevDisconnect(nc, err)
+		}
+	})
+
+	prevReconnect := conn.ReconnectHandler()
+	conn.SetReconnectHandler(func(nc *nats.Conn) {
+		mu.Lock()
+		since := disconnectedAt
+		mu.Unlock()
+
+		if guard.ConfirmReconnect(since) {
+			log.Info("nats reconnected, confirmed pending netplan change")
+		}
+
+		if prevReconnect != nil {
+			prevReconnect(nc)
+		}
+	})
+}
+
+// createFirewallProvider creates a platform-specific firewall provider. On
+// Debian, the provider deploys nftables rule sets to /etc/nftables.d/ via the
+// file provider and validates them with `nft -c` before loading. On other
+// platforms, all operations return ErrUnsupported.
+func createFirewallProvider(
+	log *slog.Logger,
+	fs avfs.VFS,
+	fileProvider fileProv.Provider,
+	fileStateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+) firewallProv.Provider {
+	plat := platform.Detect()
+
+	switch plat {
+	case "debian":
+		if fileProvider == nil {
+			log.Warn("file provider not available, firewall operations disabled")
+			return firewallProv.NewLinuxProvider()
+		}
+		return firewallProv.NewDebianProvider(
+			log, fs, fileProvider, fileStateKV, execManager, hostname,
+		)
+	case "darwin":
+		return firewallProv.NewDarwinProvider()
+	default:
+		return firewallProv.NewLinuxProvider()
+	}
+}
+
+// createSocketProvider creates a platform-specific socket provider. On
+// Linux, sockets are read from /proc/net and attributed to processes via
+// /proc/<pid>/fd. On Darwin, all operations return ErrUnsupported.
+func createSocketProvider(
+	log *slog.Logger,
+	fs avfs.VFS,
+) socketProv.Provider {
+	plat := platform.Detect()
+
+	switch plat {
+	case "darwin":
+		return socketProv.NewDarwinProvider()
+	default:
+		return socketProv.NewLinuxProvider(log, fs)
+	}
+}
@@ -139,6 +139,10 @@ func displayAgentGetDetail(
 		cli.PrintKV("Primary Iface", data.PrimaryInterface)
 	}
 
+	if data.NetworkRenderer != "" {
+		cli.PrintKV("Net Renderer", data.NetworkRenderer)
+	}
+
 	if len(data.Interfaces) > 0 {
 		for _, iface := range data.Interfaces {
 			parts := []string{}
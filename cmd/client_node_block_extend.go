@@ -0,0 +1,112 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osapi-io/osapi/internal/cli"
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+// clientNodeBlockExtendCmd represents the block extend command.
+var clientNodeBlockExtendCmd = &cobra.Command{
+	Use:   "extend",
+	Short: "Extend a logical volume",
+	Long: `Extend an LVM logical volume and, by default, grow the filesystem on it.
+Without --size the volume takes all free space in its volume group.`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		ctx := cmd.Context()
+		host, _ := cmd.Flags().GetString("target")
+		volumeGroup, _ := cmd.Flags().GetString("volume-group")
+		logicalVolume, _ := cmd.Flags().GetString("logical-volume")
+		size, _ := cmd.Flags().GetString("size")
+
+		opts := client.BlockExtendOpts{
+			VolumeGroup:   volumeGroup,
+			LogicalVolume: logicalVolume,
+			Size:          size,
+		}
+
+		if cmd.Flags().Changed("resize-fs") {
+			v, _ := cmd.Flags().GetBool("resize-fs")
+			opts.ResizeFS = &v
+		}
+
+		resp, err := sdkClient.Block.Extend(ctx, host, opts)
+		if err != nil {
+			cli.HandleError(err, logger)
+			return
+		}
+
+		if jsonOutput {
+			fmt.Println(string(resp.RawJSON()))
+			return
+		}
+
+		if resp.Data.JobID != "" {
+			fmt.Println()
+			cli.PrintKV("Job ID", resp.Data.JobID)
+		}
+
+		results := make([]cli.ResultRow, 0, len(resp.Data.Results))
+		for _, r := range resp.Data.Results {
+			var errPtr *string
+			if r.Error != "" {
+				errPtr = &r.Error
+			}
+			changed := r.Changed
+			newSize := ""
+			if r.Size > 0 {
+				newSize = cli.FormatBytes(int(r.Size))
+			}
+			results = append(results, cli.ResultRow{
+				Hostname: r.Hostname,
+				Status:   r.Status,
+				Changed:  &changed,
+				Error:    errPtr,
+				Fields:   []string{r.Name, newSize},
+			})
+		}
+		tr := cli.BuildMutationTable(results, []string{"NAME", "SIZE"})
+		cli.PrintCompactTable(
+			[]cli.Section{{Headers: tr.Headers, Rows: tr.Rows, Errors: tr.Errors}},
+		)
+	},
+}
+
+func init() {
+	clientNodeBlockCmd.AddCommand(clientNodeBlockExtendCmd)
+
+	clientNodeBlockExtendCmd.PersistentFlags().
+		String("volume-group", "", "Volume group holding the logical volume (required)")
+	clientNodeBlockExtendCmd.PersistentFlags().
+		String("logical-volume", "", "Logical volume to extend (required)")
+	clientNodeBlockExtendCmd.PersistentFlags().
+		String("size", "", "New size or increment, e.g. +10G, 50G, +50%FREE (default all free space)")
+	clientNodeBlockExtendCmd.PersistentFlags().
+		Bool("resize-fs", true, "Grow the filesystem on the volume")
+
+	_ = clientNodeBlockExtendCmd.MarkPersistentFlagRequired("volume-group")
+	_ = clientNodeBlockExtendCmd.MarkPersistentFlagRequired("logical-volume")
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osapi-io/osapi/internal/cli"
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+// clientNodeBlockListCmd represents the block list command.
+var clientNodeBlockListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List block devices and LVM volumes",
+	Long: `List the block device tree, filesystems and LVM physical volumes,
+volume groups and logical volumes on the target node.`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		ctx := cmd.Context()
+		host, _ := cmd.Flags().GetString("target")
+
+		resp, err := sdkClient.Block.List(ctx, host)
+		if err != nil {
+			cli.HandleError(err, logger)
+			return
+		}
+
+		if jsonOutput {
+			fmt.Println(string(resp.RawJSON()))
+			return
+		}
+
+		if resp.Data.JobID != "" {
+			fmt.Println()
+			cli.PrintKV("Job ID", resp.Data.JobID)
+			fmt.Println()
+		}
+
+		devices := make([]cli.ResultRow, 0)
+		volumes := make([]cli.ResultRow, 0)
+		for _, r := range resp.Data.Results {
+			if r.Error != "" {
+				e := r.Error
+				devices = append(devices, cli.ResultRow{
+					Hostname: r.Hostname,
+					Status:   r.Status,
+					Error:    &e,
+				})
+
+				continue
+			}
+
+			for _, row := range blockDeviceRows(r.Devices, 0) {
+				devices = append(devices, cli.ResultRow{
+					Hostname: r.Hostname,
+					Status:   r.Status,
+					Fields:   row,
+				})
+			}
+
+			for _, lv := range r.LogicalVolumes {
+				vgFree := ""
+				for _, vg := range r.VolumeGroups {
+					if vg.Name == lv.VolumeGroup {
+						vgFree = cli.FormatBytes(int(vg.Free))
+					}
+				}
+
+				volumes = append(volumes, cli.ResultRow{
+					Hostname: r.Hostname,
+					Status:   r.Status,
+					Fields: []string{
+						lv.VolumeGroup,
+						lv.Name,
+						cli.FormatBytes(int(lv.Size)),
+						vgFree,
+						lv.Path,
+					},
+				})
+			}
+		}
+
+		dt := cli.BuildBroadcastTable(
+			devices,
+			[]string{"NAME", "TYPE", "SIZE", "FSTYPE", "UUID", "MOUNTPOINT"},
+		)
+		sections := []cli.Section{
+			{Title: "Devices", Headers: dt.Headers, Rows: dt.Rows, Errors: dt.Errors},
+		}
+
+		if len(volumes) > 0 {
+			vt := cli.BuildBroadcastTable(
+				volumes,
+				[]string{"VG", "LV", "SIZE", "VG FREE", "PATH"},
+			)
+			sections = append(sections, cli.Section{
+				Title:   "Logical Volumes",
+				Headers: vt.Headers,
+				Rows:    vt.Rows,
+			})
+		}
+
+		for _, sec := range sections {
+			cli.PrintCompactTable([]cli.Section{sec})
+		}
+	},
+}
+
+// blockDeviceRows flattens a device tree into table rows, indenting
+// child device names by depth.
+func blockDeviceRows(
+	devices []client.BlockDevice,
+	depth int,
+) [][]string {
+	rows := make([][]string, 0, len(devices))
+	for _, d := range devices {
+		name := d.Name
+		if depth > 0 {
+			name = strings.Repeat("  ", depth-1) + "└─" + d.Name
+		}
+
+		rows = append(rows, []string{
+			name,
+			d.Type,
+			cli.FormatBytes(int(d.Size)),
+			d.FSType,
+			d.UUID,
+			d.MountPoint,
+		})
+		rows = append(rows, blockDeviceRows(d.Children, depth+1)...)
+	}
+
+	return rows
+}
+
+func init() {
+	clientNodeBlockCmd.AddCommand(clientNodeBlockListCmd)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osapi-io/osapi/internal/cli"
+)
+
+// clientNodeHostsGetCmd represents the hosts get command.
+var clientNodeHostsGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get an /etc/hosts entry",
+	Long:  `Get the /etc/hosts entry for an IP address on the target node.`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		ctx := cmd.Context()
+		host, _ := cmd.Flags().GetString("target")
+		address, _ := cmd.Flags().GetString("address")
+
+		resp, err := sdkClient.Hosts.Get(ctx, host, address)
+		if err != nil {
+			cli.HandleError(err, logger)
+			return
+		}
+
+		if jsonOutput {
+			fmt.Println(string(resp.RawJSON()))
+			return
+		}
+
+		if resp.Data.JobID != "" {
+			fmt.Println()
+			cli.PrintKV("Job ID", resp.Data.JobID)
+			fmt.Println()
+		}
+
+		results := make([]cli.ResultRow, 0)
+		for _, r := range resp.Data.Results {
+			if r.Error != "" {
+				var errPtr *string
+				e := r.Error
+				errPtr = &e
+				results = append(results, cli.ResultRow{
+					Hostname: r.Hostname,
+					Status:   r.Status,
+					Error:    errPtr,
+				})
+
+				continue
+			}
+
+			if r.Entry != nil {
+				e := r.Entry
+				results = append(results, cli.ResultRow{
+					Hostname: r.Hostname,
+					Status:   r.Status,
+					Fields: []string{
+						e.Address,
+						strings.Join(e.Hostnames, ","),
+						fmt.Sprintf("%t", e.Managed),
+						fmt.Sprintf("%t", e.Drifted),
+					},
+				})
+			}
+		}
+		tr := cli.BuildBroadcastTable(
+			results,
+			[]string{"ADDRESS", "HOSTNAMES", "MANAGED", "DRIFTED"},
+		)
+		cli.PrintCompactTable(
+			[]cli.Section{{Headers: tr.Headers, Rows: tr.Rows, Errors: tr.Errors}},
+		)
+	},
+}
+
+func init() {
+	clientNodeHostsCmd.AddCommand(clientNodeHostsGetCmd)
+
+	clientNodeHostsGetCmd.PersistentFlags().
+		String("address", "", "IP address of the entry (required)")
+
+	_ = clientNodeHostsGetCmd.MarkPersistentFlagRequired("address")
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osapi-io/osapi/internal/cli"
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+// clientNodeKernelModuleUpdateCmd represents the kernel module update command.
+var clientNodeKernelModuleUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update a managed kernel module",
+	Long: `Replace the managed configuration of a kernel module. The change is
+also applied at runtime with modprobe.`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		ctx := cmd.Context()
+		host, _ := cmd.Flags().GetString("target")
+		name, _ := cmd.Flags().GetString("name")
+		load, _ := cmd.Flags().GetBool("load")
+		blacklist, _ := cmd.Flags().GetBool("blacklist")
+		options, _ := cmd.Flags().GetString("options")
+
+		resp, err := sdkClient.Kernel.UpdateModule(ctx, host, name, client.KernelModuleUpdateOpts{
+			Load:      load,
+			Blacklist: blacklist,
+			Options:   options,
+		})
+		if err != nil {
+			cli.HandleError(err, logger)
+			return
+		}
+
+		if jsonOutput {
+			fmt.Println(string(resp.RawJSON()))
+			return
+		}
+
+		if resp.Data.JobID != "" {
+			fmt.Println()
+			cli.PrintKV("Job ID", resp.Data.JobID)
+		}
+
+		results := make([]cli.ResultRow, 0, len(resp.Data.Results))
+		for _, r := range resp.Data.Results {
+			var errPtr *string
+			if r.Error != "" {
+				errPtr = &r.Error
+			}
+			changed := r.Changed
+			results = append(results, cli.ResultRow{
+				Hostname: r.Hostname,
+				Status:   r.Status,
+				Changed:  &changed,
+				Error:    errPtr,
+				Fields:   []string{r.Name},
+			})
+		}
+		tr := cli.BuildMutationTable(results, []string{"NAME"})
+		cli.PrintCompactTable(
+			[]cli.Section{{Headers: tr.Headers, Rows: tr.Rows, Errors: tr.Errors}},
+		)
+	},
+}
+
+func init() {
+	clientNodeKernelModuleCmd.AddCommand(clientNodeKernelModuleUpdateCmd)
+
+	clientNodeKernelModuleUpdateCmd.PersistentFlags().
+		String("name", "", "Kernel module name (required)")
+	clientNodeKernelModuleUpdateCmd.PersistentFlags().
+		Bool("load", false, "Load the module now and at every boot")
+	clientNodeKernelModuleUpdateCmd.PersistentFlags().
+		Bool("blacklist", false, "Blacklist the module and unload it if possible")
+	clientNodeKernelModuleUpdateCmd.PersistentFlags().
+		String("options", "", "Space-separated module parameters, e.g. \"debug=1\"")
+
+	_ = clientNodeKernelModuleUpdateCmd.MarkPersistentFlagRequired("name")
+}
@@ -55,6 +55,28 @@ var clientNodeLogQueryCmd = &cobra.Command{
 			opts.Priority = &priority
 		}
 
+		until, _ := cmd.Flags().GetString("until")
+		if until != "" {
+			opts.Until = &until
+		}
+
+		boot, _ := cmd.Flags().GetString("boot")
+		if boot != "" {
+			opts.Boot = &boot
+		}
+
+		grep, _ := cmd.Flags().GetString("grep")
+		if grep != "" {
+			opts.Grep = &grep
+		}
+
+		opts.Fields, _ = cmd.Flags().GetStringArray("field")
+
+		if cmd.Flags().Changed("reverse") {
+			reverse, _ := cmd.Flags().GetBool("reverse")
+			opts.Reverse = &reverse
+		}
+
 		resp, err := sdkClient.Log.Query(ctx, host, opts)
 		if err != nil {
 			cli.HandleError(err, logger)
@@ -123,4 +145,14 @@ func init() {
 		String("since", "", "Return entries since this time (e.g., '1h', '2026-01-01 00:00:00')")
 	clientNodeLogQueryCmd.PersistentFlags().
 		String("priority", "", "Filter by priority level (e.g., 'err', 'warning', 'info')")
+	clientNodeLogQueryCmd.PersistentFlags().
+		String("until", "", "Return entries until this time (e.g., '2026-01-01 12:00:00')")
+	clientNodeLogQueryCmd.PersistentFlags().
+		String("boot", "", "Restrict to a boot (offset like '0' or '-1', or a boot ID)")
+	clientNodeLogQueryCmd.PersistentFlags().
+		String("grep", "", "Only return entries whose message matches this pattern")
+	clientNodeLogQueryCmd.PersistentFlags().
+		StringArray("field", []string{}, "Journal field match as FIELD=value (repeatable)")
+	clientNodeLogQueryCmd.PersistentFlags().
+		Bool("reverse", false, "Return newest entries first")
 }
@@ -21,6 +21,8 @@
 package cmd
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -33,12 +35,21 @@ import (
 var clientNodeLogUnitCmd = &cobra.Command{
 	Use:   "unit",
 	Short: "Query journal entries for a unit",
-	Long:  `Query journal log entries for a specific systemd unit on the target node.`,
+	Long: `Query journal log entries for a specific systemd unit on the target node.
+
+With --follow, new entries are streamed as they are written until the
+duration elapses or the command is interrupted. Follow requires a single
+target hostname.`,
 	Run: func(cmd *cobra.Command, _ []string) {
 		ctx := cmd.Context()
 		host, _ := cmd.Flags().GetString("target")
 		unit, _ := cmd.Flags().GetString("name")
 
+		if follow, _ := cmd.Flags().GetBool("follow"); follow {
+			followLogUnit(ctx, cmd, host, unit)
+			return
+		}
+
 		opts := client.LogQueryOpts{}
 
 		if cmd.Flags().Changed("lines") {
@@ -56,6 +67,28 @@ var clientNodeLogUnitCmd = &cobra.Command{
 			opts.Priority = &priority
 		}
 
+		until, _ := cmd.Flags().GetString("until")
+		if until != "" {
+			opts.Until = &until
+		}
+
+		boot, _ := cmd.Flags().GetString("boot")
+		if boot != "" {
+			opts.Boot = &boot
+		}
+
+		grep, _ := cmd.Flags().GetString("grep")
+		if grep != "" {
+			opts.Grep = &grep
+		}
+
+		opts.Fields, _ = cmd.Flags().GetStringArray("field")
+
+		if cmd.Flags().Changed("reverse") {
+			reverse, _ := cmd.Flags().GetBool("reverse")
+			opts.Reverse = &reverse
+		}
+
 		resp, err := sdkClient.Log.QueryUnit(ctx, host, unit, opts)
 		if err != nil {
 			cli.HandleError(err, logger)
@@ -115,6 +148,48 @@ var clientNodeLogUnitCmd = &cobra.Command{
 	},
 }
 
+// followLogUnit streams journal entries for a unit and prints each one as
+// it arrives.
+func followLogUnit(
+	ctx context.Context,
+	cmd *cobra.Command,
+	host string,
+	unit string,
+) {
+	opts := client.LogFollowOpts{}
+
+	if cmd.Flags().Changed("lines") {
+		lines, _ := cmd.Flags().GetInt("lines")
+		opts.Lines = &lines
+	}
+
+	priority, _ := cmd.Flags().GetString("priority")
+	if priority != "" {
+		opts.Priority = &priority
+	}
+
+	if cmd.Flags().Changed("duration") {
+		duration, _ := cmd.Flags().GetInt("duration")
+		opts.Duration = &duration
+	}
+
+	entries, err := sdkClient.Log.FollowUnit(ctx, host, unit, opts)
+	if err != nil {
+		cli.HandleError(err, logger)
+		return
+	}
+
+	for e := range entries {
+		if jsonOutput {
+			out, _ := json.Marshal(e)
+			fmt.Println(string(out))
+			continue
+		}
+
+		fmt.Printf("%s  %s  %s\n", e.Timestamp, e.Unit, e.Message)
+	}
+}
+
 func init() {
 	clientNodeLogCmd.AddCommand(clientNodeLogUnitCmd)
 
@@ -126,6 +201,20 @@ func init() {
 		String("since", "", "Return entries since this time (e.g., '1h', '2026-01-01 00:00:00')")
 	clientNodeLogUnitCmd.PersistentFlags().
 		String("priority", "", "Filter by priority level (e.g., 'err', 'warning', 'info')")
+	clientNodeLogUnitCmd.PersistentFlags().
+		String("until", "", "Return entries until this time (e.g., '2026-01-01 12:00:00')")
+	clientNodeLogUnitCmd.PersistentFlags().
+		String("boot", "", "Restrict to a boot (offset like '0' or '-1', or a boot ID)")
+	clientNodeLogUnitCmd.PersistentFlags().
+		String("grep", "", "Only return entries whose message matches this pattern")
+	clientNodeLogUnitCmd.PersistentFlags().
+		StringArray("field", []string{}, "Journal field match as FIELD=value (repeatable)")
+	clientNodeLogUnitCmd.PersistentFlags().
+		Bool("reverse", false, "Return newest entries first")
+	clientNodeLogUnitCmd.PersistentFlags().
+		Bool("follow", false, "Stream new entries as they are written")
+	clientNodeLogUnitCmd.PersistentFlags().
+		Int("duration", 60, "Seconds to follow before the stream ends (with --follow)")
 
 	_ = clientNodeLogUnitCmd.MarkPersistentFlagRequired("name")
 }
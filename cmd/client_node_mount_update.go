@@ -0,0 +1,117 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osapi-io/osapi/internal/cli"
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+// clientNodeMountUpdateCmd represents the mount update command.
+var clientNodeMountUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update a managed fstab entry",
+	Run: func(cmd *cobra.Command, _ []string) {
+		ctx := cmd.Context()
+		host, _ := cmd.Flags().GetString("target")
+		name, _ := cmd.Flags().GetString("name")
+		device, _ := cmd.Flags().GetString("device")
+		path, _ := cmd.Flags().GetString("path")
+		fsType, _ := cmd.Flags().GetString("fs-type")
+		options, _ := cmd.Flags().GetString("options")
+		dump, _ := cmd.Flags().GetInt("dump")
+		pass, _ := cmd.Flags().GetInt("pass")
+
+		opts := client.MountUpdateOpts{
+			Device:  device,
+			Path:    path,
+			FSType:  fsType,
+			Options: options,
+		}
+		if cmd.Flags().Changed("dump") {
+			opts.Dump = &dump
+		}
+		if cmd.Flags().Changed("pass") {
+			opts.Pass = &pass
+		}
+
+		resp, err := sdkClient.Mount.Update(ctx, host, name, opts)
+		if err != nil {
+			cli.HandleError(err, logger)
+			return
+		}
+
+		if jsonOutput {
+			fmt.Println(string(resp.RawJSON()))
+			return
+		}
+
+		if resp.Data.JobID != "" {
+			fmt.Println()
+			cli.PrintKV("Job ID", resp.Data.JobID)
+		}
+
+		results := make([]cli.ResultRow, 0, len(resp.Data.Results))
+		for _, r := range resp.Data.Results {
+			var errPtr *string
+			if r.Error != "" {
+				errPtr = &r.Error
+			}
+			changed := r.Changed
+			results = append(results, cli.ResultRow{
+				Hostname: r.Hostname,
+				Status:   r.Status,
+				Changed:  &changed,
+				Error:    errPtr,
+				Fields:   []string{r.Name},
+			})
+		}
+		tr := cli.BuildMutationTable(results, []string{"NAME"})
+		cli.PrintCompactTable(
+			[]cli.Section{{Headers: tr.Headers, Rows: tr.Rows, Errors: tr.Errors}},
+		)
+	},
+}
+
+func init() {
+	clientNodeMountCmd.AddCommand(clientNodeMountUpdateCmd)
+
+	clientNodeMountUpdateCmd.PersistentFlags().
+		String("name", "", "Mount entry name (required)")
+	clientNodeMountUpdateCmd.PersistentFlags().
+		String("device", "", "Device, UUID=, LABEL=, or remote source to mount")
+	clientNodeMountUpdateCmd.PersistentFlags().
+		String("path", "", "Absolute mount point")
+	clientNodeMountUpdateCmd.PersistentFlags().
+		String("fs-type", "", "Filesystem type, e.g. ext4, xfs, nfs")
+	clientNodeMountUpdateCmd.PersistentFlags().
+		String("options", "", "Comma-separated mount options")
+	clientNodeMountUpdateCmd.PersistentFlags().
+		Int("dump", 0, "Dump frequency (fstab field five)")
+	clientNodeMountUpdateCmd.PersistentFlags().
+		Int("pass", 0, "Filesystem check order (fstab field six)")
+
+	_ = clientNodeMountUpdateCmd.MarkPersistentFlagRequired("name")
+}
@@ -120,6 +120,28 @@ func buildInterfaceConfigOpts(
 		opts.WakeOnLAN = &v
 	}
 
+	if cmd.Flags().Changed("vlan-id") || cmd.Flags().Changed("vlan-link") {
+		opts.VLAN = &client.InterfaceVLAN{}
+		opts.VLAN.ID, _ = cmd.Flags().GetInt("vlan-id")
+		opts.VLAN.Link, _ = cmd.Flags().GetString("vlan-link")
+	}
+
+	if cmd.Flags().Changed("bond-mode") || cmd.Flags().Changed("bond-members") {
+		opts.Bond = &client.InterfaceBond{}
+		opts.Bond.Mode, _ = cmd.Flags().GetString("bond-mode")
+		opts.Bond.Members, _ = cmd.Flags().GetStringSlice("bond-members")
+		opts.Bond.MIIMonitor, _ = cmd.Flags().GetInt("bond-miimon")
+	}
+
+	if cmd.Flags().Changed("bridge-members") || cmd.Flags().Changed("bridge-stp") {
+		opts.Bridge = &client.InterfaceBridge{}
+		opts.Bridge.Members, _ = cmd.Flags().GetStringSlice("bridge-members")
+		if cmd.Flags().Changed("bridge-stp") {
+			v, _ := cmd.Flags().GetBool("bridge-stp")
+			opts.Bridge.STP = &v
+		}
+	}
+
 	return opts
 }
 
@@ -144,6 +166,20 @@ func init() {
 		String("mac-address", "", "Hardware MAC address")
 	clientNodeNetworkInterfaceCreateCmd.PersistentFlags().
 		Bool("wakeonlan", false, "Enable Wake-on-LAN")
+	clientNodeNetworkInterfaceCreateCmd.PersistentFlags().
+		Int("vlan-id", 0, "VLAN ID (1-4094)")
+	clientNodeNetworkInterfaceCreateCmd.PersistentFlags().
+		String("vlan-link", "", "Parent interface of the VLAN")
+	clientNodeNetworkInterfaceCreateCmd.PersistentFlags().
+		String("bond-mode", "", "Bonding mode (e.g., active-backup, 802.3ad)")
+	clientNodeNetworkInterfaceCreateCmd.PersistentFlags().
+		StringSlice("bond-members", []string{}, "Bond member interface (repeatable)")
+	clientNodeNetworkInterfaceCreateCmd.PersistentFlags().
+		Int("bond-miimon", 0, "Bond link monitoring interval in milliseconds")
+	clientNodeNetworkInterfaceCreateCmd.PersistentFlags().
+		StringSlice("bridge-members", []string{}, "Bridge member interface (repeatable)")
+	clientNodeNetworkInterfaceCreateCmd.PersistentFlags().
+		Bool("bridge-stp", false, "Enable spanning tree protocol on the bridge")
 
 	_ = clientNodeNetworkInterfaceCreateCmd.MarkPersistentFlagRequired("name")
 }
@@ -75,6 +75,24 @@ var clientNodeNetworkInterfaceGetCmd = &cobra.Command{
 				cli.PrintKV("MAC Address", iface.MACAddress)
 				cli.PrintKV("Wake-on-LAN", fmt.Sprintf("%t", iface.WakeOnLAN))
 				cli.PrintKV("State", iface.State)
+				if iface.Type != "" {
+					cli.PrintKV("Type", iface.Type)
+				}
+				if iface.VLAN != nil {
+					cli.PrintKV("VLAN ID", fmt.Sprintf("%d", iface.VLAN.ID))
+					cli.PrintKV("VLAN Link", iface.VLAN.Link)
+				}
+				if iface.Bond != nil {
+					cli.PrintKV("Bond Mode", iface.Bond.Mode)
+					cli.PrintKV("Bond Members", cli.FormatList(iface.Bond.Members))
+					cli.PrintKV("Bond MII Monitor", fmt.Sprintf("%d", iface.Bond.MIIMonitor))
+				}
+				if iface.Bridge != nil {
+					cli.PrintKV("Bridge Members", cli.FormatList(iface.Bridge.Members))
+					if iface.Bridge.STP != nil {
+						cli.PrintKV("Bridge STP", fmt.Sprintf("%t", *iface.Bridge.STP))
+					}
+				}
 			}
 		}
 	},
@@ -98,6 +98,20 @@ func init() {
 		String("mac-address", "", "Hardware MAC address")
 	clientNodeNetworkInterfaceUpdateCmd.PersistentFlags().
 		Bool("wakeonlan", false, "Enable Wake-on-LAN")
+	clientNodeNetworkInterfaceUpdateCmd.PersistentFlags().
+		Int("vlan-id", 0, "VLAN ID (1-4094)")
+	clientNodeNetworkInterfaceUpdateCmd.PersistentFlags().
+		String("vlan-link", "", "Parent interface of the VLAN")
+	clientNodeNetworkInterfaceUpdateCmd.PersistentFlags().
+		String("bond-mode", "", "Bonding mode (e.g., active-backup, 802.3ad)")
+	clientNodeNetworkInterfaceUpdateCmd.PersistentFlags().
+		StringSlice("bond-members", []string{}, "Bond member interface (repeatable)")
+	clientNodeNetworkInterfaceUpdateCmd.PersistentFlags().
+		Int("bond-miimon", 0, "Bond link monitoring interval in milliseconds")
+	clientNodeNetworkInterfaceUpdateCmd.PersistentFlags().
+		StringSlice("bridge-members", []string{}, "Bridge member interface (repeatable)")
+	clientNodeNetworkInterfaceUpdateCmd.PersistentFlags().
+		Bool("bridge-stp", false, "Enable spanning tree protocol on the bridge")
 
 	_ = clientNodeNetworkInterfaceUpdateCmd.MarkPersistentFlagRequired("name")
 }
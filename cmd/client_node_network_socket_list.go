@@ -0,0 +1,128 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osapi-io/osapi/internal/cli"
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+// clientNodeNetworkSocketListCmd represents the socket list command.
+var clientNodeNetworkSocketListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List listening sockets and established connections",
+	Long: `List TCP and UDP listening sockets and established connections on the
+target node, with the process that owns each socket.`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		ctx := cmd.Context()
+		host, _ := cmd.Flags().GetString("target")
+
+		var opts client.SocketListOpts
+		opts.Protocol, _ = cmd.Flags().GetString("protocol")
+		opts.State, _ = cmd.Flags().GetString("state")
+		opts.Port, _ = cmd.Flags().GetInt("port")
+		opts.PID, _ = cmd.Flags().GetInt("pid")
+
+		resp, err := sdkClient.Socket.List(ctx, host, opts)
+		if err != nil {
+			cli.HandleError(err, logger)
+			return
+		}
+
+		if jsonOutput {
+			fmt.Println(string(resp.RawJSON()))
+			return
+		}
+
+		if resp.Data.JobID != "" {
+			fmt.Println()
+			cli.PrintKV("Job ID", resp.Data.JobID)
+			fmt.Println()
+		}
+
+		results := make([]cli.ResultRow, 0)
+		for _, r := range resp.Data.Results {
+			if r.Error != "" {
+				var errPtr *string
+				e := r.Error
+				errPtr = &e
+				results = append(results, cli.ResultRow{
+					Hostname: r.Hostname,
+					Status:   r.Status,
+					Error:    errPtr,
+				})
+
+				continue
+			}
+
+			for _, s := range r.Sockets {
+				remote := ""
+				if s.RemoteAddress != "" {
+					remote = net.JoinHostPort(s.RemoteAddress, strconv.Itoa(s.RemotePort))
+				}
+
+				pid := ""
+				if s.PID != 0 {
+					pid = strconv.Itoa(s.PID)
+				}
+
+				results = append(results, cli.ResultRow{
+					Hostname: r.Hostname,
+					Status:   r.Status,
+					Fields: []string{
+						s.Protocol,
+						s.State,
+						net.JoinHostPort(s.LocalAddress, strconv.Itoa(s.LocalPort)),
+						remote,
+						pid,
+						s.Process,
+					},
+				})
+			}
+		}
+		tr := cli.BuildBroadcastTable(
+			results,
+			[]string{"PROTO", "STATE", "LOCAL", "REMOTE", "PID", "PROCESS"},
+		)
+		cli.PrintCompactTable(
+			[]cli.Section{{Headers: tr.Headers, Rows: tr.Rows, Errors: tr.Errors}},
+		)
+	},
+}
+
+func init() {
+	clientNodeNetworkSocketCmd.AddCommand(clientNodeNetworkSocketListCmd)
+
+	clientNodeNetworkSocketListCmd.PersistentFlags().
+		String("protocol", "", "Only show sockets of this protocol (tcp or udp)")
+	clientNodeNetworkSocketListCmd.PersistentFlags().
+		String("state", "", "Only show sockets in this state (listen or established)")
+	clientNodeNetworkSocketListCmd.PersistentFlags().
+		Int("port", 0, "Only show sockets whose local or remote port matches")
+	clientNodeNetworkSocketListCmd.PersistentFlags().
+		Int("pid", 0, "Only show sockets owned by this process ID")
+}
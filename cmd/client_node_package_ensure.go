@@ -0,0 +1,155 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osapi-io/osapi/internal/cli"
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+// clientNodePackageEnsureCmd represents the package ensure command.
+var clientNodePackageEnsureCmd = &cobra.Command{
+	Use:   "ensure",
+	Short: "Converge packages to desired states",
+	Long: `Converge packages on the target node to a manifest of desired states.
+
+Package format: NAME:STATE or NAME:STATE:VERSION
+STATE is one of present, absent, latest, or pinned. Pinned requires a
+version and holds the package at that version.
+  --package nginx:pinned:1.24.0-1
+  --package curl:latest
+  --package telnet:absent`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		ctx := cmd.Context()
+		host, _ := cmd.Flags().GetString("target")
+		pkgStrs, _ := cmd.Flags().GetStringSlice("package")
+
+		opts, err := parsePackageEnsureFlags(pkgStrs)
+		if err != nil {
+			fmt.Println("Error:", err)
+			return
+		}
+
+		resp, err := sdkClient.Package.Ensure(ctx, host, opts)
+		if err != nil {
+			cli.HandleError(err, logger)
+			return
+		}
+
+		if jsonOutput {
+			fmt.Println(string(resp.RawJSON()))
+			return
+		}
+
+		if resp.Data.JobID != "" {
+			fmt.Println()
+			cli.PrintKV("Job ID", resp.Data.JobID)
+		}
+
+		results := make([]cli.ResultRow, 0)
+		for _, r := range resp.Data.Results {
+			if r.Error != "" || len(r.Packages) == 0 {
+				var errPtr *string
+				if r.Error != "" {
+					errPtr = &r.Error
+				}
+				changed := r.Changed
+				results = append(results, cli.ResultRow{
+					Hostname: r.Hostname,
+					Status:   r.Status,
+					Changed:  &changed,
+					Error:    errPtr,
+					Fields:   []string{""},
+				})
+				continue
+			}
+
+			for _, p := range r.Packages {
+				status := r.Status
+				var errPtr *string
+				if p.Error != "" {
+					status = "failed"
+					e := p.Error
+					errPtr = &e
+				}
+				changed := p.Changed
+				results = append(results, cli.ResultRow{
+					Hostname: r.Hostname,
+					Status:   status,
+					Changed:  &changed,
+					Error:    errPtr,
+					Fields:   []string{p.Name},
+				})
+			}
+		}
+		tr := cli.BuildMutationTable(results, []string{"NAME"})
+		cli.PrintCompactTable(
+			[]cli.Section{{Headers: tr.Headers, Rows: tr.Rows, Errors: tr.Errors}},
+		)
+	},
+}
+
+// parsePackageEnsureFlags parses package flag values in NAME:STATE or
+// NAME:STATE:VERSION format. The version may itself contain colons
+// (e.g. a Debian epoch).
+func parsePackageEnsureFlags(
+	pkgStrs []string,
+) (client.PackageEnsureOpts, error) {
+	opts := client.PackageEnsureOpts{
+		Packages: make([]client.PackageEnsureEntry, 0, len(pkgStrs)),
+	}
+
+	for _, ps := range pkgStrs {
+		parts := strings.SplitN(ps, ":", 3)
+		if len(parts) < 2 {
+			return opts, fmt.Errorf(
+				"invalid package format %q: expected NAME:STATE or NAME:STATE:VERSION", ps,
+			)
+		}
+
+		entry := client.PackageEnsureEntry{
+			Name:  parts[0],
+			State: parts[1],
+		}
+
+		if len(parts) == 3 {
+			entry.Version = parts[2]
+		}
+
+		opts.Packages = append(opts.Packages, entry)
+	}
+
+	return opts, nil
+}
+
+func init() {
+	clientNodePackageCmd.AddCommand(clientNodePackageEnsureCmd)
+
+	clientNodePackageEnsureCmd.PersistentFlags().
+		StringSlice("package", []string{}, "Package in NAME:STATE or NAME:STATE:VERSION format (repeatable, required)")
+
+	_ = clientNodePackageEnsureCmd.MarkPersistentFlagRequired("package")
+}
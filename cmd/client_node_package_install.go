@@ -26,6 +26,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/osapi-io/osapi/internal/cli"
+	"github.com/osapi-io/osapi/pkg/sdk/client"
 )
 
 // clientNodePackageInstallCmd represents the package install command.
@@ -36,8 +37,14 @@ var clientNodePackageInstallCmd = &cobra.Command{
 		ctx := cmd.Context()
 		host, _ := cmd.Flags().GetString("target")
 		name, _ := cmd.Flags().GetString("name")
+		version, _ := cmd.Flags().GetString("version")
+		object, _ := cmd.Flags().GetString("object")
 
-		resp, err := sdkClient.Package.Install(ctx, host, name)
+		resp, err := sdkClient.Package.Install(ctx, host, client.PackageInstallOpts{
+			Name:    name,
+			Version: version,
+			Object:  object,
+		})
 		if err != nil {
 			cli.HandleError(err, logger)
 			return
@@ -79,7 +86,13 @@ func init() {
 	clientNodePackageCmd.AddCommand(clientNodePackageInstallCmd)
 
 	clientNodePackageInstallCmd.PersistentFlags().
-		String("name", "", "Name of the package to install (required)")
+		String("name", "", "Name of the package to install")
+	clientNodePackageInstallCmd.PersistentFlags().
+		String("version", "", "Exact version to install (defaults to the candidate version)")
+	clientNodePackageInstallCmd.PersistentFlags().
+		String("object", "", "Object Store reference for a .deb, .rpm, or .apk to install")
 
-	_ = clientNodePackageInstallCmd.MarkPersistentFlagRequired("name")
+	clientNodePackageInstallCmd.MarkFlagsOneRequired("name", "object")
+	clientNodePackageInstallCmd.MarkFlagsMutuallyExclusive("name", "object")
+	clientNodePackageInstallCmd.MarkFlagsMutuallyExclusive("version", "object")
 }
@@ -22,6 +22,8 @@ package cmd
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -56,6 +58,9 @@ var clientNodeProcessGetCmd = &cobra.Command{
 		}
 
 		results := make([]cli.ResultRow, 0)
+		details := make([]cli.ResultRow, 0)
+		openFiles := make([]cli.ResultRow, 0)
+		limits := make([]cli.ResultRow, 0)
 		for _, r := range resp.Data.Results {
 			if r.Error != "" {
 				var errPtr *string
@@ -88,15 +93,86 @@ var clientNodeProcessGetCmd = &cobra.Command{
 						command,
 					},
 				})
+
+				children := make([]string, 0, len(p.Children))
+				for _, c := range p.Children {
+					children = append(children, strconv.Itoa(c))
+				}
+
+				details = append(details, cli.ResultRow{
+					Hostname: r.Hostname,
+					Status:   r.Status,
+					Fields: []string{
+						strconv.Itoa(p.PPID),
+						strconv.Itoa(p.Threads),
+						strconv.Itoa(p.FDCount),
+						strings.Join(children, ","),
+						p.Cgroup,
+						strings.Join(p.EnvKeys, ","),
+					},
+				})
+
+				for _, f := range p.OpenFiles {
+					openFiles = append(openFiles, cli.ResultRow{
+						Hostname: r.Hostname,
+						Status:   r.Status,
+						Fields:   []string{strconv.Itoa(f.FD), f.Path},
+					})
+				}
+
+				for _, l := range p.Limits {
+					limits = append(limits, cli.ResultRow{
+						Hostname: r.Hostname,
+						Status:   r.Status,
+						Fields:   []string{l.Name, l.Soft, l.Hard, l.Units},
+					})
+				}
 			}
 		}
 		tr := cli.BuildBroadcastTable(
 			results,
 			[]string{"PID", "NAME", "USER", "STATE", "CPU%", "COMMAND"},
 		)
-		cli.PrintCompactTable(
-			[]cli.Section{{Headers: tr.Headers, Rows: tr.Rows, Errors: tr.Errors}},
-		)
+		sections := []cli.Section{
+			{Headers: tr.Headers, Rows: tr.Rows, Errors: tr.Errors},
+		}
+
+		if len(details) > 0 {
+			dt := cli.BuildBroadcastTable(
+				details,
+				[]string{"PPID", "THREADS", "FDS", "CHILDREN", "CGROUP", "ENV KEYS"},
+			)
+			sections = append(sections, cli.Section{
+				Title:   "Details",
+				Headers: dt.Headers,
+				Rows:    dt.Rows,
+			})
+		}
+
+		if len(openFiles) > 0 {
+			ft := cli.BuildBroadcastTable(openFiles, []string{"FD", "PATH"})
+			sections = append(sections, cli.Section{
+				Title:   "Open Files (sample)",
+				Headers: ft.Headers,
+				Rows:    ft.Rows,
+			})
+		}
+
+		if len(limits) > 0 {
+			lt := cli.BuildBroadcastTable(
+				limits,
+				[]string{"LIMIT", "SOFT", "HARD", "UNITS"},
+			)
+			sections = append(sections, cli.Section{
+				Title:   "Limits",
+				Headers: lt.Headers,
+				Rows:    lt.Rows,
+			})
+		}
+
+		for _, sec := range sections {
+			cli.PrintCompactTable([]cli.Section{sec})
+		}
 	},
 }
 
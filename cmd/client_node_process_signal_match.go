@@ -0,0 +1,149 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osapi-io/osapi/internal/cli"
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+// clientNodeProcessSignalMatchCmd represents the process signal-match command.
+var clientNodeProcessSignalMatchCmd = &cobra.Command{
+	Use:   "signal-match",
+	Short: "Send a signal to every matching process",
+	Long: `Send a signal to every process on the target node that matches the
+given name, command line pattern, user and parent PID. Each agent resolves
+its own matches, so a broadcast target signals the right PIDs on every host.
+Use --dry-run to list the PIDs that would be signalled.`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		ctx := cmd.Context()
+		host, _ := cmd.Flags().GetString("target")
+		signal, _ := cmd.Flags().GetString("signal")
+		name, _ := cmd.Flags().GetString("name")
+		cmdline, _ := cmd.Flags().GetString("cmdline")
+		user, _ := cmd.Flags().GetString("user")
+		ppid, _ := cmd.Flags().GetInt("ppid")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		opts := client.ProcessSignalMatchOpts{
+			Signal:  signal,
+			Name:    name,
+			Cmdline: cmdline,
+			User:    user,
+			PPID:    ppid,
+			DryRun:  dryRun,
+		}
+
+		resp, err := sdkClient.Process.SignalMatch(ctx, host, opts)
+		if err != nil {
+			cli.HandleError(err, logger)
+			return
+		}
+
+		if jsonOutput {
+			fmt.Println(string(resp.RawJSON()))
+			return
+		}
+
+		if resp.Data.JobID != "" {
+			fmt.Println()
+			cli.PrintKV("Job ID", resp.Data.JobID)
+		}
+
+		results := make([]cli.ResultRow, 0, len(resp.Data.Results))
+		for _, r := range resp.Data.Results {
+			var errPtr *string
+			if r.Error != "" {
+				errPtr = &r.Error
+			}
+
+			results = append(results, cli.ResultRow{
+				Hostname: r.Hostname,
+				Status:   r.Status,
+				Error:    errPtr,
+				Fields: []string{
+					r.Signal,
+					joinPIDs(r.PIDs),
+					signalFailures(r.Failed),
+					fmt.Sprintf("%v", r.DryRun),
+					fmt.Sprintf("%v", r.Changed),
+				},
+			})
+		}
+		tr := cli.BuildBroadcastTable(
+			results,
+			[]string{"SIGNAL", "PIDS", "FAILED", "DRY RUN", "CHANGED"},
+		)
+		cli.PrintCompactTable(
+			[]cli.Section{{Headers: tr.Headers, Rows: tr.Rows, Errors: tr.Errors}},
+		)
+	},
+}
+
+// joinPIDs renders PIDs as a comma-separated list.
+func joinPIDs(
+	pids []int,
+) string {
+	parts := make([]string, 0, len(pids))
+	for _, pid := range pids {
+		parts = append(parts, strconv.Itoa(pid))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// signalFailures renders each failed PID with the reason it was not
+// signalled.
+func signalFailures(
+	failed []client.ProcessSignalFailure,
+) string {
+	parts := make([]string, 0, len(failed))
+	for _, f := range failed {
+		parts = append(parts, fmt.Sprintf("%d (%s)", f.PID, f.Error))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func init() {
+	clientNodeProcessCmd.AddCommand(clientNodeProcessSignalMatchCmd)
+
+	clientNodeProcessSignalMatchCmd.PersistentFlags().
+		String("signal", "", "Signal to send, e.g. TERM, KILL, HUP (required)")
+	clientNodeProcessSignalMatchCmd.PersistentFlags().
+		String("name", "", "Match the exact process name")
+	clientNodeProcessSignalMatchCmd.PersistentFlags().
+		String("cmdline", "", "Match the command line against a regular expression")
+	clientNodeProcessSignalMatchCmd.PersistentFlags().
+		String("user", "", "Match the process owner")
+	clientNodeProcessSignalMatchCmd.PersistentFlags().
+		Int("ppid", 0, "Only match direct children of this PID")
+	clientNodeProcessSignalMatchCmd.PersistentFlags().
+		Bool("dry-run", false, "List matching PIDs without signalling them")
+
+	_ = clientNodeProcessSignalMatchCmd.MarkPersistentFlagRequired("signal")
+	clientNodeProcessSignalMatchCmd.MarkFlagsOneRequired("name", "cmdline", "user", "ppid")
+}
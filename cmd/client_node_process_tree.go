@@ -0,0 +1,130 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osapi-io/osapi/internal/cli"
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+// clientNodeProcessTreeCmd represents the process tree command.
+var clientNodeProcessTreeCmd = &cobra.Command{
+	Use:   "tree",
+	Short: "Show the process hierarchy",
+	Long: `Show running processes as a parent/child tree on the target node.
+Use --pid to show only the subtree rooted at one process.`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		ctx := cmd.Context()
+		host, _ := cmd.Flags().GetString("target")
+		pid, _ := cmd.Flags().GetInt("pid")
+
+		resp, err := sdkClient.Process.Tree(ctx, host, client.ProcessTreeOpts{
+			PID: pid,
+		})
+		if err != nil {
+			cli.HandleError(err, logger)
+			return
+		}
+
+		if jsonOutput {
+			fmt.Println(string(resp.RawJSON()))
+			return
+		}
+
+		if resp.Data.JobID != "" {
+			fmt.Println()
+			cli.PrintKV("Job ID", resp.Data.JobID)
+			fmt.Println()
+		}
+
+		results := make([]cli.ResultRow, 0)
+		for _, r := range resp.Data.Results {
+			if r.Error != "" {
+				e := r.Error
+				results = append(results, cli.ResultRow{
+					Hostname: r.Hostname,
+					Status:   r.Status,
+					Error:    &e,
+				})
+
+				continue
+			}
+
+			for _, row := range processTreeRows(r.Processes, 0) {
+				results = append(results, cli.ResultRow{
+					Hostname: r.Hostname,
+					Status:   r.Status,
+					Fields:   row,
+				})
+			}
+		}
+		tr := cli.BuildBroadcastTable(
+			results,
+			[]string{"PROCESS", "PID", "USER", "COMMAND"},
+		)
+		cli.PrintCompactTable(
+			[]cli.Section{{Headers: tr.Headers, Rows: tr.Rows, Errors: tr.Errors}},
+		)
+	},
+}
+
+// processTreeRows flattens a process tree into table rows, indenting
+// child process names by depth.
+func processTreeRows(
+	nodes []client.ProcessTreeNode,
+	depth int,
+) [][]string {
+	rows := make([][]string, 0, len(nodes))
+	for _, n := range nodes {
+		name := n.Name
+		if depth > 0 {
+			name = strings.Repeat("  ", depth-1) + "└─" + n.Name
+		}
+
+		command := n.Command
+		if len(command) > 60 {
+			command = command[:57] + "..."
+		}
+
+		rows = append(rows, []string{
+			name,
+			strconv.Itoa(n.PID),
+			n.User,
+			command,
+		})
+		rows = append(rows, processTreeRows(n.Children, depth+1)...)
+	}
+
+	return rows
+}
+
+func init() {
+	clientNodeProcessCmd.AddCommand(clientNodeProcessTreeCmd)
+
+	clientNodeProcessTreeCmd.PersistentFlags().
+		Int("pid", 0, "Root the tree at this process ID")
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osapi-io/osapi/internal/cli"
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+// clientNodeScheduleTimerUpdateCmd represents the timer update command.
+var clientNodeScheduleTimerUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update a systemd timer",
+	Run: func(cmd *cobra.Command, _ []string) {
+		ctx := cmd.Context()
+		host, _ := cmd.Flags().GetString("target")
+		name, _ := cmd.Flags().GetString("name")
+		schedule, _ := cmd.Flags().GetString("schedule")
+		command, _ := cmd.Flags().GetString("command")
+		object, _ := cmd.Flags().GetString("object")
+		user, _ := cmd.Flags().GetString("user")
+		contentType, _ := cmd.Flags().GetString("content-type")
+
+		resp, err := sdkClient.Timer.Update(ctx, host, name, client.TimerUpdateOpts{
+			Schedule:    schedule,
+			Command:     command,
+			Object:      object,
+			User:        user,
+			ContentType: contentType,
+		})
+		if err != nil {
+			cli.HandleError(err, logger)
+			return
+		}
+
+		if jsonOutput {
+			fmt.Println(string(resp.RawJSON()))
+			return
+		}
+
+		if resp.Data.JobID != "" {
+			fmt.Println()
+			cli.PrintKV("Job ID", resp.Data.JobID)
+		}
+
+		results := make([]cli.ResultRow, 0, len(resp.Data.Results))
+		for _, r := range resp.Data.Results {
+			var errPtr *string
+			if r.Error != "" {
+				errPtr = &r.Error
+			}
+			changed := r.Changed
+			results = append(results, cli.ResultRow{
+				Hostname: r.Hostname,
+				Status:   r.Status,
+				Changed:  &changed,
+				Error:    errPtr,
+				Fields:   []string{r.Name},
+			})
+		}
+		tr := cli.BuildMutationTable(results, []string{"NAME"})
+		cli.PrintCompactTable(
+			[]cli.Section{{Headers: tr.Headers, Rows: tr.Rows, Errors: tr.Errors}},
+		)
+	},
+}
+
+func init() {
+	clientNodeScheduleTimerCmd.AddCommand(clientNodeScheduleTimerUpdateCmd)
+
+	clientNodeScheduleTimerUpdateCmd.PersistentFlags().
+		String("name", "", "Name of the systemd timer to update (required)")
+	clientNodeScheduleTimerUpdateCmd.PersistentFlags().
+		String("schedule", "", "New OnCalendar expression")
+	clientNodeScheduleTimerUpdateCmd.PersistentFlags().
+		String("command", "", "New command line for the service to run")
+	clientNodeScheduleTimerUpdateCmd.PersistentFlags().
+		String("object", "", "New object to deploy")
+	clientNodeScheduleTimerUpdateCmd.PersistentFlags().
+		String("user", "", "New user to run the command as")
+	clientNodeScheduleTimerUpdateCmd.PersistentFlags().
+		String("content-type", "", "Content type: raw or template")
+
+	_ = clientNodeScheduleTimerUpdateCmd.MarkPersistentFlagRequired("name")
+	clientNodeScheduleTimerUpdateCmd.MarkFlagsOneRequired("schedule", "command", "object", "user")
+	clientNodeScheduleTimerUpdateCmd.MarkFlagsMutuallyExclusive("command", "object")
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osapi-io/osapi/internal/cli"
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+// clientNodeServiceDropInUpdateCmd represents the service drop-in update command.
+var clientNodeServiceDropInUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update a drop-in override of a service",
+	Run: func(cmd *cobra.Command, _ []string) {
+		ctx := cmd.Context()
+		host, _ := cmd.Flags().GetString("target")
+		name, _ := cmd.Flags().GetString("name")
+		dropIn, _ := cmd.Flags().GetString("dropin")
+		object, _ := cmd.Flags().GetString("object")
+		contentType, _ := cmd.Flags().GetString("content-type")
+		restart, _ := cmd.Flags().GetBool("restart")
+
+		resp, err := sdkClient.Service.UpdateDropIn(
+			ctx,
+			host,
+			name,
+			dropIn,
+			client.ServiceDropInUpdateOpts{
+				Object:      object,
+				ContentType: contentType,
+				Restart:     restart,
+			},
+		)
+		if err != nil {
+			cli.HandleError(err, logger)
+			return
+		}
+
+		if jsonOutput {
+			fmt.Println(string(resp.RawJSON()))
+			return
+		}
+
+		if resp.Data.JobID != "" {
+			fmt.Println()
+			cli.PrintKV("Job ID", resp.Data.JobID)
+		}
+
+		results := make([]cli.ResultRow, 0, len(resp.Data.Results))
+		for _, r := range resp.Data.Results {
+			var errPtr *string
+			if r.Error != "" {
+				errPtr = &r.Error
+			}
+			changed := r.Changed
+			results = append(results, cli.ResultRow{
+				Hostname: r.Hostname,
+				Status:   r.Status,
+				Changed:  &changed,
+				Error:    errPtr,
+				Fields:   []string{r.Unit, r.Name, fmt.Sprintf("%t", r.Restarted)},
+			})
+		}
+		tr := cli.BuildMutationTable(results, []string{"UNIT", "NAME", "RESTARTED"})
+		cli.PrintCompactTable(
+			[]cli.Section{{Headers: tr.Headers, Rows: tr.Rows, Errors: tr.Errors}},
+		)
+	},
+}
+
+func init() {
+	clientNodeServiceDropInCmd.AddCommand(clientNodeServiceDropInUpdateCmd)
+
+	clientNodeServiceDropInUpdateCmd.PersistentFlags().
+		String("name", "", "Service unit name (required)")
+	clientNodeServiceDropInUpdateCmd.PersistentFlags().
+		String("dropin", "override", "Drop-in name without the .conf suffix")
+	clientNodeServiceDropInUpdateCmd.PersistentFlags().
+		String("object", "", "New object store reference for the drop-in")
+	clientNodeServiceDropInUpdateCmd.PersistentFlags().
+		String("content-type", "", "Content type: raw or template")
+	clientNodeServiceDropInUpdateCmd.PersistentFlags().
+		Bool("restart", false, "Restart the unit when the drop-in changed")
+
+	_ = clientNodeServiceDropInUpdateCmd.MarkPersistentFlagRequired("name")
+}
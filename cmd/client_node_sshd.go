@@ -0,0 +1,73 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+// clientNodeSshdCmd represents the clientNodeSshd command.
+var clientNodeSshdCmd = &cobra.Command{
+	Use:   "sshd",
+	Short: "Manage OpenSSH server drop-ins",
+}
+
+// parseSshdDirectiveFlags parses directive flag values in KEYWORD=VALUE
+// format. Only the first = separates the keyword, so values may contain
+// further = signs.
+func parseSshdDirectiveFlags(
+	directiveStrs []string,
+) ([]client.SshdDirective, error) {
+	directives := make([]client.SshdDirective, 0, len(directiveStrs))
+	for _, ds := range directiveStrs {
+		keyword, value, ok := strings.Cut(ds, "=")
+		if !ok || keyword == "" {
+			return nil, fmt.Errorf("invalid directive format %q: expected KEYWORD=VALUE", ds)
+		}
+
+		directives = append(directives, client.SshdDirective{
+			Keyword: strings.TrimSpace(keyword),
+			Value:   strings.TrimSpace(value),
+		})
+	}
+
+	return directives, nil
+}
+
+// addSshdDirectiveFlags registers the directive flag shared by the create
+// and update commands.
+func addSshdDirectiveFlags(
+	cmd *cobra.Command,
+) {
+	cmd.PersistentFlags().
+		StringArray("directive", []string{}, "sshd_config directive as KEYWORD=VALUE (repeatable, required)")
+
+	_ = cmd.MarkPersistentFlagRequired("directive")
+}
+
+func init() {
+	clientNodeCmd.AddCommand(clientNodeSshdCmd)
+}
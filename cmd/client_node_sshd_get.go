@@ -0,0 +1,114 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osapi-io/osapi/internal/cli"
+)
+
+// clientNodeSshdGetCmd represents the sshd get command.
+var clientNodeSshdGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get the sshd configuration",
+	Long: `Get the managed drop-ins in /etc/ssh/sshd_config.d, one row per
+directive, and the effective configuration reported by sshd -T.`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		ctx := cmd.Context()
+		host, _ := cmd.Flags().GetString("target")
+
+		resp, err := sdkClient.Sshd.Get(ctx, host)
+		if err != nil {
+			cli.HandleError(err, logger)
+			return
+		}
+
+		if jsonOutput {
+			fmt.Println(string(resp.RawJSON()))
+			return
+		}
+
+		if resp.Data.JobID != "" {
+			fmt.Println()
+			cli.PrintKV("Job ID", resp.Data.JobID)
+			fmt.Println()
+		}
+
+		results := make([]cli.ResultRow, 0)
+		effective := make([]cli.ResultRow, 0)
+		for _, r := range resp.Data.Results {
+			if r.Error != "" {
+				var errPtr *string
+				e := r.Error
+				errPtr = &e
+				results = append(results, cli.ResultRow{
+					Hostname: r.Hostname,
+					Status:   r.Status,
+					Error:    errPtr,
+				})
+
+				continue
+			}
+
+			for _, d := range r.DropIns {
+				for _, directive := range d.Directives {
+					results = append(results, cli.ResultRow{
+						Hostname: r.Hostname,
+						Status:   r.Status,
+						Fields:   []string{d.Name, directive.Keyword, directive.Value},
+					})
+				}
+			}
+
+			for _, directive := range r.Effective {
+				effective = append(effective, cli.ResultRow{
+					Hostname: r.Hostname,
+					Status:   r.Status,
+					Fields:   []string{directive.Keyword, directive.Value},
+				})
+			}
+		}
+		tr := cli.BuildBroadcastTable(results, []string{"NAME", "KEYWORD", "VALUE"})
+		sections := []cli.Section{
+			{Title: "Drop-ins", Headers: tr.Headers, Rows: tr.Rows, Errors: tr.Errors},
+		}
+
+		if len(effective) > 0 {
+			et := cli.BuildBroadcastTable(effective, []string{"KEYWORD", "VALUE"})
+			sections = append(sections, cli.Section{
+				Title:   "Effective",
+				Headers: et.Headers,
+				Rows:    et.Rows,
+			})
+		}
+
+		for _, sec := range sections {
+			cli.PrintCompactTable([]cli.Section{sec})
+		}
+	},
+}
+
+func init() {
+	clientNodeSshdCmd.AddCommand(clientNodeSshdGetCmd)
+}
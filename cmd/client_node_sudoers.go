@@ -0,0 +1,100 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+// clientNodeSudoersCmd represents the clientNodeSudoers command.
+var clientNodeSudoersCmd = &cobra.Command{
+	Use:   "sudoers",
+	Short: "Manage sudoers drop-ins",
+}
+
+// sudoersRuleFields formats a sudoers rule as table fields. Empty host,
+// run-as and command lists are shown as ALL, matching how the agent
+// renders them.
+func sudoersRuleFields(
+	r client.SudoersRule,
+) []string {
+	all := func(values []string) string {
+		if len(values) == 0 {
+			return "ALL"
+		}
+
+		return strings.Join(values, ",")
+	}
+
+	noPassword := ""
+	if r.NoPassword {
+		noPassword = "yes"
+	}
+
+	return []string{r.Principal, all(r.Hosts), all(r.RunAs), noPassword, all(r.Commands)}
+}
+
+// sudoersRuleFromFlags builds a single sudoers rule from the rule flags of
+// the create and update commands.
+func sudoersRuleFromFlags(
+	cmd *cobra.Command,
+) client.SudoersRule {
+	principal, _ := cmd.Flags().GetString("principal")
+	hosts, _ := cmd.Flags().GetStringSlice("hosts")
+	runAs, _ := cmd.Flags().GetStringSlice("run-as")
+	noPassword, _ := cmd.Flags().GetBool("no-password")
+	commands, _ := cmd.Flags().GetStringSlice("commands")
+
+	return client.SudoersRule{
+		Principal:  principal,
+		Hosts:      hosts,
+		RunAs:      runAs,
+		NoPassword: noPassword,
+		Commands:   commands,
+	}
+}
+
+// addSudoersRuleFlags registers the rule flags shared by the create and
+// update commands.
+func addSudoersRuleFlags(
+	cmd *cobra.Command,
+) {
+	cmd.PersistentFlags().
+		String("principal", "", "User, %group or +netgroup the rule applies to (required)")
+	cmd.PersistentFlags().
+		StringSlice("hosts", []string{}, "Hosts the rule applies on (comma-separated, default ALL)")
+	cmd.PersistentFlags().
+		StringSlice("run-as", []string{}, "Users the commands may run as (comma-separated, default ALL)")
+	cmd.PersistentFlags().
+		Bool("no-password", false, "Allow the commands to run without a password prompt")
+	cmd.PersistentFlags().
+		StringSlice("commands", []string{}, "Absolute command paths the principal may run (comma-separated, default ALL)")
+
+	_ = cmd.MarkPersistentFlagRequired("principal")
+}
+
+func init() {
+	clientNodeCmd.AddCommand(clientNodeSudoersCmd)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osapi-io/osapi/internal/cli"
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+// clientNodeSwapCreateCmd represents the swap create command.
+var clientNodeSwapCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create and enable a swap file",
+	Long: `Allocate a swap file of the requested size under /var/lib/osapi/swap,
+persist it with a systemd swap unit and enable it. Creating a swap file
+that already exists is a no-op.`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		ctx := cmd.Context()
+		host, _ := cmd.Flags().GetString("target")
+		name, _ := cmd.Flags().GetString("name")
+		sizeMB, _ := cmd.Flags().GetInt("size-mb")
+
+		opts := client.SwapCreateOpts{
+			Name:   name,
+			SizeMB: sizeMB,
+		}
+
+		if cmd.Flags().Changed("priority") {
+			priority, _ := cmd.Flags().GetInt("priority")
+			opts.Priority = &priority
+		}
+
+		resp, err := sdkClient.Swap.Create(ctx, host, opts)
+		if err != nil {
+			cli.HandleError(err, logger)
+			return
+		}
+
+		if jsonOutput {
+			fmt.Println(string(resp.RawJSON()))
+			return
+		}
+
+		if resp.Data.JobID != "" {
+			fmt.Println()
+			cli.PrintKV("Job ID", resp.Data.JobID)
+		}
+
+		results := make([]cli.ResultRow, 0, len(resp.Data.Results))
+		for _, r := range resp.Data.Results {
+			var errPtr *string
+			if r.Error != "" {
+				errPtr = &r.Error
+			}
+			changed := r.Changed
+			results = append(results, cli.ResultRow{
+				Hostname: r.Hostname,
+				Status:   r.Status,
+				Changed:  &changed,
+				Error:    errPtr,
+				Fields:   []string{r.Name, r.Path},
+			})
+		}
+		tr := cli.BuildMutationTable(results, []string{"NAME", "PATH"})
+		cli.PrintCompactTable(
+			[]cli.Section{{Headers: tr.Headers, Rows: tr.Rows, Errors: tr.Errors}},
+		)
+	},
+}
+
+func init() {
+	clientNodeSwapCmd.AddCommand(clientNodeSwapCreateCmd)
+
+	clientNodeSwapCreateCmd.PersistentFlags().
+		String("name", "", "Swap file name (required)")
+	clientNodeSwapCreateCmd.PersistentFlags().
+		Int("size-mb", 0, "Swap file size in MiB (required)")
+	clientNodeSwapCreateCmd.PersistentFlags().
+		Int("priority", 0, "Swap priority; higher values are used first")
+
+	_ = clientNodeSwapCreateCmd.MarkPersistentFlagRequired("name")
+	_ = clientNodeSwapCreateCmd.MarkPersistentFlagRequired("size-mb")
+}
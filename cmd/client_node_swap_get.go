@@ -0,0 +1,128 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osapi-io/osapi/internal/cli"
+)
+
+// clientNodeSwapGetCmd represents the swap get command.
+var clientNodeSwapGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get swap areas and swappiness",
+	Long: `Show the active swap devices and files, managed swap files that are
+currently disabled, and the vm.swappiness value on the target node.`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		ctx := cmd.Context()
+		host, _ := cmd.Flags().GetString("target")
+
+		resp, err := sdkClient.Swap.Get(ctx, host)
+		if err != nil {
+			cli.HandleError(err, logger)
+			return
+		}
+
+		if jsonOutput {
+			fmt.Println(string(resp.RawJSON()))
+			return
+		}
+
+		if resp.Data.JobID != "" {
+			fmt.Println()
+			cli.PrintKV("Job ID", resp.Data.JobID)
+			fmt.Println()
+		}
+
+		summary := make([]cli.ResultRow, 0, len(resp.Data.Results))
+		areas := make([]cli.ResultRow, 0)
+		for _, r := range resp.Data.Results {
+			if r.Error != "" {
+				e := r.Error
+				summary = append(summary, cli.ResultRow{
+					Hostname: r.Hostname,
+					Status:   r.Status,
+					Error:    &e,
+				})
+
+				continue
+			}
+
+			summary = append(summary, cli.ResultRow{
+				Hostname: r.Hostname,
+				Status:   r.Status,
+				Fields: []string{
+					strconv.Itoa(r.Swappiness),
+					cli.FormatBytes(int(r.Total)),
+					cli.FormatBytes(int(r.Used)),
+				},
+			})
+
+			for _, a := range r.Areas {
+				areas = append(areas, cli.ResultRow{
+					Hostname: r.Hostname,
+					Status:   r.Status,
+					Fields: []string{
+						a.Name,
+						a.Path,
+						a.Type,
+						cli.FormatBytes(int(a.Size)),
+						cli.FormatBytes(int(a.Used)),
+						strconv.Itoa(a.Priority),
+						strconv.FormatBool(a.Active),
+					},
+				})
+			}
+		}
+
+		st := cli.BuildBroadcastTable(
+			summary,
+			[]string{"SWAPPINESS", "TOTAL", "USED"},
+		)
+		sections := []cli.Section{
+			{Title: "Swap", Headers: st.Headers, Rows: st.Rows, Errors: st.Errors},
+		}
+
+		if len(areas) > 0 {
+			at := cli.BuildBroadcastTable(
+				areas,
+				[]string{"NAME", "PATH", "TYPE", "SIZE", "USED", "PRIORITY", "ACTIVE"},
+			)
+			sections = append(sections, cli.Section{
+				Title:   "Areas",
+				Headers: at.Headers,
+				Rows:    at.Rows,
+			})
+		}
+
+		for _, sec := range sections {
+			cli.PrintCompactTable([]cli.Section{sec})
+		}
+	},
+}
+
+func init() {
+	clientNodeSwapCmd.AddCommand(clientNodeSwapGetCmd)
+}
@@ -44,17 +44,35 @@ var clientNodeUserCreateCmd = &cobra.Command{
 		groups, _ := cmd.Flags().GetStringSlice("groups")
 		password, _ := cmd.Flags().GetString("password")
 		system, _ := cmd.Flags().GetBool("system")
+		accountExpires, _ := cmd.Flags().GetString("account-expires")
+		forcePasswordChange, _ := cmd.Flags().GetBool("force-password-change")
 
-		resp, err := sdkClient.User.Create(ctx, host, client.UserCreateOpts{
-			Name:     name,
-			UID:      uid,
-			GID:      gid,
-			Home:     home,
-			Shell:    shell,
-			Groups:   groups,
-			Password: password,
-			System:   system,
-		})
+		opts := client.UserCreateOpts{
+			Name:                name,
+			UID:                 uid,
+			GID:                 gid,
+			Home:                home,
+			Shell:               shell,
+			Groups:              groups,
+			Password:            password,
+			System:              system,
+			AccountExpires:      accountExpires,
+			ForcePasswordChange: forcePasswordChange,
+		}
+		if cmd.Flags().Changed("min-days") {
+			v, _ := cmd.Flags().GetInt("min-days")
+			opts.MinDays = &v
+		}
+		if cmd.Flags().Changed("max-days") {
+			v, _ := cmd.Flags().GetInt("max-days")
+			opts.MaxDays = &v
+		}
+		if cmd.Flags().Changed("warn-days") {
+			v, _ := cmd.Flags().GetInt("warn-days")
+			opts.WarnDays = &v
+		}
+
+		resp, err := sdkClient.User.Create(ctx, host, opts)
 		if err != nil {
 			cli.HandleError(err, logger)
 			return
@@ -111,6 +129,16 @@ func init() {
 		String("password", "", "Initial password (plaintext, hashed by the agent)")
 	clientNodeUserCreateCmd.PersistentFlags().
 		Bool("system", false, "Create a system account")
+	clientNodeUserCreateCmd.PersistentFlags().
+		Int("min-days", 0, "Minimum days between password changes")
+	clientNodeUserCreateCmd.PersistentFlags().
+		Int("max-days", 0, "Maximum days a password is valid")
+	clientNodeUserCreateCmd.PersistentFlags().
+		Int("warn-days", 0, "Days of warning before the password expires")
+	clientNodeUserCreateCmd.PersistentFlags().
+		String("account-expires", "", "Account expiry date (YYYY-MM-DD)")
+	clientNodeUserCreateCmd.PersistentFlags().
+		Bool("force-password-change", false, "Require a password change at first login")
 
 	_ = clientNodeUserCreateCmd.MarkPersistentFlagRequired("name")
 }
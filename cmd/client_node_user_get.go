@@ -27,6 +27,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/osapi-io/osapi/internal/cli"
+	"github.com/osapi-io/osapi/pkg/sdk/client"
 )
 
 // clientNodeUserGetCmd represents the user get command.
@@ -72,18 +73,19 @@ var clientNodeUserGetCmd = &cobra.Command{
 				results = append(results, cli.ResultRow{
 					Hostname: r.Hostname,
 					Status:   r.Status,
-					Fields: []string{
+					Fields: append([]string{
 						u.Name,
 						fmt.Sprintf("%d", u.UID),
 						u.Home,
 						u.Shell,
 						strings.Join(u.Groups, ","),
-					},
+					}, userAgingFields(u.Aging)...),
 				})
 			}
 		}
 		tr := cli.BuildBroadcastTable(results, []string{
 			"NAME", "UID", "HOME", "SHELL", "GROUPS",
+			"MIN DAYS", "MAX DAYS", "WARN DAYS", "PW EXPIRES", "ACCOUNT EXPIRES", "MUST CHANGE",
 		})
 		cli.PrintCompactTable(
 			[]cli.Section{{Headers: tr.Headers, Rows: tr.Rows, Errors: tr.Errors}},
@@ -91,6 +93,34 @@ var clientNodeUserGetCmd = &cobra.Command{
 	},
 }
 
+// userAgingFields returns the table columns for a user's password aging.
+// Unset dates are shown as "never".
+func userAgingFields(
+	a *client.UserPasswordAging,
+) []string {
+	if a == nil {
+		return []string{"", "", "", "", "", ""}
+	}
+
+	passwordExpires := a.PasswordExpires
+	if passwordExpires == "" {
+		passwordExpires = "never"
+	}
+	accountExpires := a.AccountExpires
+	if accountExpires == "" {
+		accountExpires = "never"
+	}
+
+	return []string{
+		fmt.Sprintf("%d", a.MinDays),
+		fmt.Sprintf("%d", a.MaxDays),
+		fmt.Sprintf("%d", a.WarnDays),
+		passwordExpires,
+		accountExpires,
+		fmt.Sprintf("%t", a.MustChange),
+	}
+}
+
 func init() {
 	clientNodeUserCmd.AddCommand(clientNodeUserGetCmd)
 
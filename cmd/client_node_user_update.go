@@ -42,10 +42,14 @@ var clientNodeUserUpdateCmd = &cobra.Command{
 		groups, _ := cmd.Flags().GetStringSlice("groups")
 		lockFlag, _ := cmd.Flags().GetBool("lock")
 		unlockFlag, _ := cmd.Flags().GetBool("unlock")
+		accountExpires, _ := cmd.Flags().GetString("account-expires")
+		forcePasswordChange, _ := cmd.Flags().GetBool("force-password-change")
 
 		opts := client.UserUpdateOpts{
-			Shell: shell,
-			Home:  home,
+			Shell:               shell,
+			Home:                home,
+			AccountExpires:      accountExpires,
+			ForcePasswordChange: forcePasswordChange,
 		}
 		if cmd.Flags().Changed("groups") {
 			opts.Groups = groups
@@ -58,6 +62,18 @@ var clientNodeUserUpdateCmd = &cobra.Command{
 			b := false
 			opts.Lock = &b
 		}
+		if cmd.Flags().Changed("min-days") {
+			v, _ := cmd.Flags().GetInt("min-days")
+			opts.MinDays = &v
+		}
+		if cmd.Flags().Changed("max-days") {
+			v, _ := cmd.Flags().GetInt("max-days")
+			opts.MaxDays = &v
+		}
+		if cmd.Flags().Changed("warn-days") {
+			v, _ := cmd.Flags().GetInt("warn-days")
+			opts.WarnDays = &v
+		}
 
 		resp, err := sdkClient.User.Update(ctx, host, name, opts)
 		if err != nil {
@@ -112,8 +128,21 @@ func init() {
 		Bool("lock", false, "Lock the account")
 	clientNodeUserUpdateCmd.PersistentFlags().
 		Bool("unlock", false, "Unlock the account")
+	clientNodeUserUpdateCmd.PersistentFlags().
+		Int("min-days", 0, "Minimum days between password changes (-1 removes the limit)")
+	clientNodeUserUpdateCmd.PersistentFlags().
+		Int("max-days", 0, "Maximum days a password is valid (-1 removes the limit)")
+	clientNodeUserUpdateCmd.PersistentFlags().
+		Int("warn-days", 0, "Days of warning before the password expires (-1 removes the limit)")
+	clientNodeUserUpdateCmd.PersistentFlags().
+		String("account-expires", "", "Account expiry date (YYYY-MM-DD), or \"never\" to remove it")
+	clientNodeUserUpdateCmd.PersistentFlags().
+		Bool("force-password-change", false, "Require a password change at next login")
 
 	_ = clientNodeUserUpdateCmd.MarkPersistentFlagRequired("name")
 	clientNodeUserUpdateCmd.MarkFlagsMutuallyExclusive("lock", "unlock")
-	clientNodeUserUpdateCmd.MarkFlagsOneRequired("shell", "home", "groups", "lock", "unlock")
+	clientNodeUserUpdateCmd.MarkFlagsOneRequired(
+		"shell", "home", "groups", "lock", "unlock",
+		"min-days", "max-days", "warn-days", "account-expires", "force-password-change",
+	)
 }
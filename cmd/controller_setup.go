@@ -50,12 +50,16 @@ import (
 	"github.com/osapi-io/osapi/internal/controller/api/health"
 	jobAPI "github.com/osapi-io/osapi/internal/controller/api/job"
 	nodeAPI "github.com/osapi-io/osapi/internal/controller/api/node"
+	blockAPI "github.com/osapi-io/osapi/internal/controller/api/node/block"
 	certificateAPI "github.com/osapi-io/osapi/internal/controller/api/node/certificate"
 	commandAPI "github.com/osapi-io/osapi/internal/controller/api/node/command"
 	dockerAPI "github.com/osapi-io/osapi/internal/controller/api/node/docker"
 	nodeFileAPI "github.com/osapi-io/osapi/internal/controller/api/node/file"
 	hostnameAPI "github.com/osapi-io/osapi/internal/controller/api/node/hostname"
+	hostsAPI "github.com/osapi-io/osapi/internal/controller/api/node/hosts"
+	kernelAPI "github.com/osapi-io/osapi/internal/controller/api/node/kernel"
 	logAPI "github.com/osapi-io/osapi/internal/controller/api/node/log"
+	mountAPI "github.com/osapi-io/osapi/internal/controller/api/node/mount"
 	networkAPI "github.com/osapi-io/osapi/internal/controller/api/node/network"
 	ntpAPI "github.com/osapi-io/osapi/internal/controller/api/node/ntp"
 	packageAPI "github.com/osapi-io/osapi/internal/controller/api/node/package"
@@ -63,6 +67,9 @@ import (
 	processAPI "github.com/osapi-io/osapi/internal/controller/api/node/process"
 	scheduleAPI "github.com/osapi-io/osapi/internal/controller/api/node/schedule"
 	serviceAPI "github.com/osapi-io/osapi/internal/controller/api/node/service"
+	sshdAPI "github.com/osapi-io/osapi/internal/controller/api/node/sshd"
+	sudoersAPI "github.com/osapi-io/osapi/internal/controller/api/node/sudoers"
+	swapAPI "github.com/osapi-io/osapi/internal/controller/api/node/swap"
 	sysctlAPI "github.com/osapi-io/osapi/internal/controller/api/node/sysctl"
 	timezoneAPI "github.com/osapi-io/osapi/internal/controller/api/node/timezone"
 	userAPI "github.com/osapi-io/osapi/internal/controller/api/node/user"
@@ -783,6 +790,13 @@ func registerControllerHandlers(
 	handlers = append(handlers, packageAPI.Handler(log, jc, signingKey, customRoles)...)
 	handlers = append(handlers, certificateAPI.Handler(log, jc, signingKey, customRoles)...)
 	handlers = append(handlers, serviceAPI.Handler(log, jc, signingKey, customRoles)...)
+	handlers = append(handlers, mountAPI.Handler(log, jc, signingKey, customRoles)...)
+	handlers = append(handlers, blockAPI.Handler(log, jc, signingKey, customRoles)...)
+	handlers = append(handlers, swapAPI.Handler(log, jc, signingKey, customRoles)...)
+	handlers = append(handlers, kernelAPI.Handler(log, jc, signingKey, customRoles)...)
+	handlers = append(handlers, hostsAPI.Handler(log, jc, signingKey, customRoles)...)
+	handlers = append(handlers, sudoersAPI.Handler(log, jc, signingKey, customRoles)...)
+	handlers = append(handlers, sshdAPI.Handler(log, jc, signingKey, customRoles)...)
 	handlers = append(handlers, logAPI.Handler(log, jc, signingKey, customRoles)...)
 	handlers = append(handlers, nodeFileAPI.Handler(log, jc, signingKey, customRoles)...)
 	if auditStore != nil {
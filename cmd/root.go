@@ -150,6 +150,8 @@ func initConfig() {
 	viper.SetDefault("agent.consumer.replay_policy", "instant")
 
 	// PKI defaults.
+	viper.SetDefault("agent.network.safe_apply.enabled", false)
+	viper.SetDefault("agent.network.safe_apply.timeout", "60s")
 	viper.SetDefault("agent.pki.enabled", false)
 	viper.SetDefault("agent.pki.key_dir", "/etc/osapi/pki")
 	viper.SetDefault("controller.pki.enabled", false)
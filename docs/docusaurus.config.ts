@@ -134,6 +134,11 @@ const config: Config = {
               label: 'Cron Management',
               docId: 'sidebar/features/cron-management'
             },
+            {
+              type: 'doc',
+              label: 'Timer Management',
+              docId: 'sidebar/features/timer-management'
+            },
             {
               type: 'doc',
               label: 'Sysctl Management',
@@ -264,6 +269,11 @@ const config: Config = {
               label: 'Cron',
               docId: 'sidebar/sdk/client/services/cron'
             },
+            {
+              type: 'doc',
+              label: 'Timer',
+              docId: 'sidebar/sdk/client/services/timer'
+            },
             {
               type: 'html',
               value:
@@ -301,6 +311,11 @@ const config: Config = {
               label: 'Timezone',
               docId: 'sidebar/sdk/client/config/timezone'
             },
+            {
+              type: 'doc',
+              label: 'Kernel',
+              docId: 'sidebar/sdk/client/config/kernel'
+            },
             {
               type: 'html',
               value:
@@ -368,6 +383,26 @@ const config: Config = {
               label: 'Route',
               docId: 'sidebar/sdk/client/networking/route'
             },
+            {
+              type: 'doc',
+              label: 'Firewall',
+              docId: 'sidebar/sdk/client/networking/firewall'
+            },
+            {
+              type: 'doc',
+              label: 'Hosts',
+              docId: 'sidebar/sdk/client/networking/hosts'
+            },
+            {
+              type: 'doc',
+              label: 'Socket',
+              docId: 'sidebar/sdk/client/networking/socket'
+            },
+            {
+              type: 'doc',
+              label: 'Network',
+              docId: 'sidebar/sdk/client/networking/network'
+            },
             {
               type: 'html',
               value:
@@ -389,6 +424,16 @@ const config: Config = {
               label: 'Certificate',
               docId: 'sidebar/sdk/client/security/certificate'
             },
+            {
+              type: 'doc',
+              label: 'Sudoers',
+              docId: 'sidebar/sdk/client/security/sudoers'
+            },
+            {
+              type: 'doc',
+              label: 'SSHD',
+              docId: 'sidebar/sdk/client/security/sshd'
+            },
             {
               type: 'html',
               value:
@@ -443,6 +488,21 @@ const config: Config = {
               label: 'Memory',
               docId: 'sidebar/sdk/client/hardware/memory'
             },
+            {
+              type: 'doc',
+              label: 'Mount',
+              docId: 'sidebar/sdk/client/hardware/mount'
+            },
+            {
+              type: 'doc',
+              label: 'Block',
+              docId: 'sidebar/sdk/client/hardware/block'
+            },
+            {
+              type: 'doc',
+              label: 'Swap',
+              docId: 'sidebar/sdk/client/hardware/swap'
+            },
             {
               type: 'html',
               value:
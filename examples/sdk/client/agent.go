@@ -83,6 +83,7 @@ func main() {
 	fmt.Printf("  FQDN:         %s\n", a.Fqdn)
 	fmt.Printf("  Package Mgr:  %s\n", a.PackageMgr)
 	fmt.Printf("  Service Mgr:  %s\n", a.ServiceMgr)
+	fmt.Printf("  Net Renderer: %s\n", a.NetworkRenderer)
 	fmt.Printf("  Uptime:       %s\n", a.Uptime)
 	fmt.Printf("  Started:      %s\n", a.StartedAt.Format("2006-01-02 15:04:05"))
 	fmt.Printf("  Registered:   %s\n", a.RegisteredAt.Format("2006-01-02 15:04:05"))
@@ -0,0 +1,120 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package main demonstrates block device inventory: list the device tree,
+// LVM physical volumes, volume groups and logical volumes, then extend a
+// logical volume with any free space left in its volume group.
+//
+// All mutation and query responses return Collection[T] with per-host results.
+// Use .Data.Results to iterate over the per-host entries.
+//
+// Run with: OSAPI_TOKEN="<jwt>" go run block.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+func main() {
+	url := os.Getenv("OSAPI_URL")
+	if url == "" {
+		url = "http://localhost:8080"
+	}
+
+	token := os.Getenv("OSAPI_TOKEN")
+	if token == "" {
+		log.Fatal("OSAPI_TOKEN is required")
+	}
+
+	c := client.New(url, token)
+	ctx := context.Background()
+	target := "_any"
+
+	// List block devices and LVM volumes.
+	// Returns Collection[BlockListResult] with per-host entries.
+	fmt.Println("=== Listing block devices ===")
+	listResp, err := c.Block.List(ctx, target)
+	if err != nil {
+		log.Fatalf("list failed: %v", err)
+	}
+
+	var vg, lv string
+	for _, r := range listResp.Data.Results {
+		if r.Error != "" {
+			fmt.Printf("  %s: ERROR %s\n", r.Hostname, r.Error)
+			continue
+		}
+
+		fmt.Printf("  %s:\n", r.Hostname)
+		printDevices(r.Devices, 2)
+
+		for _, g := range r.VolumeGroups {
+			fmt.Printf("    vg %s size=%d free=%d free_extents=%d\n",
+				g.Name, g.Size, g.Free, g.FreeExtents)
+		}
+		for _, l := range r.LogicalVolumes {
+			fmt.Printf("    lv %s/%s size=%d path=%s\n",
+				l.VolumeGroup, l.Name, l.Size, l.Path)
+			if vg == "" {
+				vg, lv = l.VolumeGroup, l.Name
+			}
+		}
+	}
+
+	if vg == "" {
+		fmt.Println("\nNo logical volumes found; skipping extend.")
+		return
+	}
+
+	// Give the first logical volume the remaining free space in its
+	// volume group and grow its filesystem. Reports changed=false when
+	// there is no free space left.
+	// Returns Collection[BlockExtendResult] with per-host results.
+	fmt.Printf("\n=== Extending %s/%s ===\n", vg, lv)
+	extendResp, err := c.Block.Extend(ctx, target, client.BlockExtendOpts{
+		VolumeGroup:   vg,
+		LogicalVolume: lv,
+	})
+	if err != nil {
+		log.Fatalf("extend failed: %v", err)
+	}
+	for _, r := range extendResp.Data.Results {
+		fmt.Printf("  %s: size=%d changed=%v error=%s\n",
+			r.Hostname, r.Size, r.Changed, r.Error)
+	}
+}
+
+// printDevices prints a device tree indented by depth.
+func printDevices(
+	devices []client.BlockDevice,
+	depth int,
+) {
+	for _, d := range devices {
+		fmt.Printf("%s%s %s size=%d fstype=%s mount=%s\n",
+			strings.Repeat("  ", depth), d.Name, d.Type, d.Size, d.FSType, d.MountPoint)
+		printDevices(d.Children, depth+1)
+	}
+}
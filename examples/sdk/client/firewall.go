@@ -0,0 +1,162 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package main demonstrates nftables firewall rule set management: upload a
+// rule set to the Object Store, then create, list, get, update, and delete
+// drop-in rule sets in /etc/nftables.d/. Rule sets are syntax-checked with
+// `nft -c` before they are installed.
+//
+// All mutation and query responses return Collection[T] with per-host results.
+// Use .Data.Results to iterate over the per-host entries.
+//
+// Run with: OSAPI_TOKEN="<jwt>" go run firewall.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+func main() {
+	url := os.Getenv("OSAPI_URL")
+	if url == "" {
+		url = "http://localhost:8080"
+	}
+
+	token := os.Getenv("OSAPI_TOKEN")
+	if token == "" {
+		log.Fatal("OSAPI_TOKEN is required")
+	}
+
+	c := client.New(url, token)
+	ctx := context.Background()
+	target := "_all"
+
+	// Upload the rule set to the Object Store first.
+	// The firewall rule set references the stored object by name.
+	fmt.Println("=== Uploading rule set ===")
+	ruleSet := strings.NewReader(`table inet web {
+	chain input {
+		type filter hook input priority 0; policy accept;
+		tcp dport { 80, 443 } accept
+	}
+}
+`)
+	uploadResp, err := c.File.Upload(ctx, "web-rules", "raw", ruleSet)
+	if err != nil {
+		log.Fatalf("upload failed: %v", err)
+	}
+	fmt.Printf("Uploaded: %s (changed: %v)\n", uploadResp.Data.Name, uploadResp.Data.Changed)
+
+	// Create a rule set referencing the uploaded object.
+	// Returns Collection[FirewallMutationResult] with per-host results.
+	fmt.Println("\n=== Creating firewall rule set ===")
+	createResp, err := c.Firewall.Create(ctx, target, client.FirewallCreateOpts{
+		Name:   "web",
+		Object: "web-rules",
+	})
+	if err != nil {
+		log.Fatalf("create failed: %v", err)
+	}
+	for _, r := range createResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n", r.Hostname, r.Changed, r.Error)
+	}
+
+	// List all managed rule sets.
+	// Returns Collection[FirewallRuleSetResult] with per-host entries.
+	fmt.Println("\n=== Listing firewall rule sets ===")
+	listResp, err := c.Firewall.List(ctx, target)
+	if err != nil {
+		log.Fatalf("list failed: %v", err)
+	}
+	for _, r := range listResp.Data.Results {
+		if r.Error != "" {
+			fmt.Printf("  %s: ERROR %s\n", r.Hostname, r.Error)
+		} else {
+			fmt.Printf("  %s: %s %s %s\n", r.Hostname, r.Name, r.Object, r.Path)
+		}
+	}
+
+	// Get a specific rule set.
+	fmt.Println("\n=== Getting firewall rule set ===")
+	getResp, err := c.Firewall.Get(ctx, target, "web")
+	if err != nil {
+		log.Fatalf("get failed: %v", err)
+	}
+	for _, r := range getResp.Data.Results {
+		if r.Error != "" {
+			fmt.Printf("  %s: ERROR %s\n", r.Hostname, r.Error)
+		} else {
+			fmt.Printf("  %s: name=%s object=%s path=%s\n",
+				r.Hostname, r.Name, r.Object, r.Path)
+		}
+	}
+
+	// Update: upload a new version of the rule set and redeploy.
+	fmt.Println("\n=== Uploading new rule set version ===")
+	newRuleSet := strings.NewReader(`table inet web {
+	chain input {
+		type filter hook input priority 0; policy accept;
+		tcp dport { 80, 443, 8443 } accept
+	}
+}
+`)
+	_, err = c.File.Upload(ctx, "web-rules-v2", "raw", newRuleSet)
+	if err != nil {
+		log.Fatalf("upload new version failed: %v", err)
+	}
+	fmt.Println("Uploaded: web-rules-v2")
+
+	fmt.Println("\n=== Updating firewall rule set ===")
+	updateResp, err := c.Firewall.Update(ctx, target, "web", client.FirewallUpdateOpts{
+		Object: "web-rules-v2",
+	})
+	if err != nil {
+		log.Fatalf("update failed: %v", err)
+	}
+	for _, r := range updateResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n", r.Hostname, r.Changed, r.Error)
+	}
+
+	// Delete the rule set. The drop-in is removed and nftables is reloaded.
+	fmt.Println("\n=== Deleting firewall rule set ===")
+	deleteResp, err := c.Firewall.Delete(ctx, target, "web")
+	if err != nil {
+		log.Fatalf("delete failed: %v", err)
+	}
+	for _, r := range deleteResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n", r.Hostname, r.Changed, r.Error)
+	}
+
+	// Clean up uploaded objects from the Object Store.
+	fmt.Println("\n=== Cleaning up uploaded objects ===")
+	for _, name := range []string{"web-rules", "web-rules-v2"} {
+		_, err = c.File.Delete(ctx, name)
+		if err != nil {
+			log.Fatalf("delete object %s failed: %v", name, err)
+		}
+		fmt.Printf("Deleted object: %s\n", name)
+	}
+}
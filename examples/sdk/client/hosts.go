@@ -0,0 +1,131 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package main demonstrates /etc/hosts entry management: list entries, add a
+// managed entry, inspect and update it, then remove it again.
+//
+// All mutation and query responses return Collection[T] with per-host results.
+// Use .Data.Results to iterate over the per-host entries.
+//
+// Run with: OSAPI_TOKEN="<jwt>" go run hosts.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+func main() {
+	url := os.Getenv("OSAPI_URL")
+	if url == "" {
+		url = "http://localhost:8080"
+	}
+
+	token := os.Getenv("OSAPI_TOKEN")
+	if token == "" {
+		log.Fatal("OSAPI_TOKEN is required")
+	}
+
+	c := client.New(url, token)
+	ctx := context.Background()
+	target := "_any"
+	address := "10.0.0.5"
+
+	// List every entry in /etc/hosts, managed or not.
+	// Returns Collection[HostEntryListResult] with per-host entries.
+	fmt.Println("=== Listing hosts entries ===")
+	listResp, err := c.Hosts.List(ctx, target)
+	if err != nil {
+		log.Fatalf("list failed: %v", err)
+	}
+	for _, r := range listResp.Data.Results {
+		if r.Error != "" {
+			fmt.Printf("  %s: ERROR %s\n", r.Hostname, r.Error)
+			continue
+		}
+
+		fmt.Printf("  %s: %d entries\n", r.Hostname, len(r.Entries))
+		for _, e := range r.Entries {
+			fmt.Printf("    %s %v managed=%v drifted=%v\n",
+				e.Address, e.Hostnames, e.Managed, e.Drifted)
+		}
+	}
+
+	// Add a managed entry. Reports changed=false when the address is
+	// already managed.
+	// Returns Collection[HostEntryMutationResult] with per-host results.
+	fmt.Println("\n=== Creating hosts entry ===")
+	createResp, err := c.Hosts.Create(ctx, target, client.HostEntryCreateOpts{
+		Address:   address,
+		Hostnames: []string{"db01.internal", "db01"},
+	})
+	if err != nil {
+		log.Fatalf("create failed: %v", err)
+	}
+	for _, r := range createResp.Data.Results {
+		fmt.Printf("  %s: address=%s changed=%v error=%s\n",
+			r.Hostname, r.Address, r.Changed, r.Error)
+	}
+
+	// Get the entry for the address.
+	fmt.Println("\n=== Getting hosts entry ===")
+	getResp, err := c.Hosts.Get(ctx, target, address)
+	if err != nil {
+		log.Fatalf("get failed: %v", err)
+	}
+	for _, r := range getResp.Data.Results {
+		if r.Entry == nil {
+			fmt.Printf("  %s: status=%s error=%s\n", r.Hostname, r.Status, r.Error)
+			continue
+		}
+
+		e := r.Entry
+		fmt.Printf("  %s: hostnames=%v managed=%v drifted=%v\n",
+			r.Hostname, e.Hostnames, e.Managed, e.Drifted)
+	}
+
+	// Replace the hostnames of the managed entry.
+	fmt.Println("\n=== Updating hosts entry ===")
+	updateResp, err := c.Hosts.Update(ctx, target, address, client.HostEntryUpdateOpts{
+		Hostnames: []string{"db02.internal", "db02"},
+	})
+	if err != nil {
+		log.Fatalf("update failed: %v", err)
+	}
+	for _, r := range updateResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n",
+			r.Hostname, r.Changed, r.Error)
+	}
+
+	// Remove the managed entry.
+	fmt.Println("\n=== Deleting hosts entry ===")
+	deleteResp, err := c.Hosts.Delete(ctx, target, address)
+	if err != nil {
+		log.Fatalf("delete failed: %v", err)
+	}
+	for _, r := range deleteResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n",
+			r.Hostname, r.Changed, r.Error)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package main demonstrates kernel module management: list loaded and managed
+// modules, configure a module to load at boot, inspect and update it, then
+// remove its managed configuration again.
+//
+// All mutation and query responses return Collection[T] with per-host results.
+// Use .Data.Results to iterate over the per-host entries.
+//
+// Run with: OSAPI_TOKEN="<jwt>" go run kernel.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+func main() {
+	url := os.Getenv("OSAPI_URL")
+	if url == "" {
+		url = "http://localhost:8080"
+	}
+
+	token := os.Getenv("OSAPI_TOKEN")
+	if token == "" {
+		log.Fatal("OSAPI_TOKEN is required")
+	}
+
+	c := client.New(url, token)
+	ctx := context.Background()
+	target := "_any"
+	name := "br_netfilter"
+
+	// List loaded and managed modules.
+	// Returns Collection[KernelModuleListResult] with per-host entries.
+	fmt.Println("=== Listing kernel modules ===")
+	listResp, err := c.Kernel.ListModules(ctx, target)
+	if err != nil {
+		log.Fatalf("list failed: %v", err)
+	}
+	for _, r := range listResp.Data.Results {
+		if r.Error != "" {
+			fmt.Printf("  %s: ERROR %s\n", r.Hostname, r.Error)
+			continue
+		}
+
+		fmt.Printf("  %s: %d modules\n", r.Hostname, len(r.Modules))
+		for _, m := range r.Modules {
+			if m.Managed {
+				fmt.Printf("    %s loaded=%v load=%v blacklist=%v drifted=%v\n",
+					m.Name, m.Loaded, m.Load, m.Blacklist, m.Drifted)
+			}
+		}
+	}
+
+	// Load the module now and at every boot. Reports changed=false when
+	// the module is already managed.
+	// Returns Collection[KernelModuleMutationResult] with per-host results.
+	fmt.Println("\n=== Creating kernel module ===")
+	createResp, err := c.Kernel.CreateModule(ctx, target, client.KernelModuleCreateOpts{
+		Name: name,
+		Load: true,
+	})
+	if err != nil {
+		log.Fatalf("create failed: %v", err)
+	}
+	for _, r := range createResp.Data.Results {
+		fmt.Printf("  %s: name=%s changed=%v error=%s\n",
+			r.Hostname, r.Name, r.Changed, r.Error)
+	}
+
+	// Get the runtime state and managed configuration of the module.
+	fmt.Println("\n=== Getting kernel module ===")
+	getResp, err := c.Kernel.GetModule(ctx, target, name)
+	if err != nil {
+		log.Fatalf("get failed: %v", err)
+	}
+	for _, r := range getResp.Data.Results {
+		if r.Module == nil {
+			fmt.Printf("  %s: status=%s error=%s\n", r.Hostname, r.Status, r.Error)
+			continue
+		}
+
+		m := r.Module
+		fmt.Printf("  %s: loaded=%v size=%d used_by=%v drifted=%v\n",
+			r.Hostname, m.Loaded, m.Size, m.UsedBy, m.Drifted)
+	}
+
+	// Replace the configuration with module parameters.
+	fmt.Println("\n=== Updating kernel module ===")
+	updateResp, err := c.Kernel.UpdateModule(ctx, target, name, client.KernelModuleUpdateOpts{
+		Load:    true,
+		Options: "debug=1",
+	})
+	if err != nil {
+		log.Fatalf("update failed: %v", err)
+	}
+	for _, r := range updateResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n",
+			r.Hostname, r.Changed, r.Error)
+	}
+
+	// Remove the managed configuration. The module stays loaded.
+	fmt.Println("\n=== Deleting kernel module ===")
+	deleteResp, err := c.Kernel.DeleteModule(ctx, target, name)
+	if err != nil {
+		log.Fatalf("delete failed: %v", err)
+	}
+	for _, r := range deleteResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n",
+			r.Hostname, r.Changed, r.Error)
+	}
+}
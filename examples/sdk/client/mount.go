@@ -0,0 +1,146 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package main demonstrates filesystem mount management: list current mounts,
+// add a managed /etc/fstab entry, mount and unmount it, update its options,
+// and remove it. Only entries created through OSAPI are modified.
+//
+// All mutation and query responses return Collection[T] with per-host results.
+// Use .Data.Results to iterate over the per-host entries.
+//
+// Run with: OSAPI_TOKEN="<jwt>" go run mount.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+func main() {
+	url := os.Getenv("OSAPI_URL")
+	if url == "" {
+		url = "http://localhost:8080"
+	}
+
+	token := os.Getenv("OSAPI_TOKEN")
+	if token == "" {
+		log.Fatal("OSAPI_TOKEN is required")
+	}
+
+	c := client.New(url, token)
+	ctx := context.Background()
+	target := "_any"
+
+	// List current mounts merged with managed fstab entries.
+	// Returns Collection[MountInfoResult] with per-host entries.
+	fmt.Println("=== Listing mounts ===")
+	listResp, err := c.Mount.List(ctx, target)
+	if err != nil {
+		log.Fatalf("list failed: %v", err)
+	}
+	for _, r := range listResp.Data.Results {
+		if r.Error != "" {
+			fmt.Printf("  %s: ERROR %s\n", r.Hostname, r.Error)
+			continue
+		}
+		for _, m := range r.Mounts {
+			fmt.Printf("  %s: %s on %s type %s (mounted=%v managed=%v)\n",
+				r.Hostname, m.Device, m.Path, m.FSType, m.Mounted, m.Managed)
+		}
+	}
+
+	// Add a managed entry for a tmpfs scratch area.
+	// Returns Collection[MountMutationResult] with per-host results.
+	fmt.Println("\n=== Creating fstab entry ===")
+	createResp, err := c.Mount.Create(ctx, target, client.MountCreateOpts{
+		Name:    "scratch",
+		Device:  "tmpfs",
+		Path:    "/mnt/scratch",
+		FSType:  "tmpfs",
+		Options: "size=64m,mode=1777",
+	})
+	if err != nil {
+		log.Fatalf("create failed: %v", err)
+	}
+	for _, r := range createResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n", r.Hostname, r.Changed, r.Error)
+	}
+
+	// Mount the filesystem. Reports changed=false if already mounted.
+	fmt.Println("\n=== Mounting ===")
+	mountResp, err := c.Mount.Mount(ctx, target, "scratch")
+	if err != nil {
+		log.Fatalf("mount failed: %v", err)
+	}
+	for _, r := range mountResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n", r.Hostname, r.Changed, r.Error)
+	}
+
+	// Get the managed entry with its live state.
+	fmt.Println("\n=== Getting mount ===")
+	getResp, err := c.Mount.Get(ctx, target, "scratch")
+	if err != nil {
+		log.Fatalf("get failed: %v", err)
+	}
+	for _, r := range getResp.Data.Results {
+		if r.Error != "" {
+			fmt.Printf("  %s: ERROR %s\n", r.Hostname, r.Error)
+		} else if r.Mount != nil {
+			fmt.Printf("  %s: options=%s in effect=%s mounted=%v\n",
+				r.Hostname, r.Mount.Options, r.Mount.MountOptions, r.Mount.Mounted)
+		}
+	}
+
+	// Unmount before changing the entry so the new options apply on the
+	// next mount.
+	fmt.Println("\n=== Unmounting ===")
+	unmountResp, err := c.Mount.Unmount(ctx, target, "scratch")
+	if err != nil {
+		log.Fatalf("unmount failed: %v", err)
+	}
+	for _, r := range unmountResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n", r.Hostname, r.Changed, r.Error)
+	}
+
+	fmt.Println("\n=== Updating fstab entry ===")
+	updateResp, err := c.Mount.Update(ctx, target, "scratch", client.MountUpdateOpts{
+		Options: "size=128m,mode=1777",
+	})
+	if err != nil {
+		log.Fatalf("update failed: %v", err)
+	}
+	for _, r := range updateResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n", r.Hostname, r.Changed, r.Error)
+	}
+
+	// Remove the managed entry from /etc/fstab.
+	fmt.Println("\n=== Deleting fstab entry ===")
+	deleteResp, err := c.Mount.Delete(ctx, target, "scratch")
+	if err != nil {
+		log.Fatalf("delete failed: %v", err)
+	}
+	for _, r := range deleteResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n", r.Hostname, r.Changed, r.Error)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package main demonstrates socket inventory: list listening sockets and
+// established connections, then find which process listens on a port.
+//
+// All responses return Collection[SocketListResult] with per-host results
+// when targeting broadcast targets (_all, _any) or a single hostname. Use
+// .Data.Results to iterate over the entries.
+//
+// Run with: OSAPI_TOKEN="<jwt>" go run socket.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+func socketExample() {
+	url := os.Getenv("OSAPI_URL")
+	if url == "" {
+		url = "http://localhost:8080"
+	}
+
+	token := os.Getenv("OSAPI_TOKEN")
+	if token == "" {
+		log.Fatal("OSAPI_TOKEN is required")
+	}
+
+	c := client.New(url, token)
+	ctx := context.Background()
+	target := "_all"
+
+	// List every listening socket on the target hosts.
+	// Returns Collection[SocketListResult] with per-host results.
+	fmt.Println("=== Listening sockets ===")
+	listResp, err := c.Socket.List(ctx, target, client.SocketListOpts{
+		State: "listen",
+	})
+	if err != nil {
+		log.Fatalf("socket list failed: %v", err)
+	}
+
+	for _, r := range listResp.Data.Results {
+		if r.Error != "" {
+			fmt.Printf("  %s: ERROR %s\n", r.Hostname, r.Error)
+			continue
+		}
+		fmt.Printf("  %s: %d listening sockets\n", r.Hostname, len(r.Sockets))
+		for _, s := range r.Sockets {
+			fmt.Printf("    %s %s:%d pid=%d process=%s\n",
+				s.Protocol, s.LocalAddress, s.LocalPort, s.PID, s.Process)
+		}
+	}
+
+	// Find established SSH sessions. Port matches the local or the
+	// remote port, so this covers inbound and outbound connections.
+	fmt.Println("\n=== Established connections on port 22 ===")
+	sshResp, err := c.Socket.List(ctx, target, client.SocketListOpts{
+		Protocol: "tcp",
+		State:    "established",
+		Port:     22,
+	})
+	if err != nil {
+		log.Fatalf("socket list failed: %v", err)
+	}
+
+	for _, r := range sshResp.Data.Results {
+		if r.Error != "" {
+			fmt.Printf("  %s: ERROR %s\n", r.Hostname, r.Error)
+			continue
+		}
+		for _, s := range r.Sockets {
+			fmt.Printf("  %s: %s:%d -> %s:%d (%s)\n",
+				r.Hostname, s.LocalAddress, s.LocalPort,
+				s.RemoteAddress, s.RemotePort, s.Process)
+		}
+	}
+}
+
+func main() {
+	socketExample()
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package main demonstrates OpenSSH server drop-in management: create a
+// hardening drop-in, inspect the effective configuration, show that an
+// update sshd rejects is rolled back, then remove the drop-in again.
+//
+// All mutation and query responses return Collection[T] with per-host results.
+// Use .Data.Results to iterate over the per-host entries.
+//
+// Run with: OSAPI_TOKEN="<jwt>" go run sshd.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+func main() {
+	url := os.Getenv("OSAPI_URL")
+	if url == "" {
+		url = "http://localhost:8080"
+	}
+
+	token := os.Getenv("OSAPI_TOKEN")
+	if token == "" {
+		log.Fatal("OSAPI_TOKEN is required")
+	}
+
+	c := client.New(url, token)
+	ctx := context.Background()
+	target := "_any"
+	name := "hardening"
+
+	// Create the drop-in. The configuration is checked with sshd -t and
+	// the daemon is reloaded; established sessions stay open. Reports
+	// changed=false when the drop-in is already managed.
+	// Returns Collection[SshdMutationResult] with per-host results.
+	fmt.Println("=== Creating sshd drop-in ===")
+	createResp, err := c.Sshd.Create(ctx, target, client.SshdCreateOpts{
+		Name: name,
+		Directives: []client.SshdDirective{
+			{Keyword: "PasswordAuthentication", Value: "no"},
+			{Keyword: "PermitRootLogin", Value: "prohibit-password"},
+		},
+	})
+	if err != nil {
+		log.Fatalf("create failed: %v", err)
+	}
+	for _, r := range createResp.Data.Results {
+		fmt.Printf("  %s: name=%s changed=%v error=%s\n",
+			r.Hostname, r.Name, r.Changed, r.Error)
+	}
+
+	// Get the managed drop-ins and the effective configuration.
+	// Returns Collection[SshdGetResult] with per-host entries.
+	fmt.Println("\n=== Getting sshd configuration ===")
+	getResp, err := c.Sshd.Get(ctx, target)
+	if err != nil {
+		log.Fatalf("get failed: %v", err)
+	}
+	for _, r := range getResp.Data.Results {
+		if r.Error != "" {
+			fmt.Printf("  %s: ERROR %s\n", r.Hostname, r.Error)
+			continue
+		}
+
+		for _, d := range r.DropIns {
+			fmt.Printf("  %s: %s directives=%d\n", r.Hostname, d.Path, len(d.Directives))
+		}
+
+		for _, directive := range r.Effective {
+			switch directive.Keyword {
+			case "passwordauthentication", "permitrootlogin":
+				fmt.Printf("  %s: effective %s=%s\n",
+					r.Hostname, directive.Keyword, directive.Value)
+			}
+		}
+	}
+
+	// Replace the directives with a keyword sshd does not know. sshd -t
+	// rejects the configuration, the error is reported per host, and the
+	// drop-in is rolled back to its previous directives.
+	fmt.Println("\n=== Updating with an invalid directive ===")
+	updateResp, err := c.Sshd.Update(ctx, target, name, client.SshdUpdateOpts{
+		Directives: []client.SshdDirective{
+			{Keyword: "PasswordAuthenticaton", Value: "no"},
+		},
+	})
+	if err != nil {
+		log.Fatalf("update failed: %v", err)
+	}
+	for _, r := range updateResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n",
+			r.Hostname, r.Changed, r.Error)
+	}
+
+	// Remove the drop-in.
+	fmt.Println("\n=== Deleting sshd drop-in ===")
+	deleteResp, err := c.Sshd.Delete(ctx, target, name)
+	if err != nil {
+		log.Fatalf("delete failed: %v", err)
+	}
+	for _, r := range deleteResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n",
+			r.Hostname, r.Changed, r.Error)
+	}
+}
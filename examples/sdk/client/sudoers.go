@@ -0,0 +1,147 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package main demonstrates sudoers drop-in management: create a drop-in
+// that lets a deploy user restart a service without a password, list and
+// inspect it, show that a rejected update leaves the live file untouched,
+// then remove the drop-in again.
+//
+// All mutation and query responses return Collection[T] with per-host results.
+// Use .Data.Results to iterate over the per-host entries.
+//
+// Run with: OSAPI_TOKEN="<jwt>" go run sudoers.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+func main() {
+	url := os.Getenv("OSAPI_URL")
+	if url == "" {
+		url = "http://localhost:8080"
+	}
+
+	token := os.Getenv("OSAPI_TOKEN")
+	if token == "" {
+		log.Fatal("OSAPI_TOKEN is required")
+	}
+
+	c := client.New(url, token)
+	ctx := context.Background()
+	target := "_any"
+	name := "deploy"
+
+	// Create the drop-in. It is checked with visudo before it is installed
+	// and reports changed=false when the drop-in is already managed.
+	// Returns Collection[SudoersMutationResult] with per-host results.
+	fmt.Println("=== Creating sudoers drop-in ===")
+	createResp, err := c.Sudoers.Create(ctx, target, client.SudoersCreateOpts{
+		Name: name,
+		Rules: []client.SudoersRule{
+			{
+				Principal:  "deploy",
+				RunAs:      []string{"root"},
+				NoPassword: true,
+				Commands:   []string{"/usr/bin/systemctl restart nginx"},
+			},
+		},
+	})
+	if err != nil {
+		log.Fatalf("create failed: %v", err)
+	}
+	for _, r := range createResp.Data.Results {
+		fmt.Printf("  %s: name=%s changed=%v error=%s\n",
+			r.Hostname, r.Name, r.Changed, r.Error)
+	}
+
+	// List the managed drop-ins.
+	// Returns Collection[SudoersListResult] with per-host entries.
+	fmt.Println("\n=== Listing sudoers drop-ins ===")
+	listResp, err := c.Sudoers.List(ctx, target)
+	if err != nil {
+		log.Fatalf("list failed: %v", err)
+	}
+	for _, r := range listResp.Data.Results {
+		if r.Error != "" {
+			fmt.Printf("  %s: ERROR %s\n", r.Hostname, r.Error)
+			continue
+		}
+
+		fmt.Printf("  %s: %d drop-ins\n", r.Hostname, len(r.DropIns))
+		for _, d := range r.DropIns {
+			fmt.Printf("    %s rules=%d\n", d.Path, len(d.Rules))
+		}
+	}
+
+	// Get the rules of the drop-in.
+	fmt.Println("\n=== Getting sudoers drop-in ===")
+	getResp, err := c.Sudoers.Get(ctx, target, name)
+	if err != nil {
+		log.Fatalf("get failed: %v", err)
+	}
+	for _, r := range getResp.Data.Results {
+		if r.DropIn == nil {
+			fmt.Printf("  %s: status=%s error=%s\n", r.Hostname, r.Status, r.Error)
+			continue
+		}
+
+		for _, rule := range r.DropIn.Rules {
+			fmt.Printf("  %s: principal=%s run_as=%v nopasswd=%v commands=%v\n",
+				r.Hostname, rule.Principal, rule.RunAs, rule.NoPassword, rule.Commands)
+		}
+	}
+
+	// Replace the rules with a relative command path. The update is
+	// rejected, the error is reported per host, and the live drop-in keeps
+	// its previous rules.
+	fmt.Println("\n=== Updating with an invalid rule ===")
+	updateResp, err := c.Sudoers.Update(ctx, target, name, client.SudoersUpdateOpts{
+		Rules: []client.SudoersRule{
+			{
+				Principal: "deploy",
+				Commands:  []string{"systemctl restart nginx"},
+			},
+		},
+	})
+	if err != nil {
+		log.Fatalf("update failed: %v", err)
+	}
+	for _, r := range updateResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n",
+			r.Hostname, r.Changed, r.Error)
+	}
+
+	// Remove the drop-in.
+	fmt.Println("\n=== Deleting sudoers drop-in ===")
+	deleteResp, err := c.Sudoers.Delete(ctx, target, name)
+	if err != nil {
+		log.Fatalf("delete failed: %v", err)
+	}
+	for _, r := range deleteResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n",
+			r.Hostname, r.Changed, r.Error)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package main demonstrates swap management: show the active swap areas and
+// swappiness, create a managed swap file, then delete it again.
+//
+// All mutation and query responses return Collection[T] with per-host results.
+// Use .Data.Results to iterate over the per-host entries.
+//
+// Run with: OSAPI_TOKEN="<jwt>" go run swap.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+func main() {
+	url := os.Getenv("OSAPI_URL")
+	if url == "" {
+		url = "http://localhost:8080"
+	}
+
+	token := os.Getenv("OSAPI_TOKEN")
+	if token == "" {
+		log.Fatal("OSAPI_TOKEN is required")
+	}
+
+	c := client.New(url, token)
+	ctx := context.Background()
+	target := "_any"
+
+	// Show swap areas and swappiness.
+	// Returns Collection[SwapStatusResult] with per-host entries.
+	fmt.Println("=== Getting swap status ===")
+	getResp, err := c.Swap.Get(ctx, target)
+	if err != nil {
+		log.Fatalf("get failed: %v", err)
+	}
+	for _, r := range getResp.Data.Results {
+		if r.Error != "" {
+			fmt.Printf("  %s: ERROR %s\n", r.Hostname, r.Error)
+			continue
+		}
+
+		fmt.Printf("  %s: swappiness=%d used=%d total=%d\n",
+			r.Hostname, r.Swappiness, r.Used, r.Total)
+		for _, a := range r.Areas {
+			fmt.Printf("    %s %s size=%d priority=%d active=%v managed=%v\n",
+				a.Path, a.Type, a.Size, a.Priority, a.Active, a.Managed)
+		}
+	}
+
+	// Create and enable a 512 MiB swap file. Reports changed=false when
+	// a swap file with this name already exists.
+	// Returns Collection[SwapMutationResult] with per-host results.
+	fmt.Println("\n=== Creating swap file ===")
+	priority := 10
+	createResp, err := c.Swap.Create(ctx, target, client.SwapCreateOpts{
+		Name:     "example",
+		SizeMB:   512,
+		Priority: &priority,
+	})
+	if err != nil {
+		log.Fatalf("create failed: %v", err)
+	}
+	for _, r := range createResp.Data.Results {
+		fmt.Printf("  %s: path=%s changed=%v error=%s\n",
+			r.Hostname, r.Path, r.Changed, r.Error)
+	}
+
+	// Disable and remove the swap file.
+	fmt.Println("\n=== Deleting swap file ===")
+	deleteResp, err := c.Swap.Delete(ctx, target, "example")
+	if err != nil {
+		log.Fatalf("delete failed: %v", err)
+	}
+	for _, r := range deleteResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n",
+			r.Hostname, r.Changed, r.Error)
+	}
+}
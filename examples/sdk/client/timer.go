@@ -0,0 +1,137 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package main demonstrates systemd timer management: create a timer that
+// runs a command on an OnCalendar schedule, list timers with their next and
+// last trigger times, then update and delete it.
+//
+// All mutation and query responses return Collection[T] with per-host results.
+// Use .Data.Results to iterate over the per-host entries.
+//
+// Run with: OSAPI_TOKEN="<jwt>" go run timer.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+func main() {
+	url := os.Getenv("OSAPI_URL")
+	if url == "" {
+		url = "http://localhost:8080"
+	}
+
+	token := os.Getenv("OSAPI_TOKEN")
+	if token == "" {
+		log.Fatal("OSAPI_TOKEN is required")
+	}
+
+	c := client.New(url, token)
+	ctx := context.Background()
+	target := "_all"
+
+	// Create a timer that runs a command every night at 02:00. The agent
+	// deploys osapi-backup.timer and osapi-backup.service, then enables
+	// and starts the timer.
+	fmt.Println("=== Creating timer ===")
+	createResp, err := c.Timer.Create(ctx, target, client.TimerCreateOpts{
+		Name:     "backup",
+		Schedule: "*-*-* 02:00:00",
+		Command:  "/usr/local/bin/backup.sh --full",
+		User:     "root",
+	})
+	if err != nil {
+		log.Fatalf("create failed: %v", err)
+	}
+	for _, r := range createResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n", r.Hostname, r.Changed, r.Error)
+	}
+
+	// List all timers, including those osapi does not manage.
+	// Returns Collection[TimerEntryResult] with per-host entries.
+	fmt.Println("\n=== Listing timers ===")
+	listResp, err := c.Timer.List(ctx, target)
+	if err != nil {
+		log.Fatalf("list failed: %v", err)
+	}
+	for _, t := range listResp.Data.Results {
+		if t.Error != "" {
+			fmt.Printf("  %s: ERROR %s\n", t.Hostname, t.Error)
+		} else {
+			fmt.Printf("  %s: %s managed=%v next=%s last=%s\n",
+				t.Hostname, t.Name, t.Managed, formatRun(t.NextRun), formatRun(t.LastRun))
+		}
+	}
+
+	// Get the managed timer by name.
+	fmt.Println("\n=== Getting timer ===")
+	getResp, err := c.Timer.Get(ctx, target, "backup")
+	if err != nil {
+		log.Fatalf("get failed: %v", err)
+	}
+	for _, r := range getResp.Data.Results {
+		if r.Error != "" {
+			fmt.Printf("  %s: ERROR %s\n", r.Hostname, r.Error)
+		} else {
+			fmt.Printf("  %s: unit=%s schedule=%s command=%s next=%s\n",
+				r.Hostname, r.Unit, r.Schedule, r.Command, formatRun(r.NextRun))
+		}
+	}
+
+	// Move the timer to weekdays only. The timer is restarted so the next
+	// trigger time is recomputed.
+	fmt.Println("\n=== Updating timer ===")
+	updateResp, err := c.Timer.Update(ctx, target, "backup", client.TimerUpdateOpts{
+		Schedule: "Mon..Fri *-*-* 02:00:00",
+	})
+	if err != nil {
+		log.Fatalf("update failed: %v", err)
+	}
+	for _, r := range updateResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n", r.Hostname, r.Changed, r.Error)
+	}
+
+	// Delete the timer. It is stopped and disabled, and both units are
+	// removed from disk.
+	fmt.Println("\n=== Deleting timer ===")
+	deleteResp, err := c.Timer.Delete(ctx, target, "backup")
+	if err != nil {
+		log.Fatalf("delete failed: %v", err)
+	}
+	for _, r := range deleteResp.Data.Results {
+		fmt.Printf("  %s: changed=%v error=%s\n", r.Hostname, r.Changed, r.Error)
+	}
+}
+
+func formatRun(
+	t *time.Time,
+) string {
+	if t == nil {
+		return "-"
+	}
+
+	return t.Format(time.RFC3339)
+}
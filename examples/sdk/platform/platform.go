@@ -38,6 +38,12 @@ func main() {
 	case "debian":
 		fmt.Println("Running on Debian family (Ubuntu, Debian, Raspbian)")
 		fmt.Println("Cron drop-in management is available at /etc/cron.d/")
+	case "rhel":
+		fmt.Println("Running on RHEL family (RHEL, Rocky, AlmaLinux, Fedora)")
+		fmt.Println("Package management is available via dnf")
+	case "alpine":
+		fmt.Println("Running on Alpine")
+		fmt.Println("Package and OpenRC service management are available")
 	case "darwin":
 		fmt.Println("Running on macOS (Darwin)")
 		fmt.Println("Cron management is not supported — jobs will be skipped")
@@ -436,6 +436,7 @@ func SetAgentAppConfig(
 	// label/config-dependent dispatch picks up the change.
 	if a.registry != nil {
 		a.registry.processors["node"] = NewNodeProcessor(
+			context.Background(),
 			a.hostProvider,
 			a.diskProvider,
 			a.memProvider,
@@ -449,6 +450,14 @@ func SetAgentAppConfig(
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 			cfg,
 			a.logger,
 		)
@@ -474,6 +483,7 @@ func SetAgentHostProvider(
 	// dispatch picks up the change.
 	if a.registry != nil {
 		a.registry.processors["node"] = NewNodeProcessor(
+			context.Background(),
 			p,
 			a.diskProvider,
 			a.memProvider,
@@ -487,6 +497,14 @@ func SetAgentHostProvider(
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 			a.appConfig,
 			a.logger,
 		)
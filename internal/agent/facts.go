@@ -141,6 +141,10 @@ func (a *Agent) writeFacts(
 		reg.PrimaryInterface = primary
 	}
 
+	if renderer, err := a.netinfoProvider.GetNetworkRenderer(); err == nil {
+		reg.NetworkRenderer = renderer
+	}
+
 	a.cachedFacts = &reg
 
 	data, err := marshalJSON(reg)
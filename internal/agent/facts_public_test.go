@@ -122,6 +122,7 @@ func (s *FactsPublicTestSuite) TestWriteFacts() {
 						s.Equal("apt", reg.PackageMgr)
 						s.Len(reg.Interfaces, 1)
 						s.Equal("eth0", reg.Interfaces[0].Name)
+						s.Equal("networkd", reg.NetworkRenderer)
 						return uint64(1), nil
 					})
 			},
@@ -169,6 +170,10 @@ func (s *FactsPublicTestSuite) TestWriteFacts() {
 						GetPrimaryInterface().
 						Return("", errors.New("primary fail")).
 						AnyTimes()
+					m.EXPECT().
+						GetNetworkRenderer().
+						Return("", errors.New("renderer fail")).
+						AnyTimes()
 					return m
 				}()
 				agent.SetAgentNetinfoProvider(s.testAgent, errNetinfoProvider)
@@ -191,6 +196,7 @@ func (s *FactsPublicTestSuite) TestWriteFacts() {
 						s.Empty(reg.ServiceMgr)
 						s.Empty(reg.PackageMgr)
 						s.Nil(reg.Interfaces)
+						s.Empty(reg.NetworkRenderer)
 						return uint64(1), nil
 					})
 			},
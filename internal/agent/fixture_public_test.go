@@ -21,6 +21,7 @@
 package agent_test
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/avfs/avfs"
@@ -45,6 +46,7 @@ import (
 	sysctlProv "github.com/osapi-io/osapi/internal/provider/node/sysctl"
 	timezoneProv "github.com/osapi-io/osapi/internal/provider/node/timezone"
 	cronProv "github.com/osapi-io/osapi/internal/provider/scheduled/cron"
+	timerProv "github.com/osapi-io/osapi/internal/provider/scheduled/timer"
 	"github.com/osapi-io/osapi/internal/telemetry/process"
 )
 
@@ -67,6 +69,7 @@ type newTestAgentParams struct {
 	fileProvider     fileProv.Provider
 	dockerProvider   dockerProv.Provider
 	cronProvider     cronProv.Provider
+	timerProvider    timerProv.Provider
 	sysctlProvider   sysctlProv.Provider
 	ntpProvider      ntpProv.Provider
 	timezoneProvider timezoneProv.Provider
@@ -97,6 +100,7 @@ func newTestAgent(p newTestAgentParams) *agent.Agent {
 	registry.Register(
 		"node",
 		agent.NewNodeProcessor(
+			context.Background(),
 			p.hostProvider,
 			p.diskProvider,
 			p.memProvider,
@@ -110,6 +114,14 @@ func newTestAgent(p newTestAgentParams) *agent.Agent {
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 			p.appConfig,
 			logger,
 		),
@@ -118,7 +130,7 @@ func newTestAgent(p newTestAgentParams) *agent.Agent {
 
 	registry.Register(
 		"network",
-		agent.NewNetworkProcessor(p.dnsProvider, p.pingProvider, nil, nil, logger),
+		agent.NewNetworkProcessor(p.dnsProvider, p.pingProvider, nil, nil, nil, nil, nil, logger),
 		p.dnsProvider, p.pingProvider, p.netinfoProvider,
 	)
 
@@ -142,8 +154,8 @@ func newTestAgent(p newTestAgentParams) *agent.Agent {
 
 	registry.Register(
 		"schedule",
-		agent.NewScheduleProcessor(p.cronProvider, logger),
-		p.cronProvider,
+		agent.NewScheduleProcessor(p.cronProvider, p.timerProvider, logger),
+		p.cronProvider, p.timerProvider,
 	)
 
 	return agent.New(
@@ -51,10 +51,12 @@ var sudoCommands = []string{
 	"groupadd",
 	"groupdel",
 	"gpasswd",
+	"chage",
 	"chown",
 	"apt-get",
 	"shutdown",
 	"update-ca-certificates",
+	"sshd",
 	"sh",
 }
 
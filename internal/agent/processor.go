@@ -21,23 +21,33 @@
 package agent
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
 
 	"github.com/osapi-io/osapi/internal/config"
 	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider"
 	"github.com/osapi-io/osapi/internal/provider/node/apt"
+	blockProv "github.com/osapi-io/osapi/internal/provider/node/block"
 	"github.com/osapi-io/osapi/internal/provider/node/disk"
 	nodeHost "github.com/osapi-io/osapi/internal/provider/node/host"
+	hostsProv "github.com/osapi-io/osapi/internal/provider/node/hosts"
+	kernelProv "github.com/osapi-io/osapi/internal/provider/node/kernel"
 	"github.com/osapi-io/osapi/internal/provider/node/load"
 	logProv "github.com/osapi-io/osapi/internal/provider/node/log"
 	"github.com/osapi-io/osapi/internal/provider/node/mem"
+	mountProv "github.com/osapi-io/osapi/internal/provider/node/mount"
 	"github.com/osapi-io/osapi/internal/provider/node/ntp"
 	"github.com/osapi-io/osapi/internal/provider/node/power"
 	processProv "github.com/osapi-io/osapi/internal/provider/node/process"
 	serviceProv "github.com/osapi-io/osapi/internal/provider/node/service"
+	sshdProv "github.com/osapi-io/osapi/internal/provider/node/sshd"
+	sudoersProv "github.com/osapi-io/osapi/internal/provider/node/sudoers"
+	swapProv "github.com/osapi-io/osapi/internal/provider/node/swap"
 	"github.com/osapi-io/osapi/internal/provider/node/sysctl"
 	"github.com/osapi-io/osapi/internal/provider/node/timezone"
 	"github.com/osapi-io/osapi/internal/provider/node/user"
@@ -56,8 +66,11 @@ func (a *Agent) processJobOperation(
 	return a.registry.Dispatch(jobRequest)
 }
 
-// NewNodeProcessor returns a ProcessorFunc that handles node-related operations.
+// NewNodeProcessor returns a ProcessorFunc that handles node-related
+// operations. Work that outlives a job, such as log follows, ends when ctx
+// is done.
 func NewNodeProcessor(
+	ctx context.Context,
 	hostProvider nodeHost.Provider,
 	diskProvider disk.Provider,
 	memProvider mem.Provider,
@@ -71,6 +84,14 @@ func NewNodeProcessor(
 	packageProvider apt.Provider,
 	logProvider logProv.Provider,
 	serviceProvider serviceProv.Provider,
+	mountProvider mountProv.Provider,
+	blockProvider blockProv.Provider,
+	swapProvider swapProv.Provider,
+	kernelProvider kernelProv.Provider,
+	hostsProvider hostsProv.Provider,
+	sudoersProvider sudoersProv.Provider,
+	sshdProvider sshdProv.Provider,
+	streamPublisher NATSPublisher,
 	appConfig config.Config,
 	logger *slog.Logger,
 ) ProcessorFunc {
@@ -81,7 +102,7 @@ func NewNodeProcessor(
 		switch baseOperation {
 		case "hostname":
 			if req.Type == job.TypeModify {
-				return setNodeHostname(hostProvider, req, logger)
+				return setNodeHostname(hostProvider, hostsProvider, req, logger)
 			}
 			return getNodeHostname(hostProvider, appConfig, logger)
 		case "status":
@@ -115,9 +136,23 @@ func NewNodeProcessor(
 		case "package":
 			return processPackageOperation(packageProvider, logger, req)
 		case "log":
-			return processLogOperation(logProvider, logger, req)
+			return processLogOperation(ctx, logProvider, streamPublisher, logger, req)
 		case "service":
 			return processServiceOperation(serviceProvider, logger, req)
+		case "mount":
+			return processMountOperation(mountProvider, logger, req)
+		case "block":
+			return processBlockOperation(blockProvider, logger, req)
+		case "swap":
+			return processSwapOperation(swapProvider, logger, req)
+		case "kernel":
+			return processKernelOperation(kernelProvider, logger, req)
+		case "hosts":
+			return processHostsOperation(hostsProvider, logger, req)
+		case "sudoers":
+			return processSudoersOperation(sudoersProvider, logger, req)
+		case "sshd":
+			return processSshdOperation(sshdProvider, logger, req)
 		default:
 			return nil, fmt.Errorf("unsupported node operation: %s", req.Operation)
 		}
@@ -149,9 +184,11 @@ func getNodeHostname(
 	return json.Marshal(result)
 }
 
-// setNodeHostname sets the node hostname via the host provider.
+// setNodeHostname sets the node hostname via the host provider. When the
+// hostname changes, the 127.0.1.1 entry in /etc/hosts is updated to match.
 func setNodeHostname(
 	hostProvider nodeHost.Provider,
+	hostsProvider hostsProv.Provider,
 	req job.Request,
 	logger *slog.Logger,
 ) (json.RawMessage, error) {
@@ -164,11 +201,20 @@ func setNodeHostname(
 		return nil, fmt.Errorf("invalid hostname update data: %w", err)
 	}
 
+	var previous string
+	if hostsProvider != nil {
+		previous, _ = hostProvider.GetHostname()
+	}
+
 	result, err := hostProvider.UpdateHostname(data.Hostname)
 	if err != nil {
 		return nil, err
 	}
 
+	if result.Changed && hostsProvider != nil {
+		syncHostsLoopback(hostsProvider, previous, data.Hostname, logger)
+	}
+
 	resp := map[string]interface{}{
 		"hostname": data.Hostname,
 		"changed":  result.Changed,
@@ -177,6 +223,31 @@ func setNodeHostname(
 	return json.Marshal(resp)
 }
 
+// syncHostsLoopback points the 127.0.1.1 entry in /etc/hosts at the new
+// hostname. Failures are logged rather than returned so that a stale hosts
+// file never fails an otherwise successful hostname change.
+func syncHostsLoopback(
+	hostsProvider hostsProv.Provider,
+	previous string,
+	hostname string,
+	logger *slog.Logger,
+) {
+	logger.Debug(
+		"executing hosts.SetHostname",
+		slog.String("previous", previous),
+		slog.String("hostname", hostname),
+	)
+
+	_, err := hostsProvider.SetHostname(context.Background(), previous, hostname)
+	if err != nil && !errors.Is(err, provider.ErrUnsupported) {
+		logger.Warn(
+			"failed to update /etc/hosts after hostname change",
+			slog.String("hostname", hostname),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
 // getNodeStatus retrieves comprehensive node status.
 func getNodeStatus(
 	hostProvider nodeHost.Provider,
@@ -0,0 +1,105 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/node/block"
+)
+
+// processBlockOperation dispatches block device sub-operations.
+func processBlockOperation(
+	blockProvider block.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	if blockProvider == nil {
+		return nil, fmt.Errorf("block provider not available")
+	}
+
+	// Extract sub-operation: "block.list" -> "list"
+	parts := strings.Split(jobRequest.Operation, ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid block operation: %s", jobRequest.Operation)
+	}
+	subOp := parts[1]
+
+	ctx := context.Background()
+
+	switch subOp {
+	case "list":
+		return processBlockList(ctx, blockProvider, logger)
+	case "extend":
+		return processBlockExtend(ctx, blockProvider, logger, jobRequest)
+	default:
+		return nil, fmt.Errorf("unsupported block operation: %s", jobRequest.Operation)
+	}
+}
+
+// processBlockList returns the block device tree and LVM inventory.
+func processBlockList(
+	ctx context.Context,
+	blockProvider block.Provider,
+	logger *slog.Logger,
+) (json.RawMessage, error) {
+	logger.Debug("executing block.List")
+
+	inventory, err := blockProvider.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(inventory)
+}
+
+// processBlockExtend grows a logical volume and optionally its filesystem.
+func processBlockExtend(
+	ctx context.Context,
+	blockProvider block.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var opts block.ExtendOpts
+	if err := json.Unmarshal(jobRequest.Data, &opts); err != nil {
+		return nil, fmt.Errorf("unmarshal block extend data: %w", err)
+	}
+
+	logger.Debug(
+		"executing block.Extend",
+		slog.String("volume_group", opts.VolumeGroup),
+		slog.String("logical_volume", opts.LogicalVolume),
+		slog.String("size", opts.Size),
+		slog.Bool("resize_fs", opts.ResizeFS),
+	)
+
+	result, err := blockProvider.Extend(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
@@ -0,0 +1,335 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/agent"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/node/block"
+	blockMocks "github.com/osapi-io/osapi/internal/provider/node/block/mocks"
+)
+
+type ProcessorBlockPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl *gomock.Controller
+}
+
+func (s *ProcessorBlockPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+}
+
+func (s *ProcessorBlockPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *ProcessorBlockPublicTestSuite) newNodeProcessor(
+	blockProvider block.Provider,
+) agent.ProcessorFunc {
+	return agent.NewNodeProcessor(
+		context.Background(),
+		nil, nil, nil, nil,
+		nil, nil, nil, nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		blockProvider,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		config.Config{},
+		slog.Default(),
+	)
+}
+
+func (s *ProcessorBlockPublicTestSuite) TestProcessBlockOperation() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() block.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "nil provider returns error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "block.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock:   nil,
+			expectError: true,
+			errorMsg:    "block provider not available",
+		},
+		{
+			name: "invalid operation format missing sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "block",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() block.Provider {
+				return blockMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "invalid block operation: block",
+		},
+		{
+			name: "unsupported sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "block.unknown",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() block.Provider {
+				return blockMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unsupported block operation: block.unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var blockProvider block.Provider
+			if tt.setupMock != nil {
+				blockProvider = tt.setupMock()
+			}
+
+			processor := s.newNodeProcessor(blockProvider)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorBlockPublicTestSuite) TestProcessBlockList() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() block.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful list",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "block.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() block.Provider {
+				m := blockMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().List(gomock.Any()).Return(&block.Inventory{
+					Devices: []block.Device{
+						{
+							Name: "sda",
+							Path: "/dev/sda",
+							Type: "disk",
+						},
+					},
+					VolumeGroups: []block.VolumeGroup{
+						{
+							Name: "vg0",
+							Free: 4194304,
+						},
+					},
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var inv block.Inventory
+				err := json.Unmarshal(result, &inv)
+				s.NoError(err)
+				s.Len(inv.Devices, 1)
+				s.Equal("sda", inv.Devices[0].Name)
+				s.Equal(int64(4194304), inv.VolumeGroups[0].Free)
+			},
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "block.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() block.Provider {
+				m := blockMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().List(gomock.Any()).Return(nil, errors.New("permission denied"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "permission denied",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorBlockPublicTestSuite) TestProcessBlockExtend() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() block.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful extend",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "block.extend",
+				Data:      json.RawMessage(`{"volume_group":"vg0","logical_volume":"root","size":"+10G","resize_fs":true}`),
+			},
+			setupMock: func() block.Provider {
+				m := blockMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Extend(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ interface{}, opts block.ExtendOpts) (*block.ExtendResult, error) {
+						s.Equal("vg0", opts.VolumeGroup)
+						s.Equal("root", opts.LogicalVolume)
+						s.Equal("+10G", opts.Size)
+						s.True(opts.ResizeFS)
+						return &block.ExtendResult{
+							Name:    "vg0/root",
+							Size:    21474836480,
+							Changed: true,
+						}, nil
+					},
+				)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r block.ExtendResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("vg0/root", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "block.extend",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() block.Provider {
+				return blockMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal block extend data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "block.extend",
+				Data:      json.RawMessage(`{"volume_group":"vg0","logical_volume":"root","size":"+1T"}`),
+			},
+			setupMock: func() block.Provider {
+				m := blockMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Extend(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("insufficient free space"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "insufficient free space",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessorBlockPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessorBlockPublicTestSuite))
+}
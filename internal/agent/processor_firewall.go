@@ -0,0 +1,187 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/network/firewall"
+)
+
+// processFirewallOperation dispatches network firewall sub-operations.
+func processFirewallOperation(
+	provider firewall.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("firewall provider not available")
+	}
+
+	// Extract sub-operation: "firewall.list" -> "list"
+	parts := strings.Split(jobRequest.Operation, ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid firewall operation: %s", jobRequest.Operation)
+	}
+	subOp := parts[1]
+
+	ctx := context.Background()
+
+	switch subOp {
+	case "list":
+		return processFirewallList(ctx, provider, logger)
+	case "get":
+		return processFirewallGet(ctx, provider, logger, jobRequest)
+	case "create":
+		return processFirewallCreate(ctx, provider, logger, jobRequest)
+	case "update":
+		return processFirewallUpdate(ctx, provider, logger, jobRequest)
+	case "delete":
+		return processFirewallDelete(ctx, provider, logger, jobRequest)
+	default:
+		return nil, fmt.Errorf("unsupported firewall operation: %s", jobRequest.Operation)
+	}
+}
+
+// processFirewallList lists all managed nftables rule sets.
+func processFirewallList(
+	ctx context.Context,
+	provider firewall.Provider,
+	logger *slog.Logger,
+) (json.RawMessage, error) {
+	logger.Debug("executing firewall.List")
+
+	ruleSets, err := provider.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(ruleSets)
+}
+
+// processFirewallGet gets a single managed nftables rule set.
+func processFirewallGet(
+	ctx context.Context,
+	provider firewall.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal firewall get data: %w", err)
+	}
+
+	logger.Debug(
+		"executing firewall.Get",
+		slog.String("name", data.Name),
+	)
+
+	ruleSet, err := provider.Get(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(ruleSet)
+}
+
+// processFirewallCreate validates, deploys, and loads a new rule set.
+func processFirewallCreate(
+	ctx context.Context,
+	provider firewall.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var ruleSet firewall.RuleSet
+	if err := json.Unmarshal(jobRequest.Data, &ruleSet); err != nil {
+		return nil, fmt.Errorf("unmarshal firewall create data: %w", err)
+	}
+
+	logger.Debug(
+		"executing firewall.Create",
+		slog.String("name", ruleSet.Name),
+	)
+
+	result, err := provider.Create(ctx, ruleSet)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processFirewallUpdate validates, redeploys, and reloads a rule set.
+func processFirewallUpdate(
+	ctx context.Context,
+	provider firewall.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var ruleSet firewall.RuleSet
+	if err := json.Unmarshal(jobRequest.Data, &ruleSet); err != nil {
+		return nil, fmt.Errorf("unmarshal firewall update data: %w", err)
+	}
+
+	logger.Debug(
+		"executing firewall.Update",
+		slog.String("name", ruleSet.Name),
+	)
+
+	result, err := provider.Update(ctx, ruleSet)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processFirewallDelete removes a managed rule set and reloads nftables.
+func processFirewallDelete(
+	ctx context.Context,
+	provider firewall.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal firewall delete data: %w", err)
+	}
+
+	logger.Debug(
+		"executing firewall.Delete",
+		slog.String("name", data.Name),
+	)
+
+	result, err := provider.Delete(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
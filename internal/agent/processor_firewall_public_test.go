@@ -0,0 +1,606 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent_test
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/agent"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/network/firewall"
+	firewallMocks "github.com/osapi-io/osapi/internal/provider/network/firewall/mocks"
+)
+
+type ProcessorFirewallPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl *gomock.Controller
+}
+
+func (s *ProcessorFirewallPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+}
+
+func (s *ProcessorFirewallPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *ProcessorFirewallPublicTestSuite) TestProcessFirewallOperation() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() firewall.Provider
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name: "nil provider returns error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "network",
+				Operation: "firewall.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock:   nil,
+			expectError: true,
+			errorMsg:    "firewall provider not available",
+		},
+		{
+			name: "invalid firewall operation missing sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "network",
+				Operation: "firewall",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() firewall.Provider {
+				return firewallMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "invalid firewall operation: firewall",
+		},
+		{
+			name: "unsupported firewall sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "network",
+				Operation: "firewall.unknown",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() firewall.Provider {
+				return firewallMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unsupported firewall operation: firewall.unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var firewallProvider firewall.Provider
+			if tt.setupMock != nil {
+				firewallProvider = tt.setupMock()
+			}
+
+			processor := agent.NewNetworkProcessor(
+				nil, nil,
+				nil,
+				nil,
+				firewallProvider,
+				nil,
+				nil,
+				slog.Default(),
+			)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+			}
+		})
+	}
+}
+
+func (s *ProcessorFirewallPublicTestSuite) TestProcessFirewallList() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() firewall.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful firewall list",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "network",
+				Operation: "firewall.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() firewall.Provider {
+				m := firewallMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().List(gomock.Any()).Return([]firewall.RuleSet{
+					{Name: "web", Object: "web-rules", Path: "/etc/nftables.d/web.nft"},
+					{Name: "ssh", Object: "ssh-rules", Path: "/etc/nftables.d/ssh.nft"},
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var ruleSets []firewall.RuleSet
+				err := json.Unmarshal(result, &ruleSets)
+				s.NoError(err)
+				s.Len(ruleSets, 2)
+				s.Equal("web", ruleSets[0].Name)
+			},
+		},
+		{
+			name: "firewall list provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "network",
+				Operation: "firewall.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() firewall.Provider {
+				m := firewallMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().List(gomock.Any()).Return(nil, errors.New("permission denied"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "permission denied",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := agent.NewNetworkProcessor(
+				nil, nil,
+				nil,
+				nil,
+				tt.setupMock(),
+				nil,
+				nil,
+				slog.Default(),
+			)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorFirewallPublicTestSuite) TestProcessFirewallGet() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() firewall.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful firewall get",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "network",
+				Operation: "firewall.get",
+				Data:      json.RawMessage(`{"name":"web"}`),
+			},
+			setupMock: func() firewall.Provider {
+				m := firewallMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Get(gomock.Any(), "web").Return(&firewall.RuleSet{
+					Name:   "web",
+					Object: "web-rules",
+					Path:   "/etc/nftables.d/web.nft",
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var ruleSet firewall.RuleSet
+				err := json.Unmarshal(result, &ruleSet)
+				s.NoError(err)
+				s.Equal("web", ruleSet.Name)
+				s.Equal("web-rules", ruleSet.Object)
+			},
+		},
+		{
+			name: "firewall get with invalid JSON data",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "network",
+				Operation: "firewall.get",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() firewall.Provider {
+				return firewallMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal firewall get data",
+		},
+		{
+			name: "firewall get provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "network",
+				Operation: "firewall.get",
+				Data:      json.RawMessage(`{"name":"missing"}`),
+			},
+			setupMock: func() firewall.Provider {
+				m := firewallMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Get(gomock.Any(), "missing").Return(nil, errors.New("not found"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := agent.NewNetworkProcessor(
+				nil, nil,
+				nil,
+				nil,
+				tt.setupMock(),
+				nil,
+				nil,
+				slog.Default(),
+			)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorFirewallPublicTestSuite) TestProcessFirewallCreate() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() firewall.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful firewall create",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "network",
+				Operation: "firewall.create",
+				Data: json.RawMessage(
+					`{"name":"web","object":"web-rules","content_type":"template","vars":{"port":443}}`,
+				),
+			},
+			setupMock: func() firewall.Provider {
+				m := firewallMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Create(gomock.Any(), firewall.RuleSet{
+					Name:        "web",
+					Object:      "web-rules",
+					ContentType: "template",
+					Vars:        map[string]any{"port": float64(443)},
+				}).Return(&firewall.CreateResult{
+					Name:    "web",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r firewall.CreateResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("web", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "firewall create with invalid JSON data",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "network",
+				Operation: "firewall.create",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() firewall.Provider {
+				return firewallMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal firewall create data",
+		},
+		{
+			name: "firewall create provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "network",
+				Operation: "firewall.create",
+				Data: json.RawMessage(
+					`{"name":"web","object":"web-rules","content_type":"template","vars":{"port":443}}`,
+				),
+			},
+			setupMock: func() firewall.Provider {
+				m := firewallMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("nft validate failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "nft validate failed",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := agent.NewNetworkProcessor(
+				nil, nil,
+				nil,
+				nil,
+				tt.setupMock(),
+				nil,
+				nil,
+				slog.Default(),
+			)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorFirewallPublicTestSuite) TestProcessFirewallUpdate() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() firewall.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful firewall update",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "network",
+				Operation: "firewall.update",
+				Data: json.RawMessage(
+					`{"name":"web","object":"web-rules-v2"}`,
+				),
+			},
+			setupMock: func() firewall.Provider {
+				m := firewallMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Update(gomock.Any(), firewall.RuleSet{
+					Name:   "web",
+					Object: "web-rules-v2",
+				}).Return(&firewall.UpdateResult{
+					Name:    "web",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r firewall.UpdateResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("web", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "firewall update with invalid JSON data",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "network",
+				Operation: "firewall.update",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() firewall.Provider {
+				return firewallMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal firewall update data",
+		},
+		{
+			name: "firewall update provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "network",
+				Operation: "firewall.update",
+				Data: json.RawMessage(
+					`{"name":"web","object":"web-rules-v2"}`,
+				),
+			},
+			setupMock: func() firewall.Provider {
+				m := firewallMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not managed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "not managed",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := agent.NewNetworkProcessor(
+				nil, nil,
+				nil,
+				nil,
+				tt.setupMock(),
+				nil,
+				nil,
+				slog.Default(),
+			)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorFirewallPublicTestSuite) TestProcessFirewallDelete() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() firewall.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful firewall delete",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "network",
+				Operation: "firewall.delete",
+				Data:      json.RawMessage(`{"name":"web"}`),
+			},
+			setupMock: func() firewall.Provider {
+				m := firewallMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Delete(gomock.Any(), "web").Return(&firewall.DeleteResult{
+					Name:    "web",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r firewall.DeleteResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("web", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "firewall delete with invalid JSON data",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "network",
+				Operation: "firewall.delete",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() firewall.Provider {
+				return firewallMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal firewall delete data",
+		},
+		{
+			name: "firewall delete provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "network",
+				Operation: "firewall.delete",
+				Data:      json.RawMessage(`{"name":"missing"}`),
+			},
+			setupMock: func() firewall.Provider {
+				m := firewallMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Delete(gomock.Any(), "missing").Return(nil, errors.New("not found"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := agent.NewNetworkProcessor(
+				nil, nil,
+				nil,
+				nil,
+				tt.setupMock(),
+				nil,
+				nil,
+				slog.Default(),
+			)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessorFirewallPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessorFirewallPublicTestSuite))
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/node/hosts"
+)
+
+// processHostsOperation dispatches /etc/hosts management sub-operations.
+func processHostsOperation(
+	hostsProvider hosts.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	if hostsProvider == nil {
+		return nil, fmt.Errorf("hosts provider not available")
+	}
+
+	// Extract sub-operation: "hosts.list" -> "list"
+	parts := strings.Split(jobRequest.Operation, ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid hosts operation: %s", jobRequest.Operation)
+	}
+	subOp := parts[1]
+
+	ctx := context.Background()
+
+	switch subOp {
+	case "list":
+		return processHostsList(ctx, hostsProvider, logger)
+	case "get":
+		return processHostsGet(ctx, hostsProvider, logger, jobRequest)
+	case "create":
+		return processHostsCreate(ctx, hostsProvider, logger, jobRequest)
+	case "update":
+		return processHostsUpdate(ctx, hostsProvider, logger, jobRequest)
+	case "delete":
+		return processHostsDelete(ctx, hostsProvider, logger, jobRequest)
+	default:
+		return nil, fmt.Errorf("unsupported hosts operation: %s", jobRequest.Operation)
+	}
+}
+
+// processHostsList lists the entries in /etc/hosts.
+func processHostsList(
+	ctx context.Context,
+	hostsProvider hosts.Provider,
+	logger *slog.Logger,
+) (json.RawMessage, error) {
+	logger.Debug("executing hosts.List")
+
+	result, err := hostsProvider.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processHostsGet retrieves the entry for a single address.
+func processHostsGet(
+	ctx context.Context,
+	hostsProvider hosts.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Address string `json:"address"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal hosts get data: %w", err)
+	}
+
+	logger.Debug(
+		"executing hosts.Get",
+		slog.String("address", data.Address),
+	)
+
+	result, err := hostsProvider.Get(ctx, data.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processHostsCreate adds a managed entry.
+func processHostsCreate(
+	ctx context.Context,
+	hostsProvider hosts.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var entry hosts.Entry
+	if err := json.Unmarshal(jobRequest.Data, &entry); err != nil {
+		return nil, fmt.Errorf("unmarshal hosts create data: %w", err)
+	}
+
+	logger.Debug(
+		"executing hosts.Create",
+		slog.String("address", entry.Address),
+	)
+
+	result, err := hostsProvider.Create(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processHostsUpdate replaces the hostnames of a managed entry.
+func processHostsUpdate(
+	ctx context.Context,
+	hostsProvider hosts.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var entry hosts.Entry
+	if err := json.Unmarshal(jobRequest.Data, &entry); err != nil {
+		return nil, fmt.Errorf("unmarshal hosts update data: %w", err)
+	}
+
+	logger.Debug(
+		"executing hosts.Update",
+		slog.String("address", entry.Address),
+	)
+
+	result, err := hostsProvider.Update(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processHostsDelete removes a managed entry.
+func processHostsDelete(
+	ctx context.Context,
+	hostsProvider hosts.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Address string `json:"address"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal hosts delete data: %w", err)
+	}
+
+	logger.Debug(
+		"executing hosts.Delete",
+		slog.String("address", data.Address),
+	)
+
+	result, err := hostsProvider.Delete(ctx, data.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
@@ -0,0 +1,714 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/agent"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider"
+	nodeHost "github.com/osapi-io/osapi/internal/provider/node/host"
+	hostMocks "github.com/osapi-io/osapi/internal/provider/node/host/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/hosts"
+	hostsMocks "github.com/osapi-io/osapi/internal/provider/node/hosts/mocks"
+)
+
+type ProcessorHostsPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl *gomock.Controller
+}
+
+func (s *ProcessorHostsPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+}
+
+func (s *ProcessorHostsPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *ProcessorHostsPublicTestSuite) newNodeProcessor(
+	hostProvider nodeHost.Provider,
+	hostsProvider hosts.Provider,
+) agent.ProcessorFunc {
+	return agent.NewNodeProcessor(
+		context.Background(),
+		hostProvider, nil, nil, nil,
+		nil, nil, nil, nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		hostsProvider,
+		nil,
+		nil,
+		nil,
+		config.Config{},
+		slog.Default(),
+	)
+}
+
+func (s *ProcessorHostsPublicTestSuite) TestProcessHostsOperation() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() hosts.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "nil provider returns error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "hosts.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock:   nil,
+			expectError: true,
+			errorMsg:    "hosts provider not available",
+		},
+		{
+			name: "invalid operation format",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "hosts",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() hosts.Provider {
+				return hostsMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "invalid hosts operation: hosts",
+		},
+		{
+			name: "unsupported sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "hosts.unknown",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() hosts.Provider {
+				return hostsMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unsupported hosts operation: hosts.unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var hostsProvider hosts.Provider
+			if tt.setupMock != nil {
+				hostsProvider = tt.setupMock()
+			}
+
+			processor := s.newNodeProcessor(nil, hostsProvider)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorHostsPublicTestSuite) TestProcessHostsList() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() hosts.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful list",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "hosts.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() hosts.Provider {
+				m := hostsMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().List(gomock.Any()).Return([]hosts.Entry{
+					{
+						Address:   "127.0.0.1",
+						Hostnames: []string{"localhost"},
+					},
+					{
+						Address:   "10.0.0.5",
+						Hostnames: []string{"db01.internal", "db01"},
+						Managed:   true,
+					},
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var entries []hosts.Entry
+				err := json.Unmarshal(result, &entries)
+				s.NoError(err)
+				s.Len(entries, 2)
+				s.Equal("10.0.0.5", entries[1].Address)
+				s.True(entries[1].Managed)
+			},
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "hosts.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() hosts.Provider {
+				m := hostsMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().List(gomock.Any()).
+					Return(nil, errors.New("read /etc/hosts failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "read /etc/hosts failed",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(nil, tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorHostsPublicTestSuite) TestProcessHostsGet() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() hosts.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful get",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "hosts.get",
+				Data:      json.RawMessage(`{"address":"10.0.0.5"}`),
+			},
+			setupMock: func() hosts.Provider {
+				m := hostsMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Get(gomock.Any(), "10.0.0.5").Return(&hosts.Entry{
+					Address:   "10.0.0.5",
+					Hostnames: []string{"db01.internal", "db01"},
+					Managed:   true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var entry hosts.Entry
+				err := json.Unmarshal(result, &entry)
+				s.NoError(err)
+				s.Equal("10.0.0.5", entry.Address)
+				s.Equal([]string{"db01.internal", "db01"}, entry.Hostnames)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "hosts.get",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() hosts.Provider {
+				return hostsMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal hosts get data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "hosts.get",
+				Data:      json.RawMessage(`{"address":"10.0.0.9"}`),
+			},
+			setupMock: func() hosts.Provider {
+				m := hostsMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Get(gomock.Any(), "10.0.0.9").
+					Return(nil, errors.New("hosts: get: hosts entry \"10.0.0.9\": not found"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(nil, tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorHostsPublicTestSuite) TestProcessHostsCreate() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() hosts.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful create",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "hosts.create",
+				Data:      json.RawMessage(`{"address":"10.0.0.5","hostnames":["db01.internal","db01"]}`),
+			},
+			setupMock: func() hosts.Provider {
+				m := hostsMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Create(gomock.Any(), hosts.Entry{
+					Address:   "10.0.0.5",
+					Hostnames: []string{"db01.internal", "db01"},
+				}).Return(&hosts.Result{
+					Address: "10.0.0.5",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r hosts.Result
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("10.0.0.5", r.Address)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "hosts.create",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() hosts.Provider {
+				return hostsMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal hosts create data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "hosts.create",
+				Data:      json.RawMessage(`{"address":"10.0.0.5","hostnames":["-bad"]}`),
+			},
+			setupMock: func() hosts.Provider {
+				m := hostsMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("invalid hostname \"-bad\""))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "invalid hostname",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(nil, tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorHostsPublicTestSuite) TestProcessHostsUpdate() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() hosts.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful update",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "hosts.update",
+				Data:      json.RawMessage(`{"address":"10.0.0.5","hostnames":["db01.internal","db01"]}`),
+			},
+			setupMock: func() hosts.Provider {
+				m := hostsMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Update(gomock.Any(), hosts.Entry{
+					Address:   "10.0.0.5",
+					Hostnames: []string{"db01.internal", "db01"},
+				}).Return(&hosts.Result{
+					Address: "10.0.0.5",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r hosts.Result
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("10.0.0.5", r.Address)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "hosts.update",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() hosts.Provider {
+				return hostsMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal hosts update data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "hosts.update",
+				Data:      json.RawMessage(`{"address":"10.0.0.5","hostnames":["-bad"]}`),
+			},
+			setupMock: func() hosts.Provider {
+				m := hostsMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("invalid hostname \"-bad\""))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "invalid hostname",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(nil, tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorHostsPublicTestSuite) TestProcessHostsDelete() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() hosts.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful delete",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "hosts.delete",
+				Data:      json.RawMessage(`{"address":"10.0.0.5"}`),
+			},
+			setupMock: func() hosts.Provider {
+				m := hostsMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Delete(gomock.Any(), "10.0.0.5").Return(&hosts.Result{
+					Address: "10.0.0.5",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r hosts.Result
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "hosts.delete",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() hosts.Provider {
+				return hostsMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal hosts delete data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "hosts.delete",
+				Data:      json.RawMessage(`{"address":"10.0.0.5"}`),
+			},
+			setupMock: func() hosts.Provider {
+				m := hostsMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Delete(gomock.Any(), "10.0.0.5").
+					Return(nil, errors.New("permission denied"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "permission denied",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(nil, tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorHostsPublicTestSuite) TestHostnameUpdateSyncsHosts() {
+	tests := []struct {
+		name        string
+		setupMock   func() (nodeHost.Provider, hosts.Provider)
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "updates loopback entry when hostname changes",
+			setupMock: func() (nodeHost.Provider, hosts.Provider) {
+				hostMock := hostMocks.NewPlainMockProvider(s.mockCtrl)
+				hostMock.EXPECT().GetHostname().Return("old-host", nil)
+				hostMock.EXPECT().UpdateHostname("new-host").
+					Return(&nodeHost.UpdateHostnameResult{Changed: true}, nil)
+				hostsMock := hostsMocks.NewMockProvider(s.mockCtrl)
+				hostsMock.EXPECT().SetHostname(gomock.Any(), "old-host", "new-host").
+					Return(&hosts.Result{Address: "127.0.1.1", Changed: true}, nil)
+				return hostMock, hostsMock
+			},
+			validate: func(result json.RawMessage) {
+				var response map[string]interface{}
+				err := json.Unmarshal(result, &response)
+				s.NoError(err)
+				s.Equal("new-host", response["hostname"])
+				s.Equal(true, response["changed"])
+			},
+		},
+		{
+			name: "skips hosts update when hostname is unchanged",
+			setupMock: func() (nodeHost.Provider, hosts.Provider) {
+				hostMock := hostMocks.NewPlainMockProvider(s.mockCtrl)
+				hostMock.EXPECT().GetHostname().Return("new-host", nil)
+				hostMock.EXPECT().UpdateHostname("new-host").
+					Return(&nodeHost.UpdateHostnameResult{Changed: false}, nil)
+				return hostMock, hostsMocks.NewMockProvider(s.mockCtrl)
+			},
+			validate: func(result json.RawMessage) {
+				var response map[string]interface{}
+				err := json.Unmarshal(result, &response)
+				s.NoError(err)
+				s.Equal(false, response["changed"])
+			},
+		},
+		{
+			name: "hosts update error does not fail hostname update",
+			setupMock: func() (nodeHost.Provider, hosts.Provider) {
+				hostMock := hostMocks.NewPlainMockProvider(s.mockCtrl)
+				hostMock.EXPECT().GetHostname().Return("old-host", nil)
+				hostMock.EXPECT().UpdateHostname("new-host").
+					Return(&nodeHost.UpdateHostnameResult{Changed: true}, nil)
+				hostsMock := hostsMocks.NewMockProvider(s.mockCtrl)
+				hostsMock.EXPECT().SetHostname(gomock.Any(), "old-host", "new-host").
+					Return(nil, errors.New("permission denied"))
+				return hostMock, hostsMock
+			},
+			validate: func(result json.RawMessage) {
+				var response map[string]interface{}
+				err := json.Unmarshal(result, &response)
+				s.NoError(err)
+				s.Equal(true, response["changed"])
+			},
+		},
+		{
+			name: "unsupported hosts provider is ignored",
+			setupMock: func() (nodeHost.Provider, hosts.Provider) {
+				hostMock := hostMocks.NewPlainMockProvider(s.mockCtrl)
+				hostMock.EXPECT().GetHostname().Return("old-host", nil)
+				hostMock.EXPECT().UpdateHostname("new-host").
+					Return(&nodeHost.UpdateHostnameResult{Changed: true}, nil)
+				hostsMock := hostsMocks.NewMockProvider(s.mockCtrl)
+				hostsMock.EXPECT().SetHostname(gomock.Any(), "old-host", "new-host").
+					Return(nil, fmt.Errorf("hosts: %w", provider.ErrUnsupported))
+				return hostMock, hostsMock
+			},
+			validate: func(result json.RawMessage) {
+				var response map[string]interface{}
+				err := json.Unmarshal(result, &response)
+				s.NoError(err)
+				s.Equal(true, response["changed"])
+			},
+		},
+		{
+			name: "hostname update error skips hosts update",
+			setupMock: func() (nodeHost.Provider, hosts.Provider) {
+				hostMock := hostMocks.NewPlainMockProvider(s.mockCtrl)
+				hostMock.EXPECT().GetHostname().Return("old-host", nil)
+				hostMock.EXPECT().UpdateHostname("new-host").
+					Return(nil, errors.New("hostnamectl failed"))
+				return hostMock, hostsMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "hostnamectl failed",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			hostProvider, hostsProvider := tt.setupMock()
+			processor := s.newNodeProcessor(hostProvider, hostsProvider)
+			result, err := processor(job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "hostname.update",
+				Data:      json.RawMessage(`{"hostname":"new-host"}`),
+			})
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessorHostsPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessorHostsPublicTestSuite))
+}
@@ -110,6 +110,9 @@ func (s *ProcessorInterfacePublicTestSuite) TestProcessInterfaceOperation() {
 				nil, nil,
 				ifaceProvider,
 				nil,
+				nil,
+				nil,
+				nil,
 				slog.Default(),
 			)
 			result, err := processor(tt.jobRequest)
@@ -183,6 +186,9 @@ func (s *ProcessorInterfacePublicTestSuite) TestProcessInterfaceList() {
 				nil, nil,
 				tt.setupMock(),
 				nil,
+				nil,
+				nil,
+				nil,
 				slog.Default(),
 			)
 			result, err := processor(tt.jobRequest)
@@ -271,6 +277,9 @@ func (s *ProcessorInterfacePublicTestSuite) TestProcessInterfaceGet() {
 				nil, nil,
 				tt.setupMock(),
 				nil,
+				nil,
+				nil,
+				nil,
 				slog.Default(),
 			)
 			result, err := processor(tt.jobRequest)
@@ -366,6 +375,9 @@ func (s *ProcessorInterfacePublicTestSuite) TestProcessInterfaceCreate() {
 				nil, nil,
 				tt.setupMock(),
 				nil,
+				nil,
+				nil,
+				nil,
 				slog.Default(),
 			)
 			result, err := processor(tt.jobRequest)
@@ -461,6 +473,9 @@ func (s *ProcessorInterfacePublicTestSuite) TestProcessInterfaceUpdate() {
 				nil, nil,
 				tt.setupMock(),
 				nil,
+				nil,
+				nil,
+				nil,
 				slog.Default(),
 			)
 			result, err := processor(tt.jobRequest)
@@ -551,6 +566,9 @@ func (s *ProcessorInterfacePublicTestSuite) TestProcessInterfaceDelete() {
 				nil, nil,
 				tt.setupMock(),
 				nil,
+				nil,
+				nil,
+				nil,
 				slog.Default(),
 			)
 			result, err := processor(tt.jobRequest)
@@ -0,0 +1,188 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/node/kernel"
+)
+
+// processKernelOperation dispatches kernel sub-operations.
+func processKernelOperation(
+	kernelProvider kernel.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	if kernelProvider == nil {
+		return nil, fmt.Errorf("kernel provider not available")
+	}
+
+	// Extract sub-operation: "kernel.module.list" -> "module", "list"
+	parts := strings.Split(jobRequest.Operation, ".")
+	if len(parts) < 3 || parts[1] != "module" {
+		return nil, fmt.Errorf("unsupported kernel operation: %s", jobRequest.Operation)
+	}
+
+	ctx := context.Background()
+
+	switch parts[2] {
+	case "list":
+		return processKernelModuleList(ctx, kernelProvider, logger)
+	case "get":
+		return processKernelModuleGet(ctx, kernelProvider, logger, jobRequest)
+	case "create":
+		return processKernelModuleCreate(ctx, kernelProvider, logger, jobRequest)
+	case "update":
+		return processKernelModuleUpdate(ctx, kernelProvider, logger, jobRequest)
+	case "delete":
+		return processKernelModuleDelete(ctx, kernelProvider, logger, jobRequest)
+	default:
+		return nil, fmt.Errorf("unsupported kernel operation: %s", jobRequest.Operation)
+	}
+}
+
+// processKernelModuleList lists loaded and managed kernel modules.
+func processKernelModuleList(
+	ctx context.Context,
+	kernelProvider kernel.Provider,
+	logger *slog.Logger,
+) (json.RawMessage, error) {
+	logger.Debug("executing kernel.ListModules")
+
+	result, err := kernelProvider.ListModules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processKernelModuleGet retrieves a single kernel module.
+func processKernelModuleGet(
+	ctx context.Context,
+	kernelProvider kernel.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal kernel module get data: %w", err)
+	}
+
+	logger.Debug(
+		"executing kernel.GetModule",
+		slog.String("name", data.Name),
+	)
+
+	result, err := kernelProvider.GetModule(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processKernelModuleCreate configures and applies a kernel module.
+func processKernelModuleCreate(
+	ctx context.Context,
+	kernelProvider kernel.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var module kernel.Module
+	if err := json.Unmarshal(jobRequest.Data, &module); err != nil {
+		return nil, fmt.Errorf("unmarshal kernel module create data: %w", err)
+	}
+
+	logger.Debug(
+		"executing kernel.CreateModule",
+		slog.String("name", module.Name),
+	)
+
+	result, err := kernelProvider.CreateModule(ctx, module)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processKernelModuleUpdate reconfigures and applies a managed kernel
+// module.
+func processKernelModuleUpdate(
+	ctx context.Context,
+	kernelProvider kernel.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var module kernel.Module
+	if err := json.Unmarshal(jobRequest.Data, &module); err != nil {
+		return nil, fmt.Errorf("unmarshal kernel module update data: %w", err)
+	}
+
+	logger.Debug(
+		"executing kernel.UpdateModule",
+		slog.String("name", module.Name),
+	)
+
+	result, err := kernelProvider.UpdateModule(ctx, module)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processKernelModuleDelete removes the configuration of a managed kernel
+// module.
+func processKernelModuleDelete(
+	ctx context.Context,
+	kernelProvider kernel.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal kernel module delete data: %w", err)
+	}
+
+	logger.Debug(
+		"executing kernel.DeleteModule",
+		slog.String("name", data.Name),
+	)
+
+	result, err := kernelProvider.DeleteModule(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
@@ -0,0 +1,600 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/agent"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/node/kernel"
+	kernelMocks "github.com/osapi-io/osapi/internal/provider/node/kernel/mocks"
+)
+
+type ProcessorKernelPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl *gomock.Controller
+}
+
+func (s *ProcessorKernelPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+}
+
+func (s *ProcessorKernelPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *ProcessorKernelPublicTestSuite) newNodeProcessor(
+	kernelProvider kernel.Provider,
+) agent.ProcessorFunc {
+	return agent.NewNodeProcessor(
+		context.Background(),
+		nil, nil, nil, nil,
+		nil, nil, nil, nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		kernelProvider,
+		nil,
+		nil,
+		nil,
+		nil,
+		config.Config{},
+		slog.Default(),
+	)
+}
+
+func (s *ProcessorKernelPublicTestSuite) TestProcessKernelOperation() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() kernel.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "nil provider returns error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "kernel.module.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock:   nil,
+			expectError: true,
+			errorMsg:    "kernel provider not available",
+		},
+		{
+			name: "invalid operation format missing sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "kernel.module",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() kernel.Provider {
+				return kernelMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unsupported kernel operation: kernel.module",
+		},
+		{
+			name: "unsupported resource",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "kernel.param.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() kernel.Provider {
+				return kernelMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unsupported kernel operation: kernel.param.list",
+		},
+		{
+			name: "unsupported sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "kernel.module.unknown",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() kernel.Provider {
+				return kernelMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unsupported kernel operation: kernel.module.unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var kernelProvider kernel.Provider
+			if tt.setupMock != nil {
+				kernelProvider = tt.setupMock()
+			}
+
+			processor := s.newNodeProcessor(kernelProvider)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorKernelPublicTestSuite) TestProcessKernelModuleList() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() kernel.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful list",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "kernel.module.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() kernel.Provider {
+				m := kernelMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().ListModules(gomock.Any()).Return([]kernel.Module{
+					{
+						Name:    "br_netfilter",
+						Loaded:  true,
+						Size:    32768,
+						Managed: true,
+						Load:    true,
+					},
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var modules []kernel.Module
+				err := json.Unmarshal(result, &modules)
+				s.NoError(err)
+				s.Len(modules, 1)
+				s.Equal("br_netfilter", modules[0].Name)
+				s.True(modules[0].Managed)
+			},
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "kernel.module.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() kernel.Provider {
+				m := kernelMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().ListModules(gomock.Any()).
+					Return(nil, errors.New("read /proc/modules failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "read /proc/modules failed",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorKernelPublicTestSuite) TestProcessKernelModuleGet() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() kernel.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful get",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "kernel.module.get",
+				Data:      json.RawMessage(`{"name":"br_netfilter"}`),
+			},
+			setupMock: func() kernel.Provider {
+				m := kernelMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().GetModule(gomock.Any(), "br_netfilter").Return(&kernel.Module{
+					Name:   "br_netfilter",
+					Loaded: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var module kernel.Module
+				err := json.Unmarshal(result, &module)
+				s.NoError(err)
+				s.Equal("br_netfilter", module.Name)
+				s.True(module.Loaded)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "kernel.module.get",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() kernel.Provider {
+				return kernelMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal kernel module get data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "kernel.module.get",
+				Data:      json.RawMessage(`{"name":"floppy"}`),
+			},
+			setupMock: func() kernel.Provider {
+				m := kernelMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().GetModule(gomock.Any(), "floppy").
+					Return(nil, errors.New("kernel: get module \"floppy\": not found"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorKernelPublicTestSuite) TestProcessKernelModuleCreate() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() kernel.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful create",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "kernel.module.create",
+				Data:      json.RawMessage(`{"name":"br_netfilter","load":true,"options":"debug=1"}`),
+			},
+			setupMock: func() kernel.Provider {
+				m := kernelMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().CreateModule(gomock.Any(), kernel.Module{
+					Name:    "br_netfilter",
+					Load:    true,
+					Options: "debug=1",
+				}).Return(&kernel.ModuleResult{
+					Name:    "br_netfilter",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r kernel.ModuleResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("br_netfilter", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "kernel.module.create",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() kernel.Provider {
+				return kernelMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal kernel module create data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "kernel.module.create",
+				Data:      json.RawMessage(`{"name":"nosuch","load":true}`),
+			},
+			setupMock: func() kernel.Provider {
+				m := kernelMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().CreateModule(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("modprobe: FATAL: Module nosuch not found"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "Module nosuch not found",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorKernelPublicTestSuite) TestProcessKernelModuleUpdate() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() kernel.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful update",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "kernel.module.update",
+				Data:      json.RawMessage(`{"name":"br_netfilter","load":true,"options":"debug=1"}`),
+			},
+			setupMock: func() kernel.Provider {
+				m := kernelMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().UpdateModule(gomock.Any(), kernel.Module{
+					Name:    "br_netfilter",
+					Load:    true,
+					Options: "debug=1",
+				}).Return(&kernel.ModuleResult{
+					Name:    "br_netfilter",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r kernel.ModuleResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("br_netfilter", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "kernel.module.update",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() kernel.Provider {
+				return kernelMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal kernel module update data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "kernel.module.update",
+				Data:      json.RawMessage(`{"name":"nosuch","load":true}`),
+			},
+			setupMock: func() kernel.Provider {
+				m := kernelMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().UpdateModule(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("modprobe: FATAL: Module nosuch not found"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "Module nosuch not found",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorKernelPublicTestSuite) TestProcessKernelModuleDelete() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() kernel.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful delete",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "kernel.module.delete",
+				Data:      json.RawMessage(`{"name":"br_netfilter"}`),
+			},
+			setupMock: func() kernel.Provider {
+				m := kernelMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().DeleteModule(gomock.Any(), "br_netfilter").Return(&kernel.ModuleResult{
+					Name:    "br_netfilter",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r kernel.ModuleResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "kernel.module.delete",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() kernel.Provider {
+				return kernelMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal kernel module delete data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "kernel.module.delete",
+				Data:      json.RawMessage(`{"name":"br_netfilter"}`),
+			},
+			setupMock: func() kernel.Provider {
+				m := kernelMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().DeleteModule(gomock.Any(), "br_netfilter").
+					Return(nil, errors.New("permission denied"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "permission denied",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessorKernelPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessorKernelPublicTestSuite))
+}
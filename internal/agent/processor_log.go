@@ -26,14 +26,30 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
 
 	"github.com/osapi-io/osapi/internal/job"
 	logProv "github.com/osapi-io/osapi/internal/provider/node/log"
 )
 
+const (
+	// defaultLogFollowDuration is how long a follow streams when the
+	// request does not specify a duration.
+	defaultLogFollowDuration = 60 * time.Second
+	// maxLogFollowDuration caps how long a single follow may stream.
+	maxLogFollowDuration = time.Hour
+	// logFollowStartWait is how long a follow job waits for the provider
+	// to fail fast (e.g. unsupported platform) before reporting success.
+	logFollowStartWait = 500 * time.Millisecond
+)
+
 // processLogOperation dispatches log management sub-operations.
 func processLogOperation(
+	ctx context.Context,
 	logProvider logProv.Provider,
+	streamPublisher NATSPublisher,
 	logger *slog.Logger,
 	jobRequest job.Request,
 ) (json.RawMessage, error) {
@@ -48,15 +64,17 @@ func processLogOperation(
 	}
 	subOp := parts[1]
 
-	ctx := context.Background()
-
 	switch subOp {
 	case "query":
 		return processLogQuery(ctx, logProvider, logger, jobRequest)
 	case "queryUnit":
 		return processLogQueryUnit(ctx, logProvider, logger, jobRequest)
+	case "queryFile":
+		return processLogQueryFile(ctx, logProvider, logger, jobRequest)
 	case "sources":
 		return processLogSources(ctx, logProvider, logger)
+	case "follow":
+		return processLogFollow(ctx, logProvider, streamPublisher, logger, jobRequest)
 	default:
 		return nil, fmt.Errorf("unsupported log operation: %s", jobRequest.Operation)
 	}
@@ -126,3 +144,119 @@ func processLogQueryUnit(
 
 	return json.Marshal(result)
 }
+
+// processLogQueryFile retrieves entries from an allow-listed plain log file.
+func processLogQueryFile(
+	ctx context.Context,
+	logProvider logProv.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	logger.Debug("executing log.QueryFile")
+
+	var data struct {
+		Path string `json:"path"`
+		logProv.FileQueryOpts
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal log query file data: %w", err)
+	}
+
+	result, err := logProvider.QueryFile(ctx, data.Path, data.FileQueryOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processLogFollow streams new journal entries for a systemd unit to the
+// job's stream subject for a bounded duration. The job completes as soon
+// as streaming starts; entries and a final empty terminator message are
+// published in the background. Streaming stops early when ctx is done or
+// the consumer publishes to the job's stream stop subject.
+func processLogFollow(
+	ctx context.Context,
+	logProvider logProv.Provider,
+	streamPublisher NATSPublisher,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	logger.Debug("executing log.FollowUnit")
+
+	if streamPublisher == nil {
+		return nil, fmt.Errorf("stream publisher not available")
+	}
+
+	var data struct {
+		Unit     string `json:"unit"`
+		Duration int    `json:"duration,omitempty"`
+		logProv.FollowOpts
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal log follow data: %w", err)
+	}
+
+	duration := time.Duration(data.Duration) * time.Second
+	if duration <= 0 {
+		duration = defaultLogFollowDuration
+	}
+	if duration > maxLogFollowDuration {
+		duration = maxLogFollowDuration
+	}
+
+	subject := job.BuildStreamSubject(jobRequest.JobID)
+	followCtx, cancel := context.WithTimeout(ctx, duration)
+
+	stopSub, err := streamPublisher.Subscribe(
+		job.BuildStreamStopSubject(jobRequest.JobID),
+		func(_ *nats.Msg) {
+			cancel()
+		},
+	)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("subscribe to log follow stop: %w", err)
+	}
+
+	done := make(chan error, 1)
+
+	go func() {
+		defer cancel()
+		defer func() { _ = stopSub.Unsubscribe() }()
+
+		err := logProvider.FollowUnit(followCtx, data.Unit, data.FollowOpts, func(entry logProv.Entry) error {
+			// Entry only holds strings and ints, so Marshal always succeeds.
+			payload, _ := json.Marshal(entry)
+
+			return streamPublisher.PublishCore(subject, payload)
+		})
+		if err != nil {
+			logger.Warn(
+				"log follow stopped",
+				slog.String("unit", data.Unit),
+				slog.String("error", err.Error()),
+			)
+		}
+
+		if pubErr := streamPublisher.PublishCore(subject, []byte{}); pubErr != nil {
+			logger.Warn(
+				"failed to publish log follow terminator",
+				slog.String("subject", subject),
+				slog.String("error", pubErr.Error()),
+			)
+		}
+
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return nil, err
+		}
+	case <-time.After(logFollowStartWait):
+	}
+
+	return json.Marshal(map[string]string{"subject": subject})
+}
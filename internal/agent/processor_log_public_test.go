@@ -21,17 +21,22 @@
 package agent_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log/slog"
 	"testing"
+	"time"
 
+	"github.com/nats-io/nats.go"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 
 	"github.com/osapi-io/osapi/internal/agent"
+	agentmocks "github.com/osapi-io/osapi/internal/agent/mocks"
 	"github.com/osapi-io/osapi/internal/config"
 	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider"
 	"github.com/osapi-io/osapi/internal/provider/node/log"
 	logMocks "github.com/osapi-io/osapi/internal/provider/node/log/mocks"
 )
@@ -51,9 +56,12 @@ func (s *ProcessorLogPublicTestSuite) TearDownTest() {
 }
 
 func (s *ProcessorLogPublicTestSuite) newProcessor(
+	ctx context.Context,
 	logProvider log.Provider,
+	streamPublisher agent.NATSPublisher,
 ) agent.ProcessorFunc {
 	return agent.NewNodeProcessor(
+		ctx,
 		nil, nil, nil, nil,
 		nil, nil, nil, nil,
 		nil,
@@ -61,6 +69,14 @@ func (s *ProcessorLogPublicTestSuite) newProcessor(
 		nil,
 		logProvider,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		streamPublisher,
 		config.Config{},
 		slog.Default(),
 	)
@@ -121,7 +137,7 @@ func (s *ProcessorLogPublicTestSuite) TestProcessLogOperation() {
 				logProvider = tt.setupMock()
 			}
 
-			processor := s.newProcessor(logProvider)
+			processor := s.newProcessor(context.Background(), logProvider, nil)
 			result, err := processor(tt.jobRequest)
 
 			if tt.expectError {
@@ -182,14 +198,22 @@ func (s *ProcessorLogPublicTestSuite) TestProcessLogQuery() {
 				Type:      job.TypeQuery,
 				Category:  "node",
 				Operation: "log.query",
-				Data:      json.RawMessage(`{"lines":50,"since":"1 hour ago","priority":"err"}`),
+				Data: json.RawMessage(
+					`{"lines":50,"since":"1 hour ago","until":"now","priority":"err",` +
+						`"boot":"-1","grep":"fail","fields":{"_COMM":"sshd"},"reverse":true}`,
+				),
 			},
 			setupMock: func() log.Provider {
 				m := logMocks.NewMockProvider(s.mockCtrl)
 				m.EXPECT().Query(gomock.Any(), log.QueryOpts{
 					Lines:    50,
 					Since:    "1 hour ago",
+					Until:    "now",
 					Priority: "err",
+					Boot:     "-1",
+					Grep:     "fail",
+					Fields:   map[string]string{"_COMM": "sshd"},
+					Reverse:  true,
 				}).Return([]log.Entry{
 					{
 						Timestamp: "2026-01-01T00:00:00Z",
@@ -243,7 +267,7 @@ func (s *ProcessorLogPublicTestSuite) TestProcessLogQuery() {
 
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
-			processor := s.newProcessor(tt.setupMock())
+			processor := s.newProcessor(context.Background(), tt.setupMock(), nil)
 			result, err := processor(tt.jobRequest)
 
 			if tt.expectError {
@@ -337,7 +361,106 @@ func (s *ProcessorLogPublicTestSuite) TestProcessLogQueryUnit() {
 
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
-			processor := s.newProcessor(tt.setupMock())
+			processor := s.newProcessor(context.Background(), tt.setupMock(), nil)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorLogPublicTestSuite) TestProcessLogQueryFile() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() log.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "queryFile with path and options",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "log.queryFile",
+				Data: json.RawMessage(
+					`{"path":"/var/log/syslog","lines":20,"grep":"sshd","reverse":true}`,
+				),
+			},
+			setupMock: func() log.Provider {
+				m := logMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					QueryFile(gomock.Any(), "/var/log/syslog", log.FileQueryOpts{
+						Lines:   20,
+						Grep:    "sshd",
+						Reverse: true,
+					}).
+					Return([]log.Entry{
+						{
+							Timestamp: "2026-01-01T00:00:00Z",
+							Unit:      "sshd",
+							Message:   "Accepted publickey",
+						},
+					}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var entries []log.Entry
+				err := json.Unmarshal(result, &entries)
+				s.NoError(err)
+				s.Len(entries, 1)
+				s.Equal("sshd", entries[0].Unit)
+				s.Equal("Accepted publickey", entries[0].Message)
+			},
+		},
+		{
+			name: "queryFile unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "log.queryFile",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() log.Provider {
+				return logMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal log query file data",
+		},
+		{
+			name: "queryFile provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "log.queryFile",
+				Data:      json.RawMessage(`{"path":"/etc/shadow"}`),
+			},
+			setupMock: func() log.Provider {
+				m := logMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					QueryFile(gomock.Any(), "/etc/shadow", gomock.Any()).
+					Return(nil, errors.New("log: query file: path \"/etc/shadow\" is not under /var/log"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "is not under /var/log",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newProcessor(context.Background(), tt.setupMock(), nil)
 			result, err := processor(tt.jobRequest)
 
 			if tt.expectError {
@@ -406,7 +529,7 @@ func (s *ProcessorLogPublicTestSuite) TestProcessLogSources() {
 
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
-			processor := s.newProcessor(tt.setupMock())
+			processor := s.newProcessor(context.Background(), tt.setupMock(), nil)
 			result, err := processor(tt.jobRequest)
 
 			if tt.expectError {
@@ -424,6 +547,379 @@ func (s *ProcessorLogPublicTestSuite) TestProcessLogSources() {
 	}
 }
 
+func (s *ProcessorLogPublicTestSuite) TestProcessLogFollow() {
+	const (
+		subject     = "streams.job-1"
+		stopSubject = "streams.job-1.stop"
+	)
+
+	entry := log.Entry{
+		Timestamp: "2026-01-01T00:00:00Z",
+		Unit:      "nginx",
+		Priority:  "info",
+		Message:   "Started nginx",
+	}
+
+	tests := []struct {
+		name           string
+		jobRequest     job.Request
+		setupMock      func(published chan struct{}) (log.Provider, agent.NATSPublisher)
+		wantTerminator bool
+		agentStopped   bool
+		expectError    bool
+		errorMsg       string
+		errorIs        error
+		validate       func(json.RawMessage)
+	}{
+		{
+			name:           "follow streams entries and terminator",
+			wantTerminator: true,
+			jobRequest: job.Request{
+				JobID:     "job-1",
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "log.follow",
+				Data:      json.RawMessage(`{"unit":"nginx.service","lines":5,"priority":"err"}`),
+			},
+			setupMock: func(published chan struct{}) (log.Provider, agent.NATSPublisher) {
+				entryJSON, _ := json.Marshal(entry)
+
+				pub := agentmocks.NewMockNATSPublisher(s.mockCtrl)
+				s.expectStopSubscribe(pub, nil, nil)
+				gomock.InOrder(
+					pub.EXPECT().PublishCore(subject, entryJSON).Return(nil),
+					pub.EXPECT().
+						PublishCore(subject, []byte{}).
+						DoAndReturn(func(_ string, _ []byte) error {
+							close(published)
+							return nil
+						}),
+				)
+
+				m := logMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					FollowUnit(
+						gomock.Any(),
+						"nginx.service",
+						log.FollowOpts{Lines: 5, Priority: "err"},
+						gomock.Any(),
+					).
+					DoAndReturn(func(
+						_ context.Context,
+						_ string,
+						_ log.FollowOpts,
+						onEntry func(log.Entry) error,
+					) error {
+						return onEntry(entry)
+					})
+				return m, pub
+			},
+			validate: func(result json.RawMessage) {
+				var r map[string]string
+				s.NoError(json.Unmarshal(result, &r))
+				s.Equal(subject, r["subject"])
+			},
+		},
+		{
+			name:           "follow keeps streaming after job completes",
+			wantTerminator: true,
+			jobRequest: job.Request{
+				JobID:     "job-1",
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "log.follow",
+				Data:      json.RawMessage(`{"unit":"nginx.service","duration":1}`),
+			},
+			setupMock: func(published chan struct{}) (log.Provider, agent.NATSPublisher) {
+				pub := agentmocks.NewMockNATSPublisher(s.mockCtrl)
+				s.expectStopSubscribe(pub, nil, nil)
+				pub.EXPECT().
+					PublishCore(subject, []byte{}).
+					DoAndReturn(func(_ string, _ []byte) error {
+						close(published)
+						return nil
+					})
+
+				m := logMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					FollowUnit(gomock.Any(), "nginx.service", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						ctx context.Context,
+						_ string,
+						_ log.FollowOpts,
+						_ func(log.Entry) error,
+					) error {
+						<-ctx.Done()
+						return nil
+					})
+				return m, pub
+			},
+			validate: func(result json.RawMessage) {
+				s.Contains(string(result), subject)
+			},
+		},
+		{
+			name:           "follow unsupported returns error",
+			wantTerminator: true,
+			jobRequest: job.Request{
+				JobID:     "job-1",
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "log.follow",
+				Data:      json.RawMessage(`{"unit":"nginx.service"}`),
+			},
+			setupMock: func(published chan struct{}) (log.Provider, agent.NATSPublisher) {
+				pub := agentmocks.NewMockNATSPublisher(s.mockCtrl)
+				s.expectStopSubscribe(pub, nil, nil)
+				pub.EXPECT().
+					PublishCore(subject, []byte{}).
+					DoAndReturn(func(_ string, _ []byte) error {
+						close(published)
+						return nil
+					})
+
+				m := logMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					FollowUnit(gomock.Any(), "nginx.service", gomock.Any(), gomock.Any()).
+					Return(provider.ErrUnsupported)
+				return m, pub
+			},
+			expectError: true,
+			errorIs:     provider.ErrUnsupported,
+		},
+		{
+			name:           "follow publish error stops streaming",
+			wantTerminator: true,
+			jobRequest: job.Request{
+				JobID:     "job-1",
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "log.follow",
+				Data:      json.RawMessage(`{"unit":"nginx.service"}`),
+			},
+			setupMock: func(published chan struct{}) (log.Provider, agent.NATSPublisher) {
+				pub := agentmocks.NewMockNATSPublisher(s.mockCtrl)
+				s.expectStopSubscribe(pub, nil, nil)
+				gomock.InOrder(
+					pub.EXPECT().
+						PublishCore(subject, gomock.Any()).
+						Return(errors.New("connection closed")),
+					pub.EXPECT().
+						PublishCore(subject, []byte{}).
+						DoAndReturn(func(_ string, _ []byte) error {
+							close(published)
+							return errors.New("connection closed")
+						}),
+				)
+
+				m := logMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					FollowUnit(gomock.Any(), "nginx.service", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						_ string,
+						_ log.FollowOpts,
+						onEntry func(log.Entry) error,
+					) error {
+						return onEntry(entry)
+					})
+				return m, pub
+			},
+			expectError: true,
+			errorMsg:    "connection closed",
+		},
+		{
+			name:           "follow stops when the consumer publishes stop",
+			wantTerminator: true,
+			jobRequest: job.Request{
+				JobID:     "job-1",
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "log.follow",
+				Data:      json.RawMessage(`{"unit":"nginx.service","duration":3600}`),
+			},
+			setupMock: func(published chan struct{}) (log.Provider, agent.NATSPublisher) {
+				var stop nats.MsgHandler
+
+				pub := agentmocks.NewMockNATSPublisher(s.mockCtrl)
+				s.expectStopSubscribe(pub, &stop, nil)
+				pub.EXPECT().
+					PublishCore(subject, []byte{}).
+					DoAndReturn(func(_ string, _ []byte) error {
+						close(published)
+						return nil
+					})
+
+				m := logMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					FollowUnit(gomock.Any(), "nginx.service", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						ctx context.Context,
+						_ string,
+						_ log.FollowOpts,
+						_ func(log.Entry) error,
+					) error {
+						stop(&nats.Msg{Subject: stopSubject})
+						<-ctx.Done()
+						return nil
+					})
+				return m, pub
+			},
+			validate: func(result json.RawMessage) {
+				s.Contains(string(result), subject)
+			},
+		},
+		{
+			name:           "follow stops when the agent shuts down",
+			agentStopped:   true,
+			wantTerminator: true,
+			jobRequest: job.Request{
+				JobID:     "job-1",
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "log.follow",
+				Data:      json.RawMessage(`{"unit":"nginx.service","duration":3600}`),
+			},
+			setupMock: func(published chan struct{}) (log.Provider, agent.NATSPublisher) {
+				pub := agentmocks.NewMockNATSPublisher(s.mockCtrl)
+				s.expectStopSubscribe(pub, nil, nil)
+				pub.EXPECT().
+					PublishCore(subject, []byte{}).
+					DoAndReturn(func(_ string, _ []byte) error {
+						close(published)
+						return nil
+					})
+
+				m := logMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					FollowUnit(gomock.Any(), "nginx.service", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						ctx context.Context,
+						_ string,
+						_ log.FollowOpts,
+						_ func(log.Entry) error,
+					) error {
+						<-ctx.Done()
+						return nil
+					})
+				return m, pub
+			},
+			validate: func(result json.RawMessage) {
+				s.Contains(string(result), subject)
+			},
+		},
+		{
+			name: "follow stop subscription error",
+			jobRequest: job.Request{
+				JobID:     "job-1",
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "log.follow",
+				Data:      json.RawMessage(`{"unit":"nginx.service"}`),
+			},
+			setupMock: func(_ chan struct{}) (log.Provider, agent.NATSPublisher) {
+				pub := agentmocks.NewMockNATSPublisher(s.mockCtrl)
+				s.expectStopSubscribe(pub, nil, errors.New("connection closed"))
+
+				return logMocks.NewMockProvider(s.mockCtrl), pub
+			},
+			expectError: true,
+			errorMsg:    "subscribe to log follow stop: connection closed",
+		},
+		{
+			name: "follow unmarshal error",
+			jobRequest: job.Request{
+				JobID:     "job-1",
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "log.follow",
+				Data:      json.RawMessage(`invalid`),
+			},
+			setupMock: func(_ chan struct{}) (log.Provider, agent.NATSPublisher) {
+				return logMocks.NewMockProvider(s.mockCtrl),
+					agentmocks.NewMockNATSPublisher(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal log follow data",
+		},
+		{
+			name: "follow without stream publisher returns error",
+			jobRequest: job.Request{
+				JobID:     "job-1",
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "log.follow",
+				Data:      json.RawMessage(`{"unit":"nginx.service"}`),
+			},
+			setupMock: func(_ chan struct{}) (log.Provider, agent.NATSPublisher) {
+				return logMocks.NewMockProvider(s.mockCtrl), nil
+			},
+			expectError: true,
+			errorMsg:    "stream publisher not available",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.agentStopped {
+				cancel()
+			}
+
+			published := make(chan struct{})
+			logProvider, streamPublisher := tt.setupMock(published)
+			processor := s.newProcessor(ctx, logProvider, streamPublisher)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				if tt.errorIs != nil {
+					s.ErrorIs(err, tt.errorIs)
+				} else {
+					s.Contains(err.Error(), tt.errorMsg)
+				}
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+
+			if tt.wantTerminator {
+				select {
+				case <-published:
+				case <-time.After(5 * time.Second):
+					s.Fail("terminator was not published")
+				}
+			}
+		})
+	}
+}
+
+// expectStopSubscribe expects the follow to subscribe to the job's stream
+// stop subject and optionally captures the stop handler.
+func (s *ProcessorLogPublicTestSuite) expectStopSubscribe(
+	pub *agentmocks.MockNATSPublisher,
+	handler *nats.MsgHandler,
+	err error,
+) {
+	pub.EXPECT().
+		Subscribe("streams.job-1.stop", gomock.Any()).
+		DoAndReturn(func(_ string, h nats.MsgHandler) (*nats.Subscription, error) {
+			if handler != nil {
+				*handler = h
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			return &nats.Subscription{}, nil
+		})
+}
+
 func TestProcessorLogPublicTestSuite(t *testing.T) {
 	suite.Run(t, new(ProcessorLogPublicTestSuite))
 }
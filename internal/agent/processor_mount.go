@@ -0,0 +1,245 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/node/mount"
+)
+
+// processMountOperation dispatches mount management sub-operations.
+func processMountOperation(
+	mountProvider mount.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	if mountProvider == nil {
+		return nil, fmt.Errorf("mount provider not available")
+	}
+
+	// Extract sub-operation: "mount.list" -> "list"
+	parts := strings.Split(jobRequest.Operation, ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid mount operation: %s", jobRequest.Operation)
+	}
+	subOp := parts[1]
+
+	ctx := context.Background()
+
+	switch subOp {
+	case "list":
+		return processMountList(ctx, mountProvider, logger)
+	case "get":
+		return processMountGet(ctx, mountProvider, logger, jobRequest)
+	case "create":
+		return processMountCreate(ctx, mountProvider, logger, jobRequest)
+	case "update":
+		return processMountUpdate(ctx, mountProvider, logger, jobRequest)
+	case "delete":
+		return processMountDelete(ctx, mountProvider, logger, jobRequest)
+	case "mount":
+		return processMountMount(ctx, mountProvider, logger, jobRequest)
+	case "unmount":
+		return processMountUnmount(ctx, mountProvider, logger, jobRequest)
+	default:
+		return nil, fmt.Errorf("unsupported mount operation: %s", jobRequest.Operation)
+	}
+}
+
+// processMountList lists current mounts and managed fstab entries.
+func processMountList(
+	ctx context.Context,
+	mountProvider mount.Provider,
+	logger *slog.Logger,
+) (json.RawMessage, error) {
+	logger.Debug("executing mount.List")
+
+	entries, err := mountProvider.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(entries)
+}
+
+// processMountGet gets a single managed mount by name.
+func processMountGet(
+	ctx context.Context,
+	mountProvider mount.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal mount get data: %w", err)
+	}
+
+	logger.Debug(
+		"executing mount.Get",
+		slog.String("name", data.Name),
+	)
+
+	entry, err := mountProvider.Get(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(entry)
+}
+
+// processMountCreate adds a managed fstab entry.
+func processMountCreate(
+	ctx context.Context,
+	mountProvider mount.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var entry mount.Entry
+	if err := json.Unmarshal(jobRequest.Data, &entry); err != nil {
+		return nil, fmt.Errorf("unmarshal mount create data: %w", err)
+	}
+
+	logger.Debug(
+		"executing mount.Create",
+		slog.String("name", entry.Name),
+	)
+
+	result, err := mountProvider.Create(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processMountUpdate replaces a managed fstab entry.
+func processMountUpdate(
+	ctx context.Context,
+	mountProvider mount.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var entry mount.UpdateEntry
+	if err := json.Unmarshal(jobRequest.Data, &entry); err != nil {
+		return nil, fmt.Errorf("unmarshal mount update data: %w", err)
+	}
+
+	logger.Debug(
+		"executing mount.Update",
+		slog.String("name", entry.Name),
+	)
+
+	result, err := mountProvider.Update(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processMountDelete removes a managed fstab entry.
+func processMountDelete(
+	ctx context.Context,
+	mountProvider mount.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal mount delete data: %w", err)
+	}
+
+	logger.Debug(
+		"executing mount.Delete",
+		slog.String("name", data.Name),
+	)
+
+	result, err := mountProvider.Delete(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processMountMount mounts the filesystem of a managed entry.
+func processMountMount(
+	ctx context.Context,
+	mountProvider mount.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal mount mount data: %w", err)
+	}
+
+	logger.Debug(
+		"executing mount.Mount",
+		slog.String("name", data.Name),
+	)
+
+	result, err := mountProvider.Mount(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processMountUnmount unmounts the filesystem of a managed entry.
+func processMountUnmount(
+	ctx context.Context,
+	mountProvider mount.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal mount unmount data: %w", err)
+	}
+
+	logger.Debug(
+		"executing mount.Unmount",
+		slog.String("name", data.Name),
+	)
+
+	result, err := mountProvider.Unmount(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
@@ -0,0 +1,788 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/agent"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/node/mount"
+	mountMocks "github.com/osapi-io/osapi/internal/provider/node/mount/mocks"
+)
+
+type ProcessorMountPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl *gomock.Controller
+}
+
+func (s *ProcessorMountPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+}
+
+func (s *ProcessorMountPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *ProcessorMountPublicTestSuite) newNodeProcessor(
+	mountProvider mount.Provider,
+) agent.ProcessorFunc {
+	return agent.NewNodeProcessor(
+		context.Background(),
+		nil, nil, nil, nil,
+		nil, nil, nil, nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		mountProvider,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		config.Config{},
+		slog.Default(),
+	)
+}
+
+func (s *ProcessorMountPublicTestSuite) TestProcessMountOperation() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() mount.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "nil provider returns error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "mount.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock:   nil,
+			expectError: true,
+			errorMsg:    "mount provider not available",
+		},
+		{
+			name: "invalid operation format missing sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "mount",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() mount.Provider {
+				return mountMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "invalid mount operation: mount",
+		},
+		{
+			name: "unsupported sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "mount.unknown",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() mount.Provider {
+				return mountMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unsupported mount operation: mount.unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var mountProvider mount.Provider
+			if tt.setupMock != nil {
+				mountProvider = tt.setupMock()
+			}
+
+			processor := s.newNodeProcessor(mountProvider)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorMountPublicTestSuite) TestProcessMountList() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() mount.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful list",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "mount.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() mount.Provider {
+				m := mountMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().List(gomock.Any()).Return([]mount.Mount{
+					{
+						Name:    "data",
+						Path:    "/mnt/data",
+						Mounted: true,
+					},
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var entries []mount.Mount
+				err := json.Unmarshal(result, &entries)
+				s.NoError(err)
+				s.Len(entries, 1)
+				s.Equal("data", entries[0].Name)
+			},
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "mount.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() mount.Provider {
+				m := mountMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().List(gomock.Any()).Return(nil, errors.New("permission denied"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "permission denied",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorMountPublicTestSuite) TestProcessMountGet() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() mount.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful get",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "mount.get",
+				Data:      json.RawMessage(`{"name":"data"}`),
+			},
+			setupMock: func() mount.Provider {
+				m := mountMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Get(gomock.Any(), "data").Return(&mount.Mount{
+					Name:    "data",
+					Path:    "/mnt/data",
+					Mounted: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var info mount.Mount
+				err := json.Unmarshal(result, &info)
+				s.NoError(err)
+				s.Equal("data", info.Name)
+				s.Equal("/mnt/data", info.Path)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "mount.get",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() mount.Provider {
+				return mountMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal mount get data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "mount.get",
+				Data:      json.RawMessage(`{"name":"missing"}`),
+			},
+			setupMock: func() mount.Provider {
+				m := mountMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Get(gomock.Any(), "missing").Return(nil, errors.New("not found"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorMountPublicTestSuite) TestProcessMountCreate() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() mount.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful create",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "mount.create",
+				Data:      json.RawMessage(`{"name":"data","device":"/dev/sdb1","path":"/mnt/data","fs_type":"ext4"}`),
+			},
+			setupMock: func() mount.Provider {
+				m := mountMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ interface{}, entry mount.Entry) (*mount.CreateResult, error) {
+						s.Equal("data", entry.Name)
+						return &mount.CreateResult{
+							Name:    "data",
+							Changed: true,
+						}, nil
+					},
+				)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r mount.CreateResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("data", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "mount.create",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() mount.Provider {
+				return mountMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal mount create data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "mount.create",
+				Data:      json.RawMessage(`{"name":"dup","device":"/dev/sdb1","path":"/mnt/data","fs_type":"ext4"}`),
+			},
+			setupMock: func() mount.Provider {
+				m := mountMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("already exists"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "already exists",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorMountPublicTestSuite) TestProcessMountUpdate() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() mount.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful update",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "mount.update",
+				Data:      json.RawMessage(`{"name":"data","options":"ro"}`),
+			},
+			setupMock: func() mount.Provider {
+				m := mountMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ interface{}, entry mount.UpdateEntry) (*mount.UpdateResult, error) {
+						s.Equal("data", entry.Name)
+						s.Nil(entry.Pass)
+						return &mount.UpdateResult{
+							Name:    "data",
+							Changed: true,
+						}, nil
+					},
+				)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r mount.UpdateResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("data", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "successful update resetting pass to zero",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "mount.update",
+				Data:      json.RawMessage(`{"name":"data","pass":0}`),
+			},
+			setupMock: func() mount.Provider {
+				m := mountMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ interface{}, entry mount.UpdateEntry) (*mount.UpdateResult, error) {
+						s.Require().NotNil(entry.Pass)
+						s.Equal(0, *entry.Pass)
+						s.Nil(entry.Dump)
+						return &mount.UpdateResult{
+							Name:    "data",
+							Changed: true,
+						}, nil
+					},
+				)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r mount.UpdateResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "mount.update",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() mount.Provider {
+				return mountMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal mount update data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "mount.update",
+				Data:      json.RawMessage(`{"name":"missing","device":"/dev/sdb1","path":"/mnt/data","fs_type":"ext4"}`),
+			},
+			setupMock: func() mount.Provider {
+				m := mountMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorMountPublicTestSuite) TestProcessMountDelete() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() mount.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful delete",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "mount.delete",
+				Data:      json.RawMessage(`{"name":"data"}`),
+			},
+			setupMock: func() mount.Provider {
+				m := mountMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Delete(gomock.Any(), "data").Return(&mount.DeleteResult{
+					Name:    "data",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r mount.DeleteResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("data", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "mount.delete",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() mount.Provider {
+				return mountMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal mount delete data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "mount.delete",
+				Data:      json.RawMessage(`{"name":"missing"}`),
+			},
+			setupMock: func() mount.Provider {
+				m := mountMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Delete(gomock.Any(), "missing").
+					Return(nil, errors.New("not found"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorMountPublicTestSuite) TestProcessMountMount() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() mount.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful start",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "mount.mount",
+				Data:      json.RawMessage(`{"name":"data"}`),
+			},
+			setupMock: func() mount.Provider {
+				m := mountMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Mount(gomock.Any(), "data").Return(&mount.ActionResult{
+					Name:    "data",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r mount.ActionResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("data", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "mount.mount",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() mount.Provider {
+				return mountMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal mount mount data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "mount.mount",
+				Data:      json.RawMessage(`{"name":"data"}`),
+			},
+			setupMock: func() mount.Provider {
+				m := mountMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Mount(gomock.Any(), "data").
+					Return(nil, errors.New("failed to start"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "failed to start",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorMountPublicTestSuite) TestProcessMountUnmount() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() mount.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful stop",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "mount.unmount",
+				Data:      json.RawMessage(`{"name":"data"}`),
+			},
+			setupMock: func() mount.Provider {
+				m := mountMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Unmount(gomock.Any(), "data").Return(&mount.ActionResult{
+					Name:    "data",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r mount.ActionResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("data", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "mount.unmount",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() mount.Provider {
+				return mountMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal mount unmount data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "mount.unmount",
+				Data:      json.RawMessage(`{"name":"data"}`),
+			},
+			setupMock: func() mount.Provider {
+				m := mountMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Unmount(gomock.Any(), "data").
+					Return(nil, errors.New("failed to stop"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "failed to stop",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessorMountPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessorMountPublicTestSuite))
+}
@@ -27,10 +27,13 @@ import (
 	"strings"
 
 	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/network/firewall"
+	"github.com/osapi-io/osapi/internal/provider/network/netplan"
 	"github.com/osapi-io/osapi/internal/provider/network/netplan/dns"
 	"github.com/osapi-io/osapi/internal/provider/network/netplan/iface"
 	"github.com/osapi-io/osapi/internal/provider/network/netplan/route"
 	"github.com/osapi-io/osapi/internal/provider/network/ping"
+	"github.com/osapi-io/osapi/internal/provider/network/socket"
 )
 
 // NewNetworkProcessor returns a ProcessorFunc that handles network-related operations.
@@ -39,6 +42,9 @@ func NewNetworkProcessor(
 	pingProvider ping.Provider,
 	interfaceProvider iface.Provider,
 	routeProvider route.Provider,
+	firewallProvider firewall.Provider,
+	socketProvider socket.Provider,
+	guard *netplan.Guard,
 	logger *slog.Logger,
 ) ProcessorFunc {
 	return func(req job.Request) (json.RawMessage, error) {
@@ -54,6 +60,12 @@ func NewNetworkProcessor(
 			return processInterfaceOperation(interfaceProvider, logger, req)
 		case "route":
 			return processRouteOperation(routeProvider, logger, req)
+		case "firewall":
+			return processFirewallOperation(firewallProvider, logger, req)
+		case "socket":
+			return processSocketOperation(socketProvider, logger, req)
+		case "apply":
+			return processNetworkApplyOperation(guard, logger, req)
 		default:
 			return nil, fmt.Errorf("unsupported network operation: %s", req.Operation)
 		}
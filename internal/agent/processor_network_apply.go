@@ -0,0 +1,70 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/network/netplan"
+)
+
+// processNetworkApplyOperation dispatches network apply sub-operations.
+func processNetworkApplyOperation(
+	guard *netplan.Guard,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	// Extract sub-operation: "apply.confirm" -> "confirm"
+	parts := strings.Split(jobRequest.Operation, ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid apply operation: %s", jobRequest.Operation)
+	}
+	subOp := parts[1]
+
+	switch subOp {
+	case "confirm":
+		return processNetworkApplyConfirm(guard, logger)
+	default:
+		return nil, fmt.Errorf("unsupported apply operation: %s", jobRequest.Operation)
+	}
+}
+
+// processNetworkApplyConfirm keeps a pending Netplan change. Changed is
+// false when safe apply is disabled or nothing was waiting.
+func processNetworkApplyConfirm(
+	guard *netplan.Guard,
+	logger *slog.Logger,
+) (json.RawMessage, error) {
+	logger.Debug(
+		"executing netplan.Guard.Confirm",
+		slog.Bool("pending", guard.Pending()),
+	)
+
+	confirmed := guard.Confirm()
+
+	return json.Marshal(map[string]interface{}{
+		"changed": confirmed,
+	})
+}
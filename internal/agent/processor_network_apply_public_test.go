@@ -0,0 +1,211 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/agent"
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider/network/netplan"
+)
+
+type ProcessorNetworkApplyPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl *gomock.Controller
+}
+
+func (s *ProcessorNetworkApplyPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+}
+
+func (s *ProcessorNetworkApplyPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+// pendingGuard returns a Guard with an applied, unconfirmed change.
+func (s *ProcessorNetworkApplyPublicTestSuite) pendingGuard() *netplan.Guard {
+	appFs := memfs.New()
+	_ = appFs.MkdirAll("/etc/netplan", 0o755)
+
+	mockExec := execmocks.NewMockManager(s.mockCtrl)
+	mockExec.EXPECT().
+		RunPrivilegedCmd("netplan", []string{"generate"}).
+		Return("", nil)
+	mockExec.EXPECT().
+		RunPrivilegedCmd("netplan", []string{"apply"}).
+		Return("", nil)
+
+	mockKV := jobmocks.NewMockKeyValue(s.mockCtrl)
+	mockKV.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("not found"))
+	mockKV.EXPECT().
+		Put(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(uint64(1), nil)
+
+	guard := netplan.NewGuard(slog.Default(), appFs, nil, mockExec, "server1", time.Hour)
+
+	_, err := netplan.ApplyConfig(
+		context.Background(),
+		slog.Default(),
+		appFs,
+		mockKV,
+		mockExec,
+		guard,
+		"test-host",
+		"/etc/netplan/osapi-eth0.yaml",
+		[]byte("network:\n  version: 2\n"),
+		nil,
+	)
+	s.Require().NoError(err)
+	s.Require().True(guard.Pending())
+
+	return guard
+}
+
+func (s *ProcessorNetworkApplyPublicTestSuite) TestProcessNetworkApplyOperation() {
+	tests := []struct {
+		name         string
+		jobRequest   job.Request
+		setupGuard   func() *netplan.Guard
+		validateFunc func(guard *netplan.Guard, result json.RawMessage, err error)
+	}{
+		{
+			name: "when confirming a pending change",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "network",
+				Operation: "apply.confirm",
+			},
+			setupGuard: s.pendingGuard,
+			validateFunc: func(guard *netplan.Guard, result json.RawMessage, err error) {
+				s.Require().NoError(err)
+				s.JSONEq(`{"changed":true}`, string(result))
+				s.False(guard.Pending())
+			},
+		},
+		{
+			name: "when nothing is pending",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "network",
+				Operation: "apply.confirm",
+			},
+			setupGuard: func() *netplan.Guard {
+				return netplan.NewGuard(
+					slog.Default(),
+					memfs.New(),
+					nil,
+					execmocks.NewMockManager(s.mockCtrl),
+					"server1",
+					time.Hour,
+				)
+			},
+			validateFunc: func(_ *netplan.Guard, result json.RawMessage, err error) {
+				s.Require().NoError(err)
+				s.JSONEq(`{"changed":false}`, string(result))
+			},
+		},
+		{
+			name: "when safe apply is disabled",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "network",
+				Operation: "apply.confirm",
+			},
+			setupGuard: func() *netplan.Guard {
+				return nil
+			},
+			validateFunc: func(_ *netplan.Guard, result json.RawMessage, err error) {
+				s.Require().NoError(err)
+				s.JSONEq(`{"changed":false}`, string(result))
+			},
+		},
+		{
+			name: "when operation is missing sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "network",
+				Operation: "apply",
+			},
+			setupGuard: func() *netplan.Guard {
+				return nil
+			},
+			validateFunc: func(_ *netplan.Guard, result json.RawMessage, err error) {
+				s.Require().Error(err)
+				s.Contains(err.Error(), "invalid apply operation: apply")
+				s.Nil(result)
+			},
+		},
+		{
+			name: "when sub-operation is unsupported",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "network",
+				Operation: "apply.unknown",
+			},
+			setupGuard: func() *netplan.Guard {
+				return nil
+			},
+			validateFunc: func(_ *netplan.Guard, result json.RawMessage, err error) {
+				s.Require().Error(err)
+				s.Contains(err.Error(), "unsupported apply operation: apply.unknown")
+				s.Nil(result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			guard := tt.setupGuard()
+
+			processor := agent.NewNetworkProcessor(
+				nil, nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				guard,
+				slog.Default(),
+			)
+			result, err := processor(tt.jobRequest)
+
+			tt.validateFunc(guard, result, err)
+		})
+	}
+}
+
+func TestProcessorNetworkApplyPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessorNetworkApplyPublicTestSuite))
+}
@@ -136,6 +136,9 @@ func (s *ProcessorNetworkPublicTestSuite) TestProcessDNSDelete() {
 				dnsMock, nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 				slog.Default(),
 			)
 			result, err := processor(tt.jobRequest)
@@ -21,6 +21,7 @@
 package agent_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log/slog"
@@ -109,6 +110,7 @@ func (s *ProcessorNtpPublicTestSuite) TestProcessNtpOperation() {
 			}
 
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil, nil,
 				ntpProvider, nil, nil,
 				nil,
@@ -116,6 +118,14 @@ func (s *ProcessorNtpPublicTestSuite) TestProcessNtpOperation() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
@@ -194,6 +204,7 @@ func (s *ProcessorNtpPublicTestSuite) TestProcessNtpGet() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil, nil,
 				tt.setupMock(), nil, nil,
 				nil,
@@ -201,6 +212,14 @@ func (s *ProcessorNtpPublicTestSuite) TestProcessNtpGet() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
@@ -291,6 +310,7 @@ func (s *ProcessorNtpPublicTestSuite) TestProcessNtpCreate() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil, nil,
 				tt.setupMock(), nil, nil,
 				nil,
@@ -298,6 +318,14 @@ func (s *ProcessorNtpPublicTestSuite) TestProcessNtpCreate() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
@@ -388,6 +416,7 @@ func (s *ProcessorNtpPublicTestSuite) TestProcessNtpUpdate() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil, nil,
 				tt.setupMock(), nil, nil,
 				nil,
@@ -395,6 +424,14 @@ func (s *ProcessorNtpPublicTestSuite) TestProcessNtpUpdate() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
@@ -467,6 +504,7 @@ func (s *ProcessorNtpPublicTestSuite) TestProcessNtpDelete() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil, nil,
 				tt.setupMock(), nil, nil,
 				nil,
@@ -474,6 +512,14 @@ func (s *ProcessorNtpPublicTestSuite) TestProcessNtpDelete() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
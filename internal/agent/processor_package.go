@@ -63,6 +63,14 @@ func processPackageOperation(
 		return processPackageUpdate(ctx, packageProvider, logger)
 	case "listUpdates":
 		return processPackageListUpdates(ctx, packageProvider, logger)
+	case "hold":
+		return processPackageHold(ctx, packageProvider, logger, jobRequest)
+	case "unhold":
+		return processPackageUnhold(ctx, packageProvider, logger, jobRequest)
+	case "ensure":
+		return processPackageEnsure(ctx, packageProvider, logger, jobRequest)
+	case "repo":
+		return processPackageRepoOperation(ctx, packageProvider, logger, jobRequest)
 	default:
 		return nil, fmt.Errorf("unsupported package operation: %s", jobRequest.Operation)
 	}
@@ -108,7 +116,9 @@ func processPackageGet(
 	return json.Marshal(result)
 }
 
-// processPackageInstall installs a package by name.
+// processPackageInstall installs a package by name, optionally at a
+// specific version, or from a package artifact in the Object Store when
+// an object is given.
 func processPackageInstall(
 	ctx context.Context,
 	packageProvider apt.Provider,
@@ -118,13 +128,24 @@ func processPackageInstall(
 	logger.Debug("executing package.Install")
 
 	var data struct {
-		Name string `json:"name"`
+		Name    string `json:"name"`
+		Version string `json:"version"`
+		Object  string `json:"object"`
 	}
 	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
 		return nil, fmt.Errorf("unmarshal package install data: %w", err)
 	}
 
-	result, err := packageProvider.Install(ctx, data.Name)
+	if data.Object != "" {
+		result, err := packageProvider.InstallObject(ctx, data.Object)
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(result)
+	}
+
+	result, err := packageProvider.Install(ctx, data.Name, data.Version)
 	if err != nil {
 		return nil, err
 	}
@@ -187,3 +208,75 @@ func processPackageListUpdates(
 
 	return json.Marshal(result)
 }
+
+// processPackageHold holds a package at its installed version.
+func processPackageHold(
+	ctx context.Context,
+	packageProvider apt.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	logger.Debug("executing package.Hold")
+
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal package hold data: %w", err)
+	}
+
+	result, err := packageProvider.Hold(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processPackageUnhold releases a hold on a package.
+func processPackageUnhold(
+	ctx context.Context,
+	packageProvider apt.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	logger.Debug("executing package.Unhold")
+
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal package unhold data: %w", err)
+	}
+
+	result, err := packageProvider.Unhold(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processPackageEnsure converges packages to a desired manifest.
+func processPackageEnsure(
+	ctx context.Context,
+	packageProvider apt.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	logger.Debug("executing package.Ensure")
+
+	var data struct {
+		Packages []apt.EnsureEntry `json:"packages"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal package ensure data: %w", err)
+	}
+
+	result, err := packageProvider.Ensure(ctx, data.Packages)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
@@ -21,6 +21,7 @@
 package agent_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log/slog"
@@ -54,6 +55,7 @@ func (s *ProcessorPackagePublicTestSuite) newProcessor(
 	packageProvider apt.Provider,
 ) agent.ProcessorFunc {
 	return agent.NewNodeProcessor(
+		context.Background(),
 		nil, nil, nil, nil,
 		nil, nil, nil, nil,
 		nil,
@@ -61,6 +63,14 @@ func (s *ProcessorPackagePublicTestSuite) newProcessor(
 		packageProvider,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 		config.Config{},
 		slog.Default(),
 	)
@@ -324,7 +334,7 @@ func (s *ProcessorPackagePublicTestSuite) TestProcessPackageInstall() {
 			},
 			setupMock: func() apt.Provider {
 				m := aptMocks.NewMockProvider(s.mockCtrl)
-				m.EXPECT().Install(gomock.Any(), "nginx").Return(&apt.Result{
+				m.EXPECT().Install(gomock.Any(), "nginx", "").Return(&apt.Result{
 					Name:    "nginx",
 					Changed: true,
 				}, nil)
@@ -338,6 +348,74 @@ func (s *ProcessorPackagePublicTestSuite) TestProcessPackageInstall() {
 				s.True(r.Changed)
 			},
 		},
+		{
+			name: "successful install with version",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.install",
+				Data:      json.RawMessage(`{"name": "nginx", "version": "1.24.0-1"}`),
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Install(gomock.Any(), "nginx", "1.24.0-1").Return(&apt.Result{
+					Name:    "nginx",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r apt.Result
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("nginx", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "successful install from object",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.install",
+				Data:      json.RawMessage(`{"object": "hello_1.0-1_amd64.deb"}`),
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().InstallObject(gomock.Any(), "hello_1.0-1_amd64.deb").Return(&apt.Result{
+					Name:    "hello",
+					Version: "1.0-1",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r apt.Result
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("hello", r.Name)
+				s.Equal("1.0-1", r.Version)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "install from object provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.install",
+				Data:      json.RawMessage(`{"object": "missing.deb"}`),
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					InstallObject(gomock.Any(), "missing.deb").
+					Return(nil, errors.New("object not found"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "object not found",
+		},
 		{
 			name: "install unmarshal error",
 			jobRequest: job.Request{
@@ -363,7 +441,7 @@ func (s *ProcessorPackagePublicTestSuite) TestProcessPackageInstall() {
 			setupMock: func() apt.Provider {
 				m := aptMocks.NewMockProvider(s.mockCtrl)
 				m.EXPECT().
-					Install(gomock.Any(), "bad-pkg").
+					Install(gomock.Any(), "bad-pkg", "").
 					Return(nil, errors.New("package not found in repository"))
 				return m
 			},
@@ -619,6 +697,278 @@ func (s *ProcessorPackagePublicTestSuite) TestProcessPackageListUpdates() {
 	}
 }
 
+func (s *ProcessorPackagePublicTestSuite) TestProcessPackageHold() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() apt.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful hold",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.hold",
+				Data:      json.RawMessage(`{"name": "nginx"}`),
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Hold(gomock.Any(), "nginx").Return(&apt.Result{
+					Name:    "nginx",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r apt.Result
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("nginx", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "hold unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.hold",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() apt.Provider {
+				return aptMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal package hold data",
+		},
+		{
+			name: "hold provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.hold",
+				Data:      json.RawMessage(`{"name": "missing-pkg"}`),
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					Hold(gomock.Any(), "missing-pkg").
+					Return(nil, errors.New("package not installed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "package not installed",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorPackagePublicTestSuite) TestProcessPackageUnhold() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() apt.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful unhold",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.unhold",
+				Data:      json.RawMessage(`{"name": "nginx"}`),
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Unhold(gomock.Any(), "nginx").Return(&apt.Result{
+					Name:    "nginx",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r apt.Result
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("nginx", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unhold unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.unhold",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() apt.Provider {
+				return aptMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal package unhold data",
+		},
+		{
+			name: "unhold provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.unhold",
+				Data:      json.RawMessage(`{"name": "missing-pkg"}`),
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					Unhold(gomock.Any(), "missing-pkg").
+					Return(nil, errors.New("package not installed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "package not installed",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorPackagePublicTestSuite) TestProcessPackageEnsure() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() apt.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful ensure",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.ensure",
+				Data: json.RawMessage(`{"packages": [
+					{"name": "nginx", "state": "pinned", "version": "1.24.0-1"},
+					{"name": "telnet", "state": "absent"}
+				]}`),
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Ensure(gomock.Any(), []apt.EnsureEntry{
+					{Name: "nginx", State: apt.StatePinned, Version: "1.24.0-1"},
+					{Name: "telnet", State: apt.StateAbsent},
+				}).Return(&apt.EnsureResult{
+					Packages: []apt.Result{
+						{Name: "nginx", Changed: true},
+						{Name: "telnet"},
+					},
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r apt.EnsureResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.True(r.Changed)
+				s.Require().Len(r.Packages, 2)
+				s.True(r.Packages[0].Changed)
+				s.False(r.Packages[1].Changed)
+			},
+		},
+		{
+			name: "ensure unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.ensure",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() apt.Provider {
+				return aptMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal package ensure data",
+		},
+		{
+			name: "ensure provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.ensure",
+				Data:      json.RawMessage(`{"packages": [{"name": "nginx", "state": "present"}]}`),
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					Ensure(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("package: ensure: exec failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "package: ensure: exec failed",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
 func TestProcessorPackagePublicTestSuite(t *testing.T) {
 	suite.Run(t, new(ProcessorPackagePublicTestSuite))
 }
@@ -0,0 +1,178 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/node/apt"
+)
+
+// processPackageRepoOperation dispatches package repository sub-operations.
+func processPackageRepoOperation(
+	ctx context.Context,
+	packageProvider apt.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	// Extract sub-operation: "package.repo.list" -> "list"
+	parts := strings.Split(jobRequest.Operation, ".")
+	if len(parts) < 3 {
+		return nil, fmt.Errorf("invalid package repo operation: %s", jobRequest.Operation)
+	}
+
+	switch parts[2] {
+	case "list":
+		return processPackageRepoList(ctx, packageProvider, logger)
+	case "get":
+		return processPackageRepoGet(ctx, packageProvider, logger, jobRequest)
+	case "create":
+		return processPackageRepoCreate(ctx, packageProvider, logger, jobRequest)
+	case "update":
+		return processPackageRepoUpdate(ctx, packageProvider, logger, jobRequest)
+	case "delete":
+		return processPackageRepoDelete(ctx, packageProvider, logger, jobRequest)
+	default:
+		return nil, fmt.Errorf("unsupported package repo operation: %s", jobRequest.Operation)
+	}
+}
+
+// processPackageRepoList lists all managed package repositories.
+func processPackageRepoList(
+	ctx context.Context,
+	packageProvider apt.Provider,
+	logger *slog.Logger,
+) (json.RawMessage, error) {
+	logger.Debug("executing package.ListRepos")
+
+	result, err := packageProvider.ListRepos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processPackageRepoGet retrieves a single managed package repository.
+func processPackageRepoGet(
+	ctx context.Context,
+	packageProvider apt.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	logger.Debug("executing package.GetRepo")
+
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal package repo get data: %w", err)
+	}
+
+	result, err := packageProvider.GetRepo(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processPackageRepoCreate deploys a new package repository.
+func processPackageRepoCreate(
+	ctx context.Context,
+	packageProvider apt.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var repo apt.Repo
+	if err := json.Unmarshal(jobRequest.Data, &repo); err != nil {
+		return nil, fmt.Errorf("unmarshal package repo create data: %w", err)
+	}
+
+	logger.Debug(
+		"executing package.CreateRepo",
+		slog.String("name", repo.Name),
+	)
+
+	result, err := packageProvider.CreateRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processPackageRepoUpdate redeploys an existing package repository.
+func processPackageRepoUpdate(
+	ctx context.Context,
+	packageProvider apt.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var repo apt.Repo
+	if err := json.Unmarshal(jobRequest.Data, &repo); err != nil {
+		return nil, fmt.Errorf("unmarshal package repo update data: %w", err)
+	}
+
+	logger.Debug(
+		"executing package.UpdateRepo",
+		slog.String("name", repo.Name),
+	)
+
+	result, err := packageProvider.UpdateRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processPackageRepoDelete undeploys a package repository.
+func processPackageRepoDelete(
+	ctx context.Context,
+	packageProvider apt.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal package repo delete data: %w", err)
+	}
+
+	logger.Debug(
+		"executing package.DeleteRepo",
+		slog.String("name", data.Name),
+	)
+
+	result, err := packageProvider.DeleteRepo(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
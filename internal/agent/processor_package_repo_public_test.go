@@ -0,0 +1,398 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/agent"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/node/apt"
+	aptMocks "github.com/osapi-io/osapi/internal/provider/node/apt/mocks"
+)
+
+type ProcessorPackageRepoPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl *gomock.Controller
+}
+
+func (s *ProcessorPackageRepoPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+}
+
+func (s *ProcessorPackageRepoPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *ProcessorPackageRepoPublicTestSuite) newProcessor(
+	packageProvider apt.Provider,
+) agent.ProcessorFunc {
+	return agent.NewNodeProcessor(
+		context.Background(),
+		nil, nil, nil, nil,
+		nil, nil, nil, nil,
+		nil,
+		nil,
+		packageProvider,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		config.Config{},
+		slog.Default(),
+	)
+}
+
+func (s *ProcessorPackageRepoPublicTestSuite) TestProcessPackageRepoOperation() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() apt.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful list",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "package.repo.list",
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().ListRepos(gomock.Any()).Return([]apt.Repo{
+					{Name: "docker", Object: "docker-sources"},
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var repos []apt.Repo
+				err := json.Unmarshal(result, &repos)
+				s.NoError(err)
+				s.Len(repos, 1)
+				s.Equal("docker", repos[0].Name)
+			},
+		},
+		{
+			name: "list provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "package.repo.list",
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().ListRepos(gomock.Any()).Return(nil, errors.New("list failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "list failed",
+		},
+		{
+			name: "successful get",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "package.repo.get",
+				Data:      json.RawMessage(`{"name": "docker"}`),
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().GetRepo(gomock.Any(), "docker").Return(&apt.Repo{
+					Name:      "docker",
+					Object:    "docker-sources",
+					KeyObject: "docker-key",
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var repo apt.Repo
+				err := json.Unmarshal(result, &repo)
+				s.NoError(err)
+				s.Equal("docker-key", repo.KeyObject)
+			},
+		},
+		{
+			name: "get unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "package.repo.get",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() apt.Provider {
+				return aptMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal package repo get data",
+		},
+		{
+			name: "get provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "package.repo.get",
+				Data:      json.RawMessage(`{"name": "missing"}`),
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					GetRepo(gomock.Any(), "missing").
+					Return(nil, errors.New("not found"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "not found",
+		},
+		{
+			name: "successful create",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.repo.create",
+				Data: json.RawMessage(
+					`{"name": "docker", "object": "docker-sources", "key_object": "docker-key"}`,
+				),
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().CreateRepo(gomock.Any(), apt.Repo{
+					Name:      "docker",
+					Object:    "docker-sources",
+					KeyObject: "docker-key",
+				}).Return(&apt.RepoResult{Name: "docker", Changed: true}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r apt.RepoResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "create unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.repo.create",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() apt.Provider {
+				return aptMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal package repo create data",
+		},
+		{
+			name: "create provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.repo.create",
+				Data:      json.RawMessage(`{"name": "docker", "object": "docker-sources"}`),
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					CreateRepo(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("deploy failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "deploy failed",
+		},
+		{
+			name: "successful update",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.repo.update",
+				Data:      json.RawMessage(`{"name": "docker", "key_object": "docker-key-v2"}`),
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().UpdateRepo(gomock.Any(), apt.Repo{
+					Name:      "docker",
+					KeyObject: "docker-key-v2",
+				}).Return(&apt.RepoResult{Name: "docker", Changed: true}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r apt.RepoResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("docker", r.Name)
+			},
+		},
+		{
+			name: "update unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.repo.update",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() apt.Provider {
+				return aptMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal package repo update data",
+		},
+		{
+			name: "update provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.repo.update",
+				Data:      json.RawMessage(`{"name": "docker"}`),
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					UpdateRepo(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("does not exist"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "does not exist",
+		},
+		{
+			name: "successful delete",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.repo.delete",
+				Data:      json.RawMessage(`{"name": "docker"}`),
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					DeleteRepo(gomock.Any(), "docker").
+					Return(&apt.RepoResult{Name: "docker", Changed: true}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r apt.RepoResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "delete unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.repo.delete",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() apt.Provider {
+				return aptMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal package repo delete data",
+		},
+		{
+			name: "delete provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "package.repo.delete",
+				Data:      json.RawMessage(`{"name": "docker"}`),
+			},
+			setupMock: func() apt.Provider {
+				m := aptMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					DeleteRepo(gomock.Any(), "docker").
+					Return(nil, errors.New("undeploy failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "undeploy failed",
+		},
+		{
+			name: "invalid repo operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "package.repo",
+			},
+			setupMock: func() apt.Provider {
+				return aptMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "invalid package repo operation",
+		},
+		{
+			name: "unsupported repo operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "package.repo.unknown",
+			},
+			setupMock: func() apt.Provider {
+				return aptMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unsupported package repo operation",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessorPackageRepoPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessorPackageRepoPublicTestSuite))
+}
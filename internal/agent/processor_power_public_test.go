@@ -21,6 +21,7 @@
 package agent_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log/slog"
@@ -109,6 +110,7 @@ func (s *ProcessorPowerPublicTestSuite) TestProcessPowerOperation() {
 			}
 
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil,
 				nil, nil, nil,
 				powerProvider,
@@ -117,6 +119,14 @@ func (s *ProcessorPowerPublicTestSuite) TestProcessPowerOperation() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
@@ -237,6 +247,7 @@ func (s *ProcessorPowerPublicTestSuite) TestProcessPowerReboot() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil,
 				nil, nil, nil,
 				tt.setupMock(),
@@ -245,6 +256,14 @@ func (s *ProcessorPowerPublicTestSuite) TestProcessPowerReboot() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
@@ -365,6 +384,7 @@ func (s *ProcessorPowerPublicTestSuite) TestProcessPowerShutdown() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil,
 				nil, nil, nil,
 				tt.setupMock(),
@@ -373,6 +393,14 @@ func (s *ProcessorPowerPublicTestSuite) TestProcessPowerShutdown() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
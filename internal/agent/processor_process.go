@@ -55,8 +55,12 @@ func processProcessOperation(
 		return processProcessList(ctx, processProvider, logger)
 	case "get":
 		return processProcessGet(ctx, processProvider, logger, jobRequest)
+	case "tree":
+		return processProcessTree(ctx, processProvider, logger, jobRequest)
 	case "signal":
 		return processProcessSignal(ctx, processProvider, logger, jobRequest)
+	case "signal-match":
+		return processProcessSignalMatch(ctx, processProvider, logger, jobRequest)
 	default:
 		return nil, fmt.Errorf("unsupported process operation: %s", jobRequest.Operation)
 	}
@@ -102,6 +106,33 @@ func processProcessGet(
 	return json.Marshal(result)
 }
 
+// processProcessTree retrieves the process hierarchy, optionally rooted
+// at a specific PID.
+func processProcessTree(
+	ctx context.Context,
+	processProvider process.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	logger.Debug("executing process.Tree")
+
+	var data struct {
+		PID int `json:"pid"`
+	}
+	if len(jobRequest.Data) > 0 {
+		if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+			return nil, fmt.Errorf("unmarshal process tree data: %w", err)
+		}
+	}
+
+	result, err := processProvider.Tree(ctx, data.PID)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
 // processProcessSignal sends a signal to a process by PID.
 func processProcessSignal(
 	ctx context.Context,
@@ -126,3 +157,30 @@ func processProcessSignal(
 
 	return json.Marshal(result)
 }
+
+// processProcessSignalMatch sends a signal to every process matching the
+// requested filters, or reports the matches when dry_run is set.
+func processProcessSignalMatch(
+	ctx context.Context,
+	processProvider process.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	logger.Debug("executing process.SignalMatch")
+
+	var data struct {
+		process.Match
+		Signal string `json:"signal"`
+		DryRun bool   `json:"dry_run"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal process signal match data: %w", err)
+	}
+
+	result, err := processProvider.SignalMatch(ctx, data.Match, data.Signal, data.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
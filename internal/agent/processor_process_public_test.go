@@ -21,6 +21,7 @@
 package agent_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log/slog"
@@ -106,6 +107,7 @@ func (s *ProcessorProcessPublicTestSuite) TestProcessProcessOperation() {
 			}
 
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil,
 				nil, nil, nil, nil,
 				processProvider,
@@ -113,6 +115,14 @@ func (s *ProcessorProcessPublicTestSuite) TestProcessProcessOperation() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
@@ -199,6 +209,7 @@ func (s *ProcessorProcessPublicTestSuite) TestProcessProcessList() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil,
 				nil, nil, nil, nil,
 				tt.setupMock(),
@@ -206,6 +217,14 @@ func (s *ProcessorProcessPublicTestSuite) TestProcessProcessList() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
@@ -298,6 +317,142 @@ func (s *ProcessorProcessPublicTestSuite) TestProcessProcessGet() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			processor := agent.NewNodeProcessor(
+				context.Background(),
+				nil, nil, nil, nil,
+				nil, nil, nil, nil,
+				tt.setupMock(),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				config.Config{},
+				slog.Default(),
+			)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorProcessPublicTestSuite) TestProcessProcessTree() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() process.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful tree without data",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "process.tree",
+			},
+			setupMock: func() process.Provider {
+				m := processMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Tree(gomock.Any(), 0).Return([]process.TreeNode{
+					{
+						PID:  1,
+						Name: "systemd",
+						Children: []process.TreeNode{
+							{PID: 812, PPID: 1, Name: "sshd"},
+						},
+					},
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var nodes []process.TreeNode
+				err := json.Unmarshal(result, &nodes)
+				s.NoError(err)
+				s.Len(nodes, 1)
+				s.Equal("systemd", nodes[0].Name)
+				s.Len(nodes[0].Children, 1)
+				s.Equal(812, nodes[0].Children[0].PID)
+			},
+		},
+		{
+			name: "successful tree rooted at pid",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "process.tree",
+				Data:      json.RawMessage(`{"pid": 812}`),
+			},
+			setupMock: func() process.Provider {
+				m := processMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Tree(gomock.Any(), 812).Return([]process.TreeNode{
+					{PID: 812, PPID: 1, Name: "sshd"},
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var nodes []process.TreeNode
+				err := json.Unmarshal(result, &nodes)
+				s.NoError(err)
+				s.Len(nodes, 1)
+				s.Equal(812, nodes[0].PID)
+			},
+		},
+		{
+			name: "tree unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "process.tree",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() process.Provider {
+				return processMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal process tree data",
+		},
+		{
+			name: "tree provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "process.tree",
+				Data:      json.RawMessage(`{"pid": 9999}`),
+			},
+			setupMock: func() process.Provider {
+				m := processMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					Tree(gomock.Any(), 9999).
+					Return(nil, errors.New("process: tree: process 9999 not found"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "process 9999 not found",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil,
 				nil, nil, nil, nil,
 				tt.setupMock(),
@@ -305,6 +460,14 @@ func (s *ProcessorProcessPublicTestSuite) TestProcessProcessGet() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
@@ -397,6 +560,159 @@ func (s *ProcessorProcessPublicTestSuite) TestProcessProcessSignal() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			processor := agent.NewNodeProcessor(
+				context.Background(),
+				nil, nil, nil, nil,
+				nil, nil, nil, nil,
+				tt.setupMock(),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				config.Config{},
+				slog.Default(),
+			)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorProcessPublicTestSuite) TestProcessProcessSignalMatch() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() process.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful signal match",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "process.signal-match",
+				Data: json.RawMessage(
+					`{"name": "worker", "user": "app", "ppid": 10, "signal": "HUP"}`,
+				),
+			},
+			setupMock: func() process.Provider {
+				m := processMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					SignalMatch(
+						gomock.Any(),
+						process.Match{Name: "worker", User: "app", PPID: 10},
+						"HUP",
+						false,
+					).
+					Return(&process.SignalMatchResult{
+						Signal:  "HUP",
+						PIDs:    []int{11, 12},
+						Changed: true,
+					}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r process.SignalMatchResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("HUP", r.Signal)
+				s.Equal([]int{11, 12}, r.PIDs)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "successful dry run",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "process.signal-match",
+				Data: json.RawMessage(
+					`{"cmdline": "^/usr/bin/worker", "signal": "KILL", "dry_run": true}`,
+				),
+			},
+			setupMock: func() process.Provider {
+				m := processMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					SignalMatch(
+						gomock.Any(),
+						process.Match{Cmdline: "^/usr/bin/worker"},
+						"KILL",
+						true,
+					).
+					Return(&process.SignalMatchResult{
+						Signal: "KILL",
+						DryRun: true,
+						PIDs:   []int{11},
+					}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r process.SignalMatchResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.True(r.DryRun)
+				s.Equal([]int{11}, r.PIDs)
+				s.False(r.Changed)
+			},
+		},
+		{
+			name: "signal match unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "process.signal-match",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() process.Provider {
+				return processMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal process signal match data",
+		},
+		{
+			name: "signal match provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "process.signal-match",
+				Data:      json.RawMessage(`{"cmdline": "(", "signal": "TERM"}`),
+			},
+			setupMock: func() process.Provider {
+				m := processMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().
+					SignalMatch(gomock.Any(), process.Match{Cmdline: "("}, "TERM", false).
+					Return(nil, errors.New("invalid cmdline pattern"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "invalid cmdline pattern",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil,
 				nil, nil, nil, nil,
 				tt.setupMock(),
@@ -404,6 +720,14 @@ func (s *ProcessorProcessPublicTestSuite) TestProcessProcessSignal() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
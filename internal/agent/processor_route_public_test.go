@@ -110,6 +110,9 @@ func (s *ProcessorRoutePublicTestSuite) TestProcessRouteOperation() {
 				nil, nil,
 				nil,
 				routeProvider,
+				nil,
+				nil,
+				nil,
 				slog.Default(),
 			)
 			result, err := processor(tt.jobRequest)
@@ -183,6 +186,9 @@ func (s *ProcessorRoutePublicTestSuite) TestProcessRouteList() {
 				nil, nil,
 				nil,
 				tt.setupMock(),
+				nil,
+				nil,
+				nil,
 				slog.Default(),
 			)
 			result, err := processor(tt.jobRequest)
@@ -275,6 +281,9 @@ func (s *ProcessorRoutePublicTestSuite) TestProcessRouteGet() {
 				nil, nil,
 				nil,
 				tt.setupMock(),
+				nil,
+				nil,
+				nil,
 				slog.Default(),
 			)
 			result, err := processor(tt.jobRequest)
@@ -376,6 +385,9 @@ func (s *ProcessorRoutePublicTestSuite) TestProcessRouteCreate() {
 				nil, nil,
 				nil,
 				tt.setupMock(),
+				nil,
+				nil,
+				nil,
 				slog.Default(),
 			)
 			result, err := processor(tt.jobRequest)
@@ -477,6 +489,9 @@ func (s *ProcessorRoutePublicTestSuite) TestProcessRouteUpdate() {
 				nil, nil,
 				nil,
 				tt.setupMock(),
+				nil,
+				nil,
+				nil,
 				slog.Default(),
 			)
 			result, err := processor(tt.jobRequest)
@@ -567,6 +582,9 @@ func (s *ProcessorRoutePublicTestSuite) TestProcessRouteDelete() {
 				nil, nil,
 				nil,
 				tt.setupMock(),
+				nil,
+				nil,
+				nil,
 				slog.Default(),
 			)
 			result, err := processor(tt.jobRequest)
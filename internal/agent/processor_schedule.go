@@ -29,24 +29,30 @@ import (
 
 	"github.com/osapi-io/osapi/internal/job"
 	"github.com/osapi-io/osapi/internal/provider/scheduled/cron"
+	"github.com/osapi-io/osapi/internal/provider/scheduled/timer"
 )
 
 // NewScheduleProcessor returns a ProcessorFunc that handles schedule-related operations.
 func NewScheduleProcessor(
 	cronProvider cron.Provider,
+	timerProvider timer.Provider,
 	logger *slog.Logger,
 ) ProcessorFunc {
 	return func(req job.Request) (json.RawMessage, error) {
-		if cronProvider == nil {
-			return nil, fmt.Errorf("cron provider not available")
-		}
-
 		// Extract base operation from dotted operation (e.g., "cron.list" -> "cron")
 		baseOperation := strings.Split(req.Operation, ".")[0]
 
 		switch baseOperation {
 		case "cron":
+			if cronProvider == nil {
+				return nil, fmt.Errorf("cron provider not available")
+			}
 			return processCronOperation(cronProvider, logger, req)
+		case "timer":
+			if timerProvider == nil {
+				return nil, fmt.Errorf("timer provider not available")
+			}
+			return processTimerOperation(timerProvider, logger, req)
 		default:
 			return nil, fmt.Errorf("unsupported schedule operation: %s", req.Operation)
 		}
@@ -120,7 +120,7 @@ func (s *ProcessorSchedulePublicTestSuite) TestProcessScheduleOperation() {
 				cronProvider = tt.setupMock()
 			}
 
-			processor := agent.NewScheduleProcessor(cronProvider, slog.Default())
+			processor := agent.NewScheduleProcessor(cronProvider, nil, slog.Default())
 			result, err := processor(tt.jobRequest)
 
 			if tt.expectError {
@@ -462,7 +462,7 @@ func (s *ProcessorSchedulePublicTestSuite) TestProcessCronOperation() {
 
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
-			processor := agent.NewScheduleProcessor(tt.setupMock(), slog.Default())
+			processor := agent.NewScheduleProcessor(tt.setupMock(), nil, slog.Default())
 			result, err := processor(tt.jobRequest)
 
 			if tt.expectError {
@@ -0,0 +1,183 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/scheduled/timer"
+)
+
+// processTimerOperation dispatches timer sub-operations.
+func processTimerOperation(
+	timerProvider timer.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	// Extract sub-operation: "timer.list" -> "list"
+	parts := strings.Split(jobRequest.Operation, ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid timer operation: %s", jobRequest.Operation)
+	}
+	subOp := parts[1]
+
+	ctx := context.Background()
+
+	switch subOp {
+	case "list":
+		return processTimerList(ctx, timerProvider, logger)
+	case "get":
+		return processTimerGet(ctx, timerProvider, logger, jobRequest)
+	case "create":
+		return processTimerCreate(ctx, timerProvider, logger, jobRequest)
+	case "update":
+		return processTimerUpdate(ctx, timerProvider, logger, jobRequest)
+	case "delete":
+		return processTimerDelete(ctx, timerProvider, logger, jobRequest)
+	default:
+		return nil, fmt.Errorf("unsupported timer operation: %s", jobRequest.Operation)
+	}
+}
+
+// processTimerList lists all timers.
+func processTimerList(
+	ctx context.Context,
+	timerProvider timer.Provider,
+	logger *slog.Logger,
+) (json.RawMessage, error) {
+	logger.Debug("executing timer.List")
+
+	timers, err := timerProvider.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(timers)
+}
+
+// processTimerGet gets a single timer by name.
+func processTimerGet(
+	ctx context.Context,
+	timerProvider timer.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal timer get data: %w", err)
+	}
+
+	logger.Debug(
+		"executing timer.Get",
+		slog.String("name", data.Name),
+	)
+
+	t, err := timerProvider.Get(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(t)
+}
+
+// processTimerCreate creates a new timer.
+func processTimerCreate(
+	ctx context.Context,
+	timerProvider timer.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var entry timer.Entry
+	if err := json.Unmarshal(jobRequest.Data, &entry); err != nil {
+		return nil, fmt.Errorf("unmarshal timer create data: %w", err)
+	}
+
+	logger.Debug(
+		"executing timer.Create",
+		slog.String("name", entry.Name),
+	)
+
+	result, err := timerProvider.Create(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processTimerUpdate updates an existing timer.
+func processTimerUpdate(
+	ctx context.Context,
+	timerProvider timer.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var entry timer.Entry
+	if err := json.Unmarshal(jobRequest.Data, &entry); err != nil {
+		return nil, fmt.Errorf("unmarshal timer update data: %w", err)
+	}
+
+	logger.Debug(
+		"executing timer.Update",
+		slog.String("name", entry.Name),
+	)
+
+	result, err := timerProvider.Update(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processTimerDelete deletes a timer.
+func processTimerDelete(
+	ctx context.Context,
+	timerProvider timer.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal timer delete data: %w", err)
+	}
+
+	logger.Debug(
+		"executing timer.Delete",
+		slog.String("name", data.Name),
+	)
+
+	result, err := timerProvider.Delete(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
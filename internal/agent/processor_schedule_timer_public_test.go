@@ -0,0 +1,393 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent_test
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/agent"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/scheduled/timer"
+	timerMocks "github.com/osapi-io/osapi/internal/provider/scheduled/timer/mocks"
+)
+
+type ProcessorScheduleTimerPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl *gomock.Controller
+}
+
+func (s *ProcessorScheduleTimerPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+}
+
+func (s *ProcessorScheduleTimerPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *ProcessorScheduleTimerPublicTestSuite) TestProcessTimerOperation() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() timer.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "nil provider returns error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "schedule",
+				Operation: "timer.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() timer.Provider {
+				return nil
+			},
+			expectError: true,
+			errorMsg:    "timer provider not available",
+		},
+		{
+			name: "invalid timer operation missing sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "schedule",
+				Operation: "timer",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() timer.Provider {
+				return timerMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "invalid timer operation: timer",
+		},
+		{
+			name: "successful timer list",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "schedule",
+				Operation: "timer.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() timer.Provider {
+				m := timerMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().List(gomock.Any()).Return([]timer.Timer{
+					{
+						Name:     "backup",
+						Unit:     "osapi-backup.timer",
+						Schedule: "daily",
+						Managed:  true,
+						NextRun:  "2026-01-01T00:00:00Z",
+					},
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var timers []timer.Timer
+				err := json.Unmarshal(result, &timers)
+				s.NoError(err)
+				s.Len(timers, 1)
+				s.Equal("backup", timers[0].Name)
+				s.Equal("2026-01-01T00:00:00Z", timers[0].NextRun)
+			},
+		},
+		{
+			name: "timer list provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "schedule",
+				Operation: "timer.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() timer.Provider {
+				m := timerMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().List(gomock.Any()).Return(nil, errors.New("systemctl failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "systemctl failed",
+		},
+		{
+			name: "successful timer get",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "schedule",
+				Operation: "timer.get",
+				Data:      json.RawMessage(`{"name":"backup"}`),
+			},
+			setupMock: func() timer.Provider {
+				m := timerMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Get(gomock.Any(), "backup").Return(&timer.Timer{
+					Name:    "backup",
+					Unit:    "osapi-backup.timer",
+					Managed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var t timer.Timer
+				err := json.Unmarshal(result, &t)
+				s.NoError(err)
+				s.Equal("backup", t.Name)
+				s.True(t.Managed)
+			},
+		},
+		{
+			name: "timer get with invalid JSON data",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "schedule",
+				Operation: "timer.get",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() timer.Provider {
+				return timerMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal timer get data",
+		},
+		{
+			name: "timer get provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "schedule",
+				Operation: "timer.get",
+				Data:      json.RawMessage(`{"name":"missing"}`),
+			},
+			setupMock: func() timer.Provider {
+				m := timerMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Get(gomock.Any(), "missing").
+					Return(nil, errors.New("timer \"missing\": not found"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "not found",
+		},
+		{
+			name: "successful timer create",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "schedule",
+				Operation: "timer.create",
+				Data: json.RawMessage(
+					`{"name":"backup","schedule":"daily","command":"/usr/local/bin/backup"}`,
+				),
+			},
+			setupMock: func() timer.Provider {
+				m := timerMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Create(gomock.Any(), timer.Entry{
+					Name:     "backup",
+					Schedule: "daily",
+					Command:  "/usr/local/bin/backup",
+				}).Return(&timer.CreateResult{Name: "backup", Changed: true}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r timer.CreateResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("backup", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "timer create with invalid JSON data",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "schedule",
+				Operation: "timer.create",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() timer.Provider {
+				return timerMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal timer create data",
+		},
+		{
+			name: "timer create provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "schedule",
+				Operation: "timer.create",
+				Data:      json.RawMessage(`{"name":"backup","schedule":"sometimes"}`),
+			},
+			setupMock: func() timer.Provider {
+				m := timerMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("invalid schedule"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "invalid schedule",
+		},
+		{
+			name: "successful timer update",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "schedule",
+				Operation: "timer.update",
+				Data:      json.RawMessage(`{"name":"backup","schedule":"weekly"}`),
+			},
+			setupMock: func() timer.Provider {
+				m := timerMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Update(gomock.Any(), timer.Entry{
+					Name:     "backup",
+					Schedule: "weekly",
+				}).Return(&timer.UpdateResult{Name: "backup", Changed: true}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r timer.UpdateResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "timer update with invalid JSON data",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "schedule",
+				Operation: "timer.update",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() timer.Provider {
+				return timerMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal timer update data",
+		},
+		{
+			name: "timer update provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "schedule",
+				Operation: "timer.update",
+				Data:      json.RawMessage(`{"name":"backup"}`),
+			},
+			setupMock: func() timer.Provider {
+				m := timerMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("timer \"backup\": not found"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "not found",
+		},
+		{
+			name: "successful timer delete",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "schedule",
+				Operation: "timer.delete",
+				Data:      json.RawMessage(`{"name":"backup"}`),
+			},
+			setupMock: func() timer.Provider {
+				m := timerMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Delete(gomock.Any(), "backup").
+					Return(&timer.DeleteResult{Name: "backup", Changed: true}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r timer.DeleteResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "timer delete with invalid JSON data",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "schedule",
+				Operation: "timer.delete",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() timer.Provider {
+				return timerMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal timer delete data",
+		},
+		{
+			name: "timer delete provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "schedule",
+				Operation: "timer.delete",
+				Data:      json.RawMessage(`{"name":"backup"}`),
+			},
+			setupMock: func() timer.Provider {
+				m := timerMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Delete(gomock.Any(), "backup").
+					Return(nil, errors.New("remove failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "remove failed",
+		},
+		{
+			name: "unsupported timer sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "schedule",
+				Operation: "timer.unknown",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() timer.Provider {
+				return timerMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unsupported timer operation: timer.unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := agent.NewScheduleProcessor(nil, tt.setupMock(), slog.Default())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessorScheduleTimerPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessorScheduleTimerPublicTestSuite))
+}
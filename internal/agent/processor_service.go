@@ -71,6 +71,8 @@ func processServiceOperation(
 		return processServiceEnable(ctx, serviceProvider, logger, jobRequest)
 	case "disable":
 		return processServiceDisable(ctx, serviceProvider, logger, jobRequest)
+	case "dropin":
+		return processServiceDropInOperation(ctx, serviceProvider, logger, jobRequest)
 	default:
 		return nil, fmt.Errorf("unsupported service operation: %s", jobRequest.Operation)
 	}
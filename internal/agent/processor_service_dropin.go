@@ -0,0 +1,164 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/node/service"
+)
+
+// processServiceDropInOperation dispatches service drop-in sub-operations.
+func processServiceDropInOperation(
+	ctx context.Context,
+	serviceProvider service.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	// Extract sub-operation: "service.dropin.list" -> "list"
+	parts := strings.Split(jobRequest.Operation, ".")
+	if len(parts) < 3 {
+		return nil, fmt.Errorf("invalid service drop-in operation: %s", jobRequest.Operation)
+	}
+
+	switch parts[2] {
+	case "list":
+		return processServiceDropInList(ctx, serviceProvider, logger, jobRequest)
+	case "create":
+		return processServiceDropInCreate(ctx, serviceProvider, logger, jobRequest)
+	case "update":
+		return processServiceDropInUpdate(ctx, serviceProvider, logger, jobRequest)
+	case "delete":
+		return processServiceDropInDelete(ctx, serviceProvider, logger, jobRequest)
+	default:
+		return nil, fmt.Errorf("unsupported service drop-in operation: %s", jobRequest.Operation)
+	}
+}
+
+// processServiceDropInList lists the drop-ins of a unit.
+func processServiceDropInList(
+	ctx context.Context,
+	serviceProvider service.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal service drop-in list data: %w", err)
+	}
+
+	logger.Debug(
+		"executing service.ListDropIns",
+		slog.String("name", data.Name),
+	)
+
+	result, err := serviceProvider.ListDropIns(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processServiceDropInCreate deploys a new drop-in via the file provider.
+func processServiceDropInCreate(
+	ctx context.Context,
+	serviceProvider service.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var entry service.DropInEntry
+	if err := json.Unmarshal(jobRequest.Data, &entry); err != nil {
+		return nil, fmt.Errorf("unmarshal service drop-in create data: %w", err)
+	}
+
+	logger.Debug(
+		"executing service.CreateDropIn",
+		slog.String("unit", entry.Unit),
+		slog.String("name", entry.Name),
+	)
+
+	result, err := serviceProvider.CreateDropIn(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processServiceDropInUpdate redeploys an existing drop-in via the file provider.
+func processServiceDropInUpdate(
+	ctx context.Context,
+	serviceProvider service.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var entry service.DropInEntry
+	if err := json.Unmarshal(jobRequest.Data, &entry); err != nil {
+		return nil, fmt.Errorf("unmarshal service drop-in update data: %w", err)
+	}
+
+	logger.Debug(
+		"executing service.UpdateDropIn",
+		slog.String("unit", entry.Unit),
+		slog.String("name", entry.Name),
+	)
+
+	result, err := serviceProvider.UpdateDropIn(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processServiceDropInDelete undeploys a drop-in via the file provider.
+func processServiceDropInDelete(
+	ctx context.Context,
+	serviceProvider service.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var entry service.DropInEntry
+	if err := json.Unmarshal(jobRequest.Data, &entry); err != nil {
+		return nil, fmt.Errorf("unmarshal service drop-in delete data: %w", err)
+	}
+
+	logger.Debug(
+		"executing service.DeleteDropIn",
+		slog.String("unit", entry.Unit),
+		slog.String("name", entry.Name),
+	)
+
+	result, err := serviceProvider.DeleteDropIn(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
@@ -0,0 +1,381 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/agent"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/node/service"
+	serviceMocks "github.com/osapi-io/osapi/internal/provider/node/service/mocks"
+)
+
+type ProcessorServiceDropInPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl *gomock.Controller
+}
+
+func (s *ProcessorServiceDropInPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+}
+
+func (s *ProcessorServiceDropInPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *ProcessorServiceDropInPublicTestSuite) newNodeProcessor(
+	serviceProvider service.Provider,
+) agent.ProcessorFunc {
+	return agent.NewNodeProcessor(
+		context.Background(),
+		nil, nil, nil, nil,
+		nil, nil, nil, nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		serviceProvider,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		config.Config{},
+		slog.Default(),
+	)
+}
+
+func (s *ProcessorServiceDropInPublicTestSuite) TestProcessServiceDropInOperation() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() service.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful list",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "service.dropin.list",
+				Data:      json.RawMessage(`{"name":"nginx"}`),
+			},
+			setupMock: func() service.Provider {
+				m := serviceMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().ListDropIns(gomock.Any(), "nginx").Return([]service.DropIn{
+					{
+						Name:    "override",
+						Path:    "/etc/systemd/system/nginx.service.d/override.conf",
+						Managed: true,
+						Object:  "nginx-override",
+					},
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var dropIns []service.DropIn
+				err := json.Unmarshal(result, &dropIns)
+				s.NoError(err)
+				s.Len(dropIns, 1)
+				s.Equal("override", dropIns[0].Name)
+				s.True(dropIns[0].Managed)
+			},
+		},
+		{
+			name: "list provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "service.dropin.list",
+				Data:      json.RawMessage(`{"name":"nginx"}`),
+			},
+			setupMock: func() service.Provider {
+				m := serviceMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().ListDropIns(gomock.Any(), "nginx").
+					Return(nil, errors.New("list failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "list failed",
+		},
+		{
+			name: "list invalid JSON data",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "service.dropin.list",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() service.Provider {
+				return serviceMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal service drop-in list data",
+		},
+		{
+			name: "successful create",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "service.dropin.create",
+				Data: json.RawMessage(
+					`{"unit":"nginx","name":"limits","object":"nginx-limits","restart":true}`,
+				),
+			},
+			setupMock: func() service.Provider {
+				m := serviceMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().CreateDropIn(gomock.Any(), service.DropInEntry{
+					Unit:    "nginx",
+					Name:    "limits",
+					Object:  "nginx-limits",
+					Restart: true,
+				}).Return(&service.DropInResult{
+					Unit:      "nginx.service",
+					Name:      "limits",
+					Changed:   true,
+					Restarted: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r service.DropInResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("nginx.service", r.Unit)
+				s.True(r.Changed)
+				s.True(r.Restarted)
+			},
+		},
+		{
+			name: "create provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "service.dropin.create",
+				Data:      json.RawMessage(`{"unit":"nginx","object":"nginx-override"}`),
+			},
+			setupMock: func() service.Provider {
+				m := serviceMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().CreateDropIn(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("create failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "create failed",
+		},
+		{
+			name: "create invalid JSON data",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "service.dropin.create",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() service.Provider {
+				return serviceMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal service drop-in create data",
+		},
+		{
+			name: "successful update",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "service.dropin.update",
+				Data:      json.RawMessage(`{"unit":"nginx","object":"nginx-override-v2"}`),
+			},
+			setupMock: func() service.Provider {
+				m := serviceMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().UpdateDropIn(gomock.Any(), service.DropInEntry{
+					Unit:   "nginx",
+					Object: "nginx-override-v2",
+				}).Return(&service.DropInResult{
+					Unit:    "nginx.service",
+					Name:    "override",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r service.DropInResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.True(r.Changed)
+				s.False(r.Restarted)
+			},
+		},
+		{
+			name: "update provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "service.dropin.update",
+				Data:      json.RawMessage(`{"unit":"nginx"}`),
+			},
+			setupMock: func() service.Provider {
+				m := serviceMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().UpdateDropIn(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("update failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "update failed",
+		},
+		{
+			name: "update invalid JSON data",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "service.dropin.update",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() service.Provider {
+				return serviceMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal service drop-in update data",
+		},
+		{
+			name: "successful delete",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "service.dropin.delete",
+				Data:      json.RawMessage(`{"unit":"nginx","name":"limits"}`),
+			},
+			setupMock: func() service.Provider {
+				m := serviceMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().DeleteDropIn(gomock.Any(), service.DropInEntry{
+					Unit: "nginx",
+					Name: "limits",
+				}).Return(&service.DropInResult{
+					Unit:    "nginx.service",
+					Name:    "limits",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r service.DropInResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("limits", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "delete provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "service.dropin.delete",
+				Data:      json.RawMessage(`{"unit":"nginx"}`),
+			},
+			setupMock: func() service.Provider {
+				m := serviceMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().DeleteDropIn(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("delete failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "delete failed",
+		},
+		{
+			name: "delete invalid JSON data",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "service.dropin.delete",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() service.Provider {
+				return serviceMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal service drop-in delete data",
+		},
+		{
+			name: "invalid drop-in operation format",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "service.dropin",
+			},
+			setupMock: func() service.Provider {
+				return serviceMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "invalid service drop-in operation",
+		},
+		{
+			name: "unsupported drop-in operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "service.dropin.unknown",
+			},
+			setupMock: func() service.Provider {
+				return serviceMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unsupported service drop-in operation",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessorServiceDropInPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessorServiceDropInPublicTestSuite))
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/network/socket"
+)
+
+// processSocketOperation dispatches network socket sub-operations.
+func processSocketOperation(
+	provider socket.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("socket provider not available")
+	}
+
+	// Extract sub-operation: "socket.list" -> "list"
+	parts := strings.Split(jobRequest.Operation, ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid socket operation: %s", jobRequest.Operation)
+	}
+	subOp := parts[1]
+
+	ctx := context.Background()
+
+	switch subOp {
+	case "list":
+		return processSocketList(ctx, provider, logger, jobRequest)
+	default:
+		return nil, fmt.Errorf("unsupported socket operation: %s", jobRequest.Operation)
+	}
+}
+
+// processSocketList lists sockets matching the requested filters.
+func processSocketList(
+	ctx context.Context,
+	provider socket.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var opts socket.ListOpts
+	if len(jobRequest.Data) > 0 {
+		if err := json.Unmarshal(jobRequest.Data, &opts); err != nil {
+			return nil, fmt.Errorf("unmarshal socket list data: %w", err)
+		}
+	}
+
+	logger.Debug(
+		"executing socket.List",
+		slog.String("protocol", opts.Protocol),
+		slog.String("state", opts.State),
+		slog.Int("port", opts.Port),
+		slog.Int("pid", opts.PID),
+	)
+
+	sockets, err := provider.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(sockets)
+}
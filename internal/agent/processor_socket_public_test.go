@@ -0,0 +1,249 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent_test
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/agent"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/network/socket"
+	socketMocks "github.com/osapi-io/osapi/internal/provider/network/socket/mocks"
+)
+
+type ProcessorSocketPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl *gomock.Controller
+}
+
+func (s *ProcessorSocketPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+}
+
+func (s *ProcessorSocketPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *ProcessorSocketPublicTestSuite) TestProcessSocketOperation() {
+	tests := []struct {
+		name       string
+		jobRequest job.Request
+		setupMock  func() socket.Provider
+		errorMsg   string
+	}{
+		{
+			name: "nil provider returns error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "network",
+				Operation: "socket.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: nil,
+			errorMsg:  "socket provider not available",
+		},
+		{
+			name: "invalid socket operation missing sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "network",
+				Operation: "socket",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() socket.Provider {
+				return socketMocks.NewMockProvider(s.mockCtrl)
+			},
+			errorMsg: "invalid socket operation: socket",
+		},
+		{
+			name: "unsupported socket sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "network",
+				Operation: "socket.unknown",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() socket.Provider {
+				return socketMocks.NewMockProvider(s.mockCtrl)
+			},
+			errorMsg: "unsupported socket operation: socket.unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var socketProvider socket.Provider
+			if tt.setupMock != nil {
+				socketProvider = tt.setupMock()
+			}
+
+			processor := agent.NewNetworkProcessor(
+				nil, nil,
+				nil,
+				nil,
+				nil,
+				socketProvider,
+				nil,
+				slog.Default(),
+			)
+			result, err := processor(tt.jobRequest)
+
+			s.Error(err)
+			s.Contains(err.Error(), tt.errorMsg)
+			s.Nil(result)
+		})
+	}
+}
+
+func (s *ProcessorSocketPublicTestSuite) TestProcessSocketList() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() socket.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful socket list with filters",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "network",
+				Operation: "socket.list",
+				Data: json.RawMessage(
+					`{"protocol":"tcp","state":"listen","port":22,"pid":100}`,
+				),
+			},
+			setupMock: func() socket.Provider {
+				m := socketMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().List(gomock.Any(), socket.ListOpts{
+					Protocol: "tcp",
+					State:    "listen",
+					Port:     22,
+					PID:      100,
+				}).Return([]socket.Socket{
+					{
+						Protocol:     "tcp",
+						Family:       "inet",
+						State:        "listen",
+						LocalAddress: "0.0.0.0",
+						LocalPort:    22,
+						PID:          100,
+						Process:      "sshd",
+					},
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var sockets []socket.Socket
+				err := json.Unmarshal(result, &sockets)
+				s.NoError(err)
+				s.Len(sockets, 1)
+				s.Equal("sshd", sockets[0].Process)
+			},
+		},
+		{
+			name: "successful socket list without data",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "network",
+				Operation: "socket.list",
+			},
+			setupMock: func() socket.Provider {
+				m := socketMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().List(gomock.Any(), socket.ListOpts{}).Return([]socket.Socket{}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				s.JSONEq(`[]`, string(result))
+			},
+		},
+		{
+			name: "invalid socket list data",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "network",
+				Operation: "socket.list",
+				Data:      json.RawMessage(`{"port":"ssh"}`),
+			},
+			setupMock: func() socket.Provider {
+				return socketMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal socket list data",
+		},
+		{
+			name: "socket list provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "network",
+				Operation: "socket.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() socket.Provider {
+				m := socketMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().List(gomock.Any(), socket.ListOpts{}).
+					Return(nil, errors.New("permission denied"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "permission denied",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := agent.NewNetworkProcessor(
+				nil, nil,
+				nil,
+				nil,
+				nil,
+				tt.setupMock(),
+				nil,
+				slog.Default(),
+			)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessorSocketPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessorSocketPublicTestSuite))
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/node/sshd"
+)
+
+// processSshdOperation dispatches sshd sub-operations.
+func processSshdOperation(
+	sshdProvider sshd.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	if sshdProvider == nil {
+		return nil, fmt.Errorf("sshd provider not available")
+	}
+
+	// Extract sub-operation: "sshd.get" -> "get"
+	parts := strings.Split(jobRequest.Operation, ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid sshd operation: %s", jobRequest.Operation)
+	}
+	subOp := parts[1]
+
+	ctx := context.Background()
+
+	switch subOp {
+	case "get":
+		return processSshdGet(ctx, sshdProvider, logger)
+	case "create":
+		return processSshdCreate(ctx, sshdProvider, logger, jobRequest)
+	case "update":
+		return processSshdUpdate(ctx, sshdProvider, logger, jobRequest)
+	case "delete":
+		return processSshdDelete(ctx, sshdProvider, logger, jobRequest)
+	default:
+		return nil, fmt.Errorf("unsupported sshd operation: %s", jobRequest.Operation)
+	}
+}
+
+// processSshdGet retrieves the effective sshd configuration and the
+// managed drop-ins.
+func processSshdGet(
+	ctx context.Context,
+	sshdProvider sshd.Provider,
+	logger *slog.Logger,
+) (json.RawMessage, error) {
+	logger.Debug("executing sshd.Get")
+
+	result, err := sshdProvider.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processSshdCreate validates and activates an sshd drop-in.
+func processSshdCreate(
+	ctx context.Context,
+	sshdProvider sshd.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var dropIn sshd.DropIn
+	if err := json.Unmarshal(jobRequest.Data, &dropIn); err != nil {
+		return nil, fmt.Errorf("unmarshal sshd create data: %w", err)
+	}
+
+	logger.Debug(
+		"executing sshd.Create",
+		slog.String("name", dropIn.Name),
+	)
+
+	result, err := sshdProvider.Create(ctx, dropIn)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processSshdUpdate validates and replaces the directives of a managed
+// sshd drop-in.
+func processSshdUpdate(
+	ctx context.Context,
+	sshdProvider sshd.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var dropIn sshd.DropIn
+	if err := json.Unmarshal(jobRequest.Data, &dropIn); err != nil {
+		return nil, fmt.Errorf("unmarshal sshd update data: %w", err)
+	}
+
+	logger.Debug(
+		"executing sshd.Update",
+		slog.String("name", dropIn.Name),
+	)
+
+	result, err := sshdProvider.Update(ctx, dropIn)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processSshdDelete removes a managed sshd drop-in.
+func processSshdDelete(
+	ctx context.Context,
+	sshdProvider sshd.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal sshd delete data: %w", err)
+	}
+
+	logger.Debug(
+		"executing sshd.Delete",
+		slog.String("name", data.Name),
+	)
+
+	result, err := sshdProvider.Delete(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
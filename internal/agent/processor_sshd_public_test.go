@@ -0,0 +1,505 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/agent"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/node/sshd"
+	sshdMocks "github.com/osapi-io/osapi/internal/provider/node/sshd/mocks"
+)
+
+type ProcessorSshdPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl *gomock.Controller
+}
+
+func (s *ProcessorSshdPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+}
+
+func (s *ProcessorSshdPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *ProcessorSshdPublicTestSuite) newNodeProcessor(
+	sshdProvider sshd.Provider,
+) agent.ProcessorFunc {
+	return agent.NewNodeProcessor(
+		context.Background(),
+		nil, nil, nil, nil,
+		nil, nil, nil, nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		sshdProvider,
+		nil,
+		config.Config{},
+		slog.Default(),
+	)
+}
+
+func (s *ProcessorSshdPublicTestSuite) TestProcessSshdOperation() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() sshd.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "nil provider returns error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "sshd.get",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock:   nil,
+			expectError: true,
+			errorMsg:    "sshd provider not available",
+		},
+		{
+			name: "invalid operation format",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "sshd",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() sshd.Provider {
+				return sshdMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "invalid sshd operation: sshd",
+		},
+		{
+			name: "unsupported sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "sshd.unknown",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() sshd.Provider {
+				return sshdMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unsupported sshd operation: sshd.unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var sshdProvider sshd.Provider
+			if tt.setupMock != nil {
+				sshdProvider = tt.setupMock()
+			}
+
+			processor := s.newNodeProcessor(sshdProvider)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorSshdPublicTestSuite) TestProcessSshdGet() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() sshd.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful get",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "sshd.get",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() sshd.Provider {
+				m := sshdMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Get(gomock.Any()).Return(&sshd.Config{
+					Effective: []sshd.Directive{
+						{Keyword: "port", Value: "22"},
+						{Keyword: "passwordauthentication", Value: "no"},
+					},
+					DropIns: []sshd.DropIn{
+						{
+							Name: "hardening",
+							Path: "/etc/ssh/sshd_config.d/hardening.conf",
+							Directives: []sshd.Directive{
+								{Keyword: "PasswordAuthentication", Value: "no"},
+							},
+						},
+					},
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var config sshd.Config
+				err := json.Unmarshal(result, &config)
+				s.NoError(err)
+				s.Len(config.Effective, 2)
+				s.Equal("passwordauthentication", config.Effective[1].Keyword)
+				s.Len(config.DropIns, 1)
+				s.Equal("hardening", config.DropIns[0].Name)
+				s.Equal("/etc/ssh/sshd_config.d/hardening.conf", config.DropIns[0].Path)
+			},
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "sshd.get",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() sshd.Provider {
+				m := sshdMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Get(gomock.Any()).
+					Return(nil, errors.New("sshd: get: sshd -T failed: exit status 255"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "sshd -T failed",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorSshdPublicTestSuite) TestProcessSshdCreate() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() sshd.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful create",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sshd.create",
+				Data:      json.RawMessage(`{"name":"hardening","directives":[{"keyword":"PasswordAuthentication","value":"no"},{"keyword":"AllowGroups","value":"ssh-users"}]}`),
+			},
+			setupMock: func() sshd.Provider {
+				m := sshdMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Create(gomock.Any(), sshd.DropIn{
+					Name: "hardening",
+					Directives: []sshd.Directive{
+						{Keyword: "PasswordAuthentication", Value: "no"},
+						{Keyword: "AllowGroups", Value: "ssh-users"},
+					},
+				}).Return(&sshd.Result{Name: "hardening", Changed: true}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r sshd.Result
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("hardening", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sshd.create",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() sshd.Provider {
+				return sshdMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal sshd create data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sshd.create",
+				Data:      json.RawMessage(`{"name":"hardening","directives":[{"keyword":"PasswordAuthentication","value":"no"}]}`),
+			},
+			setupMock: func() sshd.Provider {
+				m := sshdMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("sshd: create \"hardening\": sshd validate failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "sshd validate failed",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorSshdPublicTestSuite) TestProcessSshdUpdate() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() sshd.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful update",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sshd.update",
+				Data:      json.RawMessage(`{"name":"hardening","directives":[{"keyword":"PasswordAuthentication","value":"no"},{"keyword":"AllowGroups","value":"ssh-users"}]}`),
+			},
+			setupMock: func() sshd.Provider {
+				m := sshdMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Update(gomock.Any(), sshd.DropIn{
+					Name: "hardening",
+					Directives: []sshd.Directive{
+						{Keyword: "PasswordAuthentication", Value: "no"},
+						{Keyword: "AllowGroups", Value: "ssh-users"},
+					},
+				}).Return(&sshd.Result{Name: "hardening", Changed: true}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r sshd.Result
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("hardening", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sshd.update",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() sshd.Provider {
+				return sshdMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal sshd update data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sshd.update",
+				Data:      json.RawMessage(`{"name":"hardening","directives":[{"keyword":"PasswordAuthentication","value":"no"}]}`),
+			},
+			setupMock: func() sshd.Provider {
+				m := sshdMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("sshd: update \"hardening\": not found"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorSshdPublicTestSuite) TestProcessSshdDelete() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() sshd.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful delete",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sshd.delete",
+				Data:      json.RawMessage(`{"name":"hardening"}`),
+			},
+			setupMock: func() sshd.Provider {
+				m := sshdMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Delete(gomock.Any(), "hardening").
+					Return(&sshd.Result{Name: "hardening", Changed: true}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r sshd.Result
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("hardening", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sshd.delete",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() sshd.Provider {
+				return sshdMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal sshd delete data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sshd.delete",
+				Data:      json.RawMessage(`{"name":"hardening"}`),
+			},
+			setupMock: func() sshd.Provider {
+				m := sshdMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Delete(gomock.Any(), "hardening").
+					Return(nil, errors.New("sshd: delete \"hardening\": sshd reload failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "sshd reload failed",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessorSshdPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessorSshdPublicTestSuite))
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/node/sudoers"
+)
+
+// processSudoersOperation dispatches sudoers sub-operations.
+func processSudoersOperation(
+	sudoersProvider sudoers.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	if sudoersProvider == nil {
+		return nil, fmt.Errorf("sudoers provider not available")
+	}
+
+	// Extract sub-operation: "sudoers.list" -> "list"
+	parts := strings.Split(jobRequest.Operation, ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid sudoers operation: %s", jobRequest.Operation)
+	}
+	subOp := parts[1]
+
+	ctx := context.Background()
+
+	switch subOp {
+	case "list":
+		return processSudoersList(ctx, sudoersProvider, logger)
+	case "get":
+		return processSudoersGet(ctx, sudoersProvider, logger, jobRequest)
+	case "create":
+		return processSudoersCreate(ctx, sudoersProvider, logger, jobRequest)
+	case "update":
+		return processSudoersUpdate(ctx, sudoersProvider, logger, jobRequest)
+	case "delete":
+		return processSudoersDelete(ctx, sudoersProvider, logger, jobRequest)
+	default:
+		return nil, fmt.Errorf("unsupported sudoers operation: %s", jobRequest.Operation)
+	}
+}
+
+// processSudoersList lists the managed sudoers drop-ins.
+func processSudoersList(
+	ctx context.Context,
+	sudoersProvider sudoers.Provider,
+	logger *slog.Logger,
+) (json.RawMessage, error) {
+	logger.Debug("executing sudoers.List")
+
+	result, err := sudoersProvider.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processSudoersGet retrieves a single sudoers drop-in.
+func processSudoersGet(
+	ctx context.Context,
+	sudoersProvider sudoers.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal sudoers get data: %w", err)
+	}
+
+	logger.Debug(
+		"executing sudoers.Get",
+		slog.String("name", data.Name),
+	)
+
+	result, err := sudoersProvider.Get(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processSudoersCreate validates and deploys a sudoers drop-in.
+func processSudoersCreate(
+	ctx context.Context,
+	sudoersProvider sudoers.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var entry sudoers.Entry
+	if err := json.Unmarshal(jobRequest.Data, &entry); err != nil {
+		return nil, fmt.Errorf("unmarshal sudoers create data: %w", err)
+	}
+
+	logger.Debug(
+		"executing sudoers.Create",
+		slog.String("name", entry.Name),
+	)
+
+	result, err := sudoersProvider.Create(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processSudoersUpdate validates and replaces the rules of a managed
+// sudoers drop-in.
+func processSudoersUpdate(
+	ctx context.Context,
+	sudoersProvider sudoers.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var entry sudoers.Entry
+	if err := json.Unmarshal(jobRequest.Data, &entry); err != nil {
+		return nil, fmt.Errorf("unmarshal sudoers update data: %w", err)
+	}
+
+	logger.Debug(
+		"executing sudoers.Update",
+		slog.String("name", entry.Name),
+	)
+
+	result, err := sudoersProvider.Update(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processSudoersDelete removes a managed sudoers drop-in.
+func processSudoersDelete(
+	ctx context.Context,
+	sudoersProvider sudoers.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal sudoers delete data: %w", err)
+	}
+
+	logger.Debug(
+		"executing sudoers.Delete",
+		slog.String("name", data.Name),
+	)
+
+	result, err := sudoersProvider.Delete(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
@@ -0,0 +1,591 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/agent"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/node/sudoers"
+	sudoersMocks "github.com/osapi-io/osapi/internal/provider/node/sudoers/mocks"
+)
+
+type ProcessorSudoersPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl *gomock.Controller
+}
+
+func (s *ProcessorSudoersPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+}
+
+func (s *ProcessorSudoersPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *ProcessorSudoersPublicTestSuite) newNodeProcessor(
+	sudoersProvider sudoers.Provider,
+) agent.ProcessorFunc {
+	return agent.NewNodeProcessor(
+		context.Background(),
+		nil, nil, nil, nil,
+		nil, nil, nil, nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		sudoersProvider,
+		nil,
+		nil,
+		config.Config{},
+		slog.Default(),
+	)
+}
+
+func (s *ProcessorSudoersPublicTestSuite) TestProcessSudoersOperation() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() sudoers.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "nil provider returns error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "sudoers.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock:   nil,
+			expectError: true,
+			errorMsg:    "sudoers provider not available",
+		},
+		{
+			name: "invalid operation format",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "sudoers",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() sudoers.Provider {
+				return sudoersMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "invalid sudoers operation: sudoers",
+		},
+		{
+			name: "unsupported sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "sudoers.unknown",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() sudoers.Provider {
+				return sudoersMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unsupported sudoers operation: sudoers.unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var sudoersProvider sudoers.Provider
+			if tt.setupMock != nil {
+				sudoersProvider = tt.setupMock()
+			}
+
+			processor := s.newNodeProcessor(sudoersProvider)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorSudoersPublicTestSuite) TestProcessSudoersList() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() sudoers.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful list",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "sudoers.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() sudoers.Provider {
+				m := sudoersMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().List(gomock.Any()).Return([]sudoers.Entry{
+					{
+						Name: "ops",
+						Path: "/etc/sudoers.d/ops",
+						Rules: []sudoers.Rule{
+							{Principal: "%ops", NoPassword: true},
+						},
+					},
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var entries []sudoers.Entry
+				err := json.Unmarshal(result, &entries)
+				s.NoError(err)
+				s.Len(entries, 1)
+				s.Equal("ops", entries[0].Name)
+				s.Equal("%ops", entries[0].Rules[0].Principal)
+				s.True(entries[0].Rules[0].NoPassword)
+			},
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "sudoers.list",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() sudoers.Provider {
+				m := sudoersMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().List(gomock.Any()).
+					Return(nil, errors.New("sudoers: list: permission denied"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "permission denied",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorSudoersPublicTestSuite) TestProcessSudoersGet() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() sudoers.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful get",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "sudoers.get",
+				Data:      json.RawMessage(`{"name":"ops"}`),
+			},
+			setupMock: func() sudoers.Provider {
+				m := sudoersMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Get(gomock.Any(), "ops").Return(&sudoers.Entry{
+					Name:  "ops",
+					Path:  "/etc/sudoers.d/ops",
+					Rules: []sudoers.Rule{{Principal: "%ops"}},
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var entry sudoers.Entry
+				err := json.Unmarshal(result, &entry)
+				s.NoError(err)
+				s.Equal("ops", entry.Name)
+				s.Equal("/etc/sudoers.d/ops", entry.Path)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "sudoers.get",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() sudoers.Provider {
+				return sudoersMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal sudoers get data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "sudoers.get",
+				Data:      json.RawMessage(`{"name":"missing"}`),
+			},
+			setupMock: func() sudoers.Provider {
+				m := sudoersMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Get(gomock.Any(), "missing").
+					Return(nil, errors.New("sudoers: get \"missing\": not found"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorSudoersPublicTestSuite) TestProcessSudoersCreate() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() sudoers.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful create",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sudoers.create",
+				Data:      json.RawMessage(`{"name":"ops","rules":[{"principal":"%ops","no_password":true,"commands":["/usr/bin/systemctl restart nginx"]}]}`),
+			},
+			setupMock: func() sudoers.Provider {
+				m := sudoersMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Create(gomock.Any(), sudoers.Entry{
+					Name: "ops",
+					Rules: []sudoers.Rule{
+						{
+							Principal:  "%ops",
+							NoPassword: true,
+							Commands:   []string{"/usr/bin/systemctl restart nginx"},
+						},
+					},
+				}).Return(&sudoers.Result{Name: "ops", Changed: true}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r sudoers.Result
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("ops", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sudoers.create",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() sudoers.Provider {
+				return sudoersMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal sudoers create data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sudoers.create",
+				Data:      json.RawMessage(`{"name":"ops","rules":[{"principal":"%ops"}]}`),
+			},
+			setupMock: func() sudoers.Provider {
+				m := sudoersMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("sudoers: create \"ops\": visudo validate failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "visudo validate failed",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorSudoersPublicTestSuite) TestProcessSudoersUpdate() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() sudoers.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful update",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sudoers.update",
+				Data:      json.RawMessage(`{"name":"ops","rules":[{"principal":"%ops","no_password":true,"commands":["/usr/bin/systemctl restart nginx"]}]}`),
+			},
+			setupMock: func() sudoers.Provider {
+				m := sudoersMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Update(gomock.Any(), sudoers.Entry{
+					Name: "ops",
+					Rules: []sudoers.Rule{
+						{
+							Principal:  "%ops",
+							NoPassword: true,
+							Commands:   []string{"/usr/bin/systemctl restart nginx"},
+						},
+					},
+				}).Return(&sudoers.Result{Name: "ops", Changed: true}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r sudoers.Result
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("ops", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sudoers.update",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() sudoers.Provider {
+				return sudoersMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal sudoers update data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sudoers.update",
+				Data:      json.RawMessage(`{"name":"ops","rules":[{"principal":"%ops"}]}`),
+			},
+			setupMock: func() sudoers.Provider {
+				m := sudoersMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Update(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("sudoers: update \"ops\": not found"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorSudoersPublicTestSuite) TestProcessSudoersDelete() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() sudoers.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful delete",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sudoers.delete",
+				Data:      json.RawMessage(`{"name":"ops"}`),
+			},
+			setupMock: func() sudoers.Provider {
+				m := sudoersMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Delete(gomock.Any(), "ops").
+					Return(&sudoers.Result{Name: "ops", Changed: true}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r sudoers.Result
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("ops", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sudoers.delete",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() sudoers.Provider {
+				return sudoersMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal sudoers delete data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "sudoers.delete",
+				Data:      json.RawMessage(`{"name":"ops"}`),
+			},
+			setupMock: func() sudoers.Provider {
+				m := sudoersMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Delete(gomock.Any(), "ops").
+					Return(nil, errors.New("sudoers: delete \"ops\": permission denied"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "permission denied",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessorSudoersPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessorSudoersPublicTestSuite))
+}
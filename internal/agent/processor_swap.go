@@ -0,0 +1,132 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/node/swap"
+)
+
+// processSwapOperation dispatches swap sub-operations.
+func processSwapOperation(
+	swapProvider swap.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	if swapProvider == nil {
+		return nil, fmt.Errorf("swap provider not available")
+	}
+
+	// Extract sub-operation: "swap.get" -> "get"
+	parts := strings.Split(jobRequest.Operation, ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid swap operation: %s", jobRequest.Operation)
+	}
+	subOp := parts[1]
+
+	ctx := context.Background()
+
+	switch subOp {
+	case "get":
+		return processSwapGet(ctx, swapProvider, logger)
+	case "create":
+		return processSwapCreate(ctx, swapProvider, logger, jobRequest)
+	case "delete":
+		return processSwapDelete(ctx, swapProvider, logger, jobRequest)
+	default:
+		return nil, fmt.Errorf("unsupported swap operation: %s", jobRequest.Operation)
+	}
+}
+
+// processSwapGet returns the swap areas and swappiness.
+func processSwapGet(
+	ctx context.Context,
+	swapProvider swap.Provider,
+	logger *slog.Logger,
+) (json.RawMessage, error) {
+	logger.Debug("executing swap.Get")
+
+	status, err := swapProvider.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(status)
+}
+
+// processSwapCreate creates and enables a managed swap file.
+func processSwapCreate(
+	ctx context.Context,
+	swapProvider swap.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var opts swap.CreateOpts
+	if err := json.Unmarshal(jobRequest.Data, &opts); err != nil {
+		return nil, fmt.Errorf("unmarshal swap create data: %w", err)
+	}
+
+	logger.Debug(
+		"executing swap.Create",
+		slog.String("name", opts.Name),
+		slog.Int("size_mb", opts.SizeMB),
+	)
+
+	result, err := swapProvider.Create(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+// processSwapDelete disables and removes a managed swap file.
+func processSwapDelete(
+	ctx context.Context,
+	swapProvider swap.Provider,
+	logger *slog.Logger,
+	jobRequest job.Request,
+) (json.RawMessage, error) {
+	var data struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(jobRequest.Data, &data); err != nil {
+		return nil, fmt.Errorf("unmarshal swap delete data: %w", err)
+	}
+
+	logger.Debug(
+		"executing swap.Delete",
+		slog.String("name", data.Name),
+	)
+
+	result, err := swapProvider.Delete(ctx, data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
@@ -0,0 +1,419 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package agent_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/agent"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/node/swap"
+	swapMocks "github.com/osapi-io/osapi/internal/provider/node/swap/mocks"
+)
+
+type ProcessorSwapPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl *gomock.Controller
+}
+
+func (s *ProcessorSwapPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+}
+
+func (s *ProcessorSwapPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *ProcessorSwapPublicTestSuite) newNodeProcessor(
+	swapProvider swap.Provider,
+) agent.ProcessorFunc {
+	return agent.NewNodeProcessor(
+		context.Background(),
+		nil, nil, nil, nil,
+		nil, nil, nil, nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		swapProvider,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		config.Config{},
+		slog.Default(),
+	)
+}
+
+func (s *ProcessorSwapPublicTestSuite) TestProcessSwapOperation() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() swap.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "nil provider returns error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "swap.get",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock:   nil,
+			expectError: true,
+			errorMsg:    "swap provider not available",
+		},
+		{
+			name: "invalid operation format missing sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "swap",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() swap.Provider {
+				return swapMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "invalid swap operation: swap",
+		},
+		{
+			name: "unsupported sub-operation",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "swap.unknown",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() swap.Provider {
+				return swapMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unsupported swap operation: swap.unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var swapProvider swap.Provider
+			if tt.setupMock != nil {
+				swapProvider = tt.setupMock()
+			}
+
+			processor := s.newNodeProcessor(swapProvider)
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorSwapPublicTestSuite) TestProcessSwapGet() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() swap.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful get",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "swap.get",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() swap.Provider {
+				m := swapMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Get(gomock.Any()).Return(&swap.Status{
+					Swappiness: 60,
+					Total:      2147483648,
+					Areas: []swap.Area{
+						{
+							Path:   "/swap.img",
+							Type:   "file",
+							Size:   2147483648,
+							Active: true,
+						},
+					},
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var status swap.Status
+				err := json.Unmarshal(result, &status)
+				s.NoError(err)
+				s.Equal(60, status.Swappiness)
+				s.Len(status.Areas, 1)
+				s.Equal("/swap.img", status.Areas[0].Path)
+			},
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeQuery,
+				Category:  "node",
+				Operation: "swap.get",
+				Data:      json.RawMessage(`{}`),
+			},
+			setupMock: func() swap.Provider {
+				m := swapMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Get(gomock.Any()).Return(nil, errors.New("read /proc/swaps failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "read /proc/swaps failed",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorSwapPublicTestSuite) TestProcessSwapCreate() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() swap.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful create",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "swap.create",
+				Data:      json.RawMessage(`{"name":"data","size_mb":1024,"priority":10}`),
+			},
+			setupMock: func() swap.Provider {
+				m := swapMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ interface{}, opts swap.CreateOpts) (*swap.CreateResult, error) {
+						s.Equal("data", opts.Name)
+						s.Equal(1024, opts.SizeMB)
+						s.Require().NotNil(opts.Priority)
+						s.Equal(10, *opts.Priority)
+						return &swap.CreateResult{
+							Name:    "data",
+							Path:    "/var/lib/osapi/swap/data",
+							Changed: true,
+						}, nil
+					},
+				)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r swap.CreateResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("data", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "swap.create",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() swap.Provider {
+				return swapMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal swap create data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "swap.create",
+				Data:      json.RawMessage(`{"name":"data","size_mb":1024}`),
+			},
+			setupMock: func() swap.Provider {
+				m := swapMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("No space left on device"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "No space left on device",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func (s *ProcessorSwapPublicTestSuite) TestProcessSwapDelete() {
+	tests := []struct {
+		name        string
+		jobRequest  job.Request
+		setupMock   func() swap.Provider
+		expectError bool
+		errorMsg    string
+		validate    func(json.RawMessage)
+	}{
+		{
+			name: "successful delete",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "swap.delete",
+				Data:      json.RawMessage(`{"name":"data"}`),
+			},
+			setupMock: func() swap.Provider {
+				m := swapMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Delete(gomock.Any(), "data").Return(&swap.DeleteResult{
+					Name:    "data",
+					Path:    "/var/lib/osapi/swap/data",
+					Changed: true,
+				}, nil)
+				return m
+			},
+			validate: func(result json.RawMessage) {
+				var r swap.DeleteResult
+				err := json.Unmarshal(result, &r)
+				s.NoError(err)
+				s.Equal("data", r.Name)
+				s.True(r.Changed)
+			},
+		},
+		{
+			name: "unmarshal error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "swap.delete",
+				Data:      json.RawMessage(`invalid json`),
+			},
+			setupMock: func() swap.Provider {
+				return swapMocks.NewMockProvider(s.mockCtrl)
+			},
+			expectError: true,
+			errorMsg:    "unmarshal swap delete data",
+		},
+		{
+			name: "provider error",
+			jobRequest: job.Request{
+				Type:      job.TypeModify,
+				Category:  "node",
+				Operation: "swap.delete",
+				Data:      json.RawMessage(`{"name":"data"}`),
+			},
+			setupMock: func() swap.Provider {
+				m := swapMocks.NewMockProvider(s.mockCtrl)
+				m.EXPECT().Delete(gomock.Any(), "data").
+					Return(nil, errors.New("swapoff failed"))
+				return m
+			},
+			expectError: true,
+			errorMsg:    "swapoff failed",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			processor := s.newNodeProcessor(tt.setupMock())
+			result, err := processor(tt.jobRequest)
+
+			if tt.expectError {
+				s.Error(err)
+				s.Contains(err.Error(), tt.errorMsg)
+				s.Nil(result)
+			} else {
+				s.NoError(err)
+				s.NotNil(result)
+				if tt.validate != nil {
+					tt.validate(result)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessorSwapPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessorSwapPublicTestSuite))
+}
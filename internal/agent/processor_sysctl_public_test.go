@@ -21,6 +21,7 @@
 package agent_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log/slog"
@@ -109,6 +110,7 @@ func (s *ProcessorSysctlPublicTestSuite) TestProcessSysctlOperation() {
 			}
 
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil,
 				sysctlProvider,
 				nil, nil, nil,
@@ -117,6 +119,14 @@ func (s *ProcessorSysctlPublicTestSuite) TestProcessSysctlOperation() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
@@ -192,6 +202,7 @@ func (s *ProcessorSysctlPublicTestSuite) TestProcessSysctlList() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil,
 				tt.setupMock(),
 				nil, nil, nil,
@@ -200,6 +211,14 @@ func (s *ProcessorSysctlPublicTestSuite) TestProcessSysctlList() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
@@ -288,6 +307,7 @@ func (s *ProcessorSysctlPublicTestSuite) TestProcessSysctlGet() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil,
 				tt.setupMock(),
 				nil, nil, nil,
@@ -296,6 +316,14 @@ func (s *ProcessorSysctlPublicTestSuite) TestProcessSysctlGet() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
@@ -389,6 +417,7 @@ func (s *ProcessorSysctlPublicTestSuite) TestProcessSysctlCreate() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil,
 				tt.setupMock(),
 				nil, nil, nil,
@@ -397,6 +426,14 @@ func (s *ProcessorSysctlPublicTestSuite) TestProcessSysctlCreate() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
@@ -490,6 +527,7 @@ func (s *ProcessorSysctlPublicTestSuite) TestProcessSysctlUpdate() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil,
 				tt.setupMock(),
 				nil, nil, nil,
@@ -498,6 +536,14 @@ func (s *ProcessorSysctlPublicTestSuite) TestProcessSysctlUpdate() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
@@ -586,6 +632,7 @@ func (s *ProcessorSysctlPublicTestSuite) TestProcessSysctlDelete() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil,
 				tt.setupMock(),
 				nil, nil, nil,
@@ -594,6 +641,14 @@ func (s *ProcessorSysctlPublicTestSuite) TestProcessSysctlDelete() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
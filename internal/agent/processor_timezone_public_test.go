@@ -21,6 +21,7 @@
 package agent_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log/slog"
@@ -109,6 +110,7 @@ func (s *ProcessorTimezonePublicTestSuite) TestProcessTimezoneOperation() {
 			}
 
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil, nil, nil,
 				timezoneProvider,
 				nil, nil,
@@ -116,6 +118,14 @@ func (s *ProcessorTimezonePublicTestSuite) TestProcessTimezoneOperation() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
@@ -190,6 +200,7 @@ func (s *ProcessorTimezonePublicTestSuite) TestProcessTimezoneGet() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil, nil, nil,
 				tt.setupMock(),
 				nil, nil,
@@ -197,6 +208,14 @@ func (s *ProcessorTimezonePublicTestSuite) TestProcessTimezoneGet() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
@@ -287,6 +306,7 @@ func (s *ProcessorTimezonePublicTestSuite) TestProcessTimezoneUpdate() {
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			processor := agent.NewNodeProcessor(
+				context.Background(),
 				nil, nil, nil, nil, nil, nil,
 				tt.setupMock(),
 				nil, nil,
@@ -294,6 +314,14 @@ func (s *ProcessorTimezonePublicTestSuite) TestProcessTimezoneUpdate() {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 				config.Config{},
 				slog.Default(),
 			)
@@ -21,6 +21,7 @@
 package agent_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log/slog"
@@ -54,6 +55,7 @@ func (s *ProcessorUserPublicTestSuite) newProcessor(
 	userProvider user.Provider,
 ) agent.ProcessorFunc {
 	return agent.NewNodeProcessor(
+		context.Background(),
 		nil, nil, nil, nil,
 		nil, nil, nil, nil,
 		nil,
@@ -61,6 +63,14 @@ func (s *ProcessorUserPublicTestSuite) newProcessor(
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 		config.Config{},
 		slog.Default(),
 	)
@@ -45,8 +45,8 @@ import (
 	"github.com/osapi-io/osapi/internal/telemetry/process"
 )
 
-// NATSPublisher provides core NATS pub/sub for enrollment.
-// Satisfied by the nats-client's Client type.
+// NATSPublisher provides core NATS pub/sub for enrollment and streamed
+// job output. Satisfied by the nats-client's Client type.
 type NATSPublisher interface {
 	PublishCore(subject string, data []byte) error
 	Subscribe(subject string, handler nats.MsgHandler) (*nats.Subscription, error)
@@ -63,6 +63,24 @@ const (
 	PermCertificateWrite = client.PermCertificateWrite
 	PermServiceRead      = client.PermServiceRead
 	PermServiceWrite     = client.PermServiceWrite
+	PermFirewallRead     = client.PermFirewallRead
+	PermFirewallWrite    = client.PermFirewallWrite
+	PermMountRead        = client.PermMountRead
+	PermMountWrite       = client.PermMountWrite
+	PermBlockRead        = client.PermBlockRead
+	PermBlockWrite       = client.PermBlockWrite
+	PermSwapRead         = client.PermSwapRead
+	PermSwapWrite        = client.PermSwapWrite
+	PermKernelRead       = client.PermKernelRead
+	PermKernelWrite      = client.PermKernelWrite
+	PermHostsRead        = client.PermHostsRead
+	PermHostsWrite       = client.PermHostsWrite
+	PermTimerRead        = client.PermTimerRead
+	PermTimerWrite       = client.PermTimerWrite
+	PermSudoersRead      = client.PermSudoersRead
+	PermSudoersWrite     = client.PermSudoersWrite
+	PermSshdRead         = client.PermSshdRead
+	PermSshdWrite        = client.PermSshdWrite
 )
 
 // AllPermissions is the full set of known permissions.
@@ -103,6 +121,24 @@ var AllPermissions = []Permission{
 	PermCertificateWrite,
 	PermServiceRead,
 	PermServiceWrite,
+	PermFirewallRead,
+	PermFirewallWrite,
+	PermMountRead,
+	PermMountWrite,
+	PermBlockRead,
+	PermBlockWrite,
+	PermSwapRead,
+	PermSwapWrite,
+	PermKernelRead,
+	PermKernelWrite,
+	PermHostsRead,
+	PermHostsWrite,
+	PermTimerRead,
+	PermTimerWrite,
+	PermSudoersRead,
+	PermSudoersWrite,
+	PermSshdRead,
+	PermSshdWrite,
 }
 
 // DefaultRolePermissions maps built-in role names to their granted permissions.
@@ -144,6 +180,24 @@ var DefaultRolePermissions = map[string][]Permission{
 		PermCertificateWrite,
 		PermServiceRead,
 		PermServiceWrite,
+		PermFirewallRead,
+		PermFirewallWrite,
+		PermMountRead,
+		PermMountWrite,
+		PermBlockRead,
+		PermBlockWrite,
+		PermSwapRead,
+		PermSwapWrite,
+		PermKernelRead,
+		PermKernelWrite,
+		PermHostsRead,
+		PermHostsWrite,
+		PermTimerRead,
+		PermTimerWrite,
+		PermSudoersRead,
+		PermSudoersWrite,
+		PermSshdRead,
+		PermSshdWrite,
 	},
 	client.RoleWrite: {
 		PermAgentRead,
@@ -176,6 +230,24 @@ var DefaultRolePermissions = map[string][]Permission{
 		PermCertificateWrite,
 		PermServiceRead,
 		PermServiceWrite,
+		PermFirewallRead,
+		PermFirewallWrite,
+		PermMountRead,
+		PermMountWrite,
+		PermBlockRead,
+		PermBlockWrite,
+		PermSwapRead,
+		PermSwapWrite,
+		PermKernelRead,
+		PermKernelWrite,
+		PermHostsRead,
+		PermHostsWrite,
+		PermTimerRead,
+		PermTimerWrite,
+		PermSudoersRead,
+		PermSudoersWrite,
+		PermSshdRead,
+		PermSshdWrite,
 	},
 	client.RoleRead: {
 		PermAgentRead,
@@ -195,6 +267,15 @@ var DefaultRolePermissions = map[string][]Permission{
 		PermLogRead,
 		PermCertificateRead,
 		PermServiceRead,
+		PermFirewallRead,
+		PermMountRead,
+		PermBlockRead,
+		PermSwapRead,
+		PermKernelRead,
+		PermHostsRead,
+		PermTimerRead,
+		PermSudoersRead,
+		PermSshdRead,
 	},
 }
 
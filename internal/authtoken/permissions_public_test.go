@@ -59,6 +59,24 @@ func (s *PermissionsPublicTestSuite) TestResolvePermissions() {
 				authtoken.PermHealthRead,
 				authtoken.PermFileRead,
 				authtoken.PermFileWrite,
+				authtoken.PermFirewallRead,
+				authtoken.PermFirewallWrite,
+				authtoken.PermMountRead,
+				authtoken.PermMountWrite,
+				authtoken.PermBlockRead,
+				authtoken.PermBlockWrite,
+				authtoken.PermSwapRead,
+				authtoken.PermSwapWrite,
+				authtoken.PermKernelRead,
+				authtoken.PermKernelWrite,
+				authtoken.PermHostsRead,
+				authtoken.PermHostsWrite,
+				authtoken.PermTimerRead,
+				authtoken.PermTimerWrite,
+				authtoken.PermSudoersRead,
+				authtoken.PermSudoersWrite,
+				authtoken.PermSshdRead,
+				authtoken.PermSshdWrite,
 			},
 			expectMissing: []string{
 				authtoken.PermAuditRead,
@@ -73,12 +91,30 @@ func (s *PermissionsPublicTestSuite) TestResolvePermissions() {
 				authtoken.PermJobRead,
 				authtoken.PermHealthRead,
 				authtoken.PermFileRead,
+				authtoken.PermFirewallRead,
+				authtoken.PermMountRead,
+				authtoken.PermBlockRead,
+				authtoken.PermSwapRead,
+				authtoken.PermKernelRead,
+				authtoken.PermHostsRead,
+				authtoken.PermTimerRead,
+				authtoken.PermSudoersRead,
+				authtoken.PermSshdRead,
 			},
 			expectMissing: []string{
 				authtoken.PermNetworkWrite,
 				authtoken.PermJobWrite,
 				authtoken.PermAuditRead,
 				authtoken.PermFileWrite,
+				authtoken.PermFirewallWrite,
+				authtoken.PermMountWrite,
+				authtoken.PermBlockWrite,
+				authtoken.PermSwapWrite,
+				authtoken.PermKernelWrite,
+				authtoken.PermHostsWrite,
+				authtoken.PermTimerWrite,
+				authtoken.PermSudoersWrite,
+				authtoken.PermSshdWrite,
 			},
 		},
 		{
@@ -55,6 +55,21 @@ var supportedFamilies = []OSFamily{
 			"ubuntu": {"20.04", "22.04", "24.04"},
 		},
 	},
+	{
+		Name: "RedHat",
+		Distributions: map[string][]string{
+			"redhat":    {"8", "9"},
+			"rocky":     {"8", "9"},
+			"almalinux": {"8", "9"},
+			"fedora":    {"40", "41", "42"},
+		},
+	},
+	{
+		Name: "Alpine",
+		Distributions: map[string][]string{
+			"alpine": {"3.19", "3.20", "3.21"},
+		},
+	},
 }
 
 // IsOSFamilySupported checks if the given distribution and version belong
@@ -205,6 +205,34 @@ func (suite *ValidatePublicTestSuite) TestIsOSFamilySupported() {
 			wantFamily: "Debian",
 			wantOK:     true,
 		},
+		{
+			name:       "when rocky 9 point release is supported",
+			distro:     "rocky",
+			version:    "9.4",
+			wantFamily: "RedHat",
+			wantOK:     true,
+		},
+		{
+			name:       "when almalinux 8 is supported",
+			distro:     "almalinux",
+			version:    "8.10",
+			wantFamily: "RedHat",
+			wantOK:     true,
+		},
+		{
+			name:       "when fedora 41 is supported",
+			distro:     "fedora",
+			version:    "41",
+			wantFamily: "RedHat",
+			wantOK:     true,
+		},
+		{
+			name:       "when alpine 3.20 point release is supported",
+			distro:     "alpine",
+			version:    "3.20.3",
+			wantFamily: "Alpine",
+			wantOK:     true,
+		},
 		{
 			name:       "when unsupported distro returns false",
 			distro:     "centos",
@@ -375,6 +375,22 @@ type PrivilegeEscalation struct {
 	Enabled bool `mapstructure:"enabled"`
 }
 
+// AgentNetwork holds configuration for network changes made by the agent.
+type AgentNetwork struct {
+	// SafeApply configures confirm-or-revert for Netplan changes.
+	SafeApply SafeApply `mapstructure:"safe_apply,omitempty"`
+}
+
+// SafeApply configuration for confirm-or-revert network changes. When
+// enabled, a Netplan change that is not confirmed within Timeout is
+// rolled back to the previous configuration.
+type SafeApply struct {
+	// Enabled activates confirm-or-revert for Netplan changes.
+	Enabled bool `mapstructure:"enabled"`
+	// Timeout is how long the agent waits for confirmation.
+	Timeout string `mapstructure:"timeout" validate:"omitempty,go_duration"` // e.g. "60s", "2m"
+}
+
 // AgentPKI holds PKI configuration for the agent.
 type AgentPKI struct {
 	// Enabled activates PKI enrollment and job signature verification.
@@ -420,6 +436,8 @@ type AgentConfig struct {
 	ProcessConditions ProcessConditions `mapstructure:"process_conditions,omitempty"`
 	// PrivilegeEscalation configures least-privilege agent mode.
 	PrivilegeEscalation PrivilegeEscalation `mapstructure:"privilege_escalation,omitempty"`
+	// Network holds settings for agent-side network changes.
+	Network AgentNetwork `mapstructure:"network,omitempty"`
 	// PKI holds PKI enrollment and signing settings.
 	PKI     AgentPKI      `mapstructure:"pki,omitempty"`
 	Metrics MetricsServer `mapstructure:"metrics"`
@@ -207,6 +207,11 @@ func setNetwork(
 		info.PrimaryInterface = &pi
 	}
 
+	if a.NetworkRenderer != "" {
+		nr := a.NetworkRenderer
+		info.NetworkRenderer = &nr
+	}
+
 	if len(a.Routes) > 0 {
 		routes := make([]gen.RouteResponse, len(a.Routes))
 		for i, r := range a.Routes {
@@ -189,6 +189,7 @@ func (s *AgentListPublicTestSuite) TestListAgents() {
 				{
 					Hostname:         "server1",
 					PrimaryInterface: "eth0",
+					NetworkRenderer:  "networkd",
 					Routes: []jobtypes.Route{
 						{
 							Destination: "0.0.0.0",
@@ -214,6 +215,8 @@ func (s *AgentListPublicTestSuite) TestListAgents() {
 				a := r.Agents[0]
 				s.Require().NotNil(a.PrimaryInterface)
 				s.Equal("eth0", *a.PrimaryInterface)
+				s.Require().NotNil(a.NetworkRenderer)
+				s.Equal("networkd", *a.NetworkRenderer)
 				s.Require().NotNil(a.Routes)
 				s.Len(*a.Routes, 2)
 				route0 := (*a.Routes)[0]
@@ -87,6 +87,9 @@ type AgentInfo struct {
 	// Memory Memory usage information.
 	Memory *MemoryResponse `json:"memory,omitempty"`
 
+	// NetworkRenderer Backend managing network configuration.
+	NetworkRenderer *string `json:"network_renderer,omitempty"`
+
 	// OsInfo Operating system information.
 	OsInfo *OSInfoResponse `json:"os_info,omitempty"`
 
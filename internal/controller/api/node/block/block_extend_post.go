@@ -0,0 +1,186 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package block
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/block/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	blockProv "github.com/osapi-io/osapi/internal/provider/node/block"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// PostNodeBlockExtend grows a logical volume on a target node.
+func (s *Block) PostNodeBlockExtend(
+	ctx context.Context,
+	request gen.PostNodeBlockExtendRequestObject,
+) (gen.PostNodeBlockExtendResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.PostNodeBlockExtend400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Body); !ok {
+		return gen.PostNodeBlockExtend400JSONResponse{Error: &errMsg}, nil
+	}
+
+	opts := blockProv.ExtendOpts{
+		VolumeGroup:   request.Body.VolumeGroup,
+		LogicalVolume: request.Body.LogicalVolume,
+		ResizeFS:      true,
+	}
+	if request.Body.Size != nil {
+		opts.Size = *request.Body.Size
+	}
+	if request.Body.ResizeFs != nil {
+		opts.ResizeFS = *request.Body.ResizeFs
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"block extend",
+		slog.String("target", hostname),
+		slog.String("volume_group", opts.VolumeGroup),
+		slog.String("logical_volume", opts.LogicalVolume),
+		slog.String("size", opts.Size),
+		slog.Bool("resize_fs", opts.ResizeFS),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.postNodeBlockExtendBroadcast(ctx, hostname, opts)
+	}
+
+	jobID, resp, err := s.JobClient.Modify(
+		ctx,
+		hostname,
+		"node",
+		job.OperationBlockExtend,
+		opts,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "not found") || strings.Contains(errMsg, "does not exist") {
+			return gen.PostNodeBlockExtend404JSONResponse{Error: &errMsg}, nil
+		}
+		return gen.PostNodeBlockExtend500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		jobUUID := uuid.MustParse(jobID)
+		e := resp.Error
+		return gen.PostNodeBlockExtend200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.BlockExtendEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.BlockExtendEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	var result blockProv.ExtendResult
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &result)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	changed := resp.Changed
+	name := result.Name
+	size := result.Size
+	agentHostname := resp.Hostname
+
+	return gen.PostNodeBlockExtend200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.BlockExtendEntry{
+			{
+				Hostname: agentHostname,
+				Status:   gen.BlockExtendEntryStatusOk,
+				Name:     &name,
+				Size:     &size,
+				Changed:  changed,
+			},
+		},
+	}, nil
+}
+
+// postNodeBlockExtendBroadcast handles broadcast targets for block extend.
+func (s *Block) postNodeBlockExtendBroadcast(
+	ctx context.Context,
+	target string,
+	opts blockProv.ExtendOpts,
+) (gen.PostNodeBlockExtendResponseObject, error) {
+	jobID, responses, err := s.JobClient.ModifyBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationBlockExtend,
+		opts,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PostNodeBlockExtend500JSONResponse{Error: &errMsg}, nil
+	}
+
+	var apiResponses []gen.BlockExtendEntry
+	for host, resp := range responses {
+		item := gen.BlockExtendEntry{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			item.Status = gen.BlockExtendEntryStatusFailed
+			e := resp.Error
+			item.Error = &e
+		case job.StatusSkipped:
+			item.Status = gen.BlockExtendEntryStatusSkipped
+			e := resp.Error
+			item.Error = &e
+		default:
+			item.Status = gen.BlockExtendEntryStatusOk
+			var result blockProv.ExtendResult
+			if resp.Data != nil {
+				_ = json.Unmarshal(resp.Data, &result)
+			}
+			name := result.Name
+			size := result.Size
+			item.Name = &name
+			item.Size = &size
+			item.Changed = resp.Changed
+		}
+		apiResponses = append(apiResponses, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.PostNodeBlockExtend200JSONResponse{
+		JobId:   &jobUUID,
+		Results: apiResponses,
+	}, nil
+}
@@ -0,0 +1,774 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package block_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	apiblock "github.com/osapi-io/osapi/internal/controller/api/node/block"
+	"github.com/osapi-io/osapi/internal/controller/api/node/block/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	blockProv "github.com/osapi-io/osapi/internal/provider/node/block"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type BlockExtendPostPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *apiblock.Block
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *BlockExtendPostPublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *BlockExtendPostPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = apiblock.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *BlockExtendPostPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *BlockExtendPostPublicTestSuite) TestPostNodeBlockExtend() {
+	tests := []struct {
+		name         string
+		request      gen.PostNodeBlockExtendRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.PostNodeBlockExtendResponseObject)
+	}{
+		{
+			name: "success",
+			request: gen.PostNodeBlockExtendRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeBlockExtendJSONRequestBody{
+					VolumeGroup:   "vg0",
+					LogicalVolume: "root",
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationBlockExtend,
+						gomock.Any(),
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"vg0/root","size":21474836480,"changed":true}`),
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PostNodeBlockExtendResponseObject) {
+				r, ok := resp.(gen.PostNodeBlockExtend200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("agent1", r.Results[0].Hostname)
+				s.Require().NotNil(r.Results[0].Changed)
+				s.True(*r.Results[0].Changed)
+				s.Equal("vg0/root", *r.Results[0].Name)
+				s.Equal(int64(21474836480), *r.Results[0].Size)
+			},
+		},
+		{
+			name: "success with nil response data",
+			request: gen.PostNodeBlockExtendRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeBlockExtendJSONRequestBody{
+					VolumeGroup:   "vg0",
+					LogicalVolume: "root",
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationBlockExtend,
+						gomock.Any(),
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(true),
+							Data:     nil,
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PostNodeBlockExtendResponseObject) {
+				r, ok := resp.(gen.PostNodeBlockExtend200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("", *r.Results[0].Name)
+			},
+		},
+		{
+			name: "validation error empty hostname",
+			request: gen.PostNodeBlockExtendRequestObject{
+				Hostname: "",
+				Body: &gen.PostNodeBlockExtendJSONRequestBody{
+					VolumeGroup:   "vg0",
+					LogicalVolume: "root",
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodeBlockExtendResponseObject) {
+				r, ok := resp.(gen.PostNodeBlockExtend400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "required")
+			},
+		},
+		{
+			name: "body validation error empty volume group",
+			request: gen.PostNodeBlockExtendRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeBlockExtendJSONRequestBody{
+					VolumeGroup:   "",
+					LogicalVolume: "root",
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodeBlockExtendResponseObject) {
+				r, ok := resp.(gen.PostNodeBlockExtend400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+			},
+		},
+		{
+			name: "body validation error empty logical volume",
+			request: gen.PostNodeBlockExtendRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeBlockExtendJSONRequestBody{
+					VolumeGroup:   "vg0",
+					LogicalVolume: "",
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodeBlockExtendResponseObject) {
+				r, ok := resp.(gen.PostNodeBlockExtend400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.PostNodeBlockExtendRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeBlockExtendJSONRequestBody{
+					VolumeGroup:   "vg0",
+					LogicalVolume: "root",
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationBlockExtend,
+						gomock.Any(),
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							Status:   job.StatusSkipped,
+							Hostname: "server1",
+							Error:    "block: operation not supported on this OS family",
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PostNodeBlockExtendResponseObject) {
+				r, ok := resp.(gen.PostNodeBlockExtend200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("server1", r.Results[0].Hostname)
+				s.Equal(gen.BlockExtendEntryStatusSkipped, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "success passes size and resize fs to agent",
+			request: gen.PostNodeBlockExtendRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeBlockExtendJSONRequestBody{
+					VolumeGroup:   "vg0",
+					LogicalVolume: "root",
+					Size:          strPtr("+10G"),
+					ResizeFs:      boolPtr(false),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationBlockExtend,
+						blockProv.ExtendOpts{
+							VolumeGroup:   "vg0",
+							LogicalVolume: "root",
+							Size:          "+10G",
+							ResizeFS:      false,
+						},
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(false),
+							Data:     json.RawMessage(`{"name":"vg0/root","size":10737418240,"changed":false}`),
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PostNodeBlockExtendResponseObject) {
+				r, ok := resp.(gen.PostNodeBlockExtend200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Require().NotNil(r.Results[0].Changed)
+				s.False(*r.Results[0].Changed)
+			},
+		},
+		{
+			name: "success defaults to growing filesystem",
+			request: gen.PostNodeBlockExtendRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeBlockExtendJSONRequestBody{
+					VolumeGroup:   "vg0",
+					LogicalVolume: "root",
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationBlockExtend,
+						blockProv.ExtendOpts{
+							VolumeGroup:   "vg0",
+							LogicalVolume: "root",
+							ResizeFS:      true,
+						},
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"vg0/root","size":21474836480,"changed":true}`),
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PostNodeBlockExtendResponseObject) {
+				_, ok := resp.(gen.PostNodeBlockExtend200JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "logical volume not found",
+			request: gen.PostNodeBlockExtendRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeBlockExtendJSONRequestBody{
+					VolumeGroup:   "vg0",
+					LogicalVolume: "missing",
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationBlockExtend,
+						gomock.Any(),
+					).
+					Return("", nil, errors.New(`logical volume "vg0/missing": not found`))
+			},
+			validateFunc: func(resp gen.PostNodeBlockExtendResponseObject) {
+				r, ok := resp.(gen.PostNodeBlockExtend404JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "not found")
+			},
+		},
+		{
+			name: "job client error",
+			request: gen.PostNodeBlockExtendRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeBlockExtendJSONRequestBody{
+					VolumeGroup:   "vg0",
+					LogicalVolume: "root",
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationBlockExtend,
+						gomock.Any(),
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PostNodeBlockExtendResponseObject) {
+				_, ok := resp.(gen.PostNodeBlockExtend500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "broadcast success",
+			request: gen.PostNodeBlockExtendRequestObject{
+				Hostname: "_all",
+				Body: &gen.PostNodeBlockExtendJSONRequestBody{
+					VolumeGroup:   "vg0",
+					LogicalVolume: "root",
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationBlockExtend,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "server1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"vg0/root","size":21474836480,"changed":true}`),
+						},
+						"server2": {
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "server2",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"vg0/root","size":21474836480,"changed":true}`),
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeBlockExtendResponseObject) {
+				r, ok := resp.(gen.PostNodeBlockExtend200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Len(r.Results, 2)
+			},
+		},
+		{
+			name: "broadcast with nil response data",
+			request: gen.PostNodeBlockExtendRequestObject{
+				Hostname: "_all",
+				Body: &gen.PostNodeBlockExtendJSONRequestBody{
+					VolumeGroup:   "vg0",
+					LogicalVolume: "root",
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationBlockExtend,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "server1",
+							Changed:  boolPtr(true),
+							Data:     nil,
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeBlockExtendResponseObject) {
+				r, ok := resp.(gen.PostNodeBlockExtend200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("", *r.Results[0].Name)
+			},
+		},
+		{
+			name: "broadcast with failed host",
+			request: gen.PostNodeBlockExtendRequestObject{
+				Hostname: "_all",
+				Body: &gen.PostNodeBlockExtendJSONRequestBody{
+					VolumeGroup:   "vg0",
+					LogicalVolume: "root",
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationBlockExtend,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusFailed,
+							Error:    "agent unreachable",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeBlockExtendResponseObject) {
+				r, ok := resp.(gen.PostNodeBlockExtend200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.BlockExtendEntryStatusFailed, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "unreachable")
+			},
+		},
+		{
+			name: "broadcast with skipped host",
+			request: gen.PostNodeBlockExtendRequestObject{
+				Hostname: "_all",
+				Body: &gen.PostNodeBlockExtendJSONRequestBody{
+					VolumeGroup:   "vg0",
+					LogicalVolume: "root",
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationBlockExtend,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusSkipped,
+							Error:    "block: operation not supported on this OS family",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeBlockExtendResponseObject) {
+				r, ok := resp.(gen.PostNodeBlockExtend200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.BlockExtendEntryStatusSkipped, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "broadcast error collecting responses",
+			request: gen.PostNodeBlockExtendRequestObject{
+				Hostname: "_all",
+				Body: &gen.PostNodeBlockExtendJSONRequestBody{
+					VolumeGroup:   "vg0",
+					LogicalVolume: "root",
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationBlockExtend,
+						gomock.Any(),
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PostNodeBlockExtendResponseObject) {
+				_, ok := resp.(gen.PostNodeBlockExtend500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			resp, err := s.handler.PostNodeBlockExtend(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+func (s *BlockExtendPostPublicTestSuite) TestPostNodeBlockExtendValidationHTTP() {
+	tests := []struct {
+		name         string
+		path         string
+		body         string
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when valid request",
+			path: "/api/node/server1/block/extend",
+			body: `{"volume_group":"vg0","logical_volume":"root"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationBlockExtend, gomock.Any()).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"vg0/root","size":21474836480,"changed":true}`),
+						},
+						nil,
+					)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+		{
+			name: "when target agent not found",
+			path: "/api/node/nonexistent/block/extend",
+			body: `{"volume_group":"vg0","logical_volume":"root"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "valid_target"},
+		},
+		{
+			name: "when invalid body empty volume group",
+			path: "/api/node/server1/block/extend",
+			body: `{"volume_group":"","logical_volume":"root"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			blockHandler := apiblock.New(s.logger, jobMock)
+			strictHandler := gen.NewStrictHandler(blockHandler, nil)
+
+			a := api.New(s.appConfig, s.logger)
+			gen.RegisterHandlers(a.Echo, strictHandler)
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				tc.path,
+				strings.NewReader(tc.body),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			a.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+const rbacBlockExtendTestSigningKey = "test-signing-key-for-rbac-block-extend"
+
+func (s *BlockExtendPostPublicTestSuite) TestPostNodeBlockExtendRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when no token returns 401",
+			setupAuth: func(_ *http.Request) {
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusUnauthorized,
+			wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacBlockExtendTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"docker:write"},
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid admin token returns 200",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacBlockExtendTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationBlockExtend, gomock.Any()).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"vg0/root","size":21474836480,"changed":true}`),
+						},
+						nil,
+					)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{
+							SigningKey: rbacBlockExtendTestSigningKey,
+						},
+					},
+				},
+			}
+
+			server := api.New(appConfig, s.logger)
+			handlers := apiblock.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/api/node/server1/block/extend",
+				strings.NewReader(`{"volume_group":"vg0","logical_volume":"root"}`),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+
+			server.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestBlockExtendPostPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(BlockExtendPostPublicTestSuite))
+}
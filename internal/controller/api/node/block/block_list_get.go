@@ -0,0 +1,241 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package block
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/block/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	blockProv "github.com/osapi-io/osapi/internal/provider/node/block"
+)
+
+// GetNodeBlock returns the block device and LVM inventory of a target node.
+func (s *Block) GetNodeBlock(
+	ctx context.Context,
+	request gen.GetNodeBlockRequestObject,
+) (gen.GetNodeBlockResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeBlock400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"block list",
+		slog.String("target", hostname),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeBlockBroadcast(ctx, hostname)
+	}
+
+	jobID, resp, err := s.JobClient.Query(
+		ctx,
+		hostname,
+		"node",
+		job.OperationBlockList,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeBlock500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeBlock200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.BlockListEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.BlockListEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	results := responseToBlockListEntries(resp)
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeBlock200JSONResponse{
+		JobId:   &jobUUID,
+		Results: results,
+	}, nil
+}
+
+// getNodeBlockBroadcast handles broadcast targets for block list.
+func (s *Block) getNodeBlockBroadcast(
+	ctx context.Context,
+	target string,
+) (gen.GetNodeBlockResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationBlockList,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeBlock500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.BlockListEntry, 0)
+	for host, resp := range responses {
+		switch resp.Status {
+		case job.StatusFailed:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.BlockListEntry{
+				Hostname: h,
+				Status:   gen.BlockListEntryStatusFailed,
+				Error:    &e,
+			})
+		case job.StatusSkipped:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.BlockListEntry{
+				Hostname: h,
+				Status:   gen.BlockListEntryStatusSkipped,
+				Error:    &e,
+			})
+		default:
+			allResults = append(allResults, responseToBlockListEntries(resp)...)
+		}
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeBlock200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
+
+// responseToBlockListEntries converts a job response to gen BlockListEntry slice.
+func responseToBlockListEntries(
+	resp *job.Response,
+) []gen.BlockListEntry {
+	var inv blockProv.Inventory
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &inv)
+	}
+
+	devices := blockDevicesToGen(inv.Devices)
+
+	pvs := make([]gen.BlockPhysicalVolume, 0, len(inv.PhysicalVolumes))
+	for _, pv := range inv.PhysicalVolumes {
+		pvs = append(pvs, gen.BlockPhysicalVolume{
+			Name:        strPtrOrNil(pv.Name),
+			VolumeGroup: strPtrOrNil(pv.VolumeGroup),
+			Size:        &pv.Size,
+			Free:        &pv.Free,
+		})
+	}
+
+	vgs := make([]gen.BlockVolumeGroup, 0, len(inv.VolumeGroups))
+	for _, vg := range inv.VolumeGroups {
+		vgs = append(vgs, gen.BlockVolumeGroup{
+			Name:        strPtrOrNil(vg.Name),
+			Size:        &vg.Size,
+			Free:        &vg.Free,
+			ExtentSize:  &vg.ExtentSize,
+			FreeExtents: &vg.FreeExtents,
+			PvCount:     &vg.PVCount,
+			LvCount:     &vg.LVCount,
+		})
+	}
+
+	lvs := make([]gen.BlockLogicalVolume, 0, len(inv.LogicalVolumes))
+	for _, lv := range inv.LogicalVolumes {
+		lvs = append(lvs, gen.BlockLogicalVolume{
+			Name:        strPtrOrNil(lv.Name),
+			VolumeGroup: strPtrOrNil(lv.VolumeGroup),
+			Path:        strPtrOrNil(lv.Path),
+			Size:        &lv.Size,
+			Attr:        strPtrOrNil(lv.Attr),
+		})
+	}
+
+	return []gen.BlockListEntry{
+		{
+			Hostname:        resp.Hostname,
+			Status:          gen.BlockListEntryStatusOk,
+			Devices:         &devices,
+			PhysicalVolumes: &pvs,
+			VolumeGroups:    &vgs,
+			LogicalVolumes:  &lvs,
+		},
+	}
+}
+
+// blockDevicesToGen converts provider devices to gen BlockDevice values,
+// recursing into their children.
+func blockDevicesToGen(
+	devices []blockProv.Device,
+) []gen.BlockDevice {
+	result := make([]gen.BlockDevice, 0, len(devices))
+	for _, dev := range devices {
+		d := gen.BlockDevice{
+			Name:       strPtrOrNil(dev.Name),
+			Path:       strPtrOrNil(dev.Path),
+			Type:       strPtrOrNil(dev.Type),
+			FsType:     strPtrOrNil(dev.FSType),
+			Uuid:       strPtrOrNil(dev.UUID),
+			Label:      strPtrOrNil(dev.Label),
+			MountPoint: strPtrOrNil(dev.MountPoint),
+			Model:      strPtrOrNil(dev.Model),
+		}
+
+		size := dev.Size
+		d.Size = &size
+		readOnly := dev.ReadOnly
+		d.ReadOnly = &readOnly
+
+		if len(dev.Children) > 0 {
+			children := blockDevicesToGen(dev.Children)
+			d.Children = &children
+		}
+
+		result = append(result, d)
+	}
+
+	return result
+}
+
+// strPtrOrNil returns a pointer to s, or nil when s is empty.
+func strPtrOrNil(
+	s string,
+) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
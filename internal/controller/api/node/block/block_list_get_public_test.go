@@ -0,0 +1,564 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package block_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	apiblock "github.com/osapi-io/osapi/internal/controller/api/node/block"
+	"github.com/osapi-io/osapi/internal/controller/api/node/block/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type BlockListGetPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *apiblock.Block
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *BlockListGetPublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *BlockListGetPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = apiblock.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *BlockListGetPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *BlockListGetPublicTestSuite) TestGetNodeBlock() {
+	tests := []struct {
+		name         string
+		request      gen.GetNodeBlockRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.GetNodeBlockResponseObject)
+	}{
+		{
+			name: "success",
+			request: gen.GetNodeBlockRequestObject{
+				Hostname: "server1",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationBlockList,
+						nil,
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Data: json.RawMessage(`{
+								"devices":[{"name":"sda","path":"/dev/sda","type":"disk","size":21474836480,"model":"QEMU HARDDISK","read_only":false,"children":[
+									{"name":"sda1","path":"/dev/sda1","type":"part","size":1073741824,"fs_type":"ext4","uuid":"b1c2","mount_point":"/boot","read_only":false},
+									{"name":"sda2","path":"/dev/sda2","type":"part","size":20400046080,"fs_type":"LVM2_member","read_only":false}
+								]}],
+								"physical_volumes":[{"name":"/dev/sda2","volume_group":"vg0","size":20400046080,"free":9663676416}],
+								"volume_groups":[{"name":"vg0","size":20400046080,"free":9663676416,"extent_size":4194304,"free_extents":2304,"pv_count":1,"lv_count":1}],
+								"logical_volumes":[{"name":"root","volume_group":"vg0","path":"/dev/vg0/root","size":10737418240,"attr":"-wi-ao----"}]
+							}`),
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.GetNodeBlockResponseObject) {
+				r, ok := resp.(gen.GetNodeBlock200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("agent1", r.Results[0].Hostname)
+				s.Equal(gen.BlockListEntryStatusOk, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Devices)
+				s.Require().Len(*r.Results[0].Devices, 1)
+				disk := (*r.Results[0].Devices)[0]
+				s.Equal("sda", *disk.Name)
+				s.Nil(disk.FsType)
+				s.Require().NotNil(disk.Children)
+				s.Len(*disk.Children, 2)
+				s.Equal("/boot", *(*disk.Children)[0].MountPoint)
+				s.Nil((*disk.Children)[1].Children)
+				s.Require().NotNil(r.Results[0].PhysicalVolumes)
+				s.Equal("vg0", *(*r.Results[0].PhysicalVolumes)[0].VolumeGroup)
+				s.Require().NotNil(r.Results[0].VolumeGroups)
+				s.Equal(int64(9663676416), *(*r.Results[0].VolumeGroups)[0].Free)
+				s.Equal(int64(2304), *(*r.Results[0].VolumeGroups)[0].FreeExtents)
+				s.Require().NotNil(r.Results[0].LogicalVolumes)
+				s.Equal("/dev/vg0/root", *(*r.Results[0].LogicalVolumes)[0].Path)
+			},
+		},
+		{
+			name: "success with nil response data",
+			request: gen.GetNodeBlockRequestObject{
+				Hostname: "server1",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationBlockList,
+						nil,
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Data:     nil,
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.GetNodeBlockResponseObject) {
+				r, ok := resp.(gen.GetNodeBlock200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Require().NotNil(r.Results[0].Devices)
+				s.Empty(*r.Results[0].Devices)
+				s.Require().NotNil(r.Results[0].LogicalVolumes)
+				s.Empty(*r.Results[0].LogicalVolumes)
+			},
+		},
+		{
+			name: "validation error empty hostname",
+			request: gen.GetNodeBlockRequestObject{
+				Hostname: "",
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.GetNodeBlockResponseObject) {
+				_, ok := resp.(gen.GetNodeBlock400JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.GetNodeBlockRequestObject{
+				Hostname: "server1",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationBlockList,
+						nil,
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							Status:   job.StatusSkipped,
+							Hostname: "server1",
+							Error:    "block: operation not supported on this OS family",
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.GetNodeBlockResponseObject) {
+				r, ok := resp.(gen.GetNodeBlock200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("server1", r.Results[0].Hostname)
+				s.Equal(gen.BlockListEntryStatusSkipped, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "job client error",
+			request: gen.GetNodeBlockRequestObject{
+				Hostname: "server1",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationBlockList,
+						nil,
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.GetNodeBlockResponseObject) {
+				_, ok := resp.(gen.GetNodeBlock500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "broadcast success",
+			request: gen.GetNodeBlockRequestObject{
+				Hostname: "_all",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationBlockList,
+						nil,
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "server1",
+							Data: json.RawMessage(
+								`{"devices":[{"name":"vda","path":"/dev/vda","type":"disk","size":10737418240}]}`,
+							),
+						},
+						"server2": {
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "server2",
+							Data: json.RawMessage(
+								`{"devices":[{"name":"sda","path":"/dev/sda","type":"disk","size":21474836480}]}`,
+							),
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeBlockResponseObject) {
+				r, ok := resp.(gen.GetNodeBlock200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Len(r.Results, 2)
+			},
+		},
+		{
+			name: "broadcast with failed host",
+			request: gen.GetNodeBlockRequestObject{
+				Hostname: "_all",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationBlockList,
+						nil,
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusFailed,
+							Error:    "agent unreachable",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeBlockResponseObject) {
+				r, ok := resp.(gen.GetNodeBlock200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.BlockListEntryStatusFailed, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "unreachable")
+			},
+		},
+		{
+			name: "broadcast with skipped host",
+			request: gen.GetNodeBlockRequestObject{
+				Hostname: "_all",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationBlockList,
+						nil,
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusSkipped,
+							Error:    "block: operation not supported on this OS family",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeBlockResponseObject) {
+				r, ok := resp.(gen.GetNodeBlock200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.BlockListEntryStatusSkipped, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "broadcast error collecting responses",
+			request: gen.GetNodeBlockRequestObject{
+				Hostname: "_all",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationBlockList,
+						nil,
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.GetNodeBlockResponseObject) {
+				_, ok := resp.(gen.GetNodeBlock500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			resp, err := s.handler.GetNodeBlock(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+func (s *BlockListGetPublicTestSuite) TestGetNodeBlockValidationHTTP() {
+	tests := []struct {
+		name         string
+		path         string
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when valid request",
+			path: "/api/node/server1/block",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Query(gomock.Any(), "server1", "node", job.OperationBlockList, nil).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Data:     json.RawMessage(`{}`),
+						},
+						nil,
+					)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+		{
+			name: "when target agent not found",
+			path: "/api/node/nonexistent/block",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "valid_target"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			blockHandler := apiblock.New(s.logger, jobMock)
+			strictHandler := gen.NewStrictHandler(blockHandler, nil)
+
+			a := api.New(s.appConfig, s.logger)
+			gen.RegisterHandlers(a.Echo, strictHandler)
+
+			req := httptest.NewRequest(
+				http.MethodGet,
+				tc.path,
+				nil,
+			)
+			rec := httptest.NewRecorder()
+
+			a.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+const rbacBlockListTestSigningKey = "test-signing-key-for-rbac-block-list"
+
+func (s *BlockListGetPublicTestSuite) TestGetNodeBlockRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when no token returns 401",
+			setupAuth: func(_ *http.Request) {
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusUnauthorized,
+			wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacBlockListTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"docker:write"},
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid admin token returns 200",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacBlockListTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Query(gomock.Any(), "server1", "node", job.OperationBlockList, nil).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Data:     json.RawMessage(`{}`),
+						},
+						nil,
+					)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{
+							SigningKey: rbacBlockListTestSigningKey,
+						},
+					},
+				},
+			}
+
+			server := api.New(appConfig, s.logger)
+			handlers := apiblock.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+
+			req := httptest.NewRequest(
+				http.MethodGet,
+				"/api/node/server1/block",
+				nil,
+			)
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+
+			server.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestBlockListGetPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(BlockListGetPublicTestSuite))
+}
@@ -0,0 +1,662 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hosts_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	apihosts "github.com/osapi-io/osapi/internal/controller/api/node/hosts"
+	"github.com/osapi-io/osapi/internal/controller/api/node/hosts/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type HostsCreatePostPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *apihosts.Hosts
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *HostsCreatePostPublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *HostsCreatePostPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = apihosts.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *HostsCreatePostPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *HostsCreatePostPublicTestSuite) TestPostNodeHosts() {
+	tests := []struct {
+		name         string
+		request      gen.PostNodeHostsRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.PostNodeHostsResponseObject)
+	}{
+		{
+			name: "success",
+			request: gen.PostNodeHostsRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeHostsJSONRequestBody{
+					Address:   "10.0.0.5",
+					Hostnames: []string{"db01.internal", "db01"},
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationHostsCreate,
+						gomock.Any(),
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"address":"10.0.0.5","changed":true}`),
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PostNodeHostsResponseObject) {
+				r, ok := resp.(gen.PostNodeHosts200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("agent1", r.Results[0].Hostname)
+				s.Require().NotNil(r.Results[0].Changed)
+				s.True(*r.Results[0].Changed)
+				s.Equal("10.0.0.5", *r.Results[0].Address)
+			},
+		},
+		{
+			name: "success with nil response data",
+			request: gen.PostNodeHostsRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeHostsJSONRequestBody{
+					Address:   "10.0.0.5",
+					Hostnames: []string{"db01.internal", "db01"},
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationHostsCreate,
+						gomock.Any(),
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(true),
+							Data:     nil,
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PostNodeHostsResponseObject) {
+				r, ok := resp.(gen.PostNodeHosts200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("", *r.Results[0].Address)
+			},
+		},
+		{
+			name: "validation error empty hostname",
+			request: gen.PostNodeHostsRequestObject{
+				Hostname: "",
+				Body: &gen.PostNodeHostsJSONRequestBody{
+					Address:   "10.0.0.5",
+					Hostnames: []string{"db01.internal", "db01"},
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodeHostsResponseObject) {
+				r, ok := resp.(gen.PostNodeHosts400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "required")
+			},
+		},
+		{
+			name: "body validation error empty address",
+			request: gen.PostNodeHostsRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeHostsJSONRequestBody{
+					Address:   "",
+					Hostnames: []string{"db01"},
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodeHostsResponseObject) {
+				r, ok := resp.(gen.PostNodeHosts400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+			},
+		},
+		{
+			name: "body validation error invalid hostname",
+			request: gen.PostNodeHostsRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeHostsJSONRequestBody{
+					Address:   "10.0.0.5",
+					Hostnames: []string{"-db01"},
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodeHostsResponseObject) {
+				r, ok := resp.(gen.PostNodeHosts400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.PostNodeHostsRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeHostsJSONRequestBody{
+					Address:   "10.0.0.5",
+					Hostnames: []string{"db01.internal", "db01"},
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationHostsCreate,
+						gomock.Any(),
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							Status:   job.StatusSkipped,
+							Hostname: "server1",
+							Error:    "hosts: operation not supported on this OS family",
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PostNodeHostsResponseObject) {
+				r, ok := resp.(gen.PostNodeHosts200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("server1", r.Results[0].Hostname)
+				s.Equal(gen.HostEntryMutationEntryStatusSkipped, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "job client error",
+			request: gen.PostNodeHostsRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeHostsJSONRequestBody{
+					Address:   "10.0.0.5",
+					Hostnames: []string{"db01.internal", "db01"},
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationHostsCreate,
+						gomock.Any(),
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PostNodeHostsResponseObject) {
+				_, ok := resp.(gen.PostNodeHosts500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "broadcast success",
+			request: gen.PostNodeHostsRequestObject{
+				Hostname: "_all",
+				Body: &gen.PostNodeHostsJSONRequestBody{
+					Address:   "10.0.0.5",
+					Hostnames: []string{"db01.internal", "db01"},
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationHostsCreate,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "server1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"address":"10.0.0.5","changed":true}`),
+						},
+						"server2": {
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "server2",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"address":"10.0.0.5","changed":true}`),
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeHostsResponseObject) {
+				r, ok := resp.(gen.PostNodeHosts200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Len(r.Results, 2)
+			},
+		},
+		{
+			name: "broadcast with nil response data",
+			request: gen.PostNodeHostsRequestObject{
+				Hostname: "_all",
+				Body: &gen.PostNodeHostsJSONRequestBody{
+					Address:   "10.0.0.5",
+					Hostnames: []string{"db01.internal", "db01"},
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationHostsCreate,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "server1",
+							Changed:  boolPtr(true),
+							Data:     nil,
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeHostsResponseObject) {
+				r, ok := resp.(gen.PostNodeHosts200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("", *r.Results[0].Address)
+			},
+		},
+		{
+			name: "broadcast with failed host",
+			request: gen.PostNodeHostsRequestObject{
+				Hostname: "_all",
+				Body: &gen.PostNodeHostsJSONRequestBody{
+					Address:   "10.0.0.5",
+					Hostnames: []string{"db01.internal", "db01"},
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationHostsCreate,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusFailed,
+							Error:    "agent unreachable",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeHostsResponseObject) {
+				r, ok := resp.(gen.PostNodeHosts200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.HostEntryMutationEntryStatusFailed, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "unreachable")
+			},
+		},
+		{
+			name: "broadcast with skipped host",
+			request: gen.PostNodeHostsRequestObject{
+				Hostname: "_all",
+				Body: &gen.PostNodeHostsJSONRequestBody{
+					Address:   "10.0.0.5",
+					Hostnames: []string{"db01.internal", "db01"},
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationHostsCreate,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusSkipped,
+							Error:    "hosts: operation not supported on this OS family",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeHostsResponseObject) {
+				r, ok := resp.(gen.PostNodeHosts200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.HostEntryMutationEntryStatusSkipped, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "broadcast error collecting responses",
+			request: gen.PostNodeHostsRequestObject{
+				Hostname: "_all",
+				Body: &gen.PostNodeHostsJSONRequestBody{
+					Address:   "10.0.0.5",
+					Hostnames: []string{"db01.internal", "db01"},
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationHostsCreate,
+						gomock.Any(),
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PostNodeHostsResponseObject) {
+				_, ok := resp.(gen.PostNodeHosts500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			resp, err := s.handler.PostNodeHosts(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+func (s *HostsCreatePostPublicTestSuite) TestPostNodeHostsValidationHTTP() {
+	tests := []struct {
+		name         string
+		path         string
+		body         string
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when valid request",
+			path: "/api/node/server1/hosts",
+			body: `{"address":"10.0.0.5","hostnames":["db01.internal","db01"]}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationHostsCreate, gomock.Any()).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"address":"10.0.0.5","changed":true}`),
+						},
+						nil,
+					)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+		{
+			name: "when target agent not found",
+			path: "/api/node/nonexistent/hosts",
+			body: `{"address":"10.0.0.5","hostnames":["db01.internal","db01"]}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "valid_target"},
+		},
+		{
+			name: "when invalid body empty name",
+			path: "/api/node/server1/hosts",
+			body: `{"address":"","hostnames":["db01"]}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			hostsHandler := apihosts.New(s.logger, jobMock)
+			strictHandler := gen.NewStrictHandler(hostsHandler, nil)
+
+			a := api.New(s.appConfig, s.logger)
+			gen.RegisterHandlers(a.Echo, strictHandler)
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				tc.path,
+				strings.NewReader(tc.body),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			a.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+const rbacHostsCreateTestSigningKey = "test-signing-key-for-rbac-hosts-create"
+
+func (s *HostsCreatePostPublicTestSuite) TestPostNodeHostsRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when no token returns 401",
+			setupAuth: func(_ *http.Request) {
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusUnauthorized,
+			wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacHostsCreateTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"docker:write"},
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid admin token returns 200",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacHostsCreateTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationHostsCreate, gomock.Any()).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"address":"10.0.0.5","changed":true}`),
+						},
+						nil,
+					)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{
+							SigningKey: rbacHostsCreateTestSigningKey,
+						},
+					},
+				},
+			}
+
+			server := api.New(appConfig, s.logger)
+			handlers := apihosts.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/api/node/server1/hosts",
+				strings.NewReader(`{"address":"10.0.0.5","hostnames":["db01.internal","db01"]}`),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+
+			server.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestHostsCreatePostPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(HostsCreatePostPublicTestSuite))
+}
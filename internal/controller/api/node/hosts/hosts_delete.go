@@ -0,0 +1,163 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hosts
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/hosts/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	hostsProv "github.com/osapi-io/osapi/internal/provider/node/hosts"
+)
+
+// DeleteNodeHosts deletes a managed /etc/hosts entry on a target node.
+func (s *Hosts) DeleteNodeHosts(
+	ctx context.Context,
+	request gen.DeleteNodeHostsRequestObject,
+) (gen.DeleteNodeHostsResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.DeleteNodeHosts400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validateAddress(request.Address); !ok {
+		return gen.DeleteNodeHosts400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+	address := request.Address
+
+	s.logger.Debug(
+		"hosts entry delete",
+		slog.String("target", hostname),
+		slog.String("address", address),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.deleteNodeHostsBroadcast(ctx, hostname, address)
+	}
+
+	jobID, resp, err := s.JobClient.Modify(
+		ctx,
+		hostname,
+		"node",
+		job.OperationHostsDelete,
+		map[string]string{"address": address},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.DeleteNodeHosts500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		jobUUID := uuid.MustParse(jobID)
+		e := resp.Error
+		return gen.DeleteNodeHosts200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.HostEntryMutationEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.HostEntryMutationEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	var result hostsProv.Result
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &result)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	changed := resp.Changed
+	resultAddress := result.Address
+	agentHostname := resp.Hostname
+
+	return gen.DeleteNodeHosts200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.HostEntryMutationEntry{
+			{
+				Hostname: agentHostname,
+				Status:   gen.HostEntryMutationEntryStatusOk,
+				Address:  &resultAddress,
+				Changed:  changed,
+			},
+		},
+	}, nil
+}
+
+// deleteNodeHostsBroadcast handles broadcast targets for hosts entry delete.
+func (s *Hosts) deleteNodeHostsBroadcast(
+	ctx context.Context,
+	target string,
+	address string,
+) (gen.DeleteNodeHostsResponseObject, error) {
+	jobID, responses, err := s.JobClient.ModifyBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationHostsDelete,
+		map[string]string{"address": address},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.DeleteNodeHosts500JSONResponse{Error: &errMsg}, nil
+	}
+
+	var apiResponses []gen.HostEntryMutationEntry
+	for host, resp := range responses {
+		item := gen.HostEntryMutationEntry{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			item.Status = gen.HostEntryMutationEntryStatusFailed
+			e := resp.Error
+			item.Error = &e
+		case job.StatusSkipped:
+			item.Status = gen.HostEntryMutationEntryStatusSkipped
+			e := resp.Error
+			item.Error = &e
+		default:
+			item.Status = gen.HostEntryMutationEntryStatusOk
+			var result hostsProv.Result
+			if resp.Data != nil {
+				_ = json.Unmarshal(resp.Data, &result)
+			}
+			resultAddress := result.Address
+			item.Address = &resultAddress
+			item.Changed = resp.Changed
+		}
+		apiResponses = append(apiResponses, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.DeleteNodeHosts200JSONResponse{
+		JobId:   &jobUUID,
+		Results: apiResponses,
+	}, nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hosts
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/hosts/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	hostsProv "github.com/osapi-io/osapi/internal/provider/node/hosts"
+)
+
+// GetNodeHostsByAddress gets the /etc/hosts entry for a single address on a target node.
+func (s *Hosts) GetNodeHostsByAddress(
+	ctx context.Context,
+	request gen.GetNodeHostsByAddressRequestObject,
+) (gen.GetNodeHostsByAddressResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeHostsByAddress400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validateAddress(request.Address); !ok {
+		return gen.GetNodeHostsByAddress400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+	address := request.Address
+
+	s.logger.Debug(
+		"hosts entry get",
+		slog.String("target", hostname),
+		slog.String("address", address),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeHostsByAddressBroadcast(ctx, hostname, address)
+	}
+
+	jobID, resp, err := s.JobClient.Query(
+		ctx,
+		hostname,
+		"node",
+		job.OperationHostsGet,
+		map[string]string{"address": address},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "not found") || strings.Contains(errMsg, "does not exist") {
+			return gen.GetNodeHostsByAddress404JSONResponse{Error: &errMsg}, nil
+		}
+		return gen.GetNodeHostsByAddress500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeHostsByAddress200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.HostEntryGetEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.HostEntryGetEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	results := responseToHostEntryGetEntries(resp)
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeHostsByAddress200JSONResponse{
+		JobId:   &jobUUID,
+		Results: results,
+	}, nil
+}
+
+// getNodeHostsByAddressBroadcast handles broadcast targets for hosts entry get.
+func (s *Hosts) getNodeHostsByAddressBroadcast(
+	ctx context.Context,
+	target string,
+	address string,
+) (gen.GetNodeHostsByAddressResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationHostsGet,
+		map[string]string{"address": address},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeHostsByAddress500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.HostEntryGetEntry, 0)
+	for host, resp := range responses {
+		switch resp.Status {
+		case job.StatusFailed:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.HostEntryGetEntry{
+				Hostname: h,
+				Status:   gen.HostEntryGetEntryStatusFailed,
+				Error:    &e,
+			})
+		case job.StatusSkipped:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.HostEntryGetEntry{
+				Hostname: h,
+				Status:   gen.HostEntryGetEntryStatusSkipped,
+				Error:    &e,
+			})
+		default:
+			allResults = append(allResults, responseToHostEntryGetEntries(resp)...)
+		}
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeHostsByAddress200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
+
+// responseToHostEntryGetEntries converts a job response to gen HostEntryGetEntry slice.
+func responseToHostEntryGetEntries(
+	resp *job.Response,
+) []gen.HostEntryGetEntry {
+	var info hostsProv.Entry
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &info)
+	}
+
+	hostname := resp.Hostname
+	entry := entryInfoToGen(info)
+
+	return []gen.HostEntryGetEntry{
+		{
+			Hostname: hostname,
+			Status:   gen.HostEntryGetEntryStatusOk,
+			Entry:    &entry,
+		},
+	}
+}
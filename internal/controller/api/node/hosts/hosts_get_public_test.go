@@ -0,0 +1,620 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hosts_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	apihosts "github.com/osapi-io/osapi/internal/controller/api/node/hosts"
+	"github.com/osapi-io/osapi/internal/controller/api/node/hosts/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type HostsGetPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *apihosts.Hosts
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *HostsGetPublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *HostsGetPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = apihosts.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *HostsGetPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *HostsGetPublicTestSuite) TestGetNodeHostsByAddress() {
+	tests := []struct {
+		name         string
+		request      gen.GetNodeHostsByAddressRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.GetNodeHostsByAddressResponseObject)
+	}{
+		{
+			name: "success",
+			request: gen.GetNodeHostsByAddressRequestObject{
+				Hostname: "server1",
+				Address:  "10.0.0.5",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationHostsGet,
+						gomock.Any(),
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Data: json.RawMessage(
+								`{"address":"10.0.0.5","hostnames":["db01.internal","db01"],"managed":true}`,
+							),
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.GetNodeHostsByAddressResponseObject) {
+				r, ok := resp.(gen.GetNodeHostsByAddress200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("agent1", r.Results[0].Hostname)
+				s.Equal(gen.HostEntryGetEntryStatusOk, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Entry)
+				s.Equal("10.0.0.5", *r.Results[0].Entry.Address)
+				s.Equal([]string{"db01.internal", "db01"}, *r.Results[0].Entry.Hostnames)
+				s.False(*r.Results[0].Entry.Drifted)
+				s.True(*r.Results[0].Entry.Managed)
+			},
+		},
+		{
+			name: "success with nil response data",
+			request: gen.GetNodeHostsByAddressRequestObject{
+				Hostname: "server1",
+				Address:  "10.0.0.5",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationHostsGet,
+						gomock.Any(),
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Data:     nil,
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.GetNodeHostsByAddressResponseObject) {
+				r, ok := resp.(gen.GetNodeHostsByAddress200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Require().NotNil(r.Results[0].Entry)
+			},
+		},
+		{
+			name: "validation error empty hostname",
+			request: gen.GetNodeHostsByAddressRequestObject{
+				Hostname: "",
+				Address:  "10.0.0.5",
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.GetNodeHostsByAddressResponseObject) {
+				_, ok := resp.(gen.GetNodeHostsByAddress400JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "validation error invalid address",
+			request: gen.GetNodeHostsByAddressRequestObject{
+				Hostname: "server1",
+				Address:  "not-an-ip",
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.GetNodeHostsByAddressResponseObject) {
+				r, ok := resp.(gen.GetNodeHostsByAddress400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "ip")
+			},
+		},
+		{
+			name: "not found error",
+			request: gen.GetNodeHostsByAddressRequestObject{
+				Hostname: "server1",
+				Address:  "10.0.0.9",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationHostsGet,
+						gomock.Any(),
+					).
+					Return("", nil, errors.New("hosts: get: hosts entry \"10.0.0.9\": not found"))
+			},
+			validateFunc: func(resp gen.GetNodeHostsByAddressResponseObject) {
+				r, ok := resp.(gen.GetNodeHostsByAddress404JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "not found")
+			},
+		},
+		{
+			name: "does not exist error",
+			request: gen.GetNodeHostsByAddressRequestObject{
+				Hostname: "server1",
+				Address:  "10.0.0.9",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationHostsGet,
+						gomock.Any(),
+					).
+					Return("", nil, errors.New("hosts entry does not exist"))
+			},
+			validateFunc: func(resp gen.GetNodeHostsByAddressResponseObject) {
+				r, ok := resp.(gen.GetNodeHostsByAddress404JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "does not exist")
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.GetNodeHostsByAddressRequestObject{
+				Hostname: "server1",
+				Address:  "10.0.0.5",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationHostsGet,
+						gomock.Any(),
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							Status:   job.StatusSkipped,
+							Hostname: "server1",
+							Error:    "hosts: operation not supported on this OS family",
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.GetNodeHostsByAddressResponseObject) {
+				r, ok := resp.(gen.GetNodeHostsByAddress200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("server1", r.Results[0].Hostname)
+				s.Equal(gen.HostEntryGetEntryStatusSkipped, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "job client error",
+			request: gen.GetNodeHostsByAddressRequestObject{
+				Hostname: "server1",
+				Address:  "10.0.0.5",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationHostsGet,
+						gomock.Any(),
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.GetNodeHostsByAddressResponseObject) {
+				_, ok := resp.(gen.GetNodeHostsByAddress500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "broadcast success",
+			request: gen.GetNodeHostsByAddressRequestObject{
+				Hostname: "_all",
+				Address:  "10.0.0.5",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationHostsGet,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "server1",
+							Data: json.RawMessage(
+								`{"address":"10.0.0.5","hostnames":["db01"],"managed":true}`,
+							),
+						},
+						"server2": {
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "server2",
+							Data: json.RawMessage(
+								`{"address":"10.0.0.5","hostnames":["db01"],"managed":true,"drifted":true}`,
+							),
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeHostsByAddressResponseObject) {
+				r, ok := resp.(gen.GetNodeHostsByAddress200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Len(r.Results, 2)
+			},
+		},
+		{
+			name: "broadcast with failed host",
+			request: gen.GetNodeHostsByAddressRequestObject{
+				Hostname: "_all",
+				Address:  "10.0.0.5",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationHostsGet,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusFailed,
+							Error:    "agent unreachable",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeHostsByAddressResponseObject) {
+				r, ok := resp.(gen.GetNodeHostsByAddress200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.HostEntryGetEntryStatusFailed, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "unreachable")
+			},
+		},
+		{
+			name: "broadcast with skipped host",
+			request: gen.GetNodeHostsByAddressRequestObject{
+				Hostname: "_all",
+				Address:  "10.0.0.5",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationHostsGet,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusSkipped,
+							Error:    "hosts: operation not supported on this OS family",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeHostsByAddressResponseObject) {
+				r, ok := resp.(gen.GetNodeHostsByAddress200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.HostEntryGetEntryStatusSkipped, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "broadcast error collecting responses",
+			request: gen.GetNodeHostsByAddressRequestObject{
+				Hostname: "_all",
+				Address:  "10.0.0.5",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationHostsGet,
+						gomock.Any(),
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.GetNodeHostsByAddressResponseObject) {
+				_, ok := resp.(gen.GetNodeHostsByAddress500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			resp, err := s.handler.GetNodeHostsByAddress(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+func (s *HostsGetPublicTestSuite) TestGetNodeHostsByAddressValidationHTTP() {
+	tests := []struct {
+		name         string
+		path         string
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when valid request",
+			path: "/api/node/server1/hosts/10.0.0.5",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Query(gomock.Any(), "server1", "node", job.OperationHostsGet, gomock.Any()).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Data: json.RawMessage(
+								`{"address":"10.0.0.5","hostnames":["db01"],"managed":true}`,
+							),
+						},
+						nil,
+					)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+		{
+			name: "when target agent not found",
+			path: "/api/node/nonexistent/hosts/10.0.0.5",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "valid_target"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			hostsHandler := apihosts.New(s.logger, jobMock)
+			strictHandler := gen.NewStrictHandler(hostsHandler, nil)
+
+			a := api.New(s.appConfig, s.logger)
+			gen.RegisterHandlers(a.Echo, strictHandler)
+
+			req := httptest.NewRequest(
+				http.MethodGet,
+				tc.path,
+				nil,
+			)
+			rec := httptest.NewRecorder()
+
+			a.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+const rbacHostsGetTestSigningKey = "test-signing-key-for-rbac-hosts-get"
+
+func (s *HostsGetPublicTestSuite) TestGetNodeHostsByAddressRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when no token returns 401",
+			setupAuth: func(_ *http.Request) {
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusUnauthorized,
+			wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacHostsGetTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"docker:write"},
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid admin token returns 200",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacHostsGetTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Query(gomock.Any(), "server1", "node", job.OperationHostsGet, gomock.Any()).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Data: json.RawMessage(
+								`{"address":"10.0.0.5","hostnames":["db01"],"managed":true}`,
+							),
+						},
+						nil,
+					)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{
+							SigningKey: rbacHostsGetTestSigningKey,
+						},
+					},
+				},
+			}
+
+			server := api.New(appConfig, s.logger)
+			handlers := apihosts.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+
+			req := httptest.NewRequest(
+				http.MethodGet,
+				"/api/node/server1/hosts/10.0.0.5",
+				nil,
+			)
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+
+			server.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestHostsGetPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(HostsGetPublicTestSuite))
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hosts
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/hosts/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	hostsProv "github.com/osapi-io/osapi/internal/provider/node/hosts"
+)
+
+// GetNodeHosts lists the /etc/hosts entries on a target node.
+func (s *Hosts) GetNodeHosts(
+	ctx context.Context,
+	request gen.GetNodeHostsRequestObject,
+) (gen.GetNodeHostsResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeHosts400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"hosts entry list",
+		slog.String("target", hostname),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeHostsBroadcast(ctx, hostname)
+	}
+
+	jobID, resp, err := s.JobClient.Query(
+		ctx,
+		hostname,
+		"node",
+		job.OperationHostsList,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeHosts500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeHosts200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.HostEntryListEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.HostEntryListEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	results := responseToHostEntryListEntries(resp)
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeHosts200JSONResponse{
+		JobId:   &jobUUID,
+		Results: results,
+	}, nil
+}
+
+// getNodeHostsBroadcast handles broadcast targets for hosts entry list.
+func (s *Hosts) getNodeHostsBroadcast(
+	ctx context.Context,
+	target string,
+) (gen.GetNodeHostsResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationHostsList,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeHosts500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.HostEntryListEntry, 0)
+	for host, resp := range responses {
+		switch resp.Status {
+		case job.StatusFailed:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.HostEntryListEntry{
+				Hostname: h,
+				Status:   gen.HostEntryListEntryStatusFailed,
+				Error:    &e,
+			})
+		case job.StatusSkipped:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.HostEntryListEntry{
+				Hostname: h,
+				Status:   gen.HostEntryListEntryStatusSkipped,
+				Error:    &e,
+			})
+		default:
+			allResults = append(allResults, responseToHostEntryListEntries(resp)...)
+		}
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeHosts200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
+
+// responseToHostEntryListEntries converts a job response to gen HostEntryListEntry slice.
+func responseToHostEntryListEntries(
+	resp *job.Response,
+) []gen.HostEntryListEntry {
+	var infos []hostsProv.Entry
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &infos)
+	}
+
+	hostname := resp.Hostname
+
+	entries := make([]gen.HostEntryInfo, 0, len(infos))
+	for _, info := range infos {
+		entries = append(entries, entryInfoToGen(info))
+	}
+
+	return []gen.HostEntryListEntry{
+		{
+			Hostname: hostname,
+			Status:   gen.HostEntryListEntryStatusOk,
+			Entries:  &entries,
+		},
+	}
+}
+
+// entryInfoToGen converts a provider Entry to a gen HostEntryInfo.
+func entryInfoToGen(
+	info hostsProv.Entry,
+) gen.HostEntryInfo {
+	address := info.Address
+	hostnames := info.Hostnames
+	if hostnames == nil {
+		hostnames = []string{}
+	}
+	managed := info.Managed
+	drifted := info.Drifted
+
+	return gen.HostEntryInfo{
+		Address:   &address,
+		Hostnames: &hostnames,
+		Managed:   &managed,
+		Drifted:   &drifted,
+	}
+}
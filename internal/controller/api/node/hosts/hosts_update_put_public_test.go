@@ -0,0 +1,550 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hosts_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	apihosts "github.com/osapi-io/osapi/internal/controller/api/node/hosts"
+	"github.com/osapi-io/osapi/internal/controller/api/node/hosts/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type HostsUpdatePutPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *apihosts.Hosts
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *HostsUpdatePutPublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *HostsUpdatePutPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = apihosts.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *HostsUpdatePutPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *HostsUpdatePutPublicTestSuite) TestPutNodeHosts() {
+	tests := []struct {
+		name         string
+		request      gen.PutNodeHostsRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.PutNodeHostsResponseObject)
+	}{
+		{
+			name: "success",
+			request: gen.PutNodeHostsRequestObject{
+				Hostname: "server1",
+				Address:  "10.0.0.5",
+				Body: &gen.PutNodeHostsJSONRequestBody{
+					Hostnames: []string{"db01.internal", "db01"},
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationHostsUpdate, gomock.Any()).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"address":"10.0.0.5","changed":true}`),
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PutNodeHostsResponseObject) {
+				r, ok := resp.(gen.PutNodeHosts200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("agent1", r.Results[0].Hostname)
+				s.Require().NotNil(r.Results[0].Changed)
+				s.True(*r.Results[0].Changed)
+				s.Equal("10.0.0.5", *r.Results[0].Address)
+			},
+		},
+		{
+			name: "success with nil response data",
+			request: gen.PutNodeHostsRequestObject{
+				Hostname: "server1",
+				Address:  "10.0.0.5",
+				Body:     &gen.PutNodeHostsJSONRequestBody{Hostnames: []string{"db01.internal", "db01"}},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationHostsUpdate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID: "550e8400-e29b-41d4-a716-446655440000", Hostname: "agent1", Changed: boolPtr(true), Data: nil,
+					}, nil)
+			},
+			validateFunc: func(resp gen.PutNodeHostsResponseObject) {
+				r, ok := resp.(gen.PutNodeHosts200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("", *r.Results[0].Address)
+			},
+		},
+		{
+			name: "validation error empty hostname",
+			request: gen.PutNodeHostsRequestObject{
+				Hostname: "", Address: "10.0.0.5",
+				Body: &gen.PutNodeHostsJSONRequestBody{Hostnames: []string{"db01.internal", "db01"}},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PutNodeHostsResponseObject) {
+				r, ok := resp.(gen.PutNodeHosts400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "required")
+			},
+		},
+		{
+			name: "validation error invalid address",
+			request: gen.PutNodeHostsRequestObject{
+				Hostname: "server1", Address: "not-an-ip",
+				Body: &gen.PutNodeHostsJSONRequestBody{Hostnames: []string{"db01"}},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PutNodeHostsResponseObject) {
+				r, ok := resp.(gen.PutNodeHosts400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "ip")
+			},
+		},
+		{
+			name: "body validation error empty hostnames",
+			request: gen.PutNodeHostsRequestObject{
+				Hostname: "server1", Address: "10.0.0.5",
+				Body: &gen.PutNodeHostsJSONRequestBody{Hostnames: []string{}},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PutNodeHostsResponseObject) {
+				r, ok := resp.(gen.PutNodeHosts400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+			},
+		},
+		{
+			name: "not found error",
+			request: gen.PutNodeHostsRequestObject{
+				Hostname: "server1", Address: "10.0.0.9",
+				Body: &gen.PutNodeHostsJSONRequestBody{Hostnames: []string{"db01.internal", "db01"}},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationHostsUpdate, gomock.Any()).
+					Return("", nil, errors.New("hosts: update: hosts entry \"10.0.0.9\": not found"))
+			},
+			validateFunc: func(resp gen.PutNodeHostsResponseObject) {
+				r, ok := resp.(gen.PutNodeHosts404JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "not found")
+			},
+		},
+		{
+			name: "does not exist error",
+			request: gen.PutNodeHostsRequestObject{
+				Hostname: "server1", Address: "10.0.0.9",
+				Body: &gen.PutNodeHostsJSONRequestBody{Hostnames: []string{"db01.internal", "db01"}},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationHostsUpdate, gomock.Any()).
+					Return("", nil, errors.New("hosts entry does not exist"))
+			},
+			validateFunc: func(resp gen.PutNodeHostsResponseObject) {
+				r, ok := resp.(gen.PutNodeHosts404JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "does not exist")
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.PutNodeHostsRequestObject{
+				Hostname: "server1", Address: "10.0.0.5",
+				Body: &gen.PutNodeHostsJSONRequestBody{Hostnames: []string{"db01.internal", "db01"}},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationHostsUpdate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Status: job.StatusSkipped, Hostname: "server1",
+						Error: "hosts: operation not supported on this OS family",
+					}, nil)
+			},
+			validateFunc: func(resp gen.PutNodeHostsResponseObject) {
+				r, ok := resp.(gen.PutNodeHosts200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.HostEntryMutationEntryStatusSkipped, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "job client error",
+			request: gen.PutNodeHostsRequestObject{
+				Hostname: "server1", Address: "10.0.0.5",
+				Body: &gen.PutNodeHostsJSONRequestBody{Hostnames: []string{"db01.internal", "db01"}},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationHostsUpdate, gomock.Any()).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PutNodeHostsResponseObject) {
+				_, ok := resp.(gen.PutNodeHosts500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "broadcast success",
+			request: gen.PutNodeHostsRequestObject{
+				Hostname: "_all", Address: "10.0.0.5",
+				Body: &gen.PutNodeHostsJSONRequestBody{Hostnames: []string{"db01.internal", "db01"}},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationHostsUpdate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Hostname: "server1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"address":"10.0.0.5","changed":true}`),
+						},
+						"server2": {
+							Hostname: "server2",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"address":"10.0.0.5","changed":true}`),
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PutNodeHostsResponseObject) {
+				r, ok := resp.(gen.PutNodeHosts200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Len(r.Results, 2)
+			},
+		},
+		{
+			name: "broadcast with nil response data",
+			request: gen.PutNodeHostsRequestObject{
+				Hostname: "_all", Address: "10.0.0.5",
+				Body: &gen.PutNodeHostsJSONRequestBody{Hostnames: []string{"db01.internal", "db01"}},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationHostsUpdate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {Hostname: "server1", Changed: boolPtr(true), Data: nil},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PutNodeHostsResponseObject) {
+				r, ok := resp.(gen.PutNodeHosts200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal("", *r.Results[0].Address)
+			},
+		},
+		{
+			name: "broadcast with failed host",
+			request: gen.PutNodeHostsRequestObject{
+				Hostname: "_all", Address: "10.0.0.5",
+				Body: &gen.PutNodeHostsJSONRequestBody{Hostnames: []string{"db01.internal", "db01"}},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationHostsUpdate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusFailed,
+							Error:    "agent unreachable",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PutNodeHostsResponseObject) {
+				r, ok := resp.(gen.PutNodeHosts200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.HostEntryMutationEntryStatusFailed, r.Results[0].Status)
+				s.Contains(*r.Results[0].Error, "unreachable")
+			},
+		},
+		{
+			name: "broadcast with skipped host",
+			request: gen.PutNodeHostsRequestObject{
+				Hostname: "_all", Address: "10.0.0.5",
+				Body: &gen.PutNodeHostsJSONRequestBody{Hostnames: []string{"db01.internal", "db01"}},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationHostsUpdate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusSkipped,
+							Error:    "hosts: operation not supported on this OS family",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PutNodeHostsResponseObject) {
+				r, ok := resp.(gen.PutNodeHosts200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.HostEntryMutationEntryStatusSkipped, r.Results[0].Status)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "broadcast error collecting responses",
+			request: gen.PutNodeHostsRequestObject{
+				Hostname: "_all", Address: "10.0.0.5",
+				Body: &gen.PutNodeHostsJSONRequestBody{Hostnames: []string{"db01.internal", "db01"}},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationHostsUpdate, gomock.Any()).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PutNodeHostsResponseObject) {
+				_, ok := resp.(gen.PutNodeHosts500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+			resp, err := s.handler.PutNodeHosts(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+func (s *HostsUpdatePutPublicTestSuite) TestPutNodeHostsValidationHTTP() {
+	tests := []struct {
+		name         string
+		path         string
+		body         string
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when valid request",
+			path: "/api/node/server1/hosts/10.0.0.5",
+			body: `{"hostnames":["db01.internal","db01"]}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationHostsUpdate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID: "550e8400-e29b-41d4-a716-446655440000", Hostname: "agent1", Changed: boolPtr(true),
+						Data: json.RawMessage(`{"address":"10.0.0.5","changed":true}`),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+		{
+			name: "when target agent not found",
+			path: "/api/node/nonexistent/hosts/10.0.0.5",
+			body: `{"hostnames":["db01.internal","db01"]}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "valid_target"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+			hostsHandler := apihosts.New(s.logger, jobMock)
+			strictHandler := gen.NewStrictHandler(hostsHandler, nil)
+			a := api.New(s.appConfig, s.logger)
+			gen.RegisterHandlers(a.Echo, strictHandler)
+			req := httptest.NewRequest(http.MethodPut, tc.path, strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			a.Echo.ServeHTTP(rec, req)
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+const rbacHostsUpdateTestSigningKey = "test-signing-key-for-rbac-hosts-update"
+
+func (s *HostsUpdatePutPublicTestSuite) TestPutNodeHostsRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name:      "when no token returns 401",
+			setupAuth: func(_ *http.Request) {},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusUnauthorized,
+			wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacHostsUpdateTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"docker:write"},
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid admin token returns 200",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacHostsUpdateTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationHostsUpdate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID: "550e8400-e29b-41d4-a716-446655440000", Hostname: "agent1", Changed: boolPtr(true),
+						Data: json.RawMessage(`{"address":"10.0.0.5","changed":true}`),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{
+							SigningKey: rbacHostsUpdateTestSigningKey,
+						},
+					},
+				},
+			}
+			server := api.New(appConfig, s.logger)
+			handlers := apihosts.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+			req := httptest.NewRequest(
+				http.MethodPut,
+				"/api/node/server1/hosts/10.0.0.5",
+				strings.NewReader(`{"hostnames":["db01.internal","db01"]}`),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+			server.Echo.ServeHTTP(rec, req)
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestHostsUpdatePutPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(HostsUpdatePutPublicTestSuite))
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package kernel
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/kernel/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	kernelProv "github.com/osapi-io/osapi/internal/provider/node/kernel"
+)
+
+// DeleteNodeKernelModule deletes a managed kernel module on a target node.
+func (s *Kernel) DeleteNodeKernelModule(
+	ctx context.Context,
+	request gen.DeleteNodeKernelModuleRequestObject,
+) (gen.DeleteNodeKernelModuleResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.DeleteNodeKernelModule400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+	name := request.Name
+
+	s.logger.Debug(
+		"kernel module delete",
+		slog.String("target", hostname),
+		slog.String("name", name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.deleteNodeKernelModuleBroadcast(ctx, hostname, name)
+	}
+
+	jobID, resp, err := s.JobClient.Modify(
+		ctx,
+		hostname,
+		"node",
+		job.OperationKernelModuleDelete,
+		map[string]string{"name": name},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.DeleteNodeKernelModule500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		jobUUID := uuid.MustParse(jobID)
+		e := resp.Error
+		return gen.DeleteNodeKernelModule200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.KernelModuleMutationEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.KernelModuleMutationEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	var result kernelProv.ModuleResult
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &result)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	changed := resp.Changed
+	resultName := result.Name
+	agentHostname := resp.Hostname
+
+	return gen.DeleteNodeKernelModule200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.KernelModuleMutationEntry{
+			{
+				Hostname: agentHostname,
+				Status:   gen.KernelModuleMutationEntryStatusOk,
+				Name:     &resultName,
+				Changed:  changed,
+			},
+		},
+	}, nil
+}
+
+// deleteNodeKernelModuleBroadcast handles broadcast targets for kernel module delete.
+func (s *Kernel) deleteNodeKernelModuleBroadcast(
+	ctx context.Context,
+	target string,
+	name string,
+) (gen.DeleteNodeKernelModuleResponseObject, error) {
+	jobID, responses, err := s.JobClient.ModifyBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationKernelModuleDelete,
+		map[string]string{"name": name},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.DeleteNodeKernelModule500JSONResponse{Error: &errMsg}, nil
+	}
+
+	var apiResponses []gen.KernelModuleMutationEntry
+	for host, resp := range responses {
+		item := gen.KernelModuleMutationEntry{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			item.Status = gen.KernelModuleMutationEntryStatusFailed
+			e := resp.Error
+			item.Error = &e
+		case job.StatusSkipped:
+			item.Status = gen.KernelModuleMutationEntryStatusSkipped
+			e := resp.Error
+			item.Error = &e
+		default:
+			item.Status = gen.KernelModuleMutationEntryStatusOk
+			var result kernelProv.ModuleResult
+			if resp.Data != nil {
+				_ = json.Unmarshal(resp.Data, &result)
+			}
+			resultName := result.Name
+			item.Name = &resultName
+			item.Changed = resp.Changed
+		}
+		apiResponses = append(apiResponses, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.DeleteNodeKernelModule200JSONResponse{
+		JobId:   &jobUUID,
+		Results: apiResponses,
+	}, nil
+}
@@ -0,0 +1,436 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package kernel_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	apikernel "github.com/osapi-io/osapi/internal/controller/api/node/kernel"
+	"github.com/osapi-io/osapi/internal/controller/api/node/kernel/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type KernelModuleDeletePublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *apikernel.Kernel
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *KernelModuleDeletePublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *KernelModuleDeletePublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = apikernel.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *KernelModuleDeletePublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *KernelModuleDeletePublicTestSuite) TestDeleteNodeKernelModule() {
+	tests := []struct {
+		name         string
+		request      gen.DeleteNodeKernelModuleRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.DeleteNodeKernelModuleResponseObject)
+	}{
+		{
+			name: "success",
+			request: gen.DeleteNodeKernelModuleRequestObject{
+				Hostname: "server1",
+				Name:     "br_netfilter",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationKernelModuleDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID: "550e8400-e29b-41d4-a716-446655440000", Hostname: "agent1", Changed: boolPtr(true),
+						Data: json.RawMessage(`{"name":"br_netfilter","changed":true}`),
+					}, nil)
+			},
+			validateFunc: func(resp gen.DeleteNodeKernelModuleResponseObject) {
+				r, ok := resp.(gen.DeleteNodeKernelModule200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("agent1", r.Results[0].Hostname)
+				s.True(*r.Results[0].Changed)
+				s.Equal("br_netfilter", *r.Results[0].Name)
+			},
+		},
+		{
+			name: "success with nil response data",
+			request: gen.DeleteNodeKernelModuleRequestObject{
+				Hostname: "server1",
+				Name:     "br_netfilter",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationKernelModuleDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID: "550e8400-e29b-41d4-a716-446655440000", Hostname: "agent1", Changed: boolPtr(true), Data: nil,
+					}, nil)
+			},
+			validateFunc: func(resp gen.DeleteNodeKernelModuleResponseObject) {
+				r, ok := resp.(gen.DeleteNodeKernelModule200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal("", *r.Results[0].Name)
+			},
+		},
+		{
+			name:      "validation error empty hostname",
+			request:   gen.DeleteNodeKernelModuleRequestObject{Hostname: "", Name: "br_netfilter"},
+			setupMock: func() {},
+			validateFunc: func(resp gen.DeleteNodeKernelModuleResponseObject) {
+				_, ok := resp.(gen.DeleteNodeKernelModule400JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.DeleteNodeKernelModuleRequestObject{
+				Hostname: "server1",
+				Name:     "br_netfilter",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationKernelModuleDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Status: job.StatusSkipped, Hostname: "server1", Error: "kernel: operation not supported on this OS family",
+					}, nil)
+			},
+			validateFunc: func(resp gen.DeleteNodeKernelModuleResponseObject) {
+				r, ok := resp.(gen.DeleteNodeKernelModule200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.KernelModuleMutationEntryStatusSkipped, r.Results[0].Status)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "job client error",
+			request: gen.DeleteNodeKernelModuleRequestObject{
+				Hostname: "server1",
+				Name:     "br_netfilter",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationKernelModuleDelete, gomock.Any()).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.DeleteNodeKernelModuleResponseObject) {
+				_, ok := resp.(gen.DeleteNodeKernelModule500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name:    "broadcast success",
+			request: gen.DeleteNodeKernelModuleRequestObject{Hostname: "_all", Name: "br_netfilter"},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationKernelModuleDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Hostname: "server1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"br_netfilter","changed":true}`),
+						},
+						"server2": {
+							Hostname: "server2",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"br_netfilter","changed":true}`),
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.DeleteNodeKernelModuleResponseObject) {
+				r, ok := resp.(gen.DeleteNodeKernelModule200JSONResponse)
+				s.True(ok)
+				s.Len(r.Results, 2)
+			},
+		},
+		{
+			name:    "broadcast with nil response data",
+			request: gen.DeleteNodeKernelModuleRequestObject{Hostname: "_all", Name: "br_netfilter"},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationKernelModuleDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {Hostname: "server1", Changed: boolPtr(true), Data: nil},
+					}, nil)
+			},
+			validateFunc: func(resp gen.DeleteNodeKernelModuleResponseObject) {
+				r, ok := resp.(gen.DeleteNodeKernelModule200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal("", *r.Results[0].Name)
+			},
+		},
+		{
+			name:    "broadcast with failed host",
+			request: gen.DeleteNodeKernelModuleRequestObject{Hostname: "_all", Name: "br_netfilter"},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationKernelModuleDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusFailed,
+							Error:    "agent unreachable",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.DeleteNodeKernelModuleResponseObject) {
+				r, ok := resp.(gen.DeleteNodeKernelModule200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.KernelModuleMutationEntryStatusFailed, r.Results[0].Status)
+				s.Contains(*r.Results[0].Error, "unreachable")
+			},
+		},
+		{
+			name:    "broadcast with skipped host",
+			request: gen.DeleteNodeKernelModuleRequestObject{Hostname: "_all", Name: "br_netfilter"},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationKernelModuleDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusSkipped,
+							Error:    "kernel: operation not supported on this OS family",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.DeleteNodeKernelModuleResponseObject) {
+				r, ok := resp.(gen.DeleteNodeKernelModule200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.KernelModuleMutationEntryStatusSkipped, r.Results[0].Status)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name:    "broadcast error collecting responses",
+			request: gen.DeleteNodeKernelModuleRequestObject{Hostname: "_all", Name: "br_netfilter"},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationKernelModuleDelete, gomock.Any()).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.DeleteNodeKernelModuleResponseObject) {
+				_, ok := resp.(gen.DeleteNodeKernelModule500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+			resp, err := s.handler.DeleteNodeKernelModule(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+func (s *KernelModuleDeletePublicTestSuite) TestDeleteNodeKernelModuleValidationHTTP() {
+	tests := []struct {
+		name         string
+		path         string
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when valid request",
+			path: "/api/node/server1/kernel/module/br_netfilter",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationKernelModuleDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID: "550e8400-e29b-41d4-a716-446655440000", Hostname: "agent1", Changed: boolPtr(true),
+						Data: json.RawMessage(`{"name":"br_netfilter","changed":true}`),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+		{
+			name: "when target agent not found",
+			path: "/api/node/nonexistent/kernel/module/br_netfilter",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "valid_target"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+			kernelHandler := apikernel.New(s.logger, jobMock)
+			strictHandler := gen.NewStrictHandler(kernelHandler, nil)
+			a := api.New(s.appConfig, s.logger)
+			gen.RegisterHandlers(a.Echo, strictHandler)
+			req := httptest.NewRequest(http.MethodDelete, tc.path, nil)
+			rec := httptest.NewRecorder()
+			a.Echo.ServeHTTP(rec, req)
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+const rbacKernelModuleDeleteTestSigningKey = "test-signing-key-for-rbac-kernel-module-delete"
+
+func (s *KernelModuleDeletePublicTestSuite) TestDeleteNodeKernelModuleRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when no token returns 401", setupAuth: func(_ *http.Request) {},
+			setupJobMock: func() *jobmocks.MockJobClient { return jobmocks.NewMockJobClient(s.mockCtrl) },
+			wantCode:     http.StatusUnauthorized, wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacKernelModuleDeleteTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"docker:write"},
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient { return jobmocks.NewMockJobClient(s.mockCtrl) },
+			wantCode:     http.StatusForbidden, wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid admin token returns 200",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacKernelModuleDeleteTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationKernelModuleDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID: "550e8400-e29b-41d4-a716-446655440000", Hostname: "agent1", Changed: boolPtr(true),
+						Data: json.RawMessage(`{"name":"br_netfilter","changed":true}`),
+					}, nil)
+				return mock
+			},
+			wantCode: http.StatusOK, wantContains: []string{`"job_id"`, `"results"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{
+							SigningKey: rbacKernelModuleDeleteTestSigningKey,
+						},
+					},
+				},
+			}
+			server := api.New(appConfig, s.logger)
+			handlers := apikernel.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+			req := httptest.NewRequest(
+				http.MethodDelete,
+				"/api/node/server1/kernel/module/br_netfilter",
+				nil,
+			)
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+			server.Echo.ServeHTTP(rec, req)
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestKernelModuleDeletePublicTestSuite(t *testing.T) {
+	suite.Run(t, new(KernelModuleDeletePublicTestSuite))
+}
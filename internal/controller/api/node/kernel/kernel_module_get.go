@@ -0,0 +1,167 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package kernel
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/kernel/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	kernelProv "github.com/osapi-io/osapi/internal/provider/node/kernel"
+)
+
+// GetNodeKernelModuleByName gets details for a single kernel module on a target node.
+func (s *Kernel) GetNodeKernelModuleByName(
+	ctx context.Context,
+	request gen.GetNodeKernelModuleByNameRequestObject,
+) (gen.GetNodeKernelModuleByNameResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeKernelModuleByName400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+	name := request.Name
+
+	s.logger.Debug(
+		"kernel module get",
+		slog.String("target", hostname),
+		slog.String("name", name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeKernelModuleByNameBroadcast(ctx, hostname, name)
+	}
+
+	jobID, resp, err := s.JobClient.Query(
+		ctx,
+		hostname,
+		"node",
+		job.OperationKernelModuleGet,
+		map[string]string{"name": name},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "not found") || strings.Contains(errMsg, "does not exist") {
+			return gen.GetNodeKernelModuleByName404JSONResponse{Error: &errMsg}, nil
+		}
+		return gen.GetNodeKernelModuleByName500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeKernelModuleByName200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.KernelModuleGetEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.KernelModuleGetEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	results := responseToKernelModuleGetEntries(resp)
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeKernelModuleByName200JSONResponse{
+		JobId:   &jobUUID,
+		Results: results,
+	}, nil
+}
+
+// getNodeKernelModuleByNameBroadcast handles broadcast targets for kernel module get.
+func (s *Kernel) getNodeKernelModuleByNameBroadcast(
+	ctx context.Context,
+	target string,
+	name string,
+) (gen.GetNodeKernelModuleByNameResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationKernelModuleGet,
+		map[string]string{"name": name},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeKernelModuleByName500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.KernelModuleGetEntry, 0)
+	for host, resp := range responses {
+		switch resp.Status {
+		case job.StatusFailed:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.KernelModuleGetEntry{
+				Hostname: h,
+				Status:   gen.KernelModuleGetEntryStatusFailed,
+				Error:    &e,
+			})
+		case job.StatusSkipped:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.KernelModuleGetEntry{
+				Hostname: h,
+				Status:   gen.KernelModuleGetEntryStatusSkipped,
+				Error:    &e,
+			})
+		default:
+			allResults = append(allResults, responseToKernelModuleGetEntries(resp)...)
+		}
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeKernelModuleByName200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
+
+// responseToKernelModuleGetEntries converts a job response to gen KernelModuleGetEntry slice.
+func responseToKernelModuleGetEntries(
+	resp *job.Response,
+) []gen.KernelModuleGetEntry {
+	var info kernelProv.Module
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &info)
+	}
+
+	hostname := resp.Hostname
+	m := moduleInfoToGen(info)
+
+	return []gen.KernelModuleGetEntry{
+		{
+			Hostname: hostname,
+			Status:   gen.KernelModuleGetEntryStatusOk,
+			Module:   &m,
+		},
+	}
+}
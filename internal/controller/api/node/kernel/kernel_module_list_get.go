@@ -0,0 +1,201 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package kernel
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/kernel/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	kernelProv "github.com/osapi-io/osapi/internal/provider/node/kernel"
+)
+
+// GetNodeKernelModule lists loaded and managed kernel modules on a target node.
+func (s *Kernel) GetNodeKernelModule(
+	ctx context.Context,
+	request gen.GetNodeKernelModuleRequestObject,
+) (gen.GetNodeKernelModuleResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeKernelModule400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"kernel module list",
+		slog.String("target", hostname),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeKernelModuleBroadcast(ctx, hostname)
+	}
+
+	jobID, resp, err := s.JobClient.Query(
+		ctx,
+		hostname,
+		"node",
+		job.OperationKernelModuleList,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeKernelModule500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeKernelModule200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.KernelModuleListEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.KernelModuleListEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	results := responseToKernelModuleListEntries(resp)
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeKernelModule200JSONResponse{
+		JobId:   &jobUUID,
+		Results: results,
+	}, nil
+}
+
+// getNodeKernelModuleBroadcast handles broadcast targets for kernel module list.
+func (s *Kernel) getNodeKernelModuleBroadcast(
+	ctx context.Context,
+	target string,
+) (gen.GetNodeKernelModuleResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationKernelModuleList,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeKernelModule500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.KernelModuleListEntry, 0)
+	for host, resp := range responses {
+		switch resp.Status {
+		case job.StatusFailed:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.KernelModuleListEntry{
+				Hostname: h,
+				Status:   gen.KernelModuleListEntryStatusFailed,
+				Error:    &e,
+			})
+		case job.StatusSkipped:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.KernelModuleListEntry{
+				Hostname: h,
+				Status:   gen.KernelModuleListEntryStatusSkipped,
+				Error:    &e,
+			})
+		default:
+			allResults = append(allResults, responseToKernelModuleListEntries(resp)...)
+		}
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeKernelModule200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
+
+// responseToKernelModuleListEntries converts a job response to gen KernelModuleListEntry slice.
+func responseToKernelModuleListEntries(
+	resp *job.Response,
+) []gen.KernelModuleListEntry {
+	var infos []kernelProv.Module
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &infos)
+	}
+
+	hostname := resp.Hostname
+
+	modules := make([]gen.KernelModuleInfo, 0, len(infos))
+	for _, info := range infos {
+		modules = append(modules, moduleInfoToGen(info))
+	}
+
+	return []gen.KernelModuleListEntry{
+		{
+			Hostname: hostname,
+			Status:   gen.KernelModuleListEntryStatusOk,
+			Modules:  &modules,
+		},
+	}
+}
+
+// moduleInfoToGen converts a provider Module to a gen KernelModuleInfo.
+func moduleInfoToGen(
+	info kernelProv.Module,
+) gen.KernelModuleInfo {
+	name := info.Name
+	loaded := info.Loaded
+	managed := info.Managed
+	result := gen.KernelModuleInfo{
+		Name:    &name,
+		Loaded:  &loaded,
+		Managed: &managed,
+	}
+
+	if info.Loaded {
+		size := info.Size
+		result.Size = &size
+	}
+	if len(info.UsedBy) > 0 {
+		usedBy := info.UsedBy
+		result.UsedBy = &usedBy
+	}
+	if info.Managed {
+		load := info.Load
+		result.Load = &load
+		blacklist := info.Blacklist
+		result.Blacklist = &blacklist
+		drifted := info.Drifted
+		result.Drifted = &drifted
+	}
+	if info.Options != "" {
+		options := info.Options
+		result.Options = &options
+	}
+
+	return result
+}
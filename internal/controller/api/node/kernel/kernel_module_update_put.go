@@ -0,0 +1,180 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package kernel
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/kernel/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	kernelProv "github.com/osapi-io/osapi/internal/provider/node/kernel"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// PutNodeKernelModule updates a managed kernel module on a target node.
+func (s *Kernel) PutNodeKernelModule(
+	ctx context.Context,
+	request gen.PutNodeKernelModuleRequestObject,
+) (gen.PutNodeKernelModuleResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.PutNodeKernelModule400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Body); !ok {
+		return gen.PutNodeKernelModule400JSONResponse{Error: &errMsg}, nil
+	}
+
+	module := kernelProv.Module{
+		Name: request.Name,
+	}
+	if request.Body.Load != nil {
+		module.Load = *request.Body.Load
+	}
+	if request.Body.Blacklist != nil {
+		module.Blacklist = *request.Body.Blacklist
+	}
+	if request.Body.Options != nil {
+		module.Options = *request.Body.Options
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"kernel module update",
+		slog.String("target", hostname),
+		slog.String("name", module.Name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.putNodeKernelModuleUpdateBroadcast(ctx, hostname, module)
+	}
+
+	jobID, resp, err := s.JobClient.Modify(
+		ctx,
+		hostname,
+		"node",
+		job.OperationKernelModuleUpdate,
+		module,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "not found") || strings.Contains(errMsg, "does not exist") {
+			return gen.PutNodeKernelModule404JSONResponse{Error: &errMsg}, nil
+		}
+		return gen.PutNodeKernelModule500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		jobUUID := uuid.MustParse(jobID)
+		e := resp.Error
+		return gen.PutNodeKernelModule200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.KernelModuleMutationEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.KernelModuleMutationEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	var result kernelProv.ModuleResult
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &result)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	changed := resp.Changed
+	name := result.Name
+	agentHostname := resp.Hostname
+
+	return gen.PutNodeKernelModule200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.KernelModuleMutationEntry{
+			{
+				Hostname: agentHostname,
+				Status:   gen.KernelModuleMutationEntryStatusOk,
+				Name:     &name,
+				Changed:  changed,
+			},
+		},
+	}, nil
+}
+
+// putNodeKernelModuleUpdateBroadcast handles broadcast targets for kernel module update.
+func (s *Kernel) putNodeKernelModuleUpdateBroadcast(
+	ctx context.Context,
+	target string,
+	module kernelProv.Module,
+) (gen.PutNodeKernelModuleResponseObject, error) {
+	jobID, responses, err := s.JobClient.ModifyBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationKernelModuleUpdate,
+		module,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PutNodeKernelModule500JSONResponse{Error: &errMsg}, nil
+	}
+
+	var apiResponses []gen.KernelModuleMutationEntry
+	for host, resp := range responses {
+		item := gen.KernelModuleMutationEntry{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			item.Status = gen.KernelModuleMutationEntryStatusFailed
+			e := resp.Error
+			item.Error = &e
+		case job.StatusSkipped:
+			item.Status = gen.KernelModuleMutationEntryStatusSkipped
+			e := resp.Error
+			item.Error = &e
+		default:
+			item.Status = gen.KernelModuleMutationEntryStatusOk
+			var result kernelProv.ModuleResult
+			if resp.Data != nil {
+				_ = json.Unmarshal(resp.Data, &result)
+			}
+			name := result.Name
+			item.Name = &name
+			item.Changed = resp.Changed
+		}
+		apiResponses = append(apiResponses, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.PutNodeKernelModule200JSONResponse{
+		JobId:   &jobUUID,
+		Results: apiResponses,
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -108,12 +109,27 @@ type LogSourceEntry struct {
 // LogSourceEntryStatus The status of the operation for this host.
 type LogSourceEntryStatus string
 
+// Boot defines model for Boot.
+type Boot = string
+
+// Field defines model for Field.
+type Field = []string
+
+// Grep defines model for Grep.
+type Grep = string
+
 // Hostname defines model for Hostname.
 type Hostname = string
 
+// Reverse defines model for Reverse.
+type Reverse = bool
+
 // UnitName defines model for UnitName.
 type UnitName = string
 
+// Until defines model for Until.
+type Until = string
+
 // GetNodeLogParams defines parameters for GetNodeLog.
 type GetNodeLogParams struct {
 	// Lines Maximum number of log lines to return.
@@ -124,6 +140,36 @@ type GetNodeLogParams struct {
 
 	// Priority Filter by log priority level (e.g., "err", "warning", "info", "debug").
 	Priority *string `form:"priority,omitempty" json:"priority,omitempty" validate:"omitempty,oneof=emerg alert crit err warning notice info debug"`
+
+	// Until Return log entries until this time. Accepts systemd time specifications (e.g., "10m", "2026-01-01 00:00:00").
+	Until *Until `form:"until,omitempty" json:"until,omitempty" validate:"omitempty"`
+
+	// Boot Restrict entries to one boot: an offset such as "0" (current boot) or "-1" (previous boot), or a 32-character boot ID.
+	Boot *Boot `form:"boot,omitempty" json:"boot,omitempty" validate:"omitempty,max=32,numeric|hexadecimal"`
+
+	// Grep Return only entries whose message matches this regular expression.
+	Grep *Grep `form:"grep,omitempty" json:"grep,omitempty" validate:"omitempty,max=256"`
+
+	// Field Exact journal field match in FIELD=value form (e.g., "_COMM=sshd", "SYSLOG_IDENTIFIER=cron"). Repeat for multiple matches; all must match.
+	Field *Field `form:"field,omitempty" json:"field,omitempty" validate:"omitempty,max=16,dive,min=3,max=512"`
+
+	// Reverse Return the newest entries first.
+	Reverse *Reverse `form:"reverse,omitempty" json:"reverse,omitempty" validate:"omitempty"`
+}
+
+// GetNodeLogFileParams defines parameters for GetNodeLogFile.
+type GetNodeLogFileParams struct {
+	// Path Absolute path of the log file (e.g., "/var/log/syslog", "/var/log/nginx/error.log"). Must be an allow-listed file under /var/log.
+	Path string `form:"path" json:"path" validate:"required,startswith=/var/log/,max=4096"`
+
+	// Lines Maximum number of log lines to return.
+	Lines *int `form:"lines,omitempty" json:"lines,omitempty" validate:"omitempty,min=1,max=10000"`
+
+	// Grep Return only entries whose message matches this regular expression.
+	Grep *Grep `form:"grep,omitempty" json:"grep,omitempty" validate:"omitempty,max=256"`
+
+	// Reverse Return the newest entries first.
+	Reverse *Reverse `form:"reverse,omitempty" json:"reverse,omitempty" validate:"omitempty"`
 }
 
 // GetNodeLogUnitParams defines parameters for GetNodeLogUnit.
@@ -136,6 +182,33 @@ type GetNodeLogUnitParams struct {
 
 	// Priority Filter by log priority level (e.g., "err", "warning", "info", "debug").
 	Priority *string `form:"priority,omitempty" json:"priority,omitempty" validate:"omitempty,oneof=emerg alert crit err warning notice info debug"`
+
+	// Until Return log entries until this time. Accepts systemd time specifications (e.g., "10m", "2026-01-01 00:00:00").
+	Until *Until `form:"until,omitempty" json:"until,omitempty" validate:"omitempty"`
+
+	// Boot Restrict entries to one boot: an offset such as "0" (current boot) or "-1" (previous boot), or a 32-character boot ID.
+	Boot *Boot `form:"boot,omitempty" json:"boot,omitempty" validate:"omitempty,max=32,numeric|hexadecimal"`
+
+	// Grep Return only entries whose message matches this regular expression.
+	Grep *Grep `form:"grep,omitempty" json:"grep,omitempty" validate:"omitempty,max=256"`
+
+	// Field Exact journal field match in FIELD=value form (e.g., "_COMM=sshd", "SYSLOG_IDENTIFIER=cron"). Repeat for multiple matches; all must match.
+	Field *Field `form:"field,omitempty" json:"field,omitempty" validate:"omitempty,max=16,dive,min=3,max=512"`
+
+	// Reverse Return the newest entries first.
+	Reverse *Reverse `form:"reverse,omitempty" json:"reverse,omitempty" validate:"omitempty"`
+}
+
+// GetNodeLogUnitFollowParams defines parameters for GetNodeLogUnitFollow.
+type GetNodeLogUnitFollowParams struct {
+	// Lines Number of existing log lines to send before following.
+	Lines *int `form:"lines,omitempty" json:"lines,omitempty" validate:"omitempty,min=1,max=10000"`
+
+	// Priority Filter by log priority level (e.g., "err", "warning", "info", "debug").
+	Priority *string `form:"priority,omitempty" json:"priority,omitempty" validate:"omitempty,oneof=emerg alert crit err warning notice info debug"`
+
+	// Duration How long to follow the unit's log, in seconds.
+	Duration *int `form:"duration,omitempty" json:"duration,omitempty" validate:"omitempty,min=1,max=3600"`
 }
 
 // ServerInterface represents all server handlers.
@@ -143,12 +216,18 @@ type ServerInterface interface {
 	// Get system log entries
 	// (GET /api/node/{hostname}/log)
 	GetNodeLog(ctx echo.Context, hostname Hostname, params GetNodeLogParams) error
+	// Get entries from a plain log file
+	// (GET /api/node/{hostname}/log/file)
+	GetNodeLogFile(ctx echo.Context, hostname Hostname, params GetNodeLogFileParams) error
 	// List log sources
 	// (GET /api/node/{hostname}/log/source)
 	GetNodeLogSource(ctx echo.Context, hostname Hostname) error
 	// Get log entries for a systemd unit
 	// (GET /api/node/{hostname}/log/unit/{name})
 	GetNodeLogUnit(ctx echo.Context, hostname Hostname, name UnitName, params GetNodeLogUnitParams) error
+	// Follow log entries for a systemd unit
+	// (GET /api/node/{hostname}/log/unit/{name}/follow)
+	GetNodeLogUnitFollow(ctx echo.Context, hostname Hostname, name UnitName, params GetNodeLogUnitFollowParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -192,11 +271,94 @@ func (w *ServerInterfaceWrapper) GetNodeLog(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter priority: %s", err))
 	}
 
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// ------------- Optional query parameter "boot" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "boot", ctx.QueryParams(), &params.Boot)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter boot: %s", err))
+	}
+
+	// ------------- Optional query parameter "grep" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "grep", ctx.QueryParams(), &params.Grep)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter grep: %s", err))
+	}
+
+	// ------------- Optional query parameter "field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "field", ctx.QueryParams(), &params.Field)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter field: %s", err))
+	}
+
+	// ------------- Optional query parameter "reverse" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reverse", ctx.QueryParams(), &params.Reverse)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reverse: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetNodeLog(ctx, hostname, params)
 	return err
 }
 
+// GetNodeLogFile converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeLogFile(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"log:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodeLogFileParams
+	// ------------- Required query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "path", ctx.QueryParams(), &params.Path)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter path: %s", err))
+	}
+
+	// ------------- Optional query parameter "lines" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lines", ctx.QueryParams(), &params.Lines)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lines: %s", err))
+	}
+
+	// ------------- Optional query parameter "grep" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "grep", ctx.QueryParams(), &params.Grep)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter grep: %s", err))
+	}
+
+	// ------------- Optional query parameter "reverse" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reverse", ctx.QueryParams(), &params.Reverse)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reverse: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeLogFile(ctx, hostname, params)
+	return err
+}
+
 // GetNodeLogSource converts echo context to params.
 func (w *ServerInterfaceWrapper) GetNodeLogSource(ctx echo.Context) error {
 	var err error
@@ -259,11 +421,95 @@ func (w *ServerInterfaceWrapper) GetNodeLogUnit(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter priority: %s", err))
 	}
 
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// ------------- Optional query parameter "boot" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "boot", ctx.QueryParams(), &params.Boot)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter boot: %s", err))
+	}
+
+	// ------------- Optional query parameter "grep" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "grep", ctx.QueryParams(), &params.Grep)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter grep: %s", err))
+	}
+
+	// ------------- Optional query parameter "field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "field", ctx.QueryParams(), &params.Field)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter field: %s", err))
+	}
+
+	// ------------- Optional query parameter "reverse" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reverse", ctx.QueryParams(), &params.Reverse)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reverse: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetNodeLogUnit(ctx, hostname, name, params)
 	return err
 }
 
+// GetNodeLogUnitFollow converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeLogUnitFollow(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name UnitName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"log:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodeLogUnitFollowParams
+	// ------------- Optional query parameter "lines" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lines", ctx.QueryParams(), &params.Lines)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lines: %s", err))
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", ctx.QueryParams(), &params.Priority)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter priority: %s", err))
+	}
+
+	// ------------- Optional query parameter "duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "duration", ctx.QueryParams(), &params.Duration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeLogUnitFollow(ctx, hostname, name, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -293,8 +539,10 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.GET(baseURL+"/api/node/:hostname/log", wrapper.GetNodeLog)
+	router.GET(baseURL+"/api/node/:hostname/log/file", wrapper.GetNodeLogFile)
 	router.GET(baseURL+"/api/node/:hostname/log/source", wrapper.GetNodeLogSource)
 	router.GET(baseURL+"/api/node/:hostname/log/unit/:name", wrapper.GetNodeLogUnit)
+	router.GET(baseURL+"/api/node/:hostname/log/unit/:name/follow", wrapper.GetNodeLogUnitFollow)
 
 }
 
@@ -352,6 +600,60 @@ func (response GetNodeLog500JSONResponse) VisitGetNodeLogResponse(w http.Respons
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetNodeLogFileRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+	Params   GetNodeLogFileParams
+}
+
+type GetNodeLogFileResponseObject interface {
+	VisitGetNodeLogFileResponse(w http.ResponseWriter) error
+}
+
+type GetNodeLogFile200JSONResponse LogCollectionResponse
+
+func (response GetNodeLogFile200JSONResponse) VisitGetNodeLogFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeLogFile400JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeLogFile400JSONResponse) VisitGetNodeLogFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeLogFile401JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeLogFile401JSONResponse) VisitGetNodeLogFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeLogFile403JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeLogFile403JSONResponse) VisitGetNodeLogFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeLogFile500JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeLogFile500JSONResponse) VisitGetNodeLogFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetNodeLogSourceRequestObject struct {
 	Hostname Hostname `json:"hostname"`
 }
@@ -460,17 +762,88 @@ func (response GetNodeLogUnit500JSONResponse) VisitGetNodeLogUnitResponse(w http
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetNodeLogUnitFollowRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+	Name     UnitName `json:"name"`
+	Params   GetNodeLogUnitFollowParams
+}
+
+type GetNodeLogUnitFollowResponseObject interface {
+	VisitGetNodeLogUnitFollowResponse(w http.ResponseWriter) error
+}
+
+type GetNodeLogUnitFollow200TextEventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetNodeLogUnitFollow200TextEventStreamResponse) VisitGetNodeLogUnitFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetNodeLogUnitFollow400JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeLogUnitFollow400JSONResponse) VisitGetNodeLogUnitFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeLogUnitFollow401JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeLogUnitFollow401JSONResponse) VisitGetNodeLogUnitFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeLogUnitFollow403JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeLogUnitFollow403JSONResponse) VisitGetNodeLogUnitFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeLogUnitFollow500JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeLogUnitFollow500JSONResponse) VisitGetNodeLogUnitFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// Get system log entries
 	// (GET /api/node/{hostname}/log)
 	GetNodeLog(ctx context.Context, request GetNodeLogRequestObject) (GetNodeLogResponseObject, error)
+	// Get entries from a plain log file
+	// (GET /api/node/{hostname}/log/file)
+	GetNodeLogFile(ctx context.Context, request GetNodeLogFileRequestObject) (GetNodeLogFileResponseObject, error)
 	// List log sources
 	// (GET /api/node/{hostname}/log/source)
 	GetNodeLogSource(ctx context.Context, request GetNodeLogSourceRequestObject) (GetNodeLogSourceResponseObject, error)
 	// Get log entries for a systemd unit
 	// (GET /api/node/{hostname}/log/unit/{name})
 	GetNodeLogUnit(ctx context.Context, request GetNodeLogUnitRequestObject) (GetNodeLogUnitResponseObject, error)
+	// Follow log entries for a systemd unit
+	// (GET /api/node/{hostname}/log/unit/{name}/follow)
+	GetNodeLogUnitFollow(ctx context.Context, request GetNodeLogUnitFollowRequestObject) (GetNodeLogUnitFollowResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -511,6 +884,32 @@ func (sh *strictHandler) GetNodeLog(ctx echo.Context, hostname Hostname, params
 	return nil
 }
 
+// GetNodeLogFile operation middleware
+func (sh *strictHandler) GetNodeLogFile(ctx echo.Context, hostname Hostname, params GetNodeLogFileParams) error {
+	var request GetNodeLogFileRequestObject
+
+	request.Hostname = hostname
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNodeLogFile(ctx.Request().Context(), request.(GetNodeLogFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNodeLogFile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetNodeLogFileResponseObject); ok {
+		return validResponse.VisitGetNodeLogFileResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetNodeLogSource operation middleware
 func (sh *strictHandler) GetNodeLogSource(ctx echo.Context, hostname Hostname) error {
 	var request GetNodeLogSourceRequestObject
@@ -562,3 +961,30 @@ func (sh *strictHandler) GetNodeLogUnit(ctx echo.Context, hostname Hostname, nam
 	}
 	return nil
 }
+
+// GetNodeLogUnitFollow operation middleware
+func (sh *strictHandler) GetNodeLogUnitFollow(ctx echo.Context, hostname Hostname, name UnitName, params GetNodeLogUnitFollowParams) error {
+	var request GetNodeLogUnitFollowRequestObject
+
+	request.Hostname = hostname
+	request.Name = name
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNodeLogUnitFollow(ctx.Request().Context(), request.(GetNodeLogUnitFollowRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNodeLogUnitFollow")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetNodeLogUnitFollowResponseObject); ok {
+		return validResponse.VisitGetNodeLogUnitFollowResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package log
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/log/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	logProv "github.com/osapi-io/osapi/internal/provider/node/log"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// fileQueryPayload is the JSON payload sent to the agent for plain log file queries.
+type fileQueryPayload struct {
+	Path string `json:"path"`
+	logProv.FileQueryOpts
+}
+
+// GetNodeLogFile returns entries from an allow-listed plain log file on a target node.
+func (s *Log) GetNodeLogFile(
+	ctx context.Context,
+	request gen.GetNodeLogFileRequestObject,
+) (gen.GetNodeLogFileResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeLogFile400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Params); !ok {
+		return gen.GetNodeLogFile400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"log file query",
+		slog.String("target", hostname),
+		slog.String("path", request.Params.Path),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	payload := fileQueryPayload{
+		Path: request.Params.Path,
+	}
+	if request.Params.Lines != nil {
+		payload.Lines = *request.Params.Lines
+	}
+	if request.Params.Grep != nil {
+		payload.Grep = *request.Params.Grep
+	}
+	if request.Params.Reverse != nil {
+		payload.Reverse = *request.Params.Reverse
+	}
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeLogFileBroadcast(ctx, hostname, payload)
+	}
+
+	jobID, resp, err := s.JobClient.Query(ctx, hostname, "node", job.OperationLogQueryFile, payload)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeLogFile500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeLogFile200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.LogResultEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.LogResultEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	entries := logEntriesFromResponse(resp)
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeLogFile200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.LogResultEntry{
+			{
+				Hostname: resp.Hostname,
+				Status:   gen.LogResultEntryStatusOk,
+				Entries:  &entries,
+			},
+		},
+	}, nil
+}
+
+// getNodeLogFileBroadcast handles broadcast targets for plain log file query.
+func (s *Log) getNodeLogFileBroadcast(
+	ctx context.Context,
+	target string,
+	payload fileQueryPayload,
+) (gen.GetNodeLogFileResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationLogQueryFile,
+		payload,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeLogFile500JSONResponse{Error: &errMsg}, nil
+	}
+
+	var items []gen.LogResultEntry
+	for host, resp := range responses {
+		item := gen.LogResultEntry{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			item.Status = gen.LogResultEntryStatusFailed
+			e := resp.Error
+			item.Error = &e
+		case job.StatusSkipped:
+			item.Status = gen.LogResultEntryStatusSkipped
+			e := resp.Error
+			item.Error = &e
+		default:
+			item.Status = gen.LogResultEntryStatusOk
+			entries := logEntriesFromResponse(resp)
+			item.Entries = &entries
+		}
+		items = append(items, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	return gen.GetNodeLogFile200JSONResponse{
+		JobId:   &jobUUID,
+		Results: items,
+	}, nil
+}
@@ -54,16 +54,33 @@ func (s *Log) GetNodeLog(
 		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
 	)
 
-	opts := logProv.QueryOpts{}
+	fields, errMsg, ok := parseFieldMatches(request.Params.Field)
+	if !ok {
+		return gen.GetNodeLog400JSONResponse{Error: &errMsg}, nil
+	}
+
+	opts := logProv.QueryOpts{Fields: fields}
 	if request.Params.Lines != nil {
 		opts.Lines = *request.Params.Lines
 	}
 	if request.Params.Since != nil {
 		opts.Since = *request.Params.Since
 	}
+	if request.Params.Until != nil {
+		opts.Until = *request.Params.Until
+	}
 	if request.Params.Priority != nil {
 		opts.Priority = *request.Params.Priority
 	}
+	if request.Params.Boot != nil {
+		opts.Boot = *request.Params.Boot
+	}
+	if request.Params.Grep != nil {
+		opts.Grep = *request.Params.Grep
+	}
+	if request.Params.Reverse != nil {
+		opts.Reverse = *request.Params.Reverse
+	}
 
 	if job.IsBroadcastTarget(hostname) {
 		return s.getNodeLogBroadcast(ctx, hostname, opts)
@@ -41,6 +41,7 @@ import (
 	"github.com/osapi-io/osapi/internal/controller/api/node/log/gen"
 	"github.com/osapi-io/osapi/internal/job"
 	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	logProv "github.com/osapi-io/osapi/internal/provider/node/log"
 	"github.com/osapi-io/osapi/internal/validation"
 )
 
@@ -151,6 +152,107 @@ func (s *LogQueryPublicTestSuite) TestGetNodeLog() {
 				s.Equal(gen.LogResultEntryStatusOk, r.Results[0].Status)
 			},
 		},
+		{
+			name: "success with extended filters",
+			request: gen.GetNodeLogRequestObject{
+				Hostname: "server1",
+				Params: gen.GetNodeLogParams{
+					Until:   stringPtr("10m"),
+					Boot:    stringPtr("-1"),
+					Grep:    stringPtr("timeout"),
+					Field:   &[]string{"_COMM=sshd", "SYSLOG_IDENTIFIER=sshd"},
+					Reverse: boolPtr(true),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationLogQuery,
+						gomock.Any(),
+					).
+					DoAndReturn(func(
+						_ context.Context,
+						_ string,
+						_ string,
+						_ job.OperationType,
+						data any,
+					) (string, *job.Response, error) {
+						opts, ok := data.(logProv.QueryOpts)
+						s.True(ok)
+						s.Equal(logProv.QueryOpts{
+							Until:   "10m",
+							Boot:    "-1",
+							Grep:    "timeout",
+							Reverse: true,
+							Fields: map[string]string{
+								"_COMM":             "sshd",
+								"SYSLOG_IDENTIFIER": "sshd",
+							},
+						}, opts)
+						return "550e8400-e29b-41d4-a716-446655440000", &job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Data:     json.RawMessage(`[]`),
+						}, nil
+					})
+			},
+			validateFunc: func(resp gen.GetNodeLogResponseObject) {
+				r, ok := resp.(gen.GetNodeLog200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.LogResultEntryStatusOk, r.Results[0].Status)
+			},
+		},
+		{
+			name: "validation error malformed field match",
+			request: gen.GetNodeLogRequestObject{
+				Hostname: "server1",
+				Params: gen.GetNodeLogParams{
+					Field: &[]string{"comm=sshd"},
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.GetNodeLogResponseObject) {
+				r, ok := resp.(gen.GetNodeLog400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, `invalid field match "comm=sshd"`)
+			},
+		},
+		{
+			name: "validation error field match without value separator",
+			request: gen.GetNodeLogRequestObject{
+				Hostname: "server1",
+				Params: gen.GetNodeLogParams{
+					Field: &[]string{"_COMM"},
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.GetNodeLogResponseObject) {
+				r, ok := resp.(gen.GetNodeLog400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "expected FIELD=value")
+			},
+		},
+		{
+			name: "validation error invalid boot",
+			request: gen.GetNodeLogRequestObject{
+				Hostname: "server1",
+				Params: gen.GetNodeLogParams{
+					Boot: stringPtr("last"),
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.GetNodeLogResponseObject) {
+				r, ok := resp.(gen.GetNodeLog400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+			},
+		},
 		{
 			name: "validation error empty hostname",
 			request: gen.GetNodeLogRequestObject{
@@ -546,6 +648,7 @@ func (s *LogQueryPublicTestSuite) TestGetNodeLogRBACHTTP() {
 
 func intPtr(i int) *int          { return &i }
 func stringPtr(s string) *string { return &s }
+func boolPtr(b bool) *bool       { return &b }
 
 func TestLogQueryPublicTestSuite(t *testing.T) {
 	suite.Run(t, new(LogQueryPublicTestSuite))
@@ -0,0 +1,206 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package log
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/log/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	logProv "github.com/osapi-io/osapi/internal/provider/node/log"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+const (
+	// defaultFollowDuration is the follow duration in seconds when the
+	// request does not specify one.
+	defaultFollowDuration = 60
+	// followGracePeriod extends the stream deadline past the requested
+	// duration so the agent's end-of-stream marker can arrive.
+	followGracePeriod = 10 * time.Second
+)
+
+// unitFollowPayload is the JSON payload sent to the agent for unit log follows.
+type unitFollowPayload struct {
+	Unit     string `json:"unit"`
+	Lines    int    `json:"lines,omitempty"`
+	Priority string `json:"priority,omitempty"`
+	Duration int    `json:"duration"`
+}
+
+// GetNodeLogUnitFollow streams new log entries for a specific systemd unit
+// from a target node as Server-Sent Events.
+func (s *Log) GetNodeLogUnitFollow(
+	ctx context.Context,
+	request gen.GetNodeLogUnitFollowRequestObject,
+) (gen.GetNodeLogUnitFollowResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeLogUnitFollow400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Params); !ok {
+		return gen.GetNodeLogUnitFollow400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+
+	if job.IsBroadcastTarget(hostname) {
+		errMsg := "log follow requires a single target"
+		return gen.GetNodeLogUnitFollow400JSONResponse{Error: &errMsg}, nil
+	}
+
+	payload := unitFollowPayload{
+		Unit:     request.Name,
+		Duration: defaultFollowDuration,
+	}
+	if request.Params.Lines != nil {
+		payload.Lines = *request.Params.Lines
+	}
+	if request.Params.Priority != nil {
+		payload.Priority = *request.Params.Priority
+	}
+	if request.Params.Duration != nil {
+		payload.Duration = *request.Params.Duration
+	}
+
+	s.logger.Debug(
+		"log unit follow",
+		slog.String("target", hostname),
+		slog.String("unit", request.Name),
+		slog.Int("duration", payload.Duration),
+	)
+
+	streamCtx, cancel := context.WithTimeout(
+		ctx,
+		time.Duration(payload.Duration)*time.Second+followGracePeriod,
+	)
+
+	jobID, resp, entries, err := s.JobClient.QueryStream(
+		streamCtx,
+		hostname,
+		"node",
+		job.OperationLogFollow,
+		payload,
+	)
+	if err != nil {
+		cancel()
+		errMsg := err.Error()
+		return gen.GetNodeLogUnitFollow500JSONResponse{Error: &errMsg}, nil
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	start := gen.LogCollectionResponse{
+		JobId: &jobUUID,
+		Results: []gen.LogResultEntry{
+			{
+				Hostname: resp.Hostname,
+				Status:   gen.LogResultEntryStatusOk,
+			},
+		},
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		start.Results[0].Status = gen.LogResultEntryStatusSkipped
+		start.Results[0].Error = &e
+	}
+
+	return logFollowStream{
+		start:   start,
+		entries: entries,
+		cancel:  cancel,
+		logger:  s.logger,
+	}, nil
+}
+
+// logFollowStream writes a unit log follow as Server-Sent Events, flushing
+// after each event so entries reach the client as they arrive.
+type logFollowStream struct {
+	start   gen.LogCollectionResponse
+	entries <-chan []byte
+	cancel  context.CancelFunc
+	logger  *slog.Logger
+}
+
+// VisitGetNodeLogUnitFollowResponse implements gen.GetNodeLogUnitFollowResponseObject.
+func (r logFollowStream) VisitGetNodeLogUnitFollowResponse(
+	w http.ResponseWriter,
+) error {
+	defer r.cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	if err := writeEvent(w, "start", r.start); err != nil {
+		return err
+	}
+
+	// Skipped jobs have no stream; ranging over a nil channel would block.
+	if r.entries != nil {
+		for data := range r.entries {
+			var entry logProv.Entry
+			if err := json.Unmarshal(data, &entry); err != nil {
+				r.logger.Warn(
+					"skipping malformed log follow entry",
+					slog.String("error", err.Error()),
+				)
+
+				continue
+			}
+
+			if err := writeEvent(w, "entry", logEntryToGen(entry)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return writeEvent(w, "end", struct{}{})
+}
+
+// writeEvent writes a single Server-Sent Event with a JSON payload and
+// flushes it to the client.
+func writeEvent(
+	w io.Writer,
+	event string,
+	data any,
+) error {
+	// Event payloads are generated API types, so Marshal always succeeds.
+	payload, _ := json.Marshal(data)
+
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload); err != nil {
+		return err
+	}
+
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+
+	return nil
+}
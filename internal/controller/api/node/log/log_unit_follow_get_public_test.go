@@ -0,0 +1,478 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package log_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	logAPI "github.com/osapi-io/osapi/internal/controller/api/node/log"
+	"github.com/osapi-io/osapi/internal/controller/api/node/log/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+const followJobID = "550e8400-e29b-41d4-a716-446655440000"
+
+// followEntry is a valid streamed log entry used across follow tests.
+const followEntry = `{"timestamp":"2026-01-01T00:00:00Z","unit":"sshd","priority":"info","message":"Accepted publickey","pid":1234,"hostname":"agent1"}`
+
+// streamOf returns a closed channel pre-loaded with the given messages.
+func streamOf(
+	msgs ...string,
+) <-chan []byte {
+	ch := make(chan []byte, len(msgs))
+	for _, m := range msgs {
+		ch <- []byte(m)
+	}
+	close(ch)
+
+	return ch
+}
+
+type LogUnitFollowPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *logAPI.Log
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *LogUnitFollowPublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *LogUnitFollowPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = logAPI.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *LogUnitFollowPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *LogUnitFollowPublicTestSuite) TestGetNodeLogUnitFollow() {
+	tests := []struct {
+		name         string
+		request      gen.GetNodeLogUnitFollowRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.GetNodeLogUnitFollowResponseObject)
+	}{
+		{
+			name: "when success returns stream",
+			request: gen.GetNodeLogUnitFollowRequestObject{
+				Hostname: "server1",
+				Name:     "sshd.service",
+				Params: gen.GetNodeLogUnitFollowParams{
+					Lines:    intPtr(5),
+					Priority: stringPtr("err"),
+					Duration: intPtr(30),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryStream(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationLogFollow,
+						gomock.Any(),
+					).
+					DoAndReturn(func(
+						ctx context.Context,
+						_, _ string,
+						_ job.OperationType,
+						data any,
+					) (string, *job.Response, <-chan []byte, error) {
+						_, hasDeadline := ctx.Deadline()
+						s.True(hasDeadline)
+						s.Equal(
+							`{"unit":"sshd.service","lines":5,"priority":"err","duration":30}`,
+							mustMarshal(data),
+						)
+
+						return followJobID, &job.Response{
+							Hostname: "agent1",
+							Status:   job.StatusCompleted,
+						}, streamOf(followEntry), nil
+					})
+			},
+			validateFunc: func(resp gen.GetNodeLogUnitFollowResponseObject) {
+				s.NotNil(resp)
+				_, isErr := resp.(gen.GetNodeLogUnitFollow500JSONResponse)
+				s.False(isErr)
+			},
+		},
+		{
+			name: "when default duration is sent to agent",
+			request: gen.GetNodeLogUnitFollowRequestObject{
+				Hostname: "server1",
+				Name:     "sshd.service",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryStream(gomock.Any(), "server1", "node", job.OperationLogFollow, gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						_, _ string,
+						_ job.OperationType,
+						data any,
+					) (string, *job.Response, <-chan []byte, error) {
+						s.Equal(`{"unit":"sshd.service","duration":60}`, mustMarshal(data))
+
+						return followJobID, &job.Response{
+							Hostname: "agent1",
+							Status:   job.StatusCompleted,
+						}, streamOf(), nil
+					})
+			},
+			validateFunc: func(resp gen.GetNodeLogUnitFollowResponseObject) {
+				s.NotNil(resp)
+			},
+		},
+		{
+			name: "when broadcast target returns 400",
+			request: gen.GetNodeLogUnitFollowRequestObject{
+				Hostname: "_all",
+				Name:     "sshd.service",
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.GetNodeLogUnitFollowResponseObject) {
+				r, ok := resp.(gen.GetNodeLogUnitFollow400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "single target")
+			},
+		},
+		{
+			name: "when empty hostname returns 400",
+			request: gen.GetNodeLogUnitFollowRequestObject{
+				Hostname: "",
+				Name:     "sshd.service",
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.GetNodeLogUnitFollowResponseObject) {
+				r, ok := resp.(gen.GetNodeLogUnitFollow400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+			},
+		},
+		{
+			name: "when duration out of range returns 400",
+			request: gen.GetNodeLogUnitFollowRequestObject{
+				Hostname: "server1",
+				Name:     "sshd.service",
+				Params: gen.GetNodeLogUnitFollowParams{
+					Duration: intPtr(7200),
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.GetNodeLogUnitFollowResponseObject) {
+				r, ok := resp.(gen.GetNodeLogUnitFollow400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "max")
+			},
+		},
+		{
+			name: "when job client errors returns 500",
+			request: gen.GetNodeLogUnitFollowRequestObject{
+				Hostname: "server1",
+				Name:     "sshd.service",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryStream(gomock.Any(), "server1", "node", job.OperationLogFollow, gomock.Any()).
+					Return("", nil, nil, errors.New("subscribe failed"))
+			},
+			validateFunc: func(resp gen.GetNodeLogUnitFollowResponseObject) {
+				r, ok := resp.(gen.GetNodeLogUnitFollow500JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Equal("subscribe failed", *r.Error)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			resp, err := s.handler.GetNodeLogUnitFollow(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+func (s *LogUnitFollowPublicTestSuite) TestGetNodeLogUnitFollowHTTP() {
+	tests := []struct {
+		name            string
+		path            string
+		setupJobMock    func() *jobmocks.MockJobClient
+		wantCode        int
+		wantContentType string
+		wantContains    []string
+		wantNotContains []string
+	}{
+		{
+			name: "when valid request streams events",
+			path: "/api/node/server1/log/unit/sshd.service/follow?duration=5",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					QueryStream(gomock.Any(), "server1", "node", job.OperationLogFollow, gomock.Any()).
+					Return(followJobID, &job.Response{
+						Hostname: "agent1",
+						Status:   job.StatusCompleted,
+					}, streamOf(followEntry, "not-json", followEntry), nil)
+				return mock
+			},
+			wantCode:        http.StatusOK,
+			wantContentType: "text/event-stream",
+			wantContains: []string{
+				"event: start\ndata: {\"job_id\":\"" + followJobID + "\"",
+				`"status":"ok"`,
+				"event: entry\ndata: {",
+				`"message":"Accepted publickey"`,
+				"event: end\ndata: {}\n\n",
+			},
+			wantNotContains: []string{"not-json"},
+		},
+		{
+			name: "when agent skips streams skipped start and end",
+			path: "/api/node/server1/log/unit/sshd.service/follow",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					QueryStream(gomock.Any(), "server1", "node", job.OperationLogFollow, gomock.Any()).
+					Return(followJobID, &job.Response{
+						Hostname: "agent1",
+						Status:   job.StatusSkipped,
+						Error:    "unsupported",
+					}, nil, nil)
+				return mock
+			},
+			wantCode:        http.StatusOK,
+			wantContentType: "text/event-stream",
+			wantContains: []string{
+				`"status":"skipped"`,
+				`"error":"unsupported"`,
+				"event: end",
+			},
+			wantNotContains: []string{"event: entry"},
+		},
+		{
+			name: "when invalid priority returns 400",
+			path: "/api/node/server1/log/unit/sshd.service/follow?priority=bogus",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "oneof"},
+		},
+		{
+			name: "when target agent not found",
+			path: "/api/node/nonexistent/log/unit/sshd.service/follow",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "valid_target", "not found"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			logHandler := logAPI.New(s.logger, jobMock)
+			strictHandler := gen.NewStrictHandler(logHandler, nil)
+
+			a := api.New(s.appConfig, s.logger)
+			gen.RegisterHandlers(a.Echo, strictHandler)
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			rec := httptest.NewRecorder()
+
+			a.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			if tc.wantContentType != "" {
+				s.Equal(tc.wantContentType, rec.Header().Get("Content-Type"))
+			}
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+			for _, str := range tc.wantNotContains {
+				s.NotContains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+const rbacLogUnitFollowTestSigningKey = "test-signing-key-for-rbac-log-unit-follow"
+
+func (s *LogUnitFollowPublicTestSuite) TestGetNodeLogUnitFollowRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when no token returns 401",
+			setupAuth: func(_ *http.Request) {
+				// No auth header set
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusUnauthorized,
+			wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacLogUnitFollowTestSigningKey,
+					[]string{"write"},
+					"test-user",
+					[]string{"docker:write"},
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid token with log:read returns 200",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacLogUnitFollowTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					QueryStream(gomock.Any(), "server1", "node", job.OperationLogFollow, gomock.Any()).
+					Return(followJobID, &job.Response{
+						Hostname: "agent1",
+						Status:   job.StatusCompleted,
+					}, streamOf(), nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{"event: start", "event: end"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{
+							SigningKey: rbacLogUnitFollowTestSigningKey,
+						},
+					},
+				},
+			}
+
+			server := api.New(appConfig, s.logger)
+			handlers := logAPI.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+
+			req := httptest.NewRequest(
+				http.MethodGet,
+				"/api/node/server1/log/unit/sshd.service/follow",
+				nil,
+			)
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+
+			server.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+// mustMarshal returns the JSON encoding of v as a string.
+func mustMarshal(
+	v any,
+) string {
+	b, _ := json.Marshal(v)
+
+	return string(b)
+}
+
+func TestLogUnitFollowPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(LogUnitFollowPublicTestSuite))
+}
@@ -35,10 +35,8 @@ import (
 
 // unitQueryPayload is the JSON payload sent to the agent for unit log queries.
 type unitQueryPayload struct {
-	Unit     string `json:"unit"`
-	Lines    int    `json:"lines,omitempty"`
-	Since    string `json:"since,omitempty"`
-	Priority string `json:"priority,omitempty"`
+	Unit string `json:"unit"`
+	logProv.QueryOpts
 }
 
 // GetNodeLogUnit returns log entries for a specific systemd unit from a target node.
@@ -63,8 +61,14 @@ func (s *Log) GetNodeLogUnit(
 		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
 	)
 
+	fields, errMsg, ok := parseFieldMatches(request.Params.Field)
+	if !ok {
+		return gen.GetNodeLogUnit400JSONResponse{Error: &errMsg}, nil
+	}
+
 	payload := unitQueryPayload{
-		Unit: request.Name,
+		Unit:      request.Name,
+		QueryOpts: logProv.QueryOpts{Fields: fields},
 	}
 	if request.Params.Lines != nil {
 		payload.Lines = *request.Params.Lines
@@ -72,9 +76,21 @@ func (s *Log) GetNodeLogUnit(
 	if request.Params.Since != nil {
 		payload.Since = *request.Params.Since
 	}
+	if request.Params.Until != nil {
+		payload.Until = *request.Params.Until
+	}
 	if request.Params.Priority != nil {
 		payload.Priority = *request.Params.Priority
 	}
+	if request.Params.Boot != nil {
+		payload.Boot = *request.Params.Boot
+	}
+	if request.Params.Grep != nil {
+		payload.Grep = *request.Params.Grep
+	}
+	if request.Params.Reverse != nil {
+		payload.Reverse = *request.Params.Reverse
+	}
 
 	if job.IsBroadcastTarget(hostname) {
 		return s.getNodeLogUnitBroadcast(ctx, hostname, payload)
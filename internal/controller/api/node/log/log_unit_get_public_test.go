@@ -328,6 +328,72 @@ func (s *LogUnitPublicTestSuite) TestGetNodeLogUnit() {
 				s.Len(r.Results, 3)
 			},
 		},
+		{
+			name: "success with extended filters",
+			request: gen.GetNodeLogUnitRequestObject{
+				Hostname: "server1",
+				Name:     "sshd.service",
+				Params: gen.GetNodeLogUnitParams{
+					Until:   stringPtr("2026-01-01 00:00:00"),
+					Boot:    stringPtr("0"),
+					Grep:    stringPtr("Accepted"),
+					Field:   &[]string{"_PID=1234"},
+					Reverse: boolPtr(true),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationLogQueryUnit,
+						gomock.Any(),
+					).
+					DoAndReturn(func(
+						_ context.Context,
+						_ string,
+						_ string,
+						_ job.OperationType,
+						data any,
+					) (string, *job.Response, error) {
+						b, err := json.Marshal(data)
+						s.Require().NoError(err)
+						s.JSONEq(
+							`{"unit":"sshd.service","until":"2026-01-01 00:00:00","boot":"0",`+
+								`"grep":"Accepted","fields":{"_PID":"1234"},"reverse":true}`,
+							string(b),
+						)
+						return "550e8400-e29b-41d4-a716-446655440000", &job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Data:     json.RawMessage(`[]`),
+						}, nil
+					})
+			},
+			validateFunc: func(resp gen.GetNodeLogUnitResponseObject) {
+				r, ok := resp.(gen.GetNodeLogUnit200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+			},
+		},
+		{
+			name: "validation error malformed field match",
+			request: gen.GetNodeLogUnitRequestObject{
+				Hostname: "server1",
+				Name:     "sshd.service",
+				Params: gen.GetNodeLogUnitParams{
+					Field: &[]string{"1BAD=x"},
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.GetNodeLogUnitResponseObject) {
+				r, ok := resp.(gen.GetNodeLogUnit400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, `invalid field match "1BAD=x"`)
+			},
+		},
 		{
 			name: "broadcast error collecting responses",
 			request: gen.GetNodeLogUnitRequestObject{
@@ -397,6 +463,37 @@ func (s *LogUnitPublicTestSuite) TestGetNodeLogUnitHTTP() {
 			wantCode:     http.StatusBadRequest,
 			wantContains: []string{`"error"`, "valid_target", "not found"},
 		},
+		{
+			name: "when repeated field params are sent",
+			path: "/api/node/server1/log/unit/sshd.service?field=_COMM%3Dsshd&field=_UID%3D0&reverse=true",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Query(gomock.Any(), "server1", "node", job.OperationLogQueryUnit, gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						_ string,
+						_ string,
+						_ job.OperationType,
+						data any,
+					) (string, *job.Response, error) {
+						b, err := json.Marshal(data)
+						s.Require().NoError(err)
+						s.JSONEq(
+							`{"unit":"sshd.service","fields":{"_COMM":"sshd","_UID":"0"},"reverse":true}`,
+							string(b),
+						)
+						return "550e8400-e29b-41d4-a716-446655440000", &job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Data:     json.RawMessage(`[]`),
+						}, nil
+					})
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
 		{
 			name: "when invalid priority returns 400",
 			path: "/api/node/server1/log/unit/sshd.service?priority=bogus",
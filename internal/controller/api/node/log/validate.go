@@ -20,7 +20,17 @@
 
 package log
 
-import "github.com/osapi-io/osapi/internal/validation"
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// journalFieldPattern matches valid journal field names: uppercase
+// letters, digits, and underscores, not starting with a digit.
+var journalFieldPattern = regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`)
 
 // validateHostname validates a hostname path parameter using the shared
 // validator. Returns the error message and false if invalid.
@@ -32,3 +42,29 @@ func validateHostname(
 ) (string, bool) {
 	return validation.Var(hostname, "required,min=1,valid_target")
 }
+
+// parseFieldMatches converts FIELD=value query parameters into a journal
+// field match map. Returns the error message and false if any match is
+// malformed.
+func parseFieldMatches(
+	fields *[]string,
+) (map[string]string, string, bool) {
+	if fields == nil || len(*fields) == 0 {
+		return nil, "", true
+	}
+
+	matches := make(map[string]string, len(*fields))
+	for _, f := range *fields {
+		name, value, ok := strings.Cut(f, "=")
+		if !ok || !journalFieldPattern.MatchString(name) {
+			return nil, fmt.Sprintf(
+				"invalid field match %q: expected FIELD=value with an uppercase field name",
+				f,
+			), false
+		}
+
+		matches[name] = value
+	}
+
+	return matches, "", true
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package mount
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/mount/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	mountProv "github.com/osapi-io/osapi/internal/provider/node/mount"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// PostNodeMount creates a managed fstab entry on a target node.
+func (s *Mount) PostNodeMount(
+	ctx context.Context,
+	request gen.PostNodeMountRequestObject,
+) (gen.PostNodeMountResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.PostNodeMount400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Body); !ok {
+		return gen.PostNodeMount400JSONResponse{Error: &errMsg}, nil
+	}
+
+	entry := mountProv.Entry{
+		Name:   request.Body.Name,
+		Device: request.Body.Device,
+		Path:   request.Body.Path,
+		FSType: request.Body.FsType,
+	}
+	if request.Body.Options != nil {
+		entry.Options = *request.Body.Options
+	}
+	if request.Body.Dump != nil {
+		entry.Dump = *request.Body.Dump
+	}
+	if request.Body.Pass != nil {
+		entry.Pass = *request.Body.Pass
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"mount create",
+		slog.String("target", hostname),
+		slog.String("name", entry.Name),
+		slog.String("device", entry.Device),
+		slog.String("path", entry.Path),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.postNodeMountCreateBroadcast(ctx, hostname, entry)
+	}
+
+	jobID, resp, err := s.JobClient.Modify(
+		ctx,
+		hostname,
+		"node",
+		job.OperationMountCreate,
+		entry,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PostNodeMount500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		jobUUID := uuid.MustParse(jobID)
+		e := resp.Error
+		return gen.PostNodeMount200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.MountMutationEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.MountMutationEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	var result mountProv.CreateResult
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &result)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	changed := resp.Changed
+	name := result.Name
+	agentHostname := resp.Hostname
+
+	return gen.PostNodeMount200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.MountMutationEntry{
+			{
+				Hostname: agentHostname,
+				Status:   gen.MountMutationEntryStatusOk,
+				Name:     &name,
+				Changed:  changed,
+			},
+		},
+	}, nil
+}
+
+// postNodeMountCreateBroadcast handles broadcast targets for mount create.
+func (s *Mount) postNodeMountCreateBroadcast(
+	ctx context.Context,
+	target string,
+	entry mountProv.Entry,
+) (gen.PostNodeMountResponseObject, error) {
+	jobID, responses, err := s.JobClient.ModifyBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationMountCreate,
+		entry,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PostNodeMount500JSONResponse{Error: &errMsg}, nil
+	}
+
+	var apiResponses []gen.MountMutationEntry
+	for host, resp := range responses {
+		item := gen.MountMutationEntry{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			item.Status = gen.MountMutationEntryStatusFailed
+			e := resp.Error
+			item.Error = &e
+		case job.StatusSkipped:
+			item.Status = gen.MountMutationEntryStatusSkipped
+			e := resp.Error
+			item.Error = &e
+		default:
+			item.Status = gen.MountMutationEntryStatusOk
+			var result mountProv.CreateResult
+			if resp.Data != nil {
+				_ = json.Unmarshal(resp.Data, &result)
+			}
+			name := result.Name
+			item.Name = &name
+			item.Changed = resp.Changed
+		}
+		apiResponses = append(apiResponses, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.PostNodeMount200JSONResponse{
+		JobId:   &jobUUID,
+		Results: apiResponses,
+	}, nil
+}
@@ -0,0 +1,436 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package mount_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	apimount "github.com/osapi-io/osapi/internal/controller/api/node/mount"
+	"github.com/osapi-io/osapi/internal/controller/api/node/mount/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type MountDeletePublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *apimount.Mount
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *MountDeletePublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *MountDeletePublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = apimount.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *MountDeletePublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *MountDeletePublicTestSuite) TestDeleteNodeMount() {
+	tests := []struct {
+		name         string
+		request      gen.DeleteNodeMountRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.DeleteNodeMountResponseObject)
+	}{
+		{
+			name: "success",
+			request: gen.DeleteNodeMountRequestObject{
+				Hostname: "server1",
+				Name:     "data",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationMountDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID: "550e8400-e29b-41d4-a716-446655440000", Hostname: "agent1", Changed: boolPtr(true),
+						Data: json.RawMessage(`{"name":"data","changed":true}`),
+					}, nil)
+			},
+			validateFunc: func(resp gen.DeleteNodeMountResponseObject) {
+				r, ok := resp.(gen.DeleteNodeMount200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("agent1", r.Results[0].Hostname)
+				s.True(*r.Results[0].Changed)
+				s.Equal("data", *r.Results[0].Name)
+			},
+		},
+		{
+			name: "success with nil response data",
+			request: gen.DeleteNodeMountRequestObject{
+				Hostname: "server1",
+				Name:     "data",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationMountDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID: "550e8400-e29b-41d4-a716-446655440000", Hostname: "agent1", Changed: boolPtr(true), Data: nil,
+					}, nil)
+			},
+			validateFunc: func(resp gen.DeleteNodeMountResponseObject) {
+				r, ok := resp.(gen.DeleteNodeMount200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal("", *r.Results[0].Name)
+			},
+		},
+		{
+			name:      "validation error empty hostname",
+			request:   gen.DeleteNodeMountRequestObject{Hostname: "", Name: "data"},
+			setupMock: func() {},
+			validateFunc: func(resp gen.DeleteNodeMountResponseObject) {
+				_, ok := resp.(gen.DeleteNodeMount400JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.DeleteNodeMountRequestObject{
+				Hostname: "server1",
+				Name:     "data",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationMountDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Status: job.StatusSkipped, Hostname: "server1", Error: "mount: operation not supported on this OS family",
+					}, nil)
+			},
+			validateFunc: func(resp gen.DeleteNodeMountResponseObject) {
+				r, ok := resp.(gen.DeleteNodeMount200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.MountMutationEntryStatusSkipped, r.Results[0].Status)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "job client error",
+			request: gen.DeleteNodeMountRequestObject{
+				Hostname: "server1",
+				Name:     "data",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationMountDelete, gomock.Any()).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.DeleteNodeMountResponseObject) {
+				_, ok := resp.(gen.DeleteNodeMount500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name:    "broadcast success",
+			request: gen.DeleteNodeMountRequestObject{Hostname: "_all", Name: "data"},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationMountDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Hostname: "server1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"data","changed":true}`),
+						},
+						"server2": {
+							Hostname: "server2",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"data","changed":true}`),
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.DeleteNodeMountResponseObject) {
+				r, ok := resp.(gen.DeleteNodeMount200JSONResponse)
+				s.True(ok)
+				s.Len(r.Results, 2)
+			},
+		},
+		{
+			name:    "broadcast with nil response data",
+			request: gen.DeleteNodeMountRequestObject{Hostname: "_all", Name: "data"},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationMountDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {Hostname: "server1", Changed: boolPtr(true), Data: nil},
+					}, nil)
+			},
+			validateFunc: func(resp gen.DeleteNodeMountResponseObject) {
+				r, ok := resp.(gen.DeleteNodeMount200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal("", *r.Results[0].Name)
+			},
+		},
+		{
+			name:    "broadcast with failed host",
+			request: gen.DeleteNodeMountRequestObject{Hostname: "_all", Name: "data"},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationMountDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusFailed,
+							Error:    "agent unreachable",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.DeleteNodeMountResponseObject) {
+				r, ok := resp.(gen.DeleteNodeMount200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.MountMutationEntryStatusFailed, r.Results[0].Status)
+				s.Contains(*r.Results[0].Error, "unreachable")
+			},
+		},
+		{
+			name:    "broadcast with skipped host",
+			request: gen.DeleteNodeMountRequestObject{Hostname: "_all", Name: "data"},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationMountDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusSkipped,
+							Error:    "mount: operation not supported on this OS family",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.DeleteNodeMountResponseObject) {
+				r, ok := resp.(gen.DeleteNodeMount200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.MountMutationEntryStatusSkipped, r.Results[0].Status)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name:    "broadcast error collecting responses",
+			request: gen.DeleteNodeMountRequestObject{Hostname: "_all", Name: "data"},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationMountDelete, gomock.Any()).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.DeleteNodeMountResponseObject) {
+				_, ok := resp.(gen.DeleteNodeMount500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+			resp, err := s.handler.DeleteNodeMount(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+func (s *MountDeletePublicTestSuite) TestDeleteNodeMountValidationHTTP() {
+	tests := []struct {
+		name         string
+		path         string
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when valid request",
+			path: "/api/node/server1/mount/data",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationMountDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID: "550e8400-e29b-41d4-a716-446655440000", Hostname: "agent1", Changed: boolPtr(true),
+						Data: json.RawMessage(`{"name":"data","changed":true}`),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+		{
+			name: "when target agent not found",
+			path: "/api/node/nonexistent/mount/data",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "valid_target"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+			mountHandler := apimount.New(s.logger, jobMock)
+			strictHandler := gen.NewStrictHandler(mountHandler, nil)
+			a := api.New(s.appConfig, s.logger)
+			gen.RegisterHandlers(a.Echo, strictHandler)
+			req := httptest.NewRequest(http.MethodDelete, tc.path, nil)
+			rec := httptest.NewRecorder()
+			a.Echo.ServeHTTP(rec, req)
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+const rbacMountDeleteTestSigningKey = "test-signing-key-for-rbac-mount-delete"
+
+func (s *MountDeletePublicTestSuite) TestDeleteNodeMountRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when no token returns 401", setupAuth: func(_ *http.Request) {},
+			setupJobMock: func() *jobmocks.MockJobClient { return jobmocks.NewMockJobClient(s.mockCtrl) },
+			wantCode:     http.StatusUnauthorized, wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacMountDeleteTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"docker:write"},
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient { return jobmocks.NewMockJobClient(s.mockCtrl) },
+			wantCode:     http.StatusForbidden, wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid admin token returns 200",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacMountDeleteTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationMountDelete, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID: "550e8400-e29b-41d4-a716-446655440000", Hostname: "agent1", Changed: boolPtr(true),
+						Data: json.RawMessage(`{"name":"data","changed":true}`),
+					}, nil)
+				return mock
+			},
+			wantCode: http.StatusOK, wantContains: []string{`"job_id"`, `"results"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{
+							SigningKey: rbacMountDeleteTestSigningKey,
+						},
+					},
+				},
+			}
+			server := api.New(appConfig, s.logger)
+			handlers := apimount.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+			req := httptest.NewRequest(
+				http.MethodDelete,
+				"/api/node/server1/mount/data",
+				nil,
+			)
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+			server.Echo.ServeHTTP(rec, req)
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestMountDeletePublicTestSuite(t *testing.T) {
+	suite.Run(t, new(MountDeletePublicTestSuite))
+}
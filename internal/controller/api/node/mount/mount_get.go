@@ -0,0 +1,167 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package mount
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/mount/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	mountProv "github.com/osapi-io/osapi/internal/provider/node/mount"
+)
+
+// GetNodeMountByName gets details for a single mount on a target node.
+func (s *Mount) GetNodeMountByName(
+	ctx context.Context,
+	request gen.GetNodeMountByNameRequestObject,
+) (gen.GetNodeMountByNameResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeMountByName400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+	name := request.Name
+
+	s.logger.Debug(
+		"mount get",
+		slog.String("target", hostname),
+		slog.String("name", name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeMountByNameBroadcast(ctx, hostname, name)
+	}
+
+	jobID, resp, err := s.JobClient.Query(
+		ctx,
+		hostname,
+		"node",
+		job.OperationMountGet,
+		map[string]string{"name": name},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "not found") || strings.Contains(errMsg, "does not exist") {
+			return gen.GetNodeMountByName404JSONResponse{Error: &errMsg}, nil
+		}
+		return gen.GetNodeMountByName500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeMountByName200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.MountGetEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.MountGetEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	results := responseToMountGetEntries(resp)
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeMountByName200JSONResponse{
+		JobId:   &jobUUID,
+		Results: results,
+	}, nil
+}
+
+// getNodeMountByNameBroadcast handles broadcast targets for mount get.
+func (s *Mount) getNodeMountByNameBroadcast(
+	ctx context.Context,
+	target string,
+	name string,
+) (gen.GetNodeMountByNameResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationMountGet,
+		map[string]string{"name": name},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeMountByName500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.MountGetEntry, 0)
+	for host, resp := range responses {
+		switch resp.Status {
+		case job.StatusFailed:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.MountGetEntry{
+				Hostname: h,
+				Status:   gen.MountGetEntryStatusFailed,
+				Error:    &e,
+			})
+		case job.StatusSkipped:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.MountGetEntry{
+				Hostname: h,
+				Status:   gen.MountGetEntryStatusSkipped,
+				Error:    &e,
+			})
+		default:
+			allResults = append(allResults, responseToMountGetEntries(resp)...)
+		}
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeMountByName200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
+
+// responseToMountGetEntries converts a job response to gen MountGetEntry slice.
+func responseToMountGetEntries(
+	resp *job.Response,
+) []gen.MountGetEntry {
+	var info mountProv.Mount
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &info)
+	}
+
+	hostname := resp.Hostname
+	m := mountInfoToGen(info)
+
+	return []gen.MountGetEntry{
+		{
+			Hostname: hostname,
+			Status:   gen.MountGetEntryStatusOk,
+			Mount:    &m,
+		},
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package mount
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/mount/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	mountProv "github.com/osapi-io/osapi/internal/provider/node/mount"
+)
+
+// GetNodeMount lists all mounts on a target node.
+func (s *Mount) GetNodeMount(
+	ctx context.Context,
+	request gen.GetNodeMountRequestObject,
+) (gen.GetNodeMountResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeMount400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"mount list",
+		slog.String("target", hostname),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeMountBroadcast(ctx, hostname)
+	}
+
+	jobID, resp, err := s.JobClient.Query(
+		ctx,
+		hostname,
+		"node",
+		job.OperationMountList,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeMount500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeMount200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.MountListEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.MountListEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	results := responseToMountListEntries(resp)
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeMount200JSONResponse{
+		JobId:   &jobUUID,
+		Results: results,
+	}, nil
+}
+
+// getNodeMountBroadcast handles broadcast targets for mount list.
+func (s *Mount) getNodeMountBroadcast(
+	ctx context.Context,
+	target string,
+) (gen.GetNodeMountResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationMountList,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeMount500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.MountListEntry, 0)
+	for host, resp := range responses {
+		switch resp.Status {
+		case job.StatusFailed:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.MountListEntry{
+				Hostname: h,
+				Status:   gen.MountListEntryStatusFailed,
+				Error:    &e,
+			})
+		case job.StatusSkipped:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.MountListEntry{
+				Hostname: h,
+				Status:   gen.MountListEntryStatusSkipped,
+				Error:    &e,
+			})
+		default:
+			allResults = append(allResults, responseToMountListEntries(resp)...)
+		}
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeMount200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
+
+// responseToMountListEntries converts a job response to gen MountListEntry slice.
+func responseToMountListEntries(
+	resp *job.Response,
+) []gen.MountListEntry {
+	var infos []mountProv.Mount
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &infos)
+	}
+
+	hostname := resp.Hostname
+
+	mounts := make([]gen.MountInfo, 0, len(infos))
+	for _, info := range infos {
+		mounts = append(mounts, mountInfoToGen(info))
+	}
+
+	return []gen.MountListEntry{
+		{
+			Hostname: hostname,
+			Status:   gen.MountListEntryStatusOk,
+			Mounts:   &mounts,
+		},
+	}
+}
+
+// mountInfoToGen converts a provider Mount to a gen MountInfo.
+func mountInfoToGen(
+	info mountProv.Mount,
+) gen.MountInfo {
+	result := gen.MountInfo{}
+
+	if info.Name != "" {
+		name := info.Name
+		result.Name = &name
+	}
+	if info.Device != "" {
+		device := info.Device
+		result.Device = &device
+	}
+	if info.Path != "" {
+		path := info.Path
+		result.Path = &path
+	}
+	if info.FSType != "" {
+		fsType := info.FSType
+		result.FsType = &fsType
+	}
+	if info.Options != "" {
+		options := info.Options
+		result.Options = &options
+	}
+	if info.MountOptions != "" {
+		mountOptions := info.MountOptions
+		result.MountOptions = &mountOptions
+	}
+
+	dump := info.Dump
+	result.Dump = &dump
+	pass := info.Pass
+	result.Pass = &pass
+	mounted := info.Mounted
+	result.Mounted = &mounted
+	managed := info.Managed
+	result.Managed = &managed
+
+	return result
+}
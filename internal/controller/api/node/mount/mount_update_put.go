@@ -0,0 +1,185 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package mount
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/mount/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	mountProv "github.com/osapi-io/osapi/internal/provider/node/mount"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// PutNodeMount updates a managed fstab entry on a target node.
+func (s *Mount) PutNodeMount(
+	ctx context.Context,
+	request gen.PutNodeMountRequestObject,
+) (gen.PutNodeMountResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.PutNodeMount400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Body); !ok {
+		return gen.PutNodeMount400JSONResponse{Error: &errMsg}, nil
+	}
+
+	entry := mountProv.UpdateEntry{
+		Name: request.Name,
+		Dump: request.Body.Dump,
+		Pass: request.Body.Pass,
+	}
+	if request.Body.Device != nil {
+		entry.Device = *request.Body.Device
+	}
+	if request.Body.Path != nil {
+		entry.Path = *request.Body.Path
+	}
+	if request.Body.FsType != nil {
+		entry.FSType = *request.Body.FsType
+	}
+	if request.Body.Options != nil {
+		entry.Options = *request.Body.Options
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"mount update",
+		slog.String("target", hostname),
+		slog.String("name", entry.Name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.putNodeMountUpdateBroadcast(ctx, hostname, entry)
+	}
+
+	jobID, resp, err := s.JobClient.Modify(
+		ctx,
+		hostname,
+		"node",
+		job.OperationMountUpdate,
+		entry,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "not found") || strings.Contains(errMsg, "does not exist") {
+			return gen.PutNodeMount404JSONResponse{Error: &errMsg}, nil
+		}
+		return gen.PutNodeMount500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		jobUUID := uuid.MustParse(jobID)
+		e := resp.Error
+		return gen.PutNodeMount200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.MountMutationEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.MountMutationEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	var result mountProv.UpdateResult
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &result)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	changed := resp.Changed
+	name := result.Name
+	agentHostname := resp.Hostname
+
+	return gen.PutNodeMount200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.MountMutationEntry{
+			{
+				Hostname: agentHostname,
+				Status:   gen.MountMutationEntryStatusOk,
+				Name:     &name,
+				Changed:  changed,
+			},
+		},
+	}, nil
+}
+
+// putNodeMountUpdateBroadcast handles broadcast targets for mount update.
+func (s *Mount) putNodeMountUpdateBroadcast(
+	ctx context.Context,
+	target string,
+	entry mountProv.UpdateEntry,
+) (gen.PutNodeMountResponseObject, error) {
+	jobID, responses, err := s.JobClient.ModifyBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationMountUpdate,
+		entry,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PutNodeMount500JSONResponse{Error: &errMsg}, nil
+	}
+
+	var apiResponses []gen.MountMutationEntry
+	for host, resp := range responses {
+		item := gen.MountMutationEntry{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			item.Status = gen.MountMutationEntryStatusFailed
+			e := resp.Error
+			item.Error = &e
+		case job.StatusSkipped:
+			item.Status = gen.MountMutationEntryStatusSkipped
+			e := resp.Error
+			item.Error = &e
+		default:
+			item.Status = gen.MountMutationEntryStatusOk
+			var result mountProv.UpdateResult
+			if resp.Data != nil {
+				_ = json.Unmarshal(resp.Data, &result)
+			}
+			name := result.Name
+			item.Name = &name
+			item.Changed = resp.Changed
+		}
+		apiResponses = append(apiResponses, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.PutNodeMount200JSONResponse{
+		JobId:   &jobUUID,
+		Results: apiResponses,
+	}, nil
+}
@@ -0,0 +1,600 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package mount_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	apimount "github.com/osapi-io/osapi/internal/controller/api/node/mount"
+	"github.com/osapi-io/osapi/internal/controller/api/node/mount/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	mountProv "github.com/osapi-io/osapi/internal/provider/node/mount"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type MountUpdatePutPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *apimount.Mount
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *MountUpdatePutPublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *MountUpdatePutPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = apimount.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *MountUpdatePutPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *MountUpdatePutPublicTestSuite) TestPutNodeMount() {
+	tests := []struct {
+		name         string
+		request      gen.PutNodeMountRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.PutNodeMountResponseObject)
+	}{
+		{
+			name: "success",
+			request: gen.PutNodeMountRequestObject{
+				Hostname: "server1",
+				Name:     "data",
+				Body: &gen.PutNodeMountJSONRequestBody{
+					Options: strPtr("defaults,noatime"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationMountUpdate, gomock.Any()).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"data","changed":true}`),
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PutNodeMountResponseObject) {
+				r, ok := resp.(gen.PutNodeMount200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("agent1", r.Results[0].Hostname)
+				s.Require().NotNil(r.Results[0].Changed)
+				s.True(*r.Results[0].Changed)
+				s.Equal("data", *r.Results[0].Name)
+			},
+		},
+		{
+			name: "success resetting dump and pass to zero",
+			request: gen.PutNodeMountRequestObject{
+				Hostname: "server1",
+				Name:     "data",
+				Body: &gen.PutNodeMountJSONRequestBody{
+					Dump: intPtr(0),
+					Pass: intPtr(0),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationMountUpdate, gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						_ string,
+						_ string,
+						_ job.OperationType,
+						data any,
+					) (string, *job.Response, error) {
+						entry, ok := data.(mountProv.UpdateEntry)
+						s.Require().True(ok)
+						s.Require().NotNil(entry.Dump)
+						s.Require().NotNil(entry.Pass)
+						s.Equal(0, *entry.Dump)
+						s.Equal(0, *entry.Pass)
+						return "550e8400-e29b-41d4-a716-446655440000",
+							&job.Response{
+								JobID:    "550e8400-e29b-41d4-a716-446655440000",
+								Hostname: "agent1",
+								Changed:  boolPtr(true),
+								Data:     json.RawMessage(`{"name":"data","changed":true}`),
+							},
+							nil
+					})
+			},
+			validateFunc: func(resp gen.PutNodeMountResponseObject) {
+				r, ok := resp.(gen.PutNodeMount200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.True(*r.Results[0].Changed)
+			},
+		},
+		{
+			name: "success with nil response data",
+			request: gen.PutNodeMountRequestObject{
+				Hostname: "server1",
+				Name:     "data",
+				Body:     &gen.PutNodeMountJSONRequestBody{Options: strPtr("defaults,noatime")},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationMountUpdate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID: "550e8400-e29b-41d4-a716-446655440000", Hostname: "agent1", Changed: boolPtr(true), Data: nil,
+					}, nil)
+			},
+			validateFunc: func(resp gen.PutNodeMountResponseObject) {
+				r, ok := resp.(gen.PutNodeMount200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("", *r.Results[0].Name)
+			},
+		},
+		{
+			name: "validation error empty hostname",
+			request: gen.PutNodeMountRequestObject{
+				Hostname: "", Name: "data",
+				Body: &gen.PutNodeMountJSONRequestBody{Options: strPtr("defaults,noatime")},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PutNodeMountResponseObject) {
+				r, ok := resp.(gen.PutNodeMount400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "required")
+			},
+		},
+		{
+			name: "body validation error relative path",
+			request: gen.PutNodeMountRequestObject{
+				Hostname: "server1", Name: "data",
+				Body: &gen.PutNodeMountJSONRequestBody{Path: strPtr("mnt/data")},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PutNodeMountResponseObject) {
+				r, ok := resp.(gen.PutNodeMount400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+			},
+		},
+		{
+			name: "not found error",
+			request: gen.PutNodeMountRequestObject{
+				Hostname: "server1", Name: "nonexistent.mount",
+				Body: &gen.PutNodeMountJSONRequestBody{Options: strPtr("defaults,noatime")},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationMountUpdate, gomock.Any()).
+					Return("", nil, errors.New("mount not found"))
+			},
+			validateFunc: func(resp gen.PutNodeMountResponseObject) {
+				r, ok := resp.(gen.PutNodeMount404JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "not found")
+			},
+		},
+		{
+			name: "does not exist error",
+			request: gen.PutNodeMountRequestObject{
+				Hostname: "server1", Name: "missing.mount",
+				Body: &gen.PutNodeMountJSONRequestBody{Options: strPtr("defaults,noatime")},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationMountUpdate, gomock.Any()).
+					Return("", nil, errors.New("mount does not exist"))
+			},
+			validateFunc: func(resp gen.PutNodeMountResponseObject) {
+				r, ok := resp.(gen.PutNodeMount404JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "does not exist")
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.PutNodeMountRequestObject{
+				Hostname: "server1", Name: "data",
+				Body: &gen.PutNodeMountJSONRequestBody{Options: strPtr("defaults,noatime")},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationMountUpdate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Status: job.StatusSkipped, Hostname: "server1",
+						Error: "mount: operation not supported on this OS family",
+					}, nil)
+			},
+			validateFunc: func(resp gen.PutNodeMountResponseObject) {
+				r, ok := resp.(gen.PutNodeMount200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.MountMutationEntryStatusSkipped, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "job client error",
+			request: gen.PutNodeMountRequestObject{
+				Hostname: "server1", Name: "data",
+				Body: &gen.PutNodeMountJSONRequestBody{Options: strPtr("defaults,noatime")},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationMountUpdate, gomock.Any()).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PutNodeMountResponseObject) {
+				_, ok := resp.(gen.PutNodeMount500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "broadcast success",
+			request: gen.PutNodeMountRequestObject{
+				Hostname: "_all", Name: "data",
+				Body: &gen.PutNodeMountJSONRequestBody{Options: strPtr("defaults,noatime")},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationMountUpdate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Hostname: "server1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"data","changed":true}`),
+						},
+						"server2": {
+							Hostname: "server2",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"data","changed":true}`),
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PutNodeMountResponseObject) {
+				r, ok := resp.(gen.PutNodeMount200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Len(r.Results, 2)
+			},
+		},
+		{
+			name: "broadcast with nil response data",
+			request: gen.PutNodeMountRequestObject{
+				Hostname: "_all", Name: "data",
+				Body: &gen.PutNodeMountJSONRequestBody{Options: strPtr("defaults,noatime")},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationMountUpdate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {Hostname: "server1", Changed: boolPtr(true), Data: nil},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PutNodeMountResponseObject) {
+				r, ok := resp.(gen.PutNodeMount200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal("", *r.Results[0].Name)
+			},
+		},
+		{
+			name: "broadcast with failed host",
+			request: gen.PutNodeMountRequestObject{
+				Hostname: "_all", Name: "data",
+				Body: &gen.PutNodeMountJSONRequestBody{Options: strPtr("defaults,noatime")},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationMountUpdate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusFailed,
+							Error:    "agent unreachable",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PutNodeMountResponseObject) {
+				r, ok := resp.(gen.PutNodeMount200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.MountMutationEntryStatusFailed, r.Results[0].Status)
+				s.Contains(*r.Results[0].Error, "unreachable")
+			},
+		},
+		{
+			name: "broadcast with skipped host",
+			request: gen.PutNodeMountRequestObject{
+				Hostname: "_all", Name: "data",
+				Body: &gen.PutNodeMountJSONRequestBody{Options: strPtr("defaults,noatime")},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationMountUpdate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusSkipped,
+							Error:    "mount: operation not supported on this OS family",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PutNodeMountResponseObject) {
+				r, ok := resp.(gen.PutNodeMount200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.MountMutationEntryStatusSkipped, r.Results[0].Status)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "broadcast error collecting responses",
+			request: gen.PutNodeMountRequestObject{
+				Hostname: "_all", Name: "data",
+				Body: &gen.PutNodeMountJSONRequestBody{Options: strPtr("defaults,noatime")},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(gomock.Any(), "_all", "node", job.OperationMountUpdate, gomock.Any()).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PutNodeMountResponseObject) {
+				_, ok := resp.(gen.PutNodeMount500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+			resp, err := s.handler.PutNodeMount(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+func (s *MountUpdatePutPublicTestSuite) TestPutNodeMountValidationHTTP() {
+	tests := []struct {
+		name         string
+		path         string
+		body         string
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when valid request",
+			path: "/api/node/server1/mount/data",
+			body: `{"options":"defaults,noatime"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationMountUpdate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID: "550e8400-e29b-41d4-a716-446655440000", Hostname: "agent1", Changed: boolPtr(true),
+						Data: json.RawMessage(`{"name":"data","changed":true}`),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+		{
+			name: "when target agent not found",
+			path: "/api/node/nonexistent/mount/data",
+			body: `{"options":"defaults,noatime"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "valid_target"},
+		},
+		{
+			name: "when invalid body options contain whitespace",
+			path: "/api/node/server1/mount/data",
+			body: `{"options":"defaults 0 0\n/dev/sdc1 /root ext4 defaults"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "no_whitespace"},
+		},
+		{
+			name: "when invalid body relative path",
+			path: "/api/node/server1/mount/data",
+			body: `{"path":"mnt/data"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+			mountHandler := apimount.New(s.logger, jobMock)
+			strictHandler := gen.NewStrictHandler(mountHandler, nil)
+			a := api.New(s.appConfig, s.logger)
+			gen.RegisterHandlers(a.Echo, strictHandler)
+			req := httptest.NewRequest(http.MethodPut, tc.path, strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			a.Echo.ServeHTTP(rec, req)
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+const rbacMountUpdateTestSigningKey = "test-signing-key-for-rbac-mount-update"
+
+func (s *MountUpdatePutPublicTestSuite) TestPutNodeMountRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name:      "when no token returns 401",
+			setupAuth: func(_ *http.Request) {},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusUnauthorized,
+			wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacMountUpdateTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"docker:write"},
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid admin token returns 200",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacMountUpdateTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationMountUpdate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID: "550e8400-e29b-41d4-a716-446655440000", Hostname: "agent1", Changed: boolPtr(true),
+						Data: json.RawMessage(`{"name":"data","changed":true}`),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{
+							SigningKey: rbacMountUpdateTestSigningKey,
+						},
+					},
+				},
+			}
+			server := api.New(appConfig, s.logger)
+			handlers := apimount.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+			req := httptest.NewRequest(
+				http.MethodPut,
+				"/api/node/server1/mount/data",
+				strings.NewReader(`{"options":"defaults,noatime"}`),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+			server.Echo.ServeHTTP(rec, req)
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestMountUpdatePutPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(MountUpdatePutPublicTestSuite))
+}
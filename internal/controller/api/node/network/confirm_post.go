@@ -0,0 +1,147 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package network
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/network/gen"
+	"github.com/osapi-io/osapi/internal/job"
+)
+
+// PostNodeNetworkConfirm confirms a pending network change on a target node.
+func (s *Network) PostNodeNetworkConfirm(
+	ctx context.Context,
+	request gen.PostNodeNetworkConfirmRequestObject,
+) (gen.PostNodeNetworkConfirmResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.PostNodeNetworkConfirm400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"network confirm",
+		slog.String("target", hostname),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.postNodeNetworkConfirmBroadcast(ctx, hostname)
+	}
+
+	jobID, rawResp, err := s.JobClient.Modify(
+		ctx,
+		hostname,
+		"network",
+		job.OperationNetworkApplyConfirm,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PostNodeNetworkConfirm500JSONResponse{
+			Error: &errMsg,
+		}, nil
+	}
+
+	if rawResp.Status == job.StatusSkipped {
+		jobUUID := uuid.MustParse(jobID)
+		e := rawResp.Error
+		falseVal := false
+		return gen.PostNodeNetworkConfirm200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.NetworkConfirmResultItem{
+				{
+					Hostname: rawResp.Hostname,
+					Status:   gen.NetworkConfirmResultItemStatusSkipped,
+					Error:    &e,
+					Changed:  &falseVal,
+				},
+			},
+		}, nil
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	return gen.PostNodeNetworkConfirm200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.NetworkConfirmResultItem{
+			{
+				Hostname: rawResp.Hostname,
+				Status:   gen.NetworkConfirmResultItemStatusOk,
+				Changed:  rawResp.Changed,
+			},
+		},
+	}, nil
+}
+
+// postNodeNetworkConfirmBroadcast handles broadcast targets for network confirm.
+func (s *Network) postNodeNetworkConfirmBroadcast(
+	ctx context.Context,
+	target string,
+) (gen.PostNodeNetworkConfirmResponseObject, error) {
+	jobID, responses, err := s.JobClient.ModifyBroadcast(
+		ctx,
+		target,
+		"network",
+		job.OperationNetworkApplyConfirm,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PostNodeNetworkConfirm500JSONResponse{
+			Error: &errMsg,
+		}, nil
+	}
+
+	apiResponses := make([]gen.NetworkConfirmResultItem, 0, len(responses))
+	for host, resp := range responses {
+		item := gen.NetworkConfirmResultItem{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			item.Status = gen.NetworkConfirmResultItemStatusFailed
+			e := resp.Error
+			falseVal := false
+			item.Error = &e
+			item.Changed = &falseVal
+		case job.StatusSkipped:
+			item.Status = gen.NetworkConfirmResultItemStatusSkipped
+			e := resp.Error
+			falseVal := false
+			item.Error = &e
+			item.Changed = &falseVal
+		default:
+			item.Status = gen.NetworkConfirmResultItemStatusOk
+			item.Changed = resp.Changed
+		}
+		apiResponses = append(apiResponses, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	return gen.PostNodeNetworkConfirm200JSONResponse{
+		JobId:   &jobUUID,
+		Results: apiResponses,
+	}, nil
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/network/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/network/firewall"
+)
+
+// GetNodeNetworkFirewallByName gets a single firewall rule set by name on a target node.
+func (s *Network) GetNodeNetworkFirewallByName(
+	ctx context.Context,
+	request gen.GetNodeNetworkFirewallByNameRequestObject,
+) (gen.GetNodeNetworkFirewallByNameResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeNetworkFirewallByName400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+	name := request.Name
+
+	s.logger.Debug(
+		"firewall get",
+		slog.String("target", hostname),
+		slog.String("name", name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeNetworkFirewallByNameBroadcast(ctx, hostname, name)
+	}
+
+	jobID, resp, err := s.JobClient.Query(
+		ctx,
+		hostname,
+		"network",
+		job.OperationNetworkFirewallGet,
+		map[string]string{"name": name},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "not found") || strings.Contains(errMsg, "does not exist") ||
+			strings.Contains(errMsg, "not managed") {
+			return gen.GetNodeNetworkFirewallByName404JSONResponse{Error: &errMsg}, nil
+		}
+		return gen.GetNodeNetworkFirewallByName500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeNetworkFirewallByName200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.FirewallEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.FirewallEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	var ruleSet firewall.RuleSet
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &ruleSet)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	ruleSetName := ruleSet.Name
+	object := ruleSet.Object
+	path := ruleSet.Path
+	contentType := ruleSet.ContentType
+	agentHostname := resp.Hostname
+
+	return gen.GetNodeNetworkFirewallByName200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.FirewallEntry{
+			{
+				Hostname:    agentHostname,
+				Status:      gen.FirewallEntryStatusOk,
+				Name:        &ruleSetName,
+				Object:      &object,
+				Path:        &path,
+				ContentType: &contentType,
+			},
+		},
+	}, nil
+}
+
+// getNodeNetworkFirewallByNameBroadcast handles broadcast targets for firewall get.
+func (s *Network) getNodeNetworkFirewallByNameBroadcast(
+	ctx context.Context,
+	target string,
+	name string,
+) (gen.GetNodeNetworkFirewallByNameResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"network",
+		job.OperationNetworkFirewallGet,
+		map[string]string{"name": name},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeNetworkFirewallByName500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.FirewallEntry, 0)
+	for host, resp := range responses {
+		item := gen.FirewallEntry{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			item.Status = gen.FirewallEntryStatusFailed
+			e := resp.Error
+			item.Error = &e
+		case job.StatusSkipped:
+			item.Status = gen.FirewallEntryStatusSkipped
+			e := resp.Error
+			item.Error = &e
+		default:
+			item.Status = gen.FirewallEntryStatusOk
+			var ruleSet firewall.RuleSet
+			if resp.Data != nil {
+				_ = json.Unmarshal(resp.Data, &ruleSet)
+			}
+			ruleSetName := ruleSet.Name
+			object := ruleSet.Object
+			path := ruleSet.Path
+			contentType := ruleSet.ContentType
+			item.Name = &ruleSetName
+			item.Object = &object
+			item.Path = &path
+			item.ContentType = &contentType
+		}
+		allResults = append(allResults, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeNetworkFirewallByName200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
@@ -0,0 +1,380 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	apinetwork "github.com/osapi-io/osapi/internal/controller/api/node/network"
+	"github.com/osapi-io/osapi/internal/controller/api/node/network/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type NetworkFirewallGetPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *apinetwork.Network
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *NetworkFirewallGetPublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *NetworkFirewallGetPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = apinetwork.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *NetworkFirewallGetPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *NetworkFirewallGetPublicTestSuite) TestGetNodeNetworkFirewallByName() {
+
+	tests := []struct {
+		name         string
+		request      gen.GetNodeNetworkFirewallByNameRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.GetNodeNetworkFirewallByNameResponseObject)
+	}{
+		{
+			name: "when success",
+			request: gen.GetNodeNetworkFirewallByNameRequestObject{
+				Hostname: "server1",
+				Name:     "web",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(gomock.Any(), "server1", "network", job.OperationNetworkFirewallGet, map[string]string{"name": "web"}).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "server1",
+						Data:     []byte(`{"name":"web","object":"web-rules","path":"/etc/nftables.d/web.nft","content_type":"raw"}`),
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeNetworkFirewallByNameResponseObject) {
+				r, ok := resp.(gen.GetNodeNetworkFirewallByName200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.FirewallEntryStatusOk, r.Results[0].Status)
+				s.Equal("web", *r.Results[0].Name)
+				s.Equal("web-rules", *r.Results[0].Object)
+				s.Equal("/etc/nftables.d/web.nft", *r.Results[0].Path)
+				s.Equal("raw", *r.Results[0].ContentType)
+			},
+		},
+		{
+			name: "when rule set not found",
+			request: gen.GetNodeNetworkFirewallByNameRequestObject{
+				Hostname: "server1",
+				Name:     "web",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(gomock.Any(), "server1", "network", job.OperationNetworkFirewallGet, gomock.Any()).
+					Return("", nil, fmt.Errorf("job failed: firewall rule set %q not found", "web"))
+			},
+			validateFunc: func(resp gen.GetNodeNetworkFirewallByNameResponseObject) {
+				_, ok := resp.(gen.GetNodeNetworkFirewallByName404JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "when validation error empty hostname",
+			request: gen.GetNodeNetworkFirewallByNameRequestObject{
+				Hostname: "",
+				Name:     "web",
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.GetNodeNetworkFirewallByNameResponseObject) {
+				_, ok := resp.(gen.GetNodeNetworkFirewallByName400JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "when job client error",
+			request: gen.GetNodeNetworkFirewallByNameRequestObject{
+				Hostname: "server1",
+				Name:     "web",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(gomock.Any(), "server1", "network", job.OperationNetworkFirewallGet, gomock.Any()).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.GetNodeNetworkFirewallByNameResponseObject) {
+				_, ok := resp.(gen.GetNodeNetworkFirewallByName500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.GetNodeNetworkFirewallByNameRequestObject{
+				Hostname: "server1",
+				Name:     "web",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(gomock.Any(), "server1", "network", job.OperationNetworkFirewallGet, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Status: job.StatusSkipped, Hostname: "server1", Error: "unsupported",
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeNetworkFirewallByNameResponseObject) {
+				r, ok := resp.(gen.GetNodeNetworkFirewallByName200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.FirewallEntryStatusSkipped, r.Results[0].Status)
+				s.Equal("unsupported", *r.Results[0].Error)
+			},
+		},
+		{
+			name: "when broadcast success",
+			request: gen.GetNodeNetworkFirewallByNameRequestObject{
+				Hostname: "_all",
+				Name:     "web",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(gomock.Any(), "_all", "network", job.OperationNetworkFirewallGet, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Hostname: "server1",
+							Data:     []byte(`{"name":"web","object":"web-rules","path":"/etc/nftables.d/web.nft","content_type":"raw"}`),
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeNetworkFirewallByNameResponseObject) {
+				r, ok := resp.(gen.GetNodeNetworkFirewallByName200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.FirewallEntryStatusOk, r.Results[0].Status)
+				s.Equal("web", *r.Results[0].Name)
+			},
+		},
+		{
+			name: "when broadcast with failed and skipped hosts",
+			request: gen.GetNodeNetworkFirewallByNameRequestObject{
+				Hostname: "_all",
+				Name:     "web",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(gomock.Any(), "_all", "network", job.OperationNetworkFirewallGet, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusFailed,
+							Error:    "permission denied",
+							Hostname: "server1",
+						},
+						"server2": {
+							Status:   job.StatusSkipped,
+							Error:    "unsupported",
+							Hostname: "server2",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeNetworkFirewallByNameResponseObject) {
+				r, ok := resp.(gen.GetNodeNetworkFirewallByName200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 2)
+				statuses := map[gen.FirewallEntryStatus]bool{}
+				for _, item := range r.Results {
+					statuses[item.Status] = true
+					s.Require().NotNil(item.Error)
+				}
+				s.True(statuses[gen.FirewallEntryStatusFailed])
+				s.True(statuses[gen.FirewallEntryStatusSkipped])
+			},
+		},
+		{
+			name: "when broadcast error",
+			request: gen.GetNodeNetworkFirewallByNameRequestObject{
+				Hostname: "_all",
+				Name:     "web",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(gomock.Any(), "_all", "network", job.OperationNetworkFirewallGet, gomock.Any()).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.GetNodeNetworkFirewallByNameResponseObject) {
+				_, ok := resp.(gen.GetNodeNetworkFirewallByName500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+			resp, err := s.handler.GetNodeNetworkFirewallByName(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+const rbacFirewallGetTestSigningKey = "test-signing-key-for-firewall-get-rbac"
+
+func (s *NetworkFirewallGetPublicTestSuite) TestGetNetworkFirewallRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name:      "when no token returns 401",
+			setupAuth: func(_ *http.Request) {},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusUnauthorized,
+			wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when network read without firewall read returns 403",
+			setupAuth: func(req *http.Request) {
+				token, _ := tokenManager.Generate(
+					rbacFirewallGetTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"network:read"},
+				)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when firewall write without firewall read returns 403",
+			setupAuth: func(req *http.Request) {
+				token, _ := tokenManager.Generate(
+					rbacFirewallGetTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"firewall:write"},
+				)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid token returns 200",
+			setupAuth: func(req *http.Request) {
+				token, _ := tokenManager.Generate(
+					rbacFirewallGetTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Query(gomock.Any(), "server1", "network", job.OperationNetworkFirewallGet, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{Hostname: "server1", Data: []byte(`{"name":"web","object":"web-rules","path":"/etc/nftables.d/web.nft","content_type":"raw"}`)}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"results"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{SigningKey: rbacFirewallGetTestSigningKey},
+					},
+				},
+			}
+			server := api.New(appConfig, s.logger)
+			handlers := apinetwork.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+
+			req := httptest.NewRequest(
+				http.MethodGet,
+				"/api/node/server1/network/firewall/web",
+				nil,
+			)
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+			server.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestNetworkFirewallGetPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(NetworkFirewallGetPublicTestSuite))
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/network/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/network/firewall"
+)
+
+// GetNodeNetworkFirewall lists all firewall rule sets on a target node.
+func (s *Network) GetNodeNetworkFirewall(
+	ctx context.Context,
+	request gen.GetNodeNetworkFirewallRequestObject,
+) (gen.GetNodeNetworkFirewallResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeNetworkFirewall400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"firewall list",
+		slog.String("target", hostname),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeNetworkFirewallBroadcast(ctx, hostname)
+	}
+
+	jobID, resp, err := s.JobClient.Query(ctx, hostname, "network", job.OperationNetworkFirewallList, nil)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeNetworkFirewall500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeNetworkFirewall200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.FirewallEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.FirewallEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	results := responseToFirewallEntries(resp)
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeNetworkFirewall200JSONResponse{
+		JobId:   &jobUUID,
+		Results: results,
+	}, nil
+}
+
+// getNodeNetworkFirewallBroadcast handles broadcast targets for firewall list.
+func (s *Network) getNodeNetworkFirewallBroadcast(
+	ctx context.Context,
+	target string,
+) (gen.GetNodeNetworkFirewallResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"network",
+		job.OperationNetworkFirewallList,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeNetworkFirewall500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.FirewallEntry, 0)
+	for host, resp := range responses {
+		switch resp.Status {
+		case job.StatusFailed:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.FirewallEntry{
+				Hostname: h,
+				Status:   gen.FirewallEntryStatusFailed,
+				Error:    &e,
+			})
+		case job.StatusSkipped:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.FirewallEntry{
+				Hostname: h,
+				Status:   gen.FirewallEntryStatusSkipped,
+				Error:    &e,
+			})
+		default:
+			allResults = append(allResults, responseToFirewallEntries(resp)...)
+		}
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeNetworkFirewall200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
+
+// responseToFirewallEntries converts a job response to gen FirewallEntry slice.
+func responseToFirewallEntries(
+	resp *job.Response,
+) []gen.FirewallEntry {
+	var ruleSets []firewall.RuleSet
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &ruleSets)
+	}
+
+	hostname := resp.Hostname
+
+	results := make([]gen.FirewallEntry, 0, len(ruleSets))
+	for _, r := range ruleSets {
+		name := r.Name
+		object := r.Object
+		path := r.Path
+
+		entry := gen.FirewallEntry{
+			Hostname: hostname,
+			Status:   gen.FirewallEntryStatusOk,
+			Name:     &name,
+			Object:   &object,
+			Path:     &path,
+		}
+
+		if r.ContentType != "" {
+			contentType := r.ContentType
+			entry.ContentType = &contentType
+		}
+
+		results = append(results, entry)
+	}
+
+	return results
+}
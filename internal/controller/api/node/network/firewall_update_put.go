@@ -0,0 +1,185 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/network/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/network/firewall"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// PutNodeNetworkFirewall updates a firewall rule set on a target node.
+func (s *Network) PutNodeNetworkFirewall(
+	ctx context.Context,
+	request gen.PutNodeNetworkFirewallRequestObject,
+) (gen.PutNodeNetworkFirewallResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.PutNodeNetworkFirewall400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Body); !ok {
+		return gen.PutNodeNetworkFirewall400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.AtLeastOneField(request.Body); !ok {
+		return gen.PutNodeNetworkFirewall400JSONResponse{Error: &errMsg}, nil
+	}
+
+	ruleSet := firewall.RuleSet{
+		Name: request.Name,
+	}
+	if request.Body.Object != nil {
+		ruleSet.Object = *request.Body.Object
+	}
+	if request.Body.ContentType != nil {
+		ruleSet.ContentType = string(*request.Body.ContentType)
+	}
+	if request.Body.Vars != nil {
+		ruleSet.Vars = *request.Body.Vars
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"firewall update",
+		slog.String("target", hostname),
+		slog.String("name", ruleSet.Name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.putNodeNetworkFirewallUpdateBroadcast(ctx, hostname, ruleSet)
+	}
+
+	jobID, resp, err := s.JobClient.Modify(
+		ctx,
+		hostname,
+		"network",
+		job.OperationNetworkFirewallUpdate,
+		ruleSet,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "not found") || strings.Contains(errMsg, "does not exist") ||
+			strings.Contains(errMsg, "not managed") {
+			return gen.PutNodeNetworkFirewall404JSONResponse{Error: &errMsg}, nil
+		}
+		return gen.PutNodeNetworkFirewall500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		jobUUID := uuid.MustParse(jobID)
+		e := resp.Error
+		return gen.PutNodeNetworkFirewall200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.FirewallMutationResult{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.FirewallMutationResultStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	var result firewall.UpdateResult
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &result)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	changed := resp.Changed
+	name := result.Name
+	agentHostname := resp.Hostname
+
+	return gen.PutNodeNetworkFirewall200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.FirewallMutationResult{
+			{
+				Hostname: agentHostname,
+				Status:   gen.FirewallMutationResultStatusOk,
+				Name:     &name,
+				Changed:  changed,
+			},
+		},
+	}, nil
+}
+
+// putNodeNetworkFirewallUpdateBroadcast handles broadcast targets for firewall update.
+func (s *Network) putNodeNetworkFirewallUpdateBroadcast(
+	ctx context.Context,
+	target string,
+	ruleSet firewall.RuleSet,
+) (gen.PutNodeNetworkFirewallResponseObject, error) {
+	jobID, responses, err := s.JobClient.ModifyBroadcast(
+		ctx,
+		target,
+		"network",
+		job.OperationNetworkFirewallUpdate,
+		ruleSet,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PutNodeNetworkFirewall500JSONResponse{Error: &errMsg}, nil
+	}
+
+	var apiResponses []gen.FirewallMutationResult
+	for host, resp := range responses {
+		item := gen.FirewallMutationResult{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			item.Status = gen.FirewallMutationResultStatusFailed
+			e := resp.Error
+			item.Error = &e
+		case job.StatusSkipped:
+			item.Status = gen.FirewallMutationResultStatusSkipped
+			e := resp.Error
+			item.Error = &e
+		default:
+			item.Status = gen.FirewallMutationResultStatusOk
+			var result firewall.UpdateResult
+			if resp.Data != nil {
+				_ = json.Unmarshal(resp.Data, &result)
+			}
+			name := result.Name
+			item.Name = &name
+			item.Changed = resp.Changed
+		}
+		apiResponses = append(apiResponses, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.PutNodeNetworkFirewall200JSONResponse{
+		JobId:   &jobUUID,
+		Results: apiResponses,
+	}, nil
+}
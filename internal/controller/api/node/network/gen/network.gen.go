@@ -41,6 +41,55 @@ const (
 	DNSUpdateResultItemStatusSkipped DNSUpdateResultItemStatus = "skipped"
 )
 
+// Defines values for FirewallCreateRequestContentType.
+const (
+	FirewallCreateRequestContentTypeRaw      FirewallCreateRequestContentType = "raw"
+	FirewallCreateRequestContentTypeTemplate FirewallCreateRequestContentType = "template"
+)
+
+// Defines values for FirewallEntryStatus.
+const (
+	FirewallEntryStatusFailed  FirewallEntryStatus = "failed"
+	FirewallEntryStatusOk      FirewallEntryStatus = "ok"
+	FirewallEntryStatusSkipped FirewallEntryStatus = "skipped"
+)
+
+// Defines values for FirewallMutationResultStatus.
+const (
+	FirewallMutationResultStatusFailed  FirewallMutationResultStatus = "failed"
+	FirewallMutationResultStatusOk      FirewallMutationResultStatus = "ok"
+	FirewallMutationResultStatusSkipped FirewallMutationResultStatus = "skipped"
+)
+
+// Defines values for FirewallUpdateRequestContentType.
+const (
+	FirewallUpdateRequestContentTypeRaw      FirewallUpdateRequestContentType = "raw"
+	FirewallUpdateRequestContentTypeTemplate FirewallUpdateRequestContentType = "template"
+)
+
+// Defines values for GetNodeNetworkSocketParamsProtocol.
+const (
+	GetNodeNetworkSocketParamsProtocolTcp GetNodeNetworkSocketParamsProtocol = "tcp"
+	GetNodeNetworkSocketParamsProtocolUdp GetNodeNetworkSocketParamsProtocol = "udp"
+)
+
+// Defines values for GetNodeNetworkSocketParamsState.
+const (
+	GetNodeNetworkSocketParamsStateEstablished GetNodeNetworkSocketParamsState = "established"
+	GetNodeNetworkSocketParamsStateListen      GetNodeNetworkSocketParamsState = "listen"
+)
+
+// Defines values for InterfaceBondMode.
+const (
+	InterfaceBondModeActiveBackup InterfaceBondMode = "active-backup"
+	InterfaceBondModeBalanceAlb   InterfaceBondMode = "balance-alb"
+	InterfaceBondModeBalanceRr    InterfaceBondMode = "balance-rr"
+	InterfaceBondModeBalanceTlb   InterfaceBondMode = "balance-tlb"
+	InterfaceBondModeBalanceXor   InterfaceBondMode = "balance-xor"
+	InterfaceBondModeBroadcast    InterfaceBondMode = "broadcast"
+	InterfaceBondModeN8023ad      InterfaceBondMode = "802.3ad"
+)
+
 // Defines values for InterfaceGetEntryStatus.
 const (
 	InterfaceGetEntryStatusFailed  InterfaceGetEntryStatus = "failed"
@@ -62,6 +111,13 @@ const (
 	InterfaceMutationEntryStatusSkipped InterfaceMutationEntryStatus = "skipped"
 )
 
+// Defines values for NetworkConfirmResultItemStatus.
+const (
+	NetworkConfirmResultItemStatusFailed  NetworkConfirmResultItemStatus = "failed"
+	NetworkConfirmResultItemStatusOk      NetworkConfirmResultItemStatus = "ok"
+	NetworkConfirmResultItemStatusSkipped NetworkConfirmResultItemStatus = "skipped"
+)
+
 // Defines values for PingResponseStatus.
 const (
 	PingResponseStatusFailed  PingResponseStatus = "failed"
@@ -90,6 +146,25 @@ const (
 	RouteMutationEntryStatusSkipped RouteMutationEntryStatus = "skipped"
 )
 
+// Defines values for SocketEntryStatus.
+const (
+	SocketEntryStatusFailed  SocketEntryStatus = "failed"
+	SocketEntryStatusOk      SocketEntryStatus = "ok"
+	SocketEntryStatusSkipped SocketEntryStatus = "skipped"
+)
+
+// Defines values for SocketInfoProtocol.
+const (
+	SocketInfoProtocolTcp SocketInfoProtocol = "tcp"
+	SocketInfoProtocolUdp SocketInfoProtocol = "udp"
+)
+
+// Defines values for SocketInfoState.
+const (
+	SocketInfoStateEstablished SocketInfoState = "established"
+	SocketInfoStateListen      SocketInfoState = "listen"
+)
+
 // DNSConfigCollectionResponse defines model for DNSConfigCollectionResponse.
 type DNSConfigCollectionResponse struct {
 	// JobId The job ID used to process this request.
@@ -189,16 +264,151 @@ type DNSUpdateResultItemStatus string
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse = externalRef0.ErrorResponse
 
+// FirewallCollectionResponse defines model for FirewallCollectionResponse.
+type FirewallCollectionResponse struct {
+	// JobId The job ID used to process this request.
+	JobId   *openapi_types.UUID `json:"job_id,omitempty"`
+	Results []FirewallEntry     `json:"results"`
+}
+
+// FirewallCreateRequest defines model for FirewallCreateRequest.
+type FirewallCreateRequest struct {
+	// ContentType Content type: "raw" or "template". When "template", the file content is rendered through Go text/template with agent facts and user-supplied vars.
+	ContentType *FirewallCreateRequestContentType `json:"content_type,omitempty" validate:"omitempty,oneof=raw template"`
+
+	// Name Name for the rule set. Used as the file name under /etc/nftables.d/ (with a .nft extension).
+	Name string `json:"name" validate:"required,min=1,max=64"`
+
+	// Object Name of the uploaded file in the object store to deploy as the rule set content.
+	Object string `json:"object" validate:"required,min=1"`
+
+	// Vars Template variables. Only used when content_type is "template".
+	Vars *map[string]interface{} `json:"vars,omitempty"`
+}
+
+// FirewallCreateRequestContentType Content type: "raw" or "template". When "template", the file content is rendered through Go text/template with agent facts and user-supplied vars.
+type FirewallCreateRequestContentType string
+
+// FirewallEntry A managed nftables rule set.
+type FirewallEntry struct {
+	// ContentType Content type of the deployed rule set.
+	ContentType *string `json:"content_type,omitempty"`
+
+	// Error Error message if the agent failed to retrieve this entry.
+	Error *string `json:"error,omitempty"`
+
+	// Hostname Hostname of the agent that reported this entry.
+	Hostname string `json:"hostname"`
+
+	// Name Rule set name.
+	Name *string `json:"name,omitempty"`
+
+	// Object Object store name for the deployed content.
+	Object *string `json:"object,omitempty"`
+
+	// Path Path of the deployed rule set file.
+	Path *string `json:"path,omitempty"`
+
+	// Status The status of the operation for this host.
+	Status FirewallEntryStatus `json:"status"`
+}
+
+// FirewallEntryStatus The status of the operation for this host.
+type FirewallEntryStatus string
+
+// FirewallGetResponse Collection response for a single rule set get operation.
+type FirewallGetResponse struct {
+	// JobId The job ID used to process this request.
+	JobId   *openapi_types.UUID `json:"job_id,omitempty"`
+	Results []FirewallEntry     `json:"results"`
+}
+
+// FirewallMutationResponse defines model for FirewallMutationResponse.
+type FirewallMutationResponse struct {
+	// JobId The job ID used to process this request.
+	JobId   *openapi_types.UUID      `json:"job_id,omitempty"`
+	Results []FirewallMutationResult `json:"results"`
+}
+
+// FirewallMutationResult Result of a firewall create, update, or delete operation for one host.
+type FirewallMutationResult struct {
+	// Changed Whether the operation modified system state.
+	Changed *bool `json:"changed,omitempty"`
+
+	// Error Error message if the agent failed.
+	Error *string `json:"error,omitempty"`
+
+	// Hostname Hostname of the agent that processed this operation.
+	Hostname string `json:"hostname"`
+
+	// Name Rule set name.
+	Name *string `json:"name,omitempty"`
+
+	// Status The status of the operation for this host.
+	Status FirewallMutationResultStatus `json:"status"`
+}
+
+// FirewallMutationResultStatus The status of the operation for this host.
+type FirewallMutationResultStatus string
+
+// FirewallUpdateRequest defines model for FirewallUpdateRequest.
+type FirewallUpdateRequest struct {
+	// ContentType Content type: "raw" or "template".
+	ContentType *FirewallUpdateRequestContentType `json:"content_type,omitempty" validate:"omitempty,oneof=raw template"`
+
+	// Object New object to deploy (redeploy with updated content).
+	Object *string `json:"object,omitempty" validate:"omitempty,min=1"`
+
+	// Vars Template variables.
+	Vars *map[string]interface{} `json:"vars,omitempty"`
+}
+
+// FirewallUpdateRequestContentType Content type: "raw" or "template".
+type FirewallUpdateRequestContentType string
+
+// InterfaceBond Bond (link aggregation) settings. Setting this on create makes the interface a bond of the member interfaces.
+type InterfaceBond struct {
+	// Members Interfaces enslaved to the bond.
+	Members []string `json:"members" validate:"required,min=1,dive,alphanum_or_fact"`
+
+	// Miimon MII link monitoring interval in milliseconds.
+	Miimon *int `json:"miimon,omitempty" validate:"omitempty,min=0"`
+
+	// Mode Bonding mode.
+	Mode InterfaceBondMode `json:"mode" validate:"required,oneof=balance-rr active-backup balance-xor broadcast 802.3ad balance-tlb balance-alb"`
+}
+
+// InterfaceBondMode Bonding mode.
+type InterfaceBondMode string
+
+// InterfaceBridge Bridge settings. Setting this on create makes the interface a bridge over the member interfaces.
+type InterfaceBridge struct {
+	// Members Interfaces attached to the bridge.
+	Members *[]string `json:"members,omitempty" validate:"omitempty,dive,alphanum_or_fact"`
+
+	// Stp Whether the spanning tree protocol is enabled.
+	Stp *bool `json:"stp,omitempty" validate:"omitempty"`
+}
+
 // InterfaceConfigRequest defines model for InterfaceConfigRequest.
 type InterfaceConfigRequest struct {
-	Addresses  *[]string `json:"addresses,omitempty" validate:"omitempty,dive,cidr"`
-	Dhcp4      *bool     `json:"dhcp4,omitempty" validate:"omitempty"`
-	Dhcp6      *bool     `json:"dhcp6,omitempty" validate:"omitempty"`
-	Gateway4   *string   `json:"gateway4,omitempty" validate:"omitempty,ipv4"`
-	Gateway6   *string   `json:"gateway6,omitempty" validate:"omitempty,ipv6"`
-	MacAddress *string   `json:"mac_address,omitempty" validate:"omitempty"`
-	Mtu        *int      `json:"mtu,omitempty" validate:"omitempty,min=68,max=9000"`
-	Wakeonlan  *bool     `json:"wakeonlan,omitempty" validate:"omitempty"`
+	Addresses *[]string `json:"addresses,omitempty" validate:"omitempty,dive,cidr"`
+
+	// Bond Bond (link aggregation) settings. Setting this on create makes the interface a bond of the member interfaces.
+	Bond *InterfaceBond `json:"bond,omitempty"`
+
+	// Bridge Bridge settings. Setting this on create makes the interface a bridge over the member interfaces.
+	Bridge     *InterfaceBridge `json:"bridge,omitempty"`
+	Dhcp4      *bool            `json:"dhcp4,omitempty" validate:"omitempty"`
+	Dhcp6      *bool            `json:"dhcp6,omitempty" validate:"omitempty"`
+	Gateway4   *string          `json:"gateway4,omitempty" validate:"omitempty,ipv4"`
+	Gateway6   *string          `json:"gateway6,omitempty" validate:"omitempty,ipv6"`
+	MacAddress *string          `json:"mac_address,omitempty" validate:"omitempty"`
+	Mtu        *int             `json:"mtu,omitempty" validate:"omitempty,min=68,max=9000"`
+
+	// Vlan 802.1Q VLAN settings. Setting this on create makes the interface a VLAN on top of link.
+	Vlan      *InterfaceVlan `json:"vlan,omitempty"`
+	Wakeonlan *bool          `json:"wakeonlan,omitempty" validate:"omitempty"`
 }
 
 // InterfaceGetEntry Interface get result for a single agent.
@@ -231,6 +441,12 @@ type InterfaceInfo struct {
 	// Addresses IP addresses assigned to the interface (CIDR).
 	Addresses *[]string `json:"addresses,omitempty"`
 
+	// Bond Bond (link aggregation) settings. Setting this on create makes the interface a bond of the member interfaces.
+	Bond *InterfaceBond `json:"bond,omitempty"`
+
+	// Bridge Bridge settings. Setting this on create makes the interface a bridge over the member interfaces.
+	Bridge *InterfaceBridge `json:"bridge,omitempty"`
+
 	// Dhcp4 Whether DHCPv4 is enabled.
 	Dhcp4 *bool `json:"dhcp4,omitempty"`
 
@@ -270,6 +486,12 @@ type InterfaceInfo struct {
 	// State Operational state of the interface.
 	State *string `json:"state,omitempty"`
 
+	// Type Interface type (ethernet, wifi, vlan, bond, bridge, etc.).
+	Type *string `json:"type,omitempty"`
+
+	// Vlan 802.1Q VLAN settings. Setting this on create makes the interface a VLAN on top of link.
+	Vlan *InterfaceVlan `json:"vlan,omitempty"`
+
 	// Wakeonlan Whether Wake-on-LAN is enabled.
 	Wakeonlan *bool `json:"wakeonlan,omitempty"`
 }
@@ -327,6 +549,40 @@ type InterfaceMutationResponse struct {
 	Results []InterfaceMutationEntry `json:"results"`
 }
 
+// InterfaceVlan 802.1Q VLAN settings. Setting this on create makes the interface a VLAN on top of link.
+type InterfaceVlan struct {
+	// Id VLAN ID.
+	Id int `json:"id" validate:"required,min=1,max=4094"`
+
+	// Link Parent interface carrying the tagged traffic.
+	Link string `json:"link" validate:"required,alphanum_or_fact"`
+}
+
+// NetworkConfirmCollectionResponse defines model for NetworkConfirmCollectionResponse.
+type NetworkConfirmCollectionResponse struct {
+	// JobId The job ID used to process this request.
+	JobId   *openapi_types.UUID        `json:"job_id,omitempty"`
+	Results []NetworkConfirmResultItem `json:"results"`
+}
+
+// NetworkConfirmResultItem defines model for NetworkConfirmResultItem.
+type NetworkConfirmResultItem struct {
+	// Changed Whether a pending change was confirmed. False when nothing was waiting for confirmation or safe apply is disabled.
+	Changed *bool `json:"changed,omitempty"`
+
+	// Error Error message if the agent failed.
+	Error *string `json:"error,omitempty"`
+
+	// Hostname Hostname of the agent that processed this operation.
+	Hostname string `json:"hostname"`
+
+	// Status The status of the operation for this host.
+	Status NetworkConfirmResultItemStatus `json:"status"`
+}
+
+// NetworkConfirmResultItemStatus The status of the operation for this host.
+type NetworkConfirmResultItemStatus string
+
 // PingCollectionResponse defines model for PingCollectionResponse.
 type PingCollectionResponse struct {
 	// JobId The job ID used to process this request.
@@ -478,6 +734,68 @@ type RouteMutationResponse struct {
 	Results []RouteMutationEntry `json:"results"`
 }
 
+// SocketCollectionResponse defines model for SocketCollectionResponse.
+type SocketCollectionResponse struct {
+	// JobId The job ID used to process this request.
+	JobId   *openapi_types.UUID `json:"job_id,omitempty"`
+	Results []SocketEntry       `json:"results"`
+}
+
+// SocketEntry Sockets reported by a single host.
+type SocketEntry struct {
+	// Error Error message if the agent failed to list sockets.
+	Error *string `json:"error,omitempty"`
+
+	// Hostname Hostname of the agent that reported this entry.
+	Hostname string        `json:"hostname"`
+	Sockets  *[]SocketInfo `json:"sockets,omitempty"`
+
+	// Status The status of the operation for this host.
+	Status SocketEntryStatus `json:"status"`
+}
+
+// SocketEntryStatus The status of the operation for this host.
+type SocketEntryStatus string
+
+// SocketInfo A TCP or UDP socket.
+type SocketInfo struct {
+	// Family Address family, either inet or inet6.
+	Family *string `json:"family,omitempty"`
+
+	// LocalAddress Local IP address.
+	LocalAddress *string `json:"local_address,omitempty"`
+
+	// LocalPort Local port.
+	LocalPort *int `json:"local_port,omitempty"`
+
+	// Pid ID of the process that owns the socket. Omitted when the owner cannot be determined.
+	Pid *int `json:"pid,omitempty"`
+
+	// Process Name of the process that owns the socket.
+	Process *string `json:"process,omitempty"`
+
+	// Protocol Transport protocol.
+	Protocol *SocketInfoProtocol `json:"protocol,omitempty"`
+
+	// RemoteAddress Remote IP address of an established connection.
+	RemoteAddress *string `json:"remote_address,omitempty"`
+
+	// RemotePort Remote port of an established connection.
+	RemotePort *int `json:"remote_port,omitempty"`
+
+	// State Socket state. Unconnected UDP sockets are reported as listening.
+	State *SocketInfoState `json:"state,omitempty"`
+}
+
+// SocketInfoProtocol Transport protocol.
+type SocketInfoProtocol string
+
+// SocketInfoState Socket state. Unconnected UDP sockets are reported as listening.
+type SocketInfoState string
+
+// FirewallName defines model for FirewallName.
+type FirewallName = string
+
 // Hostname defines model for Hostname.
 type Hostname = string
 
@@ -493,12 +811,39 @@ type PostNodeNetworkPingJSONBody struct {
 	Address string `json:"address" validate:"required,ip_or_fact"`
 }
 
+// GetNodeNetworkSocketParams defines parameters for GetNodeNetworkSocket.
+type GetNodeNetworkSocketParams struct {
+	// Protocol Only return sockets of this protocol.
+	Protocol *GetNodeNetworkSocketParamsProtocol `form:"protocol,omitempty" json:"protocol,omitempty" validate:"omitempty,oneof=tcp udp"`
+
+	// State Only return sockets in this state. Unconnected UDP sockets are reported as listening.
+	State *GetNodeNetworkSocketParamsState `form:"state,omitempty" json:"state,omitempty" validate:"omitempty,oneof=listen established"`
+
+	// Port Only return sockets whose local or remote port matches.
+	Port *int `form:"port,omitempty" json:"port,omitempty" validate:"omitempty,min=1,max=65535"`
+
+	// Pid Only return sockets owned by this process ID.
+	Pid *int `form:"pid,omitempty" json:"pid,omitempty" validate:"omitempty,min=1"`
+}
+
+// GetNodeNetworkSocketParamsProtocol defines parameters for GetNodeNetworkSocket.
+type GetNodeNetworkSocketParamsProtocol string
+
+// GetNodeNetworkSocketParamsState defines parameters for GetNodeNetworkSocket.
+type GetNodeNetworkSocketParamsState string
+
 // DeleteNodeNetworkDNSJSONRequestBody defines body for DeleteNodeNetworkDNS for application/json ContentType.
 type DeleteNodeNetworkDNSJSONRequestBody = DNSDeleteRequest
 
 // PutNodeNetworkDNSJSONRequestBody defines body for PutNodeNetworkDNS for application/json ContentType.
 type PutNodeNetworkDNSJSONRequestBody = DNSConfigUpdateRequest
 
+// PostNodeNetworkFirewallJSONRequestBody defines body for PostNodeNetworkFirewall for application/json ContentType.
+type PostNodeNetworkFirewallJSONRequestBody = FirewallCreateRequest
+
+// PutNodeNetworkFirewallJSONRequestBody defines body for PutNodeNetworkFirewall for application/json ContentType.
+type PutNodeNetworkFirewallJSONRequestBody = FirewallUpdateRequest
+
 // PostNodeNetworkInterfaceJSONRequestBody defines body for PostNodeNetworkInterface for application/json ContentType.
 type PostNodeNetworkInterfaceJSONRequestBody = InterfaceConfigRequest
 
@@ -516,6 +861,9 @@ type PutNodeNetworkRouteJSONRequestBody = RouteConfigRequest
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Confirm a pending network change
+	// (POST /api/node/{hostname}/network/confirm)
+	PostNodeNetworkConfirm(ctx echo.Context, hostname Hostname) error
 	// Delete DNS configuration
 	// (DELETE /api/node/{hostname}/network/dns)
 	DeleteNodeNetworkDNS(ctx echo.Context, hostname Hostname) error
@@ -525,6 +873,21 @@ type ServerInterface interface {
 	// List DNS servers
 	// (GET /api/node/{hostname}/network/dns/{interfaceName})
 	GetNodeNetworkDNSByInterface(ctx echo.Context, hostname Hostname, interfaceName string) error
+	// List firewall rule sets
+	// (GET /api/node/{hostname}/network/firewall)
+	GetNodeNetworkFirewall(ctx echo.Context, hostname Hostname) error
+	// Create a firewall rule set
+	// (POST /api/node/{hostname}/network/firewall)
+	PostNodeNetworkFirewall(ctx echo.Context, hostname Hostname) error
+	// Delete a firewall rule set
+	// (DELETE /api/node/{hostname}/network/firewall/{name})
+	DeleteNodeNetworkFirewall(ctx echo.Context, hostname Hostname, name FirewallName) error
+	// Get a firewall rule set
+	// (GET /api/node/{hostname}/network/firewall/{name})
+	GetNodeNetworkFirewallByName(ctx echo.Context, hostname Hostname, name FirewallName) error
+	// Update a firewall rule set
+	// (PUT /api/node/{hostname}/network/firewall/{name})
+	PutNodeNetworkFirewall(ctx echo.Context, hostname Hostname, name FirewallName) error
 	// List network interfaces
 	// (GET /api/node/{hostname}/network/interface)
 	GetNodeNetworkInterface(ctx echo.Context, hostname Hostname) error
@@ -558,6 +921,9 @@ type ServerInterface interface {
 	// Update routes for an interface
 	// (PUT /api/node/{hostname}/network/route/{interfaceName})
 	PutNodeNetworkRoute(ctx echo.Context, hostname Hostname, interfaceName RouteInterfaceName) error
+	// List sockets
+	// (GET /api/node/{hostname}/network/socket)
+	GetNodeNetworkSocket(ctx echo.Context, hostname Hostname, params GetNodeNetworkSocketParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -565,6 +931,24 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// PostNodeNetworkConfirm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostNodeNetworkConfirm(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"network:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostNodeNetworkConfirm(ctx, hostname)
+	return err
+}
+
 // DeleteNodeNetworkDNS converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteNodeNetworkDNS(ctx echo.Context) error {
 	var err error
@@ -627,8 +1011,8 @@ func (w *ServerInterfaceWrapper) GetNodeNetworkDNSByInterface(ctx echo.Context)
 	return err
 }
 
-// GetNodeNetworkInterface converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeNetworkInterface(ctx echo.Context) error {
+// GetNodeNetworkFirewall converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeNetworkFirewall(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "hostname" -------------
 	var hostname Hostname
@@ -638,15 +1022,15 @@ func (w *ServerInterfaceWrapper) GetNodeNetworkInterface(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{"network:read"})
+	ctx.Set(BearerAuthScopes, []string{"firewall:read"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeNetworkInterface(ctx, hostname)
+	err = w.Handler.GetNodeNetworkFirewall(ctx, hostname)
 	return err
 }
 
-// DeleteNodeNetworkInterface converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteNodeNetworkInterface(ctx echo.Context) error {
+// PostNodeNetworkFirewall converts echo context to params.
+func (w *ServerInterfaceWrapper) PostNodeNetworkFirewall(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "hostname" -------------
 	var hostname Hostname
@@ -656,23 +1040,15 @@ func (w *ServerInterfaceWrapper) DeleteNodeNetworkInterface(ctx echo.Context) er
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
 	}
 
-	// ------------- Path parameter "name" -------------
-	var name InterfaceName
-
-	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
-	}
-
-	ctx.Set(BearerAuthScopes, []string{"network:write"})
+	ctx.Set(BearerAuthScopes, []string{"firewall:write"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteNodeNetworkInterface(ctx, hostname, name)
+	err = w.Handler.PostNodeNetworkFirewall(ctx, hostname)
 	return err
 }
 
-// GetNodeNetworkInterfaceByName converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeNetworkInterfaceByName(ctx echo.Context) error {
+// DeleteNodeNetworkFirewall converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteNodeNetworkFirewall(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "hostname" -------------
 	var hostname Hostname
@@ -683,22 +1059,22 @@ func (w *ServerInterfaceWrapper) GetNodeNetworkInterfaceByName(ctx echo.Context)
 	}
 
 	// ------------- Path parameter "name" -------------
-	var name InterfaceName
+	var name FirewallName
 
 	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{"network:read"})
+	ctx.Set(BearerAuthScopes, []string{"firewall:write"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeNetworkInterfaceByName(ctx, hostname, name)
+	err = w.Handler.DeleteNodeNetworkFirewall(ctx, hostname, name)
 	return err
 }
 
-// PostNodeNetworkInterface converts echo context to params.
-func (w *ServerInterfaceWrapper) PostNodeNetworkInterface(ctx echo.Context) error {
+// GetNodeNetworkFirewallByName converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeNetworkFirewallByName(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "hostname" -------------
 	var hostname Hostname
@@ -709,22 +1085,22 @@ func (w *ServerInterfaceWrapper) PostNodeNetworkInterface(ctx echo.Context) erro
 	}
 
 	// ------------- Path parameter "name" -------------
-	var name InterfaceName
+	var name FirewallName
 
 	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{"network:write"})
+	ctx.Set(BearerAuthScopes, []string{"firewall:read"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostNodeNetworkInterface(ctx, hostname, name)
+	err = w.Handler.GetNodeNetworkFirewallByName(ctx, hostname, name)
 	return err
 }
 
-// PutNodeNetworkInterface converts echo context to params.
-func (w *ServerInterfaceWrapper) PutNodeNetworkInterface(ctx echo.Context) error {
+// PutNodeNetworkFirewall converts echo context to params.
+func (w *ServerInterfaceWrapper) PutNodeNetworkFirewall(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "hostname" -------------
 	var hostname Hostname
@@ -735,22 +1111,22 @@ func (w *ServerInterfaceWrapper) PutNodeNetworkInterface(ctx echo.Context) error
 	}
 
 	// ------------- Path parameter "name" -------------
-	var name InterfaceName
+	var name FirewallName
 
 	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{"network:write"})
+	ctx.Set(BearerAuthScopes, []string{"firewall:write"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PutNodeNetworkInterface(ctx, hostname, name)
+	err = w.Handler.PutNodeNetworkFirewall(ctx, hostname, name)
 	return err
 }
 
-// PostNodeNetworkPing converts echo context to params.
-func (w *ServerInterfaceWrapper) PostNodeNetworkPing(ctx echo.Context) error {
+// GetNodeNetworkInterface converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeNetworkInterface(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "hostname" -------------
 	var hostname Hostname
@@ -760,15 +1136,15 @@ func (w *ServerInterfaceWrapper) PostNodeNetworkPing(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{"network:write"})
+	ctx.Set(BearerAuthScopes, []string{"network:read"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostNodeNetworkPing(ctx, hostname)
+	err = w.Handler.GetNodeNetworkInterface(ctx, hostname)
 	return err
 }
 
-// GetNodeNetworkRoute converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeNetworkRoute(ctx echo.Context) error {
+// DeleteNodeNetworkInterface converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteNodeNetworkInterface(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "hostname" -------------
 	var hostname Hostname
@@ -778,15 +1154,23 @@ func (w *ServerInterfaceWrapper) GetNodeNetworkRoute(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{"network:read"})
+	// ------------- Path parameter "name" -------------
+	var name InterfaceName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"network:write"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeNetworkRoute(ctx, hostname)
+	err = w.Handler.DeleteNodeNetworkInterface(ctx, hostname, name)
 	return err
 }
 
-// DeleteNodeNetworkRoute converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteNodeNetworkRoute(ctx echo.Context) error {
+// GetNodeNetworkInterfaceByName converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeNetworkInterfaceByName(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "hostname" -------------
 	var hostname Hostname
@@ -796,23 +1180,23 @@ func (w *ServerInterfaceWrapper) DeleteNodeNetworkRoute(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
 	}
 
-	// ------------- Path parameter "interfaceName" -------------
-	var interfaceName RouteInterfaceName
+	// ------------- Path parameter "name" -------------
+	var name InterfaceName
 
-	err = runtime.BindStyledParameterWithOptions("simple", "interfaceName", ctx.Param("interfaceName"), &interfaceName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interfaceName: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{"network:write"})
+	ctx.Set(BearerAuthScopes, []string{"network:read"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteNodeNetworkRoute(ctx, hostname, interfaceName)
+	err = w.Handler.GetNodeNetworkInterfaceByName(ctx, hostname, name)
 	return err
 }
 
-// GetNodeNetworkRouteByInterface converts echo context to params.
-func (w *ServerInterfaceWrapper) GetNodeNetworkRouteByInterface(ctx echo.Context) error {
+// PostNodeNetworkInterface converts echo context to params.
+func (w *ServerInterfaceWrapper) PostNodeNetworkInterface(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "hostname" -------------
 	var hostname Hostname
@@ -822,22 +1206,136 @@ func (w *ServerInterfaceWrapper) GetNodeNetworkRouteByInterface(ctx echo.Context
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
 	}
 
-	// ------------- Path parameter "interfaceName" -------------
-	var interfaceName RouteInterfaceName
+	// ------------- Path parameter "name" -------------
+	var name InterfaceName
 
-	err = runtime.BindStyledParameterWithOptions("simple", "interfaceName", ctx.Param("interfaceName"), &interfaceName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interfaceName: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{"network:read"})
+	ctx.Set(BearerAuthScopes, []string{"network:write"})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetNodeNetworkRouteByInterface(ctx, hostname, interfaceName)
+	err = w.Handler.PostNodeNetworkInterface(ctx, hostname, name)
 	return err
 }
 
-// PostNodeNetworkRoute converts echo context to params.
+// PutNodeNetworkInterface converts echo context to params.
+func (w *ServerInterfaceWrapper) PutNodeNetworkInterface(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InterfaceName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"network:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutNodeNetworkInterface(ctx, hostname, name)
+	return err
+}
+
+// PostNodeNetworkPing converts echo context to params.
+func (w *ServerInterfaceWrapper) PostNodeNetworkPing(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"network:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostNodeNetworkPing(ctx, hostname)
+	return err
+}
+
+// GetNodeNetworkRoute converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeNetworkRoute(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"network:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeNetworkRoute(ctx, hostname)
+	return err
+}
+
+// DeleteNodeNetworkRoute converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteNodeNetworkRoute(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	// ------------- Path parameter "interfaceName" -------------
+	var interfaceName RouteInterfaceName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "interfaceName", ctx.Param("interfaceName"), &interfaceName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interfaceName: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"network:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteNodeNetworkRoute(ctx, hostname, interfaceName)
+	return err
+}
+
+// GetNodeNetworkRouteByInterface converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeNetworkRouteByInterface(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	// ------------- Path parameter "interfaceName" -------------
+	var interfaceName RouteInterfaceName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "interfaceName", ctx.Param("interfaceName"), &interfaceName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interfaceName: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"network:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeNetworkRouteByInterface(ctx, hostname, interfaceName)
+	return err
+}
+
+// PostNodeNetworkRoute converts echo context to params.
 func (w *ServerInterfaceWrapper) PostNodeNetworkRoute(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "hostname" -------------
@@ -889,6 +1387,54 @@ func (w *ServerInterfaceWrapper) PutNodeNetworkRoute(ctx echo.Context) error {
 	return err
 }
 
+// GetNodeNetworkSocket converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeNetworkSocket(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"network:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodeNetworkSocketParams
+	// ------------- Optional query parameter "protocol" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "protocol", ctx.QueryParams(), &params.Protocol)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter protocol: %s", err))
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
+	}
+
+	// ------------- Optional query parameter "port" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "port", ctx.QueryParams(), &params.Port)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter port: %s", err))
+	}
+
+	// ------------- Optional query parameter "pid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pid", ctx.QueryParams(), &params.Pid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeNetworkSocket(ctx, hostname, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -917,9 +1463,15 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.POST(baseURL+"/api/node/:hostname/network/confirm", wrapper.PostNodeNetworkConfirm)
 	router.DELETE(baseURL+"/api/node/:hostname/network/dns", wrapper.DeleteNodeNetworkDNS)
 	router.PUT(baseURL+"/api/node/:hostname/network/dns", wrapper.PutNodeNetworkDNS)
 	router.GET(baseURL+"/api/node/:hostname/network/dns/:interfaceName", wrapper.GetNodeNetworkDNSByInterface)
+	router.GET(baseURL+"/api/node/:hostname/network/firewall", wrapper.GetNodeNetworkFirewall)
+	router.POST(baseURL+"/api/node/:hostname/network/firewall", wrapper.PostNodeNetworkFirewall)
+	router.DELETE(baseURL+"/api/node/:hostname/network/firewall/:name", wrapper.DeleteNodeNetworkFirewall)
+	router.GET(baseURL+"/api/node/:hostname/network/firewall/:name", wrapper.GetNodeNetworkFirewallByName)
+	router.PUT(baseURL+"/api/node/:hostname/network/firewall/:name", wrapper.PutNodeNetworkFirewall)
 	router.GET(baseURL+"/api/node/:hostname/network/interface", wrapper.GetNodeNetworkInterface)
 	router.DELETE(baseURL+"/api/node/:hostname/network/interface/:name", wrapper.DeleteNodeNetworkInterface)
 	router.GET(baseURL+"/api/node/:hostname/network/interface/:name", wrapper.GetNodeNetworkInterfaceByName)
@@ -931,165 +1483,507 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/node/:hostname/network/route/:interfaceName", wrapper.GetNodeNetworkRouteByInterface)
 	router.POST(baseURL+"/api/node/:hostname/network/route/:interfaceName", wrapper.PostNodeNetworkRoute)
 	router.PUT(baseURL+"/api/node/:hostname/network/route/:interfaceName", wrapper.PutNodeNetworkRoute)
+	router.GET(baseURL+"/api/node/:hostname/network/socket", wrapper.GetNodeNetworkSocket)
+
+}
+
+type PostNodeNetworkConfirmRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+}
+
+type PostNodeNetworkConfirmResponseObject interface {
+	VisitPostNodeNetworkConfirmResponse(w http.ResponseWriter) error
+}
+
+type PostNodeNetworkConfirm200JSONResponse NetworkConfirmCollectionResponse
+
+func (response PostNodeNetworkConfirm200JSONResponse) VisitPostNodeNetworkConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeNetworkConfirm400JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeNetworkConfirm400JSONResponse) VisitPostNodeNetworkConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeNetworkConfirm401JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeNetworkConfirm401JSONResponse) VisitPostNodeNetworkConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeNetworkConfirm403JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeNetworkConfirm403JSONResponse) VisitPostNodeNetworkConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeNetworkConfirm500JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeNetworkConfirm500JSONResponse) VisitPostNodeNetworkConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeNetworkDNSRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+	Body     *DeleteNodeNetworkDNSJSONRequestBody
+}
+
+type DeleteNodeNetworkDNSResponseObject interface {
+	VisitDeleteNodeNetworkDNSResponse(w http.ResponseWriter) error
+}
+
+type DeleteNodeNetworkDNS200JSONResponse DNSDeleteCollectionResponse
+
+func (response DeleteNodeNetworkDNS200JSONResponse) VisitDeleteNodeNetworkDNSResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeNetworkDNS400JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodeNetworkDNS400JSONResponse) VisitDeleteNodeNetworkDNSResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeNetworkDNS401JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodeNetworkDNS401JSONResponse) VisitDeleteNodeNetworkDNSResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeNetworkDNS403JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodeNetworkDNS403JSONResponse) VisitDeleteNodeNetworkDNSResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeNetworkDNS500JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodeNetworkDNS500JSONResponse) VisitDeleteNodeNetworkDNSResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeNetworkDNSRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+	Body     *PutNodeNetworkDNSJSONRequestBody
+}
+
+type PutNodeNetworkDNSResponseObject interface {
+	VisitPutNodeNetworkDNSResponse(w http.ResponseWriter) error
+}
+
+type PutNodeNetworkDNS202JSONResponse DNSUpdateCollectionResponse
+
+func (response PutNodeNetworkDNS202JSONResponse) VisitPutNodeNetworkDNSResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeNetworkDNS400JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodeNetworkDNS400JSONResponse) VisitPutNodeNetworkDNSResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeNetworkDNS401JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodeNetworkDNS401JSONResponse) VisitPutNodeNetworkDNSResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeNetworkDNS403JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodeNetworkDNS403JSONResponse) VisitPutNodeNetworkDNSResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeNetworkDNS500JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodeNetworkDNS500JSONResponse) VisitPutNodeNetworkDNSResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeNetworkDNSByInterfaceRequestObject struct {
+	Hostname      Hostname `json:"hostname"`
+	InterfaceName string   `json:"interfaceName"`
+}
+
+type GetNodeNetworkDNSByInterfaceResponseObject interface {
+	VisitGetNodeNetworkDNSByInterfaceResponse(w http.ResponseWriter) error
+}
+
+type GetNodeNetworkDNSByInterface200JSONResponse DNSConfigCollectionResponse
+
+func (response GetNodeNetworkDNSByInterface200JSONResponse) VisitGetNodeNetworkDNSByInterfaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeNetworkDNSByInterface400JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeNetworkDNSByInterface400JSONResponse) VisitGetNodeNetworkDNSByInterfaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeNetworkDNSByInterface401JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeNetworkDNSByInterface401JSONResponse) VisitGetNodeNetworkDNSByInterfaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeNetworkDNSByInterface403JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeNetworkDNSByInterface403JSONResponse) VisitGetNodeNetworkDNSByInterfaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeNetworkDNSByInterface500JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeNetworkDNSByInterface500JSONResponse) VisitGetNodeNetworkDNSByInterfaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeNetworkFirewallRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+}
+
+type GetNodeNetworkFirewallResponseObject interface {
+	VisitGetNodeNetworkFirewallResponse(w http.ResponseWriter) error
+}
+
+type GetNodeNetworkFirewall200JSONResponse FirewallCollectionResponse
+
+func (response GetNodeNetworkFirewall200JSONResponse) VisitGetNodeNetworkFirewallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeNetworkFirewall400JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeNetworkFirewall400JSONResponse) VisitGetNodeNetworkFirewallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeNetworkFirewall401JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeNetworkFirewall401JSONResponse) VisitGetNodeNetworkFirewallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeNetworkFirewall403JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeNetworkFirewall403JSONResponse) VisitGetNodeNetworkFirewallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeNetworkFirewall500JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeNetworkFirewall500JSONResponse) VisitGetNodeNetworkFirewallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeNetworkFirewallRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+	Body     *PostNodeNetworkFirewallJSONRequestBody
+}
+
+type PostNodeNetworkFirewallResponseObject interface {
+	VisitPostNodeNetworkFirewallResponse(w http.ResponseWriter) error
+}
+
+type PostNodeNetworkFirewall200JSONResponse FirewallMutationResponse
+
+func (response PostNodeNetworkFirewall200JSONResponse) VisitPostNodeNetworkFirewallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeNetworkFirewall400JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeNetworkFirewall400JSONResponse) VisitPostNodeNetworkFirewallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeNetworkFirewall401JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeNetworkFirewall401JSONResponse) VisitPostNodeNetworkFirewallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNodeNetworkDNSRequestObject struct {
-	Hostname Hostname `json:"hostname"`
-	Body     *DeleteNodeNetworkDNSJSONRequestBody
+type PostNodeNetworkFirewall403JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeNetworkFirewall403JSONResponse) VisitPostNodeNetworkFirewallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNodeNetworkDNSResponseObject interface {
-	VisitDeleteNodeNetworkDNSResponse(w http.ResponseWriter) error
+type PostNodeNetworkFirewall500JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeNetworkFirewall500JSONResponse) VisitPostNodeNetworkFirewallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNodeNetworkDNS200JSONResponse DNSDeleteCollectionResponse
+type DeleteNodeNetworkFirewallRequestObject struct {
+	Hostname Hostname     `json:"hostname"`
+	Name     FirewallName `json:"name"`
+}
 
-func (response DeleteNodeNetworkDNS200JSONResponse) VisitDeleteNodeNetworkDNSResponse(w http.ResponseWriter) error {
+type DeleteNodeNetworkFirewallResponseObject interface {
+	VisitDeleteNodeNetworkFirewallResponse(w http.ResponseWriter) error
+}
+
+type DeleteNodeNetworkFirewall200JSONResponse FirewallMutationResponse
+
+func (response DeleteNodeNetworkFirewall200JSONResponse) VisitDeleteNodeNetworkFirewallResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNodeNetworkDNS400JSONResponse externalRef0.ErrorResponse
+type DeleteNodeNetworkFirewall400JSONResponse externalRef0.ErrorResponse
 
-func (response DeleteNodeNetworkDNS400JSONResponse) VisitDeleteNodeNetworkDNSResponse(w http.ResponseWriter) error {
+func (response DeleteNodeNetworkFirewall400JSONResponse) VisitDeleteNodeNetworkFirewallResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNodeNetworkDNS401JSONResponse externalRef0.ErrorResponse
+type DeleteNodeNetworkFirewall401JSONResponse externalRef0.ErrorResponse
 
-func (response DeleteNodeNetworkDNS401JSONResponse) VisitDeleteNodeNetworkDNSResponse(w http.ResponseWriter) error {
+func (response DeleteNodeNetworkFirewall401JSONResponse) VisitDeleteNodeNetworkFirewallResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNodeNetworkDNS403JSONResponse externalRef0.ErrorResponse
+type DeleteNodeNetworkFirewall403JSONResponse externalRef0.ErrorResponse
 
-func (response DeleteNodeNetworkDNS403JSONResponse) VisitDeleteNodeNetworkDNSResponse(w http.ResponseWriter) error {
+func (response DeleteNodeNetworkFirewall403JSONResponse) VisitDeleteNodeNetworkFirewallResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNodeNetworkDNS500JSONResponse externalRef0.ErrorResponse
+type DeleteNodeNetworkFirewall500JSONResponse externalRef0.ErrorResponse
 
-func (response DeleteNodeNetworkDNS500JSONResponse) VisitDeleteNodeNetworkDNSResponse(w http.ResponseWriter) error {
+func (response DeleteNodeNetworkFirewall500JSONResponse) VisitDeleteNodeNetworkFirewallResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutNodeNetworkDNSRequestObject struct {
-	Hostname Hostname `json:"hostname"`
-	Body     *PutNodeNetworkDNSJSONRequestBody
+type GetNodeNetworkFirewallByNameRequestObject struct {
+	Hostname Hostname     `json:"hostname"`
+	Name     FirewallName `json:"name"`
 }
 
-type PutNodeNetworkDNSResponseObject interface {
-	VisitPutNodeNetworkDNSResponse(w http.ResponseWriter) error
+type GetNodeNetworkFirewallByNameResponseObject interface {
+	VisitGetNodeNetworkFirewallByNameResponse(w http.ResponseWriter) error
 }
 
-type PutNodeNetworkDNS202JSONResponse DNSUpdateCollectionResponse
+type GetNodeNetworkFirewallByName200JSONResponse FirewallGetResponse
 
-func (response PutNodeNetworkDNS202JSONResponse) VisitPutNodeNetworkDNSResponse(w http.ResponseWriter) error {
+func (response GetNodeNetworkFirewallByName200JSONResponse) VisitGetNodeNetworkFirewallByNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutNodeNetworkDNS400JSONResponse externalRef0.ErrorResponse
+type GetNodeNetworkFirewallByName400JSONResponse externalRef0.ErrorResponse
 
-func (response PutNodeNetworkDNS400JSONResponse) VisitPutNodeNetworkDNSResponse(w http.ResponseWriter) error {
+func (response GetNodeNetworkFirewallByName400JSONResponse) VisitGetNodeNetworkFirewallByNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutNodeNetworkDNS401JSONResponse externalRef0.ErrorResponse
+type GetNodeNetworkFirewallByName401JSONResponse externalRef0.ErrorResponse
 
-func (response PutNodeNetworkDNS401JSONResponse) VisitPutNodeNetworkDNSResponse(w http.ResponseWriter) error {
+func (response GetNodeNetworkFirewallByName401JSONResponse) VisitGetNodeNetworkFirewallByNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutNodeNetworkDNS403JSONResponse externalRef0.ErrorResponse
+type GetNodeNetworkFirewallByName403JSONResponse externalRef0.ErrorResponse
 
-func (response PutNodeNetworkDNS403JSONResponse) VisitPutNodeNetworkDNSResponse(w http.ResponseWriter) error {
+func (response GetNodeNetworkFirewallByName403JSONResponse) VisitGetNodeNetworkFirewallByNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutNodeNetworkDNS500JSONResponse externalRef0.ErrorResponse
+type GetNodeNetworkFirewallByName404JSONResponse externalRef0.ErrorResponse
 
-func (response PutNodeNetworkDNS500JSONResponse) VisitPutNodeNetworkDNSResponse(w http.ResponseWriter) error {
+func (response GetNodeNetworkFirewallByName404JSONResponse) VisitGetNodeNetworkFirewallByNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeNetworkFirewallByName500JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeNetworkFirewallByName500JSONResponse) VisitGetNodeNetworkFirewallByNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNodeNetworkDNSByInterfaceRequestObject struct {
-	Hostname      Hostname `json:"hostname"`
-	InterfaceName string   `json:"interfaceName"`
+type PutNodeNetworkFirewallRequestObject struct {
+	Hostname Hostname     `json:"hostname"`
+	Name     FirewallName `json:"name"`
+	Body     *PutNodeNetworkFirewallJSONRequestBody
 }
 
-type GetNodeNetworkDNSByInterfaceResponseObject interface {
-	VisitGetNodeNetworkDNSByInterfaceResponse(w http.ResponseWriter) error
+type PutNodeNetworkFirewallResponseObject interface {
+	VisitPutNodeNetworkFirewallResponse(w http.ResponseWriter) error
 }
 
-type GetNodeNetworkDNSByInterface200JSONResponse DNSConfigCollectionResponse
+type PutNodeNetworkFirewall200JSONResponse FirewallMutationResponse
 
-func (response GetNodeNetworkDNSByInterface200JSONResponse) VisitGetNodeNetworkDNSByInterfaceResponse(w http.ResponseWriter) error {
+func (response PutNodeNetworkFirewall200JSONResponse) VisitPutNodeNetworkFirewallResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNodeNetworkDNSByInterface400JSONResponse externalRef0.ErrorResponse
+type PutNodeNetworkFirewall400JSONResponse externalRef0.ErrorResponse
 
-func (response GetNodeNetworkDNSByInterface400JSONResponse) VisitGetNodeNetworkDNSByInterfaceResponse(w http.ResponseWriter) error {
+func (response PutNodeNetworkFirewall400JSONResponse) VisitPutNodeNetworkFirewallResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNodeNetworkDNSByInterface401JSONResponse externalRef0.ErrorResponse
+type PutNodeNetworkFirewall401JSONResponse externalRef0.ErrorResponse
 
-func (response GetNodeNetworkDNSByInterface401JSONResponse) VisitGetNodeNetworkDNSByInterfaceResponse(w http.ResponseWriter) error {
+func (response PutNodeNetworkFirewall401JSONResponse) VisitPutNodeNetworkFirewallResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNodeNetworkDNSByInterface403JSONResponse externalRef0.ErrorResponse
+type PutNodeNetworkFirewall403JSONResponse externalRef0.ErrorResponse
 
-func (response GetNodeNetworkDNSByInterface403JSONResponse) VisitGetNodeNetworkDNSByInterfaceResponse(w http.ResponseWriter) error {
+func (response PutNodeNetworkFirewall403JSONResponse) VisitPutNodeNetworkFirewallResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNodeNetworkDNSByInterface500JSONResponse externalRef0.ErrorResponse
+type PutNodeNetworkFirewall404JSONResponse externalRef0.ErrorResponse
 
-func (response GetNodeNetworkDNSByInterface500JSONResponse) VisitGetNodeNetworkDNSByInterfaceResponse(w http.ResponseWriter) error {
+func (response PutNodeNetworkFirewall404JSONResponse) VisitPutNodeNetworkFirewallResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeNetworkFirewall500JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodeNetworkFirewall500JSONResponse) VisitPutNodeNetworkFirewallResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
@@ -1728,8 +2622,65 @@ func (response PutNodeNetworkRoute500JSONResponse) VisitPutNodeNetworkRouteRespo
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetNodeNetworkSocketRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+	Params   GetNodeNetworkSocketParams
+}
+
+type GetNodeNetworkSocketResponseObject interface {
+	VisitGetNodeNetworkSocketResponse(w http.ResponseWriter) error
+}
+
+type GetNodeNetworkSocket200JSONResponse SocketCollectionResponse
+
+func (response GetNodeNetworkSocket200JSONResponse) VisitGetNodeNetworkSocketResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeNetworkSocket400JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeNetworkSocket400JSONResponse) VisitGetNodeNetworkSocketResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeNetworkSocket401JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeNetworkSocket401JSONResponse) VisitGetNodeNetworkSocketResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeNetworkSocket403JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeNetworkSocket403JSONResponse) VisitGetNodeNetworkSocketResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeNetworkSocket500JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeNetworkSocket500JSONResponse) VisitGetNodeNetworkSocketResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
+	// Confirm a pending network change
+	// (POST /api/node/{hostname}/network/confirm)
+	PostNodeNetworkConfirm(ctx context.Context, request PostNodeNetworkConfirmRequestObject) (PostNodeNetworkConfirmResponseObject, error)
 	// Delete DNS configuration
 	// (DELETE /api/node/{hostname}/network/dns)
 	DeleteNodeNetworkDNS(ctx context.Context, request DeleteNodeNetworkDNSRequestObject) (DeleteNodeNetworkDNSResponseObject, error)
@@ -1739,6 +2690,21 @@ type StrictServerInterface interface {
 	// List DNS servers
 	// (GET /api/node/{hostname}/network/dns/{interfaceName})
 	GetNodeNetworkDNSByInterface(ctx context.Context, request GetNodeNetworkDNSByInterfaceRequestObject) (GetNodeNetworkDNSByInterfaceResponseObject, error)
+	// List firewall rule sets
+	// (GET /api/node/{hostname}/network/firewall)
+	GetNodeNetworkFirewall(ctx context.Context, request GetNodeNetworkFirewallRequestObject) (GetNodeNetworkFirewallResponseObject, error)
+	// Create a firewall rule set
+	// (POST /api/node/{hostname}/network/firewall)
+	PostNodeNetworkFirewall(ctx context.Context, request PostNodeNetworkFirewallRequestObject) (PostNodeNetworkFirewallResponseObject, error)
+	// Delete a firewall rule set
+	// (DELETE /api/node/{hostname}/network/firewall/{name})
+	DeleteNodeNetworkFirewall(ctx context.Context, request DeleteNodeNetworkFirewallRequestObject) (DeleteNodeNetworkFirewallResponseObject, error)
+	// Get a firewall rule set
+	// (GET /api/node/{hostname}/network/firewall/{name})
+	GetNodeNetworkFirewallByName(ctx context.Context, request GetNodeNetworkFirewallByNameRequestObject) (GetNodeNetworkFirewallByNameResponseObject, error)
+	// Update a firewall rule set
+	// (PUT /api/node/{hostname}/network/firewall/{name})
+	PutNodeNetworkFirewall(ctx context.Context, request PutNodeNetworkFirewallRequestObject) (PutNodeNetworkFirewallResponseObject, error)
 	// List network interfaces
 	// (GET /api/node/{hostname}/network/interface)
 	GetNodeNetworkInterface(ctx context.Context, request GetNodeNetworkInterfaceRequestObject) (GetNodeNetworkInterfaceResponseObject, error)
@@ -1772,6 +2738,9 @@ type StrictServerInterface interface {
 	// Update routes for an interface
 	// (PUT /api/node/{hostname}/network/route/{interfaceName})
 	PutNodeNetworkRoute(ctx context.Context, request PutNodeNetworkRouteRequestObject) (PutNodeNetworkRouteResponseObject, error)
+	// List sockets
+	// (GET /api/node/{hostname}/network/socket)
+	GetNodeNetworkSocket(ctx context.Context, request GetNodeNetworkSocketRequestObject) (GetNodeNetworkSocketResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -1786,6 +2755,31 @@ type strictHandler struct {
 	middlewares []StrictMiddlewareFunc
 }
 
+// PostNodeNetworkConfirm operation middleware
+func (sh *strictHandler) PostNodeNetworkConfirm(ctx echo.Context, hostname Hostname) error {
+	var request PostNodeNetworkConfirmRequestObject
+
+	request.Hostname = hostname
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostNodeNetworkConfirm(ctx.Request().Context(), request.(PostNodeNetworkConfirmRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostNodeNetworkConfirm")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostNodeNetworkConfirmResponseObject); ok {
+		return validResponse.VisitPostNodeNetworkConfirmResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // DeleteNodeNetworkDNS operation middleware
 func (sh *strictHandler) DeleteNodeNetworkDNS(ctx echo.Context, hostname Hostname) error {
 	var request DeleteNodeNetworkDNSRequestObject
@@ -1874,6 +2868,146 @@ func (sh *strictHandler) GetNodeNetworkDNSByInterface(ctx echo.Context, hostname
 	return nil
 }
 
+// GetNodeNetworkFirewall operation middleware
+func (sh *strictHandler) GetNodeNetworkFirewall(ctx echo.Context, hostname Hostname) error {
+	var request GetNodeNetworkFirewallRequestObject
+
+	request.Hostname = hostname
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNodeNetworkFirewall(ctx.Request().Context(), request.(GetNodeNetworkFirewallRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNodeNetworkFirewall")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetNodeNetworkFirewallResponseObject); ok {
+		return validResponse.VisitGetNodeNetworkFirewallResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostNodeNetworkFirewall operation middleware
+func (sh *strictHandler) PostNodeNetworkFirewall(ctx echo.Context, hostname Hostname) error {
+	var request PostNodeNetworkFirewallRequestObject
+
+	request.Hostname = hostname
+
+	var body PostNodeNetworkFirewallJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostNodeNetworkFirewall(ctx.Request().Context(), request.(PostNodeNetworkFirewallRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostNodeNetworkFirewall")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostNodeNetworkFirewallResponseObject); ok {
+		return validResponse.VisitPostNodeNetworkFirewallResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteNodeNetworkFirewall operation middleware
+func (sh *strictHandler) DeleteNodeNetworkFirewall(ctx echo.Context, hostname Hostname, name FirewallName) error {
+	var request DeleteNodeNetworkFirewallRequestObject
+
+	request.Hostname = hostname
+	request.Name = name
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteNodeNetworkFirewall(ctx.Request().Context(), request.(DeleteNodeNetworkFirewallRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteNodeNetworkFirewall")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteNodeNetworkFirewallResponseObject); ok {
+		return validResponse.VisitDeleteNodeNetworkFirewallResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetNodeNetworkFirewallByName operation middleware
+func (sh *strictHandler) GetNodeNetworkFirewallByName(ctx echo.Context, hostname Hostname, name FirewallName) error {
+	var request GetNodeNetworkFirewallByNameRequestObject
+
+	request.Hostname = hostname
+	request.Name = name
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNodeNetworkFirewallByName(ctx.Request().Context(), request.(GetNodeNetworkFirewallByNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNodeNetworkFirewallByName")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetNodeNetworkFirewallByNameResponseObject); ok {
+		return validResponse.VisitGetNodeNetworkFirewallByNameResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutNodeNetworkFirewall operation middleware
+func (sh *strictHandler) PutNodeNetworkFirewall(ctx echo.Context, hostname Hostname, name FirewallName) error {
+	var request PutNodeNetworkFirewallRequestObject
+
+	request.Hostname = hostname
+	request.Name = name
+
+	var body PutNodeNetworkFirewallJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutNodeNetworkFirewall(ctx.Request().Context(), request.(PutNodeNetworkFirewallRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutNodeNetworkFirewall")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutNodeNetworkFirewallResponseObject); ok {
+		return validResponse.VisitPutNodeNetworkFirewallResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetNodeNetworkInterface operation middleware
 func (sh *strictHandler) GetNodeNetworkInterface(ctx echo.Context, hostname Hostname) error {
 	var request GetNodeNetworkInterfaceRequestObject
@@ -2186,3 +3320,29 @@ func (sh *strictHandler) PutNodeNetworkRoute(ctx echo.Context, hostname Hostname
 	}
 	return nil
 }
+
+// GetNodeNetworkSocket operation middleware
+func (sh *strictHandler) GetNodeNetworkSocket(ctx echo.Context, hostname Hostname, params GetNodeNetworkSocketParams) error {
+	var request GetNodeNetworkSocketRequestObject
+
+	request.Hostname = hostname
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNodeNetworkSocket(ctx.Request().Context(), request.(GetNodeNetworkSocketRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNodeNetworkSocket")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetNodeNetworkSocketResponseObject); ok {
+		return validResponse.VisitGetNodeNetworkSocketResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
@@ -44,12 +44,16 @@ func (s *Network) PostNodeNetworkInterface(
 		return gen.PostNodeNetworkInterface400JSONResponse{Error: &errMsg}, nil
 	}
 
-	// Defense in depth: current fields use omitempty so validation
-	// always passes, but guards against future field additions.
+	// Validates field formats, including the required fields of
+	// nested vlan, bond and bridge settings.
 	if errMsg, ok := validation.Struct(request.Body); !ok {
 		return gen.PostNodeNetworkInterface400JSONResponse{Error: &errMsg}, nil
 	}
 
+	if errMsg, ok := validateInterfaceVariant(request.Body); !ok {
+		return gen.PostNodeNetworkInterface400JSONResponse{Error: &errMsg}, nil
+	}
+
 	hostname := request.Hostname
 
 	s.logger.Debug(
@@ -172,6 +176,32 @@ func buildInterfaceData(
 	if body.Wakeonlan != nil {
 		data["wakeonlan"] = *body.Wakeonlan
 	}
+	if body.Vlan != nil {
+		data["vlan"] = map[string]any{
+			"id":   body.Vlan.Id,
+			"link": body.Vlan.Link,
+		}
+	}
+	if body.Bond != nil {
+		bond := map[string]any{
+			"mode":    string(body.Bond.Mode),
+			"members": body.Bond.Members,
+		}
+		if body.Bond.Miimon != nil {
+			bond["miimon"] = *body.Bond.Miimon
+		}
+		data["bond"] = bond
+	}
+	if body.Bridge != nil {
+		bridge := map[string]any{}
+		if body.Bridge.Members != nil {
+			bridge["members"] = *body.Bridge.Members
+		}
+		if body.Bridge.Stp != nil {
+			bridge["stp"] = *body.Bridge.Stp
+		}
+		data["bridge"] = bridge
+	}
 	return data
 }
 
@@ -367,6 +367,208 @@ func (s *NetworkInterfaceCreatePostPublicTestSuite) TestPostNodeNetworkInterface
 				s.Equal(gen.InterfaceMutationEntryStatusOk, r.Results[0].Status)
 			},
 		},
+		{
+			name: "when vlan provided",
+			request: gen.PostNodeNetworkInterfaceRequestObject{
+				Hostname: "server1",
+				Name:     "vlan100",
+				Body: &gen.InterfaceConfigRequest{
+					Dhcp4: &trueVal,
+					Vlan: &gen.InterfaceVlan{
+						Id:   100,
+						Link: "eth0",
+					},
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"network",
+						job.OperationNetworkInterfaceCreate,
+						gomock.Any(),
+					).
+					DoAndReturn(func(
+						_ context.Context,
+						_ string,
+						_ string,
+						_ string,
+						data interface{},
+					) (string, *job.Response, error) {
+						d := data.(map[string]any)
+						s.Equal("vlan100", d["name"])
+						s.Equal(map[string]any{"id": 100, "link": "eth0"}, d["vlan"])
+						return "550e8400-e29b-41d4-a716-446655440000", &job.Response{
+							Hostname: "server1",
+							Changed:  &trueVal,
+						}, nil
+					})
+			},
+			validateFunc: func(resp gen.PostNodeNetworkInterfaceResponseObject) {
+				r, ok := resp.(gen.PostNodeNetworkInterface200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.InterfaceMutationEntryStatusOk, r.Results[0].Status)
+			},
+		},
+		{
+			name: "when bond provided",
+			request: gen.PostNodeNetworkInterfaceRequestObject{
+				Hostname: "server1",
+				Name:     "bond0",
+				Body: func() *gen.InterfaceConfigRequest {
+					miimon := 100
+					return &gen.InterfaceConfigRequest{
+						Dhcp4: &trueVal,
+						Bond: &gen.InterfaceBond{
+							Mode:    gen.InterfaceBondModeN8023ad,
+							Members: []string{"eth1", "eth2"},
+							Miimon:  &miimon,
+						},
+					}
+				}(),
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"network",
+						job.OperationNetworkInterfaceCreate,
+						gomock.Any(),
+					).
+					DoAndReturn(func(
+						_ context.Context,
+						_ string,
+						_ string,
+						_ string,
+						data interface{},
+					) (string, *job.Response, error) {
+						d := data.(map[string]any)
+						s.Equal(map[string]any{
+							"mode":    "802.3ad",
+							"members": []string{"eth1", "eth2"},
+							"miimon":  100,
+						}, d["bond"])
+						return "550e8400-e29b-41d4-a716-446655440000", &job.Response{
+							Hostname: "server1",
+							Changed:  &trueVal,
+						}, nil
+					})
+			},
+			validateFunc: func(resp gen.PostNodeNetworkInterfaceResponseObject) {
+				r, ok := resp.(gen.PostNodeNetworkInterface200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.InterfaceMutationEntryStatusOk, r.Results[0].Status)
+			},
+		},
+		{
+			name: "when bridge provided",
+			request: gen.PostNodeNetworkInterfaceRequestObject{
+				Hostname: "server1",
+				Name:     "br0",
+				Body: func() *gen.InterfaceConfigRequest {
+					stp := false
+					return &gen.InterfaceConfigRequest{
+						Dhcp4: &trueVal,
+						Bridge: &gen.InterfaceBridge{
+							Members: &[]string{"eth1"},
+							Stp:     &stp,
+						},
+					}
+				}(),
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"network",
+						job.OperationNetworkInterfaceCreate,
+						gomock.Any(),
+					).
+					DoAndReturn(func(
+						_ context.Context,
+						_ string,
+						_ string,
+						_ string,
+						data interface{},
+					) (string, *job.Response, error) {
+						d := data.(map[string]any)
+						s.Equal(map[string]any{
+							"members": []string{"eth1"},
+							"stp":     false,
+						}, d["bridge"])
+						return "550e8400-e29b-41d4-a716-446655440000", &job.Response{
+							Hostname: "server1",
+							Changed:  &trueVal,
+						}, nil
+					})
+			},
+			validateFunc: func(resp gen.PostNodeNetworkInterfaceResponseObject) {
+				r, ok := resp.(gen.PostNodeNetworkInterface200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.InterfaceMutationEntryStatusOk, r.Results[0].Status)
+			},
+		},
+		{
+			name: "when multiple link variants provided",
+			request: gen.PostNodeNetworkInterfaceRequestObject{
+				Hostname: "server1",
+				Name:     "bond0",
+				Body: &gen.InterfaceConfigRequest{
+					Vlan: &gen.InterfaceVlan{Id: 100, Link: "eth0"},
+					Bond: &gen.InterfaceBond{
+						Mode:    gen.InterfaceBondModeActiveBackup,
+						Members: []string{"eth1"},
+					},
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodeNetworkInterfaceResponseObject) {
+				r, ok := resp.(gen.PostNodeNetworkInterface400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "only one of vlan, bond or bridge")
+			},
+		},
+		{
+			name: "when vlan id out of range",
+			request: gen.PostNodeNetworkInterfaceRequestObject{
+				Hostname: "server1",
+				Name:     "vlan0",
+				Body: &gen.InterfaceConfigRequest{
+					Vlan: &gen.InterfaceVlan{Id: 5000, Link: "eth0"},
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodeNetworkInterfaceResponseObject) {
+				r, ok := resp.(gen.PostNodeNetworkInterface400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "Id")
+			},
+		},
+		{
+			name: "when bond has no members",
+			request: gen.PostNodeNetworkInterfaceRequestObject{
+				Hostname: "server1",
+				Name:     "bond0",
+				Body: &gen.InterfaceConfigRequest{
+					Bond: &gen.InterfaceBond{Mode: gen.InterfaceBondModeActiveBackup},
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodeNetworkInterfaceResponseObject) {
+				r, ok := resp.(gen.PostNodeNetworkInterface400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "Members")
+			},
+		},
 	}
 
 	for _, tt := range tests {
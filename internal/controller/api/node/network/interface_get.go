@@ -181,5 +181,42 @@ func convertInterfaceEntry(
 		mtu := e.MTU
 		info.Mtu = &mtu
 	}
+	setInterfaceLink(&info, e)
 	return info
 }
+
+// setInterfaceLink copies the interface type and its VLAN, bond or
+// bridge settings to the API InterfaceInfo.
+func setInterfaceLink(
+	info *gen.InterfaceInfo,
+	e iface.InterfaceEntry,
+) {
+	info.Type = strPtrOrNil(e.Type)
+	if e.VLAN != nil {
+		info.Vlan = &gen.InterfaceVlan{
+			Id:   e.VLAN.ID,
+			Link: e.VLAN.Link,
+		}
+	}
+	if e.Bond != nil {
+		bond := gen.InterfaceBond{
+			Mode:    gen.InterfaceBondMode(e.Bond.Mode),
+			Members: e.Bond.Members,
+		}
+		if e.Bond.MIIMonitor > 0 {
+			miimon := e.Bond.MIIMonitor
+			bond.Miimon = &miimon
+		}
+		info.Bond = &bond
+	}
+	if e.Bridge != nil {
+		bridge := gen.InterfaceBridge{
+			Stp: e.Bridge.STP,
+		}
+		if len(e.Bridge.Members) > 0 {
+			members := e.Bridge.Members
+			bridge.Members = &members
+		}
+		info.Bridge = &bridge
+	}
+}
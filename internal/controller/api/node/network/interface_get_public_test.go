@@ -95,6 +95,17 @@ func (s *NetworkInterfaceGetPublicTestSuite) TestGetNodeNetworkInterfaceByName()
 	}
 	fullEntryData, _ := json.Marshal(fullEntry)
 
+	bondEntry := iface.InterfaceEntry{
+		Name: "bond0",
+		Type: iface.TypeBond,
+		Bond: &iface.BondConfig{
+			Mode:       "802.3ad",
+			Members:    []string{"eth1", "eth2"},
+			MIIMonitor: 100,
+		},
+	}
+	bondEntryData, _ := json.Marshal(bondEntry)
+
 	tests := []struct {
 		name         string
 		request      gen.GetNodeNetworkInterfaceByNameRequestObject
@@ -134,6 +145,47 @@ func (s *NetworkInterfaceGetPublicTestSuite) TestGetNodeNetworkInterfaceByName()
 				s.Require().NotNil(r.Results[0].Interface)
 			},
 		},
+		{
+			name: "when success with bond interface",
+			request: gen.GetNodeNetworkInterfaceByNameRequestObject{
+				Hostname: "server1",
+				Name:     "bond0",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"network",
+						job.OperationNetworkInterfaceGet,
+						gomock.Any(),
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							Hostname: "server1",
+							Data:     bondEntryData,
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.GetNodeNetworkInterfaceByNameResponseObject) {
+				r, ok := resp.(gen.GetNodeNetworkInterfaceByName200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				ifc := r.Results[0].Interface
+				s.Require().NotNil(ifc)
+				s.Require().NotNil(ifc.Type)
+				s.Equal("bond", *ifc.Type)
+				s.Require().NotNil(ifc.Bond)
+				s.Equal(gen.InterfaceBondModeN8023ad, ifc.Bond.Mode)
+				s.Equal([]string{"eth1", "eth2"}, ifc.Bond.Members)
+				s.Require().NotNil(ifc.Bond.Miimon)
+				s.Equal(100, *ifc.Bond.Miimon)
+				s.Nil(ifc.Vlan)
+				s.Nil(ifc.Bridge)
+			},
+		},
 		{
 			name: "when success with all interface fields",
 			request: gen.GetNodeNetworkInterfaceByNameRequestObject{
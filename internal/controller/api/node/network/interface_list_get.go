@@ -180,6 +180,7 @@ func convertInterfaceEntries(
 			mtu := e.MTU
 			info.Mtu = &mtu
 		}
+		setInterfaceLink(&info, e)
 		result = append(result, info)
 	}
 	return result
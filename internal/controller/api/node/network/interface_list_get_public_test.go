@@ -356,6 +356,19 @@ func (s *NetworkInterfaceListGetPublicTestSuite) TestGetNodeNetworkInterface() {
 					{
 						Name: "lo",
 					},
+					{
+						Name: "vlan100",
+						Type: iface.TypeVLAN,
+						VLAN: &iface.VLANConfig{ID: 100, Link: "eth0"},
+					},
+					{
+						Name: "br0",
+						Type: iface.TypeBridge,
+						Bridge: &iface.BridgeConfig{
+							Members: []string{"eth1"},
+							STP:     &trueVal,
+						},
+					},
 				}
 				data, _ := json.Marshal(fullEntries)
 				s.mockJobClient.EXPECT().
@@ -381,7 +394,7 @@ func (s *NetworkInterfaceListGetPublicTestSuite) TestGetNodeNetworkInterface() {
 				s.Require().Len(r.Results, 1)
 				s.Require().NotNil(r.Results[0].Interfaces)
 				ifaces := *r.Results[0].Interfaces
-				s.Len(ifaces, 2)
+				s.Len(ifaces, 4)
 
 				// First entry has all optional fields populated.
 				s.Equal("eth0", *ifaces[0].Name)
@@ -409,6 +422,19 @@ func (s *NetworkInterfaceListGetPublicTestSuite) TestGetNodeNetworkInterface() {
 				s.Nil(ifaces[1].Gateway6)
 				s.Nil(ifaces[1].Mtu)
 				s.Nil(ifaces[1].MacAddress)
+				s.Nil(ifaces[1].Type)
+
+				// Virtual entries carry their type and link settings.
+				s.Require().NotNil(ifaces[2].Type)
+				s.Equal("vlan", *ifaces[2].Type)
+				s.Require().NotNil(ifaces[2].Vlan)
+				s.Equal(100, ifaces[2].Vlan.Id)
+				s.Equal("eth0", ifaces[2].Vlan.Link)
+				s.Require().NotNil(ifaces[3].Bridge)
+				s.Require().NotNil(ifaces[3].Bridge.Members)
+				s.Equal([]string{"eth1"}, *ifaces[3].Bridge.Members)
+				s.Require().NotNil(ifaces[3].Bridge.Stp)
+				s.True(*ifaces[3].Bridge.Stp)
 			},
 		},
 	}
@@ -48,6 +48,10 @@ func (s *Network) PutNodeNetworkInterface(
 		return gen.PutNodeNetworkInterface400JSONResponse{Error: &errMsg}, nil
 	}
 
+	if errMsg, ok := validateInterfaceVariant(request.Body); !ok {
+		return gen.PutNodeNetworkInterface400JSONResponse{Error: &errMsg}, nil
+	}
+
 	if errMsg, ok := validation.AtLeastOneField(request.Body); !ok {
 		return gen.PutNodeNetworkInterface400JSONResponse{Error: &errMsg}, nil
 	}
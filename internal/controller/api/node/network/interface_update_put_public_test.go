@@ -169,6 +169,27 @@ func (s *NetworkInterfaceUpdatePutPublicTestSuite) TestPutNodeNetworkInterface()
 				s.Contains(*r.Error, "Addresses")
 			},
 		},
+		{
+			name: "when multiple link variants provided",
+			request: gen.PutNodeNetworkInterfaceRequestObject{
+				Hostname: "server1",
+				Name:     "br0",
+				Body: &gen.InterfaceConfigRequest{
+					Bond: &gen.InterfaceBond{
+						Mode:    gen.InterfaceBondModeActiveBackup,
+						Members: []string{"eth1"},
+					},
+					Bridge: &gen.InterfaceBridge{},
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PutNodeNetworkInterfaceResponseObject) {
+				r, ok := resp.(gen.PutNodeNetworkInterface400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "only one of vlan, bond or bridge")
+			},
+		},
 		{
 			name: "when at least one field error empty body",
 			request: gen.PutNodeNetworkInterfaceRequestObject{
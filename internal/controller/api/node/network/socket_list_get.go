@@ -0,0 +1,205 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/network/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/network/socket"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// GetNodeNetworkSocket lists TCP and UDP sockets on a target node.
+func (s *Network) GetNodeNetworkSocket(
+	ctx context.Context,
+	request gen.GetNodeNetworkSocketRequestObject,
+) (gen.GetNodeNetworkSocketResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeNetworkSocket400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Params); !ok {
+		return gen.GetNodeNetworkSocket400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"socket list",
+		slog.String("target", hostname),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	var opts socket.ListOpts
+	if request.Params.Protocol != nil {
+		opts.Protocol = string(*request.Params.Protocol)
+	}
+	if request.Params.State != nil {
+		opts.State = string(*request.Params.State)
+	}
+	if request.Params.Port != nil {
+		opts.Port = *request.Params.Port
+	}
+	if request.Params.Pid != nil {
+		opts.PID = *request.Params.Pid
+	}
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeNetworkSocketBroadcast(ctx, hostname, opts)
+	}
+
+	jobID, resp, err := s.JobClient.Query(ctx, hostname, "network", job.OperationNetworkSocketList, opts)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeNetworkSocket500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeNetworkSocket200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.SocketEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.SocketEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	sockets := socketInfoListFromResponse(resp)
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeNetworkSocket200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.SocketEntry{
+			{
+				Hostname: resp.Hostname,
+				Status:   gen.SocketEntryStatusOk,
+				Sockets:  &sockets,
+			},
+		},
+	}, nil
+}
+
+// getNodeNetworkSocketBroadcast handles broadcast targets for socket list.
+func (s *Network) getNodeNetworkSocketBroadcast(
+	ctx context.Context,
+	target string,
+	opts socket.ListOpts,
+) (gen.GetNodeNetworkSocketResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"network",
+		job.OperationNetworkSocketList,
+		opts,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeNetworkSocket500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.SocketEntry, 0, len(responses))
+	for host, resp := range responses {
+		entry := gen.SocketEntry{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			e := resp.Error
+			entry.Status = gen.SocketEntryStatusFailed
+			entry.Error = &e
+		case job.StatusSkipped:
+			e := resp.Error
+			entry.Status = gen.SocketEntryStatusSkipped
+			entry.Error = &e
+		default:
+			sockets := socketInfoListFromResponse(resp)
+			entry.Status = gen.SocketEntryStatusOk
+			entry.Sockets = &sockets
+		}
+		allResults = append(allResults, entry)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeNetworkSocket200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
+
+// socketInfoListFromResponse converts a job response to a gen SocketInfo slice.
+func socketInfoListFromResponse(
+	resp *job.Response,
+) []gen.SocketInfo {
+	var sockets []socket.Socket
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &sockets)
+	}
+
+	results := make([]gen.SocketInfo, 0, len(sockets))
+	for _, sock := range sockets {
+		protocol := gen.SocketInfoProtocol(sock.Protocol)
+		family := sock.Family
+		state := gen.SocketInfoState(sock.State)
+		localAddress := sock.LocalAddress
+		localPort := sock.LocalPort
+
+		info := gen.SocketInfo{
+			Protocol:     &protocol,
+			Family:       &family,
+			State:        &state,
+			LocalAddress: &localAddress,
+			LocalPort:    &localPort,
+		}
+
+		if sock.RemoteAddress != "" {
+			remoteAddress := sock.RemoteAddress
+			remotePort := sock.RemotePort
+			info.RemoteAddress = &remoteAddress
+			info.RemotePort = &remotePort
+		}
+
+		if sock.PID != 0 {
+			pid := sock.PID
+			info.Pid = &pid
+		}
+
+		if sock.Process != "" {
+			process := sock.Process
+			info.Process = &process
+		}
+
+		results = append(results, info)
+	}
+
+	return results
+}
@@ -0,0 +1,465 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	apinetwork "github.com/osapi-io/osapi/internal/controller/api/node/network"
+	"github.com/osapi-io/osapi/internal/controller/api/node/network/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider/network/socket"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type NetworkSocketListGetPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *apinetwork.Network
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *NetworkSocketListGetPublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *NetworkSocketListGetPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = apinetwork.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *NetworkSocketListGetPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+const socketListData = `[
+	{"protocol":"tcp","family":"inet","state":"listen","local_address":"0.0.0.0","local_port":22,"pid":812,"process":"sshd"},
+	{"protocol":"tcp","family":"inet","state":"established","local_address":"10.0.2.15","local_port":22,"remote_address":"10.0.2.2","remote_port":54000},
+	{"protocol":"udp","family":"inet6","state":"listen","local_address":"::","local_port":5353}
+]`
+
+func (s *NetworkSocketListGetPublicTestSuite) TestGetNodeNetworkSocket() {
+	protocol := gen.GetNodeNetworkSocketParamsProtocolTcp
+	state := gen.GetNodeNetworkSocketParamsStateListen
+	badState := gen.GetNodeNetworkSocketParamsState("closed")
+	port := 22
+	badPort := 70000
+	pid := 812
+
+	tests := []struct {
+		name         string
+		request      gen.GetNodeNetworkSocketRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.GetNodeNetworkSocketResponseObject)
+	}{
+		{
+			name: "when success",
+			request: gen.GetNodeNetworkSocketRequestObject{
+				Hostname: "server1",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(gomock.Any(), "server1", "network", job.OperationNetworkSocketList, socket.ListOpts{}).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "server1",
+						Data:     []byte(socketListData),
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeNetworkSocketResponseObject) {
+				r, ok := resp.(gen.GetNodeNetworkSocket200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.SocketEntryStatusOk, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Sockets)
+
+				sockets := *r.Results[0].Sockets
+				s.Require().Len(sockets, 3)
+
+				s.Equal(gen.SocketInfoProtocolTcp, *sockets[0].Protocol)
+				s.Equal("inet", *sockets[0].Family)
+				s.Equal(gen.SocketInfoStateListen, *sockets[0].State)
+				s.Equal("0.0.0.0", *sockets[0].LocalAddress)
+				s.Equal(22, *sockets[0].LocalPort)
+				s.Nil(sockets[0].RemoteAddress)
+				s.Nil(sockets[0].RemotePort)
+				s.Equal(812, *sockets[0].Pid)
+				s.Equal("sshd", *sockets[0].Process)
+
+				s.Equal(gen.SocketInfoStateEstablished, *sockets[1].State)
+				s.Equal("10.0.2.2", *sockets[1].RemoteAddress)
+				s.Equal(54000, *sockets[1].RemotePort)
+				s.Nil(sockets[1].Pid)
+				s.Nil(sockets[1].Process)
+
+				s.Equal(gen.SocketInfoProtocolUdp, *sockets[2].Protocol)
+				s.Equal("inet6", *sockets[2].Family)
+			},
+		},
+		{
+			name: "when filters are set",
+			request: gen.GetNodeNetworkSocketRequestObject{
+				Hostname: "server1",
+				Params: gen.GetNodeNetworkSocketParams{
+					Protocol: &protocol,
+					State:    &state,
+					Port:     &port,
+					Pid:      &pid,
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(gomock.Any(), "server1", "network", job.OperationNetworkSocketList, socket.ListOpts{
+						Protocol: "tcp",
+						State:    "listen",
+						Port:     22,
+						PID:      812,
+					}).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "server1",
+						Data:     []byte(`[]`),
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeNetworkSocketResponseObject) {
+				r, ok := resp.(gen.GetNodeNetworkSocket200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Require().NotNil(r.Results[0].Sockets)
+				s.Empty(*r.Results[0].Sockets)
+			},
+		},
+		{
+			name: "when validation error empty hostname",
+			request: gen.GetNodeNetworkSocketRequestObject{
+				Hostname: "",
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.GetNodeNetworkSocketResponseObject) {
+				_, ok := resp.(gen.GetNodeNetworkSocket400JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "when validation error invalid state",
+			request: gen.GetNodeNetworkSocketRequestObject{
+				Hostname: "server1",
+				Params: gen.GetNodeNetworkSocketParams{
+					State: &badState,
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.GetNodeNetworkSocketResponseObject) {
+				r, ok := resp.(gen.GetNodeNetworkSocket400JSONResponse)
+				s.True(ok)
+				s.Contains(*r.Error, "State")
+			},
+		},
+		{
+			name: "when validation error port out of range",
+			request: gen.GetNodeNetworkSocketRequestObject{
+				Hostname: "server1",
+				Params: gen.GetNodeNetworkSocketParams{
+					Port: &badPort,
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.GetNodeNetworkSocketResponseObject) {
+				r, ok := resp.(gen.GetNodeNetworkSocket400JSONResponse)
+				s.True(ok)
+				s.Contains(*r.Error, "Port")
+			},
+		},
+		{
+			name: "when job client error",
+			request: gen.GetNodeNetworkSocketRequestObject{
+				Hostname: "server1",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(gomock.Any(), "server1", "network", job.OperationNetworkSocketList, gomock.Any()).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.GetNodeNetworkSocketResponseObject) {
+				_, ok := resp.(gen.GetNodeNetworkSocket500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.GetNodeNetworkSocketRequestObject{
+				Hostname: "server1",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(gomock.Any(), "server1", "network", job.OperationNetworkSocketList, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Status: job.StatusSkipped, Hostname: "server1", Error: "unsupported",
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeNetworkSocketResponseObject) {
+				r, ok := resp.(gen.GetNodeNetworkSocket200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.SocketEntryStatusSkipped, r.Results[0].Status)
+				s.Equal("unsupported", *r.Results[0].Error)
+				s.Nil(r.Results[0].Sockets)
+			},
+		},
+		{
+			name: "when broadcast success",
+			request: gen.GetNodeNetworkSocketRequestObject{
+				Hostname: "_all",
+				Params: gen.GetNodeNetworkSocketParams{
+					Port: &port,
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(gomock.Any(), "_all", "network", job.OperationNetworkSocketList, socket.ListOpts{Port: 22}).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Hostname: "server1",
+							Data:     []byte(socketListData),
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeNetworkSocketResponseObject) {
+				r, ok := resp.(gen.GetNodeNetworkSocket200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal("server1", r.Results[0].Hostname)
+				s.Equal(gen.SocketEntryStatusOk, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Sockets)
+				s.Len(*r.Results[0].Sockets, 3)
+			},
+		},
+		{
+			name: "when broadcast with failed and skipped hosts",
+			request: gen.GetNodeNetworkSocketRequestObject{
+				Hostname: "_all",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(gomock.Any(), "_all", "network", job.OperationNetworkSocketList, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusFailed,
+							Error:    "permission denied",
+							Hostname: "server1",
+						},
+						"server2": {
+							Status:   job.StatusSkipped,
+							Error:    "unsupported",
+							Hostname: "server2",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeNetworkSocketResponseObject) {
+				r, ok := resp.(gen.GetNodeNetworkSocket200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 2)
+				statuses := map[gen.SocketEntryStatus]bool{}
+				for _, item := range r.Results {
+					statuses[item.Status] = true
+					s.Require().NotNil(item.Error)
+					s.Nil(item.Sockets)
+				}
+				s.True(statuses[gen.SocketEntryStatusFailed])
+				s.True(statuses[gen.SocketEntryStatusSkipped])
+			},
+		},
+		{
+			name: "when broadcast error",
+			request: gen.GetNodeNetworkSocketRequestObject{
+				Hostname: "_all",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(gomock.Any(), "_all", "network", job.OperationNetworkSocketList, gomock.Any()).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.GetNodeNetworkSocketResponseObject) {
+				_, ok := resp.(gen.GetNodeNetworkSocket500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+			resp, err := s.handler.GetNodeNetworkSocket(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+const rbacSocketListTestSigningKey = "test-signing-key-for-socket-list-rbac"
+
+func (s *NetworkSocketListGetPublicTestSuite) TestGetNetworkSocketListRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+
+	tests := []struct {
+		name         string
+		query        string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name:      "when no token returns 401",
+			setupAuth: func(_ *http.Request) {},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusUnauthorized,
+			wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, _ := tokenManager.Generate(
+					rbacSocketListTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"node:read"},
+				)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name:  "when invalid state returns 400",
+			query: "?state=closed",
+			setupAuth: func(req *http.Request) {
+				token, _ := tokenManager.Generate(
+					rbacSocketListTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"network:read"},
+				)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{"State"},
+		},
+		{
+			name:  "when valid token returns 200",
+			query: "?protocol=tcp&state=listen&port=22",
+			setupAuth: func(req *http.Request) {
+				token, _ := tokenManager.Generate(
+					rbacSocketListTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"network:read"},
+				)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Query(gomock.Any(), "server1", "network", job.OperationNetworkSocketList, socket.ListOpts{
+						Protocol: "tcp",
+						State:    "listen",
+						Port:     22,
+					}).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{Hostname: "server1", Data: []byte(socketListData)}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"results"`, `"process":"sshd"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{SigningKey: rbacSocketListTestSigningKey},
+					},
+				},
+			}
+			server := api.New(appConfig, s.logger)
+			handlers := apinetwork.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+
+			req := httptest.NewRequest(
+				http.MethodGet,
+				"/api/node/server1/network/socket"+tc.query,
+				nil,
+			)
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+			server.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestNetworkSocketListGetPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(NetworkSocketListGetPublicTestSuite))
+}
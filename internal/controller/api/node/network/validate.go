@@ -20,7 +20,10 @@
 
 package network
 
-import "github.com/osapi-io/osapi/internal/validation"
+import (
+	"github.com/osapi-io/osapi/internal/controller/api/node/network/gen"
+	"github.com/osapi-io/osapi/internal/validation"
+)
 
 // validateHostname validates a hostname path parameter using the shared
 // validator. Returns the error message and false if invalid.
@@ -40,3 +43,23 @@ func validateInterfaceName(
 ) (string, bool) {
 	return validation.Var(name, "required,alphanum_or_fact")
 }
+
+// validateInterfaceVariant checks that an interface request sets at most
+// one of vlan, bond and bridge. The generated validate tags cannot
+// express this across sibling fields.
+func validateInterfaceVariant(
+	body *gen.InterfaceConfigRequest,
+) (string, bool) {
+	variants := 0
+	for _, set := range []bool{body.Vlan != nil, body.Bond != nil, body.Bridge != nil} {
+		if set {
+			variants++
+		}
+	}
+
+	if variants > 1 {
+		return "only one of vlan, bond or bridge may be set", false
+	}
+
+	return "", true
+}
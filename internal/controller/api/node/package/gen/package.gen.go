@@ -20,6 +20,21 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for PackageEnsureEntryState.
+const (
+	PackageEnsureEntryStateAbsent  PackageEnsureEntryState = "absent"
+	PackageEnsureEntryStateLatest  PackageEnsureEntryState = "latest"
+	PackageEnsureEntryStatePinned  PackageEnsureEntryState = "pinned"
+	PackageEnsureEntryStatePresent PackageEnsureEntryState = "present"
+)
+
+// Defines values for PackageEnsureResultStatus.
+const (
+	PackageEnsureResultStatusFailed  PackageEnsureResultStatus = "failed"
+	PackageEnsureResultStatusOk      PackageEnsureResultStatus = "ok"
+	PackageEnsureResultStatusSkipped PackageEnsureResultStatus = "skipped"
+)
+
 // Defines values for PackageEntryStatus.
 const (
 	PackageEntryStatusFailed  PackageEntryStatus = "failed"
@@ -34,11 +49,25 @@ const (
 	PackageMutationResultStatusSkipped PackageMutationResultStatus = "skipped"
 )
 
+// Defines values for PackageRepoEntryStatus.
+const (
+	PackageRepoEntryStatusFailed  PackageRepoEntryStatus = "failed"
+	PackageRepoEntryStatusOk      PackageRepoEntryStatus = "ok"
+	PackageRepoEntryStatusSkipped PackageRepoEntryStatus = "skipped"
+)
+
+// Defines values for PackageRepoMutationResultStatus.
+const (
+	PackageRepoMutationResultStatusFailed  PackageRepoMutationResultStatus = "failed"
+	PackageRepoMutationResultStatusOk      PackageRepoMutationResultStatus = "ok"
+	PackageRepoMutationResultStatusSkipped PackageRepoMutationResultStatus = "skipped"
+)
+
 // Defines values for UpdateEntryStatus.
 const (
-	Failed  UpdateEntryStatus = "failed"
-	Ok      UpdateEntryStatus = "ok"
-	Skipped UpdateEntryStatus = "skipped"
+	UpdateEntryStatusFailed  UpdateEntryStatus = "failed"
+	UpdateEntryStatusOk      UpdateEntryStatus = "ok"
+	UpdateEntryStatusSkipped UpdateEntryStatus = "skipped"
 )
 
 // ErrorResponse defines model for ErrorResponse.
@@ -51,6 +80,67 @@ type PackageCollectionResponse struct {
 	Results []PackageEntry      `json:"results"`
 }
 
+// PackageEnsureEntry defines model for PackageEnsureEntry.
+type PackageEnsureEntry struct {
+	// Name Package name.
+	Name string `json:"name" validate:"required,min=1"`
+
+	// State Desired state. "pinned" installs the given version and holds it.
+	State PackageEnsureEntryState `json:"state" validate:"required,oneof=present absent latest pinned"`
+
+	// Version Package version. Required when state is "pinned"; optional for "present".
+	Version *string `json:"version,omitempty" validate:"required_if=State pinned"`
+}
+
+// PackageEnsureEntryState Desired state. "pinned" installs the given version and holds it.
+type PackageEnsureEntryState string
+
+// PackageEnsureItem Ensure result for a single package.
+type PackageEnsureItem struct {
+	// Changed Whether the package was modified.
+	Changed bool `json:"changed"`
+
+	// Error Error message if this package failed to converge.
+	Error *string `json:"error,omitempty"`
+
+	// Name Package name.
+	Name string `json:"name"`
+}
+
+// PackageEnsureRequest defines model for PackageEnsureRequest.
+type PackageEnsureRequest struct {
+	// Packages Desired package states.
+	Packages []PackageEnsureEntry `json:"packages" validate:"required,min=1,dive"`
+}
+
+// PackageEnsureResponse defines model for PackageEnsureResponse.
+type PackageEnsureResponse struct {
+	// JobId The job ID used to process this request.
+	JobId   *openapi_types.UUID   `json:"job_id,omitempty"`
+	Results []PackageEnsureResult `json:"results"`
+}
+
+// PackageEnsureResult Result of a package ensure operation for one host.
+type PackageEnsureResult struct {
+	// Changed Whether any package was modified.
+	Changed *bool `json:"changed,omitempty"`
+
+	// Error Error message if the agent failed.
+	Error *string `json:"error,omitempty"`
+
+	// Hostname Hostname of the agent that processed this operation.
+	Hostname string `json:"hostname"`
+
+	// Packages Per-package results in manifest order.
+	Packages *[]PackageEnsureItem `json:"packages,omitempty"`
+
+	// Status The status of the operation for this host.
+	Status PackageEnsureResultStatus `json:"status"`
+}
+
+// PackageEnsureResultStatus The status of the operation for this host.
+type PackageEnsureResultStatus string
+
 // PackageEntry A package list result for one host.
 type PackageEntry struct {
 	// Error Error message if the agent failed.
@@ -87,10 +177,16 @@ type PackageInfo struct {
 	Version *string `json:"version,omitempty"`
 }
 
-// PackageInstallRequest defines model for PackageInstallRequest.
+// PackageInstallRequest Install a package by name from the configured repositories, or from a package artifact in the Object Store. Exactly one of name or object is required.
 type PackageInstallRequest struct {
 	// Name Name of the package to install.
-	Name string `json:"name" validate:"required,min=1"`
+	Name *string `json:"name,omitempty" validate:"required_without=Object,excluded_with=Object,omitempty,min=1"`
+
+	// Object Object Store reference for a package artifact (.deb, .rpm, or .apk). The agent stages and verifies the artifact, then installs it with the native package manager.
+	Object *string `json:"object,omitempty" validate:"required_without=Name,excluded_with=Name,omitempty,min=1"`
+
+	// Version Exact version to install. Defaults to the candidate version. Not valid with object.
+	Version *string `json:"version,omitempty" validate:"excluded_with=Object"`
 }
 
 // PackageMutationResponse defines model for PackageMutationResponse.
@@ -116,11 +212,106 @@ type PackageMutationResult struct {
 
 	// Status The status of the operation for this host.
 	Status PackageMutationResultStatus `json:"status"`
+
+	// Version Installed version. Set when installing from an Object Store artifact.
+	Version *string `json:"version,omitempty"`
 }
 
 // PackageMutationResultStatus The status of the operation for this host.
 type PackageMutationResultStatus string
 
+// PackageRepoCollectionResponse defines model for PackageRepoCollectionResponse.
+type PackageRepoCollectionResponse struct {
+	// JobId The job ID used to process this request.
+	JobId   *openapi_types.UUID `json:"job_id,omitempty"`
+	Results []PackageRepoEntry  `json:"results"`
+}
+
+// PackageRepoCreateRequest defines model for PackageRepoCreateRequest.
+type PackageRepoCreateRequest struct {
+	// KeyObject Object Store reference for the ASCII-armored signing key.
+	KeyObject *string `json:"key_object,omitempty"`
+
+	// Name Repository name (used as the sources and keyring filename).
+	Name string `json:"name" validate:"required,min=1,max=64"`
+
+	// Object Object Store reference for the deb822 sources file. Templated objects can use {{ .Vars.keyring }} for Signed-By.
+	Object string `json:"object" validate:"required,min=1"`
+}
+
+// PackageRepoEntry A repository list result for one host.
+type PackageRepoEntry struct {
+	// Error Error message if the agent failed.
+	Error *string `json:"error,omitempty"`
+
+	// Hostname Hostname of the agent that reported this entry.
+	Hostname string `json:"hostname"`
+
+	// Repos List of managed repositories.
+	Repos *[]PackageRepoInfo `json:"repos,omitempty"`
+
+	// Status The status of the operation for this host.
+	Status PackageRepoEntryStatus `json:"status"`
+}
+
+// PackageRepoEntryStatus The status of the operation for this host.
+type PackageRepoEntryStatus string
+
+// PackageRepoInfo An osapi-managed package repository.
+type PackageRepoInfo struct {
+	// KeyObject Object Store name of the deployed signing key.
+	KeyObject *string `json:"key_object,omitempty"`
+
+	// Keyring Path of the signing key on disk.
+	Keyring *string `json:"keyring,omitempty"`
+
+	// Name Repository name.
+	Name *string `json:"name,omitempty"`
+
+	// Object Object Store name of the deployed sources file.
+	Object *string `json:"object,omitempty"`
+
+	// Path Path of the sources file on disk.
+	Path *string `json:"path,omitempty"`
+}
+
+// PackageRepoMutationResponse defines model for PackageRepoMutationResponse.
+type PackageRepoMutationResponse struct {
+	// JobId The job ID used to process this request.
+	JobId   *openapi_types.UUID         `json:"job_id,omitempty"`
+	Results []PackageRepoMutationResult `json:"results"`
+}
+
+// PackageRepoMutationResult Result of a repository create, update, or delete for one host.
+type PackageRepoMutationResult struct {
+	// Changed Whether the operation modified system state.
+	Changed *bool `json:"changed,omitempty"`
+
+	// Error Error message if the agent failed.
+	Error *string `json:"error,omitempty"`
+
+	// Hostname Hostname of the agent that processed this operation.
+	Hostname string `json:"hostname"`
+
+	// Name Repository name.
+	Name *string `json:"name,omitempty"`
+
+	// Status The status of the operation for this host.
+	Status PackageRepoMutationResultStatus `json:"status"`
+}
+
+// PackageRepoMutationResultStatus The status of the operation for this host.
+type PackageRepoMutationResultStatus string
+
+// PackageRepoUpdateRequest defines model for PackageRepoUpdateRequest.
+type PackageRepoUpdateRequest struct {
+	// KeyObject Object Store reference for the new signing key.
+	KeyObject *string `json:"key_object,omitempty" validate:"required_without=Object"`
+
+	// Object Object Store reference for the new sources file.
+	Object *string `json:"object,omitempty" validate:"required_without=KeyObject"`
+}
+
 // UpdateCollectionResponse defines model for UpdateCollectionResponse.
 type UpdateCollectionResponse struct {
 	// JobId The job ID used to process this request.
@@ -164,9 +355,21 @@ type Hostname = string
 // PackageName defines model for PackageName.
 type PackageName = string
 
+// RepoName defines model for RepoName.
+type RepoName = string
+
 // PostNodePackageJSONRequestBody defines body for PostNodePackage for application/json ContentType.
 type PostNodePackageJSONRequestBody = PackageInstallRequest
 
+// PostNodePackageEnsureJSONRequestBody defines body for PostNodePackageEnsure for application/json ContentType.
+type PostNodePackageEnsureJSONRequestBody = PackageEnsureRequest
+
+// PostNodePackageRepoJSONRequestBody defines body for PostNodePackageRepo for application/json ContentType.
+type PostNodePackageRepoJSONRequestBody = PackageRepoCreateRequest
+
+// PutNodePackageRepoJSONRequestBody defines body for PutNodePackageRepo for application/json ContentType.
+type PutNodePackageRepoJSONRequestBody = PackageRepoUpdateRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// List installed packages
@@ -175,6 +378,24 @@ type ServerInterface interface {
 	// Install a package
 	// (POST /api/node/{hostname}/package)
 	PostNodePackage(ctx echo.Context, hostname Hostname) error
+	// Ensure package states
+	// (POST /api/node/{hostname}/package/ensure)
+	PostNodePackageEnsure(ctx echo.Context, hostname Hostname) error
+	// List package repositories
+	// (GET /api/node/{hostname}/package/repo)
+	GetNodePackageRepo(ctx echo.Context, hostname Hostname) error
+	// Create a package repository
+	// (POST /api/node/{hostname}/package/repo)
+	PostNodePackageRepo(ctx echo.Context, hostname Hostname) error
+	// Delete a package repository
+	// (DELETE /api/node/{hostname}/package/repo/{name})
+	DeleteNodePackageRepo(ctx echo.Context, hostname Hostname, name RepoName) error
+	// Get a package repository
+	// (GET /api/node/{hostname}/package/repo/{name})
+	GetNodePackageRepoByName(ctx echo.Context, hostname Hostname, name RepoName) error
+	// Update a package repository
+	// (PUT /api/node/{hostname}/package/repo/{name})
+	PutNodePackageRepo(ctx echo.Context, hostname Hostname, name RepoName) error
 	// List available updates
 	// (GET /api/node/{hostname}/package/update)
 	GetNodePackageUpdate(ctx echo.Context, hostname Hostname) error
@@ -187,6 +408,12 @@ type ServerInterface interface {
 	// Get a package
 	// (GET /api/node/{hostname}/package/{name})
 	GetNodePackageByName(ctx echo.Context, hostname Hostname, name PackageName) error
+	// Unhold a package
+	// (DELETE /api/node/{hostname}/package/{name}/hold)
+	DeleteNodePackageHold(ctx echo.Context, hostname Hostname, name PackageName) error
+	// Hold a package
+	// (POST /api/node/{hostname}/package/{name}/hold)
+	PostNodePackageHold(ctx echo.Context, hostname Hostname, name PackageName) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -230,6 +457,138 @@ func (w *ServerInterfaceWrapper) PostNodePackage(ctx echo.Context) error {
 	return err
 }
 
+// PostNodePackageEnsure converts echo context to params.
+func (w *ServerInterfaceWrapper) PostNodePackageEnsure(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"package:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostNodePackageEnsure(ctx, hostname)
+	return err
+}
+
+// GetNodePackageRepo converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodePackageRepo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"package:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodePackageRepo(ctx, hostname)
+	return err
+}
+
+// PostNodePackageRepo converts echo context to params.
+func (w *ServerInterfaceWrapper) PostNodePackageRepo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"package:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostNodePackageRepo(ctx, hostname)
+	return err
+}
+
+// DeleteNodePackageRepo converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteNodePackageRepo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name RepoName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"package:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteNodePackageRepo(ctx, hostname, name)
+	return err
+}
+
+// GetNodePackageRepoByName converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodePackageRepoByName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name RepoName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"package:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodePackageRepoByName(ctx, hostname, name)
+	return err
+}
+
+// PutNodePackageRepo converts echo context to params.
+func (w *ServerInterfaceWrapper) PutNodePackageRepo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name RepoName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"package:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutNodePackageRepo(ctx, hostname, name)
+	return err
+}
+
 // GetNodePackageUpdate converts echo context to params.
 func (w *ServerInterfaceWrapper) GetNodePackageUpdate(ctx echo.Context) error {
 	var err error
@@ -318,6 +677,58 @@ func (w *ServerInterfaceWrapper) GetNodePackageByName(ctx echo.Context) error {
 	return err
 }
 
+// DeleteNodePackageHold converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteNodePackageHold(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name PackageName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"package:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteNodePackageHold(ctx, hostname, name)
+	return err
+}
+
+// PostNodePackageHold converts echo context to params.
+func (w *ServerInterfaceWrapper) PostNodePackageHold(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name PackageName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"package:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostNodePackageHold(ctx, hostname, name)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -348,10 +759,18 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.GET(baseURL+"/api/node/:hostname/package", wrapper.GetNodePackage)
 	router.POST(baseURL+"/api/node/:hostname/package", wrapper.PostNodePackage)
+	router.POST(baseURL+"/api/node/:hostname/package/ensure", wrapper.PostNodePackageEnsure)
+	router.GET(baseURL+"/api/node/:hostname/package/repo", wrapper.GetNodePackageRepo)
+	router.POST(baseURL+"/api/node/:hostname/package/repo", wrapper.PostNodePackageRepo)
+	router.DELETE(baseURL+"/api/node/:hostname/package/repo/:name", wrapper.DeleteNodePackageRepo)
+	router.GET(baseURL+"/api/node/:hostname/package/repo/:name", wrapper.GetNodePackageRepoByName)
+	router.PUT(baseURL+"/api/node/:hostname/package/repo/:name", wrapper.PutNodePackageRepo)
 	router.GET(baseURL+"/api/node/:hostname/package/update", wrapper.GetNodePackageUpdate)
 	router.POST(baseURL+"/api/node/:hostname/package/update", wrapper.PostNodePackageUpdate)
 	router.DELETE(baseURL+"/api/node/:hostname/package/:name", wrapper.DeleteNodePackage)
 	router.GET(baseURL+"/api/node/:hostname/package/:name", wrapper.GetNodePackageByName)
+	router.DELETE(baseURL+"/api/node/:hostname/package/:name/hold", wrapper.DeleteNodePackageHold)
+	router.POST(baseURL+"/api/node/:hostname/package/:name/hold", wrapper.PostNodePackageHold)
 
 }
 
@@ -462,6 +881,348 @@ func (response PostNodePackage500JSONResponse) VisitPostNodePackageResponse(w ht
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PostNodePackageEnsureRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+	Body     *PostNodePackageEnsureJSONRequestBody
+}
+
+type PostNodePackageEnsureResponseObject interface {
+	VisitPostNodePackageEnsureResponse(w http.ResponseWriter) error
+}
+
+type PostNodePackageEnsure200JSONResponse PackageEnsureResponse
+
+func (response PostNodePackageEnsure200JSONResponse) VisitPostNodePackageEnsureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodePackageEnsure400JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodePackageEnsure400JSONResponse) VisitPostNodePackageEnsureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodePackageEnsure401JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodePackageEnsure401JSONResponse) VisitPostNodePackageEnsureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodePackageEnsure403JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodePackageEnsure403JSONResponse) VisitPostNodePackageEnsureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodePackageEnsure500JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodePackageEnsure500JSONResponse) VisitPostNodePackageEnsureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodePackageRepoRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+}
+
+type GetNodePackageRepoResponseObject interface {
+	VisitGetNodePackageRepoResponse(w http.ResponseWriter) error
+}
+
+type GetNodePackageRepo200JSONResponse PackageRepoCollectionResponse
+
+func (response GetNodePackageRepo200JSONResponse) VisitGetNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodePackageRepo400JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodePackageRepo400JSONResponse) VisitGetNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodePackageRepo401JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodePackageRepo401JSONResponse) VisitGetNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodePackageRepo403JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodePackageRepo403JSONResponse) VisitGetNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodePackageRepo500JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodePackageRepo500JSONResponse) VisitGetNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodePackageRepoRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+	Body     *PostNodePackageRepoJSONRequestBody
+}
+
+type PostNodePackageRepoResponseObject interface {
+	VisitPostNodePackageRepoResponse(w http.ResponseWriter) error
+}
+
+type PostNodePackageRepo200JSONResponse PackageRepoMutationResponse
+
+func (response PostNodePackageRepo200JSONResponse) VisitPostNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodePackageRepo400JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodePackageRepo400JSONResponse) VisitPostNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodePackageRepo401JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodePackageRepo401JSONResponse) VisitPostNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodePackageRepo403JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodePackageRepo403JSONResponse) VisitPostNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodePackageRepo500JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodePackageRepo500JSONResponse) VisitPostNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodePackageRepoRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+	Name     RepoName `json:"name"`
+}
+
+type DeleteNodePackageRepoResponseObject interface {
+	VisitDeleteNodePackageRepoResponse(w http.ResponseWriter) error
+}
+
+type DeleteNodePackageRepo200JSONResponse PackageRepoMutationResponse
+
+func (response DeleteNodePackageRepo200JSONResponse) VisitDeleteNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodePackageRepo400JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodePackageRepo400JSONResponse) VisitDeleteNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodePackageRepo401JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodePackageRepo401JSONResponse) VisitDeleteNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodePackageRepo403JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodePackageRepo403JSONResponse) VisitDeleteNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodePackageRepo500JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodePackageRepo500JSONResponse) VisitDeleteNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodePackageRepoByNameRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+	Name     RepoName `json:"name"`
+}
+
+type GetNodePackageRepoByNameResponseObject interface {
+	VisitGetNodePackageRepoByNameResponse(w http.ResponseWriter) error
+}
+
+type GetNodePackageRepoByName200JSONResponse PackageRepoCollectionResponse
+
+func (response GetNodePackageRepoByName200JSONResponse) VisitGetNodePackageRepoByNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodePackageRepoByName400JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodePackageRepoByName400JSONResponse) VisitGetNodePackageRepoByNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodePackageRepoByName401JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodePackageRepoByName401JSONResponse) VisitGetNodePackageRepoByNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodePackageRepoByName403JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodePackageRepoByName403JSONResponse) VisitGetNodePackageRepoByNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodePackageRepoByName404JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodePackageRepoByName404JSONResponse) VisitGetNodePackageRepoByNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodePackageRepoByName500JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodePackageRepoByName500JSONResponse) VisitGetNodePackageRepoByNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodePackageRepoRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+	Name     RepoName `json:"name"`
+	Body     *PutNodePackageRepoJSONRequestBody
+}
+
+type PutNodePackageRepoResponseObject interface {
+	VisitPutNodePackageRepoResponse(w http.ResponseWriter) error
+}
+
+type PutNodePackageRepo200JSONResponse PackageRepoMutationResponse
+
+func (response PutNodePackageRepo200JSONResponse) VisitPutNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodePackageRepo400JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodePackageRepo400JSONResponse) VisitPutNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodePackageRepo401JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodePackageRepo401JSONResponse) VisitPutNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodePackageRepo403JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodePackageRepo403JSONResponse) VisitPutNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodePackageRepo404JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodePackageRepo404JSONResponse) VisitPutNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodePackageRepo500JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodePackageRepo500JSONResponse) VisitPutNodePackageRepoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetNodePackageUpdateRequestObject struct {
 	Hostname Hostname `json:"hostname"`
 }
@@ -474,220 +1235,346 @@ type GetNodePackageUpdate200JSONResponse UpdateCollectionResponse
 
 func (response GetNodePackageUpdate200JSONResponse) VisitGetNodePackageUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodePackageUpdate400JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodePackageUpdate400JSONResponse) VisitGetNodePackageUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodePackageUpdate401JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodePackageUpdate401JSONResponse) VisitGetNodePackageUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodePackageUpdate403JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodePackageUpdate403JSONResponse) VisitGetNodePackageUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodePackageUpdate500JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodePackageUpdate500JSONResponse) VisitGetNodePackageUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodePackageUpdateRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+}
+
+type PostNodePackageUpdateResponseObject interface {
+	VisitPostNodePackageUpdateResponse(w http.ResponseWriter) error
+}
+
+type PostNodePackageUpdate200JSONResponse PackageMutationResponse
+
+func (response PostNodePackageUpdate200JSONResponse) VisitPostNodePackageUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodePackageUpdate400JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodePackageUpdate400JSONResponse) VisitPostNodePackageUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodePackageUpdate401JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodePackageUpdate401JSONResponse) VisitPostNodePackageUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodePackageUpdate403JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodePackageUpdate403JSONResponse) VisitPostNodePackageUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodePackageUpdate500JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodePackageUpdate500JSONResponse) VisitPostNodePackageUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodePackageRequestObject struct {
+	Hostname Hostname    `json:"hostname"`
+	Name     PackageName `json:"name"`
+}
+
+type DeleteNodePackageResponseObject interface {
+	VisitDeleteNodePackageResponse(w http.ResponseWriter) error
+}
+
+type DeleteNodePackage200JSONResponse PackageMutationResponse
+
+func (response DeleteNodePackage200JSONResponse) VisitDeleteNodePackageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodePackage400JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodePackage400JSONResponse) VisitDeleteNodePackageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNodePackageUpdate400JSONResponse externalRef0.ErrorResponse
+type DeleteNodePackage401JSONResponse externalRef0.ErrorResponse
 
-func (response GetNodePackageUpdate400JSONResponse) VisitGetNodePackageUpdateResponse(w http.ResponseWriter) error {
+func (response DeleteNodePackage401JSONResponse) VisitDeleteNodePackageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNodePackageUpdate401JSONResponse externalRef0.ErrorResponse
+type DeleteNodePackage403JSONResponse externalRef0.ErrorResponse
 
-func (response GetNodePackageUpdate401JSONResponse) VisitGetNodePackageUpdateResponse(w http.ResponseWriter) error {
+func (response DeleteNodePackage403JSONResponse) VisitDeleteNodePackageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNodePackageUpdate403JSONResponse externalRef0.ErrorResponse
+type DeleteNodePackage404JSONResponse externalRef0.ErrorResponse
 
-func (response GetNodePackageUpdate403JSONResponse) VisitGetNodePackageUpdateResponse(w http.ResponseWriter) error {
+func (response DeleteNodePackage404JSONResponse) VisitDeleteNodePackageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNodePackageUpdate500JSONResponse externalRef0.ErrorResponse
+type DeleteNodePackage500JSONResponse externalRef0.ErrorResponse
 
-func (response GetNodePackageUpdate500JSONResponse) VisitGetNodePackageUpdateResponse(w http.ResponseWriter) error {
+func (response DeleteNodePackage500JSONResponse) VisitDeleteNodePackageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostNodePackageUpdateRequestObject struct {
-	Hostname Hostname `json:"hostname"`
+type GetNodePackageByNameRequestObject struct {
+	Hostname Hostname    `json:"hostname"`
+	Name     PackageName `json:"name"`
 }
 
-type PostNodePackageUpdateResponseObject interface {
-	VisitPostNodePackageUpdateResponse(w http.ResponseWriter) error
+type GetNodePackageByNameResponseObject interface {
+	VisitGetNodePackageByNameResponse(w http.ResponseWriter) error
 }
 
-type PostNodePackageUpdate200JSONResponse PackageMutationResponse
+type GetNodePackageByName200JSONResponse PackageCollectionResponse
 
-func (response PostNodePackageUpdate200JSONResponse) VisitPostNodePackageUpdateResponse(w http.ResponseWriter) error {
+func (response GetNodePackageByName200JSONResponse) VisitGetNodePackageByNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostNodePackageUpdate400JSONResponse externalRef0.ErrorResponse
+type GetNodePackageByName400JSONResponse externalRef0.ErrorResponse
 
-func (response PostNodePackageUpdate400JSONResponse) VisitPostNodePackageUpdateResponse(w http.ResponseWriter) error {
+func (response GetNodePackageByName400JSONResponse) VisitGetNodePackageByNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostNodePackageUpdate401JSONResponse externalRef0.ErrorResponse
+type GetNodePackageByName401JSONResponse externalRef0.ErrorResponse
 
-func (response PostNodePackageUpdate401JSONResponse) VisitPostNodePackageUpdateResponse(w http.ResponseWriter) error {
+func (response GetNodePackageByName401JSONResponse) VisitGetNodePackageByNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostNodePackageUpdate403JSONResponse externalRef0.ErrorResponse
+type GetNodePackageByName403JSONResponse externalRef0.ErrorResponse
 
-func (response PostNodePackageUpdate403JSONResponse) VisitPostNodePackageUpdateResponse(w http.ResponseWriter) error {
+func (response GetNodePackageByName403JSONResponse) VisitGetNodePackageByNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostNodePackageUpdate500JSONResponse externalRef0.ErrorResponse
+type GetNodePackageByName404JSONResponse externalRef0.ErrorResponse
 
-func (response PostNodePackageUpdate500JSONResponse) VisitPostNodePackageUpdateResponse(w http.ResponseWriter) error {
+func (response GetNodePackageByName404JSONResponse) VisitGetNodePackageByNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodePackageByName500JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodePackageByName500JSONResponse) VisitGetNodePackageByNameResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNodePackageRequestObject struct {
+type DeleteNodePackageHoldRequestObject struct {
 	Hostname Hostname    `json:"hostname"`
 	Name     PackageName `json:"name"`
 }
 
-type DeleteNodePackageResponseObject interface {
-	VisitDeleteNodePackageResponse(w http.ResponseWriter) error
+type DeleteNodePackageHoldResponseObject interface {
+	VisitDeleteNodePackageHoldResponse(w http.ResponseWriter) error
 }
 
-type DeleteNodePackage200JSONResponse PackageMutationResponse
+type DeleteNodePackageHold200JSONResponse PackageMutationResponse
 
-func (response DeleteNodePackage200JSONResponse) VisitDeleteNodePackageResponse(w http.ResponseWriter) error {
+func (response DeleteNodePackageHold200JSONResponse) VisitDeleteNodePackageHoldResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNodePackage400JSONResponse externalRef0.ErrorResponse
+type DeleteNodePackageHold400JSONResponse externalRef0.ErrorResponse
 
-func (response DeleteNodePackage400JSONResponse) VisitDeleteNodePackageResponse(w http.ResponseWriter) error {
+func (response DeleteNodePackageHold400JSONResponse) VisitDeleteNodePackageHoldResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNodePackage401JSONResponse externalRef0.ErrorResponse
+type DeleteNodePackageHold401JSONResponse externalRef0.ErrorResponse
 
-func (response DeleteNodePackage401JSONResponse) VisitDeleteNodePackageResponse(w http.ResponseWriter) error {
+func (response DeleteNodePackageHold401JSONResponse) VisitDeleteNodePackageHoldResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNodePackage403JSONResponse externalRef0.ErrorResponse
+type DeleteNodePackageHold403JSONResponse externalRef0.ErrorResponse
 
-func (response DeleteNodePackage403JSONResponse) VisitDeleteNodePackageResponse(w http.ResponseWriter) error {
+func (response DeleteNodePackageHold403JSONResponse) VisitDeleteNodePackageHoldResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNodePackage404JSONResponse externalRef0.ErrorResponse
+type DeleteNodePackageHold404JSONResponse externalRef0.ErrorResponse
 
-func (response DeleteNodePackage404JSONResponse) VisitDeleteNodePackageResponse(w http.ResponseWriter) error {
+func (response DeleteNodePackageHold404JSONResponse) VisitDeleteNodePackageHoldResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteNodePackage500JSONResponse externalRef0.ErrorResponse
+type DeleteNodePackageHold500JSONResponse externalRef0.ErrorResponse
 
-func (response DeleteNodePackage500JSONResponse) VisitDeleteNodePackageResponse(w http.ResponseWriter) error {
+func (response DeleteNodePackageHold500JSONResponse) VisitDeleteNodePackageHoldResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNodePackageByNameRequestObject struct {
+type PostNodePackageHoldRequestObject struct {
 	Hostname Hostname    `json:"hostname"`
 	Name     PackageName `json:"name"`
 }
 
-type GetNodePackageByNameResponseObject interface {
-	VisitGetNodePackageByNameResponse(w http.ResponseWriter) error
+type PostNodePackageHoldResponseObject interface {
+	VisitPostNodePackageHoldResponse(w http.ResponseWriter) error
 }
 
-type GetNodePackageByName200JSONResponse PackageCollectionResponse
+type PostNodePackageHold200JSONResponse PackageMutationResponse
 
-func (response GetNodePackageByName200JSONResponse) VisitGetNodePackageByNameResponse(w http.ResponseWriter) error {
+func (response PostNodePackageHold200JSONResponse) VisitPostNodePackageHoldResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNodePackageByName400JSONResponse externalRef0.ErrorResponse
+type PostNodePackageHold400JSONResponse externalRef0.ErrorResponse
 
-func (response GetNodePackageByName400JSONResponse) VisitGetNodePackageByNameResponse(w http.ResponseWriter) error {
+func (response PostNodePackageHold400JSONResponse) VisitPostNodePackageHoldResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNodePackageByName401JSONResponse externalRef0.ErrorResponse
+type PostNodePackageHold401JSONResponse externalRef0.ErrorResponse
 
-func (response GetNodePackageByName401JSONResponse) VisitGetNodePackageByNameResponse(w http.ResponseWriter) error {
+func (response PostNodePackageHold401JSONResponse) VisitPostNodePackageHoldResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNodePackageByName403JSONResponse externalRef0.ErrorResponse
+type PostNodePackageHold403JSONResponse externalRef0.ErrorResponse
 
-func (response GetNodePackageByName403JSONResponse) VisitGetNodePackageByNameResponse(w http.ResponseWriter) error {
+func (response PostNodePackageHold403JSONResponse) VisitPostNodePackageHoldResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNodePackageByName404JSONResponse externalRef0.ErrorResponse
+type PostNodePackageHold404JSONResponse externalRef0.ErrorResponse
 
-func (response GetNodePackageByName404JSONResponse) VisitGetNodePackageByNameResponse(w http.ResponseWriter) error {
+func (response PostNodePackageHold404JSONResponse) VisitPostNodePackageHoldResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetNodePackageByName500JSONResponse externalRef0.ErrorResponse
+type PostNodePackageHold500JSONResponse externalRef0.ErrorResponse
 
-func (response GetNodePackageByName500JSONResponse) VisitGetNodePackageByNameResponse(w http.ResponseWriter) error {
+func (response PostNodePackageHold500JSONResponse) VisitPostNodePackageHoldResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
@@ -702,6 +1589,24 @@ type StrictServerInterface interface {
 	// Install a package
 	// (POST /api/node/{hostname}/package)
 	PostNodePackage(ctx context.Context, request PostNodePackageRequestObject) (PostNodePackageResponseObject, error)
+	// Ensure package states
+	// (POST /api/node/{hostname}/package/ensure)
+	PostNodePackageEnsure(ctx context.Context, request PostNodePackageEnsureRequestObject) (PostNodePackageEnsureResponseObject, error)
+	// List package repositories
+	// (GET /api/node/{hostname}/package/repo)
+	GetNodePackageRepo(ctx context.Context, request GetNodePackageRepoRequestObject) (GetNodePackageRepoResponseObject, error)
+	// Create a package repository
+	// (POST /api/node/{hostname}/package/repo)
+	PostNodePackageRepo(ctx context.Context, request PostNodePackageRepoRequestObject) (PostNodePackageRepoResponseObject, error)
+	// Delete a package repository
+	// (DELETE /api/node/{hostname}/package/repo/{name})
+	DeleteNodePackageRepo(ctx context.Context, request DeleteNodePackageRepoRequestObject) (DeleteNodePackageRepoResponseObject, error)
+	// Get a package repository
+	// (GET /api/node/{hostname}/package/repo/{name})
+	GetNodePackageRepoByName(ctx context.Context, request GetNodePackageRepoByNameRequestObject) (GetNodePackageRepoByNameResponseObject, error)
+	// Update a package repository
+	// (PUT /api/node/{hostname}/package/repo/{name})
+	PutNodePackageRepo(ctx context.Context, request PutNodePackageRepoRequestObject) (PutNodePackageRepoResponseObject, error)
 	// List available updates
 	// (GET /api/node/{hostname}/package/update)
 	GetNodePackageUpdate(ctx context.Context, request GetNodePackageUpdateRequestObject) (GetNodePackageUpdateResponseObject, error)
@@ -714,6 +1619,12 @@ type StrictServerInterface interface {
 	// Get a package
 	// (GET /api/node/{hostname}/package/{name})
 	GetNodePackageByName(ctx context.Context, request GetNodePackageByNameRequestObject) (GetNodePackageByNameResponseObject, error)
+	// Unhold a package
+	// (DELETE /api/node/{hostname}/package/{name}/hold)
+	DeleteNodePackageHold(ctx context.Context, request DeleteNodePackageHoldRequestObject) (DeleteNodePackageHoldResponseObject, error)
+	// Hold a package
+	// (POST /api/node/{hostname}/package/{name}/hold)
+	PostNodePackageHold(ctx context.Context, request PostNodePackageHoldRequestObject) (PostNodePackageHoldResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -784,6 +1695,177 @@ func (sh *strictHandler) PostNodePackage(ctx echo.Context, hostname Hostname) er
 	return nil
 }
 
+// PostNodePackageEnsure operation middleware
+func (sh *strictHandler) PostNodePackageEnsure(ctx echo.Context, hostname Hostname) error {
+	var request PostNodePackageEnsureRequestObject
+
+	request.Hostname = hostname
+
+	var body PostNodePackageEnsureJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostNodePackageEnsure(ctx.Request().Context(), request.(PostNodePackageEnsureRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostNodePackageEnsure")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostNodePackageEnsureResponseObject); ok {
+		return validResponse.VisitPostNodePackageEnsureResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetNodePackageRepo operation middleware
+func (sh *strictHandler) GetNodePackageRepo(ctx echo.Context, hostname Hostname) error {
+	var request GetNodePackageRepoRequestObject
+
+	request.Hostname = hostname
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNodePackageRepo(ctx.Request().Context(), request.(GetNodePackageRepoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNodePackageRepo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetNodePackageRepoResponseObject); ok {
+		return validResponse.VisitGetNodePackageRepoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostNodePackageRepo operation middleware
+func (sh *strictHandler) PostNodePackageRepo(ctx echo.Context, hostname Hostname) error {
+	var request PostNodePackageRepoRequestObject
+
+	request.Hostname = hostname
+
+	var body PostNodePackageRepoJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostNodePackageRepo(ctx.Request().Context(), request.(PostNodePackageRepoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostNodePackageRepo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostNodePackageRepoResponseObject); ok {
+		return validResponse.VisitPostNodePackageRepoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteNodePackageRepo operation middleware
+func (sh *strictHandler) DeleteNodePackageRepo(ctx echo.Context, hostname Hostname, name RepoName) error {
+	var request DeleteNodePackageRepoRequestObject
+
+	request.Hostname = hostname
+	request.Name = name
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteNodePackageRepo(ctx.Request().Context(), request.(DeleteNodePackageRepoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteNodePackageRepo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteNodePackageRepoResponseObject); ok {
+		return validResponse.VisitDeleteNodePackageRepoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetNodePackageRepoByName operation middleware
+func (sh *strictHandler) GetNodePackageRepoByName(ctx echo.Context, hostname Hostname, name RepoName) error {
+	var request GetNodePackageRepoByNameRequestObject
+
+	request.Hostname = hostname
+	request.Name = name
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNodePackageRepoByName(ctx.Request().Context(), request.(GetNodePackageRepoByNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNodePackageRepoByName")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetNodePackageRepoByNameResponseObject); ok {
+		return validResponse.VisitGetNodePackageRepoByNameResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutNodePackageRepo operation middleware
+func (sh *strictHandler) PutNodePackageRepo(ctx echo.Context, hostname Hostname, name RepoName) error {
+	var request PutNodePackageRepoRequestObject
+
+	request.Hostname = hostname
+	request.Name = name
+
+	var body PutNodePackageRepoJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutNodePackageRepo(ctx.Request().Context(), request.(PutNodePackageRepoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutNodePackageRepo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutNodePackageRepoResponseObject); ok {
+		return validResponse.VisitPutNodePackageRepoResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetNodePackageUpdate operation middleware
 func (sh *strictHandler) GetNodePackageUpdate(ctx echo.Context, hostname Hostname) error {
 	var request GetNodePackageUpdateRequestObject
@@ -885,3 +1967,55 @@ func (sh *strictHandler) GetNodePackageByName(ctx echo.Context, hostname Hostnam
 	}
 	return nil
 }
+
+// DeleteNodePackageHold operation middleware
+func (sh *strictHandler) DeleteNodePackageHold(ctx echo.Context, hostname Hostname, name PackageName) error {
+	var request DeleteNodePackageHoldRequestObject
+
+	request.Hostname = hostname
+	request.Name = name
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteNodePackageHold(ctx.Request().Context(), request.(DeleteNodePackageHoldRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteNodePackageHold")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteNodePackageHoldResponseObject); ok {
+		return validResponse.VisitDeleteNodePackageHoldResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostNodePackageHold operation middleware
+func (sh *strictHandler) PostNodePackageHold(ctx echo.Context, hostname Hostname, name PackageName) error {
+	var request PostNodePackageHoldRequestObject
+
+	request.Hostname = hostname
+	request.Name = name
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostNodePackageHold(ctx.Request().Context(), request.(PostNodePackageHoldRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostNodePackageHold")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostNodePackageHoldResponseObject); ok {
+		return validResponse.VisitPostNodePackageHoldResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package packageapi
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/package/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	aptProv "github.com/osapi-io/osapi/internal/provider/node/apt"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// PostNodePackageEnsure converges packages on a target node to a manifest
+// of desired states.
+func (p *Package) PostNodePackageEnsure(
+	ctx context.Context,
+	request gen.PostNodePackageEnsureRequestObject,
+) (gen.PostNodePackageEnsureResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.PostNodePackageEnsure400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Body); !ok {
+		return gen.PostNodePackageEnsure400JSONResponse{Error: &errMsg}, nil
+	}
+
+	entries := make([]aptProv.EnsureEntry, 0, len(request.Body.Packages))
+	for _, pkg := range request.Body.Packages {
+		entry := aptProv.EnsureEntry{
+			Name:  pkg.Name,
+			State: string(pkg.State),
+		}
+		if pkg.Version != nil {
+			entry.Version = *pkg.Version
+		}
+		entries = append(entries, entry)
+	}
+
+	hostname := request.Hostname
+
+	p.logger.Debug(
+		"package ensure",
+		slog.String("target", hostname),
+		slog.Int("packages", len(entries)),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	data := map[string]interface{}{
+		"packages": entries,
+	}
+
+	if job.IsBroadcastTarget(hostname) {
+		return p.postNodePackageEnsureBroadcast(ctx, hostname, data)
+	}
+
+	jobID, resp, err := p.JobClient.Modify(
+		ctx,
+		hostname,
+		"node",
+		job.OperationPackageEnsure,
+		data,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PostNodePackageEnsure500JSONResponse{Error: &errMsg}, nil
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		return gen.PostNodePackageEnsure200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.PackageEnsureResult{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.PackageEnsureResultStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	return gen.PostNodePackageEnsure200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.PackageEnsureResult{
+			{
+				Hostname: resp.Hostname,
+				Status:   gen.PackageEnsureResultStatusOk,
+				Packages: ensureItems(resp.Data),
+				Changed:  resp.Changed,
+			},
+		},
+	}, nil
+}
+
+// postNodePackageEnsureBroadcast handles broadcast targets for package ensure.
+func (p *Package) postNodePackageEnsureBroadcast(
+	ctx context.Context,
+	target string,
+	data map[string]interface{},
+) (gen.PostNodePackageEnsureResponseObject, error) {
+	jobID, responses, err := p.JobClient.ModifyBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationPackageEnsure,
+		data,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PostNodePackageEnsure500JSONResponse{Error: &errMsg}, nil
+	}
+
+	var apiResponses []gen.PackageEnsureResult
+	for host, resp := range responses {
+		item := gen.PackageEnsureResult{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			item.Status = gen.PackageEnsureResultStatusFailed
+			e := resp.Error
+			item.Error = &e
+		case job.StatusSkipped:
+			item.Status = gen.PackageEnsureResultStatusSkipped
+			e := resp.Error
+			item.Error = &e
+		default:
+			item.Status = gen.PackageEnsureResultStatusOk
+			item.Packages = ensureItems(resp.Data)
+			item.Changed = resp.Changed
+		}
+		apiResponses = append(apiResponses, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.PostNodePackageEnsure200JSONResponse{
+		JobId:   &jobUUID,
+		Results: apiResponses,
+	}, nil
+}
+
+// ensureItems converts an agent ensure result into per-package API items.
+func ensureItems(
+	data json.RawMessage,
+) *[]gen.PackageEnsureItem {
+	var result aptProv.EnsureResult
+	if data != nil {
+		_ = json.Unmarshal(data, &result)
+	}
+
+	items := make([]gen.PackageEnsureItem, 0, len(result.Packages))
+	for _, r := range result.Packages {
+		item := gen.PackageEnsureItem{
+			Name:    r.Name,
+			Changed: r.Changed,
+		}
+		if r.Error != "" {
+			e := r.Error
+			item.Error = &e
+		}
+		items = append(items, item)
+	}
+
+	return &items
+}
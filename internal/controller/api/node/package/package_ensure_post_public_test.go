@@ -0,0 +1,554 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+package packageapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	apipackage "github.com/osapi-io/osapi/internal/controller/api/node/package"
+	"github.com/osapi-io/osapi/internal/controller/api/node/package/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/apt"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type PackageEnsurePostPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *apipackage.Package
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *PackageEnsurePostPublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *PackageEnsurePostPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = apipackage.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *PackageEnsurePostPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *PackageEnsurePostPublicTestSuite) TestPostNodePackageEnsure() {
+	changeBool := true
+	body := &gen.PackageEnsureRequest{
+		Packages: []gen.PackageEnsureEntry{
+			{Name: "nginx", State: gen.PackageEnsureEntryStatePinned, Version: strPtr("1.24.0-1")},
+			{Name: "telnet", State: gen.PackageEnsureEntryStateAbsent},
+		},
+	}
+	data := map[string]interface{}{
+		"packages": []apt.EnsureEntry{
+			{Name: "nginx", State: apt.StatePinned, Version: "1.24.0-1"},
+			{Name: "telnet", State: apt.StateAbsent},
+		},
+	}
+	resultData := json.RawMessage(`{"packages":[` +
+		`{"name":"nginx","changed":true},` +
+		`{"name":"telnet","changed":false,"error":"package: remove \"telnet\": exec error"}` +
+		`],"changed":true}`)
+
+	tests := []struct {
+		name         string
+		request      gen.PostNodePackageEnsureRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.PostNodePackageEnsureResponseObject)
+	}{
+		{
+			name: "success",
+			request: gen.PostNodePackageEnsureRequestObject{
+				Hostname: "server1",
+				Body:     body,
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationPackageEnsure,
+						data,
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Changed:  &changeBool,
+						Data:     resultData,
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodePackageEnsureResponseObject) {
+				r, ok := resp.(gen.PostNodePackageEnsure200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("agent1", r.Results[0].Hostname)
+				s.Equal(gen.PackageEnsureResultStatusOk, r.Results[0].Status)
+				s.True(*r.Results[0].Changed)
+				s.Require().NotNil(r.Results[0].Packages)
+				pkgs := *r.Results[0].Packages
+				s.Require().Len(pkgs, 2)
+				s.Equal("nginx", pkgs[0].Name)
+				s.True(pkgs[0].Changed)
+				s.Nil(pkgs[0].Error)
+				s.Equal("telnet", pkgs[1].Name)
+				s.False(pkgs[1].Changed)
+				s.Require().NotNil(pkgs[1].Error)
+				s.Contains(*pkgs[1].Error, "exec error")
+			},
+		},
+		{
+			name: "validation error empty packages",
+			request: gen.PostNodePackageEnsureRequestObject{
+				Hostname: "server1",
+				Body:     &gen.PackageEnsureRequest{},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodePackageEnsureResponseObject) {
+				r, ok := resp.(gen.PostNodePackageEnsure400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "required")
+			},
+		},
+		{
+			name: "validation error invalid state",
+			request: gen.PostNodePackageEnsureRequestObject{
+				Hostname: "server1",
+				Body: &gen.PackageEnsureRequest{
+					Packages: []gen.PackageEnsureEntry{
+						{Name: "nginx", State: "purged"},
+					},
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodePackageEnsureResponseObject) {
+				r, ok := resp.(gen.PostNodePackageEnsure400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "oneof")
+			},
+		},
+		{
+			name: "validation error pinned without version",
+			request: gen.PostNodePackageEnsureRequestObject{
+				Hostname: "server1",
+				Body: &gen.PackageEnsureRequest{
+					Packages: []gen.PackageEnsureEntry{
+						{Name: "nginx", State: gen.PackageEnsureEntryStatePinned},
+					},
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodePackageEnsureResponseObject) {
+				r, ok := resp.(gen.PostNodePackageEnsure400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "required_if")
+			},
+		},
+		{
+			name: "validation error empty hostname",
+			request: gen.PostNodePackageEnsureRequestObject{
+				Hostname: "",
+				Body:     body,
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodePackageEnsureResponseObject) {
+				r, ok := resp.(gen.PostNodePackageEnsure400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "required")
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.PostNodePackageEnsureRequestObject{
+				Hostname: "server1",
+				Body:     body,
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationPackageEnsure,
+						data,
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							Status:   job.StatusSkipped,
+							Hostname: "server1",
+							Error:    "package: operation not supported on this OS family",
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PostNodePackageEnsureResponseObject) {
+				r, ok := resp.(gen.PostNodePackageEnsure200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.PackageEnsureResultStatusSkipped, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+			},
+		},
+		{
+			name: "job client error",
+			request: gen.PostNodePackageEnsureRequestObject{
+				Hostname: "server1",
+				Body:     body,
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationPackageEnsure,
+						data,
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PostNodePackageEnsureResponseObject) {
+				_, ok := resp.(gen.PostNodePackageEnsure500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "broadcast target _all includes failed and skipped agents",
+			request: gen.PostNodePackageEnsureRequestObject{
+				Hostname: "_all",
+				Body:     body,
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationPackageEnsure,
+						data,
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						map[string]*job.Response{
+							"server1": {
+								Hostname: "server1",
+								Status:   job.StatusCompleted,
+								Changed:  &changeBool,
+								Data:     resultData,
+							},
+							"server2": {
+								Status:   job.StatusFailed,
+								Error:    "package: ensure: exec failed",
+								Hostname: "server2",
+							},
+							"server3": {
+								Status:   job.StatusSkipped,
+								Error:    "package: operation not supported on this OS family",
+								Hostname: "server3",
+							},
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PostNodePackageEnsureResponseObject) {
+				r, ok := resp.(gen.PostNodePackageEnsure200JSONResponse)
+				s.True(ok)
+				s.Len(r.Results, 3)
+
+				byHost := make(map[string]*gen.PackageEnsureResult)
+				for i := range r.Results {
+					byHost[r.Results[i].Hostname] = &r.Results[i]
+				}
+
+				s.Equal(gen.PackageEnsureResultStatusOk, byHost["server1"].Status)
+				s.Require().NotNil(byHost["server1"].Packages)
+				s.Len(*byHost["server1"].Packages, 2)
+				s.Equal(gen.PackageEnsureResultStatusFailed, byHost["server2"].Status)
+				s.Equal(gen.PackageEnsureResultStatusSkipped, byHost["server3"].Status)
+			},
+		},
+		{
+			name: "broadcast job client error",
+			request: gen.PostNodePackageEnsureRequestObject{
+				Hostname: "_all",
+				Body:     body,
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationPackageEnsure,
+						data,
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PostNodePackageEnsureResponseObject) {
+				_, ok := resp.(gen.PostNodePackageEnsure500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			resp, err := s.handler.PostNodePackageEnsure(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+func (s *PackageEnsurePostPublicTestSuite) TestPostNodePackageEnsureValidationHTTP() {
+	tests := []struct {
+		name         string
+		path         string
+		body         string
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when valid request",
+			path: "/api/node/server1/package/ensure",
+			body: `{"packages":[{"name":"curl","state":"present"}]}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				changeBool := false
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationPackageEnsure,
+						map[string]interface{}{
+							"packages": []apt.EnsureEntry{
+								{Name: "curl", State: apt.StatePresent},
+							},
+						}).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Changed:  &changeBool,
+						Data: json.RawMessage(
+							`{"packages":[{"name":"curl","changed":false}],"changed":false}`,
+						),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`, `"packages"`},
+		},
+		{
+			name: "when invalid state returns 400",
+			path: "/api/node/server1/package/ensure",
+			body: `{"packages":[{"name":"curl","state":"purged"}]}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`},
+		},
+		{
+			name: "when target agent not found",
+			path: "/api/node/nonexistent/package/ensure",
+			body: `{"packages":[{"name":"curl","state":"present"}]}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "valid_target"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			packageHandler := apipackage.New(s.logger, jobMock)
+			strictHandler := gen.NewStrictHandler(packageHandler, nil)
+
+			a := api.New(s.appConfig, s.logger)
+			gen.RegisterHandlers(a.Echo, strictHandler)
+
+			req := httptest.NewRequest(http.MethodPost, tc.path,
+				bytes.NewBufferString(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			a.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+const rbacPackageEnsureTestSigningKey = "test-signing-key-for-rbac-package-ensure"
+
+func (s *PackageEnsurePostPublicTestSuite) TestPostNodePackageEnsureRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when no token returns 401",
+			setupAuth: func(_ *http.Request) {
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusUnauthorized,
+			wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacPackageEnsureTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"package:read"},
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid admin token returns 200",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacPackageEnsureTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				changeBool := true
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationPackageEnsure,
+						gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Changed:  &changeBool,
+						Data: json.RawMessage(
+							`{"packages":[{"name":"curl","changed":true}],"changed":true}`,
+						),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{
+							SigningKey: rbacPackageEnsureTestSigningKey,
+						},
+					},
+				},
+			}
+
+			server := api.New(appConfig, s.logger)
+			handlers := apipackage.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/api/node/server1/package/ensure",
+				bytes.NewBufferString(`{"packages":[{"name":"curl","state":"present"}]}`),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+
+			server.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestPackageEnsurePostPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(PackageEnsurePostPublicTestSuite))
+}
@@ -0,0 +1,497 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package packageapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	apipackage "github.com/osapi-io/osapi/internal/controller/api/node/package"
+	"github.com/osapi-io/osapi/internal/controller/api/node/package/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type PackageHoldDeletePublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *apipackage.Package
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *PackageHoldDeletePublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *PackageHoldDeletePublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = apipackage.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *PackageHoldDeletePublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *PackageHoldDeletePublicTestSuite) TestDeleteNodePackageHold() {
+	changeBool := true
+	tests := []struct {
+		name         string
+		request      gen.DeleteNodePackageHoldRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.DeleteNodePackageHoldResponseObject)
+	}{
+		{
+			name: "success",
+			request: gen.DeleteNodePackageHoldRequestObject{
+				Hostname: "server1",
+				Name:     "curl",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationPackageUnhold,
+						map[string]string{"name": "curl"},
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Changed:  &changeBool,
+						Data:     json.RawMessage(`{"name":"curl","changed":true}`),
+					}, nil)
+			},
+			validateFunc: func(resp gen.DeleteNodePackageHoldResponseObject) {
+				r, ok := resp.(gen.DeleteNodePackageHold200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("agent1", r.Results[0].Hostname)
+				s.Equal(gen.PackageMutationResultStatusOk, r.Results[0].Status)
+				s.Equal("curl", *r.Results[0].Name)
+			},
+		},
+		{
+			name: "not found",
+			request: gen.DeleteNodePackageHoldRequestObject{
+				Hostname: "server1",
+				Name:     "nonexistent",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationPackageUnhold,
+						map[string]string{"name": "nonexistent"},
+					).
+					Return("", nil, fmt.Errorf("package not found: nonexistent"))
+			},
+			validateFunc: func(resp gen.DeleteNodePackageHoldResponseObject) {
+				r, ok := resp.(gen.DeleteNodePackageHold404JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "not found")
+			},
+		},
+		{
+			name: "not installed",
+			request: gen.DeleteNodePackageHoldRequestObject{
+				Hostname: "server1",
+				Name:     "removed-pkg",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationPackageUnhold,
+						map[string]string{"name": "removed-pkg"},
+					).
+					Return("", nil, fmt.Errorf("package not installed: removed-pkg"))
+			},
+			validateFunc: func(resp gen.DeleteNodePackageHoldResponseObject) {
+				r, ok := resp.(gen.DeleteNodePackageHold404JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "not installed")
+			},
+		},
+		{
+			name: "validation error empty hostname",
+			request: gen.DeleteNodePackageHoldRequestObject{
+				Hostname: "",
+				Name:     "curl",
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.DeleteNodePackageHoldResponseObject) {
+				r, ok := resp.(gen.DeleteNodePackageHold400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "required")
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.DeleteNodePackageHoldRequestObject{
+				Hostname: "server1",
+				Name:     "curl",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationPackageUnhold,
+						map[string]string{"name": "curl"},
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							Status:   job.StatusSkipped,
+							Hostname: "server1",
+							Error:    "apt: unsupported",
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.DeleteNodePackageHoldResponseObject) {
+				r, ok := resp.(gen.DeleteNodePackageHold200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.PackageMutationResultStatusSkipped, r.Results[0].Status)
+			},
+		},
+		{
+			name: "job client error",
+			request: gen.DeleteNodePackageHoldRequestObject{
+				Hostname: "server1",
+				Name:     "curl",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationPackageUnhold,
+						map[string]string{"name": "curl"},
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.DeleteNodePackageHoldResponseObject) {
+				_, ok := resp.(gen.DeleteNodePackageHold500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "broadcast target _all includes failed and skipped agents",
+			request: gen.DeleteNodePackageHoldRequestObject{
+				Hostname: "_all",
+				Name:     "curl",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationPackageUnhold,
+						map[string]string{"name": "curl"},
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						map[string]*job.Response{
+							"server1": {
+								Hostname: "server1",
+								Status:   job.StatusCompleted,
+								Changed:  &changeBool,
+								Data:     json.RawMessage(`{"name":"curl","changed":true}`),
+							},
+							"server2": {
+								Status:   job.StatusFailed,
+								Error:    "apt: unhold failed",
+								Hostname: "server2",
+							},
+							"server3": {
+								Status:   job.StatusSkipped,
+								Error:    "apt: unsupported",
+								Hostname: "server3",
+							},
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.DeleteNodePackageHoldResponseObject) {
+				r, ok := resp.(gen.DeleteNodePackageHold200JSONResponse)
+				s.True(ok)
+				s.Len(r.Results, 3)
+
+				byHost := make(map[string]*gen.PackageMutationResult)
+				for i := range r.Results {
+					byHost[r.Results[i].Hostname] = &r.Results[i]
+				}
+
+				s.Equal(gen.PackageMutationResultStatusOk, byHost["server1"].Status)
+				s.Equal(gen.PackageMutationResultStatusFailed, byHost["server2"].Status)
+				s.Equal(gen.PackageMutationResultStatusSkipped, byHost["server3"].Status)
+			},
+		},
+		{
+			name: "broadcast job client error",
+			request: gen.DeleteNodePackageHoldRequestObject{
+				Hostname: "_all",
+				Name:     "curl",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationPackageUnhold,
+						map[string]string{"name": "curl"},
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.DeleteNodePackageHoldResponseObject) {
+				_, ok := resp.(gen.DeleteNodePackageHold500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			resp, err := s.handler.DeleteNodePackageHold(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+func (s *PackageHoldDeletePublicTestSuite) TestDeleteNodePackageHoldValidationHTTP() {
+	tests := []struct {
+		name         string
+		path         string
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when valid request",
+			path: "/api/node/server1/package/curl/hold",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				changeBool := true
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationPackageUnhold,
+						map[string]string{"name": "curl"}).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Changed:  &changeBool,
+						Data:     json.RawMessage(`{"name":"curl","changed":true}`),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+		{
+			name: "when target agent not found",
+			path: "/api/node/nonexistent/package/curl/hold",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "valid_target"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			packageHandler := apipackage.New(s.logger, jobMock)
+			strictHandler := gen.NewStrictHandler(packageHandler, nil)
+
+			a := api.New(s.appConfig, s.logger)
+			gen.RegisterHandlers(a.Echo, strictHandler)
+
+			req := httptest.NewRequest(http.MethodDelete, tc.path, nil)
+			rec := httptest.NewRecorder()
+
+			a.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+const rbacPackageUnholdTestSigningKey = "test-signing-key-for-rbac-package-unhold"
+
+func (s *PackageHoldDeletePublicTestSuite) TestDeleteNodePackageHoldRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when no token returns 401",
+			setupAuth: func(_ *http.Request) {
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusUnauthorized,
+			wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacPackageUnholdTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"package:read"},
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid admin token returns 200",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacPackageUnholdTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				changeBool := true
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationPackageUnhold,
+						map[string]string{"name": "curl"}).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Changed:  &changeBool,
+						Data:     json.RawMessage(`{"name":"curl","changed":true}`),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{
+							SigningKey: rbacPackageUnholdTestSigningKey,
+						},
+					},
+				},
+			}
+
+			server := api.New(appConfig, s.logger)
+			handlers := apipackage.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+
+			req := httptest.NewRequest(
+				http.MethodDelete,
+				"/api/node/server1/package/curl/hold",
+				nil,
+			)
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+
+			server.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestPackageHoldDeletePublicTestSuite(t *testing.T) {
+	suite.Run(t, new(PackageHoldDeletePublicTestSuite))
+}
@@ -47,17 +47,28 @@ func (p *Package) PostNodePackage(
 	}
 
 	hostname := request.Hostname
-	pkgName := request.Body.Name
+
+	data := map[string]string{}
+	if request.Body.Object != nil && *request.Body.Object != "" {
+		data["object"] = *request.Body.Object
+	} else {
+		data["name"] = *request.Body.Name
+		if request.Body.Version != nil && *request.Body.Version != "" {
+			data["version"] = *request.Body.Version
+		}
+	}
 
 	p.logger.Debug(
 		"package install",
 		slog.String("target", hostname),
-		slog.String("name", pkgName),
+		slog.String("name", data["name"]),
+		slog.String("version", data["version"]),
+		slog.String("object", data["object"]),
 		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
 	)
 
 	if job.IsBroadcastTarget(hostname) {
-		return p.postNodePackageInstallBroadcast(ctx, hostname, pkgName)
+		return p.postNodePackageInstallBroadcast(ctx, hostname, data)
 	}
 
 	jobID, resp, err := p.JobClient.Modify(
@@ -65,7 +76,7 @@ func (p *Package) PostNodePackage(
 		hostname,
 		"node",
 		job.OperationPackageInstall,
-		map[string]string{"name": pkgName},
+		data,
 	)
 	if err != nil {
 		errMsg := err.Error()
@@ -104,6 +115,7 @@ func (p *Package) PostNodePackage(
 				Hostname: agentHostname,
 				Status:   gen.PackageMutationResultStatusOk,
 				Name:     &name,
+				Version:  stringPtrOrNil(result.Version),
 				Changed:  changed,
 			},
 		},
@@ -114,14 +126,14 @@ func (p *Package) PostNodePackage(
 func (p *Package) postNodePackageInstallBroadcast(
 	ctx context.Context,
 	target string,
-	pkgName string,
+	data map[string]string,
 ) (gen.PostNodePackageResponseObject, error) {
 	jobID, responses, err := p.JobClient.ModifyBroadcast(
 		ctx,
 		target,
 		"node",
 		job.OperationPackageInstall,
-		map[string]string{"name": pkgName},
+		data,
 	)
 	if err != nil {
 		errMsg := err.Error()
@@ -150,6 +162,7 @@ func (p *Package) postNodePackageInstallBroadcast(
 			}
 			name := result.Name
 			item.Name = &name
+			item.Version = stringPtrOrNil(result.Version)
 			item.Changed = resp.Changed
 		}
 		apiResponses = append(apiResponses, item)
@@ -162,3 +175,13 @@ func (p *Package) postNodePackageInstallBroadcast(
 		Results: apiResponses,
 	}, nil
 }
+
+// stringPtrOrNil returns nil if the string is empty, otherwise a pointer.
+func stringPtrOrNil(
+	s string,
+) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
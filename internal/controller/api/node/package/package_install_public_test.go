@@ -90,7 +90,7 @@ func (s *PackageInstallPublicTestSuite) TestPostNodePackage() {
 			name: "success",
 			request: gen.PostNodePackageRequestObject{
 				Hostname: "server1",
-				Body:     &gen.PackageInstallRequest{Name: "curl"},
+				Body:     &gen.PackageInstallRequest{Name: strPtr("curl")},
 			},
 			setupMock: func() {
 				s.mockJobClient.EXPECT().
@@ -117,25 +117,139 @@ func (s *PackageInstallPublicTestSuite) TestPostNodePackage() {
 				s.Equal("curl", *r.Results[0].Name)
 			},
 		},
+		{
+			name: "success with version",
+			request: gen.PostNodePackageRequestObject{
+				Hostname: "server1",
+				Body: &gen.PackageInstallRequest{
+					Name:    strPtr("nginx"),
+					Version: strPtr("1.24.0-1"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationPackageInstall,
+						map[string]string{"name": "nginx", "version": "1.24.0-1"},
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Changed:  &changeBool,
+						Data:     json.RawMessage(`{"name":"nginx","changed":true}`),
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodePackageResponseObject) {
+				r, ok := resp.(gen.PostNodePackage200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal("nginx", *r.Results[0].Name)
+				s.True(*r.Results[0].Changed)
+			},
+		},
+		{
+			name: "success with object",
+			request: gen.PostNodePackageRequestObject{
+				Hostname: "server1",
+				Body: &gen.PackageInstallRequest{
+					Object: strPtr("hello_1.0-1_amd64.deb"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationPackageInstall,
+						map[string]string{"object": "hello_1.0-1_amd64.deb"},
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Changed:  &changeBool,
+						Data:     json.RawMessage(`{"name":"hello","version":"1.0-1","changed":true}`),
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodePackageResponseObject) {
+				r, ok := resp.(gen.PostNodePackage200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.PackageMutationResultStatusOk, r.Results[0].Status)
+				s.Equal("hello", *r.Results[0].Name)
+				s.Require().NotNil(r.Results[0].Version)
+				s.Equal("1.0-1", *r.Results[0].Version)
+				s.Equal(&changeBool, r.Results[0].Changed)
+			},
+		},
 		{
 			name: "validation error empty name",
 			request: gen.PostNodePackageRequestObject{
 				Hostname: "server1",
-				Body:     &gen.PackageInstallRequest{Name: ""},
+				Body:     &gen.PackageInstallRequest{Name: strPtr("")},
 			},
 			setupMock: func() {},
 			validateFunc: func(resp gen.PostNodePackageResponseObject) {
 				r, ok := resp.(gen.PostNodePackage400JSONResponse)
 				s.True(ok)
 				s.Require().NotNil(r.Error)
-				s.Contains(*r.Error, "required")
+				s.Contains(*r.Error, "min")
+			},
+		},
+		{
+			name: "validation error missing name and object",
+			request: gen.PostNodePackageRequestObject{
+				Hostname: "server1",
+				Body:     &gen.PackageInstallRequest{},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodePackageResponseObject) {
+				r, ok := resp.(gen.PostNodePackage400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "required_without")
+			},
+		},
+		{
+			name: "validation error name and object together",
+			request: gen.PostNodePackageRequestObject{
+				Hostname: "server1",
+				Body: &gen.PackageInstallRequest{
+					Name:   strPtr("hello"),
+					Object: strPtr("hello_1.0-1_amd64.deb"),
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodePackageResponseObject) {
+				r, ok := resp.(gen.PostNodePackage400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "excluded_with")
+			},
+		},
+		{
+			name: "validation error version with object",
+			request: gen.PostNodePackageRequestObject{
+				Hostname: "server1",
+				Body: &gen.PackageInstallRequest{
+					Object:  strPtr("hello_1.0-1_amd64.deb"),
+					Version: strPtr("1.0-1"),
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodePackageResponseObject) {
+				r, ok := resp.(gen.PostNodePackage400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "excluded_with")
 			},
 		},
 		{
 			name: "validation error empty hostname",
 			request: gen.PostNodePackageRequestObject{
 				Hostname: "",
-				Body:     &gen.PackageInstallRequest{Name: "curl"},
+				Body:     &gen.PackageInstallRequest{Name: strPtr("curl")},
 			},
 			setupMock: func() {},
 			validateFunc: func(resp gen.PostNodePackageResponseObject) {
@@ -149,7 +263,7 @@ func (s *PackageInstallPublicTestSuite) TestPostNodePackage() {
 			name: "when job skipped",
 			request: gen.PostNodePackageRequestObject{
 				Hostname: "server1",
-				Body:     &gen.PackageInstallRequest{Name: "curl"},
+				Body:     &gen.PackageInstallRequest{Name: strPtr("curl")},
 			},
 			setupMock: func() {
 				s.mockJobClient.EXPECT().
@@ -181,7 +295,7 @@ func (s *PackageInstallPublicTestSuite) TestPostNodePackage() {
 			name: "job client error",
 			request: gen.PostNodePackageRequestObject{
 				Hostname: "server1",
-				Body:     &gen.PackageInstallRequest{Name: "curl"},
+				Body:     &gen.PackageInstallRequest{Name: strPtr("curl")},
 			},
 			setupMock: func() {
 				s.mockJobClient.EXPECT().
@@ -203,7 +317,7 @@ func (s *PackageInstallPublicTestSuite) TestPostNodePackage() {
 			name: "broadcast target _all includes failed and skipped agents",
 			request: gen.PostNodePackageRequestObject{
 				Hostname: "_all",
-				Body:     &gen.PackageInstallRequest{Name: "curl"},
+				Body:     &gen.PackageInstallRequest{Name: strPtr("curl")},
 			},
 			setupMock: func() {
 				s.mockJobClient.EXPECT().
@@ -252,11 +366,52 @@ func (s *PackageInstallPublicTestSuite) TestPostNodePackage() {
 				s.Equal(gen.PackageMutationResultStatusSkipped, byHost["server3"].Status)
 			},
 		},
+		{
+			name: "broadcast target _all with object",
+			request: gen.PostNodePackageRequestObject{
+				Hostname: "_all",
+				Body: &gen.PackageInstallRequest{
+					Object: strPtr("hello_1.0-1_amd64.deb"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationPackageInstall,
+						map[string]string{"object": "hello_1.0-1_amd64.deb"},
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						map[string]*job.Response{
+							"server1": {
+								Hostname: "server1",
+								Status:   job.StatusCompleted,
+								Changed:  &changeBool,
+								Data: json.RawMessage(
+									`{"name":"hello","version":"1.0-1","changed":true}`,
+								),
+							},
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PostNodePackageResponseObject) {
+				r, ok := resp.(gen.PostNodePackage200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal("hello", *r.Results[0].Name)
+				s.Require().NotNil(r.Results[0].Version)
+				s.Equal("1.0-1", *r.Results[0].Version)
+			},
+		},
 		{
 			name: "broadcast job client error",
 			request: gen.PostNodePackageRequestObject{
 				Hostname: "_all",
-				Body:     &gen.PackageInstallRequest{Name: "curl"},
+				Body:     &gen.PackageInstallRequest{Name: strPtr("curl")},
 			},
 			setupMock: func() {
 				s.mockJobClient.EXPECT().
@@ -316,6 +471,36 @@ func (s *PackageInstallPublicTestSuite) TestPostNodePackageValidationHTTP() {
 			wantCode:     http.StatusOK,
 			wantContains: []string{`"job_id"`, `"results"`},
 		},
+		{
+			name: "when valid object request",
+			path: "/api/node/server1/package",
+			body: `{"object":"hello_1.0-1_amd64.deb"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				changeBool := true
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationPackageInstall,
+						map[string]string{"object": "hello_1.0-1_amd64.deb"}).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Changed:  &changeBool,
+						Data:     json.RawMessage(`{"name":"hello","version":"1.0-1","changed":true}`),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"version":"1.0-1"`},
+		},
+		{
+			name: "when name and object returns 400",
+			path: "/api/node/server1/package",
+			body: `{"name":"hello","object":"hello_1.0-1_amd64.deb"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`},
+		},
 		{
 			name: "when empty name returns 400",
 			path: "/api/node/server1/package",
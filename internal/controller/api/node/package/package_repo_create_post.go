@@ -0,0 +1,153 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package packageapi
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/package/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	aptProv "github.com/osapi-io/osapi/internal/provider/node/apt"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// PostNodePackageRepo creates a package repository on a target node.
+func (p *Package) PostNodePackageRepo(
+	ctx context.Context,
+	request gen.PostNodePackageRepoRequestObject,
+) (gen.PostNodePackageRepoResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.PostNodePackageRepo400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Body); !ok {
+		return gen.PostNodePackageRepo400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+
+	data := map[string]string{
+		"name":   request.Body.Name,
+		"object": request.Body.Object,
+	}
+	if request.Body.KeyObject != nil && *request.Body.KeyObject != "" {
+		data["key_object"] = *request.Body.KeyObject
+	}
+
+	p.logger.Debug(
+		"package repo create",
+		slog.String("target", hostname),
+		slog.String("name", request.Body.Name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		jobID, responses, err := p.JobClient.ModifyBroadcast(
+			ctx,
+			hostname,
+			"node",
+			job.OperationPackageRepoCreate,
+			data,
+		)
+		if err != nil {
+			errMsg := err.Error()
+			return gen.PostNodePackageRepo500JSONResponse{Error: &errMsg}, nil
+		}
+
+		jobUUID := uuid.MustParse(jobID)
+
+		return gen.PostNodePackageRepo200JSONResponse{
+			JobId:   &jobUUID,
+			Results: responsesToPackageRepoMutationResults(responses),
+		}, nil
+	}
+
+	jobID, resp, err := p.JobClient.Modify(
+		ctx,
+		hostname,
+		"node",
+		job.OperationPackageRepoCreate,
+		data,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PostNodePackageRepo500JSONResponse{Error: &errMsg}, nil
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.PostNodePackageRepo200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.PackageRepoMutationResult{
+			responseToPackageRepoMutationResult(resp.Hostname, resp),
+		},
+	}, nil
+}
+
+// responsesToPackageRepoMutationResults converts broadcast job responses
+// to gen PackageRepoMutationResult slices.
+func responsesToPackageRepoMutationResults(
+	responses map[string]*job.Response,
+) []gen.PackageRepoMutationResult {
+	results := make([]gen.PackageRepoMutationResult, 0, len(responses))
+	for host, resp := range responses {
+		results = append(results, responseToPackageRepoMutationResult(host, resp))
+	}
+
+	return results
+}
+
+// responseToPackageRepoMutationResult converts a job response to a gen
+// PackageRepoMutationResult.
+func responseToPackageRepoMutationResult(
+	host string,
+	resp *job.Response,
+) gen.PackageRepoMutationResult {
+	item := gen.PackageRepoMutationResult{
+		Hostname: host,
+	}
+
+	switch resp.Status {
+	case job.StatusFailed:
+		item.Status = gen.PackageRepoMutationResultStatusFailed
+		e := resp.Error
+		item.Error = &e
+	case job.StatusSkipped:
+		item.Status = gen.PackageRepoMutationResultStatusSkipped
+		e := resp.Error
+		item.Error = &e
+	default:
+		item.Status = gen.PackageRepoMutationResultStatusOk
+		var result aptProv.RepoResult
+		if resp.Data != nil {
+			_ = json.Unmarshal(resp.Data, &result)
+		}
+		resultName := result.Name
+		item.Name = &resultName
+		item.Changed = resp.Changed
+	}
+
+	return item
+}
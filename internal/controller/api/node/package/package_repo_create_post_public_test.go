@@ -0,0 +1,549 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package packageapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	apipackage "github.com/osapi-io/osapi/internal/controller/api/node/package"
+	"github.com/osapi-io/osapi/internal/controller/api/node/package/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type PackageRepoCreatePostPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *apipackage.Package
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *PackageRepoCreatePostPublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *PackageRepoCreatePostPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = apipackage.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *PackageRepoCreatePostPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *PackageRepoCreatePostPublicTestSuite) TestPostNodePackageRepo() {
+	changeBool := true
+	keyObject := "docker.asc"
+	tests := []struct {
+		name         string
+		request      gen.PostNodePackageRepoRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.PostNodePackageRepoResponseObject)
+	}{
+		{
+			name: "success with signing key",
+			request: gen.PostNodePackageRepoRequestObject{
+				Hostname: "server1",
+				Body: &gen.PackageRepoCreateRequest{
+					Name:      "docker",
+					Object:    "docker.sources",
+					KeyObject: &keyObject,
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationPackageRepoCreate,
+						map[string]string{
+							"name":       "docker",
+							"object":     "docker.sources",
+							"key_object": "docker.asc",
+						},
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Changed:  &changeBool,
+						Data:     json.RawMessage(`{"name":"docker","changed":true}`),
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodePackageRepoResponseObject) {
+				r, ok := resp.(gen.PostNodePackageRepo200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("agent1", r.Results[0].Hostname)
+				s.Equal(gen.PackageRepoMutationResultStatusOk, r.Results[0].Status)
+				s.Equal("docker", *r.Results[0].Name)
+				s.True(*r.Results[0].Changed)
+			},
+		},
+		{
+			name: "success without signing key",
+			request: gen.PostNodePackageRepoRequestObject{
+				Hostname: "server1",
+				Body: &gen.PackageRepoCreateRequest{
+					Name:   "local",
+					Object: "local.sources",
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationPackageRepoCreate,
+						map[string]string{
+							"name":   "local",
+							"object": "local.sources",
+						},
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Changed:  &changeBool,
+						Data:     json.RawMessage(`{"name":"local","changed":true}`),
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodePackageRepoResponseObject) {
+				r, ok := resp.(gen.PostNodePackageRepo200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal("local", *r.Results[0].Name)
+			},
+		},
+		{
+			name: "validation error missing object",
+			request: gen.PostNodePackageRepoRequestObject{
+				Hostname: "server1",
+				Body: &gen.PackageRepoCreateRequest{
+					Name: "docker",
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodePackageRepoResponseObject) {
+				r, ok := resp.(gen.PostNodePackageRepo400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "Object")
+			},
+		},
+		{
+			name: "validation error empty hostname",
+			request: gen.PostNodePackageRepoRequestObject{
+				Hostname: "",
+				Body: &gen.PackageRepoCreateRequest{
+					Name:   "docker",
+					Object: "docker.sources",
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodePackageRepoResponseObject) {
+				r, ok := resp.(gen.PostNodePackageRepo400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "required")
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.PostNodePackageRepoRequestObject{
+				Hostname: "server1",
+				Body: &gen.PackageRepoCreateRequest{
+					Name:   "docker",
+					Object: "docker.sources",
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationPackageRepoCreate,
+						gomock.Any(),
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							Status:   job.StatusSkipped,
+							Hostname: "server1",
+							Error:    "package: operation not supported on this OS family",
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PostNodePackageRepoResponseObject) {
+				r, ok := resp.(gen.PostNodePackageRepo200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.PackageRepoMutationResultStatusSkipped, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+			},
+		},
+		{
+			name: "job client error",
+			request: gen.PostNodePackageRepoRequestObject{
+				Hostname: "server1",
+				Body: &gen.PackageRepoCreateRequest{
+					Name:   "docker",
+					Object: "docker.sources",
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationPackageRepoCreate,
+						gomock.Any(),
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PostNodePackageRepoResponseObject) {
+				_, ok := resp.(gen.PostNodePackageRepo500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "broadcast target _all includes failed and skipped agents",
+			request: gen.PostNodePackageRepoRequestObject{
+				Hostname: "_all",
+				Body: &gen.PackageRepoCreateRequest{
+					Name:   "docker",
+					Object: "docker.sources",
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationPackageRepoCreate,
+						map[string]string{
+							"name":   "docker",
+							"object": "docker.sources",
+						},
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						map[string]*job.Response{
+							"server1": {
+								Hostname: "server1",
+								Status:   job.StatusCompleted,
+								Changed:  &changeBool,
+								Data:     json.RawMessage(`{"name":"docker","changed":true}`),
+							},
+							"server2": {
+								Status:   job.StatusFailed,
+								Error:    "apt-get update: NO_PUBKEY",
+								Hostname: "server2",
+							},
+							"server3": {
+								Status:   job.StatusSkipped,
+								Error:    "package: operation not supported on this OS family",
+								Hostname: "server3",
+							},
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PostNodePackageRepoResponseObject) {
+				r, ok := resp.(gen.PostNodePackageRepo200JSONResponse)
+				s.True(ok)
+				s.Len(r.Results, 3)
+
+				byHost := make(map[string]*gen.PackageRepoMutationResult)
+				for i := range r.Results {
+					byHost[r.Results[i].Hostname] = &r.Results[i]
+				}
+
+				s.Equal(gen.PackageRepoMutationResultStatusOk, byHost["server1"].Status)
+				s.Equal(gen.PackageRepoMutationResultStatusFailed, byHost["server2"].Status)
+				s.Equal(gen.PackageRepoMutationResultStatusSkipped, byHost["server3"].Status)
+			},
+		},
+		{
+			name: "broadcast job client error",
+			request: gen.PostNodePackageRepoRequestObject{
+				Hostname: "_all",
+				Body: &gen.PackageRepoCreateRequest{
+					Name:   "docker",
+					Object: "docker.sources",
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationPackageRepoCreate,
+						gomock.Any(),
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PostNodePackageRepoResponseObject) {
+				_, ok := resp.(gen.PostNodePackageRepo500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			resp, err := s.handler.PostNodePackageRepo(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+func (s *PackageRepoCreatePostPublicTestSuite) TestPostNodePackageRepoValidationHTTP() {
+	tests := []struct {
+		name         string
+		path         string
+		body         string
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when valid request",
+			path: "/api/node/server1/package/repo",
+			body: `{"name":"docker","object":"docker.sources","key_object":"docker.asc"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				changeBool := true
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationPackageRepoCreate,
+						map[string]string{
+							"name":       "docker",
+							"object":     "docker.sources",
+							"key_object": "docker.asc",
+						}).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Changed:  &changeBool,
+						Data:     json.RawMessage(`{"name":"docker","changed":true}`),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+		{
+			name: "when name missing returns 400",
+			path: "/api/node/server1/package/repo",
+			body: `{"object":"docker.sources"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`},
+		},
+		{
+			name: "when target agent not found",
+			path: "/api/node/nonexistent/package/repo",
+			body: `{"name":"docker","object":"docker.sources"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "valid_target"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			packageHandler := apipackage.New(s.logger, jobMock)
+			strictHandler := gen.NewStrictHandler(packageHandler, nil)
+
+			a := api.New(s.appConfig, s.logger)
+			gen.RegisterHandlers(a.Echo, strictHandler)
+
+			req := httptest.NewRequest(http.MethodPost, tc.path,
+				bytes.NewBufferString(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			a.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+const rbacPackageRepoCreateTestSigningKey = "test-signing-key-for-rbac-package-repo-create"
+
+func (s *PackageRepoCreatePostPublicTestSuite) TestPostNodePackageRepoRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when no token returns 401",
+			setupAuth: func(_ *http.Request) {
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusUnauthorized,
+			wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacPackageRepoCreateTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"package:read"},
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid admin token returns 200",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacPackageRepoCreateTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				changeBool := true
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationPackageRepoCreate,
+						map[string]string{"name": "docker", "object": "docker.sources"}).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Changed:  &changeBool,
+						Data:     json.RawMessage(`{"name":"docker","changed":true}`),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{
+							SigningKey: rbacPackageRepoCreateTestSigningKey,
+						},
+					},
+				},
+			}
+
+			server := api.New(appConfig, s.logger)
+			handlers := apipackage.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/api/node/server1/package/repo",
+				bytes.NewBufferString(`{"name":"docker","object":"docker.sources"}`),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+
+			server.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestPackageRepoCreatePostPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(PackageRepoCreatePostPublicTestSuite))
+}
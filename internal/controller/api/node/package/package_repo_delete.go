@@ -0,0 +1,95 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package packageapi
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/package/gen"
+	"github.com/osapi-io/osapi/internal/job"
+)
+
+// DeleteNodePackageRepo removes a managed package repository from a
+// target node.
+func (p *Package) DeleteNodePackageRepo(
+	ctx context.Context,
+	request gen.DeleteNodePackageRepoRequestObject,
+) (gen.DeleteNodePackageRepoResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.DeleteNodePackageRepo400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+	name := request.Name
+	data := map[string]string{"name": name}
+
+	p.logger.Debug(
+		"package repo delete",
+		slog.String("target", hostname),
+		slog.String("name", name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		jobID, responses, err := p.JobClient.ModifyBroadcast(
+			ctx,
+			hostname,
+			"node",
+			job.OperationPackageRepoDelete,
+			data,
+		)
+		if err != nil {
+			errMsg := err.Error()
+			return gen.DeleteNodePackageRepo500JSONResponse{Error: &errMsg}, nil
+		}
+
+		jobUUID := uuid.MustParse(jobID)
+
+		return gen.DeleteNodePackageRepo200JSONResponse{
+			JobId:   &jobUUID,
+			Results: responsesToPackageRepoMutationResults(responses),
+		}, nil
+	}
+
+	jobID, resp, err := p.JobClient.Modify(
+		ctx,
+		hostname,
+		"node",
+		job.OperationPackageRepoDelete,
+		data,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.DeleteNodePackageRepo500JSONResponse{Error: &errMsg}, nil
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.DeleteNodePackageRepo200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.PackageRepoMutationResult{
+			responseToPackageRepoMutationResult(resp.Hostname, resp),
+		},
+	}, nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package packageapi
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/package/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	aptProv "github.com/osapi-io/osapi/internal/provider/node/apt"
+)
+
+// GetNodePackageRepoByName gets a single managed package repository on a
+// target node.
+func (p *Package) GetNodePackageRepoByName(
+	ctx context.Context,
+	request gen.GetNodePackageRepoByNameRequestObject,
+) (gen.GetNodePackageRepoByNameResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodePackageRepoByName400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+	name := request.Name
+
+	p.logger.Debug(
+		"package repo get",
+		slog.String("target", hostname),
+		slog.String("name", name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return p.getNodePackageRepoByNameBroadcast(ctx, hostname, name)
+	}
+
+	jobID, resp, err := p.JobClient.Query(
+		ctx,
+		hostname,
+		"node",
+		job.OperationPackageRepoGet,
+		map[string]string{"name": name},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "not found") {
+			return gen.GetNodePackageRepoByName404JSONResponse{Error: &errMsg}, nil
+		}
+		return gen.GetNodePackageRepoByName500JSONResponse{Error: &errMsg}, nil
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodePackageRepoByName200JSONResponse{
+		JobId:   &jobUUID,
+		Results: []gen.PackageRepoEntry{responseToPackageRepoEntry(resp.Hostname, resp, decodeRepo)},
+	}, nil
+}
+
+// getNodePackageRepoByNameBroadcast handles broadcast targets for repo get.
+func (p *Package) getNodePackageRepoByNameBroadcast(
+	ctx context.Context,
+	target string,
+	name string,
+) (gen.GetNodePackageRepoByNameResponseObject, error) {
+	jobID, responses, err := p.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationPackageRepoGet,
+		map[string]string{"name": name},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodePackageRepoByName500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.PackageRepoEntry, 0)
+	for host, resp := range responses {
+		allResults = append(allResults, responseToPackageRepoEntry(host, resp, decodeRepo))
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodePackageRepoByName200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
+
+// decodeRepo reads a single repository from job response data.
+func decodeRepo(
+	data json.RawMessage,
+) []aptProv.Repo {
+	if data == nil {
+		return nil
+	}
+
+	var repo aptProv.Repo
+	if err := json.Unmarshal(data, &repo); err != nil {
+		return nil
+	}
+
+	return []aptProv.Repo{repo}
+}
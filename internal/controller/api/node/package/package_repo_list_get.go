@@ -0,0 +1,171 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package packageapi
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/package/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	aptProv "github.com/osapi-io/osapi/internal/provider/node/apt"
+)
+
+// GetNodePackageRepo lists managed package repositories on a target node.
+func (p *Package) GetNodePackageRepo(
+	ctx context.Context,
+	request gen.GetNodePackageRepoRequestObject,
+) (gen.GetNodePackageRepoResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodePackageRepo400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+
+	p.logger.Debug(
+		"package repo list",
+		slog.String("target", hostname),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return p.getNodePackageRepoBroadcast(ctx, hostname)
+	}
+
+	jobID, resp, err := p.JobClient.Query(ctx, hostname, "node", job.OperationPackageRepoList, nil)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodePackageRepo500JSONResponse{Error: &errMsg}, nil
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodePackageRepo200JSONResponse{
+		JobId:   &jobUUID,
+		Results: []gen.PackageRepoEntry{responseToPackageRepoEntry(resp.Hostname, resp, decodeRepoList)},
+	}, nil
+}
+
+// getNodePackageRepoBroadcast handles broadcast targets for repo list.
+func (p *Package) getNodePackageRepoBroadcast(
+	ctx context.Context,
+	target string,
+) (gen.GetNodePackageRepoResponseObject, error) {
+	jobID, responses, err := p.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationPackageRepoList,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodePackageRepo500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.PackageRepoEntry, 0)
+	for host, resp := range responses {
+		allResults = append(allResults, responseToPackageRepoEntry(host, resp, decodeRepoList))
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodePackageRepo200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
+
+// responseToPackageRepoEntry converts a job response to a gen
+// PackageRepoEntry, using decode to read the repositories from the
+// response data.
+func responseToPackageRepoEntry(
+	host string,
+	resp *job.Response,
+	decode func(json.RawMessage) []aptProv.Repo,
+) gen.PackageRepoEntry {
+	entry := gen.PackageRepoEntry{
+		Hostname: host,
+	}
+
+	switch resp.Status {
+	case job.StatusFailed:
+		e := resp.Error
+		entry.Status = gen.PackageRepoEntryStatusFailed
+		entry.Error = &e
+		return entry
+	case job.StatusSkipped:
+		e := resp.Error
+		entry.Status = gen.PackageRepoEntryStatusSkipped
+		entry.Error = &e
+		return entry
+	}
+
+	entry.Status = gen.PackageRepoEntryStatusOk
+
+	repos := decode(resp.Data)
+	infos := make([]gen.PackageRepoInfo, 0, len(repos))
+	for _, r := range repos {
+		infos = append(infos, repoToInfo(r))
+	}
+	entry.Repos = &infos
+
+	return entry
+}
+
+// decodeRepoList reads a list of repositories from job response data.
+func decodeRepoList(
+	data json.RawMessage,
+) []aptProv.Repo {
+	var repos []aptProv.Repo
+	if data != nil {
+		_ = json.Unmarshal(data, &repos)
+	}
+
+	return repos
+}
+
+// repoToInfo converts a provider Repo to its API representation.
+func repoToInfo(
+	r aptProv.Repo,
+) gen.PackageRepoInfo {
+	name := r.Name
+	object := r.Object
+	path := r.Path
+	info := gen.PackageRepoInfo{
+		Name:   &name,
+		Object: &object,
+		Path:   &path,
+	}
+	if r.KeyObject != "" {
+		keyObject := r.KeyObject
+		info.KeyObject = &keyObject
+	}
+	if r.Keyring != "" {
+		keyring := r.Keyring
+		info.Keyring = &keyring
+	}
+
+	return info
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package packageapi
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/package/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// PutNodePackageRepo redeploys a managed package repository on a target
+// node.
+func (p *Package) PutNodePackageRepo(
+	ctx context.Context,
+	request gen.PutNodePackageRepoRequestObject,
+) (gen.PutNodePackageRepoResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.PutNodePackageRepo400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Body); !ok {
+		return gen.PutNodePackageRepo400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+	name := request.Name
+
+	data := map[string]string{"name": name}
+	if request.Body.Object != nil && *request.Body.Object != "" {
+		data["object"] = *request.Body.Object
+	}
+	if request.Body.KeyObject != nil && *request.Body.KeyObject != "" {
+		data["key_object"] = *request.Body.KeyObject
+	}
+
+	p.logger.Debug(
+		"package repo update",
+		slog.String("target", hostname),
+		slog.String("name", name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		jobID, responses, err := p.JobClient.ModifyBroadcast(
+			ctx,
+			hostname,
+			"node",
+			job.OperationPackageRepoUpdate,
+			data,
+		)
+		if err != nil {
+			errMsg := err.Error()
+			return gen.PutNodePackageRepo500JSONResponse{Error: &errMsg}, nil
+		}
+
+		jobUUID := uuid.MustParse(jobID)
+
+		return gen.PutNodePackageRepo200JSONResponse{
+			JobId:   &jobUUID,
+			Results: responsesToPackageRepoMutationResults(responses),
+		}, nil
+	}
+
+	jobID, resp, err := p.JobClient.Modify(
+		ctx,
+		hostname,
+		"node",
+		job.OperationPackageRepoUpdate,
+		data,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "does not exist") {
+			return gen.PutNodePackageRepo404JSONResponse{Error: &errMsg}, nil
+		}
+		return gen.PutNodePackageRepo500JSONResponse{Error: &errMsg}, nil
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.PutNodePackageRepo200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.PackageRepoMutationResult{
+			responseToPackageRepoMutationResult(resp.Hostname, resp),
+		},
+	}, nil
+}
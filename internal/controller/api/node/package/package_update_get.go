@@ -73,7 +73,7 @@ func (p *Package) GetNodePackageUpdate(
 			Results: []gen.UpdateEntry{
 				{
 					Hostname: resp.Hostname,
-					Status:   gen.Skipped,
+					Status:   gen.UpdateEntryStatusSkipped,
 					Error:    &e,
 				},
 			},
@@ -114,7 +114,7 @@ func (p *Package) getNodePackageUpdateBroadcast(
 			h := host
 			allResults = append(allResults, gen.UpdateEntry{
 				Hostname: h,
-				Status:   gen.Failed,
+				Status:   gen.UpdateEntryStatusFailed,
 				Error:    &e,
 			})
 		case job.StatusSkipped:
@@ -122,7 +122,7 @@ func (p *Package) getNodePackageUpdateBroadcast(
 			h := host
 			allResults = append(allResults, gen.UpdateEntry{
 				Hostname: h,
-				Status:   gen.Skipped,
+				Status:   gen.UpdateEntryStatusSkipped,
 				Error:    &e,
 			})
 		default:
@@ -164,7 +164,7 @@ func responseToUpdateEntries(
 	return []gen.UpdateEntry{
 		{
 			Hostname: hostname,
-			Status:   gen.Ok,
+			Status:   gen.UpdateEntryStatusOk,
 			Updates:  &updateInfos,
 		},
 	}
@@ -111,7 +111,7 @@ func (s *PackageUpdateGetPublicTestSuite) TestGetNodePackageUpdate() {
 				s.Require().NotNil(r.JobId)
 				s.Require().Len(r.Results, 1)
 				s.Equal("agent1", r.Results[0].Hostname)
-				s.Equal(gen.Ok, r.Results[0].Status)
+				s.Equal(gen.UpdateEntryStatusOk, r.Results[0].Status)
 				s.Require().NotNil(r.Results[0].Updates)
 				s.Require().Len(*r.Results[0].Updates, 1)
 				s.Equal("curl", *(*r.Results[0].Updates)[0].Name)
@@ -188,7 +188,7 @@ func (s *PackageUpdateGetPublicTestSuite) TestGetNodePackageUpdate() {
 				r, ok := resp.(gen.GetNodePackageUpdate200JSONResponse)
 				s.True(ok)
 				s.Require().Len(r.Results, 1)
-				s.Equal(gen.Skipped, r.Results[0].Status)
+				s.Equal(gen.UpdateEntryStatusSkipped, r.Results[0].Status)
 			},
 		},
 		{
@@ -261,13 +261,13 @@ func (s *PackageUpdateGetPublicTestSuite) TestGetNodePackageUpdate() {
 				}
 
 				s.Require().Contains(byHost, "server1")
-				s.Equal(gen.Ok, byHost["server1"].Status)
+				s.Equal(gen.UpdateEntryStatusOk, byHost["server1"].Status)
 
 				s.Require().Contains(byHost, "server2")
-				s.Equal(gen.Failed, byHost["server2"].Status)
+				s.Equal(gen.UpdateEntryStatusFailed, byHost["server2"].Status)
 
 				s.Require().Contains(byHost, "server3")
-				s.Equal(gen.Skipped, byHost["server3"].Status)
+				s.Equal(gen.UpdateEntryStatusSkipped, byHost["server3"].Status)
 			},
 		},
 		{
@@ -34,6 +34,23 @@ const (
 	ProcessGetEntryStatusSkipped ProcessGetEntryStatus = "skipped"
 )
 
+// Defines values for ProcessSignalMatchRequestSignal.
+const (
+	ProcessSignalMatchRequestSignalHUP  ProcessSignalMatchRequestSignal = "HUP"
+	ProcessSignalMatchRequestSignalINT  ProcessSignalMatchRequestSignal = "INT"
+	ProcessSignalMatchRequestSignalKILL ProcessSignalMatchRequestSignal = "KILL"
+	ProcessSignalMatchRequestSignalTERM ProcessSignalMatchRequestSignal = "TERM"
+	ProcessSignalMatchRequestSignalUSR1 ProcessSignalMatchRequestSignal = "USR1"
+	ProcessSignalMatchRequestSignalUSR2 ProcessSignalMatchRequestSignal = "USR2"
+)
+
+// Defines values for ProcessSignalMatchResultStatus.
+const (
+	ProcessSignalMatchResultStatusFailed  ProcessSignalMatchResultStatus = "failed"
+	ProcessSignalMatchResultStatusOk      ProcessSignalMatchResultStatus = "ok"
+	ProcessSignalMatchResultStatusSkipped ProcessSignalMatchResultStatus = "skipped"
+)
+
 // Defines values for ProcessSignalRequestSignal.
 const (
 	HUP  ProcessSignalRequestSignal = "HUP"
@@ -46,9 +63,16 @@ const (
 
 // Defines values for ProcessSignalResultStatus.
 const (
-	Failed  ProcessSignalResultStatus = "failed"
-	Ok      ProcessSignalResultStatus = "ok"
-	Skipped ProcessSignalResultStatus = "skipped"
+	ProcessSignalResultStatusFailed  ProcessSignalResultStatus = "failed"
+	ProcessSignalResultStatusOk      ProcessSignalResultStatus = "ok"
+	ProcessSignalResultStatusSkipped ProcessSignalResultStatus = "skipped"
+)
+
+// Defines values for ProcessTreeEntryStatus.
+const (
+	Failed  ProcessTreeEntryStatus = "failed"
+	Ok      ProcessTreeEntryStatus = "ok"
+	Skipped ProcessTreeEntryStatus = "skipped"
 )
 
 // ErrorResponse defines model for ErrorResponse.
@@ -106,12 +130,27 @@ type ProcessGetResponse struct {
 
 // ProcessInfo Information about a running process.
 type ProcessInfo struct {
+	// Cgroup Control group path. Only set by get.
+	Cgroup *string `json:"cgroup,omitempty"`
+
+	// Children PIDs of direct child processes. Only set by get.
+	Children *[]int `json:"children,omitempty"`
+
 	// Command Full command line.
 	Command *string `json:"command,omitempty"`
 
 	// CpuPercent CPU usage percentage.
 	CpuPercent *float64 `json:"cpu_percent,omitempty"`
 
+	// EnvKeys Environment variable names. Values are never returned. Only set by get.
+	EnvKeys *[]string `json:"env_keys,omitempty"`
+
+	// FdCount Number of open file descriptors. Only set by get.
+	FdCount *int `json:"fd_count,omitempty"`
+
+	// Limits Resource limits from /proc/<pid>/limits. Only set by get.
+	Limits *[]ProcessLimit `json:"limits,omitempty"`
+
 	// MemPercent Memory usage percentage.
 	MemPercent *float32 `json:"mem_percent,omitempty"`
 
@@ -121,19 +160,122 @@ type ProcessInfo struct {
 	// Name Process name.
 	Name *string `json:"name,omitempty"`
 
+	// OpenFiles Sample of open files, capped at 20 entries. Only set by get.
+	OpenFiles *[]ProcessOpenFile `json:"open_files,omitempty"`
+
 	// Pid Process identifier.
 	Pid *int `json:"pid,omitempty"`
 
+	// Ppid Parent process identifier.
+	Ppid *int `json:"ppid,omitempty"`
+
 	// StartTime Process start time.
 	StartTime *string `json:"start_time,omitempty"`
 
 	// State Process state.
 	State *string `json:"state,omitempty"`
 
+	// Threads Number of threads. Only set by get.
+	Threads *int `json:"threads,omitempty"`
+
 	// User User running the process.
 	User *string `json:"user,omitempty"`
 }
 
+// ProcessLimit A resource limit applied to a process.
+type ProcessLimit struct {
+	// Hard Hard limit, or "unlimited".
+	Hard string `json:"hard"`
+
+	// Name Limit name as reported by the kernel.
+	Name string `json:"name"`
+
+	// Soft Soft limit, or "unlimited".
+	Soft string `json:"soft"`
+
+	// Units Units of the limit, if any.
+	Units *string `json:"units,omitempty"`
+}
+
+// ProcessOpenFile A file descriptor held open by a process.
+type ProcessOpenFile struct {
+	// Fd File descriptor number.
+	Fd int `json:"fd"`
+
+	// Path Path the descriptor refers to.
+	Path string `json:"path"`
+}
+
+// ProcessSignalFailure A matching process that could not be signalled.
+type ProcessSignalFailure struct {
+	// Error Why the signal was not delivered.
+	Error string `json:"error"`
+
+	// Pid Process identifier.
+	Pid int `json:"pid"`
+}
+
+// ProcessSignalMatchRequest Selects processes to signal. At least one of name, cmdline, user or ppid is required.
+type ProcessSignalMatchRequest struct {
+	// Cmdline Regular expression (RE2 syntax) matched against the full command line.
+	Cmdline *string `json:"cmdline,omitempty" validate:"omitempty,min=1"`
+
+	// DryRun Report the matching PIDs without signalling them.
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// Name Exact process name to match.
+	Name *string `json:"name,omitempty" validate:"omitempty,min=1"`
+
+	// Ppid Only match direct children of this process.
+	Ppid *int `json:"ppid,omitempty" validate:"omitempty,min=1"`
+
+	// Signal Signal name to send to the matching processes.
+	Signal ProcessSignalMatchRequestSignal `json:"signal" validate:"required,oneof=TERM KILL HUP INT USR1 USR2"`
+
+	// User Exact process owner to match.
+	User *string `json:"user,omitempty" validate:"omitempty,min=1"`
+}
+
+// ProcessSignalMatchRequestSignal Signal name to send to the matching processes.
+type ProcessSignalMatchRequestSignal string
+
+// ProcessSignalMatchResponse defines model for ProcessSignalMatchResponse.
+type ProcessSignalMatchResponse struct {
+	// JobId The job ID used to process this request.
+	JobId   *openapi_types.UUID        `json:"job_id,omitempty"`
+	Results []ProcessSignalMatchResult `json:"results"`
+}
+
+// ProcessSignalMatchResult Result of signalling matching processes on a single agent.
+type ProcessSignalMatchResult struct {
+	// Changed Whether the operation modified system state.
+	Changed *bool `json:"changed,omitempty"`
+
+	// DryRun Whether this was a dry run.
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// Error Error message if the agent failed.
+	Error *string `json:"error,omitempty"`
+
+	// Failed Matching processes the signal could not be delivered to.
+	Failed *[]ProcessSignalFailure `json:"failed,omitempty"`
+
+	// Hostname The hostname of the agent.
+	Hostname string `json:"hostname"`
+
+	// Pids PIDs that were signalled, or that would have been in a dry run.
+	Pids *[]int `json:"pids,omitempty"`
+
+	// Signal Signal that was sent.
+	Signal *string `json:"signal,omitempty"`
+
+	// Status The status of the operation for this host.
+	Status ProcessSignalMatchResultStatus `json:"status"`
+}
+
+// ProcessSignalMatchResultStatus The status of the operation for this host.
+type ProcessSignalMatchResultStatus string
+
 // ProcessSignalRequest defines model for ProcessSignalRequest.
 type ProcessSignalRequest struct {
 	// Signal Signal name to send to the process.
@@ -174,12 +316,67 @@ type ProcessSignalResult struct {
 // ProcessSignalResultStatus The status of the operation for this host.
 type ProcessSignalResultStatus string
 
+// ProcessTreeEntry Process tree result for a single agent.
+type ProcessTreeEntry struct {
+	// Error Error message if the agent failed.
+	Error *string `json:"error,omitempty"`
+
+	// Hostname The hostname of the agent.
+	Hostname string `json:"hostname"`
+
+	// Processes Root processes of the tree on this agent.
+	Processes *[]ProcessTreeNode `json:"processes,omitempty"`
+
+	// Status The status of the operation for this host.
+	Status ProcessTreeEntryStatus `json:"status"`
+}
+
+// ProcessTreeEntryStatus The status of the operation for this host.
+type ProcessTreeEntryStatus string
+
+// ProcessTreeNode A process and its descendants.
+type ProcessTreeNode struct {
+	// Children Child processes.
+	Children *[]ProcessTreeNode `json:"children,omitempty"`
+
+	// Command Full command line.
+	Command *string `json:"command,omitempty"`
+
+	// Name Process name.
+	Name string `json:"name"`
+
+	// Pid Process identifier.
+	Pid int `json:"pid"`
+
+	// Ppid Parent process identifier.
+	Ppid int `json:"ppid"`
+
+	// User User running the process.
+	User *string `json:"user,omitempty"`
+}
+
+// ProcessTreeResponse defines model for ProcessTreeResponse.
+type ProcessTreeResponse struct {
+	// JobId The job ID used to process this request.
+	JobId   *openapi_types.UUID `json:"job_id,omitempty"`
+	Results []ProcessTreeEntry  `json:"results"`
+}
+
 // Hostname defines model for Hostname.
 type Hostname = string
 
 // Pid defines model for Pid.
 type Pid = int
 
+// GetNodeProcessTreeParams defines parameters for GetNodeProcessTree.
+type GetNodeProcessTreeParams struct {
+	// Pid Root the tree at this process instead of returning every root.
+	Pid *int `form:"pid,omitempty" json:"pid,omitempty" validate:"omitempty,min=1"`
+}
+
+// PostNodeProcessSignalMatchJSONRequestBody defines body for PostNodeProcessSignalMatch for application/json ContentType.
+type PostNodeProcessSignalMatchJSONRequestBody = ProcessSignalMatchRequest
+
 // PostNodeProcessSignalJSONRequestBody defines body for PostNodeProcessSignal for application/json ContentType.
 type PostNodeProcessSignalJSONRequestBody = ProcessSignalRequest
 
@@ -188,6 +385,12 @@ type ServerInterface interface {
 	// List processes
 	// (GET /api/node/{hostname}/process)
 	GetNodeProcess(ctx echo.Context, hostname Hostname) error
+	// Send signal to matching processes
+	// (POST /api/node/{hostname}/process/signal)
+	PostNodeProcessSignalMatch(ctx echo.Context, hostname Hostname) error
+	// Get process tree
+	// (GET /api/node/{hostname}/process/tree)
+	GetNodeProcessTree(ctx echo.Context, hostname Hostname, params GetNodeProcessTreeParams) error
 	// Get process by PID
 	// (GET /api/node/{hostname}/process/{pid})
 	GetNodeProcessByPid(ctx echo.Context, hostname Hostname, pid Pid) error
@@ -219,6 +422,51 @@ func (w *ServerInterfaceWrapper) GetNodeProcess(ctx echo.Context) error {
 	return err
 }
 
+// PostNodeProcessSignalMatch converts echo context to params.
+func (w *ServerInterfaceWrapper) PostNodeProcessSignalMatch(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"process:execute"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostNodeProcessSignalMatch(ctx, hostname)
+	return err
+}
+
+// GetNodeProcessTree converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeProcessTree(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"process:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodeProcessTreeParams
+	// ------------- Optional query parameter "pid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pid", ctx.QueryParams(), &params.Pid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeProcessTree(ctx, hostname, params)
+	return err
+}
+
 // GetNodeProcessByPid converts echo context to params.
 func (w *ServerInterfaceWrapper) GetNodeProcessByPid(ctx echo.Context) error {
 	var err error
@@ -300,6 +548,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.GET(baseURL+"/api/node/:hostname/process", wrapper.GetNodeProcess)
+	router.POST(baseURL+"/api/node/:hostname/process/signal", wrapper.PostNodeProcessSignalMatch)
+	router.GET(baseURL+"/api/node/:hostname/process/tree", wrapper.GetNodeProcessTree)
 	router.GET(baseURL+"/api/node/:hostname/process/:pid", wrapper.GetNodeProcessByPid)
 	router.POST(baseURL+"/api/node/:hostname/process/:pid/signal", wrapper.PostNodeProcessSignal)
 
@@ -358,6 +608,123 @@ func (response GetNodeProcess500JSONResponse) VisitGetNodeProcessResponse(w http
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PostNodeProcessSignalMatchRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+	Body     *PostNodeProcessSignalMatchJSONRequestBody
+}
+
+type PostNodeProcessSignalMatchResponseObject interface {
+	VisitPostNodeProcessSignalMatchResponse(w http.ResponseWriter) error
+}
+
+type PostNodeProcessSignalMatch200JSONResponse ProcessSignalMatchResponse
+
+func (response PostNodeProcessSignalMatch200JSONResponse) VisitPostNodeProcessSignalMatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeProcessSignalMatch400JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeProcessSignalMatch400JSONResponse) VisitPostNodeProcessSignalMatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeProcessSignalMatch401JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeProcessSignalMatch401JSONResponse) VisitPostNodeProcessSignalMatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeProcessSignalMatch403JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeProcessSignalMatch403JSONResponse) VisitPostNodeProcessSignalMatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeProcessSignalMatch500JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeProcessSignalMatch500JSONResponse) VisitPostNodeProcessSignalMatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeProcessTreeRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+	Params   GetNodeProcessTreeParams
+}
+
+type GetNodeProcessTreeResponseObject interface {
+	VisitGetNodeProcessTreeResponse(w http.ResponseWriter) error
+}
+
+type GetNodeProcessTree200JSONResponse ProcessTreeResponse
+
+func (response GetNodeProcessTree200JSONResponse) VisitGetNodeProcessTreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeProcessTree400JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeProcessTree400JSONResponse) VisitGetNodeProcessTreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeProcessTree401JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeProcessTree401JSONResponse) VisitGetNodeProcessTreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeProcessTree403JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeProcessTree403JSONResponse) VisitGetNodeProcessTreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeProcessTree404JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeProcessTree404JSONResponse) VisitGetNodeProcessTreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeProcessTree500JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeProcessTree500JSONResponse) VisitGetNodeProcessTreeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetNodeProcessByPidRequestObject struct {
 	Hostname Hostname `json:"hostname"`
 	Pid      Pid      `json:"pid"`
@@ -490,6 +857,12 @@ type StrictServerInterface interface {
 	// List processes
 	// (GET /api/node/{hostname}/process)
 	GetNodeProcess(ctx context.Context, request GetNodeProcessRequestObject) (GetNodeProcessResponseObject, error)
+	// Send signal to matching processes
+	// (POST /api/node/{hostname}/process/signal)
+	PostNodeProcessSignalMatch(ctx context.Context, request PostNodeProcessSignalMatchRequestObject) (PostNodeProcessSignalMatchResponseObject, error)
+	// Get process tree
+	// (GET /api/node/{hostname}/process/tree)
+	GetNodeProcessTree(ctx context.Context, request GetNodeProcessTreeRequestObject) (GetNodeProcessTreeResponseObject, error)
 	// Get process by PID
 	// (GET /api/node/{hostname}/process/{pid})
 	GetNodeProcessByPid(ctx context.Context, request GetNodeProcessByPidRequestObject) (GetNodeProcessByPidResponseObject, error)
@@ -535,6 +908,63 @@ func (sh *strictHandler) GetNodeProcess(ctx echo.Context, hostname Hostname) err
 	return nil
 }
 
+// PostNodeProcessSignalMatch operation middleware
+func (sh *strictHandler) PostNodeProcessSignalMatch(ctx echo.Context, hostname Hostname) error {
+	var request PostNodeProcessSignalMatchRequestObject
+
+	request.Hostname = hostname
+
+	var body PostNodeProcessSignalMatchJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostNodeProcessSignalMatch(ctx.Request().Context(), request.(PostNodeProcessSignalMatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostNodeProcessSignalMatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostNodeProcessSignalMatchResponseObject); ok {
+		return validResponse.VisitPostNodeProcessSignalMatchResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetNodeProcessTree operation middleware
+func (sh *strictHandler) GetNodeProcessTree(ctx echo.Context, hostname Hostname, params GetNodeProcessTreeParams) error {
+	var request GetNodeProcessTreeRequestObject
+
+	request.Hostname = hostname
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNodeProcessTree(ctx.Request().Context(), request.(GetNodeProcessTreeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNodeProcessTree")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetNodeProcessTreeResponseObject); ok {
+		return validResponse.VisitGetNodeProcessTreeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetNodeProcessByPid operation middleware
 func (sh *strictHandler) GetNodeProcessByPid(ctx echo.Context, hostname Hostname, pid Pid) error {
 	var request GetNodeProcessByPidRequestObject
@@ -118,6 +118,50 @@ func (s *ProcessGetPublicTestSuite) TestGetNodeProcessByPid() {
 				s.Equal("nginx", *r.Results[0].Process.Name)
 			},
 		},
+		{
+			name: "success with process details",
+			request: gen.GetNodeProcessByPidRequestObject{
+				Hostname: "server1",
+				Pid:      1234,
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationProcessGet,
+						map[string]int{"pid": 1234},
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID:    "550e8400-e29b-41d4-a716-446655440000",
+						Hostname: "agent1",
+						Data: json.RawMessage(
+							`{"pid":1234,"ppid":1,"name":"nginx","children":[1235,1236],"threads":4,"fd_count":42,"open_files":[{"fd":3,"path":"/var/log/nginx/access.log"}],"cgroup":"/system.slice/nginx.service","env_keys":["HOME","PATH"],"limits":[{"name":"Max open files","soft":"1024","hard":"524288","units":"files"},{"name":"Max nice priority","soft":"0","hard":"0"}]}`,
+						),
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeProcessByPidResponseObject) {
+				r, ok := resp.(gen.GetNodeProcessByPid200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				p := r.Results[0].Process
+				s.Require().NotNil(p)
+				s.Equal(1, *p.Ppid)
+				s.Equal([]int{1235, 1236}, *p.Children)
+				s.Equal(4, *p.Threads)
+				s.Equal(42, *p.FdCount)
+				s.Equal([]gen.ProcessOpenFile{
+					{Fd: 3, Path: "/var/log/nginx/access.log"},
+				}, *p.OpenFiles)
+				s.Equal("/system.slice/nginx.service", *p.Cgroup)
+				s.Equal([]string{"HOME", "PATH"}, *p.EnvKeys)
+				s.Require().Len(*p.Limits, 2)
+				s.Equal("Max open files", (*p.Limits)[0].Name)
+				s.Equal("files", *(*p.Limits)[0].Units)
+				s.Nil((*p.Limits)[1].Units)
+			},
+		},
 		{
 			name: "validation error empty hostname",
 			request: gen.GetNodeProcessByPidRequestObject{
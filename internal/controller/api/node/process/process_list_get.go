@@ -120,8 +120,11 @@ func processInfoToGen(
 	command := info.Command
 	startTime := info.StartTime
 
-	return gen.ProcessInfo{
+	ppid := info.PPID
+
+	result := gen.ProcessInfo{
 		Pid:        &pid,
+		Ppid:       &ppid,
 		Name:       &name,
 		User:       &user,
 		State:      &state,
@@ -130,7 +133,56 @@ func processInfoToGen(
 		MemRss:     &memRSS,
 		Command:    &command,
 		StartTime:  stringPtrOrNil(startTime),
+		Cgroup:     stringPtrOrNil(info.Cgroup),
+	}
+
+	// Detail fields are only populated by get; leave them out of list
+	// responses rather than emitting zero values.
+	if len(info.Children) > 0 {
+		children := info.Children
+		result.Children = &children
+	}
+
+	if info.Threads > 0 {
+		threads := info.Threads
+		result.Threads = &threads
 	}
+
+	if info.FDCount > 0 {
+		fdCount := info.FDCount
+		result.FdCount = &fdCount
+	}
+
+	if len(info.OpenFiles) > 0 {
+		openFiles := make([]gen.ProcessOpenFile, 0, len(info.OpenFiles))
+		for _, f := range info.OpenFiles {
+			openFiles = append(openFiles, gen.ProcessOpenFile{
+				Fd:   f.FD,
+				Path: f.Path,
+			})
+		}
+		result.OpenFiles = &openFiles
+	}
+
+	if len(info.EnvKeys) > 0 {
+		envKeys := info.EnvKeys
+		result.EnvKeys = &envKeys
+	}
+
+	if len(info.Limits) > 0 {
+		limits := make([]gen.ProcessLimit, 0, len(info.Limits))
+		for _, l := range info.Limits {
+			limits = append(limits, gen.ProcessLimit{
+				Name:  l.Name,
+				Soft:  l.Soft,
+				Hard:  l.Hard,
+				Units: stringPtrOrNil(l.Units),
+			})
+		}
+		result.Limits = &limits
+	}
+
+	return result
 }
 
 // stringPtrOrNil returns nil if the string is empty, otherwise a pointer.
@@ -0,0 +1,240 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+package process
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"regexp"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/process/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	processProv "github.com/osapi-io/osapi/internal/provider/node/process"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// signalMatchData is the job payload for a signal-by-match request.
+type signalMatchData struct {
+	processProv.Match
+	Signal string `json:"signal"`
+	DryRun bool   `json:"dry_run"`
+}
+
+// PostNodeProcessSignalMatch sends a signal to every process on a target
+// node that matches the request filters.
+func (s *Process) PostNodeProcessSignalMatch(
+	ctx context.Context,
+	request gen.PostNodeProcessSignalMatchRequestObject,
+) (gen.PostNodeProcessSignalMatchResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.PostNodeProcessSignalMatch400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Body); !ok {
+		return gen.PostNodeProcessSignalMatch400JSONResponse{Error: &errMsg}, nil
+	}
+
+	data := signalMatchData{
+		Signal: string(request.Body.Signal),
+	}
+	if request.Body.Name != nil {
+		data.Name = *request.Body.Name
+	}
+	if request.Body.Cmdline != nil {
+		data.Cmdline = *request.Body.Cmdline
+	}
+	if request.Body.User != nil {
+		data.User = *request.Body.User
+	}
+	if request.Body.Ppid != nil {
+		data.PPID = *request.Body.Ppid
+	}
+	if request.Body.DryRun != nil {
+		data.DryRun = *request.Body.DryRun
+	}
+
+	if data.Match == (processProv.Match{}) {
+		errMsg := "at least one of name, cmdline, user or ppid is required"
+		return gen.PostNodeProcessSignalMatch400JSONResponse{Error: &errMsg}, nil
+	}
+
+	// Reject a bad pattern here rather than letting every agent fail on it.
+	if data.Cmdline != "" {
+		if _, err := regexp.Compile(data.Cmdline); err != nil {
+			errMsg := "invalid cmdline pattern: " + err.Error()
+			return gen.PostNodeProcessSignalMatch400JSONResponse{Error: &errMsg}, nil
+		}
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"process signal match",
+		slog.String("target", hostname),
+		slog.String("name", data.Name),
+		slog.String("cmdline", data.Cmdline),
+		slog.String("user", data.User),
+		slog.Int("ppid", data.PPID),
+		slog.String("signal", data.Signal),
+		slog.Bool("dry_run", data.DryRun),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.postNodeProcessSignalMatchBroadcast(ctx, hostname, data)
+	}
+
+	jobID, resp, err := s.JobClient.Modify(
+		ctx,
+		hostname,
+		"node",
+		job.OperationProcessSignalMatch,
+		data,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PostNodeProcessSignalMatch500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		jobUUID := uuid.MustParse(jobID)
+		e := resp.Error
+		return gen.PostNodeProcessSignalMatch200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.ProcessSignalMatchResult{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.ProcessSignalMatchResultStatusSkipped,
+					Signal:   &data.Signal,
+					DryRun:   &data.DryRun,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	if resp.Status == job.StatusFailed {
+		errMsg := resp.Error
+		return gen.PostNodeProcessSignalMatch500JSONResponse{Error: &errMsg}, nil
+	}
+
+	item := signalMatchResultToGen(resp.Hostname, resp.Data)
+	item.Changed = resp.Changed
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.PostNodeProcessSignalMatch200JSONResponse{
+		JobId:   &jobUUID,
+		Results: []gen.ProcessSignalMatchResult{item},
+	}, nil
+}
+
+// postNodeProcessSignalMatchBroadcast handles broadcast targets for
+// signal-by-match. Each agent resolves its own matches.
+func (s *Process) postNodeProcessSignalMatchBroadcast(
+	ctx context.Context,
+	target string,
+	data signalMatchData,
+) (gen.PostNodeProcessSignalMatchResponseObject, error) {
+	jobID, responses, err := s.JobClient.ModifyBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationProcessSignalMatch,
+		data,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PostNodeProcessSignalMatch500JSONResponse{Error: &errMsg}, nil
+	}
+
+	var items []gen.ProcessSignalMatchResult
+	for host, resp := range responses {
+		var item gen.ProcessSignalMatchResult
+		switch resp.Status {
+		case job.StatusFailed:
+			e := resp.Error
+			item = gen.ProcessSignalMatchResult{
+				Hostname: host,
+				Status:   gen.ProcessSignalMatchResultStatusFailed,
+				Error:    &e,
+			}
+		case job.StatusSkipped:
+			e := resp.Error
+			item = gen.ProcessSignalMatchResult{
+				Hostname: host,
+				Status:   gen.ProcessSignalMatchResultStatusSkipped,
+				Error:    &e,
+			}
+		default:
+			item = signalMatchResultToGen(host, resp.Data)
+			item.Changed = resp.Changed
+		}
+		items = append(items, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	return gen.PostNodeProcessSignalMatch200JSONResponse{
+		JobId:   &jobUUID,
+		Results: items,
+	}, nil
+}
+
+// signalMatchResultToGen converts an agent's signal-by-match result to the
+// API representation. PIDs is always set so clients can tell "matched
+// nothing" apart from a missing field.
+func signalMatchResultToGen(
+	hostname string,
+	data json.RawMessage,
+) gen.ProcessSignalMatchResult {
+	var result processProv.SignalMatchResult
+	if data != nil {
+		_ = json.Unmarshal(data, &result)
+	}
+
+	pids := result.PIDs
+	if pids == nil {
+		pids = []int{}
+	}
+
+	item := gen.ProcessSignalMatchResult{
+		Hostname: hostname,
+		Status:   gen.ProcessSignalMatchResultStatusOk,
+		Signal:   &result.Signal,
+		DryRun:   &result.DryRun,
+		Pids:     &pids,
+	}
+
+	if len(result.Failed) > 0 {
+		failed := make([]gen.ProcessSignalFailure, 0, len(result.Failed))
+		for _, f := range result.Failed {
+			failed = append(failed, gen.ProcessSignalFailure{
+				Pid:   f.PID,
+				Error: f.Error,
+			})
+		}
+		item.Failed = &failed
+	}
+
+	return item
+}
@@ -0,0 +1,748 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+package process_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	processAPI "github.com/osapi-io/osapi/internal/controller/api/node/process"
+	"github.com/osapi-io/osapi/internal/controller/api/node/process/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type ProcessSignalMatchPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *processAPI.Process
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *ProcessSignalMatchPublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *ProcessSignalMatchPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = processAPI.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *ProcessSignalMatchPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *ProcessSignalMatchPublicTestSuite) TestPostNodeProcessSignalMatch() {
+	tests := []struct {
+		name         string
+		request      gen.PostNodeProcessSignalMatchRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.PostNodeProcessSignalMatchResponseObject)
+	}{
+		{
+			name: "success",
+			request: gen.PostNodeProcessSignalMatchRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeProcessSignalMatchJSONRequestBody{
+					Signal: gen.ProcessSignalMatchRequestSignalHUP,
+					Name:   strPtr("nginx"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationProcessSignalMatch,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID:    "550e8400-e29b-41d4-a716-446655440000",
+						Hostname: "agent1",
+						Changed:  boolPtr(true),
+						Data: json.RawMessage(
+							`{"signal":"HUP","dry_run":false,"pids":[10,11],"changed":true}`,
+						),
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeProcessSignalMatchResponseObject) {
+				r, ok := resp.(gen.PostNodeProcessSignalMatch200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal("agent1", r.Results[0].Hostname)
+				s.Equal(gen.ProcessSignalMatchResultStatusOk, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Signal)
+				s.Equal("HUP", *r.Results[0].Signal)
+				s.Require().NotNil(r.Results[0].Pids)
+				s.Equal([]int{10, 11}, *r.Results[0].Pids)
+				s.Nil(r.Results[0].Failed)
+				s.Require().NotNil(r.Results[0].Changed)
+				s.True(*r.Results[0].Changed)
+			},
+		},
+		{
+			name: "success with dry run",
+			request: gen.PostNodeProcessSignalMatchRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeProcessSignalMatchJSONRequestBody{
+					Signal:  gen.ProcessSignalMatchRequestSignalKILL,
+					Cmdline: strPtr("^/usr/bin/worker"),
+					DryRun:  boolPtr(true),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationProcessSignalMatch,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID:    "550e8400-e29b-41d4-a716-446655440000",
+						Hostname: "agent1",
+						Changed:  boolPtr(false),
+						Data: json.RawMessage(
+							`{"signal":"KILL","dry_run":true,"pids":[42],"changed":false}`,
+						),
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeProcessSignalMatchResponseObject) {
+				r, ok := resp.(gen.PostNodeProcessSignalMatch200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Require().NotNil(r.Results[0].DryRun)
+				s.True(*r.Results[0].DryRun)
+				s.Require().NotNil(r.Results[0].Pids)
+				s.Equal([]int{42}, *r.Results[0].Pids)
+				s.Require().NotNil(r.Results[0].Changed)
+				s.False(*r.Results[0].Changed)
+			},
+		},
+		{
+			name: "success with partial failures",
+			request: gen.PostNodeProcessSignalMatchRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeProcessSignalMatchJSONRequestBody{
+					Signal: gen.ProcessSignalMatchRequestSignalTERM,
+					User:   strPtr("www-data"),
+					Ppid:   intPtr(10),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationProcessSignalMatch,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID:    "550e8400-e29b-41d4-a716-446655440000",
+						Hostname: "agent1",
+						Changed:  boolPtr(true),
+						Data: json.RawMessage(
+							`{"signal":"TERM","dry_run":false,"pids":[11],` +
+								`"failed":[{"pid":12,"error":"permission denied"}],"changed":true}`,
+						),
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeProcessSignalMatchResponseObject) {
+				r, ok := resp.(gen.PostNodeProcessSignalMatch200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal([]int{11}, *r.Results[0].Pids)
+				s.Require().NotNil(r.Results[0].Failed)
+				s.Equal([]gen.ProcessSignalFailure{
+					{Pid: 12, Error: "permission denied"},
+				}, *r.Results[0].Failed)
+			},
+		},
+		{
+			name: "success with no matches",
+			request: gen.PostNodeProcessSignalMatchRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeProcessSignalMatchJSONRequestBody{
+					Signal: gen.ProcessSignalMatchRequestSignalHUP,
+					Name:   strPtr("nginx"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationProcessSignalMatch,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID:    "550e8400-e29b-41d4-a716-446655440000",
+						Hostname: "agent1",
+						Changed:  boolPtr(false),
+						Data:     json.RawMessage(`{"signal":"HUP","dry_run":false,"changed":false}`),
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeProcessSignalMatchResponseObject) {
+				r, ok := resp.(gen.PostNodeProcessSignalMatch200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Require().NotNil(r.Results[0].Pids)
+				s.Empty(*r.Results[0].Pids)
+			},
+		},
+		{
+			name: "validation error empty hostname",
+			request: gen.PostNodeProcessSignalMatchRequestObject{
+				Hostname: "",
+				Body: &gen.PostNodeProcessSignalMatchJSONRequestBody{
+					Signal: gen.ProcessSignalMatchRequestSignalHUP,
+					Name:   strPtr("nginx"),
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodeProcessSignalMatchResponseObject) {
+				r, ok := resp.(gen.PostNodeProcessSignalMatch400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "required")
+			},
+		},
+		{
+			name: "validation error invalid signal",
+			request: gen.PostNodeProcessSignalMatchRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeProcessSignalMatchJSONRequestBody{
+					Signal: "INVALID",
+					Name:   strPtr("nginx"),
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodeProcessSignalMatchResponseObject) {
+				r, ok := resp.(gen.PostNodeProcessSignalMatch400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "Signal")
+			},
+		},
+		{
+			name: "validation error empty name",
+			request: gen.PostNodeProcessSignalMatchRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeProcessSignalMatchJSONRequestBody{
+					Signal: gen.ProcessSignalMatchRequestSignalTERM,
+					Name:   strPtr(""),
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodeProcessSignalMatchResponseObject) {
+				r, ok := resp.(gen.PostNodeProcessSignalMatch400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "Name")
+			},
+		},
+		{
+			name: "validation error no filters",
+			request: gen.PostNodeProcessSignalMatchRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeProcessSignalMatchJSONRequestBody{
+					Signal: gen.ProcessSignalMatchRequestSignalTERM,
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodeProcessSignalMatchResponseObject) {
+				r, ok := resp.(gen.PostNodeProcessSignalMatch400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "at least one of name, cmdline, user or ppid is required")
+			},
+		},
+		{
+			name: "validation error invalid cmdline pattern",
+			request: gen.PostNodeProcessSignalMatchRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeProcessSignalMatchJSONRequestBody{
+					Signal:  gen.ProcessSignalMatchRequestSignalTERM,
+					Cmdline: strPtr("worker-("),
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodeProcessSignalMatchResponseObject) {
+				r, ok := resp.(gen.PostNodeProcessSignalMatch400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "invalid cmdline pattern")
+			},
+		},
+		{
+			name: "job client error",
+			request: gen.PostNodeProcessSignalMatchRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeProcessSignalMatchJSONRequestBody{
+					Signal: gen.ProcessSignalMatchRequestSignalHUP,
+					Name:   strPtr("nginx"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationProcessSignalMatch,
+						gomock.Any(),
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PostNodeProcessSignalMatchResponseObject) {
+				_, ok := resp.(gen.PostNodeProcessSignalMatch500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.PostNodeProcessSignalMatchRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeProcessSignalMatchJSONRequestBody{
+					Signal: gen.ProcessSignalMatchRequestSignalHUP,
+					Name:   strPtr("nginx"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationProcessSignalMatch,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Status:   job.StatusSkipped,
+						Hostname: "server1",
+						Error:    "unsupported",
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeProcessSignalMatchResponseObject) {
+				r, ok := resp.(gen.PostNodeProcessSignalMatch200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.ProcessSignalMatchResultStatusSkipped, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Equal("unsupported", *r.Results[0].Error)
+			},
+		},
+		{
+			name: "when job failed returns 500",
+			request: gen.PostNodeProcessSignalMatchRequestObject{
+				Hostname: "server1",
+				Body: &gen.PostNodeProcessSignalMatchJSONRequestBody{
+					Signal: gen.ProcessSignalMatchRequestSignalHUP,
+					Name:   strPtr("nginx"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationProcessSignalMatch,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Status:   job.StatusFailed,
+						Hostname: "server1",
+						Error:    "process: signal match: cannot read /proc",
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeProcessSignalMatchResponseObject) {
+				r, ok := resp.(gen.PostNodeProcessSignalMatch500JSONResponse)
+				s.True(ok)
+				s.Contains(*r.Error, "cannot read /proc")
+			},
+		},
+		{
+			name: "broadcast success",
+			request: gen.PostNodeProcessSignalMatchRequestObject{
+				Hostname: "_all",
+				Body: &gen.PostNodeProcessSignalMatchJSONRequestBody{
+					Signal: gen.ProcessSignalMatchRequestSignalHUP,
+					Name:   strPtr("nginx"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationProcessSignalMatch,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Hostname: "server1",
+							Changed:  boolPtr(true),
+							Data: json.RawMessage(
+								`{"signal":"HUP","dry_run":false,"pids":[10],"changed":true}`,
+							),
+						},
+						"server2": {
+							Status:   job.StatusSkipped,
+							Error:    "unsupported",
+							Hostname: "server2",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeProcessSignalMatchResponseObject) {
+				r, ok := resp.(gen.PostNodeProcessSignalMatch200JSONResponse)
+				s.True(ok)
+				s.Len(r.Results, 2)
+
+				byHost := make(map[string]gen.ProcessSignalMatchResult)
+				for _, item := range r.Results {
+					byHost[item.Hostname] = item
+				}
+				s.Equal(gen.ProcessSignalMatchResultStatusOk, byHost["server1"].Status)
+				s.Equal([]int{10}, *byHost["server1"].Pids)
+				s.Equal(gen.ProcessSignalMatchResultStatusSkipped, byHost["server2"].Status)
+			},
+		},
+		{
+			name: "broadcast with failed host",
+			request: gen.PostNodeProcessSignalMatchRequestObject{
+				Hostname: "_all",
+				Body: &gen.PostNodeProcessSignalMatchJSONRequestBody{
+					Signal: gen.ProcessSignalMatchRequestSignalHUP,
+					Name:   strPtr("nginx"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationProcessSignalMatch,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusFailed,
+							Error:    "agent unreachable",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeProcessSignalMatchResponseObject) {
+				r, ok := resp.(gen.PostNodeProcessSignalMatch200JSONResponse)
+				s.True(ok)
+				s.Len(r.Results, 1)
+				s.Equal(gen.ProcessSignalMatchResultStatusFailed, r.Results[0].Status)
+			},
+		},
+		{
+			name: "broadcast error collecting responses",
+			request: gen.PostNodeProcessSignalMatchRequestObject{
+				Hostname: "_all",
+				Body: &gen.PostNodeProcessSignalMatchJSONRequestBody{
+					Signal: gen.ProcessSignalMatchRequestSignalHUP,
+					Name:   strPtr("nginx"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationProcessSignalMatch,
+						gomock.Any(),
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PostNodeProcessSignalMatchResponseObject) {
+				_, ok := resp.(gen.PostNodeProcessSignalMatch500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			resp, err := s.handler.PostNodeProcessSignalMatch(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+func (s *ProcessSignalMatchPublicTestSuite) TestPostNodeProcessSignalMatchValidationHTTP() {
+	tests := []struct {
+		name         string
+		path         string
+		body         string
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when valid request",
+			path: "/api/node/server1/process/signal",
+			body: `{"signal":"HUP","name":"nginx","dry_run":true}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationProcessSignalMatch, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID:    "550e8400-e29b-41d4-a716-446655440000",
+						Hostname: "agent1",
+						Changed:  boolPtr(false),
+						Data: json.RawMessage(
+							`{"signal":"HUP","dry_run":true,"pids":[10],"changed":false}`,
+						),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"pids":[10]`, `"dry_run":true`},
+		},
+		{
+			name: "when no filters",
+			path: "/api/node/server1/process/signal",
+			body: `{"signal":"TERM"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "at least one of"},
+		},
+		{
+			name: "when invalid signal",
+			path: "/api/node/server1/process/signal",
+			body: `{"signal":"INVALID","name":"nginx"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`},
+		},
+		{
+			name: "when invalid ppid",
+			path: "/api/node/server1/process/signal",
+			body: `{"signal":"TERM","ppid":-1}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "Ppid"},
+		},
+		{
+			name: "when target agent not found",
+			path: "/api/node/nonexistent/process/signal",
+			body: `{"signal":"TERM","name":"nginx"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "valid_target", "not found"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			processHandler := processAPI.New(s.logger, jobMock)
+			strictHandler := gen.NewStrictHandler(processHandler, nil)
+
+			a := api.New(s.appConfig, s.logger)
+			gen.RegisterHandlers(a.Echo, strictHandler)
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				tc.path,
+				strings.NewReader(tc.body),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			a.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+const rbacProcessSignalMatchTestSigningKey = "test-signing-key-for-rbac-process-signal-match"
+
+func (s *ProcessSignalMatchPublicTestSuite) TestPostNodeProcessSignalMatchRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when no token returns 401",
+			setupAuth: func(_ *http.Request) {
+				// No auth header set
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusUnauthorized,
+			wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacProcessSignalMatchTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"process:read"},
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid admin token returns 200",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacProcessSignalMatchTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationProcessSignalMatch, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID:    "550e8400-e29b-41d4-a716-446655440000",
+						Hostname: "agent1",
+						Changed:  boolPtr(true),
+						Data: json.RawMessage(
+							`{"signal":"TERM","dry_run":false,"pids":[10],"changed":true}`,
+						),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{
+							SigningKey: rbacProcessSignalMatchTestSigningKey,
+						},
+					},
+				},
+			}
+
+			server := api.New(appConfig, s.logger)
+			handlers := processAPI.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/api/node/server1/process/signal",
+				strings.NewReader(`{"signal":"TERM","name":"nginx"}`),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+
+			server.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestProcessSignalMatchPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessSignalMatchPublicTestSuite))
+}
@@ -91,7 +91,7 @@ func (s *Process) PostNodeProcessSignal(
 			Results: []gen.ProcessSignalResult{
 				{
 					Hostname: resp.Hostname,
-					Status:   gen.Skipped,
+					Status:   gen.ProcessSignalResultStatusSkipped,
 					Pid:      &pid,
 					Signal:   &signal,
 					Error:    &e,
@@ -125,7 +125,7 @@ func (s *Process) PostNodeProcessSignal(
 		Results: []gen.ProcessSignalResult{
 			{
 				Hostname: resp.Hostname,
-				Status:   gen.Ok,
+				Status:   gen.ProcessSignalResultStatusOk,
 				Pid:      &resPID,
 				Signal:   &resSignal,
 				Changed:  changed,
@@ -159,15 +159,15 @@ func (s *Process) postNodeProcessSignalBroadcast(
 		}
 		switch resp.Status {
 		case job.StatusFailed:
-			item.Status = gen.Failed
+			item.Status = gen.ProcessSignalResultStatusFailed
 			e := resp.Error
 			item.Error = &e
 		case job.StatusSkipped:
-			item.Status = gen.Skipped
+			item.Status = gen.ProcessSignalResultStatusSkipped
 			e := resp.Error
 			item.Error = &e
 		default:
-			item.Status = gen.Ok
+			item.Status = gen.ProcessSignalResultStatusOk
 			item.Changed = resp.Changed
 
 			var result processProv.SignalResult
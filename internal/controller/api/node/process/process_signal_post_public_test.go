@@ -117,7 +117,7 @@ func (s *ProcessSignalPublicTestSuite) TestPostNodeProcessSignal() {
 				s.True(ok)
 				s.Require().Len(r.Results, 1)
 				s.Equal("agent1", r.Results[0].Hostname)
-				s.Equal(gen.Ok, r.Results[0].Status)
+				s.Equal(gen.ProcessSignalResultStatusOk, r.Results[0].Status)
 				s.Require().NotNil(r.Results[0].Pid)
 				s.Equal(1234, *r.Results[0].Pid)
 				s.Require().NotNil(r.Results[0].Signal)
@@ -242,7 +242,7 @@ func (s *ProcessSignalPublicTestSuite) TestPostNodeProcessSignal() {
 				r, ok := resp.(gen.PostNodeProcessSignal200JSONResponse)
 				s.True(ok)
 				s.Require().Len(r.Results, 1)
-				s.Equal(gen.Skipped, r.Results[0].Status)
+				s.Equal(gen.ProcessSignalResultStatusSkipped, r.Results[0].Status)
 				s.Require().NotNil(r.Results[0].Error)
 				s.Equal("unsupported", *r.Results[0].Error)
 			},
@@ -346,7 +346,7 @@ func (s *ProcessSignalPublicTestSuite) TestPostNodeProcessSignal() {
 				r, ok := resp.(gen.PostNodeProcessSignal200JSONResponse)
 				s.True(ok)
 				s.Len(r.Results, 1)
-				s.Equal(gen.Failed, r.Results[0].Status)
+				s.Equal(gen.ProcessSignalResultStatusFailed, r.Results[0].Status)
 			},
 		},
 		{
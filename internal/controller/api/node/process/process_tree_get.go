@@ -0,0 +1,205 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package process
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/process/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	processProv "github.com/osapi-io/osapi/internal/provider/node/process"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// GetNodeProcessTree gets the process hierarchy on a target node.
+func (s *Process) GetNodeProcessTree(
+	ctx context.Context,
+	request gen.GetNodeProcessTreeRequestObject,
+) (gen.GetNodeProcessTreeResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeProcessTree400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Params); !ok {
+		return gen.GetNodeProcessTree400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+
+	var pid int
+	if request.Params.Pid != nil {
+		pid = *request.Params.Pid
+	}
+
+	s.logger.Debug(
+		"process tree",
+		slog.String("target", hostname),
+		slog.Int("pid", pid),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	data := map[string]int{"pid": pid}
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeProcessTreeBroadcast(ctx, hostname, data)
+	}
+
+	jobID, resp, err := s.JobClient.Query(
+		ctx,
+		hostname,
+		"node",
+		job.OperationProcessTree,
+		data,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeProcessTree500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeProcessTree200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.ProcessTreeEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.Skipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	if resp.Status == job.StatusFailed && strings.Contains(resp.Error, "not found") {
+		errMsg := resp.Error
+		return gen.GetNodeProcessTree404JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusFailed {
+		errMsg := resp.Error
+		return gen.GetNodeProcessTree500JSONResponse{Error: &errMsg}, nil
+	}
+
+	nodes := processTreeFromResponse(resp)
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeProcessTree200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.ProcessTreeEntry{
+			{
+				Hostname:  resp.Hostname,
+				Status:    gen.Ok,
+				Processes: &nodes,
+			},
+		},
+	}, nil
+}
+
+// getNodeProcessTreeBroadcast handles broadcast targets for process tree.
+func (s *Process) getNodeProcessTreeBroadcast(
+	ctx context.Context,
+	target string,
+	data map[string]int,
+) (gen.GetNodeProcessTreeResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationProcessTree,
+		data,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeProcessTree500JSONResponse{Error: &errMsg}, nil
+	}
+
+	var items []gen.ProcessTreeEntry
+	for host, resp := range responses {
+		item := gen.ProcessTreeEntry{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			item.Status = gen.Failed
+			e := resp.Error
+			item.Error = &e
+		case job.StatusSkipped:
+			item.Status = gen.Skipped
+			e := resp.Error
+			item.Error = &e
+		default:
+			item.Status = gen.Ok
+			nodes := processTreeFromResponse(resp)
+			item.Processes = &nodes
+		}
+		items = append(items, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	return gen.GetNodeProcessTree200JSONResponse{
+		JobId:   &jobUUID,
+		Results: items,
+	}, nil
+}
+
+// processTreeFromResponse unmarshals the process tree from a job response.
+func processTreeFromResponse(
+	resp *job.Response,
+) []gen.ProcessTreeNode {
+	var nodes []processProv.TreeNode
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &nodes)
+	}
+
+	return processTreeToGen(nodes)
+}
+
+// processTreeToGen converts provider tree nodes to gen.ProcessTreeNode,
+// recursing into children.
+func processTreeToGen(
+	nodes []processProv.TreeNode,
+) []gen.ProcessTreeNode {
+	result := make([]gen.ProcessTreeNode, 0, len(nodes))
+	for _, n := range nodes {
+		node := gen.ProcessTreeNode{
+			Pid:     n.PID,
+			Ppid:    n.PPID,
+			Name:    n.Name,
+			User:    stringPtrOrNil(n.User),
+			Command: stringPtrOrNil(n.Command),
+		}
+
+		if len(n.Children) > 0 {
+			children := processTreeToGen(n.Children)
+			node.Children = &children
+		}
+
+		result = append(result, node)
+	}
+
+	return result
+}
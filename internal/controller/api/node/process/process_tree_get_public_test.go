@@ -0,0 +1,587 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package process_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	processAPI "github.com/osapi-io/osapi/internal/controller/api/node/process"
+	"github.com/osapi-io/osapi/internal/controller/api/node/process/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type ProcessTreeGetPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *processAPI.Process
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *ProcessTreeGetPublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *ProcessTreeGetPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = processAPI.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *ProcessTreeGetPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *ProcessTreeGetPublicTestSuite) TestGetNodeProcessTree() {
+	pid := 812
+	badPid := 0
+
+	tests := []struct {
+		name         string
+		request      gen.GetNodeProcessTreeRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.GetNodeProcessTreeResponseObject)
+	}{
+		{
+			name: "success",
+			request: gen.GetNodeProcessTreeRequestObject{
+				Hostname: "server1",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationProcessTree,
+						map[string]int{"pid": 0},
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID:    "550e8400-e29b-41d4-a716-446655440000",
+						Hostname: "agent1",
+						Data: json.RawMessage(
+							`[{"pid":1,"ppid":0,"name":"systemd","user":"root","command":"/sbin/init","children":[{"pid":812,"ppid":1,"name":"sshd","user":"root","command":"sshd: /usr/sbin/sshd -D"}]}]`,
+						),
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeProcessTreeResponseObject) {
+				r, ok := resp.(gen.GetNodeProcessTree200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal("agent1", r.Results[0].Hostname)
+				s.Equal(gen.Ok, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Processes)
+
+				roots := *r.Results[0].Processes
+				s.Require().Len(roots, 1)
+				s.Equal(1, roots[0].Pid)
+				s.Equal("systemd", roots[0].Name)
+				s.Equal("/sbin/init", *roots[0].Command)
+				s.Require().NotNil(roots[0].Children)
+				s.Require().Len(*roots[0].Children, 1)
+
+				child := (*roots[0].Children)[0]
+				s.Equal(812, child.Pid)
+				s.Equal(1, child.Ppid)
+				s.Nil(child.Children)
+			},
+		},
+		{
+			name: "success rooted at pid",
+			request: gen.GetNodeProcessTreeRequestObject{
+				Hostname: "server1",
+				Params:   gen.GetNodeProcessTreeParams{Pid: &pid},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationProcessTree,
+						map[string]int{"pid": 812},
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID:    "550e8400-e29b-41d4-a716-446655440000",
+						Hostname: "agent1",
+						Data:     json.RawMessage(`[{"pid":812,"ppid":1,"name":"sshd"}]`),
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeProcessTreeResponseObject) {
+				r, ok := resp.(gen.GetNodeProcessTree200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Require().NotNil(r.Results[0].Processes)
+				s.Require().Len(*r.Results[0].Processes, 1)
+				s.Equal(812, (*r.Results[0].Processes)[0].Pid)
+				s.Nil((*r.Results[0].Processes)[0].User)
+			},
+		},
+		{
+			name: "validation error empty hostname",
+			request: gen.GetNodeProcessTreeRequestObject{
+				Hostname: "",
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.GetNodeProcessTreeResponseObject) {
+				r, ok := resp.(gen.GetNodeProcessTree400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "required")
+			},
+		},
+		{
+			name: "validation error invalid pid",
+			request: gen.GetNodeProcessTreeRequestObject{
+				Hostname: "server1",
+				Params:   gen.GetNodeProcessTreeParams{Pid: &badPid},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.GetNodeProcessTreeResponseObject) {
+				r, ok := resp.(gen.GetNodeProcessTree400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "Pid")
+			},
+		},
+		{
+			name: "not found returns 404",
+			request: gen.GetNodeProcessTreeRequestObject{
+				Hostname: "server1",
+				Params:   gen.GetNodeProcessTreeParams{Pid: &pid},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationProcessTree,
+						map[string]int{"pid": 812},
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Status:   job.StatusFailed,
+						Hostname: "server1",
+						Error:    "process: tree: process 812 not found",
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeProcessTreeResponseObject) {
+				r, ok := resp.(gen.GetNodeProcessTree404JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "not found")
+			},
+		},
+		{
+			name: "job client error",
+			request: gen.GetNodeProcessTreeRequestObject{
+				Hostname: "server1",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationProcessTree,
+						map[string]int{"pid": 0},
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.GetNodeProcessTreeResponseObject) {
+				_, ok := resp.(gen.GetNodeProcessTree500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.GetNodeProcessTreeRequestObject{
+				Hostname: "server1",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationProcessTree,
+						map[string]int{"pid": 0},
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Status:   job.StatusSkipped,
+						Hostname: "server1",
+						Error:    "unsupported",
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeProcessTreeResponseObject) {
+				r, ok := resp.(gen.GetNodeProcessTree200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.Skipped, r.Results[0].Status)
+			},
+		},
+		{
+			name: "failed non-404 returns 500",
+			request: gen.GetNodeProcessTreeRequestObject{
+				Hostname: "server1",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationProcessTree,
+						map[string]int{"pid": 0},
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Status:   job.StatusFailed,
+						Hostname: "server1",
+						Error:    "process: tree: cannot read /proc",
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeProcessTreeResponseObject) {
+				r, ok := resp.(gen.GetNodeProcessTree500JSONResponse)
+				s.True(ok)
+				s.Contains(*r.Error, "cannot read /proc")
+			},
+		},
+		{
+			name: "broadcast success",
+			request: gen.GetNodeProcessTreeRequestObject{
+				Hostname: "_all",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationProcessTree,
+						map[string]int{"pid": 0},
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Hostname: "server1",
+							Data:     json.RawMessage(`[{"pid":1,"ppid":0,"name":"systemd"}]`),
+						},
+						"server2": {
+							Status:   job.StatusFailed,
+							Error:    "permission denied",
+							Hostname: "server2",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeProcessTreeResponseObject) {
+				r, ok := resp.(gen.GetNodeProcessTree200JSONResponse)
+				s.True(ok)
+				s.Len(r.Results, 2)
+
+				for _, entry := range r.Results {
+					switch entry.Hostname {
+					case "server1":
+						s.Equal(gen.Ok, entry.Status)
+						s.Require().NotNil(entry.Processes)
+						s.Len(*entry.Processes, 1)
+					case "server2":
+						s.Equal(gen.Failed, entry.Status)
+						s.Require().NotNil(entry.Error)
+					}
+				}
+			},
+		},
+		{
+			name: "broadcast with skipped host",
+			request: gen.GetNodeProcessTreeRequestObject{
+				Hostname: "_all",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationProcessTree,
+						map[string]int{"pid": 0},
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusSkipped,
+							Error:    "unsupported",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeProcessTreeResponseObject) {
+				r, ok := resp.(gen.GetNodeProcessTree200JSONResponse)
+				s.True(ok)
+				s.Len(r.Results, 1)
+				s.Equal(gen.Skipped, r.Results[0].Status)
+			},
+		},
+		{
+			name: "broadcast error collecting responses",
+			request: gen.GetNodeProcessTreeRequestObject{
+				Hostname: "_all",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationProcessTree,
+						map[string]int{"pid": 0},
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.GetNodeProcessTreeResponseObject) {
+				_, ok := resp.(gen.GetNodeProcessTree500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			resp, err := s.handler.GetNodeProcessTree(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+func (s *ProcessTreeGetPublicTestSuite) TestGetNodeProcessTreeValidationHTTP() {
+	tests := []struct {
+		name         string
+		path         string
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when valid request",
+			path: "/api/node/server1/process/tree?pid=812",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationProcessTree,
+						map[string]int{"pid": 812},
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID:    "550e8400-e29b-41d4-a716-446655440000",
+						Hostname: "agent1",
+						Data:     json.RawMessage(`[{"pid":812,"ppid":1,"name":"sshd"}]`),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`, `"sshd"`},
+		},
+		{
+			name: "when pid is not a number",
+			path: "/api/node/server1/process/tree?pid=abc",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{"pid"},
+		},
+		{
+			name: "when target agent not found",
+			path: "/api/node/nonexistent/process/tree",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "valid_target", "not found"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			processHandler := processAPI.New(s.logger, jobMock)
+			strictHandler := gen.NewStrictHandler(processHandler, nil)
+
+			a := api.New(s.appConfig, s.logger)
+			gen.RegisterHandlers(a.Echo, strictHandler)
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			rec := httptest.NewRecorder()
+
+			a.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+const rbacProcessTreeTestSigningKey = "test-signing-key-for-rbac-process-tree"
+
+func (s *ProcessTreeGetPublicTestSuite) TestGetNodeProcessTreeRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when no token returns 401",
+			setupAuth: func(_ *http.Request) {
+				// No auth header set
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusUnauthorized,
+			wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacProcessTreeTestSigningKey,
+					[]string{"write"},
+					"test-user",
+					[]string{"docker:write"},
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid admin token returns 200",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacProcessTreeTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Query(gomock.Any(), "server1", "node", job.OperationProcessTree, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID:    "550e8400-e29b-41d4-a716-446655440000",
+						Hostname: "agent1",
+						Data:     json.RawMessage(`[{"pid":1,"ppid":0,"name":"systemd"}]`),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{
+							SigningKey: rbacProcessTreeTestSigningKey,
+						},
+					},
+				},
+			}
+
+			server := api.New(appConfig, s.logger)
+			handlers := processAPI.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+
+			req := httptest.NewRequest(
+				http.MethodGet,
+				"/api/node/server1/process/tree",
+				nil,
+			)
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+
+			server.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestProcessTreeGetPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessTreeGetPublicTestSuite))
+}
@@ -25,3 +25,15 @@ func boolPtr(
 ) *bool {
 	return &b
 }
+
+func strPtr(
+	s string,
+) *string {
+	return &s
+}
+
+func intPtr(
+	i int,
+) *int {
+	return &i
+}
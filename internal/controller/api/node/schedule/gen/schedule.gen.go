@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
@@ -62,6 +63,32 @@ const (
 	CronUpdateRequestContentTypeTemplate CronUpdateRequestContentType = "template"
 )
 
+// Defines values for TimerCreateRequestContentType.
+const (
+	TimerCreateRequestContentTypeRaw      TimerCreateRequestContentType = "raw"
+	TimerCreateRequestContentTypeTemplate TimerCreateRequestContentType = "template"
+)
+
+// Defines values for TimerEntryStatus.
+const (
+	TimerEntryStatusFailed  TimerEntryStatus = "failed"
+	TimerEntryStatusOk      TimerEntryStatus = "ok"
+	TimerEntryStatusSkipped TimerEntryStatus = "skipped"
+)
+
+// Defines values for TimerMutationResultStatus.
+const (
+	TimerMutationResultStatusFailed  TimerMutationResultStatus = "failed"
+	TimerMutationResultStatusOk      TimerMutationResultStatus = "ok"
+	TimerMutationResultStatusSkipped TimerMutationResultStatus = "skipped"
+)
+
+// Defines values for TimerUpdateRequestContentType.
+const (
+	TimerUpdateRequestContentTypeRaw      TimerUpdateRequestContentType = "raw"
+	TimerUpdateRequestContentTypeTemplate TimerUpdateRequestContentType = "template"
+)
+
 // CronCollectionResponse defines model for CronCollectionResponse.
 type CronCollectionResponse struct {
 	// JobId The job ID used to process this request.
@@ -208,18 +235,179 @@ type CronUpdateResponse struct {
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse = externalRef0.ErrorResponse
 
+// TimerCollectionResponse defines model for TimerCollectionResponse.
+type TimerCollectionResponse struct {
+	// JobId The job ID used to process this request.
+	JobId   *openapi_types.UUID `json:"job_id,omitempty"`
+	Results []TimerEntry        `json:"results"`
+}
+
+// TimerCreateRequest defines model for TimerCreateRequest.
+type TimerCreateRequest struct {
+	// Command Command run by the service unit. Mutually exclusive with object — provide exactly one.
+	Command *string `json:"command,omitempty" validate:"required_without=Object,excluded_with=Object"`
+
+	// ContentType Content type: "raw" or "template". When "template", the object is rendered through Go text/template with agent facts and user-supplied vars.
+	ContentType *TimerCreateRequestContentType `json:"content_type,omitempty" validate:"omitempty,oneof=raw template"`
+
+	// Name Name for the timer. The units are written as osapi-{name}.timer and osapi-{name}.service under /etc/systemd/system/.
+	Name string `json:"name" validate:"required,min=1,max=64"`
+
+	// Object Name of the uploaded file in the object store to deploy as the service unit. Mutually exclusive with command — provide exactly one.
+	Object *string `json:"object,omitempty" validate:"required_without=Command,excluded_with=Command"`
+
+	// Schedule systemd OnCalendar expression (e.g., "*-*-* 02:00:00" or "daily"). Validated on the agent with systemd-analyze.
+	Schedule string `json:"schedule" validate:"required,min=1"`
+
+	// User User to run the command as. Only applies when using command.
+	User *string `json:"user,omitempty"`
+
+	// Vars Template variables. Only used when content_type is "template".
+	Vars *map[string]interface{} `json:"vars,omitempty"`
+}
+
+// TimerCreateRequestContentType Content type: "raw" or "template". When "template", the object is rendered through Go text/template with agent facts and user-supplied vars.
+type TimerCreateRequestContentType string
+
+// TimerCreateResponse defines model for TimerCreateResponse.
+type TimerCreateResponse struct {
+	// JobId The job ID used to process this request.
+	JobId   *openapi_types.UUID   `json:"job_id,omitempty"`
+	Results []TimerMutationResult `json:"results"`
+}
+
+// TimerDeleteResponse defines model for TimerDeleteResponse.
+type TimerDeleteResponse struct {
+	// JobId The job ID used to process this request.
+	JobId   *openapi_types.UUID   `json:"job_id,omitempty"`
+	Results []TimerMutationResult `json:"results"`
+}
+
+// TimerEntry A systemd timer.
+type TimerEntry struct {
+	// Activates Unit started when the timer elapses.
+	Activates *string `json:"activates,omitempty"`
+
+	// Command Command run by the service unit.
+	Command *string `json:"command,omitempty"`
+
+	// Error Error message if the agent failed to retrieve this timer.
+	Error *string `json:"error,omitempty"`
+
+	// Hostname Hostname of the agent that reported this timer.
+	Hostname string `json:"hostname"`
+
+	// LastRun Last time the timer elapsed.
+	LastRun *time.Time `json:"last_run,omitempty"`
+
+	// Managed Whether the timer is managed by osapi.
+	Managed *bool `json:"managed,omitempty"`
+
+	// Name Timer name.
+	Name *string `json:"name,omitempty"`
+
+	// NextRun Next time the timer elapses.
+	NextRun *time.Time `json:"next_run,omitempty"`
+
+	// Object Object store name for the deployed service unit.
+	Object *string `json:"object,omitempty"`
+
+	// Schedule OnCalendar expression. Present for timers managed by osapi.
+	Schedule *string `json:"schedule,omitempty"`
+
+	// Status The status of the operation for this host.
+	Status TimerEntryStatus `json:"status"`
+
+	// Unit Timer unit name.
+	Unit *string `json:"unit,omitempty"`
+
+	// User User the command runs as.
+	User *string `json:"user,omitempty"`
+}
+
+// TimerEntryStatus The status of the operation for this host.
+type TimerEntryStatus string
+
+// TimerGetResponse Collection response for a single timer get operation.
+type TimerGetResponse struct {
+	// JobId The job ID used to process this request.
+	JobId   *openapi_types.UUID `json:"job_id,omitempty"`
+	Results []TimerEntry        `json:"results"`
+}
+
+// TimerMutationResult Result of a timer create, update, or delete operation for one host.
+type TimerMutationResult struct {
+	// Changed Whether the operation modified system state.
+	Changed *bool `json:"changed,omitempty"`
+
+	// Error Error message if the agent failed.
+	Error *string `json:"error,omitempty"`
+
+	// Hostname Hostname of the agent that processed this operation.
+	Hostname string `json:"hostname"`
+
+	// Name Timer name.
+	Name *string `json:"name,omitempty"`
+
+	// Status The status of the operation for this host.
+	Status TimerMutationResultStatus `json:"status"`
+}
+
+// TimerMutationResultStatus The status of the operation for this host.
+type TimerMutationResultStatus string
+
+// TimerUpdateRequest defines model for TimerUpdateRequest.
+type TimerUpdateRequest struct {
+	// Command New command for the service unit. Replaces an object.
+	Command *string `json:"command,omitempty" validate:"omitempty,min=1,excluded_with=Object"`
+
+	// ContentType Content type: "raw" or "template".
+	ContentType *TimerUpdateRequestContentType `json:"content_type,omitempty" validate:"omitempty,oneof=raw template"`
+
+	// Object New object to deploy as the service unit. Replaces a command.
+	Object *string `json:"object,omitempty" validate:"omitempty,min=1,excluded_with=Command"`
+
+	// Schedule New systemd OnCalendar expression.
+	Schedule *string `json:"schedule,omitempty" validate:"omitempty,min=1"`
+
+	// User User to run the command as.
+	User *string `json:"user,omitempty"`
+
+	// Vars Template variables.
+	Vars *map[string]interface{} `json:"vars,omitempty"`
+}
+
+// TimerUpdateRequestContentType Content type: "raw" or "template".
+type TimerUpdateRequestContentType string
+
+// TimerUpdateResponse defines model for TimerUpdateResponse.
+type TimerUpdateResponse struct {
+	// JobId The job ID used to process this request.
+	JobId   *openapi_types.UUID   `json:"job_id,omitempty"`
+	Results []TimerMutationResult `json:"results"`
+}
+
 // CronName defines model for CronName.
 type CronName = string
 
 // Hostname defines model for Hostname.
 type Hostname = string
 
+// TimerName defines model for TimerName.
+type TimerName = string
+
 // PostNodeScheduleCronJSONRequestBody defines body for PostNodeScheduleCron for application/json ContentType.
 type PostNodeScheduleCronJSONRequestBody = CronCreateRequest
 
 // PutNodeScheduleCronJSONRequestBody defines body for PutNodeScheduleCron for application/json ContentType.
 type PutNodeScheduleCronJSONRequestBody = CronUpdateRequest
 
+// PostNodeScheduleTimerJSONRequestBody defines body for PostNodeScheduleTimer for application/json ContentType.
+type PostNodeScheduleTimerJSONRequestBody = TimerCreateRequest
+
+// PutNodeScheduleTimerJSONRequestBody defines body for PutNodeScheduleTimer for application/json ContentType.
+type PutNodeScheduleTimerJSONRequestBody = TimerUpdateRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// List all cron entries
@@ -237,6 +425,21 @@ type ServerInterface interface {
 	// Update a cron entry
 	// (PUT /api/node/{hostname}/schedule/cron/{name})
 	PutNodeScheduleCron(ctx echo.Context, hostname Hostname, name CronName) error
+	// List all timers
+	// (GET /api/node/{hostname}/schedule/timer)
+	GetNodeScheduleTimer(ctx echo.Context, hostname Hostname) error
+	// Create a timer
+	// (POST /api/node/{hostname}/schedule/timer)
+	PostNodeScheduleTimer(ctx echo.Context, hostname Hostname) error
+	// Delete a timer
+	// (DELETE /api/node/{hostname}/schedule/timer/{name})
+	DeleteNodeScheduleTimer(ctx echo.Context, hostname Hostname, name TimerName) error
+	// Get a timer
+	// (GET /api/node/{hostname}/schedule/timer/{name})
+	GetNodeScheduleTimerByName(ctx echo.Context, hostname Hostname, name TimerName) error
+	// Update a timer
+	// (PUT /api/node/{hostname}/schedule/timer/{name})
+	PutNodeScheduleTimer(ctx echo.Context, hostname Hostname, name TimerName) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -358,6 +561,120 @@ func (w *ServerInterfaceWrapper) PutNodeScheduleCron(ctx echo.Context) error {
 	return err
 }
 
+// GetNodeScheduleTimer converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeScheduleTimer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"timer:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeScheduleTimer(ctx, hostname)
+	return err
+}
+
+// PostNodeScheduleTimer converts echo context to params.
+func (w *ServerInterfaceWrapper) PostNodeScheduleTimer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"timer:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostNodeScheduleTimer(ctx, hostname)
+	return err
+}
+
+// DeleteNodeScheduleTimer converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteNodeScheduleTimer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name TimerName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"timer:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteNodeScheduleTimer(ctx, hostname, name)
+	return err
+}
+
+// GetNodeScheduleTimerByName converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeScheduleTimerByName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name TimerName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"timer:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeScheduleTimerByName(ctx, hostname, name)
+	return err
+}
+
+// PutNodeScheduleTimer converts echo context to params.
+func (w *ServerInterfaceWrapper) PutNodeScheduleTimer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name TimerName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"timer:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutNodeScheduleTimer(ctx, hostname, name)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -391,6 +708,11 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/api/node/:hostname/schedule/cron/:name", wrapper.DeleteNodeScheduleCron)
 	router.GET(baseURL+"/api/node/:hostname/schedule/cron/:name", wrapper.GetNodeScheduleCronByName)
 	router.PUT(baseURL+"/api/node/:hostname/schedule/cron/:name", wrapper.PutNodeScheduleCron)
+	router.GET(baseURL+"/api/node/:hostname/schedule/timer", wrapper.GetNodeScheduleTimer)
+	router.POST(baseURL+"/api/node/:hostname/schedule/timer", wrapper.PostNodeScheduleTimer)
+	router.DELETE(baseURL+"/api/node/:hostname/schedule/timer/:name", wrapper.DeleteNodeScheduleTimer)
+	router.GET(baseURL+"/api/node/:hostname/schedule/timer/:name", wrapper.GetNodeScheduleTimerByName)
+	router.PUT(baseURL+"/api/node/:hostname/schedule/timer/:name", wrapper.PutNodeScheduleTimer)
 
 }
 
@@ -691,6 +1013,303 @@ func (response PutNodeScheduleCron500JSONResponse) VisitPutNodeScheduleCronRespo
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetNodeScheduleTimerRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+}
+
+type GetNodeScheduleTimerResponseObject interface {
+	VisitGetNodeScheduleTimerResponse(w http.ResponseWriter) error
+}
+
+type GetNodeScheduleTimer200JSONResponse TimerCollectionResponse
+
+func (response GetNodeScheduleTimer200JSONResponse) VisitGetNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeScheduleTimer400JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeScheduleTimer400JSONResponse) VisitGetNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeScheduleTimer401JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeScheduleTimer401JSONResponse) VisitGetNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeScheduleTimer403JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeScheduleTimer403JSONResponse) VisitGetNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeScheduleTimer500JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeScheduleTimer500JSONResponse) VisitGetNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeScheduleTimerRequestObject struct {
+	Hostname Hostname `json:"hostname"`
+	Body     *PostNodeScheduleTimerJSONRequestBody
+}
+
+type PostNodeScheduleTimerResponseObject interface {
+	VisitPostNodeScheduleTimerResponse(w http.ResponseWriter) error
+}
+
+type PostNodeScheduleTimer200JSONResponse TimerCreateResponse
+
+func (response PostNodeScheduleTimer200JSONResponse) VisitPostNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeScheduleTimer400JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeScheduleTimer400JSONResponse) VisitPostNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeScheduleTimer401JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeScheduleTimer401JSONResponse) VisitPostNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeScheduleTimer403JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeScheduleTimer403JSONResponse) VisitPostNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeScheduleTimer500JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeScheduleTimer500JSONResponse) VisitPostNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeScheduleTimerRequestObject struct {
+	Hostname Hostname  `json:"hostname"`
+	Name     TimerName `json:"name"`
+}
+
+type DeleteNodeScheduleTimerResponseObject interface {
+	VisitDeleteNodeScheduleTimerResponse(w http.ResponseWriter) error
+}
+
+type DeleteNodeScheduleTimer200JSONResponse TimerDeleteResponse
+
+func (response DeleteNodeScheduleTimer200JSONResponse) VisitDeleteNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeScheduleTimer400JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodeScheduleTimer400JSONResponse) VisitDeleteNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeScheduleTimer401JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodeScheduleTimer401JSONResponse) VisitDeleteNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeScheduleTimer403JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodeScheduleTimer403JSONResponse) VisitDeleteNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeScheduleTimer404JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodeScheduleTimer404JSONResponse) VisitDeleteNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeScheduleTimer500JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodeScheduleTimer500JSONResponse) VisitDeleteNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeScheduleTimerByNameRequestObject struct {
+	Hostname Hostname  `json:"hostname"`
+	Name     TimerName `json:"name"`
+}
+
+type GetNodeScheduleTimerByNameResponseObject interface {
+	VisitGetNodeScheduleTimerByNameResponse(w http.ResponseWriter) error
+}
+
+type GetNodeScheduleTimerByName200JSONResponse TimerGetResponse
+
+func (response GetNodeScheduleTimerByName200JSONResponse) VisitGetNodeScheduleTimerByNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeScheduleTimerByName400JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeScheduleTimerByName400JSONResponse) VisitGetNodeScheduleTimerByNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeScheduleTimerByName401JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeScheduleTimerByName401JSONResponse) VisitGetNodeScheduleTimerByNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeScheduleTimerByName403JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeScheduleTimerByName403JSONResponse) VisitGetNodeScheduleTimerByNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeScheduleTimerByName404JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeScheduleTimerByName404JSONResponse) VisitGetNodeScheduleTimerByNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeScheduleTimerByName500JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeScheduleTimerByName500JSONResponse) VisitGetNodeScheduleTimerByNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeScheduleTimerRequestObject struct {
+	Hostname Hostname  `json:"hostname"`
+	Name     TimerName `json:"name"`
+	Body     *PutNodeScheduleTimerJSONRequestBody
+}
+
+type PutNodeScheduleTimerResponseObject interface {
+	VisitPutNodeScheduleTimerResponse(w http.ResponseWriter) error
+}
+
+type PutNodeScheduleTimer200JSONResponse TimerUpdateResponse
+
+func (response PutNodeScheduleTimer200JSONResponse) VisitPutNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeScheduleTimer400JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodeScheduleTimer400JSONResponse) VisitPutNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeScheduleTimer401JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodeScheduleTimer401JSONResponse) VisitPutNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeScheduleTimer403JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodeScheduleTimer403JSONResponse) VisitPutNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeScheduleTimer404JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodeScheduleTimer404JSONResponse) VisitPutNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeScheduleTimer500JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodeScheduleTimer500JSONResponse) VisitPutNodeScheduleTimerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// List all cron entries
@@ -708,6 +1327,21 @@ type StrictServerInterface interface {
 	// Update a cron entry
 	// (PUT /api/node/{hostname}/schedule/cron/{name})
 	PutNodeScheduleCron(ctx context.Context, request PutNodeScheduleCronRequestObject) (PutNodeScheduleCronResponseObject, error)
+	// List all timers
+	// (GET /api/node/{hostname}/schedule/timer)
+	GetNodeScheduleTimer(ctx context.Context, request GetNodeScheduleTimerRequestObject) (GetNodeScheduleTimerResponseObject, error)
+	// Create a timer
+	// (POST /api/node/{hostname}/schedule/timer)
+	PostNodeScheduleTimer(ctx context.Context, request PostNodeScheduleTimerRequestObject) (PostNodeScheduleTimerResponseObject, error)
+	// Delete a timer
+	// (DELETE /api/node/{hostname}/schedule/timer/{name})
+	DeleteNodeScheduleTimer(ctx context.Context, request DeleteNodeScheduleTimerRequestObject) (DeleteNodeScheduleTimerResponseObject, error)
+	// Get a timer
+	// (GET /api/node/{hostname}/schedule/timer/{name})
+	GetNodeScheduleTimerByName(ctx context.Context, request GetNodeScheduleTimerByNameRequestObject) (GetNodeScheduleTimerByNameResponseObject, error)
+	// Update a timer
+	// (PUT /api/node/{hostname}/schedule/timer/{name})
+	PutNodeScheduleTimer(ctx context.Context, request PutNodeScheduleTimerRequestObject) (PutNodeScheduleTimerResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -861,3 +1495,143 @@ func (sh *strictHandler) PutNodeScheduleCron(ctx echo.Context, hostname Hostname
 	}
 	return nil
 }
+
+// GetNodeScheduleTimer operation middleware
+func (sh *strictHandler) GetNodeScheduleTimer(ctx echo.Context, hostname Hostname) error {
+	var request GetNodeScheduleTimerRequestObject
+
+	request.Hostname = hostname
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNodeScheduleTimer(ctx.Request().Context(), request.(GetNodeScheduleTimerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNodeScheduleTimer")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetNodeScheduleTimerResponseObject); ok {
+		return validResponse.VisitGetNodeScheduleTimerResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostNodeScheduleTimer operation middleware
+func (sh *strictHandler) PostNodeScheduleTimer(ctx echo.Context, hostname Hostname) error {
+	var request PostNodeScheduleTimerRequestObject
+
+	request.Hostname = hostname
+
+	var body PostNodeScheduleTimerJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostNodeScheduleTimer(ctx.Request().Context(), request.(PostNodeScheduleTimerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostNodeScheduleTimer")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostNodeScheduleTimerResponseObject); ok {
+		return validResponse.VisitPostNodeScheduleTimerResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteNodeScheduleTimer operation middleware
+func (sh *strictHandler) DeleteNodeScheduleTimer(ctx echo.Context, hostname Hostname, name TimerName) error {
+	var request DeleteNodeScheduleTimerRequestObject
+
+	request.Hostname = hostname
+	request.Name = name
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteNodeScheduleTimer(ctx.Request().Context(), request.(DeleteNodeScheduleTimerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteNodeScheduleTimer")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteNodeScheduleTimerResponseObject); ok {
+		return validResponse.VisitDeleteNodeScheduleTimerResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetNodeScheduleTimerByName operation middleware
+func (sh *strictHandler) GetNodeScheduleTimerByName(ctx echo.Context, hostname Hostname, name TimerName) error {
+	var request GetNodeScheduleTimerByNameRequestObject
+
+	request.Hostname = hostname
+	request.Name = name
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNodeScheduleTimerByName(ctx.Request().Context(), request.(GetNodeScheduleTimerByNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNodeScheduleTimerByName")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetNodeScheduleTimerByNameResponseObject); ok {
+		return validResponse.VisitGetNodeScheduleTimerByNameResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutNodeScheduleTimer operation middleware
+func (sh *strictHandler) PutNodeScheduleTimer(ctx echo.Context, hostname Hostname, name TimerName) error {
+	var request PutNodeScheduleTimerRequestObject
+
+	request.Hostname = hostname
+	request.Name = name
+
+	var body PutNodeScheduleTimerJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutNodeScheduleTimer(ctx.Request().Context(), request.(PutNodeScheduleTimerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutNodeScheduleTimer")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutNodeScheduleTimerResponseObject); ok {
+		return validResponse.VisitPutNodeScheduleTimerResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/schedule/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	timerProv "github.com/osapi-io/osapi/internal/provider/scheduled/timer"
+)
+
+// GetNodeScheduleTimerByName gets a single timer by name on a target node.
+func (s *Schedule) GetNodeScheduleTimerByName(
+	ctx context.Context,
+	request gen.GetNodeScheduleTimerByNameRequestObject,
+) (gen.GetNodeScheduleTimerByNameResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeScheduleTimerByName400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+	name := request.Name
+
+	s.logger.Debug(
+		"timer get",
+		slog.String("target", hostname),
+		slog.String("name", name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeScheduleTimerByNameBroadcast(ctx, hostname, name)
+	}
+
+	jobID, resp, err := s.JobClient.Query(
+		ctx,
+		hostname,
+		"schedule",
+		job.OperationTimerGet,
+		map[string]string{"name": name},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "not found") || strings.Contains(errMsg, "does not exist") ||
+			strings.Contains(errMsg, "not managed") {
+			return gen.GetNodeScheduleTimerByName404JSONResponse{Error: &errMsg}, nil
+		}
+		return gen.GetNodeScheduleTimerByName500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeScheduleTimerByName200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.TimerEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.TimerEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	var t timerProv.Timer
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &t)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeScheduleTimerByName200JSONResponse{
+		JobId:   &jobUUID,
+		Results: []gen.TimerEntry{timerToEntry(resp.Hostname, t)},
+	}, nil
+}
+
+// getNodeScheduleTimerByNameBroadcast handles broadcast targets for timer get.
+func (s *Schedule) getNodeScheduleTimerByNameBroadcast(
+	ctx context.Context,
+	target string,
+	name string,
+) (gen.GetNodeScheduleTimerByNameResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"schedule",
+		job.OperationTimerGet,
+		map[string]string{"name": name},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeScheduleTimerByName500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.TimerEntry, 0)
+	for host, resp := range responses {
+		item := gen.TimerEntry{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			item.Status = gen.TimerEntryStatusFailed
+			e := resp.Error
+			item.Error = &e
+		case job.StatusSkipped:
+			item.Status = gen.TimerEntryStatusSkipped
+			e := resp.Error
+			item.Error = &e
+		default:
+			var t timerProv.Timer
+			if resp.Data != nil {
+				_ = json.Unmarshal(resp.Data, &t)
+			}
+			item = timerToEntry(host, t)
+		}
+		allResults = append(allResults, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeScheduleTimerByName200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
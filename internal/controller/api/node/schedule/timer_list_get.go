@@ -0,0 +1,198 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/schedule/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	timerProv "github.com/osapi-io/osapi/internal/provider/scheduled/timer"
+)
+
+// GetNodeScheduleTimer lists all timers on a target node.
+func (s *Schedule) GetNodeScheduleTimer(
+	ctx context.Context,
+	request gen.GetNodeScheduleTimerRequestObject,
+) (gen.GetNodeScheduleTimerResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeScheduleTimer400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"timer list",
+		slog.String("target", hostname),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeScheduleTimerBroadcast(ctx, hostname)
+	}
+
+	jobID, resp, err := s.JobClient.Query(ctx, hostname, "schedule", job.OperationTimerList, nil)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeScheduleTimer500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeScheduleTimer200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.TimerEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.TimerEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	results := responseToTimerEntries(resp)
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeScheduleTimer200JSONResponse{
+		JobId:   &jobUUID,
+		Results: results,
+	}, nil
+}
+
+// getNodeScheduleTimerBroadcast handles broadcast targets for timer list.
+func (s *Schedule) getNodeScheduleTimerBroadcast(
+	ctx context.Context,
+	target string,
+) (gen.GetNodeScheduleTimerResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"schedule",
+		job.OperationTimerList,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeScheduleTimer500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.TimerEntry, 0)
+	for host, resp := range responses {
+		switch resp.Status {
+		case job.StatusFailed:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.TimerEntry{
+				Hostname: h,
+				Status:   gen.TimerEntryStatusFailed,
+				Error:    &e,
+			})
+		case job.StatusSkipped:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.TimerEntry{
+				Hostname: h,
+				Status:   gen.TimerEntryStatusSkipped,
+				Error:    &e,
+			})
+		default:
+			allResults = append(allResults, responseToTimerEntries(resp)...)
+		}
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeScheduleTimer200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
+
+// responseToTimerEntries converts a job response to gen TimerEntry slice.
+func responseToTimerEntries(
+	resp *job.Response,
+) []gen.TimerEntry {
+	var timers []timerProv.Timer
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &timers)
+	}
+
+	results := make([]gen.TimerEntry, 0, len(timers))
+	for _, t := range timers {
+		results = append(results, timerToEntry(resp.Hostname, t))
+	}
+
+	return results
+}
+
+// timerToEntry converts a provider timer to a gen TimerEntry.
+func timerToEntry(
+	hostname string,
+	t timerProv.Timer,
+) gen.TimerEntry {
+	name := t.Name
+	unit := t.Unit
+	managed := t.Managed
+
+	entry := gen.TimerEntry{
+		Hostname: hostname,
+		Status:   gen.TimerEntryStatusOk,
+		Name:     &name,
+		Unit:     &unit,
+		Managed:  &managed,
+	}
+
+	if t.Activates != "" {
+		activates := t.Activates
+		entry.Activates = &activates
+	}
+	if t.Schedule != "" {
+		schedule := t.Schedule
+		entry.Schedule = &schedule
+	}
+	if t.Command != "" {
+		command := t.Command
+		entry.Command = &command
+	}
+	if t.Object != "" {
+		object := t.Object
+		entry.Object = &object
+	}
+	if t.User != "" {
+		user := t.User
+		entry.User = &user
+	}
+	if nextRun, err := time.Parse(time.RFC3339, t.NextRun); err == nil {
+		entry.NextRun = &nextRun
+	}
+	if lastRun, err := time.Parse(time.RFC3339, t.LastRun); err == nil {
+		entry.LastRun = &lastRun
+	}
+
+	return entry
+}
@@ -0,0 +1,603 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package schedule_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	apischedule "github.com/osapi-io/osapi/internal/controller/api/node/schedule"
+	"github.com/osapi-io/osapi/internal/controller/api/node/schedule/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type TimerListGetPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *apischedule.Schedule
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *TimerListGetPublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *TimerListGetPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = apischedule.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *TimerListGetPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *TimerListGetPublicTestSuite) TestGetNodeScheduleTimer() {
+	tests := []struct {
+		name         string
+		request      gen.GetNodeScheduleTimerRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.GetNodeScheduleTimerResponseObject)
+	}{
+		{
+			name: "success",
+			request: gen.GetNodeScheduleTimerRequestObject{
+				Hostname: "server1",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"schedule",
+						job.OperationTimerList,
+						nil,
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID:    "550e8400-e29b-41d4-a716-446655440000",
+						Hostname: "agent1",
+						Data: json.RawMessage(
+							`[{"name":"backup","unit":"osapi-backup.timer","activates":"osapi-backup.service","schedule":"daily","object":"backup-service","managed":true,"next_run":"2026-01-02T00:00:00Z"}]`,
+						),
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.GetNodeScheduleTimer200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("backup", *r.Results[0].Name)
+				s.Equal("daily", *r.Results[0].Schedule)
+				s.Equal("backup-service", *r.Results[0].Object)
+				s.Nil(r.Results[0].Command)
+				s.True(*r.Results[0].Managed)
+				s.Require().NotNil(r.Results[0].NextRun)
+				s.Nil(r.Results[0].LastRun)
+			},
+		},
+		{
+			name: "success with unmanaged timers",
+			request: gen.GetNodeScheduleTimerRequestObject{
+				Hostname: "server1",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"schedule",
+						job.OperationTimerList,
+						nil,
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID:    "550e8400-e29b-41d4-a716-446655440000",
+						Hostname: "agent1",
+						Data: json.RawMessage(
+							`[{"name":"apt-daily","unit":"apt-daily.timer","activates":"apt-daily.service","managed":false,"next_run":"2026-01-02T06:00:00Z","last_run":"2026-01-01T06:00:00Z"},{"name":"report","unit":"osapi-report.timer","schedule":"weekly","command":"/usr/local/bin/report","user":"nobody","managed":true}]`,
+						),
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.GetNodeScheduleTimer200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 2)
+
+				// Unmanaged timer
+				s.Equal("apt-daily", *r.Results[0].Name)
+				s.Equal("apt-daily.timer", *r.Results[0].Unit)
+				s.Equal("apt-daily.service", *r.Results[0].Activates)
+				s.False(*r.Results[0].Managed)
+				s.Nil(r.Results[0].Schedule)
+				s.Require().NotNil(r.Results[0].LastRun)
+				s.Equal("2026-01-01T06:00:00Z", r.Results[0].LastRun.Format(time.RFC3339))
+
+				// Managed timer not yet loaded by systemd
+				s.Equal("report", *r.Results[1].Name)
+				s.Equal("weekly", *r.Results[1].Schedule)
+				s.Equal("/usr/local/bin/report", *r.Results[1].Command)
+				s.Equal("nobody", *r.Results[1].User)
+				s.True(*r.Results[1].Managed)
+				s.Nil(r.Results[1].NextRun)
+			},
+		},
+		{
+			name: "success with nil response data",
+			request: gen.GetNodeScheduleTimerRequestObject{
+				Hostname: "server1",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"schedule",
+						job.OperationTimerList,
+						nil,
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID:    "550e8400-e29b-41d4-a716-446655440000",
+						Hostname: "agent1",
+						Data:     nil,
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.GetNodeScheduleTimer200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Empty(r.Results)
+			},
+		},
+		{
+			name: "validation error empty hostname",
+			request: gen.GetNodeScheduleTimerRequestObject{
+				Hostname: "",
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.GetNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.GetNodeScheduleTimer400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "required")
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.GetNodeScheduleTimerRequestObject{
+				Hostname: "server1",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"schedule",
+						job.OperationTimerList,
+						nil,
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							Status:   job.StatusSkipped,
+							Hostname: "server1",
+							Error:    "timer: operation not supported on this OS family",
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.GetNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.GetNodeScheduleTimer200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("server1", r.Results[0].Hostname)
+				s.Equal(gen.TimerEntryStatusSkipped, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "job client error",
+			request: gen.GetNodeScheduleTimerRequestObject{
+				Hostname: "server1",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(
+						gomock.Any(),
+						"server1",
+						"schedule",
+						job.OperationTimerList,
+						nil,
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.GetNodeScheduleTimerResponseObject) {
+				_, ok := resp.(gen.GetNodeScheduleTimer500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "broadcast target _all with multiple agents",
+			request: gen.GetNodeScheduleTimerRequestObject{
+				Hostname: "_all",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(
+						gomock.Any(),
+						"_all",
+						"schedule",
+						job.OperationTimerList,
+						nil,
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						map[string]*job.Response{
+							"server1": {
+								JobID:    "550e8400-e29b-41d4-a716-446655440000",
+								Hostname: "server1",
+								Status:   job.StatusCompleted,
+								Data: json.RawMessage(
+									`[{"name":"backup","unit":"osapi-backup.timer","activates":"osapi-backup.service","schedule":"daily","object":"backup-service","managed":true,"next_run":"2026-01-02T00:00:00Z"}]`,
+								),
+							},
+							"server2": {
+								JobID:    "550e8400-e29b-41d4-a716-446655440000",
+								Hostname: "server2",
+								Status:   job.StatusCompleted,
+								Data: json.RawMessage(
+									`[{"name":"cleanup","unit":"osapi-cleanup.timer","schedule":"weekly","command":"/usr/local/bin/cleanup","managed":true}]`,
+								),
+							},
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.GetNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.GetNodeScheduleTimer200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Len(r.Results, 2)
+			},
+		},
+		{
+			name: "broadcast target _all includes failed and skipped agents",
+			request: gen.GetNodeScheduleTimerRequestObject{
+				Hostname: "_all",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(
+						gomock.Any(),
+						"_all",
+						"schedule",
+						job.OperationTimerList,
+						nil,
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						map[string]*job.Response{
+							"server1": {
+								JobID:    "550e8400-e29b-41d4-a716-446655440000",
+								Hostname: "server1",
+								Status:   job.StatusCompleted,
+								Data: json.RawMessage(
+									`[{"name":"backup","unit":"osapi-backup.timer","activates":"osapi-backup.service","schedule":"daily","object":"backup-service","managed":true,"next_run":"2026-01-02T00:00:00Z"}]`,
+								),
+							},
+							"server2": {
+								Status:   job.StatusFailed,
+								Error:    "timer: operation not supported on this OS family",
+								Hostname: "server2",
+							},
+							"server3": {
+								Status:   job.StatusSkipped,
+								Error:    "timer: operation not supported on this OS family",
+								Hostname: "server3",
+							},
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.GetNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.GetNodeScheduleTimer200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Len(r.Results, 3)
+
+				byHost := make(map[string]*gen.TimerEntry)
+				for i := range r.Results {
+					if r.Results[i].Hostname != "" {
+						byHost[r.Results[i].Hostname] = &r.Results[i]
+					}
+				}
+
+				s.Require().Contains(byHost, "server1")
+				s.Equal("backup", *byHost["server1"].Name)
+				s.Nil(byHost["server1"].Error)
+
+				s.Require().Contains(byHost, "server2")
+				s.Contains(*byHost["server2"].Error, "not supported")
+
+				s.Require().Contains(byHost, "server3")
+				s.Equal(gen.TimerEntryStatusSkipped, byHost["server3"].Status)
+				s.Contains(*byHost["server3"].Error, "not supported")
+			},
+		},
+		{
+			name: "broadcast target _all with empty responses",
+			request: gen.GetNodeScheduleTimerRequestObject{
+				Hostname: "_all",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(
+						gomock.Any(),
+						"_all",
+						"schedule",
+						job.OperationTimerList,
+						nil,
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						map[string]*job.Response{},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.GetNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.GetNodeScheduleTimer200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Empty(r.Results)
+			},
+		},
+		{
+			name: "broadcast job client error",
+			request: gen.GetNodeScheduleTimerRequestObject{
+				Hostname: "_all",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					QueryBroadcast(
+						gomock.Any(),
+						"_all",
+						"schedule",
+						job.OperationTimerList,
+						nil,
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.GetNodeScheduleTimerResponseObject) {
+				_, ok := resp.(gen.GetNodeScheduleTimer500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			resp, err := s.handler.GetNodeScheduleTimer(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+func (s *TimerListGetPublicTestSuite) TestGetNodeScheduleTimerValidationHTTP() {
+	tests := []struct {
+		name         string
+		path         string
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when valid request",
+			path: "/api/node/server1/schedule/timer",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Query(gomock.Any(), "server1", "schedule", job.OperationTimerList, nil).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Data:     json.RawMessage(`[]`),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+		{
+			name: "when target agent not found",
+			path: "/api/node/nonexistent/schedule/timer",
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "valid_target"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			scheduleHandler := apischedule.New(s.logger, jobMock)
+			strictHandler := gen.NewStrictHandler(scheduleHandler, nil)
+
+			a := api.New(s.appConfig, s.logger)
+			gen.RegisterHandlers(a.Echo, strictHandler)
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			rec := httptest.NewRecorder()
+
+			a.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+const rbacTimerListTestSigningKey = "test-signing-key-for-rbac-timer-list"
+
+func (s *TimerListGetPublicTestSuite) TestGetNodeScheduleTimerRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when no token returns 401",
+			setupAuth: func(_ *http.Request) {
+				// No auth header set
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusUnauthorized,
+			wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacTimerListTestSigningKey,
+					[]string{"write"},
+					"test-user",
+					[]string{"timer:write"},
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid admin token returns 200",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacTimerListTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Query(gomock.Any(), "server1", "schedule", job.OperationTimerList, nil).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Data:     json.RawMessage(`[]`),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{
+							SigningKey: rbacTimerListTestSigningKey,
+						},
+					},
+				},
+			}
+
+			server := api.New(appConfig, s.logger)
+			handlers := apischedule.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+
+			req := httptest.NewRequest(
+				http.MethodGet,
+				"/api/node/server1/schedule/timer",
+				nil,
+			)
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+
+			server.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestTimerListGetPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(TimerListGetPublicTestSuite))
+}
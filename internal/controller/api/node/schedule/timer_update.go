@@ -0,0 +1,193 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/schedule/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	timerProv "github.com/osapi-io/osapi/internal/provider/scheduled/timer"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// PutNodeScheduleTimer updates a timer on a target node.
+func (s *Schedule) PutNodeScheduleTimer(
+	ctx context.Context,
+	request gen.PutNodeScheduleTimerRequestObject,
+) (gen.PutNodeScheduleTimerResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.PutNodeScheduleTimer400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Body); !ok {
+		return gen.PutNodeScheduleTimer400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.AtLeastOneField(request.Body); !ok {
+		return gen.PutNodeScheduleTimer400JSONResponse{Error: &errMsg}, nil
+	}
+
+	entry := timerProv.Entry{
+		Name: request.Name,
+	}
+	if request.Body.Schedule != nil {
+		entry.Schedule = *request.Body.Schedule
+	}
+	if request.Body.Command != nil {
+		entry.Command = *request.Body.Command
+	}
+	if request.Body.Object != nil {
+		entry.Object = *request.Body.Object
+	}
+	if request.Body.User != nil {
+		entry.User = *request.Body.User
+	}
+	if request.Body.ContentType != nil {
+		entry.ContentType = string(*request.Body.ContentType)
+	}
+	if request.Body.Vars != nil {
+		entry.Vars = *request.Body.Vars
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"timer update",
+		slog.String("target", hostname),
+		slog.String("name", entry.Name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.putNodeScheduleTimerUpdateBroadcast(ctx, hostname, entry)
+	}
+
+	jobID, resp, err := s.JobClient.Modify(
+		ctx,
+		hostname,
+		"schedule",
+		job.OperationTimerUpdate,
+		entry,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "not found") || strings.Contains(errMsg, "does not exist") {
+			return gen.PutNodeScheduleTimer404JSONResponse{Error: &errMsg}, nil
+		}
+		return gen.PutNodeScheduleTimer500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		jobUUID := uuid.MustParse(jobID)
+		e := resp.Error
+		return gen.PutNodeScheduleTimer200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.TimerMutationResult{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.TimerMutationResultStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	var result timerProv.UpdateResult
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &result)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	changed := resp.Changed
+	name := result.Name
+	agentHostname := resp.Hostname
+
+	return gen.PutNodeScheduleTimer200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.TimerMutationResult{
+			{
+				Hostname: agentHostname,
+				Status:   gen.TimerMutationResultStatusOk,
+				Name:     &name,
+				Changed:  changed,
+			},
+		},
+	}, nil
+}
+
+// putNodeScheduleTimerUpdateBroadcast handles broadcast targets for timer update.
+func (s *Schedule) putNodeScheduleTimerUpdateBroadcast(
+	ctx context.Context,
+	target string,
+	entry timerProv.Entry,
+) (gen.PutNodeScheduleTimerResponseObject, error) {
+	jobID, responses, err := s.JobClient.ModifyBroadcast(
+		ctx,
+		target,
+		"schedule",
+		job.OperationTimerUpdate,
+		entry,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PutNodeScheduleTimer500JSONResponse{Error: &errMsg}, nil
+	}
+
+	var apiResponses []gen.TimerMutationResult
+	for host, resp := range responses {
+		item := gen.TimerMutationResult{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			item.Status = gen.TimerMutationResultStatusFailed
+			e := resp.Error
+			item.Error = &e
+		case job.StatusSkipped:
+			item.Status = gen.TimerMutationResultStatusSkipped
+			e := resp.Error
+			item.Error = &e
+		default:
+			item.Status = gen.TimerMutationResultStatusOk
+			var result timerProv.UpdateResult
+			if resp.Data != nil {
+				_ = json.Unmarshal(resp.Data, &result)
+			}
+			name := result.Name
+			item.Name = &name
+			item.Changed = resp.Changed
+		}
+		apiResponses = append(apiResponses, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.PutNodeScheduleTimer200JSONResponse{
+		JobId:   &jobUUID,
+		Results: apiResponses,
+	}, nil
+}
@@ -0,0 +1,708 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package schedule_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	apischedule "github.com/osapi-io/osapi/internal/controller/api/node/schedule"
+	"github.com/osapi-io/osapi/internal/controller/api/node/schedule/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type TimerUpdatePublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *apischedule.Schedule
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *TimerUpdatePublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *TimerUpdatePublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = apischedule.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *TimerUpdatePublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *TimerUpdatePublicTestSuite) TestPutNodeScheduleTimer() {
+	tests := []struct {
+		name         string
+		request      gen.PutNodeScheduleTimerRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.PutNodeScheduleTimerResponseObject)
+	}{
+		{
+			name: "success with all fields",
+			request: gen.PutNodeScheduleTimerRequestObject{
+				Hostname: "server1",
+				Name:     "backup",
+				Body: &gen.PutNodeScheduleTimerJSONRequestBody{
+					Schedule:    strPtr("weekly"),
+					Object:      strPtr("backup-v2-service"),
+					ContentType: (*gen.TimerUpdateRequestContentType)(strPtr("template")),
+					Vars:        &map[string]interface{}{"region": "us-east"},
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"schedule",
+						job.OperationTimerUpdate,
+						gomock.Any(),
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"backup","changed":true}`),
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PutNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.PutNodeScheduleTimer200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("agent1", r.Results[0].Hostname)
+				s.Require().NotNil(r.Results[0].Changed)
+				s.True(*r.Results[0].Changed)
+				s.Equal("backup", *r.Results[0].Name)
+			},
+		},
+		{
+			name: "when empty body returns 400",
+			request: gen.PutNodeScheduleTimerRequestObject{
+				Hostname: "server1",
+				Name:     "backup",
+				Body:     &gen.PutNodeScheduleTimerJSONRequestBody{},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PutNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.PutNodeScheduleTimer400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "at least one field")
+			},
+		},
+		{
+			name: "success with nil response data",
+			request: gen.PutNodeScheduleTimerRequestObject{
+				Hostname: "server1",
+				Name:     "backup",
+				Body: &gen.PutNodeScheduleTimerJSONRequestBody{
+					Schedule: strPtr("weekly"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"schedule",
+						job.OperationTimerUpdate,
+						gomock.Any(),
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(true),
+							Data:     nil,
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PutNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.PutNodeScheduleTimer200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("", *r.Results[0].Name)
+			},
+		},
+		{
+			name: "broadcast success",
+			request: gen.PutNodeScheduleTimerRequestObject{
+				Hostname: "_all",
+				Name:     "backup",
+				Body: &gen.PutNodeScheduleTimerJSONRequestBody{
+					Schedule: strPtr("weekly"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"schedule",
+						job.OperationTimerUpdate,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "server1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"backup","changed":true}`),
+						},
+						"server2": {
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "server2",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"backup","changed":true}`),
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PutNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.PutNodeScheduleTimer200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Len(r.Results, 2)
+			},
+		},
+		{
+			name: "broadcast with error entries",
+			request: gen.PutNodeScheduleTimerRequestObject{
+				Hostname: "_all",
+				Name:     "backup",
+				Body: &gen.PutNodeScheduleTimerJSONRequestBody{
+					Schedule: strPtr("weekly"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"schedule",
+						job.OperationTimerUpdate,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "server1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"backup","changed":true}`),
+						},
+						"server2": {
+							Status:   job.StatusFailed,
+							Error:    "timer not found",
+							Hostname: "server2",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PutNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.PutNodeScheduleTimer200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Len(r.Results, 2)
+				errCount := 0
+				for _, res := range r.Results {
+					if res.Error != nil {
+						errCount++
+						s.Equal("timer not found", *res.Error)
+					}
+				}
+				s.Equal(1, errCount)
+			},
+		},
+		{
+			name: "broadcast with skipped host",
+			request: gen.PutNodeScheduleTimerRequestObject{
+				Hostname: "_all",
+				Name:     "backup",
+				Body: &gen.PutNodeScheduleTimerJSONRequestBody{
+					Schedule: strPtr("weekly"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"schedule",
+						job.OperationTimerUpdate,
+						gomock.Any(),
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Status:   job.StatusSkipped,
+							Error:    "timer: operation not supported on this OS family",
+							Hostname: "server1",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PutNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.PutNodeScheduleTimer200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal(gen.TimerMutationResultStatusSkipped, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "broadcast error collecting responses",
+			request: gen.PutNodeScheduleTimerRequestObject{
+				Hostname: "_all",
+				Name:     "backup",
+				Body: &gen.PutNodeScheduleTimerJSONRequestBody{
+					Schedule: strPtr("weekly"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"schedule",
+						job.OperationTimerUpdate,
+						gomock.Any(),
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PutNodeScheduleTimerResponseObject) {
+				_, ok := resp.(gen.PutNodeScheduleTimer500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "body validation error both command and object",
+			request: gen.PutNodeScheduleTimerRequestObject{
+				Hostname: "server1",
+				Name:     "backup",
+				Body: &gen.PutNodeScheduleTimerJSONRequestBody{
+					Command: strPtr("/usr/local/bin/backup"),
+					Object:  strPtr("backup-service"),
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PutNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.PutNodeScheduleTimer400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "excluded_with")
+			},
+		},
+		{
+			name: "validation error empty hostname",
+			request: gen.PutNodeScheduleTimerRequestObject{
+				Hostname: "",
+				Name:     "backup",
+				Body: &gen.PutNodeScheduleTimerJSONRequestBody{
+					Schedule: strPtr("weekly"),
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PutNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.PutNodeScheduleTimer400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "required")
+			},
+		},
+		{
+			name: "not found error",
+			request: gen.PutNodeScheduleTimerRequestObject{
+				Hostname: "server1",
+				Name:     "nonexistent",
+				Body: &gen.PutNodeScheduleTimerJSONRequestBody{
+					Schedule: strPtr("weekly"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"schedule",
+						job.OperationTimerUpdate,
+						gomock.Any(),
+					).
+					Return("", nil, errors.New("timer not found"))
+			},
+			validateFunc: func(resp gen.PutNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.PutNodeScheduleTimer404JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "not found")
+			},
+		},
+		{
+			name: "does not exist error",
+			request: gen.PutNodeScheduleTimerRequestObject{
+				Hostname: "server1",
+				Name:     "missing",
+				Body: &gen.PutNodeScheduleTimerJSONRequestBody{
+					Schedule: strPtr("weekly"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"schedule",
+						job.OperationTimerUpdate,
+						gomock.Any(),
+					).
+					Return("", nil, errors.New("timer does not exist"))
+			},
+			validateFunc: func(resp gen.PutNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.PutNodeScheduleTimer404JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "does not exist")
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.PutNodeScheduleTimerRequestObject{
+				Hostname: "server1",
+				Name:     "backup",
+				Body: &gen.PutNodeScheduleTimerJSONRequestBody{
+					Schedule: strPtr("weekly"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"schedule",
+						job.OperationTimerUpdate,
+						gomock.Any(),
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							Status:   job.StatusSkipped,
+							Hostname: "server1",
+							Error:    "timer: operation not supported on this OS family",
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PutNodeScheduleTimerResponseObject) {
+				r, ok := resp.(gen.PutNodeScheduleTimer200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("server1", r.Results[0].Hostname)
+				s.Equal(gen.TimerMutationResultStatusSkipped, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "job client error",
+			request: gen.PutNodeScheduleTimerRequestObject{
+				Hostname: "server1",
+				Name:     "backup",
+				Body: &gen.PutNodeScheduleTimerJSONRequestBody{
+					Schedule: strPtr("weekly"),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"schedule",
+						job.OperationTimerUpdate,
+						gomock.Any(),
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PutNodeScheduleTimerResponseObject) {
+				_, ok := resp.(gen.PutNodeScheduleTimer500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			resp, err := s.handler.PutNodeScheduleTimer(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+func (s *TimerUpdatePublicTestSuite) TestPutNodeScheduleTimerValidationHTTP() {
+	tests := []struct {
+		name         string
+		path         string
+		body         string
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when valid request",
+			path: "/api/node/server1/schedule/timer/backup",
+			body: `{"schedule":"weekly"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "schedule", job.OperationTimerUpdate, gomock.Any()).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"backup","changed":true}`),
+						},
+						nil,
+					)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+		{
+			name: "when empty body returns 400",
+			path: "/api/node/server1/schedule/timer/backup",
+			body: `{}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "at least one field"},
+		},
+		{
+			name: "when command and object are both set",
+			path: "/api/node/server1/schedule/timer/backup",
+			body: `{"command":"/usr/local/bin/backup","object":"backup-service"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "excluded_with"},
+		},
+		{
+			name: "when target agent not found",
+			path: "/api/node/nonexistent/schedule/timer/backup",
+			body: `{"schedule":"weekly"}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "valid_target"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			scheduleHandler := apischedule.New(s.logger, jobMock)
+			strictHandler := gen.NewStrictHandler(scheduleHandler, nil)
+
+			a := api.New(s.appConfig, s.logger)
+			gen.RegisterHandlers(a.Echo, strictHandler)
+
+			req := httptest.NewRequest(
+				http.MethodPut,
+				tc.path,
+				strings.NewReader(tc.body),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			a.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+const rbacTimerUpdateTestSigningKey = "test-signing-key-for-rbac-timer-update"
+
+func (s *TimerUpdatePublicTestSuite) TestPutNodeScheduleTimerRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when no token returns 401",
+			setupAuth: func(_ *http.Request) {
+				// No auth header set
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusUnauthorized,
+			wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacTimerUpdateTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"timer:read"},
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid admin token returns 200",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacTimerUpdateTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "schedule", job.OperationTimerUpdate, gomock.Any()).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(true),
+							Data:     json.RawMessage(`{"name":"backup","changed":true}`),
+						},
+						nil,
+					)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{
+							SigningKey: rbacTimerUpdateTestSigningKey,
+						},
+					},
+				},
+			}
+
+			server := api.New(appConfig, s.logger)
+			handlers := apischedule.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+
+			req := httptest.NewRequest(
+				http.MethodPut,
+				"/api/node/server1/schedule/timer/backup",
+				strings.NewReader(`{"schedule":"weekly"}`),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+
+			server.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestTimerUpdatePublicTestSuite(t *testing.T) {
+	suite.Run(t, new(TimerUpdatePublicTestSuite))
+}
@@ -20,6 +20,32 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for ServiceDropInCreateRequestContentType.
+const (
+	ServiceDropInCreateRequestContentTypeRaw      ServiceDropInCreateRequestContentType = "raw"
+	ServiceDropInCreateRequestContentTypeTemplate ServiceDropInCreateRequestContentType = "template"
+)
+
+// Defines values for ServiceDropInListEntryStatus.
+const (
+	ServiceDropInListEntryStatusFailed  ServiceDropInListEntryStatus = "failed"
+	ServiceDropInListEntryStatusOk      ServiceDropInListEntryStatus = "ok"
+	ServiceDropInListEntryStatusSkipped ServiceDropInListEntryStatus = "skipped"
+)
+
+// Defines values for ServiceDropInMutationEntryStatus.
+const (
+	ServiceDropInMutationEntryStatusFailed  ServiceDropInMutationEntryStatus = "failed"
+	ServiceDropInMutationEntryStatusOk      ServiceDropInMutationEntryStatus = "ok"
+	ServiceDropInMutationEntryStatusSkipped ServiceDropInMutationEntryStatus = "skipped"
+)
+
+// Defines values for ServiceDropInUpdateRequestContentType.
+const (
+	ServiceDropInUpdateRequestContentTypeRaw      ServiceDropInUpdateRequestContentType = "raw"
+	ServiceDropInUpdateRequestContentTypeTemplate ServiceDropInUpdateRequestContentType = "template"
+)
+
 // Defines values for ServiceGetEntryStatus.
 const (
 	ServiceGetEntryStatusFailed  ServiceGetEntryStatus = "failed"
@@ -53,6 +79,122 @@ type ServiceCreateRequest struct {
 	Object string `json:"object" validate:"required,min=1"`
 }
 
+// ServiceDropInCreateRequest defines model for ServiceDropInCreateRequest.
+type ServiceDropInCreateRequest struct {
+	// ContentType Content type: "raw" or "template". When "template", the file content is rendered through Go text/template with agent facts and user-supplied vars.
+	ContentType *ServiceDropInCreateRequestContentType `json:"content_type,omitempty" validate:"omitempty,oneof=raw template"`
+
+	// Name Drop-in name without the .conf suffix. Defaults to "override". Deployed to /etc/systemd/system/{unit}.d/{name}.conf.
+	Name *string `json:"name,omitempty" validate:"omitempty,min=1,max=64"`
+
+	// Object Object Store reference for the drop-in content.
+	Object string `json:"object" validate:"required,min=1"`
+
+	// Restart Restart the unit when the drop-in changed. Units that are not running are left stopped.
+	Restart *bool `json:"restart,omitempty"`
+
+	// Vars Template variables. Only used when content_type is "template".
+	Vars *map[string]interface{} `json:"vars,omitempty"`
+}
+
+// ServiceDropInCreateRequestContentType Content type: "raw" or "template". When "template", the file content is rendered through Go text/template with agent facts and user-supplied vars.
+type ServiceDropInCreateRequestContentType string
+
+// ServiceDropInInfo Information about a systemd drop-in override file.
+type ServiceDropInInfo struct {
+	// Managed Whether the drop-in is managed by osapi.
+	Managed *bool `json:"managed,omitempty"`
+
+	// Name Drop-in name without the .conf suffix.
+	Name *string `json:"name,omitempty"`
+
+	// Object Object Store reference of a managed drop-in.
+	Object *string `json:"object,omitempty"`
+
+	// Path Absolute path of the drop-in file.
+	Path *string `json:"path,omitempty"`
+
+	// Sha256 SHA-256 of the deployed content of a managed drop-in.
+	Sha256 *string `json:"sha256,omitempty"`
+}
+
+// ServiceDropInListEntry Drop-in list result for a single agent.
+type ServiceDropInListEntry struct {
+	// Dropins Drop-ins of the unit on this host.
+	Dropins *[]ServiceDropInInfo `json:"dropins,omitempty"`
+
+	// Error Error message if the agent failed.
+	Error *string `json:"error,omitempty"`
+
+	// Hostname Hostname of the agent that reported this entry.
+	Hostname string `json:"hostname"`
+
+	// Status The status of the operation for this host.
+	Status ServiceDropInListEntryStatus `json:"status"`
+}
+
+// ServiceDropInListEntryStatus The status of the operation for this host.
+type ServiceDropInListEntryStatus string
+
+// ServiceDropInListResponse defines model for ServiceDropInListResponse.
+type ServiceDropInListResponse struct {
+	// JobId The job ID used to process this request.
+	JobId   *openapi_types.UUID      `json:"job_id,omitempty"`
+	Results []ServiceDropInListEntry `json:"results"`
+}
+
+// ServiceDropInMutationEntry Result of a drop-in mutation for one host.
+type ServiceDropInMutationEntry struct {
+	// Changed Whether the operation modified system state.
+	Changed *bool `json:"changed,omitempty"`
+
+	// Error Error message if the agent failed.
+	Error *string `json:"error,omitempty"`
+
+	// Hostname Hostname of the agent that processed this operation.
+	Hostname string `json:"hostname"`
+
+	// Name Drop-in name.
+	Name *string `json:"name,omitempty"`
+
+	// Restarted Whether the unit was restarted.
+	Restarted *bool `json:"restarted,omitempty"`
+
+	// Status The status of the operation for this host.
+	Status ServiceDropInMutationEntryStatus `json:"status"`
+
+	// Unit Fully qualified unit name.
+	Unit *string `json:"unit,omitempty"`
+}
+
+// ServiceDropInMutationEntryStatus The status of the operation for this host.
+type ServiceDropInMutationEntryStatus string
+
+// ServiceDropInMutationResponse defines model for ServiceDropInMutationResponse.
+type ServiceDropInMutationResponse struct {
+	// JobId The job ID used to process this request.
+	JobId   *openapi_types.UUID          `json:"job_id,omitempty"`
+	Results []ServiceDropInMutationEntry `json:"results"`
+}
+
+// ServiceDropInUpdateRequest defines model for ServiceDropInUpdateRequest.
+type ServiceDropInUpdateRequest struct {
+	// ContentType Content type: "raw" or "template".
+	ContentType *ServiceDropInUpdateRequestContentType `json:"content_type,omitempty" validate:"omitempty,oneof=raw template"`
+
+	// Object New object to deploy. Defaults to the currently deployed object.
+	Object *string `json:"object,omitempty" validate:"omitempty,min=1"`
+
+	// Restart Restart the unit when the drop-in changed. Units that are not running are left stopped.
+	Restart *bool `json:"restart,omitempty"`
+
+	// Vars Template variables. Only used when content_type is "template".
+	Vars *map[string]interface{} `json:"vars,omitempty"`
+}
+
+// ServiceDropInUpdateRequestContentType Content type: "raw" or "template".
+type ServiceDropInUpdateRequestContentType string
+
 // ServiceGetEntry Service get result for a single agent.
 type ServiceGetEntry struct {
 	// Error Error message if the agent failed.
@@ -155,18 +297,33 @@ type ServiceUpdateRequest struct {
 	Object string `json:"object" validate:"required,min=1"`
 }
 
+// DropInName defines model for DropInName.
+type DropInName = string
+
 // Hostname defines model for Hostname.
 type Hostname = string
 
 // ServiceName defines model for ServiceName.
 type ServiceName = string
 
+// DeleteNodeServiceDropInParams defines parameters for DeleteNodeServiceDropIn.
+type DeleteNodeServiceDropInParams struct {
+	// Restart Restart the unit after removing the drop-in. Units that are not running are left stopped.
+	Restart *bool `form:"restart,omitempty" json:"restart,omitempty"`
+}
+
 // PostNodeServiceJSONRequestBody defines body for PostNodeService for application/json ContentType.
 type PostNodeServiceJSONRequestBody = ServiceCreateRequest
 
 // PutNodeServiceJSONRequestBody defines body for PutNodeService for application/json ContentType.
 type PutNodeServiceJSONRequestBody = ServiceUpdateRequest
 
+// PostNodeServiceDropInJSONRequestBody defines body for PostNodeServiceDropIn for application/json ContentType.
+type PostNodeServiceDropInJSONRequestBody = ServiceDropInCreateRequest
+
+// PutNodeServiceDropInJSONRequestBody defines body for PutNodeServiceDropIn for application/json ContentType.
+type PutNodeServiceDropInJSONRequestBody = ServiceDropInUpdateRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// List all services
@@ -187,6 +344,18 @@ type ServerInterface interface {
 	// Disable a service
 	// (POST /api/node/{hostname}/service/{name}/disable)
 	PostNodeServiceDisable(ctx echo.Context, hostname Hostname, name ServiceName) error
+	// List service drop-ins
+	// (GET /api/node/{hostname}/service/{name}/dropin)
+	GetNodeServiceDropIn(ctx echo.Context, hostname Hostname, name ServiceName) error
+	// Create a service drop-in
+	// (POST /api/node/{hostname}/service/{name}/dropin)
+	PostNodeServiceDropIn(ctx echo.Context, hostname Hostname, name ServiceName) error
+	// Delete a service drop-in
+	// (DELETE /api/node/{hostname}/service/{name}/dropin/{dropin})
+	DeleteNodeServiceDropIn(ctx echo.Context, hostname Hostname, name ServiceName, dropin DropInName, params DeleteNodeServiceDropInParams) error
+	// Update a service drop-in
+	// (PUT /api/node/{hostname}/service/{name}/dropin/{dropin})
+	PutNodeServiceDropIn(ctx echo.Context, hostname Hostname, name ServiceName, dropin DropInName) error
 	// Enable a service
 	// (POST /api/node/{hostname}/service/{name}/enable)
 	PostNodeServiceEnable(ctx echo.Context, hostname Hostname, name ServiceName) error
@@ -346,6 +515,135 @@ func (w *ServerInterfaceWrapper) PostNodeServiceDisable(ctx echo.Context) error
 	return err
 }
 
+// GetNodeServiceDropIn converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeServiceDropIn(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name ServiceName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"service:read"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeServiceDropIn(ctx, hostname, name)
+	return err
+}
+
+// PostNodeServiceDropIn converts echo context to params.
+func (w *ServerInterfaceWrapper) PostNodeServiceDropIn(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name ServiceName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"service:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostNodeServiceDropIn(ctx, hostname, name)
+	return err
+}
+
+// DeleteNodeServiceDropIn converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteNodeServiceDropIn(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name ServiceName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Path parameter "dropin" -------------
+	var dropin DropInName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "dropin", ctx.Param("dropin"), &dropin, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dropin: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"service:write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteNodeServiceDropInParams
+	// ------------- Optional query parameter "restart" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "restart", ctx.QueryParams(), &params.Restart)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter restart: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteNodeServiceDropIn(ctx, hostname, name, dropin, params)
+	return err
+}
+
+// PutNodeServiceDropIn converts echo context to params.
+func (w *ServerInterfaceWrapper) PutNodeServiceDropIn(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "hostname" -------------
+	var hostname Hostname
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hostname", ctx.Param("hostname"), &hostname, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hostname: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name ServiceName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Path parameter "dropin" -------------
+	var dropin DropInName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "dropin", ctx.Param("dropin"), &dropin, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dropin: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{"service:write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutNodeServiceDropIn(ctx, hostname, name, dropin)
+	return err
+}
+
 // PostNodeServiceEnable converts echo context to params.
 func (w *ServerInterfaceWrapper) PostNodeServiceEnable(ctx echo.Context) error {
 	var err error
@@ -484,6 +782,10 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/node/:hostname/service/:name", wrapper.GetNodeServiceByName)
 	router.PUT(baseURL+"/api/node/:hostname/service/:name", wrapper.PutNodeService)
 	router.POST(baseURL+"/api/node/:hostname/service/:name/disable", wrapper.PostNodeServiceDisable)
+	router.GET(baseURL+"/api/node/:hostname/service/:name/dropin", wrapper.GetNodeServiceDropIn)
+	router.POST(baseURL+"/api/node/:hostname/service/:name/dropin", wrapper.PostNodeServiceDropIn)
+	router.DELETE(baseURL+"/api/node/:hostname/service/:name/dropin/:dropin", wrapper.DeleteNodeServiceDropIn)
+	router.PUT(baseURL+"/api/node/:hostname/service/:name/dropin/:dropin", wrapper.PutNodeServiceDropIn)
 	router.POST(baseURL+"/api/node/:hostname/service/:name/enable", wrapper.PostNodeServiceEnable)
 	router.POST(baseURL+"/api/node/:hostname/service/:name/restart", wrapper.PostNodeServiceRestart)
 	router.POST(baseURL+"/api/node/:hostname/service/:name/start", wrapper.PostNodeServiceStart)
@@ -833,6 +1135,245 @@ func (response PostNodeServiceDisable500JSONResponse) VisitPostNodeServiceDisabl
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetNodeServiceDropInRequestObject struct {
+	Hostname Hostname    `json:"hostname"`
+	Name     ServiceName `json:"name"`
+}
+
+type GetNodeServiceDropInResponseObject interface {
+	VisitGetNodeServiceDropInResponse(w http.ResponseWriter) error
+}
+
+type GetNodeServiceDropIn200JSONResponse ServiceDropInListResponse
+
+func (response GetNodeServiceDropIn200JSONResponse) VisitGetNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeServiceDropIn400JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeServiceDropIn400JSONResponse) VisitGetNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeServiceDropIn401JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeServiceDropIn401JSONResponse) VisitGetNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeServiceDropIn403JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeServiceDropIn403JSONResponse) VisitGetNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeServiceDropIn500JSONResponse externalRef0.ErrorResponse
+
+func (response GetNodeServiceDropIn500JSONResponse) VisitGetNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeServiceDropInRequestObject struct {
+	Hostname Hostname    `json:"hostname"`
+	Name     ServiceName `json:"name"`
+	Body     *PostNodeServiceDropInJSONRequestBody
+}
+
+type PostNodeServiceDropInResponseObject interface {
+	VisitPostNodeServiceDropInResponse(w http.ResponseWriter) error
+}
+
+type PostNodeServiceDropIn200JSONResponse ServiceDropInMutationResponse
+
+func (response PostNodeServiceDropIn200JSONResponse) VisitPostNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeServiceDropIn400JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeServiceDropIn400JSONResponse) VisitPostNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeServiceDropIn401JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeServiceDropIn401JSONResponse) VisitPostNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeServiceDropIn403JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeServiceDropIn403JSONResponse) VisitPostNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeServiceDropIn404JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeServiceDropIn404JSONResponse) VisitPostNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNodeServiceDropIn500JSONResponse externalRef0.ErrorResponse
+
+func (response PostNodeServiceDropIn500JSONResponse) VisitPostNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeServiceDropInRequestObject struct {
+	Hostname Hostname    `json:"hostname"`
+	Name     ServiceName `json:"name"`
+	Dropin   DropInName  `json:"dropin"`
+	Params   DeleteNodeServiceDropInParams
+}
+
+type DeleteNodeServiceDropInResponseObject interface {
+	VisitDeleteNodeServiceDropInResponse(w http.ResponseWriter) error
+}
+
+type DeleteNodeServiceDropIn200JSONResponse ServiceDropInMutationResponse
+
+func (response DeleteNodeServiceDropIn200JSONResponse) VisitDeleteNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeServiceDropIn400JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodeServiceDropIn400JSONResponse) VisitDeleteNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeServiceDropIn401JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodeServiceDropIn401JSONResponse) VisitDeleteNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeServiceDropIn403JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodeServiceDropIn403JSONResponse) VisitDeleteNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeServiceDropIn500JSONResponse externalRef0.ErrorResponse
+
+func (response DeleteNodeServiceDropIn500JSONResponse) VisitDeleteNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeServiceDropInRequestObject struct {
+	Hostname Hostname    `json:"hostname"`
+	Name     ServiceName `json:"name"`
+	Dropin   DropInName  `json:"dropin"`
+	Body     *PutNodeServiceDropInJSONRequestBody
+}
+
+type PutNodeServiceDropInResponseObject interface {
+	VisitPutNodeServiceDropInResponse(w http.ResponseWriter) error
+}
+
+type PutNodeServiceDropIn200JSONResponse ServiceDropInMutationResponse
+
+func (response PutNodeServiceDropIn200JSONResponse) VisitPutNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeServiceDropIn400JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodeServiceDropIn400JSONResponse) VisitPutNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeServiceDropIn401JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodeServiceDropIn401JSONResponse) VisitPutNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeServiceDropIn403JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodeServiceDropIn403JSONResponse) VisitPutNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeServiceDropIn404JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodeServiceDropIn404JSONResponse) VisitPutNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutNodeServiceDropIn500JSONResponse externalRef0.ErrorResponse
+
+func (response PutNodeServiceDropIn500JSONResponse) VisitPutNodeServiceDropInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type PostNodeServiceEnableRequestObject struct {
 	Hostname Hostname    `json:"hostname"`
 	Name     ServiceName `json:"name"`
@@ -1069,6 +1610,18 @@ type StrictServerInterface interface {
 	// Disable a service
 	// (POST /api/node/{hostname}/service/{name}/disable)
 	PostNodeServiceDisable(ctx context.Context, request PostNodeServiceDisableRequestObject) (PostNodeServiceDisableResponseObject, error)
+	// List service drop-ins
+	// (GET /api/node/{hostname}/service/{name}/dropin)
+	GetNodeServiceDropIn(ctx context.Context, request GetNodeServiceDropInRequestObject) (GetNodeServiceDropInResponseObject, error)
+	// Create a service drop-in
+	// (POST /api/node/{hostname}/service/{name}/dropin)
+	PostNodeServiceDropIn(ctx context.Context, request PostNodeServiceDropInRequestObject) (PostNodeServiceDropInResponseObject, error)
+	// Delete a service drop-in
+	// (DELETE /api/node/{hostname}/service/{name}/dropin/{dropin})
+	DeleteNodeServiceDropIn(ctx context.Context, request DeleteNodeServiceDropInRequestObject) (DeleteNodeServiceDropInResponseObject, error)
+	// Update a service drop-in
+	// (PUT /api/node/{hostname}/service/{name}/dropin/{dropin})
+	PutNodeServiceDropIn(ctx context.Context, request PutNodeServiceDropInRequestObject) (PutNodeServiceDropInResponseObject, error)
 	// Enable a service
 	// (POST /api/node/{hostname}/service/{name}/enable)
 	PostNodeServiceEnable(ctx context.Context, request PostNodeServiceEnableRequestObject) (PostNodeServiceEnableResponseObject, error)
@@ -1261,6 +1814,125 @@ func (sh *strictHandler) PostNodeServiceDisable(ctx echo.Context, hostname Hostn
 	return nil
 }
 
+// GetNodeServiceDropIn operation middleware
+func (sh *strictHandler) GetNodeServiceDropIn(ctx echo.Context, hostname Hostname, name ServiceName) error {
+	var request GetNodeServiceDropInRequestObject
+
+	request.Hostname = hostname
+	request.Name = name
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNodeServiceDropIn(ctx.Request().Context(), request.(GetNodeServiceDropInRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNodeServiceDropIn")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetNodeServiceDropInResponseObject); ok {
+		return validResponse.VisitGetNodeServiceDropInResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostNodeServiceDropIn operation middleware
+func (sh *strictHandler) PostNodeServiceDropIn(ctx echo.Context, hostname Hostname, name ServiceName) error {
+	var request PostNodeServiceDropInRequestObject
+
+	request.Hostname = hostname
+	request.Name = name
+
+	var body PostNodeServiceDropInJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostNodeServiceDropIn(ctx.Request().Context(), request.(PostNodeServiceDropInRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostNodeServiceDropIn")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostNodeServiceDropInResponseObject); ok {
+		return validResponse.VisitPostNodeServiceDropInResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteNodeServiceDropIn operation middleware
+func (sh *strictHandler) DeleteNodeServiceDropIn(ctx echo.Context, hostname Hostname, name ServiceName, dropin DropInName, params DeleteNodeServiceDropInParams) error {
+	var request DeleteNodeServiceDropInRequestObject
+
+	request.Hostname = hostname
+	request.Name = name
+	request.Dropin = dropin
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteNodeServiceDropIn(ctx.Request().Context(), request.(DeleteNodeServiceDropInRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteNodeServiceDropIn")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteNodeServiceDropInResponseObject); ok {
+		return validResponse.VisitDeleteNodeServiceDropInResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutNodeServiceDropIn operation middleware
+func (sh *strictHandler) PutNodeServiceDropIn(ctx echo.Context, hostname Hostname, name ServiceName, dropin DropInName) error {
+	var request PutNodeServiceDropInRequestObject
+
+	request.Hostname = hostname
+	request.Name = name
+	request.Dropin = dropin
+
+	var body PutNodeServiceDropInJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutNodeServiceDropIn(ctx.Request().Context(), request.(PutNodeServiceDropInRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutNodeServiceDropIn")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutNodeServiceDropInResponseObject); ok {
+		return validResponse.VisitPutNodeServiceDropInResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PostNodeServiceEnable operation middleware
 func (sh *strictHandler) PostNodeServiceEnable(ctx echo.Context, hostname Hostname, name ServiceName) error {
 	var request PostNodeServiceEnableRequestObject
@@ -0,0 +1,180 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/service/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	serviceProv "github.com/osapi-io/osapi/internal/provider/node/service"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// PostNodeServiceDropIn creates a drop-in override for a unit on a target node.
+func (s *Service) PostNodeServiceDropIn(
+	ctx context.Context,
+	request gen.PostNodeServiceDropInRequestObject,
+) (gen.PostNodeServiceDropInResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.PostNodeServiceDropIn400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Body); !ok {
+		return gen.PostNodeServiceDropIn400JSONResponse{Error: &errMsg}, nil
+	}
+
+	entry := serviceProv.DropInEntry{
+		Unit:   request.Name,
+		Object: request.Body.Object,
+	}
+	if request.Body.Name != nil {
+		entry.Name = *request.Body.Name
+	}
+	if request.Body.ContentType != nil {
+		entry.ContentType = string(*request.Body.ContentType)
+	}
+	if request.Body.Vars != nil {
+		entry.Vars = *request.Body.Vars
+	}
+	if request.Body.Restart != nil {
+		entry.Restart = *request.Body.Restart
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"service drop-in create",
+		slog.String("target", hostname),
+		slog.String("unit", entry.Unit),
+		slog.String("name", entry.Name),
+		slog.String("object", entry.Object),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.postNodeServiceDropInBroadcast(ctx, hostname, entry)
+	}
+
+	jobID, resp, err := s.JobClient.Modify(
+		ctx,
+		hostname,
+		"node",
+		job.OperationServiceDropInCreate,
+		entry,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "not found") {
+			return gen.PostNodeServiceDropIn404JSONResponse{Error: &errMsg}, nil
+		}
+		return gen.PostNodeServiceDropIn500JSONResponse{Error: &errMsg}, nil
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.PostNodeServiceDropIn200JSONResponse{
+		JobId:   &jobUUID,
+		Results: []gen.ServiceDropInMutationEntry{responseToDropInMutationEntry(resp)},
+	}, nil
+}
+
+// postNodeServiceDropInBroadcast handles broadcast targets for drop-in create.
+func (s *Service) postNodeServiceDropInBroadcast(
+	ctx context.Context,
+	target string,
+	entry serviceProv.DropInEntry,
+) (gen.PostNodeServiceDropInResponseObject, error) {
+	jobID, responses, err := s.JobClient.ModifyBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationServiceDropInCreate,
+		entry,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PostNodeServiceDropIn500JSONResponse{Error: &errMsg}, nil
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.PostNodeServiceDropIn200JSONResponse{
+		JobId:   &jobUUID,
+		Results: responsesToDropInMutationEntries(responses),
+	}, nil
+}
+
+// responseToDropInMutationEntry converts a job response to a gen
+// ServiceDropInMutationEntry.
+func responseToDropInMutationEntry(
+	resp *job.Response,
+) gen.ServiceDropInMutationEntry {
+	item := gen.ServiceDropInMutationEntry{
+		Hostname: resp.Hostname,
+	}
+
+	switch resp.Status {
+	case job.StatusFailed:
+		item.Status = gen.ServiceDropInMutationEntryStatusFailed
+		e := resp.Error
+		item.Error = &e
+	case job.StatusSkipped:
+		item.Status = gen.ServiceDropInMutationEntryStatusSkipped
+		e := resp.Error
+		item.Error = &e
+	default:
+		item.Status = gen.ServiceDropInMutationEntryStatusOk
+		var result serviceProv.DropInResult
+		if resp.Data != nil {
+			_ = json.Unmarshal(resp.Data, &result)
+		}
+		unit := result.Unit
+		name := result.Name
+		restarted := result.Restarted
+		item.Unit = &unit
+		item.Name = &name
+		item.Restarted = &restarted
+		item.Changed = resp.Changed
+	}
+
+	return item
+}
+
+// responsesToDropInMutationEntries converts broadcast job responses to gen
+// ServiceDropInMutationEntry values.
+func responsesToDropInMutationEntries(
+	responses map[string]*job.Response,
+) []gen.ServiceDropInMutationEntry {
+	apiResponses := make([]gen.ServiceDropInMutationEntry, 0, len(responses))
+	for host, resp := range responses {
+		item := responseToDropInMutationEntry(resp)
+		item.Hostname = host
+		apiResponses = append(apiResponses, item)
+	}
+
+	return apiResponses
+}
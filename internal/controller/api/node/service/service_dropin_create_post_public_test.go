@@ -0,0 +1,685 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/authtoken"
+	"github.com/osapi-io/osapi/internal/config"
+	"github.com/osapi-io/osapi/internal/controller/api"
+	apiservice "github.com/osapi-io/osapi/internal/controller/api/node/service"
+	"github.com/osapi-io/osapi/internal/controller/api/node/service/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	serviceProv "github.com/osapi-io/osapi/internal/provider/node/service"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+type ServiceDropInCreatePostPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl      *gomock.Controller
+	mockJobClient *jobmocks.MockJobClient
+	handler       *apiservice.Service
+	ctx           context.Context
+	appConfig     config.Config
+	logger        *slog.Logger
+}
+
+func (s *ServiceDropInCreatePostPublicTestSuite) SetupSuite() {
+	validation.RegisterTargetValidator(func(_ context.Context) ([]validation.AgentTarget, error) {
+		return []validation.AgentTarget{
+			{Hostname: "server1", Labels: map[string]string{"group": "web"}},
+			{Hostname: "server2"},
+		}, nil
+	})
+}
+
+func (s *ServiceDropInCreatePostPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockJobClient = jobmocks.NewMockJobClient(s.mockCtrl)
+	s.handler = apiservice.New(slog.Default(), s.mockJobClient)
+	s.ctx = context.Background()
+	s.appConfig = config.Config{}
+	s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (s *ServiceDropInCreatePostPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *ServiceDropInCreatePostPublicTestSuite) TestPostNodeServiceDropIn() {
+	tests := []struct {
+		name         string
+		request      gen.PostNodeServiceDropInRequestObject
+		setupMock    func()
+		validateFunc func(resp gen.PostNodeServiceDropInResponseObject)
+	}{
+		{
+			name: "success",
+			request: gen.PostNodeServiceDropInRequestObject{
+				Hostname: "server1",
+				Name:     "nginx",
+				Body: &gen.PostNodeServiceDropInJSONRequestBody{
+					Object:  "nginx-override",
+					Restart: boolPtr(true),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationServiceDropInCreate,
+						serviceProv.DropInEntry{
+							Unit:    "nginx",
+							Object:  "nginx-override",
+							Restart: true,
+						},
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(true),
+							Data: json.RawMessage(
+								`{"unit":"nginx.service","name":"override","changed":true,"restarted":true}`,
+							),
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PostNodeServiceDropInResponseObject) {
+				r, ok := resp.(gen.PostNodeServiceDropIn200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 1)
+				s.Equal("agent1", r.Results[0].Hostname)
+				s.Equal(gen.ServiceDropInMutationEntryStatusOk, r.Results[0].Status)
+				s.Equal("nginx.service", *r.Results[0].Unit)
+				s.Equal("override", *r.Results[0].Name)
+				s.Require().NotNil(r.Results[0].Changed)
+				s.True(*r.Results[0].Changed)
+				s.True(*r.Results[0].Restarted)
+			},
+		},
+		{
+			name: "success with nil response data",
+			request: gen.PostNodeServiceDropInRequestObject{
+				Hostname: "server1",
+				Name:     "nginx",
+				Body: &gen.PostNodeServiceDropInJSONRequestBody{
+					Object:  "nginx-override",
+					Restart: boolPtr(true),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationServiceDropInCreate,
+						serviceProv.DropInEntry{
+							Unit:    "nginx",
+							Object:  "nginx-override",
+							Restart: true,
+						},
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(false),
+							Data:     nil,
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PostNodeServiceDropInResponseObject) {
+				r, ok := resp.(gen.PostNodeServiceDropIn200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal("", *r.Results[0].Name)
+				s.False(*r.Results[0].Restarted)
+			},
+		},
+		{
+			name: "validation error empty hostname",
+			request: gen.PostNodeServiceDropInRequestObject{
+				Hostname: "",
+				Name:     "nginx",
+				Body: &gen.PostNodeServiceDropInJSONRequestBody{
+					Object:  "nginx-override",
+					Restart: boolPtr(true),
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodeServiceDropInResponseObject) {
+				r, ok := resp.(gen.PostNodeServiceDropIn400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "required")
+			},
+		},
+		{
+			name: "success with name content type and vars",
+			request: gen.PostNodeServiceDropInRequestObject{
+				Hostname: "server1",
+				Name:     "nginx",
+				Body: &gen.PostNodeServiceDropInJSONRequestBody{
+					Name:        stringPtr("limits"),
+					Object:      "nginx-limits",
+					ContentType: dropInContentTypePtr(gen.ServiceDropInCreateRequestContentTypeTemplate),
+					Vars:        &map[string]interface{}{"limit": 65536},
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationServiceDropInCreate,
+						serviceProv.DropInEntry{
+							Unit:        "nginx",
+							Name:        "limits",
+							Object:      "nginx-limits",
+							ContentType: "template",
+							Vars:        map[string]any{"limit": 65536},
+						},
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							JobID:    "550e8400-e29b-41d4-a716-446655440000",
+							Hostname: "agent1",
+							Changed:  boolPtr(true),
+							Data: json.RawMessage(
+								`{"unit":"nginx.service","name":"limits","changed":true}`,
+							),
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PostNodeServiceDropInResponseObject) {
+				r, ok := resp.(gen.PostNodeServiceDropIn200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal("limits", *r.Results[0].Name)
+				s.False(*r.Results[0].Restarted)
+			},
+		},
+		{
+			name: "body validation error empty object",
+			request: gen.PostNodeServiceDropInRequestObject{
+				Hostname: "server1",
+				Name:     "nginx",
+				Body: &gen.PostNodeServiceDropInJSONRequestBody{
+					Object: "",
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodeServiceDropInResponseObject) {
+				r, ok := resp.(gen.PostNodeServiceDropIn400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+			},
+		},
+		{
+			name: "body validation error invalid content type",
+			request: gen.PostNodeServiceDropInRequestObject{
+				Hostname: "server1",
+				Name:     "nginx",
+				Body: &gen.PostNodeServiceDropInJSONRequestBody{
+					Object:      "nginx-override",
+					ContentType: dropInContentTypePtr("yaml"),
+				},
+			},
+			setupMock: func() {},
+			validateFunc: func(resp gen.PostNodeServiceDropInResponseObject) {
+				r, ok := resp.(gen.PostNodeServiceDropIn400JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+			},
+		},
+		{
+			name: "when job skipped",
+			request: gen.PostNodeServiceDropInRequestObject{
+				Hostname: "server1",
+				Name:     "nginx",
+				Body: &gen.PostNodeServiceDropInJSONRequestBody{
+					Object:  "nginx-override",
+					Restart: boolPtr(true),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationServiceDropInCreate,
+						serviceProv.DropInEntry{
+							Unit:    "nginx",
+							Object:  "nginx-override",
+							Restart: true,
+						},
+					).
+					Return(
+						"550e8400-e29b-41d4-a716-446655440000",
+						&job.Response{
+							Status:   job.StatusSkipped,
+							Hostname: "server1",
+							Error:    "service: operation not supported on this OS family",
+						},
+						nil,
+					)
+			},
+			validateFunc: func(resp gen.PostNodeServiceDropInResponseObject) {
+				r, ok := resp.(gen.PostNodeServiceDropIn200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal("server1", r.Results[0].Hostname)
+				s.Equal(gen.ServiceDropInMutationEntryStatusSkipped, r.Results[0].Status)
+				s.Require().NotNil(r.Results[0].Error)
+				s.Contains(*r.Results[0].Error, "not supported")
+			},
+		},
+		{
+			name: "when unit not found returns 404",
+			request: gen.PostNodeServiceDropInRequestObject{
+				Hostname: "server1",
+				Name:     "nginx",
+				Body: &gen.PostNodeServiceDropInJSONRequestBody{
+					Object:  "nginx-override",
+					Restart: boolPtr(true),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationServiceDropInCreate,
+						serviceProv.DropInEntry{
+							Unit:    "nginx",
+							Object:  "nginx-override",
+							Restart: true,
+						},
+					).
+					Return("", nil, fmt.Errorf("job failed: not found"))
+			},
+			validateFunc: func(resp gen.PostNodeServiceDropInResponseObject) {
+				r, ok := resp.(gen.PostNodeServiceDropIn404JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.Error)
+				s.Contains(*r.Error, "not found")
+			},
+		},
+		{
+			name: "job client error",
+			request: gen.PostNodeServiceDropInRequestObject{
+				Hostname: "server1",
+				Name:     "nginx",
+				Body: &gen.PostNodeServiceDropInJSONRequestBody{
+					Object:  "nginx-override",
+					Restart: boolPtr(true),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(
+						gomock.Any(),
+						"server1",
+						"node",
+						job.OperationServiceDropInCreate,
+						serviceProv.DropInEntry{
+							Unit:    "nginx",
+							Object:  "nginx-override",
+							Restart: true,
+						},
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PostNodeServiceDropInResponseObject) {
+				_, ok := resp.(gen.PostNodeServiceDropIn500JSONResponse)
+				s.True(ok)
+			},
+		},
+		{
+			name: "broadcast success",
+			request: gen.PostNodeServiceDropInRequestObject{
+				Hostname: "_all",
+				Name:     "nginx",
+				Body: &gen.PostNodeServiceDropInJSONRequestBody{
+					Object:  "nginx-override",
+					Restart: boolPtr(true),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationServiceDropInCreate,
+						serviceProv.DropInEntry{
+							Unit:    "nginx",
+							Object:  "nginx-override",
+							Restart: true,
+						},
+					).
+					Return("550e8400-e29b-41d4-a716-446655440000", map[string]*job.Response{
+						"server1": {
+							Hostname: "server1",
+							Changed:  boolPtr(true),
+							Data: json.RawMessage(
+								`{"unit":"nginx.service","name":"override","changed":true}`,
+							),
+						},
+						"server2": {
+							Status:   job.StatusFailed,
+							Hostname: "server2",
+							Error:    "agent unreachable",
+						},
+						"server3": {
+							Status:   job.StatusSkipped,
+							Hostname: "server3",
+							Error:    "service: operation not supported on this OS family",
+						},
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeServiceDropInResponseObject) {
+				r, ok := resp.(gen.PostNodeServiceDropIn200JSONResponse)
+				s.True(ok)
+				s.Require().NotNil(r.JobId)
+				s.Require().Len(r.Results, 3)
+
+				byHost := make(map[string]gen.ServiceDropInMutationEntry)
+				for _, entry := range r.Results {
+					byHost[entry.Hostname] = entry
+				}
+				s.Equal(gen.ServiceDropInMutationEntryStatusOk, byHost["server1"].Status)
+				s.True(*byHost["server1"].Changed)
+				s.Equal(gen.ServiceDropInMutationEntryStatusFailed, byHost["server2"].Status)
+				s.Contains(*byHost["server2"].Error, "unreachable")
+				s.Equal(gen.ServiceDropInMutationEntryStatusSkipped, byHost["server3"].Status)
+			},
+		},
+		{
+			name: "broadcast error collecting responses",
+			request: gen.PostNodeServiceDropInRequestObject{
+				Hostname: "_all",
+				Name:     "nginx",
+				Body: &gen.PostNodeServiceDropInJSONRequestBody{
+					Object:  "nginx-override",
+					Restart: boolPtr(true),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					ModifyBroadcast(
+						gomock.Any(),
+						"_all",
+						"node",
+						job.OperationServiceDropInCreate,
+						serviceProv.DropInEntry{
+							Unit:    "nginx",
+							Object:  "nginx-override",
+							Restart: true,
+						},
+					).
+					Return("", nil, assert.AnError)
+			},
+			validateFunc: func(resp gen.PostNodeServiceDropInResponseObject) {
+				_, ok := resp.(gen.PostNodeServiceDropIn500JSONResponse)
+				s.True(ok)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			tt.setupMock()
+
+			resp, err := s.handler.PostNodeServiceDropIn(s.ctx, tt.request)
+			s.NoError(err)
+			tt.validateFunc(resp)
+		})
+	}
+}
+
+func (s *ServiceDropInCreatePostPublicTestSuite) TestPostNodeServiceDropInValidationHTTP() {
+	tests := []struct {
+		name         string
+		path         string
+		body         string
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when valid request",
+			path: "/api/node/server1/service/nginx/dropin",
+			body: `{"object":"nginx-override","restart":true}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationServiceDropInCreate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID:    "550e8400-e29b-41d4-a716-446655440000",
+						Hostname: "agent1",
+						Changed:  boolPtr(true),
+						Data:     json.RawMessage(`{"unit":"nginx.service","name":"override","changed":true}`),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+		{
+			name: "when target agent not found",
+			path: "/api/node/nonexistent/service/nginx/dropin",
+			body: `{"object":"nginx-override","restart":true}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`, "valid_target"},
+		},
+		{
+			name: "when invalid body empty object",
+			path: "/api/node/server1/service/nginx/dropin",
+			body: `{"object":""}`,
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusBadRequest,
+			wantContains: []string{`"error"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			serviceHandler := apiservice.New(s.logger, jobMock)
+			strictHandler := gen.NewStrictHandler(serviceHandler, nil)
+
+			a := api.New(s.appConfig, s.logger)
+			gen.RegisterHandlers(a.Echo, strictHandler)
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				tc.path,
+				strings.NewReader(tc.body),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			a.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+const rbacServiceDropInCreateTestSigningKey = "test-signing-key-for-rbac-servicedropincreate"
+
+func (s *ServiceDropInCreatePostPublicTestSuite) TestPostNodeServiceDropInRBACHTTP() {
+	tokenManager := authtoken.New(s.logger)
+
+	tests := []struct {
+		name         string
+		setupAuth    func(req *http.Request)
+		setupJobMock func() *jobmocks.MockJobClient
+		wantCode     int
+		wantContains []string
+	}{
+		{
+			name: "when no token returns 401",
+			setupAuth: func(_ *http.Request) {
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusUnauthorized,
+			wantContains: []string{"Bearer token required"},
+		},
+		{
+			name: "when insufficient permissions returns 403",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacServiceDropInCreateTestSigningKey,
+					[]string{"read"},
+					"test-user",
+					[]string{"docker:write"},
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				return jobmocks.NewMockJobClient(s.mockCtrl)
+			},
+			wantCode:     http.StatusForbidden,
+			wantContains: []string{"Insufficient permissions"},
+		},
+		{
+			name: "when valid admin token returns 200",
+			setupAuth: func(req *http.Request) {
+				token, err := tokenManager.Generate(
+					rbacServiceDropInCreateTestSigningKey,
+					[]string{"admin"},
+					"test-user",
+					nil,
+				)
+				s.Require().NoError(err)
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+			},
+			setupJobMock: func() *jobmocks.MockJobClient {
+				mock := jobmocks.NewMockJobClient(s.mockCtrl)
+				mock.EXPECT().
+					Modify(gomock.Any(), "server1", "node", job.OperationServiceDropInCreate, gomock.Any()).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID:    "550e8400-e29b-41d4-a716-446655440000",
+						Hostname: "agent1",
+						Changed:  boolPtr(true),
+						Data:     json.RawMessage(`{"unit":"nginx.service","name":"override","changed":true}`),
+					}, nil)
+				return mock
+			},
+			wantCode:     http.StatusOK,
+			wantContains: []string{`"job_id"`, `"results"`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			jobMock := tc.setupJobMock()
+
+			appConfig := config.Config{
+				Controller: config.Controller{
+					API: config.APIServer{
+						Security: config.ServerSecurity{
+							SigningKey: rbacServiceDropInCreateTestSigningKey,
+						},
+					},
+				},
+			}
+
+			server := api.New(appConfig, s.logger)
+			handlers := apiservice.Handler(
+				s.logger,
+				jobMock,
+				appConfig.Controller.API.Security.SigningKey,
+				nil,
+			)
+			server.RegisterHandlers(handlers)
+
+			req := httptest.NewRequest(
+				http.MethodPost,
+				"/api/node/server1/service/nginx/dropin",
+				strings.NewReader(`{"object":"nginx-override","restart":true}`),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			tc.setupAuth(req)
+			rec := httptest.NewRecorder()
+
+			server.Echo.ServeHTTP(rec, req)
+
+			s.Equal(tc.wantCode, rec.Code)
+			for _, str := range tc.wantContains {
+				s.Contains(rec.Body.String(), str)
+			}
+		})
+	}
+}
+
+func TestServiceDropInCreatePostPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceDropInCreatePostPublicTestSuite))
+}
+
+func dropInContentTypePtr(
+	v gen.ServiceDropInCreateRequestContentType,
+) *gen.ServiceDropInCreateRequestContentType {
+	return &v
+}
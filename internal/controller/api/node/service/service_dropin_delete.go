@@ -0,0 +1,109 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/service/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	serviceProv "github.com/osapi-io/osapi/internal/provider/node/service"
+)
+
+// DeleteNodeServiceDropIn deletes a managed drop-in of a unit on a target node.
+func (s *Service) DeleteNodeServiceDropIn(
+	ctx context.Context,
+	request gen.DeleteNodeServiceDropInRequestObject,
+) (gen.DeleteNodeServiceDropInResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.DeleteNodeServiceDropIn400JSONResponse{Error: &errMsg}, nil
+	}
+
+	entry := serviceProv.DropInEntry{
+		Unit: request.Name,
+		Name: request.Dropin,
+	}
+	if request.Params.Restart != nil {
+		entry.Restart = *request.Params.Restart
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"service drop-in delete",
+		slog.String("target", hostname),
+		slog.String("unit", entry.Unit),
+		slog.String("name", entry.Name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.deleteNodeServiceDropInBroadcast(ctx, hostname, entry)
+	}
+
+	jobID, resp, err := s.JobClient.Modify(
+		ctx,
+		hostname,
+		"node",
+		job.OperationServiceDropInDelete,
+		entry,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.DeleteNodeServiceDropIn500JSONResponse{Error: &errMsg}, nil
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.DeleteNodeServiceDropIn200JSONResponse{
+		JobId:   &jobUUID,
+		Results: []gen.ServiceDropInMutationEntry{responseToDropInMutationEntry(resp)},
+	}, nil
+}
+
+// deleteNodeServiceDropInBroadcast handles broadcast targets for drop-in delete.
+func (s *Service) deleteNodeServiceDropInBroadcast(
+	ctx context.Context,
+	target string,
+	entry serviceProv.DropInEntry,
+) (gen.DeleteNodeServiceDropInResponseObject, error) {
+	jobID, responses, err := s.JobClient.ModifyBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationServiceDropInDelete,
+		entry,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.DeleteNodeServiceDropIn500JSONResponse{Error: &errMsg}, nil
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.DeleteNodeServiceDropIn200JSONResponse{
+		JobId:   &jobUUID,
+		Results: responsesToDropInMutationEntries(responses),
+	}, nil
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/service/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	serviceProv "github.com/osapi-io/osapi/internal/provider/node/service"
+)
+
+// GetNodeServiceDropIn lists the drop-ins of a unit on a target node.
+func (s *Service) GetNodeServiceDropIn(
+	ctx context.Context,
+	request gen.GetNodeServiceDropInRequestObject,
+) (gen.GetNodeServiceDropInResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeServiceDropIn400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+	name := request.Name
+
+	s.logger.Debug(
+		"service drop-in list",
+		slog.String("target", hostname),
+		slog.String("name", name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeServiceDropInBroadcast(ctx, hostname, name)
+	}
+
+	jobID, resp, err := s.JobClient.Query(
+		ctx,
+		hostname,
+		"node",
+		job.OperationServiceDropInList,
+		map[string]string{"name": name},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeServiceDropIn500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeServiceDropIn200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.ServiceDropInListEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.ServiceDropInListEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeServiceDropIn200JSONResponse{
+		JobId:   &jobUUID,
+		Results: []gen.ServiceDropInListEntry{responseToDropInListEntry(resp)},
+	}, nil
+}
+
+// getNodeServiceDropInBroadcast handles broadcast targets for drop-in list.
+func (s *Service) getNodeServiceDropInBroadcast(
+	ctx context.Context,
+	target string,
+	name string,
+) (gen.GetNodeServiceDropInResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationServiceDropInList,
+		map[string]string{"name": name},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeServiceDropIn500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.ServiceDropInListEntry, 0)
+	for host, resp := range responses {
+		switch resp.Status {
+		case job.StatusFailed:
+			e := resp.Error
+			allResults = append(allResults, gen.ServiceDropInListEntry{
+				Hostname: host,
+				Status:   gen.ServiceDropInListEntryStatusFailed,
+				Error:    &e,
+			})
+		case job.StatusSkipped:
+			e := resp.Error
+			allResults = append(allResults, gen.ServiceDropInListEntry{
+				Hostname: host,
+				Status:   gen.ServiceDropInListEntryStatusSkipped,
+				Error:    &e,
+			})
+		default:
+			allResults = append(allResults, responseToDropInListEntry(resp))
+		}
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeServiceDropIn200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
+
+// responseToDropInListEntry converts a job response to a gen ServiceDropInListEntry.
+func responseToDropInListEntry(
+	resp *job.Response,
+) gen.ServiceDropInListEntry {
+	var dropIns []serviceProv.DropIn
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &dropIns)
+	}
+
+	items := make([]gen.ServiceDropInInfo, 0, len(dropIns))
+	for _, d := range dropIns {
+		items = append(items, dropInToGen(d))
+	}
+
+	return gen.ServiceDropInListEntry{
+		Hostname: resp.Hostname,
+		Status:   gen.ServiceDropInListEntryStatusOk,
+		Dropins:  &items,
+	}
+}
+
+// dropInToGen converts a provider DropIn to a gen ServiceDropInInfo.
+func dropInToGen(
+	d serviceProv.DropIn,
+) gen.ServiceDropInInfo {
+	name := d.Name
+	path := d.Path
+	managed := d.Managed
+
+	result := gen.ServiceDropInInfo{
+		Name:    &name,
+		Path:    &path,
+		Managed: &managed,
+	}
+
+	if d.Object != "" {
+		object := d.Object
+		result.Object = &object
+	}
+	if d.SHA256 != "" {
+		sha := d.SHA256
+		result.Sha256 = &sha
+	}
+
+	return result
+}
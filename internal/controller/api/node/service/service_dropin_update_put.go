@@ -0,0 +1,127 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/service/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	serviceProv "github.com/osapi-io/osapi/internal/provider/node/service"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// PutNodeServiceDropIn updates a managed drop-in of a unit on a target node.
+func (s *Service) PutNodeServiceDropIn(
+	ctx context.Context,
+	request gen.PutNodeServiceDropInRequestObject,
+) (gen.PutNodeServiceDropInResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.PutNodeServiceDropIn400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Body); !ok {
+		return gen.PutNodeServiceDropIn400JSONResponse{Error: &errMsg}, nil
+	}
+
+	entry := serviceProv.DropInEntry{
+		Unit: request.Name,
+		Name: request.Dropin,
+	}
+	if request.Body.Object != nil {
+		entry.Object = *request.Body.Object
+	}
+	if request.Body.ContentType != nil {
+		entry.ContentType = string(*request.Body.ContentType)
+	}
+	if request.Body.Vars != nil {
+		entry.Vars = *request.Body.Vars
+	}
+	if request.Body.Restart != nil {
+		entry.Restart = *request.Body.Restart
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"service drop-in update",
+		slog.String("target", hostname),
+		slog.String("unit", entry.Unit),
+		slog.String("name", entry.Name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.putNodeServiceDropInBroadcast(ctx, hostname, entry)
+	}
+
+	jobID, resp, err := s.JobClient.Modify(
+		ctx,
+		hostname,
+		"node",
+		job.OperationServiceDropInUpdate,
+		entry,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "not managed") || strings.Contains(errMsg, "not found") {
+			return gen.PutNodeServiceDropIn404JSONResponse{Error: &errMsg}, nil
+		}
+		return gen.PutNodeServiceDropIn500JSONResponse{Error: &errMsg}, nil
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.PutNodeServiceDropIn200JSONResponse{
+		JobId:   &jobUUID,
+		Results: []gen.ServiceDropInMutationEntry{responseToDropInMutationEntry(resp)},
+	}, nil
+}
+
+// putNodeServiceDropInBroadcast handles broadcast targets for drop-in update.
+func (s *Service) putNodeServiceDropInBroadcast(
+	ctx context.Context,
+	target string,
+	entry serviceProv.DropInEntry,
+) (gen.PutNodeServiceDropInResponseObject, error) {
+	jobID, responses, err := s.JobClient.ModifyBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationServiceDropInUpdate,
+		entry,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PutNodeServiceDropIn500JSONResponse{Error: &errMsg}, nil
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.PutNodeServiceDropIn200JSONResponse{
+		JobId:   &jobUUID,
+		Results: responsesToDropInMutationEntries(responses),
+	}, nil
+}
@@ -25,3 +25,9 @@ func boolPtr(
 ) *bool {
 	return &b
 }
+
+func stringPtr(
+	s string,
+) *string {
+	return &s
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package sshd
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/sshd/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	sshdProv "github.com/osapi-io/osapi/internal/provider/node/sshd"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// PostNodeSshd creates an sshd drop-in on a target node.
+func (s *Sshd) PostNodeSshd(
+	ctx context.Context,
+	request gen.PostNodeSshdRequestObject,
+) (gen.PostNodeSshdResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.PostNodeSshd400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Body); !ok {
+		return gen.PostNodeSshd400JSONResponse{Error: &errMsg}, nil
+	}
+
+	dropIn := sshdProv.DropIn{
+		Name:       request.Body.Name,
+		Directives: directivesFromGen(request.Body.Directives),
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"sshd drop-in create",
+		slog.String("target", hostname),
+		slog.String("name", dropIn.Name),
+		slog.Int("directives", len(dropIn.Directives)),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.postNodeSshdCreateBroadcast(ctx, hostname, dropIn)
+	}
+
+	jobID, resp, err := s.JobClient.Modify(
+		ctx,
+		hostname,
+		"node",
+		job.OperationSshdCreate,
+		dropIn,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PostNodeSshd500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		jobUUID := uuid.MustParse(jobID)
+		e := resp.Error
+		return gen.PostNodeSshd200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.SshdMutationEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.SshdMutationEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	var result sshdProv.Result
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &result)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	changed := resp.Changed
+	name := result.Name
+	agentHostname := resp.Hostname
+
+	return gen.PostNodeSshd200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.SshdMutationEntry{
+			{
+				Hostname: agentHostname,
+				Status:   gen.SshdMutationEntryStatusOk,
+				Name:     &name,
+				Changed:  changed,
+			},
+		},
+	}, nil
+}
+
+// postNodeSshdCreateBroadcast handles broadcast targets for sshd drop-in create.
+func (s *Sshd) postNodeSshdCreateBroadcast(
+	ctx context.Context,
+	target string,
+	dropIn sshdProv.DropIn,
+) (gen.PostNodeSshdResponseObject, error) {
+	jobID, responses, err := s.JobClient.ModifyBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationSshdCreate,
+		dropIn,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PostNodeSshd500JSONResponse{Error: &errMsg}, nil
+	}
+
+	var apiResponses []gen.SshdMutationEntry
+	for host, resp := range responses {
+		item := gen.SshdMutationEntry{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			item.Status = gen.SshdMutationEntryStatusFailed
+			e := resp.Error
+			item.Error = &e
+		case job.StatusSkipped:
+			item.Status = gen.SshdMutationEntryStatusSkipped
+			e := resp.Error
+			item.Error = &e
+		default:
+			item.Status = gen.SshdMutationEntryStatusOk
+			var result sshdProv.Result
+			if resp.Data != nil {
+				_ = json.Unmarshal(resp.Data, &result)
+			}
+			name := result.Name
+			item.Name = &name
+			item.Changed = resp.Changed
+		}
+		apiResponses = append(apiResponses, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.PostNodeSshd200JSONResponse{
+		JobId:   &jobUUID,
+		Results: apiResponses,
+	}, nil
+}
+
+// directivesFromGen converts gen SshdDirective values to provider
+// directives.
+func directivesFromGen(
+	directives []gen.SshdDirective,
+) []sshdProv.Directive {
+	result := make([]sshdProv.Directive, 0, len(directives))
+	for _, directive := range directives {
+		result = append(result, sshdProv.Directive{
+			Keyword: directive.Keyword,
+			Value:   directive.Value,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package sshd
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/sshd/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	sshdProv "github.com/osapi-io/osapi/internal/provider/node/sshd"
+)
+
+// GetNodeSshd gets the effective sshd configuration and the managed
+// drop-ins on a target node.
+func (s *Sshd) GetNodeSshd(
+	ctx context.Context,
+	request gen.GetNodeSshdRequestObject,
+) (gen.GetNodeSshdResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeSshd400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"sshd config get",
+		slog.String("target", hostname),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeSshdBroadcast(ctx, hostname)
+	}
+
+	jobID, resp, err := s.JobClient.Query(
+		ctx,
+		hostname,
+		"node",
+		job.OperationSshdGet,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeSshd500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeSshd200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.SshdGetEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.SshdGetEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	results := responseToSshdGetEntries(resp)
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeSshd200JSONResponse{
+		JobId:   &jobUUID,
+		Results: results,
+	}, nil
+}
+
+// getNodeSshdBroadcast handles broadcast targets for sshd config get.
+func (s *Sshd) getNodeSshdBroadcast(
+	ctx context.Context,
+	target string,
+) (gen.GetNodeSshdResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationSshdGet,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeSshd500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.SshdGetEntry, 0)
+	for host, resp := range responses {
+		switch resp.Status {
+		case job.StatusFailed:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.SshdGetEntry{
+				Hostname: h,
+				Status:   gen.SshdGetEntryStatusFailed,
+				Error:    &e,
+			})
+		case job.StatusSkipped:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.SshdGetEntry{
+				Hostname: h,
+				Status:   gen.SshdGetEntryStatusSkipped,
+				Error:    &e,
+			})
+		default:
+			allResults = append(allResults, responseToSshdGetEntries(resp)...)
+		}
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeSshd200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
+
+// responseToSshdGetEntries converts a job response to gen SshdGetEntry slice.
+func responseToSshdGetEntries(
+	resp *job.Response,
+) []gen.SshdGetEntry {
+	var config sshdProv.Config
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &config)
+	}
+
+	hostname := resp.Hostname
+
+	effective := directivesToGen(config.Effective)
+	dropIns := make([]gen.SshdDropInInfo, 0, len(config.DropIns))
+	for _, dropIn := range config.DropIns {
+		dropIns = append(dropIns, dropInToGen(dropIn))
+	}
+
+	return []gen.SshdGetEntry{
+		{
+			Hostname:  hostname,
+			Status:    gen.SshdGetEntryStatusOk,
+			Effective: &effective,
+			DropIns:   &dropIns,
+		},
+	}
+}
+
+// dropInToGen converts a provider DropIn to a gen SshdDropInInfo.
+func dropInToGen(
+	dropIn sshdProv.DropIn,
+) gen.SshdDropInInfo {
+	name := dropIn.Name
+	path := dropIn.Path
+	directives := directivesToGen(dropIn.Directives)
+
+	return gen.SshdDropInInfo{
+		Name:       &name,
+		Path:       &path,
+		Directives: &directives,
+	}
+}
+
+// directivesToGen converts provider Directives to gen SshdDirective values.
+func directivesToGen(
+	directives []sshdProv.Directive,
+) []gen.SshdDirective {
+	result := make([]gen.SshdDirective, 0, len(directives))
+	for _, directive := range directives {
+		result = append(result, gen.SshdDirective{
+			Keyword: directive.Keyword,
+			Value:   directive.Value,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package sudoers
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/sudoers/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	sudoersProv "github.com/osapi-io/osapi/internal/provider/node/sudoers"
+)
+
+// GetNodeSudoersByName gets details for a single sudoers drop-in on a target node.
+func (s *Sudoers) GetNodeSudoersByName(
+	ctx context.Context,
+	request gen.GetNodeSudoersByNameRequestObject,
+) (gen.GetNodeSudoersByNameResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeSudoersByName400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+	name := request.Name
+
+	s.logger.Debug(
+		"sudoers drop-in get",
+		slog.String("target", hostname),
+		slog.String("name", name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeSudoersByNameBroadcast(ctx, hostname, name)
+	}
+
+	jobID, resp, err := s.JobClient.Query(
+		ctx,
+		hostname,
+		"node",
+		job.OperationSudoersGet,
+		map[string]string{"name": name},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "not found") || strings.Contains(errMsg, "does not exist") {
+			return gen.GetNodeSudoersByName404JSONResponse{Error: &errMsg}, nil
+		}
+		return gen.GetNodeSudoersByName500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeSudoersByName200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.SudoersGetEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.SudoersGetEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	results := responseToSudoersGetEntries(resp)
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeSudoersByName200JSONResponse{
+		JobId:   &jobUUID,
+		Results: results,
+	}, nil
+}
+
+// getNodeSudoersByNameBroadcast handles broadcast targets for sudoers drop-in get.
+func (s *Sudoers) getNodeSudoersByNameBroadcast(
+	ctx context.Context,
+	target string,
+	name string,
+) (gen.GetNodeSudoersByNameResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationSudoersGet,
+		map[string]string{"name": name},
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeSudoersByName500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.SudoersGetEntry, 0)
+	for host, resp := range responses {
+		switch resp.Status {
+		case job.StatusFailed:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.SudoersGetEntry{
+				Hostname: h,
+				Status:   gen.SudoersGetEntryStatusFailed,
+				Error:    &e,
+			})
+		case job.StatusSkipped:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.SudoersGetEntry{
+				Hostname: h,
+				Status:   gen.SudoersGetEntryStatusSkipped,
+				Error:    &e,
+			})
+		default:
+			allResults = append(allResults, responseToSudoersGetEntries(resp)...)
+		}
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeSudoersByName200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
+
+// responseToSudoersGetEntries converts a job response to gen SudoersGetEntry slice.
+func responseToSudoersGetEntries(
+	resp *job.Response,
+) []gen.SudoersGetEntry {
+	var info sudoersProv.Entry
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &info)
+	}
+
+	hostname := resp.Hostname
+	m := sudoersInfoToGen(info)
+
+	return []gen.SudoersGetEntry{
+		{
+			Hostname: hostname,
+			Status:   gen.SudoersGetEntryStatusOk,
+			DropIn:   &m,
+		},
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package sudoers
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/sudoers/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	sudoersProv "github.com/osapi-io/osapi/internal/provider/node/sudoers"
+)
+
+// GetNodeSudoers lists the managed sudoers drop-ins on a target node.
+func (s *Sudoers) GetNodeSudoers(
+	ctx context.Context,
+	request gen.GetNodeSudoersRequestObject,
+) (gen.GetNodeSudoersResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeSudoers400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"sudoers drop-in list",
+		slog.String("target", hostname),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeSudoersBroadcast(ctx, hostname)
+	}
+
+	jobID, resp, err := s.JobClient.Query(
+		ctx,
+		hostname,
+		"node",
+		job.OperationSudoersList,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeSudoers500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeSudoers200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.SudoersListEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.SudoersListEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	results := responseToSudoersListEntries(resp)
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeSudoers200JSONResponse{
+		JobId:   &jobUUID,
+		Results: results,
+	}, nil
+}
+
+// getNodeSudoersBroadcast handles broadcast targets for sudoers drop-in list.
+func (s *Sudoers) getNodeSudoersBroadcast(
+	ctx context.Context,
+	target string,
+) (gen.GetNodeSudoersResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationSudoersList,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeSudoers500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.SudoersListEntry, 0)
+	for host, resp := range responses {
+		switch resp.Status {
+		case job.StatusFailed:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.SudoersListEntry{
+				Hostname: h,
+				Status:   gen.SudoersListEntryStatusFailed,
+				Error:    &e,
+			})
+		case job.StatusSkipped:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.SudoersListEntry{
+				Hostname: h,
+				Status:   gen.SudoersListEntryStatusSkipped,
+				Error:    &e,
+			})
+		default:
+			allResults = append(allResults, responseToSudoersListEntries(resp)...)
+		}
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeSudoers200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
+
+// responseToSudoersListEntries converts a job response to gen SudoersListEntry slice.
+func responseToSudoersListEntries(
+	resp *job.Response,
+) []gen.SudoersListEntry {
+	var infos []sudoersProv.Entry
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &infos)
+	}
+
+	hostname := resp.Hostname
+
+	dropIns := make([]gen.SudoersInfo, 0, len(infos))
+	for _, info := range infos {
+		dropIns = append(dropIns, sudoersInfoToGen(info))
+	}
+
+	return []gen.SudoersListEntry{
+		{
+			Hostname: hostname,
+			Status:   gen.SudoersListEntryStatusOk,
+			DropIns:  &dropIns,
+		},
+	}
+}
+
+// sudoersInfoToGen converts a provider Entry to a gen SudoersInfo.
+func sudoersInfoToGen(
+	info sudoersProv.Entry,
+) gen.SudoersInfo {
+	name := info.Name
+	path := info.Path
+	rules := make([]gen.SudoersRule, 0, len(info.Rules))
+	for _, rule := range info.Rules {
+		rules = append(rules, ruleToGen(rule))
+	}
+
+	return gen.SudoersInfo{
+		Name:  &name,
+		Path:  &path,
+		Rules: &rules,
+	}
+}
+
+// ruleToGen converts a provider Rule to a gen SudoersRule.
+func ruleToGen(
+	rule sudoersProv.Rule,
+) gen.SudoersRule {
+	result := gen.SudoersRule{
+		Principal: rule.Principal,
+	}
+
+	if len(rule.Hosts) > 0 {
+		hosts := rule.Hosts
+		result.Hosts = &hosts
+	}
+	if len(rule.RunAs) > 0 {
+		runAs := rule.RunAs
+		result.RunAs = &runAs
+	}
+	if rule.NoPassword {
+		noPassword := rule.NoPassword
+		result.NoPassword = &noPassword
+	}
+	if len(rule.Commands) > 0 {
+		commands := rule.Commands
+		result.Commands = &commands
+	}
+
+	return result
+}
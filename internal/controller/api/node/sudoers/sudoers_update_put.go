@@ -0,0 +1,172 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package sudoers
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/sudoers/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	sudoersProv "github.com/osapi-io/osapi/internal/provider/node/sudoers"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// PutNodeSudoers updates a managed sudoers drop-in on a target node.
+func (s *Sudoers) PutNodeSudoers(
+	ctx context.Context,
+	request gen.PutNodeSudoersRequestObject,
+) (gen.PutNodeSudoersResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.PutNodeSudoers400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Body); !ok {
+		return gen.PutNodeSudoers400JSONResponse{Error: &errMsg}, nil
+	}
+
+	entry := sudoersProv.Entry{
+		Name:  request.Name,
+		Rules: rulesFromGen(request.Body.Rules),
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"sudoers drop-in update",
+		slog.String("target", hostname),
+		slog.String("name", entry.Name),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.putNodeSudoersUpdateBroadcast(ctx, hostname, entry)
+	}
+
+	jobID, resp, err := s.JobClient.Modify(
+		ctx,
+		hostname,
+		"node",
+		job.OperationSudoersUpdate,
+		entry,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "not found") || strings.Contains(errMsg, "does not exist") {
+			return gen.PutNodeSudoers404JSONResponse{Error: &errMsg}, nil
+		}
+		return gen.PutNodeSudoers500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		jobUUID := uuid.MustParse(jobID)
+		e := resp.Error
+		return gen.PutNodeSudoers200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.SudoersMutationEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.SudoersMutationEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	var result sudoersProv.Result
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &result)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	changed := resp.Changed
+	name := result.Name
+	agentHostname := resp.Hostname
+
+	return gen.PutNodeSudoers200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.SudoersMutationEntry{
+			{
+				Hostname: agentHostname,
+				Status:   gen.SudoersMutationEntryStatusOk,
+				Name:     &name,
+				Changed:  changed,
+			},
+		},
+	}, nil
+}
+
+// putNodeSudoersUpdateBroadcast handles broadcast targets for sudoers drop-in update.
+func (s *Sudoers) putNodeSudoersUpdateBroadcast(
+	ctx context.Context,
+	target string,
+	entry sudoersProv.Entry,
+) (gen.PutNodeSudoersResponseObject, error) {
+	jobID, responses, err := s.JobClient.ModifyBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationSudoersUpdate,
+		entry,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PutNodeSudoers500JSONResponse{Error: &errMsg}, nil
+	}
+
+	var apiResponses []gen.SudoersMutationEntry
+	for host, resp := range responses {
+		item := gen.SudoersMutationEntry{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			item.Status = gen.SudoersMutationEntryStatusFailed
+			e := resp.Error
+			item.Error = &e
+		case job.StatusSkipped:
+			item.Status = gen.SudoersMutationEntryStatusSkipped
+			e := resp.Error
+			item.Error = &e
+		default:
+			item.Status = gen.SudoersMutationEntryStatusOk
+			var result sudoersProv.Result
+			if resp.Data != nil {
+				_ = json.Unmarshal(resp.Data, &result)
+			}
+			name := result.Name
+			item.Name = &name
+			item.Changed = resp.Changed
+		}
+		apiResponses = append(apiResponses, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.PutNodeSudoers200JSONResponse{
+		JobId:   &jobUUID,
+		Results: apiResponses,
+	}, nil
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package swap
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/swap/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	swapProv "github.com/osapi-io/osapi/internal/provider/node/swap"
+	"github.com/osapi-io/osapi/internal/validation"
+)
+
+// PostNodeSwap creates and enables a managed swap file on a target node.
+func (s *Swap) PostNodeSwap(
+	ctx context.Context,
+	request gen.PostNodeSwapRequestObject,
+) (gen.PostNodeSwapResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.PostNodeSwap400JSONResponse{Error: &errMsg}, nil
+	}
+
+	if errMsg, ok := validation.Struct(request.Body); !ok {
+		return gen.PostNodeSwap400JSONResponse{Error: &errMsg}, nil
+	}
+
+	opts := swapProv.CreateOpts{
+		Name:     request.Body.Name,
+		SizeMB:   request.Body.SizeMb,
+		Priority: request.Body.Priority,
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"swap create",
+		slog.String("target", hostname),
+		slog.String("name", opts.Name),
+		slog.Int("size_mb", opts.SizeMB),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.postNodeSwapBroadcast(ctx, hostname, opts)
+	}
+
+	jobID, resp, err := s.JobClient.Modify(
+		ctx,
+		hostname,
+		"node",
+		job.OperationSwapCreate,
+		opts,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PostNodeSwap500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		jobUUID := uuid.MustParse(jobID)
+		e := resp.Error
+		return gen.PostNodeSwap200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.SwapMutationEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.SwapMutationEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	var result swapProv.CreateResult
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &result)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+	changed := resp.Changed
+	name := result.Name
+	path := result.Path
+	agentHostname := resp.Hostname
+
+	return gen.PostNodeSwap200JSONResponse{
+		JobId: &jobUUID,
+		Results: []gen.SwapMutationEntry{
+			{
+				Hostname: agentHostname,
+				Status:   gen.SwapMutationEntryStatusOk,
+				Name:     &name,
+				Path:     &path,
+				Changed:  changed,
+			},
+		},
+	}, nil
+}
+
+// postNodeSwapBroadcast handles broadcast targets for swap create.
+func (s *Swap) postNodeSwapBroadcast(
+	ctx context.Context,
+	target string,
+	opts swapProv.CreateOpts,
+) (gen.PostNodeSwapResponseObject, error) {
+	jobID, responses, err := s.JobClient.ModifyBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationSwapCreate,
+		opts,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.PostNodeSwap500JSONResponse{Error: &errMsg}, nil
+	}
+
+	var apiResponses []gen.SwapMutationEntry
+	for host, resp := range responses {
+		item := gen.SwapMutationEntry{
+			Hostname: host,
+		}
+		switch resp.Status {
+		case job.StatusFailed:
+			item.Status = gen.SwapMutationEntryStatusFailed
+			e := resp.Error
+			item.Error = &e
+		case job.StatusSkipped:
+			item.Status = gen.SwapMutationEntryStatusSkipped
+			e := resp.Error
+			item.Error = &e
+		default:
+			item.Status = gen.SwapMutationEntryStatusOk
+			var result swapProv.CreateResult
+			if resp.Data != nil {
+				_ = json.Unmarshal(resp.Data, &result)
+			}
+			name := result.Name
+			path := result.Path
+			item.Name = &name
+			item.Path = &path
+			item.Changed = resp.Changed
+		}
+		apiResponses = append(apiResponses, item)
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.PostNodeSwap200JSONResponse{
+		JobId:   &jobUUID,
+		Results: apiResponses,
+	}, nil
+}
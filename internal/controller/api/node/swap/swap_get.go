@@ -0,0 +1,186 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package swap
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/osapi-io/osapi/internal/controller/api/node/swap/gen"
+	"github.com/osapi-io/osapi/internal/job"
+	swapProv "github.com/osapi-io/osapi/internal/provider/node/swap"
+)
+
+// GetNodeSwap returns the active swap areas and swappiness of a target node.
+func (s *Swap) GetNodeSwap(
+	ctx context.Context,
+	request gen.GetNodeSwapRequestObject,
+) (gen.GetNodeSwapResponseObject, error) {
+	if errMsg, ok := validateHostname(request.Hostname); !ok {
+		return gen.GetNodeSwap400JSONResponse{Error: &errMsg}, nil
+	}
+
+	hostname := request.Hostname
+
+	s.logger.Debug(
+		"swap get",
+		slog.String("target", hostname),
+		slog.Bool("broadcast", job.IsBroadcastTarget(hostname)),
+	)
+
+	if job.IsBroadcastTarget(hostname) {
+		return s.getNodeSwapBroadcast(ctx, hostname)
+	}
+
+	jobID, resp, err := s.JobClient.Query(
+		ctx,
+		hostname,
+		"node",
+		job.OperationSwapGet,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeSwap500JSONResponse{Error: &errMsg}, nil
+	}
+
+	if resp.Status == job.StatusSkipped {
+		e := resp.Error
+		jobUUID := uuid.MustParse(jobID)
+		return gen.GetNodeSwap200JSONResponse{
+			JobId: &jobUUID,
+			Results: []gen.SwapGetEntry{
+				{
+					Hostname: resp.Hostname,
+					Status:   gen.SwapGetEntryStatusSkipped,
+					Error:    &e,
+				},
+			},
+		}, nil
+	}
+
+	results := responseToSwapGetEntries(resp)
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeSwap200JSONResponse{
+		JobId:   &jobUUID,
+		Results: results,
+	}, nil
+}
+
+// getNodeSwapBroadcast handles broadcast targets for swap get.
+func (s *Swap) getNodeSwapBroadcast(
+	ctx context.Context,
+	target string,
+) (gen.GetNodeSwapResponseObject, error) {
+	jobID, responses, err := s.JobClient.QueryBroadcast(
+		ctx,
+		target,
+		"node",
+		job.OperationSwapGet,
+		nil,
+	)
+	if err != nil {
+		errMsg := err.Error()
+		return gen.GetNodeSwap500JSONResponse{Error: &errMsg}, nil
+	}
+
+	allResults := make([]gen.SwapGetEntry, 0)
+	for host, resp := range responses {
+		switch resp.Status {
+		case job.StatusFailed:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.SwapGetEntry{
+				Hostname: h,
+				Status:   gen.SwapGetEntryStatusFailed,
+				Error:    &e,
+			})
+		case job.StatusSkipped:
+			e := resp.Error
+			h := host
+			allResults = append(allResults, gen.SwapGetEntry{
+				Hostname: h,
+				Status:   gen.SwapGetEntryStatusSkipped,
+				Error:    &e,
+			})
+		default:
+			allResults = append(allResults, responseToSwapGetEntries(resp)...)
+		}
+	}
+
+	jobUUID := uuid.MustParse(jobID)
+
+	return gen.GetNodeSwap200JSONResponse{
+		JobId:   &jobUUID,
+		Results: allResults,
+	}, nil
+}
+
+// responseToSwapGetEntries converts a job response to gen SwapGetEntry slice.
+func responseToSwapGetEntries(
+	resp *job.Response,
+) []gen.SwapGetEntry {
+	var status swapProv.Status
+	if resp.Data != nil {
+		_ = json.Unmarshal(resp.Data, &status)
+	}
+
+	areas := make([]gen.SwapArea, 0, len(status.Areas))
+	for _, a := range status.Areas {
+		area := gen.SwapArea{
+			Name:     strPtrOrNil(a.Name),
+			Path:     strPtrOrNil(a.Path),
+			Type:     strPtrOrNil(a.Type),
+			Size:     &a.Size,
+			Used:     &a.Used,
+			Priority: &a.Priority,
+			Active:   &a.Active,
+			Managed:  &a.Managed,
+		}
+		areas = append(areas, area)
+	}
+
+	return []gen.SwapGetEntry{
+		{
+			Hostname:   resp.Hostname,
+			Status:     gen.SwapGetEntryStatusOk,
+			Swappiness: &status.Swappiness,
+			Total:      &status.Total,
+			Used:       &status.Used,
+			Areas:      &areas,
+		},
+	}
+}
+
+// strPtrOrNil returns a pointer to s, or nil when s is empty.
+func strPtrOrNil(
+	s string,
+) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
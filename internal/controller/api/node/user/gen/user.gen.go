@@ -225,6 +225,12 @@ type UserCollectionResponse struct {
 
 // UserCreateRequest defines model for UserCreateRequest.
 type UserCreateRequest struct {
+	// AccountExpires Account expiry date (YYYY-MM-DD).
+	AccountExpires *string `json:"account_expires,omitempty" validate:"omitempty,datetime=2006-01-02"`
+
+	// ForcePasswordChange Require the password to be changed at first login.
+	ForcePasswordChange *bool `json:"force_password_change,omitempty" validate:"omitempty"`
+
 	// Gid Primary group ID. If omitted, a group matching the username is created.
 	Gid *int `json:"gid,omitempty" validate:"omitempty,min=0"`
 
@@ -234,6 +240,12 @@ type UserCreateRequest struct {
 	// Home Home directory path.
 	Home *string `json:"home,omitempty" validate:"omitempty,min=1"`
 
+	// MaxDays Maximum number of days a password is valid.
+	MaxDays *int `json:"max_days,omitempty" validate:"omitempty,min=-1,max=99999"`
+
+	// MinDays Minimum number of days between password changes.
+	MinDays *int `json:"min_days,omitempty" validate:"omitempty,min=-1,max=99999"`
+
 	// Name Username for the new account.
 	Name string `json:"name" validate:"required,min=1,max=32"`
 
@@ -248,6 +260,9 @@ type UserCreateRequest struct {
 
 	// Uid Numeric user ID. If omitted, the system assigns one.
 	Uid *int `json:"uid,omitempty" validate:"omitempty,min=0"`
+
+	// WarnDays Number of days of warning before the password expires.
+	WarnDays *int `json:"warn_days,omitempty" validate:"omitempty,min=-1,max=99999"`
 }
 
 // UserEntry User listing result for one host.
@@ -270,6 +285,9 @@ type UserEntryStatus string
 
 // UserInfo A user account on the target node.
 type UserInfo struct {
+	// Aging Password aging and account expiry settings of a user account, as reported by chage. Dates are YYYY-MM-DD and omitted when unset.
+	Aging *UserPasswordAging `json:"aging,omitempty"`
+
 	// Gid Primary group ID.
 	Gid *int `json:"gid,omitempty"`
 
@@ -320,6 +338,30 @@ type UserMutationResult struct {
 // UserMutationResultStatus The status of the operation for this host.
 type UserMutationResultStatus string
 
+// UserPasswordAging Password aging and account expiry settings of a user account, as reported by chage. Dates are YYYY-MM-DD and omitted when unset.
+type UserPasswordAging struct {
+	// AccountExpires Date the account expires.
+	AccountExpires *string `json:"account_expires,omitempty"`
+
+	// LastChange Date of the last password change.
+	LastChange *string `json:"last_change,omitempty"`
+
+	// MaxDays Maximum number of days a password is valid.
+	MaxDays *int `json:"max_days,omitempty"`
+
+	// MinDays Minimum number of days between password changes.
+	MinDays *int `json:"min_days,omitempty"`
+
+	// MustChange Whether the password must be changed at next login.
+	MustChange *bool `json:"must_change,omitempty"`
+
+	// PasswordExpires Date the password expires.
+	PasswordExpires *string `json:"password_expires,omitempty"`
+
+	// WarnDays Number of days of warning before the password expires.
+	WarnDays *int `json:"warn_days,omitempty"`
+}
+
 // UserPasswordRequest defines model for UserPasswordRequest.
 type UserPasswordRequest struct {
 	// Password New password (plaintext, hashed by the agent).
@@ -328,6 +370,12 @@ type UserPasswordRequest struct {
 
 // UserUpdateRequest defines model for UserUpdateRequest.
 type UserUpdateRequest struct {
+	// AccountExpires Account expiry date (YYYY-MM-DD), or "never" to remove the expiry.
+	AccountExpires *string `json:"account_expires,omitempty" validate:"omitempty,datetime=2006-01-02|eq=never"`
+
+	// ForcePasswordChange Expire the password so it must be changed at next login.
+	ForcePasswordChange *bool `json:"force_password_change,omitempty" validate:"omitempty"`
+
 	// Groups Supplementary group names (replaces existing).
 	Groups *[]string `json:"groups,omitempty"`
 
@@ -337,8 +385,17 @@ type UserUpdateRequest struct {
 	// Lock Lock or unlock the account.
 	Lock *bool `json:"lock,omitempty" validate:"omitempty"`
 
+	// MaxDays Maximum number of days a password is valid. -1 removes the limit.
+	MaxDays *int `json:"max_days,omitempty" validate:"omitempty,min=-1,max=99999"`
+
+	// MinDays Minimum number of days between password changes. -1 removes the limit.
+	MinDays *int `json:"min_days,omitempty" validate:"omitempty,min=-1,max=99999"`
+
 	// Shell New login shell path.
 	Shell *string `json:"shell,omitempty" validate:"omitempty,min=1"`
+
+	// WarnDays Number of days of warning before the password expires. -1 removes the limit.
+	WarnDays *int `json:"warn_days,omitempty" validate:"omitempty,min=-1,max=99999"`
 }
 
 // GroupName defines model for GroupName.
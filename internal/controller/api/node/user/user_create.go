@@ -70,6 +70,21 @@ func (u *User) PostNodeUser(
 	if request.Body.System != nil {
 		opts.System = *request.Body.System
 	}
+	if request.Body.MinDays != nil {
+		opts.MinDays = request.Body.MinDays
+	}
+	if request.Body.MaxDays != nil {
+		opts.MaxDays = request.Body.MaxDays
+	}
+	if request.Body.WarnDays != nil {
+		opts.WarnDays = request.Body.WarnDays
+	}
+	if request.Body.AccountExpires != nil {
+		opts.AccountExpires = *request.Body.AccountExpires
+	}
+	if request.Body.ForcePasswordChange != nil {
+		opts.ForcePasswordChange = *request.Body.ForcePasswordChange
+	}
 
 	hostname := request.Hostname
 
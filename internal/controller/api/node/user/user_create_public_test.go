@@ -42,6 +42,7 @@ import (
 	"github.com/osapi-io/osapi/internal/controller/api/node/user/gen"
 	"github.com/osapi-io/osapi/internal/job"
 	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	userProv "github.com/osapi-io/osapi/internal/provider/node/user"
 	"github.com/osapi-io/osapi/internal/validation"
 )
 
@@ -232,6 +233,43 @@ func (s *UserCreatePublicTestSuite) TestPostNodeUser() {
 				s.Equal("newuser", *r.Results[0].Name)
 			},
 		},
+		{
+			name: "success with password aging",
+			request: gen.PostNodeUserRequestObject{
+				Hostname: "server1",
+				Body: &gen.UserCreateRequest{
+					Name:                "newuser",
+					MinDays:             intPtr(1),
+					MaxDays:             intPtr(90),
+					WarnDays:            intPtr(14),
+					AccountExpires:      strPtr("2026-12-31"),
+					ForcePasswordChange: boolPtr(true),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "user", job.OperationUserCreate,
+						userProv.CreateUserOpts{
+							Name:                "newuser",
+							MinDays:             intPtr(1),
+							MaxDays:             intPtr(90),
+							WarnDays:            intPtr(14),
+							AccountExpires:      "2026-12-31",
+							ForcePasswordChange: true,
+						}).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Changed:  boolPtr(true),
+						Data:     json.RawMessage(`{"name":"newuser","changed":true}`),
+					}, nil)
+			},
+			validateFunc: func(resp gen.PostNodeUserResponseObject) {
+				r, ok := resp.(gen.PostNodeUser200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Equal("newuser", *r.Results[0].Name)
+			},
+		},
 		{
 			name: "broadcast with failed and skipped agents",
 			request: gen.PostNodeUserRequestObject{
@@ -321,6 +359,26 @@ func (s *UserCreatePublicTestSuite) TestPostNodeUserValidationHTTP() {
 			body:     `{}`,
 			wantCode: http.StatusBadRequest,
 		},
+		{
+			name:     "when valid password aging",
+			body:     `{"name":"newuser","max_days":90,"account_expires":"2026-12-31"}`,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "when max days below -1",
+			body:     `{"name":"newuser","max_days":-2}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "when account expires is not a date",
+			body:     `{"name":"newuser","account_expires":"31/12/2026"}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "when account expires is never",
+			body:     `{"name":"newuser","account_expires":"never"}`,
+			wantCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, tc := range tests {
@@ -112,6 +112,7 @@ func (u *User) GetNodeUserByName(
 		groups := entry.Groups
 		info.Groups = &groups
 	}
+	info.Aging = passwordAgingToGen(entry.Aging)
 
 	return gen.GetNodeUserByName200JSONResponse{
 		JobId: &jobUUID,
@@ -181,6 +182,7 @@ func (u *User) getNodeUserByNameBroadcast(
 				groups := entry.Groups
 				info.Groups = &groups
 			}
+			info.Aging = passwordAgingToGen(entry.Aging)
 			item.Users = &[]gen.UserInfo{info}
 		}
 		allResults = append(allResults, item)
@@ -112,6 +112,40 @@ func (s *UserGetPublicTestSuite) TestGetNodeUserByName() {
 				s.Contains(*users[0].Groups, "sudo")
 			},
 		},
+		{
+			name: "success with password aging",
+			request: gen.GetNodeUserByNameRequestObject{
+				Hostname: "server1",
+				Name:     "testuser",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(gomock.Any(), "server1", "user", job.OperationUserGet,
+						map[string]string{"name": "testuser"}).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Data: json.RawMessage(
+							`{"name":"testuser","uid":1000,"gid":1000,"home":"/home/testuser","shell":"/bin/bash","locked":false,"aging":{"last_change":"2026-01-15","password_expires":"2026-04-15","min_days":1,"max_days":90,"warn_days":7}}`,
+						),
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeUserByNameResponseObject) {
+				r, ok := resp.(gen.GetNodeUserByName200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Require().NotNil(r.Results[0].Users)
+				users := *r.Results[0].Users
+				aging := users[0].Aging
+				s.Require().NotNil(aging)
+				s.Equal("2026-01-15", *aging.LastChange)
+				s.Equal("2026-04-15", *aging.PasswordExpires)
+				s.Nil(aging.AccountExpires)
+				s.Equal(1, *aging.MinDays)
+				s.Equal(90, *aging.MaxDays)
+				s.Equal(7, *aging.WarnDays)
+				s.False(*aging.MustChange)
+			},
+		},
 		{
 			name: "validation error empty hostname",
 			request: gen.GetNodeUserByNameRequestObject{
@@ -164,6 +164,7 @@ func responseToUserEntries(
 			groups := u.Groups
 			info.Groups = &groups
 		}
+		info.Aging = passwordAgingToGen(u.Aging)
 
 		userInfos = append(userInfos, info)
 	}
@@ -176,3 +177,39 @@ func responseToUserEntries(
 		},
 	}
 }
+
+// passwordAgingToGen converts provider password aging to its gen
+// representation. Unset dates are omitted.
+func passwordAgingToGen(
+	a *userProv.PasswordAging,
+) *gen.UserPasswordAging {
+	if a == nil {
+		return nil
+	}
+
+	minDays := a.MinDays
+	maxDays := a.MaxDays
+	warnDays := a.WarnDays
+	mustChange := a.MustChange
+
+	aging := &gen.UserPasswordAging{
+		MinDays:    &minDays,
+		MaxDays:    &maxDays,
+		WarnDays:   &warnDays,
+		MustChange: &mustChange,
+	}
+	if a.LastChange != "" {
+		lastChange := a.LastChange
+		aging.LastChange = &lastChange
+	}
+	if a.PasswordExpires != "" {
+		passwordExpires := a.PasswordExpires
+		aging.PasswordExpires = &passwordExpires
+	}
+	if a.AccountExpires != "" {
+		accountExpires := a.AccountExpires
+		aging.AccountExpires = &accountExpires
+	}
+
+	return aging
+}
@@ -138,6 +138,38 @@ func (s *UserListGetPublicTestSuite) TestGetNodeUser() {
 				s.Contains(*users[0].Groups, "docker")
 			},
 		},
+		{
+			name: "success with password aging",
+			request: gen.GetNodeUserRequestObject{
+				Hostname: "server1",
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Query(gomock.Any(), "server1", "user", job.OperationUserList, nil).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						JobID:    "550e8400-e29b-41d4-a716-446655440000",
+						Hostname: "agent1",
+						Data: json.RawMessage(
+							`[{"name":"testuser","uid":1000,"gid":1000,"home":"/home/testuser","shell":"/bin/bash","locked":false,"aging":{"must_change":true,"account_expires":"2026-12-31","min_days":0,"max_days":99999,"warn_days":7}}]`,
+						),
+					}, nil)
+			},
+			validateFunc: func(resp gen.GetNodeUserResponseObject) {
+				r, ok := resp.(gen.GetNodeUser200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Require().NotNil(r.Results[0].Users)
+				users := *r.Results[0].Users
+				s.Require().Len(users, 1)
+				aging := users[0].Aging
+				s.Require().NotNil(aging)
+				s.True(*aging.MustChange)
+				s.Nil(aging.LastChange)
+				s.Nil(aging.PasswordExpires)
+				s.Equal("2026-12-31", *aging.AccountExpires)
+				s.Equal(99999, *aging.MaxDays)
+			},
+		},
 		{
 			name: "success with nil response data",
 			request: gen.GetNodeUserRequestObject{
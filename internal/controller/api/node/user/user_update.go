@@ -66,6 +66,21 @@ func (u *User) PutNodeUser(
 	if request.Body.Lock != nil {
 		opts.Lock = request.Body.Lock
 	}
+	if request.Body.MinDays != nil {
+		opts.MinDays = request.Body.MinDays
+	}
+	if request.Body.MaxDays != nil {
+		opts.MaxDays = request.Body.MaxDays
+	}
+	if request.Body.WarnDays != nil {
+		opts.WarnDays = request.Body.WarnDays
+	}
+	if request.Body.AccountExpires != nil {
+		opts.AccountExpires = *request.Body.AccountExpires
+	}
+	if request.Body.ForcePasswordChange != nil {
+		opts.ForcePasswordChange = *request.Body.ForcePasswordChange
+	}
 
 	hostname := request.Hostname
 	name := request.Name
@@ -42,6 +42,7 @@ import (
 	"github.com/osapi-io/osapi/internal/controller/api/node/user/gen"
 	"github.com/osapi-io/osapi/internal/job"
 	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	userProv "github.com/osapi-io/osapi/internal/provider/node/user"
 	"github.com/osapi-io/osapi/internal/validation"
 )
 
@@ -247,6 +248,42 @@ func (s *UserUpdatePublicTestSuite) TestPutNodeUser() {
 				s.True(*r.Results[0].Changed)
 			},
 		},
+		{
+			name: "success with password aging",
+			request: gen.PutNodeUserRequestObject{
+				Hostname: "server1",
+				Name:     "testuser",
+				Body: &gen.UserUpdateRequest{
+					MaxDays:             intPtr(-1),
+					AccountExpires:      strPtr("never"),
+					ForcePasswordChange: boolPtr(true),
+				},
+			},
+			setupMock: func() {
+				s.mockJobClient.EXPECT().
+					Modify(gomock.Any(), "server1", "user", job.OperationUserUpdate,
+						map[string]interface{}{
+							"name": "testuser",
+							"opts": userProv.UpdateUserOpts{
+								MaxDays:             intPtr(-1),
+								AccountExpires:      "never",
+								ForcePasswordChange: true,
+							},
+						}).
+					Return("550e8400-e29b-41d4-a716-446655440000", &job.Response{
+						Hostname: "agent1",
+						Changed:  boolPtr(true),
+						Data:     json.RawMessage(`{"name":"testuser","changed":true}`),
+					}, nil)
+			},
+			validateFunc: func(resp gen.PutNodeUserResponseObject) {
+				r, ok := resp.(gen.PutNodeUser200JSONResponse)
+				s.True(ok)
+				s.Require().Len(r.Results, 1)
+				s.Require().NotNil(r.Results[0].Changed)
+				s.True(*r.Results[0].Changed)
+			},
+		},
 		{
 			name: "broadcast with failed and skipped agents",
 			request: gen.PutNodeUserRequestObject{
@@ -347,6 +384,30 @@ func (s *UserUpdatePublicTestSuite) TestPutNodeUserValidationHTTP() {
 			body:     `{"shell":"/bin/zsh"}`,
 			wantCode: http.StatusBadRequest,
 		},
+		{
+			name:     "when valid password aging",
+			path:     "/api/node/server1/user/testuser",
+			body:     `{"min_days":1,"max_days":90,"warn_days":7,"account_expires":"2026-12-31"}`,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "when account expires is never",
+			path:     "/api/node/server1/user/testuser",
+			body:     `{"account_expires":"never"}`,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "when warn days out of range",
+			path:     "/api/node/server1/user/testuser",
+			body:     `{"warn_days":100000}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "when account expires is not a date",
+			path:     "/api/node/server1/user/testuser",
+			body:     `{"account_expires":"tomorrow"}`,
+			wantCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, tc := range tests {
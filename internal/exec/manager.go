@@ -20,6 +20,10 @@
 
 package exec
 
+import (
+	"context"
+)
+
 // Manager manager responsible for exec operations.
 type Manager interface {
 	// RunCmd executes the provided command with arguments, using the current
@@ -44,4 +48,14 @@ type Manager interface {
 		cwd string,
 		timeout int,
 	) (*CmdResult, error)
+
+	// RunCmdStream executes a long-running command and calls onLine for
+	// each line it writes to stdout until the command exits or ctx is
+	// done.
+	RunCmdStream(
+		ctx context.Context,
+		name string,
+		args []string,
+		onLine func(line string) error,
+	) error
 }
@@ -10,6 +10,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	exec "github.com/osapi-io/osapi/internal/exec"
@@ -70,6 +71,20 @@ func (mr *MockManagerMockRecorder) RunCmdFull(name, args, cwd, timeout any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunCmdFull", reflect.TypeOf((*MockManager)(nil).RunCmdFull), name, args, cwd, timeout)
 }
 
+// RunCmdStream mocks base method.
+func (m *MockManager) RunCmdStream(ctx context.Context, name string, args []string, onLine func(string) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunCmdStream", ctx, name, args, onLine)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunCmdStream indicates an expected call of RunCmdStream.
+func (mr *MockManagerMockRecorder) RunCmdStream(ctx, name, args, onLine any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunCmdStream", reflect.TypeOf((*MockManager)(nil).RunCmdStream), ctx, name, args, onLine)
+}
+
 // RunPrivilegedCmd mocks base method.
 func (m *MockManager) RunPrivilegedCmd(name string, args []string) (string, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,109 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package exec
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+const (
+	// maxStreamLineLen bounds a single line read from a streamed command.
+	maxStreamLineLen = 1024 * 1024
+	// streamWaitDelay bounds how long Wait blocks on output pipes held
+	// open by children of a stopped command.
+	streamWaitDelay = time.Second
+)
+
+// RunCmdStream executes a long-running command and calls onLine for each
+// line written to stdout. The command is killed when ctx is done, which
+// is the expected way to end commands that never exit on their own (such
+// as journalctl -f) and is not reported as an error. An error returned by
+// onLine stops the command and is returned.
+func (e *Exec) RunCmdStream(
+	ctx context.Context,
+	name string,
+	args []string,
+	onLine func(line string) error,
+) error {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cmd := exec.CommandContext(streamCtx, name, args...)
+	cmd.WaitDelay = streamWaitDelay
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("failed to open stdout: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to execute command: %w", err)
+	}
+
+	e.logger.Debug(
+		"exec stream",
+		slog.String("command", strings.Join(cmd.Args, " ")),
+	)
+
+	var lineErr error
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLineLen)
+	for scanner.Scan() {
+		if lineErr = onLine(scanner.Text()); lineErr != nil {
+			break
+		}
+	}
+
+	if lineErr == nil && scanner.Err() != nil && ctx.Err() == nil {
+		lineErr = fmt.Errorf("failed to read output: %w", scanner.Err())
+	}
+
+	if lineErr != nil {
+		cancel()
+	}
+
+	waitErr := cmd.Wait()
+
+	switch {
+	case lineErr != nil:
+		return lineErr
+	case ctx.Err() != nil:
+		return nil
+	case waitErr != nil:
+		return fmt.Errorf(
+			"command failed: %w: %s",
+			waitErr,
+			strings.TrimSpace(stderr.String()),
+		)
+	}
+
+	return nil
+}
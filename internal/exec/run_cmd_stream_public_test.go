@@ -0,0 +1,142 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osapi-io/osapi/internal/exec"
+)
+
+type RunCmdStreamPublicTestSuite struct {
+	suite.Suite
+
+	logger *slog.Logger
+}
+
+func (suite *RunCmdStreamPublicTestSuite) SetupTest() {
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+}
+
+func (suite *RunCmdStreamPublicTestSuite) TestRunCmdStream() {
+	errStop := errors.New("stop")
+
+	tests := []struct {
+		name          string
+		command       string
+		args          []string
+		timeout       time.Duration
+		onLineErr     error
+		expectError   bool
+		errorContains string
+		validateLines func([]string)
+	}{
+		{
+			name:    "when command exits streams every line",
+			command: "printf",
+			args:    []string{"one\ntwo\nthree\n"},
+			timeout: 5 * time.Second,
+			validateLines: func(lines []string) {
+				suite.Equal([]string{"one", "two", "three"}, lines)
+			},
+		},
+		{
+			name:    "when context is done stops the command without error",
+			command: "/bin/sh",
+			args:    []string{"-c", "echo first; sleep 10"},
+			timeout: 200 * time.Millisecond,
+			validateLines: func(lines []string) {
+				suite.Equal([]string{"first"}, lines)
+			},
+		},
+		{
+			name:          "when onLine fails stops the command",
+			command:       "yes",
+			timeout:       5 * time.Second,
+			onLineErr:     errStop,
+			expectError:   true,
+			errorContains: "stop",
+			validateLines: func(lines []string) {
+				suite.Len(lines, 1)
+			},
+		},
+		{
+			name:          "when command exits non-zero returns stderr",
+			command:       "/bin/sh",
+			args:          []string{"-c", "echo boom >&2; exit 3"},
+			timeout:       5 * time.Second,
+			expectError:   true,
+			errorContains: "command failed: exit status 3: boom",
+		},
+		{
+			name:          "when a line exceeds the limit",
+			command:       "/bin/sh",
+			args:          []string{"-c", "head -c 2000000 /dev/zero | tr '\\0' a"},
+			timeout:       5 * time.Second,
+			expectError:   true,
+			errorContains: "failed to read output",
+		},
+		{
+			name:          "when command not found",
+			command:       "nonexistent-command-xyz",
+			timeout:       5 * time.Second,
+			expectError:   true,
+			errorContains: "failed to execute command",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			em := exec.New(suite.logger, false)
+
+			ctx, cancel := context.WithTimeout(context.Background(), tc.timeout)
+			defer cancel()
+
+			var lines []string
+			err := em.RunCmdStream(ctx, tc.command, tc.args, func(line string) error {
+				lines = append(lines, line)
+				return tc.onLineErr
+			})
+
+			if tc.expectError {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errorContains)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			if tc.validateLines != nil {
+				tc.validateLines(lines)
+			}
+		})
+	}
+}
+
+func TestRunCmdStreamPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(RunCmdStreamPublicTestSuite))
+}
@@ -322,6 +322,7 @@ func (c *Client) mergeFacts(
 	info.PackageMgr = facts.PackageMgr
 	info.Interfaces = facts.Interfaces
 	info.PrimaryInterface = facts.PrimaryInterface
+	info.NetworkRenderer = facts.NetworkRenderer
 	info.Routes = facts.Routes
 	info.Facts = facts.Facts
 }
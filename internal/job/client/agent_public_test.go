@@ -663,6 +663,7 @@ func factsRegistrationJSON() []byte {
 		ServiceMgr:       "systemd",
 		PackageMgr:       "apt",
 		PrimaryInterface: "eth0",
+		NetworkRenderer:  "networkd",
 		Facts:            map[string]any{"os_family": "debian"},
 	}
 	data, _ := json.Marshal(facts)
@@ -829,6 +830,7 @@ func (s *AgentPublicTestSuite) TestListAgents() {
 				s.Equal("systemd", agents[0].ServiceMgr)
 				s.Equal("apt", agents[0].PackageMgr)
 				s.Equal("eth0", agents[0].PrimaryInterface)
+				s.Equal("networkd", agents[0].NetworkRenderer)
 				s.NotNil(agents[0].Facts)
 			},
 		},
@@ -23,6 +23,7 @@ package client
 import (
 	"context"
 
+	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 	natsclient "github.com/osapi-io/nats-client/pkg/client"
 )
@@ -43,6 +44,14 @@ type NATSClient interface {
 		key string,
 		value []byte,
 	) error
+	Subscribe(
+		subject string,
+		handler nats.MsgHandler,
+	) (*nats.Subscription, error)
+	PublishCore(
+		subject string,
+		data []byte,
+	) error
 	ConsumeMessages(
 		ctx context.Context,
 		streamName string,
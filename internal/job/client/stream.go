@@ -0,0 +1,150 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+
+	"github.com/osapi-io/osapi/internal/job"
+)
+
+// streamBufferSize bounds how many streamed messages are buffered between
+// the NATS subscription and the consumer.
+const streamBufferSize = 256
+
+// QueryStream publishes a query job to a single target whose agent
+// streams output to the job's stream subject. It subscribes before
+// publishing so no output is missed, waits for the job response, and
+// returns a channel of streamed messages. The channel is closed when the
+// agent publishes an empty terminator message or ctx is done; in the
+// latter case the agent is asked to stop streaming. A nil channel is
+// returned when the job does not complete (e.g. skipped).
+func (c *Client) QueryStream(
+	ctx context.Context,
+	target string,
+	category string,
+	operation job.OperationType,
+	data any,
+) (string, *job.Response, <-chan []byte, error) {
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("marshal data: %w", err)
+	}
+
+	req := &job.Request{
+		JobID:     uuid.New().String(),
+		Type:      job.TypeQuery,
+		Category:  category,
+		Operation: operation,
+		Data:      json.RawMessage(dataBytes),
+	}
+
+	messages := make(chan []byte, streamBufferSize)
+	stop := make(chan struct{})
+
+	streamSubject := job.BuildStreamSubject(req.JobID)
+	sub, err := c.natsClient.Subscribe(streamSubject, func(msg *nats.Msg) {
+		select {
+		case messages <- msg.Data:
+		case <-stop:
+		}
+	})
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("failed to subscribe to stream: %w", err)
+	}
+
+	resolved := c.resolveTarget(target)
+	subject := job.BuildSubjectFromTarget(job.JobsQueryPrefix, resolved)
+	jobID, resp, err := c.publishAndWait(ctx, subject, req)
+	if err != nil {
+		_ = sub.Unsubscribe()
+		return "", nil, nil, fmt.Errorf("failed to publish and wait: %w", err)
+	}
+
+	if resp.Status == job.StatusFailed {
+		_ = sub.Unsubscribe()
+		return "", nil, nil, fmt.Errorf("job failed: %s", resp.Error)
+	}
+
+	resp.JobID = jobID
+
+	if resp.Status != job.StatusCompleted {
+		_ = sub.Unsubscribe()
+		return jobID, resp, nil, nil
+	}
+
+	c.logger.DebugContext(
+		ctx, "streaming job output",
+		slog.String("job_id", jobID),
+		slog.String("subject", streamSubject),
+	)
+
+	out := make(chan []byte)
+	go func() {
+		defer close(out)
+		defer func() {
+			close(stop)
+			_ = sub.Unsubscribe()
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				c.stopStream(jobID)
+				return
+			case msg := <-messages:
+				if len(msg) == 0 {
+					return
+				}
+
+				select {
+				case out <- msg:
+				case <-ctx.Done():
+					c.stopStream(jobID)
+					return
+				}
+			}
+		}
+	}()
+
+	return jobID, resp, out, nil
+}
+
+// stopStream tells the agent that nobody is reading a job's stream any
+// more, so it stops streaming instead of running until its deadline.
+func (c *Client) stopStream(
+	jobID string,
+) {
+	subject := job.BuildStreamStopSubject(jobID)
+	if err := c.natsClient.PublishCore(subject, nil); err != nil {
+		c.logger.Warn(
+			"failed to publish stream stop",
+			slog.String("subject", subject),
+			slog.String("error", err.Error()),
+		)
+	}
+}
@@ -0,0 +1,303 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/job/client"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+)
+
+type StreamPublicTestSuite struct {
+	suite.Suite
+
+	mockCtrl       *gomock.Controller
+	mockNATSClient *jobmocks.MockNATSClient
+	mockKV         *jobmocks.MockKeyValue
+	jobsClient     *client.Client
+	ctx            context.Context
+}
+
+func (s *StreamPublicTestSuite) SetupTest() {
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockNATSClient = jobmocks.NewMockNATSClient(s.mockCtrl)
+	s.mockKV = jobmocks.NewMockKeyValue(s.mockCtrl)
+	s.ctx = context.Background()
+
+	opts := &client.Options{
+		Timeout:    30 * time.Second,
+		KVBucket:   s.mockKV,
+		StreamName: "JOBS",
+	}
+	var err error
+	s.jobsClient, err = client.New(slog.Default(), s.mockNATSClient, opts)
+	s.Require().NoError(err)
+}
+
+func (s *StreamPublicTestSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *StreamPublicTestSuite) TestQueryStream() {
+	const (
+		target    = "server1"
+		category  = "node"
+		operation = job.OperationType("node.log.follow")
+		subject   = "jobs.query.host.server1"
+	)
+
+	successResp := `{"status":"completed"}`
+	failedResp := `{"status":"failed","error":"provider error"}`
+	skippedResp := `{"status":"skipped","error":"unsupported"}`
+
+	tests := []struct {
+		name         string
+		data         any
+		cancelCtx    bool
+		setupMocks   func(handler *nats.MsgHandler)
+		publish      []string
+		expectedErr  string
+		validateFunc func(jobID string, resp *job.Response, got []string, stream <-chan []byte)
+	}{
+		{
+			name: "when stream delivers messages until terminator",
+			setupMocks: func(handler *nats.MsgHandler) {
+				s.expectSubscribe(handler, nil)
+				setupPublishAndWaitMocks(
+					s.mockCtrl,
+					s.mockKV,
+					s.mockNATSClient,
+					subject,
+					successResp,
+					nil,
+				)
+			},
+			publish: []string{"first", "second", ""},
+			validateFunc: func(jobID string, resp *job.Response, got []string, stream <-chan []byte) {
+				s.NotEmpty(jobID)
+				s.Equal(jobID, resp.JobID)
+				s.Equal(job.StatusCompleted, resp.Status)
+				s.Equal([]string{"first", "second"}, got)
+			},
+		},
+		{
+			name:      "when context is cancelled closes stream and stops the agent",
+			cancelCtx: true,
+			setupMocks: func(handler *nats.MsgHandler) {
+				s.expectSubscribe(handler, nil)
+				setupPublishAndWaitMocks(
+					s.mockCtrl,
+					s.mockKV,
+					s.mockNATSClient,
+					subject,
+					successResp,
+					nil,
+				)
+				s.expectStop(nil)
+			},
+			validateFunc: func(jobID string, resp *job.Response, got []string, stream <-chan []byte) {
+				s.NotEmpty(jobID)
+				s.Empty(got)
+			},
+		},
+		{
+			name:      "when stop publish fails still closes stream",
+			cancelCtx: true,
+			setupMocks: func(handler *nats.MsgHandler) {
+				s.expectSubscribe(handler, nil)
+				setupPublishAndWaitMocks(
+					s.mockCtrl,
+					s.mockKV,
+					s.mockNATSClient,
+					subject,
+					successResp,
+					nil,
+				)
+				s.expectStop(errors.New("connection closed"))
+			},
+			validateFunc: func(jobID string, resp *job.Response, got []string, stream <-chan []byte) {
+				s.NotEmpty(jobID)
+				s.Empty(got)
+			},
+		},
+		{
+			name: "when job skipped returns nil stream",
+			setupMocks: func(handler *nats.MsgHandler) {
+				s.expectSubscribe(handler, nil)
+				setupPublishAndWaitMocks(
+					s.mockCtrl,
+					s.mockKV,
+					s.mockNATSClient,
+					subject,
+					skippedResp,
+					nil,
+				)
+			},
+			validateFunc: func(jobID string, resp *job.Response, got []string, stream <-chan []byte) {
+				s.NotEmpty(jobID)
+				s.Equal(job.StatusSkipped, resp.Status)
+				s.Equal("unsupported", resp.Error)
+				s.Nil(stream)
+			},
+		},
+		{
+			name: "when job failed",
+			setupMocks: func(handler *nats.MsgHandler) {
+				s.expectSubscribe(handler, nil)
+				setupPublishAndWaitMocks(
+					s.mockCtrl,
+					s.mockKV,
+					s.mockNATSClient,
+					subject,
+					failedResp,
+					nil,
+				)
+			},
+			expectedErr: "job failed: provider error",
+		},
+		{
+			name: "when publish error",
+			setupMocks: func(handler *nats.MsgHandler) {
+				s.expectSubscribe(handler, nil)
+				setupPublishAndWaitMocks(
+					s.mockCtrl,
+					s.mockKV,
+					s.mockNATSClient,
+					subject,
+					"",
+					errors.New("kv put failed"),
+				)
+			},
+			expectedErr: "failed to publish and wait",
+		},
+		{
+			name: "when subscribe error",
+			setupMocks: func(handler *nats.MsgHandler) {
+				s.expectSubscribe(handler, errors.New("connection closed"))
+			},
+			expectedErr: "failed to subscribe to stream: connection closed",
+		},
+		{
+			name: "when data marshal fails",
+			// A channel cannot be marshaled to JSON.
+			data:        make(chan int),
+			setupMocks:  func(_ *nats.MsgHandler) {},
+			expectedErr: "marshal data",
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			var handler nats.MsgHandler
+			tt.setupMocks(&handler)
+
+			ctx, cancel := context.WithCancel(s.ctx)
+			defer cancel()
+
+			jobID, resp, stream, err := s.jobsClient.QueryStream(
+				ctx,
+				target,
+				category,
+				operation,
+				tt.data,
+			)
+
+			if tt.expectedErr != "" {
+				s.Error(err)
+				s.Contains(err.Error(), tt.expectedErr)
+				s.Empty(jobID)
+				s.Nil(resp)
+				s.Nil(stream)
+
+				return
+			}
+
+			s.NoError(err)
+
+			for _, data := range tt.publish {
+				handler(&nats.Msg{Data: []byte(data)})
+			}
+			if tt.cancelCtx {
+				cancel()
+			}
+
+			var got []string
+			if stream != nil {
+				for msg := range stream {
+					got = append(got, string(msg))
+				}
+			}
+
+			tt.validateFunc(jobID, resp, got, stream)
+		})
+	}
+}
+
+// expectSubscribe expects a subscription to a job stream subject and
+// captures the message handler.
+func (s *StreamPublicTestSuite) expectSubscribe(
+	handler *nats.MsgHandler,
+	err error,
+) {
+	s.mockNATSClient.EXPECT().
+		Subscribe(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(subject string, h nats.MsgHandler) (*nats.Subscription, error) {
+			s.True(strings.HasPrefix(subject, "streams."))
+			*handler = h
+			if err != nil {
+				return nil, err
+			}
+
+			return &nats.Subscription{}, nil
+		})
+}
+
+// expectStop expects the stream stop message published when the
+// consumer goes away before the terminator.
+func (s *StreamPublicTestSuite) expectStop(
+	err error,
+) {
+	s.mockNATSClient.EXPECT().
+		PublishCore(gomock.Any(), gomock.Nil()).
+		DoAndReturn(func(subject string, _ []byte) error {
+			s.True(strings.HasPrefix(subject, "streams."))
+			s.True(strings.HasSuffix(subject, ".stop"))
+
+			return err
+		})
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestStreamPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(StreamPublicTestSuite))
+}
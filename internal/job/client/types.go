@@ -80,6 +80,13 @@ type JobClient interface {
 		operation job.OperationType,
 		data any,
 	) (string, map[string]*job.Response, error)
+	QueryStream(
+		ctx context.Context,
+		target string,
+		category string,
+		operation job.OperationType,
+		data any,
+	) (string, *job.Response, <-chan []byte, error)
 
 	// Job queue management operations
 	GetQueueSummary(
@@ -283,6 +283,23 @@ func (mr *MockJobClientMockRecorder) QueryBroadcast(ctx, target, category, opera
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryBroadcast", reflect.TypeOf((*MockJobClient)(nil).QueryBroadcast), ctx, target, category, operation, data)
 }
 
+// QueryStream mocks base method.
+func (m *MockJobClient) QueryStream(ctx context.Context, target, category string, operation job.OperationType, data any) (string, *job.Response, <-chan []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryStream", ctx, target, category, operation, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*job.Response)
+	ret2, _ := ret[2].(<-chan []byte)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// QueryStream indicates an expected call of QueryStream.
+func (mr *MockJobClientMockRecorder) QueryStream(ctx, target, category, operation, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStream", reflect.TypeOf((*MockJobClient)(nil).QueryStream), ctx, target, category, operation, data)
+}
+
 // RetryJob mocks base method.
 func (m *MockJobClient) RetryJob(ctx context.Context, jobID, targetHostname string) (*client0.CreateJobResult, error) {
 	m.ctrl.T.Helper()
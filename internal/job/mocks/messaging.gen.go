@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	nats "github.com/nats-io/nats.go"
 	jetstream "github.com/nats-io/nats.go/jetstream"
 	client "github.com/osapi-io/nats-client/pkg/client"
 	gomock "go.uber.org/mock/gomock"
@@ -112,3 +113,32 @@ func (mr *MockNATSClientMockRecorder) Publish(ctx, subject, data any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockNATSClient)(nil).Publish), ctx, subject, data)
 }
+
+// PublishCore mocks base method.
+func (m *MockNATSClient) PublishCore(subject string, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishCore", subject, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishCore indicates an expected call of PublishCore.
+func (mr *MockNATSClientMockRecorder) PublishCore(subject, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishCore", reflect.TypeOf((*MockNATSClient)(nil).PublishCore), subject, data)
+}
+
+// Subscribe mocks base method.
+func (m *MockNATSClient) Subscribe(subject string, handler nats.MsgHandler) (*nats.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", subject, handler)
+	ret0, _ := ret[0].(*nats.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockNATSClientMockRecorder) Subscribe(subject, handler any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockNATSClient)(nil).Subscribe), subject, handler)
+}
@@ -59,6 +59,10 @@ var (
 	JobsModifyPrefix = "jobs.modify"
 	// jobsBase is the base subject token (e.g., "jobs" or "osapi.jobs").
 	jobsBase = "jobs"
+	// streamsBase is the base subject token for streamed job output
+	// (e.g., "streams" or "osapi.streams"). It lives outside the jobs
+	// hierarchy so streamed messages are not captured by JetStream.
+	streamsBase = "streams"
 )
 
 // Init configures the subject namespace. An empty namespace keeps the default
@@ -71,8 +75,10 @@ func Init(
 ) {
 	if namespace == "" {
 		jobsBase = "jobs"
+		streamsBase = "streams"
 	} else {
 		jobsBase = namespace + ".jobs"
+		streamsBase = namespace + ".streams"
 	}
 	JobsQueryPrefix = jobsBase + ".query"
 	JobsModifyPrefix = jobsBase + ".modify"
@@ -136,6 +142,25 @@ func BuildModifySubjectForAllHosts() string {
 	return BuildModifySubject(AllHosts)
 }
 
+// BuildStreamSubject creates the core NATS subject an agent publishes
+// streamed output to for a job.
+// Example: streams.<jobID>
+func BuildStreamSubject(
+	jobID string,
+) string {
+	return fmt.Sprintf("%s.%s", streamsBase, jobID)
+}
+
+// BuildStreamStopSubject creates the core NATS subject a consumer
+// publishes to when it stops reading a job's streamed output, so the
+// agent can stop streaming early.
+// Example: streams.<jobID>.stop
+func BuildStreamStopSubject(
+	jobID string,
+) string {
+	return BuildStreamSubject(jobID) + ".stop"
+}
+
 // ParseSubject extracts the prefix and routing target from a job subject.
 // Supported formats (with optional namespace prefix):
 //   - [ns.]jobs.{type}._any
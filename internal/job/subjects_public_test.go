@@ -133,6 +133,46 @@ func (suite *SubjectsPublicTestSuite) TestBuildModifySubject() {
 	}
 }
 
+func (suite *SubjectsPublicTestSuite) TestBuildStreamSubject() {
+	tests := []struct {
+		name  string
+		jobID string
+		want  string
+	}{
+		{
+			name:  "when building stream subject for a job",
+			jobID: "550e8400-e29b-41d4-a716-446655440000",
+			want:  "streams.550e8400-e29b-41d4-a716-446655440000",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.Equal(tt.want, job.BuildStreamSubject(tt.jobID))
+		})
+	}
+}
+
+func (suite *SubjectsPublicTestSuite) TestBuildStreamStopSubject() {
+	tests := []struct {
+		name  string
+		jobID string
+		want  string
+	}{
+		{
+			name:  "when building stream stop subject for a job",
+			jobID: "550e8400-e29b-41d4-a716-446655440000",
+			want:  "streams.550e8400-e29b-41d4-a716-446655440000.stop",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.Equal(tt.want, job.BuildStreamStopSubject(tt.jobID))
+		})
+	}
+}
+
 func (suite *SubjectsPublicTestSuite) TestParseSubject() {
 	tests := []struct {
 		name         string
@@ -724,6 +764,7 @@ func (suite *SubjectsPublicTestSuite) TestInit() {
 		wantBuildQuery   string
 		wantSubscription string
 		wantLabelSubject string
+		wantStream       string
 	}{
 		{
 			name:             "when namespace is empty",
@@ -733,6 +774,7 @@ func (suite *SubjectsPublicTestSuite) TestInit() {
 			wantBuildQuery:   "jobs.query._any",
 			wantSubscription: "jobs.*._any",
 			wantLabelSubject: "jobs.*.label.role.web",
+			wantStream:       "streams.abc-123",
 		},
 		{
 			name:             "when namespace is set",
@@ -742,6 +784,7 @@ func (suite *SubjectsPublicTestSuite) TestInit() {
 			wantBuildQuery:   "osapi.jobs.query._any",
 			wantSubscription: "osapi.jobs.*._any",
 			wantLabelSubject: "osapi.jobs.*.label.role.web",
+			wantStream:       "osapi.streams.abc-123",
 		},
 	}
 
@@ -757,6 +800,7 @@ func (suite *SubjectsPublicTestSuite) TestInit() {
 			suite.Contains(subs, tt.wantSubscription)
 			labels := job.BuildLabelSubjects("role", "web")
 			suite.Equal([]string{tt.wantLabelSubject}, labels)
+			suite.Equal(tt.wantStream, job.BuildStreamSubject("abc-123"))
 		})
 	}
 }
@@ -179,6 +179,15 @@ const (
 	OperationCronDelete = client.OpCronDelete
 )
 
+// Schedule/Timer operations.
+const (
+	OperationTimerList   = client.OpTimerList
+	OperationTimerGet    = client.OpTimerGet
+	OperationTimerCreate = client.OpTimerCreate
+	OperationTimerUpdate = client.OpTimerUpdate
+	OperationTimerDelete = client.OpTimerDelete
+)
+
 // Sysctl operations.
 const (
 	OperationSysctlList   = client.OpSysctlList
@@ -210,9 +219,11 @@ const (
 
 // Process operations.
 const (
-	OperationProcessList   = client.OpProcessList
-	OperationProcessGet    = client.OpProcessGet
-	OperationProcessSignal = client.OpProcessSignal
+	OperationProcessList        = client.OpProcessList
+	OperationProcessGet         = client.OpProcessGet
+	OperationProcessTree        = client.OpProcessTree
+	OperationProcessSignal      = client.OpProcessSignal
+	OperationProcessSignalMatch = client.OpProcessSignalMatch
 )
 
 // User operations.
@@ -249,13 +260,23 @@ const (
 	OperationPackageRemove      = client.OpPackageRemove
 	OperationPackageUpdate      = client.OpPackageUpdate
 	OperationPackageListUpdates = client.OpPackageListUpdates
+	OperationPackageHold        = client.OpPackageHold
+	OperationPackageUnhold      = client.OpPackageUnhold
+	OperationPackageEnsure      = client.OpPackageEnsure
+	OperationPackageRepoList    = client.OpPackageRepoList
+	OperationPackageRepoGet     = client.OpPackageRepoGet
+	OperationPackageRepoCreate  = client.OpPackageRepoCreate
+	OperationPackageRepoUpdate  = client.OpPackageRepoUpdate
+	OperationPackageRepoDelete  = client.OpPackageRepoDelete
 )
 
 // Log operations.
 const (
 	OperationLogQuery     = client.OpLogQuery
 	OperationLogQueryUnit = client.OpLogQueryUnit
+	OperationLogQueryFile = client.OpLogQueryFile
 	OperationLogSources   = client.OpLogSources
+	OperationLogFollow    = client.OpLogFollow
 )
 
 // Certificate operations.
@@ -284,6 +305,25 @@ const (
 	OperationNetworkRouteDelete = client.OpNetworkRouteDelete
 )
 
+// Network firewall operations.
+const (
+	OperationNetworkFirewallList   = client.OpNetworkFirewallList
+	OperationNetworkFirewallGet    = client.OpNetworkFirewallGet
+	OperationNetworkFirewallCreate = client.OpNetworkFirewallCreate
+	OperationNetworkFirewallUpdate = client.OpNetworkFirewallUpdate
+	OperationNetworkFirewallDelete = client.OpNetworkFirewallDelete
+)
+
+// Network socket operations.
+const (
+	OperationNetworkSocketList = client.OpNetworkSocketList
+)
+
+// Network apply operations.
+const (
+	OperationNetworkApplyConfirm = client.OpNetworkApplyConfirm
+)
+
 // Service operations.
 const (
 	OperationServiceList    = client.OpServiceList
@@ -298,6 +338,73 @@ const (
 	OperationServiceDisable = client.OpServiceDisable
 )
 
+// Service drop-in operations.
+const (
+	OperationServiceDropInList   = client.OpServiceDropInList
+	OperationServiceDropInCreate = client.OpServiceDropInCreate
+	OperationServiceDropInUpdate = client.OpServiceDropInUpdate
+	OperationServiceDropInDelete = client.OpServiceDropInDelete
+)
+
+// Mount operations.
+const (
+	OperationMountList    = client.OpMountList
+	OperationMountGet     = client.OpMountGet
+	OperationMountCreate  = client.OpMountCreate
+	OperationMountUpdate  = client.OpMountUpdate
+	OperationMountDelete  = client.OpMountDelete
+	OperationMountMount   = client.OpMountMount
+	OperationMountUnmount = client.OpMountUnmount
+)
+
+// Block device operations.
+const (
+	OperationBlockList   = client.OpBlockList
+	OperationBlockExtend = client.OpBlockExtend
+)
+
+// Swap operations.
+const (
+	OperationSwapGet    = client.OpSwapGet
+	OperationSwapCreate = client.OpSwapCreate
+	OperationSwapDelete = client.OpSwapDelete
+)
+
+// Kernel module operations.
+const (
+	OperationKernelModuleList   = client.OpKernelModuleList
+	OperationKernelModuleGet    = client.OpKernelModuleGet
+	OperationKernelModuleCreate = client.OpKernelModuleCreate
+	OperationKernelModuleUpdate = client.OpKernelModuleUpdate
+	OperationKernelModuleDelete = client.OpKernelModuleDelete
+)
+
+// Hosts file operations.
+const (
+	OperationHostsList   = client.OpHostsList
+	OperationHostsGet    = client.OpHostsGet
+	OperationHostsCreate = client.OpHostsCreate
+	OperationHostsUpdate = client.OpHostsUpdate
+	OperationHostsDelete = client.OpHostsDelete
+)
+
+// Sudoers drop-in operations.
+const (
+	OperationSudoersList   = client.OpSudoersList
+	OperationSudoersGet    = client.OpSudoersGet
+	OperationSudoersCreate = client.OpSudoersCreate
+	OperationSudoersUpdate = client.OpSudoersUpdate
+	OperationSudoersDelete = client.OpSudoersDelete
+)
+
+// sshd drop-in operations.
+const (
+	OperationSshdGet    = client.OpSshdGet
+	OperationSshdCreate = client.OpSshdCreate
+	OperationSshdUpdate = client.OpSshdUpdate
+	OperationSshdDelete = client.OpSshdDelete
+)
+
 // Operation represents an operation in the new hierarchical format
 type Operation struct {
 	// Type specifies the type of operation using hierarchical format
@@ -531,6 +638,7 @@ type FactsRegistration struct {
 	Containerized    bool               `json:"containerized"`
 	Interfaces       []NetworkInterface `json:"interfaces,omitempty"`
 	PrimaryInterface string             `json:"primary_interface,omitempty"`
+	NetworkRenderer  string             `json:"network_renderer,omitempty"`
 	Routes           []Route            `json:"routes,omitempty"`
 	Facts            map[string]any     `json:"facts,omitempty"`
 }
@@ -670,6 +778,9 @@ type AgentInfo struct {
 	Interfaces []NetworkInterface `json:"interfaces,omitempty"`
 	// PrimaryInterface is the name of the interface used for the default route.
 	PrimaryInterface string `json:"primary_interface,omitempty"`
+	// NetworkRenderer is the backend managing network configuration
+	// (e.g., NetworkManager, networkd).
+	NetworkRenderer string `json:"network_renderer,omitempty"`
 	// Routes contains the network routing table.
 	Routes []Route `json:"routes,omitempty"`
 	// Facts contains arbitrary key-value facts collected by the agent.
@@ -0,0 +1,577 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package firewall
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/avfs/avfs"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/osapi-io/osapi/internal/exec"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/file"
+)
+
+const (
+	// ruleSetDir holds the deployed rule set drop-in files.
+	ruleSetDir = "/etc/nftables.d"
+	// nftablesConf is the system configuration validated and reloaded
+	// after changes.
+	nftablesConf = "/etc/nftables.conf"
+	// ruleSetExt is the file extension of rule set drop-in files.
+	ruleSetExt = ".nft"
+	// includeLine loads the drop-in directory from the system configuration.
+	includeLine = `include "/etc/nftables.d/*.nft"`
+)
+
+var (
+	validName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	// includePattern matches an include of the drop-in directory, with or
+	// without the extension glob.
+	includePattern = regexp.MustCompile(`^include\s+"/etc/nftables\.d/\*(\.nft)?"`)
+)
+
+// Compile-time check: Debian must satisfy FactsSetter.
+var _ provider.FactsSetter = (*Debian)(nil)
+
+// Debian implements the Provider interface for Debian-family systems.
+// Rule sets are deployed to /etc/nftables.d/, the complete system
+// configuration is validated with `nft -c`, and nftables is reloaded.
+// A rule set that fails validation or reload is rolled back. Managed
+// files are identified by their presence in the file-state KV.
+type Debian struct {
+	provider.FactsAware
+	logger       *slog.Logger
+	fs           avfs.VFS
+	fileDeployer file.Deployer
+	stateKV      jetstream.KeyValue
+	execManager  exec.Manager
+	hostname     string
+}
+
+// NewDebianProvider factory to create a new Debian instance.
+func NewDebianProvider(
+	logger *slog.Logger,
+	fs avfs.VFS,
+	fileDeployer file.Deployer,
+	stateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+) *Debian {
+	return &Debian{
+		logger:       logger.With(slog.String("subsystem", "provider.firewall")),
+		fs:           fs,
+		fileDeployer: fileDeployer,
+		stateKV:      stateKV,
+		execManager:  execManager,
+		hostname:     hostname,
+	}
+}
+
+// List returns all osapi-managed rule sets from /etc/nftables.d/.
+func (d *Debian) List(
+	ctx context.Context,
+) ([]RuleSet, error) {
+	dirEntries, err := d.fs.ReadDir(ruleSetDir)
+	if err != nil {
+		return nil, fmt.Errorf("list firewall rule sets: %w", err)
+	}
+
+	var result []RuleSet
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || !strings.HasSuffix(dirEntry.Name(), ruleSetExt) {
+			continue
+		}
+
+		name := strings.TrimSuffix(dirEntry.Name(), ruleSetExt)
+		path := ruleSetPath(name)
+
+		ruleSet := d.buildRuleSetFromState(ctx, name, path)
+		if ruleSet != nil {
+			result = append(result, *ruleSet)
+		}
+	}
+
+	return result, nil
+}
+
+// Get returns a single rule set by name.
+func (d *Debian) Get(
+	ctx context.Context,
+	name string,
+) (*RuleSet, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	path := ruleSetPath(name)
+	if _, err := d.fs.Stat(path); err != nil {
+		return nil, fmt.Errorf("firewall rule set %q: not found", name)
+	}
+
+	ruleSet := d.buildRuleSetFromState(ctx, name, path)
+	if ruleSet == nil {
+		return nil, fmt.Errorf("firewall rule set %q is not managed by osapi", name)
+	}
+
+	return ruleSet, nil
+}
+
+// Create validates and deploys a new rule set, then reloads nftables.
+func (d *Debian) Create(
+	ctx context.Context,
+	ruleSet RuleSet,
+) (*CreateResult, error) {
+	if err := validateName(ruleSet.Name); err != nil {
+		return nil, err
+	}
+
+	path := ruleSetPath(ruleSet.Name)
+
+	// Already managed — nothing to do.
+	if d.buildRuleSetFromState(ctx, ruleSet.Name, path) != nil {
+		return &CreateResult{
+			Name:    ruleSet.Name,
+			Changed: false,
+		}, nil
+	}
+
+	if _, err := d.fs.Stat(path); err == nil {
+		return nil, fmt.Errorf(
+			"create firewall rule set: %q exists and is not managed by osapi",
+			ruleSet.Name,
+		)
+	}
+
+	changed, err := d.deploy(ctx, ruleSet)
+	if err != nil {
+		return nil, fmt.Errorf("create firewall rule set: %w", err)
+	}
+
+	return &CreateResult{
+		Name:    ruleSet.Name,
+		Changed: changed,
+	}, nil
+}
+
+// Update validates and redeploys an existing rule set, then reloads
+// nftables when the content changed.
+func (d *Debian) Update(
+	ctx context.Context,
+	ruleSet RuleSet,
+) (*UpdateResult, error) {
+	if err := validateName(ruleSet.Name); err != nil {
+		return nil, err
+	}
+
+	existing := d.buildRuleSetFromState(ctx, ruleSet.Name, ruleSetPath(ruleSet.Name))
+	if existing == nil {
+		return nil, fmt.Errorf("firewall rule set %q not managed", ruleSet.Name)
+	}
+
+	// If no new object was specified, preserve the current one.
+	if ruleSet.Object == "" {
+		ruleSet.Object = existing.Object
+	}
+
+	changed, err := d.deploy(ctx, ruleSet)
+	if err != nil {
+		return nil, fmt.Errorf("update firewall rule set: %w", err)
+	}
+
+	return &UpdateResult{
+		Name:    ruleSet.Name,
+		Changed: changed,
+	}, nil
+}
+
+// Delete undeploys a managed rule set, validates the remaining system
+// configuration, and reloads nftables. The rule set is restored when
+// validation or the reload fails. Rule sets not written by osapi are
+// never removed.
+func (d *Debian) Delete(
+	ctx context.Context,
+	name string,
+) (*DeleteResult, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	path := ruleSetPath(name)
+	if d.buildRuleSetFromState(ctx, name, path) == nil {
+		return &DeleteResult{
+			Name:    name,
+			Changed: false,
+		}, nil
+	}
+
+	previous := d.snapshot(ctx, path)
+
+	result, err := d.fileDeployer.Undeploy(ctx, file.UndeployRequest{
+		Path: path,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("delete firewall rule set: %w", err)
+	}
+
+	if !result.Changed {
+		return &DeleteResult{
+			Name:    name,
+			Changed: false,
+		}, nil
+	}
+	previous.changed = true
+
+	if output, err := d.execManager.RunPrivilegedCmd(
+		"nft",
+		[]string{"-c", "-f", nftablesConf},
+	); err != nil {
+		d.restore(ctx, previous)
+
+		return nil, fmt.Errorf(
+			"delete firewall rule set: nft validate failed: %s: %w",
+			strings.TrimSpace(output),
+			err,
+		)
+	}
+
+	if err := d.reload(); err != nil {
+		d.restore(ctx, previous)
+
+		return nil, fmt.Errorf("delete firewall rule set: %w", err)
+	}
+
+	return &DeleteResult{
+		Name:    name,
+		Changed: true,
+	}, nil
+}
+
+// deploy installs the rule set into /etc/nftables.d/, validates the
+// complete system configuration with the candidate in place, and reloads
+// nftables. When validation or the reload fails, the previous drop-in
+// file and its file-state record are restored so the next reload does
+// not pick up a broken rule set.
+func (d *Debian) deploy(
+	ctx context.Context,
+	ruleSet RuleSet,
+) (bool, error) {
+	path := ruleSetPath(ruleSet.Name)
+	previous := d.snapshot(ctx, path)
+
+	includeAdded, err := d.ensureInclude()
+	if err != nil {
+		return false, err
+	}
+	previous.includeAdded = includeAdded
+
+	result, err := d.fileDeployer.Deploy(ctx, file.DeployRequest{
+		ObjectName:  ruleSet.Object,
+		Path:        path,
+		Mode:        "0644",
+		ContentType: ruleSet.ContentType,
+		Vars:        ruleSet.Vars,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if !result.Changed && !includeAdded {
+		return false, nil
+	}
+	previous.changed = result.Changed
+
+	if output, err := d.execManager.RunPrivilegedCmd(
+		"nft",
+		[]string{"-c", "-f", nftablesConf},
+	); err != nil {
+		d.restore(ctx, previous)
+
+		return false, fmt.Errorf(
+			"nft validate failed: %s: %w",
+			strings.TrimSpace(output),
+			err,
+		)
+	}
+
+	if err := d.reload(); err != nil {
+		d.restore(ctx, previous)
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ruleSetSnapshot records a drop-in file, its file-state entry, and the
+// system configuration before a deploy so they can be put back if the
+// new rule set is rejected.
+type ruleSetSnapshot struct {
+	path         string
+	content      []byte
+	exists       bool
+	state        []byte
+	conf         []byte
+	changed      bool
+	includeAdded bool
+}
+
+// snapshot captures the current drop-in file, its file-state entry, and
+// the system configuration.
+func (d *Debian) snapshot(
+	ctx context.Context,
+	path string,
+) ruleSetSnapshot {
+	snap := ruleSetSnapshot{path: path}
+
+	if conf, err := d.fs.ReadFile(nftablesConf); err == nil {
+		snap.conf = conf
+	}
+
+	if content, err := d.fs.ReadFile(path); err == nil {
+		snap.content = content
+		snap.exists = true
+	}
+
+	if kvEntry, err := d.stateKV.Get(ctx, file.BuildStateKey(d.hostname, path)); err == nil {
+		snap.state = kvEntry.Value()
+	}
+
+	return snap
+}
+
+// restore undoes a rejected deploy or delete: the include added to the
+// system configuration is removed, and the drop-in file captured by
+// snapshot is put back, or removed when it did not exist before.
+// Failures are logged; the caller already reports the original error.
+func (d *Debian) restore(
+	ctx context.Context,
+	snap ruleSetSnapshot,
+) {
+	if snap.includeAdded {
+		var err error
+		if snap.conf == nil {
+			err = d.fs.Remove(nftablesConf)
+		} else {
+			err = d.writeFile(nftablesConf, snap.conf, 0o755)
+		}
+		if err != nil {
+			d.logger.Warn(
+				"failed to restore nftables configuration",
+				slog.String("path", nftablesConf),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
+	if !snap.changed {
+		return
+	}
+
+	if snap.exists {
+		if err := d.writeFile(snap.path, snap.content, 0o644); err != nil {
+			d.logger.Warn(
+				"failed to restore previous rule set",
+				slog.String("path", snap.path),
+				slog.String("error", err.Error()),
+			)
+		}
+	} else if _, err := d.fileDeployer.Undeploy(ctx, file.UndeployRequest{
+		Path: snap.path,
+	}); err != nil {
+		d.logger.Warn(
+			"failed to remove rejected rule set",
+			slog.String("path", snap.path),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	if snap.state == nil {
+		return
+	}
+
+	if _, err := d.stateKV.Put(
+		ctx,
+		file.BuildStateKey(d.hostname, snap.path),
+		snap.state,
+	); err != nil {
+		d.logger.Warn(
+			"failed to restore previous rule set state",
+			slog.String("path", snap.path),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// ensureInclude makes sure the system configuration loads the drop-in
+// directory. The stock Debian /etc/nftables.conf does not include
+// /etc/nftables.d/, so without it rule sets would be deployed but never
+// loaded. A missing configuration is created. Returns true when the
+// include was added.
+func (d *Debian) ensureInclude() (bool, error) {
+	data, err := d.fs.ReadFile(nftablesConf)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return false, fmt.Errorf("read %s: %w", nftablesConf, err)
+	}
+
+	if hasInclude(string(data)) {
+		return false, nil
+	}
+
+	content := string(data)
+	if content == "" {
+		content = "#!/usr/sbin/nft -f\n"
+	} else if !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	content += "\n# Rule sets managed by osapi.\n" + includeLine + "\n"
+
+	if err := d.writeFile(nftablesConf, []byte(content), 0o755); err != nil {
+		return false, err
+	}
+
+	d.logger.Info(
+		"added rule set include to nftables configuration",
+		slog.String("path", nftablesConf),
+	)
+
+	return true, nil
+}
+
+// writeFile replaces path by writing a temporary file next to it and
+// renaming it into place, so readers never see a partial file.
+func (d *Debian) writeFile(
+	path string,
+	data []byte,
+	mode fs.FileMode,
+) error {
+	if info, err := d.fs.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	tmpPath := path + ".osapi-tmp"
+	if err := d.fs.WriteFile(tmpPath, data, mode); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+
+	if err := d.fs.Rename(tmpPath, path); err != nil {
+		_ = d.fs.Remove(tmpPath)
+
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// hasInclude reports whether the configuration includes the drop-in
+// directory on an uncommented line.
+func hasInclude(
+	content string,
+) bool {
+	for _, line := range strings.Split(content, "\n") {
+		if includePattern.MatchString(strings.TrimSpace(line)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reload loads the system nftables configuration, which includes the
+// managed rule sets from /etc/nftables.d/.
+func (d *Debian) reload() error {
+	if output, err := d.execManager.RunPrivilegedCmd(
+		"nft",
+		[]string{"-f", nftablesConf},
+	); err != nil {
+		return fmt.Errorf("nft reload: %s: %w", strings.TrimSpace(output), err)
+	}
+
+	d.logger.Info("nftables ruleset reloaded", slog.String("path", nftablesConf))
+
+	return nil
+}
+
+// buildRuleSetFromState creates a RuleSet from file-state KV metadata.
+// Returns nil when the file is not managed by osapi or has been
+// undeployed.
+func (d *Debian) buildRuleSetFromState(
+	ctx context.Context,
+	name string,
+	path string,
+) *RuleSet {
+	stateKey := file.BuildStateKey(d.hostname, path)
+
+	kvEntry, err := d.stateKV.Get(ctx, stateKey)
+	if err != nil {
+		return nil
+	}
+
+	var state job.FileState
+	if err := json.Unmarshal(kvEntry.Value(), &state); err != nil {
+		return nil
+	}
+
+	if state.UndeployedAt != "" {
+		return nil
+	}
+
+	return &RuleSet{
+		Name:        name,
+		Object:      state.ObjectName,
+		Path:        path,
+		ContentType: state.ContentType,
+	}
+}
+
+// ruleSetPath returns the drop-in file path for a rule set name.
+func ruleSetPath(
+	name string,
+) string {
+	return ruleSetDir + "/" + name + ruleSetExt
+}
+
+// validateName checks that a rule set name is safe for use as a filename.
+func validateName(
+	name string,
+) error {
+	if name == "" {
+		return fmt.Errorf("invalid firewall rule set name: empty")
+	}
+	if !validName.MatchString(name) {
+		return fmt.Errorf(
+			"invalid firewall rule set name %q: must match %s",
+			name,
+			validName.String(),
+		)
+	}
+
+	return nil
+}
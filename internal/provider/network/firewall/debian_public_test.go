@@ -0,0 +1,1139 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package firewall_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider/file"
+	filemocks "github.com/osapi-io/osapi/internal/provider/file/mocks"
+	"github.com/osapi-io/osapi/internal/provider/network/firewall"
+)
+
+const (
+	testHostname = "test-host"
+	livePath     = "/etc/nftables.d/web.nft"
+	nftablesConf = "/etc/nftables.conf"
+	baseConf     = "#!/usr/sbin/nft -f\n\nflush ruleset\n\ninclude \"/etc/nftables.d/*.nft\"\n"
+)
+
+// stateJSON returns a JSON-encoded FileState for the given object and path.
+func stateJSON(
+	objectName string,
+	path string,
+	undeployedAt string,
+) []byte {
+	state := job.FileState{
+		ObjectName:   objectName,
+		Path:         path,
+		SHA256:       "abc123",
+		ContentType:  "raw",
+		DeployedAt:   "2026-01-01T00:00:00Z",
+		UndeployedAt: undeployedAt,
+	}
+
+	b, _ := json.Marshal(state)
+
+	return b
+}
+
+type DebianPublicTestSuite struct {
+	suite.Suite
+
+	ctrl         *gomock.Controller
+	logger       *slog.Logger
+	memFs        avfs.VFS
+	mockDeployer *filemocks.MockDeployer
+	mockStateKV  *jobmocks.MockKeyValue
+	mockExec     *execmocks.MockManager
+	provider     *firewall.Debian
+}
+
+func (suite *DebianPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+	suite.mockDeployer = filemocks.NewMockDeployer(suite.ctrl)
+	suite.mockStateKV = jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+
+	_ = suite.memFs.MkdirAll("/etc/nftables.d", 0o755)
+	_ = suite.memFs.WriteFile(nftablesConf, []byte(baseConf), 0o755)
+
+	suite.provider = firewall.NewDebianProvider(
+		suite.logger,
+		suite.memFs,
+		suite.mockDeployer,
+		suite.mockStateKV,
+		suite.mockExec,
+		testHostname,
+	)
+}
+
+func (suite *DebianPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *DebianPublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+// expectLive expects the rule set to be deployed to the live directory.
+func (suite *DebianPublicTestSuite) expectLive(
+	changed bool,
+	err error,
+) {
+	suite.mockDeployer.EXPECT().
+		Deploy(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			req file.DeployRequest,
+		) (*file.DeployResult, error) {
+			suite.Equal(livePath, req.Path)
+			suite.Equal("0644", req.Mode)
+			suite.Equal("web-rules", req.ObjectName)
+			if err != nil {
+				return nil, err
+			}
+			if changed {
+				_ = suite.memFs.WriteFile(req.Path, []byte("new"), 0o644)
+			}
+			return &file.DeployResult{Changed: changed, Path: req.Path}, nil
+		})
+}
+
+// expectValidate expects the system nftables configuration to be checked.
+func (suite *DebianPublicTestSuite) expectValidate(
+	err error,
+) {
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("nft", []string{"-c", "-f", nftablesConf}).
+		Return("Error: syntax error", err)
+}
+
+// expectReload expects the system nftables configuration to be reloaded.
+func (suite *DebianPublicTestSuite) expectReload(
+	err error,
+) {
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("nft", []string{"-f", nftablesConf}).
+		Return("", err)
+}
+
+// expectState expects a single file-state KV lookup.
+func (suite *DebianPublicTestSuite) expectState(
+	data []byte,
+	err error,
+) {
+	if err != nil {
+		suite.mockStateKV.EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Return(nil, err)
+
+		return
+	}
+
+	mockEntry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+	mockEntry.EXPECT().Value().Return(data).AnyTimes()
+	suite.mockStateKV.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(mockEntry, nil)
+}
+
+// expectUnmanaged expects the managed check and the pre-deploy snapshot
+// to find no file-state entry.
+func (suite *DebianPublicTestSuite) expectUnmanaged() {
+	suite.expectState(nil, errors.New("key not found"))
+	suite.expectState(nil, errors.New("key not found"))
+}
+
+// expectManaged expects the managed check and the pre-deploy snapshot to
+// find the existing file-state entry.
+func (suite *DebianPublicTestSuite) expectManaged() {
+	suite.expectState(stateJSON("web-rules", livePath, ""), nil)
+	suite.expectState(stateJSON("web-rules", livePath, ""), nil)
+}
+
+// expectRemoveLive expects a rejected new rule set to be undeployed.
+func (suite *DebianPublicTestSuite) expectRemoveLive(
+	err error,
+) {
+	suite.mockDeployer.EXPECT().
+		Undeploy(gomock.Any(), file.UndeployRequest{Path: livePath}).
+		Return(&file.UndeployResult{Changed: true}, err)
+}
+
+// expectRestoreState expects the previous file-state entry to be put back.
+func (suite *DebianPublicTestSuite) expectRestoreState(
+	err error,
+) {
+	suite.mockStateKV.EXPECT().
+		Put(
+			gomock.Any(),
+			file.BuildStateKey(testHostname, livePath),
+			stateJSON("web-rules", livePath, ""),
+		).
+		Return(uint64(1), err)
+}
+
+// readConf returns the current system nftables configuration.
+func (suite *DebianPublicTestSuite) readConf() string {
+	data, err := suite.memFs.ReadFile(nftablesConf)
+	suite.Require().NoError(err)
+
+	return string(data)
+}
+
+func (suite *DebianPublicTestSuite) TestCreate() {
+	tests := []struct {
+		name         string
+		ruleSet      firewall.RuleSet
+		setup        func()
+		validateFunc func(*firewall.CreateResult, error)
+	}{
+		{
+			name:    "when rule set is deployed, validated, and loaded",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				suite.expectUnmanaged()
+				suite.expectLive(true, nil)
+				suite.expectValidate(nil)
+				suite.expectReload(nil)
+			},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("web", result.Name)
+				suite.True(result.Changed)
+				suite.Equal(baseConf, suite.readConf())
+			},
+		},
+		{
+			name:    "when deployed content unchanged skips reload",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				suite.expectUnmanaged()
+				suite.expectLive(false, nil)
+			},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:    "when rule set already managed returns unchanged",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("table inet web {}"), 0o644)
+				suite.expectState(stateJSON("web-rules", livePath, ""), nil)
+			},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("web", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:    "when undeployed rule set is created again",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				suite.expectState(
+					stateJSON("web-rules", livePath, "2026-01-02T00:00:00Z"),
+					nil,
+				)
+				suite.expectState(
+					stateJSON("web-rules", livePath, "2026-01-02T00:00:00Z"),
+					nil,
+				)
+				suite.expectLive(true, nil)
+				suite.expectValidate(nil)
+				suite.expectReload(nil)
+			},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when unmanaged file exists",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("table inet web {}"), 0o644)
+				suite.expectState(nil, errors.New("key not found"))
+			},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "exists and is not managed by osapi")
+			},
+		},
+		{
+			name:    "when nft validation fails removes the rule set",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				suite.expectUnmanaged()
+				suite.expectLive(true, nil)
+				suite.expectValidate(errors.New("exit status 1"))
+				suite.expectRemoveLive(nil)
+			},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "nft validate failed")
+				suite.Contains(err.Error(), "syntax error")
+			},
+		},
+		{
+			name:    "when reload fails removes the rule set",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				suite.expectUnmanaged()
+				suite.expectLive(true, nil)
+				suite.expectValidate(nil)
+				suite.expectReload(errors.New("exit status 1"))
+				suite.expectRemoveLive(nil)
+			},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "nft reload")
+			},
+		},
+		{
+			name:    "when removing rejected rule set fails still reports error",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				suite.expectUnmanaged()
+				suite.expectLive(true, nil)
+				suite.expectValidate(errors.New("exit status 1"))
+				suite.expectRemoveLive(errors.New("undeploy error"))
+			},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "nft validate failed")
+			},
+		},
+		{
+			name:    "when include missing appends it",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				_ = suite.memFs.WriteFile(nftablesConf, []byte("flush ruleset"), 0o755)
+				suite.expectUnmanaged()
+				suite.expectLive(true, nil)
+				suite.expectValidate(nil)
+				suite.expectReload(nil)
+			},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.True(result.Changed)
+				suite.Equal(
+					"flush ruleset\n\n# Rule sets managed by osapi.\n"+
+						"include \"/etc/nftables.d/*.nft\"\n",
+					suite.readConf(),
+				)
+			},
+		},
+		{
+			name:    "when include commented out appends it",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				_ = suite.memFs.WriteFile(
+					nftablesConf,
+					[]byte("#include \"/etc/nftables.d/*.nft\"\n"),
+					0o755,
+				)
+				suite.expectUnmanaged()
+				suite.expectLive(true, nil)
+				suite.expectValidate(nil)
+				suite.expectReload(nil)
+			},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Contains(
+					suite.readConf(),
+					"\n# Rule sets managed by osapi.\ninclude \"/etc/nftables.d/*.nft\"\n",
+				)
+			},
+		},
+		{
+			name:    "when include without extension glob is kept",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				_ = suite.memFs.WriteFile(
+					nftablesConf,
+					[]byte("  include \"/etc/nftables.d/*\"\n"),
+					0o755,
+				)
+				suite.expectUnmanaged()
+				suite.expectLive(true, nil)
+				suite.expectValidate(nil)
+				suite.expectReload(nil)
+			},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("  include \"/etc/nftables.d/*\"\n", suite.readConf())
+			},
+		},
+		{
+			name:    "when configuration missing creates it",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				_ = suite.memFs.Remove(nftablesConf)
+				suite.expectUnmanaged()
+				suite.expectLive(true, nil)
+				suite.expectValidate(nil)
+				suite.expectReload(nil)
+			},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(
+					"#!/usr/sbin/nft -f\n\n# Rule sets managed by osapi.\n"+
+						"include \"/etc/nftables.d/*.nft\"\n",
+					suite.readConf(),
+				)
+			},
+		},
+		{
+			name:    "when include added for unchanged rule set reloads",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				_ = suite.memFs.WriteFile(nftablesConf, []byte("flush ruleset\n"), 0o755)
+				suite.expectUnmanaged()
+				suite.expectLive(false, nil)
+				suite.expectValidate(nil)
+				suite.expectReload(nil)
+			},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when validation fails reverts the added include",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				_ = suite.memFs.WriteFile(nftablesConf, []byte("flush ruleset\n"), 0o755)
+				suite.expectUnmanaged()
+				suite.expectLive(true, nil)
+				suite.expectValidate(errors.New("exit status 1"))
+				suite.expectRemoveLive(nil)
+			},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Equal("flush ruleset\n", suite.readConf())
+			},
+		},
+		{
+			name:    "when validation fails removes a created configuration",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				_ = suite.memFs.Remove(nftablesConf)
+				suite.expectUnmanaged()
+				suite.expectLive(false, nil)
+				suite.expectValidate(errors.New("exit status 1"))
+			},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				_, statErr := suite.memFs.Stat(nftablesConf)
+				suite.Error(statErr)
+			},
+		},
+		{
+			name:    "when configuration cannot be read",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				_ = suite.memFs.Remove(nftablesConf)
+				_ = suite.memFs.MkdirAll(nftablesConf, 0o755)
+				suite.expectUnmanaged()
+			},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "read /etc/nftables.conf")
+			},
+		},
+		{
+			name:    "when live deploy fails",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				suite.expectUnmanaged()
+				suite.expectLive(false, errors.New("deploy error"))
+			},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "deploy error")
+			},
+		},
+		{
+			name:    "when invalid name",
+			ruleSet: firewall.RuleSet{Name: "bad name", Object: "web-rules"},
+			setup:   func() {},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid firewall rule set name")
+			},
+		},
+		{
+			name:    "when empty name",
+			ruleSet: firewall.RuleSet{Object: "web-rules"},
+			setup:   func() {},
+			validateFunc: func(
+				result *firewall.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "empty")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Create(context.Background(), tc.ruleSet)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestUpdate() {
+	tests := []struct {
+		name         string
+		ruleSet      firewall.RuleSet
+		setup        func()
+		validateFunc func(*firewall.UpdateResult, error)
+	}{
+		{
+			name:    "when rule set is updated and reloaded",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("old"), 0o644)
+				suite.expectManaged()
+				suite.expectLive(true, nil)
+				suite.expectValidate(nil)
+				suite.expectReload(nil)
+			},
+			validateFunc: func(
+				result *firewall.UpdateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("web", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when object omitted preserves existing object",
+			ruleSet: firewall.RuleSet{Name: "web"},
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("old"), 0o644)
+				suite.expectManaged()
+				suite.expectLive(false, nil)
+			},
+			validateFunc: func(
+				result *firewall.UpdateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:    "when rule set is not managed",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("old"), 0o644)
+				suite.expectState(nil, errors.New("key not found"))
+			},
+			validateFunc: func(
+				result *firewall.UpdateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "not managed")
+			},
+		},
+		{
+			name:    "when nft validation fails restores the previous rule set",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("old"), 0o644)
+				suite.expectManaged()
+				suite.expectLive(true, nil)
+				suite.expectValidate(errors.New("exit status 1"))
+				suite.expectRestoreState(nil)
+			},
+			validateFunc: func(
+				result *firewall.UpdateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "update firewall rule set")
+				suite.Contains(err.Error(), "nft validate failed")
+				data, readErr := suite.memFs.ReadFile(livePath)
+				suite.NoError(readErr)
+				suite.Equal("old", string(data))
+			},
+		},
+		{
+			name:    "when reload fails restores the previous rule set",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("old"), 0o644)
+				suite.expectManaged()
+				suite.expectLive(true, nil)
+				suite.expectValidate(nil)
+				suite.expectReload(errors.New("exit status 1"))
+				suite.expectRestoreState(nil)
+			},
+			validateFunc: func(
+				result *firewall.UpdateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "nft reload")
+				data, readErr := suite.memFs.ReadFile(livePath)
+				suite.NoError(readErr)
+				suite.Equal("old", string(data))
+			},
+		},
+		{
+			name:    "when restoring previous state fails still reports error",
+			ruleSet: firewall.RuleSet{Name: "web", Object: "web-rules"},
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("old"), 0o644)
+				suite.expectManaged()
+				suite.expectLive(true, nil)
+				suite.expectValidate(errors.New("exit status 1"))
+				suite.expectRestoreState(errors.New("kv error"))
+			},
+			validateFunc: func(
+				result *firewall.UpdateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "nft validate failed")
+			},
+		},
+		{
+			name:    "when invalid name",
+			ruleSet: firewall.RuleSet{Name: "../etc", Object: "web-rules"},
+			setup:   func() {},
+			validateFunc: func(
+				result *firewall.UpdateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid firewall rule set name")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Update(context.Background(), tc.ruleSet)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestDelete() {
+	tests := []struct {
+		name         string
+		ruleSetName  string
+		setup        func()
+		validateFunc func(*firewall.DeleteResult, error)
+	}{
+		{
+			name:        "when rule set is removed, validated, and ruleset reloaded",
+			ruleSetName: "web",
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("rules"), 0o644)
+				suite.expectManaged()
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: livePath}).
+					DoAndReturn(func(
+						_ context.Context,
+						req file.UndeployRequest,
+					) (*file.UndeployResult, error) {
+						_ = suite.memFs.Remove(req.Path)
+
+						return &file.UndeployResult{Changed: true}, nil
+					})
+				suite.expectValidate(nil)
+				suite.expectReload(nil)
+			},
+			validateFunc: func(
+				result *firewall.DeleteResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("web", result.Name)
+				suite.True(result.Changed)
+
+				_, statErr := suite.memFs.Stat(livePath)
+				suite.True(errors.Is(statErr, os.ErrNotExist))
+			},
+		},
+		{
+			name:        "when undeploy reports unchanged skips validation and reload",
+			ruleSetName: "web",
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("rules"), 0o644)
+				suite.expectManaged()
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: livePath}).
+					Return(&file.UndeployResult{Changed: false}, nil)
+			},
+			validateFunc: func(
+				result *firewall.DeleteResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:        "when rule set does not exist returns unchanged",
+			ruleSetName: "web",
+			setup: func() {
+				suite.expectState(nil, errors.New("key not found"))
+			},
+			validateFunc: func(
+				result *firewall.DeleteResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("web", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:        "when rule set is not managed leaves the file in place",
+			ruleSetName: "web",
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("hand-written"), 0o644)
+				suite.expectState(nil, errors.New("key not found"))
+			},
+			validateFunc: func(
+				result *firewall.DeleteResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.False(result.Changed)
+
+				data, readErr := suite.memFs.ReadFile(livePath)
+				suite.Require().NoError(readErr)
+				suite.Equal("hand-written", string(data))
+			},
+		},
+		{
+			name:        "when rule set state is undeployed returns unchanged",
+			ruleSetName: "web",
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("rules"), 0o644)
+				suite.expectState(
+					stateJSON("web-rules", livePath, "2026-02-01T00:00:00Z"),
+					nil,
+				)
+			},
+			validateFunc: func(
+				result *firewall.DeleteResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:        "when undeploy fails",
+			ruleSetName: "web",
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("rules"), 0o644)
+				suite.expectManaged()
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("undeploy error"))
+			},
+			validateFunc: func(
+				result *firewall.DeleteResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "delete firewall rule set")
+			},
+		},
+		{
+			name:        "when validation fails restores the rule set",
+			ruleSetName: "web",
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("rules"), 0o644)
+				suite.expectManaged()
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						req file.UndeployRequest,
+					) (*file.UndeployResult, error) {
+						_ = suite.memFs.Remove(req.Path)
+
+						return &file.UndeployResult{Changed: true}, nil
+					})
+				suite.expectValidate(errors.New("exit status 1"))
+				suite.expectRestoreState(nil)
+			},
+			validateFunc: func(
+				result *firewall.DeleteResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "nft validate failed")
+
+				data, readErr := suite.memFs.ReadFile(livePath)
+				suite.Require().NoError(readErr)
+				suite.Equal("rules", string(data))
+			},
+		},
+		{
+			name:        "when reload fails restores the rule set",
+			ruleSetName: "web",
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("rules"), 0o644)
+				suite.expectManaged()
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						req file.UndeployRequest,
+					) (*file.UndeployResult, error) {
+						_ = suite.memFs.Remove(req.Path)
+
+						return &file.UndeployResult{Changed: true}, nil
+					})
+				suite.expectValidate(nil)
+				suite.expectReload(errors.New("exit status 1"))
+				suite.expectRestoreState(nil)
+			},
+			validateFunc: func(
+				result *firewall.DeleteResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "nft reload")
+
+				data, readErr := suite.memFs.ReadFile(livePath)
+				suite.Require().NoError(readErr)
+				suite.Equal("rules", string(data))
+			},
+		},
+		{
+			name:        "when invalid name",
+			ruleSetName: "bad name",
+			setup:       func() {},
+			validateFunc: func(
+				result *firewall.DeleteResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid firewall rule set name")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Delete(context.Background(), tc.ruleSetName)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestList() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func([]firewall.RuleSet, error)
+	}{
+		{
+			name: "when managed rule sets exist",
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("rules"), 0o644)
+				_ = suite.memFs.WriteFile("/etc/nftables.d/README", []byte("x"), 0o644)
+				_ = suite.memFs.MkdirAll("/etc/nftables.d/sub.nft", 0o755)
+				suite.expectState(stateJSON("web-rules", livePath, ""), nil)
+			},
+			validateFunc: func(
+				ruleSets []firewall.RuleSet,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Require().Len(ruleSets, 1)
+				suite.Equal("web", ruleSets[0].Name)
+				suite.Equal("web-rules", ruleSets[0].Object)
+				suite.Equal(livePath, ruleSets[0].Path)
+				suite.Equal("raw", ruleSets[0].ContentType)
+			},
+		},
+		{
+			name: "when rule set is not managed skips it",
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("rules"), 0o644)
+				suite.expectState(nil, errors.New("key not found"))
+			},
+			validateFunc: func(
+				ruleSets []firewall.RuleSet,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Empty(ruleSets)
+			},
+		},
+		{
+			name: "when rule set state is undeployed skips it",
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("rules"), 0o644)
+				suite.expectState(
+					stateJSON("web-rules", livePath, "2026-01-02T00:00:00Z"),
+					nil,
+				)
+			},
+			validateFunc: func(
+				ruleSets []firewall.RuleSet,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Empty(ruleSets)
+			},
+		},
+		{
+			name: "when rule set state is invalid skips it",
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("rules"), 0o644)
+				suite.expectState([]byte("not-json"), nil)
+			},
+			validateFunc: func(
+				ruleSets []firewall.RuleSet,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Empty(ruleSets)
+			},
+		},
+		{
+			name: "when directory cannot be read",
+			setup: func() {
+				_ = suite.memFs.RemoveAll("/etc/nftables.d")
+			},
+			validateFunc: func(
+				ruleSets []firewall.RuleSet,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(ruleSets)
+				suite.Contains(err.Error(), "list firewall rule sets")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			ruleSets, err := suite.provider.List(context.Background())
+
+			tc.validateFunc(ruleSets, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestGet() {
+	tests := []struct {
+		name         string
+		ruleSetName  string
+		setup        func()
+		validateFunc func(*firewall.RuleSet, error)
+	}{
+		{
+			name:        "when managed rule set found",
+			ruleSetName: "web",
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("rules"), 0o644)
+				suite.expectState(stateJSON("web-rules", livePath, ""), nil)
+			},
+			validateFunc: func(
+				ruleSet *firewall.RuleSet,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("web", ruleSet.Name)
+				suite.Equal("web-rules", ruleSet.Object)
+				suite.Equal(livePath, ruleSet.Path)
+			},
+		},
+		{
+			name:        "when rule set not found",
+			ruleSetName: "web",
+			setup:       func() {},
+			validateFunc: func(
+				ruleSet *firewall.RuleSet,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(ruleSet)
+				suite.Contains(err.Error(), "not found")
+			},
+		},
+		{
+			name:        "when rule set not managed",
+			ruleSetName: "web",
+			setup: func() {
+				_ = suite.memFs.WriteFile(livePath, []byte("rules"), 0o644)
+				suite.expectState(nil, errors.New("key not found"))
+			},
+			validateFunc: func(
+				ruleSet *firewall.RuleSet,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(ruleSet)
+				suite.Contains(err.Error(), "not managed")
+			},
+		},
+		{
+			name:        "when invalid name",
+			ruleSetName: "bad name",
+			setup:       func() {},
+			validateFunc: func(
+				ruleSet *firewall.RuleSet,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(ruleSet)
+				suite.Contains(err.Error(), "invalid firewall rule set name")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			ruleSet, err := suite.provider.Get(context.Background(), tc.ruleSetName)
+
+			tc.validateFunc(ruleSet, err)
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestDebianPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(DebianPublicTestSuite))
+}
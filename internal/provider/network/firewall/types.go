@@ -0,0 +1,73 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package firewall provides management of nftables rule sets deployed as
+// drop-in files under /etc/nftables.d/. Delegates file writes to the file
+// provider for SHA tracking, idempotency, and template rendering, and
+// validates every rule set with `nft -c` before it is loaded.
+package firewall
+
+import "context"
+
+// Provider implements the methods to manage nftables rule sets.
+type Provider interface {
+	// List returns all osapi-managed rule sets.
+	List(ctx context.Context) ([]RuleSet, error)
+	// Get returns a single rule set by name.
+	Get(ctx context.Context, name string) (*RuleSet, error)
+	// Create validates, deploys, and loads a new rule set.
+	Create(ctx context.Context, ruleSet RuleSet) (*CreateResult, error)
+	// Update validates, redeploys, and reloads an existing rule set.
+	Update(ctx context.Context, ruleSet RuleSet) (*UpdateResult, error)
+	// Delete undeploys a managed rule set, validates the remaining
+	// configuration, and reloads the ruleset.
+	Delete(ctx context.Context, name string) (*DeleteResult, error)
+}
+
+// RuleSet represents a named nftables rule set stored as a drop-in file
+// in /etc/nftables.d/.
+type RuleSet struct {
+	Name        string         `json:"name"`
+	Object      string         `json:"object,omitempty"`
+	Path        string         `json:"path,omitempty"`
+	ContentType string         `json:"content_type,omitempty"`
+	Vars        map[string]any `json:"vars,omitempty"`
+}
+
+// CreateResult represents the outcome of a rule set creation.
+type CreateResult struct {
+	Name    string `json:"name"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
+
+// UpdateResult represents the outcome of a rule set update.
+type UpdateResult struct {
+	Name    string `json:"name"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
+
+// DeleteResult represents the outcome of a rule set deletion.
+type DeleteResult struct {
+	Name    string `json:"name"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package netinfo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osapi-io/osapi/internal/provider/network/netinfo"
+)
+
+type GetNetworkRendererDarwinPublicTestSuite struct {
+	suite.Suite
+}
+
+func (suite *GetNetworkRendererDarwinPublicTestSuite) SetupTest() {}
+
+func (suite *GetNetworkRendererDarwinPublicTestSuite) TearDownTest() {}
+
+func (suite *GetNetworkRendererDarwinPublicTestSuite) TestGetNetworkRenderer() {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{
+			name: "when called returns unknown",
+			want: netinfo.RendererUnknown,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			d := netinfo.NewDarwinProvider(nil)
+
+			got, err := d.GetNetworkRenderer()
+
+			suite.NoError(err)
+			suite.Equal(tc.want, got)
+		})
+	}
+}
+
+func TestGetNetworkRendererDarwinPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(GetNetworkRendererDarwinPublicTestSuite))
+}
@@ -34,6 +34,7 @@ var _ provider.FactsSetter = (*Linux)(nil)
 type Linux struct {
 	Netinfo
 	RouteReaderFn func() (io.ReadCloser, error)
+	StatFn        func(name string) (os.FileInfo, error)
 }
 
 // NewLinuxProvider factory to create a new Linux instance.
@@ -48,5 +49,6 @@ func NewLinuxProvider() *Linux {
 		RouteReaderFn: func() (io.ReadCloser, error) {
 			return os.Open("/proc/net/route")
 		},
+		StatFn: os.Stat,
 	}
 }
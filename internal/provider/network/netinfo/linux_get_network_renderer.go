@@ -0,0 +1,44 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package netinfo
+
+// networkManagerRunDir is created by NetworkManager at startup.
+const networkManagerRunDir = "/run/NetworkManager"
+
+// networkdRunDir is created by systemd-networkd at startup.
+const networkdRunDir = "/run/systemd/netif"
+
+// GetNetworkRenderer returns the backend that manages the host's network
+// configuration. The runtime directories under /run only exist while the
+// daemon has been running since boot, so a stale package install does
+// not count. NetworkManager takes precedence because a host running both
+// daemons delegates interface configuration to NetworkManager.
+func (l *Linux) GetNetworkRenderer() (string, error) {
+	if _, err := l.StatFn(networkManagerRunDir); err == nil {
+		return RendererNetworkManager, nil
+	}
+
+	if _, err := l.StatFn(networkdRunDir); err == nil {
+		return RendererNetworkd, nil
+	}
+
+	return RendererUnknown, nil
+}
@@ -57,5 +57,10 @@ func NewDefaultMockProvider(ctrl *gomock.Controller) *MockProvider {
 
 	mock.EXPECT().GetPrimaryInterface().Return("eth0", nil).AnyTimes()
 
+	mock.EXPECT().
+		GetNetworkRenderer().
+		Return(netinfo.RendererNetworkd, nil).
+		AnyTimes()
+
 	return mock
 }
@@ -55,6 +55,21 @@ func (mr *MockProviderMockRecorder) GetInterfaces() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInterfaces", reflect.TypeOf((*MockProvider)(nil).GetInterfaces))
 }
 
+// GetNetworkRenderer mocks base method.
+func (m *MockProvider) GetNetworkRenderer() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetworkRenderer")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetworkRenderer indicates an expected call of GetNetworkRenderer.
+func (mr *MockProviderMockRecorder) GetNetworkRenderer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkRenderer", reflect.TypeOf((*MockProvider)(nil).GetNetworkRenderer))
+}
+
 // GetPrimaryInterface mocks base method.
 func (m *MockProvider) GetPrimaryInterface() (string, error) {
 	m.ctrl.T.Helper()
@@ -20,6 +20,18 @@
 
 package netinfo
 
+// Network renderers reported by GetNetworkRenderer.
+const (
+	// RendererNetworkManager indicates NetworkManager manages the host's
+	// network configuration.
+	RendererNetworkManager = "NetworkManager"
+	// RendererNetworkd indicates systemd-networkd manages the host's
+	// network configuration (the default Netplan renderer on servers).
+	RendererNetworkd = "networkd"
+	// RendererUnknown indicates no supported renderer was detected.
+	RendererUnknown = "unknown"
+)
+
 // InterfaceResult represents a network interface with its address.
 type InterfaceResult struct {
 	Name   string
@@ -49,4 +61,7 @@ type Provider interface {
 	// GetPrimaryInterface returns the name of the interface used
 	// for the default route.
 	GetPrimaryInterface() (string, error)
+	// GetNetworkRenderer returns the backend that manages the host's
+	// network configuration (NetworkManager, networkd, or unknown).
+	GetNetworkRenderer() (string, error)
 }
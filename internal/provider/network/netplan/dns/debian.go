@@ -28,6 +28,7 @@ import (
 
 	"github.com/osapi-io/osapi/internal/exec"
 	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/network/netplan"
 )
 
 // Compile-time check: Debian must satisfy FactsSetter.
@@ -41,6 +42,7 @@ type Debian struct {
 	fs          avfs.VFS
 	stateKV     jetstream.KeyValue
 	execManager exec.Manager
+	guard       *netplan.Guard
 	hostname    string
 }
 
@@ -51,12 +53,14 @@ func NewDebianProvider(
 	stateKV jetstream.KeyValue,
 	em exec.Manager,
 	hostname string,
+	guard *netplan.Guard,
 ) *Debian {
 	return &Debian{
 		logger:      logger.With(slog.String("subsystem", "provider.dns")),
 		fs:          fs,
 		stateKV:     stateKV,
 		execManager: em,
+		guard:       guard,
 		hostname:    hostname,
 	}
 }
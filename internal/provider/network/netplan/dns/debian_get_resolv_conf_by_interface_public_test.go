@@ -149,7 +149,7 @@ func (suite *DebianGetResolvConfPublicTestSuite) TestGetResolvConfByInterface()
 		suite.Run(tc.name, func() {
 			mock := tc.setupMock()
 
-			net := dns.NewDebianProvider(suite.logger, memfs.New(), nil, mock, "test-host")
+			net := dns.NewDebianProvider(suite.logger, memfs.New(), nil, mock, "test-host", nil)
 			got, err := net.GetResolvConfByInterface(tc.interfaceName)
 
 			if !tc.wantErr {
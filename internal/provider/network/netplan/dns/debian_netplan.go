@@ -92,12 +92,20 @@ func generateDNSNetplanYAML(
 // defaults to "eth0" as a last resort.
 func (u *Debian) resolvePrimaryInterface(
 	interfaceName string,
+) string {
+	return resolveInterface(interfaceName, u.Facts())
+}
+
+// resolveInterface prefers interfaceName, falls back to the
+// primary_interface fact, and defaults to "eth0".
+func resolveInterface(
+	interfaceName string,
+	facts map[string]any,
 ) string {
 	if interfaceName != "" {
 		return interfaceName
 	}
 
-	facts := u.Facts()
 	if facts != nil {
 		if iface, ok := facts["primary_interface"].(string); ok && iface != "" {
 			return iface
@@ -98,6 +98,7 @@ func (u *Debian) UpdateResolvConfByInterface(
 		u.fs,
 		u.stateKV,
 		u.execManager,
+		u.guard,
 		u.hostname,
 		dnsNetplanPath(),
 		content,
@@ -125,6 +126,7 @@ func (u *Debian) DeleteNetplanConfig(
 		u.fs,
 		u.stateKV,
 		u.execManager,
+		u.guard,
 		u.hostname,
 		path,
 	)
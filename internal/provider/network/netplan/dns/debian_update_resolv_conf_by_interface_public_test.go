@@ -301,7 +301,7 @@ func (suite *DebianUpdateResolvConfByInterfacePublicTestSuite) TestUpdateResolvC
 			fs := memfs.New()
 			_ = fs.MkdirAll("/etc/netplan", 0o755)
 
-			net := dns.NewDebianProvider(suite.logger, fs, kv, mock, "test-host")
+			net := dns.NewDebianProvider(suite.logger, fs, kv, mock, "test-host", nil)
 			result, err := net.UpdateResolvConfByInterface(
 				tc.servers,
 				tc.searchDomains,
@@ -403,7 +403,7 @@ func (suite *DebianUpdateResolvConfByInterfacePublicTestSuite) TestDeleteNetplan
 			fs := memfs.New()
 			tc.setupFS(fs)
 
-			net := dns.NewDebianProvider(suite.logger, fs, kv, mock, "test-host")
+			net := dns.NewDebianProvider(suite.logger, fs, kv, mock, "test-host", nil)
 			changed, err := net.DeleteNetplanConfig("eth0")
 
 			if tc.wantErr {
@@ -20,6 +20,8 @@
 
 package dns
 
+import "encoding/json"
+
 // ExportGenerateDNSNetplanYAML exposes generateDNSNetplanYAML for testing.
 func ExportGenerateDNSNetplanYAML(
 	interfaceName string,
@@ -42,3 +44,15 @@ func (d *Debian) ExportResolvePrimaryInterface(
 ) string {
 	return d.resolvePrimaryInterface(interfaceName)
 }
+
+// SetMarshalJSON overrides the JSON marshal function for testing.
+func SetMarshalJSON(
+	fn func(v any) ([]byte, error),
+) {
+	marshalJSON = fn
+}
+
+// ResetMarshalJSON restores the default JSON marshal function.
+func ResetMarshalJSON() {
+	marshalJSON = json.Marshal
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package dns
+
+import (
+	"log/slog"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/osapi-io/osapi/internal/exec"
+	"github.com/osapi-io/osapi/internal/provider"
+)
+
+// Compile-time check: NetworkManager must satisfy Provider and FactsSetter.
+var (
+	_ Provider             = (*NetworkManager)(nil)
+	_ provider.FactsSetter = (*NetworkManager)(nil)
+)
+
+// NetworkManager implements the DNS Provider interface for hosts whose
+// network is managed by NetworkManager. DNS settings are read from
+// `nmcli device show` and written to the connection profile active on
+// the interface with `nmcli connection modify`.
+type NetworkManager struct {
+	provider.FactsAware
+
+	logger      *slog.Logger
+	stateKV     jetstream.KeyValue
+	execManager exec.Manager
+	hostname    string
+}
+
+// NewNetworkManagerProvider factory to create a new NetworkManager instance.
+func NewNetworkManagerProvider(
+	logger *slog.Logger,
+	stateKV jetstream.KeyValue,
+	em exec.Manager,
+	hostname string,
+) *NetworkManager {
+	return &NetworkManager{
+		logger:      logger.With(slog.String("subsystem", "provider.dns.networkmanager")),
+		stateKV:     stateKV,
+		execManager: em,
+		hostname:    hostname,
+	}
+}
+
+// resolvePrimaryInterface returns the network interface to configure,
+// with the same fallbacks as the Netplan backend.
+func (u *NetworkManager) resolvePrimaryInterface(
+	interfaceName string,
+) string {
+	return resolveInterface(interfaceName, u.Facts())
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package dns
+
+import (
+	"fmt"
+
+	"github.com/osapi-io/osapi/internal/provider/network/networkmanager"
+)
+
+// GetResolvConfByInterface retrieves the DNS configuration for a specific
+// network interface from `nmcli device show`. The servers and domains
+// reported are the ones NetworkManager has applied to the device,
+// including DHCP-provided values. If no search domains are configured
+// for the interface, the function defaults to returning `["."]` to
+// indicate the root domain, matching the resolvectl-based backend.
+func (u *NetworkManager) GetResolvConfByInterface(
+	interfaceName string,
+) (*GetResult, error) {
+	resolvedInterface := u.resolvePrimaryInterface(interfaceName)
+
+	d, err := networkmanager.GetDevice(u.execManager, resolvedInterface)
+	if err != nil {
+		return nil, fmt.Errorf("interface %q does not exist", resolvedInterface)
+	}
+
+	config := &GetResult{
+		DNSServers:    d.DNS,
+		SearchDomains: d.Domains,
+	}
+
+	if len(config.SearchDomains) == 0 {
+		config.SearchDomains = []string{"."}
+	}
+
+	return config, nil
+}
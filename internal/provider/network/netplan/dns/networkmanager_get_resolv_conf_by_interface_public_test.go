@@ -0,0 +1,164 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package dns_test
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider/network/netplan/dns"
+)
+
+// nmcliDeviceWithDNS is a `nmcli -t device show eth0` fixture.
+const nmcliDeviceWithDNS = `GENERAL.DEVICE:eth0
+GENERAL.TYPE:ethernet
+GENERAL.CONNECTION:Wired connection 1
+IP4.ADDRESS[1]:10.0.0.5/24
+IP4.DNS[1]:192.168.1.1
+IP4.DNS[2]:8.8.8.8
+IP4.DOMAIN[1]:example.com
+IP6.DNS[1]:2001\:4860\:4860\:\:8888
+`
+
+type NetworkManagerGetResolvConfByInterfacePublicTestSuite struct {
+	suite.Suite
+
+	ctrl     *gomock.Controller
+	logger   *slog.Logger
+	mockExec *execmocks.MockManager
+	provider *dns.NetworkManager
+}
+
+func (suite *NetworkManagerGetResolvConfByInterfacePublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+
+	suite.provider = dns.NewNetworkManagerProvider(
+		suite.logger,
+		jobmocks.NewMockKeyValue(suite.ctrl),
+		suite.mockExec,
+		"test-host",
+	)
+}
+
+func (suite *NetworkManagerGetResolvConfByInterfacePublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *NetworkManagerGetResolvConfByInterfacePublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *NetworkManagerGetResolvConfByInterfacePublicTestSuite) TestGetResolvConfByInterface() {
+	tests := []struct {
+		name          string
+		interfaceName string
+		setup         func()
+		validateFunc  func(*dns.GetResult, error)
+	}{
+		{
+			name:          "when device reports servers and domains",
+			interfaceName: "eth0",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+					Return(nmcliDeviceWithDNS, nil)
+			},
+			validateFunc: func(got *dns.GetResult, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(
+					[]string{"192.168.1.1", "8.8.8.8", "2001:4860:4860::8888"},
+					got.DNSServers,
+				)
+				suite.Equal([]string{"example.com"}, got.SearchDomains)
+			},
+		},
+		{
+			name:          "when device has no search domains",
+			interfaceName: "eth0",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+					Return("GENERAL.DEVICE:eth0\nIP4.DNS[1]:1.1.1.1\n", nil)
+			},
+			validateFunc: func(got *dns.GetResult, err error) {
+				suite.Require().NoError(err)
+				suite.Equal([]string{"1.1.1.1"}, got.DNSServers)
+				suite.Equal([]string{"."}, got.SearchDomains)
+			},
+		},
+		{
+			name:          "when interface name is empty uses primary_interface fact",
+			interfaceName: "",
+			setup: func() {
+				suite.provider.SetFactsFunc(func() map[string]any {
+					return map[string]any{"primary_interface": "ens3"}
+				})
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "ens3"}).
+					Return("GENERAL.DEVICE:ens3\n", nil)
+			},
+			validateFunc: func(got *dns.GetResult, err error) {
+				suite.Require().NoError(err)
+				suite.Empty(got.DNSServers)
+				suite.Equal([]string{"."}, got.SearchDomains)
+			},
+		},
+		{
+			name:          "when device does not exist",
+			interfaceName: "eth9",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth9"}).
+					Return("", errors.New("Error: Device 'eth9' not found."))
+			},
+			validateFunc: func(got *dns.GetResult, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), `interface "eth9" does not exist`)
+				suite.Nil(got)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.GetResolvConfByInterface(tc.interfaceName)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestNetworkManagerGetResolvConfByInterfacePublicTestSuite(t *testing.T) {
+	suite.Run(t, new(NetworkManagerGetResolvConfByInterfacePublicTestSuite))
+}
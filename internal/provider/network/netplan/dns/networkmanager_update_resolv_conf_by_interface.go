@@ -0,0 +1,224 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/provider/network/netplan"
+	"github.com/osapi-io/osapi/internal/provider/network/networkmanager"
+)
+
+// marshalJSON is a package-level variable for testing the marshal error
+// path when recording the pre-osapi DNS settings.
+var marshalJSON = json.Marshal
+
+// UpdateResolvConfByInterface updates the DNS configuration for a specific
+// network interface by modifying the connection profile active on it.
+// Unspecified values are preserved from the current configuration. The
+// settings that were in place before osapi first managed the interface
+// are recorded so DeleteNetplanConfig can restore them.
+func (u *NetworkManager) UpdateResolvConfByInterface(
+	servers []string,
+	searchDomains []string,
+	interfaceName string,
+	overrideDHCP bool,
+) (*UpdateResult, error) {
+	u.logger.Info(
+		"setting dns configuration via networkmanager",
+		slog.String("servers", strings.Join(servers, ", ")),
+		slog.String("search_domains", strings.Join(searchDomains, ", ")),
+	)
+
+	if len(servers) == 0 && len(searchDomains) == 0 {
+		return nil, fmt.Errorf("no DNS servers or search domains provided; nothing to update")
+	}
+
+	resolvedInterface := u.resolvePrimaryInterface(interfaceName)
+
+	existingConfig, err := u.GetResolvConfByInterface(resolvedInterface)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current nmcli configuration: %w", err)
+	}
+
+	// Use existing values if new values are not provided.
+	if len(servers) == 0 {
+		servers = existingConfig.DNSServers
+	}
+	if len(searchDomains) == 0 {
+		searchDomains = existingConfig.SearchDomains
+	}
+
+	// Filter out root domain marker before applying.
+	filteredDomains := make([]string, 0, len(searchDomains))
+	for _, domain := range searchDomains {
+		if domain != "." {
+			filteredDomains = append(filteredDomains, domain)
+		}
+	}
+
+	settings := dnsSettings(servers, filteredDomains, overrideDHCP)
+	sha := settingsSHA(settings)
+
+	ctx := context.TODO()
+	statePath := networkmanager.StatePath("dns", resolvedInterface)
+	state := networkmanager.GetState(ctx, u.stateKV, u.hostname, statePath)
+
+	if state != nil && state.SHA256 == sha {
+		u.logger.Debug(
+			"dns configuration unchanged, skipping",
+			slog.String("interface", resolvedInterface),
+		)
+
+		return &UpdateResult{Changed: false}, nil
+	}
+
+	metadata := map[string]string{
+		"domain":    "dns",
+		"interface": resolvedInterface,
+	}
+
+	if state != nil {
+		metadata["connection"] = state.Metadata["connection"]
+		metadata["previous"] = state.Metadata["previous"]
+	} else {
+		conn, connErr := networkmanager.ActiveConnection(u.execManager, resolvedInterface)
+		if connErr != nil {
+			return nil, fmt.Errorf("dns update: %w", connErr)
+		}
+		metadata["connection"] = conn
+	}
+
+	previous, modifyErr := networkmanager.ModifyConnection(
+		u.execManager,
+		metadata["connection"],
+		resolvedInterface,
+		settings,
+	)
+	if modifyErr != nil {
+		return nil, fmt.Errorf("dns update: %w", modifyErr)
+	}
+
+	// Record what was in place before osapi took over the interface.
+	if state == nil {
+		previousJSON, marshalErr := marshalJSON(previous)
+		if marshalErr != nil {
+			return nil, fmt.Errorf("dns update: marshal previous settings: %w", marshalErr)
+		}
+		metadata["previous"] = string(previousJSON)
+	}
+
+	if putErr := networkmanager.PutState(
+		ctx,
+		u.stateKV,
+		u.hostname,
+		statePath,
+		sha,
+		"",
+		metadata,
+	); putErr != nil {
+		return nil, fmt.Errorf("dns update: %w", putErr)
+	}
+
+	return &UpdateResult{Changed: true}, nil
+}
+
+// DeleteNetplanConfig restores the DNS settings the interface's connection
+// had before osapi managed it. Returns false when DNS is not managed for
+// the interface.
+func (u *NetworkManager) DeleteNetplanConfig(
+	interfaceName string,
+) (bool, error) {
+	ctx := context.TODO()
+	resolvedInterface := u.resolvePrimaryInterface(interfaceName)
+	statePath := networkmanager.StatePath("dns", resolvedInterface)
+
+	state := networkmanager.GetState(ctx, u.stateKV, u.hostname, statePath)
+	if state == nil {
+		return false, nil
+	}
+
+	var previous []networkmanager.Setting
+	if err := json.Unmarshal([]byte(state.Metadata["previous"]), &previous); err != nil {
+		return false, fmt.Errorf("dns delete via networkmanager: unmarshal previous settings: %w", err)
+	}
+
+	if _, err := networkmanager.ModifyConnection(
+		u.execManager,
+		state.Metadata["connection"],
+		resolvedInterface,
+		previous,
+	); err != nil {
+		return false, fmt.Errorf("dns delete via networkmanager: %w", err)
+	}
+
+	networkmanager.MarkRemoved(ctx, u.logger, u.stateKV, u.hostname, statePath)
+
+	return true, nil
+}
+
+// dnsSettings builds the nmcli settings for the given servers and search
+// domains. When overrideDHCP is true, DNS from DHCP and IPv6 router
+// advertisements is ignored so only the configured servers are used.
+func dnsSettings(
+	servers []string,
+	searchDomains []string,
+	overrideDHCP bool,
+) []networkmanager.Setting {
+	var v4, v6 []string
+	for _, s := range servers {
+		if ip := net.ParseIP(s); ip != nil && ip.To4() == nil {
+			v6 = append(v6, s)
+		} else {
+			v4 = append(v4, s)
+		}
+	}
+
+	ignoreAuto := "no"
+	if overrideDHCP {
+		ignoreAuto = "yes"
+	}
+
+	return []networkmanager.Setting{
+		{Property: "ipv4.dns", Value: strings.Join(v4, ",")},
+		{Property: "ipv6.dns", Value: strings.Join(v6, ",")},
+		{Property: "ipv4.dns-search", Value: strings.Join(searchDomains, ",")},
+		{Property: "ipv4.ignore-auto-dns", Value: ignoreAuto},
+		{Property: "ipv6.ignore-auto-dns", Value: ignoreAuto},
+	}
+}
+
+// settingsSHA returns the content hash used to detect DNS changes.
+func settingsSHA(
+	settings []networkmanager.Setting,
+) string {
+	var b strings.Builder
+	for _, s := range settings {
+		fmt.Fprintf(&b, "%s=%s\n", s.Property, s.Value)
+	}
+
+	return netplan.ComputeSHA256([]byte(b.String()))
+}
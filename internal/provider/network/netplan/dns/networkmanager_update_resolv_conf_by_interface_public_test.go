@@ -0,0 +1,463 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package dns_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider/network/netplan/dns"
+)
+
+// nmDNSSettings is the rendered form of servers 1.1.1.1, search domain
+// example.com, without a DHCP override; its hash marks DNS as unchanged.
+const nmDNSSettings = "ipv4.dns=1.1.1.1\n" +
+	"ipv6.dns=\n" +
+	"ipv4.dns-search=example.com\n" +
+	"ipv4.ignore-auto-dns=no\n" +
+	"ipv6.ignore-auto-dns=no\n"
+
+// nmPrevious is the recorded pre-osapi DNS configuration.
+const nmPrevious = `[{"property":"ipv4.dns","value":"192.168.1.1"},` +
+	`{"property":"ipv6.dns","value":""},` +
+	`{"property":"ipv4.dns-search","value":""},` +
+	`{"property":"ipv4.ignore-auto-dns","value":"no"},` +
+	`{"property":"ipv6.ignore-auto-dns","value":"no"}]`
+
+type NetworkManagerUpdateResolvConfByInterfacePublicTestSuite struct {
+	suite.Suite
+
+	ctrl        *gomock.Controller
+	logger      *slog.Logger
+	mockStateKV *jobmocks.MockKeyValue
+	mockExec    *execmocks.MockManager
+	provider    *dns.NetworkManager
+}
+
+func (suite *NetworkManagerUpdateResolvConfByInterfacePublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.mockStateKV = jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+
+	suite.provider = dns.NewNetworkManagerProvider(
+		suite.logger,
+		suite.mockStateKV,
+		suite.mockExec,
+		"test-host",
+	)
+}
+
+func (suite *NetworkManagerUpdateResolvConfByInterfacePublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *NetworkManagerUpdateResolvConfByInterfacePublicTestSuite) TearDownSubTest() {
+	dns.ResetMarshalJSON()
+}
+
+func (suite *NetworkManagerUpdateResolvConfByInterfacePublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+// expectDevice returns the eth0 fixture for the given number of
+// `nmcli device show` calls.
+func (suite *NetworkManagerUpdateResolvConfByInterfacePublicTestSuite) expectDevice(
+	times int,
+) {
+	suite.mockExec.EXPECT().
+		RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+		Return(nmcliDeviceWithDNS, nil).
+		Times(times)
+}
+
+// expectState returns managed DNS state from the KV.
+func (suite *NetworkManagerUpdateResolvConfByInterfacePublicTestSuite) expectState(
+	sha string,
+	times int,
+) {
+	stateBytes, _ := json.Marshal(job.FileState{
+		Path:   "nmcli:eth0/dns",
+		SHA256: sha,
+		Metadata: map[string]string{
+			"domain":     "dns",
+			"interface":  "eth0",
+			"connection": "Wired connection 1",
+			"previous":   nmPrevious,
+		},
+	})
+
+	mockEntry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+	mockEntry.EXPECT().Value().Return(stateBytes).Times(times)
+
+	suite.mockStateKV.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(mockEntry, nil).
+		Times(times)
+}
+
+// expectNoState reports DNS as not managed.
+func (suite *NetworkManagerUpdateResolvConfByInterfacePublicTestSuite) expectNoState() {
+	suite.mockStateKV.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("not found"))
+}
+
+// expectSnapshot answers every `nmcli -g` property read.
+func (suite *NetworkManagerUpdateResolvConfByInterfacePublicTestSuite) expectSnapshot() {
+	suite.mockExec.EXPECT().
+		RunCmd("nmcli", gomock.Any()).
+		Return("", nil).
+		AnyTimes()
+}
+
+func (suite *NetworkManagerUpdateResolvConfByInterfacePublicTestSuite) TestUpdateResolvConfByInterface() {
+	sum := sha256.Sum256([]byte(nmDNSSettings))
+	unchangedSHA := hex.EncodeToString(sum[:])
+
+	tests := []struct {
+		name          string
+		servers       []string
+		searchDomains []string
+		overrideDHCP  bool
+		setup         func()
+		validateFunc  func(*dns.UpdateResult, error)
+	}{
+		{
+			name: "when no servers or search domains are provided",
+			setup: func() {
+			},
+			validateFunc: func(got *dns.UpdateResult, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "nothing to update")
+				suite.Nil(got)
+			},
+		},
+		{
+			name:    "when the device does not exist",
+			servers: []string{"1.1.1.1"},
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+					Return("", errors.New("not found"))
+			},
+			validateFunc: func(got *dns.UpdateResult, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "failed to get current nmcli configuration")
+				suite.Nil(got)
+			},
+		},
+		{
+			name:          "when first applied records previous settings",
+			servers:       []string{"1.1.1.1", "2606:4700:4700::1111"},
+			searchDomains: []string{"corp.example.com"},
+			overrideDHCP:  true,
+			setup: func() {
+				suite.expectDevice(2)
+				suite.expectNoState()
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-g", "ipv4.dns", "connection", "show", "Wired connection 1"}).
+					Return("192.168.1.1\n", nil)
+				suite.expectSnapshot()
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{
+						"connection", "modify", "Wired connection 1",
+						"ipv4.dns", "1.1.1.1",
+						"ipv6.dns", "2606:4700:4700::1111",
+						"ipv4.dns-search", "corp.example.com",
+						"ipv4.ignore-auto-dns", "yes",
+						"ipv6.ignore-auto-dns", "yes",
+					}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"device", "reapply", "eth0"}).
+					Return("", nil)
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, value []byte) (uint64, error) {
+						var state job.FileState
+						suite.Require().NoError(json.Unmarshal(value, &state))
+						suite.Equal("nmcli:eth0/dns", state.Path)
+						suite.Equal("Wired connection 1", state.Metadata["connection"])
+						suite.Contains(state.Metadata["previous"], `"value":"192.168.1.1"`)
+
+						return 1, nil
+					})
+			},
+			validateFunc: func(got *dns.UpdateResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(got.Changed)
+			},
+		},
+		{
+			name:    "when only servers are provided keeps existing search domains",
+			servers: []string{"1.1.1.1"},
+			setup: func() {
+				suite.expectDevice(1)
+				suite.expectState("old", 1)
+				suite.expectSnapshot()
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{
+						"connection", "modify", "Wired connection 1",
+						"ipv4.dns", "1.1.1.1",
+						"ipv6.dns", "",
+						"ipv4.dns-search", "example.com",
+						"ipv4.ignore-auto-dns", "no",
+						"ipv6.ignore-auto-dns", "no",
+					}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"device", "reapply", "eth0"}).
+					Return("", nil)
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, value []byte) (uint64, error) {
+						var state job.FileState
+						suite.Require().NoError(json.Unmarshal(value, &state))
+						suite.Equal(nmPrevious, state.Metadata["previous"])
+
+						return 1, nil
+					})
+			},
+			validateFunc: func(got *dns.UpdateResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(got.Changed)
+			},
+		},
+		{
+			name:          "when configuration is unchanged",
+			servers:       []string{"1.1.1.1"},
+			searchDomains: []string{"example.com"},
+			setup: func() {
+				suite.expectDevice(1)
+				suite.expectState(unchangedSHA, 1)
+			},
+			validateFunc: func(got *dns.UpdateResult, err error) {
+				suite.Require().NoError(err)
+				suite.False(got.Changed)
+			},
+		},
+		{
+			name:    "when the device has no active connection",
+			servers: []string{"1.1.1.1"},
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+					Return("GENERAL.DEVICE:eth0\n", nil).
+					Times(2)
+				suite.expectNoState()
+			},
+			validateFunc: func(got *dns.UpdateResult, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "no active connection")
+				suite.Nil(got)
+			},
+		},
+		{
+			name:    "when nmcli modify fails",
+			servers: []string{"1.1.1.1"},
+			setup: func() {
+				suite.expectDevice(2)
+				suite.expectNoState()
+				suite.expectSnapshot()
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", gomock.Any()).
+					Return("", errors.New("invalid property"))
+			},
+			validateFunc: func(got *dns.UpdateResult, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "dns update: nmcli modify")
+				suite.Nil(got)
+			},
+		},
+		{
+			name:    "when marshaling previous settings fails",
+			servers: []string{"1.1.1.1"},
+			setup: func() {
+				suite.expectDevice(2)
+				suite.expectNoState()
+				suite.expectSnapshot()
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", gomock.Any()).
+					Return("", nil).
+					Times(2)
+				dns.SetMarshalJSON(func(_ any) ([]byte, error) {
+					return nil, errors.New("marshal failure")
+				})
+			},
+			validateFunc: func(got *dns.UpdateResult, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "marshal previous settings")
+				suite.Nil(got)
+			},
+		},
+		{
+			name:    "when state update fails",
+			servers: []string{"1.1.1.1"},
+			setup: func() {
+				suite.expectDevice(2)
+				suite.expectNoState()
+				suite.expectSnapshot()
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", gomock.Any()).
+					Return("", nil).
+					Times(2)
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(0), errors.New("kv unavailable"))
+			},
+			validateFunc: func(got *dns.UpdateResult, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "dns update: update state")
+				suite.Nil(got)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.UpdateResolvConfByInterface(
+				tc.servers,
+				tc.searchDomains,
+				"eth0",
+				tc.overrideDHCP,
+			)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *NetworkManagerUpdateResolvConfByInterfacePublicTestSuite) TestDeleteNetplanConfig() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func(bool, error)
+	}{
+		{
+			name: "when dns is not managed",
+			setup: func() {
+				suite.expectNoState()
+			},
+			validateFunc: func(got bool, err error) {
+				suite.Require().NoError(err)
+				suite.False(got)
+			},
+		},
+		{
+			name: "when previous settings are restored",
+			setup: func() {
+				suite.expectState("abc", 2)
+				suite.expectSnapshot()
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{
+						"connection", "modify", "Wired connection 1",
+						"ipv4.dns", "192.168.1.1",
+						"ipv6.dns", "",
+						"ipv4.dns-search", "",
+						"ipv4.ignore-auto-dns", "no",
+						"ipv6.ignore-auto-dns", "no",
+					}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"device", "reapply", "eth0"}).
+					Return("", nil)
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, value []byte) (uint64, error) {
+						var state job.FileState
+						suite.Require().NoError(json.Unmarshal(value, &state))
+						suite.NotEmpty(state.UndeployedAt)
+
+						return 1, nil
+					})
+			},
+			validateFunc: func(got bool, err error) {
+				suite.Require().NoError(err)
+				suite.True(got)
+			},
+		},
+		{
+			name: "when previous settings are malformed",
+			setup: func() {
+				stateBytes, _ := json.Marshal(job.FileState{
+					Metadata: map[string]string{"previous": "not json"},
+				})
+				mockEntry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+				mockEntry.EXPECT().Value().Return(stateBytes)
+
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(mockEntry, nil)
+			},
+			validateFunc: func(got bool, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "unmarshal previous settings")
+				suite.False(got)
+			},
+		},
+		{
+			name: "when nmcli restore fails",
+			setup: func() {
+				suite.expectState("abc", 1)
+				suite.expectSnapshot()
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", gomock.Any()).
+					Return("", errors.New("connection not found"))
+			},
+			validateFunc: func(got bool, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "dns delete via networkmanager")
+				suite.False(got)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.DeleteNetplanConfig("eth0")
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestNetworkManagerUpdateResolvConfByInterfacePublicTestSuite(t *testing.T) {
+	suite.Run(t, new(NetworkManagerUpdateResolvConfByInterfacePublicTestSuite))
+}
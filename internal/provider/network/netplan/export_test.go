@@ -31,3 +31,13 @@ func SetMarshalJSON(fn func(interface{}) ([]byte, error)) {
 func ResetMarshalJSON() {
 	marshalJSON = json.Marshal
 }
+
+// RevertGuard runs the pending revert immediately, as if the timeout
+// had expired.
+func RevertGuard(g *Guard) {
+	g.mu.Lock()
+	generation := g.generation
+	g.mu.Unlock()
+
+	g.revert(generation)
+}
@@ -0,0 +1,456 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package netplan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/avfs/avfs"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/osapi-io/osapi/internal/exec"
+)
+
+const (
+	// netplanDir is the directory whose files are snapshotted and restored.
+	netplanDir = "/etc/netplan"
+
+	// guardKeySuffix is appended to the hostname to form the state KV key
+	// holding the pending change.
+	guardKeySuffix = ".netplan-guard"
+
+	// guardStateTimeout bounds each state KV call made by the guard.
+	guardStateTimeout = 5 * time.Second
+)
+
+// Guard applies Netplan changes in confirm-or-revert mode. Before the
+// first unconfirmed change it snapshots the Netplan files; once the
+// change is applied it waits for Confirm. When the timeout expires
+// first, the snapshot is restored and re-applied with `netplan apply`.
+// The pending snapshot is persisted in the state KV so a restarted
+// agent can finish the revert with Recover. A nil *Guard applies
+// changes without waiting for confirmation.
+type Guard struct {
+	logger      *slog.Logger
+	fs          avfs.VFS
+	stateKV     jetstream.KeyValue
+	execManager exec.Manager
+	stateKey    string
+	timeout     time.Duration
+
+	mu         sync.Mutex
+	snapshot   map[string]snapshotFile
+	changedAt  time.Time
+	timer      *time.Timer
+	generation uint64
+}
+
+// snapshotFile is the content and mode of a Netplan file at snapshot time.
+type snapshotFile struct {
+	Data []byte      `json:"data"`
+	Mode fs.FileMode `json:"mode"`
+}
+
+// pendingChange is the state KV record of an unconfirmed change. A zero
+// Deadline means the change was written but the revert was never armed.
+type pendingChange struct {
+	Files     map[string]snapshotFile `json:"files"`
+	ChangedAt time.Time               `json:"changed_at"`
+	Deadline  time.Time               `json:"deadline"`
+}
+
+// NewGuard creates a Guard that reverts unconfirmed Netplan changes
+// after timeout. The pending snapshot is stored in stateKV under a key
+// derived from hostname; a nil stateKV keeps it in memory only.
+func NewGuard(
+	logger *slog.Logger,
+	fs avfs.VFS,
+	stateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+	timeout time.Duration,
+) *Guard {
+	return &Guard{
+		logger:      logger.With(slog.String("subsystem", "provider.netplan.guard")),
+		fs:          fs,
+		stateKV:     stateKV,
+		execManager: execManager,
+		stateKey:    hostname + guardKeySuffix,
+		timeout:     timeout,
+	}
+}
+
+// Pending reports whether an applied change is waiting for confirmation.
+func (g *Guard) Pending() bool {
+	if g == nil {
+		return false
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.timer != nil
+}
+
+// Confirm keeps the pending change and cancels its revert. Returns true
+// when a change was pending, false when there was nothing to confirm.
+func (g *Guard) Confirm() bool {
+	if g == nil {
+		return false
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.confirm()
+}
+
+// ConfirmReconnect confirms the pending change after the NATS connection
+// is re-established, but only when the connection dropped at or after the
+// change was made. A reconnect that follows an earlier, unrelated outage
+// proves nothing about the new configuration.
+func (g *Guard) ConfirmReconnect(
+	disconnectedAt time.Time,
+) bool {
+	if g == nil {
+		return false
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.timer == nil || disconnectedAt.Before(g.changedAt) {
+		return false
+	}
+
+	return g.confirm()
+}
+
+// Recover resumes a change left unconfirmed by a previous agent run.
+// A change that was never armed, or whose deadline has passed, is
+// reverted immediately; otherwise the revert is re-armed for the time
+// remaining.
+func (g *Guard) Recover() error {
+	if g == nil || g.stateKV == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), guardStateTimeout)
+	defer cancel()
+
+	entry, err := g.stateKV.Get(ctx, g.stateKey)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("netplan recover: read state: %w", err)
+	}
+
+	var pending pendingChange
+	if unmarshalErr := json.Unmarshal(entry.Value(), &pending); unmarshalErr != nil {
+		return fmt.Errorf("netplan recover: parse state: %w", unmarshalErr)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	remaining := time.Until(pending.Deadline)
+	if pending.Deadline.IsZero() || remaining <= 0 {
+		if restoreErr := g.restore(pending.Files); restoreErr != nil {
+			return restoreErr
+		}
+
+		g.clearState()
+		g.logger.Warn("netplan change not confirmed before restart, previous configuration restored")
+
+		return nil
+	}
+
+	g.snapshot = pending.Files
+	g.changedAt = pending.ChangedAt
+	g.start(remaining)
+
+	g.logger.Warn(
+		"netplan change from previous run pending confirmation",
+		slog.Duration("timeout", remaining),
+	)
+
+	return nil
+}
+
+// begin snapshots the Netplan files before a change is written. While a
+// change is pending, the existing snapshot is kept so a revert returns
+// to the last confirmed configuration.
+func (g *Guard) begin() error {
+	if g == nil {
+		return nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.changedAt = time.Now()
+
+	if g.timer != nil {
+		return nil
+	}
+
+	snapshot, err := g.readFiles()
+	if err != nil {
+		return err
+	}
+
+	if saveErr := g.saveState(pendingChange{
+		Files:     snapshot,
+		ChangedAt: g.changedAt,
+	}); saveErr != nil {
+		return fmt.Errorf("netplan snapshot: %w", saveErr)
+	}
+
+	g.snapshot = snapshot
+
+	return nil
+}
+
+// cancel drops the snapshot taken by begin when the change was never
+// applied. A change that is already pending keeps its snapshot.
+func (g *Guard) cancel() {
+	if g == nil {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.timer != nil {
+		return
+	}
+
+	g.snapshot = nil
+	g.clearState()
+}
+
+// arm starts, or restarts, the revert timer after a change is applied.
+func (g *Guard) arm() {
+	if g == nil {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.start(g.timeout)
+
+	if err := g.saveState(pendingChange{
+		Files:     g.snapshot,
+		ChangedAt: g.changedAt,
+		Deadline:  time.Now().Add(g.timeout),
+	}); err != nil {
+		g.logger.Warn(
+			"netplan change will not be reverted after a restart",
+			slog.String("error", err.Error()),
+		)
+	}
+
+	g.logger.Warn(
+		"netplan change pending confirmation",
+		slog.Duration("timeout", g.timeout),
+	)
+}
+
+// start replaces the revert timer with one that fires after timeout.
+// The caller must hold g.mu.
+func (g *Guard) start(
+	timeout time.Duration,
+) {
+	if g.timer != nil {
+		g.timer.Stop()
+	}
+
+	g.generation++
+	generation := g.generation
+	g.timer = time.AfterFunc(timeout, func() {
+		g.revert(generation)
+	})
+}
+
+// confirm stops the revert timer and forgets the snapshot. The caller
+// must hold g.mu.
+func (g *Guard) confirm() bool {
+	if g.timer == nil {
+		return false
+	}
+
+	g.timer.Stop()
+	g.timer = nil
+	g.snapshot = nil
+	g.clearState()
+
+	g.logger.Info("netplan change confirmed")
+
+	return true
+}
+
+// revert restores the snapshot when the change armed as generation is
+// still unconfirmed. A failed revert keeps the persisted snapshot so
+// Recover retries it on the next start.
+func (g *Guard) revert(
+	generation uint64,
+) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.timer == nil || g.generation != generation {
+		return
+	}
+
+	snapshot := g.snapshot
+	g.timer = nil
+	g.snapshot = nil
+
+	if err := g.restore(snapshot); err != nil {
+		g.logger.Error(
+			"netplan revert failed",
+			slog.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	g.clearState()
+	g.logger.Warn("netplan change not confirmed, previous configuration restored")
+}
+
+// saveState writes pending to the state KV.
+func (g *Guard) saveState(
+	pending pendingChange,
+) error {
+	if g.stateKV == nil {
+		return nil
+	}
+
+	data, err := marshalJSON(pending)
+	if err != nil {
+		return fmt.Errorf("marshal state: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), guardStateTimeout)
+	defer cancel()
+
+	if _, putErr := g.stateKV.Put(ctx, g.stateKey, data); putErr != nil {
+		return fmt.Errorf("update state: %w", putErr)
+	}
+
+	return nil
+}
+
+// clearState removes the pending change from the state KV. Failures are
+// logged.
+func (g *Guard) clearState() {
+	if g.stateKV == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), guardStateTimeout)
+	defer cancel()
+
+	if err := g.stateKV.Delete(ctx, g.stateKey); err != nil &&
+		!errors.Is(err, jetstream.ErrKeyNotFound) {
+		g.logger.Warn(
+			"failed to clear netplan guard state",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// readFiles returns the contents of the YAML files in the Netplan
+// directory keyed by path.
+func (g *Guard) readFiles() (map[string]snapshotFile, error) {
+	entries, err := g.fs.ReadDir(netplanDir)
+	if err != nil {
+		return nil, fmt.Errorf("netplan snapshot: read directory: %w", err)
+	}
+
+	files := make(map[string]snapshotFile, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".yaml") {
+			continue
+		}
+
+		path := filepath.Join(netplanDir, entry.Name())
+
+		info, statErr := g.fs.Stat(path)
+		if statErr != nil {
+			return nil, fmt.Errorf("netplan snapshot: stat %s: %w", path, statErr)
+		}
+
+		data, readErr := g.fs.ReadFile(path)
+		if readErr != nil {
+			return nil, fmt.Errorf("netplan snapshot: read %s: %w", path, readErr)
+		}
+
+		files[path] = snapshotFile{Data: data, Mode: info.Mode().Perm()}
+	}
+
+	return files, nil
+}
+
+// restore rewrites the Netplan directory to match snapshot and applies
+// it. Files created since the snapshot are removed.
+func (g *Guard) restore(
+	snapshot map[string]snapshotFile,
+) error {
+	current, err := g.readFiles()
+	if err != nil {
+		return err
+	}
+
+	for path := range current {
+		if _, ok := snapshot[path]; ok {
+			continue
+		}
+
+		if removeErr := g.fs.Remove(path); removeErr != nil {
+			return fmt.Errorf("netplan revert: remove %s: %w", path, removeErr)
+		}
+	}
+
+	for path, file := range snapshot {
+		if writeErr := g.fs.WriteFile(path, file.Data, file.Mode); writeErr != nil {
+			return fmt.Errorf("netplan revert: write %s: %w", path, writeErr)
+		}
+	}
+
+	if _, applyErr := g.execManager.RunPrivilegedCmd("netplan", []string{"apply"}); applyErr != nil {
+		return fmt.Errorf("netplan revert: apply: %w", applyErr)
+	}
+
+	return nil
+}
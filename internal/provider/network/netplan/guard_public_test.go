@@ -0,0 +1,906 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package netplan_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider/network/netplan"
+)
+
+const (
+	testBasePath = "/etc/netplan/50-cloud-init.yaml"
+	testNewPath  = "/etc/netplan/osapi-eth1.yaml"
+	testGuardKey = testHostname + ".netplan-guard"
+)
+
+var (
+	testBaseContent = []byte("network:\n  version: 2\n")
+	testOldContent  = []byte("network:\n  ethernets:\n    eth0:\n      dhcp4: true\n")
+)
+
+type GuardPublicTestSuite struct {
+	suite.Suite
+
+	ctrl        *gomock.Controller
+	ctx         context.Context
+	logger      *slog.Logger
+	memFs       avfs.VFS
+	mockStateKV *jobmocks.MockKeyValue
+	mockGuardKV *jobmocks.MockKeyValue
+	mockExec    *execmocks.MockManager
+}
+
+func (suite *GuardPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.ctx = context.Background()
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+	suite.mockStateKV = jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockGuardKV = jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+
+	_ = suite.memFs.MkdirAll("/etc/netplan", 0o755)
+	_ = suite.memFs.WriteFile(testBasePath, testBaseContent, 0o600)
+}
+
+func (suite *GuardPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *GuardPublicTestSuite) expectDeploy() {
+	suite.mockStateKV.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("not found"))
+
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("netplan", []string{"generate"}).
+		Return("", nil)
+
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("netplan", []string{"apply"}).
+		Return("", nil)
+
+	suite.mockStateKV.EXPECT().
+		Put(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(uint64(1), nil)
+}
+
+func (suite *GuardPublicTestSuite) waitReverted(
+	guard *netplan.Guard,
+) {
+	suite.Eventually(func() bool {
+		return !guard.Pending()
+	}, time.Second, 5*time.Millisecond)
+}
+
+func (suite *GuardPublicTestSuite) TestApplyConfig() {
+	tests := []struct {
+		name         string
+		timeout      time.Duration
+		guardFs      avfs.VFS
+		setup        func(guard *netplan.Guard)
+		validateFunc func(guard *netplan.Guard, changed bool, err error)
+	}{
+		{
+			name:    "when change is confirmed before the timeout",
+			timeout: time.Hour,
+			setup: func(_ *netplan.Guard) {
+				suite.expectDeploy()
+			},
+			validateFunc: func(guard *netplan.Guard, changed bool, err error) {
+				suite.Require().NoError(err)
+				suite.True(changed)
+				suite.True(guard.Pending())
+
+				suite.True(guard.Confirm())
+				suite.False(guard.Pending())
+				suite.False(guard.Confirm())
+
+				data, readErr := suite.memFs.ReadFile(testPath)
+				suite.Require().NoError(readErr)
+				suite.Equal(testContent, data)
+			},
+		},
+		{
+			name:    "when change is not confirmed reverts the previous files",
+			timeout: 10 * time.Millisecond,
+			setup: func(_ *netplan.Guard) {
+				_ = suite.memFs.WriteFile(testPath, testOldContent, 0o644)
+
+				suite.expectDeploy()
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"apply"}).
+					Return("", nil)
+			},
+			validateFunc: func(guard *netplan.Guard, changed bool, err error) {
+				suite.Require().NoError(err)
+				suite.True(changed)
+
+				suite.waitReverted(guard)
+
+				data, readErr := suite.memFs.ReadFile(testPath)
+				suite.Require().NoError(readErr)
+				suite.Equal(testOldContent, data)
+
+				info, statErr := suite.memFs.Stat(testPath)
+				suite.Require().NoError(statErr)
+				suite.Equal(os.FileMode(0o644), info.Mode().Perm())
+
+				data, readErr = suite.memFs.ReadFile(testBasePath)
+				suite.Require().NoError(readErr)
+				suite.Equal(testBaseContent, data)
+			},
+		},
+		{
+			name:    "when new file is not confirmed removes it",
+			timeout: 10 * time.Millisecond,
+			setup: func(_ *netplan.Guard) {
+				suite.expectDeploy()
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"apply"}).
+					Return("", nil)
+			},
+			validateFunc: func(guard *netplan.Guard, changed bool, err error) {
+				suite.Require().NoError(err)
+				suite.True(changed)
+
+				suite.waitReverted(guard)
+
+				_, statErr := suite.memFs.Stat(testPath)
+				suite.Error(statErr)
+
+				_, statErr = suite.memFs.Stat(testBasePath)
+				suite.NoError(statErr)
+			},
+		},
+		{
+			name:    "when second change is pending keeps the first snapshot",
+			timeout: time.Hour,
+			setup: func(guard *netplan.Guard) {
+				_ = suite.memFs.WriteFile(testPath, testOldContent, 0o600)
+
+				suite.expectDeploy()
+				suite.expectDeploy()
+
+				_, err := netplan.ApplyConfig(
+					suite.ctx,
+					suite.logger,
+					suite.memFs,
+					suite.mockStateKV,
+					suite.mockExec,
+					guard,
+					testHostname,
+					testNewPath,
+					testContent,
+					nil,
+				)
+				suite.Require().NoError(err)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"apply"}).
+					Return("", nil)
+			},
+			validateFunc: func(guard *netplan.Guard, changed bool, err error) {
+				suite.Require().NoError(err)
+				suite.True(changed)
+
+				netplan.RevertGuard(guard)
+				suite.False(guard.Pending())
+
+				data, readErr := suite.memFs.ReadFile(testPath)
+				suite.Require().NoError(readErr)
+				suite.Equal(testOldContent, data)
+
+				_, statErr := suite.memFs.Stat(testNewPath)
+				suite.Error(statErr)
+			},
+		},
+		{
+			name:    "when revert apply fails",
+			timeout: 10 * time.Millisecond,
+			setup: func(_ *netplan.Guard) {
+				suite.expectDeploy()
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"apply"}).
+					Return("", errors.New("apply failed"))
+			},
+			validateFunc: func(guard *netplan.Guard, changed bool, err error) {
+				suite.Require().NoError(err)
+				suite.True(changed)
+
+				suite.waitReverted(guard)
+
+				_, statErr := suite.memFs.Stat(testPath)
+				suite.Error(statErr)
+			},
+		},
+		{
+			name:    "when apply fails still arms the revert",
+			timeout: time.Hour,
+			setup: func(_ *netplan.Guard) {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"generate"}).
+					Return("", nil)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"apply"}).
+					Return("", errors.New("apply failed"))
+			},
+			validateFunc: func(guard *netplan.Guard, changed bool, err error) {
+				suite.Require().Error(err)
+				suite.False(changed)
+				suite.True(guard.Pending())
+			},
+		},
+		{
+			name:    "when generate fails does not arm the revert",
+			timeout: time.Hour,
+			setup: func(_ *netplan.Guard) {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"generate"}).
+					Return("", errors.New("invalid yaml"))
+			},
+			validateFunc: func(guard *netplan.Guard, changed bool, err error) {
+				suite.Require().Error(err)
+				suite.False(changed)
+				suite.False(guard.Pending())
+			},
+		},
+		{
+			name:    "when snapshot fails",
+			timeout: time.Hour,
+			guardFs: memfs.New(),
+			setup: func(_ *netplan.Guard) {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+			},
+			validateFunc: func(guard *netplan.Guard, changed bool, err error) {
+				suite.Require().Error(err)
+				suite.False(changed)
+				suite.Contains(err.Error(), "netplan snapshot")
+				suite.False(guard.Pending())
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			guardFs := tc.guardFs
+			if guardFs == nil {
+				guardFs = suite.memFs
+			}
+
+			guard := netplan.NewGuard(
+				suite.logger,
+				guardFs,
+				nil,
+				suite.mockExec,
+				testHostname,
+				tc.timeout,
+			)
+			tc.setup(guard)
+
+			changed, err := netplan.ApplyConfig(
+				suite.ctx,
+				suite.logger,
+				suite.memFs,
+				suite.mockStateKV,
+				suite.mockExec,
+				guard,
+				testHostname,
+				testPath,
+				testContent,
+				nil,
+			)
+
+			tc.validateFunc(guard, changed, err)
+		})
+	}
+}
+
+func (suite *GuardPublicTestSuite) TestRemoveConfig() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func(guard *netplan.Guard, changed bool, err error)
+	}{
+		{
+			name: "when removal is not confirmed restores the file",
+			setup: func() {
+				_ = suite.memFs.WriteFile(testPath, testOldContent, 0o600)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"apply"}).
+					Return("", nil).
+					Times(2)
+
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+			},
+			validateFunc: func(guard *netplan.Guard, changed bool, err error) {
+				suite.Require().NoError(err)
+				suite.True(changed)
+
+				suite.waitReverted(guard)
+
+				data, readErr := suite.memFs.ReadFile(testPath)
+				suite.Require().NoError(readErr)
+				suite.Equal(testOldContent, data)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			guard := netplan.NewGuard(
+				suite.logger,
+				suite.memFs,
+				nil,
+				suite.mockExec,
+				testHostname,
+				10*time.Millisecond,
+			)
+			tc.setup()
+
+			changed, err := netplan.RemoveConfig(
+				suite.ctx,
+				suite.logger,
+				suite.memFs,
+				suite.mockStateKV,
+				suite.mockExec,
+				guard,
+				testHostname,
+				testPath,
+			)
+
+			tc.validateFunc(guard, changed, err)
+		})
+	}
+}
+
+func (suite *GuardPublicTestSuite) TestConfirm() {
+	tests := []struct {
+		name         string
+		guard        func() *netplan.Guard
+		validateFunc func(confirmed bool, pending bool)
+	}{
+		{
+			name: "when nothing is pending",
+			guard: func() *netplan.Guard {
+				return netplan.NewGuard(
+					suite.logger,
+					suite.memFs,
+					nil,
+					suite.mockExec,
+					testHostname,
+					time.Hour,
+				)
+			},
+			validateFunc: func(confirmed bool, pending bool) {
+				suite.False(confirmed)
+				suite.False(pending)
+			},
+		},
+		{
+			name: "when guard is nil",
+			guard: func() *netplan.Guard {
+				return nil
+			},
+			validateFunc: func(confirmed bool, pending bool) {
+				suite.False(confirmed)
+				suite.False(pending)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			guard := tc.guard()
+
+			confirmed := guard.Confirm()
+			tc.validateFunc(confirmed, guard.Pending())
+		})
+	}
+}
+
+func (suite *GuardPublicTestSuite) newPersistentGuard(
+	timeout time.Duration,
+) *netplan.Guard {
+	return netplan.NewGuard(
+		suite.logger,
+		suite.memFs,
+		suite.mockGuardKV,
+		suite.mockExec,
+		testHostname,
+		timeout,
+	)
+}
+
+// pendingRecord builds the state KV record of a pending change whose
+// snapshot holds the base file only.
+func (suite *GuardPublicTestSuite) pendingRecord(
+	deadline time.Time,
+) []byte {
+	data, err := json.Marshal(map[string]interface{}{
+		"files": map[string]interface{}{
+			testBasePath: map[string]interface{}{
+				"data": testBaseContent,
+				"mode": 0o600,
+			},
+		},
+		"changed_at": time.Now().Add(-time.Minute),
+		"deadline":   deadline,
+	})
+	suite.Require().NoError(err)
+
+	return data
+}
+
+func (suite *GuardPublicTestSuite) expectRecord(
+	record []byte,
+) {
+	mockEntry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+	mockEntry.EXPECT().Value().Return(record)
+
+	suite.mockGuardKV.EXPECT().
+		Get(gomock.Any(), testGuardKey).
+		Return(mockEntry, nil)
+}
+
+func (suite *GuardPublicTestSuite) TestPersist() {
+	tests := []struct {
+		name         string
+		setup        func(puts *[][]byte)
+		validateFunc func(guard *netplan.Guard, puts [][]byte, changed bool, err error)
+	}{
+		{
+			name: "when change is armed stores the snapshot and deadline",
+			setup: func(puts *[][]byte) {
+				suite.expectDeploy()
+
+				suite.mockGuardKV.EXPECT().
+					Put(gomock.Any(), testGuardKey, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) (uint64, error) {
+						*puts = append(*puts, data)
+						return uint64(1), nil
+					}).
+					Times(2)
+
+				suite.mockGuardKV.EXPECT().
+					Delete(gomock.Any(), testGuardKey).
+					Return(nil)
+			},
+			validateFunc: func(guard *netplan.Guard, puts [][]byte, changed bool, err error) {
+				suite.Require().NoError(err)
+				suite.True(changed)
+				suite.Require().Len(puts, 2)
+
+				var begun, armed struct {
+					Files    map[string]json.RawMessage `json:"files"`
+					Deadline time.Time                  `json:"deadline"`
+				}
+				suite.Require().NoError(json.Unmarshal(puts[0], &begun))
+				suite.Require().NoError(json.Unmarshal(puts[1], &armed))
+
+				suite.Contains(begun.Files, testBasePath)
+				suite.True(begun.Deadline.IsZero())
+				suite.Contains(armed.Files, testBasePath)
+				suite.NotContains(armed.Files, testPath)
+				suite.True(armed.Deadline.After(time.Now()))
+
+				suite.True(guard.Confirm())
+			},
+		},
+		{
+			name: "when generate fails clears the stored snapshot",
+			setup: func(_ *[][]byte) {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+
+				suite.mockGuardKV.EXPECT().
+					Put(gomock.Any(), testGuardKey, gomock.Any()).
+					Return(uint64(1), nil)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"generate"}).
+					Return("", errors.New("invalid yaml"))
+
+				suite.mockGuardKV.EXPECT().
+					Delete(gomock.Any(), testGuardKey).
+					Return(nil)
+			},
+			validateFunc: func(guard *netplan.Guard, _ [][]byte, changed bool, err error) {
+				suite.Require().Error(err)
+				suite.False(changed)
+				suite.False(guard.Pending())
+			},
+		},
+		{
+			name: "when snapshot cannot be stored refuses the change",
+			setup: func(_ *[][]byte) {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+
+				suite.mockGuardKV.EXPECT().
+					Put(gomock.Any(), testGuardKey, gomock.Any()).
+					Return(uint64(0), errors.New("kv unavailable"))
+			},
+			validateFunc: func(guard *netplan.Guard, _ [][]byte, changed bool, err error) {
+				suite.Require().Error(err)
+				suite.False(changed)
+				suite.Contains(err.Error(), "netplan snapshot: update state")
+				suite.False(guard.Pending())
+
+				_, statErr := suite.memFs.Stat(testPath)
+				suite.Error(statErr)
+			},
+		},
+		{
+			name: "when revert succeeds clears the stored snapshot",
+			setup: func(_ *[][]byte) {
+				suite.expectDeploy()
+
+				suite.mockGuardKV.EXPECT().
+					Put(gomock.Any(), testGuardKey, gomock.Any()).
+					Return(uint64(1), nil).
+					Times(2)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"apply"}).
+					Return("", nil)
+
+				suite.mockGuardKV.EXPECT().
+					Delete(gomock.Any(), testGuardKey).
+					Return(nil)
+			},
+			validateFunc: func(guard *netplan.Guard, _ [][]byte, changed bool, err error) {
+				suite.Require().NoError(err)
+				suite.True(changed)
+
+				netplan.RevertGuard(guard)
+				suite.False(guard.Pending())
+			},
+		},
+		{
+			name: "when revert fails keeps the stored snapshot",
+			setup: func(_ *[][]byte) {
+				suite.expectDeploy()
+
+				suite.mockGuardKV.EXPECT().
+					Put(gomock.Any(), testGuardKey, gomock.Any()).
+					Return(uint64(1), nil).
+					Times(2)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"apply"}).
+					Return("", errors.New("apply failed"))
+			},
+			validateFunc: func(guard *netplan.Guard, _ [][]byte, changed bool, err error) {
+				suite.Require().NoError(err)
+				suite.True(changed)
+
+				netplan.RevertGuard(guard)
+				suite.False(guard.Pending())
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			var puts [][]byte
+			guard := suite.newPersistentGuard(time.Hour)
+			tc.setup(&puts)
+
+			changed, err := netplan.ApplyConfig(
+				suite.ctx,
+				suite.logger,
+				suite.memFs,
+				suite.mockStateKV,
+				suite.mockExec,
+				guard,
+				testHostname,
+				testPath,
+				testContent,
+				nil,
+			)
+
+			tc.validateFunc(guard, puts, changed, err)
+		})
+	}
+}
+
+func (suite *GuardPublicTestSuite) TestRecover() {
+	tests := []struct {
+		name         string
+		guard        func() *netplan.Guard
+		setup        func()
+		validateFunc func(guard *netplan.Guard, err error)
+	}{
+		{
+			name: "when nothing is stored",
+			setup: func() {
+				suite.mockGuardKV.EXPECT().
+					Get(gomock.Any(), testGuardKey).
+					Return(nil, jetstream.ErrKeyNotFound)
+			},
+			validateFunc: func(guard *netplan.Guard, err error) {
+				suite.NoError(err)
+				suite.False(guard.Pending())
+			},
+		},
+		{
+			name: "when deadline has passed restores the snapshot",
+			setup: func() {
+				_ = suite.memFs.WriteFile(testPath, testContent, 0o600)
+				suite.expectRecord(suite.pendingRecord(time.Now().Add(-time.Minute)))
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"apply"}).
+					Return("", nil)
+
+				suite.mockGuardKV.EXPECT().
+					Delete(gomock.Any(), testGuardKey).
+					Return(nil)
+			},
+			validateFunc: func(guard *netplan.Guard, err error) {
+				suite.Require().NoError(err)
+				suite.False(guard.Pending())
+
+				_, statErr := suite.memFs.Stat(testPath)
+				suite.Error(statErr)
+
+				_, statErr = suite.memFs.Stat(testBasePath)
+				suite.NoError(statErr)
+			},
+		},
+		{
+			name: "when change was never armed restores the snapshot",
+			setup: func() {
+				_ = suite.memFs.WriteFile(testPath, testContent, 0o600)
+				suite.expectRecord(suite.pendingRecord(time.Time{}))
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"apply"}).
+					Return("", nil)
+
+				suite.mockGuardKV.EXPECT().
+					Delete(gomock.Any(), testGuardKey).
+					Return(nil)
+			},
+			validateFunc: func(guard *netplan.Guard, err error) {
+				suite.Require().NoError(err)
+				suite.False(guard.Pending())
+
+				_, statErr := suite.memFs.Stat(testPath)
+				suite.Error(statErr)
+			},
+		},
+		{
+			name: "when deadline is ahead re-arms the revert",
+			setup: func() {
+				_ = suite.memFs.WriteFile(testPath, testContent, 0o600)
+				suite.expectRecord(suite.pendingRecord(time.Now().Add(time.Hour)))
+
+				suite.mockGuardKV.EXPECT().
+					Delete(gomock.Any(), testGuardKey).
+					Return(nil)
+			},
+			validateFunc: func(guard *netplan.Guard, err error) {
+				suite.Require().NoError(err)
+				suite.True(guard.Pending())
+
+				_, statErr := suite.memFs.Stat(testPath)
+				suite.NoError(statErr)
+
+				suite.True(guard.Confirm())
+			},
+		},
+		{
+			name: "when restore fails keeps the stored snapshot",
+			setup: func() {
+				suite.expectRecord(suite.pendingRecord(time.Now().Add(-time.Minute)))
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"apply"}).
+					Return("", errors.New("apply failed"))
+			},
+			validateFunc: func(guard *netplan.Guard, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "netplan revert: apply")
+				suite.False(guard.Pending())
+			},
+		},
+		{
+			name: "when state cannot be read",
+			setup: func() {
+				suite.mockGuardKV.EXPECT().
+					Get(gomock.Any(), testGuardKey).
+					Return(nil, errors.New("kv unavailable"))
+			},
+			validateFunc: func(_ *netplan.Guard, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "netplan recover: read state")
+			},
+		},
+		{
+			name: "when state is not valid JSON",
+			setup: func() {
+				suite.expectRecord([]byte("not json"))
+			},
+			validateFunc: func(_ *netplan.Guard, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "netplan recover: parse state")
+			},
+		},
+		{
+			name: "when state KV is not configured",
+			guard: func() *netplan.Guard {
+				return netplan.NewGuard(
+					suite.logger,
+					suite.memFs,
+					nil,
+					suite.mockExec,
+					testHostname,
+					time.Hour,
+				)
+			},
+			setup: func() {},
+			validateFunc: func(guard *netplan.Guard, err error) {
+				suite.NoError(err)
+				suite.False(guard.Pending())
+			},
+		},
+		{
+			name: "when guard is nil",
+			guard: func() *netplan.Guard {
+				return nil
+			},
+			setup: func() {},
+			validateFunc: func(guard *netplan.Guard, err error) {
+				suite.NoError(err)
+				suite.False(guard.Pending())
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			guard := suite.newPersistentGuard(time.Hour)
+			if tc.guard != nil {
+				guard = tc.guard()
+			}
+			tc.setup()
+
+			err := guard.Recover()
+			tc.validateFunc(guard, err)
+		})
+	}
+}
+
+func (suite *GuardPublicTestSuite) TestConfirmReconnect() {
+	tests := []struct {
+		name           string
+		nilGuard       bool
+		disconnectedAt func(changedAt time.Time) time.Time
+		validateFunc   func(guard *netplan.Guard, confirmed bool)
+	}{
+		{
+			name: "when connection dropped after the change",
+			disconnectedAt: func(changedAt time.Time) time.Time {
+				return changedAt.Add(time.Second)
+			},
+			validateFunc: func(guard *netplan.Guard, confirmed bool) {
+				suite.True(confirmed)
+				suite.False(guard.Pending())
+			},
+		},
+		{
+			name: "when connection dropped before the change",
+			disconnectedAt: func(changedAt time.Time) time.Time {
+				return changedAt.Add(-time.Second)
+			},
+			validateFunc: func(guard *netplan.Guard, confirmed bool) {
+				suite.False(confirmed)
+				suite.True(guard.Pending())
+			},
+		},
+		{
+			name:     "when guard is nil",
+			nilGuard: true,
+			disconnectedAt: func(changedAt time.Time) time.Time {
+				return changedAt.Add(time.Second)
+			},
+			validateFunc: func(guard *netplan.Guard, confirmed bool) {
+				suite.False(confirmed)
+				suite.False(guard.Pending())
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			guard := netplan.NewGuard(
+				suite.logger,
+				suite.memFs,
+				nil,
+				suite.mockExec,
+				testHostname,
+				time.Hour,
+			)
+			if tc.nilGuard {
+				guard = nil
+			}
+			suite.expectDeploy()
+
+			changedAt := time.Now()
+			_, err := netplan.ApplyConfig(
+				suite.ctx,
+				suite.logger,
+				suite.memFs,
+				suite.mockStateKV,
+				suite.mockExec,
+				guard,
+				testHostname,
+				testPath,
+				testContent,
+				nil,
+			)
+			suite.Require().NoError(err)
+
+			confirmed := guard.ConfirmReconnect(tc.disconnectedAt(changedAt))
+			tc.validateFunc(guard, confirmed)
+		})
+	}
+}
+
+func TestGuardPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(GuardPublicTestSuite))
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/osapi-io/osapi/internal/exec"
 	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/network/netplan"
 )
 
 // Compile-time checks.
@@ -45,6 +46,7 @@ type Debian struct {
 	fs          avfs.VFS
 	stateKV     jetstream.KeyValue
 	execManager exec.Manager
+	guard       *netplan.Guard
 	hostname    string
 }
 
@@ -55,12 +57,14 @@ func NewDebianProvider(
 	stateKV jetstream.KeyValue,
 	execManager exec.Manager,
 	hostname string,
+	guard *netplan.Guard,
 ) *Debian {
 	return &Debian{
 		logger:      logger.With(slog.String("subsystem", "provider.netplan")),
 		fs:          fs,
 		stateKV:     stateKV,
 		execManager: execManager,
+		guard:       guard,
 		hostname:    hostname,
 	}
 }
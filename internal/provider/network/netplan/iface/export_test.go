@@ -21,6 +21,15 @@
 package iface
 
 // GenerateInterfaceYAML exposes generateInterfaceYAML for testing.
-func GenerateInterfaceYAML(entry InterfaceEntry, ifaceSection string) []byte {
-	return generateInterfaceYAML(entry, ifaceSection)
+func GenerateInterfaceYAML(
+	entry InterfaceEntry,
+	ifaceSection string,
+	members []string,
+) []byte {
+	return generateInterfaceYAML(entry, ifaceSection, members)
+}
+
+// GenerateInterfaceKeyfile exposes generateInterfaceKeyfile for testing.
+func GenerateInterfaceKeyfile(entry InterfaceEntry, connType string) []byte {
+	return generateInterfaceKeyfile(entry, connType)
 }
@@ -28,6 +28,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/osapi-io/osapi/internal/exec"
 	"github.com/osapi-io/osapi/internal/provider/network/netplan"
 )
 
@@ -65,6 +66,7 @@ func (d *Debian) List(
 
 		entry := InterfaceEntry{
 			Name:    name,
+			Type:    iface.Type,
 			IPv4:    iface.IPv4(),
 			IPv6:    iface.IPv6(),
 			MAC:     iface.MACAddress,
@@ -72,6 +74,7 @@ func (d *Debian) List(
 			Primary: iface.HasDefaultRoute(),
 			DHCP4:   &dhcp,
 		}
+		readLinkDetails(d.fs, &entry)
 
 		result = append(result, entry)
 	}
@@ -115,6 +118,7 @@ func (d *Debian) Get(
 
 	entry := &InterfaceEntry{
 		Name:    name,
+		Type:    iface.Type,
 		IPv4:    iface.IPv4(),
 		IPv6:    iface.IPv6(),
 		MAC:     iface.MACAddress,
@@ -122,6 +126,7 @@ func (d *Debian) Get(
 		Primary: iface.HasDefaultRoute(),
 		DHCP4:   &dhcp,
 	}
+	readLinkDetails(d.fs, entry)
 
 	return entry, nil
 }
@@ -132,7 +137,7 @@ func (d *Debian) Create(
 	ctx context.Context,
 	entry InterfaceEntry,
 ) (*InterfaceResult, error) {
-	if err := ValidateInterfaceName(entry.Name); err != nil {
+	if err := ValidateInterfaceEntry(entry); err != nil {
 		return nil, fmt.Errorf("interface create: %w", err)
 	}
 
@@ -146,8 +151,9 @@ func (d *Debian) Create(
 		}, nil
 	}
 
-	ifaceSection := netplan.SectionForInterface(d.execManager, entry.Name)
-	content := generateInterfaceYAML(entry, ifaceSection)
+	ifaceSection := sectionForEntry(d.execManager, entry)
+	members := ethernetMembers(d.execManager, entry)
+	content := generateInterfaceYAML(entry, ifaceSection, members)
 	metadata := map[string]string{
 		"interface": entry.Name,
 	}
@@ -158,6 +164,7 @@ func (d *Debian) Create(
 		d.fs,
 		d.stateKV,
 		d.execManager,
+		d.guard,
 		d.hostname,
 		path,
 		content,
@@ -185,7 +192,7 @@ func (d *Debian) Update(
 	ctx context.Context,
 	entry InterfaceEntry,
 ) (*InterfaceResult, error) {
-	if err := ValidateInterfaceName(entry.Name); err != nil {
+	if err := ValidateInterfaceEntry(entry); err != nil {
 		return nil, fmt.Errorf("interface update: %w", err)
 	}
 
@@ -199,8 +206,9 @@ func (d *Debian) Update(
 		)
 	}
 
-	ifaceSection := netplan.SectionForInterface(d.execManager, entry.Name)
-	content := generateInterfaceYAML(entry, ifaceSection)
+	ifaceSection := sectionForEntry(d.execManager, entry)
+	members := ethernetMembers(d.execManager, entry)
+	content := generateInterfaceYAML(entry, ifaceSection, members)
 	metadata := map[string]string{
 		"interface": entry.Name,
 	}
@@ -211,6 +219,7 @@ func (d *Debian) Update(
 		d.fs,
 		d.stateKV,
 		d.execManager,
+		d.guard,
 		d.hostname,
 		path,
 		content,
@@ -257,6 +266,7 @@ func (d *Debian) Delete(
 		d.fs,
 		d.stateKV,
 		d.execManager,
+		d.guard,
 		d.hostname,
 		path,
 	)
@@ -293,20 +303,90 @@ func ValidateInterfaceName(
 	return nil
 }
 
+// sectionForEntry returns the Netplan YAML section for an entry. VLAN,
+// bond and bridge entries map to their own sections; other entries use
+// the section of the existing interface.
+func sectionForEntry(
+	execManager exec.Manager,
+	entry InterfaceEntry,
+) string {
+	switch entryType(entry) {
+	case TypeVLAN:
+		return "vlans"
+	case TypeBond:
+		return "bonds"
+	case TypeBridge:
+		return "bridges"
+	default:
+		return netplan.SectionForInterface(execManager, entry.Name)
+	}
+}
+
+// ethernetMembers returns the bond or bridge members of an entry that
+// are not virtual interfaces themselves. netplan generate rejects a bond
+// or bridge whose members are not defined, so these are defined in the
+// same file; Netplan merges them with any definition another file
+// already has. Members that netplan status reports as a VLAN, bond or
+// bridge keep their own definition.
+func ethernetMembers(
+	execManager exec.Manager,
+	entry InterfaceEntry,
+) []string {
+	var members []string
+	switch {
+	case entry.Bond != nil:
+		members = entry.Bond.Members
+	case entry.Bridge != nil:
+		members = entry.Bridge.Members
+	}
+
+	if len(members) == 0 {
+		return nil
+	}
+
+	// Without status every member is treated as an ethernet.
+	status, _ := netplan.GetStatus(execManager)
+
+	result := make([]string, 0, len(members))
+	for _, member := range members {
+		if iface, ok := status[member]; ok && iface.Type != TypeEthernet {
+			continue
+		}
+
+		result = append(result, member)
+	}
+
+	return result
+}
+
 // generateInterfaceYAML builds a Netplan YAML configuration for the
 // given interface entry. Only non-zero fields are included. The
-// ifaceSection parameter specifies the YAML section (ethernets, wifis, etc.).
+// ifaceSection parameter specifies the YAML section (ethernets, vlans,
+// bonds, etc.); members are bond or bridge members written as empty
+// ethernets definitions ahead of it.
 func generateInterfaceYAML(
 	entry InterfaceEntry,
 	ifaceSection string,
+	members []string,
 ) []byte {
 	var b strings.Builder
 
 	fmt.Fprintf(&b, "network:\n")
 	fmt.Fprintf(&b, "  version: 2\n")
+
+	if len(members) > 0 {
+		fmt.Fprintf(&b, "  ethernets:\n")
+
+		for _, member := range members {
+			fmt.Fprintf(&b, "    %s: {}\n", member)
+		}
+	}
+
 	fmt.Fprintf(&b, "  %s:\n", ifaceSection)
 	fmt.Fprintf(&b, "    %s:\n", entry.Name)
 
+	writeLinkYAML(&b, entry)
+
 	if entry.DHCP4 != nil {
 		fmt.Fprintf(&b, "      dhcp4: %t\n", *entry.DHCP4)
 	}
@@ -345,3 +425,46 @@ func generateInterfaceYAML(
 
 	return []byte(b.String())
 }
+
+// writeLinkYAML writes the VLAN, bond or bridge settings of an entry.
+func writeLinkYAML(
+	b *strings.Builder,
+	entry InterfaceEntry,
+) {
+	switch {
+	case entry.VLAN != nil:
+		fmt.Fprintf(b, "      id: %d\n", entry.VLAN.ID)
+		fmt.Fprintf(b, "      link: %s\n", entry.VLAN.Link)
+	case entry.Bond != nil:
+		writeMembersYAML(b, entry.Bond.Members)
+		fmt.Fprintf(b, "      parameters:\n")
+		fmt.Fprintf(b, "        mode: %s\n", entry.Bond.Mode)
+
+		if entry.Bond.MIIMonitor > 0 {
+			fmt.Fprintf(b, "        mii-monitor-interval: %d\n", entry.Bond.MIIMonitor)
+		}
+	case entry.Bridge != nil:
+		writeMembersYAML(b, entry.Bridge.Members)
+
+		if entry.Bridge.STP != nil {
+			fmt.Fprintf(b, "      parameters:\n")
+			fmt.Fprintf(b, "        stp: %t\n", *entry.Bridge.STP)
+		}
+	}
+}
+
+// writeMembersYAML writes the interfaces list of a bond or bridge.
+func writeMembersYAML(
+	b *strings.Builder,
+	members []string,
+) {
+	if len(members) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, "      interfaces:\n")
+
+	for _, member := range members {
+		fmt.Fprintf(b, "        - %s\n", member)
+	}
+}
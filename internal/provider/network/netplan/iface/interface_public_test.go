@@ -110,6 +110,22 @@ const netplanStatusEmpty = `{
   }
 }`
 
+// netplanStatusVirtualIfaces is a netplan status with a VLAN, a bond
+// and a bridge.
+const netplanStatusVirtualIfaces = `{
+  "netplan-global-state": {"online": true},
+  "vlan100": {"index": 4, "type": "vlan", "addresses": [], "routes": []},
+  "bond0": {"index": 5, "type": "bond", "interfaces": ["eth1", "eth2"], "addresses": [], "routes": []},
+  "br0": {"index": 6, "type": "bridge", "interfaces": ["eth3"], "addresses": [], "routes": []}
+}`
+
+// procVLANConfig is a /proc/net/vlan/config fixture.
+const procVLANConfig = `VLAN Dev name    | VLAN ID
+Name-Type: VLAN_NAME_TYPE_RAW_PLUS_VID_NO_PAD
+vlan100        | 100  | eth0
+vlan200        | 200  | eth1
+`
+
 // netplanStatusSameIndexIfaces has two non-loopback interfaces that share
 // the same index, exercising the sort fallback to alphabetical name order.
 // Interfaces are listed in JSON with "zeth" before "aeth" so the sort has
@@ -168,6 +184,7 @@ func (suite *InterfacePublicTestSuite) SetupTest() {
 		suite.mockStateKV,
 		suite.mockExec,
 		testHostname,
+		nil,
 	)
 }
 
@@ -343,6 +360,100 @@ func (suite *InterfacePublicTestSuite) TestGet() {
 				suite.Contains(err.Error(), "interface get:")
 			},
 		},
+		{
+			name:        "when interface is a vlan",
+			interfaceNm: "vlan100",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("netplan", []string{"status", "--format", "json"}).
+					Return(netplanStatusVirtualIfaces, nil)
+				_ = suite.memFs.MkdirAll("/proc/net/vlan", 0o755)
+				_ = suite.memFs.WriteFile(
+					"/proc/net/vlan/config",
+					[]byte(procVLANConfig),
+					0o644,
+				)
+			},
+			validateFunc: func(result *iface.InterfaceEntry, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("vlan", result.Type)
+				suite.Equal(&iface.VLANConfig{ID: 100, Link: "eth0"}, result.VLAN)
+				suite.Nil(result.Bond)
+				suite.Nil(result.Bridge)
+			},
+		},
+		{
+			name:        "when interface is a bond",
+			interfaceNm: "bond0",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("netplan", []string{"status", "--format", "json"}).
+					Return(netplanStatusVirtualIfaces, nil)
+				_ = suite.memFs.MkdirAll("/sys/class/net/bond0/bonding", 0o755)
+				_ = suite.memFs.WriteFile(
+					"/sys/class/net/bond0/bonding/mode",
+					[]byte("802.3ad 4\n"),
+					0o644,
+				)
+				_ = suite.memFs.WriteFile(
+					"/sys/class/net/bond0/bonding/miimon",
+					[]byte("100\n"),
+					0o644,
+				)
+				_ = suite.memFs.WriteFile(
+					"/sys/class/net/bond0/bonding/slaves",
+					[]byte("eth1 eth2\n"),
+					0o644,
+				)
+			},
+			validateFunc: func(result *iface.InterfaceEntry, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("bond", result.Type)
+				suite.Equal(&iface.BondConfig{
+					Mode:       "802.3ad",
+					Members:    []string{"eth1", "eth2"},
+					MIIMonitor: 100,
+				}, result.Bond)
+			},
+		},
+		{
+			name:        "when interface is a bridge",
+			interfaceNm: "br0",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("netplan", []string{"status", "--format", "json"}).
+					Return(netplanStatusVirtualIfaces, nil)
+				_ = suite.memFs.MkdirAll("/sys/class/net/br0/bridge", 0o755)
+				_ = suite.memFs.MkdirAll("/sys/class/net/br0/brif/eth3", 0o755)
+				_ = suite.memFs.WriteFile(
+					"/sys/class/net/br0/bridge/stp_state",
+					[]byte("1\n"),
+					0o644,
+				)
+			},
+			validateFunc: func(result *iface.InterfaceEntry, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("bridge", result.Type)
+				suite.Require().NotNil(result.Bridge)
+				suite.Equal([]string{"eth3"}, result.Bridge.Members)
+				suite.Require().NotNil(result.Bridge.STP)
+				suite.True(*result.Bridge.STP)
+			},
+		},
+		{
+			name:        "when virtual interface details are unreadable",
+			interfaceNm: "bond0",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("netplan", []string{"status", "--format", "json"}).
+					Return(netplanStatusVirtualIfaces, nil)
+			},
+			validateFunc: func(result *iface.InterfaceEntry, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("bond", result.Type)
+				suite.Nil(result.Bond)
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -493,6 +604,135 @@ func (suite *InterfacePublicTestSuite) TestCreate() {
 				suite.Contains(err.Error(), "interface create:")
 			},
 		},
+		{
+			name: "when vlan deploys to the vlans section",
+			entry: iface.InterfaceEntry{
+				Name:      "vlan100",
+				Addresses: []string{"10.100.0.5/24"},
+				VLAN:      &iface.VLANConfig{ID: 100, Link: "eth0"},
+			},
+			setup: func() {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"generate"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"apply"}).
+					Return("", nil)
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(1), nil)
+			},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+
+				data, readErr := suite.memFs.ReadFile(
+					"/etc/netplan/osapi-vlan100.yaml",
+				)
+				suite.Require().NoError(readErr)
+				suite.Contains(string(data), "  vlans:\n    vlan100:\n")
+				suite.Contains(string(data), "      id: 100\n      link: eth0\n")
+			},
+		},
+		{
+			name: "when bond defines its ethernet members",
+			entry: iface.InterfaceEntry{
+				Name:  "bond0",
+				DHCP4: &dhcp4True,
+				Bond: &iface.BondConfig{
+					Mode:    "802.3ad",
+					Members: []string{"eth1", "eth2"},
+				},
+			},
+			setup: func() {
+				// eth1 is known to netplan; eth2 is not defined anywhere.
+				suite.mockExec.EXPECT().
+					RunCmd("netplan", []string{"status", "--format", "json"}).
+					Return(`{"eth1": {"type": "ethernet"}}`, nil)
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"generate"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"apply"}).
+					Return("", nil)
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(1), nil)
+			},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+
+				data, readErr := suite.memFs.ReadFile(
+					"/etc/netplan/osapi-bond0.yaml",
+				)
+				suite.Require().NoError(readErr)
+				suite.Contains(
+					string(data),
+					"  ethernets:\n    eth1: {}\n    eth2: {}\n  bonds:\n    bond0:\n",
+				)
+				suite.Contains(string(data), "      interfaces:\n        - eth1\n        - eth2\n")
+			},
+		},
+		{
+			name: "when bridge defines ethernet members but not a bond member",
+			entry: iface.InterfaceEntry{
+				Name:  "br0",
+				DHCP4: &dhcp4True,
+				Bridge: &iface.BridgeConfig{
+					Members: []string{"bond0", "eth3"},
+				},
+			},
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("netplan", []string{"status", "--format", "json"}).
+					Return(`{"bond0": {"type": "bond"}, "eth3": {"type": "ethernet"}}`, nil)
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"generate"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"apply"}).
+					Return("", nil)
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(1), nil)
+			},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+
+				data, readErr := suite.memFs.ReadFile(
+					"/etc/netplan/osapi-br0.yaml",
+				)
+				suite.Require().NoError(readErr)
+				suite.Contains(string(data), "  ethernets:\n    eth3: {}\n  bridges:\n    br0:\n")
+				suite.NotContains(string(data), "bond0: {}")
+				suite.Contains(string(data), "      interfaces:\n        - bond0\n        - eth3\n")
+			},
+		},
+		{
+			name: "when more than one variant is set",
+			entry: iface.InterfaceEntry{
+				Name:   "br0",
+				VLAN:   &iface.VLANConfig{ID: 100, Link: "eth0"},
+				Bridge: &iface.BridgeConfig{},
+			},
+			setup: func() {},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "only one of vlan, bond or bridge")
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -736,10 +976,13 @@ func (suite *InterfacePublicTestSuite) TestGenerateInterfaceYAML() {
 	dhcp4False := false
 	dhcp6True := true
 	wolTrue := true
+	stpTrue := true
 
 	tests := []struct {
 		name         string
 		entry        iface.InterfaceEntry
+		section      string
+		members      []string
 		validateFunc func(string)
 	}{
 		{
@@ -818,11 +1061,138 @@ func (suite *InterfacePublicTestSuite) TestGenerateInterfaceYAML() {
 				suite.NotContains(result, "gateway4:")
 			},
 		},
+		{
+			name: "when vlan set",
+			entry: iface.InterfaceEntry{
+				Name:  "vlan100",
+				DHCP4: &dhcp4True,
+				VLAN:  &iface.VLANConfig{ID: 100, Link: "eth0"},
+			},
+			validateFunc: func(result string) {
+				suite.Contains(result, "    vlan100:\n      id: 100\n      link: eth0\n")
+				suite.Contains(result, "      dhcp4: true")
+				suite.NotContains(result, "interfaces:")
+			},
+		},
+		{
+			name: "when bond set",
+			entry: iface.InterfaceEntry{
+				Name: "bond0",
+				Bond: &iface.BondConfig{
+					Mode:       "802.3ad",
+					Members:    []string{"eth1", "eth2"},
+					MIIMonitor: 100,
+				},
+			},
+			validateFunc: func(result string) {
+				suite.Contains(result, "      interfaces:\n        - eth1\n        - eth2\n")
+				suite.Contains(result, "      parameters:\n        mode: 802.3ad\n")
+				suite.Contains(result, "        mii-monitor-interval: 100\n")
+			},
+		},
+		{
+			name: "when bond set without miimon",
+			entry: iface.InterfaceEntry{
+				Name: "bond0",
+				Bond: &iface.BondConfig{
+					Mode:    "active-backup",
+					Members: []string{"eth1"},
+				},
+			},
+			validateFunc: func(result string) {
+				suite.Contains(result, "        mode: active-backup\n")
+				suite.NotContains(result, "mii-monitor-interval")
+			},
+		},
+		{
+			name: "when bridge set",
+			entry: iface.InterfaceEntry{
+				Name: "br0",
+				Bridge: &iface.BridgeConfig{
+					Members: []string{"eth3"},
+					STP:     &stpTrue,
+				},
+			},
+			validateFunc: func(result string) {
+				suite.Contains(result, "      interfaces:\n        - eth3\n")
+				suite.Contains(result, "      parameters:\n        stp: true\n")
+			},
+		},
+		{
+			name: "when bond members are defined in the same file",
+			entry: iface.InterfaceEntry{
+				Name: "bond0",
+				Bond: &iface.BondConfig{
+					Mode:    "802.3ad",
+					Members: []string{"eth1", "eth2"},
+				},
+			},
+			section: "bonds",
+			members: []string{"eth1", "eth2"},
+			validateFunc: func(result string) {
+				suite.Equal(
+					"network:\n"+
+						"  version: 2\n"+
+						"  ethernets:\n"+
+						"    eth1: {}\n"+
+						"    eth2: {}\n"+
+						"  bonds:\n"+
+						"    bond0:\n"+
+						"      interfaces:\n"+
+						"        - eth1\n"+
+						"        - eth2\n"+
+						"      parameters:\n"+
+						"        mode: 802.3ad\n",
+					result,
+				)
+			},
+		},
+		{
+			name: "when bridge members are defined in the same file",
+			entry: iface.InterfaceEntry{
+				Name: "br0",
+				Bridge: &iface.BridgeConfig{
+					Members: []string{"eth3"},
+				},
+			},
+			section: "bridges",
+			members: []string{"eth3"},
+			validateFunc: func(result string) {
+				suite.Equal(
+					"network:\n"+
+						"  version: 2\n"+
+						"  ethernets:\n"+
+						"    eth3: {}\n"+
+						"  bridges:\n"+
+						"    br0:\n"+
+						"      interfaces:\n"+
+						"        - eth3\n",
+					result,
+				)
+			},
+		},
+		{
+			name: "when bridge set without members or stp",
+			entry: iface.InterfaceEntry{
+				Name:   "br0",
+				Bridge: &iface.BridgeConfig{},
+			},
+			validateFunc: func(result string) {
+				suite.Contains(result, "    br0:\n")
+				suite.NotContains(result, "interfaces:")
+				suite.NotContains(result, "parameters:")
+			},
+		},
 	}
 
 	for _, tc := range tests {
 		suite.Run(tc.name, func() {
-			result := iface.GenerateInterfaceYAML(tc.entry, "ethernets")
+			section := tc.section
+			if section == "" {
+				section = "ethernets"
+			}
+
+			result := iface.GenerateInterfaceYAML(tc.entry, section, tc.members)
 
 			tc.validateFunc(string(result))
 		})
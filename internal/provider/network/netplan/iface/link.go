@@ -0,0 +1,228 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package iface
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/avfs/avfs"
+)
+
+const (
+	// sysClassNet exposes bond and bridge settings of each link.
+	sysClassNet = "/sys/class/net"
+	// procVLANConfig lists every VLAN with its ID and parent link.
+	procVLANConfig = "/proc/net/vlan/config"
+)
+
+// entryType returns the virtual interface type selected by the entry's
+// VLAN, Bond or Bridge variant, or "" for a plain interface.
+func entryType(
+	entry InterfaceEntry,
+) string {
+	switch {
+	case entry.VLAN != nil:
+		return TypeVLAN
+	case entry.Bond != nil:
+		return TypeBond
+	case entry.Bridge != nil:
+		return TypeBridge
+	default:
+		return ""
+	}
+}
+
+// ValidateInterfaceEntry checks the interface name and, for VLAN, bond
+// and bridge entries, the variant settings. At most one variant may be
+// set.
+func ValidateInterfaceEntry(
+	entry InterfaceEntry,
+) error {
+	if err := ValidateInterfaceName(entry.Name); err != nil {
+		return err
+	}
+
+	variants := 0
+	for _, set := range []bool{entry.VLAN != nil, entry.Bond != nil, entry.Bridge != nil} {
+		if set {
+			variants++
+		}
+	}
+	if variants > 1 {
+		return fmt.Errorf("only one of vlan, bond or bridge may be set")
+	}
+
+	if variants == 1 && entry.WakeOnLAN != nil {
+		return fmt.Errorf("wakeonlan is only supported on ethernet interfaces")
+	}
+
+	switch {
+	case entry.VLAN != nil:
+		if entry.VLAN.ID < 1 || entry.VLAN.ID > 4094 {
+			return fmt.Errorf("vlan id %d out of range 1-4094", entry.VLAN.ID)
+		}
+		if err := ValidateInterfaceName(entry.VLAN.Link); err != nil {
+			return fmt.Errorf("vlan link: %w", err)
+		}
+	case entry.Bond != nil:
+		if !slices.Contains(BondModes, entry.Bond.Mode) {
+			return fmt.Errorf("bond mode %q is not supported", entry.Bond.Mode)
+		}
+		if len(entry.Bond.Members) == 0 {
+			return fmt.Errorf("bond members must not be empty")
+		}
+		if entry.Bond.MIIMonitor < 0 {
+			return fmt.Errorf("bond miimon must not be negative")
+		}
+		if err := validateMembers(entry.Name, entry.Bond.Members); err != nil {
+			return fmt.Errorf("bond members: %w", err)
+		}
+	case entry.Bridge != nil:
+		if err := validateMembers(entry.Name, entry.Bridge.Members); err != nil {
+			return fmt.Errorf("bridge members: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// validateMembers checks bond and bridge member names.
+func validateMembers(
+	name string,
+	members []string,
+) error {
+	for _, member := range members {
+		if err := ValidateInterfaceName(member); err != nil {
+			return err
+		}
+		if member == name {
+			return fmt.Errorf("%q cannot be a member of itself", name)
+		}
+	}
+
+	return nil
+}
+
+// readLinkDetails fills in the VLAN, bond or bridge settings of a
+// listed interface from /proc and /sys, based on entry.Type. Settings
+// that cannot be read are left unset.
+func readLinkDetails(
+	fs avfs.VFS,
+	entry *InterfaceEntry,
+) {
+	switch entry.Type {
+	case TypeVLAN:
+		entry.VLAN = readVLAN(fs, entry.Name)
+	case TypeBond:
+		entry.Bond = readBond(fs, entry.Name)
+	case TypeBridge:
+		entry.Bridge = readBridge(fs, entry.Name)
+	}
+}
+
+// readVLAN parses /proc/net/vlan/config, whose rows have the form
+// "name | id | link" after a two-line header.
+func readVLAN(
+	fs avfs.VFS,
+	name string,
+) *VLANConfig {
+	data, err := fs.ReadFile(procVLANConfig)
+	if err != nil {
+		return nil
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Split(line, "|")
+		if len(fields) != 3 || strings.TrimSpace(fields[0]) != name {
+			continue
+		}
+
+		id, convErr := strconv.Atoi(strings.TrimSpace(fields[1]))
+		if convErr != nil {
+			return nil
+		}
+
+		return &VLANConfig{
+			ID:   id,
+			Link: strings.TrimSpace(fields[2]),
+		}
+	}
+
+	return nil
+}
+
+// readBond reads the bonding mode, MII monitor interval and members of
+// a bond from /sys/class/net/<name>/bonding.
+func readBond(
+	fs avfs.VFS,
+	name string,
+) *BondConfig {
+	dir := sysClassNet + "/" + name + "/bonding/"
+
+	mode, err := fs.ReadFile(dir + "mode")
+	if err != nil {
+		return nil
+	}
+
+	// The mode file reads as "<name> <number>", e.g. "802.3ad 4".
+	bond := &BondConfig{}
+	if fields := strings.Fields(string(mode)); len(fields) > 0 {
+		bond.Mode = fields[0]
+	}
+
+	if miimon, readErr := fs.ReadFile(dir + "miimon"); readErr == nil {
+		bond.MIIMonitor, _ = strconv.Atoi(strings.TrimSpace(string(miimon)))
+	}
+
+	if slaves, readErr := fs.ReadFile(dir + "slaves"); readErr == nil {
+		bond.Members = strings.Fields(string(slaves))
+	}
+
+	return bond
+}
+
+// readBridge reads the STP state and attached ports of a bridge from
+// /sys/class/net/<name>/bridge and /sys/class/net/<name>/brif.
+func readBridge(
+	fs avfs.VFS,
+	name string,
+) *BridgeConfig {
+	dir := sysClassNet + "/" + name
+
+	state, err := fs.ReadFile(dir + "/bridge/stp_state")
+	if err != nil {
+		return nil
+	}
+
+	stp := strings.TrimSpace(string(state)) != "0"
+	bridge := &BridgeConfig{STP: &stp}
+
+	if ports, readErr := fs.ReadDir(dir + "/brif"); readErr == nil {
+		for _, port := range ports {
+			bridge.Members = append(bridge.Members, port.Name())
+		}
+	}
+
+	return bridge
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package iface_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osapi-io/osapi/internal/provider/network/netplan/iface"
+)
+
+type LinkPublicTestSuite struct {
+	suite.Suite
+}
+
+func (suite *LinkPublicTestSuite) TestValidateInterfaceEntry() {
+	wolTrue := true
+
+	tests := []struct {
+		name         string
+		entry        iface.InterfaceEntry
+		validateFunc func(error)
+	}{
+		{
+			name:  "when plain interface is valid",
+			entry: iface.InterfaceEntry{Name: "eth0", WakeOnLAN: &wolTrue},
+			validateFunc: func(err error) {
+				suite.NoError(err)
+			},
+		},
+		{
+			name: "when vlan is valid",
+			entry: iface.InterfaceEntry{
+				Name: "vlan100",
+				VLAN: &iface.VLANConfig{ID: 100, Link: "eth0"},
+			},
+			validateFunc: func(err error) {
+				suite.NoError(err)
+			},
+		},
+		{
+			name: "when bond is valid",
+			entry: iface.InterfaceEntry{
+				Name: "bond0",
+				Bond: &iface.BondConfig{
+					Mode:       "802.3ad",
+					Members:    []string{"eth1", "eth2"},
+					MIIMonitor: 100,
+				},
+			},
+			validateFunc: func(err error) {
+				suite.NoError(err)
+			},
+		},
+		{
+			name: "when bridge without members is valid",
+			entry: iface.InterfaceEntry{
+				Name:   "br0",
+				Bridge: &iface.BridgeConfig{},
+			},
+			validateFunc: func(err error) {
+				suite.NoError(err)
+			},
+		},
+		{
+			name:  "when name is invalid",
+			entry: iface.InterfaceEntry{Name: "eth 0"},
+			validateFunc: func(err error) {
+				suite.ErrorContains(err, "invalid characters")
+			},
+		},
+		{
+			name: "when more than one variant is set",
+			entry: iface.InterfaceEntry{
+				Name:   "bond0",
+				Bond:   &iface.BondConfig{Mode: "802.3ad", Members: []string{"eth1"}},
+				Bridge: &iface.BridgeConfig{},
+			},
+			validateFunc: func(err error) {
+				suite.ErrorContains(err, "only one of vlan, bond or bridge")
+			},
+		},
+		{
+			name: "when wakeonlan is set on a virtual interface",
+			entry: iface.InterfaceEntry{
+				Name:      "br0",
+				WakeOnLAN: &wolTrue,
+				Bridge:    &iface.BridgeConfig{},
+			},
+			validateFunc: func(err error) {
+				suite.ErrorContains(err, "only supported on ethernet")
+			},
+		},
+		{
+			name: "when vlan id is out of range",
+			entry: iface.InterfaceEntry{
+				Name: "vlan0",
+				VLAN: &iface.VLANConfig{ID: 4095, Link: "eth0"},
+			},
+			validateFunc: func(err error) {
+				suite.ErrorContains(err, "out of range 1-4094")
+			},
+		},
+		{
+			name: "when vlan link is missing",
+			entry: iface.InterfaceEntry{
+				Name: "vlan100",
+				VLAN: &iface.VLANConfig{ID: 100},
+			},
+			validateFunc: func(err error) {
+				suite.ErrorContains(err, "vlan link: name must not be empty")
+			},
+		},
+		{
+			name: "when bond mode is unsupported",
+			entry: iface.InterfaceEntry{
+				Name: "bond0",
+				Bond: &iface.BondConfig{Mode: "lacp", Members: []string{"eth1"}},
+			},
+			validateFunc: func(err error) {
+				suite.ErrorContains(err, `bond mode "lacp" is not supported`)
+			},
+		},
+		{
+			name: "when bond has no members",
+			entry: iface.InterfaceEntry{
+				Name: "bond0",
+				Bond: &iface.BondConfig{Mode: "active-backup"},
+			},
+			validateFunc: func(err error) {
+				suite.ErrorContains(err, "bond members must not be empty")
+			},
+		},
+		{
+			name: "when bond miimon is negative",
+			entry: iface.InterfaceEntry{
+				Name: "bond0",
+				Bond: &iface.BondConfig{
+					Mode:       "active-backup",
+					Members:    []string{"eth1"},
+					MIIMonitor: -1,
+				},
+			},
+			validateFunc: func(err error) {
+				suite.ErrorContains(err, "miimon must not be negative")
+			},
+		},
+		{
+			name: "when bond member name is invalid",
+			entry: iface.InterfaceEntry{
+				Name: "bond0",
+				Bond: &iface.BondConfig{Mode: "active-backup", Members: []string{"eth/1"}},
+			},
+			validateFunc: func(err error) {
+				suite.ErrorContains(err, "bond members:")
+			},
+		},
+		{
+			name: "when bridge is a member of itself",
+			entry: iface.InterfaceEntry{
+				Name:   "br0",
+				Bridge: &iface.BridgeConfig{Members: []string{"eth3", "br0"}},
+			},
+			validateFunc: func(err error) {
+				suite.ErrorContains(err, `"br0" cannot be a member of itself`)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			err := iface.ValidateInterfaceEntry(tc.entry)
+
+			tc.validateFunc(err)
+		})
+	}
+}
+
+func TestLinkPublicTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(LinkPublicTestSuite))
+}
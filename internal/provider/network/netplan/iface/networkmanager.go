@@ -0,0 +1,575 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package iface
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/avfs/avfs"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/osapi-io/osapi/internal/exec"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/network/networkmanager"
+)
+
+// Compile-time checks.
+var (
+	_ Provider             = (*NetworkManager)(nil)
+	_ provider.FactsSetter = (*NetworkManager)(nil)
+)
+
+// wakeOnLANMagic is the NetworkManager wake-on-lan flag for magic packets.
+const wakeOnLANMagic = 64
+
+// NetworkManager implements the Provider interface for hosts whose
+// network is managed by NetworkManager. It writes keyfile connection
+// profiles to /etc/NetworkManager/system-connections/ with an osapi-
+// prefix and tracks state in the file-state KV for idempotency.
+type NetworkManager struct {
+	provider.FactsAware
+	logger      *slog.Logger
+	fs          avfs.VFS
+	stateKV     jetstream.KeyValue
+	execManager exec.Manager
+	hostname    string
+}
+
+// NewNetworkManagerProvider factory to create a new NetworkManager instance.
+func NewNetworkManagerProvider(
+	logger *slog.Logger,
+	fs avfs.VFS,
+	stateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+) *NetworkManager {
+	return &NetworkManager{
+		logger:      logger.With(slog.String("subsystem", "provider.networkmanager")),
+		fs:          fs,
+		stateKV:     stateKV,
+		execManager: execManager,
+		hostname:    hostname,
+	}
+}
+
+// List returns all network interfaces known to NetworkManager, in the
+// order nmcli reports them.
+func (n *NetworkManager) List(
+	_ context.Context,
+) ([]InterfaceEntry, error) {
+	devices, err := networkmanager.GetDevices(n.execManager)
+	if err != nil {
+		return nil, fmt.Errorf("interface list: %w", err)
+	}
+
+	var result []InterfaceEntry
+
+	for _, d := range devices {
+		if d.Name == "lo" || d.Type == "loopback" {
+			continue
+		}
+
+		entry := entryFromDevice(d)
+		readLinkDetails(n.fs, &entry)
+
+		result = append(result, entry)
+	}
+
+	return result, nil
+}
+
+// Get returns a single interface by name.
+func (n *NetworkManager) Get(
+	_ context.Context,
+	name string,
+) (*InterfaceEntry, error) {
+	if name == "" {
+		return nil, fmt.Errorf("interface get: name must not be empty")
+	}
+
+	d, err := networkmanager.GetDevice(n.execManager, name)
+	if err != nil {
+		return nil, fmt.Errorf("interface %q: not found", name)
+	}
+
+	entry := entryFromDevice(*d)
+	readLinkDetails(n.fs, &entry)
+
+	return &entry, nil
+}
+
+// Create deploys a new keyfile connection profile. Fails if a managed
+// profile already exists for the interface name.
+func (n *NetworkManager) Create(
+	ctx context.Context,
+	entry InterfaceEntry,
+) (*InterfaceResult, error) {
+	if err := ValidateInterfaceEntry(entry); err != nil {
+		return nil, fmt.Errorf("interface create: %w", err)
+	}
+
+	path := keyfilePath(entry.Name)
+
+	// Already managed — nothing to do.
+	if _, statErr := n.fs.Stat(path); statErr == nil {
+		return &InterfaceResult{
+			Name:    entry.Name,
+			Changed: false,
+		}, nil
+	}
+
+	changed, err := n.apply(ctx, entry, path)
+	if err != nil {
+		return nil, fmt.Errorf("interface create: %w", err)
+	}
+
+	n.logger.Info(
+		"interface created",
+		slog.String("name", entry.Name),
+		slog.Bool("changed", changed),
+	)
+
+	return &InterfaceResult{
+		Name:    entry.Name,
+		Changed: changed,
+	}, nil
+}
+
+// Update redeploys an existing keyfile connection profile. Fails if no
+// managed profile exists for the interface name.
+func (n *NetworkManager) Update(
+	ctx context.Context,
+	entry InterfaceEntry,
+) (*InterfaceResult, error) {
+	if err := ValidateInterfaceEntry(entry); err != nil {
+		return nil, fmt.Errorf("interface update: %w", err)
+	}
+
+	path := keyfilePath(entry.Name)
+
+	// Fail if the managed file does not exist on disk.
+	if _, statErr := n.fs.Stat(path); statErr != nil {
+		return nil, fmt.Errorf(
+			"interface update: %q not managed",
+			entry.Name,
+		)
+	}
+
+	changed, err := n.apply(ctx, entry, path)
+	if err != nil {
+		return nil, fmt.Errorf("interface update: %w", err)
+	}
+
+	n.logger.Info(
+		"interface updated",
+		slog.String("name", entry.Name),
+		slog.Bool("changed", changed),
+	)
+
+	return &InterfaceResult{
+		Name:    entry.Name,
+		Changed: changed,
+	}, nil
+}
+
+// Delete removes a managed keyfile connection profile. If no managed
+// profile exists, returns Changed: false (idempotent).
+func (n *NetworkManager) Delete(
+	ctx context.Context,
+	name string,
+) (*InterfaceResult, error) {
+	if name == "" {
+		return nil, fmt.Errorf("interface delete: name must not be empty")
+	}
+
+	path := keyfilePath(name)
+
+	if _, err := n.fs.Stat(path); err != nil {
+		return &InterfaceResult{
+			Name:    name,
+			Changed: false,
+		}, nil
+	}
+
+	// Remove bond and bridge ports before the profile they belong to.
+	if err := n.removePorts(ctx, name, n.managedMembers(name), nil); err != nil {
+		return nil, fmt.Errorf("interface delete: %w", err)
+	}
+
+	changed, err := networkmanager.RemoveKeyfile(
+		ctx,
+		n.logger,
+		n.fs,
+		n.stateKV,
+		n.execManager,
+		n.hostname,
+		path,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("interface delete: %w", err)
+	}
+
+	if changed {
+		n.logger.Info(
+			"interface deleted",
+			slog.String("name", name),
+		)
+	}
+
+	return &InterfaceResult{
+		Name:    name,
+		Changed: changed,
+	}, nil
+}
+
+// apply renders the keyfile for entry and deploys it via the shared
+// NetworkManager helper. Bond and bridge members get a port profile
+// each, deployed after the controller profile; ports of members that
+// were dropped from the entry are removed.
+func (n *NetworkManager) apply(
+	ctx context.Context,
+	entry InterfaceEntry,
+	path string,
+) (bool, error) {
+	connType := entryType(entry)
+	if connType == "" {
+		connType = networkmanager.ConnectionTypeForInterface(n.execManager, entry.Name)
+	}
+
+	members := entryMembers(entry)
+	previous := n.managedMembers(entry.Name)
+
+	content := generateInterfaceKeyfile(entry, connType)
+	metadata := map[string]string{
+		"interface": entry.Name,
+	}
+
+	changed, err := networkmanager.ApplyKeyfile(
+		ctx,
+		n.logger,
+		n.fs,
+		n.stateKV,
+		n.execManager,
+		n.hostname,
+		path,
+		content,
+		metadata,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	for _, member := range members {
+		portType := networkmanager.ConnectionTypeForInterface(n.execManager, member)
+		portChanged, portErr := networkmanager.ApplyKeyfile(
+			ctx,
+			n.logger,
+			n.fs,
+			n.stateKV,
+			n.execManager,
+			n.hostname,
+			portKeyfilePath(entry.Name, member),
+			generatePortKeyfile(entry.Name, connType, member, portType),
+			map[string]string{
+				"interface":  member,
+				"controller": entry.Name,
+			},
+		)
+		if portErr != nil {
+			return false, fmt.Errorf("port %s: %w", member, portErr)
+		}
+		changed = changed || portChanged
+	}
+
+	for _, member := range previous {
+		if !slices.Contains(members, member) {
+			changed = true
+		}
+	}
+
+	if err := n.removePorts(ctx, entry.Name, previous, members); err != nil {
+		return false, err
+	}
+
+	return changed, nil
+}
+
+// managedMembers returns the members that have an osapi port profile
+// for the given bond or bridge.
+func (n *NetworkManager) managedMembers(
+	controller string,
+) []string {
+	entries, err := n.fs.ReadDir(networkmanager.ConnectionsDir)
+	if err != nil {
+		return nil
+	}
+
+	prefix := interfacePrefix + controller + "-port-"
+
+	var members []string
+	for _, e := range entries {
+		member, ok := strings.CutPrefix(e.Name(), prefix)
+		if !ok {
+			continue
+		}
+
+		if member, ok = strings.CutSuffix(member, networkmanager.KeyfileExt); ok {
+			members = append(members, member)
+		}
+	}
+
+	return members
+}
+
+// removePorts removes the port profiles of members that are not in keep.
+func (n *NetworkManager) removePorts(
+	ctx context.Context,
+	controller string,
+	members []string,
+	keep []string,
+) error {
+	for _, member := range members {
+		if slices.Contains(keep, member) {
+			continue
+		}
+
+		if _, err := networkmanager.RemoveKeyfile(
+			ctx,
+			n.logger,
+			n.fs,
+			n.stateKV,
+			n.execManager,
+			n.hostname,
+			portKeyfilePath(controller, member),
+		); err != nil {
+			return fmt.Errorf("port %s: %w", member, err)
+		}
+	}
+
+	return nil
+}
+
+// keyfilePath returns the keyfile path for an interface's osapi profile.
+func keyfilePath(
+	name string,
+) string {
+	return networkmanager.KeyfilePath(interfacePrefix + name)
+}
+
+// portKeyfilePath returns the keyfile path for the port profile that
+// attaches member to a bond or bridge.
+func portKeyfilePath(
+	controller string,
+	member string,
+) string {
+	return networkmanager.KeyfilePath(interfacePrefix + controller + "-port-" + member)
+}
+
+// entryMembers returns the bond or bridge members of an entry.
+func entryMembers(
+	entry InterfaceEntry,
+) []string {
+	switch {
+	case entry.Bond != nil:
+		return entry.Bond.Members
+	case entry.Bridge != nil:
+		return entry.Bridge.Members
+	default:
+		return nil
+	}
+}
+
+// entryFromDevice maps a NetworkManager device to an InterfaceEntry.
+func entryFromDevice(
+	d networkmanager.Device,
+) InterfaceEntry {
+	dhcp := d.IsDHCP()
+
+	return InterfaceEntry{
+		Name:    d.Name,
+		Type:    d.Type,
+		IPv4:    d.IPv4(),
+		IPv6:    d.IPv6(),
+		MAC:     d.MACAddress,
+		Family:  d.AddressFamily(),
+		Primary: d.HasDefaultRoute(),
+		DHCP4:   &dhcp,
+	}
+}
+
+// generateInterfaceKeyfile builds a NetworkManager keyfile connection
+// profile for the given interface entry. Only non-zero fields are
+// included; unset IP settings are left to NetworkManager's defaults. The
+// connType parameter specifies the connection type (ethernet, wifi, vlan,
+// etc.). Ethernet and wifi settings go in the section named after the
+// type; VLAN, bond and bridge profiles carry MTU and MAC in an [ethernet]
+// section and their own settings in the section named after the type.
+func generateInterfaceKeyfile(
+	entry InterfaceEntry,
+	connType string,
+) []byte {
+	var b strings.Builder
+
+	id := interfacePrefix + entry.Name
+
+	fmt.Fprintf(&b, "[connection]\n")
+	fmt.Fprintf(&b, "id=%s\n", id)
+	fmt.Fprintf(&b, "type=%s\n", connType)
+	fmt.Fprintf(&b, "interface-name=%s\n", entry.Name)
+	// Prefer the osapi profile over distribution defaults at boot.
+	fmt.Fprintf(&b, "autoconnect-priority=100\n")
+
+	writeLinkKeyfile(&b, entry)
+
+	linkSection := connType
+	if entryType(entry) != "" {
+		linkSection = "ethernet"
+	}
+
+	var link strings.Builder
+
+	if entry.MTU > 0 {
+		fmt.Fprintf(&link, "mtu=%d\n", entry.MTU)
+	}
+
+	if entry.MACAddress != "" {
+		fmt.Fprintf(&link, "cloned-mac-address=%s\n", entry.MACAddress)
+	}
+
+	// Wake-on-LAN is an ethernet property; other link types use
+	// different settings that the API does not model.
+	if entry.WakeOnLAN != nil && connType == "ethernet" {
+		wol := 0
+		if *entry.WakeOnLAN {
+			wol = wakeOnLANMagic
+		}
+		fmt.Fprintf(&link, "wake-on-lan=%d\n", wol)
+	}
+
+	if link.Len() > 0 {
+		fmt.Fprintf(&b, "\n[%s]\n%s", linkSection, link.String())
+	}
+
+	var v4, v6 []string
+	for _, addr := range entry.Addresses {
+		if ip, _, err := net.ParseCIDR(addr); err == nil && ip.To4() == nil {
+			v6 = append(v6, addr)
+		} else {
+			v4 = append(v4, addr)
+		}
+	}
+
+	writeIPSection(&b, "ipv4", entry.DHCP4, v4, entry.Gateway4, "disabled")
+	writeIPSection(&b, "ipv6", entry.DHCP6, v6, entry.Gateway6, "link-local")
+
+	return []byte(b.String())
+}
+
+// writeIPSection writes an [ipv4] or [ipv6] keyfile section. The method
+// is "auto" when DHCP is enabled, "manual" for static addresses, and
+// offMethod when DHCP is explicitly disabled without addresses. The
+// section is omitted when nothing is configured for the family.
+func writeIPSection(
+	b *strings.Builder,
+	section string,
+	dhcp *bool,
+	addresses []string,
+	gateway string,
+	offMethod string,
+) {
+	var method string
+
+	switch {
+	case dhcp != nil && *dhcp:
+		method = "auto"
+	case len(addresses) > 0:
+		method = "manual"
+	case dhcp != nil:
+		method = offMethod
+	default:
+		return
+	}
+
+	fmt.Fprintf(b, "\n[%s]\n", section)
+	fmt.Fprintf(b, "method=%s\n", method)
+
+	for i, addr := range addresses {
+		fmt.Fprintf(b, "address%d=%s\n", i+1, addr)
+	}
+
+	if gateway != "" {
+		fmt.Fprintf(b, "gateway=%s\n", gateway)
+	}
+}
+
+// writeLinkKeyfile writes the [vlan], [bond] or [bridge] section of an
+// entry.
+func writeLinkKeyfile(
+	b *strings.Builder,
+	entry InterfaceEntry,
+) {
+	switch {
+	case entry.VLAN != nil:
+		fmt.Fprintf(b, "\n[vlan]\n")
+		fmt.Fprintf(b, "id=%d\n", entry.VLAN.ID)
+		fmt.Fprintf(b, "parent=%s\n", entry.VLAN.Link)
+	case entry.Bond != nil:
+		fmt.Fprintf(b, "\n[bond]\n")
+		fmt.Fprintf(b, "mode=%s\n", entry.Bond.Mode)
+
+		if entry.Bond.MIIMonitor > 0 {
+			fmt.Fprintf(b, "miimon=%d\n", entry.Bond.MIIMonitor)
+		}
+	case entry.Bridge != nil:
+		fmt.Fprintf(b, "\n[bridge]\n")
+
+		if entry.Bridge.STP != nil {
+			fmt.Fprintf(b, "stp=%t\n", *entry.Bridge.STP)
+		}
+	}
+}
+
+// generatePortKeyfile builds the profile that attaches member to a bond
+// or bridge. The master and slave-type keys are used because they are
+// understood by every NetworkManager release.
+func generatePortKeyfile(
+	controller string,
+	controllerType string,
+	member string,
+	memberType string,
+) []byte {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "[connection]\n")
+	fmt.Fprintf(&b, "id=%s%s-port-%s\n", interfacePrefix, controller, member)
+	fmt.Fprintf(&b, "type=%s\n", memberType)
+	fmt.Fprintf(&b, "interface-name=%s\n", member)
+	fmt.Fprintf(&b, "master=%s\n", controller)
+	fmt.Fprintf(&b, "slave-type=%s\n", controllerType)
+	fmt.Fprintf(&b, "autoconnect-priority=100\n")
+
+	return []byte(b.String())
+}
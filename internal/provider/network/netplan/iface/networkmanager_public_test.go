@@ -0,0 +1,887 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package iface_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider/network/netplan/iface"
+)
+
+const testKeyfilePath = "/etc/NetworkManager/system-connections/osapi-eth0.nmconnection"
+
+// testBondKeyfilePath and testPortKeyfilePath are the profiles of bond0
+// and its eth1 port.
+const (
+	testBondKeyfilePath = "/etc/NetworkManager/system-connections/osapi-bond0.nmconnection"
+	testPortKeyfilePath = "/etc/NetworkManager/system-connections/osapi-bond0-port-eth1.nmconnection"
+)
+
+// nmcliDevicesTwoIfaces is a `nmcli -t device show` fixture with
+// loopback, eth0 (DHCP, default route) and eth1 (static, no default).
+const nmcliDevicesTwoIfaces = `GENERAL.DEVICE:eth0
+GENERAL.TYPE:ethernet
+GENERAL.HWADDR:AA\:BB\:CC\:DD\:EE\:F0
+GENERAL.CONNECTION:Wired connection 1
+IP4.ADDRESS[1]:10.0.0.5/24
+IP4.ROUTE[1]:dst = 0.0.0.0/0, nh = 10.0.0.1, mt = 100
+DHCP4.OPTION[1]:dhcp_lease_time = 86400
+
+GENERAL.DEVICE:eth1
+GENERAL.TYPE:ethernet
+GENERAL.HWADDR:AA\:BB\:CC\:DD\:EE\:F1
+GENERAL.CONNECTION:Wired connection 2
+IP4.ADDRESS[1]:10.0.1.5/24
+IP6.ADDRESS[1]:2001\:db8\:\:5/64
+
+GENERAL.DEVICE:lo
+GENERAL.TYPE:loopback
+GENERAL.CONNECTION:lo
+IP4.ADDRESS[1]:127.0.0.1/8
+`
+
+type NetworkManagerPublicTestSuite struct {
+	suite.Suite
+
+	ctrl        *gomock.Controller
+	ctx         context.Context
+	logger      *slog.Logger
+	memFs       avfs.VFS
+	mockStateKV *jobmocks.MockKeyValue
+	mockExec    *execmocks.MockManager
+	provider    *iface.NetworkManager
+}
+
+func (suite *NetworkManagerPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.ctx = context.Background()
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+	suite.mockStateKV = jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+
+	_ = suite.memFs.MkdirAll("/etc/NetworkManager/system-connections", 0o755)
+
+	suite.provider = iface.NewNetworkManagerProvider(
+		suite.logger,
+		suite.memFs,
+		suite.mockStateKV,
+		suite.mockExec,
+		testHostname,
+	)
+}
+
+func (suite *NetworkManagerPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *NetworkManagerPublicTestSuite) TearDownSubTest() {}
+
+// expectApply sets up the mocks for a successful keyfile deploy.
+func (suite *NetworkManagerPublicTestSuite) expectApply() {
+	suite.mockExec.EXPECT().
+		RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+		Return("GENERAL.DEVICE:eth0\nGENERAL.TYPE:ethernet\n", nil)
+
+	suite.mockStateKV.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("not found"))
+
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("nmcli", []string{"connection", "load", testKeyfilePath}).
+		Return("", nil)
+
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("nmcli", []string{"connection", "up", "id", "osapi-eth0"}).
+		Return("", nil)
+
+	suite.mockStateKV.EXPECT().
+		Put(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(uint64(1), nil)
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestList() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func([]iface.InterfaceEntry, error)
+	}{
+		{
+			name: "when nmcli returns devices",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show"}).
+					Return(nmcliDevicesTwoIfaces, nil)
+			},
+			validateFunc: func(entries []iface.InterfaceEntry, err error) {
+				suite.Require().NoError(err)
+				suite.Require().Len(entries, 2)
+
+				suite.Equal("eth0", entries[0].Name)
+				suite.Equal("10.0.0.5", entries[0].IPv4)
+				suite.Equal("aa:bb:cc:dd:ee:f0", entries[0].MAC)
+				suite.Equal("inet", entries[0].Family)
+				suite.True(entries[0].Primary)
+				suite.Require().NotNil(entries[0].DHCP4)
+				suite.True(*entries[0].DHCP4)
+
+				suite.Equal("eth1", entries[1].Name)
+				suite.Equal("2001:db8::5", entries[1].IPv6)
+				suite.Equal("dual", entries[1].Family)
+				suite.False(entries[1].Primary)
+				suite.Require().NotNil(entries[1].DHCP4)
+				suite.False(*entries[1].DHCP4)
+			},
+		},
+		{
+			name: "when nmcli fails",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show"}).
+					Return("", errors.New("nmcli not found"))
+			},
+			validateFunc: func(entries []iface.InterfaceEntry, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "interface list")
+				suite.Nil(entries)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			entries, err := suite.provider.List(suite.ctx)
+
+			tc.validateFunc(entries, err)
+		})
+	}
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestGet() {
+	tests := []struct {
+		name         string
+		ifaceName    string
+		setup        func()
+		validateFunc func(*iface.InterfaceEntry, error)
+	}{
+		{
+			name:      "when interface exists",
+			ifaceName: "eth0",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+					Return(nmcliDevicesTwoIfaces, nil)
+			},
+			validateFunc: func(entry *iface.InterfaceEntry, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("eth0", entry.Name)
+				suite.Equal("10.0.0.5", entry.IPv4)
+				suite.True(entry.Primary)
+			},
+		},
+		{
+			name:      "when interface does not exist",
+			ifaceName: "eth9",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth9"}).
+					Return("", errors.New("exit status 10"))
+			},
+			validateFunc: func(entry *iface.InterfaceEntry, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "not found")
+				suite.Nil(entry)
+			},
+		},
+		{
+			name:      "when name is empty",
+			ifaceName: "",
+			setup:     func() {},
+			validateFunc: func(entry *iface.InterfaceEntry, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "name must not be empty")
+				suite.Nil(entry)
+			},
+		},
+		{
+			name:      "when interface is a bond",
+			ifaceName: "bond0",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "bond0"}).
+					Return("GENERAL.DEVICE:bond0\nGENERAL.TYPE:bond\n", nil)
+				_ = suite.memFs.MkdirAll("/sys/class/net/bond0/bonding", 0o755)
+				_ = suite.memFs.WriteFile(
+					"/sys/class/net/bond0/bonding/mode",
+					[]byte("active-backup 1\n"),
+					0o644,
+				)
+				_ = suite.memFs.WriteFile(
+					"/sys/class/net/bond0/bonding/slaves",
+					[]byte("eth1\n"),
+					0o644,
+				)
+			},
+			validateFunc: func(entry *iface.InterfaceEntry, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("bond", entry.Type)
+				suite.Equal(&iface.BondConfig{
+					Mode:    "active-backup",
+					Members: []string{"eth1"},
+				}, entry.Bond)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			entry, err := suite.provider.Get(suite.ctx, tc.ifaceName)
+
+			tc.validateFunc(entry, err)
+		})
+	}
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestCreate() {
+	dhcp4False := false
+
+	tests := []struct {
+		name         string
+		entry        iface.InterfaceEntry
+		setup        func()
+		validateFunc func(*iface.InterfaceResult, error)
+	}{
+		{
+			name: "when new interface deploys successfully",
+			entry: iface.InterfaceEntry{
+				Name:      "eth0",
+				DHCP4:     &dhcp4False,
+				Addresses: []string{"10.0.0.5/24"},
+				Gateway4:  "10.0.0.1",
+			},
+			setup: func() {
+				suite.expectApply()
+			},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("eth0", result.Name)
+				suite.True(result.Changed)
+
+				data, readErr := suite.memFs.ReadFile(testKeyfilePath)
+				suite.Require().NoError(readErr)
+				suite.Contains(string(data), "id=osapi-eth0")
+				suite.Contains(string(data), "method=manual")
+				suite.Contains(string(data), "address1=10.0.0.5/24")
+				suite.Contains(string(data), "gateway=10.0.0.1")
+			},
+		},
+		{
+			name:  "when interface already managed returns unchanged",
+			entry: iface.InterfaceEntry{Name: "eth0"},
+			setup: func() {
+				_ = suite.memFs.WriteFile(testKeyfilePath, []byte("existing"), 0o600)
+			},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:  "when name is invalid",
+			entry: iface.InterfaceEntry{Name: "eth0;rm"},
+			setup: func() {},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "interface create")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when nmcli load fails",
+			entry: iface.InterfaceEntry{Name: "eth0"},
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+					Return("", errors.New("exit status 10"))
+
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "load", testKeyfilePath}).
+					Return("", errors.New("invalid keyfile"))
+			},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "interface create")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when bond deploys controller and port profiles",
+			entry: iface.InterfaceEntry{
+				Name: "bond0",
+				Bond: &iface.BondConfig{Mode: "802.3ad", Members: []string{"eth1"}},
+			},
+			setup: func() {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found")).
+					Times(2)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "load", testBondKeyfilePath}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "up", "id", "osapi-bond0"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth1"}).
+					Return("GENERAL.DEVICE:eth1\nGENERAL.TYPE:ethernet\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "load", testPortKeyfilePath}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd(
+						"nmcli",
+						[]string{"connection", "up", "id", "osapi-bond0-port-eth1"},
+					).
+					Return("", nil)
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(1), nil).
+					Times(2)
+			},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+
+				data, readErr := suite.memFs.ReadFile(testBondKeyfilePath)
+				suite.Require().NoError(readErr)
+				suite.Contains(string(data), "type=bond\n")
+				suite.Contains(string(data), "[bond]\nmode=802.3ad\n")
+
+				port, readErr := suite.memFs.ReadFile(testPortKeyfilePath)
+				suite.Require().NoError(readErr)
+				suite.Contains(string(port), "type=ethernet\n")
+				suite.Contains(string(port), "interface-name=eth1\n")
+				suite.Contains(string(port), "master=bond0\nslave-type=bond\n")
+			},
+		},
+		{
+			name: "when port deploy fails",
+			entry: iface.InterfaceEntry{
+				Name: "bond0",
+				Bond: &iface.BondConfig{Mode: "802.3ad", Members: []string{"eth1"}},
+			},
+			setup: func() {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found")).
+					AnyTimes()
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(1), nil).
+					AnyTimes()
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", gomock.Any()).
+					Return("GENERAL.DEVICE:eth1\nGENERAL.TYPE:ethernet\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "load", testPortKeyfilePath}).
+					Return("", errors.New("invalid keyfile"))
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", gomock.Any()).
+					Return("", nil).
+					AnyTimes()
+			},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "interface create: port eth1:")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when variant is invalid",
+			entry: iface.InterfaceEntry{
+				Name: "vlan0",
+				VLAN: &iface.VLANConfig{ID: 0, Link: "eth0"},
+			},
+			setup: func() {},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "out of range")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Create(suite.ctx, tc.entry)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestUpdate() {
+	dhcp4True := true
+
+	tests := []struct {
+		name         string
+		entry        iface.InterfaceEntry
+		setup        func()
+		validateFunc func(*iface.InterfaceResult, error)
+	}{
+		{
+			name: "when managed interface updates successfully",
+			entry: iface.InterfaceEntry{
+				Name:  "eth0",
+				DHCP4: &dhcp4True,
+				MTU:   9000,
+			},
+			setup: func() {
+				_ = suite.memFs.WriteFile(testKeyfilePath, []byte("existing"), 0o600)
+				suite.expectApply()
+			},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+
+				data, readErr := suite.memFs.ReadFile(testKeyfilePath)
+				suite.Require().NoError(readErr)
+				suite.Contains(string(data), "mtu=9000")
+				suite.Contains(string(data), "method=auto")
+			},
+		},
+		{
+			name:  "when interface not managed",
+			entry: iface.InterfaceEntry{Name: "eth0"},
+			setup: func() {},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "not managed")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when name is invalid",
+			entry: iface.InterfaceEntry{Name: ""},
+			setup: func() {},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "interface update")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when nmcli up fails",
+			entry: iface.InterfaceEntry{Name: "eth0"},
+			setup: func() {
+				_ = suite.memFs.WriteFile(testKeyfilePath, []byte("existing"), 0o600)
+
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+					Return("GENERAL.DEVICE:eth0\nGENERAL.TYPE:ethernet\n", nil)
+
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "load", testKeyfilePath}).
+					Return("", nil)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "up", "id", "osapi-eth0"}).
+					Return("", errors.New("activation failed"))
+			},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "interface update")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when a bond member is dropped its port is removed",
+			entry: iface.InterfaceEntry{
+				Name: "bond0",
+				Bond: &iface.BondConfig{Mode: "802.3ad", Members: []string{"eth1"}},
+			},
+			setup: func() {
+				_ = suite.memFs.WriteFile(testBondKeyfilePath, []byte("existing"), 0o600)
+				_ = suite.memFs.WriteFile(testPortKeyfilePath, []byte("existing"), 0o600)
+				_ = suite.memFs.WriteFile(
+					"/etc/NetworkManager/system-connections/osapi-bond0-port-eth2.nmconnection",
+					[]byte("existing"),
+					0o600,
+				)
+
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found")).
+					AnyTimes()
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(1), nil).
+					AnyTimes()
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", gomock.Any()).
+					Return("GENERAL.DEVICE:eth1\nGENERAL.TYPE:ethernet\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "reload"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", gomock.Any()).
+					Return("", nil).
+					Times(4)
+			},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+
+				_, statErr := suite.memFs.Stat(testPortKeyfilePath)
+				suite.NoError(statErr)
+				_, statErr = suite.memFs.Stat(
+					"/etc/NetworkManager/system-connections/osapi-bond0-port-eth2.nmconnection",
+				)
+				suite.Error(statErr)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Update(suite.ctx, tc.entry)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestDelete() {
+	tests := []struct {
+		name         string
+		ifaceName    string
+		setup        func()
+		validateFunc func(*iface.InterfaceResult, error)
+	}{
+		{
+			name:      "when managed interface is removed",
+			ifaceName: "eth0",
+			setup: func() {
+				_ = suite.memFs.WriteFile(testKeyfilePath, []byte("existing"), 0o600)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "reload"}).
+					Return("", nil)
+
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+			},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+
+				_, statErr := suite.memFs.Stat(testKeyfilePath)
+				suite.Error(statErr)
+			},
+		},
+		{
+			name:      "when interface not managed",
+			ifaceName: "eth0",
+			setup:     func() {},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:      "when name is empty",
+			ifaceName: "",
+			setup:     func() {},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "name must not be empty")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:      "when nmcli reload fails",
+			ifaceName: "eth0",
+			setup: func() {
+				_ = suite.memFs.WriteFile(testKeyfilePath, []byte("existing"), 0o600)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "reload"}).
+					Return("", errors.New("reload failed"))
+			},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "interface delete")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:      "when bond is removed with its ports",
+			ifaceName: "bond0",
+			setup: func() {
+				_ = suite.memFs.WriteFile(testBondKeyfilePath, []byte("existing"), 0o600)
+				_ = suite.memFs.WriteFile(testPortKeyfilePath, []byte("existing"), 0o600)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "reload"}).
+					Return("", nil).
+					Times(2)
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found")).
+					Times(2)
+			},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+
+				_, statErr := suite.memFs.Stat(testPortKeyfilePath)
+				suite.Error(statErr)
+				_, statErr = suite.memFs.Stat(testBondKeyfilePath)
+				suite.Error(statErr)
+			},
+		},
+		{
+			name:      "when port removal fails",
+			ifaceName: "bond0",
+			setup: func() {
+				_ = suite.memFs.WriteFile(testBondKeyfilePath, []byte("existing"), 0o600)
+				_ = suite.memFs.WriteFile(testPortKeyfilePath, []byte("existing"), 0o600)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "reload"}).
+					Return("", errors.New("reload failed"))
+			},
+			validateFunc: func(result *iface.InterfaceResult, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "interface delete: port eth1:")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Delete(suite.ctx, tc.ifaceName)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestGenerateInterfaceKeyfile() {
+	dhcp4True := true
+	dhcp4False := false
+	dhcp6False := false
+	wolTrue := true
+	wolFalse := false
+	stpFalse := false
+
+	tests := []struct {
+		name         string
+		entry        iface.InterfaceEntry
+		connType     string
+		validateFunc func(string)
+	}{
+		{
+			name: "when all fields set",
+			entry: iface.InterfaceEntry{
+				Name:       "eth0",
+				DHCP4:      &dhcp4False,
+				Addresses:  []string{"10.0.0.5/24", "2001:db8::5/64"},
+				Gateway4:   "10.0.0.1",
+				Gateway6:   "2001:db8::1",
+				MTU:        9000,
+				MACAddress: "aa:bb:cc:dd:ee:ff",
+				WakeOnLAN:  &wolTrue,
+			},
+			connType: "ethernet",
+			validateFunc: func(result string) {
+				suite.Equal(`[connection]
+id=osapi-eth0
+type=ethernet
+interface-name=eth0
+autoconnect-priority=100
+
+[ethernet]
+mtu=9000
+cloned-mac-address=aa:bb:cc:dd:ee:ff
+wake-on-lan=64
+
+[ipv4]
+method=manual
+address1=10.0.0.5/24
+gateway=10.0.0.1
+
+[ipv6]
+method=manual
+address1=2001:db8::5/64
+gateway=2001:db8::1
+`, result)
+			},
+		},
+		{
+			name: "when only DHCP4 set",
+			entry: iface.InterfaceEntry{
+				Name:  "eth0",
+				DHCP4: &dhcp4True,
+			},
+			connType: "ethernet",
+			validateFunc: func(result string) {
+				suite.Contains(result, "[ipv4]\nmethod=auto\n")
+				suite.NotContains(result, "[ethernet]")
+				suite.NotContains(result, "[ipv6]")
+			},
+		},
+		{
+			name: "when DHCP disabled without addresses",
+			entry: iface.InterfaceEntry{
+				Name:  "eth0",
+				DHCP4: &dhcp4False,
+				DHCP6: &dhcp6False,
+			},
+			connType: "ethernet",
+			validateFunc: func(result string) {
+				suite.Contains(result, "[ipv4]\nmethod=disabled\n")
+				suite.Contains(result, "[ipv6]\nmethod=link-local\n")
+			},
+		},
+		{
+			name: "when wake-on-lan disabled on ethernet",
+			entry: iface.InterfaceEntry{
+				Name:      "eth0",
+				WakeOnLAN: &wolFalse,
+			},
+			connType: "ethernet",
+			validateFunc: func(result string) {
+				suite.Contains(result, "[ethernet]\nwake-on-lan=0\n")
+			},
+		},
+		{
+			name: "when wake-on-lan set on wifi is omitted",
+			entry: iface.InterfaceEntry{
+				Name:      "wlan0",
+				MTU:       1400,
+				WakeOnLAN: &wolTrue,
+			},
+			connType: "wifi",
+			validateFunc: func(result string) {
+				suite.Contains(result, "type=wifi")
+				suite.Contains(result, "[wifi]\nmtu=1400\n")
+				suite.NotContains(result, "wake-on-lan")
+			},
+		},
+		{
+			name: "when vlan set",
+			entry: iface.InterfaceEntry{
+				Name: "vlan100",
+				MTU:  1496,
+				VLAN: &iface.VLANConfig{ID: 100, Link: "eth0"},
+			},
+			connType: "vlan",
+			validateFunc: func(result string) {
+				suite.Contains(result, "type=vlan\n")
+				suite.Contains(result, "[vlan]\nid=100\nparent=eth0\n")
+				suite.Contains(result, "[ethernet]\nmtu=1496\n")
+			},
+		},
+		{
+			name: "when bond set",
+			entry: iface.InterfaceEntry{
+				Name: "bond0",
+				Bond: &iface.BondConfig{
+					Mode:       "balance-alb",
+					Members:    []string{"eth1", "eth2"},
+					MIIMonitor: 100,
+				},
+			},
+			connType: "bond",
+			validateFunc: func(result string) {
+				suite.Contains(result, "[bond]\nmode=balance-alb\nmiimon=100\n")
+				suite.NotContains(result, "eth1")
+			},
+		},
+		{
+			name: "when bridge set",
+			entry: iface.InterfaceEntry{
+				Name:   "br0",
+				Bridge: &iface.BridgeConfig{STP: &stpFalse},
+			},
+			connType: "bridge",
+			validateFunc: func(result string) {
+				suite.Contains(result, "[bridge]\nstp=false\n")
+			},
+		},
+		{
+			name: "when bridge set without stp",
+			entry: iface.InterfaceEntry{
+				Name:   "br0",
+				Bridge: &iface.BridgeConfig{},
+			},
+			connType: "bridge",
+			validateFunc: func(result string) {
+				suite.Contains(result, "[bridge]\n")
+				suite.NotContains(result, "stp=")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			result := iface.GenerateInterfaceKeyfile(tc.entry, tc.connType)
+
+			tc.validateFunc(string(result))
+		})
+	}
+}
+
+func TestNetworkManagerPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(NetworkManagerPublicTestSuite))
+}
@@ -37,25 +37,78 @@ type Provider interface {
 	Delete(ctx context.Context, name string) (*InterfaceResult, error)
 }
 
+// Interface types reported by list/get and implied by the VLAN, Bond
+// and Bridge variants of an InterfaceEntry.
+const (
+	TypeEthernet = "ethernet"
+	TypeVLAN     = "vlan"
+	TypeBond     = "bond"
+	TypeBridge   = "bridge"
+)
+
+// BondModes lists the bonding modes accepted for bond interfaces.
+var BondModes = []string{
+	"balance-rr",
+	"active-backup",
+	"balance-xor",
+	"broadcast",
+	"802.3ad",
+	"balance-tlb",
+	"balance-alb",
+}
+
 // InterfaceEntry represents a network interface. For list/get operations,
-// the read-only fields (IPv4, IPv6, MAC, Family) are populated from the
-// system. For create/update, the config fields (DHCP, Addresses, Gateway,
-// etc.) are used to generate Netplan YAML.
+// the read-only fields (IPv4, IPv6, MAC, Family, Type) are populated from
+// the system. For create/update, the config fields (DHCP, Addresses,
+// Gateway, etc.) are used to generate Netplan YAML. At most one of VLAN,
+// Bond and Bridge is set; it selects the kind of virtual interface to
+// create. When none is set the entry configures an existing interface.
 type InterfaceEntry struct {
-	Name       string   `json:"name"`
-	IPv4       string   `json:"ipv4,omitempty"`
-	IPv6       string   `json:"ipv6,omitempty"`
-	MAC        string   `json:"mac,omitempty"`
-	Family     string   `json:"family,omitempty"`
-	DHCP4      *bool    `json:"dhcp4,omitempty"`
-	DHCP6      *bool    `json:"dhcp6,omitempty"`
-	Addresses  []string `json:"addresses,omitempty"`
-	Gateway4   string   `json:"gateway4,omitempty"`
-	Gateway6   string   `json:"gateway6,omitempty"`
-	MTU        int      `json:"mtu,omitempty"`
-	MACAddress string   `json:"mac_address,omitempty"`
-	WakeOnLAN  *bool    `json:"wakeonlan,omitempty"`
-	Primary    bool     `json:"primary,omitempty"`
+	Name       string        `json:"name"`
+	Type       string        `json:"type,omitempty"`
+	IPv4       string        `json:"ipv4,omitempty"`
+	IPv6       string        `json:"ipv6,omitempty"`
+	MAC        string        `json:"mac,omitempty"`
+	Family     string        `json:"family,omitempty"`
+	DHCP4      *bool         `json:"dhcp4,omitempty"`
+	DHCP6      *bool         `json:"dhcp6,omitempty"`
+	Addresses  []string      `json:"addresses,omitempty"`
+	Gateway4   string        `json:"gateway4,omitempty"`
+	Gateway6   string        `json:"gateway6,omitempty"`
+	MTU        int           `json:"mtu,omitempty"`
+	MACAddress string        `json:"mac_address,omitempty"`
+	WakeOnLAN  *bool         `json:"wakeonlan,omitempty"`
+	Primary    bool          `json:"primary,omitempty"`
+	VLAN       *VLANConfig   `json:"vlan,omitempty"`
+	Bond       *BondConfig   `json:"bond,omitempty"`
+	Bridge     *BridgeConfig `json:"bridge,omitempty"`
+}
+
+// VLANConfig describes an 802.1Q VLAN interface.
+type VLANConfig struct {
+	// ID is the VLAN tag (1-4094).
+	ID int `json:"id"`
+	// Link is the parent interface carrying the tagged traffic.
+	Link string `json:"link"`
+}
+
+// BondConfig describes a bonded (link aggregation) interface.
+type BondConfig struct {
+	// Mode is the bonding mode, one of BondModes.
+	Mode string `json:"mode"`
+	// Members are the interfaces enslaved to the bond.
+	Members []string `json:"members"`
+	// MIIMonitor is the link monitoring interval in milliseconds.
+	// Zero leaves the kernel default.
+	MIIMonitor int `json:"miimon,omitempty"`
+}
+
+// BridgeConfig describes a Linux bridge interface.
+type BridgeConfig struct {
+	// Members are the interfaces attached to the bridge.
+	Members []string `json:"members,omitempty"`
+	// STP enables the spanning tree protocol when set.
+	STP *bool `json:"stp,omitempty"`
 }
 
 // InterfaceResult is the outcome of a create/update/delete operation.
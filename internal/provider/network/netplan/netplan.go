@@ -45,14 +45,17 @@ var marshalJSON = json.Marshal
 
 // ApplyConfig writes a Netplan configuration file to disk, validates it
 // with `netplan generate`, applies it with `netplan apply`, and tracks
-// the file state in the KV store. Returns (true, nil) when the file was
-// written and applied, (false, nil) when the content is unchanged.
+// the file state in the KV store. When guard is non-nil the change is
+// held until confirmed and reverted otherwise. Returns (true, nil) when
+// the file was written and applied, (false, nil) when the content is
+// unchanged.
 func ApplyConfig(
 	ctx context.Context,
 	logger *slog.Logger,
 	fs avfs.VFS,
 	stateKV jetstream.KeyValue,
 	execManager exec.Manager,
+	guard *Guard,
 	hostname string,
 	path string,
 	content []byte,
@@ -61,13 +64,16 @@ func ApplyConfig(
 	sha := ComputeSHA256(content)
 	stateKey := file.BuildStateKey(hostname, path)
 
-	// Check for idempotency: if SHA matches and file exists, skip.
+	// Check for idempotency: if SHA matches and the file on disk still
+	// holds that content, skip. A reverted change leaves the state SHA
+	// ahead of the file, so the content is compared rather than trusted.
 	kvEntry, err := stateKV.Get(ctx, stateKey)
 	if err == nil {
 		var state job.FileState
 		if unmarshalErr := json.Unmarshal(kvEntry.Value(), &state); unmarshalErr == nil {
 			if state.SHA256 == sha && state.UndeployedAt == "" {
-				if _, statErr := fs.Stat(path); statErr == nil {
+				if data, readErr := fs.ReadFile(path); readErr == nil &&
+					ComputeSHA256(data) == sha {
 					logger.Debug(
 						"netplan config unchanged, skipping deploy",
 						slog.String("path", path),
@@ -85,8 +91,15 @@ func ApplyConfig(
 		return false, fmt.Errorf("netplan apply: create directory: %w", mkErr)
 	}
 
+	// Snapshot the current files so an unconfirmed change can be reverted.
+	if snapErr := guard.begin(); snapErr != nil {
+		return false, fmt.Errorf("netplan apply: %w", snapErr)
+	}
+
 	// Write the config file.
 	if writeErr := fs.WriteFile(path, content, 0o600); writeErr != nil {
+		guard.cancel()
+
 		return false, fmt.Errorf("netplan apply: write file: %w", writeErr)
 	}
 
@@ -94,6 +107,7 @@ func ApplyConfig(
 	if _, genErr := execManager.RunPrivilegedCmd("netplan", []string{"generate"}); genErr != nil {
 		// Roll back: remove the invalid file.
 		_ = fs.Remove(path)
+		guard.cancel()
 
 		return false, fmt.Errorf(
 			"netplan validate failed (file rolled back): %w",
@@ -101,8 +115,11 @@ func ApplyConfig(
 		)
 	}
 
-	// Apply the configuration.
-	if _, applyErr := execManager.RunPrivilegedCmd("netplan", []string{"apply"}); applyErr != nil {
+	// Apply the configuration and, in confirm-or-revert mode, start the
+	// revert timer even when apply fails part way.
+	_, applyErr := execManager.RunPrivilegedCmd("netplan", []string{"apply"})
+	guard.arm()
+	if applyErr != nil {
 		return false, fmt.Errorf("netplan apply: %w", applyErr)
 	}
 
@@ -135,14 +152,16 @@ func ApplyConfig(
 
 // RemoveConfig removes a Netplan configuration file from disk, applies
 // the change with `netplan apply`, and marks the file state as undeployed
-// in the KV store. Returns (true, nil) when the file was removed and
-// applied, (false, nil) when the file does not exist.
+// in the KV store. When guard is non-nil the removal is held until
+// confirmed and reverted otherwise. Returns (true, nil) when the file was
+// removed and applied, (false, nil) when the file does not exist.
 func RemoveConfig(
 	ctx context.Context,
 	logger *slog.Logger,
 	fs avfs.VFS,
 	stateKV jetstream.KeyValue,
 	execManager exec.Manager,
+	guard *Guard,
 	hostname string,
 	path string,
 ) (bool, error) {
@@ -151,13 +170,22 @@ func RemoveConfig(
 		return false, nil
 	}
 
+	// Snapshot the current files so an unconfirmed removal can be reverted.
+	if snapErr := guard.begin(); snapErr != nil {
+		return false, fmt.Errorf("netplan remove: %w", snapErr)
+	}
+
 	// Remove the file.
 	if removeErr := fs.Remove(path); removeErr != nil {
+		guard.cancel()
+
 		return false, fmt.Errorf("netplan remove: remove file: %w", removeErr)
 	}
 
 	// Apply the configuration change.
-	if _, applyErr := execManager.RunPrivilegedCmd("netplan", []string{"apply"}); applyErr != nil {
+	_, applyErr := execManager.RunPrivilegedCmd("netplan", []string{"apply"})
+	guard.arm()
+	if applyErr != nil {
 		return false, fmt.Errorf("netplan remove: apply: %w", applyErr)
 	}
 
@@ -189,6 +189,48 @@ func (suite *NetplanPublicTestSuite) TestApplyConfig() {
 				suite.True(changed)
 			},
 		},
+		{
+			name: "when SHA matches but file content differs (rewrites)",
+			setup: func() {
+				// File on disk was reverted to earlier content.
+				_ = suite.memFs.WriteFile(testPath, []byte("network: {}\n"), 0o644)
+
+				state := job.FileState{
+					Path:       testPath,
+					SHA256:     testSHA(),
+					Mode:       "0644",
+					DeployedAt: "2026-01-01T00:00:00Z",
+				}
+				stateBytes, _ := json.Marshal(state)
+
+				mockEntry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+				mockEntry.EXPECT().Value().Return(stateBytes)
+
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(mockEntry, nil)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"generate"}).
+					Return("", nil)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("netplan", []string{"apply"}).
+					Return("", nil)
+
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(1), nil)
+			},
+			validateFunc: func(changed bool, err error) {
+				suite.Require().NoError(err)
+				suite.True(changed)
+
+				data, readErr := suite.memFs.ReadFile(testPath)
+				suite.Require().NoError(readErr)
+				suite.Equal(testContent, data)
+			},
+		},
 		{
 			name: "when netplan generate fails (rolls back file)",
 			setup: func() {
@@ -353,6 +395,7 @@ func (suite *NetplanPublicTestSuite) TestApplyConfig() {
 				suite.memFs,
 				suite.mockStateKV,
 				suite.mockExec,
+				nil,
 				testHostname,
 				testPath,
 				testContent,
@@ -528,6 +571,7 @@ func (suite *NetplanPublicTestSuite) TestRemoveConfig() {
 				suite.memFs,
 				suite.mockStateKV,
 				suite.mockExec,
+				nil,
 				testHostname,
 				testPath,
 			)
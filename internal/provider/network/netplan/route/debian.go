@@ -29,6 +29,7 @@ import (
 
 	"github.com/osapi-io/osapi/internal/exec"
 	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/network/netplan"
 )
 
 // marshalJSON is a package-level variable for testing the marshal
@@ -50,6 +51,7 @@ type Debian struct {
 	fs          avfs.VFS
 	stateKV     jetstream.KeyValue
 	execManager exec.Manager
+	guard       *netplan.Guard
 	hostname    string
 }
 
@@ -60,12 +62,14 @@ func NewDebianProvider(
 	stateKV jetstream.KeyValue,
 	execManager exec.Manager,
 	hostname string,
+	guard *netplan.Guard,
 ) *Debian {
 	return &Debian{
 		logger:      logger.With(slog.String("subsystem", "provider.netplan.route")),
 		fs:          fs,
 		stateKV:     stateKV,
 		execManager: execManager,
+		guard:       guard,
 		hostname:    hostname,
 	}
 }
@@ -0,0 +1,399 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package route
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/osapi-io/osapi/internal/exec"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/network/netplan"
+	"github.com/osapi-io/osapi/internal/provider/network/netplan/iface"
+	"github.com/osapi-io/osapi/internal/provider/network/networkmanager"
+)
+
+// Compile-time checks.
+var (
+	_ Provider             = (*NetworkManager)(nil)
+	_ provider.FactsSetter = (*NetworkManager)(nil)
+)
+
+// NetworkManager implements the Provider interface for hosts whose
+// network is managed by NetworkManager. Routes are added to the
+// connection profile active on the interface with `nmcli connection
+// modify` and tracked in the file-state KV, so routes configured
+// outside of osapi are left untouched.
+type NetworkManager struct {
+	provider.FactsAware
+	logger      *slog.Logger
+	stateKV     jetstream.KeyValue
+	execManager exec.Manager
+	hostname    string
+}
+
+// NewNetworkManagerProvider factory to create a new NetworkManager instance.
+func NewNetworkManagerProvider(
+	logger *slog.Logger,
+	stateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+) *NetworkManager {
+	return &NetworkManager{
+		logger:      logger.With(slog.String("subsystem", "provider.networkmanager.route")),
+		stateKV:     stateKV,
+		execManager: execManager,
+		hostname:    hostname,
+	}
+}
+
+// List returns the routes NetworkManager reports for each device,
+// excluding the loopback device.
+func (n *NetworkManager) List(
+	_ context.Context,
+) ([]ListEntry, error) {
+	devices, err := networkmanager.GetDevices(n.execManager)
+	if err != nil {
+		return nil, fmt.Errorf("route list: %w", err)
+	}
+
+	var result []ListEntry
+
+	for _, d := range devices {
+		if d.Name == "lo" || d.Type == "loopback" {
+			continue
+		}
+
+		for _, r := range d.Routes {
+			result = append(result, ListEntry{
+				Destination: r.To,
+				Gateway:     r.Via,
+				Interface:   d.Name,
+				Metric:      r.Metric,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// Get returns the managed routes for a specific interface by reading
+// the route metadata from the file-state KV store.
+func (n *NetworkManager) Get(
+	ctx context.Context,
+	interfaceName string,
+) (*Entry, error) {
+	if interfaceName == "" {
+		return nil, fmt.Errorf("route get: interface name must not be empty")
+	}
+
+	state := networkmanager.GetState(
+		ctx,
+		n.stateKV,
+		n.hostname,
+		networkmanager.StatePath("routes", interfaceName),
+	)
+	if state == nil {
+		return nil, fmt.Errorf("route %q: not found", interfaceName)
+	}
+
+	routes, err := routesFromState(state)
+	if err != nil {
+		return nil, fmt.Errorf("route get: %w", err)
+	}
+
+	return &Entry{
+		Interface: interfaceName,
+		Routes:    routes,
+	}, nil
+}
+
+// Create adds routes to the connection active on an interface. Fails
+// if any route targets the default gateway. Returns Changed: false if
+// routes are already managed for the interface.
+func (n *NetworkManager) Create(
+	ctx context.Context,
+	entry Entry,
+) (*Result, error) {
+	if err := iface.ValidateInterfaceName(entry.Interface); err != nil {
+		return nil, fmt.Errorf("route create: %w", err)
+	}
+
+	if containsDefaultRoute(entry.Routes) {
+		return nil, fmt.Errorf(
+			"route create: default route (0.0.0.0/0, ::/0, default) not allowed",
+		)
+	}
+
+	statePath := networkmanager.StatePath("routes", entry.Interface)
+
+	// Already managed — nothing to do.
+	if state := networkmanager.GetState(ctx, n.stateKV, n.hostname, statePath); state != nil {
+		return &Result{
+			Interface: entry.Interface,
+			Changed:   false,
+		}, nil
+	}
+
+	conn, err := networkmanager.ActiveConnection(n.execManager, entry.Interface)
+	if err != nil {
+		return nil, fmt.Errorf("route create: %w", err)
+	}
+
+	if err := n.apply(ctx, entry, conn, statePath, routeSettings("+", entry.Routes)); err != nil {
+		return nil, fmt.Errorf("route create: %w", err)
+	}
+
+	n.logger.Info(
+		"route created",
+		slog.String("interface", entry.Interface),
+		slog.String("connection", conn),
+	)
+
+	return &Result{
+		Interface: entry.Interface,
+		Changed:   true,
+	}, nil
+}
+
+// Update replaces the managed routes of an interface. Fails if no
+// routes are managed for the interface, or if any route targets the
+// default gateway.
+func (n *NetworkManager) Update(
+	ctx context.Context,
+	entry Entry,
+) (*Result, error) {
+	if err := iface.ValidateInterfaceName(entry.Interface); err != nil {
+		return nil, fmt.Errorf("route update: %w", err)
+	}
+
+	if containsDefaultRoute(entry.Routes) {
+		return nil, fmt.Errorf(
+			"route update: default route (0.0.0.0/0, ::/0, default) not allowed",
+		)
+	}
+
+	statePath := networkmanager.StatePath("routes", entry.Interface)
+
+	state := networkmanager.GetState(ctx, n.stateKV, n.hostname, statePath)
+	if state == nil {
+		return nil, fmt.Errorf(
+			"route update: %q not managed",
+			entry.Interface,
+		)
+	}
+
+	if state.SHA256 == routesSHA(entry.Routes) {
+		return &Result{
+			Interface: entry.Interface,
+			Changed:   false,
+		}, nil
+	}
+
+	previous, err := routesFromState(state)
+	if err != nil {
+		return nil, fmt.Errorf("route update: %w", err)
+	}
+
+	conn := state.Metadata["connection"]
+	settings := append(routeSettings("-", previous), routeSettings("+", entry.Routes)...)
+
+	if err := n.apply(ctx, entry, conn, statePath, settings); err != nil {
+		return nil, fmt.Errorf("route update: %w", err)
+	}
+
+	n.logger.Info(
+		"route updated",
+		slog.String("interface", entry.Interface),
+		slog.String("connection", conn),
+	)
+
+	return &Result{
+		Interface: entry.Interface,
+		Changed:   true,
+	}, nil
+}
+
+// Delete removes the managed routes of an interface. If no routes are
+// managed, returns Changed: false (idempotent).
+func (n *NetworkManager) Delete(
+	ctx context.Context,
+	interfaceName string,
+) (*Result, error) {
+	if interfaceName == "" {
+		return nil, fmt.Errorf("route delete: interface name must not be empty")
+	}
+
+	statePath := networkmanager.StatePath("routes", interfaceName)
+
+	state := networkmanager.GetState(ctx, n.stateKV, n.hostname, statePath)
+	if state == nil {
+		return &Result{
+			Interface: interfaceName,
+			Changed:   false,
+		}, nil
+	}
+
+	previous, err := routesFromState(state)
+	if err != nil {
+		return nil, fmt.Errorf("route delete: %w", err)
+	}
+
+	if _, modifyErr := networkmanager.ModifyConnection(
+		n.execManager,
+		state.Metadata["connection"],
+		interfaceName,
+		routeSettings("-", previous),
+	); modifyErr != nil {
+		return nil, fmt.Errorf("route delete: %w", modifyErr)
+	}
+
+	networkmanager.MarkRemoved(ctx, n.logger, n.stateKV, n.hostname, statePath)
+
+	n.logger.Info(
+		"route deleted",
+		slog.String("interface", interfaceName),
+	)
+
+	return &Result{
+		Interface: interfaceName,
+		Changed:   true,
+	}, nil
+}
+
+// apply modifies the connection and records the managed routes in the
+// file-state KV.
+func (n *NetworkManager) apply(
+	ctx context.Context,
+	entry Entry,
+	conn string,
+	statePath string,
+	settings []networkmanager.Setting,
+) error {
+	metadata, err := buildRouteMetadata(entry)
+	if err != nil {
+		return err
+	}
+	metadata["connection"] = conn
+
+	if _, err := networkmanager.ModifyConnection(
+		n.execManager,
+		conn,
+		entry.Interface,
+		settings,
+	); err != nil {
+		return err
+	}
+
+	return networkmanager.PutState(
+		ctx,
+		n.stateKV,
+		n.hostname,
+		statePath,
+		routesSHA(entry.Routes),
+		"",
+		metadata,
+	)
+}
+
+// routeSettings builds the nmcli settings that add ("+") or remove
+// ("-") routes, grouped by address family.
+func routeSettings(
+	op string,
+	routes []Route,
+) []networkmanager.Setting {
+	v4, v6 := nmcliRouteValues(routes)
+
+	var settings []networkmanager.Setting
+
+	if len(v4) > 0 {
+		settings = append(settings, networkmanager.Setting{
+			Property: op + "ipv4.routes",
+			Value:    strings.Join(v4, ", "),
+		})
+	}
+
+	if len(v6) > 0 {
+		settings = append(settings, networkmanager.Setting{
+			Property: op + "ipv6.routes",
+			Value:    strings.Join(v6, ", "),
+		})
+	}
+
+	return settings
+}
+
+// nmcliRouteValues renders routes in nmcli's "dest next-hop [metric]"
+// format, split into IPv4 and IPv6 values.
+func nmcliRouteValues(
+	routes []Route,
+) ([]string, []string) {
+	var v4, v6 []string
+
+	for _, r := range routes {
+		value := r.To + " " + r.Via
+		if r.Metric > 0 {
+			value += " " + strconv.Itoa(r.Metric)
+		}
+
+		if strings.Contains(r.To, ":") {
+			v6 = append(v6, value)
+		} else {
+			v4 = append(v4, value)
+		}
+	}
+
+	return v4, v6
+}
+
+// routesSHA returns the content hash used to detect route changes.
+func routesSHA(
+	routes []Route,
+) string {
+	v4, v6 := nmcliRouteValues(routes)
+
+	return netplan.ComputeSHA256([]byte(strings.Join(append(v4, v6...), "\n")))
+}
+
+// routesFromState decodes the managed routes recorded in the state
+// metadata.
+func routesFromState(
+	state *job.FileState,
+) ([]Route, error) {
+	routesJSON, ok := state.Metadata["routes"]
+	if !ok {
+		return nil, fmt.Errorf("no route metadata for %q", state.Metadata["interface"])
+	}
+
+	var routes []Route
+	if err := json.Unmarshal([]byte(routesJSON), &routes); err != nil {
+		return nil, fmt.Errorf("unmarshal routes: %w", err)
+	}
+
+	return routes, nil
+}
@@ -0,0 +1,711 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package route_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider/network/netplan/route"
+)
+
+// nmcliDevicesWithRoutes is a `nmcli -t device show` fixture with
+// routes on eth0 and the loopback device.
+const nmcliDevicesWithRoutes = `GENERAL.DEVICE:eth0
+GENERAL.TYPE:ethernet
+GENERAL.CONNECTION:Wired connection 1
+IP4.ROUTE[1]:dst = 0.0.0.0/0, nh = 10.0.0.1, mt = 100
+IP4.ROUTE[2]:dst = 10.0.0.0/24, nh = 0.0.0.0, mt = 100
+IP6.ROUTE[1]:dst = 2001\:db8\:\:/64, nh = \:\:, mt = 256
+
+GENERAL.DEVICE:lo
+GENERAL.TYPE:loopback
+IP4.ROUTE[1]:dst = 127.0.0.0/8, nh = 0.0.0.0, mt = 0
+`
+
+type NetworkManagerPublicTestSuite struct {
+	suite.Suite
+
+	ctrl        *gomock.Controller
+	ctx         context.Context
+	logger      *slog.Logger
+	mockStateKV *jobmocks.MockKeyValue
+	mockExec    *execmocks.MockManager
+	provider    *route.NetworkManager
+}
+
+func (suite *NetworkManagerPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.ctx = context.Background()
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.mockStateKV = jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+
+	suite.provider = route.NewNetworkManagerProvider(
+		suite.logger,
+		suite.mockStateKV,
+		suite.mockExec,
+		testHostname,
+	)
+}
+
+func (suite *NetworkManagerPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *NetworkManagerPublicTestSuite) TearDownSubTest() {
+	route.ResetMarshalJSON()
+}
+
+// expectState returns the given routes as managed state from the KV.
+func (suite *NetworkManagerPublicTestSuite) expectState(
+	sha string,
+	routes []route.Route,
+) {
+	routesJSON, _ := json.Marshal(routes)
+	stateBytes, _ := json.Marshal(job.FileState{
+		Path:   "nmcli:eth0/routes",
+		SHA256: sha,
+		Metadata: map[string]string{
+			"interface":  "eth0",
+			"connection": "Wired connection 1",
+			"routes":     string(routesJSON),
+		},
+	})
+
+	mockEntry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+	mockEntry.EXPECT().Value().Return(stateBytes)
+
+	suite.mockStateKV.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(mockEntry, nil)
+}
+
+// expectModify sets up a successful nmcli modify and reapply.
+func (suite *NetworkManagerPublicTestSuite) expectModify(
+	args ...string,
+) {
+	suite.mockExec.EXPECT().
+		RunCmd("nmcli", gomock.Any()).
+		Return("", nil).
+		AnyTimes()
+
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd(
+			"nmcli",
+			append([]string{"connection", "modify", "Wired connection 1"}, args...),
+		).
+		Return("", nil)
+
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("nmcli", []string{"device", "reapply", "eth0"}).
+		Return("", nil)
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestList() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func([]route.ListEntry, error)
+	}{
+		{
+			name: "when nmcli returns routes",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show"}).
+					Return(nmcliDevicesWithRoutes, nil)
+			},
+			validateFunc: func(entries []route.ListEntry, err error) {
+				suite.Require().NoError(err)
+				suite.Equal([]route.ListEntry{
+					{Destination: "default", Gateway: "10.0.0.1", Interface: "eth0", Metric: 100},
+					{Destination: "10.0.0.0/24", Interface: "eth0", Metric: 100},
+					{Destination: "2001:db8::/64", Interface: "eth0", Metric: 256},
+				}, entries)
+			},
+		},
+		{
+			name: "when nmcli fails",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show"}).
+					Return("", errors.New("nmcli not found"))
+			},
+			validateFunc: func(entries []route.ListEntry, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "route list")
+				suite.Nil(entries)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			entries, err := suite.provider.List(suite.ctx)
+
+			tc.validateFunc(entries, err)
+		})
+	}
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestGet() {
+	tests := []struct {
+		name         string
+		ifaceName    string
+		setup        func()
+		validateFunc func(*route.Entry, error)
+	}{
+		{
+			name:      "when routes are managed",
+			ifaceName: "eth0",
+			setup: func() {
+				suite.expectState("abc", []route.Route{
+					{To: "10.1.0.0/16", Via: "10.0.0.1", Metric: 100},
+				})
+			},
+			validateFunc: func(entry *route.Entry, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("eth0", entry.Interface)
+				suite.Equal([]route.Route{
+					{To: "10.1.0.0/16", Via: "10.0.0.1", Metric: 100},
+				}, entry.Routes)
+			},
+		},
+		{
+			name:      "when routes are not managed",
+			ifaceName: "eth0",
+			setup: func() {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+			},
+			validateFunc: func(entry *route.Entry, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "not found")
+				suite.Nil(entry)
+			},
+		},
+		{
+			name:      "when route metadata is missing",
+			ifaceName: "eth0",
+			setup: func() {
+				stateBytes, _ := json.Marshal(job.FileState{
+					Metadata: map[string]string{"interface": "eth0"},
+				})
+				mockEntry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+				mockEntry.EXPECT().Value().Return(stateBytes)
+
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(mockEntry, nil)
+			},
+			validateFunc: func(entry *route.Entry, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "no route metadata")
+				suite.Nil(entry)
+			},
+		},
+		{
+			name:      "when route metadata is malformed",
+			ifaceName: "eth0",
+			setup: func() {
+				stateBytes, _ := json.Marshal(job.FileState{
+					Metadata: map[string]string{"routes": "not json"},
+				})
+				mockEntry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+				mockEntry.EXPECT().Value().Return(stateBytes)
+
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(mockEntry, nil)
+			},
+			validateFunc: func(entry *route.Entry, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "unmarshal routes")
+				suite.Nil(entry)
+			},
+		},
+		{
+			name:      "when interface name is empty",
+			ifaceName: "",
+			setup:     func() {},
+			validateFunc: func(entry *route.Entry, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "must not be empty")
+				suite.Nil(entry)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			entry, err := suite.provider.Get(suite.ctx, tc.ifaceName)
+
+			tc.validateFunc(entry, err)
+		})
+	}
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestCreate() {
+	tests := []struct {
+		name         string
+		entry        route.Entry
+		setup        func()
+		validateFunc func(*route.Result, error)
+	}{
+		{
+			name: "when routes are added successfully",
+			entry: route.Entry{
+				Interface: "eth0",
+				Routes: []route.Route{
+					{To: "10.1.0.0/16", Via: "10.0.0.1", Metric: 100},
+					{To: "10.2.0.0/16", Via: "10.0.0.1"},
+					{To: "2001:db8:1::/48", Via: "2001:db8::1"},
+				},
+			},
+			setup: func() {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+					Return(nmcliDevicesWithRoutes, nil)
+
+				suite.expectModify(
+					"+ipv4.routes", "10.1.0.0/16 10.0.0.1 100, 10.2.0.0/16 10.0.0.1",
+					"+ipv6.routes", "2001:db8:1::/48 2001:db8::1",
+				)
+
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) (uint64, error) {
+						var state job.FileState
+						suite.Require().NoError(json.Unmarshal(data, &state))
+						suite.Equal("nmcli:eth0/routes", state.Path)
+						suite.Equal("Wired connection 1", state.Metadata["connection"])
+
+						return uint64(1), nil
+					})
+			},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("eth0", result.Interface)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name: "when routes already managed returns unchanged",
+			entry: route.Entry{
+				Interface: "eth0",
+				Routes:    []route.Route{{To: "10.1.0.0/16", Via: "10.0.0.1"}},
+			},
+			setup: func() {
+				suite.expectState("abc", []route.Route{{To: "10.1.0.0/16", Via: "10.0.0.1"}})
+			},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name: "when default route is requested",
+			entry: route.Entry{
+				Interface: "eth0",
+				Routes:    []route.Route{{To: "0.0.0.0/0", Via: "10.0.0.1"}},
+			},
+			setup: func() {},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "default route")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when interface name is invalid",
+			entry: route.Entry{
+				Interface: "eth0;rm",
+			},
+			setup: func() {},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "route create")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when interface has no active connection",
+			entry: route.Entry{
+				Interface: "eth0",
+				Routes:    []route.Route{{To: "10.1.0.0/16", Via: "10.0.0.1"}},
+			},
+			setup: func() {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+					Return("GENERAL.DEVICE:eth0\nGENERAL.CONNECTION:--\n", nil)
+			},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "no active connection")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when marshal metadata fails",
+			entry: route.Entry{
+				Interface: "eth0",
+				Routes:    []route.Route{{To: "10.1.0.0/16", Via: "10.0.0.1"}},
+			},
+			setup: func() {
+				route.SetMarshalJSON(func(interface{}) ([]byte, error) {
+					return nil, errors.New("marshal failed")
+				})
+
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+					Return(nmcliDevicesWithRoutes, nil)
+			},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "marshal routes")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when reapply fails",
+			entry: route.Entry{
+				Interface: "eth0",
+				Routes:    []route.Route{{To: "10.1.0.0/16", Via: "10.0.0.1"}},
+			},
+			setup: func() {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+					Return(nmcliDevicesWithRoutes, nil)
+
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-g", "ipv4.routes", "connection", "show", "Wired connection 1"}).
+					Return("", nil)
+
+				gomock.InOrder(
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("nmcli", []string{
+							"connection", "modify", "Wired connection 1",
+							"+ipv4.routes", "10.1.0.0/16 10.0.0.1",
+						}).
+						Return("", nil),
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("nmcli", []string{"device", "reapply", "eth0"}).
+						Return("", errors.New("reapply failed")),
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("nmcli", []string{
+							"connection", "modify", "Wired connection 1",
+							"ipv4.routes", "",
+						}).
+						Return("", nil),
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("nmcli", []string{"device", "reapply", "eth0"}).
+						Return("", nil),
+				)
+			},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "rolled back")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Create(suite.ctx, tc.entry)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestUpdate() {
+	previous := []route.Route{{To: "10.1.0.0/16", Via: "10.0.0.1"}}
+
+	tests := []struct {
+		name         string
+		entry        route.Entry
+		setup        func()
+		validateFunc func(*route.Result, error)
+	}{
+		{
+			name: "when routes are replaced successfully",
+			entry: route.Entry{
+				Interface: "eth0",
+				Routes:    []route.Route{{To: "10.2.0.0/16", Via: "10.0.0.1", Metric: 50}},
+			},
+			setup: func() {
+				suite.expectState("abc", previous)
+
+				suite.expectModify(
+					"-ipv4.routes", "10.1.0.0/16 10.0.0.1",
+					"+ipv4.routes", "10.2.0.0/16 10.0.0.1 50",
+				)
+
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(1), nil)
+			},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name: "when routes are unchanged",
+			entry: route.Entry{
+				Interface: "eth0",
+				Routes:    previous,
+			},
+			setup: func() {
+				// SHA of "10.1.0.0/16 10.0.0.1".
+				suite.expectState(
+					"3a4f78097dd1c9db1f01b8ca95288c1cef8df6b17458ee57d0cf86e9dd47fd06",
+					previous,
+				)
+			},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name: "when routes not managed",
+			entry: route.Entry{
+				Interface: "eth0",
+				Routes:    previous,
+			},
+			setup: func() {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+			},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "not managed")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when default route is requested",
+			entry: route.Entry{
+				Interface: "eth0",
+				Routes:    []route.Route{{To: "default", Via: "10.0.0.1"}},
+			},
+			setup: func() {},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "default route")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when interface name is invalid",
+			entry: route.Entry{
+				Interface: "",
+			},
+			setup: func() {},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "route update")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when previous route metadata is malformed",
+			entry: route.Entry{
+				Interface: "eth0",
+				Routes:    []route.Route{{To: "10.2.0.0/16", Via: "10.0.0.1"}},
+			},
+			setup: func() {
+				stateBytes, _ := json.Marshal(job.FileState{
+					Metadata: map[string]string{"routes": "not json"},
+				})
+				mockEntry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+				mockEntry.EXPECT().Value().Return(stateBytes)
+
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(mockEntry, nil)
+			},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "unmarshal routes")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Update(suite.ctx, tc.entry)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestDelete() {
+	tests := []struct {
+		name         string
+		ifaceName    string
+		setup        func()
+		validateFunc func(*route.Result, error)
+	}{
+		{
+			name:      "when managed routes are removed",
+			ifaceName: "eth0",
+			setup: func() {
+				suite.expectState("abc", []route.Route{
+					{To: "10.1.0.0/16", Via: "10.0.0.1"},
+					{To: "2001:db8:1::/48", Via: "2001:db8::1", Metric: 10},
+				})
+
+				suite.expectModify(
+					"-ipv4.routes", "10.1.0.0/16 10.0.0.1",
+					"-ipv6.routes", "2001:db8:1::/48 2001:db8::1 10",
+				)
+
+				// MarkRemoved re-reads and updates the state.
+				suite.expectState("abc", nil)
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(1), nil)
+			},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:      "when routes not managed",
+			ifaceName: "eth0",
+			setup: func() {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+			},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:      "when interface name is empty",
+			ifaceName: "",
+			setup:     func() {},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "must not be empty")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:      "when route metadata is malformed",
+			ifaceName: "eth0",
+			setup: func() {
+				stateBytes, _ := json.Marshal(job.FileState{
+					Metadata: map[string]string{"routes": "not json"},
+				})
+				mockEntry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+				mockEntry.EXPECT().Value().Return(stateBytes)
+
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(mockEntry, nil)
+			},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "route delete")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:      "when nmcli modify fails",
+			ifaceName: "eth0",
+			setup: func() {
+				suite.expectState("abc", []route.Route{{To: "10.1.0.0/16", Via: "10.0.0.1"}})
+
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", gomock.Any()).
+					Return("", nil)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", gomock.Any()).
+					Return("", errors.New("unknown connection"))
+			},
+			validateFunc: func(result *route.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "route delete")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Delete(suite.ctx, tc.ifaceName)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func TestNetworkManagerPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(NetworkManagerPublicTestSuite))
+}
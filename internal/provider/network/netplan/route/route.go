@@ -178,6 +178,7 @@ func (d *Debian) Create(
 		d.fs,
 		d.stateKV,
 		d.execManager,
+		d.guard,
 		d.hostname,
 		path,
 		content,
@@ -240,6 +241,7 @@ func (d *Debian) Update(
 		d.fs,
 		d.stateKV,
 		d.execManager,
+		d.guard,
 		d.hostname,
 		path,
 		content,
@@ -286,6 +288,7 @@ func (d *Debian) Delete(
 		d.fs,
 		d.stateKV,
 		d.execManager,
+		d.guard,
 		d.hostname,
 		path,
 	)
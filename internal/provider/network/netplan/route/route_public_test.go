@@ -117,6 +117,7 @@ func (suite *RoutePublicTestSuite) SetupTest() {
 		suite.mockStateKV,
 		suite.mockExec,
 		testHostname,
+		nil,
 	)
 }
 
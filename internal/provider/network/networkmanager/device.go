@@ -0,0 +1,327 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package networkmanager
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/exec"
+)
+
+// Device represents one device from `nmcli device show`.
+type Device struct {
+	Name       string
+	Type       string
+	MACAddress string
+	MTU        int
+	Connection string
+	Addresses  []string
+	Gateway4   string
+	Gateway6   string
+	Routes     []DeviceRoute
+	DNS        []string
+	Domains    []string
+	DHCP4      bool
+	DHCP6      bool
+}
+
+// DeviceRoute represents a single route reported for a device.
+type DeviceRoute struct {
+	To     string
+	Via    string
+	Metric int
+}
+
+// GetDevices runs "nmcli -t device show" and parses the output for all
+// devices, in the order nmcli reports them.
+func GetDevices(
+	execManager exec.Manager,
+) ([]Device, error) {
+	output, err := execManager.RunCmd("nmcli", []string{"-t", "device", "show"})
+	if err != nil {
+		return nil, fmt.Errorf("nmcli device show: %w", err)
+	}
+
+	return parseDevices(output), nil
+}
+
+// GetDevice runs "nmcli -t device show <name>" and parses the output.
+// Returns an error if the device does not exist.
+func GetDevice(
+	execManager exec.Manager,
+	name string,
+) (*Device, error) {
+	output, err := execManager.RunCmd("nmcli", []string{"-t", "device", "show", name})
+	if err != nil {
+		return nil, fmt.Errorf("device %q: not found", name)
+	}
+
+	devices := parseDevices(output)
+	if len(devices) == 0 {
+		return nil, fmt.Errorf("device %q: not found", name)
+	}
+
+	return &devices[0], nil
+}
+
+// ActiveConnection returns the name of the connection profile active on
+// a device. Returns an error if the device has no active connection.
+func ActiveConnection(
+	execManager exec.Manager,
+	device string,
+) (string, error) {
+	d, err := GetDevice(execManager, device)
+	if err != nil {
+		return "", err
+	}
+
+	if d.Connection == "" {
+		return "", fmt.Errorf("device %q: no active connection", device)
+	}
+
+	return d.Connection, nil
+}
+
+// ConnectionTypeForInterface returns the keyfile connection type for an
+// interface by querying NetworkManager. Falls back to "ethernet" if the
+// device type cannot be determined.
+func ConnectionTypeForInterface(
+	execManager exec.Manager,
+	interfaceName string,
+) string {
+	d, err := GetDevice(execManager, interfaceName)
+	if err != nil {
+		return "ethernet"
+	}
+
+	switch d.Type {
+	case "wifi", "bridge", "bond", "vlan":
+		return d.Type
+	default:
+		return "ethernet"
+	}
+}
+
+// IPv4 returns the first IPv4 address (without prefix) or empty string.
+func (d Device) IPv4() string {
+	for _, addr := range d.Addresses {
+		if ip, _, err := net.ParseCIDR(addr); err == nil && ip.To4() != nil {
+			return ip.String()
+		}
+	}
+
+	return ""
+}
+
+// IPv6 returns the first global IPv6 address (without prefix) or empty
+// string. Link-local addresses are skipped.
+func (d Device) IPv6() string {
+	for _, addr := range d.Addresses {
+		ip, _, err := net.ParseCIDR(addr)
+		if err != nil || ip.To4() != nil || ip.IsLinkLocalUnicast() {
+			continue
+		}
+
+		return ip.String()
+	}
+
+	return ""
+}
+
+// AddressFamily returns "inet", "inet6", or "dual" based on the
+// addresses present.
+func (d Device) AddressFamily() string {
+	hasV4 := d.IPv4() != ""
+	hasV6 := d.IPv6() != ""
+
+	switch {
+	case hasV4 && hasV6:
+		return "dual"
+	case hasV6:
+		return "inet6"
+	default:
+		return "inet"
+	}
+}
+
+// HasDefaultRoute returns true if the device carries a default route.
+func (d Device) HasDefaultRoute() bool {
+	for _, r := range d.Routes {
+		if r.To == "default" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsDHCP returns true if the device obtained its configuration via
+// DHCPv4 or DHCPv6.
+func (d Device) IsDHCP() bool {
+	return d.DHCP4 || d.DHCP6
+}
+
+// parseDevices parses terse `nmcli device show` output. Each device is a
+// block of KEY:VALUE lines starting with GENERAL.DEVICE.
+func parseDevices(
+	output string,
+) []Device {
+	var devices []Device
+	var current *Device
+
+	for _, line := range strings.Split(output, "\n") {
+		key, value, ok := splitTerse(line)
+		if !ok {
+			continue
+		}
+
+		if key == "GENERAL.DEVICE" {
+			devices = append(devices, Device{Name: value})
+			current = &devices[len(devices)-1]
+
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		field, _, _ := strings.Cut(key, "[")
+
+		switch field {
+		case "GENERAL.TYPE":
+			current.Type = value
+		case "GENERAL.HWADDR":
+			current.MACAddress = strings.ToLower(value)
+		case "GENERAL.MTU":
+			current.MTU, _ = strconv.Atoi(value)
+		case "GENERAL.CONNECTION":
+			if value != "--" {
+				current.Connection = value
+			}
+		case "IP4.ADDRESS", "IP6.ADDRESS":
+			current.Addresses = append(current.Addresses, value)
+		case "IP4.GATEWAY":
+			current.Gateway4 = value
+		case "IP6.GATEWAY":
+			current.Gateway6 = value
+		case "IP4.ROUTE", "IP6.ROUTE":
+			if r, ok := parseRoute(value); ok {
+				current.Routes = append(current.Routes, r)
+			}
+		case "IP4.DNS", "IP6.DNS":
+			current.DNS = append(current.DNS, value)
+		case "IP4.DOMAIN", "IP6.DOMAIN":
+			current.Domains = append(current.Domains, value)
+		case "DHCP4.OPTION":
+			current.DHCP4 = true
+		case "DHCP6.OPTION":
+			current.DHCP6 = true
+		}
+	}
+
+	return devices
+}
+
+// parseRoute parses a route value such as
+// "dst = 10.0.0.0/8, nh = 192.168.1.1, mt = 100". Unspecified next hops
+// are reported as an empty gateway and default destinations as "default",
+// matching the Netplan backend.
+func parseRoute(
+	value string,
+) (DeviceRoute, bool) {
+	var r DeviceRoute
+
+	for _, part := range strings.Split(value, ",") {
+		k, v, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+
+		k = strings.TrimSpace(k)
+		v = strings.TrimSpace(v)
+
+		switch k {
+		case "dst":
+			r.To = v
+		case "nh":
+			r.Via = v
+		case "mt":
+			r.Metric, _ = strconv.Atoi(v)
+		}
+	}
+
+	if r.To == "" {
+		return r, false
+	}
+
+	if r.To == "0.0.0.0/0" || r.To == "::/0" {
+		r.To = "default"
+	}
+
+	if r.Via == "0.0.0.0" || r.Via == "::" {
+		r.Via = ""
+	}
+
+	return r, true
+}
+
+// splitTerse splits a terse nmcli line on the first unescaped colon and
+// unescapes the value. In terse mode nmcli escapes ":" and "\" in values
+// with a backslash.
+func splitTerse(
+	line string,
+) (string, string, bool) {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case ':':
+			return line[:i], unescape(line[i+1:]), true
+		}
+	}
+
+	return "", "", false
+}
+
+// unescape removes the backslash escaping nmcli applies to ":" and "\"
+// in terse output.
+func unescape(
+	value string,
+) string {
+	if !strings.Contains(value, `\`) {
+		return value
+	}
+
+	var b strings.Builder
+
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) {
+			i++
+		}
+		b.WriteByte(value[i])
+	}
+
+	return b.String()
+}
@@ -0,0 +1,453 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package networkmanager_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/provider/network/networkmanager"
+)
+
+// nmcliDeviceShow is a trimmed version of real `nmcli -t device show`
+// output used across the device tests.
+const nmcliDeviceShow = `GENERAL.DEVICE:eth0
+GENERAL.TYPE:ethernet
+GENERAL.HWADDR:52\:54\:00\:12\:34\:56
+GENERAL.MTU:1500
+GENERAL.STATE:100 (connected)
+GENERAL.CONNECTION:Wired connection 1
+IP4.ADDRESS[1]:192.168.1.10/24
+IP4.GATEWAY:192.168.1.1
+IP4.ROUTE[1]:dst = 0.0.0.0/0, nh = 192.168.1.1, mt = 100
+IP4.ROUTE[2]:dst = 192.168.1.0/24, nh = 0.0.0.0, mt = 100
+IP4.DNS[1]:192.168.1.1
+IP4.DOMAIN[1]:example.com
+DHCP4.OPTION[1]:dhcp_lease_time = 86400
+IP6.ADDRESS[1]:2001\:db8\:\:10/64
+IP6.ADDRESS[2]:fe80\:\:5054\:ff\:fe12\:3456/64
+IP6.GATEWAY:
+IP6.ROUTE[1]:dst = fe80\:\:/64, nh = \:\:, mt = 1024
+
+GENERAL.DEVICE:lo
+GENERAL.TYPE:loopback
+GENERAL.HWADDR:00\:00\:00\:00\:00\:00
+GENERAL.MTU:65536
+GENERAL.STATE:100 (connected (externally))
+GENERAL.CONNECTION:lo
+IP4.ADDRESS[1]:127.0.0.1/8
+
+GENERAL.DEVICE:wlan0
+GENERAL.TYPE:wifi
+GENERAL.HWADDR:B0\:A4\:60\:17\:CB\:90
+GENERAL.MTU:1500
+GENERAL.STATE:30 (disconnected)
+GENERAL.CONNECTION:--
+`
+
+type DevicePublicTestSuite struct {
+	suite.Suite
+
+	ctrl     *gomock.Controller
+	mockExec *execmocks.MockManager
+}
+
+func (suite *DevicePublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+}
+
+func (suite *DevicePublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *DevicePublicTestSuite) TearDownSubTest() {}
+
+func (suite *DevicePublicTestSuite) TestGetDevices() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func([]networkmanager.Device, error)
+	}{
+		{
+			name: "when nmcli returns devices",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show"}).
+					Return(nmcliDeviceShow, nil)
+			},
+			validateFunc: func(devices []networkmanager.Device, err error) {
+				suite.Require().NoError(err)
+				suite.Require().Len(devices, 3)
+
+				eth0 := devices[0]
+				suite.Equal("eth0", eth0.Name)
+				suite.Equal("ethernet", eth0.Type)
+				suite.Equal("52:54:00:12:34:56", eth0.MACAddress)
+				suite.Equal(1500, eth0.MTU)
+				suite.Equal("Wired connection 1", eth0.Connection)
+				suite.Equal([]string{
+					"192.168.1.10/24",
+					"2001:db8::10/64",
+					"fe80::5054:ff:fe12:3456/64",
+				}, eth0.Addresses)
+				suite.Equal("192.168.1.1", eth0.Gateway4)
+				suite.Empty(eth0.Gateway6)
+				suite.Equal([]networkmanager.DeviceRoute{
+					{To: "default", Via: "192.168.1.1", Metric: 100},
+					{To: "192.168.1.0/24", Metric: 100},
+					{To: "fe80::/64", Metric: 1024},
+				}, eth0.Routes)
+				suite.Equal([]string{"192.168.1.1"}, eth0.DNS)
+				suite.Equal([]string{"example.com"}, eth0.Domains)
+				suite.True(eth0.DHCP4)
+				suite.False(eth0.DHCP6)
+
+				suite.Equal("loopback", devices[1].Type)
+
+				wlan0 := devices[2]
+				suite.Equal("wifi", wlan0.Type)
+				suite.Equal("b0:a4:60:17:cb:90", wlan0.MACAddress)
+				suite.Empty(wlan0.Connection)
+			},
+		},
+		{
+			name: "when nmcli fails",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show"}).
+					Return("", errors.New("nmcli not found"))
+			},
+			validateFunc: func(devices []networkmanager.Device, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "nmcli device show")
+				suite.Nil(devices)
+			},
+		},
+		{
+			name: "when output has lines before the first device",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show"}).
+					Return("GENERAL.TYPE:ethernet\nnot a field\nGENERAL.DEVICE:eth1\n", nil)
+			},
+			validateFunc: func(devices []networkmanager.Device, err error) {
+				suite.Require().NoError(err)
+				suite.Require().Len(devices, 1)
+				suite.Equal("eth1", devices[0].Name)
+				suite.Empty(devices[0].Type)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			devices, err := networkmanager.GetDevices(suite.mockExec)
+
+			tc.validateFunc(devices, err)
+		})
+	}
+}
+
+func (suite *DevicePublicTestSuite) TestGetDevice() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func(*networkmanager.Device, error)
+	}{
+		{
+			name: "when device exists",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+					Return(nmcliDeviceShow, nil)
+			},
+			validateFunc: func(d *networkmanager.Device, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("eth0", d.Name)
+			},
+		},
+		{
+			name: "when nmcli fails",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+					Return("Error: Device 'eth0' not found.", errors.New("exit status 10"))
+			},
+			validateFunc: func(d *networkmanager.Device, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "not found")
+				suite.Nil(d)
+			},
+		},
+		{
+			name: "when output is empty",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+					Return("", nil)
+			},
+			validateFunc: func(d *networkmanager.Device, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "not found")
+				suite.Nil(d)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			d, err := networkmanager.GetDevice(suite.mockExec, "eth0")
+
+			tc.validateFunc(d, err)
+		})
+	}
+}
+
+func (suite *DevicePublicTestSuite) TestActiveConnection() {
+	tests := []struct {
+		name         string
+		device       string
+		setup        func()
+		validateFunc func(string, error)
+	}{
+		{
+			name:   "when device has an active connection",
+			device: "eth0",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+					Return(nmcliDeviceShow, nil)
+			},
+			validateFunc: func(conn string, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("Wired connection 1", conn)
+			},
+		},
+		{
+			name:   "when device has no active connection",
+			device: "wlan0",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "wlan0"}).
+					Return("GENERAL.DEVICE:wlan0\nGENERAL.CONNECTION:--\n", nil)
+			},
+			validateFunc: func(conn string, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "no active connection")
+				suite.Empty(conn)
+			},
+		},
+		{
+			name:   "when device does not exist",
+			device: "eth9",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-t", "device", "show", "eth9"}).
+					Return("", errors.New("exit status 10"))
+			},
+			validateFunc: func(conn string, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "not found")
+				suite.Empty(conn)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			conn, err := networkmanager.ActiveConnection(suite.mockExec, tc.device)
+
+			tc.validateFunc(conn, err)
+		})
+	}
+}
+
+func (suite *DevicePublicTestSuite) TestConnectionTypeForInterface() {
+	tests := []struct {
+		name   string
+		output string
+		err    error
+		want   string
+	}{
+		{
+			name:   "when device is ethernet",
+			output: "GENERAL.DEVICE:eth0\nGENERAL.TYPE:ethernet\n",
+			want:   "ethernet",
+		},
+		{
+			name:   "when device is wifi",
+			output: "GENERAL.DEVICE:eth0\nGENERAL.TYPE:wifi\n",
+			want:   "wifi",
+		},
+		{
+			name:   "when device is a bridge",
+			output: "GENERAL.DEVICE:eth0\nGENERAL.TYPE:bridge\n",
+			want:   "bridge",
+		},
+		{
+			name:   "when device type is unsupported",
+			output: "GENERAL.DEVICE:eth0\nGENERAL.TYPE:tun\n",
+			want:   "ethernet",
+		},
+		{
+			name: "when device does not exist",
+			err:  errors.New("exit status 10"),
+			want: "ethernet",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.mockExec.EXPECT().
+				RunCmd("nmcli", []string{"-t", "device", "show", "eth0"}).
+				Return(tc.output, tc.err)
+
+			got := networkmanager.ConnectionTypeForInterface(suite.mockExec, "eth0")
+
+			suite.Equal(tc.want, got)
+		})
+	}
+}
+
+func (suite *DevicePublicTestSuite) TestDeviceAddresses() {
+	tests := []struct {
+		name       string
+		device     networkmanager.Device
+		wantIPv4   string
+		wantIPv6   string
+		wantFamily string
+	}{
+		{
+			name: "when device has IPv4 and global IPv6",
+			device: networkmanager.Device{
+				Addresses: []string{"192.168.1.10/24", "fe80::1/64", "2001:db8::10/64"},
+			},
+			wantIPv4:   "192.168.1.10",
+			wantIPv6:   "2001:db8::10",
+			wantFamily: "dual",
+		},
+		{
+			name: "when device has only link-local IPv6",
+			device: networkmanager.Device{
+				Addresses: []string{"192.168.1.10/24", "fe80::1/64"},
+			},
+			wantIPv4:   "192.168.1.10",
+			wantFamily: "inet",
+		},
+		{
+			name: "when device has only IPv6",
+			device: networkmanager.Device{
+				Addresses: []string{"2001:db8::10/64"},
+			},
+			wantIPv6:   "2001:db8::10",
+			wantFamily: "inet6",
+		},
+		{
+			name: "when addresses are malformed",
+			device: networkmanager.Device{
+				Addresses: []string{"not-an-address"},
+			},
+			wantFamily: "inet",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.Equal(tc.wantIPv4, tc.device.IPv4())
+			suite.Equal(tc.wantIPv6, tc.device.IPv6())
+			suite.Equal(tc.wantFamily, tc.device.AddressFamily())
+		})
+	}
+}
+
+func (suite *DevicePublicTestSuite) TestDeviceHasDefaultRoute() {
+	tests := []struct {
+		name   string
+		device networkmanager.Device
+		want   bool
+	}{
+		{
+			name: "when device has a default route",
+			device: networkmanager.Device{
+				Routes: []networkmanager.DeviceRoute{{To: "default", Via: "192.168.1.1"}},
+			},
+			want: true,
+		},
+		{
+			name: "when device has no default route",
+			device: networkmanager.Device{
+				Routes: []networkmanager.DeviceRoute{{To: "10.0.0.0/8"}},
+			},
+			want: false,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.Equal(tc.want, tc.device.HasDefaultRoute())
+		})
+	}
+}
+
+func (suite *DevicePublicTestSuite) TestDeviceIsDHCP() {
+	tests := []struct {
+		name   string
+		device networkmanager.Device
+		want   bool
+	}{
+		{
+			name:   "when DHCPv4 is active",
+			device: networkmanager.Device{DHCP4: true},
+			want:   true,
+		},
+		{
+			name:   "when DHCPv6 is active",
+			device: networkmanager.Device{DHCP6: true},
+			want:   true,
+		},
+		{
+			name:   "when addresses are static",
+			device: networkmanager.Device{},
+			want:   false,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.Equal(tc.want, tc.device.IsDHCP())
+		})
+	}
+}
+
+func TestDevicePublicTestSuite(t *testing.T) {
+	suite.Run(t, new(DevicePublicTestSuite))
+}
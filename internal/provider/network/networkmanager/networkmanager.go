@@ -0,0 +1,380 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package networkmanager provides shared helpers for managing network
+// configuration through NetworkManager: keyfile connection profiles,
+// nmcli connection settings with rollback, and file-state tracking.
+package networkmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/avfs/avfs"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/osapi-io/osapi/internal/exec"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/file"
+	"github.com/osapi-io/osapi/internal/provider/network/netplan"
+)
+
+const (
+	// ConnectionsDir is the directory NetworkManager loads keyfile
+	// connection profiles from.
+	ConnectionsDir = "/etc/NetworkManager/system-connections"
+	// KeyfileExt is the extension NetworkManager requires for keyfiles.
+	KeyfileExt = ".nmconnection"
+	// statePathPrefix marks synthetic state paths for settings that are
+	// applied with nmcli rather than written to a file.
+	statePathPrefix = "nmcli:"
+)
+
+// marshalJSON is a package-level variable for testing the marshal error path.
+var marshalJSON = json.Marshal
+
+// Setting is a single connection property passed to
+// `nmcli connection modify`. A leading "+" or "-" on the property
+// appends to or removes from a list property.
+type Setting struct {
+	Property string `json:"property"`
+	Value    string `json:"value"`
+}
+
+// KeyfilePath returns the keyfile path for a connection profile id.
+func KeyfilePath(
+	id string,
+) string {
+	return ConnectionsDir + "/" + id + KeyfileExt
+}
+
+// StatePath returns the synthetic path used to track nmcli-managed
+// settings of a kind (e.g., "routes", "dns") for a device in the
+// file-state KV.
+func StatePath(
+	kind string,
+	device string,
+) string {
+	return statePathPrefix + device + "/" + kind
+}
+
+// ApplyKeyfile writes a keyfile connection profile to disk, validates it
+// with `nmcli connection load`, activates it with `nmcli connection up`,
+// and tracks the file state in the KV store. The connection id is the
+// keyfile name without its extension. Returns (true, nil) when the
+// profile was written and activated, (false, nil) when the content is
+// unchanged.
+func ApplyKeyfile(
+	ctx context.Context,
+	logger *slog.Logger,
+	fs avfs.VFS,
+	stateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+	path string,
+	content []byte,
+	metadata map[string]string,
+) (bool, error) {
+	sha := netplan.ComputeSHA256(content)
+
+	// Check for idempotency: if SHA matches and file exists, skip.
+	if state := GetState(ctx, stateKV, hostname, path); state != nil && state.SHA256 == sha {
+		if _, statErr := fs.Stat(path); statErr == nil {
+			logger.Debug(
+				"networkmanager keyfile unchanged, skipping deploy",
+				slog.String("path", path),
+			)
+
+			return false, nil
+		}
+	}
+
+	// Ensure the parent directory exists.
+	if mkErr := fs.MkdirAll(filepath.Dir(path), 0o755); mkErr != nil {
+		return false, fmt.Errorf("networkmanager apply: create directory: %w", mkErr)
+	}
+
+	// NetworkManager ignores keyfiles readable by other users.
+	if writeErr := fs.WriteFile(path, content, 0o600); writeErr != nil {
+		return false, fmt.Errorf("networkmanager apply: write file: %w", writeErr)
+	}
+
+	// Validate by loading the profile; nmcli rejects malformed keyfiles.
+	if _, loadErr := execManager.RunPrivilegedCmd(
+		"nmcli",
+		[]string{"connection", "load", path},
+	); loadErr != nil {
+		// Roll back: remove the invalid file.
+		_ = fs.Remove(path)
+
+		return false, fmt.Errorf(
+			"networkmanager validate failed (file rolled back): %w",
+			loadErr,
+		)
+	}
+
+	id := strings.TrimSuffix(filepath.Base(path), KeyfileExt)
+	if _, upErr := execManager.RunPrivilegedCmd(
+		"nmcli",
+		[]string{"connection", "up", "id", id},
+	); upErr != nil {
+		return false, fmt.Errorf("networkmanager apply: %w", upErr)
+	}
+
+	if putErr := PutState(ctx, stateKV, hostname, path, sha, "0600", metadata); putErr != nil {
+		return false, fmt.Errorf("networkmanager apply: %w", putErr)
+	}
+
+	logger.Info(
+		"networkmanager keyfile deployed",
+		slog.String("path", path),
+		slog.String("sha256", sha),
+	)
+
+	return true, nil
+}
+
+// RemoveKeyfile removes a keyfile connection profile from disk, reloads
+// the NetworkManager connection profiles, and marks the file state as
+// undeployed in the KV store. Returns (true, nil) when the file was
+// removed, (false, nil) when the file does not exist.
+func RemoveKeyfile(
+	ctx context.Context,
+	logger *slog.Logger,
+	fs avfs.VFS,
+	stateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+	path string,
+) (bool, error) {
+	// Check if file exists — if not, nothing to do.
+	if _, err := fs.Stat(path); err != nil {
+		return false, nil
+	}
+
+	if removeErr := fs.Remove(path); removeErr != nil {
+		return false, fmt.Errorf("networkmanager remove: remove file: %w", removeErr)
+	}
+
+	// Reloading drops the profile; the device falls back to the next
+	// profile that can autoconnect.
+	if _, reloadErr := execManager.RunPrivilegedCmd(
+		"nmcli",
+		[]string{"connection", "reload"},
+	); reloadErr != nil {
+		return false, fmt.Errorf("networkmanager remove: reload: %w", reloadErr)
+	}
+
+	MarkRemoved(ctx, logger, stateKV, hostname, path)
+
+	logger.Info(
+		"networkmanager keyfile removed",
+		slog.String("path", path),
+	)
+
+	return true, nil
+}
+
+// ModifyConnection applies settings to a connection profile with
+// `nmcli connection modify` and activates them on the device with
+// `nmcli device reapply`. If the reapply fails, the previous values of
+// the modified properties are restored and reapplied so the device keeps
+// its last working configuration. Returns the previous values so
+// callers can restore them later.
+func ModifyConnection(
+	execManager exec.Manager,
+	connection string,
+	device string,
+	settings []Setting,
+) ([]Setting, error) {
+	previous, err := snapshotSettings(execManager, connection, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, modifyErr := execManager.RunPrivilegedCmd(
+		"nmcli",
+		modifyArgs(connection, settings),
+	); modifyErr != nil {
+		return nil, fmt.Errorf("nmcli modify: %w", modifyErr)
+	}
+
+	if _, reapplyErr := execManager.RunPrivilegedCmd(
+		"nmcli",
+		[]string{"device", "reapply", device},
+	); reapplyErr != nil {
+		// Roll back to the snapshot; errors here are secondary to the
+		// reapply failure being reported.
+		_, _ = execManager.RunPrivilegedCmd("nmcli", modifyArgs(connection, previous))
+		_, _ = execManager.RunPrivilegedCmd("nmcli", []string{"device", "reapply", device})
+
+		return nil, fmt.Errorf(
+			"nmcli reapply failed (settings rolled back): %w",
+			reapplyErr,
+		)
+	}
+
+	return previous, nil
+}
+
+// snapshotSettings reads the current values of the properties touched by
+// settings so they can be restored as-is.
+func snapshotSettings(
+	execManager exec.Manager,
+	connection string,
+	settings []Setting,
+) ([]Setting, error) {
+	seen := make(map[string]bool, len(settings))
+	previous := make([]Setting, 0, len(settings))
+
+	for _, s := range settings {
+		property := strings.TrimLeft(s.Property, "+-")
+		if seen[property] {
+			continue
+		}
+		seen[property] = true
+
+		output, err := execManager.RunCmd(
+			"nmcli",
+			[]string{"-g", property, "connection", "show", connection},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("nmcli show %s: %w", property, err)
+		}
+
+		previous = append(previous, Setting{
+			Property: property,
+			Value:    unescape(strings.TrimSpace(output)),
+		})
+	}
+
+	return previous, nil
+}
+
+// modifyArgs builds the `nmcli connection modify` arguments.
+func modifyArgs(
+	connection string,
+	settings []Setting,
+) []string {
+	args := []string{"connection", "modify", connection}
+	for _, s := range settings {
+		args = append(args, s.Property, s.Value)
+	}
+
+	return args
+}
+
+// GetState returns the tracked state for a path, or nil when the path
+// is not managed (no entry, unreadable entry, or undeployed).
+func GetState(
+	ctx context.Context,
+	stateKV jetstream.KeyValue,
+	hostname string,
+	path string,
+) *job.FileState {
+	kvEntry, err := stateKV.Get(ctx, file.BuildStateKey(hostname, path))
+	if err != nil {
+		return nil
+	}
+
+	var state job.FileState
+	if unmarshalErr := json.Unmarshal(kvEntry.Value(), &state); unmarshalErr != nil {
+		return nil
+	}
+
+	if state.UndeployedAt != "" {
+		return nil
+	}
+
+	return &state
+}
+
+// PutState records a deployed path with its content hash and metadata
+// in the file-state KV.
+func PutState(
+	ctx context.Context,
+	stateKV jetstream.KeyValue,
+	hostname string,
+	path string,
+	sha string,
+	mode string,
+	metadata map[string]string,
+) error {
+	state := job.FileState{
+		Path:       path,
+		SHA256:     sha,
+		Mode:       mode,
+		DeployedAt: time.Now().UTC().Format(time.RFC3339),
+		Metadata:   metadata,
+	}
+
+	stateBytes, err := marshalJSON(state)
+	if err != nil {
+		return fmt.Errorf("marshal state: %w", err)
+	}
+
+	if _, putErr := stateKV.Put(ctx, file.BuildStateKey(hostname, path), stateBytes); putErr != nil {
+		return fmt.Errorf("update state: %w", putErr)
+	}
+
+	return nil
+}
+
+// MarkRemoved marks the state of a path as undeployed. Failures are
+// logged and otherwise ignored.
+func MarkRemoved(
+	ctx context.Context,
+	logger *slog.Logger,
+	stateKV jetstream.KeyValue,
+	hostname string,
+	path string,
+) {
+	stateKey := file.BuildStateKey(hostname, path)
+
+	kvEntry, err := stateKV.Get(ctx, stateKey)
+	if err != nil {
+		return
+	}
+
+	var state job.FileState
+	if unmarshalErr := json.Unmarshal(kvEntry.Value(), &state); unmarshalErr != nil {
+		return
+	}
+
+	state.UndeployedAt = time.Now().UTC().Format(time.RFC3339)
+
+	stateBytes, marshalErr := marshalJSON(state)
+	if marshalErr != nil {
+		return
+	}
+
+	if _, putErr := stateKV.Put(ctx, stateKey, stateBytes); putErr != nil {
+		logger.Warn(
+			"networkmanager: failed to update state",
+			slog.String("path", path),
+			slog.String("error", putErr.Error()),
+		)
+	}
+}
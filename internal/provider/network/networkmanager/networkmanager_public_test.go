@@ -0,0 +1,750 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package networkmanager_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/failfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider/network/netplan"
+	"github.com/osapi-io/osapi/internal/provider/network/networkmanager"
+)
+
+const (
+	testHostname = "test-host"
+	testPath     = "/etc/NetworkManager/system-connections/osapi-eth0.nmconnection"
+)
+
+var testContent = []byte(
+	"[connection]\nid=osapi-eth0\ntype=ethernet\ninterface-name=eth0\n",
+)
+
+type NetworkManagerPublicTestSuite struct {
+	suite.Suite
+
+	ctrl        *gomock.Controller
+	ctx         context.Context
+	logger      *slog.Logger
+	memFs       avfs.VFS
+	mockStateKV *jobmocks.MockKeyValue
+	mockExec    *execmocks.MockManager
+}
+
+func (suite *NetworkManagerPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.ctx = context.Background()
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+	suite.mockStateKV = jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+
+	_ = suite.memFs.MkdirAll(networkmanager.ConnectionsDir, 0o755)
+}
+
+func (suite *NetworkManagerPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *NetworkManagerPublicTestSuite) TearDownSubTest() {
+	networkmanager.ResetMarshalJSON()
+}
+
+func (suite *NetworkManagerPublicTestSuite) expectState(
+	state job.FileState,
+) {
+	stateBytes, _ := json.Marshal(state)
+
+	mockEntry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+	mockEntry.EXPECT().Value().Return(stateBytes)
+
+	suite.mockStateKV.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(mockEntry, nil)
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestKeyfilePath() {
+	suite.Equal(testPath, networkmanager.KeyfilePath("osapi-eth0"))
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestStatePath() {
+	suite.Equal("nmcli:eth0/routes", networkmanager.StatePath("routes", "eth0"))
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestApplyKeyfile() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func(bool, error)
+	}{
+		{
+			name: "when new keyfile deploys successfully",
+			setup: func() {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "load", testPath}).
+					Return("", nil)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "up", "id", "osapi-eth0"}).
+					Return("", nil)
+
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(1), nil)
+			},
+			validateFunc: func(changed bool, err error) {
+				suite.Require().NoError(err)
+				suite.True(changed)
+
+				data, readErr := suite.memFs.ReadFile(testPath)
+				suite.Require().NoError(readErr)
+				suite.Equal(testContent, data)
+
+				info, statErr := suite.memFs.Stat(testPath)
+				suite.Require().NoError(statErr)
+				suite.Equal(os.FileMode(0o600), info.Mode().Perm())
+			},
+		},
+		{
+			name: "when SHA matches and file exists (idempotent)",
+			setup: func() {
+				_ = suite.memFs.WriteFile(testPath, testContent, 0o600)
+
+				suite.expectState(job.FileState{
+					Path:   testPath,
+					SHA256: netplan.ComputeSHA256(testContent),
+				})
+			},
+			validateFunc: func(changed bool, err error) {
+				suite.Require().NoError(err)
+				suite.False(changed)
+			},
+		},
+		{
+			name: "when SHA matches but file missing (rewrites)",
+			setup: func() {
+				suite.expectState(job.FileState{
+					Path:   testPath,
+					SHA256: netplan.ComputeSHA256(testContent),
+				})
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "load", testPath}).
+					Return("", nil)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "up", "id", "osapi-eth0"}).
+					Return("", nil)
+
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(1), nil)
+			},
+			validateFunc: func(changed bool, err error) {
+				suite.Require().NoError(err)
+				suite.True(changed)
+			},
+		},
+		{
+			name: "when nmcli load fails (rolls back file)",
+			setup: func() {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "load", testPath}).
+					Return("", errors.New("invalid keyfile"))
+			},
+			validateFunc: func(changed bool, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "rolled back")
+				suite.False(changed)
+
+				_, statErr := suite.memFs.Stat(testPath)
+				suite.Error(statErr)
+			},
+		},
+		{
+			name: "when nmcli up fails",
+			setup: func() {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "load", testPath}).
+					Return("", nil)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "up", "id", "osapi-eth0"}).
+					Return("", errors.New("activation failed"))
+			},
+			validateFunc: func(changed bool, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "networkmanager apply")
+				suite.False(changed)
+			},
+		},
+		{
+			name: "when write file fails",
+			setup: func() {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+
+				baseFs := memfs.New()
+				_ = baseFs.MkdirAll(networkmanager.ConnectionsDir, 0o755)
+
+				vfs := failfs.New(baseFs)
+				_ = vfs.SetFailFunc(func(
+					_ avfs.VFSBase,
+					fn avfs.FnVFS,
+					_ *failfs.FailParam,
+				) error {
+					if fn == avfs.FnOpenFile {
+						return errors.New("write failed")
+					}
+
+					return nil
+				})
+				suite.memFs = vfs
+			},
+			validateFunc: func(changed bool, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "write file")
+				suite.False(changed)
+			},
+		},
+		{
+			name: "when create directory fails",
+			setup: func() {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+
+				vfs := failfs.New(memfs.New())
+				_ = vfs.SetFailFunc(func(
+					_ avfs.VFSBase,
+					fn avfs.FnVFS,
+					_ *failfs.FailParam,
+				) error {
+					if fn == avfs.FnMkdirAll {
+						return errors.New("mkdir failed")
+					}
+
+					return nil
+				})
+				suite.memFs = vfs
+			},
+			validateFunc: func(changed bool, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "create directory")
+				suite.False(changed)
+			},
+		},
+		{
+			name: "when marshal state fails",
+			setup: func() {
+				networkmanager.SetMarshalJSON(func(interface{}) ([]byte, error) {
+					return nil, errors.New("marshal failed")
+				})
+
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "load", testPath}).
+					Return("", nil)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "up", "id", "osapi-eth0"}).
+					Return("", nil)
+			},
+			validateFunc: func(changed bool, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "marshal state")
+				suite.False(changed)
+			},
+		},
+		{
+			name: "when KV put fails",
+			setup: func() {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "load", testPath}).
+					Return("", nil)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "up", "id", "osapi-eth0"}).
+					Return("", nil)
+
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(0), errors.New("kv unavailable"))
+			},
+			validateFunc: func(changed bool, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "update state")
+				suite.False(changed)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			changed, err := networkmanager.ApplyKeyfile(
+				suite.ctx,
+				suite.logger,
+				suite.memFs,
+				suite.mockStateKV,
+				suite.mockExec,
+				testHostname,
+				testPath,
+				testContent,
+				map[string]string{"interface": "eth0"},
+			)
+
+			tc.validateFunc(changed, err)
+		})
+	}
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestRemoveKeyfile() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func(bool, error)
+	}{
+		{
+			name: "when file exists and removal succeeds",
+			setup: func() {
+				_ = suite.memFs.WriteFile(testPath, testContent, 0o600)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "reload"}).
+					Return("", nil)
+
+				suite.expectState(job.FileState{Path: testPath})
+
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) (uint64, error) {
+						var state job.FileState
+						suite.Require().NoError(json.Unmarshal(data, &state))
+						suite.NotEmpty(state.UndeployedAt)
+
+						return uint64(1), nil
+					})
+			},
+			validateFunc: func(changed bool, err error) {
+				suite.Require().NoError(err)
+				suite.True(changed)
+
+				_, statErr := suite.memFs.Stat(testPath)
+				suite.Error(statErr)
+			},
+		},
+		{
+			name:  "when file does not exist",
+			setup: func() {},
+			validateFunc: func(changed bool, err error) {
+				suite.Require().NoError(err)
+				suite.False(changed)
+			},
+		},
+		{
+			name: "when nmcli reload fails",
+			setup: func() {
+				_ = suite.memFs.WriteFile(testPath, testContent, 0o600)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "reload"}).
+					Return("", errors.New("reload failed"))
+			},
+			validateFunc: func(changed bool, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "reload")
+				suite.False(changed)
+			},
+		},
+		{
+			name: "when remove file fails",
+			setup: func() {
+				baseFs := memfs.New()
+				_ = baseFs.MkdirAll(networkmanager.ConnectionsDir, 0o755)
+				_ = baseFs.WriteFile(testPath, testContent, 0o600)
+
+				vfs := failfs.New(baseFs)
+				_ = vfs.SetFailFunc(func(
+					_ avfs.VFSBase,
+					fn avfs.FnVFS,
+					_ *failfs.FailParam,
+				) error {
+					if fn == avfs.FnRemove {
+						return errors.New("remove failed")
+					}
+
+					return nil
+				})
+				suite.memFs = vfs
+			},
+			validateFunc: func(changed bool, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "remove file")
+				suite.False(changed)
+			},
+		},
+		{
+			name: "when state update fails (logs warning)",
+			setup: func() {
+				_ = suite.memFs.WriteFile(testPath, testContent, 0o600)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"connection", "reload"}).
+					Return("", nil)
+
+				suite.expectState(job.FileState{Path: testPath})
+
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(0), errors.New("kv unavailable"))
+			},
+			validateFunc: func(changed bool, err error) {
+				suite.Require().NoError(err)
+				suite.True(changed)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			changed, err := networkmanager.RemoveKeyfile(
+				suite.ctx,
+				suite.logger,
+				suite.memFs,
+				suite.mockStateKV,
+				suite.mockExec,
+				testHostname,
+				testPath,
+			)
+
+			tc.validateFunc(changed, err)
+		})
+	}
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestModifyConnection() {
+	settings := []networkmanager.Setting{
+		{Property: "+ipv4.routes", Value: "10.0.0.0/8 192.168.1.1"},
+		{Property: "-ipv4.routes", Value: "172.16.0.0/12 192.168.1.1"},
+		{Property: "ipv4.ignore-auto-dns", Value: "yes"},
+	}
+
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func([]networkmanager.Setting, error)
+	}{
+		{
+			name: "when modify and reapply succeed",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-g", "ipv4.routes", "connection", "show", "Wired"}).
+					Return("172.16.0.0/12 192.168.1.1\n", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-g", "ipv4.ignore-auto-dns", "connection", "show", "Wired"}).
+					Return("no\n", nil)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{
+						"connection", "modify", "Wired",
+						"+ipv4.routes", "10.0.0.0/8 192.168.1.1",
+						"-ipv4.routes", "172.16.0.0/12 192.168.1.1",
+						"ipv4.ignore-auto-dns", "yes",
+					}).
+					Return("", nil)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", []string{"device", "reapply", "eth0"}).
+					Return("", nil)
+			},
+			validateFunc: func(previous []networkmanager.Setting, err error) {
+				suite.Require().NoError(err)
+				suite.Equal([]networkmanager.Setting{
+					{Property: "ipv4.routes", Value: "172.16.0.0/12 192.168.1.1"},
+					{Property: "ipv4.ignore-auto-dns", Value: "no"},
+				}, previous)
+			},
+		},
+		{
+			name: "when reapply fails (restores previous settings)",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-g", "ipv4.routes", "connection", "show", "Wired"}).
+					Return("172.16.0.0/12 192.168.1.1\n", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-g", "ipv4.ignore-auto-dns", "connection", "show", "Wired"}).
+					Return("no\n", nil)
+
+				gomock.InOrder(
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("nmcli", []string{
+							"connection", "modify", "Wired",
+							"+ipv4.routes", "10.0.0.0/8 192.168.1.1",
+							"-ipv4.routes", "172.16.0.0/12 192.168.1.1",
+							"ipv4.ignore-auto-dns", "yes",
+						}).
+						Return("", nil),
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("nmcli", []string{"device", "reapply", "eth0"}).
+						Return("", errors.New("reapply failed")),
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("nmcli", []string{
+							"connection", "modify", "Wired",
+							"ipv4.routes", "172.16.0.0/12 192.168.1.1",
+							"ipv4.ignore-auto-dns", "no",
+						}).
+						Return("", nil),
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("nmcli", []string{"device", "reapply", "eth0"}).
+						Return("", nil),
+				)
+			},
+			validateFunc: func(previous []networkmanager.Setting, err error) {
+				suite.Require().Error(err)
+				suite.Nil(previous)
+				suite.Contains(err.Error(), "rolled back")
+			},
+		},
+		{
+			name: "when modify fails",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", gomock.Any()).
+					Return("", nil).
+					Times(2)
+
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("nmcli", gomock.Any()).
+					Return("Error: invalid route", errors.New("exit status 2"))
+			},
+			validateFunc: func(previous []networkmanager.Setting, err error) {
+				suite.Require().Error(err)
+				suite.Nil(previous)
+				suite.Contains(err.Error(), "nmcli modify")
+			},
+		},
+		{
+			name: "when snapshot fails",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("nmcli", []string{"-g", "ipv4.routes", "connection", "show", "Wired"}).
+					Return("", errors.New("unknown connection"))
+			},
+			validateFunc: func(previous []networkmanager.Setting, err error) {
+				suite.Require().Error(err)
+				suite.Nil(previous)
+				suite.Contains(err.Error(), "nmcli show ipv4.routes")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			previous, err := networkmanager.ModifyConnection(
+				suite.mockExec,
+				"Wired",
+				"eth0",
+				settings,
+			)
+
+			tc.validateFunc(previous, err)
+		})
+	}
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestGetState() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func(*job.FileState)
+	}{
+		{
+			name: "when state is deployed",
+			setup: func() {
+				suite.expectState(job.FileState{
+					Path:     "nmcli:eth0/routes",
+					SHA256:   "abc",
+					Metadata: map[string]string{"interface": "eth0"},
+				})
+			},
+			validateFunc: func(state *job.FileState) {
+				suite.Require().NotNil(state)
+				suite.Equal("abc", state.SHA256)
+				suite.Equal("eth0", state.Metadata["interface"])
+			},
+		},
+		{
+			name: "when state is undeployed",
+			setup: func() {
+				suite.expectState(job.FileState{
+					Path:         "nmcli:eth0/routes",
+					UndeployedAt: "2026-01-01T00:00:00Z",
+				})
+			},
+			validateFunc: func(state *job.FileState) {
+				suite.Nil(state)
+			},
+		},
+		{
+			name: "when state is missing",
+			setup: func() {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+			},
+			validateFunc: func(state *job.FileState) {
+				suite.Nil(state)
+			},
+		},
+		{
+			name: "when state is malformed",
+			setup: func() {
+				mockEntry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+				mockEntry.EXPECT().Value().Return([]byte("not json"))
+
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(mockEntry, nil)
+			},
+			validateFunc: func(state *job.FileState) {
+				suite.Nil(state)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			state := networkmanager.GetState(
+				suite.ctx,
+				suite.mockStateKV,
+				testHostname,
+				"nmcli:eth0/routes",
+			)
+
+			tc.validateFunc(state)
+		})
+	}
+}
+
+func (suite *NetworkManagerPublicTestSuite) TestMarkRemoved() {
+	tests := []struct {
+		name  string
+		setup func()
+	}{
+		{
+			name: "when state exists marks undeployed",
+			setup: func() {
+				suite.expectState(job.FileState{Path: "nmcli:eth0/dns"})
+
+				suite.mockStateKV.EXPECT().
+					Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(1), nil)
+			},
+		},
+		{
+			name: "when state is missing does nothing",
+			setup: func() {
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found"))
+			},
+		},
+		{
+			name: "when state is malformed does nothing",
+			setup: func() {
+				mockEntry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+				mockEntry.EXPECT().Value().Return([]byte("not json"))
+
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(mockEntry, nil)
+			},
+		},
+		{
+			name: "when marshal fails does nothing",
+			setup: func() {
+				networkmanager.SetMarshalJSON(func(interface{}) ([]byte, error) {
+					return nil, errors.New("marshal failed")
+				})
+
+				suite.expectState(job.FileState{Path: "nmcli:eth0/dns"})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			networkmanager.MarkRemoved(
+				suite.ctx,
+				suite.logger,
+				suite.mockStateKV,
+				testHostname,
+				"nmcli:eth0/dns",
+			)
+		})
+	}
+}
+
+func TestNetworkManagerPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(NetworkManagerPublicTestSuite))
+}
@@ -0,0 +1,313 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package socket
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/avfs/avfs"
+)
+
+// procDir is the root of the process filesystem.
+const procDir = "/proc"
+
+// table describes one /proc/net socket table.
+type table struct {
+	path     string
+	protocol string
+	family   string
+}
+
+// tables lists the socket tables read by List, in reporting order.
+var tables = []table{
+	{path: "/proc/net/tcp", protocol: ProtocolTCP, family: "inet"},
+	{path: "/proc/net/tcp6", protocol: ProtocolTCP, family: "inet6"},
+	{path: "/proc/net/udp", protocol: ProtocolUDP, family: "inet"},
+	{path: "/proc/net/udp6", protocol: ProtocolUDP, family: "inet6"},
+}
+
+// kernelStates maps the hex socket states from /proc/net to the states
+// reported by List, per protocol. UDP has no listen state; an unconnected
+// UDP socket (TCP_CLOSE, 07) is receiving on its local address and is
+// reported as listening. Sockets in any other state are not reported.
+var kernelStates = map[string]map[string]string{
+	ProtocolTCP: {
+		"0A": StateListen,
+		"01": StateEstablished,
+	},
+	ProtocolUDP: {
+		"07": StateListen,
+		"01": StateEstablished,
+	},
+}
+
+// owner identifies the process holding a socket.
+type owner struct {
+	pid  int
+	name string
+}
+
+// Linux implements the Provider interface for Linux systems by parsing
+// /proc/net/{tcp,tcp6,udp,udp6}. Socket inodes are matched against the
+// /proc/<pid>/fd links of every visible process to find the owner;
+// sockets whose owner cannot be read (for example, when the agent is not
+// privileged) are reported without a PID.
+type Linux struct {
+	logger *slog.Logger
+	fs     avfs.VFS
+}
+
+// NewLinuxProvider factory to create a new Linux instance.
+func NewLinuxProvider(
+	logger *slog.Logger,
+	fs avfs.VFS,
+) *Linux {
+	return &Linux{
+		logger: logger.With(slog.String("subsystem", "provider.socket")),
+		fs:     fs,
+	}
+}
+
+// List returns listening sockets and established connections that match
+// opts. A missing table, such as tcp6 on a host with IPv6 disabled, is
+// skipped.
+func (l *Linux) List(
+	ctx context.Context,
+	opts ListOpts,
+) ([]Socket, error) {
+	owners, err := l.readOwners(ctx, opts.PID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Socket, 0)
+	for _, t := range tables {
+		if opts.Protocol != "" && opts.Protocol != t.protocol {
+			continue
+		}
+
+		data, err := l.fs.ReadFile(t.path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			return nil, fmt.Errorf("socket: read %s: %w", t.path, err)
+		}
+
+		for _, e := range parseTable(string(data), t) {
+			if o, ok := owners[e.inode]; ok {
+				e.socket.PID = o.pid
+				e.socket.Process = o.name
+			}
+
+			if matches(e.socket, opts) {
+				result = append(result, e.socket)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// matches reports whether s satisfies every filter set in opts.
+func matches(
+	s Socket,
+	opts ListOpts,
+) bool {
+	if opts.State != "" && opts.State != s.State {
+		return false
+	}
+
+	if opts.Port != 0 && opts.Port != s.LocalPort && opts.Port != s.RemotePort {
+		return false
+	}
+
+	if opts.PID != 0 && opts.PID != s.PID {
+		return false
+	}
+
+	return true
+}
+
+// entry pairs a parsed socket with its inode for owner lookup.
+type entry struct {
+	socket Socket
+	inode  string
+}
+
+// parseTable parses the content of a /proc/net socket table. The first
+// line is a header; each following line holds the slot, local address,
+// remote address, and state in fields 1-3 and the inode in field 9.
+// Malformed lines and sockets in unreported states are skipped.
+func parseTable(
+	content string,
+	t table,
+) []entry {
+	var entries []entry
+
+	lines := strings.Split(content, "\n")
+	for _, line := range lines[1:] {
+		fields := strings.Fields(line)
+		if len(fields) < 10 {
+			continue
+		}
+
+		state, ok := kernelStates[t.protocol][strings.ToUpper(fields[3])]
+		if !ok {
+			continue
+		}
+
+		localAddr, localPort, ok := parseEndpoint(fields[1])
+		if !ok {
+			continue
+		}
+
+		s := Socket{
+			Protocol:     t.protocol,
+			Family:       t.family,
+			State:        state,
+			LocalAddress: localAddr,
+			LocalPort:    localPort,
+		}
+
+		if state == StateEstablished {
+			remoteAddr, remotePort, ok := parseEndpoint(fields[2])
+			if !ok {
+				continue
+			}
+			s.RemoteAddress = remoteAddr
+			s.RemotePort = remotePort
+		}
+
+		entries = append(entries, entry{socket: s, inode: fields[9]})
+	}
+
+	return entries
+}
+
+// parseEndpoint decodes an "ADDRESS:PORT" pair from /proc/net. The port
+// is big-endian hex. The address is hex in host byte order: one 32-bit
+// word for IPv4 and four for IPv6, each little-endian on the platforms
+// the agent supports.
+func parseEndpoint(
+	s string,
+) (string, int, bool) {
+	addrHex, portHex, ok := strings.Cut(s, ":")
+	if !ok {
+		return "", 0, false
+	}
+
+	port, err := strconv.ParseUint(portHex, 16, 16)
+	if err != nil {
+		return "", 0, false
+	}
+
+	b, err := hex.DecodeString(addrHex)
+	if err != nil || (len(b) != net.IPv4len && len(b) != net.IPv6len) {
+		return "", 0, false
+	}
+
+	ip := make(net.IP, len(b))
+	for i := 0; i < len(b); i += 4 {
+		ip[i], ip[i+1], ip[i+2], ip[i+3] = b[i+3], b[i+2], b[i+1], b[i]
+	}
+
+	return ip.String(), int(port), true
+}
+
+// readOwners maps socket inodes to the processes holding them by reading
+// the "socket:[inode]" links under /proc/<pid>/fd. When pid is non-zero
+// only that process is inspected. Processes that exit or cannot be read
+// during the scan are skipped.
+func (l *Linux) readOwners(
+	ctx context.Context,
+	pid int,
+) (map[string]owner, error) {
+	var pids []int
+	if pid != 0 {
+		pids = []int{pid}
+	} else {
+		dirEntries, err := l.fs.ReadDir(procDir)
+		if err != nil {
+			return nil, fmt.Errorf("socket: read %s: %w", procDir, err)
+		}
+
+		for _, de := range dirEntries {
+			if n, err := strconv.Atoi(de.Name()); err == nil && n > 0 {
+				pids = append(pids, n)
+			}
+		}
+	}
+
+	owners := make(map[string]owner)
+	for _, p := range pids {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		procPath := l.fs.Join(procDir, strconv.Itoa(p))
+		fdDir := l.fs.Join(procPath, "fd")
+
+		fds, err := l.fs.ReadDir(fdDir)
+		if err != nil {
+			l.logger.Debug(
+				"skipping process",
+				slog.Int("pid", p),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+
+		var name string
+		for _, fd := range fds {
+			target, err := l.fs.Readlink(l.fs.Join(fdDir, fd.Name()))
+			if err != nil {
+				continue
+			}
+
+			inode, ok := strings.CutPrefix(target, "socket:[")
+			if !ok {
+				continue
+			}
+			inode = strings.TrimSuffix(inode, "]")
+
+			if name == "" {
+				comm, _ := l.fs.ReadFile(l.fs.Join(procPath, "comm"))
+				name = strings.TrimSpace(string(comm))
+			}
+
+			if _, seen := owners[inode]; !seen {
+				owners[inode] = owner{pid: p, name: name}
+			}
+		}
+	}
+
+	return owners, nil
+}
@@ -0,0 +1,325 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package socket_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osapi-io/osapi/internal/provider/network/socket"
+)
+
+const (
+	tableHeader = "  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode\n"
+	procNetTCP  = tableHeader +
+		"   0: 00000000:0016 00000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 1001 1 0000000000000000 100 0 0 10 0\n" +
+		"   1: 0100007F:0CEA 00000000:0000 0A 00000000:00000000 00:00000000 00000000   108        0 1002 1 0000000000000000 100 0 0 10 0\n" +
+		"   2: 0F02000A:0016 0202000A:D2F0 01 00000000:00000000 02:000AF0A8 00000000     0        0 1003 4 0000000000000000 20 4 29 10 -1\n" +
+		"   3: 0F02000A:C350 5DB8D822:01BB 01 00000000:00000000 00:00000000 00000000  1000        0 1006 1 0000000000000000 20 4 30 10 -1\n" +
+		"   4: 0F02000A:C351 5DB8D822:01BB 06 00000000:00000000 03:00000F2D 00000000     0        0 0 3 0000000000000000\n"
+	procNetTCP6 = tableHeader +
+		"   0: 00000000000000000000000000000000:0016 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 1004 1 0000000000000000 100 0 0 10 0\n" +
+		"   1: 00000000000000000000000001000000:0277 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 1005 1 0000000000000000 100 0 0 10 0\n"
+	procNetUDP = "   sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode ref pointer drops\n" +
+		"  100: 00000000:0044 00000000:0000 07 00000000:00000000 00:00000000 00000000     0        0 2001 2 0000000000000000 0\n" +
+		"  200: 3500007F:0035 00000000:0000 07 00000000:00000000 00:00000000 00000000   101        0 2002 2 0000000000000000 0\n" +
+		"  300: malformed\n"
+)
+
+type LinuxPublicTestSuite struct {
+	suite.Suite
+
+	logger   *slog.Logger
+	memFs    avfs.VFS
+	provider *socket.Linux
+}
+
+func (suite *LinuxPublicTestSuite) SetupTest() {
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+
+	_ = suite.memFs.MkdirAll("/proc/net", 0o755)
+	_ = suite.memFs.WriteFile("/proc/net/tcp", []byte(procNetTCP), 0o444)
+	_ = suite.memFs.WriteFile("/proc/net/tcp6", []byte(procNetTCP6), 0o444)
+	_ = suite.memFs.WriteFile("/proc/net/udp", []byte(procNetUDP), 0o444)
+
+	suite.writeProcess(100, "sshd", map[string]string{
+		"0": "/dev/null",
+		"3": "socket:[1001]",
+		"4": "socket:[1004]",
+	})
+	suite.writeProcess(200, "mysqld", map[string]string{
+		"10": "socket:[1002]",
+	})
+	suite.writeProcess(300, "sshd", map[string]string{
+		"3": "socket:[1003]",
+	})
+	suite.writeProcess(400, "curl", map[string]string{
+		"5": "socket:[1006]",
+	})
+	suite.writeProcess(500, "systemd-resolve", map[string]string{
+		"12": "socket:[2002]",
+	})
+
+	suite.provider = socket.NewLinuxProvider(suite.logger, suite.memFs)
+}
+
+// writeProcess creates /proc/<pid> with a comm file and fd links.
+func (suite *LinuxPublicTestSuite) writeProcess(
+	pid int,
+	comm string,
+	fds map[string]string,
+) {
+	dir := suite.memFs.Join("/proc", strconv.Itoa(pid))
+	_ = suite.memFs.MkdirAll(suite.memFs.Join(dir, "fd"), 0o755)
+	_ = suite.memFs.WriteFile(suite.memFs.Join(dir, "comm"), []byte(comm+"\n"), 0o444)
+
+	for fd, target := range fds {
+		_ = suite.memFs.Symlink(target, suite.memFs.Join(dir, "fd", fd))
+	}
+}
+
+var (
+	sshdListen4 = socket.Socket{
+		Protocol:     "tcp",
+		Family:       "inet",
+		State:        "listen",
+		LocalAddress: "0.0.0.0",
+		LocalPort:    22,
+		PID:          100,
+		Process:      "sshd",
+	}
+	mysqlListen = socket.Socket{
+		Protocol:     "tcp",
+		Family:       "inet",
+		State:        "listen",
+		LocalAddress: "127.0.0.1",
+		LocalPort:    3306,
+		PID:          200,
+		Process:      "mysqld",
+	}
+	sshdSession = socket.Socket{
+		Protocol:      "tcp",
+		Family:        "inet",
+		State:         "established",
+		LocalAddress:  "10.0.2.15",
+		LocalPort:     22,
+		RemoteAddress: "10.0.2.2",
+		RemotePort:    54000,
+		PID:           300,
+		Process:       "sshd",
+	}
+	curlOutbound = socket.Socket{
+		Protocol:      "tcp",
+		Family:        "inet",
+		State:         "established",
+		LocalAddress:  "10.0.2.15",
+		LocalPort:     50000,
+		RemoteAddress: "34.216.184.93",
+		RemotePort:    443,
+		PID:           400,
+		Process:       "curl",
+	}
+	sshdListen6 = socket.Socket{
+		Protocol:     "tcp",
+		Family:       "inet6",
+		State:        "listen",
+		LocalAddress: "::",
+		LocalPort:    22,
+		PID:          100,
+		Process:      "sshd",
+	}
+	cupsListen6 = socket.Socket{
+		Protocol:     "tcp",
+		Family:       "inet6",
+		State:        "listen",
+		LocalAddress: "::1",
+		LocalPort:    631,
+	}
+	dhcpUDP = socket.Socket{
+		Protocol:     "udp",
+		Family:       "inet",
+		State:        "listen",
+		LocalAddress: "0.0.0.0",
+		LocalPort:    68,
+	}
+	resolvedUDP = socket.Socket{
+		Protocol:     "udp",
+		Family:       "inet",
+		State:        "listen",
+		LocalAddress: "127.0.0.53",
+		LocalPort:    53,
+		PID:          500,
+		Process:      "systemd-resolve",
+	}
+)
+
+func (suite *LinuxPublicTestSuite) TestList() {
+	tests := []struct {
+		name        string
+		setup       func()
+		ctx         func() context.Context
+		opts        socket.ListOpts
+		want        []socket.Socket
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "when no filters returns every reported socket",
+			want: []socket.Socket{
+				sshdListen4,
+				mysqlListen,
+				sshdSession,
+				curlOutbound,
+				sshdListen6,
+				cupsListen6,
+				dhcpUDP,
+				resolvedUDP,
+			},
+		},
+		{
+			name: "when filtering by protocol",
+			opts: socket.ListOpts{Protocol: "udp"},
+			want: []socket.Socket{dhcpUDP, resolvedUDP},
+		},
+		{
+			name: "when filtering by state",
+			opts: socket.ListOpts{State: "established"},
+			want: []socket.Socket{sshdSession, curlOutbound},
+		},
+		{
+			name: "when filtering by local port",
+			opts: socket.ListOpts{Port: 22},
+			want: []socket.Socket{sshdListen4, sshdSession, sshdListen6},
+		},
+		{
+			name: "when filtering by remote port",
+			opts: socket.ListOpts{Port: 443},
+			want: []socket.Socket{curlOutbound},
+		},
+		{
+			name: "when filtering by pid",
+			opts: socket.ListOpts{PID: 100},
+			want: []socket.Socket{sshdListen4, sshdListen6},
+		},
+		{
+			name: "when filtering by pid and state",
+			opts: socket.ListOpts{PID: 100, State: "established"},
+			want: []socket.Socket{},
+		},
+		{
+			name: "when pid does not exist",
+			opts: socket.ListOpts{PID: 999},
+			want: []socket.Socket{},
+		},
+		{
+			name: "when process fds cannot be read reports sockets without owner",
+			setup: func() {
+				_ = suite.memFs.RemoveAll("/proc/200/fd")
+			},
+			opts: socket.ListOpts{Port: 3306},
+			want: []socket.Socket{
+				{
+					Protocol:     "tcp",
+					Family:       "inet",
+					State:        "listen",
+					LocalAddress: "127.0.0.1",
+					LocalPort:    3306,
+				},
+			},
+		},
+		{
+			name: "when a table is missing skips it",
+			setup: func() {
+				_ = suite.memFs.Remove("/proc/net/tcp6")
+			},
+			opts: socket.ListOpts{Protocol: "tcp", State: "listen"},
+			want: []socket.Socket{sshdListen4, mysqlListen},
+		},
+		{
+			name: "when a table cannot be read returns error",
+			setup: func() {
+				_ = suite.memFs.Remove("/proc/net/udp")
+				_ = suite.memFs.MkdirAll("/proc/net/udp", 0o755)
+			},
+			wantErr:     true,
+			errContains: "socket: read /proc/net/udp",
+		},
+		{
+			name: "when /proc cannot be read returns error",
+			setup: func() {
+				_ = suite.memFs.RemoveAll("/proc")
+			},
+			wantErr:     true,
+			errContains: "socket: read /proc",
+		},
+		{
+			name: "when context is cancelled returns error",
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx
+			},
+			wantErr:     true,
+			errContains: "context canceled",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			ctx := context.Background()
+			if tc.ctx != nil {
+				ctx = tc.ctx()
+			}
+
+			got, err := suite.provider.List(ctx, tc.opts)
+
+			if tc.wantErr {
+				suite.Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+				suite.Nil(got)
+				return
+			}
+
+			suite.NoError(err)
+			suite.Equal(tc.want, got)
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestLinuxPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(LinuxPublicTestSuite))
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package socket provides a read-only inventory of TCP and UDP sockets
+// parsed from /proc/net, with each socket attributed to the process that
+// owns it.
+package socket
+
+import "context"
+
+// Protocol values reported in Socket.Protocol.
+const (
+	ProtocolTCP = "tcp"
+	ProtocolUDP = "udp"
+)
+
+// State values reported in Socket.State.
+const (
+	StateListen      = "listen"
+	StateEstablished = "established"
+)
+
+// Provider implements the methods to inspect sockets.
+type Provider interface {
+	// List returns listening sockets and established connections that
+	// match opts.
+	List(ctx context.Context, opts ListOpts) ([]Socket, error)
+}
+
+// ListOpts filters the sockets returned by List. Zero values match
+// everything.
+type ListOpts struct {
+	// Protocol limits results to "tcp" or "udp".
+	Protocol string `json:"protocol,omitempty"`
+	// State limits results to "listen" or "established".
+	State string `json:"state,omitempty"`
+	// Port matches sockets whose local or remote port equals Port.
+	Port int `json:"port,omitempty"`
+	// PID limits results to sockets owned by the process.
+	PID int `json:"pid,omitempty"`
+}
+
+// Socket represents a single TCP or UDP socket. UDP sockets without a
+// connected peer are reported in the listen state.
+type Socket struct {
+	Protocol      string `json:"protocol"`
+	Family        string `json:"family"`
+	State         string `json:"state"`
+	LocalAddress  string `json:"local_address"`
+	LocalPort     int    `json:"local_port"`
+	RemoteAddress string `json:"remote_address,omitempty"`
+	RemotePort    int    `json:"remote_port,omitempty"`
+	PID           int    `json:"pid,omitempty"`
+	Process       string `json:"process,omitempty"`
+}
@@ -0,0 +1,389 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package apt
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/avfs/avfs"
+
+	"github.com/osapi-io/osapi/internal/exec"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/file"
+)
+
+// Compile-time checks.
+var (
+	_ Provider             = (*Alpine)(nil)
+	_ provider.FactsSetter = (*Alpine)(nil)
+)
+
+// Alpine implements the Provider interface for Alpine systems using apk.
+type Alpine struct {
+	provider.FactsAware
+	logger       *slog.Logger
+	fs           avfs.VFS
+	fileDeployer file.Deployer
+	execManager  exec.Manager
+}
+
+// NewAlpineProvider factory to create a new Alpine instance. A nil
+// fileDeployer disables installing packages from the Object Store.
+func NewAlpineProvider(
+	logger *slog.Logger,
+	fs avfs.VFS,
+	fileDeployer file.Deployer,
+	execManager exec.Manager,
+) *Alpine {
+	return &Alpine{
+		logger:       logger.With(slog.String("subsystem", "provider.apk")),
+		fs:           fs,
+		fileDeployer: fileDeployer,
+		execManager:  execManager,
+	}
+}
+
+// List returns all installed packages by querying apk.
+func (a *Alpine) List(
+	_ context.Context,
+) ([]Package, error) {
+	output, err := a.execManager.RunCmd(
+		"apk",
+		[]string{"list", "--installed"},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("package: list: %w", err)
+	}
+
+	return a.parsePackages(output), nil
+}
+
+// Get returns details for a single installed package.
+func (a *Alpine) Get(
+	_ context.Context,
+	name string,
+) (*Package, error) {
+	output, err := a.execManager.RunCmd(
+		"apk",
+		[]string{"list", "--installed", name},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("package: get %q: %w", name, err)
+	}
+
+	// apk list matches patterns, so filter for the exact name.
+	for _, pkg := range a.parsePackages(output) {
+		if pkg.Name == name {
+			return &pkg, nil
+		}
+	}
+
+	return nil, fmt.Errorf("package: get %q: not found", name)
+}
+
+// apkChangeMarkers are the apk add progress verbs that indicate a
+// package was actually written to disk.
+var apkChangeMarkers = []string{"Installing ", "Upgrading ", "Downgrading "}
+
+// Install installs a package by name using apk add. When version is set
+// the package is added as name=version, which also records the version
+// constraint in /etc/apk/world. Returns changed=false when apk did not
+// install, upgrade, or downgrade anything.
+func (a *Alpine) Install(
+	_ context.Context,
+	name string,
+	version string,
+) (*Result, error) {
+	spec := name
+	if version != "" {
+		spec = name + "=" + version
+	}
+
+	output, err := a.execManager.RunPrivilegedCmd(
+		"apk",
+		[]string{"add", spec},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("package: install %q: %w", name, err)
+	}
+
+	if !containsAny(output, apkChangeMarkers) {
+		a.logger.Debug(
+			"package already installed",
+			slog.String("name", name),
+		)
+
+		return &Result{
+			Name:    name,
+			Changed: false,
+		}, nil
+	}
+
+	a.logger.Info(
+		"package installed",
+		slog.String("name", name),
+		slog.String("version", version),
+	)
+
+	return &Result{
+		Name:    name,
+		Changed: true,
+	}, nil
+}
+
+// Remove removes a package by name using apk del. Returns changed=false
+// when apk did not purge anything.
+func (a *Alpine) Remove(
+	_ context.Context,
+	name string,
+) (*Result, error) {
+	output, err := a.execManager.RunPrivilegedCmd(
+		"apk",
+		[]string{"del", name},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("package: remove %q: %w", name, err)
+	}
+
+	if !strings.Contains(output, "Purging ") {
+		a.logger.Debug(
+			"package not installed",
+			slog.String("name", name),
+		)
+
+		return &Result{
+			Name:    name,
+			Changed: false,
+		}, nil
+	}
+
+	a.logger.Info(
+		"package removed",
+		slog.String("name", name),
+	)
+
+	return &Result{
+		Name:    name,
+		Changed: true,
+	}, nil
+}
+
+// Update refreshes the package index using apk update.
+func (a *Alpine) Update(
+	_ context.Context,
+) (*Result, error) {
+	_, err := a.execManager.RunPrivilegedCmd(
+		"apk",
+		[]string{"update"},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("package: update: %w", err)
+	}
+
+	a.logger.Info("package index updated")
+
+	return &Result{
+		Changed: true,
+	}, nil
+}
+
+// ListUpdates returns packages with available updates by parsing
+// apk list --upgradable output.
+func (a *Alpine) ListUpdates(
+	_ context.Context,
+) ([]Update, error) {
+	output, err := a.execManager.RunCmd(
+		"apk",
+		[]string{"list", "--upgradable"},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("package: list updates: %w", err)
+	}
+
+	return a.parseUpdates(output), nil
+}
+
+// Hold returns ErrUnsupported on Alpine. apk has no hold mechanism;
+// install with an explicit version to constrain a package instead.
+func (a *Alpine) Hold(
+	_ context.Context,
+	_ string,
+) (*Result, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// Unhold returns ErrUnsupported on Alpine.
+func (a *Alpine) Unhold(
+	_ context.Context,
+	_ string,
+) (*Result, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// Ensure converges installed packages to the desired manifest. Entries
+// with the pinned state fail because apk does not support holds.
+func (a *Alpine) Ensure(
+	ctx context.Context,
+	entries []EnsureEntry,
+) (*EnsureResult, error) {
+	return ensure(ctx, a, entries)
+}
+
+// ListRepos returns ErrUnsupported on Alpine. Repository management
+// deploys deb822 sources and is only implemented for apt.
+func (a *Alpine) ListRepos(
+	_ context.Context,
+) ([]Repo, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// GetRepo returns ErrUnsupported on Alpine.
+func (a *Alpine) GetRepo(
+	_ context.Context,
+	_ string,
+) (*Repo, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// CreateRepo returns ErrUnsupported on Alpine.
+func (a *Alpine) CreateRepo(
+	_ context.Context,
+	_ Repo,
+) (*RepoResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// UpdateRepo returns ErrUnsupported on Alpine.
+func (a *Alpine) UpdateRepo(
+	_ context.Context,
+	_ Repo,
+) (*RepoResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// DeleteRepo returns ErrUnsupported on Alpine.
+func (a *Alpine) DeleteRepo(
+	_ context.Context,
+	_ string,
+) (*RepoResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// parsePackages parses apk list output. Each line has the format:
+// name-version-rN arch {origin} (license) [installed]
+func (a *Alpine) parsePackages(
+	output string,
+) []Package {
+	var result []Package
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		name, version, ok := splitAPKPackage(fields[0])
+		if !ok {
+			continue
+		}
+
+		result = append(result, Package{
+			Name:    name,
+			Version: version,
+			Status:  "installed",
+		})
+	}
+
+	return result
+}
+
+// parseUpdates parses apk list --upgradable output. Each line has the
+// format: name-newversion arch {origin} (license) [upgradable from: name-oldversion]
+func (a *Alpine) parseUpdates(
+	output string,
+) []Update {
+	var result []Update
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for _, line := range lines {
+		_, from, found := strings.Cut(line, "[upgradable from: ")
+		if !found {
+			continue
+		}
+
+		fields := strings.Fields(line)
+
+		name, newVersion, ok := splitAPKPackage(fields[0])
+		if !ok {
+			continue
+		}
+
+		_, currentVersion, ok := splitAPKPackage(strings.TrimSuffix(from, "]"))
+		if !ok {
+			continue
+		}
+
+		result = append(result, Update{
+			Name:           name,
+			CurrentVersion: currentVersion,
+			NewVersion:     newVersion,
+		})
+	}
+
+	return result
+}
+
+// splitAPKPackage splits an apk "name-version-rN" identifier into name
+// and version. Package names may contain hyphens, but the version always
+// occupies the last two hyphen-separated segments.
+func splitAPKPackage(
+	pkgver string,
+) (string, string, bool) {
+	relIdx := strings.LastIndex(pkgver, "-")
+	if relIdx <= 0 {
+		return "", "", false
+	}
+
+	verIdx := strings.LastIndex(pkgver[:relIdx], "-")
+	if verIdx <= 0 {
+		return "", "", false
+	}
+
+	return pkgver[:verIdx], pkgver[verIdx+1:], true
+}
+
+// containsAny reports whether s contains any of the given substrings.
+func containsAny(
+	s string,
+	substrs []string,
+) bool {
+	for _, sub := range substrs {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+
+	return false
+}
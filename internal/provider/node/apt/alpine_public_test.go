@@ -0,0 +1,702 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package apt_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execMocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/node/apt"
+)
+
+type AlpinePublicTestSuite struct {
+	suite.Suite
+
+	ctrl        *gomock.Controller
+	mockExec    *execMocks.MockManager
+	logger      *slog.Logger
+	provider    *apt.Alpine
+	apkOutput   string
+	apkUpOutput string
+}
+
+func (suite *AlpinePublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockExec = execMocks.NewMockManager(suite.ctrl)
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.provider = apt.NewAlpineProvider(
+		suite.logger,
+		memfs.New(),
+		nil,
+		suite.mockExec,
+	)
+	suite.apkOutput = "busybox-1.36.1-r29 x86_64 {busybox} (GPL-2.0-only) [installed]\n" +
+		"ca-certificates-bundle-20240705-r0 x86_64 {ca-certificates} (MPL-2.0 AND MIT) [installed]\n"
+	suite.apkUpOutput = "busybox-1.36.1-r30 x86_64 {busybox} (GPL-2.0-only) [upgradable from: busybox-1.36.1-r29]\n" +
+		"ca-certificates-bundle-20241121-r0 x86_64 {ca-certificates} (MPL-2.0 AND MIT) " +
+		"[upgradable from: ca-certificates-bundle-20240705-r0]\n"
+}
+
+func (suite *AlpinePublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *AlpinePublicTestSuite) TestList() {
+	tests := []struct {
+		name         string
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func([]apt.Package)
+	}{
+		{
+			name: "when list succeeds",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apk", []string{"list", "--installed"}).
+					Return(suite.apkOutput, nil)
+			},
+			validateFunc: func(pkgs []apt.Package) {
+				suite.Require().Len(pkgs, 2)
+				suite.Equal("busybox", pkgs[0].Name)
+				suite.Equal("1.36.1-r29", pkgs[0].Version)
+				suite.Equal("installed", pkgs[0].Status)
+				suite.Equal("ca-certificates-bundle", pkgs[1].Name)
+				suite.Equal("20240705-r0", pkgs[1].Version)
+			},
+		},
+		{
+			name: "when exec error",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apk", []string{"list", "--installed"}).
+					Return("", fmt.Errorf("exec failed"))
+			},
+			wantErr:     true,
+			errContains: "package: list:",
+		},
+		{
+			name: "when empty output",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apk", []string{"list", "--installed"}).
+					Return("", nil)
+			},
+			validateFunc: func(pkgs []apt.Package) {
+				suite.Require().Empty(pkgs)
+			},
+		},
+		{
+			name: "when malformed lines are skipped",
+			setupMock: func() {
+				output := "WARNING: opening cache\n" +
+					"noversion x86_64 {noversion} (MIT) [installed]\n" +
+					"norelease-1 x86_64 {norelease} (MIT) [installed]\n" +
+					"busybox-1.36.1-r29 x86_64 {busybox} (GPL-2.0-only) [installed]\n"
+				suite.mockExec.EXPECT().
+					RunCmd("apk", []string{"list", "--installed"}).
+					Return(output, nil)
+			},
+			validateFunc: func(pkgs []apt.Package) {
+				suite.Require().Len(pkgs, 1)
+				suite.Equal("busybox", pkgs[0].Name)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.List(context.Background())
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *AlpinePublicTestSuite) TestGet() {
+	tests := []struct {
+		name         string
+		pkgName      string
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func(*apt.Package)
+	}{
+		{
+			name:    "when get succeeds",
+			pkgName: "busybox",
+			setupMock: func() {
+				output := "busybox-1.36.1-r29 x86_64 {busybox} (GPL-2.0-only) [installed]\n" +
+					"busybox-binsh-1.36.1-r29 x86_64 {busybox} (GPL-2.0-only) [installed]\n"
+				suite.mockExec.EXPECT().
+					RunCmd("apk", []string{"list", "--installed", "busybox"}).
+					Return(output, nil)
+			},
+			validateFunc: func(pkg *apt.Package) {
+				suite.Require().NotNil(pkg)
+				suite.Equal("busybox", pkg.Name)
+				suite.Equal("1.36.1-r29", pkg.Version)
+				suite.Equal("installed", pkg.Status)
+			},
+		},
+		{
+			name:    "when package not installed",
+			pkgName: "nonexistent",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apk", []string{"list", "--installed", "nonexistent"}).
+					Return("", nil)
+			},
+			wantErr:     true,
+			errContains: "package: get \"nonexistent\": not found",
+		},
+		{
+			name:    "when exec error",
+			pkgName: "busybox",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apk", []string{"list", "--installed", "busybox"}).
+					Return("", fmt.Errorf("exec failed"))
+			},
+			wantErr:     true,
+			errContains: "package: get \"busybox\":",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Get(context.Background(), tc.pkgName)
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *AlpinePublicTestSuite) TestInstall() {
+	tests := []struct {
+		name         string
+		pkgName      string
+		version      string
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func(*apt.Result)
+	}{
+		{
+			name:    "when install succeeds",
+			pkgName: "curl",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apk", []string{"add", "curl"}).
+					Return("(1/1) Installing curl (8.11.1-r0)\nOK: 10 MiB in 25 packages\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("curl", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when package already installed",
+			pkgName: "curl",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apk", []string{"add", "curl"}).
+					Return("OK: 10 MiB in 25 packages\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("curl", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:    "when install with version succeeds",
+			pkgName: "curl",
+			version: "8.12.1-r0",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apk", []string{"add", "curl=8.12.1-r0"}).
+					Return("(1/1) Upgrading curl (8.11.1-r0 -> 8.12.1-r0)\nOK: 10 MiB in 25 packages\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("curl", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when exec error",
+			pkgName: "badpkg",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apk", []string{"add", "badpkg"}).
+					Return("", fmt.Errorf("ERROR: unable to select packages"))
+			},
+			wantErr:     true,
+			errContains: "package: install \"badpkg\":",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Install(context.Background(), tc.pkgName, tc.version)
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *AlpinePublicTestSuite) TestRemove() {
+	tests := []struct {
+		name         string
+		pkgName      string
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func(*apt.Result)
+	}{
+		{
+			name:    "when remove succeeds",
+			pkgName: "curl",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apk", []string{"del", "curl"}).
+					Return("(1/1) Purging curl (8.11.1-r0)\nOK: 9 MiB in 24 packages\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("curl", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when package not installed",
+			pkgName: "curl",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apk", []string{"del", "curl"}).
+					Return("OK: 9 MiB in 24 packages\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:    "when exec error",
+			pkgName: "curl",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apk", []string{"del", "curl"}).
+					Return("", fmt.Errorf("permission denied"))
+			},
+			wantErr:     true,
+			errContains: "package: remove \"curl\":",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Remove(context.Background(), tc.pkgName)
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *AlpinePublicTestSuite) TestUpdate() {
+	tests := []struct {
+		name         string
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func(*apt.Result)
+	}{
+		{
+			name: "when update succeeds",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apk", []string{"update"}).
+					Return("OK: 24173 distinct packages available\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name: "when exec error",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apk", []string{"update"}).
+					Return("", fmt.Errorf("permission denied"))
+			},
+			wantErr:     true,
+			errContains: "package: update:",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Update(context.Background())
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *AlpinePublicTestSuite) TestListUpdates() {
+	tests := []struct {
+		name         string
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func([]apt.Update)
+	}{
+		{
+			name: "when list updates succeeds",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apk", []string{"list", "--upgradable"}).
+					Return(suite.apkUpOutput, nil)
+			},
+			validateFunc: func(updates []apt.Update) {
+				suite.Require().Len(updates, 2)
+				suite.Equal("busybox", updates[0].Name)
+				suite.Equal("1.36.1-r30", updates[0].NewVersion)
+				suite.Equal("1.36.1-r29", updates[0].CurrentVersion)
+				suite.Equal("ca-certificates-bundle", updates[1].Name)
+				suite.Equal("20241121-r0", updates[1].NewVersion)
+				suite.Equal("20240705-r0", updates[1].CurrentVersion)
+			},
+		},
+		{
+			name: "when no updates available",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apk", []string{"list", "--upgradable"}).
+					Return("", nil)
+			},
+			validateFunc: func(updates []apt.Update) {
+				suite.Require().Empty(updates)
+			},
+		},
+		{
+			name: "when malformed lines are skipped",
+			setupMock: func() {
+				output := "busybox-1.36.1-r30 x86_64 {busybox} (GPL-2.0-only)\n" +
+					"bad x86_64 {bad} (MIT) [upgradable from: bad-1.0-r0]\n" +
+					"good-1.1-r0 x86_64 {good} (MIT) [upgradable from: broken]\n" +
+					"busybox-1.36.1-r30 x86_64 {busybox} (GPL-2.0-only) [upgradable from: busybox-1.36.1-r29]\n"
+				suite.mockExec.EXPECT().
+					RunCmd("apk", []string{"list", "--upgradable"}).
+					Return(output, nil)
+			},
+			validateFunc: func(updates []apt.Update) {
+				suite.Require().Len(updates, 1)
+				suite.Equal("busybox", updates[0].Name)
+			},
+		},
+		{
+			name: "when exec error",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apk", []string{"list", "--upgradable"}).
+					Return("", fmt.Errorf("exec failed"))
+			},
+			wantErr:     true,
+			errContains: "package: list updates:",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.ListUpdates(context.Background())
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *AlpinePublicTestSuite) TestHold() {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "returns not implemented error",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			got, err := suite.provider.Hold(context.Background(), "vim")
+
+			suite.Nil(got)
+			suite.ErrorIs(err, provider.ErrUnsupported)
+		})
+	}
+}
+
+func (suite *AlpinePublicTestSuite) TestUnhold() {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "returns not implemented error",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			got, err := suite.provider.Unhold(context.Background(), "vim")
+
+			suite.Nil(got)
+			suite.ErrorIs(err, provider.ErrUnsupported)
+		})
+	}
+}
+
+func (suite *AlpinePublicTestSuite) TestEnsure() {
+	tests := []struct {
+		name         string
+		entries      []apt.EnsureEntry
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func(*apt.EnsureResult)
+	}{
+		{
+			name: "when packages converge",
+			entries: []apt.EnsureEntry{
+				{Name: "busybox", State: apt.StatePresent},
+				{Name: "curl", State: apt.StatePresent, Version: "8.12.1-r0"},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apk", []string{"list", "--installed"}).
+					Return(suite.apkOutput, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apk", []string{"add", "curl=8.12.1-r0"}).
+					Return("(1/1) Installing curl (8.12.1-r0)\nOK: 10 MiB in 25 packages\n", nil)
+			},
+			validateFunc: func(result *apt.EnsureResult) {
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+				suite.Require().Len(result.Packages, 2)
+				suite.False(result.Packages[0].Changed)
+				suite.True(result.Packages[1].Changed)
+			},
+		},
+		{
+			name: "when latest upgrades an installed package",
+			entries: []apt.EnsureEntry{
+				{Name: "busybox", State: apt.StateLatest},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apk", []string{"list", "--installed"}).
+					Return(suite.apkOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apk", []string{"list", "--upgradable"}).
+					Return(suite.apkUpOutput, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apk", []string{"add", "busybox=1.36.1-r30"}).
+					Return("(1/1) Upgrading busybox (1.36.1-r29 -> 1.36.1-r30)\nOK: 10 MiB in 25 packages\n", nil)
+			},
+			validateFunc: func(result *apt.EnsureResult) {
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+				suite.Require().Len(result.Packages, 1)
+				suite.True(result.Packages[0].Changed)
+			},
+		},
+		{
+			name: "when pinned is requested",
+			entries: []apt.EnsureEntry{
+				{Name: "busybox", State: apt.StatePinned, Version: "1.36.1-r29"},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apk", []string{"list", "--installed"}).
+					Return(suite.apkOutput, nil)
+			},
+			validateFunc: func(result *apt.EnsureResult) {
+				suite.Require().NotNil(result)
+				suite.False(result.Changed)
+				suite.Require().Len(result.Packages, 1)
+				suite.Contains(result.Packages[0].Error, "operation not supported")
+			},
+		},
+		{
+			name: "when list errors",
+			entries: []apt.EnsureEntry{
+				{Name: "busybox", State: apt.StatePresent},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apk", []string{"list", "--installed"}).
+					Return("", fmt.Errorf("exec failed"))
+			},
+			wantErr:     true,
+			errContains: "package: ensure:",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Ensure(context.Background(), tc.entries)
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *AlpinePublicTestSuite) TestRepoOperations() {
+	tests := []struct {
+		name string
+		fn   func() (any, error)
+	}{
+		{
+			name: "list repos returns not implemented error",
+			fn: func() (any, error) {
+				return suite.provider.ListRepos(context.Background())
+			},
+		},
+		{
+			name: "get repo returns not implemented error",
+			fn: func() (any, error) {
+				return suite.provider.GetRepo(context.Background(), "docker")
+			},
+		},
+		{
+			name: "create repo returns not implemented error",
+			fn: func() (any, error) {
+				return suite.provider.CreateRepo(context.Background(), apt.Repo{Name: "docker"})
+			},
+		},
+		{
+			name: "update repo returns not implemented error",
+			fn: func() (any, error) {
+				return suite.provider.UpdateRepo(context.Background(), apt.Repo{Name: "docker"})
+			},
+		},
+		{
+			name: "delete repo returns not implemented error",
+			fn: func() (any, error) {
+				return suite.provider.DeleteRepo(context.Background(), "docker")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			_, err := tc.fn()
+
+			suite.ErrorIs(err, provider.ErrUnsupported)
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestAlpinePublicTestSuite(t *testing.T) {
+	suite.Run(t, new(AlpinePublicTestSuite))
+}
@@ -54,6 +54,15 @@ func (d *Darwin) Get(
 func (d *Darwin) Install(
 	_ context.Context,
 	_ string,
+	_ string,
+) (*Result, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// InstallObject returns ErrUnsupported on Darwin.
+func (d *Darwin) InstallObject(
+	_ context.Context,
+	_ string,
 ) (*Result, error) {
 	return nil, provider.ErrUnsupported
 }
@@ -79,3 +88,66 @@ func (d *Darwin) ListUpdates(
 ) ([]Update, error) {
 	return nil, provider.ErrUnsupported
 }
+
+// Hold returns ErrUnsupported on Darwin.
+func (d *Darwin) Hold(
+	_ context.Context,
+	_ string,
+) (*Result, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// Unhold returns ErrUnsupported on Darwin.
+func (d *Darwin) Unhold(
+	_ context.Context,
+	_ string,
+) (*Result, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// Ensure returns ErrUnsupported on Darwin.
+func (d *Darwin) Ensure(
+	_ context.Context,
+	_ []EnsureEntry,
+) (*EnsureResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// ListRepos returns ErrUnsupported on Darwin.
+func (d *Darwin) ListRepos(
+	_ context.Context,
+) ([]Repo, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// GetRepo returns ErrUnsupported on Darwin.
+func (d *Darwin) GetRepo(
+	_ context.Context,
+	_ string,
+) (*Repo, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// CreateRepo returns ErrUnsupported on Darwin.
+func (d *Darwin) CreateRepo(
+	_ context.Context,
+	_ Repo,
+) (*RepoResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// UpdateRepo returns ErrUnsupported on Darwin.
+func (d *Darwin) UpdateRepo(
+	_ context.Context,
+	_ Repo,
+) (*RepoResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// DeleteRepo returns ErrUnsupported on Darwin.
+func (d *Darwin) DeleteRepo(
+	_ context.Context,
+	_ string,
+) (*RepoResult, error) {
+	return nil, provider.ErrUnsupported
+}
@@ -27,8 +27,12 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/avfs/avfs"
+	"github.com/nats-io/nats.go/jetstream"
+
 	"github.com/osapi-io/osapi/internal/exec"
 	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/file"
 )
 
 // dpkgQueryFormat is the format string for dpkg-query output.
@@ -41,21 +45,35 @@ var (
 )
 
 // Debian implements the Provider interface for Debian-family systems
-// using dpkg-query and apt-get.
+// using dpkg-query and apt-get. Repository sources and signing keys are
+// written through a FileDeployer for SHA tracking and drift detection.
 type Debian struct {
 	provider.FactsAware
-	logger      *slog.Logger
-	execManager exec.Manager
+	logger       *slog.Logger
+	fs           avfs.VFS
+	fileDeployer file.Deployer
+	stateKV      jetstream.KeyValue
+	execManager  exec.Manager
+	hostname     string
 }
 
-// NewDebianProvider factory to create a new Debian instance.
+// NewDebianProvider factory to create a new Debian instance. A nil
+// fileDeployer disables repository management.
 func NewDebianProvider(
 	logger *slog.Logger,
+	fs avfs.VFS,
+	fileDeployer file.Deployer,
+	stateKV jetstream.KeyValue,
 	execManager exec.Manager,
+	hostname string,
 ) *Debian {
 	return &Debian{
-		logger:      logger.With(slog.String("subsystem", "provider.apt")),
-		execManager: execManager,
+		logger:       logger.With(slog.String("subsystem", "provider.apt")),
+		fs:           fs,
+		fileDeployer: fileDeployer,
+		stateKV:      stateKV,
+		execManager:  execManager,
+		hostname:     hostname,
 	}
 }
 
@@ -95,15 +113,20 @@ func (d *Debian) Get(
 	return &pkgs[0], nil
 }
 
-// Install installs a package by name using apt-get.
+// Install installs a package by name using apt-get. When version is set
+// the package is installed as name=version, allowing downgrades so an
+// older pin can be applied over a newer installed version.
 func (d *Debian) Install(
 	_ context.Context,
 	name string,
+	version string,
 ) (*Result, error) {
-	_, err := d.execManager.RunPrivilegedCmd(
-		"apt-get",
-		[]string{"install", "-y", name},
-	)
+	args := []string{"install", "-y", name}
+	if version != "" {
+		args = []string{"install", "-y", "--allow-downgrades", name + "=" + version}
+	}
+
+	_, err := d.execManager.RunPrivilegedCmd("apt-get", args)
 	if err != nil {
 		return nil, fmt.Errorf("package: install %q: %w", name, err)
 	}
@@ -111,6 +134,7 @@ func (d *Debian) Install(
 	d.logger.Info(
 		"package installed",
 		slog.String("name", name),
+		slog.String("version", version),
 	)
 
 	return &Result{
@@ -178,6 +202,101 @@ func (d *Debian) ListUpdates(
 	return d.parseUpdates(output), nil
 }
 
+// Hold marks a package as held using apt-mark so it is skipped by
+// upgrades. Returns Changed=false when the package is already held.
+func (d *Debian) Hold(
+	_ context.Context,
+	name string,
+) (*Result, error) {
+	held, err := d.isHeld(name)
+	if err != nil {
+		return nil, fmt.Errorf("package: hold %q: %w", name, err)
+	}
+
+	if held {
+		return &Result{Name: name, Changed: false}, nil
+	}
+
+	if _, err := d.execManager.RunPrivilegedCmd(
+		"apt-mark",
+		[]string{"hold", name},
+	); err != nil {
+		return nil, fmt.Errorf("package: hold %q: %w", name, err)
+	}
+
+	d.logger.Info(
+		"package held",
+		slog.String("name", name),
+	)
+
+	return &Result{
+		Name:    name,
+		Changed: true,
+	}, nil
+}
+
+// Unhold removes an apt-mark hold from a package. Returns Changed=false
+// when the package is not held.
+func (d *Debian) Unhold(
+	_ context.Context,
+	name string,
+) (*Result, error) {
+	held, err := d.isHeld(name)
+	if err != nil {
+		return nil, fmt.Errorf("package: unhold %q: %w", name, err)
+	}
+
+	if !held {
+		return &Result{Name: name, Changed: false}, nil
+	}
+
+	if _, err := d.execManager.RunPrivilegedCmd(
+		"apt-mark",
+		[]string{"unhold", name},
+	); err != nil {
+		return nil, fmt.Errorf("package: unhold %q: %w", name, err)
+	}
+
+	d.logger.Info(
+		"package unheld",
+		slog.String("name", name),
+	)
+
+	return &Result{
+		Name:    name,
+		Changed: true,
+	}, nil
+}
+
+// Ensure converges installed packages to the desired manifest.
+func (d *Debian) Ensure(
+	ctx context.Context,
+	entries []EnsureEntry,
+) (*EnsureResult, error) {
+	return ensure(ctx, d, entries)
+}
+
+// isHeld reports whether a package is listed by apt-mark showhold.
+func (d *Debian) isHeld(
+	name string,
+) (bool, error) {
+	output, err := d.execManager.RunCmd(
+		"apt-mark",
+		[]string{"showhold"},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	for _, line := range strings.Split(output, "\n") {
+		if strings.TrimSpace(line) == name {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 // parsePackages parses dpkg-query tab-separated output into Package
 // slices. Only lines with status starting with "ii" (installed) are
 // included. Installed-Size is converted from KB to bytes.
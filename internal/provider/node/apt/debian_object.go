@@ -0,0 +1,76 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package apt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// InstallObject installs a .deb artifact from the Object Store. The
+// artifact is staged through the file deployer, verified, and installed
+// with apt-get so its dependencies are resolved from configured
+// repositories.
+func (d *Debian) InstallObject(
+	ctx context.Context,
+	object string,
+) (*Result, error) {
+	return installObject(ctx, d, d.logger, d.fs, d.fileDeployer, object, ".deb")
+}
+
+// inspectArtifact reads the package name and version from a .deb with
+// dpkg-deb.
+func (d *Debian) inspectArtifact(
+	path string,
+) (string, string, error) {
+	output, err := d.execManager.RunCmd(
+		"dpkg-deb",
+		[]string{"--show", "--showformat", "${Package}\t${Version}", path},
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("inspect artifact: %w", err)
+	}
+
+	name, version, ok := strings.Cut(strings.TrimSpace(output), "\t")
+	if !ok || name == "" || version == "" {
+		return "", "", fmt.Errorf("inspect artifact: unexpected dpkg-deb output %q", output)
+	}
+
+	return name, version, nil
+}
+
+// installArtifact installs a local .deb with apt-get, allowing
+// downgrades so an older build can replace a newer installed one.
+func (d *Debian) installArtifact(
+	path string,
+	_ string,
+	_ *Package,
+) error {
+	if _, err := d.execManager.RunPrivilegedCmd(
+		"apt-get",
+		[]string{"install", "-y", "--allow-downgrades", path},
+	); err != nil {
+		return fmt.Errorf("apt-get install: %w", err)
+	}
+
+	return nil
+}
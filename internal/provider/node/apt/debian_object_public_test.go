@@ -0,0 +1,359 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package apt_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execMocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/file"
+	fileMocks "github.com/osapi-io/osapi/internal/provider/file/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/apt"
+)
+
+const (
+	debArtifactPath   = "/var/cache/osapi/packages/hello_1.0-1_amd64.deb"
+	debArtifactObject = "hello_1.0-1_amd64.deb"
+	debDpkgFormat     = "${Package}\t${Version}\t${binary:Summary}\t${db:Status-Abbrev}\t${Installed-Size}\n"
+)
+
+// artifactSHA returns the hex SHA-256 of data, as the file deployer
+// reports it.
+func artifactSHA(
+	data []byte,
+) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+type DebianObjectPublicTestSuite struct {
+	suite.Suite
+
+	ctrl         *gomock.Controller
+	logger       *slog.Logger
+	memFs        avfs.VFS
+	mockDeployer *fileMocks.MockDeployer
+	mockExec     *execMocks.MockManager
+	provider     *apt.Debian
+}
+
+func (suite *DebianObjectPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+	suite.mockDeployer = fileMocks.NewMockDeployer(suite.ctrl)
+	suite.mockExec = execMocks.NewMockManager(suite.ctrl)
+
+	_ = suite.memFs.MkdirAll("/var/cache/osapi/packages", 0o755)
+
+	suite.provider = apt.NewDebianProvider(
+		suite.logger,
+		suite.memFs,
+		suite.mockDeployer,
+		nil,
+		suite.mockExec,
+		"test-host",
+	)
+}
+
+func (suite *DebianObjectPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *DebianObjectPublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+// expectStaged stages content at path as the deployer would and reports
+// sha as the deployed SHA-256.
+func (suite *DebianObjectPublicTestSuite) expectStaged(
+	object string,
+	path string,
+	content []byte,
+	sha string,
+) {
+	suite.mockDeployer.EXPECT().
+		Deploy(gomock.Any(), file.DeployRequest{
+			ObjectName:  object,
+			Path:        path,
+			Mode:        "0600",
+			ContentType: "raw",
+			Metadata:    map[string]string{"package_artifact": object},
+		}).
+		DoAndReturn(func(
+			_ context.Context,
+			_ file.DeployRequest,
+		) (*file.DeployResult, error) {
+			_ = suite.memFs.WriteFile(path, content, 0o600)
+
+			return &file.DeployResult{Changed: true, SHA256: sha, Path: path}, nil
+		})
+}
+
+func (suite *DebianObjectPublicTestSuite) expectInspect(
+	path string,
+	output string,
+	err error,
+) {
+	suite.mockExec.EXPECT().
+		RunCmd("dpkg-deb", []string{"--show", "--showformat", "${Package}\t${Version}", path}).
+		Return(output, err)
+}
+
+func (suite *DebianObjectPublicTestSuite) expectGet(
+	output string,
+	err error,
+) {
+	suite.mockExec.EXPECT().
+		RunCmd("dpkg-query", []string{"-W", "-f", debDpkgFormat, "hello"}).
+		Return(output, err)
+}
+
+func (suite *DebianObjectPublicTestSuite) expectInstall(
+	path string,
+	err error,
+) {
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("apt-get", []string{"install", "-y", "--allow-downgrades", path}).
+		Return("", err)
+}
+
+func (suite *DebianObjectPublicTestSuite) TestInstallObject() {
+	content := []byte("deb-bytes")
+	sha := artifactSHA(content)
+
+	tests := []struct {
+		name         string
+		object       string
+		setupMock    func()
+		wantErr      bool
+		wantErrType  error
+		errContains  string
+		validateFunc func(*apt.Result)
+	}{
+		{
+			name:   "when package not yet installed",
+			object: debArtifactObject,
+			setupMock: func() {
+				suite.expectStaged(debArtifactObject, debArtifactPath, content, sha)
+				suite.expectInspect(debArtifactPath, "hello\t1.0-1", nil)
+				suite.expectGet("", errors.New("no packages found matching hello"))
+				suite.expectInstall(debArtifactPath, nil)
+				suite.expectGet("hello\t1.0-1\tHello\tii \t10\n", nil)
+			},
+			validateFunc: func(r *apt.Result) {
+				suite.Equal("hello", r.Name)
+				suite.Equal("1.0-1", r.Version)
+				suite.True(r.Changed)
+			},
+		},
+		{
+			name:   "when same version already installed",
+			object: debArtifactObject,
+			setupMock: func() {
+				suite.expectStaged(debArtifactObject, debArtifactPath, content, sha)
+				suite.expectInspect(debArtifactPath, "hello\t1.0-1", nil)
+				suite.expectGet("hello\t1.0-1\tHello\tii \t10\n", nil)
+			},
+			validateFunc: func(r *apt.Result) {
+				suite.Equal("hello", r.Name)
+				suite.Equal("1.0-1", r.Version)
+				suite.False(r.Changed)
+			},
+		},
+		{
+			name:   "when different version installed",
+			object: debArtifactObject,
+			setupMock: func() {
+				suite.expectStaged(debArtifactObject, debArtifactPath, content, sha)
+				suite.expectInspect(debArtifactPath, "hello\t1.0-1", nil)
+				suite.expectGet("hello\t0.9-1\tHello\tii \t10\n", nil)
+				suite.expectInstall(debArtifactPath, nil)
+				suite.expectGet("hello\t1.0-1\tHello\tii \t10\n", nil)
+			},
+			validateFunc: func(r *apt.Result) {
+				suite.Equal("1.0-1", r.Version)
+				suite.True(r.Changed)
+			},
+		},
+		{
+			name:   "when object name lacks the extension",
+			object: "hello-latest",
+			setupMock: func() {
+				path := "/var/cache/osapi/packages/hello-latest.deb"
+				suite.expectStaged("hello-latest", path, content, sha)
+				suite.expectInspect(path, "hello\t1.0-1", nil)
+				suite.expectGet("hello\t1.0-1\tHello\tii \t10\n", nil)
+			},
+			validateFunc: func(r *apt.Result) {
+				suite.Equal("hello", r.Name)
+				suite.False(r.Changed)
+			},
+		},
+		{
+			name:   "when deploy fails",
+			object: debArtifactObject,
+			setupMock: func() {
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("object not found"))
+			},
+			wantErr:     true,
+			errContains: "object not found",
+		},
+		{
+			name:   "when staged artifact does not match deployed sha",
+			object: debArtifactObject,
+			setupMock: func() {
+				suite.expectStaged(debArtifactObject, debArtifactPath, content, "deadbeef")
+			},
+			wantErr:     true,
+			errContains: "sha256 mismatch",
+		},
+		{
+			name:   "when staged artifact is missing",
+			object: debArtifactObject,
+			setupMock: func() {
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(&file.DeployResult{SHA256: sha, Path: debArtifactPath}, nil)
+			},
+			wantErr:     true,
+			errContains: "read staged artifact",
+		},
+		{
+			name:   "when dpkg-deb fails",
+			object: debArtifactObject,
+			setupMock: func() {
+				suite.expectStaged(debArtifactObject, debArtifactPath, content, sha)
+				suite.expectInspect(debArtifactPath, "", errors.New("not a debian archive"))
+			},
+			wantErr:     true,
+			errContains: "not a debian archive",
+		},
+		{
+			name:   "when dpkg-deb output is malformed",
+			object: debArtifactObject,
+			setupMock: func() {
+				suite.expectStaged(debArtifactObject, debArtifactPath, content, sha)
+				suite.expectInspect(debArtifactPath, "hello", nil)
+			},
+			wantErr:     true,
+			errContains: "unexpected dpkg-deb output",
+		},
+		{
+			name:   "when apt-get install fails",
+			object: debArtifactObject,
+			setupMock: func() {
+				suite.expectStaged(debArtifactObject, debArtifactPath, content, sha)
+				suite.expectInspect(debArtifactPath, "hello\t1.0-1", nil)
+				suite.expectGet("", errors.New("no packages found matching hello"))
+				suite.expectInstall(debArtifactPath, errors.New("unmet dependencies"))
+			},
+			wantErr:     true,
+			errContains: "apt-get install: unmet dependencies",
+		},
+		{
+			name:   "when package missing after install",
+			object: debArtifactObject,
+			setupMock: func() {
+				suite.expectStaged(debArtifactObject, debArtifactPath, content, sha)
+				suite.expectInspect(debArtifactPath, "hello\t1.0-1", nil)
+				suite.expectGet("", errors.New("no packages found matching hello"))
+				suite.expectInstall(debArtifactPath, nil)
+				suite.expectGet("", errors.New("no packages found matching hello"))
+			},
+			wantErr:     true,
+			errContains: "package: get \"hello\"",
+		},
+		{
+			name:   "when installed version does not match artifact",
+			object: debArtifactObject,
+			setupMock: func() {
+				suite.expectStaged(debArtifactObject, debArtifactPath, content, sha)
+				suite.expectInspect(debArtifactPath, "hello\t1.0-1", nil)
+				suite.expectGet("hello\t2.0-1\tHello\tii \t10\n", nil)
+				suite.expectInstall(debArtifactPath, nil)
+				suite.expectGet("hello\t2.0-1\tHello\tii \t10\n", nil)
+			},
+			wantErr:     true,
+			errContains: "installed version \"2.0-1\" does not match artifact version \"1.0-1\"",
+		},
+		{
+			name:   "when no file deployer is configured",
+			object: debArtifactObject,
+			setupMock: func() {
+				suite.provider = apt.NewDebianProvider(
+					suite.logger,
+					suite.memFs,
+					nil,
+					nil,
+					suite.mockExec,
+					"test-host",
+				)
+			},
+			wantErr:     true,
+			wantErrType: provider.ErrUnsupported,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.InstallObject(context.Background(), tc.object)
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Nil(got)
+				if tc.wantErrType != nil {
+					suite.ErrorIs(err, tc.wantErrType)
+				}
+				if tc.errContains != "" {
+					suite.Contains(err.Error(), tc.errContains)
+				}
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NotNil(got)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func TestDebianObjectPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(DebianObjectPublicTestSuite))
+}
@@ -27,6 +27,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/avfs/avfs/vfs/memfs"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 
@@ -50,7 +51,14 @@ func (suite *DebianPublicTestSuite) SetupTest() {
 	suite.ctrl = gomock.NewController(suite.T())
 	suite.mockExec = execMocks.NewMockManager(suite.ctrl)
 	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
-	suite.provider = apt.NewDebianProvider(suite.logger, suite.mockExec)
+	suite.provider = apt.NewDebianProvider(
+		suite.logger,
+		memfs.New(),
+		nil,
+		nil,
+		suite.mockExec,
+		"test-host",
+	)
 	suite.dpkgFormat = "${Package}\t${Version}\t${binary:Summary}\t${db:Status-Abbrev}\t${Installed-Size}\n"
 	suite.dpkgOutput = "vim\t2:9.0.1378-2\tVi IMproved - enhanced vi editor\tii \t3826\n" +
 		"curl\t7.88.1-10+deb12u5\tcommand line tool for transferring data with URL syntax\tii \t513\n" +
@@ -231,6 +239,7 @@ func (suite *DebianPublicTestSuite) TestInstall() {
 	tests := []struct {
 		name         string
 		pkgName      string
+		version      string
 		setupMock    func()
 		wantErr      bool
 		errContains  string
@@ -250,6 +259,23 @@ func (suite *DebianPublicTestSuite) TestInstall() {
 				suite.True(result.Changed)
 			},
 		},
+		{
+			name:    "when install with version succeeds",
+			pkgName: "nginx",
+			version: "1.24.0-1",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{
+						"install", "-y", "--allow-downgrades", "nginx=1.24.0-1",
+					}).
+					Return("", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("nginx", result.Name)
+				suite.True(result.Changed)
+			},
+		},
 		{
 			name:    "when exec error",
 			pkgName: "badpkg",
@@ -267,7 +293,7 @@ func (suite *DebianPublicTestSuite) TestInstall() {
 		suite.Run(tc.name, func() {
 			tc.setupMock()
 
-			got, err := suite.provider.Install(context.Background(), tc.pkgName)
+			got, err := suite.provider.Install(context.Background(), tc.pkgName, tc.version)
 
 			if tc.wantErr {
 				suite.Require().Error(err)
@@ -471,6 +497,601 @@ func (suite *DebianPublicTestSuite) TestListUpdates() {
 	}
 }
 
+func (suite *DebianPublicTestSuite) TestHold() {
+	tests := []struct {
+		name         string
+		pkgName      string
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func(*apt.Result)
+	}{
+		{
+			name:    "when hold succeeds",
+			pkgName: "nginx",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("vim\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-mark", []string{"hold", "nginx"}).
+					Return("nginx set on hold.\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("nginx", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when already held",
+			pkgName: "nginx",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("vim\nnginx\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("nginx", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:    "when showhold errors",
+			pkgName: "nginx",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("", fmt.Errorf("exec error"))
+			},
+			wantErr:     true,
+			errContains: "package: hold \"nginx\":",
+		},
+		{
+			name:    "when hold errors",
+			pkgName: "nginx",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-mark", []string{"hold", "nginx"}).
+					Return("", fmt.Errorf("E: Unable to locate package nginx"))
+			},
+			wantErr:     true,
+			errContains: "package: hold \"nginx\":",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Hold(context.Background(), tc.pkgName)
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestUnhold() {
+	tests := []struct {
+		name         string
+		pkgName      string
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func(*apt.Result)
+	}{
+		{
+			name:    "when unhold succeeds",
+			pkgName: "nginx",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("nginx\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-mark", []string{"unhold", "nginx"}).
+					Return("Canceled hold on nginx.\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("nginx", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when not held",
+			pkgName: "nginx",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("nginx", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:    "when showhold errors",
+			pkgName: "nginx",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("", fmt.Errorf("exec error"))
+			},
+			wantErr:     true,
+			errContains: "package: unhold \"nginx\":",
+		},
+		{
+			name:    "when unhold errors",
+			pkgName: "nginx",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("nginx\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-mark", []string{"unhold", "nginx"}).
+					Return("", fmt.Errorf("exec error"))
+			},
+			wantErr:     true,
+			errContains: "package: unhold \"nginx\":",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Unhold(context.Background(), tc.pkgName)
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestEnsure() {
+	tests := []struct {
+		name         string
+		entries      []apt.EnsureEntry
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func(*apt.EnsureResult)
+	}{
+		{
+			name: "when packages already converged",
+			entries: []apt.EnsureEntry{
+				{Name: "vim", State: apt.StatePresent},
+				{Name: "curl", State: apt.StatePresent, Version: "7.88.1-10+deb12u5"},
+				{Name: "nginx", State: apt.StateAbsent},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dpkg-query", []string{"-W", "-f", suite.dpkgFormat}).
+					Return(suite.dpkgOutput, nil)
+			},
+			validateFunc: func(result *apt.EnsureResult) {
+				suite.Require().NotNil(result)
+				suite.False(result.Changed)
+				suite.Require().Len(result.Packages, 3)
+				for _, r := range result.Packages {
+					suite.False(r.Changed)
+					suite.Empty(r.Error)
+				}
+			},
+		},
+		{
+			name: "when packages need changes",
+			entries: []apt.EnsureEntry{
+				{Name: "nginx", State: apt.StatePresent},
+				{Name: "curl", State: apt.StateAbsent},
+				{Name: "vim", State: apt.StatePresent, Version: "2:9.0.1378-1"},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dpkg-query", []string{"-W", "-f", suite.dpkgFormat}).
+					Return(suite.dpkgOutput, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{"install", "-y", "nginx"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{"remove", "-y", "curl"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{
+						"install", "-y", "--allow-downgrades", "vim=2:9.0.1378-1",
+					}).
+					Return("", nil)
+			},
+			validateFunc: func(result *apt.EnsureResult) {
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+				suite.Require().Len(result.Packages, 3)
+				suite.Equal("nginx", result.Packages[0].Name)
+				suite.True(result.Packages[0].Changed)
+				suite.Equal("curl", result.Packages[1].Name)
+				suite.True(result.Packages[1].Changed)
+				suite.Equal("vim", result.Packages[2].Name)
+				suite.True(result.Packages[2].Changed)
+			},
+		},
+		{
+			name: "when latest upgrades and installs",
+			entries: []apt.EnsureEntry{
+				{Name: "vim", State: apt.StateLatest},
+				{Name: "nginx", State: apt.StateLatest},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dpkg-query", []string{"-W", "-f", suite.dpkgFormat}).
+					Return(suite.dpkgOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apt", []string{"list", "--upgradable"}).
+					Return(suite.aptUpOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{
+						"install", "-y", "--allow-downgrades", "vim=2:9.0.1378-3",
+					}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{"install", "-y", "nginx"}).
+					Return("", nil)
+			},
+			validateFunc: func(result *apt.EnsureResult) {
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+				suite.Require().Len(result.Packages, 2)
+				suite.True(result.Packages[0].Changed)
+				suite.True(result.Packages[1].Changed)
+			},
+		},
+		{
+			name: "when latest upgrades a held package",
+			entries: []apt.EnsureEntry{
+				{Name: "vim", State: apt.StateLatest},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dpkg-query", []string{"-W", "-f", suite.dpkgFormat}).
+					Return(suite.dpkgOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apt", []string{"list", "--upgradable"}).
+					Return(suite.aptUpOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("vim\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-mark", []string{"unhold", "vim"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{
+						"install", "-y", "--allow-downgrades", "vim=2:9.0.1378-3",
+					}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-mark", []string{"hold", "vim"}).
+					Return("", nil)
+			},
+			validateFunc: func(result *apt.EnsureResult) {
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+				suite.Require().Len(result.Packages, 1)
+				suite.True(result.Packages[0].Changed)
+				suite.Empty(result.Packages[0].Error)
+			},
+		},
+		{
+			name: "when latest already current",
+			entries: []apt.EnsureEntry{
+				{Name: "vim", State: apt.StateLatest},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dpkg-query", []string{"-W", "-f", suite.dpkgFormat}).
+					Return(suite.dpkgOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apt", []string{"list", "--upgradable"}).
+					Return("Listing... Done\n", nil)
+			},
+			validateFunc: func(result *apt.EnsureResult) {
+				suite.Require().NotNil(result)
+				suite.False(result.Changed)
+				suite.Require().Len(result.Packages, 1)
+				suite.False(result.Packages[0].Changed)
+			},
+		},
+		{
+			name: "when pinned installs and holds",
+			entries: []apt.EnsureEntry{
+				{Name: "nginx", State: apt.StatePinned, Version: "1.24.0-1"},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dpkg-query", []string{"-W", "-f", suite.dpkgFormat}).
+					Return(suite.dpkgOutput, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{
+						"install", "-y", "--allow-downgrades", "nginx=1.24.0-1",
+					}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-mark", []string{"hold", "nginx"}).
+					Return("", nil)
+			},
+			validateFunc: func(result *apt.EnsureResult) {
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+				suite.Require().Len(result.Packages, 1)
+				suite.Equal("nginx", result.Packages[0].Name)
+				suite.True(result.Packages[0].Changed)
+			},
+		},
+		{
+			name: "when pinned version changes on a held package",
+			entries: []apt.EnsureEntry{
+				{Name: "vim", State: apt.StatePinned, Version: "2:9.0.1378-1"},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dpkg-query", []string{"-W", "-f", suite.dpkgFormat}).
+					Return(suite.dpkgOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("vim\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-mark", []string{"unhold", "vim"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{
+						"install", "-y", "--allow-downgrades", "vim=2:9.0.1378-1",
+					}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-mark", []string{"hold", "vim"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("vim\n", nil)
+			},
+			validateFunc: func(result *apt.EnsureResult) {
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+				suite.Require().Len(result.Packages, 1)
+				suite.True(result.Packages[0].Changed)
+				suite.Empty(result.Packages[0].Error)
+			},
+		},
+		{
+			name: "when re-pin install fails the hold is restored",
+			entries: []apt.EnsureEntry{
+				{Name: "vim", State: apt.StatePinned, Version: "2:9.0.1378-9"},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dpkg-query", []string{"-W", "-f", suite.dpkgFormat}).
+					Return(suite.dpkgOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("vim\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-mark", []string{"unhold", "vim"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{
+						"install", "-y", "--allow-downgrades", "vim=2:9.0.1378-9",
+					}).
+					Return("", fmt.Errorf("E: Version '2:9.0.1378-9' for 'vim' was not found"))
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-mark", []string{"hold", "vim"}).
+					Return("", nil)
+			},
+			validateFunc: func(result *apt.EnsureResult) {
+				suite.Require().NotNil(result)
+				suite.False(result.Changed)
+				suite.Require().Len(result.Packages, 1)
+				suite.Contains(result.Packages[0].Error, "package: install \"vim\":")
+			},
+		},
+		{
+			name: "when unhold before re-pin fails",
+			entries: []apt.EnsureEntry{
+				{Name: "vim", State: apt.StatePinned, Version: "2:9.0.1378-1"},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dpkg-query", []string{"-W", "-f", suite.dpkgFormat}).
+					Return(suite.dpkgOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("", fmt.Errorf("exec error"))
+			},
+			validateFunc: func(result *apt.EnsureResult) {
+				suite.Require().NotNil(result)
+				suite.False(result.Changed)
+				suite.Require().Len(result.Packages, 1)
+				suite.Contains(result.Packages[0].Error, "package: unhold \"vim\":")
+			},
+		},
+		{
+			name: "when re-hold after upgrade fails",
+			entries: []apt.EnsureEntry{
+				{Name: "vim", State: apt.StateLatest},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dpkg-query", []string{"-W", "-f", suite.dpkgFormat}).
+					Return(suite.dpkgOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apt", []string{"list", "--upgradable"}).
+					Return(suite.aptUpOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("vim\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-mark", []string{"unhold", "vim"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{
+						"install", "-y", "--allow-downgrades", "vim=2:9.0.1378-3",
+					}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("", fmt.Errorf("exec error"))
+			},
+			validateFunc: func(result *apt.EnsureResult) {
+				suite.Require().NotNil(result)
+				suite.Require().Len(result.Packages, 1)
+				suite.Contains(result.Packages[0].Error, "package: hold \"vim\":")
+			},
+		},
+		{
+			name: "when pinned already installed and held",
+			entries: []apt.EnsureEntry{
+				{Name: "vim", State: apt.StatePinned, Version: "2:9.0.1378-2"},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dpkg-query", []string{"-W", "-f", suite.dpkgFormat}).
+					Return(suite.dpkgOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("vim\n", nil)
+			},
+			validateFunc: func(result *apt.EnsureResult) {
+				suite.Require().NotNil(result)
+				suite.False(result.Changed)
+				suite.Require().Len(result.Packages, 1)
+				suite.False(result.Packages[0].Changed)
+			},
+		},
+		{
+			name: "when entries fail they are reported per package",
+			entries: []apt.EnsureEntry{
+				{Name: "nginx", State: apt.StatePinned},
+				{Name: "nginx", State: "purged"},
+				{Name: "badpkg", State: apt.StatePresent},
+				{Name: "badpkg", State: apt.StatePinned, Version: "1.0"},
+				{Name: "vim", State: apt.StatePinned, Version: "2:9.0.1378-2"},
+				{Name: "curl", State: apt.StateAbsent},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dpkg-query", []string{"-W", "-f", suite.dpkgFormat}).
+					Return(suite.dpkgOutput, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{"install", "-y", "badpkg"}).
+					Return("", fmt.Errorf("E: Unable to locate package badpkg"))
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{
+						"install", "-y", "--allow-downgrades", "badpkg=1.0",
+					}).
+					Return("", fmt.Errorf("E: Unable to locate package badpkg"))
+				suite.mockExec.EXPECT().
+					RunCmd("apt-mark", []string{"showhold"}).
+					Return("", fmt.Errorf("exec error"))
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{"remove", "-y", "curl"}).
+					Return("", nil)
+			},
+			validateFunc: func(result *apt.EnsureResult) {
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+				suite.Require().Len(result.Packages, 6)
+				suite.Contains(result.Packages[0].Error, "version required")
+				suite.Contains(result.Packages[1].Error, "unsupported state")
+				suite.Contains(result.Packages[2].Error, "package: install \"badpkg\":")
+				suite.Contains(result.Packages[3].Error, "package: install \"badpkg\":")
+				suite.Contains(result.Packages[4].Error, "package: hold \"vim\":")
+				suite.Empty(result.Packages[5].Error)
+				suite.True(result.Packages[5].Changed)
+			},
+		},
+		{
+			name: "when list errors",
+			entries: []apt.EnsureEntry{
+				{Name: "vim", State: apt.StatePresent},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dpkg-query", []string{"-W", "-f", suite.dpkgFormat}).
+					Return("", fmt.Errorf("exec failed"))
+			},
+			wantErr:     true,
+			errContains: "package: ensure:",
+		},
+		{
+			name: "when list updates errors",
+			entries: []apt.EnsureEntry{
+				{Name: "vim", State: apt.StateLatest},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dpkg-query", []string{"-W", "-f", suite.dpkgFormat}).
+					Return(suite.dpkgOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("apt", []string{"list", "--upgradable"}).
+					Return("", fmt.Errorf("exec failed"))
+			},
+			wantErr:     true,
+			errContains: "package: ensure:",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Ensure(context.Background(), tc.entries)
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
 // In order for `go test` to run this suite, we need to create
 // a normal test function and pass our suite to suite.Run.
 func TestDebianPublicTestSuite(t *testing.T) {
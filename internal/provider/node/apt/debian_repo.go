@@ -0,0 +1,391 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package apt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/file"
+)
+
+const (
+	sourcesDir        = "/etc/apt/sources.list.d"
+	keyringDir        = "/etc/apt/keyrings"
+	repoManagedPrefix = "osapi-"
+	sourcesSuffix     = ".sources"
+	keyringSuffix     = ".asc"
+)
+
+var validRepoName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// ListRepos returns all osapi-managed repositories. Only deb822 sources
+// with the osapi- prefix that are tracked in the file-state KV (and not
+// undeployed) are included.
+func (d *Debian) ListRepos(
+	ctx context.Context,
+) ([]Repo, error) {
+	if d.fileDeployer == nil {
+		return nil, provider.ErrUnsupported
+	}
+
+	repos := []Repo{}
+
+	dirEntries, err := d.fs.ReadDir(sourcesDir)
+	if err != nil {
+		return repos, nil
+	}
+
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() {
+			continue
+		}
+
+		fileName := dirEntry.Name()
+		if !strings.HasPrefix(fileName, repoManagedPrefix) ||
+			!strings.HasSuffix(fileName, sourcesSuffix) {
+			continue
+		}
+
+		name := strings.TrimPrefix(fileName, repoManagedPrefix)
+		name = strings.TrimSuffix(name, sourcesSuffix)
+
+		if repo := d.buildRepoFromState(ctx, name); repo != nil {
+			repos = append(repos, *repo)
+		}
+	}
+
+	return repos, nil
+}
+
+// GetRepo returns a single osapi-managed repository.
+func (d *Debian) GetRepo(
+	ctx context.Context,
+	name string,
+) (*Repo, error) {
+	if d.fileDeployer == nil {
+		return nil, provider.ErrUnsupported
+	}
+
+	if err := validateRepoName(name); err != nil {
+		return nil, err
+	}
+
+	repo := d.buildRepoFromState(ctx, name)
+	if repo == nil {
+		return nil, fmt.Errorf("package: get repo %q: not found", name)
+	}
+
+	return repo, nil
+}
+
+// CreateRepo deploys a deb822 sources file and, when KeyObject is set,
+// its signing key into /etc/apt/keyrings. The keyring path is passed to
+// templated sources as the "keyring" var for use in Signed-By. The
+// package index is refreshed only when a file was written.
+func (d *Debian) CreateRepo(
+	ctx context.Context,
+	repo Repo,
+) (*RepoResult, error) {
+	if d.fileDeployer == nil {
+		return nil, provider.ErrUnsupported
+	}
+
+	if err := validateRepoName(repo.Name); err != nil {
+		return nil, err
+	}
+
+	if _, err := d.fs.Stat(sourcesFilePath(repo.Name)); err == nil {
+		return &RepoResult{
+			Name:    repo.Name,
+			Changed: false,
+		}, nil
+	}
+
+	changed, err := d.deployRepo(ctx, repo)
+	if err != nil {
+		return nil, fmt.Errorf("package: create repo %q: %w", repo.Name, err)
+	}
+
+	return &RepoResult{
+		Name:    repo.Name,
+		Changed: changed,
+	}, nil
+}
+
+// UpdateRepo redeploys an existing repository. Empty Object or KeyObject
+// fields preserve the currently deployed objects.
+func (d *Debian) UpdateRepo(
+	ctx context.Context,
+	repo Repo,
+) (*RepoResult, error) {
+	if d.fileDeployer == nil {
+		return nil, provider.ErrUnsupported
+	}
+
+	if err := validateRepoName(repo.Name); err != nil {
+		return nil, err
+	}
+
+	if _, err := d.fs.Stat(sourcesFilePath(repo.Name)); err != nil {
+		return nil, fmt.Errorf("package: update repo %q: does not exist", repo.Name)
+	}
+
+	if repo.Object == "" || repo.KeyObject == "" {
+		existing := d.buildRepoFromState(ctx, repo.Name)
+		if existing == nil {
+			return nil, fmt.Errorf(
+				"package: update repo %q: failed to read existing state",
+				repo.Name,
+			)
+		}
+
+		if repo.Object == "" {
+			repo.Object = existing.Object
+		}
+		if repo.KeyObject == "" {
+			repo.KeyObject = existing.KeyObject
+		}
+	}
+
+	changed, err := d.deployRepo(ctx, repo)
+	if err != nil {
+		return nil, fmt.Errorf("package: update repo %q: %w", repo.Name, err)
+	}
+
+	return &RepoResult{
+		Name:    repo.Name,
+		Changed: changed,
+	}, nil
+}
+
+// DeleteRepo undeploys a repository's sources file and signing key, then
+// refreshes the package index so stale lists are dropped.
+func (d *Debian) DeleteRepo(
+	ctx context.Context,
+	name string,
+) (*RepoResult, error) {
+	if d.fileDeployer == nil {
+		return nil, provider.ErrUnsupported
+	}
+
+	if err := validateRepoName(name); err != nil {
+		return nil, err
+	}
+
+	sourcesPath := sourcesFilePath(name)
+
+	if _, err := d.fs.Stat(sourcesPath); err != nil {
+		return &RepoResult{
+			Name:    name,
+			Changed: false,
+		}, nil
+	}
+
+	result, err := d.fileDeployer.Undeploy(ctx, file.UndeployRequest{
+		Path: sourcesPath,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("package: delete repo %q: %w", name, err)
+	}
+	changed := result.Changed
+
+	keyringPath := keyringFilePath(name)
+	if _, err := d.fs.Stat(keyringPath); err == nil {
+		result, err := d.fileDeployer.Undeploy(ctx, file.UndeployRequest{
+			Path: keyringPath,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("package: delete repo %q: %w", name, err)
+		}
+		changed = changed || result.Changed
+	}
+
+	if changed {
+		if err := d.refreshIndex(); err != nil {
+			return nil, fmt.Errorf("package: delete repo %q: %w", name, err)
+		}
+	}
+
+	d.logger.Info(
+		"package repository deleted",
+		slog.String("name", name),
+		slog.Bool("changed", changed),
+	)
+
+	return &RepoResult{
+		Name:    name,
+		Changed: changed,
+	}, nil
+}
+
+// deployRepo writes the signing key (first, so the sources never point at
+// a missing keyring) and the sources file, then runs apt-get update when
+// either changed.
+func (d *Debian) deployRepo(
+	ctx context.Context,
+	repo Repo,
+) (bool, error) {
+	keyringPath := keyringFilePath(repo.Name)
+	changed := false
+
+	if repo.KeyObject != "" {
+		result, err := d.fileDeployer.Deploy(ctx, file.DeployRequest{
+			ObjectName: repo.KeyObject,
+			Path:       keyringPath,
+			Mode:       "0644",
+			Metadata:   map[string]string{"repo": repo.Name},
+		})
+		if err != nil {
+			return false, err
+		}
+		changed = result.Changed
+	}
+
+	result, err := d.fileDeployer.Deploy(ctx, file.DeployRequest{
+		ObjectName: repo.Object,
+		Path:       sourcesFilePath(repo.Name),
+		Mode:       "0644",
+		Vars:       map[string]any{"keyring": keyringPath},
+		Metadata:   map[string]string{"repo": repo.Name},
+	})
+	if err != nil {
+		return false, err
+	}
+	changed = changed || result.Changed
+
+	if changed {
+		if err := d.refreshIndex(); err != nil {
+			return false, err
+		}
+	}
+
+	d.logger.Info(
+		"package repository deployed",
+		slog.String("name", repo.Name),
+		slog.Bool("changed", changed),
+	)
+
+	return changed, nil
+}
+
+// refreshIndex runs apt-get update after repository changes.
+func (d *Debian) refreshIndex() error {
+	if _, err := d.execManager.RunPrivilegedCmd(
+		"apt-get",
+		[]string{"update"},
+	); err != nil {
+		return fmt.Errorf("apt-get update: %w", err)
+	}
+
+	return nil
+}
+
+// buildRepoFromState creates a Repo from file-state KV entries. Returns
+// nil when the sources file is not managed by osapi.
+func (d *Debian) buildRepoFromState(
+	ctx context.Context,
+	name string,
+) *Repo {
+	sourcesPath := sourcesFilePath(name)
+
+	sources := d.fileState(ctx, sourcesPath)
+	if sources == nil {
+		return nil
+	}
+
+	repo := &Repo{
+		Name:   name,
+		Object: sources.ObjectName,
+		Path:   sourcesPath,
+	}
+
+	keyringPath := keyringFilePath(name)
+	if keyring := d.fileState(ctx, keyringPath); keyring != nil {
+		repo.KeyObject = keyring.ObjectName
+		repo.Keyring = keyringPath
+	}
+
+	return repo
+}
+
+// fileState returns the file-state KV entry for path, or nil when the
+// path is not tracked or has been undeployed.
+func (d *Debian) fileState(
+	ctx context.Context,
+	path string,
+) *job.FileState {
+	kvEntry, err := d.stateKV.Get(ctx, file.BuildStateKey(d.hostname, path))
+	if err != nil {
+		return nil
+	}
+
+	var state job.FileState
+	if err := json.Unmarshal(kvEntry.Value(), &state); err != nil {
+		return nil
+	}
+
+	if state.UndeployedAt != "" {
+		return nil
+	}
+
+	return &state
+}
+
+// sourcesFilePath returns the deb822 sources path for a managed repository.
+func sourcesFilePath(
+	name string,
+) string {
+	return filepath.Join(sourcesDir, repoManagedPrefix+name+sourcesSuffix)
+}
+
+// keyringFilePath returns the signing key path for a managed repository.
+func keyringFilePath(
+	name string,
+) string {
+	return filepath.Join(keyringDir, repoManagedPrefix+name+keyringSuffix)
+}
+
+// validateRepoName checks that a repository name is safe for use as a
+// filename.
+func validateRepoName(
+	name string,
+) error {
+	if name == "" {
+		return fmt.Errorf("invalid repository name: empty")
+	}
+	if !validRepoName.MatchString(name) {
+		return fmt.Errorf(
+			"invalid repository name %q: must match %s",
+			name,
+			validRepoName.String(),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,821 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package apt_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execMocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/job"
+	jobMocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/file"
+	fileMocks "github.com/osapi-io/osapi/internal/provider/file/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/apt"
+)
+
+const (
+	repoTestHostname = "test-host"
+	repoSourcesPath  = "/etc/apt/sources.list.d/osapi-docker.sources"
+	repoKeyringPath  = "/etc/apt/keyrings/osapi-docker.asc"
+)
+
+type DebianRepoPublicTestSuite struct {
+	suite.Suite
+
+	ctrl         *gomock.Controller
+	logger       *slog.Logger
+	memFs        avfs.VFS
+	mockDeployer *fileMocks.MockDeployer
+	mockStateKV  *jobMocks.MockKeyValue
+	mockExec     *execMocks.MockManager
+	provider     *apt.Debian
+}
+
+func (suite *DebianRepoPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+	suite.mockDeployer = fileMocks.NewMockDeployer(suite.ctrl)
+	suite.mockStateKV = jobMocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExec = execMocks.NewMockManager(suite.ctrl)
+
+	_ = suite.memFs.MkdirAll("/etc/apt/sources.list.d", 0o755)
+	_ = suite.memFs.MkdirAll("/etc/apt/keyrings", 0o755)
+
+	suite.provider = apt.NewDebianProvider(
+		suite.logger,
+		suite.memFs,
+		suite.mockDeployer,
+		suite.mockStateKV,
+		suite.mockExec,
+		repoTestHostname,
+	)
+}
+
+func (suite *DebianRepoPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *DebianRepoPublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+// expectState registers a file-state KV lookup for path. A nil state
+// makes the lookup fail as if the key does not exist.
+func (suite *DebianRepoPublicTestSuite) expectState(
+	path string,
+	state *job.FileState,
+) {
+	key := file.BuildStateKey(repoTestHostname, path)
+	if state == nil {
+		suite.mockStateKV.EXPECT().
+			Get(gomock.Any(), key).
+			Return(nil, errors.New("key not found"))
+		return
+	}
+
+	data, _ := json.Marshal(state)
+	entry := jobMocks.NewMockKeyValueEntry(suite.ctrl)
+	entry.EXPECT().Value().Return(data).AnyTimes()
+	suite.mockStateKV.EXPECT().
+		Get(gomock.Any(), key).
+		Return(entry, nil)
+}
+
+// expectManagedRepo registers state for a repository with a signing key.
+func (suite *DebianRepoPublicTestSuite) expectManagedRepo() {
+	suite.expectState(repoSourcesPath, &job.FileState{
+		ObjectName: "docker-sources",
+		Path:       repoSourcesPath,
+	})
+	suite.expectState(repoKeyringPath, &job.FileState{
+		ObjectName: "docker-key",
+		Path:       repoKeyringPath,
+	})
+}
+
+func (suite *DebianRepoPublicTestSuite) sourcesRequest(
+	object string,
+) file.DeployRequest {
+	return file.DeployRequest{
+		ObjectName: object,
+		Path:       repoSourcesPath,
+		Mode:       "0644",
+		Vars:       map[string]any{"keyring": repoKeyringPath},
+		Metadata:   map[string]string{"repo": "docker"},
+	}
+}
+
+func (suite *DebianRepoPublicTestSuite) keyringRequest(
+	object string,
+) file.DeployRequest {
+	return file.DeployRequest{
+		ObjectName: object,
+		Path:       repoKeyringPath,
+		Mode:       "0644",
+		Metadata:   map[string]string{"repo": "docker"},
+	}
+}
+
+func (suite *DebianRepoPublicTestSuite) TestListRepos() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func([]apt.Repo, error)
+	}{
+		{
+			name: "when managed repositories exist",
+			setup: func() {
+				_ = suite.memFs.WriteFile(repoSourcesPath, []byte("Types: deb"), 0o644)
+				_ = suite.memFs.WriteFile(
+					"/etc/apt/sources.list.d/osapi-local.sources",
+					[]byte("Types: deb"),
+					0o644,
+				)
+				_ = suite.memFs.WriteFile(
+					"/etc/apt/sources.list.d/debian.sources",
+					[]byte("Types: deb"),
+					0o644,
+				)
+				_ = suite.memFs.WriteFile(
+					"/etc/apt/sources.list.d/osapi-legacy.list",
+					[]byte("deb http://example.com stable main"),
+					0o644,
+				)
+				_ = suite.memFs.MkdirAll("/etc/apt/sources.list.d/osapi-dir.sources", 0o755)
+
+				suite.expectManagedRepo()
+				suite.expectState("/etc/apt/sources.list.d/osapi-local.sources", &job.FileState{
+					ObjectName: "local-sources",
+				})
+				suite.expectState("/etc/apt/keyrings/osapi-local.asc", nil)
+			},
+			validateFunc: func(
+				repos []apt.Repo,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal([]apt.Repo{
+					{
+						Name:      "docker",
+						Object:    "docker-sources",
+						KeyObject: "docker-key",
+						Path:      repoSourcesPath,
+						Keyring:   repoKeyringPath,
+					},
+					{
+						Name:   "local",
+						Object: "local-sources",
+						Path:   "/etc/apt/sources.list.d/osapi-local.sources",
+					},
+				}, repos)
+			},
+		},
+		{
+			name: "when sources file is not tracked or undeployed",
+			setup: func() {
+				_ = suite.memFs.WriteFile(repoSourcesPath, []byte("Types: deb"), 0o644)
+				_ = suite.memFs.WriteFile(
+					"/etc/apt/sources.list.d/osapi-gone.sources",
+					[]byte("Types: deb"),
+					0o644,
+				)
+				_ = suite.memFs.WriteFile(
+					"/etc/apt/sources.list.d/osapi-bad.sources",
+					[]byte("Types: deb"),
+					0o644,
+				)
+
+				suite.expectState(repoSourcesPath, nil)
+				suite.expectState("/etc/apt/sources.list.d/osapi-gone.sources", &job.FileState{
+					ObjectName:   "gone-sources",
+					UndeployedAt: "2026-01-01T00:00:00Z",
+				})
+
+				entry := jobMocks.NewMockKeyValueEntry(suite.ctrl)
+				entry.EXPECT().Value().Return([]byte("not-json"))
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), file.BuildStateKey(
+						repoTestHostname,
+						"/etc/apt/sources.list.d/osapi-bad.sources",
+					)).
+					Return(entry, nil)
+			},
+			validateFunc: func(
+				repos []apt.Repo,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Empty(repos)
+			},
+		},
+		{
+			name: "when sources directory does not exist",
+			setup: func() {
+				_ = suite.memFs.RemoveAll("/etc/apt/sources.list.d")
+			},
+			validateFunc: func(
+				repos []apt.Repo,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(repos)
+				suite.Empty(repos)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			repos, err := suite.provider.ListRepos(context.Background())
+			tc.validateFunc(repos, err)
+		})
+	}
+}
+
+func (suite *DebianRepoPublicTestSuite) TestGetRepo() {
+	tests := []struct {
+		name         string
+		repoName     string
+		setup        func()
+		validateFunc func(*apt.Repo, error)
+	}{
+		{
+			name:     "when repository is managed",
+			repoName: "docker",
+			setup: func() {
+				suite.expectManagedRepo()
+			},
+			validateFunc: func(
+				repo *apt.Repo,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("docker", repo.Name)
+				suite.Equal("docker-sources", repo.Object)
+				suite.Equal("docker-key", repo.KeyObject)
+				suite.Equal(repoSourcesPath, repo.Path)
+				suite.Equal(repoKeyringPath, repo.Keyring)
+			},
+		},
+		{
+			name:     "when repository is not managed",
+			repoName: "docker",
+			setup: func() {
+				suite.expectState(repoSourcesPath, nil)
+			},
+			validateFunc: func(
+				repo *apt.Repo,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(repo)
+				suite.Contains(err.Error(), "not found")
+			},
+		},
+		{
+			name:     "when name is invalid",
+			repoName: "../etc",
+			setup:    func() {},
+			validateFunc: func(
+				repo *apt.Repo,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(repo)
+				suite.Contains(err.Error(), "invalid repository name")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			repo, err := suite.provider.GetRepo(context.Background(), tc.repoName)
+			tc.validateFunc(repo, err)
+		})
+	}
+}
+
+func (suite *DebianRepoPublicTestSuite) TestCreateRepo() {
+	tests := []struct {
+		name         string
+		repo         apt.Repo
+		setup        func()
+		validateFunc func(*apt.RepoResult, error)
+	}{
+		{
+			name: "when sources and key deploy and index refreshes",
+			repo: apt.Repo{
+				Name:      "docker",
+				Object:    "docker-sources",
+				KeyObject: "docker-key",
+			},
+			setup: func() {
+				gomock.InOrder(
+					suite.mockDeployer.EXPECT().
+						Deploy(gomock.Any(), suite.keyringRequest("docker-key")).
+						Return(&file.DeployResult{Changed: true}, nil),
+					suite.mockDeployer.EXPECT().
+						Deploy(gomock.Any(), suite.sourcesRequest("docker-sources")).
+						Return(&file.DeployResult{Changed: true}, nil),
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("apt-get", []string{"update"}).
+						Return("", nil),
+				)
+			},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("docker", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name: "when sources deploy is unchanged skips index refresh",
+			repo: apt.Repo{
+				Name:   "docker",
+				Object: "docker-sources",
+			},
+			setup: func() {
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), suite.sourcesRequest("docker-sources")).
+					Return(&file.DeployResult{Changed: false}, nil)
+			},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name: "when repository already exists returns unchanged",
+			repo: apt.Repo{
+				Name:   "docker",
+				Object: "docker-sources",
+			},
+			setup: func() {
+				_ = suite.memFs.WriteFile(repoSourcesPath, []byte("Types: deb"), 0o644)
+			},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("docker", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name: "when key deploy fails",
+			repo: apt.Repo{
+				Name:      "docker",
+				Object:    "docker-sources",
+				KeyObject: "docker-key",
+			},
+			setup: func() {
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), suite.keyringRequest("docker-key")).
+					Return(nil, errors.New("object not found"))
+			},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "create repo")
+				suite.Contains(err.Error(), "object not found")
+			},
+		},
+		{
+			name: "when sources deploy fails",
+			repo: apt.Repo{
+				Name:   "docker",
+				Object: "docker-sources",
+			},
+			setup: func() {
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), suite.sourcesRequest("docker-sources")).
+					Return(nil, errors.New("render failed"))
+			},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "render failed")
+			},
+		},
+		{
+			name: "when apt-get update fails",
+			repo: apt.Repo{
+				Name:   "docker",
+				Object: "docker-sources",
+			},
+			setup: func() {
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), suite.sourcesRequest("docker-sources")).
+					Return(&file.DeployResult{Changed: true}, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{"update"}).
+					Return("", errors.New("NO_PUBKEY"))
+			},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "apt-get update")
+				suite.Contains(err.Error(), "NO_PUBKEY")
+			},
+		},
+		{
+			name: "when name is empty",
+			repo: apt.Repo{
+				Object: "docker-sources",
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "empty")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.CreateRepo(context.Background(), tc.repo)
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianRepoPublicTestSuite) TestUpdateRepo() {
+	tests := []struct {
+		name         string
+		repo         apt.Repo
+		setup        func()
+		validateFunc func(*apt.RepoResult, error)
+	}{
+		{
+			name: "when new objects are deployed",
+			repo: apt.Repo{
+				Name:      "docker",
+				Object:    "docker-sources-v2",
+				KeyObject: "docker-key-v2",
+			},
+			setup: func() {
+				_ = suite.memFs.WriteFile(repoSourcesPath, []byte("Types: deb"), 0o644)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), suite.keyringRequest("docker-key-v2")).
+					Return(&file.DeployResult{Changed: true}, nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), suite.sourcesRequest("docker-sources-v2")).
+					Return(&file.DeployResult{Changed: false}, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{"update"}).
+					Return("", nil)
+			},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("docker", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name: "when objects are omitted preserves existing",
+			repo: apt.Repo{
+				Name: "docker",
+			},
+			setup: func() {
+				_ = suite.memFs.WriteFile(repoSourcesPath, []byte("Types: deb"), 0o644)
+				suite.expectManagedRepo()
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), suite.keyringRequest("docker-key")).
+					Return(&file.DeployResult{Changed: false}, nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), suite.sourcesRequest("docker-sources")).
+					Return(&file.DeployResult{Changed: false}, nil)
+			},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name: "when existing state cannot be read",
+			repo: apt.Repo{
+				Name: "docker",
+			},
+			setup: func() {
+				_ = suite.memFs.WriteFile(repoSourcesPath, []byte("Types: deb"), 0o644)
+				suite.expectState(repoSourcesPath, nil)
+			},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "failed to read existing state")
+			},
+		},
+		{
+			name: "when repository does not exist",
+			repo: apt.Repo{
+				Name:   "docker",
+				Object: "docker-sources",
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "does not exist")
+			},
+		},
+		{
+			name: "when deploy fails",
+			repo: apt.Repo{
+				Name:      "docker",
+				Object:    "docker-sources",
+				KeyObject: "docker-key",
+			},
+			setup: func() {
+				_ = suite.memFs.WriteFile(repoSourcesPath, []byte("Types: deb"), 0o644)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), suite.keyringRequest("docker-key")).
+					Return(&file.DeployResult{Changed: true}, nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), suite.sourcesRequest("docker-sources")).
+					Return(nil, errors.New("object not found"))
+			},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "update repo")
+			},
+		},
+		{
+			name: "when name is invalid",
+			repo: apt.Repo{
+				Name: "bad name",
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid repository name")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.UpdateRepo(context.Background(), tc.repo)
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianRepoPublicTestSuite) TestDeleteRepo() {
+	tests := []struct {
+		name         string
+		repoName     string
+		setup        func()
+		validateFunc func(*apt.RepoResult, error)
+	}{
+		{
+			name:     "when sources and keyring are undeployed",
+			repoName: "docker",
+			setup: func() {
+				_ = suite.memFs.WriteFile(repoSourcesPath, []byte("Types: deb"), 0o644)
+				_ = suite.memFs.WriteFile(repoKeyringPath, []byte("key"), 0o644)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: repoSourcesPath}).
+					Return(&file.UndeployResult{Changed: true}, nil)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: repoKeyringPath}).
+					Return(&file.UndeployResult{Changed: true}, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{"update"}).
+					Return("", nil)
+			},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("docker", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:     "when undeploy is unchanged skips index refresh",
+			repoName: "docker",
+			setup: func() {
+				_ = suite.memFs.WriteFile(repoSourcesPath, []byte("Types: deb"), 0o644)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: repoSourcesPath}).
+					Return(&file.UndeployResult{Changed: false}, nil)
+			},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:     "when repository does not exist returns unchanged",
+			repoName: "docker",
+			setup:    func() {},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:     "when sources undeploy fails",
+			repoName: "docker",
+			setup: func() {
+				_ = suite.memFs.WriteFile(repoSourcesPath, []byte("Types: deb"), 0o644)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: repoSourcesPath}).
+					Return(nil, errors.New("permission denied"))
+			},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "delete repo")
+				suite.Contains(err.Error(), "permission denied")
+			},
+		},
+		{
+			name:     "when keyring undeploy fails",
+			repoName: "docker",
+			setup: func() {
+				_ = suite.memFs.WriteFile(repoSourcesPath, []byte("Types: deb"), 0o644)
+				_ = suite.memFs.WriteFile(repoKeyringPath, []byte("key"), 0o644)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: repoSourcesPath}).
+					Return(&file.UndeployResult{Changed: true}, nil)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: repoKeyringPath}).
+					Return(nil, errors.New("permission denied"))
+			},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "permission denied")
+			},
+		},
+		{
+			name:     "when apt-get update fails",
+			repoName: "docker",
+			setup: func() {
+				_ = suite.memFs.WriteFile(repoSourcesPath, []byte("Types: deb"), 0o644)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: repoSourcesPath}).
+					Return(&file.UndeployResult{Changed: true}, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("apt-get", []string{"update"}).
+					Return("", errors.New("lock held"))
+			},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "lock held")
+			},
+		},
+		{
+			name:     "when name is invalid",
+			repoName: "a/b",
+			setup:    func() {},
+			validateFunc: func(
+				result *apt.RepoResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid repository name")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.DeleteRepo(context.Background(), tc.repoName)
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianRepoPublicTestSuite) TestWithoutFileDeployer() {
+	p := apt.NewDebianProvider(
+		suite.logger,
+		suite.memFs,
+		nil,
+		nil,
+		suite.mockExec,
+		repoTestHostname,
+	)
+	ctx := context.Background()
+
+	_, err := p.ListRepos(ctx)
+	suite.ErrorIs(err, provider.ErrUnsupported)
+
+	_, err = p.GetRepo(ctx, "docker")
+	suite.ErrorIs(err, provider.ErrUnsupported)
+
+	_, err = p.CreateRepo(ctx, apt.Repo{Name: "docker"})
+	suite.ErrorIs(err, provider.ErrUnsupported)
+
+	_, err = p.UpdateRepo(ctx, apt.Repo{Name: "docker"})
+	suite.ErrorIs(err, provider.ErrUnsupported)
+
+	_, err = p.DeleteRepo(ctx, "docker")
+	suite.ErrorIs(err, provider.ErrUnsupported)
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestDebianRepoPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(DebianRepoPublicTestSuite))
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package apt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/osapi-io/osapi/internal/provider"
+)
+
+// ensure converges packages to the states described by entries using the
+// primitive operations of p. The installed package list is read once up
+// front and available updates are only queried when an entry asks for the
+// latest version. Failures are recorded per entry so one bad package does
+// not abort the rest of the manifest.
+func ensure(
+	ctx context.Context,
+	p Provider,
+	entries []EnsureEntry,
+) (*EnsureResult, error) {
+	pkgs, err := p.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("package: ensure: %w", err)
+	}
+
+	installed := make(map[string]string, len(pkgs))
+	for _, pkg := range pkgs {
+		installed[pkg.Name] = pkg.Version
+	}
+
+	var updates map[string]string
+
+	result := &EnsureResult{
+		Packages: make([]Result, 0, len(entries)),
+	}
+
+	for _, entry := range entries {
+		if entry.State == StateLatest && updates == nil {
+			updates, err = listUpdateVersions(ctx, p)
+			if err != nil {
+				return nil, fmt.Errorf("package: ensure: %w", err)
+			}
+		}
+
+		r, err := ensureEntry(ctx, p, entry, installed, updates)
+		if err != nil {
+			result.Packages = append(result.Packages, Result{
+				Name:  entry.Name,
+				Error: err.Error(),
+			})
+
+			continue
+		}
+
+		if r.Changed {
+			result.Changed = true
+		}
+
+		result.Packages = append(result.Packages, *r)
+	}
+
+	return result, nil
+}
+
+// ensureEntry converges a single package to its desired state.
+func ensureEntry(
+	ctx context.Context,
+	p Provider,
+	entry EnsureEntry,
+	installed map[string]string,
+	updates map[string]string,
+) (*Result, error) {
+	current, isInstalled := installed[entry.Name]
+	unchanged := &Result{Name: entry.Name}
+
+	switch entry.State {
+	case StateAbsent:
+		if !isInstalled {
+			return unchanged, nil
+		}
+
+		return p.Remove(ctx, entry.Name)
+	case StatePresent:
+		if isInstalled && (entry.Version == "" || entry.Version == current) {
+			return unchanged, nil
+		}
+
+		return p.Install(ctx, entry.Name, entry.Version)
+	case StateLatest:
+		newVersion, hasUpdate := updates[entry.Name]
+		if isInstalled && !hasUpdate {
+			return unchanged, nil
+		}
+
+		if isInstalled {
+			return installHeld(ctx, p, entry.Name, newVersion)
+		}
+
+		return p.Install(ctx, entry.Name, newVersion)
+	case StatePinned:
+		if entry.Version == "" {
+			return nil, fmt.Errorf("package: ensure %q: version required for state %q",
+				entry.Name, StatePinned)
+		}
+
+		changed := false
+		if !isInstalled || entry.Version != current {
+			var r *Result
+			var err error
+			if isInstalled {
+				r, err = installHeld(ctx, p, entry.Name, entry.Version)
+			} else {
+				r, err = p.Install(ctx, entry.Name, entry.Version)
+			}
+			if err != nil {
+				return nil, err
+			}
+			changed = r.Changed
+		}
+
+		r, err := p.Hold(ctx, entry.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Result{
+			Name:    entry.Name,
+			Changed: changed || r.Changed,
+		}, nil
+	default:
+		return nil, fmt.Errorf("package: ensure %q: unsupported state %q",
+			entry.Name, entry.State)
+	}
+}
+
+// installHeld installs version of an installed package that may be held.
+// apt-get refuses to change held packages and dnf versionlock hides every
+// other version, so an existing hold is lifted for the install and put
+// back afterwards, also when the install fails. Platforms without holds
+// install directly.
+func installHeld(
+	ctx context.Context,
+	p Provider,
+	name string,
+	version string,
+) (*Result, error) {
+	unheld, err := p.Unhold(ctx, name)
+	if errors.Is(err, provider.ErrUnsupported) {
+		return p.Install(ctx, name, version)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	r, installErr := p.Install(ctx, name, version)
+
+	if unheld.Changed {
+		if _, err := p.Hold(ctx, name); err != nil && installErr == nil {
+			return nil, err
+		}
+	}
+
+	if installErr != nil {
+		return nil, installErr
+	}
+
+	return r, nil
+}
+
+// listUpdateVersions returns a map of package name to the newest available
+// version for every package with a pending update.
+func listUpdateVersions(
+	ctx context.Context,
+	p Provider,
+) (map[string]string, error) {
+	updates, err := p.ListUpdates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(map[string]string, len(updates))
+	for _, u := range updates {
+		versions[u.Name] = u.NewVersion
+	}
+
+	return versions, nil
+}
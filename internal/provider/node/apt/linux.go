@@ -55,6 +55,15 @@ func (l *Linux) Get(
 func (l *Linux) Install(
 	_ context.Context,
 	_ string,
+	_ string,
+) (*Result, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// InstallObject returns ErrUnsupported on generic Linux.
+func (l *Linux) InstallObject(
+	_ context.Context,
+	_ string,
 ) (*Result, error) {
 	return nil, provider.ErrUnsupported
 }
@@ -80,3 +89,66 @@ func (l *Linux) ListUpdates(
 ) ([]Update, error) {
 	return nil, provider.ErrUnsupported
 }
+
+// Hold returns ErrUnsupported on generic Linux.
+func (l *Linux) Hold(
+	_ context.Context,
+	_ string,
+) (*Result, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// Unhold returns ErrUnsupported on generic Linux.
+func (l *Linux) Unhold(
+	_ context.Context,
+	_ string,
+) (*Result, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// Ensure returns ErrUnsupported on generic Linux.
+func (l *Linux) Ensure(
+	_ context.Context,
+	_ []EnsureEntry,
+) (*EnsureResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// ListRepos returns ErrUnsupported on Linux.
+func (l *Linux) ListRepos(
+	_ context.Context,
+) ([]Repo, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// GetRepo returns ErrUnsupported on Linux.
+func (l *Linux) GetRepo(
+	_ context.Context,
+	_ string,
+) (*Repo, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// CreateRepo returns ErrUnsupported on Linux.
+func (l *Linux) CreateRepo(
+	_ context.Context,
+	_ Repo,
+) (*RepoResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// UpdateRepo returns ErrUnsupported on Linux.
+func (l *Linux) UpdateRepo(
+	_ context.Context,
+	_ Repo,
+) (*RepoResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// DeleteRepo returns ErrUnsupported on Linux.
+func (l *Linux) DeleteRepo(
+	_ context.Context,
+	_ string,
+) (*RepoResult, error) {
+	return nil, provider.ErrUnsupported
+}
@@ -89,7 +89,26 @@ func (suite *LinuxPublicTestSuite) TestInstall() {
 
 	for _, tc := range tests {
 		suite.Run(tc.name, func() {
-			got, err := suite.provider.Install(context.Background(), "vim")
+			got, err := suite.provider.Install(context.Background(), "vim", "")
+
+			suite.Nil(got)
+			suite.ErrorIs(err, provider.ErrUnsupported)
+		})
+	}
+}
+
+func (suite *LinuxPublicTestSuite) TestInstallObject() {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "returns not implemented error",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			got, err := suite.provider.InstallObject(context.Background(), "vim.deb")
 
 			suite.Nil(got)
 			suite.ErrorIs(err, provider.ErrUnsupported)
@@ -154,6 +173,112 @@ func (suite *LinuxPublicTestSuite) TestListUpdates() {
 	}
 }
 
+func (suite *LinuxPublicTestSuite) TestHold() {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "returns not implemented error",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			got, err := suite.provider.Hold(context.Background(), "vim")
+
+			suite.Nil(got)
+			suite.ErrorIs(err, provider.ErrUnsupported)
+		})
+	}
+}
+
+func (suite *LinuxPublicTestSuite) TestUnhold() {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "returns not implemented error",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			got, err := suite.provider.Unhold(context.Background(), "vim")
+
+			suite.Nil(got)
+			suite.ErrorIs(err, provider.ErrUnsupported)
+		})
+	}
+}
+
+func (suite *LinuxPublicTestSuite) TestEnsure() {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "returns not implemented error",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			got, err := suite.provider.Ensure(
+				context.Background(),
+				[]apt.EnsureEntry{{Name: "vim", State: apt.StatePresent}},
+			)
+
+			suite.Nil(got)
+			suite.ErrorIs(err, provider.ErrUnsupported)
+		})
+	}
+}
+
+func (suite *LinuxPublicTestSuite) TestRepoOperations() {
+	tests := []struct {
+		name string
+		fn   func() (any, error)
+	}{
+		{
+			name: "list repos returns not implemented error",
+			fn: func() (any, error) {
+				return suite.provider.ListRepos(context.Background())
+			},
+		},
+		{
+			name: "get repo returns not implemented error",
+			fn: func() (any, error) {
+				return suite.provider.GetRepo(context.Background(), "docker")
+			},
+		},
+		{
+			name: "create repo returns not implemented error",
+			fn: func() (any, error) {
+				return suite.provider.CreateRepo(context.Background(), apt.Repo{Name: "docker"})
+			},
+		},
+		{
+			name: "update repo returns not implemented error",
+			fn: func() (any, error) {
+				return suite.provider.UpdateRepo(context.Background(), apt.Repo{Name: "docker"})
+			},
+		},
+		{
+			name: "delete repo returns not implemented error",
+			fn: func() (any, error) {
+				return suite.provider.DeleteRepo(context.Background(), "docker")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			_, err := tc.fn()
+
+			suite.ErrorIs(err, provider.ErrUnsupported)
+		})
+	}
+}
+
 // In order for `go test` to run this suite, we need to create
 // a normal test function and pass our suite to suite.Run.
 func TestLinuxPublicTestSuite(t *testing.T) {
@@ -41,6 +41,51 @@ func (m *MockProvider) EXPECT() *MockProviderMockRecorder {
 	return m.recorder
 }
 
+// CreateRepo mocks base method.
+func (m *MockProvider) CreateRepo(ctx context.Context, repo apt.Repo) (*apt.RepoResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRepo", ctx, repo)
+	ret0, _ := ret[0].(*apt.RepoResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRepo indicates an expected call of CreateRepo.
+func (mr *MockProviderMockRecorder) CreateRepo(ctx, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRepo", reflect.TypeOf((*MockProvider)(nil).CreateRepo), ctx, repo)
+}
+
+// DeleteRepo mocks base method.
+func (m *MockProvider) DeleteRepo(ctx context.Context, name string) (*apt.RepoResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRepo", ctx, name)
+	ret0, _ := ret[0].(*apt.RepoResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRepo indicates an expected call of DeleteRepo.
+func (mr *MockProviderMockRecorder) DeleteRepo(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepo", reflect.TypeOf((*MockProvider)(nil).DeleteRepo), ctx, name)
+}
+
+// Ensure mocks base method.
+func (m *MockProvider) Ensure(ctx context.Context, entries []apt.EnsureEntry) (*apt.EnsureResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ensure", ctx, entries)
+	ret0, _ := ret[0].(*apt.EnsureResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ensure indicates an expected call of Ensure.
+func (mr *MockProviderMockRecorder) Ensure(ctx, entries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ensure", reflect.TypeOf((*MockProvider)(nil).Ensure), ctx, entries)
+}
+
 // Get mocks base method.
 func (m *MockProvider) Get(ctx context.Context, name string) (*apt.Package, error) {
 	m.ctrl.T.Helper()
@@ -56,19 +101,64 @@ func (mr *MockProviderMockRecorder) Get(ctx, name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProvider)(nil).Get), ctx, name)
 }
 
+// GetRepo mocks base method.
+func (m *MockProvider) GetRepo(ctx context.Context, name string) (*apt.Repo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepo", ctx, name)
+	ret0, _ := ret[0].(*apt.Repo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepo indicates an expected call of GetRepo.
+func (mr *MockProviderMockRecorder) GetRepo(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepo", reflect.TypeOf((*MockProvider)(nil).GetRepo), ctx, name)
+}
+
+// Hold mocks base method.
+func (m *MockProvider) Hold(ctx context.Context, name string) (*apt.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hold", ctx, name)
+	ret0, _ := ret[0].(*apt.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hold indicates an expected call of Hold.
+func (mr *MockProviderMockRecorder) Hold(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hold", reflect.TypeOf((*MockProvider)(nil).Hold), ctx, name)
+}
+
 // Install mocks base method.
-func (m *MockProvider) Install(ctx context.Context, name string) (*apt.Result, error) {
+func (m *MockProvider) Install(ctx context.Context, name, version string) (*apt.Result, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Install", ctx, name)
+	ret := m.ctrl.Call(m, "Install", ctx, name, version)
 	ret0, _ := ret[0].(*apt.Result)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Install indicates an expected call of Install.
-func (mr *MockProviderMockRecorder) Install(ctx, name any) *gomock.Call {
+func (mr *MockProviderMockRecorder) Install(ctx, name, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Install", reflect.TypeOf((*MockProvider)(nil).Install), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Install", reflect.TypeOf((*MockProvider)(nil).Install), ctx, name, version)
+}
+
+// InstallObject mocks base method.
+func (m *MockProvider) InstallObject(ctx context.Context, object string) (*apt.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallObject", ctx, object)
+	ret0, _ := ret[0].(*apt.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstallObject indicates an expected call of InstallObject.
+func (mr *MockProviderMockRecorder) InstallObject(ctx, object any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallObject", reflect.TypeOf((*MockProvider)(nil).InstallObject), ctx, object)
 }
 
 // List mocks base method.
@@ -86,6 +176,21 @@ func (mr *MockProviderMockRecorder) List(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProvider)(nil).List), ctx)
 }
 
+// ListRepos mocks base method.
+func (m *MockProvider) ListRepos(ctx context.Context) ([]apt.Repo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepos", ctx)
+	ret0, _ := ret[0].([]apt.Repo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRepos indicates an expected call of ListRepos.
+func (mr *MockProviderMockRecorder) ListRepos(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepos", reflect.TypeOf((*MockProvider)(nil).ListRepos), ctx)
+}
+
 // ListUpdates mocks base method.
 func (m *MockProvider) ListUpdates(ctx context.Context) ([]apt.Update, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +221,21 @@ func (mr *MockProviderMockRecorder) Remove(ctx, name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockProvider)(nil).Remove), ctx, name)
 }
 
+// Unhold mocks base method.
+func (m *MockProvider) Unhold(ctx context.Context, name string) (*apt.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unhold", ctx, name)
+	ret0, _ := ret[0].(*apt.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unhold indicates an expected call of Unhold.
+func (mr *MockProviderMockRecorder) Unhold(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unhold", reflect.TypeOf((*MockProvider)(nil).Unhold), ctx, name)
+}
+
 // Update mocks base method.
 func (m *MockProvider) Update(ctx context.Context) (*apt.Result, error) {
 	m.ctrl.T.Helper()
@@ -130,3 +250,18 @@ func (mr *MockProviderMockRecorder) Update(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProvider)(nil).Update), ctx)
 }
+
+// UpdateRepo mocks base method.
+func (m *MockProvider) UpdateRepo(ctx context.Context, repo apt.Repo) (*apt.RepoResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRepo", ctx, repo)
+	ret0, _ := ret[0].(*apt.RepoResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRepo indicates an expected call of UpdateRepo.
+func (mr *MockProviderMockRecorder) UpdateRepo(ctx, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRepo", reflect.TypeOf((*MockProvider)(nil).UpdateRepo), ctx, repo)
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package apt
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
+
+	"github.com/avfs/avfs"
+
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/file"
+)
+
+// artifactDir is where package artifacts fetched from the Object Store
+// are staged before installation.
+const artifactDir = "/var/cache/osapi/packages"
+
+// artifactInstaller is implemented by platform providers that can install
+// a package file staged on local disk.
+type artifactInstaller interface {
+	// Get returns the installed package, or an error when it is not
+	// installed.
+	Get(ctx context.Context, name string) (*Package, error)
+	// inspectArtifact reads the package name and version from a staged
+	// artifact without installing it.
+	inspectArtifact(path string) (string, string, error)
+	// installArtifact installs a staged artifact with the native
+	// package manager. version is the artifact's version and installed
+	// the currently installed package, or nil.
+	installArtifact(path string, version string, installed *Package) error
+}
+
+// installObject stages a package artifact from the Object Store through
+// the file deployer, verifies the staged bytes against the deployed
+// SHA-256, and installs it with inst. The artifact's own name and version
+// decide idempotency: when that exact version is already installed the
+// package manager is not invoked and changed is false. After installing,
+// the installed version must match the artifact.
+func installObject(
+	ctx context.Context,
+	inst artifactInstaller,
+	logger *slog.Logger,
+	fs avfs.VFS,
+	deployer file.Deployer,
+	object string,
+	ext string,
+) (*Result, error) {
+	if deployer == nil {
+		return nil, provider.ErrUnsupported
+	}
+
+	path := artifactPath(object, ext)
+
+	deployed, err := deployer.Deploy(ctx, file.DeployRequest{
+		ObjectName:  object,
+		Path:        path,
+		Mode:        "0600",
+		ContentType: "raw",
+		Metadata:    map[string]string{"package_artifact": object},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("package: install object %q: %w", object, err)
+	}
+
+	if err := verifyArtifact(fs, path, deployed.SHA256); err != nil {
+		return nil, fmt.Errorf("package: install object %q: %w", object, err)
+	}
+
+	name, version, err := inst.inspectArtifact(path)
+	if err != nil {
+		return nil, fmt.Errorf("package: install object %q: %w", object, err)
+	}
+
+	before, _ := inst.Get(ctx, name)
+	if before != nil && before.Version == version {
+		logger.Debug(
+			"package artifact already installed",
+			slog.String("name", name),
+			slog.String("version", version),
+		)
+
+		return &Result{
+			Name:    name,
+			Version: version,
+			Changed: false,
+		}, nil
+	}
+
+	if err := inst.installArtifact(path, version, before); err != nil {
+		return nil, fmt.Errorf("package: install object %q: %w", object, err)
+	}
+
+	after, err := inst.Get(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("package: install object %q: %w", object, err)
+	}
+
+	if after.Version != version {
+		return nil, fmt.Errorf(
+			"package: install object %q: installed version %q does not match artifact version %q",
+			object,
+			after.Version,
+			version,
+		)
+	}
+
+	changed := before == nil || before.Version != after.Version
+
+	logger.Info(
+		"package installed from object",
+		slog.String("object", object),
+		slog.String("name", name),
+		slog.String("version", after.Version),
+		slog.Bool("changed", changed),
+	)
+
+	return &Result{
+		Name:    name,
+		Version: after.Version,
+		Changed: changed,
+	}, nil
+}
+
+// verifyArtifact checks that the staged file on disk hashes to the
+// SHA-256 recorded by the deployer, so a truncated or tampered file is
+// never handed to the package manager.
+func verifyArtifact(
+	fs avfs.VFS,
+	path string,
+	want string,
+) error {
+	data, err := fs.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read staged artifact: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	if got := hex.EncodeToString(sum[:]); got != want {
+		return fmt.Errorf("sha256 mismatch: staged %s, expected %s", got, want)
+	}
+
+	return nil
+}
+
+// artifactPath returns the staging path for an object. The package
+// manager extension is appended when the object name lacks it, since apt
+// and dnf only treat arguments ending in .deb or .rpm as local files.
+func artifactPath(
+	object string,
+	ext string,
+) string {
+	name := filepath.Base(object)
+	if !strings.HasSuffix(name, ext) {
+		name += ext
+	}
+
+	return filepath.Join(artifactDir, name)
+}
@@ -0,0 +1,467 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package apt
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/avfs/avfs"
+
+	"github.com/osapi-io/osapi/internal/exec"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/file"
+)
+
+// rpmQueryFormat is the format string for rpm query output.
+const rpmQueryFormat = "%{NAME}\t%{EVR}\t%{SUMMARY}\t%{SIZE}\n"
+
+// dnfNothingToDo is printed by dnf when a transaction has no work.
+const dnfNothingToDo = "Nothing to do"
+
+// Compile-time checks.
+var (
+	_ Provider             = (*RHEL)(nil)
+	_ provider.FactsSetter = (*RHEL)(nil)
+)
+
+// RHEL implements the Provider interface for RHEL-family systems
+// (RHEL, Rocky, Alma, CentOS, Fedora) using rpm and dnf.
+type RHEL struct {
+	provider.FactsAware
+	logger       *slog.Logger
+	fs           avfs.VFS
+	fileDeployer file.Deployer
+	execManager  exec.Manager
+}
+
+// NewRHELProvider factory to create a new RHEL instance. A nil
+// fileDeployer disables installing packages from the Object Store.
+func NewRHELProvider(
+	logger *slog.Logger,
+	fs avfs.VFS,
+	fileDeployer file.Deployer,
+	execManager exec.Manager,
+) *RHEL {
+	return &RHEL{
+		logger:       logger.With(slog.String("subsystem", "provider.dnf")),
+		fs:           fs,
+		fileDeployer: fileDeployer,
+		execManager:  execManager,
+	}
+}
+
+// List returns all installed packages by querying rpm.
+func (r *RHEL) List(
+	_ context.Context,
+) ([]Package, error) {
+	output, err := r.execManager.RunCmd(
+		"rpm",
+		[]string{"-qa", "--queryformat", rpmQueryFormat},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("package: list: %w", err)
+	}
+
+	return r.parsePackages(output), nil
+}
+
+// Get returns details for a single installed package.
+func (r *RHEL) Get(
+	_ context.Context,
+	name string,
+) (*Package, error) {
+	output, err := r.execManager.RunCmd(
+		"rpm",
+		[]string{"-q", "--queryformat", rpmQueryFormat, name},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("package: get %q: %w", name, err)
+	}
+
+	pkgs := r.parsePackages(output)
+	if len(pkgs) == 0 {
+		return nil, fmt.Errorf("package: get %q: not found", name)
+	}
+
+	return &pkgs[0], nil
+}
+
+// Install installs a package by name using dnf. When version is set the
+// package is installed as name-version, and when that version is older
+// than the installed one dnf downgrade is used instead, since dnf install
+// refuses to downgrade. Returns changed=false when dnf reports there is
+// nothing to do.
+func (r *RHEL) Install(
+	ctx context.Context,
+	name string,
+	version string,
+) (*Result, error) {
+	spec := name
+	if version != "" {
+		spec = name + "-" + version
+	}
+
+	action := "install"
+	if version != "" && r.isDowngrade(ctx, name, version) {
+		action = "downgrade"
+	}
+
+	output, err := r.execManager.RunPrivilegedCmd(
+		"dnf",
+		[]string{action, "-y", spec},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("package: install %q: %w", name, err)
+	}
+
+	if strings.Contains(output, dnfNothingToDo) {
+		r.logger.Debug(
+			"package already installed",
+			slog.String("name", name),
+		)
+
+		return &Result{
+			Name:    name,
+			Changed: false,
+		}, nil
+	}
+
+	r.logger.Info(
+		"package installed",
+		slog.String("name", name),
+		slog.String("version", version),
+	)
+
+	return &Result{
+		Name:    name,
+		Changed: true,
+	}, nil
+}
+
+// isDowngrade reports whether version is older than the installed version
+// of name. A package that is not installed is never a downgrade.
+func (r *RHEL) isDowngrade(
+	ctx context.Context,
+	name string,
+	version string,
+) bool {
+	pkg, err := r.Get(ctx, name)
+	if err != nil {
+		return false
+	}
+
+	return compareEVR(version, pkg.Version) < 0
+}
+
+// Remove removes a package by name using dnf. Returns changed=false
+// when dnf reports there is nothing to do.
+func (r *RHEL) Remove(
+	_ context.Context,
+	name string,
+) (*Result, error) {
+	output, err := r.execManager.RunPrivilegedCmd(
+		"dnf",
+		[]string{"remove", "-y", name},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("package: remove %q: %w", name, err)
+	}
+
+	if strings.Contains(output, dnfNothingToDo) {
+		r.logger.Debug(
+			"package not installed",
+			slog.String("name", name),
+		)
+
+		return &Result{
+			Name:    name,
+			Changed: false,
+		}, nil
+	}
+
+	r.logger.Info(
+		"package removed",
+		slog.String("name", name),
+	)
+
+	return &Result{
+		Name:    name,
+		Changed: true,
+	}, nil
+}
+
+// Update refreshes the package metadata cache using dnf makecache.
+func (r *RHEL) Update(
+	_ context.Context,
+) (*Result, error) {
+	_, err := r.execManager.RunPrivilegedCmd(
+		"dnf",
+		[]string{"makecache"},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("package: update: %w", err)
+	}
+
+	r.logger.Info("package index updated")
+
+	return &Result{
+		Changed: true,
+	}, nil
+}
+
+// ListUpdates returns packages with available updates by parsing
+// dnf list --upgrades output. The current version of each package
+// is resolved from the rpm database.
+func (r *RHEL) ListUpdates(
+	ctx context.Context,
+) ([]Update, error) {
+	output, err := r.execManager.RunCmd(
+		"dnf",
+		[]string{"-q", "list", "--upgrades"},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("package: list updates: %w", err)
+	}
+
+	installed, err := r.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("package: list updates: %w", err)
+	}
+
+	current := make(map[string]string, len(installed))
+	for _, pkg := range installed {
+		current[pkg.Name] = pkg.Version
+	}
+
+	return r.parseUpdates(output, current), nil
+}
+
+// Hold locks a package at its installed version using the dnf
+// versionlock plugin. Returns Changed=false when a lock already exists.
+func (r *RHEL) Hold(
+	_ context.Context,
+	name string,
+) (*Result, error) {
+	locked, err := r.isLocked(name)
+	if err != nil {
+		return nil, fmt.Errorf("package: hold %q: %w", name, err)
+	}
+
+	if locked {
+		return &Result{Name: name, Changed: false}, nil
+	}
+
+	if _, err := r.execManager.RunPrivilegedCmd(
+		"dnf",
+		[]string{"versionlock", "add", name},
+	); err != nil {
+		return nil, fmt.Errorf("package: hold %q: %w", name, err)
+	}
+
+	r.logger.Info(
+		"package held",
+		slog.String("name", name),
+	)
+
+	return &Result{
+		Name:    name,
+		Changed: true,
+	}, nil
+}
+
+// Unhold removes a dnf versionlock entry for a package. Returns
+// Changed=false when the package is not locked.
+func (r *RHEL) Unhold(
+	_ context.Context,
+	name string,
+) (*Result, error) {
+	locked, err := r.isLocked(name)
+	if err != nil {
+		return nil, fmt.Errorf("package: unhold %q: %w", name, err)
+	}
+
+	if !locked {
+		return &Result{Name: name, Changed: false}, nil
+	}
+
+	if _, err := r.execManager.RunPrivilegedCmd(
+		"dnf",
+		[]string{"versionlock", "delete", name},
+	); err != nil {
+		return nil, fmt.Errorf("package: unhold %q: %w", name, err)
+	}
+
+	r.logger.Info(
+		"package unheld",
+		slog.String("name", name),
+	)
+
+	return &Result{
+		Name:    name,
+		Changed: true,
+	}, nil
+}
+
+// Ensure converges installed packages to the desired manifest.
+func (r *RHEL) Ensure(
+	ctx context.Context,
+	entries []EnsureEntry,
+) (*EnsureResult, error) {
+	return ensure(ctx, r, entries)
+}
+
+// ListRepos returns ErrUnsupported on RHEL. Repository management
+// deploys deb822 sources and is only implemented for apt.
+func (r *RHEL) ListRepos(
+	_ context.Context,
+) ([]Repo, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// GetRepo returns ErrUnsupported on RHEL.
+func (r *RHEL) GetRepo(
+	_ context.Context,
+	_ string,
+) (*Repo, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// CreateRepo returns ErrUnsupported on RHEL.
+func (r *RHEL) CreateRepo(
+	_ context.Context,
+	_ Repo,
+) (*RepoResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// UpdateRepo returns ErrUnsupported on RHEL.
+func (r *RHEL) UpdateRepo(
+	_ context.Context,
+	_ Repo,
+) (*RepoResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// DeleteRepo returns ErrUnsupported on RHEL.
+func (r *RHEL) DeleteRepo(
+	_ context.Context,
+	_ string,
+) (*RepoResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// isLocked reports whether dnf versionlock has an entry for a package.
+// Entries are listed as name-[epoch:]version-release.* by dnf4 and as
+// "Package name: name" by dnf5.
+func (r *RHEL) isLocked(
+	name string,
+) (bool, error) {
+	output, err := r.execManager.RunCmd(
+		"dnf",
+		[]string{"versionlock", "list"},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.TrimPrefix(line, "Package name: ") == name {
+			return true, nil
+		}
+
+		rest, ok := strings.CutPrefix(line, name+"-")
+		if ok && rest != "" && rest[0] >= '0' && rest[0] <= '9' {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// parsePackages parses rpm tab-separated output into Package slices.
+// SIZE is already reported in bytes.
+func (r *RHEL) parsePackages(
+	output string,
+) []Package {
+	var result []Package
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		fields := strings.SplitN(line, "\t", 4)
+		if len(fields) < 4 {
+			continue
+		}
+
+		size, _ := strconv.ParseInt(strings.TrimSpace(fields[3]), 10, 64)
+
+		result = append(result, Package{
+			Name:        strings.TrimSpace(fields[0]),
+			Version:     strings.TrimSpace(fields[1]),
+			Description: strings.TrimSpace(fields[2]),
+			Status:      "installed",
+			Size:        size,
+		})
+	}
+
+	return result
+}
+
+// parseUpdates parses dnf list --upgrades output. Each line has the
+// format: name.arch version repo
+// Header lines ("Available Upgrades", "Last metadata ...") are skipped.
+func (r *RHEL) parseUpdates(
+	output string,
+	current map[string]string,
+) []Update {
+	var result []Update
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
+		}
+
+		dotIdx := strings.LastIndex(fields[0], ".")
+		if dotIdx <= 0 {
+			continue
+		}
+
+		name := fields[0][:dotIdx]
+
+		result = append(result, Update{
+			Name:           name,
+			CurrentVersion: current[name],
+			NewVersion:     fields[1],
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package apt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// InstallObject installs an .rpm artifact from the Object Store. The
+// artifact is staged through the file deployer, verified, and installed
+// with dnf so its dependencies are resolved from configured repositories.
+func (r *RHEL) InstallObject(
+	ctx context.Context,
+	object string,
+) (*Result, error) {
+	return installObject(ctx, r, r.logger, r.fs, r.fileDeployer, object, ".rpm")
+}
+
+// inspectArtifact reads the package name and version from an .rpm with
+// rpm -qp. The version uses the same EVR format as List and Get.
+func (r *RHEL) inspectArtifact(
+	path string,
+) (string, string, error) {
+	output, err := r.execManager.RunCmd(
+		"rpm",
+		[]string{"-qp", "--queryformat", "%{NAME}\t%{EVR}", path},
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("inspect artifact: %w", err)
+	}
+
+	name, version, ok := strings.Cut(strings.TrimSpace(output), "\t")
+	if !ok || name == "" || version == "" {
+		return "", "", fmt.Errorf("inspect artifact: unexpected rpm output %q", output)
+	}
+
+	return name, version, nil
+}
+
+// installArtifact installs a local .rpm with dnf. When the artifact is
+// older than the installed package dnf downgrade is used, matching the
+// --allow-downgrades behaviour of the Debian provider.
+func (r *RHEL) installArtifact(
+	path string,
+	version string,
+	installed *Package,
+) error {
+	action := "install"
+	if installed != nil && compareEVR(version, installed.Version) < 0 {
+		action = "downgrade"
+	}
+
+	if _, err := r.execManager.RunPrivilegedCmd(
+		"dnf",
+		[]string{action, "-y", path},
+	); err != nil {
+		return fmt.Errorf("dnf %s: %w", action, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,296 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package apt_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execMocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/file"
+	fileMocks "github.com/osapi-io/osapi/internal/provider/file/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/apt"
+)
+
+type RHELObjectPublicTestSuite struct {
+	suite.Suite
+
+	ctrl         *gomock.Controller
+	logger       *slog.Logger
+	memFs        avfs.VFS
+	mockDeployer *fileMocks.MockDeployer
+	mockExec     *execMocks.MockManager
+	provider     *apt.RHEL
+}
+
+func (suite *RHELObjectPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+	suite.mockDeployer = fileMocks.NewMockDeployer(suite.ctrl)
+	suite.mockExec = execMocks.NewMockManager(suite.ctrl)
+
+	_ = suite.memFs.MkdirAll("/var/cache/osapi/packages", 0o755)
+
+	suite.provider = apt.NewRHELProvider(
+		suite.logger,
+		suite.memFs,
+		suite.mockDeployer,
+		suite.mockExec,
+	)
+}
+
+func (suite *RHELObjectPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *RHELObjectPublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+// expectStaged stages content at path as the deployer would.
+func (suite *RHELObjectPublicTestSuite) expectStaged(
+	object string,
+	path string,
+	content []byte,
+) {
+	suite.mockDeployer.EXPECT().
+		Deploy(gomock.Any(), file.DeployRequest{
+			ObjectName:  object,
+			Path:        path,
+			Mode:        "0600",
+			ContentType: "raw",
+			Metadata:    map[string]string{"package_artifact": object},
+		}).
+		DoAndReturn(func(
+			_ context.Context,
+			_ file.DeployRequest,
+		) (*file.DeployResult, error) {
+			_ = suite.memFs.WriteFile(path, content, 0o600)
+
+			return &file.DeployResult{
+				Changed: true,
+				SHA256:  artifactSHA(content),
+				Path:    path,
+			}, nil
+		})
+}
+
+func (suite *RHELObjectPublicTestSuite) expectInspect(
+	path string,
+	output string,
+	err error,
+) {
+	suite.mockExec.EXPECT().
+		RunCmd("rpm", []string{"-qp", "--queryformat", "%{NAME}\t%{EVR}", path}).
+		Return(output, err)
+}
+
+func (suite *RHELObjectPublicTestSuite) expectGet(
+	output string,
+	err error,
+) {
+	suite.mockExec.EXPECT().
+		RunCmd("rpm", []string{"-q", "--queryformat", "%{NAME}\t%{EVR}\t%{SUMMARY}\t%{SIZE}\n", "hello"}).
+		Return(output, err)
+}
+
+func (suite *RHELObjectPublicTestSuite) expectInstall(
+	path string,
+	err error,
+) {
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("dnf", []string{"install", "-y", path}).
+		Return("", err)
+}
+
+func (suite *RHELObjectPublicTestSuite) expectDowngrade(
+	path string,
+	err error,
+) {
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("dnf", []string{"downgrade", "-y", path}).
+		Return("", err)
+}
+
+func (suite *RHELObjectPublicTestSuite) TestInstallObject() {
+	const (
+		object = "hello-1.0-1.el9.x86_64.rpm"
+		path   = "/var/cache/osapi/packages/hello-1.0-1.el9.x86_64.rpm"
+	)
+	content := []byte("rpm-bytes")
+
+	tests := []struct {
+		name         string
+		setupMock    func()
+		wantErr      bool
+		wantErrType  error
+		errContains  string
+		validateFunc func(*apt.Result)
+	}{
+		{
+			name: "when package not yet installed",
+			setupMock: func() {
+				suite.expectStaged(object, path, content)
+				suite.expectInspect(path, "hello\t1.0-1.el9", nil)
+				suite.expectGet("", errors.New("package hello is not installed"))
+				suite.expectInstall(path, nil)
+				suite.expectGet("hello\t1.0-1.el9\tHello\t10\n", nil)
+			},
+			validateFunc: func(r *apt.Result) {
+				suite.Equal("hello", r.Name)
+				suite.Equal("1.0-1.el9", r.Version)
+				suite.True(r.Changed)
+			},
+		},
+		{
+			name: "when same version already installed",
+			setupMock: func() {
+				suite.expectStaged(object, path, content)
+				suite.expectInspect(path, "hello\t1.0-1.el9", nil)
+				suite.expectGet("hello\t1.0-1.el9\tHello\t10\n", nil)
+			},
+			validateFunc: func(r *apt.Result) {
+				suite.Equal("1.0-1.el9", r.Version)
+				suite.False(r.Changed)
+			},
+		},
+		{
+			name: "when different version installed",
+			setupMock: func() {
+				suite.expectStaged(object, path, content)
+				suite.expectInspect(path, "hello\t1.0-1.el9", nil)
+				suite.expectGet("hello\t0.9-1.el9\tHello\t10\n", nil)
+				suite.expectInstall(path, nil)
+				suite.expectGet("hello\t1.0-1.el9\tHello\t10\n", nil)
+			},
+			validateFunc: func(r *apt.Result) {
+				suite.Equal("1.0-1.el9", r.Version)
+				suite.True(r.Changed)
+			},
+		},
+		{
+			name: "when newer version installed downgrades",
+			setupMock: func() {
+				suite.expectStaged(object, path, content)
+				suite.expectInspect(path, "hello\t1.0-1.el9", nil)
+				suite.expectGet("hello\t1.10-1.el9\tHello\t10\n", nil)
+				suite.expectDowngrade(path, nil)
+				suite.expectGet("hello\t1.0-1.el9\tHello\t10\n", nil)
+			},
+			validateFunc: func(r *apt.Result) {
+				suite.Equal("1.0-1.el9", r.Version)
+				suite.True(r.Changed)
+			},
+		},
+		{
+			name: "when downgrade fails",
+			setupMock: func() {
+				suite.expectStaged(object, path, content)
+				suite.expectInspect(path, "hello\t1.0-1.el9", nil)
+				suite.expectGet("hello\t1.0-2.el9\tHello\t10\n", nil)
+				suite.expectDowngrade(path, errors.New("no match"))
+			},
+			wantErr:     true,
+			errContains: "dnf downgrade: no match",
+		},
+		{
+			name: "when artifact metadata is malformed",
+			setupMock: func() {
+				suite.expectStaged(object, path, content)
+				suite.expectInspect(path, "hello", nil)
+			},
+			wantErr:     true,
+			errContains: "unexpected rpm output",
+		},
+		{
+			name: "when inspect fails",
+			setupMock: func() {
+				suite.expectStaged(object, path, content)
+				suite.expectInspect(path, "", errors.New("not a package"))
+			},
+			wantErr:     true,
+			errContains: "inspect artifact: not a package",
+		},
+		{
+			name: "when install fails",
+			setupMock: func() {
+				suite.expectStaged(object, path, content)
+				suite.expectInspect(path, "hello\t1.0-1.el9", nil)
+				suite.expectGet("", errors.New("package hello is not installed"))
+				suite.expectInstall(path, errors.New("conflict"))
+			},
+			wantErr:     true,
+			errContains: "dnf install: conflict",
+		},
+		{
+			name: "when no file deployer is configured",
+			setupMock: func() {
+				suite.provider = apt.NewRHELProvider(
+					suite.logger,
+					suite.memFs,
+					nil,
+					suite.mockExec,
+				)
+			},
+			wantErr:     true,
+			wantErrType: provider.ErrUnsupported,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.InstallObject(context.Background(), object)
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Nil(got)
+				if tc.wantErrType != nil {
+					suite.ErrorIs(err, tc.wantErrType)
+				}
+				if tc.errContains != "" {
+					suite.Contains(err.Error(), tc.errContains)
+				}
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NotNil(got)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func TestRHELObjectPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(RHELObjectPublicTestSuite))
+}
@@ -0,0 +1,995 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package apt_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execMocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/node/apt"
+)
+
+type RHELPublicTestSuite struct {
+	suite.Suite
+
+	ctrl        *gomock.Controller
+	mockExec    *execMocks.MockManager
+	logger      *slog.Logger
+	provider    *apt.RHEL
+	rpmFormat   string
+	rpmOutput   string
+	dnfUpOutput string
+}
+
+func (suite *RHELPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockExec = execMocks.NewMockManager(suite.ctrl)
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.provider = apt.NewRHELProvider(
+		suite.logger,
+		memfs.New(),
+		nil,
+		suite.mockExec,
+	)
+	suite.rpmFormat = "%{NAME}\t%{EVR}\t%{SUMMARY}\t%{SIZE}\n"
+	suite.rpmOutput = "vim-enhanced\t2:8.2.2637-20.el9_1\tA version of the VIM editor which includes recent enhancements\t4043485\n" +
+		"curl\t7.76.1-26.el9\tA utility for getting files from remote servers\t707937\n"
+	suite.dnfUpOutput = "Available Upgrades\n" +
+		"vim-enhanced.x86_64    2:8.2.2637-21.el9    appstream\n" +
+		"curl.x86_64            7.76.1-29.el9_4      baseos\n"
+}
+
+func (suite *RHELPublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *RHELPublicTestSuite) TestList() {
+	tests := []struct {
+		name         string
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func([]apt.Package)
+	}{
+		{
+			name: "when list succeeds",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-qa", "--queryformat", suite.rpmFormat}).
+					Return(suite.rpmOutput, nil)
+			},
+			validateFunc: func(pkgs []apt.Package) {
+				suite.Require().Len(pkgs, 2)
+				suite.Equal("vim-enhanced", pkgs[0].Name)
+				suite.Equal("2:8.2.2637-20.el9_1", pkgs[0].Version)
+				suite.Equal(
+					"A version of the VIM editor which includes recent enhancements",
+					pkgs[0].Description,
+				)
+				suite.Equal("installed", pkgs[0].Status)
+				suite.Equal(int64(4043485), pkgs[0].Size)
+				suite.Equal("curl", pkgs[1].Name)
+				suite.Equal(int64(707937), pkgs[1].Size)
+			},
+		},
+		{
+			name: "when exec error",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-qa", "--queryformat", suite.rpmFormat}).
+					Return("", fmt.Errorf("exec failed"))
+			},
+			wantErr:     true,
+			errContains: "package: list:",
+		},
+		{
+			name: "when empty output",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-qa", "--queryformat", suite.rpmFormat}).
+					Return("", nil)
+			},
+			validateFunc: func(pkgs []apt.Package) {
+				suite.Require().Empty(pkgs)
+			},
+		},
+		{
+			name: "when malformed lines are skipped",
+			setupMock: func() {
+				output := "only-two\tfields\n" +
+					"curl\t7.76.1-26.el9\tcurl\t707937\n"
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-qa", "--queryformat", suite.rpmFormat}).
+					Return(output, nil)
+			},
+			validateFunc: func(pkgs []apt.Package) {
+				suite.Require().Len(pkgs, 1)
+				suite.Equal("curl", pkgs[0].Name)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.List(context.Background())
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *RHELPublicTestSuite) TestGet() {
+	tests := []struct {
+		name         string
+		pkgName      string
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func(*apt.Package)
+	}{
+		{
+			name:    "when get succeeds",
+			pkgName: "curl",
+			setupMock: func() {
+				output := "curl\t7.76.1-26.el9\tA utility for getting files from remote servers\t707937\n"
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-q", "--queryformat", suite.rpmFormat, "curl"}).
+					Return(output, nil)
+			},
+			validateFunc: func(pkg *apt.Package) {
+				suite.Require().NotNil(pkg)
+				suite.Equal("curl", pkg.Name)
+				suite.Equal("7.76.1-26.el9", pkg.Version)
+				suite.Equal("installed", pkg.Status)
+				suite.Equal(int64(707937), pkg.Size)
+			},
+		},
+		{
+			name:    "when package not installed",
+			pkgName: "nonexistent",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-q", "--queryformat", suite.rpmFormat, "nonexistent"}).
+					Return("", fmt.Errorf("package nonexistent is not installed"))
+			},
+			wantErr:     true,
+			errContains: "package: get \"nonexistent\":",
+		},
+		{
+			name:    "when output has no packages",
+			pkgName: "curl",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-q", "--queryformat", suite.rpmFormat, "curl"}).
+					Return("", nil)
+			},
+			wantErr:     true,
+			errContains: "package: get \"curl\": not found",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Get(context.Background(), tc.pkgName)
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *RHELPublicTestSuite) TestInstall() {
+	tests := []struct {
+		name         string
+		pkgName      string
+		version      string
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func(*apt.Result)
+	}{
+		{
+			name:    "when install succeeds",
+			pkgName: "vim-enhanced",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"install", "-y", "vim-enhanced"}).
+					Return("Installed:\n  vim-enhanced-2:8.2.2637-20.el9_1.x86_64\n\nComplete!\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("vim-enhanced", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when package already installed",
+			pkgName: "curl",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"install", "-y", "curl"}).
+					Return("Package curl-7.76.1-26.el9.x86_64 is already installed.\n"+
+						"Dependencies resolved.\nNothing to do.\nComplete!\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("curl", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:    "when install with version succeeds",
+			pkgName: "nginx",
+			version: "1.20.1-14.el9",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-q", "--queryformat", suite.rpmFormat, "nginx"}).
+					Return("", fmt.Errorf("package nginx is not installed"))
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"install", "-y", "nginx-1.20.1-14.el9"}).
+					Return("Installed:\n  nginx-1:1.20.1-14.el9.x86_64\n\nComplete!\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("nginx", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when version is newer than installed upgrades",
+			pkgName: "curl",
+			version: "7.76.1-29.el9_4",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-q", "--queryformat", suite.rpmFormat, "curl"}).
+					Return("curl\t7.76.1-26.el9\tcurl\t707937\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"install", "-y", "curl-7.76.1-29.el9_4"}).
+					Return("Upgraded:\n  curl-7.76.1-29.el9_4.x86_64\n\nComplete!\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when version is older than installed downgrades",
+			pkgName: "curl",
+			version: "7.76.1-9.el9",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-q", "--queryformat", suite.rpmFormat, "curl"}).
+					Return("curl\t7.76.1-26.el9\tcurl\t707937\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"downgrade", "-y", "curl-7.76.1-9.el9"}).
+					Return("Downgraded:\n  curl-7.76.1-9.el9.x86_64\n\nComplete!\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("curl", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when version without release is older downgrades",
+			pkgName: "vim-enhanced",
+			version: "8.2.100",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-q", "--queryformat", suite.rpmFormat, "vim-enhanced"}).
+					Return("vim-enhanced\t2:8.2.2637-20.el9_1\tvim\t4043485\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"downgrade", "-y", "vim-enhanced-8.2.100"}).
+					Return("Downgraded:\n  vim-enhanced-2:8.2.100-1.el9.x86_64\n\nComplete!\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when lower epoch is requested downgrades",
+			pkgName: "vim-enhanced",
+			version: "1:9.0.0-1.el9",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-q", "--queryformat", suite.rpmFormat, "vim-enhanced"}).
+					Return("vim-enhanced\t2:8.2.2637-20.el9_1\tvim\t4043485\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"downgrade", "-y", "vim-enhanced-1:9.0.0-1.el9"}).
+					Return("Downgraded:\n  vim-enhanced-1:9.0.0-1.el9.x86_64\n\nComplete!\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when pre-release tilde version is older downgrades",
+			pkgName: "curl",
+			version: "7.76.1~rc1",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-q", "--queryformat", suite.rpmFormat, "curl"}).
+					Return("curl\t7.76.1-26.el9\tcurl\t707937\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"downgrade", "-y", "curl-7.76.1~rc1"}).
+					Return("Downgraded:\n  curl-7.76.1~rc1.x86_64\n\nComplete!\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when alphabetic suffix sorts before numeric upgrades",
+			pkgName: "curl",
+			version: "7.76.1-26.el9.1",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-q", "--queryformat", suite.rpmFormat, "curl"}).
+					Return("curl\t7.76.1-26.el9a\tcurl\t707937\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"install", "-y", "curl-7.76.1-26.el9.1"}).
+					Return("Upgraded:\n  curl-7.76.1-26.el9.1.x86_64\n\nComplete!\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when exec error",
+			pkgName: "badpkg",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"install", "-y", "badpkg"}).
+					Return("", fmt.Errorf("Error: Unable to find a match: badpkg"))
+			},
+			wantErr:     true,
+			errContains: "package: install \"badpkg\":",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Install(context.Background(), tc.pkgName, tc.version)
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *RHELPublicTestSuite) TestRemove() {
+	tests := []struct {
+		name         string
+		pkgName      string
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func(*apt.Result)
+	}{
+		{
+			name:    "when remove succeeds",
+			pkgName: "curl",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"remove", "-y", "curl"}).
+					Return("Removed:\n  curl-7.76.1-26.el9.x86_64\n\nComplete!\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("curl", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when package not installed",
+			pkgName: "nonexistent",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"remove", "-y", "nonexistent"}).
+					Return("No match for argument: nonexistent\nNo packages marked for removal.\n"+
+						"Dependencies resolved.\nNothing to do.\nComplete!\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("nonexistent", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:    "when exec error",
+			pkgName: "curl",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"remove", "-y", "curl"}).
+					Return("", fmt.Errorf("permission denied"))
+			},
+			wantErr:     true,
+			errContains: "package: remove \"curl\":",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Remove(context.Background(), tc.pkgName)
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *RHELPublicTestSuite) TestUpdate() {
+	tests := []struct {
+		name         string
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func(*apt.Result)
+	}{
+		{
+			name: "when update succeeds",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"makecache"}).
+					Return("Metadata cache created.\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name: "when exec error",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"makecache"}).
+					Return("", fmt.Errorf("permission denied"))
+			},
+			wantErr:     true,
+			errContains: "package: update:",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Update(context.Background())
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *RHELPublicTestSuite) TestListUpdates() {
+	tests := []struct {
+		name         string
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func([]apt.Update)
+	}{
+		{
+			name: "when list updates succeeds",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"-q", "list", "--upgrades"}).
+					Return(suite.dnfUpOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-qa", "--queryformat", suite.rpmFormat}).
+					Return(suite.rpmOutput, nil)
+			},
+			validateFunc: func(updates []apt.Update) {
+				suite.Require().Len(updates, 2)
+				suite.Equal("vim-enhanced", updates[0].Name)
+				suite.Equal("2:8.2.2637-21.el9", updates[0].NewVersion)
+				suite.Equal("2:8.2.2637-20.el9_1", updates[0].CurrentVersion)
+				suite.Equal("curl", updates[1].Name)
+				suite.Equal("7.76.1-29.el9_4", updates[1].NewVersion)
+				suite.Equal("7.76.1-26.el9", updates[1].CurrentVersion)
+			},
+		},
+		{
+			name: "when no updates available",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"-q", "list", "--upgrades"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-qa", "--queryformat", suite.rpmFormat}).
+					Return(suite.rpmOutput, nil)
+			},
+			validateFunc: func(updates []apt.Update) {
+				suite.Require().Empty(updates)
+			},
+		},
+		{
+			name: "when malformed lines are skipped",
+			setupMock: func() {
+				output := "Last metadata expiration check: 0:01:02 ago on Mon 01 Jan 2026.\n" +
+					"Available Upgrades\n" +
+					"noarch 1.0 repo\n" +
+					"curl.x86_64 7.76.1-29.el9_4 baseos\n"
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"-q", "list", "--upgrades"}).
+					Return(output, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-qa", "--queryformat", suite.rpmFormat}).
+					Return(suite.rpmOutput, nil)
+			},
+			validateFunc: func(updates []apt.Update) {
+				suite.Require().Len(updates, 1)
+				suite.Equal("curl", updates[0].Name)
+			},
+		},
+		{
+			name: "when exec error",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"-q", "list", "--upgrades"}).
+					Return("", fmt.Errorf("exec failed"))
+			},
+			wantErr:     true,
+			errContains: "package: list updates:",
+		},
+		{
+			name: "when rpm query error",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"-q", "list", "--upgrades"}).
+					Return(suite.dnfUpOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-qa", "--queryformat", suite.rpmFormat}).
+					Return("", fmt.Errorf("rpmdb locked"))
+			},
+			wantErr:     true,
+			errContains: "package: list updates:",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.ListUpdates(context.Background())
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *RHELPublicTestSuite) TestHold() {
+	tests := []struct {
+		name         string
+		pkgName      string
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func(*apt.Result)
+	}{
+		{
+			name:    "when hold succeeds",
+			pkgName: "nginx",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"versionlock", "list"}).
+					Return("nginx-mod-http-perl-1:1.20.1-14.el9.*\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"versionlock", "add", "nginx"}).
+					Return("", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("nginx", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when already locked by dnf4",
+			pkgName: "nginx",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"versionlock", "list"}).
+					Return("Last metadata expiration check: 0:01:02 ago.\nnginx-1:1.20.1-14.el9.*\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("nginx", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:    "when already locked by dnf5",
+			pkgName: "nginx",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"versionlock", "list"}).
+					Return("# Added by 'versionlock add' command on 2026-01-02 10:00:00\nPackage name: nginx\nevr = 1.20.1-14.el9\n", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("nginx", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:    "when versionlock list errors",
+			pkgName: "nginx",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"versionlock", "list"}).
+					Return("", fmt.Errorf("exec error"))
+			},
+			wantErr:     true,
+			errContains: "package: hold \"nginx\":",
+		},
+		{
+			name:    "when versionlock add errors",
+			pkgName: "nginx",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"versionlock", "list"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"versionlock", "add", "nginx"}).
+					Return("", fmt.Errorf("exec error"))
+			},
+			wantErr:     true,
+			errContains: "package: hold \"nginx\":",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Hold(context.Background(), tc.pkgName)
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *RHELPublicTestSuite) TestUnhold() {
+	tests := []struct {
+		name         string
+		pkgName      string
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func(*apt.Result)
+	}{
+		{
+			name:    "when unhold succeeds",
+			pkgName: "nginx",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"versionlock", "list"}).
+					Return("Last metadata expiration check: 0:01:02 ago.\nnginx-1:1.20.1-14.el9.*\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"versionlock", "delete", "nginx"}).
+					Return("", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("nginx", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:    "when not locked",
+			pkgName: "nginx",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"versionlock", "list"}).
+					Return("", nil)
+			},
+			validateFunc: func(result *apt.Result) {
+				suite.Require().NotNil(result)
+				suite.Equal("nginx", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:    "when versionlock list errors",
+			pkgName: "nginx",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"versionlock", "list"}).
+					Return("", fmt.Errorf("exec error"))
+			},
+			wantErr:     true,
+			errContains: "package: unhold \"nginx\":",
+		},
+		{
+			name:    "when versionlock delete errors",
+			pkgName: "nginx",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"versionlock", "list"}).
+					Return("# Added by 'versionlock add' command on 2026-01-02 10:00:00\nPackage name: nginx\nevr = 1.20.1-14.el9\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"versionlock", "delete", "nginx"}).
+					Return("", fmt.Errorf("exec error"))
+			},
+			wantErr:     true,
+			errContains: "package: unhold \"nginx\":",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Unhold(context.Background(), tc.pkgName)
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *RHELPublicTestSuite) TestEnsure() {
+	tests := []struct {
+		name         string
+		entries      []apt.EnsureEntry
+		setupMock    func()
+		wantErr      bool
+		errContains  string
+		validateFunc func(*apt.EnsureResult)
+	}{
+		{
+			name: "when pinned installs and locks",
+			entries: []apt.EnsureEntry{
+				{Name: "curl", State: apt.StatePresent},
+				{Name: "nginx", State: apt.StatePinned, Version: "1.20.1-14.el9"},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-qa", "--queryformat", suite.rpmFormat}).
+					Return(suite.rpmOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-q", "--queryformat", suite.rpmFormat, "nginx"}).
+					Return("", fmt.Errorf("package nginx is not installed"))
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"install", "-y", "nginx-1.20.1-14.el9"}).
+					Return("Installed:\n  nginx-1:1.20.1-14.el9.x86_64\n\nComplete!\n", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"versionlock", "list"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"versionlock", "add", "nginx"}).
+					Return("", nil)
+			},
+			validateFunc: func(result *apt.EnsureResult) {
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+				suite.Require().Len(result.Packages, 2)
+				suite.False(result.Packages[0].Changed)
+				suite.True(result.Packages[1].Changed)
+			},
+		},
+		{
+			name: "when pinned version changes on a locked package",
+			entries: []apt.EnsureEntry{
+				{Name: "curl", State: apt.StatePinned, Version: "7.76.1-29.el9_4"},
+			},
+			setupMock: func() {
+				locked := "Package name: curl\nevr = 7.76.1-26.el9\n"
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-qa", "--queryformat", suite.rpmFormat}).
+					Return(suite.rpmOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"versionlock", "list"}).
+					Return(locked, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"versionlock", "delete", "curl"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-q", "--queryformat", suite.rpmFormat, "curl"}).
+					Return("curl\t7.76.1-26.el9\tcurl\t707937\n", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"install", "-y", "curl-7.76.1-29.el9_4"}).
+					Return("Upgraded:\n  curl-7.76.1-29.el9_4.x86_64\n\nComplete!\n", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"versionlock", "list"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dnf", []string{"versionlock", "add", "curl"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("dnf", []string{"versionlock", "list"}).
+					Return("Package name: curl\nevr = 7.76.1-29.el9_4\n", nil)
+			},
+			validateFunc: func(result *apt.EnsureResult) {
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+				suite.Require().Len(result.Packages, 1)
+				suite.True(result.Packages[0].Changed)
+				suite.Empty(result.Packages[0].Error)
+			},
+		},
+		{
+			name: "when list errors",
+			entries: []apt.EnsureEntry{
+				{Name: "curl", State: apt.StatePresent},
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("rpm", []string{"-qa", "--queryformat", suite.rpmFormat}).
+					Return("", fmt.Errorf("exec failed"))
+			},
+			wantErr:     true,
+			errContains: "package: ensure:",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Ensure(context.Background(), tc.entries)
+
+			if tc.wantErr {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.errContains)
+
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *RHELPublicTestSuite) TestRepoOperations() {
+	tests := []struct {
+		name string
+		fn   func() (any, error)
+	}{
+		{
+			name: "list repos returns not implemented error",
+			fn: func() (any, error) {
+				return suite.provider.ListRepos(context.Background())
+			},
+		},
+		{
+			name: "get repo returns not implemented error",
+			fn: func() (any, error) {
+				return suite.provider.GetRepo(context.Background(), "docker")
+			},
+		},
+		{
+			name: "create repo returns not implemented error",
+			fn: func() (any, error) {
+				return suite.provider.CreateRepo(context.Background(), apt.Repo{Name: "docker"})
+			},
+		},
+		{
+			name: "update repo returns not implemented error",
+			fn: func() (any, error) {
+				return suite.provider.UpdateRepo(context.Background(), apt.Repo{Name: "docker"})
+			},
+		},
+		{
+			name: "delete repo returns not implemented error",
+			fn: func() (any, error) {
+				return suite.provider.DeleteRepo(context.Background(), "docker")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			_, err := tc.fn()
+
+			suite.ErrorIs(err, provider.ErrUnsupported)
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestRHELPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(RHELPublicTestSuite))
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package apt
+
+import (
+	"strings"
+)
+
+// compareEVR compares a requested rpm version against an installed EVR
+// (epoch:version-release) and returns -1, 0, or 1 as requested is older
+// than, equal to, or newer than installed. Parts missing from requested
+// are not compared, so "1.20.1" matches any release of 1.20.1.
+func compareEVR(
+	requested string,
+	installed string,
+) int {
+	rEpoch, rVersion, rRelease := splitEVR(requested)
+	iEpoch, iVersion, iRelease := splitEVR(installed)
+
+	if rEpoch != "" {
+		if c := rpmVerCmp(rEpoch, defaultEpoch(iEpoch)); c != 0 {
+			return c
+		}
+	}
+
+	if c := rpmVerCmp(rVersion, iVersion); c != 0 {
+		return c
+	}
+
+	if rRelease == "" {
+		return 0
+	}
+
+	return rpmVerCmp(rRelease, iRelease)
+}
+
+// splitEVR splits an rpm version string into epoch, version, and release.
+func splitEVR(
+	evr string,
+) (string, string, string) {
+	epoch, rest, ok := strings.Cut(evr, ":")
+	if !ok {
+		epoch, rest = "", evr
+	}
+
+	version, release, _ := strings.Cut(rest, "-")
+
+	return epoch, version, release
+}
+
+// defaultEpoch returns epoch, or "0" when it is unset.
+func defaultEpoch(
+	epoch string,
+) string {
+	if epoch == "" {
+		return "0"
+	}
+
+	return epoch
+}
+
+// rpmVerCmp compares two version segments the way rpm does: strings are
+// split into alternating numeric and alphabetic runs, numeric runs compare
+// by value and sort after alphabetic ones, and a tilde sorts before
+// anything, including the end of the string.
+func rpmVerCmp(
+	a string,
+	b string,
+) int {
+	if a == b {
+		return 0
+	}
+
+	for {
+		a = strings.TrimLeftFunc(a, isVersionSeparator)
+		b = strings.TrimLeftFunc(b, isVersionSeparator)
+
+		aTilde := strings.HasPrefix(a, "~")
+		bTilde := strings.HasPrefix(b, "~")
+		if aTilde || bTilde {
+			if !aTilde {
+				return 1
+			}
+			if !bTilde {
+				return -1
+			}
+
+			a, b = a[1:], b[1:]
+
+			continue
+		}
+
+		if a == "" || b == "" {
+			break
+		}
+
+		numeric := isDigit(rune(a[0]))
+		segA, restA := splitVersionRun(a, numeric)
+		segB, restB := splitVersionRun(b, numeric)
+		a, b = restA, restB
+
+		if segB == "" {
+			if numeric {
+				return 1
+			}
+
+			return -1
+		}
+
+		if numeric {
+			segA = strings.TrimLeft(segA, "0")
+			segB = strings.TrimLeft(segB, "0")
+
+			if len(segA) != len(segB) {
+				if len(segA) > len(segB) {
+					return 1
+				}
+
+				return -1
+			}
+		}
+
+		if c := strings.Compare(segA, segB); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case a == "" && b == "":
+		return 0
+	case a == "":
+		return -1
+	default:
+		return 1
+	}
+}
+
+// splitVersionRun returns the leading numeric or alphabetic run of s and
+// the remainder.
+func splitVersionRun(
+	s string,
+	numeric bool,
+) (string, string) {
+	i := 0
+	for i < len(s) {
+		r := rune(s[i])
+		if numeric && !isDigit(r) || !numeric && !isAlpha(r) {
+			break
+		}
+		i++
+	}
+
+	return s[:i], s[i:]
+}
+
+// isVersionSeparator reports whether r separates version segments.
+func isVersionSeparator(
+	r rune,
+) bool {
+	return r != '~' && !isDigit(r) && !isAlpha(r)
+}
+
+func isDigit(
+	r rune,
+) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isAlpha(
+	r rune,
+) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+}
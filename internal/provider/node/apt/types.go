@@ -18,7 +18,7 @@
 // FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
 // DEALINGS IN THE SOFTWARE.
 
-// Package apt provides package management operations via apt.
+// Package apt provides package management operations via apt, dnf, and apk.
 package apt
 
 import "context"
@@ -29,16 +29,50 @@ type Provider interface {
 	List(ctx context.Context) ([]Package, error)
 	// Get returns details for a single installed package.
 	Get(ctx context.Context, name string) (*Package, error)
-	// Install installs a package by name.
-	Install(ctx context.Context, name string) (*Result, error)
+	// Install installs a package by name. A non-empty version installs
+	// that exact version instead of the repository candidate.
+	Install(ctx context.Context, name string, version string) (*Result, error)
+	// InstallObject installs a package artifact stored in the Object
+	// Store. Changed reflects whether the installed version changed.
+	InstallObject(ctx context.Context, object string) (*Result, error)
 	// Remove removes a package by name.
 	Remove(ctx context.Context, name string) (*Result, error)
 	// Update refreshes the package index.
 	Update(ctx context.Context) (*Result, error)
 	// ListUpdates returns packages with available updates.
 	ListUpdates(ctx context.Context) ([]Update, error)
+	// Hold prevents a package from being upgraded.
+	Hold(ctx context.Context, name string) (*Result, error)
+	// Unhold allows a previously held package to be upgraded.
+	Unhold(ctx context.Context, name string) (*Result, error)
+	// Ensure converges installed packages to the desired manifest.
+	Ensure(ctx context.Context, entries []EnsureEntry) (*EnsureResult, error)
+	// ListRepos returns all osapi-managed package repositories.
+	ListRepos(ctx context.Context) ([]Repo, error)
+	// GetRepo returns a single osapi-managed package repository.
+	GetRepo(ctx context.Context, name string) (*Repo, error)
+	// CreateRepo deploys a new repository source and optional signing key.
+	CreateRepo(ctx context.Context, repo Repo) (*RepoResult, error)
+	// UpdateRepo redeploys an existing repository source or signing key.
+	UpdateRepo(ctx context.Context, repo Repo) (*RepoResult, error)
+	// DeleteRepo undeploys a repository source and its signing key.
+	DeleteRepo(ctx context.Context, name string) (*RepoResult, error)
 }
 
+// Desired package states accepted by Ensure.
+const (
+	// StatePresent installs the package when missing. When a version is
+	// given the installed version must match it.
+	StatePresent = "present"
+	// StateAbsent removes the package when installed.
+	StateAbsent = "absent"
+	// StateLatest installs the package or upgrades it to the newest
+	// available version.
+	StateLatest = "latest"
+	// StatePinned installs the given version and holds it.
+	StatePinned = "pinned"
+)
+
 // Package represents an installed apt package.
 type Package struct {
 	Name        string `json:"name"`
@@ -58,6 +92,39 @@ type Update struct {
 // Result represents the outcome of a package mutation operation.
 type Result struct {
 	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
+
+// EnsureEntry describes the desired state of a single package.
+type EnsureEntry struct {
+	Name    string `json:"name"`
+	State   string `json:"state"`
+	Version string `json:"version,omitempty"`
+}
+
+// EnsureResult represents the outcome of converging a package manifest.
+type EnsureResult struct {
+	Packages []Result `json:"packages"`
+	Changed  bool     `json:"changed"`
+}
+
+// Repo represents an osapi-managed package repository. Object names the
+// deb822 sources file in the Object Store and KeyObject the optional
+// ASCII-armored signing key. Path and Keyring report where they were
+// deployed on disk.
+type Repo struct {
+	Name      string `json:"name"`
+	Object    string `json:"object,omitempty"`
+	KeyObject string `json:"key_object,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Keyring   string `json:"keyring,omitempty"`
+}
+
+// RepoResult represents the outcome of a repository mutation.
+type RepoResult struct {
+	Name    string `json:"name"`
 	Changed bool   `json:"changed"`
 	Error   string `json:"error,omitempty"`
 }
@@ -0,0 +1,299 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package block
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/exec"
+	"github.com/osapi-io/osapi/internal/provider"
+)
+
+// lsblkColumns are the lsblk output columns requested for the device tree.
+const lsblkColumns = "NAME,PATH,TYPE,SIZE,FSTYPE,UUID,LABEL,MOUNTPOINT,MODEL,RO"
+
+// Compile-time checks.
+var (
+	_ Provider             = (*Debian)(nil)
+	_ provider.FactsSetter = (*Debian)(nil)
+)
+
+// Debian implements the Provider interface for Debian-family systems.
+// Devices are read with lsblk and LVM details with the pvs, vgs and lvs
+// JSON reports; logical volumes are grown with lvextend.
+type Debian struct {
+	provider.FactsAware
+	logger      *slog.Logger
+	execManager exec.Manager
+}
+
+// NewDebianProvider factory to create a new Debian instance.
+func NewDebianProvider(
+	logger *slog.Logger,
+	execManager exec.Manager,
+) *Debian {
+	return &Debian{
+		logger:      logger.With(slog.String("subsystem", "provider.block")),
+		execManager: execManager,
+	}
+}
+
+// lsblkDevice represents a single device from lsblk JSON output.
+type lsblkDevice struct {
+	Name       string        `json:"name"`
+	Path       string        `json:"path"`
+	Type       string        `json:"type"`
+	Size       lsblkInt      `json:"size"`
+	FSType     string        `json:"fstype"`
+	UUID       string        `json:"uuid"`
+	Label      string        `json:"label"`
+	MountPoint string        `json:"mountpoint"`
+	Model      string        `json:"model"`
+	RO         lsblkBool     `json:"ro"`
+	Children   []lsblkDevice `json:"children"`
+}
+
+// lsblkInt decodes an lsblk numeric column. Older util-linux releases
+// emit numbers as JSON strings.
+type lsblkInt int64
+
+// UnmarshalJSON accepts both numeric and string encodings.
+func (n *lsblkInt) UnmarshalJSON(
+	data []byte,
+) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*n = 0
+		return nil
+	}
+
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	*n = lsblkInt(v)
+
+	return nil
+}
+
+// lsblkBool decodes an lsblk boolean column. Older util-linux releases
+// emit booleans as "0" or "1".
+type lsblkBool bool
+
+// UnmarshalJSON accepts both boolean and string encodings.
+func (b *lsblkBool) UnmarshalJSON(
+	data []byte,
+) error {
+	s := strings.Trim(string(data), `"`)
+	*b = lsblkBool(s == "true" || s == "1")
+
+	return nil
+}
+
+// lvmReport represents the JSON report emitted by pvs, vgs and lvs.
+type lvmReport struct {
+	Report []map[string][]map[string]string `json:"report"`
+}
+
+// List returns the lsblk device tree merged with LVM physical volume,
+// volume group and logical volume details. Nodes without the LVM tools
+// return empty LVM sections.
+func (d *Debian) List(
+	_ context.Context,
+) (*Inventory, error) {
+	d.logger.Debug("executing block.List")
+
+	output, err := d.execManager.RunCmd("lsblk", []string{
+		"--json",
+		"--bytes",
+		"--output",
+		lsblkColumns,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("block: list: lsblk: %w", err)
+	}
+
+	var tree struct {
+		BlockDevices []lsblkDevice `json:"blockdevices"`
+	}
+	if err := json.Unmarshal([]byte(jsonObject(output)), &tree); err != nil {
+		return nil, fmt.Errorf("block: list: parse lsblk: %w", err)
+	}
+
+	inv := &Inventory{
+		Devices:         toDevices(tree.BlockDevices),
+		PhysicalVolumes: []PhysicalVolume{},
+		VolumeGroups:    []VolumeGroup{},
+		LogicalVolumes:  []LogicalVolume{},
+	}
+
+	pvs, err := d.lvmRows("pvs", "pv", "pv_name,vg_name,pv_size,pv_free")
+	if err != nil {
+		d.logger.Debug(
+			"failed to query LVM, volume details will be unavailable",
+			slog.String("error", err.Error()),
+		)
+
+		return inv, nil
+	}
+	for _, row := range pvs {
+		inv.PhysicalVolumes = append(inv.PhysicalVolumes, PhysicalVolume{
+			Name:        row["pv_name"],
+			VolumeGroup: row["vg_name"],
+			Size:        parseInt(row["pv_size"]),
+			Free:        parseInt(row["pv_free"]),
+		})
+	}
+
+	vgs, err := d.lvmRows(
+		"vgs",
+		"vg",
+		"vg_name,vg_size,vg_free,vg_extent_size,vg_free_count,pv_count,lv_count",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("block: list: %w", err)
+	}
+	for _, row := range vgs {
+		inv.VolumeGroups = append(inv.VolumeGroups, VolumeGroup{
+			Name:        row["vg_name"],
+			Size:        parseInt(row["vg_size"]),
+			Free:        parseInt(row["vg_free"]),
+			ExtentSize:  parseInt(row["vg_extent_size"]),
+			FreeExtents: parseInt(row["vg_free_count"]),
+			PVCount:     int(parseInt(row["pv_count"])),
+			LVCount:     int(parseInt(row["lv_count"])),
+		})
+	}
+
+	lvs, err := d.lvmRows("lvs", "lv", "lv_name,vg_name,lv_path,lv_size,lv_attr")
+	if err != nil {
+		return nil, fmt.Errorf("block: list: %w", err)
+	}
+	for _, row := range lvs {
+		inv.LogicalVolumes = append(inv.LogicalVolumes, toLogicalVolume(row))
+	}
+
+	return inv, nil
+}
+
+// lvmRows runs an LVM reporting command with JSON output and byte units
+// and returns the rows of the named report section.
+func (d *Debian) lvmRows(
+	command string,
+	section string,
+	fields string,
+	args ...string,
+) ([]map[string]string, error) {
+	cmdArgs := append([]string{
+		"--reportformat",
+		"json",
+		"--units",
+		"b",
+		"--nosuffix",
+		"-o",
+		fields,
+	}, args...)
+
+	output, err := d.execManager.RunPrivilegedCmd(command, cmdArgs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s: %w", command, strings.TrimSpace(output), err)
+	}
+
+	var report lvmReport
+	if err := json.Unmarshal([]byte(jsonObject(output)), &report); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", command, err)
+	}
+
+	var rows []map[string]string
+	for _, r := range report.Report {
+		rows = append(rows, r[section]...)
+	}
+
+	return rows, nil
+}
+
+// toDevices converts lsblk devices, recursing into their children.
+func toDevices(
+	devices []lsblkDevice,
+) []Device {
+	result := make([]Device, 0, len(devices))
+	for _, dev := range devices {
+		d := Device{
+			Name:       dev.Name,
+			Path:       dev.Path,
+			Type:       dev.Type,
+			Size:       int64(dev.Size),
+			FSType:     dev.FSType,
+			UUID:       dev.UUID,
+			Label:      dev.Label,
+			MountPoint: dev.MountPoint,
+			Model:      strings.TrimSpace(dev.Model),
+			ReadOnly:   bool(dev.RO),
+		}
+		if len(dev.Children) > 0 {
+			d.Children = toDevices(dev.Children)
+		}
+		result = append(result, d)
+	}
+
+	return result
+}
+
+// toLogicalVolume converts an lvs report row.
+func toLogicalVolume(
+	row map[string]string,
+) LogicalVolume {
+	return LogicalVolume{
+		Name:        row["lv_name"],
+		VolumeGroup: row["vg_name"],
+		Path:        row["lv_path"],
+		Size:        parseInt(row["lv_size"]),
+		Attr:        row["lv_attr"],
+	}
+}
+
+// jsonObject trims any warnings printed around a JSON document, since the
+// exec manager returns combined stdout and stderr.
+func jsonObject(
+	output string,
+) string {
+	start := strings.Index(output, "{")
+	end := strings.LastIndex(output, "}")
+	if start < 0 || end < start {
+		return output
+	}
+
+	return output[start : end+1]
+}
+
+// parseInt parses an LVM numeric field, returning zero when empty.
+func parseInt(
+	s string,
+) int64 {
+	v, _ := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+
+	return v
+}
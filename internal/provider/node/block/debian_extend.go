@@ -0,0 +1,175 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package block
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// validLVMName matches LVM volume group and logical volume names.
+	validLVMName = regexp.MustCompile(`^[a-zA-Z0-9_.+][a-zA-Z0-9_.+-]*$`)
+	// sizePattern matches an absolute or relative lvextend --size value.
+	sizePattern = regexp.MustCompile(`^\+?[0-9]+(\.[0-9]+)?[bBsSkKmMgGtTpPeE]?$`)
+	// extentsPattern matches a relative lvextend --extents percentage.
+	extentsPattern = regexp.MustCompile(`^\+?[0-9]+%(FREE|VG|PVS|ORIGIN)$`)
+	// fsUnchanged matches resize2fs and xfs_growfs output for a filesystem
+	// that already fills its device.
+	fsUnchanged = regexp.MustCompile(`Nothing to do|data size unchanged`)
+)
+
+// defaultExtents grows a logical volume into all free extents.
+const defaultExtents = "+100%FREE"
+
+// Extend grows a logical volume with lvextend. When ResizeFS is set the
+// filesystem on the volume is grown in the same step. A request that
+// would not change the volume size returns Changed: false, unless
+// ResizeFS is set and the filesystem still had to be grown to fill the
+// volume.
+func (d *Debian) Extend(
+	_ context.Context,
+	opts ExtendOpts,
+) (*ExtendResult, error) {
+	d.logger.Debug("executing block.Extend")
+
+	if err := validateExtend(opts); err != nil {
+		return nil, fmt.Errorf("block: extend: %w", err)
+	}
+
+	name := opts.VolumeGroup + "/" + opts.LogicalVolume
+
+	before, err := d.logicalVolume(name)
+	if err != nil {
+		return nil, err
+	}
+
+	args := sizeArgs(opts.Size)
+	if opts.ResizeFS {
+		args = append(args, "--resizefs")
+	}
+	args = append(args, name)
+
+	output, err := d.execManager.RunPrivilegedCmd("lvextend", args)
+	if err != nil {
+		if strings.Contains(output, "matches existing size") {
+			// lvextend stops before --resizefs when the volume is already
+			// at size, so a filesystem left behind by an earlier extend
+			// without ResizeFS is grown separately.
+			changed := false
+			if opts.ResizeFS {
+				changed, err = d.growFS(before.Path)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			return &ExtendResult{Name: name, Size: before.Size, Changed: changed}, nil
+		}
+
+		return nil, fmt.Errorf(
+			"block: extend: lvextend: %s: %w",
+			strings.TrimSpace(output),
+			err,
+		)
+	}
+
+	after, err := d.logicalVolume(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExtendResult{Name: name, Size: after.Size, Changed: after.Size != before.Size}, nil
+}
+
+// logicalVolume returns a single logical volume by its vg/lv name.
+func (d *Debian) logicalVolume(
+	name string,
+) (*LogicalVolume, error) {
+	rows, err := d.lvmRows("lvs", "lv", "lv_name,vg_name,lv_path,lv_size,lv_attr", name)
+	if err != nil {
+		if strings.Contains(err.Error(), "Failed to find") {
+			return nil, fmt.Errorf("logical volume %q: not found", name)
+		}
+
+		return nil, fmt.Errorf("block: extend: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("logical volume %q: not found", name)
+	}
+
+	lv := toLogicalVolume(rows[0])
+
+	return &lv, nil
+}
+
+// growFS grows the filesystem on device to fill it. fsadm dispatches to
+// resize2fs or xfs_growfs for the filesystem found on the device. Returns
+// false when the filesystem already filled the device.
+func (d *Debian) growFS(
+	device string,
+) (bool, error) {
+	output, err := d.execManager.RunPrivilegedCmd("fsadm", []string{"resize", device})
+	if err != nil {
+		return false, fmt.Errorf(
+			"block: extend: fsadm: %s: %w",
+			strings.TrimSpace(output),
+			err,
+		)
+	}
+
+	return !fsUnchanged.MatchString(output), nil
+}
+
+// sizeArgs returns the lvextend size arguments for a requested size.
+func sizeArgs(
+	size string,
+) []string {
+	switch {
+	case size == "":
+		return []string{"--extents", defaultExtents}
+	case extentsPattern.MatchString(size):
+		return []string{"--extents", size}
+	default:
+		return []string{"--size", size}
+	}
+}
+
+// validateExtend checks the volume names and size of an extend request.
+func validateExtend(
+	opts ExtendOpts,
+) error {
+	if !validLVMName.MatchString(opts.VolumeGroup) {
+		return fmt.Errorf("invalid volume group %q", opts.VolumeGroup)
+	}
+	if !validLVMName.MatchString(opts.LogicalVolume) {
+		return fmt.Errorf("invalid logical volume %q", opts.LogicalVolume)
+	}
+	if opts.Size != "" &&
+		!sizePattern.MatchString(opts.Size) &&
+		!extentsPattern.MatchString(opts.Size) {
+		return fmt.Errorf("invalid size %q", opts.Size)
+	}
+
+	return nil
+}
@@ -0,0 +1,371 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package block_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/block"
+)
+
+const (
+	lvRootBefore = `{"report":[{"lv":[{"lv_name":"root","vg_name":"vg0","lv_path":"/dev/vg0/root","lv_size":"10737418240","lv_attr":"-wi-ao----"}]}]}`
+	lvRootAfter  = `{"report":[{"lv":[{"lv_name":"root","vg_name":"vg0","lv_path":"/dev/vg0/root","lv_size":"21474836480","lv_attr":"-wi-ao----"}]}]}`
+)
+
+type DebianExtendPublicTestSuite struct {
+	suite.Suite
+
+	ctrl     *gomock.Controller
+	mockExec *execmocks.MockManager
+	provider *block.Debian
+}
+
+func (suite *DebianExtendPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+
+	suite.provider = block.NewDebianProvider(
+		slog.New(slog.NewTextHandler(os.Stdout, nil)),
+		suite.mockExec,
+	)
+}
+
+func (suite *DebianExtendPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *DebianExtendPublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *DebianExtendPublicTestSuite) expectLV(
+	output string,
+	err error,
+) {
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("lvs", append(append([]string{}, lvsArgs...), "vg0/root")).
+		Return(output, err)
+}
+
+func (suite *DebianExtendPublicTestSuite) TestExtend() {
+	tests := []struct {
+		name         string
+		opts         block.ExtendOpts
+		setupMock    func()
+		wantErr      bool
+		wantErrMsg   string
+		validateFunc func(*block.ExtendResult)
+	}{
+		{
+			name: "when size is empty grows into all free extents",
+			opts: block.ExtendOpts{VolumeGroup: "vg0", LogicalVolume: "root"},
+			setupMock: func() {
+				suite.expectLV(lvRootBefore, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("lvextend", []string{"--extents", "+100%FREE", "vg0/root"}).
+					Return("  Logical volume vg0/root successfully resized.", nil)
+				suite.expectLV(lvRootAfter, nil)
+			},
+			validateFunc: func(r *block.ExtendResult) {
+				suite.Equal("vg0/root", r.Name)
+				suite.Equal(int64(21474836480), r.Size)
+				suite.True(r.Changed)
+			},
+		},
+		{
+			name: "when size and resize fs are set passes them to lvextend",
+			opts: block.ExtendOpts{
+				VolumeGroup:   "vg0",
+				LogicalVolume: "root",
+				Size:          "+10G",
+				ResizeFS:      true,
+			},
+			setupMock: func() {
+				suite.expectLV(lvRootBefore, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("lvextend", []string{"--size", "+10G", "--resizefs", "vg0/root"}).
+					Return("", nil)
+				suite.expectLV(lvRootAfter, nil)
+			},
+			validateFunc: func(r *block.ExtendResult) {
+				suite.True(r.Changed)
+			},
+		},
+		{
+			name: "when size is an extent percentage uses extents",
+			opts: block.ExtendOpts{
+				VolumeGroup:   "vg0",
+				LogicalVolume: "root",
+				Size:          "+50%FREE",
+			},
+			setupMock: func() {
+				suite.expectLV(lvRootBefore, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("lvextend", []string{"--extents", "+50%FREE", "vg0/root"}).
+					Return("", nil)
+				suite.expectLV(lvRootAfter, nil)
+			},
+			validateFunc: func(r *block.ExtendResult) {
+				suite.True(r.Changed)
+			},
+		},
+		{
+			name: "when size matches existing returns unchanged",
+			opts: block.ExtendOpts{VolumeGroup: "vg0", LogicalVolume: "root", Size: "10G"},
+			setupMock: func() {
+				suite.expectLV(lvRootBefore, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("lvextend", []string{"--size", "10G", "vg0/root"}).
+					Return(
+						"  New size (2560 extents) matches existing size (2560 extents).",
+						errors.New("exit status 5"),
+					)
+			},
+			validateFunc: func(r *block.ExtendResult) {
+				suite.Equal(int64(10737418240), r.Size)
+				suite.False(r.Changed)
+			},
+		},
+		{
+			name: "when size matches existing with resize fs grows the filesystem",
+			opts: block.ExtendOpts{
+				VolumeGroup:   "vg0",
+				LogicalVolume: "root",
+				Size:          "10G",
+				ResizeFS:      true,
+			},
+			setupMock: func() {
+				suite.expectLV(lvRootBefore, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("lvextend", []string{"--size", "10G", "--resizefs", "vg0/root"}).
+					Return(
+						"  New size (2560 extents) matches existing size (2560 extents).",
+						errors.New("exit status 5"),
+					)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("fsadm", []string{"resize", "/dev/vg0/root"}).
+					Return(
+						"The filesystem on /dev/mapper/vg0-root is now 2621440 (4k) blocks long.",
+						nil,
+					)
+			},
+			validateFunc: func(r *block.ExtendResult) {
+				suite.Equal(int64(10737418240), r.Size)
+				suite.True(r.Changed)
+			},
+		},
+		{
+			name: "when size matches existing and ext filesystem already fills it",
+			opts: block.ExtendOpts{
+				VolumeGroup:   "vg0",
+				LogicalVolume: "root",
+				Size:          "10G",
+				ResizeFS:      true,
+			},
+			setupMock: func() {
+				suite.expectLV(lvRootBefore, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("lvextend", []string{"--size", "10G", "--resizefs", "vg0/root"}).
+					Return(
+						"  New size (2560 extents) matches existing size (2560 extents).",
+						errors.New("exit status 5"),
+					)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("fsadm", []string{"resize", "/dev/vg0/root"}).
+					Return(
+						"The filesystem is already 2621440 (4k) blocks long.  Nothing to do!",
+						nil,
+					)
+			},
+			validateFunc: func(r *block.ExtendResult) {
+				suite.False(r.Changed)
+			},
+		},
+		{
+			name: "when size matches existing and xfs filesystem already fills it",
+			opts: block.ExtendOpts{
+				VolumeGroup:   "vg0",
+				LogicalVolume: "root",
+				Size:          "10G",
+				ResizeFS:      true,
+			},
+			setupMock: func() {
+				suite.expectLV(lvRootBefore, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("lvextend", []string{"--size", "10G", "--resizefs", "vg0/root"}).
+					Return(
+						"  New size (2560 extents) matches existing size (2560 extents).",
+						errors.New("exit status 5"),
+					)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("fsadm", []string{"resize", "/dev/vg0/root"}).
+					Return("data size unchanged, skipping", nil)
+			},
+			validateFunc: func(r *block.ExtendResult) {
+				suite.False(r.Changed)
+			},
+		},
+		{
+			name: "when size matches existing and fsadm fails returns error",
+			opts: block.ExtendOpts{
+				VolumeGroup:   "vg0",
+				LogicalVolume: "root",
+				Size:          "10G",
+				ResizeFS:      true,
+			},
+			setupMock: func() {
+				suite.expectLV(lvRootBefore, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("lvextend", []string{"--size", "10G", "--resizefs", "vg0/root"}).
+					Return(
+						"  New size (2560 extents) matches existing size (2560 extents).",
+						errors.New("exit status 5"),
+					)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("fsadm", []string{"resize", "/dev/vg0/root"}).
+					Return("fsadm: Filesystem not recognized", errors.New("exit status 1"))
+			},
+			wantErr:    true,
+			wantErrMsg: "block: extend: fsadm: fsadm: Filesystem not recognized",
+		},
+		{
+			name:       "when volume group is invalid returns error",
+			opts:       block.ExtendOpts{VolumeGroup: "-vg0", LogicalVolume: "root"},
+			setupMock:  func() {},
+			wantErr:    true,
+			wantErrMsg: `block: extend: invalid volume group "-vg0"`,
+		},
+		{
+			name:       "when logical volume is invalid returns error",
+			opts:       block.ExtendOpts{VolumeGroup: "vg0", LogicalVolume: "ro/ot"},
+			setupMock:  func() {},
+			wantErr:    true,
+			wantErrMsg: `block: extend: invalid logical volume "ro/ot"`,
+		},
+		{
+			name: "when size is invalid returns error",
+			opts: block.ExtendOpts{
+				VolumeGroup:   "vg0",
+				LogicalVolume: "root",
+				Size:          "10G; reboot",
+			},
+			setupMock:  func() {},
+			wantErr:    true,
+			wantErrMsg: `block: extend: invalid size "10G; reboot"`,
+		},
+		{
+			name: "when logical volume does not exist returns not found",
+			opts: block.ExtendOpts{VolumeGroup: "vg0", LogicalVolume: "root"},
+			setupMock: func() {
+				suite.expectLV(
+					`  Failed to find logical volume "vg0/root"`,
+					errors.New("exit status 5"),
+				)
+			},
+			wantErr:    true,
+			wantErrMsg: `logical volume "vg0/root": not found`,
+		},
+		{
+			name: "when lvs returns no rows returns not found",
+			opts: block.ExtendOpts{VolumeGroup: "vg0", LogicalVolume: "root"},
+			setupMock: func() {
+				suite.expectLV(`{"report":[{"lv":[]}]}`, nil)
+			},
+			wantErr:    true,
+			wantErrMsg: `logical volume "vg0/root": not found`,
+		},
+		{
+			name: "when lvs fails returns error",
+			opts: block.ExtendOpts{VolumeGroup: "vg0", LogicalVolume: "root"},
+			setupMock: func() {
+				suite.expectLV("", errors.New("permission denied"))
+			},
+			wantErr:    true,
+			wantErrMsg: "block: extend: lvs: : permission denied",
+		},
+		{
+			name: "when lvextend fails returns error",
+			opts: block.ExtendOpts{VolumeGroup: "vg0", LogicalVolume: "root", Size: "+1T"},
+			setupMock: func() {
+				suite.expectLV(lvRootBefore, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("lvextend", []string{"--size", "+1T", "vg0/root"}).
+					Return(
+						"  Insufficient free space: 262144 extents needed, but only 2304 available",
+						errors.New("exit status 5"),
+					)
+			},
+			wantErr:    true,
+			wantErrMsg: "block: extend: lvextend: Insufficient free space",
+		},
+		{
+			name: "when size lookup after extend fails returns error",
+			opts: block.ExtendOpts{VolumeGroup: "vg0", LogicalVolume: "root"},
+			setupMock: func() {
+				suite.expectLV(lvRootBefore, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("lvextend", []string{"--extents", "+100%FREE", "vg0/root"}).
+					Return("", nil)
+				suite.expectLV("", errors.New("exit status 5"))
+			},
+			wantErr:    true,
+			wantErrMsg: "block: extend: lvs",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Extend(context.Background(), tc.opts)
+
+			if tc.wantErr {
+				suite.Error(err)
+				suite.Contains(err.Error(), tc.wantErrMsg)
+				suite.Nil(got)
+
+				return
+			}
+
+			suite.NoError(err)
+			suite.NotNil(got)
+
+			if tc.validateFunc != nil {
+				tc.validateFunc(got)
+			}
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestDebianExtendPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(DebianExtendPublicTestSuite))
+}
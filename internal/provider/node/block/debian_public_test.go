@@ -0,0 +1,316 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package block_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/block"
+)
+
+var (
+	lsblkArgs = []string{
+		"--json",
+		"--bytes",
+		"--output",
+		"NAME,PATH,TYPE,SIZE,FSTYPE,UUID,LABEL,MOUNTPOINT,MODEL,RO",
+	}
+	pvsArgs = []string{
+		"--reportformat", "json", "--units", "b", "--nosuffix",
+		"-o", "pv_name,vg_name,pv_size,pv_free",
+	}
+	vgsArgs = []string{
+		"--reportformat", "json", "--units", "b", "--nosuffix",
+		"-o", "vg_name,vg_size,vg_free,vg_extent_size,vg_free_count,pv_count,lv_count",
+	}
+	lvsArgs = []string{
+		"--reportformat", "json", "--units", "b", "--nosuffix",
+		"-o", "lv_name,vg_name,lv_path,lv_size,lv_attr",
+	}
+)
+
+const (
+	lsblkJSON = `{
+   "blockdevices": [
+      {"name":"sda", "path":"/dev/sda", "type":"disk", "size":21474836480, "fstype":null, "uuid":null, "label":null, "mountpoint":null, "model":"QEMU HARDDISK   ", "ro":false,
+         "children": [
+            {"name":"sda1", "path":"/dev/sda1", "type":"part", "size":1073741824, "fstype":"ext4", "uuid":"b1c2", "label":"boot", "mountpoint":"/boot", "model":null, "ro":false},
+            {"name":"sda2", "path":"/dev/sda2", "type":"part", "size":20400046080, "fstype":"LVM2_member", "uuid":"pv-uuid", "label":null, "mountpoint":null, "model":null, "ro":false,
+               "children": [
+                  {"name":"vg0-root", "path":"/dev/mapper/vg0-root", "type":"lvm", "size":10737418240, "fstype":"ext4", "uuid":"root-uuid", "label":null, "mountpoint":"/", "model":null, "ro":false}
+               ]
+            }
+         ]
+      }
+   ]
+}`
+	lsblkLegacyJSON = `{
+   "blockdevices": [
+      {"name":"sr0", "path":"/dev/sr0", "type":"rom", "size":"1073741312", "fstype":null, "uuid":null, "label":null, "mountpoint":null, "model":"QEMU DVD-ROM", "ro":"1"}
+   ]
+}`
+	pvsJSON = `  WARNING: Running as a non-root user.
+  {
+      "report": [
+          {
+              "pv": [
+                  {"pv_name":"/dev/sda2", "vg_name":"vg0", "pv_size":"20400046080", "pv_free":"9663676416"}
+              ]
+          }
+      ]
+  }`
+	vgsJSON = `{
+      "report": [
+          {
+              "vg": [
+                  {"vg_name":"vg0", "vg_size":"20400046080", "vg_free":"9663676416", "vg_extent_size":"4194304", "vg_free_count":"2304", "pv_count":"1", "lv_count":"1"}
+              ]
+          }
+      ]
+  }`
+	lvsJSON = `{
+      "report": [
+          {
+              "lv": [
+                  {"lv_name":"root", "vg_name":"vg0", "lv_path":"/dev/vg0/root", "lv_size":"10737418240", "lv_attr":"-wi-ao----"}
+              ]
+          }
+      ]
+  }`
+)
+
+type DebianPublicTestSuite struct {
+	suite.Suite
+
+	ctrl     *gomock.Controller
+	logger   *slog.Logger
+	mockExec *execmocks.MockManager
+	provider *block.Debian
+}
+
+func (suite *DebianPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+
+	suite.provider = block.NewDebianProvider(
+		suite.logger,
+		suite.mockExec,
+	)
+}
+
+func (suite *DebianPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *DebianPublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *DebianPublicTestSuite) TestList() {
+	tests := []struct {
+		name         string
+		setupMock    func()
+		wantErr      bool
+		wantErrMsg   string
+		validateFunc func(*block.Inventory)
+	}{
+		{
+			name: "when successful returns device tree and LVM details",
+			setupMock: func() {
+				suite.mockExec.EXPECT().RunCmd("lsblk", lsblkArgs).Return(lsblkJSON, nil)
+				suite.mockExec.EXPECT().RunPrivilegedCmd("pvs", pvsArgs).Return(pvsJSON, nil)
+				suite.mockExec.EXPECT().RunPrivilegedCmd("vgs", vgsArgs).Return(vgsJSON, nil)
+				suite.mockExec.EXPECT().RunPrivilegedCmd("lvs", lvsArgs).Return(lvsJSON, nil)
+			},
+			validateFunc: func(inv *block.Inventory) {
+				suite.Require().Len(inv.Devices, 1)
+				disk := inv.Devices[0]
+				suite.Equal("sda", disk.Name)
+				suite.Equal("disk", disk.Type)
+				suite.Equal(int64(21474836480), disk.Size)
+				suite.Equal("QEMU HARDDISK", disk.Model)
+				suite.Empty(disk.FSType)
+				suite.Require().Len(disk.Children, 2)
+				suite.Equal("/boot", disk.Children[0].MountPoint)
+				suite.Equal("boot", disk.Children[0].Label)
+				suite.Equal("LVM2_member", disk.Children[1].FSType)
+				suite.Require().Len(disk.Children[1].Children, 1)
+				suite.Equal("root-uuid", disk.Children[1].Children[0].UUID)
+
+				suite.Equal([]block.PhysicalVolume{{
+					Name:        "/dev/sda2",
+					VolumeGroup: "vg0",
+					Size:        20400046080,
+					Free:        9663676416,
+				}}, inv.PhysicalVolumes)
+				suite.Equal([]block.VolumeGroup{{
+					Name:        "vg0",
+					Size:        20400046080,
+					Free:        9663676416,
+					ExtentSize:  4194304,
+					FreeExtents: 2304,
+					PVCount:     1,
+					LVCount:     1,
+				}}, inv.VolumeGroups)
+				suite.Equal([]block.LogicalVolume{{
+					Name:        "root",
+					VolumeGroup: "vg0",
+					Path:        "/dev/vg0/root",
+					Size:        10737418240,
+					Attr:        "-wi-ao----",
+				}}, inv.LogicalVolumes)
+			},
+		},
+		{
+			name: "when lsblk emits string columns decodes them",
+			setupMock: func() {
+				suite.mockExec.EXPECT().RunCmd("lsblk", lsblkArgs).Return(lsblkLegacyJSON, nil)
+				suite.mockExec.EXPECT().RunPrivilegedCmd("pvs", pvsArgs).Return(pvsJSON, nil)
+				suite.mockExec.EXPECT().RunPrivilegedCmd("vgs", vgsArgs).Return(vgsJSON, nil)
+				suite.mockExec.EXPECT().RunPrivilegedCmd("lvs", lvsArgs).Return(lvsJSON, nil)
+			},
+			validateFunc: func(inv *block.Inventory) {
+				suite.Require().Len(inv.Devices, 1)
+				suite.Equal(int64(1073741312), inv.Devices[0].Size)
+				suite.True(inv.Devices[0].ReadOnly)
+				suite.Nil(inv.Devices[0].Children)
+			},
+		},
+		{
+			name: "when LVM tools are unavailable returns empty LVM sections",
+			setupMock: func() {
+				suite.mockExec.EXPECT().RunCmd("lsblk", lsblkArgs).Return(lsblkJSON, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("pvs", pvsArgs).
+					Return("", errors.New("executable file not found"))
+			},
+			validateFunc: func(inv *block.Inventory) {
+				suite.Len(inv.Devices, 1)
+				suite.Empty(inv.PhysicalVolumes)
+				suite.NotNil(inv.PhysicalVolumes)
+				suite.Empty(inv.VolumeGroups)
+				suite.Empty(inv.LogicalVolumes)
+			},
+		},
+		{
+			name: "when lsblk fails returns error",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("lsblk", lsblkArgs).
+					Return("", errors.New("command not found"))
+			},
+			wantErr:    true,
+			wantErrMsg: "block: list: lsblk: command not found",
+		},
+		{
+			name: "when lsblk output is invalid returns error",
+			setupMock: func() {
+				suite.mockExec.EXPECT().RunCmd("lsblk", lsblkArgs).Return("not json", nil)
+			},
+			wantErr:    true,
+			wantErrMsg: "block: list: parse lsblk",
+		},
+		{
+			name: "when lsblk size is invalid returns error",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("lsblk", lsblkArgs).
+					Return(`{"blockdevices":[{"name":"sda","size":"big"}]}`, nil)
+			},
+			wantErr:    true,
+			wantErrMsg: "block: list: parse lsblk",
+		},
+		{
+			name: "when pvs output is invalid logs and returns devices",
+			setupMock: func() {
+				suite.mockExec.EXPECT().RunCmd("lsblk", lsblkArgs).Return(lsblkJSON, nil)
+				suite.mockExec.EXPECT().RunPrivilegedCmd("pvs", pvsArgs).Return("{", nil)
+			},
+			validateFunc: func(inv *block.Inventory) {
+				suite.Len(inv.Devices, 1)
+				suite.Empty(inv.PhysicalVolumes)
+			},
+		},
+		{
+			name: "when vgs fails returns error",
+			setupMock: func() {
+				suite.mockExec.EXPECT().RunCmd("lsblk", lsblkArgs).Return(lsblkJSON, nil)
+				suite.mockExec.EXPECT().RunPrivilegedCmd("pvs", pvsArgs).Return(pvsJSON, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("vgs", vgsArgs).
+					Return("  Locking failed", errors.New("exit status 5"))
+			},
+			wantErr:    true,
+			wantErrMsg: "block: list: vgs: Locking failed: exit status 5",
+		},
+		{
+			name: "when lvs fails returns error",
+			setupMock: func() {
+				suite.mockExec.EXPECT().RunCmd("lsblk", lsblkArgs).Return(lsblkJSON, nil)
+				suite.mockExec.EXPECT().RunPrivilegedCmd("pvs", pvsArgs).Return(pvsJSON, nil)
+				suite.mockExec.EXPECT().RunPrivilegedCmd("vgs", vgsArgs).Return(vgsJSON, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("lvs", lvsArgs).
+					Return("", errors.New("exit status 5"))
+			},
+			wantErr:    true,
+			wantErrMsg: "block: list: lvs",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.List(context.Background())
+
+			if tc.wantErr {
+				suite.Error(err)
+				suite.Contains(err.Error(), tc.wantErrMsg)
+				suite.Nil(got)
+
+				return
+			}
+
+			suite.NoError(err)
+			suite.NotNil(got)
+
+			if tc.validateFunc != nil {
+				tc.validateFunc(got)
+			}
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestDebianPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(DebianPublicTestSuite))
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package block provides block device, partition and LVM inventory, and
+// logical volume extension for disk-pressure remediation.
+package block
+
+import "context"
+
+// Provider implements the methods to inspect and grow block storage.
+type Provider interface {
+	// List returns the block device tree and LVM inventory.
+	List(ctx context.Context) (*Inventory, error)
+	// Extend grows a logical volume and, optionally, its filesystem.
+	Extend(ctx context.Context, opts ExtendOpts) (*ExtendResult, error)
+}
+
+// Inventory represents the block storage layout of a node.
+type Inventory struct {
+	Devices         []Device         `json:"devices"`
+	PhysicalVolumes []PhysicalVolume `json:"physical_volumes"`
+	VolumeGroups    []VolumeGroup    `json:"volume_groups"`
+	LogicalVolumes  []LogicalVolume  `json:"logical_volumes"`
+}
+
+// Device represents a block device as reported by lsblk. Partitions,
+// device-mapper volumes and other holders are nested under Children.
+type Device struct {
+	Name       string   `json:"name"`
+	Path       string   `json:"path"`
+	Type       string   `json:"type"`
+	Size       int64    `json:"size"`
+	FSType     string   `json:"fs_type,omitempty"`
+	UUID       string   `json:"uuid,omitempty"`
+	Label      string   `json:"label,omitempty"`
+	MountPoint string   `json:"mount_point,omitempty"`
+	Model      string   `json:"model,omitempty"`
+	ReadOnly   bool     `json:"read_only"`
+	Children   []Device `json:"children,omitempty"`
+}
+
+// PhysicalVolume represents an LVM physical volume. Sizes are in bytes.
+type PhysicalVolume struct {
+	Name        string `json:"name"`
+	VolumeGroup string `json:"volume_group,omitempty"`
+	Size        int64  `json:"size"`
+	Free        int64  `json:"free"`
+}
+
+// VolumeGroup represents an LVM volume group. Sizes are in bytes.
+type VolumeGroup struct {
+	Name        string `json:"name"`
+	Size        int64  `json:"size"`
+	Free        int64  `json:"free"`
+	ExtentSize  int64  `json:"extent_size"`
+	FreeExtents int64  `json:"free_extents"`
+	PVCount     int    `json:"pv_count"`
+	LVCount     int    `json:"lv_count"`
+}
+
+// LogicalVolume represents an LVM logical volume. Sizes are in bytes.
+type LogicalVolume struct {
+	Name        string `json:"name"`
+	VolumeGroup string `json:"volume_group"`
+	Path        string `json:"path"`
+	Size        int64  `json:"size"`
+	Attr        string `json:"attr"`
+}
+
+// ExtendOpts contains the options for extending a logical volume.
+type ExtendOpts struct {
+	// VolumeGroup is the volume group holding the logical volume.
+	VolumeGroup string `json:"volume_group"`
+	// LogicalVolume is the logical volume to extend.
+	LogicalVolume string `json:"logical_volume"`
+	// Size is an lvextend size such as "+10G", "50G" or "+100%FREE".
+	// Empty grows the volume into all free extents of its group.
+	Size string `json:"size,omitempty"`
+	// ResizeFS grows the filesystem on the volume along with it.
+	ResizeFS bool `json:"resize_fs"`
+}
+
+// ExtendResult represents the outcome of a logical volume extension.
+type ExtendResult struct {
+	Name    string `json:"name"`
+	Size    int64  `json:"size"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
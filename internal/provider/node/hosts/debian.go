@@ -0,0 +1,468 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hosts
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/netip"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/avfs/avfs"
+
+	"github.com/osapi-io/osapi/internal/provider"
+)
+
+const (
+	// hostsPath is the static table of host names.
+	hostsPath = "/etc/hosts"
+	// hostnamePath holds the static system hostname.
+	hostnamePath = "/etc/hostname"
+	// loopbackAddress is the Debian convention for mapping the system
+	// hostname when it has no permanent address.
+	loopbackAddress = "127.0.1.1"
+	// maxHostnameLength is the longest name accepted by resolvers.
+	maxHostnameLength = 253
+)
+
+var validHostname = regexp.MustCompile(
+	`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`,
+)
+
+// Compile-time check: Debian must satisfy Provider.
+var _ Provider = (*Debian)(nil)
+
+// Compile-time check: Debian must satisfy FactsSetter.
+var _ provider.FactsSetter = (*Debian)(nil)
+
+// Debian implements the Provider interface for Debian-family systems.
+// Managed entries are written to /etc/hosts between osapi begin and end
+// marker comments; lines outside the block are never rewritten, except
+// for the 127.0.1.1 entry when the system hostname changes. Mutations
+// are serialized and the file is replaced atomically.
+type Debian struct {
+	provider.FactsAware
+	logger *slog.Logger
+	fs     avfs.VFS
+	// mu serializes the read-modify-write cycle of mutations.
+	mu sync.Mutex
+}
+
+// NewDebianProvider factory to create a new Debian instance.
+func NewDebianProvider(
+	logger *slog.Logger,
+	fs avfs.VFS,
+) *Debian {
+	return &Debian{
+		logger: logger.With(slog.String("subsystem", "provider.hosts")),
+		fs:     fs,
+	}
+}
+
+// List returns every entry in /etc/hosts in file order. Entries in the
+// managed block are marked managed, and drift is reported against the
+// rest of the file and the current system hostname.
+func (d *Debian) List(
+	_ context.Context,
+) ([]Entry, error) {
+	table, err := d.readHosts()
+	if err != nil {
+		return nil, fmt.Errorf("hosts: list: %w", err)
+	}
+
+	entries := table.entries()
+	d.applyDrift(entries)
+
+	return entries, nil
+}
+
+// Get returns the entry for an address. A managed entry takes precedence
+// over an unmanaged line for the same address.
+func (d *Debian) Get(
+	_ context.Context,
+	address string,
+) (*Entry, error) {
+	addr, err := normalizeAddress(address)
+	if err != nil {
+		return nil, fmt.Errorf("hosts: get: %w", err)
+	}
+
+	table, err := d.readHosts()
+	if err != nil {
+		return nil, fmt.Errorf("hosts: get: %w", err)
+	}
+
+	entries := table.entries()
+	d.applyDrift(entries)
+
+	var found *Entry
+	for i := range entries {
+		if entries[i].Address != addr {
+			continue
+		}
+		if found == nil || (entries[i].Managed && !found.Managed) {
+			found = &entries[i]
+		}
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("hosts entry %q: not found", addr)
+	}
+
+	return found, nil
+}
+
+// Create appends a managed entry to the osapi block. An address that is
+// already managed returns Changed: false without modifying the file.
+func (d *Debian) Create(
+	_ context.Context,
+	entry Entry,
+) (*Result, error) {
+	normalized, err := validateEntry(entry)
+	if err != nil {
+		return nil, fmt.Errorf("hosts: create: %w", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	table, err := d.readHosts()
+	if err != nil {
+		return nil, fmt.Errorf("hosts: create: %w", err)
+	}
+
+	if table.findManaged(normalized.Address) >= 0 {
+		return &Result{Address: normalized.Address, Changed: false}, nil
+	}
+
+	table.managed = append(table.managed, formatLine(normalized.Address, normalized.Hostnames, ""))
+
+	if err := d.writeHosts(table); err != nil {
+		return nil, fmt.Errorf("hosts: create: %w", err)
+	}
+
+	d.logger.Info("hosts entry created",
+		slog.String("address", normalized.Address),
+		slog.String("hostnames", strings.Join(normalized.Hostnames, " ")),
+	)
+
+	return &Result{Address: normalized.Address, Changed: true}, nil
+}
+
+// Update replaces the hostnames of a managed entry. Returns Changed: false
+// when the hostnames are unchanged.
+func (d *Debian) Update(
+	_ context.Context,
+	entry Entry,
+) (*Result, error) {
+	normalized, err := validateEntry(entry)
+	if err != nil {
+		return nil, fmt.Errorf("hosts: update: %w", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	table, err := d.readHosts()
+	if err != nil {
+		return nil, fmt.Errorf("hosts: update: %w", err)
+	}
+
+	idx := table.findManaged(normalized.Address)
+	if idx < 0 {
+		return nil, fmt.Errorf("hosts entry %q: not found", normalized.Address)
+	}
+
+	line := formatLine(normalized.Address, normalized.Hostnames, "")
+	if table.managed[idx] == line {
+		return &Result{Address: normalized.Address, Changed: false}, nil
+	}
+
+	table.managed[idx] = line
+
+	if err := d.writeHosts(table); err != nil {
+		return nil, fmt.Errorf("hosts: update: %w", err)
+	}
+
+	d.logger.Info("hosts entry updated",
+		slog.String("address", normalized.Address),
+		slog.String("hostnames", strings.Join(normalized.Hostnames, " ")),
+	)
+
+	return &Result{Address: normalized.Address, Changed: true}, nil
+}
+
+// Delete removes a managed entry. The block markers are removed with the
+// last entry. Returns Changed: false when the address is not managed.
+func (d *Debian) Delete(
+	_ context.Context,
+	address string,
+) (*Result, error) {
+	addr, err := normalizeAddress(address)
+	if err != nil {
+		return nil, fmt.Errorf("hosts: delete: %w", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	table, err := d.readHosts()
+	if err != nil {
+		return nil, fmt.Errorf("hosts: delete: %w", err)
+	}
+
+	idx := table.findManaged(addr)
+	if idx < 0 {
+		return &Result{Address: addr, Changed: false}, nil
+	}
+
+	table.managed = append(table.managed[:idx], table.managed[idx+1:]...)
+
+	if err := d.writeHosts(table); err != nil {
+		return nil, fmt.Errorf("hosts: delete: %w", err)
+	}
+
+	d.logger.Info("hosts entry deleted", slog.String("address", addr))
+
+	return &Result{Address: addr, Changed: true}, nil
+}
+
+// SetHostname rewrites the first 127.0.1.1 entry so that it maps the new
+// hostname. Names equal to previous, and fully qualified names whose
+// first label is previous, are renamed; other aliases are kept. When no
+// name was renamed the hostname is inserted as the canonical name. A
+// managed entry is added when /etc/hosts has no 127.0.1.1 line.
+func (d *Debian) SetHostname(
+	_ context.Context,
+	previous string,
+	hostname string,
+) (*Result, error) {
+	if err := validateHostname(hostname); err != nil {
+		return nil, fmt.Errorf("hosts: set hostname: %w", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	table, err := d.readHosts()
+	if err != nil {
+		return nil, fmt.Errorf("hosts: set hostname: %w", err)
+	}
+
+	lines, idx := table.findLoopback()
+	if idx < 0 {
+		table.managed = append(
+			table.managed,
+			formatLine(loopbackAddress, []string{hostname}, ""),
+		)
+	} else {
+		addr, names, comment, _ := parseLine(lines[idx])
+		renamed := renameHost(names, previous, hostname)
+		if strings.Join(renamed, " ") == strings.Join(names, " ") {
+			return &Result{Address: loopbackAddress, Changed: false}, nil
+		}
+		lines[idx] = formatLine(addr, renamed, comment)
+	}
+
+	if err := d.writeHosts(table); err != nil {
+		return nil, fmt.Errorf("hosts: set hostname: %w", err)
+	}
+
+	d.logger.Info("hosts loopback entry updated",
+		slog.String("previous", previous),
+		slog.String("hostname", hostname),
+	)
+
+	return &Result{Address: loopbackAddress, Changed: true}, nil
+}
+
+// applyDrift flags managed entries whose hostnames resolve to another
+// address of the same family elsewhere in the file, and 127.0.1.1
+// entries that do not list the current system hostname.
+func (d *Debian) applyDrift(
+	entries []Entry,
+) {
+	system := d.systemHostname()
+
+	for i := range entries {
+		entry := &entries[i]
+
+		if entry.Address == loopbackAddress && system != "" &&
+			!hasHostname(entry.Hostnames, system) {
+			entry.Drifted = true
+		}
+
+		if !entry.Managed {
+			continue
+		}
+
+		for j, other := range entries {
+			if i == j || other.Address == entry.Address ||
+				!sameFamily(entry.Address, other.Address) {
+				continue
+			}
+			for _, name := range entry.Hostnames {
+				if containsFold(other.Hostnames, name) {
+					entry.Drifted = true
+				}
+			}
+		}
+	}
+}
+
+// systemHostname returns the static hostname from /etc/hostname, or an
+// empty string when it cannot be read.
+func (d *Debian) systemHostname() string {
+	data, err := d.fs.ReadFile(hostnamePath)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(data))
+}
+
+// validateEntry checks the address and hostnames of an entry and returns
+// it with the address in canonical form.
+func validateEntry(
+	entry Entry,
+) (Entry, error) {
+	addr, err := normalizeAddress(entry.Address)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	if len(entry.Hostnames) == 0 {
+		return Entry{}, fmt.Errorf("hosts entry %q: at least one hostname is required", addr)
+	}
+
+	for _, name := range entry.Hostnames {
+		if err := validateHostname(name); err != nil {
+			return Entry{}, err
+		}
+	}
+
+	return Entry{Address: addr, Hostnames: entry.Hostnames}, nil
+}
+
+// normalizeAddress parses an IPv4 or IPv6 address and returns its
+// canonical form. Zoned addresses are rejected.
+func normalizeAddress(
+	address string,
+) (string, error) {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return "", fmt.Errorf("invalid address %q", address)
+	}
+
+	if addr.Zone() != "" {
+		return "", fmt.Errorf("invalid address %q: zones are not supported", address)
+	}
+
+	return addr.String(), nil
+}
+
+// validateHostname checks that a name is a valid RFC 1123 hostname.
+func validateHostname(
+	name string,
+) error {
+	if name == "" || len(name) > maxHostnameLength || !validHostname.MatchString(name) {
+		return fmt.Errorf("invalid hostname %q", name)
+	}
+
+	return nil
+}
+
+// renameHost replaces previous with hostname in names, carrying over the
+// domain of fully qualified names. The hostname is inserted as the
+// canonical name when nothing was renamed and it is not yet listed.
+func renameHost(
+	names []string,
+	previous string,
+	hostname string,
+) []string {
+	result := make([]string, 0, len(names)+1)
+	renamed := false
+
+	for _, name := range names {
+		switch {
+		case previous != "" && strings.EqualFold(name, previous):
+			name = hostname
+			renamed = true
+		case previous != "" && len(name) > len(previous) &&
+			strings.EqualFold(name[:len(previous)+1], previous+"."):
+			name = hostname + name[len(previous):]
+			renamed = true
+		}
+
+		if !containsFold(result, name) {
+			result = append(result, name)
+		}
+	}
+
+	if !renamed && !containsFold(result, hostname) {
+		result = append([]string{hostname}, result...)
+	}
+
+	return result
+}
+
+// hasHostname reports whether names contains hostname or a fully
+// qualified name whose first label is hostname.
+func hasHostname(
+	names []string,
+	hostname string,
+) bool {
+	for _, name := range names {
+		if strings.EqualFold(name, hostname) ||
+			(len(name) > len(hostname) &&
+				strings.EqualFold(name[:len(hostname)+1], hostname+".")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containsFold reports whether names contains name, ignoring case.
+func containsFold(
+	names []string,
+	name string,
+) bool {
+	for _, n := range names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sameFamily reports whether two canonical addresses are both IPv4 or
+// both IPv6.
+func sameFamily(
+	a string,
+	b string,
+) bool {
+	return strings.Contains(a, ":") == strings.Contains(b, ":")
+}
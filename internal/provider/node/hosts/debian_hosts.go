@@ -0,0 +1,248 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hosts
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/netip"
+	"strings"
+	"syscall"
+)
+
+const (
+	// blockBegin opens the block of managed entries.
+	blockBegin = "# BEGIN osapi managed hosts"
+	// blockEnd closes the block of managed entries.
+	blockEnd = "# END osapi managed hosts"
+)
+
+// hostsTable is /etc/hosts split around the managed block. Lines before
+// and after the block are kept verbatim.
+type hostsTable struct {
+	before  []string
+	managed []string
+	after   []string
+}
+
+// readHosts reads /etc/hosts and splits it around the managed block. A
+// missing file is treated as empty.
+func (d *Debian) readHosts() (*hostsTable, error) {
+	data, err := d.fs.ReadFile(hostsPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return &hostsTable{}, nil
+		}
+
+		return nil, fmt.Errorf("read %s: %w", hostsPath, err)
+	}
+
+	return splitTable(splitLines(string(data)))
+}
+
+// writeHosts writes the table back to /etc/hosts. The block markers are
+// omitted when there are no managed entries. The new content is written
+// to a temporary file and renamed into place so readers never see a
+// truncated file.
+func (d *Debian) writeHosts(
+	table *hostsTable,
+) error {
+	lines := make([]string, 0, len(table.before)+len(table.managed)+len(table.after)+2)
+	lines = append(lines, table.before...)
+	if len(table.managed) > 0 {
+		lines = append(lines, blockBegin)
+		lines = append(lines, table.managed...)
+		lines = append(lines, blockEnd)
+	}
+	lines = append(lines, table.after...)
+
+	content := []byte(strings.Join(lines, "\n") + "\n")
+
+	var mode fs.FileMode = 0o644
+	if info, err := d.fs.Stat(hostsPath); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	tmpPath := hostsPath + ".osapi-tmp"
+	if err := d.fs.WriteFile(tmpPath, content, mode); err != nil {
+		return fmt.Errorf("write %s: %w", hostsPath, err)
+	}
+
+	if err := d.fs.Rename(tmpPath, hostsPath); err != nil {
+		_ = d.fs.Remove(tmpPath)
+
+		// Containers bind-mount /etc/hosts, which cannot be replaced by
+		// a rename; rewrite it in place instead.
+		if !errors.Is(err, syscall.EBUSY) {
+			return fmt.Errorf("write %s: %w", hostsPath, err)
+		}
+
+		if err := d.fs.WriteFile(hostsPath, content, mode); err != nil {
+			return fmt.Errorf("write %s: %w", hostsPath, err)
+		}
+	}
+
+	return nil
+}
+
+// splitTable locates the managed block. A begin marker without a
+// matching end marker is rejected rather than guessing where the block
+// stops.
+func splitTable(
+	lines []string,
+) (*hostsTable, error) {
+	begin, end := -1, -1
+	for i, line := range lines {
+		switch strings.TrimSpace(line) {
+		case blockBegin:
+			if begin < 0 {
+				begin = i
+			}
+		case blockEnd:
+			if begin >= 0 && end < 0 {
+				end = i
+			}
+		}
+	}
+
+	if begin < 0 {
+		return &hostsTable{before: lines}, nil
+	}
+
+	if end < 0 {
+		return nil, fmt.Errorf("%s: managed block is not terminated", hostsPath)
+	}
+
+	table := &hostsTable{
+		before: lines[:begin],
+		after:  lines[end+1:],
+	}
+	for _, line := range lines[begin+1 : end] {
+		if _, _, _, ok := parseLine(line); ok {
+			table.managed = append(table.managed, line)
+		}
+	}
+
+	return table, nil
+}
+
+// entries returns every parsable entry in file order.
+func (t *hostsTable) entries() []Entry {
+	var result []Entry
+	add := func(lines []string, managed bool) {
+		for _, line := range lines {
+			addr, names, _, ok := parseLine(line)
+			if !ok {
+				continue
+			}
+			result = append(result, Entry{
+				Address:   addr,
+				Hostnames: names,
+				Managed:   managed,
+			})
+		}
+	}
+
+	add(t.before, false)
+	add(t.managed, true)
+	add(t.after, false)
+
+	return result
+}
+
+// findManaged returns the index of the managed entry for address, or -1.
+func (t *hostsTable) findManaged(
+	address string,
+) int {
+	for i, line := range t.managed {
+		if addr, _, _, ok := parseLine(line); ok && addr == address {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// findLoopback returns the section holding the first 127.0.1.1 entry
+// and its index within that section, or -1 when there is none.
+func (t *hostsTable) findLoopback() ([]string, int) {
+	for _, lines := range [][]string{t.before, t.managed, t.after} {
+		for i, line := range lines {
+			if addr, _, _, ok := parseLine(line); ok && addr == loopbackAddress {
+				return lines, i
+			}
+		}
+	}
+
+	return nil, -1
+}
+
+// parseLine parses a hosts line into its canonical address, hostnames
+// and trailing comment. Blank lines, comments and lines with an invalid
+// address or no hostnames are rejected.
+func parseLine(
+	line string,
+) (string, []string, string, bool) {
+	content, comment, found := strings.Cut(line, "#")
+	if found {
+		comment = "#" + comment
+	}
+
+	fields := strings.Fields(content)
+	if len(fields) < 2 {
+		return "", nil, "", false
+	}
+
+	addr, err := netip.ParseAddr(fields[0])
+	if err != nil {
+		return "", nil, "", false
+	}
+
+	return addr.String(), fields[1:], strings.TrimSpace(comment), true
+}
+
+// formatLine renders an address, its hostnames and an optional comment
+// as a hosts line.
+func formatLine(
+	address string,
+	hostnames []string,
+	comment string,
+) string {
+	line := address + "\t" + strings.Join(hostnames, " ")
+	if comment != "" {
+		line += " " + comment
+	}
+
+	return line
+}
+
+// splitLines splits content into lines, dropping the trailing newline.
+func splitLines(
+	content string,
+) []string {
+	content = strings.TrimSuffix(content, "\n")
+	if content == "" {
+		return nil
+	}
+
+	return strings.Split(content, "\n")
+}
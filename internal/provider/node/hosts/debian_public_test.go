@@ -0,0 +1,1112 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package hosts_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/failfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osapi-io/osapi/internal/provider/node/hosts"
+)
+
+const (
+	baseHosts = "127.0.0.1\tlocalhost\n" +
+		"127.0.1.1\tweb01.example.com web01\n" +
+		"\n" +
+		"# The following lines are desirable for IPv6 capable hosts\n" +
+		"::1     localhost ip6-localhost ip6-loopback\n"
+	managedHosts = baseHosts +
+		"# BEGIN osapi managed hosts\n" +
+		"10.0.0.5\tdb01.internal db01\n" +
+		"# END osapi managed hosts\n"
+)
+
+type DebianPublicTestSuite struct {
+	suite.Suite
+
+	logger   *slog.Logger
+	memFs    avfs.VFS
+	provider *hosts.Debian
+}
+
+func (suite *DebianPublicTestSuite) SetupTest() {
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+
+	_ = suite.memFs.MkdirAll("/etc", 0o755)
+
+	suite.provider = hosts.NewDebianProvider(
+		suite.logger,
+		suite.memFs,
+	)
+}
+
+func (suite *DebianPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+// writeFiles seeds /etc/hosts and /etc/hostname.
+func (suite *DebianPublicTestSuite) writeFiles(
+	content string,
+	hostname string,
+) {
+	_ = suite.memFs.WriteFile("/etc/hosts", []byte(content), 0o644)
+	_ = suite.memFs.WriteFile("/etc/hostname", []byte(hostname+"\n"), 0o644)
+}
+
+// readHosts returns the current /etc/hosts content.
+func (suite *DebianPublicTestSuite) readHosts() string {
+	data, err := suite.memFs.ReadFile("/etc/hosts")
+	suite.Require().NoError(err)
+
+	return string(data)
+}
+
+// useFailingFs replaces the provider filesystem with one that fails the
+// given operation once it has been called more than skip times. ReadFile
+// opens the file internally, so write failures skip the hosts read.
+func (suite *DebianPublicTestSuite) useFailingFs(
+	content string,
+	failFn avfs.FnVFS,
+	skip int,
+) {
+	suite.useFailingFsErr(content, failFn, skip, errors.New("fs failed"))
+}
+
+// useFailingFsErr is useFailingFs with a caller-supplied error. The
+// underlying filesystem stays reachable through readHosts.
+func (suite *DebianPublicTestSuite) useFailingFsErr(
+	content string,
+	failFn avfs.FnVFS,
+	skip int,
+	failErr error,
+) {
+	baseFs := memfs.New()
+	_ = baseFs.MkdirAll("/etc", 0o755)
+	_ = baseFs.WriteFile("/etc/hosts", []byte(content), 0o644)
+
+	calls := 0
+	vfs := failfs.New(baseFs)
+	_ = vfs.SetFailFunc(func(
+		_ avfs.VFSBase,
+		fn avfs.FnVFS,
+		_ *failfs.FailParam,
+	) error {
+		if fn == failFn {
+			calls++
+			if calls > skip {
+				return failErr
+			}
+		}
+
+		return nil
+	})
+
+	suite.memFs = baseFs
+
+	suite.provider = hosts.NewDebianProvider(
+		suite.logger,
+		vfs,
+	)
+}
+
+func (suite *DebianPublicTestSuite) TestList() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func([]hosts.Entry, error)
+	}{
+		{
+			name: "when unmanaged lines are listed with managed entries",
+			setup: func() {
+				suite.writeFiles(managedHosts, "web01")
+			},
+			validateFunc: func(
+				result []hosts.Entry,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal([]hosts.Entry{
+					{Address: "127.0.0.1", Hostnames: []string{"localhost"}},
+					{Address: "127.0.1.1", Hostnames: []string{"web01.example.com", "web01"}},
+					{
+						Address:   "::1",
+						Hostnames: []string{"localhost", "ip6-localhost", "ip6-loopback"},
+					},
+					{
+						Address:   "10.0.0.5",
+						Hostnames: []string{"db01.internal", "db01"},
+						Managed:   true,
+					},
+				}, result)
+			},
+		},
+		{
+			name: "when managed hostname is mapped to another address",
+			setup: func() {
+				suite.writeFiles(
+					baseHosts+"10.0.0.9 db01 # old database\n"+
+						"# BEGIN osapi managed hosts\n"+
+						"10.0.0.5\tdb01.internal db01\n"+
+						"# END osapi managed hosts\n",
+					"web01",
+				)
+			},
+			validateFunc: func(
+				result []hosts.Entry,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Require().Len(result, 5)
+				suite.False(result[3].Managed)
+				suite.False(result[3].Drifted)
+				suite.True(result[4].Managed)
+				suite.True(result[4].Drifted)
+			},
+		},
+		{
+			name: "when managed hostname is mapped to another address family",
+			setup: func() {
+				suite.writeFiles(
+					baseHosts+"fd00::5 db01\n"+
+						"# BEGIN osapi managed hosts\n"+
+						"10.0.0.5\tdb01\n"+
+						"# END osapi managed hosts\n",
+					"web01",
+				)
+			},
+			validateFunc: func(
+				result []hosts.Entry,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Require().Len(result, 5)
+				suite.False(result[4].Drifted)
+			},
+		},
+		{
+			name: "when loopback entry does not list the system hostname",
+			setup: func() {
+				suite.writeFiles(managedHosts, "web02")
+			},
+			validateFunc: func(
+				result []hosts.Entry,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Require().Len(result, 4)
+				suite.Equal("127.0.1.1", result[1].Address)
+				suite.True(result[1].Drifted)
+				suite.False(result[3].Drifted)
+			},
+		},
+		{
+			name: "when hosts file does not exist",
+			setup: func() {
+			},
+			validateFunc: func(
+				result []hosts.Entry,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Empty(result)
+			},
+		},
+		{
+			name: "when managed block is not terminated",
+			setup: func() {
+				suite.writeFiles(
+					baseHosts+"# BEGIN osapi managed hosts\n10.0.0.5 db01\n",
+					"web01",
+				)
+			},
+			validateFunc: func(
+				result []hosts.Entry,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "managed block is not terminated")
+			},
+		},
+		{
+			name: "when hosts read fails",
+			setup: func() {
+				suite.useFailingFs(managedHosts, avfs.FnReadFile, 0)
+			},
+			validateFunc: func(
+				result []hosts.Entry,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "hosts: list")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.List(context.Background())
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestGet() {
+	tests := []struct {
+		name         string
+		address      string
+		setup        func()
+		validateFunc func(*hosts.Entry, error)
+	}{
+		{
+			name:    "when managed entry exists",
+			address: "10.0.0.5",
+			setup: func() {
+				suite.writeFiles(managedHosts, "web01")
+			},
+			validateFunc: func(
+				result *hosts.Entry,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&hosts.Entry{
+					Address:   "10.0.0.5",
+					Hostnames: []string{"db01.internal", "db01"},
+					Managed:   true,
+				}, result)
+			},
+		},
+		{
+			name:    "when managed entry shadows an unmanaged line",
+			address: "10.0.0.5",
+			setup: func() {
+				suite.writeFiles(baseHosts+"10.0.0.5 legacy\n"+
+					"# BEGIN osapi managed hosts\n"+
+					"10.0.0.5\tdb01\n"+
+					"# END osapi managed hosts\n", "web01")
+			},
+			validateFunc: func(
+				result *hosts.Entry,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.True(result.Managed)
+				suite.Equal([]string{"db01"}, result.Hostnames)
+			},
+		},
+		{
+			name:    "when unmanaged entry exists",
+			address: "127.0.1.1",
+			setup: func() {
+				suite.writeFiles(managedHosts, "web01")
+			},
+			validateFunc: func(
+				result *hosts.Entry,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.False(result.Managed)
+				suite.False(result.Drifted)
+				suite.Equal([]string{"web01.example.com", "web01"}, result.Hostnames)
+			},
+		},
+		{
+			name:    "when address is not canonical",
+			address: "0:0:0:0:0:0:0:1",
+			setup: func() {
+				suite.writeFiles(managedHosts, "web01")
+			},
+			validateFunc: func(
+				result *hosts.Entry,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("::1", result.Address)
+			},
+		},
+		{
+			name:    "when entry is not found",
+			address: "10.0.0.99",
+			setup: func() {
+				suite.writeFiles(managedHosts, "web01")
+			},
+			validateFunc: func(
+				result *hosts.Entry,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "not found")
+			},
+		},
+		{
+			name:    "when address is invalid",
+			address: "db01",
+			setup:   func() {},
+			validateFunc: func(
+				result *hosts.Entry,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid address")
+			},
+		},
+		{
+			name:    "when hosts read fails",
+			address: "10.0.0.5",
+			setup: func() {
+				suite.useFailingFs(managedHosts, avfs.FnReadFile, 0)
+			},
+			validateFunc: func(
+				result *hosts.Entry,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "hosts: get")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Get(context.Background(), tc.address)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestCreate() {
+	tests := []struct {
+		name         string
+		entry        hosts.Entry
+		setup        func()
+		validateFunc func(*hosts.Result, error)
+	}{
+		{
+			name: "when block is added after unmanaged lines",
+			entry: hosts.Entry{
+				Address:   "10.0.0.5",
+				Hostnames: []string{"db01.internal", "db01"},
+			},
+			setup: func() {
+				suite.writeFiles(baseHosts, "web01")
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&hosts.Result{Address: "10.0.0.5", Changed: true}, result)
+				suite.Equal(managedHosts, suite.readHosts())
+			},
+		},
+		{
+			name: "when entry is appended to the existing block",
+			entry: hosts.Entry{
+				Address:   "fd00:0:0::6",
+				Hostnames: []string{"cache01"},
+			},
+			setup: func() {
+				suite.writeFiles(managedHosts+"# trailing comment\n", "web01")
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&hosts.Result{Address: "fd00::6", Changed: true}, result)
+				suite.Equal(
+					baseHosts+
+						"# BEGIN osapi managed hosts\n"+
+						"10.0.0.5\tdb01.internal db01\n"+
+						"fd00::6\tcache01\n"+
+						"# END osapi managed hosts\n"+
+						"# trailing comment\n",
+					suite.readHosts(),
+				)
+			},
+		},
+		{
+			name: "when hosts file does not exist",
+			entry: hosts.Entry{
+				Address:   "10.0.0.5",
+				Hostnames: []string{"db01"},
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.True(result.Changed)
+				suite.Equal(
+					"# BEGIN osapi managed hosts\n10.0.0.5\tdb01\n# END osapi managed hosts\n",
+					suite.readHosts(),
+				)
+			},
+		},
+		{
+			name: "when address is already managed",
+			entry: hosts.Entry{
+				Address:   "10.0.0.5",
+				Hostnames: []string{"other"},
+			},
+			setup: func() {
+				suite.writeFiles(managedHosts, "web01")
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&hosts.Result{Address: "10.0.0.5", Changed: false}, result)
+				suite.Equal(managedHosts, suite.readHosts())
+			},
+		},
+		{
+			name: "when address is invalid",
+			entry: hosts.Entry{
+				Address:   "10.0.0.256",
+				Hostnames: []string{"db01"},
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid address")
+			},
+		},
+		{
+			name: "when address has a zone",
+			entry: hosts.Entry{
+				Address:   "fe80::1%eth0",
+				Hostnames: []string{"router"},
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "zones are not supported")
+			},
+		},
+		{
+			name: "when hostnames are missing",
+			entry: hosts.Entry{
+				Address: "10.0.0.5",
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "at least one hostname is required")
+			},
+		},
+		{
+			name: "when hostname is invalid",
+			entry: hosts.Entry{
+				Address:   "10.0.0.5",
+				Hostnames: []string{"db01", "bad_name"},
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), `invalid hostname "bad_name"`)
+			},
+		},
+		{
+			name: "when hosts read fails",
+			entry: hosts.Entry{
+				Address:   "10.0.0.5",
+				Hostnames: []string{"db01"},
+			},
+			setup: func() {
+				suite.useFailingFs(baseHosts, avfs.FnReadFile, 0)
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "hosts: create")
+			},
+		},
+		{
+			name: "when hosts write fails",
+			entry: hosts.Entry{
+				Address:   "10.0.0.5",
+				Hostnames: []string{"db01"},
+			},
+			setup: func() {
+				suite.useFailingFs(baseHosts, avfs.FnOpenFile, 1)
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "write /etc/hosts")
+			},
+		},
+		{
+			name: "when hosts rename fails",
+			entry: hosts.Entry{
+				Address:   "10.0.0.5",
+				Hostnames: []string{"db01"},
+			},
+			setup: func() {
+				suite.useFailingFs(baseHosts, avfs.FnRename, 0)
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "write /etc/hosts")
+				suite.Equal(baseHosts, suite.readHosts())
+
+				_, statErr := suite.memFs.Stat("/etc/hosts.osapi-tmp")
+				suite.True(errors.Is(statErr, fs.ErrNotExist))
+			},
+		},
+		{
+			name: "when hosts is a bind mount rewrites in place",
+			entry: hosts.Entry{
+				Address:   "10.0.0.5",
+				Hostnames: []string{"db01.internal", "db01"},
+			},
+			setup: func() {
+				suite.useFailingFsErr(baseHosts, avfs.FnRename, 0, syscall.EBUSY)
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&hosts.Result{Address: "10.0.0.5", Changed: true}, result)
+				suite.Equal(managedHosts, suite.readHosts())
+
+				_, statErr := suite.memFs.Stat("/etc/hosts.osapi-tmp")
+				suite.True(errors.Is(statErr, fs.ErrNotExist))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Create(context.Background(), tc.entry)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestCreateConcurrent() {
+	suite.writeFiles(baseHosts, "web01")
+
+	// Stall every rename so unserialized mutations would overlap and
+	// overwrite each other's entries.
+	vfs := failfs.New(suite.memFs)
+	_ = vfs.SetFailFunc(func(
+		_ avfs.VFSBase,
+		fn avfs.FnVFS,
+		_ *failfs.FailParam,
+	) error {
+		if fn == avfs.FnRename {
+			time.Sleep(time.Millisecond)
+		}
+
+		return nil
+	})
+	suite.provider = hosts.NewDebianProvider(suite.logger, vfs)
+
+	const count = 20
+
+	var wg sync.WaitGroup
+	for i := range count {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := suite.provider.Create(context.Background(), hosts.Entry{
+				Address:   fmt.Sprintf("10.0.1.%d", i+1),
+				Hostnames: []string{fmt.Sprintf("node%02d", i+1)},
+			})
+			suite.NoError(err)
+		}()
+	}
+	wg.Wait()
+
+	entries, err := suite.provider.List(context.Background())
+	suite.Require().NoError(err)
+
+	managed := 0
+	for _, e := range entries {
+		if e.Managed {
+			managed++
+		}
+	}
+	suite.Equal(count, managed)
+}
+
+func (suite *DebianPublicTestSuite) TestUpdate() {
+	tests := []struct {
+		name         string
+		entry        hosts.Entry
+		setup        func()
+		validateFunc func(*hosts.Result, error)
+	}{
+		{
+			name: "when hostnames are replaced",
+			entry: hosts.Entry{
+				Address:   "10.0.0.5",
+				Hostnames: []string{"pg01.internal", "pg01"},
+			},
+			setup: func() {
+				suite.writeFiles(managedHosts, "web01")
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&hosts.Result{Address: "10.0.0.5", Changed: true}, result)
+				suite.Equal(
+					baseHosts+
+						"# BEGIN osapi managed hosts\n"+
+						"10.0.0.5\tpg01.internal pg01\n"+
+						"# END osapi managed hosts\n",
+					suite.readHosts(),
+				)
+			},
+		},
+		{
+			name: "when hostnames are unchanged",
+			entry: hosts.Entry{
+				Address:   "10.0.0.5",
+				Hostnames: []string{"db01.internal", "db01"},
+			},
+			setup: func() {
+				suite.writeFiles(managedHosts, "web01")
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&hosts.Result{Address: "10.0.0.5", Changed: false}, result)
+			},
+		},
+		{
+			name: "when address is not managed",
+			entry: hosts.Entry{
+				Address:   "127.0.1.1",
+				Hostnames: []string{"web02"},
+			},
+			setup: func() {
+				suite.writeFiles(managedHosts, "web01")
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "not found")
+				suite.Equal(managedHosts, suite.readHosts())
+			},
+		},
+		{
+			name: "when hostname is invalid",
+			entry: hosts.Entry{
+				Address:   "10.0.0.5",
+				Hostnames: []string{"-db01"},
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid hostname")
+			},
+		},
+		{
+			name: "when hosts read fails",
+			entry: hosts.Entry{
+				Address:   "10.0.0.5",
+				Hostnames: []string{"db01"},
+			},
+			setup: func() {
+				suite.useFailingFs(managedHosts, avfs.FnReadFile, 0)
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "hosts: update")
+			},
+		},
+		{
+			name: "when hosts write fails",
+			entry: hosts.Entry{
+				Address:   "10.0.0.5",
+				Hostnames: []string{"pg01"},
+			},
+			setup: func() {
+				suite.useFailingFs(managedHosts, avfs.FnOpenFile, 1)
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "write /etc/hosts")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Update(context.Background(), tc.entry)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestDelete() {
+	tests := []struct {
+		name         string
+		address      string
+		setup        func()
+		validateFunc func(*hosts.Result, error)
+	}{
+		{
+			name:    "when last managed entry is removed with the block",
+			address: "10.0.0.5",
+			setup: func() {
+				suite.writeFiles(managedHosts, "web01")
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&hosts.Result{Address: "10.0.0.5", Changed: true}, result)
+				suite.Equal(baseHosts, suite.readHosts())
+			},
+		},
+		{
+			name:    "when other managed entries remain",
+			address: "10.0.0.5",
+			setup: func() {
+				suite.writeFiles(baseHosts+
+					"# BEGIN osapi managed hosts\n"+
+					"10.0.0.5\tdb01\n"+
+					"10.0.0.6\tdb02\n"+
+					"# END osapi managed hosts\n", "web01")
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.True(result.Changed)
+				suite.Equal(
+					baseHosts+
+						"# BEGIN osapi managed hosts\n"+
+						"10.0.0.6\tdb02\n"+
+						"# END osapi managed hosts\n",
+					suite.readHosts(),
+				)
+			},
+		},
+		{
+			name:    "when address is not managed",
+			address: "127.0.1.1",
+			setup: func() {
+				suite.writeFiles(managedHosts, "web01")
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&hosts.Result{Address: "127.0.1.1", Changed: false}, result)
+				suite.Equal(managedHosts, suite.readHosts())
+			},
+		},
+		{
+			name:    "when address is invalid",
+			address: "not-an-ip",
+			setup:   func() {},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid address")
+			},
+		},
+		{
+			name:    "when hosts read fails",
+			address: "10.0.0.5",
+			setup: func() {
+				suite.useFailingFs(managedHosts, avfs.FnReadFile, 0)
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "hosts: delete")
+			},
+		},
+		{
+			name:    "when hosts write fails",
+			address: "10.0.0.5",
+			setup: func() {
+				suite.useFailingFs(managedHosts, avfs.FnOpenFile, 1)
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "write /etc/hosts")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Delete(context.Background(), tc.address)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestSetHostname() {
+	tests := []struct {
+		name         string
+		previous     string
+		hostname     string
+		setup        func()
+		validateFunc func(*hosts.Result, error)
+	}{
+		{
+			name:     "when short and fully qualified names are renamed",
+			previous: "web01",
+			hostname: "web02",
+			setup: func() {
+				suite.writeFiles(managedHosts, "web01")
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&hosts.Result{Address: "127.0.1.1", Changed: true}, result)
+				suite.Contains(
+					suite.readHosts(),
+					"127.0.0.1\tlocalhost\n127.0.1.1\tweb02.example.com web02\n\n",
+				)
+			},
+		},
+		{
+			name:     "when loopback entry already lists the hostname",
+			previous: "web01",
+			hostname: "web01",
+			setup: func() {
+				suite.writeFiles(managedHosts, "web01")
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&hosts.Result{Address: "127.0.1.1", Changed: false}, result)
+				suite.Equal(managedHosts, suite.readHosts())
+			},
+		},
+		{
+			name:     "when previous name is not listed the hostname is inserted",
+			previous: "web01",
+			hostname: "web02",
+			setup: func() {
+				suite.writeFiles("127.0.1.1 appliance # set by installer\n", "web01")
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.True(result.Changed)
+				suite.Equal(
+					"127.0.1.1\tweb02 appliance # set by installer\n",
+					suite.readHosts(),
+				)
+			},
+		},
+		{
+			name:     "when loopback entry is missing it is added to the block",
+			previous: "web01",
+			hostname: "web02",
+			setup: func() {
+				suite.writeFiles("127.0.0.1\tlocalhost\n", "web01")
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.True(result.Changed)
+				suite.Equal(
+					"127.0.0.1\tlocalhost\n"+
+						"# BEGIN osapi managed hosts\n"+
+						"127.0.1.1\tweb02\n"+
+						"# END osapi managed hosts\n",
+					suite.readHosts(),
+				)
+			},
+		},
+		{
+			name:     "when hostname is invalid",
+			previous: "web01",
+			hostname: "web 02",
+			setup:    func() {},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid hostname")
+			},
+		},
+		{
+			name:     "when hosts read fails",
+			previous: "web01",
+			hostname: "web02",
+			setup: func() {
+				suite.useFailingFs(baseHosts, avfs.FnReadFile, 0)
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "hosts: set hostname")
+			},
+		},
+		{
+			name:     "when hosts write fails",
+			previous: "web01",
+			hostname: "web02",
+			setup: func() {
+				suite.useFailingFs(baseHosts, avfs.FnOpenFile, 1)
+			},
+			validateFunc: func(
+				result *hosts.Result,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "write /etc/hosts")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.SetHostname(
+				context.Background(),
+				tc.previous,
+				tc.hostname,
+			)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestDebianPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(DebianPublicTestSuite))
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package hosts provides /etc/hosts entry management. Managed entries are
+// kept in a marked block of /etc/hosts so that unmanaged lines are
+// preserved as written.
+package hosts
+
+import "context"
+
+// Provider implements the methods to manage /etc/hosts entries.
+type Provider interface {
+	// List returns every entry in /etc/hosts, marking managed entries and
+	// reporting drift.
+	List(ctx context.Context) ([]Entry, error)
+	// Get returns the entry for an address, preferring the managed entry.
+	Get(ctx context.Context, address string) (*Entry, error)
+	// Create adds a managed entry. Idempotent: returns Changed: false if
+	// the address is already managed.
+	Create(ctx context.Context, entry Entry) (*Result, error)
+	// Update replaces the hostnames of a managed entry. Fails if the
+	// address is not managed.
+	Update(ctx context.Context, entry Entry) (*Result, error)
+	// Delete removes a managed entry. Returns Changed: false if the
+	// address is not managed.
+	Delete(ctx context.Context, address string) (*Result, error)
+	// SetHostname points the 127.0.1.1 entry at a new system hostname,
+	// replacing the previous name. The entry is added to the managed
+	// block when /etc/hosts has none.
+	SetHostname(ctx context.Context, previous, hostname string) (*Result, error)
+}
+
+// Entry represents a single /etc/hosts line.
+type Entry struct {
+	// Address is the IPv4 or IPv6 address of the entry.
+	Address string `json:"address"`
+	// Hostnames lists the canonical name followed by any aliases.
+	Hostnames []string `json:"hostnames"`
+	// Managed reports whether the entry lives in the osapi block.
+	Managed bool `json:"managed"`
+	// Drifted reports whether the entry disagrees with the expected
+	// state: a managed hostname is mapped to another address of the same
+	// family elsewhere in the file, or the 127.0.1.1 entry does not list
+	// the current system hostname.
+	Drifted bool `json:"drifted,omitempty"`
+}
+
+// Result represents the outcome of a hosts entry mutation.
+type Result struct {
+	Address string `json:"address"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package kernel
+
+import (
+	"context"
+
+	"github.com/osapi-io/osapi/internal/provider"
+)
+
+// Darwin implements the Provider interface for Darwin (macOS).
+// All methods return ErrUnsupported as macOS has no Linux kernel modules.
+type Darwin struct{}
+
+// NewDarwinProvider factory to create a new Darwin instance.
+func NewDarwinProvider() *Darwin {
+	return &Darwin{}
+}
+
+// ListModules returns ErrUnsupported on Darwin.
+func (d *Darwin) ListModules(
+	_ context.Context,
+) ([]Module, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// GetModule returns ErrUnsupported on Darwin.
+func (d *Darwin) GetModule(
+	_ context.Context,
+	_ string,
+) (*Module, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// CreateModule returns ErrUnsupported on Darwin.
+func (d *Darwin) CreateModule(
+	_ context.Context,
+	_ Module,
+) (*ModuleResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// UpdateModule returns ErrUnsupported on Darwin.
+func (d *Darwin) UpdateModule(
+	_ context.Context,
+	_ Module,
+) (*ModuleResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// DeleteModule returns ErrUnsupported on Darwin.
+func (d *Darwin) DeleteModule(
+	_ context.Context,
+	_ string,
+) (*ModuleResult, error) {
+	return nil, provider.ErrUnsupported
+}
@@ -0,0 +1,613 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package kernel
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/avfs/avfs"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/osapi-io/osapi/internal/exec"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/file"
+)
+
+const (
+	// modulesLoadDir holds the systemd-modules-load drop-ins.
+	modulesLoadDir = "/etc/modules-load.d"
+	// modprobeDir holds the modprobe configuration drop-ins.
+	modprobeDir = "/etc/modprobe.d"
+	// managedPrefix marks drop-ins written by osapi.
+	managedPrefix = "osapi-"
+	// confSuffix is the required suffix for both drop-in directories.
+	confSuffix = ".conf"
+	// loadTemplate is the system template rendered into each
+	// modules-load.d drop-in.
+	loadTemplate = "osapi/modules-load.tmpl"
+	// modprobeTemplate is the system template rendered into each
+	// modprobe.d drop-in.
+	modprobeTemplate = "osapi/modprobe.tmpl"
+	// procModules lists the loaded kernel modules.
+	procModules = "/proc/modules"
+)
+
+var (
+	validName   = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	validOption = regexp.MustCompile(`^[a-zA-Z0-9_-]+(=[^\s#]+)?$`)
+)
+
+// Compile-time checks.
+var (
+	_ Provider             = (*Debian)(nil)
+	_ provider.FactsSetter = (*Debian)(nil)
+)
+
+// Debian implements the Provider interface for Debian-family systems.
+// A module is managed when its modules-load.d or modprobe.d drop-in is
+// recorded in the file-state KV. Blacklist and option settings are kept
+// in the modprobe.d drop-in's state metadata so they can be reported
+// without parsing the file.
+type Debian struct {
+	provider.FactsAware
+	logger       *slog.Logger
+	fs           avfs.VFS
+	fileDeployer file.Deployer
+	stateKV      jetstream.KeyValue
+	execManager  exec.Manager
+	hostname     string
+}
+
+// NewDebianProvider factory to create a new Debian instance.
+func NewDebianProvider(
+	logger *slog.Logger,
+	fs avfs.VFS,
+	fileDeployer file.Deployer,
+	stateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+) *Debian {
+	return &Debian{
+		logger:       logger.With(slog.String("subsystem", "provider.kernel")),
+		fs:           fs,
+		fileDeployer: fileDeployer,
+		stateKV:      stateKV,
+		execManager:  execManager,
+		hostname:     hostname,
+	}
+}
+
+// ListModules returns every loaded module plus any managed module that is
+// not loaded, sorted by name. Managed modules carry their configuration
+// and are flagged as drifted when the runtime state disagrees with it.
+func (d *Debian) ListModules(
+	ctx context.Context,
+) ([]Module, error) {
+	loaded, err := d.readLoaded()
+	if err != nil {
+		return nil, fmt.Errorf("kernel: list modules: %w", err)
+	}
+
+	for _, name := range d.managedNames() {
+		m, ok := loaded[name]
+		if !ok {
+			m = Module{Name: name}
+		}
+
+		d.applyState(ctx, &m)
+		loaded[name] = m
+	}
+
+	result := make([]Module, 0, len(loaded))
+	for _, m := range loaded {
+		result = append(result, m)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+// GetModule returns a single module by name. A module that is neither
+// loaded nor managed is reported as not found.
+func (d *Debian) GetModule(
+	ctx context.Context,
+	name string,
+) (*Module, error) {
+	name = normalizeName(name)
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	loaded, err := d.readLoaded()
+	if err != nil {
+		return nil, fmt.Errorf("kernel: get module %q: %w", name, err)
+	}
+
+	m, ok := loaded[name]
+	if !ok {
+		m = Module{Name: name}
+	}
+
+	d.applyState(ctx, &m)
+
+	if !m.Loaded && !m.Managed {
+		return nil, fmt.Errorf("kernel: get module %q: not found", name)
+	}
+
+	return &m, nil
+}
+
+// CreateModule deploys the drop-ins for a module and applies them at
+// runtime. Idempotent: returns Changed: false if the module is already
+// managed.
+func (d *Debian) CreateModule(
+	ctx context.Context,
+	module Module,
+) (*ModuleResult, error) {
+	module.Name = normalizeName(module.Name)
+	if err := validateModule(module); err != nil {
+		return nil, err
+	}
+
+	// Already managed — nothing to do.
+	if d.isManaged(ctx, module.Name) {
+		return &ModuleResult{
+			Name:    module.Name,
+			Changed: false,
+		}, nil
+	}
+
+	changed, err := d.apply(ctx, module)
+	if err != nil {
+		return nil, fmt.Errorf("kernel: create module %q: %w", module.Name, err)
+	}
+
+	return &ModuleResult{
+		Name:    module.Name,
+		Changed: changed,
+	}, nil
+}
+
+// UpdateModule replaces the configuration of a managed module and applies
+// it at runtime. Drop-ins that are no longer needed are undeployed.
+func (d *Debian) UpdateModule(
+	ctx context.Context,
+	module Module,
+) (*ModuleResult, error) {
+	module.Name = normalizeName(module.Name)
+	if err := validateModule(module); err != nil {
+		return nil, err
+	}
+
+	if !d.isManaged(ctx, module.Name) {
+		return nil, fmt.Errorf("kernel: update module %q: not found", module.Name)
+	}
+
+	changed, err := d.apply(ctx, module)
+	if err != nil {
+		return nil, fmt.Errorf("kernel: update module %q: %w", module.Name, err)
+	}
+
+	return &ModuleResult{
+		Name:    module.Name,
+		Changed: changed,
+	}, nil
+}
+
+// DeleteModule undeploys the drop-ins of a managed module. The module is
+// neither loaded nor unloaded. Idempotent: returns Changed: false if the
+// module is not managed.
+func (d *Debian) DeleteModule(
+	ctx context.Context,
+	name string,
+) (*ModuleResult, error) {
+	name = normalizeName(name)
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	changed := false
+	for _, path := range []string{loadPath(name), modprobePath(name)} {
+		if d.fileState(ctx, path) == nil {
+			continue
+		}
+
+		result, err := d.fileDeployer.Undeploy(ctx, file.UndeployRequest{
+			Path: path,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("kernel: delete module %q: %w", name, err)
+		}
+		changed = changed || result.Changed
+	}
+
+	d.logger.Info(
+		"kernel module configuration removed",
+		slog.String("name", name),
+		slog.Bool("changed", changed),
+	)
+
+	return &ModuleResult{
+		Name:    name,
+		Changed: changed,
+	}, nil
+}
+
+// apply brings the drop-ins and the runtime state of a module in line
+// with its configuration. A module set to load is loaded before its
+// drop-in is written, so a module that does not exist fails without
+// leaving configuration behind. A blacklisted module is unloaded after
+// its drop-in is written; a failed unload (typically because the module
+// is in use) is logged and reported as drift by ListModules.
+func (d *Debian) apply(
+	ctx context.Context,
+	module Module,
+) (bool, error) {
+	_, loaded := d.loadedModules()[module.Name]
+	changed := false
+
+	if module.Load && !loaded {
+		args := append([]string{module.Name}, strings.Fields(module.Options)...)
+		if err := d.modprobe(args...); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+
+	deployed, err := d.deploy(ctx, module)
+	if err != nil {
+		return false, err
+	}
+	changed = changed || deployed
+
+	if module.Blacklist && loaded {
+		if err := d.modprobe("-r", module.Name); err != nil {
+			d.logger.Warn(
+				"kernel module unload failed after blacklist",
+				slog.String("name", module.Name),
+				slog.String("error", err.Error()),
+			)
+		} else {
+			changed = true
+		}
+	}
+
+	d.logger.Info(
+		"kernel module configuration applied",
+		slog.String("name", module.Name),
+		slog.Bool("load", module.Load),
+		slog.Bool("blacklist", module.Blacklist),
+		slog.Bool("changed", changed),
+	)
+
+	return changed, nil
+}
+
+// deploy writes or removes the modules-load.d and modprobe.d drop-ins of
+// a module. Returns true when either file changed.
+func (d *Debian) deploy(
+	ctx context.Context,
+	module Module,
+) (bool, error) {
+	changed := false
+
+	loadFile := loadPath(module.Name)
+	if module.Load {
+		result, err := d.fileDeployer.Deploy(ctx, file.DeployRequest{
+			ObjectName:  loadTemplate,
+			Path:        loadFile,
+			Mode:        "0644",
+			ContentType: "template",
+			Vars:        map[string]any{"name": module.Name},
+			Metadata:    map[string]string{"module": module.Name},
+		})
+		if err != nil {
+			return false, fmt.Errorf("deploy %s: %w", loadFile, err)
+		}
+		changed = result.Changed
+	} else if d.fileState(ctx, loadFile) != nil {
+		result, err := d.fileDeployer.Undeploy(ctx, file.UndeployRequest{
+			Path: loadFile,
+		})
+		if err != nil {
+			return false, fmt.Errorf("undeploy %s: %w", loadFile, err)
+		}
+		changed = result.Changed
+	}
+
+	modprobeFile := modprobePath(module.Name)
+	if module.Blacklist || module.Options != "" {
+		result, err := d.fileDeployer.Deploy(ctx, file.DeployRequest{
+			ObjectName:  modprobeTemplate,
+			Path:        modprobeFile,
+			Mode:        "0644",
+			ContentType: "template",
+			Vars: map[string]any{
+				"name":      module.Name,
+				"options":   module.Options,
+				"blacklist": module.Blacklist,
+			},
+			Metadata: map[string]string{
+				"module":    module.Name,
+				"options":   module.Options,
+				"blacklist": strconv.FormatBool(module.Blacklist),
+			},
+		})
+		if err != nil {
+			return false, fmt.Errorf("deploy %s: %w", modprobeFile, err)
+		}
+		changed = changed || result.Changed
+	} else if d.fileState(ctx, modprobeFile) != nil {
+		result, err := d.fileDeployer.Undeploy(ctx, file.UndeployRequest{
+			Path: modprobeFile,
+		})
+		if err != nil {
+			return false, fmt.Errorf("undeploy %s: %w", modprobeFile, err)
+		}
+		changed = changed || result.Changed
+	}
+
+	return changed, nil
+}
+
+// modprobe runs a privileged modprobe command.
+func (d *Debian) modprobe(
+	args ...string,
+) error {
+	if output, err := d.execManager.RunPrivilegedCmd("modprobe", args); err != nil {
+		return fmt.Errorf(
+			"modprobe %s: %s: %w",
+			strings.Join(args, " "),
+			strings.TrimSpace(output),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// applyState fills in the managed configuration of m from the file-state
+// KV and flags drift between it and the runtime state.
+func (d *Debian) applyState(
+	ctx context.Context,
+	m *Module,
+) {
+	load := d.fileState(ctx, loadPath(m.Name))
+	modprobe := d.fileState(ctx, modprobePath(m.Name))
+	if load == nil && modprobe == nil {
+		return
+	}
+
+	m.Managed = true
+	m.Load = load != nil
+
+	if modprobe != nil {
+		m.Blacklist = modprobe.Metadata["blacklist"] == "true"
+		m.Options = modprobe.Metadata["options"]
+	}
+
+	m.Drifted = (m.Load && !m.Loaded) || (m.Blacklist && m.Loaded)
+}
+
+// isManaged reports whether either drop-in of a module is recorded in the
+// file-state KV and has not been undeployed.
+func (d *Debian) isManaged(
+	ctx context.Context,
+	name string,
+) bool {
+	return d.fileState(ctx, loadPath(name)) != nil ||
+		d.fileState(ctx, modprobePath(name)) != nil
+}
+
+// fileState returns the file-state KV entry for path, or nil when the
+// path is not tracked or has been undeployed.
+func (d *Debian) fileState(
+	ctx context.Context,
+	path string,
+) *job.FileState {
+	kvEntry, err := d.stateKV.Get(ctx, file.BuildStateKey(d.hostname, path))
+	if err != nil {
+		return nil
+	}
+
+	var state job.FileState
+	if err := json.Unmarshal(kvEntry.Value(), &state); err != nil {
+		return nil
+	}
+
+	if state.UndeployedAt != "" {
+		return nil
+	}
+
+	return &state
+}
+
+// managedNames returns the module names of the osapi drop-ins found in
+// modules-load.d and modprobe.d, sorted and without duplicates.
+func (d *Debian) managedNames() []string {
+	seen := make(map[string]bool)
+
+	for _, dir := range []string{modulesLoadDir, modprobeDir} {
+		dirEntries, err := d.fs.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, dirEntry := range dirEntries {
+			fileName := dirEntry.Name()
+			if dirEntry.IsDir() ||
+				!strings.HasPrefix(fileName, managedPrefix) ||
+				!strings.HasSuffix(fileName, confSuffix) {
+				continue
+			}
+
+			name := strings.TrimSuffix(strings.TrimPrefix(fileName, managedPrefix), confSuffix)
+			seen[name] = true
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// loadedModules returns the loaded modules, or an empty map when
+// /proc/modules cannot be read.
+func (d *Debian) loadedModules() map[string]Module {
+	loaded, err := d.readLoaded()
+	if err != nil {
+		return map[string]Module{}
+	}
+
+	return loaded
+}
+
+// readLoaded parses /proc/modules into modules keyed by name. Each line
+// holds the name, size in bytes, reference count, dependent modules
+// ("-" when none), state and load address.
+func (d *Debian) readLoaded() (map[string]Module, error) {
+	data, err := d.fs.ReadFile(procModules)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", procModules, err)
+	}
+
+	loaded := make(map[string]Module)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 {
+			continue
+		}
+
+		m := Module{
+			Name:   fields[0],
+			Loaded: true,
+		}
+		m.Size, _ = strconv.ParseInt(fields[1], 10, 64)
+
+		if fields[3] != "-" {
+			for _, dep := range strings.Split(fields[3], ",") {
+				if dep != "" {
+					m.UsedBy = append(m.UsedBy, dep)
+				}
+			}
+		}
+
+		loaded[m.Name] = m
+	}
+
+	return loaded, nil
+}
+
+// loadPath returns the modules-load.d drop-in path for a module.
+func loadPath(
+	name string,
+) string {
+	return filepath.Join(modulesLoadDir, managedPrefix+name+confSuffix)
+}
+
+// modprobePath returns the modprobe.d drop-in path for a module.
+func modprobePath(
+	name string,
+) string {
+	return filepath.Join(modprobeDir, managedPrefix+name+confSuffix)
+}
+
+// normalizeName converts dashes to underscores. modprobe treats both as
+// equivalent, but /proc/modules always reports underscores.
+func normalizeName(
+	name string,
+) string {
+	return strings.ReplaceAll(name, "-", "_")
+}
+
+// validateName checks that a module name is safe for use as a filename.
+func validateName(
+	name string,
+) error {
+	if name == "" {
+		return fmt.Errorf("invalid module name: empty")
+	}
+	if !validName.MatchString(name) {
+		return fmt.Errorf(
+			"invalid module name %q: must match %s",
+			name,
+			validName.String(),
+		)
+	}
+
+	return nil
+}
+
+// validateModule checks the name, options and settings of a module
+// configuration.
+func validateModule(
+	module Module,
+) error {
+	if err := validateName(module.Name); err != nil {
+		return err
+	}
+
+	if module.Load && module.Blacklist {
+		return fmt.Errorf(
+			"invalid module %q: load and blacklist are mutually exclusive",
+			module.Name,
+		)
+	}
+
+	if !module.Load && !module.Blacklist && module.Options == "" {
+		return fmt.Errorf(
+			"invalid module %q: one of load, blacklist or options is required",
+			module.Name,
+		)
+	}
+
+	for _, option := range strings.Fields(module.Options) {
+		if !validOption.MatchString(option) {
+			return fmt.Errorf(
+				"invalid module option %q: must match %s",
+				option,
+				validOption.String(),
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,843 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package kernel_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider/file"
+	filemocks "github.com/osapi-io/osapi/internal/provider/file/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/kernel"
+)
+
+const (
+	testHostname = "test-host"
+	loadPath     = "/etc/modules-load.d/osapi-br_netfilter.conf"
+	modprobePath = "/etc/modprobe.d/osapi-br_netfilter.conf"
+	floppyLoad   = "/etc/modules-load.d/osapi-floppy.conf"
+	floppyProbe  = "/etc/modprobe.d/osapi-floppy.conf"
+	procModules  = `br_netfilter 32768 0 - Live 0x0000000000000000
+bridge 311296 1 br_netfilter, Live 0x0000000000000000
+stp 16384 1 bridge, Live 0x0000000000000000
+`
+)
+
+// stateJSON returns a JSON-encoded FileState for a module drop-in.
+func stateJSON(
+	path string,
+	undeployedAt string,
+	metadata map[string]string,
+) []byte {
+	state := job.FileState{
+		ObjectName:   "osapi/modprobe.tmpl",
+		Path:         path,
+		SHA256:       "abc123",
+		ContentType:  "template",
+		DeployedAt:   "2026-01-01T00:00:00Z",
+		UndeployedAt: undeployedAt,
+		Metadata:     metadata,
+	}
+
+	b, _ := json.Marshal(state)
+
+	return b
+}
+
+type DebianPublicTestSuite struct {
+	suite.Suite
+
+	ctrl         *gomock.Controller
+	logger       *slog.Logger
+	memFs        avfs.VFS
+	mockDeployer *filemocks.MockDeployer
+	mockStateKV  *jobmocks.MockKeyValue
+	mockExec     *execmocks.MockManager
+	provider     *kernel.Debian
+}
+
+func (suite *DebianPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+	suite.mockDeployer = filemocks.NewMockDeployer(suite.ctrl)
+	suite.mockStateKV = jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+
+	_ = suite.memFs.MkdirAll("/proc", 0o755)
+	_ = suite.memFs.MkdirAll("/etc/modules-load.d", 0o755)
+	_ = suite.memFs.MkdirAll("/etc/modprobe.d", 0o755)
+
+	suite.provider = kernel.NewDebianProvider(
+		suite.logger,
+		suite.memFs,
+		suite.mockDeployer,
+		suite.mockStateKV,
+		suite.mockExec,
+		testHostname,
+	)
+}
+
+func (suite *DebianPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *DebianPublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+// expectStates answers file-state KV lookups from a map of drop-in path
+// to state. Paths missing from the map are reported as not found.
+func (suite *DebianPublicTestSuite) expectStates(
+	states map[string][]byte,
+) {
+	byKey := make(map[string][]byte, len(states))
+	for path, data := range states {
+		byKey[file.BuildStateKey(testHostname, path)] = data
+	}
+
+	suite.mockStateKV.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			key string,
+		) (jetstream.KeyValueEntry, error) {
+			data, ok := byKey[key]
+			if !ok {
+				return nil, jetstream.ErrKeyNotFound
+			}
+
+			entry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+			entry.EXPECT().Value().Return(data).AnyTimes()
+
+			return entry, nil
+		}).
+		AnyTimes()
+}
+
+// writeDropIn creates an osapi drop-in so the module is discovered as
+// managed.
+func (suite *DebianPublicTestSuite) writeDropIn(
+	path string,
+) {
+	_ = suite.memFs.WriteFile(path, []byte("# Managed by osapi. Do not edit.\n"), 0o644)
+}
+
+func (suite *DebianPublicTestSuite) TestListModules() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func([]kernel.Module, error)
+	}{
+		{
+			name: "when modules are loaded returns them sorted with dependents",
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.expectStates(nil)
+			},
+			validateFunc: func(modules []kernel.Module, err error) {
+				suite.Require().NoError(err)
+				suite.Require().Len(modules, 3)
+				suite.Equal(kernel.Module{
+					Name:   "br_netfilter",
+					Loaded: true,
+					Size:   32768,
+				}, modules[0])
+				suite.Equal(kernel.Module{
+					Name:   "bridge",
+					Loaded: true,
+					Size:   311296,
+					UsedBy: []string{"br_netfilter"},
+				}, modules[1])
+				suite.Equal("stp", modules[2].Name)
+			},
+		},
+		{
+			name: "when modules are managed merges configuration and reports drift",
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.writeDropIn(loadPath)
+				suite.writeDropIn(modprobePath)
+				suite.writeDropIn(floppyProbe)
+				suite.writeDropIn("/etc/modprobe.d/blacklist.conf")
+				suite.expectStates(map[string][]byte{
+					loadPath: stateJSON(loadPath, "", nil),
+					modprobePath: stateJSON(modprobePath, "", map[string]string{
+						"blacklist": "false",
+						"options":   "debug=1",
+					}),
+					floppyProbe: stateJSON(floppyProbe, "", map[string]string{
+						"blacklist": "true",
+					}),
+				})
+			},
+			validateFunc: func(modules []kernel.Module, err error) {
+				suite.Require().NoError(err)
+				suite.Require().Len(modules, 4)
+				suite.Equal(kernel.Module{
+					Name:    "br_netfilter",
+					Loaded:  true,
+					Size:    32768,
+					Managed: true,
+					Load:    true,
+					Options: "debug=1",
+				}, modules[0])
+				suite.Equal(kernel.Module{
+					Name:      "floppy",
+					Managed:   true,
+					Blacklist: true,
+				}, modules[2])
+			},
+		},
+		{
+			name: "when a loaded module is blacklisted reports drift",
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.writeDropIn("/etc/modprobe.d/osapi-stp.conf")
+				suite.expectStates(map[string][]byte{
+					"/etc/modprobe.d/osapi-stp.conf": stateJSON(
+						"/etc/modprobe.d/osapi-stp.conf",
+						"",
+						map[string]string{"blacklist": "true"},
+					),
+				})
+			},
+			validateFunc: func(modules []kernel.Module, err error) {
+				suite.Require().NoError(err)
+				suite.Require().Len(modules, 3)
+				suite.True(modules[2].Blacklist)
+				suite.True(modules[2].Drifted)
+			},
+		},
+		{
+			name: "when a module set to load is not loaded reports drift",
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(""), 0o444)
+				suite.writeDropIn(floppyLoad)
+				suite.expectStates(map[string][]byte{
+					floppyLoad: stateJSON(floppyLoad, "", nil),
+				})
+			},
+			validateFunc: func(modules []kernel.Module, err error) {
+				suite.Require().NoError(err)
+				suite.Require().Len(modules, 1)
+				suite.Equal(kernel.Module{
+					Name:    "floppy",
+					Managed: true,
+					Load:    true,
+					Drifted: true,
+				}, modules[0])
+			},
+		},
+		{
+			name: "when drop-in was undeployed reports it as unmanaged",
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.writeDropIn(loadPath)
+				suite.expectStates(map[string][]byte{
+					loadPath: stateJSON(loadPath, "2026-01-02T00:00:00Z", nil),
+				})
+			},
+			validateFunc: func(modules []kernel.Module, err error) {
+				suite.Require().NoError(err)
+				suite.False(modules[0].Managed)
+				suite.False(modules[0].Load)
+			},
+		},
+		{
+			name: "when state is not valid JSON reports it as unmanaged",
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.writeDropIn(loadPath)
+				suite.expectStates(map[string][]byte{
+					loadPath: []byte("not-json"),
+				})
+			},
+			validateFunc: func(modules []kernel.Module, err error) {
+				suite.Require().NoError(err)
+				suite.False(modules[0].Managed)
+			},
+		},
+		{
+			name: "when /proc/modules cannot be read returns error",
+			setup: func() {
+			},
+			validateFunc: func(modules []kernel.Module, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "kernel: list modules")
+				suite.Nil(modules)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.ListModules(context.Background())
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestGetModule() {
+	tests := []struct {
+		name         string
+		moduleName   string
+		setup        func()
+		validateFunc func(*kernel.Module, error)
+	}{
+		{
+			name:       "when module is loaded and managed returns it",
+			moduleName: "br-netfilter",
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.expectStates(map[string][]byte{
+					loadPath: stateJSON(loadPath, "", nil),
+				})
+			},
+			validateFunc: func(module *kernel.Module, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("br_netfilter", module.Name)
+				suite.True(module.Loaded)
+				suite.True(module.Managed)
+				suite.True(module.Load)
+				suite.False(module.Drifted)
+			},
+		},
+		{
+			name:       "when module is managed but not loaded returns it",
+			moduleName: "floppy",
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.expectStates(map[string][]byte{
+					floppyProbe: stateJSON(floppyProbe, "", map[string]string{
+						"blacklist": "true",
+					}),
+				})
+			},
+			validateFunc: func(module *kernel.Module, err error) {
+				suite.Require().NoError(err)
+				suite.False(module.Loaded)
+				suite.True(module.Blacklist)
+				suite.False(module.Drifted)
+			},
+		},
+		{
+			name:       "when module is neither loaded nor managed returns not found",
+			moduleName: "floppy",
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.expectStates(nil)
+			},
+			validateFunc: func(module *kernel.Module, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "not found")
+				suite.Nil(module)
+			},
+		},
+		{
+			name:       "when name is invalid returns error",
+			moduleName: "../etc",
+			setup:      func() {},
+			validateFunc: func(module *kernel.Module, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid module name")
+				suite.Nil(module)
+			},
+		},
+		{
+			name:       "when name is empty returns error",
+			moduleName: "",
+			setup:      func() {},
+			validateFunc: func(module *kernel.Module, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid module name: empty")
+				suite.Nil(module)
+			},
+		},
+		{
+			name:       "when /proc/modules cannot be read returns error",
+			moduleName: "floppy",
+			setup:      func() {},
+			validateFunc: func(module *kernel.Module, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "kernel: get module")
+				suite.Nil(module)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.GetModule(context.Background(), tc.moduleName)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestCreateModule() {
+	tests := []struct {
+		name         string
+		module       kernel.Module
+		setup        func()
+		validateFunc func(*kernel.ModuleResult, error)
+	}{
+		{
+			name:   "when module is not loaded loads it and deploys drop-ins",
+			module: kernel.Module{Name: "floppy", Load: true, Options: "debug=1"},
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.expectStates(nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("modprobe", []string{"floppy", "debug=1"}).
+					Return("", nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						req file.DeployRequest,
+					) (*file.DeployResult, error) {
+						suite.Equal("osapi/modules-load.tmpl", req.ObjectName)
+						suite.Equal(floppyLoad, req.Path)
+						suite.Equal("template", req.ContentType)
+						suite.Equal(map[string]any{"name": "floppy"}, req.Vars)
+						return &file.DeployResult{Changed: true, Path: req.Path}, nil
+					})
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						req file.DeployRequest,
+					) (*file.DeployResult, error) {
+						suite.Equal("osapi/modprobe.tmpl", req.ObjectName)
+						suite.Equal(floppyProbe, req.Path)
+						suite.Equal(map[string]any{
+							"name":      "floppy",
+							"options":   "debug=1",
+							"blacklist": false,
+						}, req.Vars)
+						suite.Equal("debug=1", req.Metadata["options"])
+						suite.Equal("false", req.Metadata["blacklist"])
+						return &file.DeployResult{Changed: true, Path: req.Path}, nil
+					})
+			},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("floppy", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:   "when module is loaded only deploys load drop-in",
+			module: kernel.Module{Name: "br-netfilter", Load: true},
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.expectStates(nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(&file.DeployResult{Changed: true, Path: loadPath}, nil)
+			},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("br_netfilter", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:   "when loaded module is blacklisted unloads it",
+			module: kernel.Module{Name: "stp", Blacklist: true},
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.expectStates(nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						req file.DeployRequest,
+					) (*file.DeployResult, error) {
+						suite.Equal("/etc/modprobe.d/osapi-stp.conf", req.Path)
+						suite.Equal(true, req.Vars["blacklist"])
+						return &file.DeployResult{Changed: false, Path: req.Path}, nil
+					})
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("modprobe", []string{"-r", "stp"}).
+					Return("", nil)
+			},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:   "when unload fails after blacklist keeps configuration",
+			module: kernel.Module{Name: "stp", Blacklist: true},
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.expectStates(nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(&file.DeployResult{Changed: true}, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("modprobe", []string{"-r", "stp"}).
+					Return("modprobe: FATAL: Module stp is in use.", errors.New("exit status 1"))
+			},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:   "when module is already managed returns unchanged",
+			module: kernel.Module{Name: "br_netfilter", Load: true},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					loadPath: stateJSON(loadPath, "", nil),
+				})
+			},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Require().NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:   "when modprobe fails returns error without deploying",
+			module: kernel.Module{Name: "nosuch", Load: true},
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.expectStates(nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("modprobe", []string{"nosuch"}).
+					Return("modprobe: FATAL: Module nosuch not found", errors.New("exit status 1"))
+			},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "kernel: create module \"nosuch\"")
+				suite.Contains(err.Error(), "Module nosuch not found")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:   "when deploy fails returns error",
+			module: kernel.Module{Name: "br_netfilter", Load: true},
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.expectStates(nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("object not found"))
+			},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "deploy "+loadPath)
+				suite.Nil(result)
+			},
+		},
+		{
+			name:   "when modprobe drop-in deploy fails returns error",
+			module: kernel.Module{Name: "br_netfilter", Options: "debug=1"},
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.expectStates(nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("object not found"))
+			},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "deploy "+modprobePath)
+				suite.Nil(result)
+			},
+		},
+		{
+			name:   "when load and blacklist are both set returns error",
+			module: kernel.Module{Name: "floppy", Load: true, Blacklist: true},
+			setup:  func() {},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "mutually exclusive")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:   "when no setting is given returns error",
+			module: kernel.Module{Name: "floppy"},
+			setup:  func() {},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "one of load, blacklist or options is required")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:   "when an option is invalid returns error",
+			module: kernel.Module{Name: "floppy", Options: "debug=1 #x"},
+			setup:  func() {},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid module option \"#x\"")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:   "when name is invalid returns error",
+			module: kernel.Module{Name: "a/b", Load: true},
+			setup:  func() {},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid module name")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.CreateModule(context.Background(), tc.module)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestUpdateModule() {
+	managed := map[string][]byte{
+		loadPath: stateJSON(loadPath, "", nil),
+		modprobePath: stateJSON(modprobePath, "", map[string]string{
+			"options": "debug=1",
+		}),
+	}
+
+	tests := []struct {
+		name         string
+		module       kernel.Module
+		setup        func()
+		validateFunc func(*kernel.ModuleResult, error)
+	}{
+		{
+			name:   "when options are removed undeploys modprobe drop-in",
+			module: kernel.Module{Name: "br_netfilter", Load: true},
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.expectStates(managed)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(&file.DeployResult{Changed: false, Path: loadPath}, nil)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: modprobePath}).
+					Return(&file.UndeployResult{Changed: true, Path: modprobePath}, nil)
+			},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:   "when switched to blacklist undeploys load drop-in and unloads",
+			module: kernel.Module{Name: "br_netfilter", Blacklist: true},
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.expectStates(managed)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: loadPath}).
+					Return(&file.UndeployResult{Changed: true, Path: loadPath}, nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(&file.DeployResult{Changed: true, Path: modprobePath}, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("modprobe", []string{"-r", "br_netfilter"}).
+					Return("", nil)
+			},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:   "when module is not managed returns not found",
+			module: kernel.Module{Name: "floppy", Load: true},
+			setup: func() {
+				suite.expectStates(nil)
+			},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "not found")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:   "when load drop-in undeploy fails returns error",
+			module: kernel.Module{Name: "br_netfilter", Options: "debug=2"},
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.expectStates(managed)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("permission denied"))
+			},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "kernel: update module")
+				suite.Contains(err.Error(), "undeploy "+loadPath)
+				suite.Nil(result)
+			},
+		},
+		{
+			name:   "when modprobe drop-in undeploy fails returns error",
+			module: kernel.Module{Name: "br_netfilter", Load: true},
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/modules", []byte(procModules), 0o444)
+				suite.expectStates(managed)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(&file.DeployResult{Changed: false}, nil)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("permission denied"))
+			},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "undeploy "+modprobePath)
+				suite.Nil(result)
+			},
+		},
+		{
+			name:   "when validation fails returns error",
+			module: kernel.Module{Name: "br_netfilter"},
+			setup:  func() {},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Error(err)
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.UpdateModule(context.Background(), tc.module)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestDeleteModule() {
+	tests := []struct {
+		name         string
+		moduleName   string
+		setup        func()
+		validateFunc func(*kernel.ModuleResult, error)
+	}{
+		{
+			name:       "when module is managed undeploys both drop-ins",
+			moduleName: "br_netfilter",
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					loadPath:     stateJSON(loadPath, "", nil),
+					modprobePath: stateJSON(modprobePath, "", nil),
+				})
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: loadPath}).
+					Return(&file.UndeployResult{Changed: true, Path: loadPath}, nil)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: modprobePath}).
+					Return(&file.UndeployResult{Changed: true, Path: modprobePath}, nil)
+			},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("br_netfilter", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:       "when module is not managed returns unchanged",
+			moduleName: "floppy",
+			setup: func() {
+				suite.expectStates(nil)
+			},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Require().NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:       "when undeploy fails returns error",
+			moduleName: "br_netfilter",
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					loadPath: stateJSON(loadPath, "", nil),
+				})
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("permission denied"))
+			},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "kernel: delete module")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:       "when name is invalid returns error",
+			moduleName: "a b",
+			setup:      func() {},
+			validateFunc: func(result *kernel.ModuleResult, err error) {
+				suite.Error(err)
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.DeleteModule(context.Background(), tc.moduleName)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func TestDebianPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(DebianPublicTestSuite))
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package kernel provides kernel module management. Loaded modules are
+// read from /proc/modules. Persistent load entries in /etc/modules-load.d
+// and option and blacklist entries in /etc/modprobe.d are deployed through
+// the file provider and applied at runtime with modprobe.
+package kernel
+
+import "context"
+
+// Provider implements the methods to manage kernel modules.
+type Provider interface {
+	// ListModules returns the loaded kernel modules merged with the
+	// osapi-managed module configuration, reporting drift between the two.
+	ListModules(ctx context.Context) ([]Module, error)
+	// GetModule returns a single kernel module by name.
+	GetModule(ctx context.Context, name string) (*Module, error)
+	// CreateModule deploys the configuration for a module and applies it.
+	// Idempotent: returns Changed: false if the module is already managed.
+	CreateModule(ctx context.Context, module Module) (*ModuleResult, error)
+	// UpdateModule replaces the configuration of a managed module and
+	// applies it. Fails if the module is not managed.
+	UpdateModule(ctx context.Context, module Module) (*ModuleResult, error)
+	// DeleteModule removes the configuration of a managed module. The
+	// runtime state of the module is left as is.
+	DeleteModule(ctx context.Context, name string) (*ModuleResult, error)
+}
+
+// Module represents a kernel module. Runtime fields come from
+// /proc/modules; configuration fields are set for managed modules only.
+type Module struct {
+	// Name is the module name as shown in /proc/modules.
+	Name string `json:"name"`
+	// Loaded reports whether the module is currently loaded.
+	Loaded bool `json:"loaded"`
+	// Size is the memory size of the loaded module in bytes.
+	Size int64 `json:"size,omitempty"`
+	// UsedBy lists the loaded modules that depend on this module.
+	UsedBy []string `json:"used_by,omitempty"`
+	// Managed reports whether osapi manages configuration for the module.
+	Managed bool `json:"managed"`
+	// Load persists the module in /etc/modules-load.d so it is loaded
+	// at boot.
+	Load bool `json:"load,omitempty"`
+	// Blacklist prevents the module from being loaded automatically.
+	Blacklist bool `json:"blacklist,omitempty"`
+	// Options are space-separated module parameters written to
+	// /etc/modprobe.d (e.g., "nf_conntrack_helper=1").
+	Options string `json:"options,omitempty"`
+	// Drifted reports whether the runtime state disagrees with the
+	// managed configuration: a module set to load is not loaded, or a
+	// blacklisted module is loaded.
+	Drifted bool `json:"drifted,omitempty"`
+}
+
+// ModuleResult represents the outcome of a kernel module mutation.
+type ModuleResult struct {
+	Name    string `json:"name"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
@@ -52,9 +52,28 @@ func (d *Darwin) QueryUnit(
 	return nil, provider.ErrUnsupported
 }
 
+// QueryFile returns ErrUnsupported on Darwin.
+func (d *Darwin) QueryFile(
+	_ context.Context,
+	_ string,
+	_ FileQueryOpts,
+) ([]Entry, error) {
+	return nil, provider.ErrUnsupported
+}
+
 // ListSources returns ErrUnsupported on Darwin.
 func (d *Darwin) ListSources(
 	_ context.Context,
 ) ([]string, error) {
 	return nil, provider.ErrUnsupported
 }
+
+// FollowUnit returns ErrUnsupported on Darwin.
+func (d *Darwin) FollowUnit(
+	_ context.Context,
+	_ string,
+	_ FollowOpts,
+	_ func(Entry) error,
+) error {
+	return provider.ErrUnsupported
+}
@@ -25,6 +25,8 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/avfs/avfs"
+
 	"github.com/osapi-io/osapi/internal/exec"
 	"github.com/osapi-io/osapi/internal/provider"
 )
@@ -36,20 +38,23 @@ var (
 )
 
 // Debian implements the Provider interface for Debian-family systems
-// using journalctl for log querying.
+// using journalctl for log querying and the filesystem for plain log files.
 type Debian struct {
 	provider.FactsAware
 	logger      *slog.Logger
+	fs          avfs.VFS
 	execManager exec.Manager
 }
 
 // NewDebianProvider factory to create a new Debian instance.
 func NewDebianProvider(
 	logger *slog.Logger,
+	fs avfs.VFS,
 	execManager exec.Manager,
 ) *Debian {
 	return &Debian{
 		logger:      logger.With(slog.String("subsystem", "provider.log")),
+		fs:          fs,
 		execManager: execManager,
 	}
 }
@@ -60,7 +65,10 @@ func (d *Debian) Query(
 	opts QueryOpts,
 ) ([]Entry, error) {
 	d.logger.Debug("executing log.Query")
-	args := buildArgs(opts)
+	args, err := buildArgs(opts)
+	if err != nil {
+		return nil, fmt.Errorf("log: query: %w", err)
+	}
 
 	output, err := d.execManager.RunCmd("journalctl", args)
 	if err != nil {
@@ -80,7 +88,10 @@ func (d *Debian) QueryUnit(
 		"executing log.QueryUnit",
 		slog.String("unit", unit),
 	)
-	args := buildUnitArgs(unit, opts)
+	args, err := buildUnitArgs(unit, opts)
+	if err != nil {
+		return nil, fmt.Errorf("log: query unit: %w", err)
+	}
 
 	output, err := d.execManager.RunCmd("journalctl", args)
 	if err != nil {
@@ -103,3 +114,32 @@ func (d *Debian) ListSources(
 
 	return parseSources(output), nil
 }
+
+// FollowUnit streams new journal entries for a specific systemd unit
+// using journalctl -f. It returns nil once ctx is done.
+func (d *Debian) FollowUnit(
+	ctx context.Context,
+	unit string,
+	opts FollowOpts,
+	onEntry func(Entry) error,
+) error {
+	d.logger.Debug(
+		"executing log.FollowUnit",
+		slog.String("unit", unit),
+	)
+	args := buildFollowArgs(unit, opts)
+
+	err := d.execManager.RunCmdStream(ctx, "journalctl", args, func(line string) error {
+		entry, ok := parseJournalLine(line, d.logger)
+		if !ok {
+			return nil
+		}
+
+		return onEntry(entry)
+	})
+	if err != nil {
+		return fmt.Errorf("log: follow unit: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,313 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package log
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// logDir is the only directory plain log files are read from.
+	logDir = "/var/log"
+	// maxFileTailBytes bounds how much of the end of a file is read, so
+	// large logs cost at most this much memory per query.
+	maxFileTailBytes = 4 * 1024 * 1024
+	// maxFileLineLen is the longest line read from a plain log file.
+	maxFileLineLen = 1024 * 1024
+)
+
+// allowedLogFiles are the glob patterns, relative to logDir, of the plain
+// log files that may be queried. Rotated copies with a numeric suffix
+// (e.g. syslog.1) are allowed; compressed archives are not.
+var allowedLogFiles = []string{
+	"syslog",
+	"messages",
+	"auth.log",
+	"kern.log",
+	"daemon.log",
+	"user.log",
+	"mail.log",
+	"dpkg.log",
+	"alternatives.log",
+	"cloud-init.log",
+	"cloud-init-output.log",
+	"apt/history.log",
+	"apt/term.log",
+	"unattended-upgrades/*.log",
+	"nginx/*.log",
+	"apache2/*.log",
+	"mysql/*.log",
+	"postgresql/*.log",
+	"redis/*.log",
+}
+
+// rotatedSuffix matches the numeric suffix logrotate adds to rotated files.
+var rotatedSuffix = regexp.MustCompile(`\.[0-9]+$`)
+
+// QueryFile returns the last entries of an allow-listed plain log file.
+// Lines are parsed as syslog records where possible; other lines are
+// returned with only the message set.
+func (d *Debian) QueryFile(
+	_ context.Context,
+	filePath string,
+	opts FileQueryOpts,
+) ([]Entry, error) {
+	d.logger.Debug(
+		"executing log.QueryFile",
+		slog.String("path", filePath),
+	)
+
+	if err := validateLogFile(filePath); err != nil {
+		return nil, fmt.Errorf("log: query file: %w", err)
+	}
+
+	var grep *regexp.Regexp
+	if opts.Grep != "" {
+		re, err := regexp.Compile(opts.Grep)
+		if err != nil {
+			return nil, fmt.Errorf("log: query file: invalid grep pattern: %w", err)
+		}
+		grep = re
+	}
+
+	if err := d.checkNoSymlinks(filePath); err != nil {
+		return nil, fmt.Errorf("log: query file: %w", err)
+	}
+
+	lines, err := d.tailFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("log: query file: %w", err)
+	}
+
+	limit := opts.Lines
+	if limit <= 0 {
+		limit = 100
+	}
+
+	matched := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if grep != nil && !grep.MatchString(line) {
+			continue
+		}
+
+		matched = append(matched, line)
+	}
+
+	if len(matched) > limit {
+		matched = matched[len(matched)-limit:]
+	}
+
+	now := time.Now()
+	entries := make([]Entry, 0, len(matched))
+	for _, line := range matched {
+		entries = append(entries, parseFileLine(line, now))
+	}
+
+	if opts.Reverse {
+		for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+			entries[i], entries[j] = entries[j], entries[i]
+		}
+	}
+
+	return entries, nil
+}
+
+// validateLogFile checks that filePath is a clean absolute path to an
+// allow-listed file under logDir.
+func validateLogFile(
+	filePath string,
+) error {
+	if !path.IsAbs(filePath) || path.Clean(filePath) != filePath {
+		return fmt.Errorf("path %q must be absolute and clean", filePath)
+	}
+
+	rel, ok := strings.CutPrefix(filePath, logDir+"/")
+	if !ok {
+		return fmt.Errorf("path %q is not under %s", filePath, logDir)
+	}
+
+	name := rotatedSuffix.ReplaceAllString(rel, "")
+	for _, pattern := range allowedLogFiles {
+		if matched, _ := path.Match(pattern, name); matched {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("path %q is not an allow-listed log file", filePath)
+}
+
+// checkNoSymlinks verifies that neither filePath nor any directory between
+// it and logDir is a symlink, so the allow-list cannot be escaped, and that
+// filePath is a regular file.
+func (d *Debian) checkNoSymlinks(
+	filePath string,
+) error {
+	rel := strings.TrimPrefix(filePath, logDir+"/")
+	current := logDir
+	parts := strings.Split(rel, "/")
+
+	for i, part := range parts {
+		current = path.Join(current, part)
+
+		info, err := d.fs.Lstat(current)
+		if err != nil {
+			return err
+		}
+
+		if info.Mode()&fs.ModeSymlink != 0 {
+			return fmt.Errorf("%s is a symlink", current)
+		}
+
+		if i == len(parts)-1 && !info.Mode().IsRegular() {
+			return fmt.Errorf("%s is not a regular file", current)
+		}
+	}
+
+	return nil
+}
+
+// tailFile reads the lines in the last maxFileTailBytes of filePath. When
+// the read starts mid-file, the first partial line is dropped.
+func (d *Debian) tailFile(
+	filePath string,
+) ([]string, error) {
+	f, err := d.fs.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", filePath, err)
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("stat %s: %w", filePath, err)
+	}
+
+	skipFirst := false
+	if info.Size() > maxFileTailBytes {
+		if _, err := f.Seek(info.Size()-maxFileTailBytes, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("seek %s: %w", filePath, err)
+		}
+		skipFirst = true
+	}
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxFileLineLen)
+
+	var lines []string
+	for scanner.Scan() {
+		if skipFirst {
+			skipFirst = false
+			continue
+		}
+
+		lines = append(lines, scanner.Text())
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read %s: %w", filePath, err)
+	}
+
+	return lines, nil
+}
+
+// parseFileLine parses a syslog line in either RFC 3339 format
+// ("2026-01-02T15:04:05.000000+00:00 host ident[pid]: msg") or
+// traditional BSD format ("Jan  2 15:04:05 host ident[pid]: msg"). BSD
+// timestamps carry no year, so the most recent matching date not after
+// now is assumed. Lines in neither format are returned as the message.
+func parseFileLine(
+	line string,
+	now time.Time,
+) Entry {
+	ts, rest, ok := splitFileTimestamp(line, now)
+	if !ok {
+		return Entry{Message: line}
+	}
+
+	host, body, ok := strings.Cut(rest, " ")
+	if !ok {
+		return Entry{Timestamp: ts, Message: rest}
+	}
+
+	tag, message, ok := strings.Cut(body, ": ")
+	if !ok || strings.Contains(tag, " ") {
+		return Entry{Timestamp: ts, Hostname: host, Message: body}
+	}
+
+	entry := Entry{
+		Timestamp: ts,
+		Unit:      tag,
+		Message:   message,
+		Hostname:  host,
+	}
+
+	if ident, pid, ok := strings.Cut(tag, "["); ok {
+		if p, err := strconv.Atoi(strings.TrimSuffix(pid, "]")); err == nil {
+			entry.Unit = ident
+			entry.PID = p
+		}
+	}
+
+	return entry
+}
+
+// splitFileTimestamp parses the leading timestamp of a syslog line and
+// returns it as RFC3339Nano in UTC along with the remainder of the line.
+func splitFileTimestamp(
+	line string,
+	now time.Time,
+) (string, string, bool) {
+	if field, rest, ok := strings.Cut(line, " "); ok {
+		if t, err := time.Parse(time.RFC3339Nano, field); err == nil {
+			return t.UTC().Format(time.RFC3339Nano), rest, true
+		}
+	}
+
+	if len(line) <= len(time.Stamp) || line[len(time.Stamp)] != ' ' {
+		return "", "", false
+	}
+
+	t, err := time.ParseInLocation(time.Stamp, line[:len(time.Stamp)], now.Location())
+	if err != nil {
+		return "", "", false
+	}
+
+	t = t.AddDate(now.Year()-t.Year(), 0, 0)
+	if t.After(now.Add(24 * time.Hour)) {
+		t = t.AddDate(-1, 0, 0)
+	}
+
+	return t.UTC().Format(time.RFC3339Nano), line[len(time.Stamp)+1:], true
+}
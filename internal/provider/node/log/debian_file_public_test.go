@@ -0,0 +1,354 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package log_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	oslog "github.com/osapi-io/osapi/internal/provider/node/log"
+)
+
+// syslogLines is a small RFC 3339 syslog file used across file tests.
+const syslogLines = `2026-01-01T00:00:01.000000+00:00 web-01 sshd[1234]: Accepted publickey for ops
+2026-01-01T00:00:02.000000+00:00 web-01 CRON[99]: (root) CMD (run-parts)
+
+2026-01-01T00:00:03.000000+00:00 web-01 sshd[1234]: Connection timeout
+plain line without a timestamp
+`
+
+type DebianFilePublicTestSuite struct {
+	suite.Suite
+
+	ctrl     *gomock.Controller
+	memFs    avfs.VFS
+	provider *oslog.Debian
+}
+
+func (suite *DebianFilePublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.memFs = memfs.New()
+	suite.provider = oslog.NewDebianProvider(
+		slog.New(slog.NewTextHandler(os.Stdout, nil)),
+		suite.memFs,
+		execmocks.NewMockManager(suite.ctrl),
+	)
+
+	suite.Require().NoError(suite.memFs.MkdirAll("/var/log/nginx", 0o755))
+	suite.Require().NoError(suite.memFs.MkdirAll("/etc", 0o755))
+	suite.Require().NoError(
+		suite.memFs.WriteFile("/var/log/syslog", []byte(syslogLines), 0o640),
+	)
+}
+
+func (suite *DebianFilePublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *DebianFilePublicTestSuite) TestQueryFile() {
+	tests := []struct {
+		name         string
+		path         string
+		opts         oslog.FileQueryOpts
+		setup        func()
+		wantErr      bool
+		wantErrMsg   string
+		validateFunc func(result []oslog.Entry)
+	}{
+		{
+			name: "when file exists returns parsed entries",
+			path: "/var/log/syslog",
+			validateFunc: func(result []oslog.Entry) {
+				suite.Require().Len(result, 4)
+				suite.Equal("2026-01-01T00:00:01Z", result[0].Timestamp)
+				suite.Equal("sshd", result[0].Unit)
+				suite.Equal(1234, result[0].PID)
+				suite.Equal("web-01", result[0].Hostname)
+				suite.Equal("Accepted publickey for ops", result[0].Message)
+				suite.Equal("CRON", result[1].Unit)
+				suite.Equal("plain line without a timestamp", result[3].Message)
+				suite.Empty(result[3].Timestamp)
+			},
+		},
+		{
+			name: "when lines set returns the last lines",
+			path: "/var/log/syslog",
+			opts: oslog.FileQueryOpts{Lines: 2},
+			validateFunc: func(result []oslog.Entry) {
+				suite.Require().Len(result, 2)
+				suite.Equal("Connection timeout", result[0].Message)
+			},
+		},
+		{
+			name: "when grep set returns matching lines",
+			path: "/var/log/syslog",
+			opts: oslog.FileQueryOpts{Grep: "sshd\\[[0-9]+\\]"},
+			validateFunc: func(result []oslog.Entry) {
+				suite.Require().Len(result, 2)
+				suite.Equal("Accepted publickey for ops", result[0].Message)
+				suite.Equal("Connection timeout", result[1].Message)
+			},
+		},
+		{
+			name: "when reverse set returns newest first",
+			path: "/var/log/syslog",
+			opts: oslog.FileQueryOpts{Lines: 2, Reverse: true},
+			validateFunc: func(result []oslog.Entry) {
+				suite.Require().Len(result, 2)
+				suite.Equal("plain line without a timestamp", result[0].Message)
+				suite.Equal("Connection timeout", result[1].Message)
+			},
+		},
+		{
+			name: "when rotated nested file returns entries",
+			path: "/var/log/nginx/error.log.1",
+			setup: func() {
+				suite.Require().NoError(suite.memFs.WriteFile(
+					"/var/log/nginx/error.log.1",
+					[]byte("2026/01/01 00:00:01 [error] 12#12: upstream timed out\n"),
+					0o640,
+				))
+			},
+			validateFunc: func(result []oslog.Entry) {
+				suite.Require().Len(result, 1)
+				suite.Equal("2026/01/01 00:00:01 [error] 12#12: upstream timed out", result[0].Message)
+			},
+		},
+		{
+			name: "when file exceeds tail size reads only the end",
+			path: "/var/log/kern.log",
+			setup: func() {
+				line := strings.Repeat("x", 1023) + "\n"
+				content := strings.Repeat(line, 5*1024) + "last line\n"
+				suite.Require().NoError(
+					suite.memFs.WriteFile("/var/log/kern.log", []byte(content), 0o640),
+				)
+			},
+			opts: oslog.FileQueryOpts{Lines: 10000},
+			validateFunc: func(result []oslog.Entry) {
+				suite.Len(result, 4*1024)
+				suite.Equal("last line", result[len(result)-1].Message)
+			},
+		},
+		{
+			name:       "when path is relative returns error",
+			path:       "var/log/syslog",
+			wantErr:    true,
+			wantErrMsg: "must be absolute and clean",
+		},
+		{
+			name:       "when path is not clean returns error",
+			path:       "/var/log/../../etc/shadow",
+			wantErr:    true,
+			wantErrMsg: "must be absolute and clean",
+		},
+		{
+			name:       "when path is outside log dir returns error",
+			path:       "/etc/shadow",
+			wantErr:    true,
+			wantErrMsg: "is not under /var/log",
+		},
+		{
+			name:       "when file is not allow-listed returns error",
+			path:       "/var/log/btmp",
+			wantErr:    true,
+			wantErrMsg: "is not an allow-listed log file",
+		},
+		{
+			name:       "when file is compressed returns error",
+			path:       "/var/log/syslog.2.gz",
+			wantErr:    true,
+			wantErrMsg: "is not an allow-listed log file",
+		},
+		{
+			name:       "when grep pattern is invalid returns error",
+			path:       "/var/log/syslog",
+			opts:       oslog.FileQueryOpts{Grep: "("},
+			wantErr:    true,
+			wantErrMsg: "invalid grep pattern",
+		},
+		{
+			name: "when file is a symlink returns error",
+			path: "/var/log/auth.log",
+			setup: func() {
+				suite.Require().NoError(suite.memFs.WriteFile("/etc/shadow", []byte("root:x"), 0o600))
+				suite.Require().NoError(suite.memFs.Symlink("/etc/shadow", "/var/log/auth.log"))
+			},
+			wantErr:    true,
+			wantErrMsg: "/var/log/auth.log is a symlink",
+		},
+		{
+			name: "when parent directory is a symlink returns error",
+			path: "/var/log/apt/history.log",
+			setup: func() {
+				suite.Require().NoError(suite.memFs.WriteFile("/etc/history.log", []byte("x"), 0o600))
+				suite.Require().NoError(suite.memFs.Symlink("/etc", "/var/log/apt"))
+			},
+			wantErr:    true,
+			wantErrMsg: "/var/log/apt is a symlink",
+		},
+		{
+			name: "when path is a directory returns error",
+			path: "/var/log/nginx/access.log",
+			setup: func() {
+				suite.Require().NoError(suite.memFs.MkdirAll("/var/log/nginx/access.log", 0o755))
+			},
+			wantErr:    true,
+			wantErrMsg: "is not a regular file",
+		},
+		{
+			name:       "when file does not exist returns error",
+			path:       "/var/log/messages",
+			wantErr:    true,
+			wantErrMsg: "log: query file:",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			got, err := suite.provider.QueryFile(context.Background(), tc.path, tc.opts)
+
+			if tc.wantErr {
+				suite.Error(err)
+				suite.Contains(err.Error(), tc.wantErrMsg)
+				suite.Nil(got)
+
+				return
+			}
+
+			suite.NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *DebianFilePublicTestSuite) TestParseFileLine() {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		line string
+		want oslog.Entry
+	}{
+		{
+			name: "when RFC 3339 line parses all fields",
+			line: "2026-01-01T01:00:00.5+01:00 web-01 sshd[1234]: Accepted publickey",
+			want: oslog.Entry{
+				Timestamp: "2026-01-01T00:00:00.5Z",
+				Unit:      "sshd",
+				Message:   "Accepted publickey",
+				PID:       1234,
+				Hostname:  "web-01",
+			},
+		},
+		{
+			name: "when BSD line uses the current year",
+			line: "Jan  1 10:00:00 web-01 kernel: eth0: link up",
+			want: oslog.Entry{
+				Timestamp: "2026-01-01T10:00:00Z",
+				Unit:      "kernel",
+				Message:   "eth0: link up",
+				Hostname:  "web-01",
+			},
+		},
+		{
+			name: "when BSD line is after now uses the previous year",
+			line: "Dec 31 23:59:59 web-01 CRON[99]: (root) CMD (run-parts)",
+			want: oslog.Entry{
+				Timestamp: "2025-12-31T23:59:59Z",
+				Unit:      "CRON",
+				Message:   "(root) CMD (run-parts)",
+				PID:       99,
+				Hostname:  "web-01",
+			},
+		},
+		{
+			name: "when tag pid is not numeric keeps the tag as unit",
+			line: "Jan  1 10:00:00 web-01 app[main]: started",
+			want: oslog.Entry{
+				Timestamp: "2026-01-01T10:00:00Z",
+				Unit:      "app[main]",
+				Message:   "started",
+				Hostname:  "web-01",
+			},
+		},
+		{
+			name: "when line has no tag returns the remainder as message",
+			line: "Jan  1 10:00:00 web-01 -- MARK --",
+			want: oslog.Entry{
+				Timestamp: "2026-01-01T10:00:00Z",
+				Message:   "-- MARK --",
+				Hostname:  "web-01",
+			},
+		},
+		{
+			name: "when line has only a hostname returns it as message",
+			line: "Jan  1 10:00:00 web-01",
+			want: oslog.Entry{
+				Timestamp: "2026-01-01T10:00:00Z",
+				Message:   "web-01",
+			},
+		},
+		{
+			name: "when line has no timestamp returns it as message",
+			line: "127.0.0.1 - - [01/Jan/2026:00:00:01 +0000] \"GET / HTTP/1.1\" 200",
+			want: oslog.Entry{
+				Message: "127.0.0.1 - - [01/Jan/2026:00:00:01 +0000] \"GET / HTTP/1.1\" 200",
+			},
+		},
+		{
+			name: "when BSD-length prefix is not a timestamp returns it as message",
+			line: "Not a timestamp here",
+			want: oslog.Entry{
+				Message: "Not a timestamp here",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			got := oslog.ParseFileLine(tc.line, now)
+
+			suite.Equal(tc.want, got)
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestDebianFilePublicTestSuite(t *testing.T) {
+	suite.Run(t, new(DebianFilePublicTestSuite))
+}
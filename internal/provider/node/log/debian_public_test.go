@@ -27,6 +27,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/avfs/avfs/vfs/memfs"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 
@@ -54,6 +55,7 @@ func (suite *DebianPublicTestSuite) SetupTest() {
 	suite.mockManager = execmocks.NewMockManager(suite.ctrl)
 	suite.provider = oslog.NewDebianProvider(
 		slog.New(slog.NewTextHandler(os.Stdout, nil)),
+		memfs.New(),
 		suite.mockManager,
 	)
 }
@@ -108,6 +110,47 @@ func (suite *DebianPublicTestSuite) TestQuery() {
 				suite.Equal("nginx", result[0].Unit)
 			},
 		},
+		{
+			name: "when extended filters set uses correct args",
+			opts: oslog.QueryOpts{
+				Since:   "yesterday",
+				Until:   "today",
+				Boot:    "-1",
+				Grep:    "timeout",
+				Reverse: true,
+				Fields: map[string]string{
+					"_COMM":             "sshd",
+					"SYSLOG_IDENTIFIER": "sshd",
+				},
+			},
+			setupMock: func() {
+				suite.mockManager.EXPECT().
+					RunCmd("journalctl", []string{
+						"--output=json",
+						"--since", "yesterday",
+						"--until", "today",
+						"--boot=-1",
+						"--grep", "timeout",
+						"--reverse",
+						"-n", "100",
+						"SYSLOG_IDENTIFIER=sshd",
+						"_COMM=sshd",
+					}).
+					Return(singleEntry, nil)
+			},
+			validateFunc: func(result []oslog.Entry) {
+				suite.Len(result, 1)
+			},
+		},
+		{
+			name: "when field name is invalid returns error",
+			opts: oslog.QueryOpts{
+				Fields: map[string]string{"--output": "short"},
+			},
+			setupMock:  func() {},
+			wantErr:    true,
+			wantErrMsg: `log: query: invalid journal field "--output"`,
+		},
 		{
 			name: "when custom lines only uses correct args",
 			opts: oslog.QueryOpts{Lines: 25},
@@ -249,6 +292,40 @@ func (suite *DebianPublicTestSuite) TestQueryUnit() {
 				suite.Len(result, 1)
 			},
 		},
+		{
+			name: "when extended filters set uses correct args",
+			unit: "sshd.service",
+			opts: oslog.QueryOpts{
+				Until:  "2026-01-01 00:00:00",
+				Boot:   "0",
+				Fields: map[string]string{"_PID": "1234"},
+			},
+			setupMock: func() {
+				suite.mockManager.EXPECT().
+					RunCmd("journalctl", []string{
+						"--output=json",
+						"-u", "sshd.service",
+						"--until", "2026-01-01 00:00:00",
+						"--boot=0",
+						"-n", "100",
+						"_PID=1234",
+					}).
+					Return(singleEntry, nil)
+			},
+			validateFunc: func(result []oslog.Entry) {
+				suite.Len(result, 1)
+			},
+		},
+		{
+			name: "when field name is invalid returns error",
+			unit: "sshd.service",
+			opts: oslog.QueryOpts{
+				Fields: map[string]string{"comm": "sshd"},
+			},
+			setupMock:  func() {},
+			wantErr:    true,
+			wantErrMsg: `log: query unit: invalid journal field "comm"`,
+		},
 		{
 			name: "when exec errors returns error",
 			unit: "nginx.service",
@@ -345,6 +422,139 @@ func (suite *DebianPublicTestSuite) TestListSources() {
 	}
 }
 
+func (suite *DebianPublicTestSuite) TestFollowUnit() {
+	errStop := errors.New("stop")
+
+	tests := []struct {
+		name         string
+		opts         oslog.FollowOpts
+		setupMock    func()
+		onEntryErr   error
+		wantErr      bool
+		wantErrMsg   string
+		validateFunc func(result []oslog.Entry)
+	}{
+		{
+			name: "when default follow streams entries",
+			opts: oslog.FollowOpts{},
+			setupMock: func() {
+				suite.mockManager.EXPECT().
+					RunCmdStream(
+						gomock.Any(),
+						"journalctl",
+						[]string{"--output=json", "--follow", "-u", "nginx.service", "-n", "10"},
+						gomock.Any(),
+					).
+					DoAndReturn(func(
+						_ context.Context,
+						_ string,
+						_ []string,
+						onLine func(string) error,
+					) error {
+						suite.Require().NoError(onLine(singleEntry))
+						suite.Require().NoError(onLine(""))
+						suite.Require().NoError(onLine("not json"))
+
+						return nil
+					})
+			},
+			validateFunc: func(result []oslog.Entry) {
+				suite.Len(result, 1)
+				suite.Equal("nginx", result[0].Unit)
+				suite.Equal("info", result[0].Priority)
+				suite.Equal("Started nginx", result[0].Message)
+			},
+		},
+		{
+			name: "when all options set uses correct args",
+			opts: oslog.FollowOpts{
+				Lines:    50,
+				Priority: "err",
+			},
+			setupMock: func() {
+				suite.mockManager.EXPECT().
+					RunCmdStream(
+						gomock.Any(),
+						"journalctl",
+						[]string{
+							"--output=json",
+							"--follow",
+							"-u",
+							"nginx.service",
+							"--priority",
+							"err",
+							"-n",
+							"50",
+						},
+						gomock.Any(),
+					).
+					Return(nil)
+			},
+			validateFunc: func(result []oslog.Entry) {
+				suite.Empty(result)
+			},
+		},
+		{
+			name:       "when onEntry fails returns error",
+			opts:       oslog.FollowOpts{},
+			onEntryErr: errStop,
+			setupMock: func() {
+				suite.mockManager.EXPECT().
+					RunCmdStream(gomock.Any(), "journalctl", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						_ string,
+						_ []string,
+						onLine func(string) error,
+					) error {
+						return onLine(singleEntry)
+					})
+			},
+			wantErr:    true,
+			wantErrMsg: "log: follow unit: stop",
+		},
+		{
+			name: "when exec fails returns error",
+			opts: oslog.FollowOpts{},
+			setupMock: func() {
+				suite.mockManager.EXPECT().
+					RunCmdStream(gomock.Any(), "journalctl", gomock.Any(), gomock.Any()).
+					Return(errors.New("journalctl not found"))
+			},
+			wantErr:    true,
+			wantErrMsg: "log: follow unit: journalctl not found",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			var got []oslog.Entry
+			err := suite.provider.FollowUnit(
+				context.Background(),
+				"nginx.service",
+				tc.opts,
+				func(entry oslog.Entry) error {
+					got = append(got, entry)
+
+					return tc.onEntryErr
+				},
+			)
+
+			if tc.wantErr {
+				suite.Error(err)
+				suite.Contains(err.Error(), tc.wantErrMsg)
+
+				return
+			}
+
+			suite.NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
 // In order for `go test` to run this suite, we need to create
 // a normal test function and pass our suite to suite.Run.
 func TestDebianPublicTestSuite(t *testing.T) {
@@ -22,7 +22,9 @@ package log
 
 import (
 	"encoding/json"
+	"fmt"
 	"log/slog"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -39,6 +41,11 @@ type journalEntry struct {
 	Hostname  string `json:"_HOSTNAME"`
 }
 
+// journalFieldPattern matches valid journal field names. Field names are
+// uppercase letters, digits, and underscores, and may not start with a
+// digit. Validating them also keeps a match from being read as an option.
+var journalFieldPattern = regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`)
+
 // priorityNames maps journald priority numbers to human-readable names.
 var priorityNames = map[string]string{
 	"0": "emerg",
@@ -55,43 +62,88 @@ var priorityNames = map[string]string{
 // Always includes --output=json. Defaults to 100 lines if opts.Lines <= 0.
 func buildArgs(
 	opts QueryOpts,
-) []string {
+) ([]string, error) {
+	return appendQueryArgs([]string{"--output=json"}, opts)
+}
+
+// buildUnitArgs constructs journalctl arguments for a unit-specific query.
+// Adds -u <unit> before the filter arguments.
+func buildUnitArgs(
+	unit string,
+	opts QueryOpts,
+) ([]string, error) {
+	return appendQueryArgs([]string{"--output=json", "-u", unit}, opts)
+}
+
+// appendQueryArgs appends the filter, ordering, and line count arguments
+// for opts to args. Field matches are appended last, sorted by field name,
+// as journalctl expects matches after all options.
+func appendQueryArgs(
+	args []string,
+	opts QueryOpts,
+) ([]string, error) {
 	lines := opts.Lines
 	if lines <= 0 {
 		lines = 100
 	}
 
-	args := []string{"--output=json"}
-
 	if opts.Since != "" {
 		args = append(args, "--since", opts.Since)
 	}
 
+	if opts.Until != "" {
+		args = append(args, "--until", opts.Until)
+	}
+
 	if opts.Priority != "" {
 		args = append(args, "--priority", opts.Priority)
 	}
 
+	if opts.Boot != "" {
+		// --boot takes an optional argument, so it must be attached.
+		args = append(args, "--boot="+opts.Boot)
+	}
+
+	if opts.Grep != "" {
+		args = append(args, "--grep", opts.Grep)
+	}
+
+	if opts.Reverse {
+		args = append(args, "--reverse")
+	}
+
 	args = append(args, "-n", strconv.Itoa(lines))
 
-	return args
+	fields := make([]string, 0, len(opts.Fields))
+	for field := range opts.Fields {
+		if !journalFieldPattern.MatchString(field) {
+			return nil, fmt.Errorf("invalid journal field %q", field)
+		}
+
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		args = append(args, field+"="+opts.Fields[field])
+	}
+
+	return args, nil
 }
 
-// buildUnitArgs constructs journalctl arguments for a unit-specific query.
-// Adds -u <unit> before the line count argument.
-func buildUnitArgs(
+// buildFollowArgs constructs journalctl arguments for following a unit.
+// Defaults to 10 lines of backlog if opts.Lines <= 0.
+func buildFollowArgs(
 	unit string,
-	opts QueryOpts,
+	opts FollowOpts,
 ) []string {
 	lines := opts.Lines
 	if lines <= 0 {
-		lines = 100
+		lines = 10
 	}
 
-	args := []string{"--output=json", "-u", unit}
-
-	if opts.Since != "" {
-		args = append(args, "--since", opts.Since)
-	}
+	args := []string{"--output=json", "--follow", "-u", unit}
 
 	if opts.Priority != "" {
 		args = append(args, "--priority", opts.Priority)
@@ -112,25 +164,36 @@ func parseJournalOutput(
 	entries := make([]Entry, 0, len(lines))
 
 	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" {
-			continue
+		if entry, ok := parseJournalLine(line, logger); ok {
+			entries = append(entries, entry)
 		}
+	}
 
-		var je journalEntry
-		if err := json.Unmarshal([]byte(line), &je); err != nil {
-			logger.Debug(
-				"skipping malformed journal line",
-				slog.String("error", err.Error()),
-			)
+	return entries
+}
 
-			continue
-		}
+// parseJournalLine parses a single JSON line from journalctl. It returns
+// false for empty or malformed lines, logging the latter at debug level.
+func parseJournalLine(
+	line string,
+	logger *slog.Logger,
+) (Entry, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return Entry{}, false
+	}
 
-		entries = append(entries, journalEntryToEntry(je))
+	var je journalEntry
+	if err := json.Unmarshal([]byte(line), &je); err != nil {
+		logger.Debug(
+			"skipping malformed journal line",
+			slog.String("error", err.Error()),
+		)
+
+		return Entry{}, false
 	}
 
-	return entries
+	return journalEntryToEntry(je), true
 }
 
 // journalEntryToEntry converts a raw journalEntry to an Entry.
@@ -52,9 +52,28 @@ func (l *Linux) QueryUnit(
 	return nil, provider.ErrUnsupported
 }
 
+// QueryFile returns ErrUnsupported on generic Linux.
+func (l *Linux) QueryFile(
+	_ context.Context,
+	_ string,
+	_ FileQueryOpts,
+) ([]Entry, error) {
+	return nil, provider.ErrUnsupported
+}
+
 // ListSources returns ErrUnsupported on generic Linux.
 func (l *Linux) ListSources(
 	_ context.Context,
 ) ([]string, error) {
 	return nil, provider.ErrUnsupported
 }
+
+// FollowUnit returns ErrUnsupported on Linux.
+func (l *Linux) FollowUnit(
+	_ context.Context,
+	_ string,
+	_ FollowOpts,
+	_ func(Entry) error,
+) error {
+	return provider.ErrUnsupported
+}
@@ -82,6 +82,29 @@ func (suite *LinuxPublicTestSuite) TestQueryUnit() {
 	}
 }
 
+func (suite *LinuxPublicTestSuite) TestQueryFile() {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "returns not implemented error",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			got, err := suite.provider.QueryFile(
+				context.Background(),
+				"/var/log/syslog",
+				oslog.FileQueryOpts{},
+			)
+
+			suite.Nil(got)
+			suite.ErrorIs(err, provider.ErrUnsupported)
+		})
+	}
+}
+
 func (suite *LinuxPublicTestSuite) TestListSources() {
 	tests := []struct {
 		name string
@@ -101,6 +124,29 @@ func (suite *LinuxPublicTestSuite) TestListSources() {
 	}
 }
 
+func (suite *LinuxPublicTestSuite) TestFollowUnit() {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "returns not implemented error",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			err := suite.provider.FollowUnit(
+				context.Background(),
+				"nginx.service",
+				oslog.FollowOpts{},
+				func(oslog.Entry) error { return nil },
+			)
+
+			suite.ErrorIs(err, provider.ErrUnsupported)
+		})
+	}
+}
+
 // In order for `go test` to run this suite, we need to create
 // a normal test function and pass our suite to suite.Run.
 func TestLinuxPublicTestSuite(t *testing.T) {
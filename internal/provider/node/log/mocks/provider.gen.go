@@ -41,6 +41,20 @@ func (m *MockProvider) EXPECT() *MockProviderMockRecorder {
 	return m.recorder
 }
 
+// FollowUnit mocks base method.
+func (m *MockProvider) FollowUnit(ctx context.Context, unit string, opts log.FollowOpts, onEntry func(log.Entry) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowUnit", ctx, unit, opts, onEntry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FollowUnit indicates an expected call of FollowUnit.
+func (mr *MockProviderMockRecorder) FollowUnit(ctx, unit, opts, onEntry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowUnit", reflect.TypeOf((*MockProvider)(nil).FollowUnit), ctx, unit, opts, onEntry)
+}
+
 // ListSources mocks base method.
 func (m *MockProvider) ListSources(ctx context.Context) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +85,21 @@ func (mr *MockProviderMockRecorder) Query(ctx, opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockProvider)(nil).Query), ctx, opts)
 }
 
+// QueryFile mocks base method.
+func (m *MockProvider) QueryFile(ctx context.Context, path string, opts log.FileQueryOpts) ([]log.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryFile", ctx, path, opts)
+	ret0, _ := ret[0].([]log.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryFile indicates an expected call of QueryFile.
+func (mr *MockProviderMockRecorder) QueryFile(ctx, path, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryFile", reflect.TypeOf((*MockProvider)(nil).QueryFile), ctx, path, opts)
+}
+
 // QueryUnit mocks base method.
 func (m *MockProvider) QueryUnit(ctx context.Context, unit string, opts log.QueryOpts) ([]log.Entry, error) {
 	m.ctrl.T.Helper()
@@ -31,14 +31,43 @@ type Provider interface {
 	Query(ctx context.Context, opts QueryOpts) ([]Entry, error)
 	// QueryUnit returns journal entries for a specific systemd unit.
 	QueryUnit(ctx context.Context, unit string, opts QueryOpts) ([]Entry, error)
+	// QueryFile returns entries from an allow-listed plain log file under
+	// /var/log, for services that do not log to the journal.
+	QueryFile(ctx context.Context, path string, opts FileQueryOpts) ([]Entry, error)
 	// ListSources returns unique syslog identifiers from the journal.
 	ListSources(ctx context.Context) ([]string, error)
+	// FollowUnit streams new journal entries for a specific systemd unit,
+	// calling onEntry for each one until ctx is done.
+	FollowUnit(ctx context.Context, unit string, opts FollowOpts, onEntry func(Entry) error) error
 }
 
 // QueryOpts contains optional filters for log queries.
 type QueryOpts struct {
 	Lines    int    `json:"lines,omitempty"`
 	Since    string `json:"since,omitempty"`
+	Until    string `json:"until,omitempty"`
+	Priority string `json:"priority,omitempty"`
+	// Boot restricts entries to one boot: an offset such as "0" or "-1",
+	// or a 32-character boot ID.
+	Boot string `json:"boot,omitempty"`
+	// Grep filters entries whose message matches the pattern.
+	Grep string `json:"grep,omitempty"`
+	// Fields are exact journal field matches (e.g. "_COMM": "sshd").
+	Fields map[string]string `json:"fields,omitempty"`
+	// Reverse returns the newest entries first.
+	Reverse bool `json:"reverse,omitempty"`
+}
+
+// FileQueryOpts contains optional filters for plain log file queries.
+type FileQueryOpts struct {
+	Lines   int    `json:"lines,omitempty"`
+	Grep    string `json:"grep,omitempty"`
+	Reverse bool   `json:"reverse,omitempty"`
+}
+
+// FollowOpts contains optional filters for following a unit's journal.
+type FollowOpts struct {
+	Lines    int    `json:"lines,omitempty"`
 	Priority string `json:"priority,omitempty"`
 }
 
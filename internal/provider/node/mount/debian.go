@@ -0,0 +1,420 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package mount
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/avfs/avfs"
+
+	"github.com/osapi-io/osapi/internal/exec"
+	"github.com/osapi-io/osapi/internal/provider"
+)
+
+const (
+	// fstabPath is the static filesystem table holding managed entries.
+	fstabPath = "/etc/fstab"
+	// mountsPath lists the filesystems currently mounted.
+	mountsPath = "/proc/self/mounts"
+	// defaultOptions is used when an entry does not specify options.
+	defaultOptions = "defaults"
+)
+
+var validName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// Compile-time check: Debian must satisfy Provider.
+var _ Provider = (*Debian)(nil)
+
+// Compile-time check: Debian must satisfy FactsSetter.
+var _ provider.FactsSetter = (*Debian)(nil)
+
+// Debian implements the Provider interface for Debian-family systems.
+// Managed entries are written to /etc/fstab beneath an osapi marker
+// comment, and systemd is reloaded so its generated .mount units track
+// the table. Mount and unmount use mount(8) and umount(8). Mutations
+// are serialized and the table is replaced atomically.
+type Debian struct {
+	provider.FactsAware
+	logger      *slog.Logger
+	fs          avfs.VFS
+	execManager exec.Manager
+	// mu serializes the read-modify-write cycle of mutations.
+	mu sync.Mutex
+}
+
+// NewDebianProvider factory to create a new Debian instance.
+func NewDebianProvider(
+	logger *slog.Logger,
+	fs avfs.VFS,
+	execManager exec.Manager,
+) *Debian {
+	return &Debian{
+		logger:      logger.With(slog.String("subsystem", "provider.mount")),
+		fs:          fs,
+		execManager: execManager,
+	}
+}
+
+// List returns all current mounts from /proc/self/mounts merged with the
+// managed fstab entries. Managed entries that are not mounted are listed
+// after the live mounts.
+func (d *Debian) List(
+	_ context.Context,
+) ([]Mount, error) {
+	live, err := d.readMounts()
+	if err != nil {
+		return nil, fmt.Errorf("mount: list: %w", err)
+	}
+
+	lines, err := d.readFstab()
+	if err != nil {
+		return nil, fmt.Errorf("mount: list: %w", err)
+	}
+
+	managed := managedEntries(lines)
+	byPath := make(map[string]Entry, len(managed))
+	for _, entry := range managed {
+		byPath[entry.Path] = entry
+	}
+
+	result := make([]Mount, 0, len(live)+len(managed))
+	seen := make(map[string]bool, len(managed))
+	for _, m := range live {
+		if entry, ok := byPath[m.Path]; ok && !seen[m.Path] {
+			applyEntry(&m, entry)
+			seen[m.Path] = true
+		}
+		result = append(result, m)
+	}
+
+	for _, entry := range managed {
+		if seen[entry.Path] {
+			continue
+		}
+		m := Mount{
+			Device: entry.Device,
+			Path:   entry.Path,
+			FSType: entry.FSType,
+		}
+		applyEntry(&m, entry)
+		result = append(result, m)
+	}
+
+	return result, nil
+}
+
+// Get returns a single managed mount by name, including whether it is
+// currently mounted and the options in effect.
+func (d *Debian) Get(
+	_ context.Context,
+	name string,
+) (*Mount, error) {
+	if err := validateName(name); err != nil {
+		return nil, fmt.Errorf("mount: get: %w", err)
+	}
+
+	lines, err := d.readFstab()
+	if err != nil {
+		return nil, fmt.Errorf("mount: get: %w", err)
+	}
+
+	idx := findManaged(lines, name)
+	if idx < 0 {
+		return nil, fmt.Errorf("mount %q: not found", name)
+	}
+
+	entry, _ := parseEntry(name, lines[idx+1])
+
+	m := Mount{
+		Device: entry.Device,
+		Path:   entry.Path,
+		FSType: entry.FSType,
+	}
+	applyEntry(&m, entry)
+
+	live, err := d.findMounted(entry.Path)
+	if err != nil {
+		return nil, fmt.Errorf("mount: get: %w", err)
+	}
+	if live != nil {
+		m.Mounted = true
+		m.MountOptions = live.MountOptions
+	}
+
+	return &m, nil
+}
+
+// Create appends a managed entry to /etc/fstab. An entry that already
+// exists returns Changed: false without modifying the table.
+func (d *Debian) Create(
+	_ context.Context,
+	entry Entry,
+) (*CreateResult, error) {
+	if err := validateName(entry.Name); err != nil {
+		return nil, fmt.Errorf("mount: create: %w", err)
+	}
+	if entry.Options == "" {
+		entry.Options = defaultOptions
+	}
+	if err := validateEntry(entry); err != nil {
+		return nil, fmt.Errorf("mount: create: %w", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	lines, err := d.readFstab()
+	if err != nil {
+		return nil, fmt.Errorf("mount: create: %w", err)
+	}
+
+	if findManaged(lines, entry.Name) >= 0 {
+		return &CreateResult{Name: entry.Name, Changed: false}, nil
+	}
+
+	if fstabHasPath(lines, entry.Path, -1) {
+		return nil, fmt.Errorf(
+			"mount: create: path %q already present in %s",
+			entry.Path,
+			fstabPath,
+		)
+	}
+
+	lines = append(lines, markerLine(entry.Name), formatEntry(entry))
+
+	if err := d.writeFstab(lines); err != nil {
+		return nil, fmt.Errorf("mount: create: %w", err)
+	}
+
+	d.logger.Info("mount entry created",
+		slog.String("name", entry.Name),
+		slog.String("path", entry.Path),
+	)
+
+	return &CreateResult{Name: entry.Name, Changed: true}, nil
+}
+
+// Update replaces an existing managed entry in /etc/fstab. Empty or nil
+// fields keep their current values. Returns Changed: false when the
+// resulting entry is identical.
+func (d *Debian) Update(
+	_ context.Context,
+	entry UpdateEntry,
+) (*UpdateResult, error) {
+	if err := validateName(entry.Name); err != nil {
+		return nil, fmt.Errorf("mount: update: %w", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	lines, err := d.readFstab()
+	if err != nil {
+		return nil, fmt.Errorf("mount: update: %w", err)
+	}
+
+	idx := findManaged(lines, entry.Name)
+	if idx < 0 {
+		return nil, fmt.Errorf("mount %q: not found", entry.Name)
+	}
+
+	existing, _ := parseEntry(entry.Name, lines[idx+1])
+	merged := mergeEntry(existing, entry)
+
+	if err := validateEntry(merged); err != nil {
+		return nil, fmt.Errorf("mount: update: %w", err)
+	}
+
+	if merged == existing {
+		return &UpdateResult{Name: entry.Name, Changed: false}, nil
+	}
+
+	if merged.Path != existing.Path && fstabHasPath(lines, merged.Path, idx+1) {
+		return nil, fmt.Errorf(
+			"mount: update: path %q already present in %s",
+			merged.Path,
+			fstabPath,
+		)
+	}
+
+	lines[idx+1] = formatEntry(merged)
+
+	if err := d.writeFstab(lines); err != nil {
+		return nil, fmt.Errorf("mount: update: %w", err)
+	}
+
+	d.logger.Info("mount entry updated",
+		slog.String("name", entry.Name),
+		slog.String("path", merged.Path),
+	)
+
+	return &UpdateResult{Name: entry.Name, Changed: true}, nil
+}
+
+// Delete removes a managed entry from /etc/fstab. The filesystem is not
+// unmounted. Returns Changed: false when the entry does not exist.
+func (d *Debian) Delete(
+	_ context.Context,
+	name string,
+) (*DeleteResult, error) {
+	if err := validateName(name); err != nil {
+		return nil, fmt.Errorf("mount: delete: %w", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	lines, err := d.readFstab()
+	if err != nil {
+		return nil, fmt.Errorf("mount: delete: %w", err)
+	}
+
+	idx := findManaged(lines, name)
+	if idx < 0 {
+		return &DeleteResult{Name: name, Changed: false}, nil
+	}
+
+	lines = append(lines[:idx], lines[idx+2:]...)
+
+	if err := d.writeFstab(lines); err != nil {
+		return nil, fmt.Errorf("mount: delete: %w", err)
+	}
+
+	d.logger.Info("mount entry deleted", slog.String("name", name))
+
+	return &DeleteResult{Name: name, Changed: true}, nil
+}
+
+// validateName checks that a mount name is safe for use as a marker.
+func validateName(
+	name string,
+) error {
+	if name == "" {
+		return fmt.Errorf("invalid mount name: empty")
+	}
+	if !validName.MatchString(name) {
+		return fmt.Errorf(
+			"invalid mount name %q: must match %s",
+			name,
+			validName.String(),
+		)
+	}
+
+	return nil
+}
+
+// validateEntry checks that an entry has every field fstab requires and
+// that no field can break out of its column. Device and path are escaped
+// when written, but fs_type and options are written verbatim, so they
+// must not contain whitespace.
+func validateEntry(
+	entry Entry,
+) error {
+	if entry.Device == "" {
+		return fmt.Errorf("device is required")
+	}
+	if strings.IndexFunc(entry.Device, unicode.IsControl) >= 0 {
+		return fmt.Errorf("invalid device %q: must not contain control characters", entry.Device)
+	}
+	if entry.Path == "" || !filepath.IsAbs(entry.Path) {
+		return fmt.Errorf("path %q must be absolute", entry.Path)
+	}
+	if strings.IndexFunc(entry.Path, unicode.IsControl) >= 0 {
+		return fmt.Errorf("invalid path %q: must not contain control characters", entry.Path)
+	}
+	if entry.FSType == "" {
+		return fmt.Errorf("fs_type is required")
+	}
+	if !isToken(entry.FSType) {
+		return fmt.Errorf(
+			"invalid fs_type %q: must not contain whitespace or control characters",
+			entry.FSType,
+		)
+	}
+	if !isToken(entry.Options) {
+		return fmt.Errorf(
+			"invalid options %q: must not contain whitespace or control characters",
+			entry.Options,
+		)
+	}
+	if entry.Dump < 0 || entry.Pass < 0 || entry.Pass > 2 {
+		return fmt.Errorf("invalid dump %d or pass %d", entry.Dump, entry.Pass)
+	}
+
+	return nil
+}
+
+// isToken reports whether s is a single fstab field: non-empty and free
+// of whitespace and control characters.
+func isToken(
+	s string,
+) bool {
+	return s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsControl(r)
+	}) < 0
+}
+
+// mergeEntry overlays the non-empty fields of update onto existing.
+func mergeEntry(
+	existing Entry,
+	update UpdateEntry,
+) Entry {
+	merged := existing
+	if update.Device != "" {
+		merged.Device = update.Device
+	}
+	if update.Path != "" {
+		merged.Path = update.Path
+	}
+	if update.FSType != "" {
+		merged.FSType = update.FSType
+	}
+	if update.Options != "" {
+		merged.Options = update.Options
+	}
+	if update.Dump != nil {
+		merged.Dump = *update.Dump
+	}
+	if update.Pass != nil {
+		merged.Pass = *update.Pass
+	}
+
+	return merged
+}
+
+// applyEntry copies the fstab declaration of a managed entry onto m.
+func applyEntry(
+	m *Mount,
+	entry Entry,
+) {
+	m.Name = entry.Name
+	m.Options = entry.Options
+	m.Dump = entry.Dump
+	m.Pass = entry.Pass
+	m.Managed = true
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package mount
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// Mount mounts the filesystem of a managed entry using its fstab
+// declaration. If the path is already mounted, it returns Changed: false
+// without taking action.
+func (d *Debian) Mount(
+	_ context.Context,
+	name string,
+) (*ActionResult, error) {
+	entry, err := d.managedEntry(name)
+	if err != nil {
+		return nil, fmt.Errorf("mount: mount: %w", err)
+	}
+
+	d.logger.Debug("executing mount.Mount",
+		slog.String("name", name),
+		slog.String("path", entry.Path),
+	)
+
+	live, err := d.findMounted(entry.Path)
+	if err != nil {
+		return nil, fmt.Errorf("mount: mount: %w", err)
+	}
+	if live != nil {
+		return &ActionResult{Name: name, Changed: false}, nil
+	}
+
+	if output, err := d.execManager.RunPrivilegedCmd(
+		"mount",
+		[]string{entry.Path},
+	); err != nil {
+		return nil, fmt.Errorf("mount: mount: %s: %w", strings.TrimSpace(output), err)
+	}
+
+	return &ActionResult{Name: name, Changed: true}, nil
+}
+
+// Unmount unmounts the filesystem of a managed entry. If the path is not
+// mounted, it returns Changed: false without taking action.
+func (d *Debian) Unmount(
+	_ context.Context,
+	name string,
+) (*ActionResult, error) {
+	entry, err := d.managedEntry(name)
+	if err != nil {
+		return nil, fmt.Errorf("mount: unmount: %w", err)
+	}
+
+	d.logger.Debug("executing mount.Unmount",
+		slog.String("name", name),
+		slog.String("path", entry.Path),
+	)
+
+	live, err := d.findMounted(entry.Path)
+	if err != nil {
+		return nil, fmt.Errorf("mount: unmount: %w", err)
+	}
+	if live == nil {
+		return &ActionResult{Name: name, Changed: false}, nil
+	}
+
+	if output, err := d.execManager.RunPrivilegedCmd(
+		"umount",
+		[]string{entry.Path},
+	); err != nil {
+		return nil, fmt.Errorf("mount: unmount: %s: %w", strings.TrimSpace(output), err)
+	}
+
+	return &ActionResult{Name: name, Changed: true}, nil
+}
+
+// managedEntry returns the managed fstab entry for name.
+func (d *Debian) managedEntry(
+	name string,
+) (*Entry, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	lines, err := d.readFstab()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := findManaged(lines, name)
+	if idx < 0 {
+		return nil, fmt.Errorf("mount %q: not found", name)
+	}
+
+	entry, _ := parseEntry(name, lines[idx+1])
+
+	return &entry, nil
+}
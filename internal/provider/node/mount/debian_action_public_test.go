@@ -0,0 +1,257 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package mount_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/avfs/avfs"
+
+	"github.com/osapi-io/osapi/internal/provider/node/mount"
+)
+
+func (suite *DebianPublicTestSuite) TestMount() {
+	tests := []struct {
+		name         string
+		mountName    string
+		setup        func()
+		validateFunc func(*mount.ActionResult, error)
+	}{
+		{
+			name:      "when path is not mounted",
+			mountName: "data",
+			setup: func() {
+				suite.writeFiles(managedFstab, liveMounts)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("mount", []string{"/mnt/data"}).
+					Return("", nil)
+			},
+			validateFunc: func(
+				result *mount.ActionResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&mount.ActionResult{Name: "data", Changed: true}, result)
+			},
+		},
+		{
+			name:      "when path is already mounted",
+			mountName: "data",
+			setup: func() {
+				suite.writeFiles(managedFstab, dataMounted)
+			},
+			validateFunc: func(
+				result *mount.ActionResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&mount.ActionResult{Name: "data", Changed: false}, result)
+			},
+		},
+		{
+			name:      "when mount command fails",
+			mountName: "data",
+			setup: func() {
+				suite.writeFiles(managedFstab, liveMounts)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("mount", []string{"/mnt/data"}).
+					Return("mount: /mnt/data: special device /dev/sdb1 does not exist.\n",
+						errors.New("exit status 32"))
+			},
+			validateFunc: func(
+				result *mount.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "special device /dev/sdb1 does not exist")
+			},
+		},
+		{
+			name:      "when entry is not managed",
+			mountName: "missing",
+			setup: func() {
+				suite.writeFiles(managedFstab, liveMounts)
+			},
+			validateFunc: func(
+				result *mount.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "not found")
+			},
+		},
+		{
+			name:      "when name is invalid",
+			mountName: "",
+			setup:     func() {},
+			validateFunc: func(
+				result *mount.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid mount name")
+			},
+		},
+		{
+			name:      "when fstab read fails",
+			mountName: "data",
+			setup: func() {
+				suite.useFailingFs(managedFstab, liveMounts, avfs.FnReadFile, 0)
+			},
+			validateFunc: func(
+				result *mount.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "mount: mount")
+			},
+		},
+		{
+			name:      "when mounts cannot be read",
+			mountName: "data",
+			setup: func() {
+				_ = suite.memFs.WriteFile("/etc/fstab", []byte(managedFstab), 0o644)
+			},
+			validateFunc: func(
+				result *mount.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "/proc/self/mounts")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Mount(context.Background(), tc.mountName)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestUnmount() {
+	tests := []struct {
+		name         string
+		mountName    string
+		setup        func()
+		validateFunc func(*mount.ActionResult, error)
+	}{
+		{
+			name:      "when path is mounted",
+			mountName: "data",
+			setup: func() {
+				suite.writeFiles(managedFstab, dataMounted)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("umount", []string{"/mnt/data"}).
+					Return("", nil)
+			},
+			validateFunc: func(
+				result *mount.ActionResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&mount.ActionResult{Name: "data", Changed: true}, result)
+			},
+		},
+		{
+			name:      "when path is not mounted",
+			mountName: "data",
+			setup: func() {
+				suite.writeFiles(managedFstab, liveMounts)
+			},
+			validateFunc: func(
+				result *mount.ActionResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&mount.ActionResult{Name: "data", Changed: false}, result)
+			},
+		},
+		{
+			name:      "when umount command fails",
+			mountName: "data",
+			setup: func() {
+				suite.writeFiles(managedFstab, dataMounted)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("umount", []string{"/mnt/data"}).
+					Return("umount: /mnt/data: target is busy.\n", errors.New("exit status 32"))
+			},
+			validateFunc: func(
+				result *mount.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "target is busy")
+			},
+		},
+		{
+			name:      "when entry is not managed",
+			mountName: "missing",
+			setup: func() {
+				suite.writeFiles(managedFstab, dataMounted)
+			},
+			validateFunc: func(
+				result *mount.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "not found")
+			},
+		},
+		{
+			name:      "when mounts cannot be read",
+			mountName: "data",
+			setup: func() {
+				_ = suite.memFs.WriteFile("/etc/fstab", []byte(managedFstab), 0o644)
+			},
+			validateFunc: func(
+				result *mount.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "mount: unmount")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Unmount(context.Background(), tc.mountName)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package mount
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+// markerPrefix precedes the name of every managed fstab entry. The entry
+// itself is the line following the marker.
+const markerPrefix = "# osapi-managed: "
+
+// fieldEscaper encodes whitespace and backslashes the way fstab(5) and
+// /proc/self/mounts expect.
+var fieldEscaper = strings.NewReplacer(
+	`\`, `\134`,
+	" ", `\040`,
+	"\t", `\011`,
+	"\n", `\012`,
+)
+
+// readFstab returns the lines of /etc/fstab. A missing table is treated
+// as empty.
+func (d *Debian) readFstab() ([]string, error) {
+	data, err := d.fs.ReadFile(fstabPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("read %s: %w", fstabPath, err)
+	}
+
+	return splitLines(string(data)), nil
+}
+
+// writeFstab writes the lines back to /etc/fstab and reloads systemd so
+// the generated .mount units reflect the new table. The new table is
+// written to a temporary file and renamed into place so a failed write
+// never leaves a truncated fstab behind.
+func (d *Debian) writeFstab(
+	lines []string,
+) error {
+	content := []byte(strings.Join(lines, "\n") + "\n")
+
+	var mode fs.FileMode = 0o644
+	if info, err := d.fs.Stat(fstabPath); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	tmpPath := fstabPath + ".osapi-tmp"
+	if err := d.fs.WriteFile(tmpPath, content, mode); err != nil {
+		return fmt.Errorf("write %s: %w", fstabPath, err)
+	}
+
+	if err := d.fs.Rename(tmpPath, fstabPath); err != nil {
+		_ = d.fs.Remove(tmpPath)
+
+		return fmt.Errorf("write %s: %w", fstabPath, err)
+	}
+
+	if _, err := d.execManager.RunPrivilegedCmd(
+		"systemctl",
+		[]string{"daemon-reload"},
+	); err != nil {
+		return fmt.Errorf("daemon-reload: %w", err)
+	}
+
+	return nil
+}
+
+// readMounts parses /proc/self/mounts into live mounts.
+func (d *Debian) readMounts() ([]Mount, error) {
+	data, err := d.fs.ReadFile(mountsPath)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", mountsPath, err)
+	}
+
+	var result []Mount
+	for _, line := range splitLines(string(data)) {
+		fields := strings.Fields(line)
+		if len(fields) < 4 {
+			continue
+		}
+
+		result = append(result, Mount{
+			Device:       unescapeField(fields[0]),
+			Path:         unescapeField(fields[1]),
+			FSType:       fields[2],
+			MountOptions: fields[3],
+			Mounted:      true,
+		})
+	}
+
+	return result, nil
+}
+
+// findMounted returns the live mount at path, or nil when nothing is
+// mounted there. The last match wins, mirroring mount stacking.
+func (d *Debian) findMounted(
+	path string,
+) (*Mount, error) {
+	live, err := d.readMounts()
+	if err != nil {
+		return nil, err
+	}
+
+	var found *Mount
+	for i := range live {
+		if live[i].Path == path {
+			found = &live[i]
+		}
+	}
+
+	return found, nil
+}
+
+// findManaged returns the index of the marker line for name, or -1 when
+// the table has no managed entry with that name.
+func findManaged(
+	lines []string,
+	name string,
+) int {
+	marker := markerLine(name)
+	for i := 0; i < len(lines)-1; i++ {
+		if strings.TrimSpace(lines[i]) == marker {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// managedEntries returns every managed entry in the table, in order.
+func managedEntries(
+	lines []string,
+) []Entry {
+	var result []Entry
+	for i := 0; i < len(lines)-1; i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if !strings.HasPrefix(trimmed, markerPrefix) {
+			continue
+		}
+
+		name := strings.TrimSpace(strings.TrimPrefix(trimmed, markerPrefix))
+		if entry, ok := parseEntry(name, lines[i+1]); ok {
+			result = append(result, entry)
+		}
+	}
+
+	return result
+}
+
+// fstabHasPath reports whether any active fstab line other than the one
+// at skip mounts onto path.
+func fstabHasPath(
+	lines []string,
+	path string,
+	skip int,
+) bool {
+	for i, line := range lines {
+		if i == skip {
+			continue
+		}
+		if entry, ok := parseEntry("", line); ok && entry.Path == path {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseEntry parses a single fstab line. Comments, blank lines, and lines
+// with fewer than three fields are rejected.
+func parseEntry(
+	name string,
+	line string,
+) (Entry, bool) {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+		return Entry{}, false
+	}
+
+	fields := strings.Fields(trimmed)
+	if len(fields) < 3 {
+		return Entry{}, false
+	}
+
+	entry := Entry{
+		Name:    name,
+		Device:  unescapeField(fields[0]),
+		Path:    unescapeField(fields[1]),
+		FSType:  fields[2],
+		Options: defaultOptions,
+	}
+	if len(fields) > 3 {
+		entry.Options = fields[3]
+	}
+	if len(fields) > 4 {
+		entry.Dump, _ = strconv.Atoi(fields[4])
+	}
+	if len(fields) > 5 {
+		entry.Pass, _ = strconv.Atoi(fields[5])
+	}
+
+	return entry, true
+}
+
+// formatEntry renders an entry as an fstab line.
+func formatEntry(
+	entry Entry,
+) string {
+	return strings.Join([]string{
+		fieldEscaper.Replace(entry.Device),
+		fieldEscaper.Replace(entry.Path),
+		entry.FSType,
+		entry.Options,
+		strconv.Itoa(entry.Dump),
+		strconv.Itoa(entry.Pass),
+	}, " ")
+}
+
+// markerLine returns the marker comment for a managed entry.
+func markerLine(
+	name string,
+) string {
+	return markerPrefix + name
+}
+
+// unescapeField decodes the octal escapes (e.g. \040) used by fstab(5)
+// and /proc/self/mounts.
+func unescapeField(
+	field string,
+) string {
+	if !strings.Contains(field, `\`) {
+		return field
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(field); i++ {
+		if field[i] == '\\' && i+3 < len(field) {
+			if v, err := strconv.ParseUint(field[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(v))
+				i += 3
+
+				continue
+			}
+		}
+		b.WriteByte(field[i])
+	}
+
+	return b.String()
+}
+
+// splitLines splits content into lines, dropping the trailing newline.
+func splitLines(
+	content string,
+) []string {
+	content = strings.TrimSuffix(content, "\n")
+	if content == "" {
+		return nil
+	}
+
+	return strings.Split(content, "\n")
+}
@@ -0,0 +1,1136 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package mount_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/failfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/mount"
+)
+
+const (
+	baseFstab = "# /etc/fstab: static file system information.\n" +
+		"UUID=1234 / ext4 errors=remount-ro 0 1\n"
+	managedFstab = baseFstab +
+		"# osapi-managed: data\n" +
+		"/dev/sdb1 /mnt/data ext4 defaults,noatime 0 2\n"
+	liveMounts = "/dev/sda1 / ext4 rw,relatime 0 0\n" +
+		"proc /proc proc rw,nosuid,nodev,noexec,relatime 0 0\n"
+	dataMounted = liveMounts +
+		"/dev/sdb1 /mnt/data ext4 rw,noatime 0 0\n"
+)
+
+type DebianPublicTestSuite struct {
+	suite.Suite
+
+	ctrl     *gomock.Controller
+	logger   *slog.Logger
+	memFs    avfs.VFS
+	mockExec *execmocks.MockManager
+	provider *mount.Debian
+}
+
+func (suite *DebianPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+
+	_ = suite.memFs.MkdirAll("/etc", 0o755)
+	_ = suite.memFs.MkdirAll("/proc/self", 0o755)
+
+	suite.provider = mount.NewDebianProvider(
+		suite.logger,
+		suite.memFs,
+		suite.mockExec,
+	)
+}
+
+func (suite *DebianPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *DebianPublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+// writeFiles seeds /etc/fstab and /proc/self/mounts.
+func (suite *DebianPublicTestSuite) writeFiles(
+	fstab string,
+	mounts string,
+) {
+	_ = suite.memFs.WriteFile("/etc/fstab", []byte(fstab), 0o644)
+	_ = suite.memFs.WriteFile("/proc/self/mounts", []byte(mounts), 0o444)
+}
+
+// readFstab returns the current /etc/fstab content.
+func (suite *DebianPublicTestSuite) readFstab() string {
+	data, err := suite.memFs.ReadFile("/etc/fstab")
+	suite.Require().NoError(err)
+
+	return string(data)
+}
+
+// expectReload expects systemd to be reloaded after an fstab change.
+func (suite *DebianPublicTestSuite) expectReload(
+	err error,
+) {
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("systemctl", []string{"daemon-reload"}).
+		Return("", err)
+}
+
+// useFailingFs replaces the provider filesystem with one that fails the
+// given operation once it has been called more than skip times. ReadFile
+// opens the file internally, so write failures skip the fstab read.
+func (suite *DebianPublicTestSuite) useFailingFs(
+	fstab string,
+	mounts string,
+	failFn avfs.FnVFS,
+	skip int,
+) {
+	baseFs := memfs.New()
+	_ = baseFs.MkdirAll("/etc", 0o755)
+	_ = baseFs.MkdirAll("/proc/self", 0o755)
+	_ = baseFs.WriteFile("/etc/fstab", []byte(fstab), 0o644)
+	_ = baseFs.WriteFile("/proc/self/mounts", []byte(mounts), 0o444)
+
+	calls := 0
+	vfs := failfs.New(baseFs)
+	_ = vfs.SetFailFunc(func(
+		_ avfs.VFSBase,
+		fn avfs.FnVFS,
+		_ *failfs.FailParam,
+	) error {
+		if fn == failFn {
+			calls++
+			if calls > skip {
+				return errors.New("fs failed")
+			}
+		}
+
+		return nil
+	})
+
+	suite.memFs = baseFs
+	suite.provider = mount.NewDebianProvider(
+		suite.logger,
+		vfs,
+		suite.mockExec,
+	)
+}
+
+func (suite *DebianPublicTestSuite) TestList() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func([]mount.Mount, error)
+	}{
+		{
+			name: "when live mounts are merged with managed entries",
+			setup: func() {
+				suite.writeFiles(managedFstab, dataMounted)
+			},
+			validateFunc: func(
+				result []mount.Mount,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Require().Len(result, 3)
+				suite.Equal(mount.Mount{
+					Device:       "/dev/sda1",
+					Path:         "/",
+					FSType:       "ext4",
+					MountOptions: "rw,relatime",
+					Mounted:      true,
+				}, result[0])
+				suite.Equal(mount.Mount{
+					Name:         "data",
+					Device:       "/dev/sdb1",
+					Path:         "/mnt/data",
+					FSType:       "ext4",
+					Options:      "defaults,noatime",
+					MountOptions: "rw,noatime",
+					Pass:         2,
+					Mounted:      true,
+					Managed:      true,
+				}, result[2])
+			},
+		},
+		{
+			name: "when managed entry is not mounted",
+			setup: func() {
+				suite.writeFiles(managedFstab, liveMounts)
+			},
+			validateFunc: func(
+				result []mount.Mount,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Require().Len(result, 3)
+				suite.Equal("data", result[2].Name)
+				suite.True(result[2].Managed)
+				suite.False(result[2].Mounted)
+				suite.Empty(result[2].MountOptions)
+			},
+		},
+		{
+			name: "when fstab does not exist",
+			setup: func() {
+				_ = suite.memFs.WriteFile(
+					"/proc/self/mounts",
+					[]byte(liveMounts),
+					0o444,
+				)
+			},
+			validateFunc: func(
+				result []mount.Mount,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Len(result, 2)
+			},
+		},
+		{
+			name: "when mount paths contain escaped spaces",
+			setup: func() {
+				suite.writeFiles(
+					baseFstab,
+					"//nas/share /mnt/my\\040share cifs rw 0 0\n",
+				)
+			},
+			validateFunc: func(
+				result []mount.Mount,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Require().Len(result, 1)
+				suite.Equal("/mnt/my share", result[0].Path)
+			},
+		},
+		{
+			name: "when mounts cannot be read",
+			setup: func() {
+				_ = suite.memFs.WriteFile("/etc/fstab", []byte(baseFstab), 0o644)
+			},
+			validateFunc: func(
+				result []mount.Mount,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "mount: list")
+			},
+		},
+		{
+			name: "when fstab read fails",
+			setup: func() {
+				suite.useFailingFs(baseFstab, liveMounts, avfs.FnReadFile, 0)
+			},
+			validateFunc: func(
+				result []mount.Mount,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.List(context.Background())
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestGet() {
+	tests := []struct {
+		name         string
+		mountName    string
+		setup        func()
+		validateFunc func(*mount.Mount, error)
+	}{
+		{
+			name:      "when managed entry is mounted",
+			mountName: "data",
+			setup: func() {
+				suite.writeFiles(managedFstab, dataMounted)
+			},
+			validateFunc: func(
+				result *mount.Mount,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&mount.Mount{
+					Name:         "data",
+					Device:       "/dev/sdb1",
+					Path:         "/mnt/data",
+					FSType:       "ext4",
+					Options:      "defaults,noatime",
+					MountOptions: "rw,noatime",
+					Pass:         2,
+					Mounted:      true,
+					Managed:      true,
+				}, result)
+			},
+		},
+		{
+			name:      "when managed entry is not mounted",
+			mountName: "data",
+			setup: func() {
+				suite.writeFiles(managedFstab, liveMounts)
+			},
+			validateFunc: func(
+				result *mount.Mount,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.False(result.Mounted)
+				suite.True(result.Managed)
+			},
+		},
+		{
+			name:      "when entry is not found",
+			mountName: "missing",
+			setup: func() {
+				suite.writeFiles(managedFstab, liveMounts)
+			},
+			validateFunc: func(
+				result *mount.Mount,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "not found")
+			},
+		},
+		{
+			name:      "when name is invalid",
+			mountName: "../etc",
+			setup:     func() {},
+			validateFunc: func(
+				result *mount.Mount,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid mount name")
+			},
+		},
+		{
+			name:      "when fstab read fails",
+			mountName: "data",
+			setup: func() {
+				suite.useFailingFs(managedFstab, liveMounts, avfs.FnReadFile, 0)
+			},
+			validateFunc: func(
+				result *mount.Mount,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "mount: get")
+			},
+		},
+		{
+			name:      "when mounts cannot be read",
+			mountName: "data",
+			setup: func() {
+				_ = suite.memFs.WriteFile("/etc/fstab", []byte(managedFstab), 0o644)
+			},
+			validateFunc: func(
+				result *mount.Mount,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "/proc/self/mounts")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Get(context.Background(), tc.mountName)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestCreate() {
+	tests := []struct {
+		name         string
+		entry        mount.Entry
+		setup        func()
+		validateFunc func(*mount.CreateResult, error)
+	}{
+		{
+			name: "when entry is appended with default options",
+			entry: mount.Entry{
+				Name:   "data",
+				Device: "/dev/sdb1",
+				Path:   "/mnt/data",
+				FSType: "ext4",
+				Pass:   2,
+			},
+			setup: func() {
+				suite.writeFiles(baseFstab, liveMounts)
+				suite.expectReload(nil)
+			},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&mount.CreateResult{Name: "data", Changed: true}, result)
+				suite.Equal(
+					baseFstab+
+						"# osapi-managed: data\n"+
+						"/dev/sdb1 /mnt/data ext4 defaults 0 2\n",
+					suite.readFstab(),
+				)
+			},
+		},
+		{
+			name: "when fstab does not exist",
+			entry: mount.Entry{
+				Name:    "scratch",
+				Device:  "tmpfs",
+				Path:    "/mnt/my scratch",
+				FSType:  "tmpfs",
+				Options: "size=64m",
+			},
+			setup: func() {
+				suite.expectReload(nil)
+			},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.True(result.Changed)
+				suite.Equal(
+					"# osapi-managed: scratch\n"+
+						"tmpfs /mnt/my\\040scratch tmpfs size=64m 0 0\n",
+					suite.readFstab(),
+				)
+			},
+		},
+		{
+			name: "when entry already exists",
+			entry: mount.Entry{
+				Name:   "data",
+				Device: "/dev/sdc1",
+				Path:   "/mnt/other",
+				FSType: "xfs",
+			},
+			setup: func() {
+				suite.writeFiles(managedFstab, liveMounts)
+			},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.False(result.Changed)
+				suite.Equal(managedFstab, suite.readFstab())
+			},
+		},
+		{
+			name: "when path is already in fstab",
+			entry: mount.Entry{
+				Name:   "root",
+				Device: "/dev/sdc1",
+				Path:   "/",
+				FSType: "ext4",
+			},
+			setup: func() {
+				suite.writeFiles(baseFstab, liveMounts)
+			},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "already present")
+			},
+		},
+		{
+			name:  "when name is invalid",
+			entry: mount.Entry{Name: "bad name"},
+			setup: func() {},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid mount name")
+			},
+		},
+		{
+			name:  "when device is missing",
+			entry: mount.Entry{Name: "data", Path: "/mnt/data", FSType: "ext4"},
+			setup: func() {},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "device is required")
+			},
+		},
+		{
+			name: "when path is relative",
+			entry: mount.Entry{
+				Name:   "data",
+				Device: "/dev/sdb1",
+				Path:   "mnt/data",
+				FSType: "ext4",
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "must be absolute")
+			},
+		},
+		{
+			name:  "when fs type is missing",
+			entry: mount.Entry{Name: "data", Device: "/dev/sdb1", Path: "/mnt/data"},
+			setup: func() {},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "fs_type is required")
+			},
+		},
+		{
+			name: "when fs type contains whitespace",
+			entry: mount.Entry{
+				Name:   "data",
+				Device: "/dev/sdb1",
+				Path:   "/mnt/data",
+				FSType: "ext4 defaults 0 0\n/dev/sdc1 /root ext4",
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid fs_type")
+			},
+		},
+		{
+			name: "when options contain a tab",
+			entry: mount.Entry{
+				Name:    "data",
+				Device:  "/dev/sdb1",
+				Path:    "/mnt/data",
+				FSType:  "ext4",
+				Options: "defaults\tnoatime",
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid options")
+			},
+		},
+		{
+			name: "when options contain a control character",
+			entry: mount.Entry{
+				Name:    "data",
+				Device:  "/dev/sdb1",
+				Path:    "/mnt/data",
+				FSType:  "ext4",
+				Options: "defaults\x00",
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid options")
+			},
+		},
+		{
+			name: "when device contains a control character",
+			entry: mount.Entry{
+				Name:   "data",
+				Device: "/dev/sdb1\r",
+				Path:   "/mnt/data",
+				FSType: "ext4",
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid device")
+			},
+		},
+		{
+			name: "when path contains a control character",
+			entry: mount.Entry{
+				Name:   "data",
+				Device: "/dev/sdb1",
+				Path:   "/mnt/data\x1b",
+				FSType: "ext4",
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid path")
+			},
+		},
+		{
+			name: "when pass is out of range",
+			entry: mount.Entry{
+				Name:   "data",
+				Device: "/dev/sdb1",
+				Path:   "/mnt/data",
+				FSType: "ext4",
+				Pass:   3,
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid dump")
+			},
+		},
+		{
+			name: "when fstab read fails",
+			entry: mount.Entry{
+				Name:   "data",
+				Device: "/dev/sdb1",
+				Path:   "/mnt/data",
+				FSType: "ext4",
+			},
+			setup: func() {
+				suite.useFailingFs(baseFstab, liveMounts, avfs.FnReadFile, 0)
+			},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "read /etc/fstab")
+			},
+		},
+		{
+			name: "when fstab write fails",
+			entry: mount.Entry{
+				Name:   "data",
+				Device: "/dev/sdb1",
+				Path:   "/mnt/data",
+				FSType: "ext4",
+			},
+			setup: func() {
+				suite.useFailingFs(baseFstab, liveMounts, avfs.FnOpenFile, 1)
+			},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "write /etc/fstab")
+			},
+		},
+		{
+			name: "when fstab rename fails",
+			entry: mount.Entry{
+				Name:   "data",
+				Device: "/dev/sdb1",
+				Path:   "/mnt/data",
+				FSType: "ext4",
+			},
+			setup: func() {
+				suite.useFailingFs(baseFstab, liveMounts, avfs.FnRename, 0)
+			},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "write /etc/fstab")
+				suite.Equal(baseFstab, suite.readFstab())
+
+				_, statErr := suite.memFs.Stat("/etc/fstab.osapi-tmp")
+				suite.True(errors.Is(statErr, fs.ErrNotExist))
+			},
+		},
+		{
+			name: "when daemon-reload fails",
+			entry: mount.Entry{
+				Name:   "data",
+				Device: "/dev/sdb1",
+				Path:   "/mnt/data",
+				FSType: "ext4",
+			},
+			setup: func() {
+				suite.writeFiles(baseFstab, liveMounts)
+				suite.expectReload(errors.New("reload failed"))
+			},
+			validateFunc: func(
+				result *mount.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "daemon-reload")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Create(context.Background(), tc.entry)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestCreateConcurrent() {
+	suite.writeFiles(baseFstab, liveMounts)
+
+	const count = 20
+
+	// Stall every rename so unserialized mutations would overlap and
+	// overwrite each other's entries.
+	vfs := failfs.New(suite.memFs)
+	_ = vfs.SetFailFunc(func(
+		_ avfs.VFSBase,
+		fn avfs.FnVFS,
+		_ *failfs.FailParam,
+	) error {
+		if fn == avfs.FnRename {
+			time.Sleep(time.Millisecond)
+		}
+
+		return nil
+	})
+	suite.provider = mount.NewDebianProvider(suite.logger, vfs, suite.mockExec)
+
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("systemctl", []string{"daemon-reload"}).
+		Return("", nil).
+		Times(count)
+
+	var wg sync.WaitGroup
+	for i := range count {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := suite.provider.Create(context.Background(), mount.Entry{
+				Name:   fmt.Sprintf("data%02d", i),
+				Device: fmt.Sprintf("/dev/sdc%d", i+1),
+				Path:   fmt.Sprintf("/mnt/data%02d", i),
+				FSType: "ext4",
+			})
+			suite.NoError(err)
+		}()
+	}
+	wg.Wait()
+
+	suite.Equal(count, strings.Count(suite.readFstab(), "# osapi-managed: data"))
+}
+
+func (suite *DebianPublicTestSuite) TestUpdate() {
+	tests := []struct {
+		name         string
+		entry        mount.UpdateEntry
+		setup        func()
+		validateFunc func(*mount.UpdateResult, error)
+	}{
+		{
+			name:  "when options change",
+			entry: mount.UpdateEntry{Name: "data", Options: "ro"},
+			setup: func() {
+				suite.writeFiles(managedFstab, liveMounts)
+				suite.expectReload(nil)
+			},
+			validateFunc: func(
+				result *mount.UpdateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&mount.UpdateResult{Name: "data", Changed: true}, result)
+				suite.Equal(
+					baseFstab+
+						"# osapi-managed: data\n"+
+						"/dev/sdb1 /mnt/data ext4 ro 0 2\n",
+					suite.readFstab(),
+				)
+			},
+		},
+		{
+			name: "when entry is unchanged",
+			entry: mount.UpdateEntry{
+				Name:    "data",
+				Device:  "/dev/sdb1",
+				Options: "defaults,noatime",
+			},
+			setup: func() {
+				suite.writeFiles(managedFstab, liveMounts)
+			},
+			validateFunc: func(
+				result *mount.UpdateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.False(result.Changed)
+				suite.Equal(managedFstab, suite.readFstab())
+			},
+		},
+		{
+			name: "when dump and pass are reset to zero",
+			entry: mount.UpdateEntry{
+				Name: "data",
+				Dump: intPtr(0),
+				Pass: intPtr(0),
+			},
+			setup: func() {
+				suite.writeFiles(
+					baseFstab+
+						"# osapi-managed: data\n"+
+						"/dev/sdb1 /mnt/data ext4 defaults,noatime 1 2\n",
+					liveMounts,
+				)
+				suite.expectReload(nil)
+			},
+			validateFunc: func(
+				result *mount.UpdateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.True(result.Changed)
+				suite.Equal(
+					baseFstab+
+						"# osapi-managed: data\n"+
+						"/dev/sdb1 /mnt/data ext4 defaults,noatime 0 0\n",
+					suite.readFstab(),
+				)
+			},
+		},
+		{
+			name:  "when dump and pass are omitted keeps them",
+			entry: mount.UpdateEntry{Name: "data", Options: "ro"},
+			setup: func() {
+				suite.writeFiles(
+					baseFstab+
+						"# osapi-managed: data\n"+
+						"/dev/sdb1 /mnt/data ext4 defaults 1 2\n",
+					liveMounts,
+				)
+				suite.expectReload(nil)
+			},
+			validateFunc: func(
+				result *mount.UpdateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.True(result.Changed)
+				suite.Contains(suite.readFstab(), "/dev/sdb1 /mnt/data ext4 ro 1 2\n")
+			},
+		},
+		{
+			name: "when options contain whitespace",
+			entry: mount.UpdateEntry{
+				Name:    "data",
+				Options: "defaults 0 0\n/dev/sdc1 /root ext4 defaults",
+			},
+			setup: func() {
+				suite.writeFiles(managedFstab, liveMounts)
+			},
+			validateFunc: func(
+				result *mount.UpdateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid options")
+				suite.Equal(managedFstab, suite.readFstab())
+			},
+		},
+		{
+			name:  "when entry is not managed",
+			entry: mount.UpdateEntry{Name: "missing", Options: "ro"},
+			setup: func() {
+				suite.writeFiles(managedFstab, liveMounts)
+			},
+			validateFunc: func(
+				result *mount.UpdateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "not found")
+			},
+		},
+		{
+			name:  "when new path is already in fstab",
+			entry: mount.UpdateEntry{Name: "data", Path: "/"},
+			setup: func() {
+				suite.writeFiles(managedFstab, liveMounts)
+			},
+			validateFunc: func(
+				result *mount.UpdateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "already present")
+			},
+		},
+		{
+			name:  "when new path is relative",
+			entry: mount.UpdateEntry{Name: "data", Path: "relative"},
+			setup: func() {
+				suite.writeFiles(managedFstab, liveMounts)
+			},
+			validateFunc: func(
+				result *mount.UpdateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "must be absolute")
+			},
+		},
+		{
+			name:  "when name is invalid",
+			entry: mount.UpdateEntry{Name: ""},
+			setup: func() {},
+			validateFunc: func(
+				result *mount.UpdateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid mount name")
+			},
+		},
+		{
+			name:  "when fstab read fails",
+			entry: mount.UpdateEntry{Name: "data", Options: "ro"},
+			setup: func() {
+				suite.useFailingFs(managedFstab, liveMounts, avfs.FnReadFile, 0)
+			},
+			validateFunc: func(
+				result *mount.UpdateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "mount: update")
+			},
+		},
+		{
+			name:  "when fstab write fails",
+			entry: mount.UpdateEntry{Name: "data", Options: "ro"},
+			setup: func() {
+				suite.useFailingFs(managedFstab, liveMounts, avfs.FnOpenFile, 1)
+			},
+			validateFunc: func(
+				result *mount.UpdateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "write /etc/fstab")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Update(context.Background(), tc.entry)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestDelete() {
+	tests := []struct {
+		name         string
+		mountName    string
+		setup        func()
+		validateFunc func(*mount.DeleteResult, error)
+	}{
+		{
+			name:      "when managed entry is removed",
+			mountName: "data",
+			setup: func() {
+				suite.writeFiles(managedFstab, liveMounts)
+				suite.expectReload(nil)
+			},
+			validateFunc: func(
+				result *mount.DeleteResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal(&mount.DeleteResult{Name: "data", Changed: true}, result)
+				suite.Equal(baseFstab, suite.readFstab())
+			},
+		},
+		{
+			name:      "when entry does not exist",
+			mountName: "missing",
+			setup: func() {
+				suite.writeFiles(managedFstab, liveMounts)
+			},
+			validateFunc: func(
+				result *mount.DeleteResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:      "when name is invalid",
+			mountName: "a/b",
+			setup:     func() {},
+			validateFunc: func(
+				result *mount.DeleteResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid mount name")
+			},
+		},
+		{
+			name:      "when fstab read fails",
+			mountName: "data",
+			setup: func() {
+				suite.useFailingFs(managedFstab, liveMounts, avfs.FnReadFile, 0)
+			},
+			validateFunc: func(
+				result *mount.DeleteResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "mount: delete")
+			},
+		},
+		{
+			name:      "when fstab write fails",
+			mountName: "data",
+			setup: func() {
+				suite.useFailingFs(managedFstab, liveMounts, avfs.FnOpenFile, 1)
+			},
+			validateFunc: func(
+				result *mount.DeleteResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "write /etc/fstab")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Delete(context.Background(), tc.mountName)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestDebianPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(DebianPublicTestSuite))
+}
+
+// intPtr returns a pointer to v.
+func intPtr(
+	v int,
+) *int {
+	return &v
+}
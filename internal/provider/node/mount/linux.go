@@ -0,0 +1,92 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package mount
+
+import (
+	"context"
+
+	"github.com/osapi-io/osapi/internal/provider"
+)
+
+// Linux implements the Provider interface for generic Linux.
+// All methods return ErrUnsupported; use the Debian provider for
+// Debian-family systems.
+type Linux struct{}
+
+// NewLinuxProvider factory to create a new Linux instance.
+func NewLinuxProvider() *Linux {
+	return &Linux{}
+}
+
+// List returns ErrUnsupported on generic Linux.
+func (l *Linux) List(
+	_ context.Context,
+) ([]Mount, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// Get returns ErrUnsupported on generic Linux.
+func (l *Linux) Get(
+	_ context.Context,
+	_ string,
+) (*Mount, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// Create returns ErrUnsupported on generic Linux.
+func (l *Linux) Create(
+	_ context.Context,
+	_ Entry,
+) (*CreateResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// Update returns ErrUnsupported on generic Linux.
+func (l *Linux) Update(
+	_ context.Context,
+	_ UpdateEntry,
+) (*UpdateResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// Delete returns ErrUnsupported on generic Linux.
+func (l *Linux) Delete(
+	_ context.Context,
+	_ string,
+) (*DeleteResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// Mount returns ErrUnsupported on generic Linux.
+func (l *Linux) Mount(
+	_ context.Context,
+	_ string,
+) (*ActionResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// Unmount returns ErrUnsupported on generic Linux.
+func (l *Linux) Unmount(
+	_ context.Context,
+	_ string,
+) (*ActionResult, error) {
+	return nil, provider.ErrUnsupported
+}
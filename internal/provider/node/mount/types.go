@@ -0,0 +1,111 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package mount provides filesystem mount inspection and management of
+// osapi-owned /etc/fstab entries. Live mounts are read from
+// /proc/self/mounts; managed entries are fstab lines preceded by an
+// osapi marker comment.
+package mount
+
+import "context"
+
+// Provider implements the methods to manage filesystem mounts.
+type Provider interface {
+	// List returns current mounts merged with managed fstab entries.
+	List(ctx context.Context) ([]Mount, error)
+	// Get returns a single managed mount by name.
+	Get(ctx context.Context, name string) (*Mount, error)
+	// Create adds a managed fstab entry.
+	Create(ctx context.Context, entry Entry) (*CreateResult, error)
+	// Update replaces an existing managed fstab entry.
+	Update(ctx context.Context, entry UpdateEntry) (*UpdateResult, error)
+	// Delete removes a managed fstab entry.
+	Delete(ctx context.Context, name string) (*DeleteResult, error)
+	// Mount mounts the filesystem of a managed entry.
+	Mount(ctx context.Context, name string) (*ActionResult, error)
+	// Unmount unmounts the filesystem of a managed entry.
+	Unmount(ctx context.Context, name string) (*ActionResult, error)
+}
+
+// Entry represents a managed fstab entry.
+type Entry struct {
+	Name    string `json:"name"`
+	Device  string `json:"device,omitempty"`
+	Path    string `json:"path,omitempty"`
+	FSType  string `json:"fs_type,omitempty"`
+	Options string `json:"options,omitempty"`
+	Dump    int    `json:"dump,omitempty"`
+	Pass    int    `json:"pass,omitempty"`
+}
+
+// UpdateEntry represents changes to a managed fstab entry. Empty strings
+// and nil pointers keep the current values, so dump and pass can be
+// reset to zero.
+type UpdateEntry struct {
+	Name    string `json:"name"`
+	Device  string `json:"device,omitempty"`
+	Path    string `json:"path,omitempty"`
+	FSType  string `json:"fs_type,omitempty"`
+	Options string `json:"options,omitempty"`
+	Dump    *int   `json:"dump,omitempty"`
+	Pass    *int   `json:"pass,omitempty"`
+}
+
+// Mount represents a filesystem mount. Live mounts report the options in
+// effect; managed mounts additionally report their fstab declaration.
+type Mount struct {
+	Name         string `json:"name,omitempty"`
+	Device       string `json:"device"`
+	Path         string `json:"path"`
+	FSType       string `json:"fs_type"`
+	Options      string `json:"options,omitempty"`
+	MountOptions string `json:"mount_options,omitempty"`
+	Dump         int    `json:"dump,omitempty"`
+	Pass         int    `json:"pass,omitempty"`
+	Mounted      bool   `json:"mounted"`
+	Managed      bool   `json:"managed"`
+}
+
+// CreateResult represents the outcome of a mount entry creation.
+type CreateResult struct {
+	Name    string `json:"name"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
+
+// UpdateResult represents the outcome of a mount entry update.
+type UpdateResult struct {
+	Name    string `json:"name"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
+
+// DeleteResult represents the outcome of a mount entry deletion.
+type DeleteResult struct {
+	Name    string `json:"name"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
+
+// ActionResult represents the outcome of a mount or unmount action.
+type ActionResult struct {
+	Name    string `json:"name"`
+	Changed bool   `json:"changed"`
+}
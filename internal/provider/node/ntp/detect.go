@@ -0,0 +1,65 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package ntp
+
+import (
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/exec"
+)
+
+// Daemon identifies the time-sync daemon running on a host.
+type Daemon string
+
+const (
+	// DaemonChrony is chronyd, managed through chrony sources drop-ins.
+	DaemonChrony Daemon = "chrony"
+	// DaemonTimesyncd is systemd-timesyncd, managed through
+	// timesyncd.conf drop-ins.
+	DaemonTimesyncd Daemon = "timesyncd"
+)
+
+// daemonUnits maps each daemon to its systemd unit, in the order they
+// are checked. chrony comes first because installing it on Debian
+// disables systemd-timesyncd.
+var daemonUnits = []struct {
+	daemon Daemon
+	unit   string
+}{
+	{DaemonChrony, "chrony"},
+	{DaemonTimesyncd, "systemd-timesyncd"},
+}
+
+// DetectDaemon returns the active time-sync daemon by asking systemd
+// which unit is running. Falls back to DaemonChrony when neither unit is
+// active, so a host without a running daemon is managed as before.
+func DetectDaemon(
+	execManager exec.Manager,
+) Daemon {
+	for _, d := range daemonUnits {
+		output, _ := execManager.RunCmd("systemctl", []string{"is-active", d.unit})
+		if strings.TrimSpace(output) == "active" {
+			return d.daemon
+		}
+	}
+
+	return DaemonChrony
+}
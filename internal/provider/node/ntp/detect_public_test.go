@@ -0,0 +1,106 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package ntp_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/ntp"
+)
+
+type DetectPublicTestSuite struct {
+	suite.Suite
+
+	ctrl     *gomock.Controller
+	mockExec *execmocks.MockManager
+}
+
+func (suite *DetectPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+}
+
+func (suite *DetectPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *DetectPublicTestSuite) TestDetectDaemon() {
+	tests := []struct {
+		name      string
+		setupMock func()
+		want      ntp.Daemon
+	}{
+		{
+			name: "when chrony is active returns chrony",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("systemctl", []string{"is-active", "chrony"}).
+					Return("active\n", nil)
+			},
+			want: ntp.DaemonChrony,
+		},
+		{
+			name: "when timesyncd is active returns timesyncd",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("systemctl", []string{"is-active", "chrony"}).
+					Return("inactive\n", errors.New("exit status 3"))
+				suite.mockExec.EXPECT().
+					RunCmd("systemctl", []string{"is-active", "systemd-timesyncd"}).
+					Return("active\n", nil)
+			},
+			want: ntp.DaemonTimesyncd,
+		},
+		{
+			name: "when neither is active defaults to chrony",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("systemctl", []string{"is-active", "chrony"}).
+					Return("inactive\n", errors.New("exit status 3"))
+				suite.mockExec.EXPECT().
+					RunCmd("systemctl", []string{"is-active", "systemd-timesyncd"}).
+					Return("inactive\n", errors.New("exit status 3"))
+			},
+			want: ntp.DaemonChrony,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got := ntp.DetectDaemon(suite.mockExec)
+
+			suite.Equal(tc.want, got)
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestDetectPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(DetectPublicTestSuite))
+}
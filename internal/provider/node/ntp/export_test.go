@@ -37,3 +37,12 @@ var GenerateContent = generateContent
 
 // ComputeSHA256 exposes computeSHA256 for testing.
 var ComputeSHA256 = computeSHA256
+
+// ParseShowTimesync exposes parseShowTimesync for testing.
+var ParseShowTimesync = parseShowTimesync
+
+// ParseTimesyncOffset exposes parseTimesyncOffset for testing.
+var ParseTimesyncOffset = parseTimesyncOffset
+
+// GenerateTimesyncdContent exposes generateTimesyncdContent for testing.
+var GenerateTimesyncdContent = generateTimesyncdContent
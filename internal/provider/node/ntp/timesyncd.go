@@ -0,0 +1,318 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package ntp
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/avfs/avfs"
+
+	"github.com/osapi-io/osapi/internal/exec"
+	"github.com/osapi-io/osapi/internal/provider"
+)
+
+const (
+	timesyncdDropInDir  = "/etc/systemd/timesyncd.conf.d"
+	timesyncdDropInFile = "/etc/systemd/timesyncd.conf.d/osapi-ntp.conf"
+	timesyncdUnit       = "systemd-timesyncd"
+)
+
+// Compile-time checks.
+var (
+	_ Provider             = (*Timesyncd)(nil)
+	_ provider.FactsSetter = (*Timesyncd)(nil)
+)
+
+// Timesyncd implements the Provider interface for Debian-family systems
+// using systemd-timesyncd for NTP management. Servers are written to a
+// drop-in in /etc/systemd/timesyncd.conf.d and sync state is read with
+// timedatectl.
+type Timesyncd struct {
+	provider.FactsAware
+	logger      *slog.Logger
+	fs          avfs.VFS
+	execManager exec.Manager
+}
+
+// NewTimesyncdProvider factory to create a new Timesyncd instance.
+func NewTimesyncdProvider(
+	logger *slog.Logger,
+	fs avfs.VFS,
+	execManager exec.Manager,
+) *Timesyncd {
+	return &Timesyncd{
+		logger:      logger.With(slog.String("subsystem", "provider.ntp")),
+		fs:          fs,
+		execManager: execManager,
+	}
+}
+
+// Get returns current NTP sync status and configured servers by
+// running timedatectl show-timesync. The offset is not part of the
+// show-timesync properties and is read from timedatectl timesync-status.
+func (t *Timesyncd) Get(
+	_ context.Context,
+) (*Status, error) {
+	showOutput, err := t.execManager.RunCmd("timedatectl", []string{"show-timesync"})
+	if err != nil {
+		return nil, fmt.Errorf("ntp: timedatectl show-timesync: %w", err)
+	}
+
+	status := parseShowTimesync(showOutput)
+
+	statusOutput, err := t.execManager.RunCmd("timedatectl", []string{"timesync-status"})
+	if err != nil {
+		return nil, fmt.Errorf("ntp: timedatectl timesync-status: %w", err)
+	}
+
+	status.Offset = parseTimesyncOffset(statusOutput)
+
+	return status, nil
+}
+
+// Create deploys a managed NTP server configuration via a timesyncd
+// drop-in file. Idempotent: returns Changed: false if already managed.
+func (t *Timesyncd) Create(
+	_ context.Context,
+	config Config,
+) (*CreateResult, error) {
+	if _, err := t.fs.Stat(timesyncdDropInFile); err == nil {
+		return &CreateResult{Changed: false}, nil
+	}
+
+	content := generateTimesyncdContent(config.Servers)
+
+	if mkErr := t.fs.MkdirAll(timesyncdDropInDir, 0o755); mkErr != nil {
+		return nil, fmt.Errorf("ntp: create directory: %w", mkErr)
+	}
+
+	if writeErr := t.fs.WriteFile(timesyncdDropInFile, content, 0o644); writeErr != nil {
+		return nil, fmt.Errorf("ntp: write file: %w", writeErr)
+	}
+
+	t.restart()
+
+	t.logger.Info(
+		"ntp config deployed",
+		slog.Int("servers", len(config.Servers)),
+		slog.Bool("changed", true),
+	)
+
+	return &CreateResult{Changed: true}, nil
+}
+
+// Update replaces the managed NTP server configuration. Fails if the
+// drop-in does not exist. Idempotent: returns Changed false when the
+// content SHA matches.
+func (t *Timesyncd) Update(
+	_ context.Context,
+	config Config,
+) (*UpdateResult, error) {
+	existing, err := t.fs.ReadFile(timesyncdDropInFile)
+	if err != nil {
+		return nil, fmt.Errorf("ntp: config not managed")
+	}
+
+	content := generateTimesyncdContent(config.Servers)
+
+	if computeSHA256(content) == computeSHA256(existing) {
+		t.logger.Debug(
+			"ntp config unchanged, skipping deploy",
+			slog.String("path", timesyncdDropInFile),
+		)
+
+		return &UpdateResult{Changed: false}, nil
+	}
+
+	if writeErr := t.fs.WriteFile(timesyncdDropInFile, content, 0o644); writeErr != nil {
+		return nil, fmt.Errorf("ntp: write file: %w", writeErr)
+	}
+
+	t.restart()
+
+	t.logger.Info(
+		"ntp config updated",
+		slog.Int("servers", len(config.Servers)),
+		slog.Bool("changed", true),
+	)
+
+	return &UpdateResult{Changed: true}, nil
+}
+
+// Delete removes the managed NTP server configuration. Fails if the
+// drop-in does not exist.
+func (t *Timesyncd) Delete(
+	_ context.Context,
+) (*DeleteResult, error) {
+	if _, err := t.fs.Stat(timesyncdDropInFile); err != nil {
+		return nil, fmt.Errorf("ntp: config not managed")
+	}
+
+	if removeErr := t.fs.Remove(timesyncdDropInFile); removeErr != nil {
+		return nil, fmt.Errorf("ntp: remove file: %w", removeErr)
+	}
+
+	t.restart()
+
+	t.logger.Info(
+		"ntp config removed",
+		slog.Bool("changed", true),
+	)
+
+	return &DeleteResult{Changed: true}, nil
+}
+
+// restart restarts systemd-timesyncd so it re-reads its drop-ins;
+// timesyncd has no reload. Failures are logged as warnings but do not
+// fail the operation.
+func (t *Timesyncd) restart() {
+	if _, err := t.execManager.RunPrivilegedCmd(
+		"systemctl",
+		[]string{"restart", timesyncdUnit},
+	); err != nil {
+		t.logger.Warn(
+			"systemd-timesyncd restart failed",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// generateTimesyncdContent builds the timesyncd drop-in content from a
+// list of server addresses.
+func generateTimesyncdContent(
+	servers []string,
+) []byte {
+	return []byte("[Time]\nNTP=" + strings.Join(servers, " ") + "\n")
+}
+
+// ntpMessageFieldRegexp matches the numeric fields of the NTPMessage
+// property, e.g. "Leap=0," or "PacketCount=37,".
+var ntpMessageFieldRegexp = regexp.MustCompile(`\b(Leap|Stratum|PacketCount)=(\d+)`)
+
+// leapNotInSync is the NTP leap indicator of an unsynchronized server.
+const leapNotInSync = 3
+
+// parseShowTimesync extracts sync status fields from timedatectl
+// show-timesync output. The host counts as synchronized once timesyncd
+// has received at least one packet from a server whose leap indicator
+// is not the alarm condition. Servers are the system and link servers
+// timesyncd is configured with, or the fallback servers when there are
+// none.
+func parseShowTimesync(
+	output string,
+) *Status {
+	status := &Status{}
+	props := make(map[string]string)
+
+	for _, line := range strings.Split(output, "\n") {
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+
+		props[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	status.CurrentSource = props["ServerName"]
+	if status.CurrentSource == "" {
+		status.CurrentSource = props["ServerAddress"]
+	}
+
+	leap, packets := -1, 0
+	for _, m := range ntpMessageFieldRegexp.FindAllStringSubmatch(props["NTPMessage"], -1) {
+		n, err := strconv.Atoi(m[2])
+		if err != nil {
+			continue
+		}
+
+		switch m[1] {
+		case "Leap":
+			leap = n
+		case "Stratum":
+			status.Stratum = n
+		case "PacketCount":
+			packets = n
+		}
+	}
+
+	status.Synchronized = props["ServerAddress"] != "" &&
+		leap >= 0 && leap != leapNotInSync && packets > 0
+
+	status.Servers = uniqueFields(props["SystemNTPServers"], props["LinkNTPServers"])
+	if len(status.Servers) == 0 {
+		status.Servers = uniqueFields(props["FallbackNTPServers"])
+	}
+
+	return status
+}
+
+// parseTimesyncOffset extracts the offset from timedatectl
+// timesync-status output and formats it like the chrony offset.
+// Input: "       Offset: -1.063ms" → "-0.001063000s"
+func parseTimesyncOffset(
+	output string,
+) string {
+	for _, line := range strings.Split(output, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok || strings.TrimSpace(key) != "Offset" {
+			continue
+		}
+
+		value = strings.TrimSpace(value)
+
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return value
+		}
+
+		return fmt.Sprintf("%+.9fs", d.Seconds())
+	}
+
+	return ""
+}
+
+// uniqueFields splits each space-separated list and returns the fields
+// in order with duplicates removed.
+func uniqueFields(
+	lists ...string,
+) []string {
+	var fields []string
+	seen := make(map[string]bool)
+
+	for _, list := range lists {
+		for _, f := range strings.Fields(list) {
+			if seen[f] {
+				continue
+			}
+
+			seen[f] = true
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
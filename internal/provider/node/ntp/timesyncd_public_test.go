@@ -0,0 +1,681 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package ntp_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/failfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/ntp"
+)
+
+const (
+	showTimesyncOutput = `LinkNTPServers=
+SystemNTPServers=time.cloudflare.com ntp.ubuntu.com
+FallbackNTPServers=ntp.ubuntu.com
+ServerName=time.cloudflare.com
+ServerAddress=162.159.200.1
+RootDistanceMaxUSec=5s
+PollIntervalMinUSec=32s
+PollIntervalMaxUSec=34min 8s
+PollIntervalUSec=2min 8s
+NTPMessage={ Leap=0, Version=4, Mode=4, Stratum=3, Precision=-25, RootDelay=10.879ms, RootDispersion=228us, Reference=A29FC801, OriginateTimestamp=Sun 2026-03-30 22:30:45 UTC, ReceiveTimestamp=Sun 2026-03-30 22:30:45 UTC, TransmitTimestamp=Sun 2026-03-30 22:30:45 UTC, DestinationTimestamp=Sun 2026-03-30 22:30:45 UTC, Ignored=no PacketCount=12, Jitter=1.123ms }
+Frequency=-812996`
+
+	timesyncStatusOutput = `       Server: 162.159.200.1 (time.cloudflare.com)
+Poll interval: 2min 8s (min: 32s; max 34min 8s)
+         Leap: normal
+      Version: 4
+      Stratum: 3
+    Reference: A29FC801
+    Precision: 1us (-25)
+Root distance: 5.667ms (max: 5s)
+       Offset: -1.834ms
+        Delay: 12.112ms
+       Jitter: 1.123ms
+ Packet count: 12
+    Frequency: -12.406ppm`
+
+	timesyncdDropInFile = "/etc/systemd/timesyncd.conf.d/osapi-ntp.conf"
+	timesyncdDropInDir  = "/etc/systemd/timesyncd.conf.d"
+)
+
+type TimesyncdPublicTestSuite struct {
+	suite.Suite
+
+	ctrl     *gomock.Controller
+	logger   *slog.Logger
+	memFs    avfs.VFS
+	mockExec *execmocks.MockManager
+	provider *ntp.Timesyncd
+}
+
+func (suite *TimesyncdPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+
+	suite.provider = ntp.NewTimesyncdProvider(
+		suite.logger,
+		suite.memFs,
+		suite.mockExec,
+	)
+}
+
+func (suite *TimesyncdPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *TimesyncdPublicTestSuite) TestGet() {
+	tests := []struct {
+		name         string
+		setupMock    func()
+		validateFunc func(*ntp.Status, error)
+	}{
+		{
+			name: "when successful returns status with servers",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("timedatectl", []string{"show-timesync"}).
+					Return(showTimesyncOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("timedatectl", []string{"timesync-status"}).
+					Return(timesyncStatusOutput, nil)
+			},
+			validateFunc: func(got *ntp.Status, err error) {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(got)
+				suite.True(got.Synchronized)
+				suite.Equal(3, got.Stratum)
+				suite.Equal("-0.001834000s", got.Offset)
+				suite.Equal("time.cloudflare.com", got.CurrentSource)
+				suite.Equal([]string{"time.cloudflare.com", "ntp.ubuntu.com"}, got.Servers)
+			},
+		},
+		{
+			name: "when no server contacted yet not synchronized",
+			setupMock: func() {
+				output := `LinkNTPServers=
+SystemNTPServers=
+FallbackNTPServers=ntp.ubuntu.com
+ServerName=ntp.ubuntu.com
+ServerAddress=
+NTPMessage=`
+				suite.mockExec.EXPECT().
+					RunCmd("timedatectl", []string{"show-timesync"}).
+					Return(output, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("timedatectl", []string{"timesync-status"}).
+					Return("       Server: n/a (ntp.ubuntu.com)\n", nil)
+			},
+			validateFunc: func(got *ntp.Status, err error) {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(got)
+				suite.False(got.Synchronized)
+				suite.Empty(got.Offset)
+				suite.Equal("ntp.ubuntu.com", got.CurrentSource)
+				suite.Equal([]string{"ntp.ubuntu.com"}, got.Servers)
+			},
+		},
+		{
+			name: "when show-timesync fails returns error",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("timedatectl", []string{"show-timesync"}).
+					Return("", errors.New("command not found"))
+			},
+			validateFunc: func(got *ntp.Status, err error) {
+				suite.Require().Error(err)
+				suite.Nil(got)
+				suite.Contains(err.Error(), "ntp: timedatectl show-timesync: command not found")
+			},
+		},
+		{
+			name: "when timesync-status fails returns error",
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("timedatectl", []string{"show-timesync"}).
+					Return(showTimesyncOutput, nil)
+				suite.mockExec.EXPECT().
+					RunCmd("timedatectl", []string{"timesync-status"}).
+					Return("", errors.New("timesyncd not running"))
+			},
+			validateFunc: func(got *ntp.Status, err error) {
+				suite.Require().Error(err)
+				suite.Nil(got)
+				suite.Contains(
+					err.Error(),
+					"ntp: timedatectl timesync-status: timesyncd not running",
+				)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Get(context.Background())
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *TimesyncdPublicTestSuite) TestCreate() {
+	tests := []struct {
+		name         string
+		config       ntp.Config
+		setupFs      func()
+		setupMock    func()
+		validateFunc func(*ntp.CreateResult, error)
+	}{
+		{
+			name: "when successful creates drop-in",
+			config: ntp.Config{
+				Servers: []string{"0.pool.ntp.org", "1.pool.ntp.org"},
+			},
+			setupFs: func() {},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", []string{"restart", "systemd-timesyncd"}).
+					Return("", nil)
+			},
+			validateFunc: func(got *ntp.CreateResult, err error) {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(got)
+				suite.True(got.Changed)
+
+				content, readErr := suite.memFs.ReadFile(timesyncdDropInFile)
+				suite.NoError(readErr)
+				suite.Equal(
+					"[Time]\nNTP=0.pool.ntp.org 1.pool.ntp.org\n",
+					string(content),
+				)
+			},
+		},
+		{
+			name: "when config already managed returns unchanged",
+			config: ntp.Config{
+				Servers: []string{"0.pool.ntp.org"},
+			},
+			setupFs: func() {
+				_ = suite.memFs.MkdirAll(timesyncdDropInDir, 0o755)
+				_ = suite.memFs.WriteFile(timesyncdDropInFile, []byte("existing"), 0o644)
+			},
+			setupMock: func() {},
+			validateFunc: func(got *ntp.CreateResult, err error) {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(got)
+				suite.False(got.Changed)
+			},
+		},
+		{
+			name: "when mkdir fails returns error",
+			config: ntp.Config{
+				Servers: []string{"0.pool.ntp.org"},
+			},
+			setupFs: func() {
+				vfs := failfs.New(memfs.New())
+				_ = vfs.SetFailFunc(func(
+					_ avfs.VFSBase,
+					fn avfs.FnVFS,
+					_ *failfs.FailParam,
+				) error {
+					if fn == avfs.FnMkdirAll {
+						return errors.New("permission denied")
+					}
+
+					return nil
+				})
+				suite.memFs = vfs
+				suite.provider = ntp.NewTimesyncdProvider(
+					suite.logger,
+					suite.memFs,
+					suite.mockExec,
+				)
+			},
+			setupMock: func() {},
+			validateFunc: func(got *ntp.CreateResult, err error) {
+				suite.Require().Error(err)
+				suite.Nil(got)
+				suite.Contains(err.Error(), "ntp: create directory: permission denied")
+			},
+		},
+		{
+			name: "when write fails returns error",
+			config: ntp.Config{
+				Servers: []string{"0.pool.ntp.org"},
+			},
+			setupFs: func() {
+				baseFs := memfs.New()
+				_ = baseFs.MkdirAll(timesyncdDropInDir, 0o755)
+				vfs := failfs.New(baseFs)
+				_ = vfs.SetFailFunc(func(
+					_ avfs.VFSBase,
+					fn avfs.FnVFS,
+					_ *failfs.FailParam,
+				) error {
+					if fn == avfs.FnOpenFile {
+						return errors.New("disk full")
+					}
+
+					return nil
+				})
+				suite.memFs = vfs
+				suite.provider = ntp.NewTimesyncdProvider(
+					suite.logger,
+					suite.memFs,
+					suite.mockExec,
+				)
+			},
+			setupMock: func() {},
+			validateFunc: func(got *ntp.CreateResult, err error) {
+				suite.Require().Error(err)
+				suite.Nil(got)
+				suite.Contains(err.Error(), "ntp: write file: disk full")
+			},
+		},
+		{
+			name: "when restart fails logs warning but succeeds",
+			config: ntp.Config{
+				Servers: []string{"0.pool.ntp.org"},
+			},
+			setupFs: func() {},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", []string{"restart", "systemd-timesyncd"}).
+					Return("", errors.New("unit not found"))
+			},
+			validateFunc: func(got *ntp.CreateResult, err error) {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(got)
+				suite.True(got.Changed)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupFs()
+			tc.setupMock()
+
+			got, err := suite.provider.Create(context.Background(), tc.config)
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *TimesyncdPublicTestSuite) TestUpdate() {
+	tests := []struct {
+		name         string
+		config       ntp.Config
+		setupFs      func()
+		setupMock    func()
+		validateFunc func(*ntp.UpdateResult, error)
+	}{
+		{
+			name: "when content changed updates drop-in",
+			config: ntp.Config{
+				Servers: []string{"time.google.com", "time.cloudflare.com"},
+			},
+			setupFs: func() {
+				_ = suite.memFs.MkdirAll(timesyncdDropInDir, 0o755)
+				_ = suite.memFs.WriteFile(
+					timesyncdDropInFile,
+					[]byte("[Time]\nNTP=0.pool.ntp.org\n"),
+					0o644,
+				)
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", []string{"restart", "systemd-timesyncd"}).
+					Return("", nil)
+			},
+			validateFunc: func(got *ntp.UpdateResult, err error) {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(got)
+				suite.True(got.Changed)
+
+				content, readErr := suite.memFs.ReadFile(timesyncdDropInFile)
+				suite.NoError(readErr)
+				suite.Equal(
+					"[Time]\nNTP=time.google.com time.cloudflare.com\n",
+					string(content),
+				)
+			},
+		},
+		{
+			name: "when content unchanged returns not changed",
+			config: ntp.Config{
+				Servers: []string{"0.pool.ntp.org"},
+			},
+			setupFs: func() {
+				_ = suite.memFs.MkdirAll(timesyncdDropInDir, 0o755)
+				_ = suite.memFs.WriteFile(
+					timesyncdDropInFile,
+					[]byte("[Time]\nNTP=0.pool.ntp.org\n"),
+					0o644,
+				)
+			},
+			setupMock: func() {},
+			validateFunc: func(got *ntp.UpdateResult, err error) {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(got)
+				suite.False(got.Changed)
+			},
+		},
+		{
+			name: "when config not managed returns error",
+			config: ntp.Config{
+				Servers: []string{"0.pool.ntp.org"},
+			},
+			setupFs:   func() {},
+			setupMock: func() {},
+			validateFunc: func(got *ntp.UpdateResult, err error) {
+				suite.Require().Error(err)
+				suite.Nil(got)
+				suite.Contains(err.Error(), "ntp: config not managed")
+			},
+		},
+		{
+			name: "when write fails returns error",
+			config: ntp.Config{
+				Servers: []string{"time.google.com"},
+			},
+			setupFs: func() {
+				baseFs := memfs.New()
+				_ = baseFs.MkdirAll(timesyncdDropInDir, 0o755)
+				_ = baseFs.WriteFile(
+					timesyncdDropInFile,
+					[]byte("[Time]\nNTP=0.pool.ntp.org\n"),
+					0o644,
+				)
+				vfs := failfs.New(baseFs)
+				openCount := 0
+				_ = vfs.SetFailFunc(func(
+					_ avfs.VFSBase,
+					fn avfs.FnVFS,
+					_ *failfs.FailParam,
+				) error {
+					if fn == avfs.FnOpenFile {
+						openCount++
+						// First OpenFile call is ReadFile in Update;
+						// second is the WriteFile we want to fail.
+						if openCount > 1 {
+							return errors.New("disk full")
+						}
+					}
+
+					return nil
+				})
+				suite.memFs = vfs
+				suite.provider = ntp.NewTimesyncdProvider(
+					suite.logger,
+					suite.memFs,
+					suite.mockExec,
+				)
+			},
+			setupMock: func() {},
+			validateFunc: func(got *ntp.UpdateResult, err error) {
+				suite.Require().Error(err)
+				suite.Nil(got)
+				suite.Contains(err.Error(), "ntp: write file: disk full")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupFs()
+			tc.setupMock()
+
+			got, err := suite.provider.Update(context.Background(), tc.config)
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *TimesyncdPublicTestSuite) TestDelete() {
+	tests := []struct {
+		name         string
+		setupFs      func()
+		setupMock    func()
+		validateFunc func(*ntp.DeleteResult, error)
+	}{
+		{
+			name: "when successful removes drop-in",
+			setupFs: func() {
+				_ = suite.memFs.MkdirAll(timesyncdDropInDir, 0o755)
+				_ = suite.memFs.WriteFile(
+					timesyncdDropInFile,
+					[]byte("[Time]\nNTP=0.pool.ntp.org\n"),
+					0o644,
+				)
+			},
+			setupMock: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", []string{"restart", "systemd-timesyncd"}).
+					Return("", nil)
+			},
+			validateFunc: func(got *ntp.DeleteResult, err error) {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(got)
+				suite.True(got.Changed)
+
+				_, statErr := suite.memFs.Stat(timesyncdDropInFile)
+				suite.Error(statErr)
+			},
+		},
+		{
+			name:      "when config not managed returns error",
+			setupFs:   func() {},
+			setupMock: func() {},
+			validateFunc: func(got *ntp.DeleteResult, err error) {
+				suite.Require().Error(err)
+				suite.Nil(got)
+				suite.Contains(err.Error(), "ntp: config not managed")
+			},
+		},
+		{
+			name: "when remove fails returns error",
+			setupFs: func() {
+				baseFs := memfs.New()
+				_ = baseFs.MkdirAll(timesyncdDropInDir, 0o755)
+				_ = baseFs.WriteFile(
+					timesyncdDropInFile,
+					[]byte("[Time]\nNTP=0.pool.ntp.org\n"),
+					0o644,
+				)
+				vfs := failfs.New(baseFs)
+				_ = vfs.SetFailFunc(func(
+					_ avfs.VFSBase,
+					fn avfs.FnVFS,
+					_ *failfs.FailParam,
+				) error {
+					if fn == avfs.FnRemove {
+						return errors.New("permission denied")
+					}
+
+					return nil
+				})
+				suite.memFs = vfs
+				suite.provider = ntp.NewTimesyncdProvider(
+					suite.logger,
+					suite.memFs,
+					suite.mockExec,
+				)
+			},
+			setupMock: func() {},
+			validateFunc: func(got *ntp.DeleteResult, err error) {
+				suite.Require().Error(err)
+				suite.Nil(got)
+				suite.Contains(err.Error(), "ntp: remove file: permission denied")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupFs()
+			tc.setupMock()
+
+			got, err := suite.provider.Delete(context.Background())
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *TimesyncdPublicTestSuite) TestParseShowTimesync() {
+	tests := []struct {
+		name         string
+		input        string
+		validateFunc func(*ntp.Status)
+	}{
+		{
+			name:  "when full output parses all fields",
+			input: showTimesyncOutput,
+			validateFunc: func(s *ntp.Status) {
+				suite.True(s.Synchronized)
+				suite.Equal(3, s.Stratum)
+				suite.Equal("time.cloudflare.com", s.CurrentSource)
+				suite.Equal([]string{"time.cloudflare.com", "ntp.ubuntu.com"}, s.Servers)
+			},
+		},
+		{
+			name: "when leap indicates alarm not synchronized",
+			input: "ServerAddress=162.159.200.1\n" +
+				"NTPMessage={ Leap=3, Version=4, Mode=4, Stratum=0, Ignored=no PacketCount=4, Jitter=0 }\n",
+			validateFunc: func(s *ntp.Status) {
+				suite.False(s.Synchronized)
+				suite.Equal("162.159.200.1", s.CurrentSource)
+			},
+		},
+		{
+			name: "when link servers set merges them without duplicates",
+			input: "SystemNTPServers=time.google.com\n" +
+				"LinkNTPServers=10.0.0.1 time.google.com\n" +
+				"FallbackNTPServers=ntp.ubuntu.com\n",
+			validateFunc: func(s *ntp.Status) {
+				suite.Equal([]string{"time.google.com", "10.0.0.1"}, s.Servers)
+			},
+		},
+		{
+			name:  "when empty input returns zero status",
+			input: "",
+			validateFunc: func(s *ntp.Status) {
+				suite.False(s.Synchronized)
+				suite.Equal(0, s.Stratum)
+				suite.Empty(s.CurrentSource)
+				suite.Nil(s.Servers)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			got := ntp.ParseShowTimesync(tc.input)
+
+			suite.NotNil(got)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *TimesyncdPublicTestSuite) TestParseTimesyncOffset() {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "when milliseconds returns seconds",
+			input: timesyncStatusOutput,
+			want:  "-0.001834000s",
+		},
+		{
+			name:  "when microseconds returns positive seconds",
+			input: "       Offset: +283us\n",
+			want:  "+0.000283000s",
+		},
+		{
+			name:  "when value is not a duration returns it unchanged",
+			input: "       Offset: n/a\n",
+			want:  "n/a",
+		},
+		{
+			name:  "when offset line missing returns empty",
+			input: "       Server: n/a (ntp.ubuntu.com)\n",
+			want:  "",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			got := ntp.ParseTimesyncOffset(tc.input)
+
+			suite.Equal(tc.want, got)
+		})
+	}
+}
+
+func (suite *TimesyncdPublicTestSuite) TestGenerateTimesyncdContent() {
+	tests := []struct {
+		name    string
+		servers []string
+		want    string
+	}{
+		{
+			name:    "when multiple servers joins them on one line",
+			servers: []string{"0.pool.ntp.org", "1.pool.ntp.org"},
+			want:    "[Time]\nNTP=0.pool.ntp.org 1.pool.ntp.org\n",
+		},
+		{
+			name:    "when single server generates one entry",
+			servers: []string{"time.google.com"},
+			want:    "[Time]\nNTP=time.google.com\n",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			got := ntp.GenerateTimesyncdContent(tc.servers)
+
+			suite.Equal(tc.want, string(got))
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestTimesyncdPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(TimesyncdPublicTestSuite))
+}
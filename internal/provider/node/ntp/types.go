@@ -18,7 +18,8 @@
 // FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
 // DEALINGS IN THE SOFTWARE.
 
-// Package ntp provides NTP server management via chrony.
+// Package ntp provides NTP server management via chrony or
+// systemd-timesyncd, whichever daemon is active on the host.
 package ntp
 
 import "context"
@@ -50,6 +50,14 @@ func (d *Darwin) Get(
 	return nil, provider.ErrUnsupported
 }
 
+// Tree returns ErrUnsupported on Darwin.
+func (d *Darwin) Tree(
+	_ context.Context,
+	_ int,
+) ([]TreeNode, error) {
+	return nil, provider.ErrUnsupported
+}
+
 // Signal returns ErrUnsupported on Darwin.
 func (d *Darwin) Signal(
 	_ context.Context,
@@ -58,3 +66,13 @@ func (d *Darwin) Signal(
 ) (*SignalResult, error) {
 	return nil, provider.ErrUnsupported
 }
+
+// SignalMatch returns ErrUnsupported on Darwin.
+func (d *Darwin) SignalMatch(
+	_ context.Context,
+	_ Match,
+	_ string,
+	_ bool,
+) (*SignalMatchResult, error) {
+	return nil, provider.ErrUnsupported
+}
@@ -78,6 +78,25 @@ func (suite *DarwinPublicTestSuite) TestGet() {
 	}
 }
 
+func (suite *DarwinPublicTestSuite) TestTree() {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "returns not implemented error",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			got, err := suite.provider.Tree(context.Background(), 0)
+
+			suite.Nil(got)
+			suite.ErrorIs(err, provider.ErrUnsupported)
+		})
+	}
+}
+
 func (suite *DarwinPublicTestSuite) TestSignal() {
 	tests := []struct {
 		name string
@@ -97,6 +116,30 @@ func (suite *DarwinPublicTestSuite) TestSignal() {
 	}
 }
 
+func (suite *DarwinPublicTestSuite) TestSignalMatch() {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "returns not implemented error",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			got, err := suite.provider.SignalMatch(
+				context.Background(),
+				process.Match{Name: "nginx"},
+				"TERM",
+				false,
+			)
+
+			suite.Nil(got)
+			suite.ErrorIs(err, provider.ErrUnsupported)
+		})
+	}
+}
+
 // In order for `go test` to run this suite, we need to create
 // a normal test function and pass our suite to suite.Run.
 func TestDarwinPublicTestSuite(t *testing.T) {
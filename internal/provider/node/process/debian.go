@@ -22,11 +22,17 @@ package process
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/avfs/avfs"
 	gopsutil "github.com/shirou/gopsutil/v4/process"
 
 	"github.com/osapi-io/osapi/internal/provider"
@@ -42,6 +48,19 @@ var allowedSignals = map[string]syscall.Signal{
 	"USR2": syscall.SIGUSR2,
 }
 
+const (
+	// initPID is the init process; signalling it can bring the host down.
+	initPID = 1
+	// kthreaddPID is the kernel thread daemon, the parent of every kernel
+	// thread.
+	kthreaddPID = 2
+)
+
+// maxOpenFiles caps the open file sample returned by Get. A process that
+// leaks descriptors can hold tens of thousands of them; FDCount carries
+// the real total.
+const maxOpenFiles = 20
+
 // gopsutilLister wraps gopsutil calls to satisfy Lister.
 // These are thin OS wrappers — error paths are not coverable in
 // unit tests because gopsutil.Processes() always succeeds on a
@@ -105,20 +124,27 @@ var (
 type Debian struct {
 	provider.FactsAware
 	logger   *slog.Logger
+	fs       avfs.VFS
 	lister   Lister
 	signaler Signaler
+	self     int
+	parent   int
 }
 
 // NewDebianProvider factory to create a new Debian instance.
 func NewDebianProvider(
 	logger *slog.Logger,
+	fs avfs.VFS,
 	lister Lister,
 	signaler Signaler,
 ) *Debian {
 	return &Debian{
 		logger:   logger.With(slog.String("subsystem", "provider.process")),
+		fs:       fs,
 		lister:   lister,
 		signaler: signaler,
+		self:     os.Getpid(),
+		parent:   os.Getppid(),
 	}
 }
 
@@ -126,11 +152,22 @@ func NewDebianProvider(
 func (d *Debian) List(
 	_ context.Context,
 ) ([]Info, error) {
-	procs, err := d.lister.Processes()
+	result, err := d.collect()
 	if err != nil {
 		return nil, fmt.Errorf("process: list: %w", err)
 	}
 
+	return result, nil
+}
+
+// collect gathers the summary fields for every running process,
+// skipping processes that exit or deny access mid-scan.
+func (d *Debian) collect() ([]Info, error) {
+	procs, err := d.lister.Processes()
+	if err != nil {
+		return nil, err
+	}
+
 	var result []Info
 
 	for _, item := range procs {
@@ -166,9 +203,293 @@ func (d *Debian) Get(
 		return nil, fmt.Errorf("process: get: %w", err)
 	}
 
+	d.gatherDetails(pid, p, info)
+
 	return info, nil
 }
 
+// Tree returns the process hierarchy. When pid is zero every process
+// whose parent is not visible (PID 1, kthreadd, or anything in another
+// PID namespace) becomes a root.
+func (d *Debian) Tree(
+	_ context.Context,
+	pid int,
+) ([]TreeNode, error) {
+	procs, err := d.collect()
+	if err != nil {
+		return nil, fmt.Errorf("process: tree: %w", err)
+	}
+
+	byPID := make(map[int]Info, len(procs))
+	for _, p := range procs {
+		byPID[p.PID] = p
+	}
+
+	children := make(map[int][]int)
+	var roots []int
+
+	for _, p := range procs {
+		if _, ok := byPID[p.PPID]; ok && p.PPID != p.PID {
+			children[p.PPID] = append(children[p.PPID], p.PID)
+
+			continue
+		}
+
+		roots = append(roots, p.PID)
+	}
+
+	if pid != 0 {
+		if _, ok := byPID[pid]; !ok {
+			return nil, fmt.Errorf("process: tree: process %d not found", pid)
+		}
+
+		roots = []int{pid}
+	}
+
+	sort.Ints(roots)
+
+	visited := make(map[int]bool, len(procs))
+	result := make([]TreeNode, 0, len(roots))
+
+	for _, root := range roots {
+		result = append(result, buildTreeNode(root, byPID, children, visited))
+	}
+
+	if pid != 0 {
+		return result, nil
+	}
+
+	// Processes caught in a parent loop have no root above them; surface
+	// them rather than silently dropping them from the tree.
+	for _, p := range procs {
+		if !visited[p.PID] {
+			result = append(result, buildTreeNode(p.PID, byPID, children, visited))
+		}
+	}
+
+	return result, nil
+}
+
+// buildTreeNode renders pid and its descendants. The visited set guards
+// against parent links that form a loop when a PID is reused mid-scan.
+func buildTreeNode(
+	pid int,
+	byPID map[int]Info,
+	children map[int][]int,
+	visited map[int]bool,
+) TreeNode {
+	visited[pid] = true
+
+	info := byPID[pid]
+	node := TreeNode{
+		PID:     info.PID,
+		PPID:    info.PPID,
+		Name:    info.Name,
+		User:    info.User,
+		Command: info.Command,
+	}
+
+	kids := children[pid]
+	sort.Ints(kids)
+
+	for _, child := range kids {
+		if visited[child] {
+			continue
+		}
+
+		node.Children = append(node.Children, buildTreeNode(child, byPID, children, visited))
+	}
+
+	return node
+}
+
+// gatherDetails fills in the fields only Get reports. Every detail is
+// best effort: an unprivileged agent cannot read the descriptors or
+// environment of processes owned by other users, and that should not
+// fail the whole lookup.
+func (d *Debian) gatherDetails(
+	pid int,
+	p Querier,
+	info *Info,
+) {
+	skip := func(detail string, err error) {
+		d.logger.Debug(
+			"skipping process detail",
+			slog.Int("pid", pid),
+			slog.String("detail", detail),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	children, err := p.Children()
+	switch {
+	case err == nil:
+		for _, c := range children {
+			info.Children = append(info.Children, int(c.Pid))
+		}
+		sort.Ints(info.Children)
+	case !errors.Is(err, gopsutil.ErrorNoChildren):
+		skip("children", err)
+	}
+
+	threads, err := p.NumThreads()
+	if err != nil {
+		skip("threads", err)
+	} else {
+		info.Threads = int(threads)
+	}
+
+	fds, err := p.NumFDs()
+	if err != nil {
+		skip("fd_count", err)
+	} else {
+		info.FDCount = int(fds)
+	}
+
+	files, err := p.OpenFiles()
+	if err != nil {
+		skip("open_files", err)
+	}
+
+	for i, f := range files {
+		if i == maxOpenFiles {
+			break
+		}
+
+		info.OpenFiles = append(info.OpenFiles, OpenFile{
+			FD:   int(f.Fd),
+			Path: f.Path,
+		})
+	}
+
+	environ, err := p.Environ()
+	if err != nil {
+		skip("env_keys", err)
+	} else {
+		info.EnvKeys = envKeys(environ)
+	}
+
+	cgroup, err := d.fs.ReadFile(fmt.Sprintf("/proc/%d/cgroup", pid))
+	if err != nil {
+		skip("cgroup", err)
+	} else {
+		info.Cgroup = parseCgroup(string(cgroup))
+	}
+
+	limits, err := d.fs.ReadFile(fmt.Sprintf("/proc/%d/limits", pid))
+	if err != nil {
+		skip("limits", err)
+	} else {
+		info.Limits = parseLimits(string(limits))
+	}
+}
+
+// envKeys returns the sorted variable names from an environment. Values
+// are dropped because they routinely carry credentials.
+func envKeys(
+	environ []string,
+) []string {
+	var keys []string
+
+	for _, entry := range environ {
+		key, _, _ := strings.Cut(entry, "=")
+		if key == "" {
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// parseCgroup returns the cgroup path from /proc/<pid>/cgroup. The cgroup
+// v2 unified entry ("0::/path") wins; on v1 hosts the systemd hierarchy
+// is used, falling back to the first entry.
+func parseCgroup(
+	data string,
+) string {
+	var first, systemd string
+
+	for _, line := range strings.Split(strings.TrimSpace(data), "\n") {
+		parts := strings.SplitN(line, ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+
+		if parts[0] == "0" && parts[1] == "" {
+			return parts[2]
+		}
+
+		if parts[1] == "name=systemd" {
+			systemd = parts[2]
+		}
+
+		if first == "" {
+			first = parts[2]
+		}
+	}
+
+	if systemd != "" {
+		return systemd
+	}
+
+	return first
+}
+
+// parseLimits parses /proc/<pid>/limits. The file is a fixed-width
+// table whose limit names contain spaces, so columns are sliced at the
+// offsets of the header labels rather than split on whitespace.
+func parseLimits(
+	data string,
+) []Limit {
+	lines := strings.Split(data, "\n")
+	if len(lines) == 0 {
+		return nil
+	}
+
+	header := lines[0]
+	softAt := strings.Index(header, "Soft Limit")
+	hardAt := strings.Index(header, "Hard Limit")
+	unitsAt := strings.Index(header, "Units")
+
+	if softAt <= 0 || hardAt <= softAt || unitsAt <= hardAt {
+		return nil
+	}
+
+	column := func(line string, from, to int) string {
+		if from >= len(line) {
+			return ""
+		}
+
+		if to < 0 || to > len(line) {
+			to = len(line)
+		}
+
+		return strings.TrimSpace(line[from:to])
+	}
+
+	var limits []Limit
+
+	for _, line := range lines[1:] {
+		name := column(line, 0, softAt)
+		if name == "" {
+			continue
+		}
+
+		limits = append(limits, Limit{
+			Name:  name,
+			Soft:  column(line, softAt, hardAt),
+			Hard:  column(line, hardAt, unitsAt),
+			Units: column(line, unitsAt, -1),
+		})
+	}
+
+	return limits
+}
+
 // Signal sends a signal to a process by PID.
 func (d *Debian) Signal(
 	_ context.Context,
@@ -181,15 +502,7 @@ func (d *Debian) Signal(
 	}
 
 	if err := d.signaler.Kill(pid, sig); err != nil {
-		if err == syscall.ESRCH {
-			return nil, fmt.Errorf("process: signal: process not found")
-		}
-
-		if err == syscall.EPERM {
-			return nil, fmt.Errorf("process: signal: permission denied")
-		}
-
-		return nil, fmt.Errorf("process: signal: %w", err)
+		return nil, fmt.Errorf("process: signal: %w", signalError(err))
 	}
 
 	return &SignalResult{
@@ -199,6 +512,145 @@ func (d *Debian) Signal(
 	}, nil
 }
 
+// SignalMatch sends a signal to every process selected by match. Init,
+// kernel threads, the agent and the agent's ancestors (such as its
+// supervisor) are never matched, so a broad filter like user=root cannot
+// take down the host or the agent before it reports back. A process that
+// exits or denies the signal is recorded in Failed rather than failing
+// the whole call.
+func (d *Debian) SignalMatch(
+	_ context.Context,
+	match Match,
+	signal string,
+	dryRun bool,
+) (*SignalMatchResult, error) {
+	sig, ok := allowedSignals[signal]
+	if !ok {
+		return nil, fmt.Errorf("process: signal match: invalid signal %q", signal)
+	}
+
+	if match == (Match{}) {
+		return nil, fmt.Errorf(
+			"process: signal match: at least one of name, cmdline, user or ppid is required",
+		)
+	}
+
+	var cmdline *regexp.Regexp
+	if match.Cmdline != "" {
+		re, err := regexp.Compile(match.Cmdline)
+		if err != nil {
+			return nil, fmt.Errorf("process: signal match: invalid cmdline pattern: %w", err)
+		}
+
+		cmdline = re
+	}
+
+	procs, err := d.collect()
+	if err != nil {
+		return nil, fmt.Errorf("process: signal match: %w", err)
+	}
+
+	protected := d.protectedPIDs(procs)
+	pids := make([]int, 0)
+
+	for _, p := range procs {
+		if protected[p.PID] || p.PPID == kthreaddPID {
+			continue
+		}
+
+		if match.Name != "" && p.Name != match.Name {
+			continue
+		}
+
+		if match.User != "" && p.User != match.User {
+			continue
+		}
+
+		if match.PPID != 0 && p.PPID != match.PPID {
+			continue
+		}
+
+		if cmdline != nil && !cmdline.MatchString(p.Command) {
+			continue
+		}
+
+		pids = append(pids, p.PID)
+	}
+
+	sort.Ints(pids)
+
+	result := &SignalMatchResult{
+		Signal: signal,
+		DryRun: dryRun,
+		PIDs:   pids,
+	}
+
+	if dryRun {
+		return result, nil
+	}
+
+	signalled := make([]int, 0, len(pids))
+
+	for _, pid := range pids {
+		if err := d.signaler.Kill(pid, sig); err != nil {
+			result.Failed = append(result.Failed, SignalFailure{
+				PID:   pid,
+				Error: signalError(err).Error(),
+			})
+
+			continue
+		}
+
+		signalled = append(signalled, pid)
+	}
+
+	result.PIDs = signalled
+	result.Changed = len(signalled) > 0
+
+	return result, nil
+}
+
+// protectedPIDs returns the processes SignalMatch never selects: init,
+// the kernel thread daemon, the agent and every ancestor of the agent,
+// found by following parent PIDs through procs.
+func (d *Debian) protectedPIDs(
+	procs []Info,
+) map[int]bool {
+	parents := make(map[int]int, len(procs))
+	for _, p := range procs {
+		parents[p.PID] = p.PPID
+	}
+
+	protected := map[int]bool{
+		initPID:     true,
+		kthreaddPID: true,
+	}
+
+	for _, pid := range []int{d.self, d.parent} {
+		for pid > 0 && !protected[pid] {
+			protected[pid] = true
+			pid = parents[pid]
+		}
+	}
+
+	return protected
+}
+
+// signalError maps the errno values kill(2) commonly returns to messages
+// the controller can match on.
+func signalError(
+	err error,
+) error {
+	switch err {
+	case syscall.ESRCH:
+		return errors.New("process not found")
+	case syscall.EPERM:
+		return errors.New("permission denied")
+	default:
+		return err
+	}
+}
+
 // gatherInfo extracts process information from a Querier.
 // PID is passed separately because gopsutil exposes it as a struct
 // field rather than a method.
@@ -259,8 +711,14 @@ func gatherInfo(
 
 	startTime := time.UnixMilli(createTime).UTC().Format(time.RFC3339)
 
+	ppid, err := p.Ppid()
+	if err != nil {
+		return nil, err
+	}
+
 	return &Info{
 		PID:        int(pid),
+		PPID:       int(ppid),
 		Name:       name,
 		User:       user,
 		State:      state,
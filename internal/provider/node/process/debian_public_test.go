@@ -25,9 +25,13 @@ import (
 	"errors"
 	"log/slog"
 	"os"
+	"strconv"
 	"syscall"
 	"testing"
 
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/avfs/avfs/vfs/osfs"
 	gopsutil "github.com/shirou/gopsutil/v4/process"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
@@ -40,6 +44,7 @@ type DebianPublicTestSuite struct {
 	suite.Suite
 
 	ctrl         *gomock.Controller
+	appFs        avfs.VFS
 	mockLister   *mocks.MockLister
 	mockSignaler *mocks.MockSignaler
 	provider     *process.Debian
@@ -49,8 +54,10 @@ func (suite *DebianPublicTestSuite) SetupTest() {
 	suite.ctrl = gomock.NewController(suite.T())
 	suite.mockLister = mocks.NewMockLister(suite.ctrl)
 	suite.mockSignaler = mocks.NewMockSignaler(suite.ctrl)
+	suite.appFs = memfs.New()
 	suite.provider = process.NewDebianProvider(
 		slog.New(slog.NewTextHandler(os.Stdout, nil)),
+		suite.appFs,
 		suite.mockLister,
 		suite.mockSignaler,
 	)
@@ -80,6 +87,7 @@ func (suite *DebianPublicTestSuite) TestList() {
 				q1.EXPECT().MemoryInfo().Return(&gopsutil.MemoryInfoStat{RSS: 1024}, nil)
 				q1.EXPECT().Cmdline().Return("/usr/bin/test", nil)
 				q1.EXPECT().CreateTime().Return(int64(1735689600000), nil)
+				q1.EXPECT().Ppid().Return(int32(1), nil)
 
 				q2 := mocks.NewMockQuerier(suite.ctrl)
 				q2.EXPECT().Name().Return("other-proc", nil)
@@ -90,6 +98,7 @@ func (suite *DebianPublicTestSuite) TestList() {
 				q2.EXPECT().MemoryInfo().Return(&gopsutil.MemoryInfoStat{RSS: 2048}, nil)
 				q2.EXPECT().Cmdline().Return("/usr/bin/other", nil)
 				q2.EXPECT().CreateTime().Return(int64(1735689600000), nil)
+				q2.EXPECT().Ppid().Return(int32(1), nil)
 
 				suite.mockLister.EXPECT().Processes().Return([]process.Item{
 					{PID: 1, Querier: q1},
@@ -130,6 +139,7 @@ func (suite *DebianPublicTestSuite) TestList() {
 				q1.EXPECT().MemoryInfo().Return(&gopsutil.MemoryInfoStat{}, nil)
 				q1.EXPECT().Cmdline().Return("", nil)
 				q1.EXPECT().CreateTime().Return(int64(0), nil)
+				q1.EXPECT().Ppid().Return(int32(1), nil)
 
 				q2 := mocks.NewMockQuerier(suite.ctrl)
 				q2.EXPECT().Name().Return("", errors.New("permission denied"))
@@ -143,6 +153,7 @@ func (suite *DebianPublicTestSuite) TestList() {
 				q3.EXPECT().MemoryInfo().Return(&gopsutil.MemoryInfoStat{}, nil)
 				q3.EXPECT().Cmdline().Return("", nil)
 				q3.EXPECT().CreateTime().Return(int64(0), nil)
+				q3.EXPECT().Ppid().Return(int32(1), nil)
 
 				suite.mockLister.EXPECT().Processes().Return([]process.Item{
 					{PID: 1, Querier: q1},
@@ -182,6 +193,7 @@ func (suite *DebianPublicTestSuite) TestList() {
 				q1.EXPECT().MemoryInfo().Return(&gopsutil.MemoryInfoStat{}, nil)
 				q1.EXPECT().Cmdline().Return("", nil)
 				q1.EXPECT().CreateTime().Return(int64(0), nil)
+				q1.EXPECT().Ppid().Return(int32(1), nil)
 
 				suite.mockLister.EXPECT().Processes().Return([]process.Item{
 					{PID: 1, Querier: q1},
@@ -204,6 +216,7 @@ func (suite *DebianPublicTestSuite) TestList() {
 				q1.EXPECT().MemoryInfo().Return(nil, nil)
 				q1.EXPECT().Cmdline().Return("", nil)
 				q1.EXPECT().CreateTime().Return(int64(0), nil)
+				q1.EXPECT().Ppid().Return(int32(1), nil)
 
 				suite.mockLister.EXPECT().Processes().Return([]process.Item{
 					{PID: 1, Querier: q1},
@@ -258,11 +271,20 @@ func (suite *DebianPublicTestSuite) TestGet() {
 				q.EXPECT().MemoryInfo().Return(&gopsutil.MemoryInfoStat{RSS: 2048}, nil)
 				q.EXPECT().Cmdline().Return("/usr/bin/test --flag", nil)
 				q.EXPECT().CreateTime().Return(int64(1735689600000), nil)
+				q.EXPECT().Ppid().Return(int32(1), nil)
+				q.EXPECT().Children().Return(nil, gopsutil.ErrorNoChildren)
+				q.EXPECT().NumThreads().Return(int32(4), nil)
+				q.EXPECT().NumFDs().Return(int32(3), nil)
+				q.EXPECT().OpenFiles().Return(nil, nil)
+				q.EXPECT().Environ().Return(nil, nil)
 
 				suite.mockLister.EXPECT().NewProcess(int32(42)).Return(q, nil)
 			},
 			validateFunc: func(result *process.Info) {
 				suite.Equal(42, result.PID)
+				suite.Equal(1, result.PPID)
+				suite.Equal(4, result.Threads)
+				suite.Equal(3, result.FDCount)
 				suite.Equal("test-proc", result.Name)
 				suite.Equal("root", result.User)
 				suite.Equal("sleeping", result.State)
@@ -318,6 +340,263 @@ func (suite *DebianPublicTestSuite) TestGet() {
 	}
 }
 
+func (suite *DebianPublicTestSuite) TestGetDetails() {
+	const limits = `Limit                     Soft Limit           Hard Limit           Units
+Max cpu time              unlimited            unlimited            seconds
+Max open files            1024                 524288               files
+Max nice priority         0                    0
+`
+
+	openFiles := make([]gopsutil.OpenFilesStat, 25)
+	for i := range openFiles {
+		openFiles[i] = gopsutil.OpenFilesStat{
+			Fd:   uint64(i + 3),
+			Path: "/var/log/app.log",
+		}
+	}
+
+	tests := []struct {
+		name         string
+		pid          int
+		files        map[string]string
+		setupMock    func(q *mocks.MockQuerier)
+		validateFunc func(result *process.Info)
+	}{
+		{
+			name: "when all details are readable returns them",
+			pid:  100,
+			files: map[string]string{
+				"/proc/100/cgroup": "0::/system.slice/nginx.service\n",
+				"/proc/100/limits": limits,
+			},
+			setupMock: func(q *mocks.MockQuerier) {
+				q.EXPECT().Children().Return([]*gopsutil.Process{
+					{Pid: 102},
+					{Pid: 101},
+				}, nil)
+				q.EXPECT().NumThreads().Return(int32(8), nil)
+				q.EXPECT().NumFDs().Return(int32(28), nil)
+				q.EXPECT().OpenFiles().Return(openFiles, nil)
+				q.EXPECT().Environ().Return([]string{
+					"PATH=/usr/bin",
+					"HOME=/root",
+					"EMPTY=",
+					"=orphan",
+				}, nil)
+			},
+			validateFunc: func(result *process.Info) {
+				suite.Equal([]int{101, 102}, result.Children)
+				suite.Equal(8, result.Threads)
+				suite.Equal(28, result.FDCount)
+				suite.Len(result.OpenFiles, 20)
+				suite.Equal(process.OpenFile{FD: 3, Path: "/var/log/app.log"}, result.OpenFiles[0])
+				suite.Equal([]string{"EMPTY", "HOME", "PATH"}, result.EnvKeys)
+				suite.Equal("/system.slice/nginx.service", result.Cgroup)
+				suite.Equal([]process.Limit{
+					{Name: "Max cpu time", Soft: "unlimited", Hard: "unlimited", Units: "seconds"},
+					{Name: "Max open files", Soft: "1024", Hard: "524288", Units: "files"},
+					{Name: "Max nice priority", Soft: "0", Hard: "0"},
+				}, result.Limits)
+			},
+		},
+		{
+			name: "when cgroup v1 prefers the systemd hierarchy",
+			pid:  200,
+			files: map[string]string{
+				"/proc/200/cgroup": "12:memory:/user.slice\n1:name=systemd:/system.slice/cron.service\n",
+			},
+			setupMock: func(q *mocks.MockQuerier) {
+				q.EXPECT().Children().Return(nil, gopsutil.ErrorNoChildren)
+				q.EXPECT().NumThreads().Return(int32(1), nil)
+				q.EXPECT().NumFDs().Return(int32(0), nil)
+				q.EXPECT().OpenFiles().Return(nil, nil)
+				q.EXPECT().Environ().Return(nil, nil)
+			},
+			validateFunc: func(result *process.Info) {
+				suite.Empty(result.Children)
+				suite.Equal("/system.slice/cron.service", result.Cgroup)
+				suite.Empty(result.Limits)
+			},
+		},
+		{
+			name: "when cgroup v1 has no systemd hierarchy uses the first entry",
+			pid:  300,
+			files: map[string]string{
+				"/proc/300/cgroup": "4:cpu,cpuacct:/batch\n3:memory:/batch-mem\n",
+				"/proc/300/limits": "garbage\n",
+			},
+			setupMock: func(q *mocks.MockQuerier) {
+				q.EXPECT().Children().Return(nil, gopsutil.ErrorNoChildren)
+				q.EXPECT().NumThreads().Return(int32(1), nil)
+				q.EXPECT().NumFDs().Return(int32(0), nil)
+				q.EXPECT().OpenFiles().Return(nil, nil)
+				q.EXPECT().Environ().Return(nil, nil)
+			},
+			validateFunc: func(result *process.Info) {
+				suite.Equal("/batch", result.Cgroup)
+				suite.Empty(result.Limits)
+			},
+		},
+		{
+			name: "when details are unreadable skips them",
+			pid:  400,
+			setupMock: func(q *mocks.MockQuerier) {
+				q.EXPECT().Children().Return(nil, errors.New("permission denied"))
+				q.EXPECT().NumThreads().Return(int32(0), errors.New("permission denied"))
+				q.EXPECT().NumFDs().Return(int32(0), errors.New("permission denied"))
+				q.EXPECT().OpenFiles().Return(nil, errors.New("permission denied"))
+				q.EXPECT().Environ().Return(nil, errors.New("permission denied"))
+			},
+			validateFunc: func(result *process.Info) {
+				suite.Equal(400, result.PID)
+				suite.Equal("proc", result.Name)
+				suite.Empty(result.Children)
+				suite.Zero(result.Threads)
+				suite.Zero(result.FDCount)
+				suite.Empty(result.OpenFiles)
+				suite.Empty(result.EnvKeys)
+				suite.Empty(result.Cgroup)
+				suite.Empty(result.Limits)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			for path, content := range tc.files {
+				suite.Require().NoError(suite.appFs.MkdirAll("/proc/"+strconv.Itoa(tc.pid), 0o755))
+				suite.Require().NoError(suite.appFs.WriteFile(path, []byte(content), 0o644))
+			}
+
+			q := suite.newQuerier("proc", 1)
+			tc.setupMock(q)
+			suite.mockLister.EXPECT().NewProcess(int32(tc.pid)).Return(q, nil)
+
+			got, err := suite.provider.Get(context.Background(), tc.pid)
+
+			suite.NoError(err)
+			suite.Require().NotNil(got)
+			tc.validateFunc(got)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestTree() {
+	tests := []struct {
+		name         string
+		pid          int
+		setupMock    func()
+		wantErr      bool
+		wantErrMsg   string
+		validateFunc func(result []process.TreeNode)
+	}{
+		{
+			name: "when pid is zero returns every root",
+			setupMock: func() {
+				suite.mockLister.EXPECT().Processes().Return([]process.Item{
+					{PID: 12, Querier: suite.newQuerier("bash", 10)},
+					{PID: 1, Querier: suite.newQuerier("systemd", 0)},
+					{PID: 20, Querier: suite.newQuerier("kworker", 2)},
+					{PID: 10, Querier: suite.newQuerier("sshd", 1)},
+					{PID: 2, Querier: suite.newQuerier("kthreadd", 0)},
+					{PID: 11, Querier: suite.newQuerier("bash", 10)},
+				}, nil)
+			},
+			validateFunc: func(result []process.TreeNode) {
+				suite.Len(result, 2)
+				suite.Equal(1, result[0].PID)
+				suite.Equal("systemd", result[0].Name)
+				suite.Require().Len(result[0].Children, 1)
+
+				sshd := result[0].Children[0]
+				suite.Equal(10, sshd.PID)
+				suite.Equal(1, sshd.PPID)
+				suite.Require().Len(sshd.Children, 2)
+				suite.Equal(11, sshd.Children[0].PID)
+				suite.Equal(12, sshd.Children[1].PID)
+				suite.Empty(sshd.Children[0].Children)
+
+				suite.Equal(2, result[1].PID)
+				suite.Require().Len(result[1].Children, 1)
+				suite.Equal(20, result[1].Children[0].PID)
+			},
+		},
+		{
+			name: "when pid is set returns the subtree",
+			pid:  10,
+			setupMock: func() {
+				suite.mockLister.EXPECT().Processes().Return([]process.Item{
+					{PID: 1, Querier: suite.newQuerier("systemd", 0)},
+					{PID: 10, Querier: suite.newQuerier("sshd", 1)},
+					{PID: 11, Querier: suite.newQuerier("bash", 10)},
+				}, nil)
+			},
+			validateFunc: func(result []process.TreeNode) {
+				suite.Len(result, 1)
+				suite.Equal(10, result[0].PID)
+				suite.Require().Len(result[0].Children, 1)
+				suite.Equal(11, result[0].Children[0].PID)
+			},
+		},
+		{
+			name: "when parent links form a loop surfaces them",
+			setupMock: func() {
+				suite.mockLister.EXPECT().Processes().Return([]process.Item{
+					{PID: 1, Querier: suite.newQuerier("systemd", 0)},
+					{PID: 5, Querier: suite.newQuerier("a", 6)},
+					{PID: 6, Querier: suite.newQuerier("b", 5)},
+				}, nil)
+			},
+			validateFunc: func(result []process.TreeNode) {
+				suite.Len(result, 2)
+				suite.Equal(1, result[0].PID)
+				suite.Equal(5, result[1].PID)
+				suite.Require().Len(result[1].Children, 1)
+				suite.Equal(6, result[1].Children[0].PID)
+				suite.Empty(result[1].Children[0].Children)
+			},
+		},
+		{
+			name: "when pid is not running returns error",
+			pid:  99,
+			setupMock: func() {
+				suite.mockLister.EXPECT().Processes().Return([]process.Item{
+					{PID: 1, Querier: suite.newQuerier("systemd", 0)},
+				}, nil)
+			},
+			wantErr:    true,
+			wantErrMsg: "process: tree: process 99 not found",
+		},
+		{
+			name: "when listing errors returns error",
+			setupMock: func() {
+				suite.mockLister.EXPECT().Processes().Return(nil, errors.New("cannot read /proc"))
+			},
+			wantErr:    true,
+			wantErrMsg: "process: tree: cannot read /proc",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setupMock()
+
+			got, err := suite.provider.Tree(context.Background(), tc.pid)
+
+			if tc.wantErr {
+				suite.Error(err)
+				suite.Contains(err.Error(), tc.wantErrMsg)
+				suite.Nil(got)
+
+				return
+			}
+
+			suite.NoError(err)
+			tc.validateFunc(got)
+		})
+	}
+}
+
 func (suite *DebianPublicTestSuite) TestSignal() {
 	tests := []struct {
 		name         string
@@ -417,6 +696,278 @@ func (suite *DebianPublicTestSuite) TestSignal() {
 	}
 }
 
+func (suite *DebianPublicTestSuite) TestSignalMatch() {
+	const (
+		agentPID  = 1000
+		parentPID = 900
+	)
+
+	tests := []struct {
+		name         string
+		match        process.Match
+		signal       string
+		dryRun       bool
+		setupMock    func()
+		wantErr      bool
+		wantErrMsg   string
+		validateFunc func(result *process.SignalMatchResult)
+	}{
+		{
+			name:   "when matching by name signals every match",
+			match:  process.Match{Name: "nginx"},
+			signal: "HUP",
+			setupMock: func() {
+				suite.mockLister.EXPECT().Processes().Return([]process.Item{
+					{PID: 30, Querier: suite.newQuerier("nginx", 10)},
+					{PID: 20, Querier: suite.newQuerier("sshd", 1)},
+					{PID: 10, Querier: suite.newQuerier("nginx", 1)},
+				}, nil)
+				suite.mockSignaler.EXPECT().Kill(10, syscall.SIGHUP).Return(nil)
+				suite.mockSignaler.EXPECT().Kill(30, syscall.SIGHUP).Return(nil)
+			},
+			validateFunc: func(result *process.SignalMatchResult) {
+				suite.Equal("HUP", result.Signal)
+				suite.False(result.DryRun)
+				suite.Equal([]int{10, 30}, result.PIDs)
+				suite.Empty(result.Failed)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:   "when matching by cmdline pattern signals every match",
+			match:  process.Match{Cmdline: `^/usr/bin/worker-[0-9]+$`},
+			signal: "TERM",
+			setupMock: func() {
+				suite.mockLister.EXPECT().Processes().Return([]process.Item{
+					{PID: 40, Querier: suite.newQuerier("worker-1", 1)},
+					{PID: 41, Querier: suite.newQuerier("worker-x", 1)},
+					{PID: 42, Querier: suite.newQuerier("worker-22", 1)},
+				}, nil)
+				suite.mockSignaler.EXPECT().Kill(40, syscall.SIGTERM).Return(nil)
+				suite.mockSignaler.EXPECT().Kill(42, syscall.SIGTERM).Return(nil)
+			},
+			validateFunc: func(result *process.SignalMatchResult) {
+				suite.Equal([]int{40, 42}, result.PIDs)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:   "when matching by user and parent applies every filter",
+			match:  process.Match{Name: "php-fpm", User: "www-data", PPID: 50},
+			signal: "USR2",
+			setupMock: func() {
+				suite.mockLister.EXPECT().Processes().Return([]process.Item{
+					{PID: 50, Querier: suite.newQuerierAs("php-fpm", "root", 1)},
+					{PID: 51, Querier: suite.newQuerierAs("php-fpm", "www-data", 50)},
+					{PID: 52, Querier: suite.newQuerierAs("php-fpm", "www-data", 60)},
+					{PID: 53, Querier: suite.newQuerierAs("php-fpm", "deploy", 50)},
+				}, nil)
+				suite.mockSignaler.EXPECT().Kill(51, syscall.SIGUSR2).Return(nil)
+			},
+			validateFunc: func(result *process.SignalMatchResult) {
+				suite.Equal([]int{51}, result.PIDs)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:   "when dry run reports matches without signalling",
+			match:  process.Match{Name: "nginx"},
+			signal: "KILL",
+			dryRun: true,
+			setupMock: func() {
+				suite.mockLister.EXPECT().Processes().Return([]process.Item{
+					{PID: 10, Querier: suite.newQuerier("nginx", 1)},
+					{PID: 11, Querier: suite.newQuerier("nginx", 10)},
+				}, nil)
+			},
+			validateFunc: func(result *process.SignalMatchResult) {
+				suite.True(result.DryRun)
+				suite.Equal([]int{10, 11}, result.PIDs)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:   "when the agent matches itself skips it",
+			match:  process.Match{User: "root"},
+			signal: "TERM",
+			setupMock: func() {
+				suite.mockLister.EXPECT().Processes().Return([]process.Item{
+					{PID: agentPID, Querier: suite.newQuerier("osapi", 1)},
+					{PID: 10, Querier: suite.newQuerier("nginx", 1)},
+				}, nil)
+				suite.mockSignaler.EXPECT().Kill(10, syscall.SIGTERM).Return(nil)
+			},
+			validateFunc: func(result *process.SignalMatchResult) {
+				suite.Equal([]int{10}, result.PIDs)
+			},
+		},
+		{
+			name:   "when init matches skips it",
+			match:  process.Match{User: "root"},
+			signal: "TERM",
+			setupMock: func() {
+				suite.mockLister.EXPECT().Processes().Return([]process.Item{
+					{PID: 1, Querier: suite.newQuerier("systemd", 0)},
+					{PID: 10, Querier: suite.newQuerier("nginx", 1)},
+				}, nil)
+				suite.mockSignaler.EXPECT().Kill(10, syscall.SIGTERM).Return(nil)
+			},
+			validateFunc: func(result *process.SignalMatchResult) {
+				suite.Equal([]int{10}, result.PIDs)
+			},
+		},
+		{
+			name:   "when kernel threads match skips them",
+			match:  process.Match{User: "root"},
+			signal: "KILL",
+			setupMock: func() {
+				suite.mockLister.EXPECT().Processes().Return([]process.Item{
+					{PID: 2, Querier: suite.newQuerier("kthreadd", 0)},
+					{PID: 3, Querier: suite.newQuerier("rcu_gp", 2)},
+					{PID: 4, Querier: suite.newQuerier("kworker/0:0", 2)},
+					{PID: 10, Querier: suite.newQuerier("nginx", 1)},
+				}, nil)
+				suite.mockSignaler.EXPECT().Kill(10, syscall.SIGKILL).Return(nil)
+			},
+			validateFunc: func(result *process.SignalMatchResult) {
+				suite.Equal([]int{10}, result.PIDs)
+			},
+		},
+		{
+			name:   "when the agent's ancestors match skips them",
+			match:  process.Match{User: "root"},
+			signal: "TERM",
+			setupMock: func() {
+				suite.mockLister.EXPECT().Processes().Return([]process.Item{
+					{PID: 800, Querier: suite.newQuerier("supervisord", 1)},
+					{PID: parentPID, Querier: suite.newQuerier("sh", 800)},
+					{PID: agentPID, Querier: suite.newQuerier("osapi", parentPID)},
+					{PID: 1001, Querier: suite.newQuerier("worker", parentPID)},
+					{PID: 10, Querier: suite.newQuerier("nginx", 1)},
+				}, nil)
+				suite.mockSignaler.EXPECT().Kill(10, syscall.SIGTERM).Return(nil)
+				suite.mockSignaler.EXPECT().Kill(1001, syscall.SIGTERM).Return(nil)
+			},
+			validateFunc: func(result *process.SignalMatchResult) {
+				suite.Equal([]int{10, 1001}, result.PIDs)
+			},
+		},
+		{
+			name:   "when the agent is missing from the scan still skips its parent",
+			match:  process.Match{User: "root"},
+			signal: "TERM",
+			setupMock: func() {
+				suite.mockLister.EXPECT().Processes().Return([]process.Item{
+					{PID: 800, Querier: suite.newQuerier("supervisord", 1)},
+					{PID: parentPID, Querier: suite.newQuerier("sh", 800)},
+					{PID: 10, Querier: suite.newQuerier("nginx", 1)},
+				}, nil)
+				suite.mockSignaler.EXPECT().Kill(10, syscall.SIGTERM).Return(nil)
+			},
+			validateFunc: func(result *process.SignalMatchResult) {
+				suite.Equal([]int{10}, result.PIDs)
+			},
+		},
+		{
+			name:   "when some signals fail reports them separately",
+			match:  process.Match{Name: "nginx"},
+			signal: "TERM",
+			setupMock: func() {
+				suite.mockLister.EXPECT().Processes().Return([]process.Item{
+					{PID: 10, Querier: suite.newQuerier("nginx", 1)},
+					{PID: 11, Querier: suite.newQuerier("nginx", 10)},
+					{PID: 12, Querier: suite.newQuerier("nginx", 10)},
+				}, nil)
+				suite.mockSignaler.EXPECT().Kill(10, syscall.SIGTERM).Return(syscall.EPERM)
+				suite.mockSignaler.EXPECT().Kill(11, syscall.SIGTERM).Return(nil)
+				suite.mockSignaler.EXPECT().Kill(12, syscall.SIGTERM).Return(syscall.ESRCH)
+			},
+			validateFunc: func(result *process.SignalMatchResult) {
+				suite.Equal([]int{11}, result.PIDs)
+				suite.Equal([]process.SignalFailure{
+					{PID: 10, Error: "permission denied"},
+					{PID: 12, Error: "process not found"},
+				}, result.Failed)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:   "when nothing matches returns empty result",
+			match:  process.Match{Name: "missing"},
+			signal: "TERM",
+			setupMock: func() {
+				suite.mockLister.EXPECT().Processes().Return([]process.Item{
+					{PID: 10, Querier: suite.newQuerier("nginx", 1)},
+				}, nil)
+			},
+			validateFunc: func(result *process.SignalMatchResult) {
+				suite.NotNil(result.PIDs)
+				suite.Empty(result.PIDs)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:       "when invalid signal returns error",
+			match:      process.Match{Name: "nginx"},
+			signal:     "INVALID",
+			setupMock:  func() {},
+			wantErr:    true,
+			wantErrMsg: `process: signal match: invalid signal "INVALID"`,
+		},
+		{
+			name:       "when match is empty returns error",
+			signal:     "TERM",
+			setupMock:  func() {},
+			wantErr:    true,
+			wantErrMsg: "at least one of name, cmdline, user or ppid is required",
+		},
+		{
+			name:       "when cmdline pattern is invalid returns error",
+			match:      process.Match{Cmdline: "worker-("},
+			signal:     "TERM",
+			setupMock:  func() {},
+			wantErr:    true,
+			wantErrMsg: "process: signal match: invalid cmdline pattern",
+		},
+		{
+			name:   "when listing errors returns error",
+			match:  process.Match{Name: "nginx"},
+			signal: "TERM",
+			setupMock: func() {
+				suite.mockLister.EXPECT().Processes().Return(nil, errors.New("cannot read /proc"))
+			},
+			wantErr:    true,
+			wantErrMsg: "process: signal match: cannot read /proc",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			process.SetAgentPIDs(suite.provider, agentPID, parentPID)
+			tc.setupMock()
+
+			got, err := suite.provider.SignalMatch(
+				context.Background(),
+				tc.match,
+				tc.signal,
+				tc.dryRun,
+			)
+
+			if tc.wantErr {
+				suite.Error(err)
+				suite.Contains(err.Error(), tc.wantErrMsg)
+				suite.Nil(got)
+
+				return
+			}
+
+			suite.NoError(err)
+			suite.NotNil(got)
+			tc.validateFunc(got)
+		})
+	}
+}
+
 func (suite *DebianPublicTestSuite) TestGatherInfoErrors() {
 	tests := []struct {
 		name      string
@@ -521,6 +1072,24 @@ func (suite *DebianPublicTestSuite) TestGatherInfoErrors() {
 			},
 			wantErr: "create time error",
 		},
+		{
+			name: "when Ppid errors returns error",
+			setupMock: func() *mocks.MockQuerier {
+				q := mocks.NewMockQuerier(suite.ctrl)
+				q.EXPECT().Name().Return("proc", nil)
+				q.EXPECT().Username().Return("root", nil)
+				q.EXPECT().Status().Return([]string{"running"}, nil)
+				q.EXPECT().CPUPercent().Return(0.0, nil)
+				q.EXPECT().MemoryPercent().Return(float32(0.0), nil)
+				q.EXPECT().MemoryInfo().Return(&gopsutil.MemoryInfoStat{}, nil)
+				q.EXPECT().Cmdline().Return("cmd", nil)
+				q.EXPECT().CreateTime().Return(int64(0), nil)
+				q.EXPECT().Ppid().Return(int32(0), errors.New("ppid error"))
+
+				return q
+			},
+			wantErr: "ppid error",
+		},
 	}
 
 	for _, tc := range tests {
@@ -537,6 +1106,34 @@ func (suite *DebianPublicTestSuite) TestGatherInfoErrors() {
 	}
 }
 
+// newQuerier returns a Querier mock that answers the summary fields
+// gathered for every process.
+func (suite *DebianPublicTestSuite) newQuerier(
+	name string,
+	ppid int32,
+) *mocks.MockQuerier {
+	return suite.newQuerierAs(name, "root", ppid)
+}
+
+func (suite *DebianPublicTestSuite) newQuerierAs(
+	name string,
+	user string,
+	ppid int32,
+) *mocks.MockQuerier {
+	q := mocks.NewMockQuerier(suite.ctrl)
+	q.EXPECT().Name().Return(name, nil)
+	q.EXPECT().Username().Return(user, nil)
+	q.EXPECT().Status().Return([]string{"sleeping"}, nil)
+	q.EXPECT().CPUPercent().Return(0.0, nil)
+	q.EXPECT().MemoryPercent().Return(float32(0.0), nil)
+	q.EXPECT().MemoryInfo().Return(&gopsutil.MemoryInfoStat{}, nil)
+	q.EXPECT().Cmdline().Return("/usr/bin/"+name, nil)
+	q.EXPECT().CreateTime().Return(int64(0), nil)
+	q.EXPECT().Ppid().Return(ppid, nil)
+
+	return q
+}
+
 // TestDefaultOSFunctions exercises the real OS-interaction wrappers
 // against the current test process to ensure coverage of gopsutil calls.
 func (suite *DebianPublicTestSuite) TestDefaultOSFunctions() {
@@ -553,7 +1150,12 @@ func (suite *DebianPublicTestSuite) TestDefaultOSFunctions() {
 		{
 			name: "when listing with real OS returns current process",
 			fn: func() error {
-				provider := process.NewDebianProvider(slog.Default(), lister, signaler)
+				provider := process.NewDebianProvider(
+					slog.Default(),
+					osfs.NewWithNoIdm(),
+					lister,
+					signaler,
+				)
 				results, err := provider.List(context.Background())
 				if err != nil {
 					return err
@@ -576,7 +1178,12 @@ func (suite *DebianPublicTestSuite) TestDefaultOSFunctions() {
 		{
 			name: "when getting with real OS returns current process info",
 			fn: func() error {
-				provider := process.NewDebianProvider(slog.Default(), lister, signaler)
+				provider := process.NewDebianProvider(
+					slog.Default(),
+					osfs.NewWithNoIdm(),
+					lister,
+					signaler,
+				)
 				info, err := provider.Get(context.Background(), pid)
 				if err != nil {
 					return err
@@ -585,6 +1192,29 @@ func (suite *DebianPublicTestSuite) TestDefaultOSFunctions() {
 				suite.Equal(pid, info.PID)
 				suite.NotEmpty(info.Name)
 				suite.NotEmpty(info.StartTime)
+				suite.Equal(os.Getppid(), info.PPID)
+				suite.Positive(info.Threads)
+				suite.NotEmpty(info.Limits)
+
+				return nil
+			},
+		},
+		{
+			name: "when building the tree with real OS returns current process",
+			fn: func() error {
+				provider := process.NewDebianProvider(
+					slog.Default(),
+					osfs.NewWithNoIdm(),
+					lister,
+					signaler,
+				)
+				nodes, err := provider.Tree(context.Background(), pid)
+				if err != nil {
+					return err
+				}
+
+				suite.Len(nodes, 1)
+				suite.Equal(pid, nodes[0].PID)
 
 				return nil
 			},
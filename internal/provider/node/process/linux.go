@@ -50,6 +50,14 @@ func (l *Linux) Get(
 	return nil, provider.ErrUnsupported
 }
 
+// Tree returns ErrUnsupported on generic Linux.
+func (l *Linux) Tree(
+	_ context.Context,
+	_ int,
+) ([]TreeNode, error) {
+	return nil, provider.ErrUnsupported
+}
+
 // Signal returns ErrUnsupported on generic Linux.
 func (l *Linux) Signal(
 	_ context.Context,
@@ -58,3 +66,13 @@ func (l *Linux) Signal(
 ) (*SignalResult, error) {
 	return nil, provider.ErrUnsupported
 }
+
+// SignalMatch returns ErrUnsupported on generic Linux.
+func (l *Linux) SignalMatch(
+	_ context.Context,
+	_ Match,
+	_ string,
+	_ bool,
+) (*SignalMatchResult, error) {
+	return nil, provider.ErrUnsupported
+}
@@ -78,6 +78,25 @@ func (suite *LinuxPublicTestSuite) TestGet() {
 	}
 }
 
+func (suite *LinuxPublicTestSuite) TestTree() {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "returns not implemented error",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			got, err := suite.provider.Tree(context.Background(), 0)
+
+			suite.Nil(got)
+			suite.ErrorIs(err, provider.ErrUnsupported)
+		})
+	}
+}
+
 func (suite *LinuxPublicTestSuite) TestSignal() {
 	tests := []struct {
 		name string
@@ -97,6 +116,30 @@ func (suite *LinuxPublicTestSuite) TestSignal() {
 	}
 }
 
+func (suite *LinuxPublicTestSuite) TestSignalMatch() {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "returns not implemented error",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			got, err := suite.provider.SignalMatch(
+				context.Background(),
+				process.Match{Name: "nginx"},
+				"TERM",
+				false,
+			)
+
+			suite.Nil(got)
+			suite.ErrorIs(err, provider.ErrUnsupported)
+		})
+	}
+}
+
 // In order for `go test` to run this suite, we need to create
 // a normal test function and pass our suite to suite.Run.
 func TestLinuxPublicTestSuite(t *testing.T) {
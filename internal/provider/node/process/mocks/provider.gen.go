@@ -88,6 +88,36 @@ func (mr *MockProviderMockRecorder) Signal(ctx, pid, signal any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Signal", reflect.TypeOf((*MockProvider)(nil).Signal), ctx, pid, signal)
 }
 
+// SignalMatch mocks base method.
+func (m *MockProvider) SignalMatch(ctx context.Context, match process.Match, signal string, dryRun bool) (*process.SignalMatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignalMatch", ctx, match, signal, dryRun)
+	ret0, _ := ret[0].(*process.SignalMatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignalMatch indicates an expected call of SignalMatch.
+func (mr *MockProviderMockRecorder) SignalMatch(ctx, match, signal, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalMatch", reflect.TypeOf((*MockProvider)(nil).SignalMatch), ctx, match, signal, dryRun)
+}
+
+// Tree mocks base method.
+func (m *MockProvider) Tree(ctx context.Context, pid int) ([]process.TreeNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tree", ctx, pid)
+	ret0, _ := ret[0].([]process.TreeNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Tree indicates an expected call of Tree.
+func (mr *MockProviderMockRecorder) Tree(ctx, pid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tree", reflect.TypeOf((*MockProvider)(nil).Tree), ctx, pid)
+}
+
 // MockQuerier is a mock of Querier interface.
 type MockQuerier struct {
 	ctrl     *gomock.Controller
@@ -127,6 +157,21 @@ func (mr *MockQuerierMockRecorder) CPUPercent() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CPUPercent", reflect.TypeOf((*MockQuerier)(nil).CPUPercent))
 }
 
+// Children mocks base method.
+func (m *MockQuerier) Children() ([]*process0.Process, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Children")
+	ret0, _ := ret[0].([]*process0.Process)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Children indicates an expected call of Children.
+func (mr *MockQuerierMockRecorder) Children() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Children", reflect.TypeOf((*MockQuerier)(nil).Children))
+}
+
 // Cmdline mocks base method.
 func (m *MockQuerier) Cmdline() (string, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +202,21 @@ func (mr *MockQuerierMockRecorder) CreateTime() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTime", reflect.TypeOf((*MockQuerier)(nil).CreateTime))
 }
 
+// Environ mocks base method.
+func (m *MockQuerier) Environ() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Environ")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Environ indicates an expected call of Environ.
+func (mr *MockQuerierMockRecorder) Environ() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environ", reflect.TypeOf((*MockQuerier)(nil).Environ))
+}
+
 // MemoryInfo mocks base method.
 func (m *MockQuerier) MemoryInfo() (*process0.MemoryInfoStat, error) {
 	m.ctrl.T.Helper()
@@ -202,6 +262,66 @@ func (mr *MockQuerierMockRecorder) Name() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockQuerier)(nil).Name))
 }
 
+// NumFDs mocks base method.
+func (m *MockQuerier) NumFDs() (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NumFDs")
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NumFDs indicates an expected call of NumFDs.
+func (mr *MockQuerierMockRecorder) NumFDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NumFDs", reflect.TypeOf((*MockQuerier)(nil).NumFDs))
+}
+
+// NumThreads mocks base method.
+func (m *MockQuerier) NumThreads() (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NumThreads")
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NumThreads indicates an expected call of NumThreads.
+func (mr *MockQuerierMockRecorder) NumThreads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NumThreads", reflect.TypeOf((*MockQuerier)(nil).NumThreads))
+}
+
+// OpenFiles mocks base method.
+func (m *MockQuerier) OpenFiles() ([]process0.OpenFilesStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenFiles")
+	ret0, _ := ret[0].([]process0.OpenFilesStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenFiles indicates an expected call of OpenFiles.
+func (mr *MockQuerierMockRecorder) OpenFiles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenFiles", reflect.TypeOf((*MockQuerier)(nil).OpenFiles))
+}
+
+// Ppid mocks base method.
+func (m *MockQuerier) Ppid() (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ppid")
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ppid indicates an expected call of Ppid.
+func (mr *MockQuerierMockRecorder) Ppid() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ppid", reflect.TypeOf((*MockQuerier)(nil).Ppid))
+}
+
 // Status mocks base method.
 func (m *MockQuerier) Status() ([]string, error) {
 	m.ctrl.T.Helper()
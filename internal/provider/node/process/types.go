@@ -34,8 +34,19 @@ type Provider interface {
 	List(ctx context.Context) ([]Info, error)
 	// Get returns details for a specific process by PID.
 	Get(ctx context.Context, pid int) (*Info, error)
+	// Tree returns the process hierarchy. When pid is non-zero only the
+	// subtree rooted at that process is returned.
+	Tree(ctx context.Context, pid int) ([]TreeNode, error)
 	// Signal sends a signal to a process by PID.
 	Signal(ctx context.Context, pid int, signal string) (*SignalResult, error)
+	// SignalMatch sends a signal to every process selected by match.
+	// When dryRun is set the matching PIDs are reported but not signalled.
+	SignalMatch(
+		ctx context.Context,
+		match Match,
+		signal string,
+		dryRun bool,
+	) (*SignalMatchResult, error)
 }
 
 // Querier provides methods to query a single process.
@@ -49,6 +60,12 @@ type Querier interface {
 	MemoryInfo() (*gopsutil.MemoryInfoStat, error)
 	Cmdline() (string, error)
 	CreateTime() (int64, error)
+	Ppid() (int32, error)
+	Children() ([]*gopsutil.Process, error)
+	NumThreads() (int32, error)
+	NumFDs() (int32, error)
+	OpenFiles() ([]gopsutil.OpenFilesStat, error)
+	Environ() ([]string, error)
 }
 
 // Item pairs a PID with a Querier. PID is exposed
@@ -70,9 +87,11 @@ type Signaler interface {
 	Kill(pid int, sig syscall.Signal) error
 }
 
-// Info represents a running process.
+// Info represents a running process. The detail fields after StartTime
+// are only populated by Get; List leaves them empty to stay cheap.
 type Info struct {
 	PID        int     `json:"pid"`
+	PPID       int     `json:"ppid"`
 	Name       string  `json:"name"`
 	User       string  `json:"user"`
 	State      string  `json:"state"`
@@ -81,6 +100,39 @@ type Info struct {
 	MemRSS     int64   `json:"mem_rss"`
 	Command    string  `json:"command"`
 	StartTime  string  `json:"start_time"`
+
+	Children  []int      `json:"children,omitempty"`
+	Threads   int        `json:"threads,omitempty"`
+	FDCount   int        `json:"fd_count,omitempty"`
+	OpenFiles []OpenFile `json:"open_files,omitempty"`
+	Cgroup    string     `json:"cgroup,omitempty"`
+	EnvKeys   []string   `json:"env_keys,omitempty"`
+	Limits    []Limit    `json:"limits,omitempty"`
+}
+
+// OpenFile is a file descriptor held open by a process.
+type OpenFile struct {
+	FD   int    `json:"fd"`
+	Path string `json:"path"`
+}
+
+// Limit is a resource limit from /proc/<pid>/limits. Soft and Hard are
+// kept as strings because the kernel reports "unlimited".
+type Limit struct {
+	Name  string `json:"name"`
+	Soft  string `json:"soft"`
+	Hard  string `json:"hard"`
+	Units string `json:"units,omitempty"`
+}
+
+// TreeNode is a process and its descendants.
+type TreeNode struct {
+	PID      int        `json:"pid"`
+	PPID     int        `json:"ppid"`
+	Name     string     `json:"name"`
+	User     string     `json:"user"`
+	Command  string     `json:"command"`
+	Children []TreeNode `json:"children,omitempty"`
 }
 
 // SignalResult represents the outcome of sending a signal to a process.
@@ -90,3 +142,33 @@ type SignalResult struct {
 	Changed bool   `json:"changed"`
 	Error   string `json:"error,omitempty"`
 }
+
+// Match selects processes by attribute. Every non-zero field must match;
+// at least one field must be set.
+type Match struct {
+	// Name is compared exactly against the process name.
+	Name string `json:"name,omitempty"`
+	// Cmdline is a regular expression matched against the full command line.
+	Cmdline string `json:"cmdline,omitempty"`
+	// User is compared exactly against the process owner.
+	User string `json:"user,omitempty"`
+	// PPID restricts matches to direct children of this process.
+	PPID int `json:"ppid,omitempty"`
+}
+
+// SignalMatchResult represents the outcome of signalling matched processes.
+// PIDs lists every process that was signalled, or would have been in a dry
+// run; processes the signal could not be delivered to are in Failed.
+type SignalMatchResult struct {
+	Signal  string          `json:"signal"`
+	DryRun  bool            `json:"dry_run"`
+	PIDs    []int           `json:"pids"`
+	Failed  []SignalFailure `json:"failed,omitempty"`
+	Changed bool            `json:"changed"`
+}
+
+// SignalFailure records a matched process that could not be signalled.
+type SignalFailure struct {
+	PID   int    `json:"pid"`
+	Error string `json:"error"`
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package service
+
+import (
+	"log/slog"
+
+	"github.com/avfs/avfs"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/osapi-io/osapi/internal/exec"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/file"
+)
+
+// openrcRunlevel is the runlevel services are enabled in.
+const openrcRunlevel = "default"
+
+// Compile-time check: Alpine must satisfy Provider.
+var _ Provider = (*Alpine)(nil)
+
+// Compile-time check: Alpine must satisfy FactsSetter.
+var _ provider.FactsSetter = (*Alpine)(nil)
+
+// Alpine implements the Provider interface for Alpine systems running
+// OpenRC. It delegates init script writes to a FileDeployer for SHA
+// tracking and idempotency. Service control operations use rc-service
+// and rc-update via exec.Manager.
+type Alpine struct {
+	provider.FactsAware
+	logger       *slog.Logger
+	fs           avfs.VFS
+	fileDeployer file.Deployer
+	stateKV      jetstream.KeyValue
+	execManager  exec.Manager
+	hostname     string
+}
+
+// NewAlpineProvider factory to create a new Alpine instance.
+func NewAlpineProvider(
+	logger *slog.Logger,
+	fs avfs.VFS,
+	fileDeployer file.Deployer,
+	stateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+) *Alpine {
+	return &Alpine{
+		logger:       logger.With(slog.String("subsystem", "provider.service")),
+		fs:           fs,
+		fileDeployer: fileDeployer,
+		stateKV:      stateKV,
+		execManager:  execManager,
+		hostname:     hostname,
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// Start starts an OpenRC service. If the service is already started, it
+// returns Changed: false without taking action.
+func (a *Alpine) Start(
+	_ context.Context,
+	name string,
+) (*ActionResult, error) {
+	if err := validateName(name); err != nil {
+		return nil, fmt.Errorf("service: start: %w", err)
+	}
+
+	svcName := managedPrefix + name
+
+	a.logger.Debug("executing service.Start", slog.String("name", svcName))
+
+	if a.status(svcName) == "active" {
+		return &ActionResult{Name: name, Changed: false}, nil
+	}
+
+	if _, err := a.execManager.RunPrivilegedCmd("rc-service", []string{svcName, "start"}); err != nil {
+		return nil, fmt.Errorf("service: start: %w", err)
+	}
+
+	return &ActionResult{Name: name, Changed: true}, nil
+}
+
+// Stop stops an OpenRC service. If the service is not started, it
+// returns Changed: false without taking action.
+func (a *Alpine) Stop(
+	_ context.Context,
+	name string,
+) (*ActionResult, error) {
+	if err := validateName(name); err != nil {
+		return nil, fmt.Errorf("service: stop: %w", err)
+	}
+
+	svcName := managedPrefix + name
+
+	a.logger.Debug("executing service.Stop", slog.String("name", svcName))
+
+	if a.status(svcName) != "active" {
+		return &ActionResult{Name: name, Changed: false}, nil
+	}
+
+	if _, err := a.execManager.RunPrivilegedCmd("rc-service", []string{svcName, "stop"}); err != nil {
+		return nil, fmt.Errorf("service: stop: %w", err)
+	}
+
+	return &ActionResult{Name: name, Changed: true}, nil
+}
+
+// Restart restarts an OpenRC service. Always returns Changed: true on success.
+func (a *Alpine) Restart(
+	_ context.Context,
+	name string,
+) (*ActionResult, error) {
+	if err := validateName(name); err != nil {
+		return nil, fmt.Errorf("service: restart: %w", err)
+	}
+
+	svcName := managedPrefix + name
+
+	a.logger.Debug("executing service.Restart", slog.String("name", svcName))
+
+	if _, err := a.execManager.RunPrivilegedCmd("rc-service", []string{svcName, "restart"}); err != nil {
+		return nil, fmt.Errorf("service: restart: %w", err)
+	}
+
+	return &ActionResult{Name: name, Changed: true}, nil
+}
+
+// Enable adds an OpenRC service to the default runlevel. If the service
+// is already in the runlevel, it returns Changed: false without taking
+// action.
+func (a *Alpine) Enable(
+	_ context.Context,
+	name string,
+) (*ActionResult, error) {
+	if err := validateName(name); err != nil {
+		return nil, fmt.Errorf("service: enable: %w", err)
+	}
+
+	svcName := managedPrefix + name
+
+	a.logger.Debug("executing service.Enable", slog.String("name", svcName))
+
+	if a.buildEnabledMap()[svcName] {
+		return &ActionResult{Name: name, Changed: false}, nil
+	}
+
+	if _, err := a.execManager.RunPrivilegedCmd(
+		"rc-update",
+		[]string{"add", svcName, openrcRunlevel},
+	); err != nil {
+		return nil, fmt.Errorf("service: enable: %w", err)
+	}
+
+	return &ActionResult{Name: name, Changed: true}, nil
+}
+
+// Disable removes an OpenRC service from the default runlevel. If the
+// service is not in the runlevel, it returns Changed: false without
+// taking action.
+func (a *Alpine) Disable(
+	_ context.Context,
+	name string,
+) (*ActionResult, error) {
+	if err := validateName(name); err != nil {
+		return nil, fmt.Errorf("service: disable: %w", err)
+	}
+
+	svcName := managedPrefix + name
+
+	a.logger.Debug("executing service.Disable", slog.String("name", svcName))
+
+	if !a.buildEnabledMap()[svcName] {
+		return &ActionResult{Name: name, Changed: false}, nil
+	}
+
+	if _, err := a.execManager.RunPrivilegedCmd(
+		"rc-update",
+		[]string{"del", svcName, openrcRunlevel},
+	); err != nil {
+		return nil, fmt.Errorf("service: disable: %w", err)
+	}
+
+	return &ActionResult{Name: name, Changed: true}, nil
+}
+
+// status returns the normalized state of an OpenRC service from
+// rc-service status. rc-service exits non-zero for stopped services,
+// so the output is parsed regardless of the error.
+func (a *Alpine) status(
+	svcName string,
+) string {
+	output, _ := a.execManager.RunCmd("rc-service", []string{svcName, "status"})
+
+	state, _ := parseOpenRCStatus(output)
+
+	return state
+}
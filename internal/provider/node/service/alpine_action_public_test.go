@@ -0,0 +1,500 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package service_test
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	filemocks "github.com/osapi-io/osapi/internal/provider/file/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/service"
+)
+
+type AlpineActionPublicTestSuite struct {
+	suite.Suite
+
+	ctrl            *gomock.Controller
+	mockExecManager *execmocks.MockManager
+	provider        *service.Alpine
+}
+
+func (suite *AlpineActionPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	memFs := memfs.New()
+	mockDeployer := filemocks.NewMockDeployer(suite.ctrl)
+	mockStateKV := jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExecManager = execmocks.NewMockManager(suite.ctrl)
+
+	suite.provider = service.NewAlpineProvider(
+		logger,
+		memFs,
+		mockDeployer,
+		mockStateKV,
+		suite.mockExecManager,
+		testHostname,
+	)
+}
+
+func (suite *AlpineActionPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *AlpineActionPublicTestSuite) TestStart() {
+	tests := []struct {
+		name         string
+		serviceName  string
+		setup        func()
+		validateFunc func(*service.ActionResult, error)
+	}{
+		{
+			name:        "when service is stopped starts it",
+			serviceName: "nginx",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-service", []string{"osapi-nginx", "status"}).
+					Return(" * status: stopped\n", errors.New("exit status 3"))
+				suite.mockExecManager.EXPECT().
+					RunPrivilegedCmd("rc-service", []string{"osapi-nginx", "start"}).
+					Return("", nil)
+			},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("nginx", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:        "when service is already started returns changed false",
+			serviceName: "nginx",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-service", []string{"osapi-nginx", "status"}).
+					Return(" * status: started\n", nil)
+			},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("nginx", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:        "when start command fails returns error",
+			serviceName: "nginx",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-service", []string{"osapi-nginx", "status"}).
+					Return(" * status: stopped\n", errors.New("exit status 3"))
+				suite.mockExecManager.EXPECT().
+					RunPrivilegedCmd("rc-service", []string{"osapi-nginx", "start"}).
+					Return("", errors.New("start failed"))
+			},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "service: start:")
+			},
+		},
+		{
+			name:        "when name is invalid returns error",
+			serviceName: "bad name!",
+			setup:       func() {},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid service name")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Start(suite.T().Context(), tc.serviceName)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *AlpineActionPublicTestSuite) TestStop() {
+	tests := []struct {
+		name         string
+		serviceName  string
+		setup        func()
+		validateFunc func(*service.ActionResult, error)
+	}{
+		{
+			name:        "when service is started stops it",
+			serviceName: "nginx",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-service", []string{"osapi-nginx", "status"}).
+					Return(" * status: started\n", nil)
+				suite.mockExecManager.EXPECT().
+					RunPrivilegedCmd("rc-service", []string{"osapi-nginx", "stop"}).
+					Return("", nil)
+			},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("nginx", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:        "when service is already stopped returns changed false",
+			serviceName: "nginx",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-service", []string{"osapi-nginx", "status"}).
+					Return(" * status: stopped\n", errors.New("exit status 3"))
+			},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("nginx", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:        "when stop command fails returns error",
+			serviceName: "nginx",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-service", []string{"osapi-nginx", "status"}).
+					Return(" * status: started\n", nil)
+				suite.mockExecManager.EXPECT().
+					RunPrivilegedCmd("rc-service", []string{"osapi-nginx", "stop"}).
+					Return("", errors.New("stop failed"))
+			},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "service: stop:")
+			},
+		},
+		{
+			name:        "when name is invalid returns error",
+			serviceName: "bad name!",
+			setup:       func() {},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid service name")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Stop(suite.T().Context(), tc.serviceName)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *AlpineActionPublicTestSuite) TestRestart() {
+	tests := []struct {
+		name         string
+		serviceName  string
+		setup        func()
+		validateFunc func(*service.ActionResult, error)
+	}{
+		{
+			name:        "when restart succeeds",
+			serviceName: "nginx",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunPrivilegedCmd("rc-service", []string{"osapi-nginx", "restart"}).
+					Return("", nil)
+			},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("nginx", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:        "when restart command fails returns error",
+			serviceName: "nginx",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunPrivilegedCmd("rc-service", []string{"osapi-nginx", "restart"}).
+					Return("", errors.New("restart failed"))
+			},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "service: restart:")
+			},
+		},
+		{
+			name:        "when name is invalid returns error",
+			serviceName: "bad name!",
+			setup:       func() {},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid service name")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Restart(suite.T().Context(), tc.serviceName)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *AlpineActionPublicTestSuite) TestEnable() {
+	tests := []struct {
+		name         string
+		serviceName  string
+		setup        func()
+		validateFunc func(*service.ActionResult, error)
+	}{
+		{
+			name:        "when service is not in runlevel adds it",
+			serviceName: "nginx",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-update", []string{"show", "-v"}).
+					Return("         osapi-nginx |\n                sshd | default\n", nil)
+				suite.mockExecManager.EXPECT().
+					RunPrivilegedCmd("rc-update", []string{"add", "osapi-nginx", "default"}).
+					Return("", nil)
+			},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("nginx", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:        "when service is already in runlevel returns changed false",
+			serviceName: "nginx",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-update", []string{"show", "-v"}).
+					Return("         osapi-nginx | default\n", nil)
+			},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("nginx", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:        "when enable command fails returns error",
+			serviceName: "nginx",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-update", []string{"show", "-v"}).
+					Return("", errors.New("exec failed"))
+				suite.mockExecManager.EXPECT().
+					RunPrivilegedCmd("rc-update", []string{"add", "osapi-nginx", "default"}).
+					Return("", errors.New("enable failed"))
+			},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "service: enable:")
+			},
+		},
+		{
+			name:        "when name is invalid returns error",
+			serviceName: "bad name!",
+			setup:       func() {},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid service name")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Enable(suite.T().Context(), tc.serviceName)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *AlpineActionPublicTestSuite) TestDisable() {
+	tests := []struct {
+		name         string
+		serviceName  string
+		setup        func()
+		validateFunc func(*service.ActionResult, error)
+	}{
+		{
+			name:        "when service is in runlevel removes it",
+			serviceName: "nginx",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-update", []string{"show", "-v"}).
+					Return("         osapi-nginx | default\n", nil)
+				suite.mockExecManager.EXPECT().
+					RunPrivilegedCmd("rc-update", []string{"del", "osapi-nginx", "default"}).
+					Return("", nil)
+			},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("nginx", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:        "when service is not in runlevel returns changed false",
+			serviceName: "nginx",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-update", []string{"show", "-v"}).
+					Return("         osapi-nginx |\n", nil)
+			},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("nginx", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:        "when disable command fails returns error",
+			serviceName: "nginx",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-update", []string{"show", "-v"}).
+					Return("         osapi-nginx | boot default\n", nil)
+				suite.mockExecManager.EXPECT().
+					RunPrivilegedCmd("rc-update", []string{"del", "osapi-nginx", "default"}).
+					Return("", errors.New("disable failed"))
+			},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "service: disable:")
+			},
+		},
+		{
+			name:        "when name is invalid returns error",
+			serviceName: "bad name!",
+			setup:       func() {},
+			validateFunc: func(
+				result *service.ActionResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid service name")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Disable(suite.T().Context(), tc.serviceName)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestAlpineActionPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(AlpineActionPublicTestSuite))
+}
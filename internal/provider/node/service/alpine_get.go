@@ -0,0 +1,99 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// Get returns a single OpenRC service by name using rc-service status.
+func (a *Alpine) Get(
+	_ context.Context,
+	name string,
+) (*Info, error) {
+	a.logger.Debug(
+		"executing service.Get",
+		slog.String("name", name),
+	)
+
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	// rc-service exits non-zero for stopped services; only fail when the
+	// output carries no status line.
+	output, err := a.execManager.RunCmd("rc-service", []string{name, "status"})
+
+	state, ok := parseOpenRCStatus(output)
+	if !ok {
+		if err != nil {
+			return nil, fmt.Errorf("service: get: %w", err)
+		}
+
+		return nil, fmt.Errorf("service: get: unexpected status output: %q", output)
+	}
+
+	info := &Info{
+		Name:    name,
+		Status:  state,
+		Enabled: a.buildEnabledMap()[name],
+	}
+
+	return info, nil
+}
+
+// parseOpenRCStatus extracts the service state from rc-service status
+// output (e.g., " * status: started") and normalizes it to the systemd
+// vocabulary used by Info.Status.
+func parseOpenRCStatus(
+	output string,
+) (string, bool) {
+	for _, line := range strings.Split(output, "\n") {
+		_, state, found := strings.Cut(line, "status:")
+		if !found {
+			continue
+		}
+
+		return normalizeOpenRCState(strings.TrimSpace(state)), true
+	}
+
+	return "", false
+}
+
+// normalizeOpenRCState maps OpenRC service states to the systemd
+// ActiveState values reported by the Debian provider.
+func normalizeOpenRCState(
+	state string,
+) string {
+	switch state {
+	case "started":
+		return "active"
+	case "stopped":
+		return "inactive"
+	case "crashed":
+		return "failed"
+	default:
+		return state
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package service_test
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	filemocks "github.com/osapi-io/osapi/internal/provider/file/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/service"
+)
+
+type AlpineGetPublicTestSuite struct {
+	suite.Suite
+
+	ctrl            *gomock.Controller
+	mockExecManager *execmocks.MockManager
+	provider        *service.Alpine
+}
+
+func (suite *AlpineGetPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	memFs := memfs.New()
+	mockDeployer := filemocks.NewMockDeployer(suite.ctrl)
+	mockStateKV := jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExecManager = execmocks.NewMockManager(suite.ctrl)
+
+	suite.provider = service.NewAlpineProvider(
+		logger,
+		memFs,
+		mockDeployer,
+		mockStateKV,
+		suite.mockExecManager,
+		testHostname,
+	)
+}
+
+func (suite *AlpineGetPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *AlpineGetPublicTestSuite) TestGet() {
+	tests := []struct {
+		name         string
+		serviceName  string
+		setup        func()
+		validateFunc func(*service.Info, error)
+	}{
+		{
+			name:        "when started enabled service returns info",
+			serviceName: "sshd",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-service", []string{"sshd", "status"}).
+					Return(" * status: started\n", nil)
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-update", []string{"show", "-v"}).
+					Return("                sshd | default\n", nil)
+			},
+			validateFunc: func(
+				info *service.Info,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("sshd", info.Name)
+				suite.Equal("active", info.Status)
+				suite.True(info.Enabled)
+			},
+		},
+		{
+			name:        "when stopped service exits non-zero returns info",
+			serviceName: "crond",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-service", []string{"crond", "status"}).
+					Return(" * status: stopped\n", errors.New("exit status 3"))
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-update", []string{"show", "-v"}).
+					Return("               crond |\n", nil)
+			},
+			validateFunc: func(
+				info *service.Info,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("crond", info.Name)
+				suite.Equal("inactive", info.Status)
+				suite.False(info.Enabled)
+			},
+		},
+		{
+			name:        "when crashed service reports failed",
+			serviceName: "worker",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-service", []string{"worker", "status"}).
+					Return(" * status: crashed\n", errors.New("exit status 32"))
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-update", []string{"show", "-v"}).
+					Return("", errors.New("exec failed"))
+			},
+			validateFunc: func(
+				info *service.Info,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("failed", info.Status)
+				suite.False(info.Enabled)
+			},
+		},
+		{
+			name:        "when unknown state is passed through",
+			serviceName: "worker",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-service", []string{"worker", "status"}).
+					Return(" * status: starting\n", nil)
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-update", []string{"show", "-v"}).
+					Return("", nil)
+			},
+			validateFunc: func(
+				info *service.Info,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("starting", info.Status)
+			},
+		},
+		{
+			name:        "when exec fails without status returns error",
+			serviceName: "missing",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-service", []string{"missing", "status"}).
+					Return(" * rc-service: service `missing' does not exist\n", errors.New("exit status 1"))
+			},
+			validateFunc: func(
+				info *service.Info,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(info)
+				suite.Contains(err.Error(), "service: get:")
+			},
+		},
+		{
+			name:        "when output is unexpected returns error",
+			serviceName: "odd",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-service", []string{"odd", "status"}).
+					Return("garbage\n", nil)
+			},
+			validateFunc: func(
+				info *service.Info,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(info)
+				suite.Contains(err.Error(), "unexpected status output")
+			},
+		},
+		{
+			name:        "when name is invalid returns error",
+			serviceName: "bad name!",
+			setup:       func() {},
+			validateFunc: func(
+				info *service.Info,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(info)
+				suite.Contains(err.Error(), "invalid service name")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			info, err := suite.provider.Get(suite.T().Context(), tc.serviceName)
+
+			tc.validateFunc(info, err)
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestAlpineGetPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(AlpineGetPublicTestSuite))
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// List returns all OpenRC services by merging rc-status and rc-update output.
+func (a *Alpine) List(
+	_ context.Context,
+) ([]Info, error) {
+	a.logger.Debug("executing service.List")
+
+	output, err := a.execManager.RunCmd("rc-status", []string{"--servicelist"})
+	if err != nil {
+		return nil, fmt.Errorf("service: list: %w", err)
+	}
+
+	enabledMap := a.buildEnabledMap()
+
+	var result []Info
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		// Format: " sshd     [  started  ]"
+		name, rest, found := strings.Cut(strings.TrimSpace(line), " ")
+		if !found {
+			continue
+		}
+
+		state := strings.Trim(strings.TrimSpace(rest), "[] ")
+
+		result = append(result, Info{
+			Name:    name,
+			Status:  normalizeOpenRCState(state),
+			Enabled: enabledMap[name],
+		})
+	}
+
+	return result, nil
+}
+
+// buildEnabledMap runs rc-update show and returns a map of service name
+// to whether it is in the default runlevel. Errors are logged and result
+// in an empty map (all services default to enabled=false).
+func (a *Alpine) buildEnabledMap() map[string]bool {
+	output, err := a.execManager.RunCmd("rc-update", []string{"show", "-v"})
+	if err != nil {
+		a.logger.Debug(
+			"failed to list runlevels, enabled status will be unavailable",
+			slog.String("error", err.Error()),
+		)
+
+		return map[string]bool{}
+	}
+
+	enabledMap := make(map[string]bool)
+	for _, line := range strings.Split(output, "\n") {
+		// Format: "  sshd | boot default"
+		name, levels, found := strings.Cut(line, "|")
+		if !found {
+			continue
+		}
+
+		for _, level := range strings.Fields(levels) {
+			if level == openrcRunlevel {
+				enabledMap[strings.TrimSpace(name)] = true
+			}
+		}
+	}
+
+	return enabledMap
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package service_test
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	filemocks "github.com/osapi-io/osapi/internal/provider/file/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/service"
+)
+
+type AlpineListPublicTestSuite struct {
+	suite.Suite
+
+	ctrl            *gomock.Controller
+	mockExecManager *execmocks.MockManager
+	provider        *service.Alpine
+}
+
+func (suite *AlpineListPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	memFs := memfs.New()
+	mockDeployer := filemocks.NewMockDeployer(suite.ctrl)
+	mockStateKV := jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExecManager = execmocks.NewMockManager(suite.ctrl)
+
+	suite.provider = service.NewAlpineProvider(
+		logger,
+		memFs,
+		mockDeployer,
+		mockStateKV,
+		suite.mockExecManager,
+		testHostname,
+	)
+}
+
+func (suite *AlpineListPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *AlpineListPublicTestSuite) TestList() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func([]service.Info, error)
+	}{
+		{
+			name: "when services are listed with enabled state",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-status", []string{"--servicelist"}).
+					Return(" sshd                     [  started  ]\n"+
+						"\n"+
+						" crond                    [  stopped  ]\n", nil)
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-update", []string{"show", "-v"}).
+					Return("               crond |\n"+
+						"                sshd | boot default\n", nil)
+			},
+			validateFunc: func(
+				result []service.Info,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Require().Len(result, 2)
+				suite.Equal("sshd", result[0].Name)
+				suite.Equal("active", result[0].Status)
+				suite.True(result[0].Enabled)
+				suite.Equal("crond", result[1].Name)
+				suite.Equal("inactive", result[1].Status)
+				suite.False(result[1].Enabled)
+			},
+		},
+		{
+			name: "when rc-update fails enabled defaults to false",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-status", []string{"--servicelist"}).
+					Return(" sshd                     [  started  ]\n", nil)
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-update", []string{"show", "-v"}).
+					Return("", errors.New("exec failed"))
+			},
+			validateFunc: func(
+				result []service.Info,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Require().Len(result, 1)
+				suite.False(result[0].Enabled)
+			},
+		},
+		{
+			name: "when rc-status fails returns error",
+			setup: func() {
+				suite.mockExecManager.EXPECT().
+					RunCmd("rc-status", []string{"--servicelist"}).
+					Return("", errors.New("exec failed"))
+			},
+			validateFunc: func(
+				result []service.Info,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "service: list:")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.List(suite.T().Context())
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestAlpineListPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(AlpineListPublicTestSuite))
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/file"
+)
+
+const initDir = "/etc/init.d"
+
+// Create deploys a new OpenRC init script via the file provider.
+func (a *Alpine) Create(
+	ctx context.Context,
+	entry Entry,
+) (*CreateResult, error) {
+	if err := validateName(entry.Name); err != nil {
+		return nil, err
+	}
+
+	filePath := initScriptPath(entry.Name)
+
+	if _, err := a.fs.Stat(filePath); err == nil {
+		return &CreateResult{
+			Name:    entry.Name,
+			Changed: false,
+		}, nil
+	}
+
+	a.logger.Debug(
+		"creating service init script",
+		slog.String("name", entry.Name),
+	)
+
+	result, err := a.fileDeployer.Deploy(ctx, file.DeployRequest{
+		ObjectName: entry.Object,
+		Path:       filePath,
+		Mode:       "0755",
+		Metadata:   map[string]string{"source": "custom"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("service: create: %w", err)
+	}
+
+	return &CreateResult{
+		Name:    entry.Name,
+		Changed: result.Changed,
+	}, nil
+}
+
+// Update redeploys an existing OpenRC init script via the file provider.
+func (a *Alpine) Update(
+	ctx context.Context,
+	entry Entry,
+) (*UpdateResult, error) {
+	if err := validateName(entry.Name); err != nil {
+		return nil, err
+	}
+
+	filePath := initScriptPath(entry.Name)
+
+	if _, err := a.fs.Stat(filePath); err != nil {
+		return nil, fmt.Errorf("service init script %q not managed", entry.Name)
+	}
+
+	// If no new object was specified, preserve the current one.
+	if entry.Object == "" {
+		existing := buildEntryFromState(ctx, a.stateKV, a.hostname, entry.Name, filePath)
+		if existing == nil {
+			return nil, fmt.Errorf(
+				"service: update: failed to read existing state for %q",
+				entry.Name,
+			)
+		}
+		entry.Object = existing.Object
+	}
+
+	a.logger.Debug(
+		"updating service init script",
+		slog.String("name", entry.Name),
+	)
+
+	result, err := a.fileDeployer.Deploy(ctx, file.DeployRequest{
+		ObjectName: entry.Object,
+		Path:       filePath,
+		Mode:       "0755",
+		Metadata:   map[string]string{"source": "custom"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("service: update: %w", err)
+	}
+
+	return &UpdateResult{
+		Name:    entry.Name,
+		Changed: result.Changed,
+	}, nil
+}
+
+// Delete undeploys an OpenRC init script via the file provider.
+func (a *Alpine) Delete(
+	ctx context.Context,
+	name string,
+) (*DeleteResult, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	filePath := initScriptPath(name)
+
+	if _, err := a.fs.Stat(filePath); err != nil {
+		return &DeleteResult{
+			Name:    name,
+			Changed: false,
+		}, nil
+	}
+
+	svcName := managedPrefix + name
+
+	// Best-effort stop and remove from the runlevel before removing the
+	// init script.
+	if _, err := a.execManager.RunPrivilegedCmd("rc-service", []string{svcName, "stop"}); err != nil {
+		a.logger.Warn(
+			"failed to stop service before delete",
+			slog.String("name", name),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	if _, err := a.execManager.RunPrivilegedCmd(
+		"rc-update",
+		[]string{"del", svcName, openrcRunlevel},
+	); err != nil {
+		a.logger.Warn(
+			"failed to disable service before delete",
+			slog.String("name", name),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	a.logger.Debug(
+		"deleting service init script",
+		slog.String("name", name),
+	)
+
+	result, err := a.fileDeployer.Undeploy(ctx, file.UndeployRequest{
+		Path: filePath,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("service: delete: %w", err)
+	}
+
+	return &DeleteResult{
+		Name:    name,
+		Changed: result.Changed,
+	}, nil
+}
+
+// initScriptPath returns the file path for a managed init script.
+func initScriptPath(
+	name string,
+) string {
+	return initDir + "/" + managedPrefix + name
+}
+
+// ListDropIns returns ErrUnsupported on Alpine. Drop-in overrides are a
+// systemd feature with no OpenRC equivalent.
+func (a *Alpine) ListDropIns(
+	_ context.Context,
+	_ string,
+) ([]DropIn, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// CreateDropIn returns ErrUnsupported on Alpine.
+func (a *Alpine) CreateDropIn(
+	_ context.Context,
+	_ DropInEntry,
+) (*DropInResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// UpdateDropIn returns ErrUnsupported on Alpine.
+func (a *Alpine) UpdateDropIn(
+	_ context.Context,
+	_ DropInEntry,
+) (*DropInResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// DeleteDropIn returns ErrUnsupported on Alpine.
+func (a *Alpine) DeleteDropIn(
+	_ context.Context,
+	_ DropInEntry,
+) (*DropInResult, error) {
+	return nil, provider.ErrUnsupported
+}
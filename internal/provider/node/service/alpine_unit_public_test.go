@@ -0,0 +1,518 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package service_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/file"
+	filemocks "github.com/osapi-io/osapi/internal/provider/file/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/service"
+)
+
+const alpineScriptPath = "/etc/init.d/osapi-myapp"
+
+type AlpineUnitPublicTestSuite struct {
+	suite.Suite
+
+	ctrl            *gomock.Controller
+	logger          *slog.Logger
+	memFs           avfs.VFS
+	mockDeployer    *filemocks.MockDeployer
+	mockStateKV     *jobmocks.MockKeyValue
+	mockExecManager *execmocks.MockManager
+	provider        *service.Alpine
+}
+
+func (suite *AlpineUnitPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+	suite.mockDeployer = filemocks.NewMockDeployer(suite.ctrl)
+	suite.mockStateKV = jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExecManager = execmocks.NewMockManager(suite.ctrl)
+
+	_ = suite.memFs.MkdirAll("/etc/init.d", 0o755)
+
+	suite.provider = service.NewAlpineProvider(
+		suite.logger,
+		suite.memFs,
+		suite.mockDeployer,
+		suite.mockStateKV,
+		suite.mockExecManager,
+		testHostname,
+	)
+}
+
+func (suite *AlpineUnitPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *AlpineUnitPublicTestSuite) writeScript() {
+	_ = suite.memFs.WriteFile(
+		alpineScriptPath,
+		[]byte("#!/sbin/openrc-run\ncommand=/usr/bin/myapp\n"),
+		0o755,
+	)
+}
+
+func (suite *AlpineUnitPublicTestSuite) TestCreate() {
+	tests := []struct {
+		name         string
+		entry        service.Entry
+		setup        func()
+		validateFunc func(*service.CreateResult, error)
+	}{
+		{
+			name: "when deploy succeeds",
+			entry: service.Entry{
+				Name:   "myapp",
+				Object: "myapp-init",
+			},
+			setup: func() {
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), file.DeployRequest{
+						ObjectName: "myapp-init",
+						Path:       alpineScriptPath,
+						Mode:       "0755",
+						Metadata:   map[string]string{"source": "custom"},
+					}).
+					Return(&file.DeployResult{
+						Changed: true,
+						Path:    alpineScriptPath,
+					}, nil)
+			},
+			validateFunc: func(
+				result *service.CreateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("myapp", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name: "when init script already managed returns unchanged",
+			entry: service.Entry{
+				Name:   "myapp",
+				Object: "myapp-init",
+			},
+			setup: func() {
+				suite.writeScript()
+			},
+			validateFunc: func(
+				result *service.CreateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("myapp", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name: "when deploy fails",
+			entry: service.Entry{
+				Name:   "myapp",
+				Object: "myapp-init",
+			},
+			setup: func() {
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("deploy error"))
+			},
+			validateFunc: func(
+				result *service.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "service: create")
+			},
+		},
+		{
+			name: "when name is invalid",
+			entry: service.Entry{
+				Name:   "",
+				Object: "myapp-init",
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *service.CreateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid service name")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Create(context.Background(), tc.entry)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *AlpineUnitPublicTestSuite) TestUpdate() {
+	tests := []struct {
+		name         string
+		entry        service.Entry
+		setup        func()
+		validateFunc func(*service.UpdateResult, error)
+	}{
+		{
+			name: "when deploy succeeds",
+			entry: service.Entry{
+				Name:   "myapp",
+				Object: "myapp-init-v2",
+			},
+			setup: func() {
+				suite.writeScript()
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), file.DeployRequest{
+						ObjectName: "myapp-init-v2",
+						Path:       alpineScriptPath,
+						Mode:       "0755",
+						Metadata:   map[string]string{"source": "custom"},
+					}).
+					Return(&file.DeployResult{Changed: true}, nil)
+			},
+			validateFunc: func(
+				result *service.UpdateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("myapp", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name: "when init script does not exist",
+			entry: service.Entry{
+				Name:   "nonexistent",
+				Object: "some-init",
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *service.UpdateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "not managed")
+			},
+		},
+		{
+			name: "when deploy fails",
+			entry: service.Entry{
+				Name:   "myapp",
+				Object: "myapp-init",
+			},
+			setup: func() {
+				suite.writeScript()
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("deploy error"))
+			},
+			validateFunc: func(
+				result *service.UpdateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "service: update")
+			},
+		},
+		{
+			name: "when name is invalid",
+			entry: service.Entry{
+				Name:   "bad name!",
+				Object: "some-init",
+			},
+			setup: func() {},
+			validateFunc: func(
+				result *service.UpdateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid service name")
+			},
+		},
+		{
+			name: "when object not specified preserves existing from state",
+			entry: service.Entry{
+				Name: "myapp",
+			},
+			setup: func() {
+				suite.writeScript()
+				mockEntry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+				mockEntry.EXPECT().
+					Value().
+					Return(managedStateJSON("original-init", alpineScriptPath)).
+					AnyTimes()
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(mockEntry, nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), file.DeployRequest{
+						ObjectName: "original-init",
+						Path:       alpineScriptPath,
+						Mode:       "0755",
+						Metadata:   map[string]string{"source": "custom"},
+					}).
+					Return(&file.DeployResult{Changed: false}, nil)
+			},
+			validateFunc: func(
+				result *service.UpdateResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("myapp", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name: "when object not specified and state lookup fails",
+			entry: service.Entry{
+				Name: "myapp",
+			},
+			setup: func() {
+				suite.writeScript()
+				suite.mockStateKV.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("kv error"))
+			},
+			validateFunc: func(
+				result *service.UpdateResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "failed to read existing state")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Update(context.Background(), tc.entry)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *AlpineUnitPublicTestSuite) TestDelete() {
+	tests := []struct {
+		name         string
+		entryName    string
+		setup        func()
+		validateFunc func(*service.DeleteResult, error)
+	}{
+		{
+			name:      "when undeploy succeeds with stop and runlevel removal",
+			entryName: "myapp",
+			setup: func() {
+				suite.writeScript()
+				suite.mockExecManager.EXPECT().
+					RunPrivilegedCmd("rc-service", []string{"osapi-myapp", "stop"}).
+					Return("", nil)
+				suite.mockExecManager.EXPECT().
+					RunPrivilegedCmd("rc-update", []string{"del", "osapi-myapp", "default"}).
+					Return("", nil)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{
+						Path: alpineScriptPath,
+					}).
+					Return(&file.UndeployResult{
+						Changed: true,
+						Path:    alpineScriptPath,
+					}, nil)
+			},
+			validateFunc: func(
+				result *service.DeleteResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("myapp", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:      "when init script not found returns unchanged",
+			entryName: "nonexistent",
+			setup:     func() {},
+			validateFunc: func(
+				result *service.DeleteResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Equal("nonexistent", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:      "when stop and runlevel removal fail continues with delete",
+			entryName: "myapp",
+			setup: func() {
+				suite.writeScript()
+				suite.mockExecManager.EXPECT().
+					RunPrivilegedCmd("rc-service", []string{"osapi-myapp", "stop"}).
+					Return("", errors.New("stop error"))
+				suite.mockExecManager.EXPECT().
+					RunPrivilegedCmd("rc-update", []string{"del", "osapi-myapp", "default"}).
+					Return("", errors.New("del error"))
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), gomock.Any()).
+					Return(&file.UndeployResult{Changed: true}, nil)
+			},
+			validateFunc: func(
+				result *service.DeleteResult,
+				err error,
+			) {
+				suite.NoError(err)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:      "when undeploy fails",
+			entryName: "myapp",
+			setup: func() {
+				suite.writeScript()
+				suite.mockExecManager.EXPECT().
+					RunPrivilegedCmd("rc-service", []string{"osapi-myapp", "stop"}).
+					Return("", nil)
+				suite.mockExecManager.EXPECT().
+					RunPrivilegedCmd("rc-update", []string{"del", "osapi-myapp", "default"}).
+					Return("", nil)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("undeploy error"))
+			},
+			validateFunc: func(
+				result *service.DeleteResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "service: delete")
+			},
+		},
+		{
+			name:      "when name is invalid",
+			entryName: "bad name!",
+			setup:     func() {},
+			validateFunc: func(
+				result *service.DeleteResult,
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid service name")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.Delete(context.Background(), tc.entryName)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *AlpineUnitPublicTestSuite) TestDropIns() {
+	tests := []struct {
+		name string
+		call func() (any, error)
+	}{
+		{
+			name: "when listing drop-ins returns unsupported",
+			call: func() (any, error) {
+				return suite.provider.ListDropIns(context.Background(), "nginx")
+			},
+		},
+		{
+			name: "when creating drop-in returns unsupported",
+			call: func() (any, error) {
+				return suite.provider.CreateDropIn(
+					context.Background(),
+					service.DropInEntry{Unit: "nginx"},
+				)
+			},
+		},
+		{
+			name: "when updating drop-in returns unsupported",
+			call: func() (any, error) {
+				return suite.provider.UpdateDropIn(
+					context.Background(),
+					service.DropInEntry{Unit: "nginx"},
+				)
+			},
+		},
+		{
+			name: "when deleting drop-in returns unsupported",
+			call: func() (any, error) {
+				return suite.provider.DeleteDropIn(
+					context.Background(),
+					service.DropInEntry{Unit: "nginx"},
+				)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			_, err := tc.call()
+
+			suite.ErrorIs(err, provider.ErrUnsupported)
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestAlpineUnitPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(AlpineUnitPublicTestSuite))
+}
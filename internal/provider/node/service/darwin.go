@@ -113,3 +113,35 @@ func (d *Darwin) Disable(
 ) (*ActionResult, error) {
 	return nil, provider.ErrUnsupported
 }
+
+// ListDropIns returns ErrUnsupported on Darwin.
+func (d *Darwin) ListDropIns(
+	_ context.Context,
+	_ string,
+) ([]DropIn, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// CreateDropIn returns ErrUnsupported on Darwin.
+func (d *Darwin) CreateDropIn(
+	_ context.Context,
+	_ DropInEntry,
+) (*DropInResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// UpdateDropIn returns ErrUnsupported on Darwin.
+func (d *Darwin) UpdateDropIn(
+	_ context.Context,
+	_ DropInEntry,
+) (*DropInResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// DeleteDropIn returns ErrUnsupported on Darwin.
+func (d *Darwin) DeleteDropIn(
+	_ context.Context,
+	_ DropInEntry,
+) (*DropInResult, error) {
+	return nil, provider.ErrUnsupported
+}
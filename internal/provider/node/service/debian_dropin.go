@@ -0,0 +1,404 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider/file"
+)
+
+const (
+	// defaultDropInName is the drop-in written when no name is given,
+	// matching the file systemctl edit creates.
+	defaultDropInName = "override"
+	dropInSuffix      = ".conf"
+)
+
+var validDropInName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// unitSuffixes are the systemd unit types a unit name may already carry.
+// Names without one of these suffixes are treated as services.
+var unitSuffixes = []string{
+	".service",
+	".socket",
+	".timer",
+	".path",
+	".mount",
+	".target",
+	".slice",
+	".scope",
+}
+
+// ListDropIns returns the drop-ins in /etc/systemd/system/<unit>.d/.
+// A missing directory yields an empty list; other read errors are
+// returned.
+// Drop-ins tracked in the file-state KV are reported as managed with
+// their object name and SHA-256; others are listed without them.
+func (d *Debian) ListDropIns(
+	ctx context.Context,
+	name string,
+) ([]DropIn, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	dropIns := []DropIn{}
+
+	dir := dropInDir(name)
+	dirEntries, err := d.fs.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return dropIns, nil
+		}
+
+		return nil, fmt.Errorf("service: list drop-ins: %w", err)
+	}
+
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || !strings.HasSuffix(dirEntry.Name(), dropInSuffix) {
+			continue
+		}
+
+		filePath := path.Join(dir, dirEntry.Name())
+		dropIn := DropIn{
+			Name: strings.TrimSuffix(dirEntry.Name(), dropInSuffix),
+			Path: filePath,
+		}
+
+		if state := d.dropInState(ctx, filePath); state != nil {
+			dropIn.Managed = true
+			dropIn.Object = state.ObjectName
+			dropIn.SHA256 = state.SHA256
+		}
+
+		dropIns = append(dropIns, dropIn)
+	}
+
+	return dropIns, nil
+}
+
+// CreateDropIn deploys a new drop-in for an existing unit. Idempotent:
+// returns Changed: false if the drop-in is already managed. A drop-in
+// that exists on disk but was not deployed by osapi is left untouched.
+func (d *Debian) CreateDropIn(
+	ctx context.Context,
+	entry DropInEntry,
+) (*DropInResult, error) {
+	entry, err := normalizeDropIn(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.Object == "" {
+		return nil, fmt.Errorf("drop-in %q: object is required", entry.Name)
+	}
+
+	filePath := dropInPath(entry.Unit, entry.Name)
+
+	if d.dropInState(ctx, filePath) != nil {
+		return &DropInResult{
+			Unit:    entry.Unit,
+			Name:    entry.Name,
+			Changed: false,
+		}, nil
+	}
+
+	if _, err := d.fs.Stat(filePath); err == nil {
+		return nil, fmt.Errorf(
+			"service: create drop-in: %s exists and is not managed by osapi",
+			filePath,
+		)
+	}
+
+	if err := d.checkUnitExists(entry.Unit); err != nil {
+		return nil, fmt.Errorf("service: create drop-in: %w", err)
+	}
+
+	d.logger.Debug(
+		"creating drop-in",
+		slog.String("unit", entry.Unit),
+		slog.String("name", entry.Name),
+	)
+
+	return d.deployDropIn(ctx, entry, filePath)
+}
+
+// UpdateDropIn redeploys a managed drop-in. When no object is given,
+// the current object and content type are kept so that only the vars
+// are re-rendered.
+func (d *Debian) UpdateDropIn(
+	ctx context.Context,
+	entry DropInEntry,
+) (*DropInResult, error) {
+	entry, err := normalizeDropIn(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	filePath := dropInPath(entry.Unit, entry.Name)
+
+	state := d.dropInState(ctx, filePath)
+	if state == nil {
+		return nil, fmt.Errorf(
+			"service: update drop-in: %q of %q not managed",
+			entry.Name,
+			entry.Unit,
+		)
+	}
+
+	if entry.Object == "" {
+		entry.Object = state.ObjectName
+		if entry.ContentType == "" {
+			entry.ContentType = state.ContentType
+		}
+	}
+
+	d.logger.Debug(
+		"updating drop-in",
+		slog.String("unit", entry.Unit),
+		slog.String("name", entry.Name),
+	)
+
+	return d.deployDropIn(ctx, entry, filePath)
+}
+
+// DeleteDropIn undeploys a managed drop-in and removes the drop-in
+// directory when it is left empty. Idempotent: returns Changed: false if
+// the drop-in is not managed.
+func (d *Debian) DeleteDropIn(
+	ctx context.Context,
+	entry DropInEntry,
+) (*DropInResult, error) {
+	entry, err := normalizeDropIn(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	filePath := dropInPath(entry.Unit, entry.Name)
+
+	if d.dropInState(ctx, filePath) == nil {
+		return &DropInResult{
+			Unit:    entry.Unit,
+			Name:    entry.Name,
+			Changed: false,
+		}, nil
+	}
+
+	d.logger.Debug(
+		"deleting drop-in",
+		slog.String("unit", entry.Unit),
+		slog.String("name", entry.Name),
+	)
+
+	result, err := d.fileDeployer.Undeploy(ctx, file.UndeployRequest{
+		Path: filePath,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("service: delete drop-in: %w", err)
+	}
+
+	if dirEntries, err := d.fs.ReadDir(dropInDir(entry.Unit)); err == nil &&
+		len(dirEntries) == 0 {
+		_ = d.fs.Remove(dropInDir(entry.Unit))
+	}
+
+	return d.applyDropIn(entry, result.Changed, "delete drop-in")
+}
+
+// deployDropIn writes a drop-in through the file provider and applies it.
+func (d *Debian) deployDropIn(
+	ctx context.Context,
+	entry DropInEntry,
+	filePath string,
+) (*DropInResult, error) {
+	result, err := d.fileDeployer.Deploy(ctx, file.DeployRequest{
+		ObjectName:  entry.Object,
+		Path:        filePath,
+		Mode:        "0644",
+		ContentType: entry.ContentType,
+		Vars:        entry.Vars,
+		Metadata: map[string]string{
+			"source": "dropin",
+			"unit":   entry.Unit,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("service: deploy drop-in: %w", err)
+	}
+
+	return d.applyDropIn(entry, result.Changed, "deploy drop-in")
+}
+
+// applyDropIn reloads systemd after a drop-in changed and, when
+// requested, restarts the unit if it is active. Restarted is only
+// reported when a restart was actually issued.
+func (d *Debian) applyDropIn(
+	entry DropInEntry,
+	changed bool,
+	action string,
+) (*DropInResult, error) {
+	result := &DropInResult{
+		Unit:    entry.Unit,
+		Name:    entry.Name,
+		Changed: changed,
+	}
+
+	if !changed {
+		return result, nil
+	}
+
+	if err := d.daemonReload(); err != nil {
+		return nil, fmt.Errorf("service: %s: %w", action, err)
+	}
+
+	if !entry.Restart {
+		return result, nil
+	}
+
+	output, _ := d.execManager.RunCmd("systemctl", []string{"is-active", entry.Unit})
+	if strings.TrimSpace(output) != "active" {
+		return result, nil
+	}
+
+	if _, err := d.execManager.RunPrivilegedCmd(
+		"systemctl",
+		[]string{"try-restart", entry.Unit},
+	); err != nil {
+		return nil, fmt.Errorf("service: %s: restart %s: %w", action, entry.Unit, err)
+	}
+	result.Restarted = true
+
+	return result, nil
+}
+
+// checkUnitExists returns an error when systemd has no unit by the
+// given name, so that a typo does not leave an orphaned drop-in.
+func (d *Debian) checkUnitExists(
+	unit string,
+) error {
+	output, err := d.execManager.RunCmd("systemctl", []string{
+		"show",
+		unit,
+		"--property=LoadState",
+		"--no-pager",
+	})
+	if err != nil {
+		return fmt.Errorf("unit %q: %w", unit, err)
+	}
+
+	props, err := parseProperties(output)
+	if err != nil {
+		return fmt.Errorf("unit %q: %w", unit, err)
+	}
+
+	if props["LoadState"] == "not-found" {
+		return fmt.Errorf("unit %q not found", unit)
+	}
+
+	return nil
+}
+
+// dropInState returns the file-state KV entry of a drop-in, or nil when
+// the drop-in is not tracked or has been undeployed.
+func (d *Debian) dropInState(
+	ctx context.Context,
+	filePath string,
+) *job.FileState {
+	kvEntry, err := d.stateKV.Get(ctx, file.BuildStateKey(d.hostname, filePath))
+	if err != nil {
+		return nil
+	}
+
+	var state job.FileState
+	if err := json.Unmarshal(kvEntry.Value(), &state); err != nil {
+		return nil
+	}
+
+	if state.UndeployedAt != "" {
+		return nil
+	}
+
+	return &state
+}
+
+// normalizeDropIn validates a drop-in entry, qualifies the unit name,
+// and applies the default drop-in name.
+func normalizeDropIn(
+	entry DropInEntry,
+) (DropInEntry, error) {
+	if err := validateName(entry.Unit); err != nil {
+		return entry, err
+	}
+
+	if entry.Name == "" {
+		entry.Name = defaultDropInName
+	}
+
+	if !validDropInName.MatchString(entry.Name) {
+		return entry, fmt.Errorf(
+			"invalid drop-in name %q: must match %s",
+			entry.Name,
+			validDropInName.String(),
+		)
+	}
+
+	entry.Unit = unitName(entry.Unit)
+
+	return entry, nil
+}
+
+// unitName returns the full unit name, defaulting to a service unit.
+func unitName(
+	name string,
+) string {
+	for _, suffix := range unitSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return name
+		}
+	}
+
+	return name + ".service"
+}
+
+// dropInDir returns the drop-in directory of a unit.
+func dropInDir(
+	name string,
+) string {
+	return unitDir + "/" + unitName(name) + ".d"
+}
+
+// dropInPath returns the file path of a drop-in.
+func dropInPath(
+	unit string,
+	name string,
+) string {
+	return dropInDir(unit) + "/" + name + dropInSuffix
+}
@@ -0,0 +1,931 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider/file"
+	filemocks "github.com/osapi-io/osapi/internal/provider/file/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/service"
+)
+
+const (
+	nginxDropInDir  = "/etc/systemd/system/nginx.service.d"
+	nginxDropInPath = "/etc/systemd/system/nginx.service.d/override.conf"
+)
+
+// dropInStateJSON returns a JSON-encoded FileState for a deployed drop-in.
+func dropInStateJSON(
+	objectName string,
+	contentType string,
+	path string,
+) []byte {
+	state := job.FileState{
+		ObjectName:  objectName,
+		Path:        path,
+		SHA256:      "def456",
+		DeployedAt:  "2026-01-01T00:00:00Z",
+		ContentType: contentType,
+		Metadata:    map[string]string{"source": "dropin", "unit": "nginx.service"},
+	}
+
+	b, _ := json.Marshal(state)
+
+	return b
+}
+
+type DebianDropInPublicTestSuite struct {
+	suite.Suite
+
+	ctrl            *gomock.Controller
+	logger          *slog.Logger
+	memFs           avfs.VFS
+	mockDeployer    *filemocks.MockDeployer
+	mockStateKV     *jobmocks.MockKeyValue
+	mockExecManager *execmocks.MockManager
+	provider        *service.Debian
+}
+
+func (suite *DebianDropInPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+	suite.mockDeployer = filemocks.NewMockDeployer(suite.ctrl)
+	suite.mockStateKV = jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExecManager = execmocks.NewMockManager(suite.ctrl)
+
+	_ = suite.memFs.MkdirAll("/etc/systemd/system", 0o755)
+
+	suite.provider = service.NewDebianProvider(
+		suite.logger,
+		suite.memFs,
+		suite.mockDeployer,
+		suite.mockStateKV,
+		suite.mockExecManager,
+		testHostname,
+	)
+}
+
+func (suite *DebianDropInPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+// expectStates serves file-state KV lookups from a path-to-state map.
+// Paths without an entry are reported as not tracked.
+func (suite *DebianDropInPublicTestSuite) expectStates(
+	states map[string][]byte,
+) {
+	suite.mockStateKV.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key string) (jetstream.KeyValueEntry, error) {
+			for path, data := range states {
+				if key == file.BuildStateKey(testHostname, path) {
+					mockEntry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+					mockEntry.EXPECT().Value().Return(data).AnyTimes()
+					return mockEntry, nil
+				}
+			}
+
+			return nil, jetstream.ErrKeyNotFound
+		}).
+		AnyTimes()
+}
+
+// expectLoadState expects the unit existence check for nginx.service.
+func (suite *DebianDropInPublicTestSuite) expectLoadState(
+	output string,
+	err error,
+) {
+	suite.mockExecManager.EXPECT().
+		RunCmd("systemctl", []string{
+			"show",
+			"nginx.service",
+			"--property=LoadState",
+			"--no-pager",
+		}).
+		Return(output, err)
+}
+
+// expectDeploy expects the default nginx drop-in to be deployed.
+func (suite *DebianDropInPublicTestSuite) expectDeploy(
+	req file.DeployRequest,
+	changed bool,
+	err error,
+) {
+	call := suite.mockDeployer.EXPECT().Deploy(gomock.Any(), req)
+	if err != nil {
+		call.Return(nil, err)
+		return
+	}
+	call.Return(&file.DeployResult{Changed: changed, Path: req.Path}, nil)
+}
+
+// expectSystemctl expects a privileged systemctl invocation.
+func (suite *DebianDropInPublicTestSuite) expectSystemctl(
+	err error,
+	args ...string,
+) {
+	suite.mockExecManager.EXPECT().
+		RunPrivilegedCmd("systemctl", args).
+		Return("", err)
+}
+
+// expectIsActive expects the active-state check for nginx.service.
+func (suite *DebianDropInPublicTestSuite) expectIsActive(
+	output string,
+) {
+	suite.mockExecManager.EXPECT().
+		RunCmd("systemctl", []string{"is-active", "nginx.service"}).
+		Return(output, nil)
+}
+
+func nginxDeployRequest(
+	objectName string,
+	contentType string,
+	vars map[string]any,
+) file.DeployRequest {
+	return file.DeployRequest{
+		ObjectName:  objectName,
+		Path:        nginxDropInPath,
+		Mode:        "0644",
+		ContentType: contentType,
+		Vars:        vars,
+		Metadata: map[string]string{
+			"source": "dropin",
+			"unit":   "nginx.service",
+		},
+	}
+}
+
+func (suite *DebianDropInPublicTestSuite) TestListDropIns() {
+	tests := []struct {
+		name         string
+		unit         string
+		setup        func()
+		validateFunc func([]service.DropIn, error)
+	}{
+		{
+			name: "when drop-ins exist returns managed and unmanaged",
+			unit: "nginx",
+			setup: func() {
+				_ = suite.memFs.MkdirAll(nginxDropInDir+"/nested", 0o755)
+				_ = suite.memFs.WriteFile(nginxDropInPath, []byte("[Service]\n"), 0o644)
+				_ = suite.memFs.WriteFile(
+					nginxDropInDir+"/10-limits.conf",
+					[]byte("[Service]\nLimitNOFILE=65536\n"),
+					0o644,
+				)
+				_ = suite.memFs.WriteFile(nginxDropInDir+"/README", []byte("x"), 0o644)
+				suite.expectStates(map[string][]byte{
+					nginxDropInPath: dropInStateJSON("nginx-override", "raw", nginxDropInPath),
+				})
+			},
+			validateFunc: func(dropIns []service.DropIn, err error) {
+				suite.NoError(err)
+				suite.Require().Len(dropIns, 2)
+				suite.Equal(service.DropIn{
+					Name: "10-limits",
+					Path: nginxDropInDir + "/10-limits.conf",
+				}, dropIns[0])
+				suite.Equal(service.DropIn{
+					Name:    "override",
+					Path:    nginxDropInPath,
+					Managed: true,
+					Object:  "nginx-override",
+					SHA256:  "def456",
+				}, dropIns[1])
+			},
+		},
+		{
+			name: "when unit has a type suffix uses it as is",
+			unit: "docker.socket",
+			setup: func() {
+				_ = suite.memFs.MkdirAll("/etc/systemd/system/docker.socket.d", 0o755)
+				_ = suite.memFs.WriteFile(
+					"/etc/systemd/system/docker.socket.d/override.conf",
+					[]byte("[Socket]\n"),
+					0o644,
+				)
+				suite.expectStates(nil)
+			},
+			validateFunc: func(dropIns []service.DropIn, err error) {
+				suite.NoError(err)
+				suite.Require().Len(dropIns, 1)
+				suite.Equal(
+					"/etc/systemd/system/docker.socket.d/override.conf",
+					dropIns[0].Path,
+				)
+				suite.False(dropIns[0].Managed)
+			},
+		},
+		{
+			name: "when state is undeployed reports unmanaged",
+			unit: "nginx",
+			setup: func() {
+				_ = suite.memFs.MkdirAll(nginxDropInDir, 0o755)
+				_ = suite.memFs.WriteFile(nginxDropInPath, []byte("[Service]\n"), 0o644)
+				state := job.FileState{
+					ObjectName:   "nginx-override",
+					Path:         nginxDropInPath,
+					UndeployedAt: "2026-01-02T00:00:00Z",
+				}
+				data, _ := json.Marshal(state)
+				suite.expectStates(map[string][]byte{nginxDropInPath: data})
+			},
+			validateFunc: func(dropIns []service.DropIn, err error) {
+				suite.NoError(err)
+				suite.Require().Len(dropIns, 1)
+				suite.False(dropIns[0].Managed)
+				suite.Empty(dropIns[0].Object)
+			},
+		},
+		{
+			name: "when state is not valid JSON reports unmanaged",
+			unit: "nginx",
+			setup: func() {
+				_ = suite.memFs.MkdirAll(nginxDropInDir, 0o755)
+				_ = suite.memFs.WriteFile(nginxDropInPath, []byte("[Service]\n"), 0o644)
+				suite.expectStates(map[string][]byte{nginxDropInPath: []byte("not-json")})
+			},
+			validateFunc: func(dropIns []service.DropIn, err error) {
+				suite.NoError(err)
+				suite.Require().Len(dropIns, 1)
+				suite.False(dropIns[0].Managed)
+			},
+		},
+		{
+			name:  "when drop-in directory does not exist returns empty",
+			unit:  "nginx",
+			setup: func() {},
+			validateFunc: func(dropIns []service.DropIn, err error) {
+				suite.NoError(err)
+				suite.NotNil(dropIns)
+				suite.Empty(dropIns)
+			},
+		},
+		{
+			name: "when drop-in directory cannot be read returns error",
+			unit: "nginx",
+			setup: func() {
+				_ = suite.memFs.WriteFile(nginxDropInDir, []byte("x"), 0o644)
+			},
+			validateFunc: func(dropIns []service.DropIn, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "service: list drop-ins")
+				suite.Nil(dropIns)
+			},
+		},
+		{
+			name:  "when unit name is invalid returns error",
+			unit:  "nginx/../etc",
+			setup: func() {},
+			validateFunc: func(dropIns []service.DropIn, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid service name")
+				suite.Nil(dropIns)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			dropIns, err := suite.provider.ListDropIns(context.Background(), tc.unit)
+
+			tc.validateFunc(dropIns, err)
+		})
+	}
+}
+
+func (suite *DebianDropInPublicTestSuite) TestCreateDropIn() {
+	tests := []struct {
+		name         string
+		entry        service.DropInEntry
+		setup        func()
+		validateFunc func(*service.DropInResult, error)
+	}{
+		{
+			name: "when deploy changes the drop-in reloads systemd",
+			entry: service.DropInEntry{
+				Unit:   "nginx",
+				Object: "nginx-override",
+			},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectLoadState("LoadState=loaded\n", nil)
+				suite.expectDeploy(nginxDeployRequest("nginx-override", "", nil), true, nil)
+				suite.expectSystemctl(nil, "daemon-reload")
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.NoError(err)
+				suite.Equal(&service.DropInResult{
+					Unit:    "nginx.service",
+					Name:    "override",
+					Changed: true,
+				}, result)
+			},
+		},
+		{
+			name: "when restart is requested restarts the running unit",
+			entry: service.DropInEntry{
+				Unit:        "nginx.service",
+				Name:        "override",
+				Object:      "nginx-override",
+				ContentType: "template",
+				Vars:        map[string]any{"limit": 65536},
+				Restart:     true,
+			},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectLoadState("LoadState=loaded\n", nil)
+				suite.expectDeploy(
+					nginxDeployRequest(
+						"nginx-override",
+						"template",
+						map[string]any{"limit": 65536},
+					),
+					true,
+					nil,
+				)
+				suite.expectSystemctl(nil, "daemon-reload")
+				suite.expectIsActive("active\n")
+				suite.expectSystemctl(nil, "try-restart", "nginx.service")
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.NoError(err)
+				suite.True(result.Changed)
+				suite.True(result.Restarted)
+			},
+		},
+		{
+			name: "when restart is requested but the unit is inactive skips restart",
+			entry: service.DropInEntry{
+				Unit:    "nginx",
+				Object:  "nginx-override",
+				Restart: true,
+			},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectLoadState("LoadState=loaded\n", nil)
+				suite.expectDeploy(nginxDeployRequest("nginx-override", "", nil), true, nil)
+				suite.expectSystemctl(nil, "daemon-reload")
+				suite.expectIsActive("inactive\n")
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.NoError(err)
+				suite.True(result.Changed)
+				suite.False(result.Restarted)
+			},
+		},
+		{
+			name: "when deploy leaves the drop-in unchanged skips reload and restart",
+			entry: service.DropInEntry{
+				Unit:    "nginx",
+				Object:  "nginx-override",
+				Restart: true,
+			},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectLoadState("LoadState=loaded\n", nil)
+				suite.expectDeploy(nginxDeployRequest("nginx-override", "", nil), false, nil)
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.NoError(err)
+				suite.False(result.Changed)
+				suite.False(result.Restarted)
+			},
+		},
+		{
+			name: "when drop-in already managed returns unchanged",
+			entry: service.DropInEntry{
+				Unit:   "nginx",
+				Object: "nginx-override",
+			},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					nginxDropInPath: dropInStateJSON("nginx-override", "raw", nginxDropInPath),
+				})
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.NoError(err)
+				suite.Equal(&service.DropInResult{
+					Unit:    "nginx.service",
+					Name:    "override",
+					Changed: false,
+				}, result)
+			},
+		},
+		{
+			name: "when unmanaged drop-in exists returns error",
+			entry: service.DropInEntry{
+				Unit:   "nginx",
+				Object: "nginx-override",
+			},
+			setup: func() {
+				_ = suite.memFs.MkdirAll(nginxDropInDir, 0o755)
+				_ = suite.memFs.WriteFile(nginxDropInPath, []byte("[Service]\n"), 0o644)
+				suite.expectStates(nil)
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "is not managed by osapi")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when unit does not exist returns error",
+			entry: service.DropInEntry{
+				Unit:   "nginx",
+				Object: "nginx-override",
+			},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectLoadState("LoadState=not-found\n", nil)
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), `unit "nginx.service" not found`)
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when systemctl show fails returns error",
+			entry: service.DropInEntry{
+				Unit:   "nginx",
+				Object: "nginx-override",
+			},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectLoadState("", errors.New("systemctl failed"))
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "systemctl failed")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when systemctl show output is malformed returns error",
+			entry: service.DropInEntry{
+				Unit:   "nginx",
+				Object: "nginx-override",
+			},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectLoadState("garbage\n", nil)
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "malformed property line")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when deploy fails returns error",
+			entry: service.DropInEntry{
+				Unit:   "nginx",
+				Object: "nginx-override",
+			},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectLoadState("LoadState=loaded\n", nil)
+				suite.expectDeploy(
+					nginxDeployRequest("nginx-override", "", nil),
+					false,
+					errors.New("object not found"),
+				)
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "service: deploy drop-in: object not found")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when daemon-reload fails returns error",
+			entry: service.DropInEntry{
+				Unit:   "nginx",
+				Object: "nginx-override",
+			},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectLoadState("LoadState=loaded\n", nil)
+				suite.expectDeploy(nginxDeployRequest("nginx-override", "", nil), true, nil)
+				suite.expectSystemctl(errors.New("reload failed"), "daemon-reload")
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "daemon-reload: reload failed")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when restart fails returns error",
+			entry: service.DropInEntry{
+				Unit:    "nginx",
+				Object:  "nginx-override",
+				Restart: true,
+			},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectLoadState("LoadState=loaded\n", nil)
+				suite.expectDeploy(nginxDeployRequest("nginx-override", "", nil), true, nil)
+				suite.expectSystemctl(nil, "daemon-reload")
+				suite.expectIsActive("active\n")
+				suite.expectSystemctl(
+					errors.New("job failed"),
+					"try-restart",
+					"nginx.service",
+				)
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "restart nginx.service: job failed")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when object is missing returns error",
+			entry: service.DropInEntry{
+				Unit: "nginx",
+			},
+			setup: func() {},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "object is required")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when unit name is invalid returns error",
+			entry: service.DropInEntry{
+				Unit:   "",
+				Object: "nginx-override",
+			},
+			setup: func() {},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid service name")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when drop-in name is invalid returns error",
+			entry: service.DropInEntry{
+				Unit:   "nginx",
+				Name:   "../override",
+				Object: "nginx-override",
+			},
+			setup: func() {},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid drop-in name")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.CreateDropIn(context.Background(), tc.entry)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianDropInPublicTestSuite) TestUpdateDropIn() {
+	tests := []struct {
+		name         string
+		entry        service.DropInEntry
+		setup        func()
+		validateFunc func(*service.DropInResult, error)
+	}{
+		{
+			name: "when new object is given redeploys and restarts",
+			entry: service.DropInEntry{
+				Unit:    "nginx",
+				Object:  "nginx-override-v2",
+				Restart: true,
+			},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					nginxDropInPath: dropInStateJSON("nginx-override", "raw", nginxDropInPath),
+				})
+				suite.expectDeploy(nginxDeployRequest("nginx-override-v2", "", nil), true, nil)
+				suite.expectSystemctl(nil, "daemon-reload")
+				suite.expectIsActive("active\n")
+				suite.expectSystemctl(nil, "try-restart", "nginx.service")
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.NoError(err)
+				suite.Equal(&service.DropInResult{
+					Unit:      "nginx.service",
+					Name:      "override",
+					Changed:   true,
+					Restarted: true,
+				}, result)
+			},
+		},
+		{
+			name: "when no object is given keeps object and content type",
+			entry: service.DropInEntry{
+				Unit: "nginx",
+				Vars: map[string]any{"limit": 1024},
+			},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					nginxDropInPath: dropInStateJSON(
+						"nginx-override",
+						"template",
+						nginxDropInPath,
+					),
+				})
+				suite.expectDeploy(
+					nginxDeployRequest(
+						"nginx-override",
+						"template",
+						map[string]any{"limit": 1024},
+					),
+					true,
+					nil,
+				)
+				suite.expectSystemctl(nil, "daemon-reload")
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.NoError(err)
+				suite.True(result.Changed)
+				suite.False(result.Restarted)
+			},
+		},
+		{
+			name: "when content is unchanged skips reload",
+			entry: service.DropInEntry{
+				Unit:    "nginx",
+				Object:  "nginx-override",
+				Restart: true,
+			},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					nginxDropInPath: dropInStateJSON("nginx-override", "raw", nginxDropInPath),
+				})
+				suite.expectDeploy(nginxDeployRequest("nginx-override", "", nil), false, nil)
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.NoError(err)
+				suite.False(result.Changed)
+				suite.False(result.Restarted)
+			},
+		},
+		{
+			name: "when drop-in is not managed returns error",
+			entry: service.DropInEntry{
+				Unit:   "nginx",
+				Object: "nginx-override",
+			},
+			setup: func() {
+				suite.expectStates(nil)
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), `"override" of "nginx.service" not managed`)
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when deploy fails returns error",
+			entry: service.DropInEntry{
+				Unit:   "nginx",
+				Object: "nginx-override",
+			},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					nginxDropInPath: dropInStateJSON("nginx-override", "raw", nginxDropInPath),
+				})
+				suite.expectDeploy(
+					nginxDeployRequest("nginx-override", "", nil),
+					false,
+					errors.New("deploy failed"),
+				)
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "deploy failed")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when drop-in name is invalid returns error",
+			entry: service.DropInEntry{
+				Unit: "nginx",
+				Name: "bad name",
+			},
+			setup: func() {},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid drop-in name")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.UpdateDropIn(context.Background(), tc.entry)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+func (suite *DebianDropInPublicTestSuite) TestDeleteDropIn() {
+	tests := []struct {
+		name         string
+		entry        service.DropInEntry
+		setup        func()
+		validateFunc func(*service.DropInResult, error)
+	}{
+		{
+			name: "when drop-in is managed undeploys and removes empty directory",
+			entry: service.DropInEntry{
+				Unit:    "nginx",
+				Restart: true,
+			},
+			setup: func() {
+				_ = suite.memFs.MkdirAll(nginxDropInDir, 0o755)
+				suite.expectStates(map[string][]byte{
+					nginxDropInPath: dropInStateJSON("nginx-override", "raw", nginxDropInPath),
+				})
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: nginxDropInPath}).
+					Return(&file.UndeployResult{Changed: true}, nil)
+				suite.expectSystemctl(nil, "daemon-reload")
+				suite.expectIsActive("active\n")
+				suite.expectSystemctl(nil, "try-restart", "nginx.service")
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.NoError(err)
+				suite.Equal(&service.DropInResult{
+					Unit:      "nginx.service",
+					Name:      "override",
+					Changed:   true,
+					Restarted: true,
+				}, result)
+
+				_, statErr := suite.memFs.Stat(nginxDropInDir)
+				suite.True(errors.Is(statErr, os.ErrNotExist))
+			},
+		},
+		{
+			name: "when other drop-ins remain keeps directory",
+			entry: service.DropInEntry{
+				Unit: "nginx",
+			},
+			setup: func() {
+				_ = suite.memFs.MkdirAll(nginxDropInDir, 0o755)
+				_ = suite.memFs.WriteFile(
+					nginxDropInDir+"/10-limits.conf",
+					[]byte("[Service]\n"),
+					0o644,
+				)
+				suite.expectStates(map[string][]byte{
+					nginxDropInPath: dropInStateJSON("nginx-override", "raw", nginxDropInPath),
+				})
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: nginxDropInPath}).
+					Return(&file.UndeployResult{Changed: true}, nil)
+				suite.expectSystemctl(nil, "daemon-reload")
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.NoError(err)
+				suite.True(result.Changed)
+				suite.False(result.Restarted)
+
+				_, statErr := suite.memFs.Stat(nginxDropInDir)
+				suite.NoError(statErr)
+			},
+		},
+		{
+			name: "when drop-in is not managed returns unchanged",
+			entry: service.DropInEntry{
+				Unit: "nginx",
+			},
+			setup: func() {
+				suite.expectStates(nil)
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.NoError(err)
+				suite.Equal(&service.DropInResult{
+					Unit:    "nginx.service",
+					Name:    "override",
+					Changed: false,
+				}, result)
+			},
+		},
+		{
+			name: "when undeploy fails returns error",
+			entry: service.DropInEntry{
+				Unit: "nginx",
+			},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					nginxDropInPath: dropInStateJSON("nginx-override", "raw", nginxDropInPath),
+				})
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: nginxDropInPath}).
+					Return(nil, errors.New("undeploy failed"))
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "service: delete drop-in: undeploy failed")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when daemon-reload fails returns error",
+			entry: service.DropInEntry{
+				Unit: "nginx",
+			},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					nginxDropInPath: dropInStateJSON("nginx-override", "raw", nginxDropInPath),
+				})
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: nginxDropInPath}).
+					Return(&file.UndeployResult{Changed: true}, nil)
+				suite.expectSystemctl(errors.New("reload failed"), "daemon-reload")
+			},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "service: delete drop-in: daemon-reload")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when unit name is invalid returns error",
+			entry: service.DropInEntry{
+				Unit: "nginx service",
+			},
+			setup: func() {},
+			validateFunc: func(result *service.DropInResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid service name")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			result, err := suite.provider.DeleteDropIn(context.Background(), tc.entry)
+
+			tc.validateFunc(result, err)
+		})
+	}
+}
+
+// In order for `go test` to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestDebianDropInPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(DebianDropInPublicTestSuite))
+}
@@ -22,11 +22,9 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log/slog"
 
-	"github.com/osapi-io/osapi/internal/job"
 	"github.com/osapi-io/osapi/internal/provider/file"
 )
 
@@ -97,7 +95,7 @@ func (d *Debian) Update(
 
 	// If no new object was specified, preserve the current one.
 	if entry.Object == "" {
-		existing := d.buildEntryFromState(ctx, entry.Name, filePath)
+		existing := buildEntryFromState(ctx, d.stateKV, d.hostname, entry.Name, filePath)
 		if existing == nil {
 			return nil, fmt.Errorf(
 				"service: update: failed to read existing state for %q",
@@ -211,27 +209,3 @@ func (d *Debian) daemonReload() error {
 
 	return nil
 }
-
-// buildEntryFromState creates an Entry from file-state KV metadata.
-func (d *Debian) buildEntryFromState(
-	ctx context.Context,
-	name string,
-	path string,
-) *Entry {
-	stateKey := file.BuildStateKey(d.hostname, path)
-
-	kvEntry, err := d.stateKV.Get(ctx, stateKey)
-	if err != nil {
-		return nil
-	}
-
-	var state job.FileState
-	if err := json.Unmarshal(kvEntry.Value(), &state); err != nil {
-		return nil
-	}
-
-	return &Entry{
-		Name:   name,
-		Object: state.ObjectName,
-	}
-}
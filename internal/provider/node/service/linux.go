@@ -113,3 +113,35 @@ func (l *Linux) Disable(
 ) (*ActionResult, error) {
 	return nil, provider.ErrUnsupported
 }
+
+// ListDropIns returns ErrUnsupported on generic Linux.
+func (l *Linux) ListDropIns(
+	_ context.Context,
+	_ string,
+) ([]DropIn, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// CreateDropIn returns ErrUnsupported on generic Linux.
+func (l *Linux) CreateDropIn(
+	_ context.Context,
+	_ DropInEntry,
+) (*DropInResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// UpdateDropIn returns ErrUnsupported on generic Linux.
+func (l *Linux) UpdateDropIn(
+	_ context.Context,
+	_ DropInEntry,
+) (*DropInResult, error) {
+	return nil, provider.ErrUnsupported
+}
+
+// DeleteDropIn returns ErrUnsupported on generic Linux.
+func (l *Linux) DeleteDropIn(
+	_ context.Context,
+	_ DropInEntry,
+) (*DropInResult, error) {
+	return nil, provider.ErrUnsupported
+}
@@ -56,6 +56,21 @@ func (mr *MockProviderMockRecorder) Create(ctx, entry any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProvider)(nil).Create), ctx, entry)
 }
 
+// CreateDropIn mocks base method.
+func (m *MockProvider) CreateDropIn(ctx context.Context, entry service.DropInEntry) (*service.DropInResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDropIn", ctx, entry)
+	ret0, _ := ret[0].(*service.DropInResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDropIn indicates an expected call of CreateDropIn.
+func (mr *MockProviderMockRecorder) CreateDropIn(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDropIn", reflect.TypeOf((*MockProvider)(nil).CreateDropIn), ctx, entry)
+}
+
 // Delete mocks base method.
 func (m *MockProvider) Delete(ctx context.Context, name string) (*service.DeleteResult, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +86,21 @@ func (mr *MockProviderMockRecorder) Delete(ctx, name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProvider)(nil).Delete), ctx, name)
 }
 
+// DeleteDropIn mocks base method.
+func (m *MockProvider) DeleteDropIn(ctx context.Context, entry service.DropInEntry) (*service.DropInResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDropIn", ctx, entry)
+	ret0, _ := ret[0].(*service.DropInResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDropIn indicates an expected call of DeleteDropIn.
+func (mr *MockProviderMockRecorder) DeleteDropIn(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDropIn", reflect.TypeOf((*MockProvider)(nil).DeleteDropIn), ctx, entry)
+}
+
 // Disable mocks base method.
 func (m *MockProvider) Disable(ctx context.Context, name string) (*service.ActionResult, error) {
 	m.ctrl.T.Helper()
@@ -131,6 +161,21 @@ func (mr *MockProviderMockRecorder) List(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProvider)(nil).List), ctx)
 }
 
+// ListDropIns mocks base method.
+func (m *MockProvider) ListDropIns(ctx context.Context, name string) ([]service.DropIn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDropIns", ctx, name)
+	ret0, _ := ret[0].([]service.DropIn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDropIns indicates an expected call of ListDropIns.
+func (mr *MockProviderMockRecorder) ListDropIns(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDropIns", reflect.TypeOf((*MockProvider)(nil).ListDropIns), ctx, name)
+}
+
 // Restart mocks base method.
 func (m *MockProvider) Restart(ctx context.Context, name string) (*service.ActionResult, error) {
 	m.ctrl.T.Helper()
@@ -190,3 +235,18 @@ func (mr *MockProviderMockRecorder) Update(ctx, entry any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProvider)(nil).Update), ctx, entry)
 }
+
+// UpdateDropIn mocks base method.
+func (m *MockProvider) UpdateDropIn(ctx context.Context, entry service.DropInEntry) (*service.DropInResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDropIn", ctx, entry)
+	ret0, _ := ret[0].(*service.DropInResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDropIn indicates an expected call of UpdateDropIn.
+func (mr *MockProviderMockRecorder) UpdateDropIn(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDropIn", reflect.TypeOf((*MockProvider)(nil).UpdateDropIn), ctx, entry)
+}
@@ -18,10 +18,12 @@
 // FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
 // DEALINGS IN THE SOFTWARE.
 
-// Package service provides systemd service management operations.
-// Supports listing, inspecting, and controlling systemd services, and managing
-// custom unit files in /etc/systemd/system/. Delegates unit file writes to the
-// file provider for SHA tracking, idempotency, and template rendering.
+// Package service provides systemd and OpenRC service management operations.
+// Supports listing, inspecting, and controlling services, and managing custom
+// unit files in /etc/systemd/system/ (or init scripts in /etc/init.d/ on
+// OpenRC) and drop-in overrides for existing systemd units. Delegates file
+// writes to the file provider for SHA tracking, idempotency, and template
+// rendering.
 package service
 
 import "context"
@@ -48,6 +50,14 @@ type Provider interface {
 	Enable(ctx context.Context, name string) (*ActionResult, error)
 	// Disable disables a systemd service.
 	Disable(ctx context.Context, name string) (*ActionResult, error)
+	// ListDropIns returns the drop-in overrides of a unit.
+	ListDropIns(ctx context.Context, name string) ([]DropIn, error)
+	// CreateDropIn deploys a new drop-in override for a unit.
+	CreateDropIn(ctx context.Context, entry DropInEntry) (*DropInResult, error)
+	// UpdateDropIn redeploys an existing drop-in override.
+	UpdateDropIn(ctx context.Context, entry DropInEntry) (*DropInResult, error)
+	// DeleteDropIn undeploys a drop-in override.
+	DeleteDropIn(ctx context.Context, entry DropInEntry) (*DropInResult, error)
 }
 
 // Info represents a systemd service.
@@ -91,3 +101,36 @@ type ActionResult struct {
 	Name    string `json:"name"`
 	Changed bool   `json:"changed"`
 }
+
+// DropIn represents a drop-in override file of a unit in
+// /etc/systemd/system/<unit>.d/. Managed drop-ins were deployed by osapi
+// and carry their object name and SHA-256 from the file-state KV.
+type DropIn struct {
+	Name    string `json:"name"`
+	Path    string `json:"path"`
+	Managed bool   `json:"managed"`
+	Object  string `json:"object,omitempty"`
+	SHA256  string `json:"sha256,omitempty"`
+}
+
+// DropInEntry represents a drop-in deployment request. Unit is the unit
+// being overridden and Name the drop-in file name without the .conf
+// suffix (defaults to "override"). When Restart is set, the unit is
+// restarted if it is running and the drop-in changed.
+type DropInEntry struct {
+	Unit        string         `json:"unit"`
+	Name        string         `json:"name,omitempty"`
+	Object      string         `json:"object,omitempty"`
+	ContentType string         `json:"content_type,omitempty"`
+	Vars        map[string]any `json:"vars,omitempty"`
+	Restart     bool           `json:"restart,omitempty"`
+}
+
+// DropInResult represents the outcome of a drop-in mutation.
+type DropInResult struct {
+	Unit      string `json:"unit"`
+	Name      string `json:"name"`
+	Changed   bool   `json:"changed"`
+	Restarted bool   `json:"restarted"`
+	Error     string `json:"error,omitempty"`
+}
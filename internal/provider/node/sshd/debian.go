@@ -0,0 +1,879 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package sshd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/avfs/avfs"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/osapi-io/osapi/internal/exec"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/file"
+)
+
+const (
+	// sshdDir is the directory relative Include paths are resolved against.
+	sshdDir = "/etc/ssh"
+	// sshdConfigPath is the main configuration file of the daemon.
+	sshdConfigPath = "/etc/ssh/sshd_config"
+	// sshdConfigDir holds the drop-ins read by the stock Debian
+	// `Include /etc/ssh/sshd_config.d/*.conf` line.
+	sshdConfigDir = "/etc/ssh/sshd_config.d"
+	// dropInSuffix is the extension sshd's Include glob matches.
+	dropInSuffix = ".conf"
+	// dropInGlob is the Include pattern that loads the drop-ins.
+	dropInGlob = sshdConfigDir + "/*" + dropInSuffix
+	// stagingPrefix marks candidate drop-ins. Candidates do not end in
+	// dropInSuffix, so the Include glob never loads them.
+	stagingPrefix = ".osapi-"
+	// stagingSuffix is the extension of candidate drop-ins.
+	stagingSuffix = ".candidate"
+	// candidateConfigPath is the main configuration rewritten to load the
+	// candidate drop-in, checked with `sshd -t -f`.
+	candidateConfigPath = "/etc/ssh/.osapi-sshd_config.candidate"
+	// sshdTemplate is the system template rendered into each drop-in.
+	sshdTemplate = "osapi/sshd.tmpl"
+	// sshdMode is the mode drop-ins are written with.
+	sshdMode = "0644"
+	// sshdService is the systemd unit of the OpenSSH server on Debian.
+	sshdService = "ssh"
+)
+
+var (
+	validName    = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	validKeyword = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
+	// blockedKeywords change how the lines that follow them are read, so
+	// they cannot be expressed as a flat list of directives.
+	blockedKeywords = map[string]bool{
+		"match":   true,
+		"include": true,
+	}
+)
+
+// Compile-time checks.
+var (
+	_ Provider             = (*Debian)(nil)
+	_ provider.FactsSetter = (*Debian)(nil)
+)
+
+// Debian implements the Provider interface for Debian-family systems.
+// Each drop-in is deployed through the file provider to a staging file in
+// /etc/ssh/sshd_config.d that the Include glob does not match. The
+// configuration sshd would load with the candidate in place is checked
+// with `sshd -t -f`, and only then is the candidate renamed over the live
+// drop-in and the daemon reloaded. The previous content of the drop-in
+// and its file state are snapshotted before the rename and restored when
+// the reload fails. A drop-in is managed when it is recorded in the
+// file-state KV; its directives are kept in the state metadata.
+type Debian struct {
+	provider.FactsAware
+	logger       *slog.Logger
+	fs           avfs.VFS
+	fileDeployer file.Deployer
+	stateKV      jetstream.KeyValue
+	execManager  exec.Manager
+	hostname     string
+}
+
+// snapshot holds what a drop-in looked like before a change so the change
+// can be rolled back.
+type snapshot struct {
+	// content is the file content, or nil when the file did not exist.
+	content []byte
+	// state is the raw file-state entry, or nil when none was recorded.
+	state []byte
+}
+
+// NewDebianProvider factory to create a new Debian instance.
+func NewDebianProvider(
+	logger *slog.Logger,
+	fs avfs.VFS,
+	fileDeployer file.Deployer,
+	stateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+) *Debian {
+	return &Debian{
+		logger:       logger.With(slog.String("subsystem", "provider.sshd")),
+		fs:           fs,
+		fileDeployer: fileDeployer,
+		stateKV:      stateKV,
+		execManager:  execManager,
+		hostname:     hostname,
+	}
+}
+
+// Get returns the effective configuration from `sshd -T` and the
+// osapi-managed drop-ins. Drop-ins written by hand are not reported, but
+// their settings are part of the effective configuration.
+func (d *Debian) Get(
+	ctx context.Context,
+) (*Config, error) {
+	output, err := d.execManager.RunPrivilegedCmd("sshd", []string{"-T"})
+	if err != nil {
+		return nil, fmt.Errorf(
+			"sshd: get: sshd -T failed: %s: %w",
+			strings.TrimSpace(output),
+			err,
+		)
+	}
+
+	dropIns, err := d.listDropIns(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("sshd: get: %w", err)
+	}
+
+	return &Config{
+		Effective: parseEffective(output),
+		DropIns:   dropIns,
+	}, nil
+}
+
+// Create validates and activates a new drop-in. Idempotent: returns
+// Changed: false if the drop-in is already managed. A drop-in written by
+// hand is never overwritten.
+func (d *Debian) Create(
+	ctx context.Context,
+	dropIn DropIn,
+) (*Result, error) {
+	if err := validateDropIn(dropIn); err != nil {
+		return nil, err
+	}
+
+	path := dropInPath(dropIn.Name)
+
+	// Already managed — nothing to do.
+	if d.fileState(ctx, path) != nil {
+		return &Result{
+			Name:    dropIn.Name,
+			Changed: false,
+		}, nil
+	}
+
+	if _, err := d.fs.Stat(path); err == nil {
+		return nil, fmt.Errorf(
+			"sshd: create %q: exists and is not managed by osapi",
+			dropIn.Name,
+		)
+	}
+
+	changed, err := d.apply(ctx, dropIn)
+	if err != nil {
+		return nil, fmt.Errorf("sshd: create %q: %w", dropIn.Name, err)
+	}
+
+	return &Result{
+		Name:    dropIn.Name,
+		Changed: changed,
+	}, nil
+}
+
+// Update validates and replaces the directives of a managed drop-in. The
+// previous drop-in is restored when the new configuration fails
+// validation or the reload.
+func (d *Debian) Update(
+	ctx context.Context,
+	dropIn DropIn,
+) (*Result, error) {
+	if err := validateDropIn(dropIn); err != nil {
+		return nil, err
+	}
+
+	if d.fileState(ctx, dropInPath(dropIn.Name)) == nil {
+		return nil, fmt.Errorf("sshd: update %q: not found", dropIn.Name)
+	}
+
+	changed, err := d.apply(ctx, dropIn)
+	if err != nil {
+		return nil, fmt.Errorf("sshd: update %q: %w", dropIn.Name, err)
+	}
+
+	return &Result{
+		Name:    dropIn.Name,
+		Changed: changed,
+	}, nil
+}
+
+// Delete checks the configuration without the drop-in, undeploys it and
+// reloads the daemon. The drop-in is left in place when the remaining
+// configuration fails validation and restored when the reload fails.
+// Idempotent: returns Changed: false if the drop-in is not managed.
+func (d *Debian) Delete(
+	ctx context.Context,
+	name string,
+) (*Result, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	path := dropInPath(name)
+	if d.fileState(ctx, path) == nil {
+		return &Result{
+			Name:    name,
+			Changed: false,
+		}, nil
+	}
+
+	if err := d.validate(path, ""); err != nil {
+		return nil, fmt.Errorf("sshd: delete %q: %w", name, err)
+	}
+
+	snap, err := d.snapshot(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("sshd: delete %q: %w", name, err)
+	}
+
+	result, err := d.fileDeployer.Undeploy(ctx, file.UndeployRequest{
+		Path: path,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sshd: delete %q: %w", name, err)
+	}
+
+	if result.Changed {
+		if err := d.activate(ctx, path, snap); err != nil {
+			return nil, fmt.Errorf("sshd: delete %q: %w", name, err)
+		}
+	}
+
+	d.logger.Info(
+		"sshd drop-in removed",
+		slog.String("name", name),
+		slog.Bool("changed", result.Changed),
+	)
+
+	return &Result{
+		Name:    name,
+		Changed: result.Changed,
+	}, nil
+}
+
+// apply renders the directives of a drop-in into its staging file, checks
+// the configuration with the candidate in place of the live drop-in, and
+// renames the candidate over the live drop-in before reloading. A
+// candidate that fails the check is removed and the live drop-in is left
+// as is. Returns false without validating or reloading when the candidate
+// matches the live drop-in.
+func (d *Debian) apply(
+	ctx context.Context,
+	dropIn DropIn,
+) (bool, error) {
+	path := dropInPath(dropIn.Name)
+	staging := stagingPath(dropIn.Name)
+
+	lines := make([]string, 0, len(dropIn.Directives))
+	for _, directive := range dropIn.Directives {
+		lines = append(lines, directive.Keyword+" "+directive.Value)
+	}
+
+	directives, err := json.Marshal(dropIn.Directives)
+	if err != nil {
+		return false, fmt.Errorf("marshal directives: %w", err)
+	}
+
+	result, err := d.fileDeployer.Deploy(ctx, file.DeployRequest{
+		ObjectName:  sshdTemplate,
+		Path:        staging,
+		Mode:        sshdMode,
+		ContentType: "template",
+		Vars:        map[string]any{"directives": lines},
+		Metadata:    map[string]string{"directives": string(directives)},
+	})
+	if err != nil {
+		d.discard(ctx, staging)
+
+		return false, fmt.Errorf("deploy %s: %w", staging, err)
+	}
+
+	if current := d.fileState(ctx, path); current != nil && current.SHA256 == result.SHA256 {
+		if _, err := d.fs.Stat(path); err == nil {
+			d.discard(ctx, staging)
+
+			return false, nil
+		}
+	}
+
+	if err := d.validate(path, staging); err != nil {
+		d.discard(ctx, staging)
+
+		return false, err
+	}
+
+	snap, err := d.snapshot(ctx, path)
+	if err != nil {
+		d.discard(ctx, staging)
+
+		return false, err
+	}
+
+	if err := d.fs.Rename(staging, path); err != nil {
+		d.discard(ctx, staging)
+
+		return false, fmt.Errorf("activate %s: %w", path, err)
+	}
+
+	if err := d.moveState(ctx, staging, path); err != nil {
+		d.restore(ctx, path, snap)
+
+		return false, err
+	}
+
+	if err := d.activate(ctx, path, snap); err != nil {
+		return false, err
+	}
+
+	d.logger.Info(
+		"sshd drop-in applied",
+		slog.String("name", dropIn.Name),
+		slog.Int("directives", len(dropIn.Directives)),
+	)
+
+	return true, nil
+}
+
+// validate checks the configuration sshd would load with the drop-in at
+// path replaced by candidate, or left out when candidate is empty. The
+// main configuration is rewritten to a separate file for the check, so
+// the live drop-ins are not touched.
+func (d *Debian) validate(
+	path string,
+	candidate string,
+) error {
+	config, err := d.candidateConfig(path, candidate)
+	if err != nil {
+		return err
+	}
+
+	if err := d.fs.WriteFile(candidateConfigPath, config, 0o600); err != nil {
+		return fmt.Errorf("write %s: %w", candidateConfigPath, err)
+	}
+
+	defer func() {
+		if err := d.fs.Remove(candidateConfigPath); err != nil {
+			d.logger.Warn(
+				"failed to remove candidate sshd config",
+				slog.String("path", candidateConfigPath),
+				slog.String("error", err.Error()),
+			)
+		}
+	}()
+
+	if output, err := d.execManager.RunPrivilegedCmd(
+		"sshd",
+		[]string{"-t", "-f", candidateConfigPath},
+	); err != nil {
+		return fmt.Errorf(
+			"sshd validate failed: %s: %w",
+			strings.TrimSpace(output),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// candidateConfig returns the main configuration with the drop-in Include
+// expanded to an explicit list of files. The list keeps the order the
+// glob would load them in, with path replaced by candidate, or left out
+// when candidate is empty, so the candidate takes the precedence the live
+// drop-in will have.
+func (d *Debian) candidateConfig(
+	path string,
+	candidate string,
+) ([]byte, error) {
+	config, err := d.fs.ReadFile(sshdConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", sshdConfigPath, err)
+	}
+
+	dropIns, err := d.includedDropIns(path, candidate)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(string(config), "\n")
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || !strings.EqualFold(fields[0], "include") {
+			continue
+		}
+
+		args := make([]string, 0, len(fields)-1)
+		for _, arg := range fields[1:] {
+			if !filepath.IsAbs(arg) {
+				arg = filepath.Join(sshdDir, arg)
+			}
+
+			if arg == dropInGlob {
+				args = append(args, dropIns...)
+
+				continue
+			}
+
+			args = append(args, arg)
+		}
+
+		if len(args) == 0 {
+			lines[i] = ""
+
+			continue
+		}
+
+		lines[i] = "Include " + strings.Join(args, " ")
+	}
+
+	return []byte(strings.Join(lines, "\n")), nil
+}
+
+// includedDropIns returns the files the drop-in Include glob would load,
+// sorted by name, with path replaced by candidate, or left out when
+// candidate is empty.
+func (d *Debian) includedDropIns(
+	path string,
+	candidate string,
+) ([]string, error) {
+	dirEntries, err := d.fs.ReadDir(sshdConfigDir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("list %s: %w", sshdConfigDir, err)
+	}
+
+	paths := make([]string, 0, len(dirEntries)+1)
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		if dirEntry.IsDir() || strings.HasPrefix(name, ".") ||
+			!strings.HasSuffix(name, dropInSuffix) {
+			continue
+		}
+
+		if entryPath := filepath.Join(sshdConfigDir, name); entryPath != path {
+			paths = append(paths, entryPath)
+		}
+	}
+
+	if candidate != "" {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	for i := range paths {
+		if paths[i] == path {
+			paths[i] = candidate
+		}
+	}
+
+	return paths, nil
+}
+
+// activate reloads the daemon so it runs the change at path. When the
+// reload fails, path is restored from snap and the daemon is reloaded
+// again so it runs the restored configuration.
+func (d *Debian) activate(
+	ctx context.Context,
+	path string,
+	snap *snapshot,
+) error {
+	if output, err := d.reload(); err != nil {
+		d.restore(ctx, path, snap)
+
+		if _, reloadErr := d.reload(); reloadErr != nil {
+			d.logger.Warn(
+				"failed to reload sshd after rollback",
+				slog.String("path", path),
+				slog.String("error", reloadErr.Error()),
+			)
+		}
+
+		return fmt.Errorf(
+			"sshd reload failed: %s: %w",
+			strings.TrimSpace(output),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// reload asks the daemon to re-read its configuration. Established
+// sessions are not affected.
+func (d *Debian) reload() (string, error) {
+	return d.execManager.RunPrivilegedCmd(
+		"systemctl",
+		[]string{"reload", sshdService},
+	)
+}
+
+// snapshot records the current content and file state of path.
+func (d *Debian) snapshot(
+	ctx context.Context,
+	path string,
+) (*snapshot, error) {
+	snap := &snapshot{}
+
+	content, err := d.fs.ReadFile(path)
+	switch {
+	case err == nil:
+		snap.content = content
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	kvEntry, err := d.stateKV.Get(ctx, file.BuildStateKey(d.hostname, path))
+	switch {
+	case err == nil:
+		snap.state = kvEntry.Value()
+	case !errors.Is(err, jetstream.ErrKeyNotFound):
+		return nil, fmt.Errorf("read state for %s: %w", path, err)
+	}
+
+	return snap, nil
+}
+
+// restore puts path and its file-state entry back to snap. Failures are
+// logged; the caller already reports the original error.
+func (d *Debian) restore(
+	ctx context.Context,
+	path string,
+	snap *snapshot,
+) {
+	if snap.content == nil {
+		if err := d.fs.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			d.logger.Warn(
+				"failed to remove sshd drop-in during rollback",
+				slog.String("path", path),
+				slog.String("error", err.Error()),
+			)
+		}
+	} else if err := d.fs.WriteFile(path, snap.content, 0o644); err != nil {
+		d.logger.Warn(
+			"failed to restore sshd drop-in during rollback",
+			slog.String("path", path),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	key := file.BuildStateKey(d.hostname, path)
+	if snap.state == nil {
+		if err := d.stateKV.Delete(ctx, key); err != nil &&
+			!errors.Is(err, jetstream.ErrKeyNotFound) {
+			d.logger.Warn(
+				"failed to remove sshd drop-in state during rollback",
+				slog.String("path", path),
+				slog.String("error", err.Error()),
+			)
+		}
+	} else if _, err := d.stateKV.Put(ctx, key, snap.state); err != nil {
+		d.logger.Warn(
+			"failed to restore sshd drop-in state during rollback",
+			slog.String("path", path),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	d.logger.Warn(
+		"sshd drop-in rolled back",
+		slog.String("path", path),
+	)
+}
+
+// moveState re-keys the file-state entry recorded for the staging file to
+// the live drop-in path once the candidate has been renamed into place.
+func (d *Debian) moveState(
+	ctx context.Context,
+	staging string,
+	path string,
+) error {
+	stagingKey := file.BuildStateKey(d.hostname, staging)
+
+	kvEntry, err := d.stateKV.Get(ctx, stagingKey)
+	if err != nil {
+		return fmt.Errorf("read state for %s: %w", staging, err)
+	}
+
+	var state job.FileState
+	if err := json.Unmarshal(kvEntry.Value(), &state); err != nil {
+		return fmt.Errorf("read state for %s: %w", staging, err)
+	}
+	state.Path = path
+
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("marshal state for %s: %w", path, err)
+	}
+
+	if _, err := d.stateKV.Put(ctx, file.BuildStateKey(d.hostname, path), stateBytes); err != nil {
+		return fmt.Errorf("record state for %s: %w", path, err)
+	}
+
+	if err := d.stateKV.Delete(ctx, stagingKey); err != nil {
+		d.logger.Warn(
+			"failed to remove staging file state",
+			slog.String("path", staging),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	return nil
+}
+
+// discard removes a staging file and its file-state entry. Failures are
+// logged; the caller already reports the original error.
+func (d *Debian) discard(
+	ctx context.Context,
+	staging string,
+) {
+	if err := d.fs.Remove(staging); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		d.logger.Warn(
+			"failed to remove staging file",
+			slog.String("path", staging),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	if err := d.stateKV.Delete(
+		ctx,
+		file.BuildStateKey(d.hostname, staging),
+	); err != nil && !errors.Is(err, jetstream.ErrKeyNotFound) {
+		d.logger.Warn(
+			"failed to remove staging file state",
+			slog.String("path", staging),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// listDropIns returns the osapi-managed drop-ins, sorted by name. A
+// missing drop-in directory yields an empty list.
+func (d *Debian) listDropIns(
+	ctx context.Context,
+) ([]DropIn, error) {
+	dirEntries, err := d.fs.ReadDir(sshdConfigDir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return []DropIn{}, nil
+		}
+
+		return nil, fmt.Errorf("list %s: %w", sshdConfigDir, err)
+	}
+
+	result := make([]DropIn, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		name, ok := strings.CutSuffix(dirEntry.Name(), dropInSuffix)
+		if dirEntry.IsDir() || !ok || !validName.MatchString(name) {
+			continue
+		}
+
+		if dropIn := d.buildDropInFromState(ctx, name); dropIn != nil {
+			result = append(result, *dropIn)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+// fileState returns the file-state KV entry for path, or nil when the
+// path is not tracked or has been undeployed.
+func (d *Debian) fileState(
+	ctx context.Context,
+	path string,
+) *job.FileState {
+	kvEntry, err := d.stateKV.Get(ctx, file.BuildStateKey(d.hostname, path))
+	if err != nil {
+		return nil
+	}
+
+	var state job.FileState
+	if err := json.Unmarshal(kvEntry.Value(), &state); err != nil {
+		return nil
+	}
+
+	if state.UndeployedAt != "" {
+		return nil
+	}
+
+	return &state
+}
+
+// buildDropInFromState creates a DropIn from file-state KV metadata.
+// Returns nil when the drop-in is not managed by osapi or has been
+// undeployed.
+func (d *Debian) buildDropInFromState(
+	ctx context.Context,
+	name string,
+) *DropIn {
+	path := dropInPath(name)
+
+	state := d.fileState(ctx, path)
+	if state == nil {
+		return nil
+	}
+
+	dropIn := &DropIn{
+		Name:       name,
+		Path:       path,
+		Directives: []Directive{},
+	}
+
+	if directives := state.Metadata["directives"]; directives != "" {
+		if err := json.Unmarshal([]byte(directives), &dropIn.Directives); err != nil {
+			d.logger.Warn(
+				"failed to decode sshd directives from file state",
+				slog.String("path", path),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
+	return dropIn
+}
+
+// parseEffective parses `sshd -T` output, one lower-case keyword and its
+// value per line. Keywords that take several values, such as hostkey,
+// appear once per value.
+func parseEffective(
+	output string,
+) []Directive {
+	directives := make([]Directive, 0)
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		keyword, value, _ := strings.Cut(line, " ")
+		directives = append(directives, Directive{
+			Keyword: keyword,
+			Value:   strings.TrimSpace(value),
+		})
+	}
+
+	return directives
+}
+
+// dropInPath returns the drop-in path for a name.
+func dropInPath(
+	name string,
+) string {
+	return filepath.Join(sshdConfigDir, name+dropInSuffix)
+}
+
+// stagingPath returns the candidate drop-in path for a name.
+func stagingPath(
+	name string,
+) string {
+	return filepath.Join(sshdConfigDir, stagingPrefix+name+stagingSuffix)
+}
+
+// validateName checks that a drop-in name is safe for use as a filename.
+func validateName(
+	name string,
+) error {
+	if name == "" {
+		return fmt.Errorf("invalid sshd drop-in name: empty")
+	}
+	if !validName.MatchString(name) {
+		return fmt.Errorf(
+			"invalid sshd drop-in name %q: must match %s",
+			name,
+			validName.String(),
+		)
+	}
+
+	return nil
+}
+
+// validateDropIn checks the name and directives of a drop-in. Anything
+// that could break out of a single configuration line is rejected here;
+// `sshd -t` checks the rest.
+func validateDropIn(
+	dropIn DropIn,
+) error {
+	if err := validateName(dropIn.Name); err != nil {
+		return err
+	}
+
+	if len(dropIn.Directives) == 0 {
+		return fmt.Errorf(
+			"invalid sshd drop-in %q: at least one directive is required",
+			dropIn.Name,
+		)
+	}
+
+	for i, directive := range dropIn.Directives {
+		if err := validateDirective(directive); err != nil {
+			return fmt.Errorf(
+				"invalid sshd drop-in %q: directive %d: %w",
+				dropIn.Name,
+				i+1,
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// validateDirective checks the keyword and value of a single directive.
+func validateDirective(
+	directive Directive,
+) error {
+	if !validKeyword.MatchString(directive.Keyword) {
+		return fmt.Errorf(
+			"invalid keyword %q: must match %s",
+			directive.Keyword,
+			validKeyword.String(),
+		)
+	}
+
+	if blockedKeywords[strings.ToLower(directive.Keyword)] {
+		return fmt.Errorf("keyword %q is not supported", directive.Keyword)
+	}
+
+	if strings.TrimSpace(directive.Value) == "" {
+		return fmt.Errorf("invalid value for %s: empty", directive.Keyword)
+	}
+
+	if strings.IndexFunc(directive.Value, unicode.IsControl) >= 0 {
+		return fmt.Errorf(
+			"invalid value for %s: contains control characters",
+			directive.Keyword,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,959 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package sshd_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider/file"
+	filemocks "github.com/osapi-io/osapi/internal/provider/file/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/sshd"
+)
+
+const (
+	testHostname        = "test-host"
+	sshdConfigPath      = "/etc/ssh/sshd_config"
+	sshdConfigDir       = "/etc/ssh/sshd_config.d"
+	hardeningPath       = "/etc/ssh/sshd_config.d/hardening.conf"
+	stagingPath         = "/etc/ssh/sshd_config.d/.osapi-hardening.candidate"
+	candidateConfigPath = "/etc/ssh/.osapi-sshd_config.candidate"
+	header              = "# Managed by osapi. Do not edit.\n"
+	mainConfig          = "Include /etc/ssh/sshd_config.d/*.conf\n" +
+		"KbdInteractiveAuthentication no\n"
+)
+
+var hardeningDirectives = []sshd.Directive{
+	{Keyword: "PasswordAuthentication", Value: "no"},
+	{Keyword: "AllowGroups", Value: "ssh-users"},
+}
+
+// stateJSON returns a JSON-encoded FileState for an sshd drop-in.
+func stateJSON(
+	path string,
+	sha string,
+	undeployedAt string,
+	directives []sshd.Directive,
+) []byte {
+	encoded, _ := json.Marshal(directives)
+	state := job.FileState{
+		ObjectName:   "osapi/sshd.tmpl",
+		Path:         path,
+		SHA256:       sha,
+		Mode:         "0644",
+		ContentType:  "template",
+		DeployedAt:   "2026-01-01T00:00:00Z",
+		UndeployedAt: undeployedAt,
+		Metadata:     map[string]string{"directives": string(encoded)},
+	}
+
+	b, _ := json.Marshal(state)
+
+	return b
+}
+
+// render mirrors osapi/sshd.tmpl for the rendered directive lines.
+func render(
+	lines []string,
+) string {
+	return header + strings.Join(lines, "\n") + "\n"
+}
+
+// sha returns the hex-encoded SHA-256 of content.
+func sha(
+	content string,
+) string {
+	h := sha256.Sum256([]byte(content))
+
+	return hex.EncodeToString(h[:])
+}
+
+type DebianPublicTestSuite struct {
+	suite.Suite
+
+	ctrl         *gomock.Controller
+	logger       *slog.Logger
+	memFs        avfs.VFS
+	mockDeployer *filemocks.MockDeployer
+	mockStateKV  *jobmocks.MockKeyValue
+	mockExec     *execmocks.MockManager
+	provider     *sshd.Debian
+	states       map[string][]byte
+	// candidate is the main configuration passed to the last sshd -t -f.
+	candidate string
+}
+
+func (suite *DebianPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+	suite.mockDeployer = filemocks.NewMockDeployer(suite.ctrl)
+	suite.mockStateKV = jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+	suite.states = make(map[string][]byte)
+	suite.candidate = ""
+
+	_ = suite.memFs.MkdirAll(sshdConfigDir, 0o755)
+	_ = suite.memFs.WriteFile(sshdConfigPath, []byte(mainConfig), 0o644)
+
+	suite.provider = sshd.NewDebianProvider(
+		suite.logger,
+		suite.memFs,
+		suite.mockDeployer,
+		suite.mockStateKV,
+		suite.mockExec,
+		testHostname,
+	)
+}
+
+func (suite *DebianPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *DebianPublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+// expectStateKV backs the file-state KV with suite.states, keyed by path.
+func (suite *DebianPublicTestSuite) expectStateKV() {
+	suite.mockStateKV.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			key string,
+		) (jetstream.KeyValueEntry, error) {
+			data, ok := suite.states[key]
+			if !ok {
+				return nil, jetstream.ErrKeyNotFound
+			}
+
+			entry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+			entry.EXPECT().Value().Return(data).AnyTimes()
+
+			return entry, nil
+		}).
+		AnyTimes()
+	suite.mockStateKV.EXPECT().
+		Put(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			key string,
+			value []byte,
+		) (uint64, error) {
+			suite.states[key] = value
+
+			return 1, nil
+		}).
+		AnyTimes()
+	suite.mockStateKV.EXPECT().
+		Delete(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			key string,
+			_ ...jetstream.KVDeleteOpt,
+		) error {
+			delete(suite.states, key)
+
+			return nil
+		}).
+		AnyTimes()
+}
+
+// setState records a file-state entry for path.
+func (suite *DebianPublicTestSuite) setState(
+	path string,
+	data []byte,
+) {
+	suite.states[file.BuildStateKey(testHostname, path)] = data
+}
+
+// state returns the file-state entry recorded for path, or nil.
+func (suite *DebianPublicTestSuite) state(
+	path string,
+) *job.FileState {
+	data, ok := suite.states[file.BuildStateKey(testHostname, path)]
+	if !ok {
+		return nil
+	}
+
+	var state job.FileState
+	_ = json.Unmarshal(data, &state)
+
+	return &state
+}
+
+// manage writes a managed hardening drop-in with the given directives.
+func (suite *DebianPublicTestSuite) manage(
+	directives []sshd.Directive,
+) string {
+	lines := make([]string, 0, len(directives))
+	for _, d := range directives {
+		lines = append(lines, d.Keyword+" "+d.Value)
+	}
+	content := render(lines)
+
+	_ = suite.memFs.WriteFile(hardeningPath, []byte(content), 0o644)
+	suite.setState(hardeningPath, stateJSON(hardeningPath, sha(content), "", directives))
+
+	return content
+}
+
+// expectDeploy emulates the file provider: the rendered directives are
+// written to the staging path and its file state is recorded.
+func (suite *DebianPublicTestSuite) expectDeploy() {
+	suite.mockDeployer.EXPECT().
+		Deploy(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			req file.DeployRequest,
+		) (*file.DeployResult, error) {
+			suite.Equal("osapi/sshd.tmpl", req.ObjectName)
+			suite.Equal(stagingPath, req.Path)
+			suite.Equal("0644", req.Mode)
+			suite.Equal("template", req.ContentType)
+
+			content := render(req.Vars["directives"].([]string))
+			_ = suite.memFs.WriteFile(req.Path, []byte(content), 0o644)
+
+			state, _ := json.Marshal(job.FileState{
+				ObjectName:  req.ObjectName,
+				Path:        req.Path,
+				SHA256:      sha(content),
+				Mode:        req.Mode,
+				ContentType: req.ContentType,
+				Metadata:    req.Metadata,
+			})
+			suite.setState(req.Path, state)
+
+			return &file.DeployResult{Changed: true, SHA256: sha(content), Path: req.Path}, nil
+		})
+}
+
+// expectUndeploy emulates the file provider removing the drop-in and
+// marking its file state undeployed.
+func (suite *DebianPublicTestSuite) expectUndeploy() {
+	suite.mockDeployer.EXPECT().
+		Undeploy(gomock.Any(), file.UndeployRequest{Path: hardeningPath}).
+		DoAndReturn(func(
+			_ context.Context,
+			req file.UndeployRequest,
+		) (*file.UndeployResult, error) {
+			_ = suite.memFs.Remove(req.Path)
+
+			state := suite.state(req.Path)
+			state.UndeployedAt = "2026-02-01T00:00:00Z"
+			b, _ := json.Marshal(state)
+			suite.setState(req.Path, b)
+
+			return &file.UndeployResult{Changed: true, Path: req.Path}, nil
+		})
+}
+
+// expectValidate expects the candidate configuration to be checked with
+// sshd -t -f and records it in suite.candidate.
+func (suite *DebianPublicTestSuite) expectValidate(
+	output string,
+	err error,
+) {
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("sshd", []string{"-t", "-f", candidateConfigPath}).
+		DoAndReturn(func(
+			_ string,
+			_ []string,
+		) (string, error) {
+			content, readErr := suite.memFs.ReadFile(candidateConfigPath)
+			suite.Require().NoError(readErr)
+			suite.candidate = string(content)
+
+			return output, err
+		})
+}
+
+// expectReload expects the daemon to be reloaded times times.
+func (suite *DebianPublicTestSuite) expectReload(
+	output string,
+	err error,
+	times int,
+) {
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("systemctl", []string{"reload", "ssh"}).
+		Return(output, err).
+		Times(times)
+}
+
+// assertAbsent checks that the drop-in and its file state do not exist.
+func (suite *DebianPublicTestSuite) assertAbsent() {
+	_, err := suite.memFs.Stat(hardeningPath)
+	suite.True(errors.Is(err, os.ErrNotExist))
+	suite.Nil(suite.state(hardeningPath))
+	suite.assertNoStaging()
+}
+
+// assertNoStaging checks that the candidate files were cleaned up.
+func (suite *DebianPublicTestSuite) assertNoStaging() {
+	for _, path := range []string{stagingPath, candidateConfigPath} {
+		_, err := suite.memFs.Stat(path)
+		suite.True(errors.Is(err, os.ErrNotExist), path)
+	}
+	suite.Nil(suite.state(stagingPath))
+}
+
+// assertContent checks the content of the drop-in.
+func (suite *DebianPublicTestSuite) assertContent(
+	want string,
+) {
+	content, err := suite.memFs.ReadFile(hardeningPath)
+	suite.Require().NoError(err)
+	suite.Equal(want, string(content))
+}
+
+func (suite *DebianPublicTestSuite) TestGet() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func(*sshd.Config, error)
+	}{
+		{
+			name: "when drop-ins are managed returns effective config and drop-ins",
+			setup: func() {
+				suite.manage(hardeningDirectives)
+				_ = suite.memFs.WriteFile(
+					"/etc/ssh/sshd_config.d/manual.conf",
+					[]byte("X11Forwarding no\n"),
+					0o644,
+				)
+				_ = suite.memFs.WriteFile(
+					"/etc/ssh/sshd_config.d/README",
+					[]byte("#\n"),
+					0o644,
+				)
+				suite.setState("/etc/ssh/sshd_config.d/old.conf", stateJSON(
+					"/etc/ssh/sshd_config.d/old.conf",
+					"abc",
+					"2026-02-01T00:00:00Z",
+					hardeningDirectives,
+				))
+				_ = suite.memFs.WriteFile(
+					"/etc/ssh/sshd_config.d/old.conf",
+					[]byte(header),
+					0o644,
+				)
+				suite.expectStateKV()
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("sshd", []string{"-T"}).
+					Return(
+						"port 22\npasswordauthentication no\n"+
+							"hostkey /etc/ssh/ssh_host_rsa_key\n"+
+							"hostkey /etc/ssh/ssh_host_ed25519_key\n"+
+							"allowgroups ssh-users\n",
+						nil,
+					)
+			},
+			validateFunc: func(config *sshd.Config, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&sshd.Config{
+					Effective: []sshd.Directive{
+						{Keyword: "port", Value: "22"},
+						{Keyword: "passwordauthentication", Value: "no"},
+						{Keyword: "hostkey", Value: "/etc/ssh/ssh_host_rsa_key"},
+						{Keyword: "hostkey", Value: "/etc/ssh/ssh_host_ed25519_key"},
+						{Keyword: "allowgroups", Value: "ssh-users"},
+					},
+					DropIns: []sshd.DropIn{
+						{
+							Name:       "hardening",
+							Path:       hardeningPath,
+							Directives: hardeningDirectives,
+						},
+					},
+				}, config)
+			},
+		},
+		{
+			name: "when drop-in directory is missing returns no drop-ins",
+			setup: func() {
+				_ = suite.memFs.RemoveAll(sshdConfigDir)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("sshd", []string{"-T"}).
+					Return("port 22\n", nil)
+			},
+			validateFunc: func(config *sshd.Config, err error) {
+				suite.Require().NoError(err)
+				suite.Equal([]sshd.Directive{{Keyword: "port", Value: "22"}}, config.Effective)
+				suite.Empty(config.DropIns)
+			},
+		},
+		{
+			name: "when sshd -T fails returns error",
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("sshd", []string{"-T"}).
+					Return("/etc/ssh/sshd_config line 3: Bad configuration option\n",
+						errors.New("exit status 255"))
+			},
+			validateFunc: func(config *sshd.Config, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "sshd -T failed")
+				suite.Contains(err.Error(), "Bad configuration option")
+				suite.Nil(config)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.Get(context.Background())
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestCreate() {
+	tests := []struct {
+		name         string
+		dropIn       sshd.DropIn
+		setup        func()
+		validateFunc func(*sshd.Result, error)
+	}{
+		{
+			name:   "when config validates deploys the drop-in and reloads",
+			dropIn: sshd.DropIn{Name: "hardening", Directives: hardeningDirectives},
+			setup: func() {
+				suite.expectStateKV()
+				suite.expectDeploy()
+				suite.expectValidate("", nil)
+				suite.expectReload("", nil, 1)
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&sshd.Result{Name: "hardening", Changed: true}, result)
+
+				want := render([]string{
+					"PasswordAuthentication no",
+					"AllowGroups ssh-users",
+				})
+				suite.assertContent(want)
+
+				state := suite.state(hardeningPath)
+				suite.Require().NotNil(state)
+				suite.Equal(sha(want), state.SHA256)
+				suite.Equal(hardeningPath, state.Path)
+				suite.Contains(state.Metadata["directives"], `"keyword":"AllowGroups"`)
+				suite.assertNoStaging()
+				suite.Equal(
+					"Include "+stagingPath+"\nKbdInteractiveAuthentication no\n",
+					suite.candidate,
+				)
+			},
+		},
+		{
+			name:   "when other drop-ins exist checks the candidate in glob order",
+			dropIn: sshd.DropIn{Name: "hardening", Directives: hardeningDirectives},
+			setup: func() {
+				_ = suite.memFs.WriteFile(
+					sshdConfigPath,
+					[]byte("Port 22\nInclude sshd_config.d/*.conf /etc/ssh/extra.conf\n"),
+					0o644,
+				)
+				for _, name := range []string{"00-first.conf", "zz-last.conf", "README", ".hidden.conf"} {
+					_ = suite.memFs.WriteFile(sshdConfigDir+"/"+name, []byte("#\n"), 0o644)
+				}
+				suite.expectStateKV()
+				suite.expectDeploy()
+				suite.expectValidate("", nil)
+				suite.expectReload("", nil, 1)
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&sshd.Result{Name: "hardening", Changed: true}, result)
+				suite.Equal(
+					"Port 22\nInclude /etc/ssh/sshd_config.d/00-first.conf "+
+						stagingPath+
+						" /etc/ssh/sshd_config.d/zz-last.conf /etc/ssh/extra.conf\n",
+					suite.candidate,
+				)
+			},
+		},
+		{
+			name:   "when main config cannot be read removes the candidate",
+			dropIn: sshd.DropIn{Name: "hardening", Directives: hardeningDirectives},
+			setup: func() {
+				_ = suite.memFs.Remove(sshdConfigPath)
+				suite.expectStateKV()
+				suite.expectDeploy()
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "read /etc/ssh/sshd_config")
+				suite.Nil(result)
+				suite.assertAbsent()
+			},
+		},
+		{
+			name:   "when sshd -t fails never activates the drop-in",
+			dropIn: sshd.DropIn{Name: "hardening", Directives: hardeningDirectives},
+			setup: func() {
+				suite.expectStateKV()
+				suite.expectDeploy()
+				suite.expectValidate(
+					"/etc/ssh/sshd_config.d/hardening.conf line 2: Bad configuration option\n",
+					errors.New("exit status 255"),
+				)
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "sshd validate failed")
+				suite.Contains(err.Error(), "Bad configuration option")
+				suite.Nil(result)
+				suite.assertAbsent()
+			},
+		},
+		{
+			name:   "when reload fails removes the drop-in and reloads again",
+			dropIn: sshd.DropIn{Name: "hardening", Directives: hardeningDirectives},
+			setup: func() {
+				suite.expectStateKV()
+				suite.expectDeploy()
+				suite.expectValidate("", nil)
+				suite.expectReload(
+					"Job for ssh.service failed.\n",
+					errors.New("exit status 1"),
+					2,
+				)
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "sshd reload failed")
+				suite.Contains(err.Error(), "Job for ssh.service failed.")
+				suite.Nil(result)
+				suite.assertAbsent()
+			},
+		},
+		{
+			name:   "when deploy fails returns error",
+			dropIn: sshd.DropIn{Name: "hardening", Directives: hardeningDirectives},
+			setup: func() {
+				suite.expectStateKV()
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("object not found"))
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "object not found")
+				suite.Nil(result)
+				suite.assertAbsent()
+			},
+		},
+		{
+			name:   "when drop-in is already managed returns unchanged",
+			dropIn: sshd.DropIn{Name: "hardening", Directives: hardeningDirectives},
+			setup: func() {
+				suite.manage(hardeningDirectives)
+				suite.expectStateKV()
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&sshd.Result{Name: "hardening", Changed: false}, result)
+			},
+		},
+		{
+			name:   "when unmanaged file exists returns error",
+			dropIn: sshd.DropIn{Name: "hardening", Directives: hardeningDirectives},
+			setup: func() {
+				_ = suite.memFs.WriteFile(hardeningPath, []byte("Port 2222\n"), 0o644)
+				suite.expectStateKV()
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "exists and is not managed by osapi")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:   "when directives are empty returns error",
+			dropIn: sshd.DropIn{Name: "hardening"},
+			setup:  func() {},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "at least one directive is required")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when keyword is invalid returns error",
+			dropIn: sshd.DropIn{
+				Name:       "hardening",
+				Directives: []sshd.Directive{{Keyword: "Password-Auth", Value: "no"}},
+			},
+			setup: func() {},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), `directive 1: invalid keyword "Password-Auth"`)
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when keyword is Match returns error",
+			dropIn: sshd.DropIn{
+				Name: "hardening",
+				Directives: []sshd.Directive{
+					{Keyword: "PasswordAuthentication", Value: "no"},
+					{Keyword: "Match", Value: "Group admins"},
+				},
+			},
+			setup: func() {},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), `directive 2: keyword "Match" is not supported`)
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when value spans lines returns error",
+			dropIn: sshd.DropIn{
+				Name: "hardening",
+				Directives: []sshd.Directive{
+					{Keyword: "AllowGroups", Value: "ssh-users\nPermitRootLogin yes"},
+				},
+			},
+			setup: func() {},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "contains control characters")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when value is empty returns error",
+			dropIn: sshd.DropIn{
+				Name:       "hardening",
+				Directives: []sshd.Directive{{Keyword: "AllowGroups", Value: " "}},
+			},
+			setup: func() {},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "invalid value for AllowGroups: empty")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:   "when name is invalid returns error",
+			dropIn: sshd.DropIn{Name: "../sshd_config", Directives: hardeningDirectives},
+			setup:  func() {},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "invalid sshd drop-in name")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.Create(context.Background(), tc.dropIn)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestUpdate() {
+	updated := []sshd.Directive{
+		{Keyword: "PasswordAuthentication", Value: "no"},
+		{Keyword: "Ciphers", Value: "chacha20-poly1305@openssh.com,aes256-gcm@openssh.com"},
+	}
+
+	tests := []struct {
+		name         string
+		dropIn       sshd.DropIn
+		setup        func()
+		validateFunc func(*sshd.Result, error)
+	}{
+		{
+			name:   "when directives change replaces the drop-in and reloads",
+			dropIn: sshd.DropIn{Name: "hardening", Directives: updated},
+			setup: func() {
+				suite.manage(hardeningDirectives)
+				suite.expectStateKV()
+				suite.expectDeploy()
+				suite.expectValidate("", nil)
+				suite.expectReload("", nil, 1)
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&sshd.Result{Name: "hardening", Changed: true}, result)
+				suite.assertContent(render([]string{
+					"PasswordAuthentication no",
+					"Ciphers chacha20-poly1305@openssh.com,aes256-gcm@openssh.com",
+				}))
+			},
+		},
+		{
+			name:   "when directives are unchanged skips validation and reload",
+			dropIn: sshd.DropIn{Name: "hardening", Directives: hardeningDirectives},
+			setup: func() {
+				suite.manage(hardeningDirectives)
+				suite.expectStateKV()
+				suite.expectDeploy()
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&sshd.Result{Name: "hardening", Changed: false}, result)
+				suite.assertNoStaging()
+			},
+		},
+		{
+			name:   "when sshd -t fails leaves the live drop-in untouched",
+			dropIn: sshd.DropIn{Name: "hardening", Directives: updated},
+			setup: func() {
+				suite.manage(hardeningDirectives)
+				suite.expectStateKV()
+				suite.expectDeploy()
+				suite.expectValidate(
+					"/etc/ssh/sshd_config.d/hardening.conf line 2: Bad SSH2 cipher spec\n",
+					errors.New("exit status 255"),
+				)
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "sshd validate failed")
+				suite.Contains(err.Error(), "Bad SSH2 cipher spec")
+				suite.Nil(result)
+
+				previous := render([]string{
+					"PasswordAuthentication no",
+					"AllowGroups ssh-users",
+				})
+				suite.assertContent(previous)
+				suite.Equal(sha(previous), suite.state(hardeningPath).SHA256)
+				suite.assertNoStaging()
+			},
+		},
+		{
+			name:   "when reload fails restores the previous drop-in and reloads again",
+			dropIn: sshd.DropIn{Name: "hardening", Directives: updated},
+			setup: func() {
+				suite.manage(hardeningDirectives)
+				suite.expectStateKV()
+				suite.expectDeploy()
+				suite.expectValidate("", nil)
+				gomock.InOrder(
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("systemctl", []string{"reload", "ssh"}).
+						Return("", errors.New("exit status 1")),
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("systemctl", []string{"reload", "ssh"}).
+						Return("", nil),
+				)
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "sshd reload failed")
+				suite.Nil(result)
+				suite.assertContent(render([]string{
+					"PasswordAuthentication no",
+					"AllowGroups ssh-users",
+				}))
+			},
+		},
+		{
+			name:   "when drop-in is not managed returns error",
+			dropIn: sshd.DropIn{Name: "hardening", Directives: updated},
+			setup: func() {
+				suite.expectStateKV()
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), `sshd: update "hardening": not found`)
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.Update(context.Background(), tc.dropIn)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestDelete() {
+	tests := []struct {
+		name         string
+		dropInName   string
+		setup        func()
+		validateFunc func(*sshd.Result, error)
+	}{
+		{
+			name:       "when drop-in is managed undeploys it and reloads",
+			dropInName: "hardening",
+			setup: func() {
+				suite.manage(hardeningDirectives)
+				suite.expectStateKV()
+				suite.expectValidate("", nil)
+				suite.expectUndeploy()
+				suite.expectReload("", nil, 1)
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&sshd.Result{Name: "hardening", Changed: true}, result)
+				suite.Equal("\nKbdInteractiveAuthentication no\n", suite.candidate)
+
+				_, statErr := suite.memFs.Stat(hardeningPath)
+				suite.True(errors.Is(statErr, os.ErrNotExist))
+				suite.assertNoStaging()
+			},
+		},
+		{
+			name:       "when reload fails restores the drop-in and reloads again",
+			dropInName: "hardening",
+			setup: func() {
+				suite.manage(hardeningDirectives)
+				suite.expectStateKV()
+				suite.expectValidate("", nil)
+				suite.expectUndeploy()
+				gomock.InOrder(
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("systemctl", []string{"reload", "ssh"}).
+						Return("", errors.New("exit status 1")),
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("systemctl", []string{"reload", "ssh"}).
+						Return("", nil),
+				)
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "sshd reload failed")
+				suite.Nil(result)
+
+				suite.assertContent(render([]string{
+					"PasswordAuthentication no",
+					"AllowGroups ssh-users",
+				}))
+				state := suite.state(hardeningPath)
+				suite.Require().NotNil(state)
+				suite.Empty(state.UndeployedAt)
+			},
+		},
+		{
+			name:       "when sshd -t fails keeps the drop-in",
+			dropInName: "hardening",
+			setup: func() {
+				suite.manage(hardeningDirectives)
+				suite.expectStateKV()
+				suite.expectValidate("Missing privilege separation directory\n",
+					errors.New("exit status 255"))
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "sshd validate failed")
+				suite.Nil(result)
+
+				suite.assertContent(render([]string{
+					"PasswordAuthentication no",
+					"AllowGroups ssh-users",
+				}))
+				state := suite.state(hardeningPath)
+				suite.Require().NotNil(state)
+				suite.Empty(state.UndeployedAt)
+			},
+		},
+		{
+			name:       "when drop-in is not managed returns unchanged",
+			dropInName: "hardening",
+			setup: func() {
+				_ = suite.memFs.WriteFile(hardeningPath, []byte("Port 2222\n"), 0o644)
+				suite.expectStateKV()
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&sshd.Result{Name: "hardening", Changed: false}, result)
+			},
+		},
+		{
+			name:       "when undeploy fails returns error",
+			dropInName: "hardening",
+			setup: func() {
+				suite.manage(hardeningDirectives)
+				suite.expectStateKV()
+				suite.expectValidate("", nil)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("permission denied"))
+			},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "permission denied")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:       "when name is invalid returns error",
+			dropInName: "",
+			setup:      func() {},
+			validateFunc: func(result *sshd.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "invalid sshd drop-in name: empty")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.Delete(context.Background(), tc.dropInName)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func TestDebianPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(DebianPublicTestSuite))
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package sshd provides management of OpenSSH server drop-in files in
+// /etc/ssh/sshd_config.d. Drop-ins are staged through the file provider,
+// the configuration with the candidate in place is checked with
+// `sshd -t -f` before it goes live, and the daemon is reloaded. If the
+// reload fails, the previous drop-in is restored so a bad change cannot
+// lock operators out.
+package sshd
+
+import "context"
+
+// Provider implements the methods to manage sshd configuration.
+type Provider interface {
+	// Get returns the effective sshd configuration reported by `sshd -T`
+	// and the osapi-managed drop-ins.
+	Get(ctx context.Context) (*Config, error)
+	// Create validates and activates a new drop-in. Idempotent: returns
+	// Changed: false if the drop-in is already managed.
+	Create(ctx context.Context, dropIn DropIn) (*Result, error)
+	// Update validates and replaces the directives of a managed drop-in.
+	// Fails if the drop-in is not managed.
+	Update(ctx context.Context, dropIn DropIn) (*Result, error)
+	// Delete removes a managed drop-in and reloads the daemon.
+	Delete(ctx context.Context, name string) (*Result, error)
+}
+
+// Config represents the sshd configuration of a host.
+type Config struct {
+	// Effective is the configuration the daemon would run with, as
+	// reported by `sshd -T`. Keywords are lower case.
+	Effective []Directive `json:"effective"`
+	// DropIns are the osapi-managed drop-ins, sorted by name.
+	DropIns []DropIn `json:"drop_ins"`
+}
+
+// DropIn represents a drop-in file in /etc/ssh/sshd_config.d.
+type DropIn struct {
+	// Name is the drop-in name. The file is written as <name>.conf.
+	Name string `json:"name"`
+	// Path is the full path of the drop-in file.
+	Path string `json:"path,omitempty"`
+	// Directives are the configuration lines written to the file, in
+	// order.
+	Directives []Directive `json:"directives"`
+}
+
+// Directive is a single sshd configuration keyword and its value,
+// rendered as `<keyword> <value>`.
+type Directive struct {
+	Keyword string `json:"keyword"`
+	Value   string `json:"value"`
+}
+
+// Result represents the outcome of an sshd drop-in mutation.
+type Result struct {
+	Name    string `json:"name"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
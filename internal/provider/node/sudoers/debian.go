@@ -0,0 +1,607 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package sudoers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/avfs/avfs"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/osapi-io/osapi/internal/exec"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/file"
+)
+
+const (
+	// sudoersDir holds the sudoers drop-ins read through @includedir.
+	sudoersDir = "/etc/sudoers.d"
+	// stagingPrefix marks candidate files. sudo skips files in an
+	// include directory whose names contain a ".", so a candidate is
+	// never live while it is being validated.
+	stagingPrefix = ".osapi-"
+	// sudoersTemplate is the system template rendered into each drop-in.
+	sudoersTemplate = "osapi/sudoers.tmpl"
+	// sudoersMode is the mode sudo expects for its configuration files.
+	sudoersMode = "0440"
+)
+
+var (
+	validName      = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	validPrincipal = regexp.MustCompile(`^[%+]?#?[a-zA-Z0-9_][a-zA-Z0-9_.-]*$`)
+	validRunAs     = regexp.MustCompile(`^!?[%+]?#?[a-zA-Z0-9_][a-zA-Z0-9_.-]*$`)
+	validHost      = regexp.MustCompile(`^!?[a-zA-Z0-9_.*/-]+$`)
+	// commandEscaper escapes the characters sudoers treats as special in
+	// command arguments.
+	commandEscaper = strings.NewReplacer(`\`, `\\`, `,`, `\,`, `:`, `\:`, `=`, `\=`)
+)
+
+// Compile-time checks.
+var (
+	_ Provider             = (*Debian)(nil)
+	_ provider.FactsSetter = (*Debian)(nil)
+)
+
+// Debian implements the Provider interface for Debian-family systems.
+// Each drop-in is deployed through the file provider to a staging file in
+// /etc/sudoers.d, checked with `visudo -cf`, and renamed into place only
+// when the check passes. A drop-in is managed when it is recorded in the
+// file-state KV; its rules are kept in the state metadata so they can be
+// reported without parsing the file.
+type Debian struct {
+	provider.FactsAware
+	logger       *slog.Logger
+	fs           avfs.VFS
+	fileDeployer file.Deployer
+	stateKV      jetstream.KeyValue
+	execManager  exec.Manager
+	hostname     string
+}
+
+// NewDebianProvider factory to create a new Debian instance.
+func NewDebianProvider(
+	logger *slog.Logger,
+	fs avfs.VFS,
+	fileDeployer file.Deployer,
+	stateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+) *Debian {
+	return &Debian{
+		logger:       logger.With(slog.String("subsystem", "provider.sudoers")),
+		fs:           fs,
+		fileDeployer: fileDeployer,
+		stateKV:      stateKV,
+		execManager:  execManager,
+		hostname:     hostname,
+	}
+}
+
+// List returns all osapi-managed drop-ins from /etc/sudoers.d, sorted by
+// name. Files written by hand are not reported.
+func (d *Debian) List(
+	ctx context.Context,
+) ([]Entry, error) {
+	dirEntries, err := d.fs.ReadDir(sudoersDir)
+	if err != nil {
+		return nil, fmt.Errorf("sudoers: list: %w", err)
+	}
+
+	result := make([]Entry, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		if dirEntry.IsDir() || !validName.MatchString(name) {
+			continue
+		}
+
+		if entry := d.buildEntryFromState(ctx, name); entry != nil {
+			result = append(result, *entry)
+		}
+	}
+
+	return result, nil
+}
+
+// Get returns a single managed drop-in by name.
+func (d *Debian) Get(
+	ctx context.Context,
+	name string,
+) (*Entry, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	entry := d.buildEntryFromState(ctx, name)
+	if entry != nil {
+		return entry, nil
+	}
+
+	if _, err := d.fs.Stat(dropInPath(name)); err == nil {
+		return nil, fmt.Errorf("sudoers: get %q: not managed by osapi", name)
+	}
+
+	return nil, fmt.Errorf("sudoers: get %q: not found", name)
+}
+
+// Create validates and deploys a new drop-in. Idempotent: returns
+// Changed: false if the drop-in is already managed. A drop-in written by
+// hand is never overwritten.
+func (d *Debian) Create(
+	ctx context.Context,
+	entry Entry,
+) (*Result, error) {
+	if err := validateEntry(entry); err != nil {
+		return nil, err
+	}
+
+	// Already managed — nothing to do.
+	if d.fileState(ctx, dropInPath(entry.Name)) != nil {
+		return &Result{
+			Name:    entry.Name,
+			Changed: false,
+		}, nil
+	}
+
+	if _, err := d.fs.Stat(dropInPath(entry.Name)); err == nil {
+		return nil, fmt.Errorf(
+			"sudoers: create %q: exists and is not managed by osapi",
+			entry.Name,
+		)
+	}
+
+	changed, err := d.apply(ctx, entry)
+	if err != nil {
+		return nil, fmt.Errorf("sudoers: create %q: %w", entry.Name, err)
+	}
+
+	return &Result{
+		Name:    entry.Name,
+		Changed: changed,
+	}, nil
+}
+
+// Update validates and replaces the rules of a managed drop-in. The live
+// file is left untouched when the new rules fail validation.
+func (d *Debian) Update(
+	ctx context.Context,
+	entry Entry,
+) (*Result, error) {
+	if err := validateEntry(entry); err != nil {
+		return nil, err
+	}
+
+	if d.fileState(ctx, dropInPath(entry.Name)) == nil {
+		return nil, fmt.Errorf("sudoers: update %q: not found", entry.Name)
+	}
+
+	changed, err := d.apply(ctx, entry)
+	if err != nil {
+		return nil, fmt.Errorf("sudoers: update %q: %w", entry.Name, err)
+	}
+
+	return &Result{
+		Name:    entry.Name,
+		Changed: changed,
+	}, nil
+}
+
+// Delete undeploys a managed drop-in. Idempotent: returns Changed: false
+// if the drop-in is not managed.
+func (d *Debian) Delete(
+	ctx context.Context,
+	name string,
+) (*Result, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	path := dropInPath(name)
+	if d.fileState(ctx, path) == nil {
+		return &Result{
+			Name:    name,
+			Changed: false,
+		}, nil
+	}
+
+	result, err := d.fileDeployer.Undeploy(ctx, file.UndeployRequest{
+		Path: path,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sudoers: delete %q: %w", name, err)
+	}
+
+	d.logger.Info(
+		"sudoers drop-in removed",
+		slog.String("name", name),
+		slog.Bool("changed", result.Changed),
+	)
+
+	return &Result{
+		Name:    name,
+		Changed: result.Changed,
+	}, nil
+}
+
+// apply renders the rules of a drop-in into its staging file, checks the
+// candidate with `visudo -cf`, and renames it over the live drop-in. A
+// candidate that fails the check is removed and the live drop-in is left
+// as is. Returns false without touching the live drop-in when the
+// candidate matches it.
+func (d *Debian) apply(
+	ctx context.Context,
+	entry Entry,
+) (bool, error) {
+	path := dropInPath(entry.Name)
+	staging := stagingPath(entry.Name)
+
+	lines := make([]string, 0, len(entry.Rules))
+	for _, rule := range entry.Rules {
+		lines = append(lines, renderRule(rule))
+	}
+
+	rules, err := json.Marshal(entry.Rules)
+	if err != nil {
+		return false, fmt.Errorf("marshal rules: %w", err)
+	}
+
+	result, err := d.fileDeployer.Deploy(ctx, file.DeployRequest{
+		ObjectName:  sudoersTemplate,
+		Path:        staging,
+		Mode:        sudoersMode,
+		ContentType: "template",
+		Vars:        map[string]any{"rules": lines},
+		Metadata:    map[string]string{"rules": string(rules)},
+	})
+	if err != nil {
+		d.discard(ctx, staging)
+
+		return false, fmt.Errorf("deploy %s: %w", staging, err)
+	}
+
+	if output, err := d.execManager.RunPrivilegedCmd(
+		"visudo",
+		[]string{"-c", "-f", staging},
+	); err != nil {
+		d.discard(ctx, staging)
+
+		return false, fmt.Errorf(
+			"visudo validate failed: %s: %w",
+			strings.TrimSpace(output),
+			err,
+		)
+	}
+
+	if current := d.fileState(ctx, path); current != nil && current.SHA256 == result.SHA256 {
+		if _, err := d.fs.Stat(path); err == nil {
+			d.discard(ctx, staging)
+
+			return false, nil
+		}
+	}
+
+	if err := d.fs.Rename(staging, path); err != nil {
+		d.discard(ctx, staging)
+
+		return false, fmt.Errorf("activate %s: %w", path, err)
+	}
+
+	if err := d.moveState(ctx, staging, path); err != nil {
+		return false, err
+	}
+
+	d.logger.Info(
+		"sudoers drop-in applied",
+		slog.String("name", entry.Name),
+		slog.Int("rules", len(entry.Rules)),
+	)
+
+	return true, nil
+}
+
+// moveState re-keys the file-state entry recorded for the staging file to
+// the live drop-in path once the candidate has been renamed into place.
+func (d *Debian) moveState(
+	ctx context.Context,
+	staging string,
+	path string,
+) error {
+	stagingKey := file.BuildStateKey(d.hostname, staging)
+
+	kvEntry, err := d.stateKV.Get(ctx, stagingKey)
+	if err != nil {
+		return fmt.Errorf("read state for %s: %w", staging, err)
+	}
+
+	var state job.FileState
+	if err := json.Unmarshal(kvEntry.Value(), &state); err != nil {
+		return fmt.Errorf("read state for %s: %w", staging, err)
+	}
+	state.Path = path
+
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("marshal state for %s: %w", path, err)
+	}
+
+	if _, err := d.stateKV.Put(ctx, file.BuildStateKey(d.hostname, path), stateBytes); err != nil {
+		return fmt.Errorf("record state for %s: %w", path, err)
+	}
+
+	if err := d.stateKV.Delete(ctx, stagingKey); err != nil {
+		d.logger.Warn(
+			"failed to remove staging file state",
+			slog.String("path", staging),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	return nil
+}
+
+// discard removes a staging file and its file-state entry. Failures are
+// logged; the caller already reports the original error.
+func (d *Debian) discard(
+	ctx context.Context,
+	staging string,
+) {
+	if err := d.fs.Remove(staging); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		d.logger.Warn(
+			"failed to remove staging file",
+			slog.String("path", staging),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	if err := d.stateKV.Delete(
+		ctx,
+		file.BuildStateKey(d.hostname, staging),
+	); err != nil && !errors.Is(err, jetstream.ErrKeyNotFound) {
+		d.logger.Warn(
+			"failed to remove staging file state",
+			slog.String("path", staging),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// fileState returns the file-state KV entry for path, or nil when the
+// path is not tracked or has been undeployed.
+func (d *Debian) fileState(
+	ctx context.Context,
+	path string,
+) *job.FileState {
+	kvEntry, err := d.stateKV.Get(ctx, file.BuildStateKey(d.hostname, path))
+	if err != nil {
+		return nil
+	}
+
+	var state job.FileState
+	if err := json.Unmarshal(kvEntry.Value(), &state); err != nil {
+		return nil
+	}
+
+	if state.UndeployedAt != "" {
+		return nil
+	}
+
+	return &state
+}
+
+// buildEntryFromState creates an Entry from file-state KV metadata.
+// Returns nil when the drop-in is not managed by osapi or has been
+// undeployed.
+func (d *Debian) buildEntryFromState(
+	ctx context.Context,
+	name string,
+) *Entry {
+	path := dropInPath(name)
+
+	state := d.fileState(ctx, path)
+	if state == nil {
+		return nil
+	}
+
+	entry := &Entry{
+		Name:  name,
+		Path:  path,
+		Rules: []Rule{},
+	}
+
+	if rules := state.Metadata["rules"]; rules != "" {
+		if err := json.Unmarshal([]byte(rules), &entry.Rules); err != nil {
+			d.logger.Warn(
+				"failed to decode sudoers rules from file state",
+				slog.String("path", path),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
+	return entry
+}
+
+// renderRule formats a rule as a sudoers user specification, filling in
+// ALL for omitted hosts, run-as users and commands.
+func renderRule(
+	rule Rule,
+) string {
+	commands := make([]string, 0, len(rule.Commands))
+	for _, command := range orAll(rule.Commands) {
+		commands = append(commands, escapeCommand(command))
+	}
+
+	tag := ""
+	if rule.NoPassword {
+		tag = "NOPASSWD: "
+	}
+
+	return fmt.Sprintf(
+		"%s %s=(%s) %s%s",
+		rule.Principal,
+		strings.Join(orAll(rule.Hosts), ", "),
+		strings.Join(orAll(rule.RunAs), ", "),
+		tag,
+		strings.Join(commands, ", "),
+	)
+}
+
+// escapeCommand escapes the arguments of a command. The command path is
+// written as is.
+func escapeCommand(
+	command string,
+) string {
+	path, args, found := strings.Cut(command, " ")
+	if !found {
+		return path
+	}
+
+	return path + " " + commandEscaper.Replace(args)
+}
+
+// orAll returns values, or a list holding only ALL when values is empty.
+func orAll(
+	values []string,
+) []string {
+	if len(values) == 0 {
+		return []string{"ALL"}
+	}
+
+	return values
+}
+
+// dropInPath returns the live drop-in path for a name.
+func dropInPath(
+	name string,
+) string {
+	return filepath.Join(sudoersDir, name)
+}
+
+// stagingPath returns the candidate file path for a name.
+func stagingPath(
+	name string,
+) string {
+	return filepath.Join(sudoersDir, stagingPrefix+name)
+}
+
+// validateName checks that a drop-in name is safe for use as a filename
+// and is not one sudo would skip.
+func validateName(
+	name string,
+) error {
+	if name == "" {
+		return fmt.Errorf("invalid sudoers name: empty")
+	}
+	if !validName.MatchString(name) {
+		return fmt.Errorf(
+			"invalid sudoers name %q: must match %s",
+			name,
+			validName.String(),
+		)
+	}
+
+	return nil
+}
+
+// validateEntry checks the name and rules of a drop-in. Anything that
+// could break out of a single user specification is rejected here;
+// visudo checks the rest.
+func validateEntry(
+	entry Entry,
+) error {
+	if err := validateName(entry.Name); err != nil {
+		return err
+	}
+
+	if len(entry.Rules) == 0 {
+		return fmt.Errorf("invalid sudoers %q: at least one rule is required", entry.Name)
+	}
+
+	for i, rule := range entry.Rules {
+		if err := validateRule(rule); err != nil {
+			return fmt.Errorf("invalid sudoers %q: rule %d: %w", entry.Name, i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// validateRule checks the fields of a single rule.
+func validateRule(
+	rule Rule,
+) error {
+	if !validPrincipal.MatchString(rule.Principal) {
+		return fmt.Errorf(
+			"invalid principal %q: must match %s",
+			rule.Principal,
+			validPrincipal.String(),
+		)
+	}
+
+	for _, host := range rule.Hosts {
+		if !validHost.MatchString(host) {
+			return fmt.Errorf("invalid host %q: must match %s", host, validHost.String())
+		}
+	}
+
+	for _, runAs := range rule.RunAs {
+		if !validRunAs.MatchString(runAs) {
+			return fmt.Errorf("invalid run-as %q: must match %s", runAs, validRunAs.String())
+		}
+	}
+
+	for _, command := range rule.Commands {
+		if err := validateCommand(command); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateCommand checks that a command is ALL, sudoedit, or a fully
+// qualified path, optionally negated, on a single line.
+func validateCommand(
+	command string,
+) error {
+	if strings.IndexFunc(command, unicode.IsControl) >= 0 {
+		return fmt.Errorf("invalid command %q: contains control characters", command)
+	}
+
+	path, _, _ := strings.Cut(strings.TrimPrefix(command, "!"), " ")
+	if path != "ALL" && path != "sudoedit" && !strings.HasPrefix(path, "/") {
+		return fmt.Errorf(
+			"invalid command %q: must be ALL, sudoedit or a fully qualified path",
+			command,
+		)
+	}
+
+	return nil
+}
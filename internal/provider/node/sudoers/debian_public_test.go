@@ -0,0 +1,789 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package sudoers_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider/file"
+	filemocks "github.com/osapi-io/osapi/internal/provider/file/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/sudoers"
+)
+
+const (
+	testHostname = "test-host"
+	opsPath      = "/etc/sudoers.d/ops"
+	opsStaging   = "/etc/sudoers.d/.osapi-ops"
+	header       = "# Managed by osapi. Do not edit.\n"
+)
+
+var opsRules = []sudoers.Rule{
+	{
+		Principal:  "%ops",
+		NoPassword: true,
+		Commands:   []string{"/usr/bin/systemctl restart nginx"},
+	},
+}
+
+// stateJSON returns a JSON-encoded FileState for a sudoers drop-in.
+func stateJSON(
+	path string,
+	sha string,
+	undeployedAt string,
+	rules []sudoers.Rule,
+) []byte {
+	encoded, _ := json.Marshal(rules)
+	state := job.FileState{
+		ObjectName:   "osapi/sudoers.tmpl",
+		Path:         path,
+		SHA256:       sha,
+		Mode:         "0440",
+		ContentType:  "template",
+		DeployedAt:   "2026-01-01T00:00:00Z",
+		UndeployedAt: undeployedAt,
+		Metadata:     map[string]string{"rules": string(encoded)},
+	}
+
+	b, _ := json.Marshal(state)
+
+	return b
+}
+
+// render mirrors osapi/sudoers.tmpl for the rendered rule lines.
+func render(
+	lines []string,
+) string {
+	return header + strings.Join(lines, "\n") + "\n"
+}
+
+// sha returns the hex-encoded SHA-256 of content.
+func sha(
+	content string,
+) string {
+	h := sha256.Sum256([]byte(content))
+
+	return hex.EncodeToString(h[:])
+}
+
+type DebianPublicTestSuite struct {
+	suite.Suite
+
+	ctrl         *gomock.Controller
+	logger       *slog.Logger
+	memFs        avfs.VFS
+	mockDeployer *filemocks.MockDeployer
+	mockStateKV  *jobmocks.MockKeyValue
+	mockExec     *execmocks.MockManager
+	provider     *sudoers.Debian
+	states       map[string][]byte
+}
+
+func (suite *DebianPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+	suite.mockDeployer = filemocks.NewMockDeployer(suite.ctrl)
+	suite.mockStateKV = jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+	suite.states = make(map[string][]byte)
+
+	_ = suite.memFs.MkdirAll("/etc/sudoers.d", 0o755)
+
+	suite.provider = sudoers.NewDebianProvider(
+		suite.logger,
+		suite.memFs,
+		suite.mockDeployer,
+		suite.mockStateKV,
+		suite.mockExec,
+		testHostname,
+	)
+}
+
+func (suite *DebianPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *DebianPublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+// expectStateKV backs the file-state KV with suite.states, keyed by path.
+func (suite *DebianPublicTestSuite) expectStateKV() {
+	suite.mockStateKV.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			key string,
+		) (jetstream.KeyValueEntry, error) {
+			data, ok := suite.states[key]
+			if !ok {
+				return nil, jetstream.ErrKeyNotFound
+			}
+
+			entry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+			entry.EXPECT().Value().Return(data).AnyTimes()
+
+			return entry, nil
+		}).
+		AnyTimes()
+	suite.mockStateKV.EXPECT().
+		Put(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			key string,
+			value []byte,
+		) (uint64, error) {
+			suite.states[key] = value
+
+			return 1, nil
+		}).
+		AnyTimes()
+	suite.mockStateKV.EXPECT().
+		Delete(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			key string,
+			_ ...jetstream.KVDeleteOpt,
+		) error {
+			delete(suite.states, key)
+
+			return nil
+		}).
+		AnyTimes()
+}
+
+// setState records a file-state entry for path.
+func (suite *DebianPublicTestSuite) setState(
+	path string,
+	data []byte,
+) {
+	suite.states[file.BuildStateKey(testHostname, path)] = data
+}
+
+// state returns the file-state entry recorded for path, or nil.
+func (suite *DebianPublicTestSuite) state(
+	path string,
+) *job.FileState {
+	data, ok := suite.states[file.BuildStateKey(testHostname, path)]
+	if !ok {
+		return nil
+	}
+
+	var state job.FileState
+	_ = json.Unmarshal(data, &state)
+
+	return &state
+}
+
+// expectDeploy emulates the file provider: the rendered rules are written
+// to the requested path and its file state is recorded.
+func (suite *DebianPublicTestSuite) expectDeploy() {
+	suite.mockDeployer.EXPECT().
+		Deploy(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			req file.DeployRequest,
+		) (*file.DeployResult, error) {
+			suite.Equal("osapi/sudoers.tmpl", req.ObjectName)
+			suite.Equal(opsStaging, req.Path)
+			suite.Equal("0440", req.Mode)
+			suite.Equal("template", req.ContentType)
+
+			content := render(req.Vars["rules"].([]string))
+			_ = suite.memFs.WriteFile(req.Path, []byte(content), 0o440)
+
+			state, _ := json.Marshal(job.FileState{
+				ObjectName:  req.ObjectName,
+				Path:        req.Path,
+				SHA256:      sha(content),
+				Mode:        req.Mode,
+				ContentType: req.ContentType,
+				Metadata:    req.Metadata,
+			})
+			suite.setState(req.Path, state)
+
+			return &file.DeployResult{Changed: true, SHA256: sha(content), Path: req.Path}, nil
+		})
+}
+
+// expectVisudo expects the staging file to be checked with visudo.
+func (suite *DebianPublicTestSuite) expectVisudo(
+	output string,
+	err error,
+) {
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("visudo", []string{"-c", "-f", opsStaging}).
+		Return(output, err)
+}
+
+// assertStagingRemoved checks that no candidate was left behind.
+func (suite *DebianPublicTestSuite) assertStagingRemoved() {
+	_, err := suite.memFs.Stat(opsStaging)
+	suite.True(errors.Is(err, os.ErrNotExist))
+	suite.Nil(suite.state(opsStaging))
+}
+
+func (suite *DebianPublicTestSuite) TestList() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func([]sudoers.Entry, error)
+	}{
+		{
+			name: "when drop-ins are managed returns them with their rules",
+			setup: func() {
+				_ = suite.memFs.WriteFile(opsPath, []byte(header), 0o440)
+				_ = suite.memFs.WriteFile("/etc/sudoers.d/README", []byte("#\n"), 0o440)
+				_ = suite.memFs.WriteFile(opsStaging, []byte(header), 0o440)
+				_ = suite.memFs.WriteFile("/etc/sudoers.d/old", []byte(header), 0o440)
+				suite.setState(opsPath, stateJSON(opsPath, "abc", "", opsRules))
+				suite.setState("/etc/sudoers.d/old", stateJSON(
+					"/etc/sudoers.d/old",
+					"abc",
+					"2026-02-01T00:00:00Z",
+					opsRules,
+				))
+				suite.expectStateKV()
+			},
+			validateFunc: func(entries []sudoers.Entry, err error) {
+				suite.Require().NoError(err)
+				suite.Equal([]sudoers.Entry{
+					{
+						Name:  "ops",
+						Path:  opsPath,
+						Rules: opsRules,
+					},
+				}, entries)
+			},
+		},
+		{
+			name: "when nothing is managed returns empty list",
+			setup: func() {
+				suite.expectStateKV()
+			},
+			validateFunc: func(entries []sudoers.Entry, err error) {
+				suite.Require().NoError(err)
+				suite.Empty(entries)
+			},
+		},
+		{
+			name: "when directory cannot be read returns error",
+			setup: func() {
+				_ = suite.memFs.RemoveAll("/etc/sudoers.d")
+			},
+			validateFunc: func(entries []sudoers.Entry, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "sudoers: list")
+				suite.Nil(entries)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.List(context.Background())
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestGet() {
+	tests := []struct {
+		name         string
+		entryName    string
+		setup        func()
+		validateFunc func(*sudoers.Entry, error)
+	}{
+		{
+			name:      "when drop-in is managed returns it",
+			entryName: "ops",
+			setup: func() {
+				suite.setState(opsPath, stateJSON(opsPath, "abc", "", opsRules))
+				suite.expectStateKV()
+			},
+			validateFunc: func(entry *sudoers.Entry, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&sudoers.Entry{
+					Name:  "ops",
+					Path:  opsPath,
+					Rules: opsRules,
+				}, entry)
+			},
+		},
+		{
+			name:      "when file exists but is not managed returns error",
+			entryName: "ops",
+			setup: func() {
+				_ = suite.memFs.WriteFile(opsPath, []byte("root ALL=(ALL) ALL\n"), 0o440)
+				suite.expectStateKV()
+			},
+			validateFunc: func(entry *sudoers.Entry, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "not managed by osapi")
+				suite.Nil(entry)
+			},
+		},
+		{
+			name:      "when drop-in does not exist returns not found",
+			entryName: "ops",
+			setup: func() {
+				suite.expectStateKV()
+			},
+			validateFunc: func(entry *sudoers.Entry, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "not found")
+				suite.Nil(entry)
+			},
+		},
+		{
+			name:      "when name contains a dot returns error",
+			entryName: "ops.conf",
+			setup:     func() {},
+			validateFunc: func(entry *sudoers.Entry, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "invalid sudoers name")
+				suite.Nil(entry)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.Get(context.Background(), tc.entryName)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestCreate() {
+	tests := []struct {
+		name         string
+		entry        sudoers.Entry
+		setup        func()
+		validateFunc func(*sudoers.Result, error)
+	}{
+		{
+			name: "when candidate passes visudo renames it into place",
+			entry: sudoers.Entry{
+				Name: "ops",
+				Rules: []sudoers.Rule{
+					{
+						Principal:  "%ops",
+						NoPassword: true,
+						Commands: []string{
+							"/usr/bin/systemctl restart nginx",
+							"/usr/bin/journalctl -u nginx,sshd --since=today",
+						},
+					},
+					{
+						Principal: "deploy",
+						Hosts:     []string{"web01", "web02"},
+						RunAs:     []string{"www-data"},
+					},
+				},
+			},
+			setup: func() {
+				suite.expectStateKV()
+				suite.expectDeploy()
+				suite.expectVisudo("", nil)
+			},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&sudoers.Result{Name: "ops", Changed: true}, result)
+
+				content, readErr := suite.memFs.ReadFile(opsPath)
+				suite.Require().NoError(readErr)
+				suite.Equal(render([]string{
+					`%ops ALL=(ALL) NOPASSWD: /usr/bin/systemctl restart nginx, ` +
+						`/usr/bin/journalctl -u nginx\,sshd --since\=today`,
+					"deploy web01, web02=(www-data) ALL",
+				}), string(content))
+
+				state := suite.state(opsPath)
+				suite.Require().NotNil(state)
+				suite.Equal(opsPath, state.Path)
+				suite.Equal(sha(string(content)), state.SHA256)
+				suite.Contains(state.Metadata["rules"], `"principal":"deploy"`)
+				suite.assertStagingRemoved()
+			},
+		},
+		{
+			name:  "when candidate fails visudo refuses to write it",
+			entry: sudoers.Entry{Name: "ops", Rules: opsRules},
+			setup: func() {
+				suite.expectStateKV()
+				suite.expectDeploy()
+				suite.expectVisudo(
+					">>> /etc/sudoers.d/.osapi-ops: syntax error near line 2 <<<\n",
+					errors.New("exit status 1"),
+				)
+			},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "visudo validate failed")
+				suite.Contains(err.Error(), "syntax error near line 2")
+				suite.Nil(result)
+
+				_, statErr := suite.memFs.Stat(opsPath)
+				suite.True(errors.Is(statErr, os.ErrNotExist))
+				suite.Nil(suite.state(opsPath))
+				suite.assertStagingRemoved()
+			},
+		},
+		{
+			name:  "when deploy fails returns error",
+			entry: sudoers.Entry{Name: "ops", Rules: opsRules},
+			setup: func() {
+				suite.expectStateKV()
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("object not found"))
+			},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "object not found")
+				suite.Nil(result)
+				suite.assertStagingRemoved()
+			},
+		},
+		{
+			name:  "when drop-in is already managed returns unchanged",
+			entry: sudoers.Entry{Name: "ops", Rules: opsRules},
+			setup: func() {
+				suite.setState(opsPath, stateJSON(opsPath, "abc", "", opsRules))
+				suite.expectStateKV()
+			},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&sudoers.Result{Name: "ops", Changed: false}, result)
+			},
+		},
+		{
+			name:  "when unmanaged file exists returns error",
+			entry: sudoers.Entry{Name: "ops", Rules: opsRules},
+			setup: func() {
+				_ = suite.memFs.WriteFile(opsPath, []byte("root ALL=(ALL) ALL\n"), 0o440)
+				suite.expectStateKV()
+			},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "exists and is not managed by osapi")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when rules are empty returns error",
+			entry: sudoers.Entry{Name: "ops"},
+			setup: func() {},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "at least one rule is required")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when principal is invalid returns error",
+			entry: sudoers.Entry{
+				Name:  "ops",
+				Rules: []sudoers.Rule{{Principal: "root ALL=(ALL) ALL\nevil"}},
+			},
+			setup: func() {},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "rule 1: invalid principal")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when host is invalid returns error",
+			entry: sudoers.Entry{
+				Name:  "ops",
+				Rules: []sudoers.Rule{{Principal: "alice", Hosts: []string{"web01=(ALL)"}}},
+			},
+			setup: func() {},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "invalid host")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when run-as is invalid returns error",
+			entry: sudoers.Entry{
+				Name:  "ops",
+				Rules: []sudoers.Rule{{Principal: "alice", RunAs: []string{"root)"}}},
+			},
+			setup: func() {},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "invalid run-as")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when command spans lines returns error",
+			entry: sudoers.Entry{
+				Name: "ops",
+				Rules: []sudoers.Rule{{
+					Principal: "alice",
+					Commands:  []string{"/bin/true\nalice ALL=(ALL) ALL"},
+				}},
+			},
+			setup: func() {},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "contains control characters")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when command is not fully qualified returns error",
+			entry: sudoers.Entry{
+				Name:  "ops",
+				Rules: []sudoers.Rule{{Principal: "alice", Commands: []string{"systemctl"}}},
+			},
+			setup: func() {},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "fully qualified path")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when name is invalid returns error",
+			entry: sudoers.Entry{Name: "../sudoers", Rules: opsRules},
+			setup: func() {},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "invalid sudoers name")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.Create(context.Background(), tc.entry)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestUpdate() {
+	current := render([]string{"%ops ALL=(ALL) NOPASSWD: /usr/bin/systemctl restart nginx"})
+
+	tests := []struct {
+		name         string
+		entry        sudoers.Entry
+		setup        func()
+		validateFunc func(*sudoers.Result, error)
+	}{
+		{
+			name: "when rules change replaces the live drop-in",
+			entry: sudoers.Entry{
+				Name:  "ops",
+				Rules: []sudoers.Rule{{Principal: "%ops"}},
+			},
+			setup: func() {
+				_ = suite.memFs.WriteFile(opsPath, []byte(current), 0o440)
+				suite.setState(opsPath, stateJSON(opsPath, sha(current), "", opsRules))
+				suite.expectStateKV()
+				suite.expectDeploy()
+				suite.expectVisudo("", nil)
+			},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&sudoers.Result{Name: "ops", Changed: true}, result)
+
+				content, readErr := suite.memFs.ReadFile(opsPath)
+				suite.Require().NoError(readErr)
+				suite.Equal(render([]string{"%ops ALL=(ALL) ALL"}), string(content))
+				suite.assertStagingRemoved()
+			},
+		},
+		{
+			name:  "when rules are unchanged leaves the live drop-in alone",
+			entry: sudoers.Entry{Name: "ops", Rules: opsRules},
+			setup: func() {
+				_ = suite.memFs.WriteFile(opsPath, []byte(current), 0o440)
+				suite.setState(opsPath, stateJSON(opsPath, sha(current), "", opsRules))
+				suite.expectStateKV()
+				suite.expectDeploy()
+				suite.expectVisudo("", nil)
+			},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&sudoers.Result{Name: "ops", Changed: false}, result)
+				suite.assertStagingRemoved()
+			},
+		},
+		{
+			name: "when candidate fails visudo keeps the live drop-in",
+			entry: sudoers.Entry{
+				Name:  "ops",
+				Rules: []sudoers.Rule{{Principal: "nosuchalias_"}},
+			},
+			setup: func() {
+				_ = suite.memFs.WriteFile(opsPath, []byte(current), 0o440)
+				suite.setState(opsPath, stateJSON(opsPath, sha(current), "", opsRules))
+				suite.expectStateKV()
+				suite.expectDeploy()
+				suite.expectVisudo("parse error", errors.New("exit status 1"))
+			},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "sudoers: update \"ops\"")
+				suite.Nil(result)
+
+				content, readErr := suite.memFs.ReadFile(opsPath)
+				suite.Require().NoError(readErr)
+				suite.Equal(current, string(content))
+				suite.Equal(sha(current), suite.state(opsPath).SHA256)
+				suite.assertStagingRemoved()
+			},
+		},
+		{
+			name:  "when drop-in is not managed returns error",
+			entry: sudoers.Entry{Name: "ops", Rules: opsRules},
+			setup: func() {
+				suite.expectStateKV()
+			},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "not found")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.Update(context.Background(), tc.entry)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestDelete() {
+	tests := []struct {
+		name         string
+		entryName    string
+		setup        func()
+		validateFunc func(*sudoers.Result, error)
+	}{
+		{
+			name:      "when drop-in is managed undeploys it",
+			entryName: "ops",
+			setup: func() {
+				suite.setState(opsPath, stateJSON(opsPath, "abc", "", opsRules))
+				suite.expectStateKV()
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: opsPath}).
+					Return(&file.UndeployResult{Changed: true, Path: opsPath}, nil)
+			},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&sudoers.Result{Name: "ops", Changed: true}, result)
+			},
+		},
+		{
+			name:      "when drop-in is not managed returns unchanged",
+			entryName: "ops",
+			setup: func() {
+				_ = suite.memFs.WriteFile(opsPath, []byte("root ALL=(ALL) ALL\n"), 0o440)
+				suite.expectStateKV()
+			},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&sudoers.Result{Name: "ops", Changed: false}, result)
+			},
+		},
+		{
+			name:      "when undeploy fails returns error",
+			entryName: "ops",
+			setup: func() {
+				suite.setState(opsPath, stateJSON(opsPath, "abc", "", opsRules))
+				suite.expectStateKV()
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("permission denied"))
+			},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "permission denied")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:      "when name is invalid returns error",
+			entryName: "",
+			setup:     func() {},
+			validateFunc: func(result *sudoers.Result, err error) {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), "invalid sudoers name: empty")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.Delete(context.Background(), tc.entryName)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func TestDebianPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(DebianPublicTestSuite))
+}
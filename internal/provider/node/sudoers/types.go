@@ -0,0 +1,76 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package sudoers provides management of sudoers drop-in files in
+// /etc/sudoers.d. Rules are rendered through the file provider into a
+// staging file that sudo ignores, checked with `visudo -cf`, and only then
+// renamed into place, so a drop-in that fails validation is never live.
+package sudoers
+
+import "context"
+
+// Provider implements the methods to manage sudoers drop-ins.
+type Provider interface {
+	// List returns all osapi-managed sudoers drop-ins.
+	List(ctx context.Context) ([]Entry, error)
+	// Get returns a single sudoers drop-in by name.
+	Get(ctx context.Context, name string) (*Entry, error)
+	// Create validates and deploys a new sudoers drop-in. Idempotent:
+	// returns Changed: false if the drop-in is already managed.
+	Create(ctx context.Context, entry Entry) (*Result, error)
+	// Update validates and replaces the rules of a managed sudoers
+	// drop-in. Fails if the drop-in is not managed.
+	Update(ctx context.Context, entry Entry) (*Result, error)
+	// Delete removes a managed sudoers drop-in.
+	Delete(ctx context.Context, name string) (*Result, error)
+}
+
+// Entry represents a sudoers drop-in file in /etc/sudoers.d.
+type Entry struct {
+	// Name is the drop-in file name in /etc/sudoers.d.
+	Name string `json:"name"`
+	// Path is the full path of the drop-in file.
+	Path string `json:"path,omitempty"`
+	// Rules are the user privilege specifications written to the file.
+	Rules []Rule `json:"rules"`
+}
+
+// Rule is a single sudoers user specification, rendered as
+// `<principal> <hosts>=(<run_as>) [NOPASSWD: ]<commands>`.
+type Rule struct {
+	// Principal is the user, %group or +netgroup the rule applies to.
+	Principal string `json:"principal"`
+	// Hosts limits the rule to the listed hosts. Defaults to ALL.
+	Hosts []string `json:"hosts,omitempty"`
+	// RunAs lists the users the commands may be run as. Defaults to ALL.
+	RunAs []string `json:"run_as,omitempty"`
+	// NoPassword allows the commands to run without a password prompt.
+	NoPassword bool `json:"no_password,omitempty"`
+	// Commands are the fully qualified commands the principal may run.
+	// Defaults to ALL.
+	Commands []string `json:"commands,omitempty"`
+}
+
+// Result represents the outcome of a sudoers drop-in mutation.
+type Result struct {
+	Name    string `json:"name"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
@@ -0,0 +1,483 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package swap
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/avfs/avfs"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/osapi-io/osapi/internal/exec"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/file"
+)
+
+const (
+	// swapDir holds the managed swap files.
+	swapDir = "/var/lib/osapi/swap"
+	// unitDir holds the deployed systemd swap units.
+	unitDir = "/etc/systemd/system"
+	// unitTemplate is the system template seeded into the Object Store
+	// by the agent and rendered into each swap unit.
+	unitTemplate = "osapi/swap.tmpl"
+	// procSwaps lists the active swap areas.
+	procSwaps = "/proc/swaps"
+	// procSwappiness holds the current vm.swappiness value.
+	procSwappiness = "/proc/sys/vm/swappiness"
+)
+
+var validName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// Compile-time checks.
+var (
+	_ Provider             = (*Debian)(nil)
+	_ provider.FactsSetter = (*Debian)(nil)
+)
+
+// Debian implements the Provider interface for Debian-family systems.
+// Swap files are allocated with fallocate (falling back to dd), formatted
+// with mkswap, and enabled through a systemd swap unit deployed by the
+// file provider. A swap file is managed when its unit is recorded in the
+// file-state KV.
+type Debian struct {
+	provider.FactsAware
+	logger       *slog.Logger
+	fs           avfs.VFS
+	fileDeployer file.Deployer
+	stateKV      jetstream.KeyValue
+	execManager  exec.Manager
+	hostname     string
+}
+
+// NewDebianProvider factory to create a new Debian instance.
+func NewDebianProvider(
+	logger *slog.Logger,
+	fs avfs.VFS,
+	fileDeployer file.Deployer,
+	stateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+) *Debian {
+	return &Debian{
+		logger:       logger.With(slog.String("subsystem", "provider.swap")),
+		fs:           fs,
+		fileDeployer: fileDeployer,
+		stateKV:      stateKV,
+		execManager:  execManager,
+		hostname:     hostname,
+	}
+}
+
+// Get returns the active swap areas from /proc/swaps, merged with the
+// managed swap files that are not currently active, and the current
+// swappiness.
+func (d *Debian) Get(
+	ctx context.Context,
+) (*Status, error) {
+	data, err := d.fs.ReadFile(procSwaps)
+	if err != nil {
+		return nil, fmt.Errorf("swap: get: %w", err)
+	}
+
+	status := &Status{
+		Swappiness: d.readSwappiness(),
+		Areas:      parseProcSwaps(data),
+	}
+
+	active := make(map[string]bool, len(status.Areas))
+	for i := range status.Areas {
+		area := &status.Areas[i]
+		active[area.Path] = true
+		status.Total += area.Size
+		status.Used += area.Used
+
+		name, ok := strings.CutPrefix(area.Path, swapDir+"/")
+		if ok && d.isManaged(ctx, name) {
+			area.Name = name
+			area.Managed = true
+		}
+	}
+
+	// Managed swap files that are not active are included so drift is
+	// visible. A missing directory just means nothing was created yet.
+	dirEntries, _ := d.fs.ReadDir(swapDir)
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		path := swapPath(name)
+		if dirEntry.IsDir() || active[path] || !d.isManaged(ctx, name) {
+			continue
+		}
+
+		var size int64
+		if info, infoErr := dirEntry.Info(); infoErr == nil {
+			size = info.Size()
+		}
+
+		status.Areas = append(status.Areas, Area{
+			Name:    name,
+			Path:    path,
+			Type:    "file",
+			Size:    size,
+			Managed: true,
+		})
+	}
+
+	return status, nil
+}
+
+// Create allocates and formats a swap file, deploys its systemd swap
+// unit, and enables it. Idempotent: returns Changed: false if a swap
+// file with the same name is already managed.
+func (d *Debian) Create(
+	ctx context.Context,
+	opts CreateOpts,
+) (*CreateResult, error) {
+	if err := validateName(opts.Name); err != nil {
+		return nil, err
+	}
+
+	if opts.SizeMB <= 0 {
+		return nil, fmt.Errorf("swap: create: size_mb must be greater than zero")
+	}
+
+	path := swapPath(opts.Name)
+
+	// Already managed — nothing to do.
+	if d.isManaged(ctx, opts.Name) {
+		return &CreateResult{
+			Name:    opts.Name,
+			Path:    path,
+			Changed: false,
+		}, nil
+	}
+
+	if err := d.allocate(path, opts.SizeMB); err != nil {
+		return nil, fmt.Errorf("swap: create: %w", err)
+	}
+
+	priority := ""
+	if opts.Priority != nil {
+		priority = strconv.Itoa(*opts.Priority)
+	}
+
+	unit := unitName(path)
+	if _, err := d.fileDeployer.Deploy(ctx, file.DeployRequest{
+		ObjectName:  unitTemplate,
+		Path:        unitDir + "/" + unit,
+		Mode:        "0644",
+		ContentType: "template",
+		Vars: map[string]any{
+			"name":     opts.Name,
+			"path":     path,
+			"priority": priority,
+		},
+		Metadata: map[string]string{
+			"swap_name": opts.Name,
+			"swap_path": path,
+			"size_mb":   strconv.Itoa(opts.SizeMB),
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("swap: create: deploy unit: %w", err)
+	}
+
+	if err := d.systemctl("daemon-reload"); err != nil {
+		return nil, fmt.Errorf("swap: create: %w", err)
+	}
+
+	if err := d.systemctl("enable", "--now", unit); err != nil {
+		return nil, fmt.Errorf("swap: create: %w", err)
+	}
+
+	d.logger.Info(
+		"swap file enabled",
+		slog.String("name", opts.Name),
+		slog.String("path", path),
+		slog.Int("size_mb", opts.SizeMB),
+	)
+
+	return &CreateResult{
+		Name:    opts.Name,
+		Path:    path,
+		Changed: true,
+	}, nil
+}
+
+// Delete disables a managed swap file, removes its systemd swap unit,
+// and deletes the file. Idempotent: returns Changed: false if the swap
+// file is not managed.
+func (d *Debian) Delete(
+	ctx context.Context,
+	name string,
+) (*DeleteResult, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	path := swapPath(name)
+
+	if !d.isManaged(ctx, name) {
+		return &DeleteResult{
+			Name:    name,
+			Path:    path,
+			Changed: false,
+		}, nil
+	}
+
+	unit := unitName(path)
+
+	// Stopping the unit runs swapoff, which fails when the pages in the
+	// swap file cannot be moved back into memory.
+	if err := d.systemctl("disable", "--now", unit); err != nil {
+		return nil, fmt.Errorf("swap: delete: %w", err)
+	}
+
+	if _, err := d.fileDeployer.Undeploy(ctx, file.UndeployRequest{
+		Path: unitDir + "/" + unit,
+	}); err != nil {
+		return nil, fmt.Errorf("swap: delete: undeploy unit: %w", err)
+	}
+
+	if err := d.systemctl("daemon-reload"); err != nil {
+		return nil, fmt.Errorf("swap: delete: %w", err)
+	}
+
+	if output, err := d.execManager.RunPrivilegedCmd(
+		"rm",
+		[]string{"-f", path},
+	); err != nil {
+		return nil, fmt.Errorf(
+			"swap: delete: remove %s: %s: %w",
+			path,
+			strings.TrimSpace(output),
+			err,
+		)
+	}
+
+	d.logger.Info(
+		"swap file removed",
+		slog.String("name", name),
+		slog.String("path", path),
+	)
+
+	return &DeleteResult{
+		Name:    name,
+		Path:    path,
+		Changed: true,
+	}, nil
+}
+
+// allocate creates a swap file of sizeMB megabytes with mode 0600 and
+// formats it with mkswap. fallocate is tried first; filesystems that do
+// not support it for swap files fall back to dd.
+func (d *Debian) allocate(
+	path string,
+	sizeMB int,
+) error {
+	if output, err := d.execManager.RunPrivilegedCmd(
+		"mkdir",
+		[]string{"-p", "-m", "0700", swapDir},
+	); err != nil {
+		return fmt.Errorf("create %s: %s: %w", swapDir, strings.TrimSpace(output), err)
+	}
+
+	if _, err := d.execManager.RunPrivilegedCmd(
+		"fallocate",
+		[]string{"-l", strconv.Itoa(sizeMB) + "M", path},
+	); err != nil {
+		d.logger.Debug(
+			"fallocate failed, falling back to dd",
+			slog.String("path", path),
+			slog.String("error", err.Error()),
+		)
+
+		if output, ddErr := d.execManager.RunPrivilegedCmd(
+			"dd",
+			[]string{
+				"if=/dev/zero",
+				"of=" + path,
+				"bs=1M",
+				"count=" + strconv.Itoa(sizeMB),
+			},
+		); ddErr != nil {
+			return fmt.Errorf("allocate %s: %s: %w", path, strings.TrimSpace(output), ddErr)
+		}
+	}
+
+	if output, err := d.execManager.RunPrivilegedCmd(
+		"chmod",
+		[]string{"0600", path},
+	); err != nil {
+		return fmt.Errorf("chmod %s: %s: %w", path, strings.TrimSpace(output), err)
+	}
+
+	if output, err := d.execManager.RunPrivilegedCmd(
+		"mkswap",
+		[]string{path},
+	); err != nil {
+		return fmt.Errorf("mkswap %s: %s: %w", path, strings.TrimSpace(output), err)
+	}
+
+	return nil
+}
+
+// systemctl runs a privileged systemctl command.
+func (d *Debian) systemctl(
+	args ...string,
+) error {
+	if output, err := d.execManager.RunPrivilegedCmd("systemctl", args); err != nil {
+		return fmt.Errorf(
+			"systemctl %s: %s: %w",
+			strings.Join(args, " "),
+			strings.TrimSpace(output),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// isManaged reports whether the swap unit for name is recorded in the
+// file-state KV and has not been undeployed.
+func (d *Debian) isManaged(
+	ctx context.Context,
+	name string,
+) bool {
+	stateKey := file.BuildStateKey(d.hostname, unitDir+"/"+unitName(swapPath(name)))
+
+	kvEntry, err := d.stateKV.Get(ctx, stateKey)
+	if err != nil {
+		return false
+	}
+
+	var state job.FileState
+	if err := json.Unmarshal(kvEntry.Value(), &state); err != nil {
+		return false
+	}
+
+	return state.UndeployedAt == ""
+}
+
+// readSwappiness returns the current vm.swappiness value, or zero when
+// it cannot be read.
+func (d *Debian) readSwappiness() int {
+	data, err := d.fs.ReadFile(procSwappiness)
+	if err != nil {
+		return 0
+	}
+
+	v, _ := strconv.Atoi(strings.TrimSpace(string(data)))
+
+	return v
+}
+
+// parseProcSwaps parses /proc/swaps. Sizes in the file are in KiB and
+// are converted to bytes.
+func parseProcSwaps(
+	data []byte,
+) []Area {
+	areas := make([]Area, 0)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 || fields[0] == "Filename" {
+			continue
+		}
+
+		size, _ := strconv.ParseInt(fields[2], 10, 64)
+		used, _ := strconv.ParseInt(fields[3], 10, 64)
+		priority, _ := strconv.Atoi(fields[4])
+
+		areas = append(areas, Area{
+			// Spaces in paths are octal-escaped by the kernel.
+			Path:     strings.ReplaceAll(fields[0], `\040`, " "),
+			Type:     fields[1],
+			Size:     size * 1024,
+			Used:     used * 1024,
+			Priority: priority,
+			Active:   true,
+		})
+	}
+
+	return areas
+}
+
+// swapPath returns the swap file path for a name.
+func swapPath(
+	name string,
+) string {
+	return swapDir + "/" + name
+}
+
+// unitName returns the systemd swap unit name for path, escaped the way
+// systemd-escape --path does: slashes become dashes and bytes outside
+// [a-zA-Z0-9:_.] become \xNN.
+func unitName(
+	path string,
+) string {
+	trimmed := strings.Trim(path, "/")
+
+	var b strings.Builder
+	for i := 0; i < len(trimmed); i++ {
+		c := trimmed[i]
+		switch {
+		case c == '/':
+			b.WriteByte('-')
+		case c == '.' && i == 0:
+			fmt.Fprintf(&b, `\x%02x`, c)
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9',
+			c == ':', c == '_', c == '.':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, `\x%02x`, c)
+		}
+	}
+
+	return b.String() + ".swap"
+}
+
+// validateName checks that a swap file name is safe for use as a filename.
+func validateName(
+	name string,
+) error {
+	if name == "" {
+		return fmt.Errorf("invalid swap name: empty")
+	}
+	if !validName.MatchString(name) {
+		return fmt.Errorf(
+			"invalid swap name %q: must match %s",
+			name,
+			validName.String(),
+		)
+	}
+
+	return nil
+}
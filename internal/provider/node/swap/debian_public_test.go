@@ -0,0 +1,786 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package swap_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider/file"
+	filemocks "github.com/osapi-io/osapi/internal/provider/file/mocks"
+	"github.com/osapi-io/osapi/internal/provider/node/swap"
+)
+
+const (
+	testHostname = "test-host"
+	dataPath     = "/var/lib/osapi/swap/data"
+	dataUnit     = "var-lib-osapi-swap-data.swap"
+	dataUnitPath = "/etc/systemd/system/var-lib-osapi-swap-data.swap"
+	procSwaps    = `Filename				Type		Size		Used		Priority
+/swap.img                               file		2097148		1024		-2
+/var/lib/osapi/swap/data                file		1048572		0		10
+`
+)
+
+// stateJSON returns a JSON-encoded FileState for a swap unit.
+func stateJSON(
+	path string,
+	undeployedAt string,
+) []byte {
+	state := job.FileState{
+		ObjectName:   "osapi/swap.tmpl",
+		Path:         path,
+		SHA256:       "abc123",
+		ContentType:  "template",
+		DeployedAt:   "2026-01-01T00:00:00Z",
+		UndeployedAt: undeployedAt,
+	}
+
+	b, _ := json.Marshal(state)
+
+	return b
+}
+
+type DebianPublicTestSuite struct {
+	suite.Suite
+
+	ctrl         *gomock.Controller
+	logger       *slog.Logger
+	memFs        avfs.VFS
+	mockDeployer *filemocks.MockDeployer
+	mockStateKV  *jobmocks.MockKeyValue
+	mockExec     *execmocks.MockManager
+	provider     *swap.Debian
+}
+
+func (suite *DebianPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+	suite.mockDeployer = filemocks.NewMockDeployer(suite.ctrl)
+	suite.mockStateKV = jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+
+	_ = suite.memFs.MkdirAll("/proc/sys/vm", 0o755)
+
+	suite.provider = swap.NewDebianProvider(
+		suite.logger,
+		suite.memFs,
+		suite.mockDeployer,
+		suite.mockStateKV,
+		suite.mockExec,
+		testHostname,
+	)
+}
+
+func (suite *DebianPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *DebianPublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+// expectStates answers file-state KV lookups from a map of unit path to
+// state. Paths missing from the map are reported as not found.
+func (suite *DebianPublicTestSuite) expectStates(
+	states map[string][]byte,
+) {
+	byKey := make(map[string][]byte, len(states))
+	for path, data := range states {
+		byKey[file.BuildStateKey(testHostname, path)] = data
+	}
+
+	suite.mockStateKV.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			key string,
+		) (jetstream.KeyValueEntry, error) {
+			data, ok := byKey[key]
+			if !ok {
+				return nil, jetstream.ErrKeyNotFound
+			}
+
+			entry := jobmocks.NewMockKeyValueEntry(suite.ctrl)
+			entry.EXPECT().Value().Return(data).AnyTimes()
+
+			return entry, nil
+		}).
+		AnyTimes()
+}
+
+// expectAllocate expects the swap directory to be created and the swap
+// file to be allocated, restricted, and formatted.
+func (suite *DebianPublicTestSuite) expectAllocate() {
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("mkdir", []string{"-p", "-m", "0700", "/var/lib/osapi/swap"}).
+		Return("", nil)
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("fallocate", []string{"-l", "1024M", dataPath}).
+		Return("", nil)
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("chmod", []string{"0600", dataPath}).
+		Return("", nil)
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("mkswap", []string{dataPath}).
+		Return("", nil)
+}
+
+func (suite *DebianPublicTestSuite) TestGet() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func(*swap.Status, error)
+	}{
+		{
+			name: "when swap areas are active returns areas and totals",
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/swaps", []byte(procSwaps), 0o444)
+				_ = suite.memFs.WriteFile("/proc/sys/vm/swappiness", []byte("60\n"), 0o644)
+				_ = suite.memFs.MkdirAll("/var/lib/osapi/swap", 0o700)
+				_ = suite.memFs.WriteFile(dataPath, []byte("swap"), 0o600)
+				suite.expectStates(map[string][]byte{
+					dataUnitPath: stateJSON(dataUnitPath, ""),
+				})
+			},
+			validateFunc: func(status *swap.Status, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(60, status.Swappiness)
+				suite.Equal(int64(3145720*1024), status.Total)
+				suite.Equal(int64(1024*1024), status.Used)
+				suite.Require().Len(status.Areas, 2)
+				suite.Equal(swap.Area{
+					Path:     "/swap.img",
+					Type:     "file",
+					Size:     2097148 * 1024,
+					Used:     1024 * 1024,
+					Priority: -2,
+					Active:   true,
+				}, status.Areas[0])
+				suite.Equal(swap.Area{
+					Name:     "data",
+					Path:     dataPath,
+					Type:     "file",
+					Size:     1048572 * 1024,
+					Priority: 10,
+					Active:   true,
+					Managed:  true,
+				}, status.Areas[1])
+			},
+		},
+		{
+			name: "when managed swap file is inactive includes it",
+			setup: func() {
+				_ = suite.memFs.WriteFile(
+					"/proc/swaps",
+					[]byte("Filename\tType\tSize\tUsed\tPriority\n"),
+					0o444,
+				)
+				_ = suite.memFs.WriteFile("/proc/sys/vm/swappiness", []byte("10\n"), 0o644)
+				_ = suite.memFs.MkdirAll("/var/lib/osapi/swap", 0o700)
+				_ = suite.memFs.WriteFile(dataPath, []byte("swap"), 0o600)
+				_ = suite.memFs.WriteFile("/var/lib/osapi/swap/stray", []byte("x"), 0o600)
+				suite.expectStates(map[string][]byte{
+					dataUnitPath: stateJSON(dataUnitPath, ""),
+				})
+			},
+			validateFunc: func(status *swap.Status, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(10, status.Swappiness)
+				suite.Zero(status.Total)
+				suite.Require().Len(status.Areas, 1)
+				suite.Equal("data", status.Areas[0].Name)
+				suite.Equal(int64(4), status.Areas[0].Size)
+				suite.False(status.Areas[0].Active)
+				suite.True(status.Areas[0].Managed)
+			},
+		},
+		{
+			name: "when swap file was undeployed reports it as unmanaged",
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/swaps", []byte(procSwaps), 0o444)
+				suite.expectStates(map[string][]byte{
+					dataUnitPath: stateJSON(dataUnitPath, "2026-01-02T00:00:00Z"),
+				})
+			},
+			validateFunc: func(status *swap.Status, err error) {
+				suite.Require().NoError(err)
+				suite.Zero(status.Swappiness)
+				suite.Require().Len(status.Areas, 2)
+				suite.False(status.Areas[1].Managed)
+				suite.Empty(status.Areas[1].Name)
+			},
+		},
+		{
+			name: "when state is not valid JSON reports it as unmanaged",
+			setup: func() {
+				_ = suite.memFs.WriteFile("/proc/swaps", []byte(procSwaps), 0o444)
+				suite.expectStates(map[string][]byte{
+					dataUnitPath: []byte("not-json"),
+				})
+			},
+			validateFunc: func(status *swap.Status, err error) {
+				suite.Require().NoError(err)
+				suite.False(status.Areas[1].Managed)
+			},
+		},
+		{
+			name: "when /proc/swaps cannot be read returns error",
+			setup: func() {
+			},
+			validateFunc: func(status *swap.Status, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "swap: get")
+				suite.Nil(status)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.Get(context.Background())
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestCreate() {
+	priority := 10
+
+	tests := []struct {
+		name         string
+		opts         swap.CreateOpts
+		setup        func()
+		validateFunc func(*swap.CreateResult, error)
+	}{
+		{
+			name: "when swap file is created enables its unit",
+			opts: swap.CreateOpts{Name: "data", SizeMB: 1024, Priority: &priority},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectAllocate()
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						req file.DeployRequest,
+					) (*file.DeployResult, error) {
+						suite.Equal("osapi/swap.tmpl", req.ObjectName)
+						suite.Equal(dataUnitPath, req.Path)
+						suite.Equal("template", req.ContentType)
+						suite.Equal(map[string]any{
+							"name":     "data",
+							"path":     dataPath,
+							"priority": "10",
+						}, req.Vars)
+						suite.Equal("1024", req.Metadata["size_mb"])
+						return &file.DeployResult{Changed: true, Path: req.Path}, nil
+					})
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", []string{"daemon-reload"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", []string{"enable", "--now", dataUnit}).
+					Return("", nil)
+			},
+			validateFunc: func(result *swap.CreateResult, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("data", result.Name)
+				suite.Equal(dataPath, result.Path)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name: "when name contains a hyphen escapes the unit name",
+			opts: swap.CreateOpts{Name: "my-swap", SizeMB: 64},
+			setup: func() {
+				path := "/var/lib/osapi/swap/my-swap"
+				unit := `var-lib-osapi-swap-my\x2dswap.swap`
+
+				suite.expectStates(nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("mkdir", gomock.Any()).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("fallocate", []string{"-l", "64M", path}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("chmod", []string{"0600", path}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("mkswap", []string{path}).
+					Return("", nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						req file.DeployRequest,
+					) (*file.DeployResult, error) {
+						suite.Equal("/etc/systemd/system/"+unit, req.Path)
+						suite.Equal("", req.Vars["priority"])
+						return &file.DeployResult{Changed: true, Path: req.Path}, nil
+					})
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", []string{"daemon-reload"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", []string{"enable", "--now", unit}).
+					Return("", nil)
+			},
+			validateFunc: func(result *swap.CreateResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name: "when fallocate fails falls back to dd",
+			opts: swap.CreateOpts{Name: "data", SizeMB: 1024},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("mkdir", gomock.Any()).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("fallocate", gomock.Any()).
+					Return("fallocate: Operation not supported", errors.New("exit status 1"))
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dd", []string{
+						"if=/dev/zero", "of=" + dataPath, "bs=1M", "count=1024",
+					}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("chmod", gomock.Any()).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("mkswap", gomock.Any()).
+					Return("", nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(&file.DeployResult{Changed: true}, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", gomock.Any()).
+					Return("", nil).
+					Times(2)
+			},
+			validateFunc: func(result *swap.CreateResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name: "when already managed returns unchanged",
+			opts: swap.CreateOpts{Name: "data", SizeMB: 1024},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					dataUnitPath: stateJSON(dataUnitPath, ""),
+				})
+			},
+			validateFunc: func(result *swap.CreateResult, err error) {
+				suite.Require().NoError(err)
+				suite.False(result.Changed)
+				suite.Equal(dataPath, result.Path)
+			},
+		},
+		{
+			name: "when previously deleted creates it again",
+			opts: swap.CreateOpts{Name: "data", SizeMB: 1024},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					dataUnitPath: stateJSON(dataUnitPath, "2026-01-02T00:00:00Z"),
+				})
+				suite.expectAllocate()
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(&file.DeployResult{Changed: true}, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", gomock.Any()).
+					Return("", nil).
+					Times(2)
+			},
+			validateFunc: func(result *swap.CreateResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name: "when name is empty returns error",
+			opts: swap.CreateOpts{SizeMB: 1024},
+			setup: func() {
+			},
+			validateFunc: func(result *swap.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid swap name: empty")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when name is invalid returns error",
+			opts: swap.CreateOpts{Name: "../etc", SizeMB: 1024},
+			setup: func() {
+			},
+			validateFunc: func(result *swap.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid swap name")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when size is zero returns error",
+			opts: swap.CreateOpts{Name: "data"},
+			setup: func() {
+			},
+			validateFunc: func(result *swap.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "size_mb must be greater than zero")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when swap directory cannot be created returns error",
+			opts: swap.CreateOpts{Name: "data", SizeMB: 1024},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("mkdir", gomock.Any()).
+					Return("mkdir: permission denied", errors.New("exit status 1"))
+			},
+			validateFunc: func(result *swap.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "create /var/lib/osapi/swap")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when dd fails returns error",
+			opts: swap.CreateOpts{Name: "data", SizeMB: 1024},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("mkdir", gomock.Any()).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("fallocate", gomock.Any()).
+					Return("", errors.New("exit status 1"))
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("dd", gomock.Any()).
+					Return("dd: No space left on device", errors.New("exit status 1"))
+			},
+			validateFunc: func(result *swap.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "No space left on device")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when chmod fails returns error",
+			opts: swap.CreateOpts{Name: "data", SizeMB: 1024},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("mkdir", gomock.Any()).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("fallocate", gomock.Any()).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("chmod", gomock.Any()).
+					Return("", errors.New("exit status 1"))
+			},
+			validateFunc: func(result *swap.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "chmod "+dataPath)
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when mkswap fails returns error",
+			opts: swap.CreateOpts{Name: "data", SizeMB: 1024},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("mkdir", gomock.Any()).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("fallocate", gomock.Any()).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("chmod", gomock.Any()).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("mkswap", gomock.Any()).
+					Return("mkswap: error", errors.New("exit status 1"))
+			},
+			validateFunc: func(result *swap.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "mkswap "+dataPath)
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when unit deploy fails returns error",
+			opts: swap.CreateOpts{Name: "data", SizeMB: 1024},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectAllocate()
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("object not found"))
+			},
+			validateFunc: func(result *swap.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "deploy unit")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when daemon-reload fails returns error",
+			opts: swap.CreateOpts{Name: "data", SizeMB: 1024},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectAllocate()
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(&file.DeployResult{Changed: true}, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", []string{"daemon-reload"}).
+					Return("", errors.New("exit status 1"))
+			},
+			validateFunc: func(result *swap.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "systemctl daemon-reload")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when enabling the unit fails returns error",
+			opts: swap.CreateOpts{Name: "data", SizeMB: 1024},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectAllocate()
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(&file.DeployResult{Changed: true}, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", []string{"daemon-reload"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", []string{"enable", "--now", dataUnit}).
+					Return("swapon failed: Invalid argument", errors.New("exit status 1"))
+			},
+			validateFunc: func(result *swap.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "swapon failed: Invalid argument")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.Create(context.Background(), tc.opts)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestDelete() {
+	managed := map[string][]byte{
+		dataUnitPath: stateJSON(dataUnitPath, ""),
+	}
+
+	tests := []struct {
+		name         string
+		swapName     string
+		setup        func()
+		validateFunc func(*swap.DeleteResult, error)
+	}{
+		{
+			name:     "when managed disables the unit and removes the file",
+			swapName: "data",
+			setup: func() {
+				suite.expectStates(managed)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", []string{"disable", "--now", dataUnit}).
+					Return("", nil)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: dataUnitPath}).
+					Return(&file.UndeployResult{Changed: true}, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", []string{"daemon-reload"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("rm", []string{"-f", dataPath}).
+					Return("", nil)
+			},
+			validateFunc: func(result *swap.DeleteResult, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("data", result.Name)
+				suite.Equal(dataPath, result.Path)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:     "when not managed returns unchanged",
+			swapName: "data",
+			setup: func() {
+				suite.expectStates(nil)
+			},
+			validateFunc: func(result *swap.DeleteResult, err error) {
+				suite.Require().NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:     "when already deleted returns unchanged",
+			swapName: "data",
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					dataUnitPath: stateJSON(dataUnitPath, "2026-01-02T00:00:00Z"),
+				})
+			},
+			validateFunc: func(result *swap.DeleteResult, err error) {
+				suite.Require().NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:     "when name is invalid returns error",
+			swapName: "bad name",
+			setup: func() {
+			},
+			validateFunc: func(result *swap.DeleteResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid swap name")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:     "when swapoff fails returns error",
+			swapName: "data",
+			setup: func() {
+				suite.expectStates(managed)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", []string{"disable", "--now", dataUnit}).
+					Return("swapoff failed: Cannot allocate memory", errors.New("exit status 1"))
+			},
+			validateFunc: func(result *swap.DeleteResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "Cannot allocate memory")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:     "when undeploy fails returns error",
+			swapName: "data",
+			setup: func() {
+				suite.expectStates(managed)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", gomock.Any()).
+					Return("", nil)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("state update failed"))
+			},
+			validateFunc: func(result *swap.DeleteResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "undeploy unit")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:     "when daemon-reload fails returns error",
+			swapName: "data",
+			setup: func() {
+				suite.expectStates(managed)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", []string{"disable", "--now", dataUnit}).
+					Return("", nil)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), gomock.Any()).
+					Return(&file.UndeployResult{Changed: true}, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", []string{"daemon-reload"}).
+					Return("", errors.New("exit status 1"))
+			},
+			validateFunc: func(result *swap.DeleteResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "systemctl daemon-reload")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:     "when swap file cannot be removed returns error",
+			swapName: "data",
+			setup: func() {
+				suite.expectStates(managed)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("systemctl", gomock.Any()).
+					Return("", nil).
+					Times(2)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), gomock.Any()).
+					Return(&file.UndeployResult{Changed: true}, nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("rm", gomock.Any()).
+					Return("rm: permission denied", errors.New("exit status 1"))
+			},
+			validateFunc: func(result *swap.DeleteResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "remove "+dataPath)
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.Delete(context.Background(), tc.swapName)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func TestDebianPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(DebianPublicTestSuite))
+}
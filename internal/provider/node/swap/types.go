@@ -0,0 +1,84 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package swap provides swap space management. Swap files are allocated
+// under /var/lib/osapi/swap/ and activated through systemd swap units
+// deployed with the file provider, so they persist across reboots and are
+// tracked in the file-state KV.
+package swap
+
+import "context"
+
+// Provider implements the methods to manage swap space.
+type Provider interface {
+	// Get returns the active swap areas, managed swap files, and the
+	// current swappiness.
+	Get(ctx context.Context) (*Status, error)
+	// Create allocates, formats, and enables a managed swap file.
+	// Idempotent: returns Changed: false if already managed.
+	Create(ctx context.Context, opts CreateOpts) (*CreateResult, error)
+	// Delete disables and removes a managed swap file. Idempotent:
+	// returns Changed: false if not managed.
+	Delete(ctx context.Context, name string) (*DeleteResult, error)
+}
+
+// Status represents the swap configuration of a host. Sizes are in bytes.
+type Status struct {
+	Swappiness int    `json:"swappiness"`
+	Total      int64  `json:"total"`
+	Used       int64  `json:"used"`
+	Areas      []Area `json:"areas"`
+}
+
+// Area represents a swap device or swap file.
+type Area struct {
+	// Name is the managed swap file name. Empty for unmanaged areas.
+	Name     string `json:"name,omitempty"`
+	Path     string `json:"path"`
+	Type     string `json:"type"`
+	Size     int64  `json:"size"`
+	Used     int64  `json:"used"`
+	Priority int    `json:"priority"`
+	Active   bool   `json:"active"`
+	Managed  bool   `json:"managed"`
+}
+
+// CreateOpts contains options for creating a managed swap file.
+type CreateOpts struct {
+	Name     string `json:"name"`
+	SizeMB   int    `json:"size_mb"`
+	Priority *int   `json:"priority,omitempty"`
+}
+
+// CreateResult represents the outcome of a swap file creation.
+type CreateResult struct {
+	Name    string `json:"name"`
+	Path    string `json:"path"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
+
+// DeleteResult represents the outcome of a swap file deletion.
+type DeleteResult struct {
+	Name    string `json:"name"`
+	Path    string `json:"path"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
@@ -43,6 +43,15 @@ john:x:1000:1000:John Doe:/home/john:/bin/bash
 jane:x:1001:1001:Jane Doe:/home/jane:/bin/zsh
 `
 
+const chageContent = `Last password change					: 2026-01-15
+Password expires					: 2026-04-15
+Password inactive					: never
+Account expires						: never
+Minimum number of days between password change		: 1
+Maximum number of days between password change		: 90
+Number of days of warning before password expires	: 7
+`
+
 const groupContent = `root:x:0:
 daemon:x:1:
 sudo:x:27:john,jane
@@ -124,24 +133,36 @@ func (suite *DebianPublicTestSuite) TestListUsers() {
 				suite.mockExec.EXPECT().
 					RunCmd("passwd", []string{"-S", "root"}).
 					Return("root P 01/01/2026 0 99999 7 -1", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("env", []string{"LC_ALL=C", "chage", "-l", "-i", "root"}).
+					Return(chageContent, nil)
 				suite.mockExec.EXPECT().
 					RunCmd("id", []string{"-Gn", "daemon"}).
 					Return("daemon", nil)
 				suite.mockExec.EXPECT().
 					RunCmd("passwd", []string{"-S", "daemon"}).
 					Return("daemon L 01/01/2026 0 99999 7 -1", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("env", []string{"LC_ALL=C", "chage", "-l", "-i", "daemon"}).
+					Return(chageContent, nil)
 				suite.mockExec.EXPECT().
 					RunCmd("id", []string{"-Gn", "john"}).
 					Return("john sudo docker", nil)
 				suite.mockExec.EXPECT().
 					RunCmd("passwd", []string{"-S", "john"}).
 					Return("john P 01/01/2026 0 99999 7 -1", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("env", []string{"LC_ALL=C", "chage", "-l", "-i", "john"}).
+					Return(chageContent, nil)
 				suite.mockExec.EXPECT().
 					RunCmd("id", []string{"-Gn", "jane"}).
 					Return("jane sudo", nil)
 				suite.mockExec.EXPECT().
 					RunCmd("passwd", []string{"-S", "jane"}).
 					Return("jane L 01/01/2026 0 99999 7 -1", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("env", []string{"LC_ALL=C", "chage", "-l", "-i", "jane"}).
+					Return(chageContent, nil)
 			},
 			validateFunc: func(result []user.User, err error) {
 				suite.NoError(err)
@@ -154,6 +175,8 @@ func (suite *DebianPublicTestSuite) TestListUsers() {
 				suite.Equal("/bin/bash", result[0].Shell)
 				suite.Equal([]string{"root"}, result[0].Groups)
 				suite.False(result[0].Locked)
+				suite.Require().NotNil(result[0].Aging)
+				suite.Equal(90, result[0].Aging.MaxDays)
 
 				suite.Equal("daemon", result[1].Name)
 				suite.Equal(1, result[1].UID)
@@ -192,6 +215,9 @@ func (suite *DebianPublicTestSuite) TestListUsers() {
 				suite.mockExec.EXPECT().
 					RunCmd("passwd", []string{"-S", "root"}).
 					Return("root P 01/01/2026 0 99999 7 -1", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("env", []string{"LC_ALL=C", "chage", "-l", "-i", "root"}).
+					Return(chageContent, nil)
 			},
 			validateFunc: func(result []user.User, err error) {
 				suite.NoError(err)
@@ -210,6 +236,9 @@ func (suite *DebianPublicTestSuite) TestListUsers() {
 				suite.mockExec.EXPECT().
 					RunCmd("passwd", []string{"-S", "valid"}).
 					Return("valid P 01/01/2026 0 99999 7 -1", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("env", []string{"LC_ALL=C", "chage", "-l", "-i", "valid"}).
+					Return(chageContent, nil)
 			},
 			validateFunc: func(result []user.User, err error) {
 				suite.NoError(err)
@@ -262,12 +291,16 @@ func (suite *DebianPublicTestSuite) TestListUsers() {
 				suite.mockExec.EXPECT().
 					RunCmd("id", []string{"-Gn", "testuser"}).
 					Return("", errors.New("id failed"))
+				suite.mockExec.EXPECT().
+					RunCmd("env", []string{"LC_ALL=C", "chage", "-l", "-i", "testuser"}).
+					Return("", errors.New("chage failed"))
 			},
 			validateFunc: func(result []user.User, err error) {
 				suite.NoError(err)
 				suite.Require().Len(result, 1)
 				suite.Equal("testuser", result[0].Name)
 				suite.Nil(result[0].Groups)
+				suite.Nil(result[0].Aging)
 			},
 		},
 	}
@@ -305,6 +338,9 @@ func (suite *DebianPublicTestSuite) TestGetUser() {
 				suite.mockExec.EXPECT().
 					RunCmd("passwd", []string{"-S", "john"}).
 					Return("john P 01/01/2026 0 99999 7 -1", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("env", []string{"LC_ALL=C", "chage", "-l", "-i", "john"}).
+					Return(chageContent, nil)
 			},
 			validateFunc: func(result *user.User, err error) {
 				suite.NoError(err)
@@ -316,6 +352,113 @@ func (suite *DebianPublicTestSuite) TestGetUser() {
 				suite.Equal("/bin/bash", result.Shell)
 				suite.Equal([]string{"john", "sudo", "docker"}, result.Groups)
 				suite.False(result.Locked)
+				suite.Require().NotNil(result.Aging)
+				suite.Equal(user.PasswordAging{
+					LastChange:      "2026-01-15",
+					PasswordExpires: "2026-04-15",
+					MinDays:         1,
+					MaxDays:         90,
+					WarnDays:        7,
+				}, *result.Aging)
+			},
+		},
+		{
+			name:     "when password must be changed and account expires",
+			userName: "john",
+			passwd:   passwdContent,
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("id", []string{"-Gn", "john"}).
+					Return("john", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("passwd", []string{"-S", "john"}).
+					Return("john P 01/01/1970 0 99999 7 -1", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("env", []string{"LC_ALL=C", "chage", "-l", "-i", "john"}).
+					Return(`Last password change					: password must be changed
+Password expires					: password must be changed
+Password inactive					: password must be changed
+Account expires						: 2026-12-31
+Minimum number of days between password change		: 0
+Maximum number of days between password change		: 99999
+Number of days of warning before password expires	: 7
+`, nil)
+			},
+			validateFunc: func(result *user.User, err error) {
+				suite.NoError(err)
+				suite.Require().NotNil(result)
+				suite.Require().NotNil(result.Aging)
+				suite.Equal(user.PasswordAging{
+					MustChange:     true,
+					AccountExpires: "2026-12-31",
+					MinDays:        0,
+					MaxDays:        99999,
+					WarnDays:       7,
+				}, *result.Aging)
+			},
+		},
+		{
+			name:     "when chage output is invalid aging is omitted",
+			userName: "john",
+			passwd:   passwdContent,
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("id", []string{"-Gn", "john"}).
+					Return("john", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("passwd", []string{"-S", "john"}).
+					Return("john P 01/01/2026 0 99999 7 -1", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("env", []string{"LC_ALL=C", "chage", "-l", "-i", "john"}).
+					Return("Account expires	: someday\n", nil)
+			},
+			validateFunc: func(result *user.User, err error) {
+				suite.NoError(err)
+				suite.Require().NotNil(result)
+				suite.Nil(result.Aging)
+			},
+		},
+		{
+			name:     "when chage output has no known labels aging is omitted",
+			userName: "john",
+			passwd:   passwdContent,
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("id", []string{"-Gn", "john"}).
+					Return("john", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("passwd", []string{"-S", "john"}).
+					Return("john P 01/01/2026 0 99999 7 -1", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("env", []string{"LC_ALL=C", "chage", "-l", "-i", "john"}).
+					Return("Letzte Passwortänderung\t: 2026-01-15\n"+
+						"Maximale Anzahl an Tagen zwischen Passwortänderungen\t: 90\n", nil)
+			},
+			validateFunc: func(result *user.User, err error) {
+				suite.NoError(err)
+				suite.Require().NotNil(result)
+				suite.Nil(result.Aging)
+			},
+		},
+		{
+			name:     "when chage days are invalid aging is omitted",
+			userName: "john",
+			passwd:   passwdContent,
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunCmd("id", []string{"-Gn", "john"}).
+					Return("john", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("passwd", []string{"-S", "john"}).
+					Return("john P 01/01/2026 0 99999 7 -1", nil)
+				suite.mockExec.EXPECT().
+					RunCmd("env", []string{"LC_ALL=C", "chage", "-l", "-i", "john"}).
+					Return("Maximum number of days between password change\t: lots\n", nil)
+			},
+			validateFunc: func(result *user.User, err error) {
+				suite.NoError(err)
+				suite.Require().NotNil(result)
+				suite.Nil(result.Aging)
 			},
 		},
 		{
@@ -350,6 +493,9 @@ func (suite *DebianPublicTestSuite) TestGetUser() {
 				suite.mockExec.EXPECT().
 					RunCmd("passwd", []string{"-S", "john"}).
 					Return("", errors.New("passwd failed"))
+				suite.mockExec.EXPECT().
+					RunCmd("env", []string{"LC_ALL=C", "chage", "-l", "-i", "john"}).
+					Return(chageContent, nil)
 			},
 			validateFunc: func(result *user.User, err error) {
 				suite.NoError(err)
@@ -376,6 +522,11 @@ func (suite *DebianPublicTestSuite) TestGetUser() {
 }
 
 func (suite *DebianPublicTestSuite) TestCreateUser() {
+	minDays := 1
+	maxDays := 90
+	warnDays := 14
+	invalidDays := -2
+
 	tests := []struct {
 		name         string
 		opts         user.CreateUserOpts
@@ -468,6 +619,89 @@ func (suite *DebianPublicTestSuite) TestCreateUser() {
 				suite.Contains(err.Error(), "useradd failed")
 			},
 		},
+		{
+			name: "when create with password aging",
+			opts: user.CreateUserOpts{
+				Name:                "newuser",
+				Password:            "secret123",
+				MinDays:             &minDays,
+				MaxDays:             &maxDays,
+				WarnDays:            &warnDays,
+				AccountExpires:      "2026-12-31",
+				ForcePasswordChange: true,
+			},
+			setup: func() {
+				gomock.InOrder(
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("useradd", []string{"--create-home", "newuser"}).
+						Return("", nil),
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("sh", []string{"-c", "echo 'newuser:secret123' | chpasswd"}).
+						Return("", nil),
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("chage", []string{
+							"-m", "1",
+							"-M", "90",
+							"-W", "14",
+							"-E", "2026-12-31",
+							"-d", "0",
+							"newuser",
+						}).
+						Return("", nil),
+				)
+			},
+			validateFunc: func(result *user.Result, err error) {
+				suite.NoError(err)
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name: "when account expiry is invalid",
+			opts: user.CreateUserOpts{
+				Name:           "newuser",
+				AccountExpires: "31/12/2026",
+			},
+			setup: func() {},
+			validateFunc: func(result *user.Result, err error) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid account expiry")
+			},
+		},
+		{
+			name: "when aging days are invalid",
+			opts: user.CreateUserOpts{
+				Name:    "newuser",
+				MaxDays: &invalidDays,
+			},
+			setup: func() {},
+			validateFunc: func(result *user.Result, err error) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid max days -2")
+			},
+		},
+		{
+			name: "when chage fails after create",
+			opts: user.CreateUserOpts{
+				Name:                "newuser",
+				ForcePasswordChange: true,
+			},
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("useradd", []string{"--create-home", "newuser"}).
+					Return("", nil)
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("chage", []string{"-d", "0", "newuser"}).
+					Return("", errors.New("chage error"))
+			},
+			validateFunc: func(result *user.Result, err error) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "chage failed")
+			},
+		},
 		{
 			name: "when password set fails after create",
 			opts: user.CreateUserOpts{
@@ -503,6 +737,8 @@ func (suite *DebianPublicTestSuite) TestCreateUser() {
 func (suite *DebianPublicTestSuite) TestUpdateUser() {
 	lockTrue := true
 	lockFalse := false
+	maxDays := 90
+	noLimit := -1
 
 	tests := []struct {
 		name         string
@@ -609,6 +845,78 @@ func (suite *DebianPublicTestSuite) TestUpdateUser() {
 				suite.False(result.Changed)
 			},
 		},
+		{
+			name:     "when password aging change",
+			userName: "john",
+			opts: user.UpdateUserOpts{
+				MaxDays:        &maxDays,
+				AccountExpires: "never",
+			},
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("chage", []string{"-M", "90", "-E", "-1", "john"}).
+					Return("", nil)
+			},
+			validateFunc: func(result *user.Result, err error) {
+				suite.NoError(err)
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:     "when shell and aging change",
+			userName: "john",
+			opts: user.UpdateUserOpts{
+				Shell:               "/bin/zsh",
+				MaxDays:             &noLimit,
+				ForcePasswordChange: true,
+			},
+			setup: func() {
+				gomock.InOrder(
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("usermod", []string{"-s", "/bin/zsh", "john"}).
+						Return("", nil),
+					suite.mockExec.EXPECT().
+						RunPrivilegedCmd("chage", []string{"-M", "-1", "-d", "0", "john"}).
+						Return("", nil),
+				)
+			},
+			validateFunc: func(result *user.Result, err error) {
+				suite.NoError(err)
+				suite.Require().NotNil(result)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:     "when account expiry is invalid",
+			userName: "john",
+			opts: user.UpdateUserOpts{
+				AccountExpires: "tomorrow",
+			},
+			setup: func() {},
+			validateFunc: func(result *user.Result, err error) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "invalid account expiry")
+			},
+		},
+		{
+			name:     "when chage fails",
+			userName: "john",
+			opts: user.UpdateUserOpts{
+				ForcePasswordChange: true,
+			},
+			setup: func() {
+				suite.mockExec.EXPECT().
+					RunPrivilegedCmd("chage", []string{"-d", "0", "john"}).
+					Return("", errors.New("chage error"))
+			},
+			validateFunc: func(result *user.Result, err error) {
+				suite.Error(err)
+				suite.Nil(result)
+				suite.Contains(err.Error(), "chage failed")
+			},
+		},
 		{
 			name:     "when usermod fails",
 			userName: "john",
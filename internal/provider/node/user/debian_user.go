@@ -27,6 +27,7 @@ import (
 	"log/slog"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -34,6 +35,12 @@ const (
 	passwdFields   = 7
 	passwdLocked   = "L"
 	passwdStatusFn = 2
+
+	// chage -l -i prints dates as ISO 8601.
+	isoDateLayout    = "2006-01-02"
+	chageNever       = "never"
+	chageMustChange  = "password must be changed"
+	chageRemoveLimit = "-1"
 )
 
 // ListUsers returns all user accounts from /etc/passwd.
@@ -60,6 +67,7 @@ func (d *Debian) ListUsers(
 
 		u.Groups = groups
 		u.Locked = locked
+		u.Aging = d.getPasswordAgingOrWarn(u.Name)
 		users = append(users, u)
 	}
 
@@ -91,6 +99,7 @@ func (d *Debian) GetUser(
 
 			u.Groups = groups
 			u.Locked = locked
+			u.Aging = d.getPasswordAgingOrWarn(u.Name)
 
 			return &u, nil
 		}
@@ -106,9 +115,21 @@ func (d *Debian) CreateUser(
 ) (*Result, error) {
 	_ = ctx
 
+	chageArgs, err := d.buildChageArgs(
+		opts.Name,
+		opts.MinDays,
+		opts.MaxDays,
+		opts.WarnDays,
+		opts.AccountExpires,
+		opts.ForcePasswordChange,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("user: %w", err)
+	}
+
 	args := d.buildUseraddArgs(opts)
 
-	_, err := d.execManager.RunPrivilegedCmd("useradd", args)
+	_, err = d.execManager.RunPrivilegedCmd("useradd", args)
 	if err != nil {
 		return nil, fmt.Errorf("user: useradd failed: %w", err)
 	}
@@ -119,6 +140,14 @@ func (d *Debian) CreateUser(
 		}
 	}
 
+	// Aging is applied after the password, since chpasswd resets the
+	// last change date that a forced password change relies on.
+	if chageArgs != nil {
+		if _, err := d.execManager.RunPrivilegedCmd("chage", chageArgs); err != nil {
+			return nil, fmt.Errorf("user: chage failed: %w", err)
+		}
+	}
+
 	d.logger.Info(
 		"user created",
 		slog.String("name", opts.Name),
@@ -138,17 +167,36 @@ func (d *Debian) UpdateUser(
 ) (*Result, error) {
 	_ = ctx
 
+	chageArgs, err := d.buildChageArgs(
+		name,
+		opts.MinDays,
+		opts.MaxDays,
+		opts.WarnDays,
+		opts.AccountExpires,
+		opts.ForcePasswordChange,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("user: %w", err)
+	}
+
 	args := d.buildUsermodArgs(name, opts)
-	if len(args) == 0 {
+	if len(args) == 0 && len(chageArgs) == 0 {
 		return &Result{
 			Name:    name,
 			Changed: false,
 		}, nil
 	}
 
-	_, err := d.execManager.RunPrivilegedCmd("usermod", args)
-	if err != nil {
-		return nil, fmt.Errorf("user: usermod failed: %w", err)
+	if len(args) > 0 {
+		if _, err := d.execManager.RunPrivilegedCmd("usermod", args); err != nil {
+			return nil, fmt.Errorf("user: usermod failed: %w", err)
+		}
+	}
+
+	if len(chageArgs) > 0 {
+		if _, err := d.execManager.RunPrivilegedCmd("chage", chageArgs); err != nil {
+			return nil, fmt.Errorf("user: chage failed: %w", err)
+		}
 	}
 
 	d.logger.Info(
@@ -281,6 +329,169 @@ func (d *Debian) getUserDetails(
 	return groups, locked, nil
 }
 
+// getPasswordAgingOrWarn returns a user's password aging settings, logging
+// a warning and returning nil when they cannot be read.
+func (d *Debian) getPasswordAgingOrWarn(
+	name string,
+) *PasswordAging {
+	aging, err := d.getPasswordAging(name)
+	if err != nil {
+		d.logger.Warn(
+			"failed to get password aging",
+			slog.String("name", name),
+			slog.String("error", err.Error()),
+		)
+
+		return nil
+	}
+
+	return aging
+}
+
+// getPasswordAging reads a user's password aging and account expiry
+// settings with chage -l. chage translates its labels, so it runs in the
+// C locale with -i for ISO 8601 dates. Output without any known label is
+// an error rather than a zeroed result.
+func (d *Debian) getPasswordAging(
+	name string,
+) (*PasswordAging, error) {
+	out, err := d.execManager.RunCmd(
+		"env",
+		[]string{"LC_ALL=C", "chage", "-l", "-i", name},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("chage -l %s: %w", name, err)
+	}
+
+	aging := &PasswordAging{}
+	recognized := 0
+	for _, line := range strings.Split(out, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		var parseErr error
+		switch key {
+		case "Last password change":
+			if value == chageMustChange {
+				aging.MustChange = true
+			} else {
+				aging.LastChange, parseErr = parseChageDate(value)
+			}
+		case "Password expires":
+			if value == chageMustChange {
+				aging.MustChange = true
+			} else {
+				aging.PasswordExpires, parseErr = parseChageDate(value)
+			}
+		case "Account expires":
+			aging.AccountExpires, parseErr = parseChageDate(value)
+		case "Minimum number of days between password change":
+			aging.MinDays, parseErr = strconv.Atoi(value)
+		case "Maximum number of days between password change":
+			aging.MaxDays, parseErr = strconv.Atoi(value)
+		case "Number of days of warning before password expires":
+			aging.WarnDays, parseErr = strconv.Atoi(value)
+		default:
+			continue
+		}
+		recognized++
+
+		if parseErr != nil {
+			return nil, fmt.Errorf("chage -l %s: parse %q: %w", name, key, parseErr)
+		}
+	}
+
+	if recognized == 0 {
+		return nil, fmt.Errorf("chage -l %s: no password aging fields in output", name)
+	}
+
+	return aging, nil
+}
+
+// parseChageDate converts a date printed by chage -l to YYYY-MM-DD.
+// "never" is returned as an empty string.
+func parseChageDate(
+	value string,
+) (string, error) {
+	if value == chageNever {
+		return "", nil
+	}
+
+	t, err := time.Parse(isoDateLayout, value)
+	if err != nil {
+		return "", fmt.Errorf("invalid date %q", value)
+	}
+
+	return t.Format(isoDateLayout), nil
+}
+
+// buildChageArgs constructs command-line arguments for chage. Returns nil
+// when no aging setting is requested.
+func (d *Debian) buildChageArgs(
+	name string,
+	minDays *int,
+	maxDays *int,
+	warnDays *int,
+	accountExpires string,
+	forcePasswordChange bool,
+) ([]string, error) {
+	var args []string
+
+	days := []struct {
+		flag  string
+		label string
+		value *int
+	}{
+		{"-m", "min days", minDays},
+		{"-M", "max days", maxDays},
+		{"-W", "warn days", warnDays},
+	}
+	for _, day := range days {
+		if day.value == nil {
+			continue
+		}
+
+		if *day.value < -1 {
+			return nil, fmt.Errorf("invalid %s %d: must be -1 or greater", day.label, *day.value)
+		}
+
+		args = append(args, day.flag, strconv.Itoa(*day.value))
+	}
+
+	if accountExpires != "" {
+		expires := chageRemoveLimit
+		if accountExpires != chageNever {
+			if _, err := time.Parse(isoDateLayout, accountExpires); err != nil {
+				return nil, fmt.Errorf(
+					"invalid account expiry %q: must be YYYY-MM-DD or %q",
+					accountExpires,
+					chageNever,
+				)
+			}
+			expires = accountExpires
+		}
+
+		args = append(args, "-E", expires)
+	}
+
+	if forcePasswordChange {
+		args = append(args, "-d", "0")
+	}
+
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	args = append(args, name)
+
+	return args, nil
+}
+
 // buildUseraddArgs constructs command-line arguments for useradd.
 func (d *Debian) buildUseraddArgs(
 	opts CreateUserOpts,
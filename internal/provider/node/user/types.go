@@ -57,6 +57,30 @@ type User struct {
 	Shell  string   `json:"shell"`
 	Groups []string `json:"groups,omitempty"`
 	Locked bool     `json:"locked"`
+	// Aging holds the password aging and account expiry settings reported
+	// by `chage -l`. Nil when they could not be read.
+	Aging *PasswordAging `json:"aging,omitempty"`
+}
+
+// PasswordAging represents the password aging and account expiry settings
+// of a user account from /etc/shadow. Dates are formatted as YYYY-MM-DD and
+// are empty when unset ("never").
+type PasswordAging struct {
+	// LastChange is the date of the last password change.
+	LastChange string `json:"last_change,omitempty"`
+	// MustChange is true when the password must be changed at next login.
+	MustChange bool `json:"must_change,omitempty"`
+	// PasswordExpires is the date the password expires.
+	PasswordExpires string `json:"password_expires,omitempty"`
+	// AccountExpires is the date the account expires.
+	AccountExpires string `json:"account_expires,omitempty"`
+	// MinDays is the minimum number of days between password changes.
+	MinDays int `json:"min_days"`
+	// MaxDays is the maximum number of days a password is valid.
+	MaxDays int `json:"max_days"`
+	// WarnDays is the number of days of warning before the password
+	// expires.
+	WarnDays int `json:"warn_days"`
 }
 
 // CreateUserOpts contains options for creating a new user.
@@ -69,6 +93,16 @@ type CreateUserOpts struct {
 	Groups   []string `json:"groups,omitempty"`
 	Password string   `json:"password,omitempty"`
 	System   bool     `json:"system,omitempty"`
+	// MinDays, MaxDays and WarnDays set the password aging of the new
+	// account. Nil leaves the system default.
+	MinDays  *int `json:"min_days,omitempty"`
+	MaxDays  *int `json:"max_days,omitempty"`
+	WarnDays *int `json:"warn_days,omitempty"`
+	// AccountExpires is the account expiry date as YYYY-MM-DD.
+	AccountExpires string `json:"account_expires,omitempty"`
+	// ForcePasswordChange requires the password to be changed at first
+	// login.
+	ForcePasswordChange bool `json:"force_password_change,omitempty"`
 }
 
 // UpdateUserOpts contains options for updating an existing user.
@@ -77,6 +111,17 @@ type UpdateUserOpts struct {
 	Home   string   `json:"home,omitempty"`
 	Groups []string `json:"groups,omitempty"`
 	Lock   *bool    `json:"lock,omitempty"`
+	// MinDays, MaxDays and WarnDays replace the password aging settings.
+	// Nil leaves the current value; -1 removes the limit.
+	MinDays  *int `json:"min_days,omitempty"`
+	MaxDays  *int `json:"max_days,omitempty"`
+	WarnDays *int `json:"warn_days,omitempty"`
+	// AccountExpires is the account expiry date as YYYY-MM-DD, or "never"
+	// to remove the expiry.
+	AccountExpires string `json:"account_expires,omitempty"`
+	// ForcePasswordChange expires the password so it must be changed at
+	// next login.
+	ForcePasswordChange bool `json:"force_password_change,omitempty"`
 }
 
 // Group represents a system group.
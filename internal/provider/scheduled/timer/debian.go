@@ -0,0 +1,534 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package timer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/avfs/avfs"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/osapi-io/osapi/internal/exec"
+	"github.com/osapi-io/osapi/internal/job"
+	"github.com/osapi-io/osapi/internal/provider"
+	"github.com/osapi-io/osapi/internal/provider/file"
+)
+
+const (
+	// unitDir holds the managed timer and service units.
+	unitDir = "/etc/systemd/system"
+	// managedPrefix marks units written by osapi.
+	managedPrefix = "osapi-"
+	// timerTemplate is the system template rendered into each timer unit.
+	timerTemplate = "osapi/timer.tmpl"
+	// serviceTemplate is the system template rendered into each service
+	// unit that runs a command.
+	serviceTemplate = "osapi/timer-service.tmpl"
+)
+
+var (
+	validName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	validUnit = regexp.MustCompile(`^[a-zA-Z0-9_.@:-]+$`)
+	validUser = regexp.MustCompile(`^[a-z_][a-z0-9_-]*\$?$`)
+)
+
+// Compile-time checks.
+var (
+	_ Provider             = (*Debian)(nil)
+	_ provider.FactsSetter = (*Debian)(nil)
+)
+
+// Debian implements the Provider interface for Debian-family systems.
+// A timer is managed when its timer unit is recorded in the file-state
+// KV. The schedule, command and user are kept in the state metadata of
+// the units so they can be reported without parsing the files. Timer
+// control uses systemctl via exec.Manager.
+type Debian struct {
+	provider.FactsAware
+	logger       *slog.Logger
+	fs           avfs.VFS
+	fileDeployer file.Deployer
+	stateKV      jetstream.KeyValue
+	execManager  exec.Manager
+	hostname     string
+}
+
+// NewDebianProvider factory to create a new Debian instance.
+func NewDebianProvider(
+	logger *slog.Logger,
+	fs avfs.VFS,
+	fileDeployer file.Deployer,
+	stateKV jetstream.KeyValue,
+	execManager exec.Manager,
+	hostname string,
+) *Debian {
+	return &Debian{
+		logger:       logger.With(slog.String("subsystem", "provider.timer")),
+		fs:           fs,
+		fileDeployer: fileDeployer,
+		stateKV:      stateKV,
+		execManager:  execManager,
+		hostname:     hostname,
+	}
+}
+
+// Create deploys the service and timer units of a new timer, then
+// enables and starts the timer. Idempotent: returns Changed: false if
+// the timer is already managed.
+func (d *Debian) Create(
+	ctx context.Context,
+	entry Entry,
+) (*CreateResult, error) {
+	if err := validateEntry(entry); err != nil {
+		return nil, err
+	}
+
+	if entry.Schedule == "" {
+		return nil, fmt.Errorf("timer %q: schedule is required", entry.Name)
+	}
+
+	if entry.Command == "" && entry.Object == "" {
+		return nil, fmt.Errorf("timer %q: command or object is required", entry.Name)
+	}
+
+	// Already managed — nothing to do.
+	if d.fileState(ctx, timerPath(entry.Name)) != nil {
+		return &CreateResult{
+			Name:    entry.Name,
+			Changed: false,
+		}, nil
+	}
+
+	if err := d.validateSchedule(entry.Schedule); err != nil {
+		return nil, fmt.Errorf("timer: create %q: %w", entry.Name, err)
+	}
+
+	serviceChanged, err := d.deployService(ctx, entry)
+	if err != nil {
+		return nil, fmt.Errorf("timer: create %q: %w", entry.Name, err)
+	}
+
+	timerChanged, err := d.deployTimer(ctx, entry.Name, entry.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("timer: create %q: %w", entry.Name, err)
+	}
+
+	if err := d.daemonReload(); err != nil {
+		return nil, fmt.Errorf("timer: create %q: %w", entry.Name, err)
+	}
+
+	if err := d.systemctl("enable", "--now", timerUnit(entry.Name)); err != nil {
+		return nil, fmt.Errorf("timer: create %q: %w", entry.Name, err)
+	}
+
+	d.logger.Info(
+		"timer created",
+		slog.String("name", entry.Name),
+		slog.String("schedule", entry.Schedule),
+	)
+
+	return &CreateResult{
+		Name:    entry.Name,
+		Changed: serviceChanged || timerChanged,
+	}, nil
+}
+
+// Update redeploys the units of a managed timer. The timer unit is only
+// redeployed when a new schedule is given, and the service unit only when
+// the command, object, user, content type or vars change. A new command
+// replaces an object and vice versa. The timer is restarted so that the
+// next trigger time is recomputed.
+func (d *Debian) Update(
+	ctx context.Context,
+	entry Entry,
+) (*UpdateResult, error) {
+	if err := validateEntry(entry); err != nil {
+		return nil, err
+	}
+
+	if d.fileState(ctx, timerPath(entry.Name)) == nil {
+		return nil, fmt.Errorf("timer %q: not found", entry.Name)
+	}
+
+	changed := false
+
+	if entry.Schedule != "" {
+		if err := d.validateSchedule(entry.Schedule); err != nil {
+			return nil, fmt.Errorf("timer: update %q: %w", entry.Name, err)
+		}
+
+		timerChanged, err := d.deployTimer(ctx, entry.Name, entry.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("timer: update %q: %w", entry.Name, err)
+		}
+		changed = timerChanged
+	}
+
+	if service, ok := d.mergeService(ctx, entry); ok {
+		serviceChanged, err := d.deployService(ctx, service)
+		if err != nil {
+			return nil, fmt.Errorf("timer: update %q: %w", entry.Name, err)
+		}
+		changed = changed || serviceChanged
+	}
+
+	if changed {
+		if err := d.daemonReload(); err != nil {
+			return nil, fmt.Errorf("timer: update %q: %w", entry.Name, err)
+		}
+
+		if err := d.systemctl("restart", timerUnit(entry.Name)); err != nil {
+			return nil, fmt.Errorf("timer: update %q: %w", entry.Name, err)
+		}
+	}
+
+	d.logger.Info(
+		"timer updated",
+		slog.String("name", entry.Name),
+		slog.Bool("changed", changed),
+	)
+
+	return &UpdateResult{
+		Name:    entry.Name,
+		Changed: changed,
+	}, nil
+}
+
+// Delete stops and disables a managed timer and undeploys its units.
+// Idempotent: returns Changed: false if the timer is not managed.
+func (d *Debian) Delete(
+	ctx context.Context,
+	name string,
+) (*DeleteResult, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, 2)
+	for _, path := range []string{timerPath(name), servicePath(name)} {
+		if d.fileState(ctx, path) != nil {
+			paths = append(paths, path)
+		}
+	}
+
+	if len(paths) == 0 {
+		return &DeleteResult{
+			Name:    name,
+			Changed: false,
+		}, nil
+	}
+
+	// Best-effort stop and disable before removing the unit files.
+	if err := d.systemctl("disable", "--now", timerUnit(name)); err != nil {
+		d.logger.Warn(
+			"failed to stop timer before delete",
+			slog.String("name", name),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	changed := false
+	for _, path := range paths {
+		result, err := d.fileDeployer.Undeploy(ctx, file.UndeployRequest{
+			Path: path,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("timer: delete %q: %w", name, err)
+		}
+		changed = changed || result.Changed
+	}
+
+	if changed {
+		if err := d.daemonReload(); err != nil {
+			return nil, fmt.Errorf("timer: delete %q: %w", name, err)
+		}
+	}
+
+	d.logger.Info(
+		"timer deleted",
+		slog.String("name", name),
+		slog.Bool("changed", changed),
+	)
+
+	return &DeleteResult{
+		Name:    name,
+		Changed: changed,
+	}, nil
+}
+
+// mergeService applies the service fields of an update to the deployed
+// service unit. Returns false when the update leaves the service unit
+// untouched. The user only applies to command timers, and the content
+// type of an object is kept unless a new one is given.
+func (d *Debian) mergeService(
+	ctx context.Context,
+	entry Entry,
+) (Entry, bool) {
+	service := Entry{
+		Name:        entry.Name,
+		ContentType: entry.ContentType,
+		Vars:        entry.Vars,
+	}
+
+	if state := d.fileState(ctx, servicePath(entry.Name)); state != nil {
+		if state.Metadata["source"] == "command" {
+			service.Command = state.Metadata["command"]
+			service.User = state.Metadata["user"]
+		} else {
+			service.Object = state.ObjectName
+			if service.ContentType == "" {
+				service.ContentType = state.ContentType
+			}
+		}
+	}
+
+	switch {
+	case entry.Command != "":
+		service.Command = entry.Command
+		service.Object = ""
+	case entry.Object != "":
+		service.Object = entry.Object
+		service.Command = ""
+	case entry.ContentType == "" && entry.Vars == nil &&
+		(entry.User == "" || service.Command == ""):
+		return Entry{}, false
+	}
+
+	if entry.User != "" {
+		service.User = entry.User
+	}
+
+	if service.Command == "" && service.Object == "" {
+		return Entry{}, false
+	}
+
+	return service, true
+}
+
+// deployTimer writes the timer unit of a timer from the built-in
+// template. Returns true when the file changed.
+func (d *Debian) deployTimer(
+	ctx context.Context,
+	name string,
+	schedule string,
+) (bool, error) {
+	path := timerPath(name)
+
+	result, err := d.fileDeployer.Deploy(ctx, file.DeployRequest{
+		ObjectName:  timerTemplate,
+		Path:        path,
+		Mode:        "0644",
+		ContentType: "template",
+		Vars: map[string]any{
+			"name":     name,
+			"schedule": schedule,
+		},
+		Metadata: map[string]string{"schedule": schedule},
+	})
+	if err != nil {
+		return false, fmt.Errorf("deploy %s: %w", path, err)
+	}
+
+	return result.Changed, nil
+}
+
+// deployService writes the service unit of a timer. A command is
+// rendered from the built-in template; otherwise the object is deployed
+// as the unit file. Returns true when the file changed.
+func (d *Debian) deployService(
+	ctx context.Context,
+	entry Entry,
+) (bool, error) {
+	path := servicePath(entry.Name)
+
+	req := file.DeployRequest{
+		ObjectName:  entry.Object,
+		Path:        path,
+		Mode:        "0644",
+		ContentType: entry.ContentType,
+		Vars:        entry.Vars,
+		Metadata:    map[string]string{"source": "object"},
+	}
+
+	if entry.Command != "" {
+		req = file.DeployRequest{
+			ObjectName:  serviceTemplate,
+			Path:        path,
+			Mode:        "0644",
+			ContentType: "template",
+			Vars: map[string]any{
+				"name":    entry.Name,
+				"command": escapeSpecifiers(entry.Command),
+				"user":    entry.User,
+			},
+			Metadata: map[string]string{
+				"source":  "command",
+				"command": entry.Command,
+				"user":    entry.User,
+			},
+		}
+	}
+
+	result, err := d.fileDeployer.Deploy(ctx, req)
+	if err != nil {
+		return false, fmt.Errorf("deploy %s: %w", path, err)
+	}
+
+	return result.Changed, nil
+}
+
+// validateSchedule checks an OnCalendar expression with
+// systemd-analyze, so that an invalid schedule fails before any unit
+// is written.
+func (d *Debian) validateSchedule(
+	schedule string,
+) error {
+	output, err := d.execManager.RunCmd("systemd-analyze", []string{"calendar", "--", schedule})
+	if err != nil {
+		return fmt.Errorf(
+			"invalid schedule %q: %s: %w",
+			schedule,
+			strings.TrimSpace(output),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// daemonReload runs systemctl daemon-reload to pick up unit file changes.
+func (d *Debian) daemonReload() error {
+	return d.systemctl("daemon-reload")
+}
+
+// systemctl runs a privileged systemctl command.
+func (d *Debian) systemctl(
+	args ...string,
+) error {
+	if output, err := d.execManager.RunPrivilegedCmd("systemctl", args); err != nil {
+		return fmt.Errorf(
+			"systemctl %s: %s: %w",
+			strings.Join(args, " "),
+			strings.TrimSpace(output),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// fileState returns the file-state KV entry for path, or nil when the
+// path is not tracked or has been undeployed.
+func (d *Debian) fileState(
+	ctx context.Context,
+	path string,
+) *job.FileState {
+	kvEntry, err := d.stateKV.Get(ctx, file.BuildStateKey(d.hostname, path))
+	if err != nil {
+		return nil
+	}
+
+	var state job.FileState
+	if err := json.Unmarshal(kvEntry.Value(), &state); err != nil {
+		return nil
+	}
+
+	if state.UndeployedAt != "" {
+		return nil
+	}
+
+	return &state
+}
+
+// timerUnit returns the unit name of a managed timer.
+func timerUnit(
+	name string,
+) string {
+	return managedPrefix + name + ".timer"
+}
+
+// timerPath returns the file path of a managed timer unit.
+func timerPath(
+	name string,
+) string {
+	return unitDir + "/" + timerUnit(name)
+}
+
+// servicePath returns the file path of a managed timer's service unit.
+func servicePath(
+	name string,
+) string {
+	return unitDir + "/" + managedPrefix + name + ".service"
+}
+
+// escapeSpecifiers escapes systemd unit specifiers so that a command is
+// run as written.
+func escapeSpecifiers(
+	command string,
+) string {
+	return strings.ReplaceAll(command, "%", "%%")
+}
+
+// validateEntry checks the fields of an entry that end up in unit files.
+func validateEntry(
+	entry Entry,
+) error {
+	if err := validateName(entry.Name); err != nil {
+		return err
+	}
+
+	if entry.Command != "" && entry.Object != "" {
+		return fmt.Errorf("timer %q: command and object are mutually exclusive", entry.Name)
+	}
+
+	if strings.ContainsAny(entry.Schedule, "\r\n") {
+		return fmt.Errorf("invalid timer schedule %q: must be a single line", entry.Schedule)
+	}
+
+	if strings.ContainsAny(entry.Command, "\r\n") {
+		return fmt.Errorf("invalid timer command %q: must be a single line", entry.Command)
+	}
+
+	if entry.User != "" && !validUser.MatchString(entry.User) {
+		return fmt.Errorf("invalid timer user %q: must match %s", entry.User, validUser.String())
+	}
+
+	return nil
+}
+
+// validateName checks that a timer name is safe for use in a unit name.
+func validateName(
+	name string,
+) error {
+	if name == "" {
+		return fmt.Errorf("invalid timer name: empty")
+	}
+	if !validName.MatchString(name) {
+		return fmt.Errorf("invalid timer name %q: must match %s", name, validName.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package timer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// listTimersRow represents a single timer from systemctl list-timers JSON
+// output. Trigger times are microseconds since the epoch, or null when
+// the timer has not elapsed or will not elapse.
+type listTimersRow struct {
+	Next      *uint64 `json:"next"`
+	Last      *uint64 `json:"last"`
+	Unit      string  `json:"unit"`
+	Activates string  `json:"activates"`
+}
+
+// List returns every timer reported by systemctl list-timers, ordered by
+// next trigger time. Managed timers carry their schedule and command, and
+// managed timers that systemd has not loaded are appended without trigger
+// times.
+func (d *Debian) List(
+	ctx context.Context,
+) ([]Timer, error) {
+	rows, err := d.listTimers()
+	if err != nil {
+		return nil, fmt.Errorf("timer: list: %w", err)
+	}
+
+	seen := make(map[string]bool, len(rows))
+	result := make([]Timer, 0, len(rows))
+	for _, row := range rows {
+		t := timerFromRow(row)
+		if name, ok := managedName(row.Unit); ok {
+			if d.applyState(ctx, &t, name) {
+				seen[name] = true
+			}
+		}
+
+		result = append(result, t)
+	}
+
+	for _, name := range d.managedNames(ctx) {
+		if seen[name] {
+			continue
+		}
+
+		t := Timer{
+			Name: name,
+			Unit: timerUnit(name),
+		}
+		d.applyState(ctx, &t, name)
+		result = append(result, t)
+	}
+
+	return result, nil
+}
+
+// Get returns a single timer by name. A managed timer is looked up by the
+// name it was created with; any other timer by its unit name without the
+// .timer suffix.
+func (d *Debian) Get(
+	ctx context.Context,
+	name string,
+) (*Timer, error) {
+	if name == "" || !validUnit.MatchString(name) {
+		return nil, fmt.Errorf("invalid timer name %q: must match %s", name, validUnit.String())
+	}
+
+	unit := name + ".timer"
+	managed := validName.MatchString(name) && d.fileState(ctx, timerPath(name)) != nil
+	if managed {
+		unit = timerUnit(name)
+	}
+
+	rows, err := d.listTimers(unit)
+	if err != nil {
+		return nil, fmt.Errorf("timer: get %q: %w", name, err)
+	}
+
+	t := Timer{
+		Name: name,
+		Unit: unit,
+	}
+	for _, row := range rows {
+		if row.Unit == unit {
+			t = timerFromRow(row)
+			t.Name = name
+		}
+	}
+
+	if managed {
+		d.applyState(ctx, &t, name)
+	} else if len(rows) == 0 {
+		return nil, fmt.Errorf("timer %q: not found", name)
+	}
+
+	return &t, nil
+}
+
+// listTimers runs systemctl list-timers for all timers, or for the units
+// matching patterns.
+func (d *Debian) listTimers(
+	patterns ...string,
+) ([]listTimersRow, error) {
+	args := append([]string{
+		"list-timers",
+		"--all",
+		"--no-pager",
+		"--output=json",
+	}, patterns...)
+
+	output, err := d.execManager.RunCmd("systemctl", args)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []listTimersRow
+	if err := json.Unmarshal([]byte(output), &rows); err != nil {
+		return nil, fmt.Errorf("parse timers: %w", err)
+	}
+
+	return rows, nil
+}
+
+// applyState fills in the managed configuration of t from the file-state
+// KV. Returns false when the timer unit is not managed.
+func (d *Debian) applyState(
+	ctx context.Context,
+	t *Timer,
+	name string,
+) bool {
+	timerState := d.fileState(ctx, timerPath(name))
+	if timerState == nil {
+		return false
+	}
+
+	t.Name = name
+	t.Managed = true
+	t.Schedule = timerState.Metadata["schedule"]
+	if t.Activates == "" {
+		t.Activates = managedPrefix + name + ".service"
+	}
+
+	serviceState := d.fileState(ctx, servicePath(name))
+	if serviceState == nil {
+		return true
+	}
+
+	if serviceState.Metadata["source"] == "command" {
+		t.Command = serviceState.Metadata["command"]
+		t.User = serviceState.Metadata["user"]
+	} else {
+		t.Object = serviceState.ObjectName
+	}
+
+	return true
+}
+
+// managedNames returns the names of the managed timer units found in the
+// unit directory.
+func (d *Debian) managedNames(
+	ctx context.Context,
+) []string {
+	entries, err := d.fs.ReadDir(unitDir)
+	if err != nil {
+		return nil
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		name, ok := managedName(entry.Name())
+		if !ok || d.fileState(ctx, timerPath(name)) == nil {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// managedName returns the timer name of an osapi timer unit.
+func managedName(
+	unit string,
+) (string, bool) {
+	if !strings.HasPrefix(unit, managedPrefix) || !strings.HasSuffix(unit, ".timer") {
+		return "", false
+	}
+
+	name := strings.TrimSuffix(strings.TrimPrefix(unit, managedPrefix), ".timer")
+	if !validName.MatchString(name) {
+		return "", false
+	}
+
+	return name, true
+}
+
+// timerFromRow converts a list-timers row to a Timer named after its unit.
+func timerFromRow(
+	row listTimersRow,
+) Timer {
+	return Timer{
+		Name:      strings.TrimSuffix(row.Unit, ".timer"),
+		Unit:      row.Unit,
+		Activates: row.Activates,
+		NextRun:   formatTimestamp(row.Next),
+		LastRun:   formatTimestamp(row.Last),
+	}
+}
+
+// formatTimestamp formats a systemd timestamp in microseconds as RFC 3339
+// in UTC. Unset timestamps are returned as an empty string.
+func formatTimestamp(
+	usec *uint64,
+) string {
+	if usec == nil || *usec == 0 {
+		return ""
+	}
+
+	return time.UnixMicro(int64(*usec)).UTC().Format(time.RFC3339)
+}
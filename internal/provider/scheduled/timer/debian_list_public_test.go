@@ -0,0 +1,362 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package timer_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	filemocks "github.com/osapi-io/osapi/internal/provider/file/mocks"
+	"github.com/osapi-io/osapi/internal/provider/scheduled/timer"
+)
+
+const (
+	reportTimerPath   = "/etc/systemd/system/osapi-report.timer"
+	reportServicePath = "/etc/systemd/system/osapi-report.service"
+	listTimersJSON    = `[
+  {"next":1767268800000000,"left":3600000000,"last":1767182400000000,"passed":82800000000,"unit":"osapi-backup.timer","activates":"osapi-backup.service"},
+  {"next":1767225600000000,"left":0,"last":null,"passed":null,"unit":"apt-daily.timer","activates":"apt-daily.service"},
+  {"next":null,"left":null,"last":null,"passed":null,"unit":"osapi-stale.timer","activates":"osapi-stale.service"}
+]`
+)
+
+type DebianListPublicTestSuite struct {
+	suite.Suite
+
+	ctrl        *gomock.Controller
+	memFs       avfs.VFS
+	mockStateKV *jobmocks.MockKeyValue
+	mockExec    *execmocks.MockManager
+	provider    *timer.Debian
+}
+
+func (suite *DebianListPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+	mockDeployer := filemocks.NewMockDeployer(suite.ctrl)
+	suite.mockStateKV = jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+
+	_ = suite.memFs.MkdirAll("/etc/systemd/system", 0o755)
+
+	suite.provider = timer.NewDebianProvider(
+		logger,
+		suite.memFs,
+		mockDeployer,
+		suite.mockStateKV,
+		suite.mockExec,
+		testHostname,
+	)
+}
+
+func (suite *DebianListPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *DebianListPublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *DebianListPublicTestSuite) expectStates(
+	states map[string][]byte,
+) {
+	expectStates(suite.ctrl, suite.mockStateKV, states)
+}
+
+// expectListTimers expects systemctl list-timers for the given unit
+// patterns.
+func (suite *DebianListPublicTestSuite) expectListTimers(
+	output string,
+	err error,
+	patterns ...string,
+) {
+	args := append([]string{
+		"list-timers",
+		"--all",
+		"--no-pager",
+		"--output=json",
+	}, patterns...)
+
+	suite.mockExec.EXPECT().
+		RunCmd("systemctl", args).
+		Return(output, err)
+}
+
+// writeUnit creates a unit file so the timer is discovered in the unit
+// directory.
+func (suite *DebianListPublicTestSuite) writeUnit(
+	path string,
+) {
+	_ = suite.memFs.WriteFile(path, []byte("# Managed by osapi. Do not edit.\n"), 0o644)
+}
+
+func (suite *DebianListPublicTestSuite) TestList() {
+	tests := []struct {
+		name         string
+		setup        func()
+		validateFunc func([]timer.Timer, error)
+	}{
+		{
+			name: "when timers exist returns trigger times and managed configuration",
+			setup: func() {
+				suite.writeUnit(timerPath)
+				suite.writeUnit(servicePath)
+				suite.writeUnit(reportTimerPath)
+				suite.writeUnit(reportServicePath)
+				suite.writeUnit("/etc/systemd/system/osapi-stale.timer")
+				suite.expectListTimers(listTimersJSON, nil)
+				suite.expectStates(map[string][]byte{
+					timerPath:         timerState(timerPath, "*-*-* 02:00:00"),
+					servicePath:       commandState(servicePath, "/usr/local/bin/backup", "backup"),
+					reportTimerPath:   timerState(reportTimerPath, "weekly"),
+					reportServicePath: objectState(reportServicePath, "report.service"),
+				})
+			},
+			validateFunc: func(timers []timer.Timer, err error) {
+				suite.Require().NoError(err)
+				suite.Require().Len(timers, 4)
+				suite.Equal(timer.Timer{
+					Name:      "backup",
+					Unit:      "osapi-backup.timer",
+					Activates: "osapi-backup.service",
+					Schedule:  "*-*-* 02:00:00",
+					Command:   "/usr/local/bin/backup",
+					User:      "backup",
+					Managed:   true,
+					NextRun:   "2026-01-01T12:00:00Z",
+					LastRun:   "2025-12-31T12:00:00Z",
+				}, timers[0])
+				suite.Equal(timer.Timer{
+					Name:      "apt-daily",
+					Unit:      "apt-daily.timer",
+					Activates: "apt-daily.service",
+					NextRun:   "2026-01-01T00:00:00Z",
+				}, timers[1])
+				suite.Equal(timer.Timer{
+					Name:      "osapi-stale",
+					Unit:      "osapi-stale.timer",
+					Activates: "osapi-stale.service",
+				}, timers[2])
+				suite.Equal(timer.Timer{
+					Name:      "report",
+					Unit:      "osapi-report.timer",
+					Activates: "osapi-report.service",
+					Schedule:  "weekly",
+					Object:    "report.service",
+					Managed:   true,
+				}, timers[3])
+			},
+		},
+		{
+			name: "when no timers exist returns empty list",
+			setup: func() {
+				suite.expectListTimers("[]", nil)
+			},
+			validateFunc: func(timers []timer.Timer, err error) {
+				suite.Require().NoError(err)
+				suite.Empty(timers)
+			},
+		},
+		{
+			name: "when systemctl fails returns error",
+			setup: func() {
+				suite.expectListTimers("", errors.New("exit status 1"))
+			},
+			validateFunc: func(timers []timer.Timer, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "timer: list")
+				suite.Nil(timers)
+			},
+		},
+		{
+			name: "when output is not JSON returns error",
+			setup: func() {
+				suite.expectListTimers("NEXT LEFT LAST PASSED UNIT ACTIVATES", nil)
+			},
+			validateFunc: func(timers []timer.Timer, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "parse timers")
+				suite.Nil(timers)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.List(context.Background())
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *DebianListPublicTestSuite) TestGet() {
+	tests := []struct {
+		name         string
+		timerName    string
+		setup        func()
+		validateFunc func(*timer.Timer, error)
+	}{
+		{
+			name:      "when timer is managed returns trigger times and configuration",
+			timerName: "backup",
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath:   timerState(timerPath, "daily"),
+					servicePath: commandState(servicePath, "true", ""),
+				})
+				suite.expectListTimers(
+					`[{"next":1767268800000000,"last":null,"unit":"osapi-backup.timer","activates":"osapi-backup.service"}]`,
+					nil,
+					"osapi-backup.timer",
+				)
+			},
+			validateFunc: func(t *timer.Timer, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&timer.Timer{
+					Name:      "backup",
+					Unit:      "osapi-backup.timer",
+					Activates: "osapi-backup.service",
+					Schedule:  "daily",
+					Command:   "true",
+					Managed:   true,
+					NextRun:   "2026-01-01T12:00:00Z",
+				}, t)
+			},
+		},
+		{
+			name:      "when managed timer is not loaded returns configuration only",
+			timerName: "backup",
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath: timerState(timerPath, "daily"),
+				})
+				suite.expectListTimers("[]", nil, "osapi-backup.timer")
+			},
+			validateFunc: func(t *timer.Timer, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&timer.Timer{
+					Name:      "backup",
+					Unit:      "osapi-backup.timer",
+					Activates: "osapi-backup.service",
+					Schedule:  "daily",
+					Managed:   true,
+				}, t)
+			},
+		},
+		{
+			name:      "when timer is not managed looks up the unit name",
+			timerName: "apt-daily",
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectListTimers(listTimersJSON, nil, "apt-daily.timer")
+			},
+			validateFunc: func(t *timer.Timer, err error) {
+				suite.Require().NoError(err)
+				suite.Equal(&timer.Timer{
+					Name:      "apt-daily",
+					Unit:      "apt-daily.timer",
+					Activates: "apt-daily.service",
+					NextRun:   "2026-01-01T00:00:00Z",
+				}, t)
+			},
+		},
+		{
+			name:      "when unit name contains a dot skips the state lookup",
+			timerName: "systemd-tmpfiles-clean.daily",
+			setup: func() {
+				suite.expectListTimers(
+					`[{"next":null,"last":null,"unit":"systemd-tmpfiles-clean.daily.timer","activates":"x.service"}]`,
+					nil,
+					"systemd-tmpfiles-clean.daily.timer",
+				)
+			},
+			validateFunc: func(t *timer.Timer, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("systemd-tmpfiles-clean.daily", t.Name)
+				suite.False(t.Managed)
+			},
+		},
+		{
+			name:      "when timer does not exist returns not found",
+			timerName: "missing",
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectListTimers("[]", nil, "missing.timer")
+			},
+			validateFunc: func(t *timer.Timer, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "not found")
+				suite.Nil(t)
+			},
+		},
+		{
+			name:      "when systemctl fails returns error",
+			timerName: "backup",
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectListTimers("", errors.New("exit status 1"), "backup.timer")
+			},
+			validateFunc: func(t *timer.Timer, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "timer: get \"backup\"")
+				suite.Nil(t)
+			},
+		},
+		{
+			name:      "when name is invalid returns error",
+			timerName: "a/b",
+			setup:     func() {},
+			validateFunc: func(t *timer.Timer, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid timer name")
+				suite.Nil(t)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.Get(context.Background(), tc.timerName)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func TestDebianListPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(DebianListPublicTestSuite))
+}
@@ -0,0 +1,928 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package timer_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/avfs/avfs"
+	"github.com/avfs/avfs/vfs/memfs"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	execmocks "github.com/osapi-io/osapi/internal/exec/mocks"
+	"github.com/osapi-io/osapi/internal/job"
+	jobmocks "github.com/osapi-io/osapi/internal/job/mocks"
+	"github.com/osapi-io/osapi/internal/provider/file"
+	filemocks "github.com/osapi-io/osapi/internal/provider/file/mocks"
+	"github.com/osapi-io/osapi/internal/provider/scheduled/timer"
+)
+
+const (
+	testHostname = "test-host"
+	timerPath    = "/etc/systemd/system/osapi-backup.timer"
+	servicePath  = "/etc/systemd/system/osapi-backup.service"
+)
+
+// stateJSON returns a JSON-encoded FileState for a timer unit.
+func stateJSON(
+	objectName string,
+	path string,
+	contentType string,
+	metadata map[string]string,
+) []byte {
+	state := job.FileState{
+		ObjectName:  objectName,
+		Path:        path,
+		SHA256:      "abc123",
+		ContentType: contentType,
+		DeployedAt:  "2026-01-01T00:00:00Z",
+		Metadata:    metadata,
+	}
+
+	b, _ := json.Marshal(state)
+
+	return b
+}
+
+// timerState returns the state of a managed timer unit.
+func timerState(
+	path string,
+	schedule string,
+) []byte {
+	return stateJSON("osapi/timer.tmpl", path, "template", map[string]string{
+		"schedule": schedule,
+	})
+}
+
+// commandState returns the state of a service unit rendered from a
+// command.
+func commandState(
+	path string,
+	command string,
+	user string,
+) []byte {
+	return stateJSON("osapi/timer-service.tmpl", path, "template", map[string]string{
+		"source":  "command",
+		"command": command,
+		"user":    user,
+	})
+}
+
+// objectState returns the state of a service unit deployed from an
+// object.
+func objectState(
+	path string,
+	object string,
+) []byte {
+	return stateJSON(object, path, "raw", map[string]string{
+		"source": "object",
+	})
+}
+
+// expectStates answers file-state KV lookups from a map of unit path to
+// state. Paths missing from the map are reported as not found.
+func expectStates(
+	ctrl *gomock.Controller,
+	stateKV *jobmocks.MockKeyValue,
+	states map[string][]byte,
+) {
+	byKey := make(map[string][]byte, len(states))
+	for path, data := range states {
+		byKey[file.BuildStateKey(testHostname, path)] = data
+	}
+
+	stateKV.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			key string,
+		) (jetstream.KeyValueEntry, error) {
+			data, ok := byKey[key]
+			if !ok {
+				return nil, jetstream.ErrKeyNotFound
+			}
+
+			entry := jobmocks.NewMockKeyValueEntry(ctrl)
+			entry.EXPECT().Value().Return(data).AnyTimes()
+
+			return entry, nil
+		}).
+		AnyTimes()
+}
+
+type DebianPublicTestSuite struct {
+	suite.Suite
+
+	ctrl         *gomock.Controller
+	logger       *slog.Logger
+	memFs        avfs.VFS
+	mockDeployer *filemocks.MockDeployer
+	mockStateKV  *jobmocks.MockKeyValue
+	mockExec     *execmocks.MockManager
+	provider     *timer.Debian
+}
+
+func (suite *DebianPublicTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	suite.memFs = memfs.New()
+	suite.mockDeployer = filemocks.NewMockDeployer(suite.ctrl)
+	suite.mockStateKV = jobmocks.NewMockKeyValue(suite.ctrl)
+	suite.mockExec = execmocks.NewMockManager(suite.ctrl)
+
+	suite.provider = timer.NewDebianProvider(
+		suite.logger,
+		suite.memFs,
+		suite.mockDeployer,
+		suite.mockStateKV,
+		suite.mockExec,
+		testHostname,
+	)
+}
+
+func (suite *DebianPublicTestSuite) SetupSubTest() {
+	suite.SetupTest()
+}
+
+func (suite *DebianPublicTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *DebianPublicTestSuite) expectStates(
+	states map[string][]byte,
+) {
+	expectStates(suite.ctrl, suite.mockStateKV, states)
+}
+
+// expectSchedule expects the schedule to be validated with
+// systemd-analyze.
+func (suite *DebianPublicTestSuite) expectSchedule(
+	schedule string,
+	output string,
+	err error,
+) {
+	suite.mockExec.EXPECT().
+		RunCmd("systemd-analyze", []string{"calendar", "--", schedule}).
+		Return(output, err)
+}
+
+// expectSystemctl expects a privileged systemctl call.
+func (suite *DebianPublicTestSuite) expectSystemctl(
+	err error,
+	args ...string,
+) {
+	suite.mockExec.EXPECT().
+		RunPrivilegedCmd("systemctl", args).
+		Return("", err)
+}
+
+func (suite *DebianPublicTestSuite) TestCreate() {
+	tests := []struct {
+		name         string
+		entry        timer.Entry
+		setup        func()
+		validateFunc func(*timer.CreateResult, error)
+	}{
+		{
+			name: "when command timer is new deploys units and enables it",
+			entry: timer.Entry{
+				Name:     "backup",
+				Schedule: "*-*-* 02:00:00",
+				Command:  "/usr/local/bin/backup --date=%Y",
+				User:     "backup",
+			},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectSchedule("*-*-* 02:00:00", "", nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						req file.DeployRequest,
+					) (*file.DeployResult, error) {
+						suite.Equal("osapi/timer-service.tmpl", req.ObjectName)
+						suite.Equal(servicePath, req.Path)
+						suite.Equal("template", req.ContentType)
+						suite.Equal(map[string]any{
+							"name":    "backup",
+							"command": "/usr/local/bin/backup --date=%%Y",
+							"user":    "backup",
+						}, req.Vars)
+						suite.Equal(map[string]string{
+							"source":  "command",
+							"command": "/usr/local/bin/backup --date=%Y",
+							"user":    "backup",
+						}, req.Metadata)
+						return &file.DeployResult{Changed: true, Path: req.Path}, nil
+					})
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						req file.DeployRequest,
+					) (*file.DeployResult, error) {
+						suite.Equal("osapi/timer.tmpl", req.ObjectName)
+						suite.Equal(timerPath, req.Path)
+						suite.Equal(map[string]any{
+							"name":     "backup",
+							"schedule": "*-*-* 02:00:00",
+						}, req.Vars)
+						suite.Equal("*-*-* 02:00:00", req.Metadata["schedule"])
+						return &file.DeployResult{Changed: true, Path: req.Path}, nil
+					})
+				suite.expectSystemctl(nil, "daemon-reload")
+				suite.expectSystemctl(nil, "enable", "--now", "osapi-backup.timer")
+			},
+			validateFunc: func(result *timer.CreateResult, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("backup", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name: "when object timer is new deploys the object as service unit",
+			entry: timer.Entry{
+				Name:        "backup",
+				Schedule:    "daily",
+				Object:      "backup.service",
+				ContentType: "raw",
+			},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectSchedule("daily", "", nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						req file.DeployRequest,
+					) (*file.DeployResult, error) {
+						suite.Equal("backup.service", req.ObjectName)
+						suite.Equal(servicePath, req.Path)
+						suite.Equal("raw", req.ContentType)
+						suite.Equal("object", req.Metadata["source"])
+						return &file.DeployResult{Changed: true, Path: req.Path}, nil
+					})
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(&file.DeployResult{Changed: true, Path: timerPath}, nil)
+				suite.expectSystemctl(nil, "daemon-reload")
+				suite.expectSystemctl(nil, "enable", "--now", "osapi-backup.timer")
+			},
+			validateFunc: func(result *timer.CreateResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:  "when timer is already managed returns unchanged",
+			entry: timer.Entry{Name: "backup", Schedule: "daily", Command: "true"},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath: timerState(timerPath, "daily"),
+				})
+			},
+			validateFunc: func(result *timer.CreateResult, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("backup", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:  "when schedule is invalid returns error",
+			entry: timer.Entry{Name: "backup", Schedule: "sometimes", Command: "true"},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectSchedule(
+					"sometimes",
+					"Failed to parse calendar specification 'sometimes'",
+					errors.New("exit status 1"),
+				)
+			},
+			validateFunc: func(result *timer.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid schedule \"sometimes\"")
+				suite.Contains(err.Error(), "Failed to parse calendar specification")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when service deploy fails returns error",
+			entry: timer.Entry{Name: "backup", Schedule: "daily", Command: "true"},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectSchedule("daily", "", nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("object not found"))
+			},
+			validateFunc: func(result *timer.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "timer: create \"backup\"")
+				suite.Contains(err.Error(), "object not found")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when timer deploy fails returns error",
+			entry: timer.Entry{Name: "backup", Schedule: "daily", Command: "true"},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectSchedule("daily", "", nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(&file.DeployResult{Changed: true}, nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("write failed"))
+			},
+			validateFunc: func(result *timer.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "deploy "+timerPath)
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when daemon-reload fails returns error",
+			entry: timer.Entry{Name: "backup", Schedule: "daily", Command: "true"},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectSchedule("daily", "", nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(&file.DeployResult{Changed: true}, nil).
+					Times(2)
+				suite.expectSystemctl(errors.New("exit status 1"), "daemon-reload")
+			},
+			validateFunc: func(result *timer.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "systemctl daemon-reload")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when enable fails returns error",
+			entry: timer.Entry{Name: "backup", Schedule: "daily", Command: "true"},
+			setup: func() {
+				suite.expectStates(nil)
+				suite.expectSchedule("daily", "", nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(&file.DeployResult{Changed: true}, nil).
+					Times(2)
+				suite.expectSystemctl(nil, "daemon-reload")
+				suite.expectSystemctl(
+					errors.New("exit status 1"),
+					"enable",
+					"--now",
+					"osapi-backup.timer",
+				)
+			},
+			validateFunc: func(result *timer.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "systemctl enable --now osapi-backup.timer")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when schedule is missing returns error",
+			entry: timer.Entry{Name: "backup", Command: "true"},
+			setup: func() {},
+			validateFunc: func(result *timer.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "schedule is required")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when command and object are missing returns error",
+			entry: timer.Entry{Name: "backup", Schedule: "daily"},
+			setup: func() {},
+			validateFunc: func(result *timer.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "command or object is required")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when command and object are both set returns error",
+			entry: timer.Entry{
+				Name:     "backup",
+				Schedule: "daily",
+				Command:  "true",
+				Object:   "backup.service",
+			},
+			setup: func() {},
+			validateFunc: func(result *timer.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "mutually exclusive")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when name is invalid returns error",
+			entry: timer.Entry{Name: "../etc", Schedule: "daily", Command: "true"},
+			setup: func() {},
+			validateFunc: func(result *timer.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid timer name")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when name is empty returns error",
+			entry: timer.Entry{Schedule: "daily", Command: "true"},
+			setup: func() {},
+			validateFunc: func(result *timer.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid timer name: empty")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when command spans lines returns error",
+			entry: timer.Entry{
+				Name:     "backup",
+				Schedule: "daily",
+				Command:  "true\nExecStartPost=/bin/sh",
+			},
+			setup: func() {},
+			validateFunc: func(result *timer.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid timer command")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when schedule spans lines returns error",
+			entry: timer.Entry{
+				Name:     "backup",
+				Schedule: "daily\nPersistent=false",
+				Command:  "true",
+			},
+			setup: func() {},
+			validateFunc: func(result *timer.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid timer schedule")
+				suite.Nil(result)
+			},
+		},
+		{
+			name: "when user is invalid returns error",
+			entry: timer.Entry{
+				Name:     "backup",
+				Schedule: "daily",
+				Command:  "true",
+				User:     "Root User",
+			},
+			setup: func() {},
+			validateFunc: func(result *timer.CreateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid timer user")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.Create(context.Background(), tc.entry)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestUpdate() {
+	tests := []struct {
+		name         string
+		entry        timer.Entry
+		setup        func()
+		validateFunc func(*timer.UpdateResult, error)
+	}{
+		{
+			name:  "when schedule changes redeploys timer unit and restarts",
+			entry: timer.Entry{Name: "backup", Schedule: "weekly"},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath:   timerState(timerPath, "daily"),
+					servicePath: commandState(servicePath, "true", ""),
+				})
+				suite.expectSchedule("weekly", "", nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						req file.DeployRequest,
+					) (*file.DeployResult, error) {
+						suite.Equal(timerPath, req.Path)
+						suite.Equal("weekly", req.Vars["schedule"])
+						return &file.DeployResult{Changed: true, Path: req.Path}, nil
+					})
+				suite.expectSystemctl(nil, "daemon-reload")
+				suite.expectSystemctl(nil, "restart", "osapi-backup.timer")
+			},
+			validateFunc: func(result *timer.UpdateResult, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("backup", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:  "when command replaces object renders service template",
+			entry: timer.Entry{Name: "backup", Command: "/usr/local/bin/backup"},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath:   timerState(timerPath, "daily"),
+					servicePath: objectState(servicePath, "backup.service"),
+				})
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						req file.DeployRequest,
+					) (*file.DeployResult, error) {
+						suite.Equal("osapi/timer-service.tmpl", req.ObjectName)
+						suite.Equal(servicePath, req.Path)
+						suite.Equal("/usr/local/bin/backup", req.Metadata["command"])
+						return &file.DeployResult{Changed: true, Path: req.Path}, nil
+					})
+				suite.expectSystemctl(nil, "daemon-reload")
+				suite.expectSystemctl(nil, "restart", "osapi-backup.timer")
+			},
+			validateFunc: func(result *timer.UpdateResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:  "when object replaces command deploys the object",
+			entry: timer.Entry{Name: "backup", Object: "backup.service"},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath:   timerState(timerPath, "daily"),
+					servicePath: commandState(servicePath, "true", "backup"),
+				})
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						req file.DeployRequest,
+					) (*file.DeployResult, error) {
+						suite.Equal("backup.service", req.ObjectName)
+						suite.Equal(map[string]string{"source": "object"}, req.Metadata)
+						return &file.DeployResult{Changed: true, Path: req.Path}, nil
+					})
+				suite.expectSystemctl(nil, "daemon-reload")
+				suite.expectSystemctl(nil, "restart", "osapi-backup.timer")
+			},
+			validateFunc: func(result *timer.UpdateResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:  "when user changes on command timer keeps the command",
+			entry: timer.Entry{Name: "backup", User: "nobody"},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath:   timerState(timerPath, "daily"),
+					servicePath: commandState(servicePath, "true", "backup"),
+				})
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						req file.DeployRequest,
+					) (*file.DeployResult, error) {
+						suite.Equal("true", req.Vars["command"])
+						suite.Equal("nobody", req.Vars["user"])
+						return &file.DeployResult{Changed: true, Path: req.Path}, nil
+					})
+				suite.expectSystemctl(nil, "daemon-reload")
+				suite.expectSystemctl(nil, "restart", "osapi-backup.timer")
+			},
+			validateFunc: func(result *timer.UpdateResult, err error) {
+				suite.Require().NoError(err)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:  "when vars change on object timer keeps the content type",
+			entry: timer.Entry{Name: "backup", Vars: map[string]any{"dest": "/srv"}},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath:   timerState(timerPath, "daily"),
+					servicePath: objectState(servicePath, "backup.service"),
+				})
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						req file.DeployRequest,
+					) (*file.DeployResult, error) {
+						suite.Equal("backup.service", req.ObjectName)
+						suite.Equal("raw", req.ContentType)
+						suite.Equal(map[string]any{"dest": "/srv"}, req.Vars)
+						return &file.DeployResult{Changed: false, Path: req.Path}, nil
+					})
+			},
+			validateFunc: func(result *timer.UpdateResult, err error) {
+				suite.Require().NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:  "when user changes on object timer leaves units untouched",
+			entry: timer.Entry{Name: "backup", User: "nobody"},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath:   timerState(timerPath, "daily"),
+					servicePath: objectState(servicePath, "backup.service"),
+				})
+			},
+			validateFunc: func(result *timer.UpdateResult, err error) {
+				suite.Require().NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:  "when timer is not managed returns not found",
+			entry: timer.Entry{Name: "backup", Schedule: "daily"},
+			setup: func() {
+				suite.expectStates(nil)
+			},
+			validateFunc: func(result *timer.UpdateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "not found")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when schedule is invalid returns error",
+			entry: timer.Entry{Name: "backup", Schedule: "sometimes"},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath: timerState(timerPath, "daily"),
+				})
+				suite.expectSchedule("sometimes", "", errors.New("exit status 1"))
+			},
+			validateFunc: func(result *timer.UpdateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "timer: update \"backup\"")
+				suite.Contains(err.Error(), "invalid schedule")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when timer deploy fails returns error",
+			entry: timer.Entry{Name: "backup", Schedule: "weekly"},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath: timerState(timerPath, "daily"),
+				})
+				suite.expectSchedule("weekly", "", nil)
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("write failed"))
+			},
+			validateFunc: func(result *timer.UpdateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "write failed")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when service deploy fails returns error",
+			entry: timer.Entry{Name: "backup", Command: "false"},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath:   timerState(timerPath, "daily"),
+					servicePath: commandState(servicePath, "true", ""),
+				})
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("write failed"))
+			},
+			validateFunc: func(result *timer.UpdateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "deploy "+servicePath)
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when restart fails returns error",
+			entry: timer.Entry{Name: "backup", Command: "false"},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath:   timerState(timerPath, "daily"),
+					servicePath: commandState(servicePath, "true", ""),
+				})
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(&file.DeployResult{Changed: true}, nil)
+				suite.expectSystemctl(nil, "daemon-reload")
+				suite.expectSystemctl(errors.New("exit status 1"), "restart", "osapi-backup.timer")
+			},
+			validateFunc: func(result *timer.UpdateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "systemctl restart osapi-backup.timer")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when daemon-reload fails returns error",
+			entry: timer.Entry{Name: "backup", Command: "false"},
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath:   timerState(timerPath, "daily"),
+					servicePath: commandState(servicePath, "true", ""),
+				})
+				suite.mockDeployer.EXPECT().
+					Deploy(gomock.Any(), gomock.Any()).
+					Return(&file.DeployResult{Changed: true}, nil)
+				suite.expectSystemctl(errors.New("exit status 1"), "daemon-reload")
+			},
+			validateFunc: func(result *timer.UpdateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "systemctl daemon-reload")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when command and object are both set returns error",
+			entry: timer.Entry{Name: "backup", Command: "true", Object: "backup.service"},
+			setup: func() {},
+			validateFunc: func(result *timer.UpdateResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "mutually exclusive")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.Update(context.Background(), tc.entry)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func (suite *DebianPublicTestSuite) TestDelete() {
+	tests := []struct {
+		name         string
+		timerName    string
+		setup        func()
+		validateFunc func(*timer.DeleteResult, error)
+	}{
+		{
+			name:      "when timer is managed stops it and undeploys units",
+			timerName: "backup",
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath:   timerState(timerPath, "daily"),
+					servicePath: commandState(servicePath, "true", ""),
+				})
+				suite.expectSystemctl(nil, "disable", "--now", "osapi-backup.timer")
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: timerPath}).
+					Return(&file.UndeployResult{Changed: true}, nil)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: servicePath}).
+					Return(&file.UndeployResult{Changed: true}, nil)
+				suite.expectSystemctl(nil, "daemon-reload")
+			},
+			validateFunc: func(result *timer.DeleteResult, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("backup", result.Name)
+				suite.True(result.Changed)
+			},
+		},
+		{
+			name:      "when stop fails still undeploys units",
+			timerName: "backup",
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath: timerState(timerPath, "daily"),
+				})
+				suite.expectSystemctl(
+					errors.New("exit status 5"),
+					"disable",
+					"--now",
+					"osapi-backup.timer",
+				)
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), file.UndeployRequest{Path: timerPath}).
+					Return(&file.UndeployResult{Changed: false}, nil)
+			},
+			validateFunc: func(result *timer.DeleteResult, err error) {
+				suite.Require().NoError(err)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:      "when timer is not managed returns unchanged",
+			timerName: "backup",
+			setup: func() {
+				suite.expectStates(nil)
+			},
+			validateFunc: func(result *timer.DeleteResult, err error) {
+				suite.Require().NoError(err)
+				suite.Equal("backup", result.Name)
+				suite.False(result.Changed)
+			},
+		},
+		{
+			name:      "when undeploy fails returns error",
+			timerName: "backup",
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath: timerState(timerPath, "daily"),
+				})
+				suite.expectSystemctl(nil, "disable", "--now", "osapi-backup.timer")
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("remove failed"))
+			},
+			validateFunc: func(result *timer.DeleteResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "timer: delete \"backup\"")
+				suite.Contains(err.Error(), "remove failed")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:      "when daemon-reload fails returns error",
+			timerName: "backup",
+			setup: func() {
+				suite.expectStates(map[string][]byte{
+					timerPath: timerState(timerPath, "daily"),
+				})
+				suite.expectSystemctl(nil, "disable", "--now", "osapi-backup.timer")
+				suite.mockDeployer.EXPECT().
+					Undeploy(gomock.Any(), gomock.Any()).
+					Return(&file.UndeployResult{Changed: true}, nil)
+				suite.expectSystemctl(errors.New("exit status 1"), "daemon-reload")
+			},
+			validateFunc: func(result *timer.DeleteResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "systemctl daemon-reload")
+				suite.Nil(result)
+			},
+		},
+		{
+			name:      "when name is invalid returns error",
+			timerName: "a/b",
+			setup:     func() {},
+			validateFunc: func(result *timer.DeleteResult, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "invalid timer name")
+				suite.Nil(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			got, err := suite.provider.Delete(context.Background(), tc.timerName)
+
+			tc.validateFunc(got, err)
+		})
+	}
+}
+
+func TestDebianPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(DebianPublicTestSuite))
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+// Package timer provides management of systemd timers. Each managed timer is
+// a pair of osapi-{name}.timer and osapi-{name}.service units in
+// /etc/systemd/system/. Delegates unit file writes to the file provider for
+// SHA tracking, idempotency, and template rendering.
+package timer
+
+import "context"
+
+// Provider implements the methods to manage systemd timers.
+type Provider interface {
+	// List returns all timers known to systemd, including osapi-managed
+	// timers that systemd has not loaded.
+	List(ctx context.Context) ([]Timer, error)
+	// Get returns a single timer by name.
+	Get(ctx context.Context, name string) (*Timer, error)
+	// Create deploys a new timer and service unit pair via the file
+	// provider and starts the timer.
+	Create(ctx context.Context, entry Entry) (*CreateResult, error)
+	// Update redeploys an existing timer and service unit pair via the
+	// file provider.
+	Update(ctx context.Context, entry Entry) (*UpdateResult, error)
+	// Delete stops a timer and undeploys its units via the file provider.
+	Delete(ctx context.Context, name string) (*DeleteResult, error)
+}
+
+// Entry represents a timer deployment request. The service unit runs
+// either Command, rendered from a built-in template, or the unit file
+// stored as Object.
+type Entry struct {
+	Name        string         `json:"name"`
+	Schedule    string         `json:"schedule,omitempty"`
+	Command     string         `json:"command,omitempty"`
+	Object      string         `json:"object,omitempty"`
+	User        string         `json:"user,omitempty"`
+	ContentType string         `json:"content_type,omitempty"`
+	Vars        map[string]any `json:"vars,omitempty"`
+}
+
+// Timer represents a systemd timer with its trigger times.
+type Timer struct {
+	Name      string `json:"name"`
+	Unit      string `json:"unit"`
+	Activates string `json:"activates,omitempty"`
+	Schedule  string `json:"schedule,omitempty"`
+	Command   string `json:"command,omitempty"`
+	Object    string `json:"object,omitempty"`
+	User      string `json:"user,omitempty"`
+	Managed   bool   `json:"managed"`
+	NextRun   string `json:"next_run,omitempty"`
+	LastRun   string `json:"last_run,omitempty"`
+}
+
+// CreateResult represents the outcome of a timer creation.
+type CreateResult struct {
+	Name    string `json:"name"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
+
+// UpdateResult represents the outcome of a timer update.
+type UpdateResult struct {
+	Name    string `json:"name"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
+
+// DeleteResult represents the outcome of a timer deletion.
+type DeleteResult struct {
+	Name    string `json:"name"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
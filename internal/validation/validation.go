@@ -26,6 +26,7 @@ import (
 	"reflect"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/go-playground/validator/v10"
 	cronparser "github.com/robfig/cron/v3"
@@ -71,6 +72,14 @@ func init() {
 		_, err := cronParser.Parse(fl.Field().String())
 		return err == nil
 	})
+
+	// no_whitespace rejects whitespace and control characters, for values
+	// written verbatim into whitespace-separated files such as fstab.
+	_ = instance.RegisterValidation("no_whitespace", func(fl validator.FieldLevel) bool {
+		return strings.IndexFunc(fl.Field().String(), func(r rune) bool {
+			return unicode.IsSpace(r) || unicode.IsControl(r)
+		}) < 0
+	})
 }
 
 // customHints maps validator tags to a hint appended to the default error.
@@ -102,6 +111,12 @@ var customHints = map[string]func(fe validator.FieldError) string{
 			fe.Value(),
 		)
 	},
+	"no_whitespace": func(fe validator.FieldError) string {
+		return fmt.Sprintf(
+			"%q must not contain whitespace or control characters",
+			fe.Value(),
+		)
+	},
 }
 
 // Struct validates a struct and returns the error message and false if invalid.
@@ -462,6 +462,69 @@ func (s *ValidationPublicTestSuite) TestGoDuration() {
 	}
 }
 
+func (s *ValidationPublicTestSuite) TestNoWhitespace() {
+	tests := []struct {
+		name     string
+		field    string
+		wantOK   bool
+		contains []string
+	}{
+		{
+			name:   "when single token passes",
+			field:  "defaults,noatime",
+			wantOK: true,
+		},
+		{
+			name:   "when empty string passes",
+			field:  "",
+			wantOK: true,
+		},
+		{
+			name:   "when contains space fails",
+			field:  "ext4 defaults",
+			wantOK: false,
+			contains: []string{
+				"no_whitespace",
+				"must not contain whitespace or control characters",
+			},
+		},
+		{
+			name:   "when contains newline fails",
+			field:  "ext4\n/dev/sdc1",
+			wantOK: false,
+		},
+		{
+			name:   "when contains tab fails",
+			field:  "defaults\tnoatime",
+			wantOK: false,
+		},
+		{
+			name:   "when contains control character fails",
+			field:  "defaults\x00",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			if len(tt.contains) > 0 {
+				// Test through Struct() to verify hint formatting.
+				type fstabReq struct {
+					Options string `validate:"no_whitespace"`
+				}
+				errMsg, ok := validation.Struct(fstabReq{Options: tt.field})
+				s.False(ok)
+				for _, c := range tt.contains {
+					s.Contains(errMsg, c)
+				}
+			} else {
+				_, ok := validation.Var(tt.field, "no_whitespace")
+				s.Equal(tt.wantOK, ok)
+			}
+		})
+	}
+}
+
 func (s *ValidationPublicTestSuite) TestAtLeastOneField() {
 	type allPointers struct {
 		Shell  *string
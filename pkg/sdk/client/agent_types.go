@@ -44,6 +44,7 @@ type Agent struct {
 	Memory           *Memory            `json:"memory,omitempty"`
 	OSInfo           *OSInfo            `json:"os_info,omitempty"`
 	PrimaryInterface string             `json:"primary_interface,omitempty"`
+	NetworkRenderer  string             `json:"network_renderer,omitempty"`
 	Interfaces       []NetworkInterface `json:"interfaces,omitempty"`
 	Routes           []Route            `json:"routes,omitempty"`
 	Conditions       []Condition        `json:"conditions,omitempty"`
@@ -160,6 +161,10 @@ func agentFromGen(
 		a.PrimaryInterface = *g.PrimaryInterface
 	}
 
+	if g.NetworkRenderer != nil {
+		a.NetworkRenderer = *g.NetworkRenderer
+	}
+
 	if g.Routes != nil {
 		routes := make([]Route, 0, len(*g.Routes))
 		for _, r := range *g.Routes {
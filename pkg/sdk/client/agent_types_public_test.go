@@ -54,6 +54,7 @@ func (suite *AgentTypesPublicTestSuite) TestAgentFromGen() {
 				packageMgr := "apt"
 				serviceMgr := "systemd"
 				primaryIface := "eth0"
+				renderer := "NetworkManager"
 				routeMask := "255.255.255.0"
 				routeFlags := "UG"
 				routeMetric := 100
@@ -100,6 +101,7 @@ func (suite *AgentTypesPublicTestSuite) TestAgentFromGen() {
 						Version:      "22.04",
 					},
 					PrimaryInterface: &primaryIface,
+					NetworkRenderer:  &renderer,
 					Interfaces: &[]gen.NetworkInterfaceResponse{
 						{
 							Name:   "eth0",
@@ -177,6 +179,7 @@ func (suite *AgentTypesPublicTestSuite) TestAgentFromGen() {
 				suite.Equal("22.04", a.OSInfo.Version)
 
 				suite.Equal("eth0", a.PrimaryInterface)
+				suite.Equal("NetworkManager", a.NetworkRenderer)
 
 				suite.Require().Len(a.Interfaces, 1)
 				suite.Equal("eth0", a.Interfaces[0].Name)
@@ -243,6 +246,7 @@ func (suite *AgentTypesPublicTestSuite) TestAgentFromGen() {
 				suite.Nil(a.Memory)
 				suite.Nil(a.OSInfo)
 				suite.Empty(a.PrimaryInterface)
+				suite.Empty(a.NetworkRenderer)
 				suite.Nil(a.Interfaces)
 				suite.Nil(a.Routes)
 				suite.Nil(a.Conditions)
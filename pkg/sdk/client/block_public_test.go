@@ -0,0 +1,483 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+type BlockPublicTestSuite struct {
+	suite.Suite
+
+	ctx context.Context
+}
+
+func (suite *BlockPublicTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+}
+
+func (suite *BlockPublicTestSuite) TestBlockList() {
+	tests := []struct {
+		name         string
+		handler      http.HandlerFunc
+		serverURL    string
+		validateFunc func(*client.Response[client.Collection[client.BlockListResult]], error)
+	}{
+		{
+			name: "when listing block devices returns results",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","devices":[{"name":"sda","path":"/dev/sda","type":"disk","size":21474836480,"model":"QEMU HARDDISK","read_only":false,"children":[{"name":"sda2","path":"/dev/sda2","type":"part","size":20400046080,"fs_type":"LVM2_member","uuid":"pv-uuid","read_only":false}]}],"physical_volumes":[{"name":"/dev/sda2","volume_group":"vg0","size":20400046080,"free":9663676416}],"volume_groups":[{"name":"vg0","size":20400046080,"free":9663676416,"extent_size":4194304,"free_extents":2304,"pv_count":1,"lv_count":1}],"logical_volumes":[{"name":"root","volume_group":"vg0","path":"/dev/vg0/root","size":10737418240,"attr":"-wi-ao----"}]}]}`,
+					),
+				)
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.BlockListResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Equal("00000000-0000-0000-0000-000000000001", resp.Data.JobID)
+				suite.Len(resp.Data.Results, 1)
+				suite.Equal("agent1", resp.Data.Results[0].Hostname)
+				suite.Equal("ok", resp.Data.Results[0].Status)
+				result := resp.Data.Results[0]
+				suite.Require().Len(result.Devices, 1)
+				suite.Equal("sda", result.Devices[0].Name)
+				suite.Equal(int64(21474836480), result.Devices[0].Size)
+				suite.Equal("QEMU HARDDISK", result.Devices[0].Model)
+				suite.Require().Len(result.Devices[0].Children, 1)
+				suite.Equal("LVM2_member", result.Devices[0].Children[0].FSType)
+				suite.Equal("pv-uuid", result.Devices[0].Children[0].UUID)
+				suite.Require().Len(result.PhysicalVolumes, 1)
+				suite.Equal("vg0", result.PhysicalVolumes[0].VolumeGroup)
+				suite.Equal(int64(9663676416), result.PhysicalVolumes[0].Free)
+				suite.Require().Len(result.VolumeGroups, 1)
+				suite.Equal(int64(4194304), result.VolumeGroups[0].ExtentSize)
+				suite.Equal(int64(2304), result.VolumeGroups[0].FreeExtents)
+				suite.Equal(1, result.VolumeGroups[0].LVCount)
+				suite.Require().Len(result.LogicalVolumes, 1)
+				suite.Equal("/dev/vg0/root", result.LogicalVolumes[0].Path)
+				suite.Equal("-wi-ao----", result.LogicalVolumes[0].Attr)
+			},
+		},
+		{
+			name: "when broadcast list returns multiple results",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000002","results":[{"hostname":"server1","status":"ok","devices":[]},{"hostname":"server2","status":"ok","devices":[]}]}`,
+					),
+				)
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.BlockListResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Len(resp.Data.Results, 2)
+			},
+		},
+		{
+			name: "when server returns 403 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error":"forbidden"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.BlockListResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusForbidden, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 401 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":"unauthorized"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.BlockListResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusUnauthorized, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 500 returns ServerError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"error":"internal error"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.BlockListResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ServerError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusInternalServerError, target.StatusCode)
+			},
+		},
+		{
+			name:      "when client HTTP call fails returns error",
+			serverURL: "http://127.0.0.1:0",
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.BlockListResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+				suite.Contains(err.Error(), "block list")
+			},
+		},
+		{
+			name: "when server returns 200 with no JSON body returns UnexpectedStatusError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.BlockListResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.UnexpectedStatusError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusOK, target.StatusCode)
+				suite.Equal("nil response body", target.Message)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			var (
+				serverURL string
+				cleanup   func()
+			)
+
+			if tc.serverURL != "" {
+				serverURL = tc.serverURL
+				cleanup = func() {}
+			} else {
+				server := httptest.NewServer(tc.handler)
+				serverURL = server.URL
+				cleanup = server.Close
+			}
+			defer cleanup()
+
+			sut := client.New(
+				serverURL,
+				"test-token",
+				client.WithLogger(slog.Default()),
+			)
+
+			resp, err := sut.Block.List(suite.ctx, "_any")
+			tc.validateFunc(resp, err)
+		})
+	}
+}
+
+func (suite *BlockPublicTestSuite) TestBlockExtend() {
+	resizeFS := false
+
+	tests := []struct {
+		name         string
+		handler      http.HandlerFunc
+		serverURL    string
+		opts         client.BlockExtendOpts
+		validateFunc func(*client.Response[client.Collection[client.BlockExtendResult]], error)
+	}{
+		{
+			name: "when extending logical volume returns result collection",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","name":"vg0/root","size":21474836480,"changed":true}]}`,
+					),
+				)
+			},
+			opts: client.BlockExtendOpts{
+				VolumeGroup:   "vg0",
+				LogicalVolume: "root",
+				Size:          "+10G",
+				ResizeFS:      &resizeFS,
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.BlockExtendResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Equal("00000000-0000-0000-0000-000000000001", resp.Data.JobID)
+				suite.Len(resp.Data.Results, 1)
+				suite.Equal("agent1", resp.Data.Results[0].Hostname)
+				suite.Equal("vg0/root", resp.Data.Results[0].Name)
+				suite.Equal(int64(21474836480), resp.Data.Results[0].Size)
+				suite.True(resp.Data.Results[0].Changed)
+			},
+		},
+		{
+			name: "when broadcast extend returns multiple results",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000002","results":[{"hostname":"server1","status":"ok","name":"vg0/root","changed":true},{"hostname":"server2","status":"ok","name":"vg0/root","changed":true}]}`,
+					),
+				)
+			},
+			opts: client.BlockExtendOpts{
+				VolumeGroup:   "vg0",
+				LogicalVolume: "root",
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.BlockExtendResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Len(resp.Data.Results, 2)
+			},
+		},
+		{
+			name: "when server returns 400 returns ValidationError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"invalid volume_group"}`))
+			},
+			opts: client.BlockExtendOpts{
+				VolumeGroup:   "vg0",
+				LogicalVolume: "root",
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.BlockExtendResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ValidationError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusBadRequest, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 404 returns NotFoundError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error":"logical volume not found"}`))
+			},
+			opts: client.BlockExtendOpts{
+				VolumeGroup:   "vg0",
+				LogicalVolume: "root",
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.BlockExtendResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.NotFoundError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusNotFound, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 401 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":"unauthorized"}`))
+			},
+			opts: client.BlockExtendOpts{
+				VolumeGroup:   "vg0",
+				LogicalVolume: "root",
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.BlockExtendResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusUnauthorized, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 403 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error":"forbidden"}`))
+			},
+			opts: client.BlockExtendOpts{
+				VolumeGroup:   "vg0",
+				LogicalVolume: "root",
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.BlockExtendResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusForbidden, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 500 returns ServerError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"error":"internal error"}`))
+			},
+			opts: client.BlockExtendOpts{
+				VolumeGroup:   "vg0",
+				LogicalVolume: "root",
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.BlockExtendResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ServerError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusInternalServerError, target.StatusCode)
+			},
+		},
+		{
+			name:      "when client HTTP call fails returns error",
+			serverURL: "http://127.0.0.1:0",
+			opts: client.BlockExtendOpts{
+				VolumeGroup:   "vg0",
+				LogicalVolume: "root",
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.BlockExtendResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+				suite.Contains(err.Error(), "block extend")
+			},
+		},
+		{
+			name: "when server returns 200 with no JSON body returns UnexpectedStatusError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+			opts: client.BlockExtendOpts{
+				VolumeGroup:   "vg0",
+				LogicalVolume: "root",
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.BlockExtendResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.UnexpectedStatusError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusOK, target.StatusCode)
+				suite.Equal("nil response body", target.Message)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			var (
+				serverURL string
+				cleanup   func()
+			)
+
+			if tc.serverURL != "" {
+				serverURL = tc.serverURL
+				cleanup = func() {}
+			} else {
+				server := httptest.NewServer(tc.handler)
+				serverURL = server.URL
+				cleanup = server.Close
+			}
+			defer cleanup()
+
+			sut := client.New(
+				serverURL,
+				"test-token",
+				client.WithLogger(slog.Default()),
+			)
+
+			resp, err := sut.Block.Extend(suite.ctx, "_any", tc.opts)
+			tc.validateFunc(resp, err)
+		})
+	}
+}
+
+func TestBlockPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(BlockPublicTestSuite))
+}
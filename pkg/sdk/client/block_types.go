@@ -0,0 +1,226 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// BlockListResult represents a block device inventory from a single agent.
+type BlockListResult struct {
+	Hostname        string                `json:"hostname"`
+	Status          string                `json:"status"`
+	Devices         []BlockDevice         `json:"devices,omitempty"`
+	PhysicalVolumes []BlockPhysicalVolume `json:"physical_volumes,omitempty"`
+	VolumeGroups    []BlockVolumeGroup    `json:"volume_groups,omitempty"`
+	LogicalVolumes  []BlockLogicalVolume  `json:"logical_volumes,omitempty"`
+	Error           string                `json:"error,omitempty"`
+}
+
+// BlockDevice represents a block device. Partitions and device-mapper
+// volumes are nested under Children.
+type BlockDevice struct {
+	Name       string        `json:"name,omitempty"`
+	Path       string        `json:"path,omitempty"`
+	Type       string        `json:"type,omitempty"`
+	Size       int64         `json:"size"`
+	FSType     string        `json:"fs_type,omitempty"`
+	UUID       string        `json:"uuid,omitempty"`
+	Label      string        `json:"label,omitempty"`
+	MountPoint string        `json:"mount_point,omitempty"`
+	Model      string        `json:"model,omitempty"`
+	ReadOnly   bool          `json:"read_only"`
+	Children   []BlockDevice `json:"children,omitempty"`
+}
+
+// BlockPhysicalVolume represents an LVM physical volume. Sizes are in bytes.
+type BlockPhysicalVolume struct {
+	Name        string `json:"name,omitempty"`
+	VolumeGroup string `json:"volume_group,omitempty"`
+	Size        int64  `json:"size"`
+	Free        int64  `json:"free"`
+}
+
+// BlockVolumeGroup represents an LVM volume group. Sizes are in bytes.
+type BlockVolumeGroup struct {
+	Name        string `json:"name,omitempty"`
+	Size        int64  `json:"size"`
+	Free        int64  `json:"free"`
+	ExtentSize  int64  `json:"extent_size"`
+	FreeExtents int64  `json:"free_extents"`
+	PVCount     int    `json:"pv_count"`
+	LVCount     int    `json:"lv_count"`
+}
+
+// BlockLogicalVolume represents an LVM logical volume. Sizes are in bytes.
+type BlockLogicalVolume struct {
+	Name        string `json:"name,omitempty"`
+	VolumeGroup string `json:"volume_group,omitempty"`
+	Path        string `json:"path,omitempty"`
+	Size        int64  `json:"size"`
+	Attr        string `json:"attr,omitempty"`
+}
+
+// BlockExtendResult represents the result of a logical volume extension.
+type BlockExtendResult struct {
+	Hostname string `json:"hostname"`
+	Status   string `json:"status"`
+	Name     string `json:"name"`
+	Size     int64  `json:"size"`
+	Changed  bool   `json:"changed"`
+	Error    string `json:"error,omitempty"`
+}
+
+// BlockExtendOpts contains options for extending a logical volume.
+type BlockExtendOpts struct {
+	// VolumeGroup is the volume group holding the logical volume (required).
+	VolumeGroup string
+	// LogicalVolume is the logical volume to extend (required).
+	LogicalVolume string
+	// Size is the new size or increment in lvextend syntax (e.g., "+10G",
+	// "50G", "+100%FREE"). Defaults to all free space in the volume group.
+	Size string
+	// ResizeFS grows the filesystem on the volume along with it. Nil
+	// uses the server default (true).
+	ResizeFS *bool
+}
+
+// blockListCollectionFromGen converts a gen.BlockListResponse
+// to a Collection[BlockListResult].
+func blockListCollectionFromGen(
+	g *gen.BlockListResponse,
+) Collection[BlockListResult] {
+	results := make([]BlockListResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		result := BlockListResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Error:    derefString(r.Error),
+		}
+
+		if r.Devices != nil {
+			result.Devices = blockDevicesFromGen(*r.Devices)
+		}
+
+		if r.PhysicalVolumes != nil {
+			pvs := make([]BlockPhysicalVolume, 0, len(*r.PhysicalVolumes))
+			for _, pv := range *r.PhysicalVolumes {
+				pvs = append(pvs, BlockPhysicalVolume{
+					Name:        derefString(pv.Name),
+					VolumeGroup: derefString(pv.VolumeGroup),
+					Size:        derefInt64(pv.Size),
+					Free:        derefInt64(pv.Free),
+				})
+			}
+			result.PhysicalVolumes = pvs
+		}
+
+		if r.VolumeGroups != nil {
+			vgs := make([]BlockVolumeGroup, 0, len(*r.VolumeGroups))
+			for _, vg := range *r.VolumeGroups {
+				vgs = append(vgs, BlockVolumeGroup{
+					Name:        derefString(vg.Name),
+					Size:        derefInt64(vg.Size),
+					Free:        derefInt64(vg.Free),
+					ExtentSize:  derefInt64(vg.ExtentSize),
+					FreeExtents: derefInt64(vg.FreeExtents),
+					PVCount:     derefInt(vg.PvCount),
+					LVCount:     derefInt(vg.LvCount),
+				})
+			}
+			result.VolumeGroups = vgs
+		}
+
+		if r.LogicalVolumes != nil {
+			lvs := make([]BlockLogicalVolume, 0, len(*r.LogicalVolumes))
+			for _, lv := range *r.LogicalVolumes {
+				lvs = append(lvs, BlockLogicalVolume{
+					Name:        derefString(lv.Name),
+					VolumeGroup: derefString(lv.VolumeGroup),
+					Path:        derefString(lv.Path),
+					Size:        derefInt64(lv.Size),
+					Attr:        derefString(lv.Attr),
+				})
+			}
+			result.LogicalVolumes = lvs
+		}
+
+		results = append(results, result)
+	}
+
+	return Collection[BlockListResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// blockDevicesFromGen converts gen.BlockDevice values to BlockDevice
+// values, recursing into their children.
+func blockDevicesFromGen(
+	devices []gen.BlockDevice,
+) []BlockDevice {
+	result := make([]BlockDevice, 0, len(devices))
+	for _, d := range devices {
+		dev := BlockDevice{
+			Name:       derefString(d.Name),
+			Path:       derefString(d.Path),
+			Type:       derefString(d.Type),
+			Size:       derefInt64(d.Size),
+			FSType:     derefString(d.FsType),
+			UUID:       derefString(d.Uuid),
+			Label:      derefString(d.Label),
+			MountPoint: derefString(d.MountPoint),
+			Model:      derefString(d.Model),
+			ReadOnly:   derefBool(d.ReadOnly),
+		}
+
+		if d.Children != nil {
+			dev.Children = blockDevicesFromGen(*d.Children)
+		}
+
+		result = append(result, dev)
+	}
+
+	return result
+}
+
+// blockExtendCollectionFromGen converts a gen.BlockExtendResponse
+// to a Collection[BlockExtendResult].
+func blockExtendCollectionFromGen(
+	g *gen.BlockExtendResponse,
+) Collection[BlockExtendResult] {
+	results := make([]BlockExtendResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, BlockExtendResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Name:     derefString(r.Name),
+			Size:     derefInt64(r.Size),
+			Changed:  derefBool(r.Changed),
+			Error:    derefString(r.Error),
+		})
+	}
+
+	return Collection[BlockExtendResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
@@ -510,6 +510,14 @@ func ProcessInfoCollectionFromGet(
 	return processInfoCollectionFromGet(input)
 }
 
+// ProcessTreeCollectionFromGen exposes the private
+// processTreeCollectionFromGen for testing.
+func ProcessTreeCollectionFromGen(
+	input *gen.ProcessTreeResponse,
+) Collection[ProcessTreeResult] {
+	return processTreeCollectionFromGen(input)
+}
+
 // ProcessSignalCollectionFromGen exposes the private
 // processSignalCollectionFromGen for testing.
 func ProcessSignalCollectionFromGen(
@@ -518,6 +526,14 @@ func ProcessSignalCollectionFromGen(
 	return processSignalCollectionFromGen(input)
 }
 
+// ProcessSignalMatchCollectionFromGen exposes the private
+// processSignalMatchCollectionFromGen for testing.
+func ProcessSignalMatchCollectionFromGen(
+	input *gen.ProcessSignalMatchResponse,
+) Collection[ProcessSignalMatchResult] {
+	return processSignalMatchCollectionFromGen(input)
+}
+
 // ExportDerefStringSlice exposes the private derefStringSlice for testing.
 func ExportDerefStringSlice(
 	s *[]string,
@@ -709,6 +725,38 @@ func ExportPackageInfosFromGen(
 	return packageInfosFromGen(input)
 }
 
+// PackageMutationCollectionFromHold exposes the private
+// packageMutationCollectionFromHold for testing.
+func PackageMutationCollectionFromHold(
+	input *gen.PackageMutationResponse,
+) Collection[PackageMutationResult] {
+	return packageMutationCollectionFromHold(input)
+}
+
+// PackageMutationCollectionFromUnhold exposes the private
+// packageMutationCollectionFromUnhold for testing.
+func PackageMutationCollectionFromUnhold(
+	input *gen.PackageMutationResponse,
+) Collection[PackageMutationResult] {
+	return packageMutationCollectionFromUnhold(input)
+}
+
+// PackageEnsureCollectionFromGen exposes the private
+// packageEnsureCollectionFromGen for testing.
+func PackageEnsureCollectionFromGen(
+	input *gen.PackageEnsureResponse,
+) Collection[PackageEnsureResult] {
+	return packageEnsureCollectionFromGen(input)
+}
+
+// ExportPackageEnsureItemsFromGen exposes the private
+// packageEnsureItemsFromGen for testing.
+func ExportPackageEnsureItemsFromGen(
+	input *[]gen.PackageEnsureItem,
+) []PackageEnsureItem {
+	return packageEnsureItemsFromGen(input)
+}
+
 // ExportUpdateInfosFromGen exposes the private updateInfosFromGen
 // for testing.
 func ExportUpdateInfosFromGen(
@@ -717,6 +765,54 @@ func ExportUpdateInfosFromGen(
 	return updateInfosFromGen(input)
 }
 
+// PackageRepoCollectionFromList exposes the private
+// packageRepoCollectionFromList for testing.
+func PackageRepoCollectionFromList(
+	input *gen.PackageRepoCollectionResponse,
+) Collection[PackageRepoResult] {
+	return packageRepoCollectionFromList(input)
+}
+
+// PackageRepoCollectionFromGet exposes the private
+// packageRepoCollectionFromGet for testing.
+func PackageRepoCollectionFromGet(
+	input *gen.PackageRepoCollectionResponse,
+) Collection[PackageRepoResult] {
+	return packageRepoCollectionFromGet(input)
+}
+
+// ExportPackageReposFromGen exposes the private packageReposFromGen
+// for testing.
+func ExportPackageReposFromGen(
+	input *[]gen.PackageRepoInfo,
+) []PackageRepo {
+	return packageReposFromGen(input)
+}
+
+// PackageRepoMutationCollectionFromCreate exposes the private
+// packageRepoMutationCollectionFromCreate for testing.
+func PackageRepoMutationCollectionFromCreate(
+	input *gen.PackageRepoMutationResponse,
+) Collection[PackageRepoMutationResult] {
+	return packageRepoMutationCollectionFromCreate(input)
+}
+
+// PackageRepoMutationCollectionFromUpdate exposes the private
+// packageRepoMutationCollectionFromUpdate for testing.
+func PackageRepoMutationCollectionFromUpdate(
+	input *gen.PackageRepoMutationResponse,
+) Collection[PackageRepoMutationResult] {
+	return packageRepoMutationCollectionFromUpdate(input)
+}
+
+// PackageRepoMutationCollectionFromDelete exposes the private
+// packageRepoMutationCollectionFromDelete for testing.
+func PackageRepoMutationCollectionFromDelete(
+	input *gen.PackageRepoMutationResponse,
+) Collection[PackageRepoMutationResult] {
+	return packageRepoMutationCollectionFromDelete(input)
+}
+
 // CertificateCACollectionFromGen exposes the private
 // certificateCACollectionFromGen for testing.
 func CertificateCACollectionFromGen(
@@ -772,6 +868,22 @@ func ServiceMutationCollectionFromGen(
 	return serviceMutationCollectionFromGen(input)
 }
 
+// ServiceDropInListCollectionFromGen exposes the private
+// serviceDropInListCollectionFromGen for testing.
+func ServiceDropInListCollectionFromGen(
+	input *gen.ServiceDropInListResponse,
+) Collection[ServiceDropInListResult] {
+	return serviceDropInListCollectionFromGen(input)
+}
+
+// ServiceDropInMutationCollectionFromGen exposes the private
+// serviceDropInMutationCollectionFromGen for testing.
+func ServiceDropInMutationCollectionFromGen(
+	input *gen.ServiceDropInMutationResponse,
+) Collection[ServiceDropInMutationResult] {
+	return serviceDropInMutationCollectionFromGen(input)
+}
+
 // LogCollectionFromGen exposes the private logCollectionFromGen for testing.
 func LogCollectionFromGen(
 	input *gen.LogCollectionResponse,
@@ -0,0 +1,218 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// FirewallService provides nftables firewall rule set management
+// operations.
+type FirewallService struct {
+	client *gen.ClientWithResponses
+}
+
+// List lists all managed firewall rule sets on the target host.
+func (s *FirewallService) List(
+	ctx context.Context,
+	hostname string,
+) (*Response[Collection[FirewallRuleSetResult]], error) {
+	resp, err := s.client.GetNodeNetworkFirewallWithResponse(ctx, hostname)
+	if err != nil {
+		return nil, fmt.Errorf("firewall list: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(firewallCollectionFromList(resp.JSON200), resp.Body), nil
+}
+
+// Get retrieves a single managed firewall rule set by name on the target
+// host.
+func (s *FirewallService) Get(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[FirewallRuleSetResult]], error) {
+	resp, err := s.client.GetNodeNetworkFirewallByNameWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("firewall get: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(firewallCollectionFromGet(resp.JSON200), resp.Body), nil
+}
+
+// Create deploys a new nftables rule set from the Object Store to the
+// target host. The agent validates it with `nft -c` before loading.
+func (s *FirewallService) Create(
+	ctx context.Context,
+	hostname string,
+	opts FirewallCreateOpts,
+) (*Response[Collection[FirewallMutationResult]], error) {
+	body := gen.FirewallCreateRequest{
+		Name:   opts.Name,
+		Object: opts.Object,
+	}
+	if opts.ContentType != "" {
+		ct := gen.FirewallCreateRequestContentType(opts.ContentType)
+		body.ContentType = &ct
+	}
+	if opts.Vars != nil {
+		body.Vars = &opts.Vars
+	}
+
+	resp, err := s.client.PostNodeNetworkFirewallWithResponse(ctx, hostname, body)
+	if err != nil {
+		return nil, fmt.Errorf("firewall create: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(firewallMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Update redeploys an existing nftables rule set on the target host. An
+// empty Object keeps the currently deployed content.
+func (s *FirewallService) Update(
+	ctx context.Context,
+	hostname string,
+	name string,
+	opts FirewallUpdateOpts,
+) (*Response[Collection[FirewallMutationResult]], error) {
+	body := gen.FirewallUpdateRequest{}
+	if opts.Object != "" {
+		body.Object = &opts.Object
+	}
+	if opts.ContentType != "" {
+		ct := gen.FirewallUpdateRequestContentType(opts.ContentType)
+		body.ContentType = &ct
+	}
+	if opts.Vars != nil {
+		body.Vars = &opts.Vars
+	}
+
+	resp, err := s.client.PutNodeNetworkFirewallWithResponse(ctx, hostname, name, body)
+	if err != nil {
+		return nil, fmt.Errorf("firewall update: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(firewallMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Delete removes a managed nftables rule set from the target host.
+func (s *FirewallService) Delete(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[FirewallMutationResult]], error) {
+	resp, err := s.client.DeleteNodeNetworkFirewallWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("firewall delete: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(firewallMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// FirewallRuleSetResult represents an nftables rule set from a single agent.
+type FirewallRuleSetResult struct {
+	Hostname    string `json:"hostname,omitempty"`
+	Status      string `json:"status"`
+	Name        string `json:"name,omitempty"`
+	Object      string `json:"object,omitempty"`
+	Path        string `json:"path,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// FirewallMutationResult represents the result of a firewall rule set
+// create, update, or delete operation.
+type FirewallMutationResult struct {
+	Hostname string `json:"hostname,omitempty"`
+	Status   string `json:"status"`
+	Name     string `json:"name,omitempty"`
+	Changed  bool   `json:"changed"`
+	Error    string `json:"error,omitempty"`
+}
+
+// FirewallCreateOpts contains options for creating a firewall rule set.
+type FirewallCreateOpts struct {
+	// Name is the rule set name, used as the drop-in file name (required).
+	Name string
+	// Object is the name of the uploaded file in the object store (required).
+	Object string
+	// ContentType is "raw" or "template" (optional, defaults to raw).
+	ContentType string
+	// Vars contains template variables (optional).
+	Vars map[string]any
+}
+
+// FirewallUpdateOpts contains options for updating a firewall rule set.
+type FirewallUpdateOpts struct {
+	// Object is the new object to deploy (optional).
+	Object string
+	// ContentType is "raw" or "template" (optional).
+	ContentType string
+	// Vars contains template variables (optional).
+	Vars map[string]any
+}
+
+// firewallRuleSetFromGen converts a gen.FirewallEntry to a
+// FirewallRuleSetResult.
+func firewallRuleSetFromGen(
+	r gen.FirewallEntry,
+) FirewallRuleSetResult {
+	return FirewallRuleSetResult{
+		Hostname:    r.Hostname,
+		Status:      string(r.Status),
+		Name:        derefString(r.Name),
+		Object:      derefString(r.Object),
+		Path:        derefString(r.Path),
+		ContentType: derefString(r.ContentType),
+		Error:       derefString(r.Error),
+	}
+}
+
+// firewallCollectionFromList converts a gen.FirewallCollectionResponse
+// to a Collection[FirewallRuleSetResult].
+func firewallCollectionFromList(
+	g *gen.FirewallCollectionResponse,
+) Collection[FirewallRuleSetResult] {
+	results := make([]FirewallRuleSetResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, firewallRuleSetFromGen(r))
+	}
+
+	return Collection[FirewallRuleSetResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// firewallCollectionFromGet converts a gen.FirewallGetResponse
+// to a Collection[FirewallRuleSetResult].
+func firewallCollectionFromGet(
+	g *gen.FirewallGetResponse,
+) Collection[FirewallRuleSetResult] {
+	results := make([]FirewallRuleSetResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, firewallRuleSetFromGen(r))
+	}
+
+	return Collection[FirewallRuleSetResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// firewallMutationCollectionFromGen converts a gen.FirewallMutationResponse
+// to a Collection[FirewallMutationResult].
+func firewallMutationCollectionFromGen(
+	g *gen.FirewallMutationResponse,
+) Collection[FirewallMutationResult] {
+	results := make([]FirewallMutationResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, FirewallMutationResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Name:     derefString(r.Name),
+			Changed:  derefBool(r.Changed),
+			Error:    derefString(r.Error),
+		})
+	}
+
+	return Collection[FirewallMutationResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
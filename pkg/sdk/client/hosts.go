@@ -0,0 +1,202 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// HostsService provides /etc/hosts entry management operations.
+type HostsService struct {
+	client *gen.ClientWithResponses
+}
+
+// List returns every /etc/hosts entry on the target host, flagging entries
+// in the osapi-managed block.
+func (s *HostsService) List(
+	ctx context.Context,
+	hostname string,
+) (*Response[Collection[HostEntryListResult]], error) {
+	resp, err := s.client.GetNodeHostsWithResponse(ctx, hostname)
+	if err != nil {
+		return nil, fmt.Errorf("hosts list: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(hostEntryListCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Get returns the /etc/hosts entry for a single address on the target host.
+func (s *HostsService) Get(
+	ctx context.Context,
+	hostname string,
+	address string,
+) (*Response[Collection[HostEntryGetResult]], error) {
+	resp, err := s.client.GetNodeHostsByAddressWithResponse(ctx, hostname, address)
+	if err != nil {
+		return nil, fmt.Errorf("hosts get: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(hostEntryGetCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Create adds a managed /etc/hosts entry on the target host.
+func (s *HostsService) Create(
+	ctx context.Context,
+	hostname string,
+	opts HostEntryCreateOpts,
+) (*Response[Collection[HostEntryMutationResult]], error) {
+	body := gen.HostEntryCreateRequest{
+		Address:   opts.Address,
+		Hostnames: opts.Hostnames,
+	}
+
+	resp, err := s.client.PostNodeHostsWithResponse(ctx, hostname, body)
+	if err != nil {
+		return nil, fmt.Errorf("hosts create: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(hostEntryMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Update replaces the hostnames of a managed /etc/hosts entry on the target
+// host.
+func (s *HostsService) Update(
+	ctx context.Context,
+	hostname string,
+	address string,
+	opts HostEntryUpdateOpts,
+) (*Response[Collection[HostEntryMutationResult]], error) {
+	body := gen.HostEntryUpdateRequest{
+		Hostnames: opts.Hostnames,
+	}
+
+	resp, err := s.client.PutNodeHostsWithResponse(ctx, hostname, address, body)
+	if err != nil {
+		return nil, fmt.Errorf("hosts update: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(hostEntryMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Delete removes a managed /etc/hosts entry from the target host. Lines
+// outside the managed block are never removed.
+func (s *HostsService) Delete(
+	ctx context.Context,
+	hostname string,
+	address string,
+) (*Response[Collection[HostEntryMutationResult]], error) {
+	resp, err := s.client.DeleteNodeHostsWithResponse(ctx, hostname, address)
+	if err != nil {
+		return nil, fmt.Errorf("hosts delete: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(hostEntryMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// HostEntryListResult represents a hosts entry list result from a single
+// agent.
+type HostEntryListResult struct {
+	Hostname string      `json:"hostname"`
+	Status   string      `json:"status"`
+	Entries  []HostEntry `json:"entries,omitempty"`
+	Error    string      `json:"error,omitempty"`
+}
+
+// HostEntry represents an entry in /etc/hosts.
+type HostEntry struct {
+	Address   string   `json:"address"`
+	Hostnames []string `json:"hostnames"`
+	Managed   bool     `json:"managed"`
+	Drifted   bool     `json:"drifted,omitempty"`
+}
+
+// HostEntryGetResult represents a hosts entry get result from a single
+// agent.
+type HostEntryGetResult struct {
+	Hostname string     `json:"hostname"`
+	Status   string     `json:"status"`
+	Entry    *HostEntry `json:"entry,omitempty"`
+	Error    string     `json:"error,omitempty"`
+}
+
+// HostEntryMutationResult represents the result of a hosts entry mutation.
+type HostEntryMutationResult struct {
+	Hostname string `json:"hostname"`
+	Status   string `json:"status"`
+	Address  string `json:"address"`
+	Changed  bool   `json:"changed"`
+	Error    string `json:"error,omitempty"`
+}
+
+// HostEntryCreateOpts contains options for adding a managed hosts entry.
+type HostEntryCreateOpts struct {
+	// Address is the IPv4 or IPv6 address of the entry (required).
+	Address string
+	// Hostnames is the canonical name followed by any aliases (required).
+	Hostnames []string
+}
+
+// HostEntryUpdateOpts contains the replacement hostnames for a managed
+// hosts entry.
+type HostEntryUpdateOpts struct {
+	// Hostnames is the canonical name followed by any aliases (required).
+	Hostnames []string
+}
+
+// hostEntryListCollectionFromGen converts a gen.HostEntryListResponse to a
+// Collection[HostEntryListResult].
+func hostEntryListCollectionFromGen(
+	g *gen.HostEntryListResponse,
+) Collection[HostEntryListResult] {
+	results := make([]HostEntryListResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		result := HostEntryListResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Error:    derefString(r.Error),
+		}
+
+		if r.Entries != nil {
+			entries := make([]HostEntry, 0, len(*r.Entries))
+			for _, e := range *r.Entries {
+				entries = append(entries, hostEntryFromGen(e))
+			}
+			result.Entries = entries
+		}
+
+		results = append(results, result)
+	}
+
+	return Collection[HostEntryListResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// hostEntryFromGen converts a gen.HostEntryInfo to a HostEntry.
+func hostEntryFromGen(
+	e gen.HostEntryInfo,
+) HostEntry {
+	return HostEntry{
+		Address:   derefString(e.Address),
+		Hostnames: derefStringSlice(e.Hostnames),
+		Managed:   derefBool(e.Managed),
+		Drifted:   derefBool(e.Drifted),
+	}
+}
+
+// hostEntryGetCollectionFromGen converts a gen.HostEntryGetResponse to a
+// Collection[HostEntryGetResult].
+func hostEntryGetCollectionFromGen(
+	g *gen.HostEntryGetResponse,
+) Collection[HostEntryGetResult] {
+	results := make([]HostEntryGetResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		result := HostEntryGetResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Error:    derefString(r.Error),
+		}
+
+		if r.Entry != nil {
+			entry := hostEntryFromGen(*r.Entry)
+			result.Entry = &entry
+		}
+
+		results = append(results, result)
+	}
+
+	return Collection[HostEntryGetResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// hostEntryMutationCollectionFromGen converts a gen.HostEntryMutationResponse
+// to a Collection[HostEntryMutationResult].
+func hostEntryMutationCollectionFromGen(
+	g *gen.HostEntryMutationResponse,
+) Collection[HostEntryMutationResult] {
+	results := make([]HostEntryMutationResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, HostEntryMutationResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Address:  derefString(r.Address),
+			Changed:  derefBool(r.Changed),
+			Error:    derefString(r.Error),
+		})
+	}
+
+	return Collection[HostEntryMutationResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
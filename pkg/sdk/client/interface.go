@@ -233,5 +233,31 @@ func interfaceConfigRequestFromOpts(
 		body.Wakeonlan = opts.WakeOnLAN
 	}
 
+	if opts.VLAN != nil {
+		body.Vlan = &gen.InterfaceVlan{
+			Id:   opts.VLAN.ID,
+			Link: opts.VLAN.Link,
+		}
+	}
+
+	if opts.Bond != nil {
+		body.Bond = &gen.InterfaceBond{
+			Mode:    gen.InterfaceBondMode(opts.Bond.Mode),
+			Members: opts.Bond.Members,
+		}
+		if opts.Bond.MIIMonitor > 0 {
+			body.Bond.Miimon = &opts.Bond.MIIMonitor
+		}
+	}
+
+	if opts.Bridge != nil {
+		body.Bridge = &gen.InterfaceBridge{
+			Stp: opts.Bridge.STP,
+		}
+		if len(opts.Bridge.Members) > 0 {
+			body.Bridge.Members = &opts.Bridge.Members
+		}
+	}
+
 	return body
 }
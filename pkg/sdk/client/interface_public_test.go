@@ -23,6 +23,7 @@ package client_test
 import (
 	"context"
 	"errors"
+	"io"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
@@ -473,6 +474,94 @@ func (suite *InterfacePublicTestSuite) TestCreate() {
 				suite.Require().NotNil(resp)
 			},
 		},
+		{
+			name: "when creating vlan sends vlan settings",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				suite.JSONEq(`{"vlan":{"id":100,"link":"eth0"}}`, string(body))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","name":"vlan100","changed":true}]}`,
+					),
+				)
+			},
+			opts: client.InterfaceConfigOpts{
+				VLAN: &client.InterfaceVLAN{ID: 100, Link: "eth0"},
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.InterfaceMutationResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Require().NotNil(resp)
+			},
+		},
+		{
+			name: "when creating bond sends bond settings",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				suite.JSONEq(
+					`{"bond":{"mode":"802.3ad","members":["eth1","eth2"],"miimon":100}}`,
+					string(body),
+				)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","name":"bond0","changed":true}]}`,
+					),
+				)
+			},
+			opts: client.InterfaceConfigOpts{
+				Bond: &client.InterfaceBond{
+					Mode:       "802.3ad",
+					Members:    []string{"eth1", "eth2"},
+					MIIMonitor: 100,
+				},
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.InterfaceMutationResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Require().NotNil(resp)
+			},
+		},
+		{
+			name: "when creating bridge sends bridge settings",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				suite.JSONEq(`{"bridge":{"members":["eth1"],"stp":false}}`, string(body))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","name":"br0","changed":true}]}`,
+					),
+				)
+			},
+			opts: func() client.InterfaceConfigOpts {
+				stp := false
+				return client.InterfaceConfigOpts{
+					Bridge: &client.InterfaceBridge{
+						Members: []string{"eth1"},
+						STP:     &stp,
+					},
+				}
+			}(),
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.InterfaceMutationResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Require().NotNil(resp)
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -41,6 +41,37 @@ type InterfaceInfo struct {
 	MACAddress string   `json:"mac_address,omitempty"`
 	WakeOnLAN  bool     `json:"wakeonlan"`
 	State      string   `json:"state,omitempty"`
+	Type       string   `json:"type,omitempty"`
+
+	VLAN   *InterfaceVLAN   `json:"vlan,omitempty"`
+	Bond   *InterfaceBond   `json:"bond,omitempty"`
+	Bridge *InterfaceBridge `json:"bridge,omitempty"`
+}
+
+// InterfaceVLAN contains the settings of a VLAN interface.
+type InterfaceVLAN struct {
+	// ID is the VLAN tag (1-4094).
+	ID int `json:"id"`
+	// Link is the parent interface carrying the tagged traffic.
+	Link string `json:"link"`
+}
+
+// InterfaceBond contains the settings of a bond interface.
+type InterfaceBond struct {
+	// Mode is the bonding mode (e.g., "active-backup", "802.3ad").
+	Mode string `json:"mode"`
+	// Members are the interfaces enslaved to the bond.
+	Members []string `json:"members"`
+	// MIIMonitor is the link monitoring interval in milliseconds.
+	MIIMonitor int `json:"miimon,omitempty"`
+}
+
+// InterfaceBridge contains the settings of a bridge interface.
+type InterfaceBridge struct {
+	// Members are the interfaces attached to the bridge.
+	Members []string `json:"members,omitempty"`
+	// STP enables or disables the spanning tree protocol.
+	STP *bool `json:"stp,omitempty"`
 }
 
 // InterfaceListResult represents an interface list result from a single agent.
@@ -88,6 +119,12 @@ type InterfaceConfigOpts struct {
 	MACAddress string
 	// WakeOnLAN enables or disables Wake-on-LAN.
 	WakeOnLAN *bool
+	// VLAN makes the interface a VLAN on top of another interface.
+	VLAN *InterfaceVLAN
+	// Bond makes the interface a bond of its member interfaces.
+	Bond *InterfaceBond
+	// Bridge makes the interface a bridge over its member interfaces.
+	Bridge *InterfaceBridge
 }
 
 // interfaceInfoFromGen converts a gen.InterfaceInfo to an InterfaceInfo.
@@ -109,12 +146,37 @@ func interfaceInfoFromGen(
 		MACAddress: derefString(g.MacAddress),
 		WakeOnLAN:  derefBool(g.Wakeonlan),
 		State:      derefString(g.State),
+		Type:       derefString(g.Type),
 	}
 
 	if g.Addresses != nil {
 		info.Addresses = *g.Addresses
 	}
 
+	if g.Vlan != nil {
+		info.VLAN = &InterfaceVLAN{
+			ID:   g.Vlan.Id,
+			Link: g.Vlan.Link,
+		}
+	}
+
+	if g.Bond != nil {
+		info.Bond = &InterfaceBond{
+			Mode:       string(g.Bond.Mode),
+			Members:    g.Bond.Members,
+			MIIMonitor: derefInt(g.Bond.Miimon),
+		}
+	}
+
+	if g.Bridge != nil {
+		info.Bridge = &InterfaceBridge{
+			STP: g.Bridge.Stp,
+		}
+		if g.Bridge.Members != nil {
+			info.Bridge.Members = *g.Bridge.Members
+		}
+	}
+
 	return info
 }
 
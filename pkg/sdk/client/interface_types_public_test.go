@@ -80,6 +80,72 @@ func (suite *InterfaceTypesPublicTestSuite) TestInterfaceInfoFromGen() {
 				suite.Equal([]string{"192.168.1.10/24", "10.0.0.1/8"}, info.Addresses)
 			},
 		},
+		{
+			name: "when vlan settings are populated",
+			input: func() gen.InterfaceInfo {
+				name := "vlan100"
+				typ := "vlan"
+
+				return gen.InterfaceInfo{
+					Name: &name,
+					Type: &typ,
+					Vlan: &gen.InterfaceVlan{Id: 100, Link: "eth0"},
+				}
+			}(),
+			validateFunc: func(info client.InterfaceInfo) {
+				suite.Equal("vlan", info.Type)
+				suite.Equal(&client.InterfaceVLAN{ID: 100, Link: "eth0"}, info.VLAN)
+				suite.Nil(info.Bond)
+				suite.Nil(info.Bridge)
+			},
+		},
+		{
+			name: "when bond settings are populated",
+			input: func() gen.InterfaceInfo {
+				typ := "bond"
+				miimon := 100
+
+				return gen.InterfaceInfo{
+					Type: &typ,
+					Bond: &gen.InterfaceBond{
+						Mode:    gen.InterfaceBondModeActiveBackup,
+						Members: []string{"eth1", "eth2"},
+						Miimon:  &miimon,
+					},
+				}
+			}(),
+			validateFunc: func(info client.InterfaceInfo) {
+				suite.Equal("bond", info.Type)
+				suite.Equal(&client.InterfaceBond{
+					Mode:       "active-backup",
+					Members:    []string{"eth1", "eth2"},
+					MIIMonitor: 100,
+				}, info.Bond)
+			},
+		},
+		{
+			name: "when bridge settings are populated",
+			input: func() gen.InterfaceInfo {
+				typ := "bridge"
+				members := []string{"eth1"}
+				stp := true
+
+				return gen.InterfaceInfo{
+					Type: &typ,
+					Bridge: &gen.InterfaceBridge{
+						Members: &members,
+						Stp:     &stp,
+					},
+				}
+			}(),
+			validateFunc: func(info client.InterfaceInfo) {
+				suite.Equal("bridge", info.Type)
+				suite.Require().NotNil(info.Bridge)
+				suite.Equal([]string{"eth1"}, info.Bridge.Members)
+				suite.Require().NotNil(info.Bridge.STP)
+				suite.True(*info.Bridge.STP)
+			},
+		},
 		{
 			name:  "when all fields are nil",
 			input: gen.InterfaceInfo{},
@@ -94,6 +160,10 @@ func (suite *InterfaceTypesPublicTestSuite) TestInterfaceInfoFromGen() {
 				suite.False(info.WakeOnLAN)
 				suite.Empty(info.State)
 				suite.Nil(info.Addresses)
+				suite.Empty(info.Type)
+				suite.Nil(info.VLAN)
+				suite.Nil(info.Bond)
+				suite.Nil(info.Bridge)
 			},
 		},
 	}
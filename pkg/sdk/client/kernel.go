@@ -0,0 +1,218 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// KernelService provides kernel module management operations.
+type KernelService struct {
+	client *gen.ClientWithResponses
+}
+
+// ListModules returns loaded and managed kernel modules on the target host.
+func (s *KernelService) ListModules(
+	ctx context.Context,
+	hostname string,
+) (*Response[Collection[KernelModuleListResult]], error) {
+	resp, err := s.client.GetNodeKernelModuleWithResponse(ctx, hostname)
+	if err != nil {
+		return nil, fmt.Errorf("kernel module list: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(kernelModuleListCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// GetModule returns a single kernel module on the target host.
+func (s *KernelService) GetModule(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[KernelModuleGetResult]], error) {
+	resp, err := s.client.GetNodeKernelModuleByNameWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("kernel module get: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(kernelModuleGetCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// CreateModule configures a kernel module on the target host and applies
+// it at runtime.
+func (s *KernelService) CreateModule(
+	ctx context.Context,
+	hostname string,
+	opts KernelModuleCreateOpts,
+) (*Response[Collection[KernelModuleMutationResult]], error) {
+	body := gen.KernelModuleCreateRequest{
+		Name: opts.Name,
+	}
+
+	if opts.Load {
+		body.Load = &opts.Load
+	}
+
+	if opts.Blacklist {
+		body.Blacklist = &opts.Blacklist
+	}
+
+	if opts.Options != "" {
+		body.Options = &opts.Options
+	}
+
+	resp, err := s.client.PostNodeKernelModuleWithResponse(ctx, hostname, body)
+	if err != nil {
+		return nil, fmt.Errorf("kernel module create: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(kernelModuleMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// UpdateModule replaces the configuration of a managed kernel module on the
+// target host and applies it at runtime.
+func (s *KernelService) UpdateModule(
+	ctx context.Context,
+	hostname string,
+	name string,
+	opts KernelModuleUpdateOpts,
+) (*Response[Collection[KernelModuleMutationResult]], error) {
+	body := gen.KernelModuleUpdateRequest{
+		Load:      &opts.Load,
+		Blacklist: &opts.Blacklist,
+	}
+
+	if opts.Options != "" {
+		body.Options = &opts.Options
+	}
+
+	resp, err := s.client.PutNodeKernelModuleWithResponse(ctx, hostname, name, body)
+	if err != nil {
+		return nil, fmt.Errorf("kernel module update: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(kernelModuleMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// DeleteModule removes the managed configuration of a kernel module from the
+// target host. The module is neither loaded nor unloaded.
+func (s *KernelService) DeleteModule(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[KernelModuleMutationResult]], error) {
+	resp, err := s.client.DeleteNodeKernelModuleWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("kernel module delete: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(kernelModuleMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
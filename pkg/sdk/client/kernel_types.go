@@ -0,0 +1,190 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// KernelModuleListResult represents a kernel module list result from a
+// single agent.
+type KernelModuleListResult struct {
+	Hostname string         `json:"hostname"`
+	Status   string         `json:"status"`
+	Modules  []KernelModule `json:"modules,omitempty"`
+	Error    string         `json:"error,omitempty"`
+}
+
+// KernelModule represents a loaded or managed kernel module.
+type KernelModule struct {
+	Name      string   `json:"name"`
+	Loaded    bool     `json:"loaded"`
+	Size      int64    `json:"size,omitempty"`
+	UsedBy    []string `json:"used_by,omitempty"`
+	Managed   bool     `json:"managed"`
+	Load      bool     `json:"load,omitempty"`
+	Blacklist bool     `json:"blacklist,omitempty"`
+	Options   string   `json:"options,omitempty"`
+	Drifted   bool     `json:"drifted,omitempty"`
+}
+
+// KernelModuleGetResult represents a kernel module get result from a single
+// agent.
+type KernelModuleGetResult struct {
+	Hostname string        `json:"hostname"`
+	Status   string        `json:"status"`
+	Module   *KernelModule `json:"module,omitempty"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// KernelModuleMutationResult represents the result of a kernel module
+// mutation.
+type KernelModuleMutationResult struct {
+	Hostname string `json:"hostname"`
+	Status   string `json:"status"`
+	Name     string `json:"name"`
+	Changed  bool   `json:"changed"`
+	Error    string `json:"error,omitempty"`
+}
+
+// KernelModuleCreateOpts contains options for configuring a kernel module.
+// At least one of Load, Blacklist or Options must be set.
+type KernelModuleCreateOpts struct {
+	// Name is the kernel module name (required).
+	Name string
+	// Load loads the module now and at every boot.
+	Load bool
+	// Blacklist prevents the module from loading automatically and unloads
+	// it if possible. Mutually exclusive with Load.
+	Blacklist bool
+	// Options is the space-separated module parameters.
+	Options string
+}
+
+// KernelModuleUpdateOpts contains the replacement configuration for a
+// managed kernel module. At least one of Load, Blacklist or Options must
+// be set.
+type KernelModuleUpdateOpts struct {
+	// Load loads the module now and at every boot.
+	Load bool
+	// Blacklist prevents the module from loading automatically and unloads
+	// it if possible. Mutually exclusive with Load.
+	Blacklist bool
+	// Options is the space-separated module parameters.
+	Options string
+}
+
+// kernelModuleListCollectionFromGen converts a gen.KernelModuleListResponse
+// to a Collection[KernelModuleListResult].
+func kernelModuleListCollectionFromGen(
+	g *gen.KernelModuleListResponse,
+) Collection[KernelModuleListResult] {
+	results := make([]KernelModuleListResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		result := KernelModuleListResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Error:    derefString(r.Error),
+		}
+
+		if r.Modules != nil {
+			modules := make([]KernelModule, 0, len(*r.Modules))
+			for _, m := range *r.Modules {
+				modules = append(modules, kernelModuleFromGen(m))
+			}
+			result.Modules = modules
+		}
+
+		results = append(results, result)
+	}
+
+	return Collection[KernelModuleListResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// kernelModuleFromGen converts a gen.KernelModuleInfo to a KernelModule.
+func kernelModuleFromGen(
+	m gen.KernelModuleInfo,
+) KernelModule {
+	return KernelModule{
+		Name:      derefString(m.Name),
+		Loaded:    derefBool(m.Loaded),
+		Size:      derefInt64(m.Size),
+		UsedBy:    derefStringSlice(m.UsedBy),
+		Managed:   derefBool(m.Managed),
+		Load:      derefBool(m.Load),
+		Blacklist: derefBool(m.Blacklist),
+		Options:   derefString(m.Options),
+		Drifted:   derefBool(m.Drifted),
+	}
+}
+
+// kernelModuleGetCollectionFromGen converts a gen.KernelModuleGetResponse
+// to a Collection[KernelModuleGetResult].
+func kernelModuleGetCollectionFromGen(
+	g *gen.KernelModuleGetResponse,
+) Collection[KernelModuleGetResult] {
+	results := make([]KernelModuleGetResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		result := KernelModuleGetResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Error:    derefString(r.Error),
+		}
+
+		if r.Module != nil {
+			module := kernelModuleFromGen(*r.Module)
+			result.Module = &module
+		}
+
+		results = append(results, result)
+	}
+
+	return Collection[KernelModuleGetResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// kernelModuleMutationCollectionFromGen converts a
+// gen.KernelModuleMutationResponse to a
+// Collection[KernelModuleMutationResult].
+func kernelModuleMutationCollectionFromGen(
+	g *gen.KernelModuleMutationResponse,
+) Collection[KernelModuleMutationResult] {
+	results := make([]KernelModuleMutationResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, KernelModuleMutationResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Name:     derefString(r.Name),
+			Changed:  derefBool(r.Changed),
+			Error:    derefString(r.Error),
+		})
+	}
+
+	return Collection[KernelModuleMutationResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
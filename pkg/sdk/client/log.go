@@ -21,8 +21,11 @@
 package client
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
 )
@@ -41,7 +44,14 @@ func (s *LogService) Query(
 	params := &gen.GetNodeLogParams{
 		Lines:    opts.Lines,
 		Since:    opts.Since,
+		Until:    opts.Until,
 		Priority: opts.Priority,
+		Boot:     opts.Boot,
+		Grep:     opts.Grep,
+		Reverse:  opts.Reverse,
+	}
+	if len(opts.Fields) > 0 {
+		params.Field = &opts.Fields
 	}
 
 	resp, err := s.client.GetNodeLogWithResponse(ctx, hostname, params)
@@ -69,6 +79,47 @@ func (s *LogService) Query(
 	return NewResponse(logCollectionFromGen(resp.JSON200), resp.Body), nil
 }
 
+// QueryFile returns entries from an allow-listed plain log file under
+// /var/log on the target host, for services that do not log to the
+// journal.
+func (s *LogService) QueryFile(
+	ctx context.Context,
+	hostname string,
+	path string,
+	opts LogFileQueryOpts,
+) (*Response[Collection[LogEntryResult]], error) {
+	params := &gen.GetNodeLogFileParams{
+		Path:    path,
+		Lines:   opts.Lines,
+		Grep:    opts.Grep,
+		Reverse: opts.Reverse,
+	}
+
+	resp, err := s.client.GetNodeLogFileWithResponse(ctx, hostname, params)
+	if err != nil {
+		return nil, fmt.Errorf("log query file: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(logCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
 // Sources returns unique syslog identifiers available in the journal on the
 // target host.
 func (s *LogService) Sources(
@@ -111,7 +162,14 @@ func (s *LogService) QueryUnit(
 	params := &gen.GetNodeLogUnitParams{
 		Lines:    opts.Lines,
 		Since:    opts.Since,
+		Until:    opts.Until,
 		Priority: opts.Priority,
+		Boot:     opts.Boot,
+		Grep:     opts.Grep,
+		Reverse:  opts.Reverse,
+	}
+	if len(opts.Fields) > 0 {
+		params.Field = &opts.Fields
 	}
 
 	resp, err := s.client.GetNodeLogUnitWithResponse(ctx, hostname, unit, params)
@@ -138,3 +196,118 @@ func (s *LogService) QueryUnit(
 
 	return NewResponse(logCollectionFromGen(resp.JSON200), resp.Body), nil
 }
+
+// FollowUnit streams new journal log entries for a specific systemd unit
+// on the target host. The returned channel receives entries until the
+// follow duration elapses, the stream ends, or ctx is cancelled, and is
+// then closed. Broadcast targets are not supported.
+func (s *LogService) FollowUnit(
+	ctx context.Context,
+	hostname string,
+	unit string,
+	opts LogFollowOpts,
+) (<-chan LogEntry, error) {
+	params := &gen.GetNodeLogUnitFollowParams{
+		Lines:    opts.Lines,
+		Priority: opts.Priority,
+		Duration: opts.Duration,
+	}
+
+	resp, err := s.client.GetNodeLogUnitFollow(ctx, hostname, unit, params)
+	if err != nil {
+		return nil, fmt.Errorf("log follow unit: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		// Error responses are JSON, so the generated parser can read them.
+		parsed, err := gen.ParseGetNodeLogUnitFollowResponse(resp)
+		if err != nil {
+			return nil, fmt.Errorf("log follow unit: %w", err)
+		}
+
+		return nil, checkError(
+			parsed.StatusCode(),
+			parsed.JSON400,
+			parsed.JSON401,
+			parsed.JSON403,
+			parsed.JSON500,
+		)
+	}
+
+	scanner := newSSEScanner(resp.Body)
+
+	start, err := readLogFollowStart(scanner)
+	if err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+
+	if start.Status != string(gen.LogResultEntryStatusOk) {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf(
+			"log follow unit: %s on %s: %s",
+			start.Status,
+			start.Hostname,
+			start.Error,
+		)
+	}
+
+	entries := make(chan LogEntry)
+	go func() {
+		defer close(entries)
+		defer func() { _ = resp.Body.Close() }()
+
+		for {
+			event, ok := readSSEEvent(scanner)
+			if !ok || event.name == "end" {
+				return
+			}
+
+			if event.name != "entry" {
+				continue
+			}
+
+			var info gen.LogEntryInfo
+			if err := json.Unmarshal(event.data, &info); err != nil {
+				continue
+			}
+
+			select {
+			case entries <- logEntryInfoFromGen(info):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return entries, nil
+}
+
+// readLogFollowStart reads the opening start event of a log follow stream
+// and returns the target agent's result.
+func readLogFollowStart(
+	scanner *bufio.Scanner,
+) (LogEntryResult, error) {
+	event, ok := readSSEEvent(scanner)
+	if !ok {
+		return LogEntryResult{}, fmt.Errorf("log follow unit: stream closed before start")
+	}
+
+	if event.name != "start" {
+		return LogEntryResult{}, fmt.Errorf(
+			"log follow unit: unexpected event %q",
+			event.name,
+		)
+	}
+
+	var start gen.LogCollectionResponse
+	if err := json.Unmarshal(event.data, &start); err != nil {
+		return LogEntryResult{}, fmt.Errorf("log follow unit: decode start: %w", err)
+	}
+
+	if len(start.Results) == 0 {
+		return LogEntryResult{}, fmt.Errorf("log follow unit: no agent result")
+	}
+
+	return logEntryResultFromGen(start.Results[0]), nil
+}
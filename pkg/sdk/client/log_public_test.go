@@ -218,7 +218,11 @@ func (suite *LogPublicTestSuite) TestQuery() {
 func (suite *LogPublicTestSuite) TestQueryWithOpts() {
 	lines := 100
 	since := "1h"
+	until := "10m"
 	priority := "err"
+	boot := "-1"
+	grep := "timeout"
+	reverse := true
 
 	tests := []struct {
 		name         string
@@ -251,6 +255,40 @@ func (suite *LogPublicTestSuite) TestQueryWithOpts() {
 				suite.Len(resp.Data.Results, 1)
 			},
 		},
+		{
+			name: "when extended filters are set sends query params",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query()
+				suite.Equal("10m", q.Get("until"))
+				suite.Equal("-1", q.Get("boot"))
+				suite.Equal("timeout", q.Get("grep"))
+				suite.Equal([]string{"_COMM=sshd", "_UID=0"}, q["field"])
+				suite.Equal("true", q.Get("reverse"))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","entries":[]}]}`,
+					),
+				)
+			},
+			opts: client.LogQueryOpts{
+				Until:   &until,
+				Boot:    &boot,
+				Grep:    &grep,
+				Fields:  []string{"_COMM=sshd", "_UID=0"},
+				Reverse: &reverse,
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.LogEntryResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Len(resp.Data.Results, 1)
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -426,6 +464,424 @@ func (suite *LogPublicTestSuite) TestQueryUnit() {
 	}
 }
 
+func (suite *LogPublicTestSuite) TestQueryFile() {
+	lines := 20
+	grep := "upstream"
+	reverse := true
+
+	tests := []struct {
+		name         string
+		handler      http.HandlerFunc
+		serverURL    string
+		opts         client.LogFileQueryOpts
+		validateFunc func(*client.Response[client.Collection[client.LogEntryResult]], error)
+	}{
+		{
+			name: "when querying a file returns result collection",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				suite.Equal("/api/node/_any/log/file", r.URL.Path)
+				q := r.URL.Query()
+				suite.Equal("/var/log/nginx/error.log", q.Get("path"))
+				suite.Equal("20", q.Get("lines"))
+				suite.Equal("upstream", q.Get("grep"))
+				suite.Equal("true", q.Get("reverse"))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","entries":[{"timestamp":"2026-01-01T00:00:00Z","unit":"nginx","priority":"","message":"upstream timed out","pid":12,"hostname":"web-01"}]}]}`,
+					),
+				)
+			},
+			opts: client.LogFileQueryOpts{
+				Lines:   &lines,
+				Grep:    &grep,
+				Reverse: &reverse,
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.LogEntryResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Equal("00000000-0000-0000-0000-000000000001", resp.Data.JobID)
+				suite.Require().Len(resp.Data.Results, 1)
+				suite.Require().Len(resp.Data.Results[0].Entries, 1)
+				suite.Equal("upstream timed out", resp.Data.Results[0].Entries[0].Message)
+				suite.Equal("nginx", resp.Data.Results[0].Entries[0].Unit)
+				suite.Equal(12, resp.Data.Results[0].Entries[0].PID)
+			},
+		},
+		{
+			name: "when server returns 400 returns ValidationError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"Key: 'GetNodeLogFileParams.Path' Error:Field validation for 'Path' failed on the 'startswith' tag"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.LogEntryResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ValidationError
+				suite.True(errors.As(err, &target))
+				suite.Contains(target.Message, "startswith")
+			},
+		},
+		{
+			name: "when server returns 401 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":"unauthorized"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.LogEntryResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusUnauthorized, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 500 returns ServerError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"error":"internal error"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.LogEntryResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ServerError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusInternalServerError, target.StatusCode)
+			},
+		},
+		{
+			name:      "when client HTTP call fails returns error",
+			serverURL: "http://127.0.0.1:0",
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.LogEntryResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+				suite.Contains(err.Error(), "log query file")
+			},
+		},
+		{
+			name: "when server returns 200 with no JSON body returns UnexpectedStatusError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.LogEntryResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.UnexpectedStatusError
+				suite.True(errors.As(err, &target))
+				suite.Equal("nil response body", target.Message)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			var (
+				serverURL string
+				cleanup   func()
+			)
+
+			if tc.serverURL != "" {
+				serverURL = tc.serverURL
+				cleanup = func() {}
+			} else {
+				server := httptest.NewServer(tc.handler)
+				serverURL = server.URL
+				cleanup = server.Close
+			}
+			defer cleanup()
+
+			sut := client.New(
+				serverURL,
+				"test-token",
+				client.WithLogger(slog.Default()),
+			)
+
+			resp, err := sut.Log.QueryFile(
+				suite.ctx,
+				"_any",
+				"/var/log/nginx/error.log",
+				tc.opts,
+			)
+			tc.validateFunc(resp, err)
+		})
+	}
+}
+
+func (suite *LogPublicTestSuite) TestFollowUnit() {
+	const (
+		startOK      = "event: start\ndata: {\"job_id\":\"00000000-0000-0000-0000-000000000001\",\"results\":[{\"hostname\":\"agent1\",\"status\":\"ok\"}]}\n\n"
+		entryEvent   = "event: entry\ndata: {\"timestamp\":\"2026-01-01T00:00:00Z\",\"unit\":\"sshd\",\"priority\":\"info\",\"message\":\"Accepted publickey\",\"pid\":1234}\n\n"
+		endEvent     = "event: end\ndata: {}\n\n"
+		sseMediaType = "text/event-stream"
+	)
+
+	lines := 5
+	duration := 30
+
+	writeSSE := func(w http.ResponseWriter, events ...string) {
+		w.Header().Set("Content-Type", sseMediaType)
+		w.WriteHeader(http.StatusOK)
+		for _, e := range events {
+			_, _ = w.Write([]byte(e))
+		}
+	}
+
+	tests := []struct {
+		name         string
+		handler      http.HandlerFunc
+		serverURL    string
+		opts         client.LogFollowOpts
+		cancel       bool
+		validateFunc func(entries []client.LogEntry, err error)
+	}{
+		{
+			name: "when following streams entries until end",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				suite.Equal("/api/node/server1/log/unit/sshd.service/follow", r.URL.Path)
+				suite.Equal("5", r.URL.Query().Get("lines"))
+				suite.Equal("err", r.URL.Query().Get("priority"))
+				suite.Equal("30", r.URL.Query().Get("duration"))
+				writeSSE(
+					w,
+					": keepalive\n\n",
+					startOK,
+					entryEvent,
+					"event: entry\ndata: not-json\n\n",
+					"event: unknown\ndata: {}\n\n",
+					entryEvent,
+					endEvent,
+					entryEvent,
+				)
+			},
+			opts: client.LogFollowOpts{
+				Lines:    &lines,
+				Priority: strPtr("err"),
+				Duration: &duration,
+			},
+			validateFunc: func(entries []client.LogEntry, err error) {
+				suite.NoError(err)
+				suite.Require().Len(entries, 2)
+				suite.Equal("Accepted publickey", entries[0].Message)
+				suite.Equal("sshd", entries[0].Unit)
+				suite.Equal("info", entries[0].Priority)
+				suite.Equal(1234, entries[0].PID)
+			},
+		},
+		{
+			name: "when stream closes without end closes channel",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				writeSSE(w, startOK, entryEvent)
+			},
+			validateFunc: func(entries []client.LogEntry, err error) {
+				suite.NoError(err)
+				suite.Len(entries, 1)
+			},
+		},
+		{
+			name: "when context is cancelled closes channel",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeSSE(w, startOK, entryEvent)
+				w.(http.Flusher).Flush()
+				<-r.Context().Done()
+			},
+			cancel: true,
+			validateFunc: func(_ []client.LogEntry, err error) {
+				suite.NoError(err)
+			},
+		},
+		{
+			name: "when agent skips returns error",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				writeSSE(
+					w,
+					"event: start\ndata: {\"results\":[{\"hostname\":\"agent1\",\"status\":\"skipped\",\"error\":\"unsupported\"}]}\n\n",
+					endEvent,
+				)
+			},
+			validateFunc: func(entries []client.LogEntry, err error) {
+				suite.Error(err)
+				suite.Nil(entries)
+				suite.Contains(err.Error(), "skipped on agent1: unsupported")
+			},
+		},
+		{
+			name: "when stream closes before start returns error",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				writeSSE(w)
+			},
+			validateFunc: func(_ []client.LogEntry, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "stream closed before start")
+			},
+		},
+		{
+			name: "when first event is not start returns error",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				writeSSE(w, entryEvent)
+			},
+			validateFunc: func(_ []client.LogEntry, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), `unexpected event "entry"`)
+			},
+		},
+		{
+			name: "when start event is malformed returns error",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				writeSSE(w, "event: start\ndata: not-json\n\n")
+			},
+			validateFunc: func(_ []client.LogEntry, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "decode start")
+			},
+		},
+		{
+			name: "when start event has no results returns error",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				writeSSE(w, "event: start\ndata: {\"results\":[]}\n\n")
+			},
+			validateFunc: func(_ []client.LogEntry, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "no agent result")
+			},
+		},
+		{
+			name: "when server returns 400 returns ValidationError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"log follow requires a single target"}`))
+			},
+			validateFunc: func(entries []client.LogEntry, err error) {
+				suite.Error(err)
+				suite.Nil(entries)
+
+				var target *client.ValidationError
+				suite.True(errors.As(err, &target))
+				suite.Equal("log follow requires a single target", target.Message)
+			},
+		},
+		{
+			name: "when server returns 403 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error":"forbidden"}`))
+			},
+			validateFunc: func(_ []client.LogEntry, err error) {
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusForbidden, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 500 returns ServerError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"error":"subscribe failed"}`))
+			},
+			validateFunc: func(_ []client.LogEntry, err error) {
+				var target *client.ServerError
+				suite.True(errors.As(err, &target))
+				suite.Equal("subscribe failed", target.Message)
+			},
+		},
+		{
+			name: "when error body is malformed returns error",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`not-json`))
+			},
+			validateFunc: func(_ []client.LogEntry, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "log follow unit")
+			},
+		},
+		{
+			name:      "when client HTTP call fails returns error",
+			serverURL: "http://127.0.0.1:0",
+			validateFunc: func(_ []client.LogEntry, err error) {
+				suite.Error(err)
+				suite.Contains(err.Error(), "log follow unit")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			var (
+				serverURL string
+				cleanup   func()
+			)
+
+			if tc.serverURL != "" {
+				serverURL = tc.serverURL
+				cleanup = func() {}
+			} else {
+				server := httptest.NewServer(tc.handler)
+				serverURL = server.URL
+				cleanup = server.Close
+			}
+			defer cleanup()
+
+			sut := client.New(
+				serverURL,
+				"test-token",
+				client.WithLogger(slog.Default()),
+			)
+
+			ctx, cancel := context.WithCancel(suite.ctx)
+			defer cancel()
+
+			stream, err := sut.Log.FollowUnit(ctx, "server1", "sshd.service", tc.opts)
+			if err != nil {
+				tc.validateFunc(nil, err)
+				return
+			}
+
+			if tc.cancel {
+				cancel()
+			}
+
+			var entries []client.LogEntry
+			for entry := range stream {
+				entries = append(entries, entry)
+			}
+
+			tc.validateFunc(entries, nil)
+		})
+	}
+}
+
 func (suite *LogPublicTestSuite) TestSources() {
 	tests := []struct {
 		name         string
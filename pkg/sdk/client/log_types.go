@@ -56,8 +56,40 @@ type LogQueryOpts struct {
 	Lines *int
 	// Since filters entries since this time (e.g., "1h", "2026-01-01 00:00:00").
 	Since *string
+	// Until filters entries until this time (e.g., "10m", "2026-01-01 00:00:00").
+	Until *string
 	// Priority filters by log priority level (e.g., "err", "warning", "info").
 	Priority *string
+	// Boot restricts entries to one boot: an offset such as "0" or "-1",
+	// or a 32-character boot ID.
+	Boot *string
+	// Grep filters entries whose message matches this regular expression.
+	Grep *string
+	// Fields are exact journal field matches in FIELD=value form
+	// (e.g., "_COMM=sshd").
+	Fields []string
+	// Reverse returns the newest entries first.
+	Reverse *bool
+}
+
+// LogFileQueryOpts contains options for plain log file queries.
+type LogFileQueryOpts struct {
+	// Lines is the maximum number of log lines to return.
+	Lines *int
+	// Grep filters lines matching this regular expression.
+	Grep *string
+	// Reverse returns the newest entries first.
+	Reverse *bool
+}
+
+// LogFollowOpts contains options for following a unit's log.
+type LogFollowOpts struct {
+	// Lines is the number of existing log lines to send before following.
+	Lines *int
+	// Priority filters by log priority level (e.g., "err", "warning", "info").
+	Priority *string
+	// Duration is how long to follow the log, in seconds.
+	Duration *int
 }
 
 // logCollectionFromGen converts a gen.LogCollectionResponse to a
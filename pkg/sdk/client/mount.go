@@ -0,0 +1,292 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// MountService provides filesystem mount and fstab management operations.
+type MountService struct {
+	client *gen.ClientWithResponses
+}
+
+// List returns current and managed mounts on the target host.
+func (s *MountService) List(
+	ctx context.Context,
+	hostname string,
+) (*Response[Collection[MountInfoResult]], error) {
+	resp, err := s.client.GetNodeMountWithResponse(ctx, hostname)
+	if err != nil {
+		return nil, fmt.Errorf("mount list: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(mountListCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Get returns information about a managed mount on the target host.
+func (s *MountService) Get(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[MountGetResult]], error) {
+	resp, err := s.client.GetNodeMountByNameWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("mount get: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(mountGetCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Create adds a managed fstab entry on the target host.
+func (s *MountService) Create(
+	ctx context.Context,
+	hostname string,
+	opts MountCreateOpts,
+) (*Response[Collection[MountMutationResult]], error) {
+	body := gen.MountCreateRequest{
+		Name:   opts.Name,
+		Device: opts.Device,
+		Path:   opts.Path,
+		FsType: opts.FSType,
+	}
+
+	if opts.Options != "" {
+		body.Options = &opts.Options
+	}
+
+	if opts.Dump != 0 {
+		body.Dump = &opts.Dump
+	}
+
+	if opts.Pass != 0 {
+		body.Pass = &opts.Pass
+	}
+
+	resp, err := s.client.PostNodeMountWithResponse(ctx, hostname, body)
+	if err != nil {
+		return nil, fmt.Errorf("mount create: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(mountMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Update updates a managed fstab entry on the target host.
+func (s *MountService) Update(
+	ctx context.Context,
+	hostname string,
+	name string,
+	opts MountUpdateOpts,
+) (*Response[Collection[MountMutationResult]], error) {
+	body := gen.MountUpdateRequest{}
+
+	if opts.Device != "" {
+		body.Device = &opts.Device
+	}
+
+	if opts.Path != "" {
+		body.Path = &opts.Path
+	}
+
+	if opts.FSType != "" {
+		body.FsType = &opts.FSType
+	}
+
+	if opts.Options != "" {
+		body.Options = &opts.Options
+	}
+
+	body.Dump = opts.Dump
+	body.Pass = opts.Pass
+
+	resp, err := s.client.PutNodeMountWithResponse(ctx, hostname, name, body)
+	if err != nil {
+		return nil, fmt.Errorf("mount update: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(mountMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Delete removes a managed fstab entry from the target host.
+func (s *MountService) Delete(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[MountMutationResult]], error) {
+	resp, err := s.client.DeleteNodeMountWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("mount delete: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(mountMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Mount mounts a managed filesystem on the target host.
+func (s *MountService) Mount(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[MountMutationResult]], error) {
+	resp, err := s.client.PostNodeMountMountWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("mount mount: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(mountMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Unmount unmounts a managed filesystem on the target host.
+func (s *MountService) Unmount(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[MountMutationResult]], error) {
+	resp, err := s.client.PostNodeMountUnmountWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("mount unmount: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(mountMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// MountInfoResult represents a mount list result from a single agent.
+type MountInfoResult struct {
+	Hostname string      `json:"hostname"`
+	Status   string      `json:"status"`
+	Mounts   []MountInfo `json:"mounts,omitempty"`
+	Error    string      `json:"error,omitempty"`
+}
+
+// MountInfo represents a single filesystem mount.
+type MountInfo struct {
+	Name         string `json:"name,omitempty"`
+	Device       string `json:"device,omitempty"`
+	Path         string `json:"path,omitempty"`
+	FSType       string `json:"fs_type,omitempty"`
+	Options      string `json:"options,omitempty"`
+	MountOptions string `json:"mount_options,omitempty"`
+	Dump         int    `json:"dump"`
+	Pass         int    `json:"pass"`
+	Mounted      bool   `json:"mounted"`
+	Managed      bool   `json:"managed"`
+}
+
+// MountGetResult represents a mount get result from a single agent.
+type MountGetResult struct {
+	Hostname string     `json:"hostname"`
+	Status   string     `json:"status"`
+	Mount    *MountInfo `json:"mount,omitempty"`
+	Error    string     `json:"error,omitempty"`
+}
+
+// MountMutationResult represents the result of a mount mutation or
+// action operation.
+type MountMutationResult struct {
+	Hostname string `json:"hostname"`
+	Status   string `json:"status"`
+	Name     string `json:"name"`
+	Changed  bool   `json:"changed"`
+	Error    string `json:"error,omitempty"`
+}
+
+// MountCreateOpts contains options for creating a managed fstab entry.
+type MountCreateOpts struct {
+	// Name identifies the managed entry (required).
+	Name string
+	// Device is the block device, UUID=, LABEL=, or remote source (required).
+	Device string
+	// Path is the absolute mount point (required).
+	Path string
+	// FSType is the filesystem type (required).
+	FSType string
+	// Options is the comma-separated mount options. Defaults to "defaults".
+	Options string
+	// Dump is the dump frequency (fstab field five).
+	Dump int
+	// Pass is the filesystem check order (fstab field six).
+	Pass int
+}
+
+// MountUpdateOpts contains options for updating a managed fstab entry.
+// Empty or nil fields keep their current values.
+type MountUpdateOpts struct {
+	// Device is the block device, UUID=, LABEL=, or remote source.
+	Device string
+	// Path is the absolute mount point.
+	Path string
+	// FSType is the filesystem type.
+	FSType string
+	// Options is the comma-separated mount options.
+	Options string
+	// Dump is the dump frequency (fstab field five). Nil keeps the
+	// current value.
+	Dump *int
+	// Pass is the filesystem check order (fstab field six). Nil keeps
+	// the current value.
+	Pass *int
+}
+
+// mountListCollectionFromGen converts a gen.MountListResponse
+// to a Collection[MountInfoResult].
+func mountListCollectionFromGen(
+	g *gen.MountListResponse,
+) Collection[MountInfoResult] {
+	results := make([]MountInfoResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		result := MountInfoResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Error:    derefString(r.Error),
+		}
+
+		if r.Mounts != nil {
+			mounts := make([]MountInfo, 0, len(*r.Mounts))
+			for _, m := range *r.Mounts {
+				mounts = append(mounts, mountInfoFromGen(m))
+			}
+			result.Mounts = mounts
+		}
+
+		results = append(results, result)
+	}
+
+	return Collection[MountInfoResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// mountInfoFromGen converts a gen.MountInfo to a MountInfo.
+func mountInfoFromGen(
+	m gen.MountInfo,
+) MountInfo {
+	return MountInfo{
+		Name:         derefString(m.Name),
+		Device:       derefString(m.Device),
+		Path:         derefString(m.Path),
+		FSType:       derefString(m.FsType),
+		Options:      derefString(m.Options),
+		MountOptions: derefString(m.MountOptions),
+		Dump:         derefInt(m.Dump),
+		Pass:         derefInt(m.Pass),
+		Mounted:      derefBool(m.Mounted),
+		Managed:      derefBool(m.Managed),
+	}
+}
+
+// mountGetCollectionFromGen converts a gen.MountGetResponse
+// to a Collection[MountGetResult].
+func mountGetCollectionFromGen(
+	g *gen.MountGetResponse,
+) Collection[MountGetResult] {
+	results := make([]MountGetResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		result := MountGetResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Error:    derefString(r.Error),
+		}
+
+		if r.Mount != nil {
+			info := mountInfoFromGen(*r.Mount)
+			result.Mount = &info
+		}
+
+		results = append(results, result)
+	}
+
+	return Collection[MountGetResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// mountMutationCollectionFromGen converts a gen.MountMutationResponse
+// to a Collection[MountMutationResult].
+func mountMutationCollectionFromGen(
+	g *gen.MountMutationResponse,
+) Collection[MountMutationResult] {
+	results := make([]MountMutationResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, MountMutationResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Name:     derefString(r.Name),
+			Changed:  derefBool(r.Changed),
+			Error:    derefString(r.Error),
+		})
+	}
+
+	return Collection[MountMutationResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
@@ -91,6 +91,15 @@ const (
 	OpCronDelete JobOperation = "schedule.cron.delete"
 )
 
+// Schedule/Timer operations.
+const (
+	OpTimerList   JobOperation = "schedule.timer.list"
+	OpTimerGet    JobOperation = "schedule.timer.get"
+	OpTimerCreate JobOperation = "schedule.timer.create"
+	OpTimerUpdate JobOperation = "schedule.timer.update"
+	OpTimerDelete JobOperation = "schedule.timer.delete"
+)
+
 // Sysctl operations.
 const (
 	OpSysctlList   JobOperation = "node.sysctl.list"
@@ -122,9 +131,11 @@ const (
 
 // Process operations.
 const (
-	OpProcessList   JobOperation = "node.process.list"
-	OpProcessGet    JobOperation = "node.process.get"
-	OpProcessSignal JobOperation = "node.process.signal"
+	OpProcessList        JobOperation = "node.process.list"
+	OpProcessGet         JobOperation = "node.process.get"
+	OpProcessTree        JobOperation = "node.process.tree"
+	OpProcessSignal      JobOperation = "node.process.signal"
+	OpProcessSignalMatch JobOperation = "node.process.signal-match"
 )
 
 // User operations.
@@ -161,13 +172,23 @@ const (
 	OpPackageRemove      JobOperation = "node.package.remove"
 	OpPackageUpdate      JobOperation = "node.package.update"
 	OpPackageListUpdates JobOperation = "node.package.listUpdates"
+	OpPackageHold        JobOperation = "node.package.hold"
+	OpPackageUnhold      JobOperation = "node.package.unhold"
+	OpPackageEnsure      JobOperation = "node.package.ensure"
+	OpPackageRepoList    JobOperation = "node.package.repo.list"
+	OpPackageRepoGet     JobOperation = "node.package.repo.get"
+	OpPackageRepoCreate  JobOperation = "node.package.repo.create"
+	OpPackageRepoUpdate  JobOperation = "node.package.repo.update"
+	OpPackageRepoDelete  JobOperation = "node.package.repo.delete"
 )
 
 // Log operations.
 const (
 	OpLogQuery     JobOperation = "node.log.query"
 	OpLogQueryUnit JobOperation = "node.log.queryUnit"
+	OpLogQueryFile JobOperation = "node.log.queryFile"
 	OpLogSources   JobOperation = "node.log.sources"
+	OpLogFollow    JobOperation = "node.log.follow"
 )
 
 // Certificate operations.
@@ -196,6 +217,25 @@ const (
 	OpNetworkRouteDelete JobOperation = "network.route.delete"
 )
 
+// Network firewall operations.
+const (
+	OpNetworkFirewallList   JobOperation = "network.firewall.list"
+	OpNetworkFirewallGet    JobOperation = "network.firewall.get"
+	OpNetworkFirewallCreate JobOperation = "network.firewall.create"
+	OpNetworkFirewallUpdate JobOperation = "network.firewall.update"
+	OpNetworkFirewallDelete JobOperation = "network.firewall.delete"
+)
+
+// Network socket operations.
+const (
+	OpNetworkSocketList JobOperation = "network.socket.list"
+)
+
+// Network apply operations.
+const (
+	OpNetworkApplyConfirm JobOperation = "network.apply.confirm"
+)
+
 // Service operations.
 const (
 	OpServiceList    JobOperation = "node.service.list"
@@ -210,6 +250,73 @@ const (
 	OpServiceDisable JobOperation = "node.service.disable"
 )
 
+// Service drop-in operations.
+const (
+	OpServiceDropInList   JobOperation = "node.service.dropin.list"
+	OpServiceDropInCreate JobOperation = "node.service.dropin.create"
+	OpServiceDropInUpdate JobOperation = "node.service.dropin.update"
+	OpServiceDropInDelete JobOperation = "node.service.dropin.delete"
+)
+
+// Mount operations.
+const (
+	OpMountList    JobOperation = "node.mount.list"
+	OpMountGet     JobOperation = "node.mount.get"
+	OpMountCreate  JobOperation = "node.mount.create"
+	OpMountUpdate  JobOperation = "node.mount.update"
+	OpMountDelete  JobOperation = "node.mount.delete"
+	OpMountMount   JobOperation = "node.mount.mount"
+	OpMountUnmount JobOperation = "node.mount.unmount"
+)
+
+// Block device operations.
+const (
+	OpBlockList   JobOperation = "node.block.list"
+	OpBlockExtend JobOperation = "node.block.extend"
+)
+
+// Swap operations.
+const (
+	OpSwapGet    JobOperation = "node.swap.get"
+	OpSwapCreate JobOperation = "node.swap.create"
+	OpSwapDelete JobOperation = "node.swap.delete"
+)
+
+// Kernel module operations.
+const (
+	OpKernelModuleList   JobOperation = "node.kernel.module.list"
+	OpKernelModuleGet    JobOperation = "node.kernel.module.get"
+	OpKernelModuleCreate JobOperation = "node.kernel.module.create"
+	OpKernelModuleUpdate JobOperation = "node.kernel.module.update"
+	OpKernelModuleDelete JobOperation = "node.kernel.module.delete"
+)
+
+// Hosts file operations.
+const (
+	OpHostsList   JobOperation = "node.hosts.list"
+	OpHostsGet    JobOperation = "node.hosts.get"
+	OpHostsCreate JobOperation = "node.hosts.create"
+	OpHostsUpdate JobOperation = "node.hosts.update"
+	OpHostsDelete JobOperation = "node.hosts.delete"
+)
+
+// Sudoers drop-in operations.
+const (
+	OpSudoersList   JobOperation = "node.sudoers.list"
+	OpSudoersGet    JobOperation = "node.sudoers.get"
+	OpSudoersCreate JobOperation = "node.sudoers.create"
+	OpSudoersUpdate JobOperation = "node.sudoers.update"
+	OpSudoersDelete JobOperation = "node.sudoers.delete"
+)
+
+// sshd drop-in operations.
+const (
+	OpSshdGet    JobOperation = "node.sshd.get"
+	OpSshdCreate JobOperation = "node.sshd.create"
+	OpSshdUpdate JobOperation = "node.sshd.update"
+	OpSshdDelete JobOperation = "node.sshd.delete"
+)
+
 // Target constants for job routing.
 const (
 	// TargetAny routes to any available agent (load-balanced).
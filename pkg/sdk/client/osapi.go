@@ -100,6 +100,10 @@ type Client struct {
 	// create, update, delete).
 	Cron *CronService
 
+	// Timer provides systemd timer management operations (list, get,
+	// create, update, delete).
+	Timer *TimerService
+
 	// Sysctl provides sysctl parameter management operations (list, get,
 	// set, delete).
 	Sysctl *SysctlService
@@ -147,6 +151,44 @@ type Client struct {
 	// create, update, delete).
 	Route *RouteService
 
+	// Firewall provides nftables firewall rule set management operations
+	// (list, get, create, update, delete).
+	Firewall *FirewallService
+
+	// Socket provides TCP and UDP socket inventory operations (list).
+	Socket *SocketService
+
+	// Network provides network-wide operations (confirm a pending
+	// safe-apply change).
+	Network *NetworkService
+
+	// Mount provides filesystem mount and fstab management operations (list,
+	// get, create, update, delete, mount, unmount).
+	Mount *MountService
+
+	// Block provides block device, partition and LVM inventory and
+	// logical volume extension operations (list, extend).
+	Block *BlockService
+
+	// Swap provides swap file management operations (get, create, delete).
+	Swap *SwapService
+
+	// Kernel provides kernel module management operations (list, get,
+	// create, update, delete).
+	Kernel *KernelService
+
+	// Hosts provides /etc/hosts entry management operations (list, get,
+	// create, update, delete).
+	Hosts *HostsService
+
+	// Sudoers provides sudoers drop-in management operations (list, get,
+	// create, update, delete).
+	Sudoers *SudoersService
+
+	// Sshd provides OpenSSH server drop-in management operations (get,
+	// create, update, delete).
+	Sshd *SshdService
+
 	httpClient    *gen.ClientWithResponses
 	baseURL       string
 	logger        *slog.Logger
@@ -224,6 +266,7 @@ func New(
 	c.File = &FileService{client: httpClient}
 	c.Docker = &DockerService{client: httpClient}
 	c.Cron = &CronService{client: httpClient}
+	c.Timer = &TimerService{client: httpClient}
 	c.Sysctl = &SysctlService{client: httpClient}
 	c.NTP = &NTPService{client: httpClient}
 	c.Timezone = &TimezoneService{client: httpClient}
@@ -237,6 +280,16 @@ func New(
 	c.Service = &ServiceService{client: httpClient}
 	c.Interface = &InterfaceService{client: httpClient}
 	c.Route = &RouteService{client: httpClient}
+	c.Firewall = &FirewallService{client: httpClient}
+	c.Socket = &SocketService{client: httpClient}
+	c.Network = &NetworkService{client: httpClient}
+	c.Mount = &MountService{client: httpClient}
+	c.Block = &BlockService{client: httpClient}
+	c.Swap = &SwapService{client: httpClient}
+	c.Kernel = &KernelService{client: httpClient}
+	c.Hosts = &HostsService{client: httpClient}
+	c.Sudoers = &SudoersService{client: httpClient}
+	c.Sshd = &SshdService{client: httpClient}
 
 	return c
 }
@@ -94,14 +94,27 @@ func (s *PackageService) Get(
 	return NewResponse(packageInfoCollectionFromGet(resp.JSON200), resp.Body), nil
 }
 
-// Install installs a package on the target host.
+// Install installs a package on the target host. Set opts.Version to
+// install a specific version instead of the candidate, or set
+// opts.Object instead of opts.Name to install a package artifact from
+// the Object Store.
 func (s *PackageService) Install(
 	ctx context.Context,
 	hostname string,
-	name string,
+	opts PackageInstallOpts,
 ) (*Response[Collection[PackageMutationResult]], error) {
-	body := gen.PackageInstallRequest{
-		Name: name,
+	body := gen.PackageInstallRequest{}
+
+	if opts.Name != "" {
+		body.Name = &opts.Name
+	}
+
+	if opts.Version != "" {
+		body.Version = &opts.Version
+	}
+
+	if opts.Object != "" {
+		body.Object = &opts.Object
 	}
 
 	resp, err := s.client.PostNodePackageWithResponse(ctx, hostname, body)
@@ -220,3 +233,296 @@ func (s *PackageService) ListUpdates(
 
 	return NewResponse(packageUpdateCollectionFromGen(resp.JSON200), resp.Body), nil
 }
+
+// Hold holds a package at its installed version on the target host.
+func (s *PackageService) Hold(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[PackageMutationResult]], error) {
+	resp, err := s.client.PostNodePackageHoldWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("package hold: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(packageMutationCollectionFromHold(resp.JSON200), resp.Body), nil
+}
+
+// Unhold releases a package hold on the target host.
+func (s *PackageService) Unhold(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[PackageMutationResult]], error) {
+	resp, err := s.client.DeleteNodePackageHoldWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("package unhold: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(packageMutationCollectionFromUnhold(resp.JSON200), resp.Body), nil
+}
+
+// Ensure converges packages on the target host to the desired states in
+// opts. Each result carries per-package changes.
+func (s *PackageService) Ensure(
+	ctx context.Context,
+	hostname string,
+	opts PackageEnsureOpts,
+) (*Response[Collection[PackageEnsureResult]], error) {
+	body := gen.PackageEnsureRequest{
+		Packages: make([]gen.PackageEnsureEntry, 0, len(opts.Packages)),
+	}
+
+	for _, p := range opts.Packages {
+		entry := gen.PackageEnsureEntry{
+			Name:  p.Name,
+			State: gen.PackageEnsureEntryState(p.State),
+		}
+		if p.Version != "" {
+			v := p.Version
+			entry.Version = &v
+		}
+		body.Packages = append(body.Packages, entry)
+	}
+
+	resp, err := s.client.PostNodePackageEnsureWithResponse(ctx, hostname, body)
+	if err != nil {
+		return nil, fmt.Errorf("package ensure: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(packageEnsureCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// ListRepos lists osapi-managed package repositories on the target host.
+func (s *PackageService) ListRepos(
+	ctx context.Context,
+	hostname string,
+) (*Response[Collection[PackageRepoResult]], error) {
+	resp, err := s.client.GetNodePackageRepoWithResponse(ctx, hostname)
+	if err != nil {
+		return nil, fmt.Errorf("package repo list: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(packageRepoCollectionFromList(resp.JSON200), resp.Body), nil
+}
+
+// GetRepo retrieves a single osapi-managed package repository by name on
+// the target host.
+func (s *PackageService) GetRepo(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[PackageRepoResult]], error) {
+	resp, err := s.client.GetNodePackageRepoByNameWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("package repo get: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(packageRepoCollectionFromGet(resp.JSON200), resp.Body), nil
+}
+
+// CreateRepo deploys a deb822 sources file and optional signing key from
+// the Object Store to the target host. The package index is refreshed
+// only when a file changed.
+func (s *PackageService) CreateRepo(
+	ctx context.Context,
+	hostname string,
+	opts PackageRepoCreateOpts,
+) (*Response[Collection[PackageRepoMutationResult]], error) {
+	body := gen.PackageRepoCreateRequest{
+		Name:   opts.Name,
+		Object: opts.Object,
+	}
+
+	if opts.KeyObject != "" {
+		body.KeyObject = &opts.KeyObject
+	}
+
+	resp, err := s.client.PostNodePackageRepoWithResponse(ctx, hostname, body)
+	if err != nil {
+		return nil, fmt.Errorf("package repo create: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(packageRepoMutationCollectionFromCreate(resp.JSON200), resp.Body), nil
+}
+
+// UpdateRepo redeploys an existing package repository on the target host.
+// Empty fields in opts keep the currently deployed objects.
+func (s *PackageService) UpdateRepo(
+	ctx context.Context,
+	hostname string,
+	name string,
+	opts PackageRepoUpdateOpts,
+) (*Response[Collection[PackageRepoMutationResult]], error) {
+	body := gen.PackageRepoUpdateRequest{}
+
+	if opts.Object != "" {
+		body.Object = &opts.Object
+	}
+
+	if opts.KeyObject != "" {
+		body.KeyObject = &opts.KeyObject
+	}
+
+	resp, err := s.client.PutNodePackageRepoWithResponse(ctx, hostname, name, body)
+	if err != nil {
+		return nil, fmt.Errorf("package repo update: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(packageRepoMutationCollectionFromUpdate(resp.JSON200), resp.Body), nil
+}
+
+// DeleteRepo removes a package repository and its signing key from the
+// target host.
+func (s *PackageService) DeleteRepo(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[PackageRepoMutationResult]], error) {
+	resp, err := s.client.DeleteNodePackageRepoWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("package repo delete: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(packageRepoMutationCollectionFromDelete(resp.JSON200), resp.Body), nil
+}
@@ -41,12 +41,66 @@ type PackageInfo struct {
 	Size        int64  `json:"size,omitempty"`
 }
 
+// PackageInstallOpts contains options for installing a package.
+type PackageInstallOpts struct {
+	// Name is the package name. Required unless Object is set.
+	Name string
+	// Version pins the install to an exact version. Defaults to the
+	// candidate version. Not valid with Object.
+	Version string
+	// Object is an Object Store reference for a package artifact
+	// (.deb, .rpm, or .apk). Mutually exclusive with Name.
+	Object string
+}
+
+// Package ensure states.
+const (
+	PackageStatePresent = "present"
+	PackageStateAbsent  = "absent"
+	PackageStateLatest  = "latest"
+	PackageStatePinned  = "pinned"
+)
+
+// PackageEnsureOpts contains the desired package manifest for Ensure.
+type PackageEnsureOpts struct {
+	// Packages lists the desired package states. Required.
+	Packages []PackageEnsureEntry
+}
+
+// PackageEnsureEntry describes the desired state of a single package.
+type PackageEnsureEntry struct {
+	// Name is the package name. Required.
+	Name string
+	// State is one of present, absent, latest, or pinned. Required.
+	State string
+	// Version is the package version. Required for pinned.
+	Version string
+}
+
+// PackageEnsureResult represents the result of a package ensure operation
+// for one host.
+type PackageEnsureResult struct {
+	Hostname string              `json:"hostname"`
+	Status   string              `json:"status"`
+	Packages []PackageEnsureItem `json:"packages,omitempty"`
+	Changed  bool                `json:"changed"`
+	Error    string              `json:"error,omitempty"`
+}
+
+// PackageEnsureItem represents the ensure outcome for a single package.
+type PackageEnsureItem struct {
+	Name    string `json:"name"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
+
 // PackageMutationResult represents the result of a package install, remove,
-// or update operation.
+// hold, unhold, or update operation.
 type PackageMutationResult struct {
 	Hostname string `json:"hostname"`
 	Status   string `json:"status"`
 	Name     string `json:"name,omitempty"`
+	Version  string `json:"version,omitempty"`
 	Changed  bool   `json:"changed"`
 	Error    string `json:"error,omitempty"`
 }
@@ -66,6 +120,55 @@ type UpdateInfo struct {
 	NewVersion     string `json:"new_version,omitempty"`
 }
 
+// PackageRepoResult represents a repository list result for one host.
+type PackageRepoResult struct {
+	Hostname string        `json:"hostname"`
+	Status   string        `json:"status"`
+	Repos    []PackageRepo `json:"repos,omitempty"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// PackageRepo represents an osapi-managed package repository.
+type PackageRepo struct {
+	Name      string `json:"name,omitempty"`
+	Object    string `json:"object,omitempty"`
+	KeyObject string `json:"key_object,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Keyring   string `json:"keyring,omitempty"`
+}
+
+// PackageRepoCreateOpts contains options for creating a package repository.
+type PackageRepoCreateOpts struct {
+	// Name is the repository name, used for the sources and keyring
+	// filenames. Required.
+	Name string
+	// Object is the Object Store name of the deb822 sources file.
+	// Required.
+	Object string
+	// KeyObject is the Object Store name of the ASCII-armored signing
+	// key. Optional.
+	KeyObject string
+}
+
+// PackageRepoUpdateOpts contains options for updating a package
+// repository. At least one field must be set.
+type PackageRepoUpdateOpts struct {
+	// Object is the Object Store name of the new sources file.
+	Object string
+	// KeyObject is the Object Store name of the new signing key.
+	KeyObject string
+}
+
+// PackageRepoMutationResult represents the result of a repository create,
+// update, or delete operation.
+type PackageRepoMutationResult struct {
+	Hostname string `json:"hostname"`
+	Status   string `json:"status"`
+	Name     string `json:"name,omitempty"`
+	Changed  bool   `json:"changed"`
+	Error    string `json:"error,omitempty"`
+}
+
 // packageInfoCollectionFromList converts a gen.PackageCollectionResponse
 // to a Collection[PackageInfoResult].
 func packageInfoCollectionFromList(
@@ -134,6 +237,22 @@ func packageMutationCollectionFromRemove(
 	return packageMutationCollectionFromGen(g)
 }
 
+// packageMutationCollectionFromHold converts a gen.PackageMutationResponse
+// to a Collection[PackageMutationResult].
+func packageMutationCollectionFromHold(
+	g *gen.PackageMutationResponse,
+) Collection[PackageMutationResult] {
+	return packageMutationCollectionFromGen(g)
+}
+
+// packageMutationCollectionFromUnhold converts a gen.PackageMutationResponse
+// to a Collection[PackageMutationResult].
+func packageMutationCollectionFromUnhold(
+	g *gen.PackageMutationResponse,
+) Collection[PackageMutationResult] {
+	return packageMutationCollectionFromGen(g)
+}
+
 // packageMutationCollectionFromUpdate converts a gen.PackageMutationResponse
 // to a Collection[PackageMutationResult].
 func packageMutationCollectionFromUpdate(
@@ -153,6 +272,7 @@ func packageMutationCollectionFromGen(
 			Hostname: r.Hostname,
 			Status:   string(r.Status),
 			Name:     derefString(r.Name),
+			Version:  derefString(r.Version),
 			Changed:  derefBool(r.Changed),
 			Error:    derefString(r.Error),
 		})
@@ -204,3 +324,144 @@ func updateInfosFromGen(
 
 	return result
 }
+
+// packageEnsureCollectionFromGen converts a gen.PackageEnsureResponse
+// to a Collection[PackageEnsureResult].
+func packageEnsureCollectionFromGen(
+	g *gen.PackageEnsureResponse,
+) Collection[PackageEnsureResult] {
+	results := make([]PackageEnsureResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, PackageEnsureResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Packages: packageEnsureItemsFromGen(r.Packages),
+			Changed:  derefBool(r.Changed),
+			Error:    derefString(r.Error),
+		})
+	}
+
+	return Collection[PackageEnsureResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// packageEnsureItemsFromGen converts a gen ensure item slice to SDK
+// PackageEnsureItem slice.
+func packageEnsureItemsFromGen(
+	items *[]gen.PackageEnsureItem,
+) []PackageEnsureItem {
+	if items == nil {
+		return nil
+	}
+
+	result := make([]PackageEnsureItem, 0, len(*items))
+	for _, i := range *items {
+		result = append(result, PackageEnsureItem{
+			Name:    i.Name,
+			Changed: i.Changed,
+			Error:   derefString(i.Error),
+		})
+	}
+
+	return result
+}
+
+// packageRepoCollectionFromList converts a gen.PackageRepoCollectionResponse
+// to a Collection[PackageRepoResult].
+func packageRepoCollectionFromList(
+	g *gen.PackageRepoCollectionResponse,
+) Collection[PackageRepoResult] {
+	results := make([]PackageRepoResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, PackageRepoResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Repos:    packageReposFromGen(r.Repos),
+			Error:    derefString(r.Error),
+		})
+	}
+
+	return Collection[PackageRepoResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// packageRepoCollectionFromGet converts a gen.PackageRepoCollectionResponse
+// from a get-by-name response to a Collection[PackageRepoResult].
+func packageRepoCollectionFromGet(
+	g *gen.PackageRepoCollectionResponse,
+) Collection[PackageRepoResult] {
+	return packageRepoCollectionFromList(g)
+}
+
+// packageReposFromGen converts a gen repository info slice to SDK
+// PackageRepo slice.
+func packageReposFromGen(
+	repos *[]gen.PackageRepoInfo,
+) []PackageRepo {
+	if repos == nil {
+		return nil
+	}
+
+	result := make([]PackageRepo, 0, len(*repos))
+	for _, r := range *repos {
+		result = append(result, PackageRepo{
+			Name:      derefString(r.Name),
+			Object:    derefString(r.Object),
+			KeyObject: derefString(r.KeyObject),
+			Path:      derefString(r.Path),
+			Keyring:   derefString(r.Keyring),
+		})
+	}
+
+	return result
+}
+
+// packageRepoMutationCollectionFromCreate converts a
+// gen.PackageRepoMutationResponse to a Collection[PackageRepoMutationResult].
+func packageRepoMutationCollectionFromCreate(
+	g *gen.PackageRepoMutationResponse,
+) Collection[PackageRepoMutationResult] {
+	return packageRepoMutationCollectionFromGen(g)
+}
+
+// packageRepoMutationCollectionFromUpdate converts a
+// gen.PackageRepoMutationResponse to a Collection[PackageRepoMutationResult].
+func packageRepoMutationCollectionFromUpdate(
+	g *gen.PackageRepoMutationResponse,
+) Collection[PackageRepoMutationResult] {
+	return packageRepoMutationCollectionFromGen(g)
+}
+
+// packageRepoMutationCollectionFromDelete converts a
+// gen.PackageRepoMutationResponse to a Collection[PackageRepoMutationResult].
+func packageRepoMutationCollectionFromDelete(
+	g *gen.PackageRepoMutationResponse,
+) Collection[PackageRepoMutationResult] {
+	return packageRepoMutationCollectionFromGen(g)
+}
+
+// packageRepoMutationCollectionFromGen converts a
+// gen.PackageRepoMutationResponse to a Collection[PackageRepoMutationResult].
+func packageRepoMutationCollectionFromGen(
+	g *gen.PackageRepoMutationResponse,
+) Collection[PackageRepoMutationResult] {
+	results := make([]PackageRepoMutationResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, PackageRepoMutationResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Name:     derefString(r.Name),
+			Changed:  derefBool(r.Changed),
+			Error:    derefString(r.Error),
+		})
+	}
+
+	return Collection[PackageRepoMutationResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
@@ -231,6 +231,31 @@ func (suite *PackageTypesPublicTestSuite) TestPackageMutationCollectionFromInsta
 				suite.Empty(r.Error)
 			},
 		},
+		{
+			name: "when version is set",
+			input: func() *gen.PackageMutationResponse {
+				name := "hello"
+				version := "1.0-1"
+				changed := true
+
+				return &gen.PackageMutationResponse{
+					Results: []gen.PackageMutationResult{
+						{
+							Hostname: "web-01",
+							Status:   gen.PackageMutationResultStatusOk,
+							Name:     &name,
+							Version:  &version,
+							Changed:  &changed,
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.PackageMutationResult]) {
+				suite.Require().Len(c.Results, 1)
+				suite.Equal("hello", c.Results[0].Name)
+				suite.Equal("1.0-1", c.Results[0].Version)
+			},
+		},
 		{
 			name: "when error field is set",
 			input: func() *gen.PackageMutationResponse {
@@ -303,6 +328,167 @@ func (suite *PackageTypesPublicTestSuite) TestPackageMutationCollectionFromRemov
 	}
 }
 
+func (suite *PackageTypesPublicTestSuite) TestPackageMutationCollectionFromHold() {
+	tests := []struct {
+		name         string
+		input        *gen.PackageMutationResponse
+		validateFunc func(client.Collection[client.PackageMutationResult])
+	}{
+		{
+			name: "when all fields are populated",
+			input: func() *gen.PackageMutationResponse {
+				name := "curl"
+				changed := true
+
+				return &gen.PackageMutationResponse{
+					Results: []gen.PackageMutationResult{
+						{
+							Hostname: "web-01",
+							Status:   gen.PackageMutationResultStatusOk,
+							Name:     &name,
+							Changed:  &changed,
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.PackageMutationResult]) {
+				suite.Require().Len(c.Results, 1)
+				suite.Equal("web-01", c.Results[0].Hostname)
+				suite.Equal("curl", c.Results[0].Name)
+				suite.True(c.Results[0].Changed)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			result := client.PackageMutationCollectionFromHold(tc.input)
+			tc.validateFunc(result)
+		})
+	}
+}
+
+func (suite *PackageTypesPublicTestSuite) TestPackageMutationCollectionFromUnhold() {
+	tests := []struct {
+		name         string
+		input        *gen.PackageMutationResponse
+		validateFunc func(client.Collection[client.PackageMutationResult])
+	}{
+		{
+			name: "when all fields are populated",
+			input: func() *gen.PackageMutationResponse {
+				name := "curl"
+				changed := true
+
+				return &gen.PackageMutationResponse{
+					Results: []gen.PackageMutationResult{
+						{
+							Hostname: "web-01",
+							Status:   gen.PackageMutationResultStatusOk,
+							Name:     &name,
+							Changed:  &changed,
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.PackageMutationResult]) {
+				suite.Require().Len(c.Results, 1)
+				suite.Equal("web-01", c.Results[0].Hostname)
+				suite.Equal("curl", c.Results[0].Name)
+				suite.True(c.Results[0].Changed)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			result := client.PackageMutationCollectionFromUnhold(tc.input)
+			tc.validateFunc(result)
+		})
+	}
+}
+
+func (suite *PackageTypesPublicTestSuite) TestPackageEnsureCollectionFromGen() {
+	testUUID := openapi_types.UUID{
+		0x55, 0x0e, 0x84, 0x00,
+		0xe2, 0x9b, 0x41, 0xd4,
+		0xa7, 0x16, 0x44, 0x66,
+		0x55, 0x44, 0x00, 0x00,
+	}
+
+	tests := []struct {
+		name         string
+		input        *gen.PackageEnsureResponse
+		validateFunc func(client.Collection[client.PackageEnsureResult])
+	}{
+		{
+			name: "when all fields are populated",
+			input: func() *gen.PackageEnsureResponse {
+				changed := true
+				pkgErr := "package: install \"badpkg\": exit status 100"
+
+				return &gen.PackageEnsureResponse{
+					JobId: &testUUID,
+					Results: []gen.PackageEnsureResult{
+						{
+							Hostname: "web-01",
+							Status:   gen.PackageEnsureResultStatusOk,
+							Changed:  &changed,
+							Packages: &[]gen.PackageEnsureItem{
+								{Name: "nginx", Changed: true},
+								{Name: "badpkg", Error: &pkgErr},
+							},
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.PackageEnsureResult]) {
+				suite.Equal("550e8400-e29b-41d4-a716-446655440000", c.JobID)
+				suite.Require().Len(c.Results, 1)
+				suite.Equal("web-01", c.Results[0].Hostname)
+				suite.Equal("ok", c.Results[0].Status)
+				suite.True(c.Results[0].Changed)
+				suite.Require().Len(c.Results[0].Packages, 2)
+				suite.Equal("nginx", c.Results[0].Packages[0].Name)
+				suite.True(c.Results[0].Packages[0].Changed)
+				suite.Equal("badpkg", c.Results[0].Packages[1].Name)
+				suite.Contains(c.Results[0].Packages[1].Error, "badpkg")
+			},
+		},
+		{
+			name: "when agent failed",
+			input: func() *gen.PackageEnsureResponse {
+				errMsg := "package: ensure: exec failed"
+
+				return &gen.PackageEnsureResponse{
+					Results: []gen.PackageEnsureResult{
+						{
+							Hostname: "web-02",
+							Status:   gen.PackageEnsureResultStatusFailed,
+							Error:    &errMsg,
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.PackageEnsureResult]) {
+				suite.Empty(c.JobID)
+				suite.Require().Len(c.Results, 1)
+				suite.Equal("failed", c.Results[0].Status)
+				suite.False(c.Results[0].Changed)
+				suite.Nil(c.Results[0].Packages)
+				suite.Equal("package: ensure: exec failed", c.Results[0].Error)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			result := client.PackageEnsureCollectionFromGen(tc.input)
+			tc.validateFunc(result)
+		})
+	}
+}
+
 func (suite *PackageTypesPublicTestSuite) TestPackageMutationCollectionFromUpdate() {
 	tests := []struct {
 		name         string
@@ -556,6 +742,297 @@ func (suite *PackageTypesPublicTestSuite) TestUpdateInfosFromGen() {
 	}
 }
 
+func (suite *PackageTypesPublicTestSuite) TestPackageEnsureItemsFromGen() {
+	tests := []struct {
+		name         string
+		input        *[]gen.PackageEnsureItem
+		validateFunc func([]client.PackageEnsureItem)
+	}{
+		{
+			name:  "when input is nil",
+			input: nil,
+			validateFunc: func(result []client.PackageEnsureItem) {
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when input is empty",
+			input: &[]gen.PackageEnsureItem{},
+			validateFunc: func(result []client.PackageEnsureItem) {
+				suite.Empty(result)
+			},
+		},
+		{
+			name: "when all fields are populated",
+			input: func() *[]gen.PackageEnsureItem {
+				errMsg := "version required"
+
+				return &[]gen.PackageEnsureItem{
+					{Name: "nginx", Changed: false, Error: &errMsg},
+				}
+			}(),
+			validateFunc: func(result []client.PackageEnsureItem) {
+				suite.Require().Len(result, 1)
+				suite.Equal("nginx", result[0].Name)
+				suite.False(result[0].Changed)
+				suite.Equal("version required", result[0].Error)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			result := client.ExportPackageEnsureItemsFromGen(tc.input)
+			tc.validateFunc(result)
+		})
+	}
+}
+
+func (suite *PackageTypesPublicTestSuite) TestPackageRepoCollectionFromList() {
+	testUUID := openapi_types.UUID{
+		0x55, 0x0e, 0x84, 0x00,
+		0xe2, 0x9b, 0x41, 0xd4,
+		0xa7, 0x16, 0x44, 0x66,
+		0x55, 0x44, 0x00, 0x00,
+	}
+
+	tests := []struct {
+		name         string
+		input        *gen.PackageRepoCollectionResponse
+		validateFunc func(client.Collection[client.PackageRepoResult])
+	}{
+		{
+			name: "when all fields are populated",
+			input: func() *gen.PackageRepoCollectionResponse {
+				name := "docker"
+				object := "docker.sources"
+				keyObject := "docker.asc"
+				path := "/etc/apt/sources.list.d/osapi-docker.sources"
+				keyring := "/etc/apt/keyrings/osapi-docker.asc"
+
+				return &gen.PackageRepoCollectionResponse{
+					JobId: &testUUID,
+					Results: []gen.PackageRepoEntry{
+						{
+							Hostname: "web-01",
+							Status:   gen.PackageRepoEntryStatusOk,
+							Repos: &[]gen.PackageRepoInfo{
+								{
+									Name:      &name,
+									Object:    &object,
+									KeyObject: &keyObject,
+									Path:      &path,
+									Keyring:   &keyring,
+								},
+							},
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.PackageRepoResult]) {
+				suite.Equal("550e8400-e29b-41d4-a716-446655440000", c.JobID)
+				suite.Require().Len(c.Results, 1)
+				suite.Equal("web-01", c.Results[0].Hostname)
+				suite.Equal("ok", c.Results[0].Status)
+				suite.Require().Len(c.Results[0].Repos, 1)
+				suite.Equal(client.PackageRepo{
+					Name:      "docker",
+					Object:    "docker.sources",
+					KeyObject: "docker.asc",
+					Path:      "/etc/apt/sources.list.d/osapi-docker.sources",
+					Keyring:   "/etc/apt/keyrings/osapi-docker.asc",
+				}, c.Results[0].Repos[0])
+			},
+		},
+		{
+			name: "when agent reports an error",
+			input: func() *gen.PackageRepoCollectionResponse {
+				errMsg := "unsupported"
+
+				return &gen.PackageRepoCollectionResponse{
+					Results: []gen.PackageRepoEntry{
+						{
+							Hostname: "web-02",
+							Status:   gen.PackageRepoEntryStatusSkipped,
+							Error:    &errMsg,
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.PackageRepoResult]) {
+				suite.Empty(c.JobID)
+				suite.Require().Len(c.Results, 1)
+				suite.Equal("skipped", c.Results[0].Status)
+				suite.Equal("unsupported", c.Results[0].Error)
+				suite.Nil(c.Results[0].Repos)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			result := client.PackageRepoCollectionFromList(tc.input)
+			tc.validateFunc(result)
+		})
+	}
+}
+
+func (suite *PackageTypesPublicTestSuite) TestPackageRepoCollectionFromGet() {
+	tests := []struct {
+		name         string
+		input        *gen.PackageRepoCollectionResponse
+		validateFunc func(client.Collection[client.PackageRepoResult])
+	}{
+		{
+			name: "when repo is returned",
+			input: func() *gen.PackageRepoCollectionResponse {
+				name := "docker"
+
+				return &gen.PackageRepoCollectionResponse{
+					Results: []gen.PackageRepoEntry{
+						{
+							Hostname: "web-01",
+							Status:   gen.PackageRepoEntryStatusOk,
+							Repos:    &[]gen.PackageRepoInfo{{Name: &name}},
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.PackageRepoResult]) {
+				suite.Require().Len(c.Results, 1)
+				suite.Require().Len(c.Results[0].Repos, 1)
+				suite.Equal("docker", c.Results[0].Repos[0].Name)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			result := client.PackageRepoCollectionFromGet(tc.input)
+			tc.validateFunc(result)
+		})
+	}
+}
+
+func (suite *PackageTypesPublicTestSuite) TestPackageRepoMutationCollectionFromGen() {
+	tests := []struct {
+		name         string
+		convert      func(*gen.PackageRepoMutationResponse) client.Collection[client.PackageRepoMutationResult]
+		input        *gen.PackageRepoMutationResponse
+		validateFunc func(client.Collection[client.PackageRepoMutationResult])
+	}{
+		{
+			name:    "when create result is populated",
+			convert: client.PackageRepoMutationCollectionFromCreate,
+			input: func() *gen.PackageRepoMutationResponse {
+				name := "docker"
+				changed := true
+
+				return &gen.PackageRepoMutationResponse{
+					Results: []gen.PackageRepoMutationResult{
+						{
+							Hostname: "web-01",
+							Status:   gen.PackageRepoMutationResultStatusOk,
+							Name:     &name,
+							Changed:  &changed,
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.PackageRepoMutationResult]) {
+				suite.Require().Len(c.Results, 1)
+				suite.Equal("web-01", c.Results[0].Hostname)
+				suite.Equal("docker", c.Results[0].Name)
+				suite.True(c.Results[0].Changed)
+			},
+		},
+		{
+			name:    "when update result failed",
+			convert: client.PackageRepoMutationCollectionFromUpdate,
+			input: func() *gen.PackageRepoMutationResponse {
+				errMsg := "does not exist"
+
+				return &gen.PackageRepoMutationResponse{
+					Results: []gen.PackageRepoMutationResult{
+						{
+							Hostname: "web-01",
+							Status:   gen.PackageRepoMutationResultStatusFailed,
+							Error:    &errMsg,
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.PackageRepoMutationResult]) {
+				suite.Require().Len(c.Results, 1)
+				suite.Equal("failed", c.Results[0].Status)
+				suite.Equal("does not exist", c.Results[0].Error)
+				suite.False(c.Results[0].Changed)
+			},
+		},
+		{
+			name:    "when delete result is unchanged",
+			convert: client.PackageRepoMutationCollectionFromDelete,
+			input: func() *gen.PackageRepoMutationResponse {
+				name := "docker"
+				changed := false
+
+				return &gen.PackageRepoMutationResponse{
+					Results: []gen.PackageRepoMutationResult{
+						{
+							Hostname: "web-01",
+							Status:   gen.PackageRepoMutationResultStatusOk,
+							Name:     &name,
+							Changed:  &changed,
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.PackageRepoMutationResult]) {
+				suite.Require().Len(c.Results, 1)
+				suite.Equal("docker", c.Results[0].Name)
+				suite.False(c.Results[0].Changed)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			result := tc.convert(tc.input)
+			tc.validateFunc(result)
+		})
+	}
+}
+
+func (suite *PackageTypesPublicTestSuite) TestPackageReposFromGen() {
+	tests := []struct {
+		name         string
+		input        *[]gen.PackageRepoInfo
+		validateFunc func([]client.PackageRepo)
+	}{
+		{
+			name:  "when input is nil",
+			input: nil,
+			validateFunc: func(result []client.PackageRepo) {
+				suite.Nil(result)
+			},
+		},
+		{
+			name:  "when input is empty",
+			input: &[]gen.PackageRepoInfo{},
+			validateFunc: func(result []client.PackageRepo) {
+				suite.Empty(result)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			result := client.ExportPackageReposFromGen(tc.input)
+			tc.validateFunc(result)
+		})
+	}
+}
+
 func TestPackageTypesPublicTestSuite(t *testing.T) {
 	suite.Run(t, new(PackageTypesPublicTestSuite))
 }
@@ -63,6 +63,24 @@ const (
 	PermCertificateWrite Permission = "certificate:write"
 	PermServiceRead      Permission = "service:read"
 	PermServiceWrite     Permission = "service:write"
+	PermFirewallRead     Permission = "firewall:read"
+	PermFirewallWrite    Permission = "firewall:write"
+	PermMountRead        Permission = "mount:read"
+	PermMountWrite       Permission = "mount:write"
+	PermBlockRead        Permission = "block:read"
+	PermBlockWrite       Permission = "block:write"
+	PermSwapRead         Permission = "swap:read"
+	PermSwapWrite        Permission = "swap:write"
+	PermKernelRead       Permission = "kernel:read"
+	PermKernelWrite      Permission = "kernel:write"
+	PermHostsRead        Permission = "hosts:read"
+	PermHostsWrite       Permission = "hosts:write"
+	PermTimerRead        Permission = "timer:read"
+	PermTimerWrite       Permission = "timer:write"
+	PermSudoersRead      Permission = "sudoers:read"
+	PermSudoersWrite     Permission = "sudoers:write"
+	PermSshdRead         Permission = "sshd:read"
+	PermSshdWrite        Permission = "sshd:write"
 )
 
 // Role represents a built-in RBAC role name.
@@ -94,6 +94,44 @@ func (s *ProcessService) Get(
 	return NewResponse(processInfoCollectionFromGet(resp.JSON200), resp.Body), nil
 }
 
+// Tree returns the process hierarchy on the target host. Set opts.PID to
+// return only the subtree rooted at that process.
+func (s *ProcessService) Tree(
+	ctx context.Context,
+	hostname string,
+	opts ProcessTreeOpts,
+) (*Response[Collection[ProcessTreeResult]], error) {
+	params := &gen.GetNodeProcessTreeParams{}
+	if opts.PID != 0 {
+		params.Pid = &opts.PID
+	}
+
+	resp, err := s.client.GetNodeProcessTreeWithResponse(ctx, hostname, params)
+	if err != nil {
+		return nil, fmt.Errorf("process tree: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(processTreeCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
 // Signal sends a signal to a specific process by PID on the target host.
 func (s *ProcessService) Signal(
 	ctx context.Context,
@@ -130,3 +168,61 @@ func (s *ProcessService) Signal(
 
 	return NewResponse(processSignalCollectionFromGen(resp.JSON200), resp.Body), nil
 }
+
+// SignalMatch sends a signal to every process on the target host that
+// matches the filters in opts. Each agent resolves its own matches, so a
+// broadcast target signals the right PIDs on every host. With DryRun set
+// the matching PIDs are reported without being signalled.
+func (s *ProcessService) SignalMatch(
+	ctx context.Context,
+	hostname string,
+	opts ProcessSignalMatchOpts,
+) (*Response[Collection[ProcessSignalMatchResult]], error) {
+	body := gen.ProcessSignalMatchRequest{
+		Signal: gen.ProcessSignalMatchRequestSignal(opts.Signal),
+	}
+
+	if opts.Name != "" {
+		body.Name = &opts.Name
+	}
+
+	if opts.Cmdline != "" {
+		body.Cmdline = &opts.Cmdline
+	}
+
+	if opts.User != "" {
+		body.User = &opts.User
+	}
+
+	if opts.PPID != 0 {
+		body.Ppid = &opts.PPID
+	}
+
+	if opts.DryRun {
+		body.DryRun = &opts.DryRun
+	}
+
+	resp, err := s.client.PostNodeProcessSignalMatchWithResponse(ctx, hostname, body)
+	if err != nil {
+		return nil, fmt.Errorf("process signal match: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(processSignalMatchCollectionFromGen(resp.JSON200), resp.Body), nil
+}
@@ -23,6 +23,7 @@ package client_test
 import (
 	"context"
 	"errors"
+	"io"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
@@ -378,6 +379,196 @@ func (suite *ProcessPublicTestSuite) TestGet() {
 	}
 }
 
+func (suite *ProcessPublicTestSuite) TestTree() {
+	tests := []struct {
+		name         string
+		handler      http.HandlerFunc
+		serverURL    string
+		validateFunc func(*client.Response[client.Collection[client.ProcessTreeResult]], error)
+	}{
+		{
+			name: "when getting process tree returns result",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				suite.Equal("/api/node/_any/process/tree", r.URL.Path)
+				suite.Equal("1234", r.URL.Query().Get("pid"))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","processes":[{"pid":1234,"ppid":1,"name":"nginx","children":[{"pid":1235,"ppid":1234,"name":"nginx"}]}]}]}`,
+					),
+				)
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ProcessTreeResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Equal("00000000-0000-0000-0000-000000000001", resp.Data.JobID)
+				suite.Len(resp.Data.Results, 1)
+				suite.Equal("agent1", resp.Data.Results[0].Hostname)
+				suite.Require().Len(resp.Data.Results[0].Processes, 1)
+				suite.Equal(1234, resp.Data.Results[0].Processes[0].PID)
+				suite.Require().Len(resp.Data.Results[0].Processes[0].Children, 1)
+				suite.Equal(1235, resp.Data.Results[0].Processes[0].Children[0].PID)
+			},
+		},
+		{
+			name: "when server returns 400 returns ValidationError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"invalid pid"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ProcessTreeResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ValidationError
+				suite.True(errors.As(err, &target))
+			},
+		},
+		{
+			name: "when server returns 401 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":"unauthorized"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ProcessTreeResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+			},
+		},
+		{
+			name: "when server returns 403 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error":"forbidden"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ProcessTreeResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+			},
+		},
+		{
+			name: "when server returns 404 returns NotFoundError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error":"not found"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ProcessTreeResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.NotFoundError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusNotFound, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 500 returns ServerError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"error":"internal error"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ProcessTreeResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ServerError
+				suite.True(errors.As(err, &target))
+			},
+		},
+		{
+			name:      "when client HTTP call fails returns error",
+			serverURL: "http://127.0.0.1:0",
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ProcessTreeResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+				suite.Contains(err.Error(), "process tree")
+			},
+		},
+		{
+			name: "when server returns 200 with no JSON body returns UnexpectedStatusError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ProcessTreeResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.UnexpectedStatusError
+				suite.True(errors.As(err, &target))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			var (
+				serverURL string
+				cleanup   func()
+			)
+
+			if tc.serverURL != "" {
+				serverURL = tc.serverURL
+				cleanup = func() {}
+			} else {
+				server := httptest.NewServer(tc.handler)
+				serverURL = server.URL
+				cleanup = server.Close
+			}
+			defer cleanup()
+
+			sut := client.New(
+				serverURL,
+				"test-token",
+				client.WithLogger(slog.Default()),
+			)
+
+			resp, err := sut.Process.Tree(
+				suite.ctx,
+				"_any",
+				client.ProcessTreeOpts{PID: 1234},
+			)
+			tc.validateFunc(resp, err)
+		})
+	}
+}
+
 func (suite *ProcessPublicTestSuite) TestSignal() {
 	tests := []struct {
 		name         string
@@ -597,6 +788,251 @@ func (suite *ProcessPublicTestSuite) TestSignal() {
 	}
 }
 
+func (suite *ProcessPublicTestSuite) TestSignalMatch() {
+	tests := []struct {
+		name         string
+		handler      http.HandlerFunc
+		serverURL    string
+		opts         client.ProcessSignalMatchOpts
+		validateFunc func(*client.Response[client.Collection[client.ProcessSignalMatchResult]], error)
+	}{
+		{
+			name: "when signalling matching processes returns result",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				suite.Equal("/api/node/_any/process/signal", r.URL.Path)
+				suite.JSONEq(
+					`{"signal":"HUP","name":"nginx","cmdline":"master","user":"root","ppid":1}`,
+					string(body),
+				)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","signal":"HUP","dry_run":false,"pids":[10,11],"failed":[{"pid":12,"error":"permission denied"}],"changed":true}]}`,
+					),
+				)
+			},
+			opts: client.ProcessSignalMatchOpts{
+				Signal:  "HUP",
+				Name:    "nginx",
+				Cmdline: "master",
+				User:    "root",
+				PPID:    1,
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ProcessSignalMatchResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Equal("00000000-0000-0000-0000-000000000001", resp.Data.JobID)
+				suite.Len(resp.Data.Results, 1)
+				suite.Equal("agent1", resp.Data.Results[0].Hostname)
+				suite.Equal("ok", resp.Data.Results[0].Status)
+				suite.Equal("HUP", resp.Data.Results[0].Signal)
+				suite.Equal([]int{10, 11}, resp.Data.Results[0].PIDs)
+				suite.Equal([]client.ProcessSignalFailure{
+					{PID: 12, Error: "permission denied"},
+				}, resp.Data.Results[0].Failed)
+				suite.True(resp.Data.Results[0].Changed)
+			},
+		},
+		{
+			name: "when dry run sends dry_run and reports matches",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				suite.JSONEq(`{"signal":"KILL","name":"worker","dry_run":true}`, string(body))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","signal":"KILL","dry_run":true,"pids":[42],"changed":false}]}`,
+					),
+				)
+			},
+			opts: client.ProcessSignalMatchOpts{
+				Signal: "KILL",
+				Name:   "worker",
+				DryRun: true,
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ProcessSignalMatchResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Require().Len(resp.Data.Results, 1)
+				suite.True(resp.Data.Results[0].DryRun)
+				suite.Equal([]int{42}, resp.Data.Results[0].PIDs)
+				suite.False(resp.Data.Results[0].Changed)
+			},
+		},
+		{
+			name: "when broadcast signal returns multiple results",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000002","results":[{"hostname":"server1","status":"ok","changed":true},{"hostname":"server2","status":"skipped","error":"unsupported"}]}`,
+					),
+				)
+			},
+			opts: client.ProcessSignalMatchOpts{Signal: "KILL", Name: "worker"},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ProcessSignalMatchResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Len(resp.Data.Results, 2)
+			},
+		},
+		{
+			name: "when server returns 400 returns ValidationError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"invalid signal"}`))
+			},
+			opts: client.ProcessSignalMatchOpts{Signal: "INVALID", Name: "nginx"},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ProcessSignalMatchResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ValidationError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusBadRequest, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 401 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":"unauthorized"}`))
+			},
+			opts: client.ProcessSignalMatchOpts{Signal: "TERM", Name: "nginx"},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ProcessSignalMatchResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusUnauthorized, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 403 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error":"forbidden"}`))
+			},
+			opts: client.ProcessSignalMatchOpts{Signal: "TERM", Name: "nginx"},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ProcessSignalMatchResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusForbidden, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 500 returns ServerError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"error":"internal error"}`))
+			},
+			opts: client.ProcessSignalMatchOpts{Signal: "TERM", Name: "nginx"},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ProcessSignalMatchResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ServerError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusInternalServerError, target.StatusCode)
+			},
+		},
+		{
+			name:      "when client HTTP call fails returns error",
+			serverURL: "http://127.0.0.1:0",
+			opts:      client.ProcessSignalMatchOpts{Signal: "TERM", Name: "nginx"},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ProcessSignalMatchResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+				suite.Contains(err.Error(), "process signal match")
+			},
+		},
+		{
+			name: "when server returns 200 with no JSON body returns UnexpectedStatusError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+			opts: client.ProcessSignalMatchOpts{Signal: "TERM", Name: "nginx"},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ProcessSignalMatchResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.UnexpectedStatusError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusOK, target.StatusCode)
+				suite.Equal("nil response body", target.Message)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			var (
+				serverURL string
+				cleanup   func()
+			)
+
+			if tc.serverURL != "" {
+				serverURL = tc.serverURL
+				cleanup = func() {}
+			} else {
+				server := httptest.NewServer(tc.handler)
+				serverURL = server.URL
+				cleanup = server.Close
+			}
+			defer cleanup()
+
+			sut := client.New(
+				serverURL,
+				"test-token",
+				client.WithLogger(slog.Default()),
+			)
+
+			resp, err := sut.Process.SignalMatch(suite.ctx, "_any", tc.opts)
+			tc.validateFunc(resp, err)
+		})
+	}
+}
+
 func TestProcessPublicTestSuite(t *testing.T) {
 	suite.Run(t, new(ProcessPublicTestSuite))
 }
@@ -34,8 +34,10 @@ type ProcessInfoResult struct {
 }
 
 // ProcessInfo represents information about a single running process.
+// The fields after StartTime are only populated by Get.
 type ProcessInfo struct {
 	PID        int     `json:"pid"`
+	PPID       int     `json:"ppid,omitempty"`
 	Name       string  `json:"name,omitempty"`
 	User       string  `json:"user,omitempty"`
 	State      string  `json:"state,omitempty"`
@@ -44,6 +46,52 @@ type ProcessInfo struct {
 	MemRSS     int64   `json:"mem_rss,omitempty"`
 	Command    string  `json:"command,omitempty"`
 	StartTime  string  `json:"start_time,omitempty"`
+
+	Children  []int             `json:"children,omitempty"`
+	Threads   int               `json:"threads,omitempty"`
+	FDCount   int               `json:"fd_count,omitempty"`
+	OpenFiles []ProcessOpenFile `json:"open_files,omitempty"`
+	Cgroup    string            `json:"cgroup,omitempty"`
+	EnvKeys   []string          `json:"env_keys,omitempty"`
+	Limits    []ProcessLimit    `json:"limits,omitempty"`
+}
+
+// ProcessOpenFile represents a file descriptor held open by a process.
+type ProcessOpenFile struct {
+	FD   int    `json:"fd"`
+	Path string `json:"path"`
+}
+
+// ProcessLimit represents a resource limit applied to a process.
+type ProcessLimit struct {
+	Name  string `json:"name"`
+	Soft  string `json:"soft"`
+	Hard  string `json:"hard"`
+	Units string `json:"units,omitempty"`
+}
+
+// ProcessTreeResult represents the process tree for one host.
+type ProcessTreeResult struct {
+	Hostname  string            `json:"hostname"`
+	Status    string            `json:"status"`
+	Processes []ProcessTreeNode `json:"processes,omitempty"`
+	Error     string            `json:"error,omitempty"`
+}
+
+// ProcessTreeNode represents a process and its descendants.
+type ProcessTreeNode struct {
+	PID      int               `json:"pid"`
+	PPID     int               `json:"ppid"`
+	Name     string            `json:"name"`
+	User     string            `json:"user,omitempty"`
+	Command  string            `json:"command,omitempty"`
+	Children []ProcessTreeNode `json:"children,omitempty"`
+}
+
+// ProcessTreeOpts contains options for the process tree operation.
+type ProcessTreeOpts struct {
+	// PID roots the tree at this process. Zero returns every root.
+	PID int
 }
 
 // ProcessSignalResult represents the result of sending a signal to a process
@@ -63,6 +111,44 @@ type ProcessSignalOpts struct {
 	Signal string
 }
 
+// ProcessSignalMatchResult represents the result of signalling matching
+// processes for one host.
+type ProcessSignalMatchResult struct {
+	Hostname string                 `json:"hostname"`
+	Status   string                 `json:"status"`
+	Signal   string                 `json:"signal,omitempty"`
+	DryRun   bool                   `json:"dry_run"`
+	PIDs     []int                  `json:"pids,omitempty"`
+	Failed   []ProcessSignalFailure `json:"failed,omitempty"`
+	Changed  bool                   `json:"changed"`
+	Error    string                 `json:"error,omitempty"`
+}
+
+// ProcessSignalFailure is a matching process the signal could not be
+// delivered to.
+type ProcessSignalFailure struct {
+	PID   int    `json:"pid"`
+	Error string `json:"error"`
+}
+
+// ProcessSignalMatchOpts contains options for the signal-by-match
+// operation. Every set filter must match, and at least one of Name,
+// Cmdline, User or PPID is required.
+type ProcessSignalMatchOpts struct {
+	// Signal is the signal name to send (e.g., TERM, KILL, HUP).
+	Signal string
+	// Name matches the process name exactly.
+	Name string
+	// Cmdline is a regular expression matched against the command line.
+	Cmdline string
+	// User matches the process owner exactly.
+	User string
+	// PPID only matches direct children of this process.
+	PPID int
+	// DryRun reports the matching PIDs without signalling them.
+	DryRun bool
+}
+
 // processInfoCollectionFromList converts a gen.ProcessCollectionResponse
 // to a Collection[ProcessInfoResult].
 func processInfoCollectionFromList(
@@ -95,6 +181,57 @@ func processInfoCollectionFromGet(
 	}
 }
 
+// processTreeCollectionFromGen converts a gen.ProcessTreeResponse
+// to a Collection[ProcessTreeResult].
+func processTreeCollectionFromGen(
+	g *gen.ProcessTreeResponse,
+) Collection[ProcessTreeResult] {
+	results := make([]ProcessTreeResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		result := ProcessTreeResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Error:    derefString(r.Error),
+		}
+
+		if r.Processes != nil {
+			result.Processes = processTreeNodesFromGen(*r.Processes)
+		}
+
+		results = append(results, result)
+	}
+
+	return Collection[ProcessTreeResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// processTreeNodesFromGen converts gen.ProcessTreeNode values to
+// ProcessTreeNode, recursing into children.
+func processTreeNodesFromGen(
+	nodes []gen.ProcessTreeNode,
+) []ProcessTreeNode {
+	result := make([]ProcessTreeNode, 0, len(nodes))
+	for _, n := range nodes {
+		node := ProcessTreeNode{
+			PID:     n.Pid,
+			PPID:    n.Ppid,
+			Name:    n.Name,
+			User:    derefString(n.User),
+			Command: derefString(n.Command),
+		}
+
+		if n.Children != nil {
+			node.Children = processTreeNodesFromGen(*n.Children)
+		}
+
+		result = append(result, node)
+	}
+
+	return result
+}
+
 // processSignalCollectionFromGen converts a gen.ProcessSignalResponse
 // to a Collection[ProcessSignalResult].
 func processSignalCollectionFromGen(
@@ -111,6 +248,22 @@ func processSignalCollectionFromGen(
 	}
 }
 
+// processSignalMatchCollectionFromGen converts a
+// gen.ProcessSignalMatchResponse to a Collection[ProcessSignalMatchResult].
+func processSignalMatchCollectionFromGen(
+	g *gen.ProcessSignalMatchResponse,
+) Collection[ProcessSignalMatchResult] {
+	results := make([]ProcessSignalMatchResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, processSignalMatchResultFromGen(r))
+	}
+
+	return Collection[ProcessSignalMatchResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
 // processInfoResultFromListEntry converts a gen.ProcessEntry to a
 // ProcessInfoResult.
 func processInfoResultFromListEntry(
@@ -155,8 +308,9 @@ func processInfoResultFromGetEntry(
 func processInfoFromGen(
 	p gen.ProcessInfo,
 ) ProcessInfo {
-	return ProcessInfo{
+	info := ProcessInfo{
 		PID:        derefInt(p.Pid),
+		PPID:       derefInt(p.Ppid),
 		Name:       derefString(p.Name),
 		User:       derefString(p.User),
 		State:      derefString(p.State),
@@ -165,7 +319,39 @@ func processInfoFromGen(
 		MemRSS:     derefInt64(p.MemRss),
 		Command:    derefString(p.Command),
 		StartTime:  derefString(p.StartTime),
+		Threads:    derefInt(p.Threads),
+		FDCount:    derefInt(p.FdCount),
+		Cgroup:     derefString(p.Cgroup),
+		EnvKeys:    derefStringSlice(p.EnvKeys),
+	}
+
+	if p.Children != nil {
+		info.Children = *p.Children
+	}
+
+	if p.OpenFiles != nil {
+		info.OpenFiles = make([]ProcessOpenFile, 0, len(*p.OpenFiles))
+		for _, f := range *p.OpenFiles {
+			info.OpenFiles = append(info.OpenFiles, ProcessOpenFile{
+				FD:   f.Fd,
+				Path: f.Path,
+			})
+		}
+	}
+
+	if p.Limits != nil {
+		info.Limits = make([]ProcessLimit, 0, len(*p.Limits))
+		for _, l := range *p.Limits {
+			info.Limits = append(info.Limits, ProcessLimit{
+				Name:  l.Name,
+				Soft:  l.Soft,
+				Hard:  l.Hard,
+				Units: derefString(l.Units),
+			})
+		}
 	}
+
+	return info
 }
 
 // processSignalResultFromGen converts a gen.ProcessSignalResult to a
@@ -182,3 +368,34 @@ func processSignalResultFromGen(
 		Error:    derefString(r.Error),
 	}
 }
+
+// processSignalMatchResultFromGen converts a gen.ProcessSignalMatchResult
+// to a ProcessSignalMatchResult.
+func processSignalMatchResultFromGen(
+	r gen.ProcessSignalMatchResult,
+) ProcessSignalMatchResult {
+	result := ProcessSignalMatchResult{
+		Hostname: r.Hostname,
+		Status:   string(r.Status),
+		Signal:   derefString(r.Signal),
+		DryRun:   derefBool(r.DryRun),
+		Changed:  derefBool(r.Changed),
+		Error:    derefString(r.Error),
+	}
+
+	if r.Pids != nil {
+		result.PIDs = *r.Pids
+	}
+
+	if r.Failed != nil {
+		result.Failed = make([]ProcessSignalFailure, 0, len(*r.Failed))
+		for _, f := range *r.Failed {
+			result.Failed = append(result.Failed, ProcessSignalFailure{
+				PID:   f.Pid,
+				Error: f.Error,
+			})
+		}
+	}
+
+	return result
+}
@@ -214,6 +214,60 @@ func (suite *ProcessTypesPublicTestSuite) TestProcessInfoCollectionFromGet() {
 				suite.Equal("sshd", r.Processes[0].Name)
 			},
 		},
+		{
+			name: "when process has details",
+			input: func() *gen.ProcessGetResponse {
+				pid := 42
+				ppid := 1
+				children := []int{43, 44}
+				threads := 3
+				fdCount := 12
+				cgroup := "/system.slice/ssh.service"
+				envKeys := []string{"LANG", "PATH"}
+				units := "files"
+				openFiles := []gen.ProcessOpenFile{
+					{Fd: 3, Path: "/dev/null"},
+				}
+				limits := []gen.ProcessLimit{
+					{Name: "Max open files", Soft: "1024", Hard: "524288", Units: &units},
+				}
+				return &gen.ProcessGetResponse{
+					Results: []gen.ProcessGetEntry{
+						{
+							Hostname: "web-01",
+							Status:   gen.ProcessGetEntryStatusOk,
+							Process: &gen.ProcessInfo{
+								Pid:       &pid,
+								Ppid:      &ppid,
+								Children:  &children,
+								Threads:   &threads,
+								FdCount:   &fdCount,
+								OpenFiles: &openFiles,
+								Cgroup:    &cgroup,
+								EnvKeys:   &envKeys,
+								Limits:    &limits,
+							},
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.ProcessInfoResult]) {
+				suite.Require().Len(c.Results, 1)
+				suite.Require().Len(c.Results[0].Processes, 1)
+
+				p := c.Results[0].Processes[0]
+				suite.Equal(1, p.PPID)
+				suite.Equal([]int{43, 44}, p.Children)
+				suite.Equal(3, p.Threads)
+				suite.Equal(12, p.FDCount)
+				suite.Equal([]client.ProcessOpenFile{{FD: 3, Path: "/dev/null"}}, p.OpenFiles)
+				suite.Equal("/system.slice/ssh.service", p.Cgroup)
+				suite.Equal([]string{"LANG", "PATH"}, p.EnvKeys)
+				suite.Equal([]client.ProcessLimit{
+					{Name: "Max open files", Soft: "1024", Hard: "524288", Units: "files"},
+				}, p.Limits)
+			},
+		},
 		{
 			name: "when process not found returns error entry",
 			input: func() *gen.ProcessGetResponse {
@@ -260,6 +314,97 @@ func (suite *ProcessTypesPublicTestSuite) TestProcessInfoCollectionFromGet() {
 	}
 }
 
+func (suite *ProcessTypesPublicTestSuite) TestProcessTreeCollectionFromGen() {
+	testUUID := openapi_types.UUID{
+		0x55, 0x0e, 0x84, 0x00,
+		0xe2, 0x9b, 0x41, 0xd4,
+		0xa7, 0x16, 0x44, 0x66,
+		0x55, 0x44, 0x00, 0x00,
+	}
+
+	tests := []struct {
+		name         string
+		input        *gen.ProcessTreeResponse
+		validateFunc func(client.Collection[client.ProcessTreeResult])
+	}{
+		{
+			name: "when tree has nested children",
+			input: func() *gen.ProcessTreeResponse {
+				user := "root"
+				command := "/sbin/init"
+				children := []gen.ProcessTreeNode{
+					{Pid: 812, Ppid: 1, Name: "sshd"},
+				}
+				roots := []gen.ProcessTreeNode{
+					{
+						Pid:      1,
+						Ppid:     0,
+						Name:     "systemd",
+						User:     &user,
+						Command:  &command,
+						Children: &children,
+					},
+				}
+				return &gen.ProcessTreeResponse{
+					JobId: &testUUID,
+					Results: []gen.ProcessTreeEntry{
+						{
+							Hostname:  "web-01",
+							Status:    gen.ProcessTreeEntryStatusOk,
+							Processes: &roots,
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.ProcessTreeResult]) {
+				suite.Equal("550e8400-e29b-41d4-a716-446655440000", c.JobID)
+				suite.Require().Len(c.Results, 1)
+
+				r := c.Results[0]
+				suite.Equal("web-01", r.Hostname)
+				suite.Equal("ok", r.Status)
+				suite.Require().Len(r.Processes, 1)
+				suite.Equal(1, r.Processes[0].PID)
+				suite.Equal("root", r.Processes[0].User)
+				suite.Equal("/sbin/init", r.Processes[0].Command)
+				suite.Require().Len(r.Processes[0].Children, 1)
+				suite.Equal(812, r.Processes[0].Children[0].PID)
+				suite.Equal(1, r.Processes[0].Children[0].PPID)
+				suite.Empty(r.Processes[0].Children[0].User)
+				suite.Nil(r.Processes[0].Children[0].Children)
+			},
+		},
+		{
+			name: "when agent failed returns error entry",
+			input: func() *gen.ProcessTreeResponse {
+				errMsg := "process: tree: process 99 not found"
+				return &gen.ProcessTreeResponse{
+					Results: []gen.ProcessTreeEntry{
+						{
+							Hostname: "web-01",
+							Status:   gen.ProcessTreeEntryStatusFailed,
+							Error:    &errMsg,
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.ProcessTreeResult]) {
+				suite.Require().Len(c.Results, 1)
+				suite.Equal("failed", c.Results[0].Status)
+				suite.Equal("process: tree: process 99 not found", c.Results[0].Error)
+				suite.Nil(c.Results[0].Processes)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			result := client.ProcessTreeCollectionFromGen(tc.input)
+			tc.validateFunc(result)
+		})
+	}
+}
+
 func (suite *ProcessTypesPublicTestSuite) TestProcessSignalCollectionFromGen() {
 	testUUID := openapi_types.UUID{
 		0x55, 0x0e, 0x84, 0x00,
@@ -370,6 +515,125 @@ func (suite *ProcessTypesPublicTestSuite) TestProcessSignalCollectionFromGen() {
 	}
 }
 
+func (suite *ProcessTypesPublicTestSuite) TestProcessSignalMatchCollectionFromGen() {
+	testUUID := openapi_types.UUID{
+		0x55, 0x0e, 0x84, 0x00,
+		0xe2, 0x9b, 0x41, 0xd4,
+		0xa7, 0x16, 0x44, 0x66,
+		0x55, 0x44, 0x00, 0x00,
+	}
+
+	tests := []struct {
+		name         string
+		input        *gen.ProcessSignalMatchResponse
+		validateFunc func(client.Collection[client.ProcessSignalMatchResult])
+	}{
+		{
+			name: "when all fields are populated",
+			input: func() *gen.ProcessSignalMatchResponse {
+				changed := true
+				dryRun := false
+				signal := "TERM"
+				pids := []int{10, 11}
+				failed := []gen.ProcessSignalFailure{
+					{Pid: 12, Error: "permission denied"},
+				}
+				return &gen.ProcessSignalMatchResponse{
+					JobId: &testUUID,
+					Results: []gen.ProcessSignalMatchResult{
+						{
+							Hostname: "web-01",
+							Status:   gen.ProcessSignalMatchResultStatusOk,
+							Signal:   &signal,
+							DryRun:   &dryRun,
+							Pids:     &pids,
+							Failed:   &failed,
+							Changed:  &changed,
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.ProcessSignalMatchResult]) {
+				suite.Equal("550e8400-e29b-41d4-a716-446655440000", c.JobID)
+				suite.Require().Len(c.Results, 1)
+
+				r := c.Results[0]
+				suite.Equal("web-01", r.Hostname)
+				suite.Equal("ok", r.Status)
+				suite.Equal("TERM", r.Signal)
+				suite.False(r.DryRun)
+				suite.Equal([]int{10, 11}, r.PIDs)
+				suite.Equal([]client.ProcessSignalFailure{
+					{PID: 12, Error: "permission denied"},
+				}, r.Failed)
+				suite.True(r.Changed)
+				suite.Empty(r.Error)
+			},
+		},
+		{
+			name: "when dry run",
+			input: func() *gen.ProcessSignalMatchResponse {
+				dryRun := true
+				pids := []int{42}
+				return &gen.ProcessSignalMatchResponse{
+					Results: []gen.ProcessSignalMatchResult{
+						{
+							Hostname: "web-01",
+							Status:   gen.ProcessSignalMatchResultStatusOk,
+							DryRun:   &dryRun,
+							Pids:     &pids,
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.ProcessSignalMatchResult]) {
+				suite.Empty(c.JobID)
+				suite.Require().Len(c.Results, 1)
+
+				r := c.Results[0]
+				suite.True(r.DryRun)
+				suite.Equal([]int{42}, r.PIDs)
+				suite.Nil(r.Failed)
+				suite.False(r.Changed)
+			},
+		},
+		{
+			name: "when multiple results with nil pointers",
+			input: func() *gen.ProcessSignalMatchResponse {
+				errMsg := "unsupported"
+				return &gen.ProcessSignalMatchResponse{
+					JobId: &testUUID,
+					Results: []gen.ProcessSignalMatchResult{
+						{
+							Hostname: "web-01",
+							Status:   gen.ProcessSignalMatchResultStatusOk,
+						},
+						{
+							Hostname: "web-02",
+							Status:   gen.ProcessSignalMatchResultStatusSkipped,
+							Error:    &errMsg,
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.ProcessSignalMatchResult]) {
+				suite.Require().Len(c.Results, 2)
+				suite.Nil(c.Results[0].PIDs)
+				suite.Empty(c.Results[0].Signal)
+				suite.Equal("skipped", c.Results[1].Status)
+				suite.Equal("unsupported", c.Results[1].Error)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			result := client.ProcessSignalMatchCollectionFromGen(tc.input)
+			tc.validateFunc(result)
+		})
+	}
+}
+
 func TestProcessTypesPublicTestSuite(t *testing.T) {
 	suite.Run(t, new(ProcessTypesPublicTestSuite))
 }
@@ -352,3 +352,183 @@ func (s *ServiceService) Disable(
 
 	return NewResponse(serviceMutationCollectionFromGen(resp.JSON200), resp.Body), nil
 }
+
+// ListDropIns returns the drop-in overrides of a service on the target
+// host.
+func (s *ServiceService) ListDropIns(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[ServiceDropInListResult]], error) {
+	resp, err := s.client.GetNodeServiceDropInWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("service drop-in list: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(serviceDropInListCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// CreateDropIn deploys a drop-in override for an existing service on the
+// target host.
+func (s *ServiceService) CreateDropIn(
+	ctx context.Context,
+	hostname string,
+	name string,
+	opts ServiceDropInCreateOpts,
+) (*Response[Collection[ServiceDropInMutationResult]], error) {
+	body := gen.ServiceDropInCreateRequest{
+		Object: opts.Object,
+	}
+	if opts.Name != "" {
+		body.Name = &opts.Name
+	}
+	if opts.ContentType != "" {
+		ct := gen.ServiceDropInCreateRequestContentType(opts.ContentType)
+		body.ContentType = &ct
+	}
+	if opts.Vars != nil {
+		body.Vars = &opts.Vars
+	}
+	if opts.Restart {
+		body.Restart = &opts.Restart
+	}
+
+	resp, err := s.client.PostNodeServiceDropInWithResponse(ctx, hostname, name, body)
+	if err != nil {
+		return nil, fmt.Errorf("service drop-in create: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(serviceDropInMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// UpdateDropIn redeploys a managed drop-in override of a service on the
+// target host.
+func (s *ServiceService) UpdateDropIn(
+	ctx context.Context,
+	hostname string,
+	name string,
+	dropIn string,
+	opts ServiceDropInUpdateOpts,
+) (*Response[Collection[ServiceDropInMutationResult]], error) {
+	body := gen.ServiceDropInUpdateRequest{}
+	if opts.Object != "" {
+		body.Object = &opts.Object
+	}
+	if opts.ContentType != "" {
+		ct := gen.ServiceDropInUpdateRequestContentType(opts.ContentType)
+		body.ContentType = &ct
+	}
+	if opts.Vars != nil {
+		body.Vars = &opts.Vars
+	}
+	if opts.Restart {
+		body.Restart = &opts.Restart
+	}
+
+	resp, err := s.client.PutNodeServiceDropInWithResponse(ctx, hostname, name, dropIn, body)
+	if err != nil {
+		return nil, fmt.Errorf("service drop-in update: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(serviceDropInMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// DeleteDropIn removes a managed drop-in override of a service on the
+// target host.
+func (s *ServiceService) DeleteDropIn(
+	ctx context.Context,
+	hostname string,
+	name string,
+	dropIn string,
+	params *ServiceDropInDeleteParams,
+) (*Response[Collection[ServiceDropInMutationResult]], error) {
+	var genParams *gen.DeleteNodeServiceDropInParams
+	if params != nil {
+		genParams = &gen.DeleteNodeServiceDropInParams{
+			Restart: &params.Restart,
+		}
+	}
+
+	resp, err := s.client.DeleteNodeServiceDropInWithResponse(
+		ctx,
+		hostname,
+		name,
+		dropIn,
+		genParams,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("service drop-in delete: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(serviceDropInMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
@@ -22,6 +22,7 @@ package client_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"log/slog"
 	"net/http"
@@ -1009,6 +1010,916 @@ func (suite *ServicePublicTestSuite) TestServiceDelete() {
 	}
 }
 
+func (suite *ServicePublicTestSuite) TestServiceListDropIns() {
+	tests := []struct {
+		name         string
+		handler      http.HandlerFunc
+		serverURL    string
+		validateFunc func(*client.Response[client.Collection[client.ServiceDropInListResult]], error)
+	}{
+		{
+			name: "when listing drop-ins returns result collection",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				suite.Equal("/api/node/_any/service/nginx.service/dropin", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","dropins":[{"name":"override","path":"/etc/systemd/system/nginx.service.d/override.conf","managed":true,"object":"nginx-override","sha256":"abc123"}]}]}`,
+					),
+				)
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInListResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Equal("00000000-0000-0000-0000-000000000001", resp.Data.JobID)
+				suite.Require().Len(resp.Data.Results, 1)
+				suite.Equal("agent1", resp.Data.Results[0].Hostname)
+				suite.Require().Len(resp.Data.Results[0].DropIns, 1)
+				suite.Equal("override", resp.Data.Results[0].DropIns[0].Name)
+				suite.True(resp.Data.Results[0].DropIns[0].Managed)
+				suite.Equal("abc123", resp.Data.Results[0].DropIns[0].SHA256)
+			},
+		},
+		{
+			name: "when server returns 400 returns ValidationError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"invalid name"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInListResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ValidationError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusBadRequest, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 401 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":"unauthorized"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInListResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusUnauthorized, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 403 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error":"forbidden"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInListResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusForbidden, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 500 returns ServerError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"error":"internal error"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInListResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ServerError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusInternalServerError, target.StatusCode)
+			},
+		},
+		{
+			name:      "when client HTTP call fails returns error",
+			serverURL: "http://127.0.0.1:0",
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInListResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+				suite.Contains(err.Error(), "service drop-in list")
+			},
+		},
+		{
+			name: "when server returns 200 with no JSON body returns UnexpectedStatusError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInListResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.UnexpectedStatusError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusOK, target.StatusCode)
+				suite.Equal("nil response body", target.Message)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			var (
+				serverURL string
+				cleanup   func()
+			)
+
+			if tc.serverURL != "" {
+				serverURL = tc.serverURL
+				cleanup = func() {}
+			} else {
+				server := httptest.NewServer(tc.handler)
+				serverURL = server.URL
+				cleanup = server.Close
+			}
+			defer cleanup()
+
+			sut := client.New(
+				serverURL,
+				"test-token",
+				client.WithLogger(slog.Default()),
+			)
+
+			resp, err := sut.Service.ListDropIns(suite.ctx, "_any", "nginx.service")
+			tc.validateFunc(resp, err)
+		})
+	}
+}
+
+func (suite *ServicePublicTestSuite) TestServiceCreateDropIn() {
+	tests := []struct {
+		name         string
+		handler      http.HandlerFunc
+		serverURL    string
+		opts         client.ServiceDropInCreateOpts
+		validateFunc func(*client.Response[client.Collection[client.ServiceDropInMutationResult]], error)
+	}{
+		{
+			name: "when creating drop-in with all options returns result collection",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				suite.Equal(http.MethodPost, r.Method)
+				suite.Equal("/api/node/_any/service/nginx.service/dropin", r.URL.Path)
+
+				var body map[string]any
+				suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+				suite.Equal("override", body["name"])
+				suite.Equal("nginx-override", body["object"])
+				suite.Equal("template", body["content_type"])
+				suite.Equal(true, body["restart"])
+				suite.NotNil(body["vars"])
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","unit":"nginx.service","name":"override","changed":true,"restarted":true}]}`,
+					),
+				)
+			},
+			opts: client.ServiceDropInCreateOpts{
+				Name:        "override",
+				Object:      "nginx-override",
+				ContentType: "template",
+				Vars:        map[string]any{"workers": 4},
+				Restart:     true,
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Equal("00000000-0000-0000-0000-000000000001", resp.Data.JobID)
+				suite.Require().Len(resp.Data.Results, 1)
+				suite.Equal("nginx.service", resp.Data.Results[0].Unit)
+				suite.Equal("override", resp.Data.Results[0].Name)
+				suite.True(resp.Data.Results[0].Changed)
+				suite.True(resp.Data.Results[0].Restarted)
+			},
+		},
+		{
+			name: "when creating drop-in with only object returns result collection",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				suite.Equal(http.MethodPost, r.Method)
+				suite.Equal("/api/node/_any/service/nginx.service/dropin", r.URL.Path)
+
+				var body map[string]any
+				suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+				suite.Equal("nginx-override", body["object"])
+				suite.NotContains(body, "name")
+				suite.NotContains(body, "restart")
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","unit":"nginx.service","name":"override","changed":true,"restarted":true}]}`,
+					),
+				)
+			},
+			opts: client.ServiceDropInCreateOpts{
+				Object: "nginx-override",
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Equal("00000000-0000-0000-0000-000000000001", resp.Data.JobID)
+				suite.Require().Len(resp.Data.Results, 1)
+				suite.Equal("nginx.service", resp.Data.Results[0].Unit)
+				suite.Equal("override", resp.Data.Results[0].Name)
+				suite.True(resp.Data.Results[0].Changed)
+				suite.True(resp.Data.Results[0].Restarted)
+			},
+		},
+		{
+			name: "when server returns 400 returns ValidationError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"invalid name"}`))
+			},
+			opts: client.ServiceDropInCreateOpts{
+				Object: "nginx-override",
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ValidationError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusBadRequest, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 401 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":"unauthorized"}`))
+			},
+			opts: client.ServiceDropInCreateOpts{
+				Object: "nginx-override",
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusUnauthorized, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 403 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error":"forbidden"}`))
+			},
+			opts: client.ServiceDropInCreateOpts{
+				Object: "nginx-override",
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusForbidden, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 404 returns NotFoundError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error":"not found"}`))
+			},
+			opts: client.ServiceDropInCreateOpts{
+				Object: "nginx-override",
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.NotFoundError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusNotFound, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 500 returns ServerError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"error":"internal error"}`))
+			},
+			opts: client.ServiceDropInCreateOpts{
+				Object: "nginx-override",
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ServerError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusInternalServerError, target.StatusCode)
+			},
+		},
+		{
+			name:      "when client HTTP call fails returns error",
+			serverURL: "http://127.0.0.1:0",
+			opts: client.ServiceDropInCreateOpts{
+				Object: "nginx-override",
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+				suite.Contains(err.Error(), "service drop-in create")
+			},
+		},
+		{
+			name: "when server returns 200 with no JSON body returns UnexpectedStatusError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+			opts: client.ServiceDropInCreateOpts{
+				Object: "nginx-override",
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.UnexpectedStatusError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusOK, target.StatusCode)
+				suite.Equal("nil response body", target.Message)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			var (
+				serverURL string
+				cleanup   func()
+			)
+
+			if tc.serverURL != "" {
+				serverURL = tc.serverURL
+				cleanup = func() {}
+			} else {
+				server := httptest.NewServer(tc.handler)
+				serverURL = server.URL
+				cleanup = server.Close
+			}
+			defer cleanup()
+
+			sut := client.New(
+				serverURL,
+				"test-token",
+				client.WithLogger(slog.Default()),
+			)
+
+			resp, err := sut.Service.CreateDropIn(suite.ctx, "_any", "nginx.service", tc.opts)
+			tc.validateFunc(resp, err)
+		})
+	}
+}
+
+func (suite *ServicePublicTestSuite) TestServiceUpdateDropIn() {
+	tests := []struct {
+		name         string
+		handler      http.HandlerFunc
+		serverURL    string
+		opts         client.ServiceDropInUpdateOpts
+		validateFunc func(*client.Response[client.Collection[client.ServiceDropInMutationResult]], error)
+	}{
+		{
+			name: "when updating drop-in with all options returns result collection",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				suite.Equal(http.MethodPut, r.Method)
+				suite.Equal("/api/node/_any/service/nginx.service/dropin/override", r.URL.Path)
+
+				var body map[string]any
+				suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+				suite.Equal("nginx-override-v2", body["object"])
+				suite.Equal("raw", body["content_type"])
+				suite.Equal(true, body["restart"])
+				suite.NotNil(body["vars"])
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","unit":"nginx.service","name":"override","changed":true,"restarted":true}]}`,
+					),
+				)
+			},
+			opts: client.ServiceDropInUpdateOpts{
+				Object:      "nginx-override-v2",
+				ContentType: "raw",
+				Vars:        map[string]any{"workers": 8},
+				Restart:     true,
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Equal("00000000-0000-0000-0000-000000000001", resp.Data.JobID)
+				suite.Require().Len(resp.Data.Results, 1)
+				suite.Equal("nginx.service", resp.Data.Results[0].Unit)
+				suite.Equal("override", resp.Data.Results[0].Name)
+				suite.True(resp.Data.Results[0].Changed)
+				suite.True(resp.Data.Results[0].Restarted)
+			},
+		},
+		{
+			name: "when updating drop-in with no options returns result collection",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				suite.Equal(http.MethodPut, r.Method)
+				suite.Equal("/api/node/_any/service/nginx.service/dropin/override", r.URL.Path)
+
+				var body map[string]any
+				suite.NoError(json.NewDecoder(r.Body).Decode(&body))
+				suite.Empty(body)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","unit":"nginx.service","name":"override","changed":true,"restarted":true}]}`,
+					),
+				)
+			},
+			opts: client.ServiceDropInUpdateOpts{},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Equal("00000000-0000-0000-0000-000000000001", resp.Data.JobID)
+				suite.Require().Len(resp.Data.Results, 1)
+				suite.Equal("nginx.service", resp.Data.Results[0].Unit)
+				suite.Equal("override", resp.Data.Results[0].Name)
+				suite.True(resp.Data.Results[0].Changed)
+				suite.True(resp.Data.Results[0].Restarted)
+			},
+		},
+		{
+			name: "when server returns 400 returns ValidationError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"invalid name"}`))
+			},
+			opts: client.ServiceDropInUpdateOpts{
+				Restart: true,
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ValidationError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusBadRequest, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 401 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":"unauthorized"}`))
+			},
+			opts: client.ServiceDropInUpdateOpts{
+				Restart: true,
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusUnauthorized, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 403 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error":"forbidden"}`))
+			},
+			opts: client.ServiceDropInUpdateOpts{
+				Restart: true,
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusForbidden, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 404 returns NotFoundError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error":"not found"}`))
+			},
+			opts: client.ServiceDropInUpdateOpts{
+				Restart: true,
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.NotFoundError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusNotFound, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 500 returns ServerError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"error":"internal error"}`))
+			},
+			opts: client.ServiceDropInUpdateOpts{
+				Restart: true,
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ServerError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusInternalServerError, target.StatusCode)
+			},
+		},
+		{
+			name:      "when client HTTP call fails returns error",
+			serverURL: "http://127.0.0.1:0",
+			opts: client.ServiceDropInUpdateOpts{
+				Restart: true,
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+				suite.Contains(err.Error(), "service drop-in update")
+			},
+		},
+		{
+			name: "when server returns 200 with no JSON body returns UnexpectedStatusError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+			opts: client.ServiceDropInUpdateOpts{
+				Restart: true,
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.UnexpectedStatusError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusOK, target.StatusCode)
+				suite.Equal("nil response body", target.Message)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			var (
+				serverURL string
+				cleanup   func()
+			)
+
+			if tc.serverURL != "" {
+				serverURL = tc.serverURL
+				cleanup = func() {}
+			} else {
+				server := httptest.NewServer(tc.handler)
+				serverURL = server.URL
+				cleanup = server.Close
+			}
+			defer cleanup()
+
+			sut := client.New(
+				serverURL,
+				"test-token",
+				client.WithLogger(slog.Default()),
+			)
+
+			resp, err := sut.Service.UpdateDropIn(
+				suite.ctx,
+				"_any",
+				"nginx.service",
+				"override",
+				tc.opts,
+			)
+			tc.validateFunc(resp, err)
+		})
+	}
+}
+
+func (suite *ServicePublicTestSuite) TestServiceDeleteDropIn() {
+	tests := []struct {
+		name         string
+		handler      http.HandlerFunc
+		serverURL    string
+		params       *client.ServiceDropInDeleteParams
+		validateFunc func(*client.Response[client.Collection[client.ServiceDropInMutationResult]], error)
+	}{
+		{
+			name: "when deleting drop-in with restart returns result collection",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				suite.Equal(http.MethodDelete, r.Method)
+				suite.Equal("/api/node/_any/service/nginx.service/dropin/override", r.URL.Path)
+				suite.Equal("restart=true", r.URL.RawQuery)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","unit":"nginx.service","name":"override","changed":true,"restarted":true}]}`,
+					),
+				)
+			},
+			params: &client.ServiceDropInDeleteParams{Restart: true},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Equal("00000000-0000-0000-0000-000000000001", resp.Data.JobID)
+				suite.Require().Len(resp.Data.Results, 1)
+				suite.Equal("nginx.service", resp.Data.Results[0].Unit)
+				suite.Equal("override", resp.Data.Results[0].Name)
+				suite.True(resp.Data.Results[0].Changed)
+				suite.True(resp.Data.Results[0].Restarted)
+			},
+		},
+		{
+			name: "when deleting drop-in without params returns result collection",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				suite.Equal(http.MethodDelete, r.Method)
+				suite.Equal("/api/node/_any/service/nginx.service/dropin/override", r.URL.Path)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","unit":"nginx.service","name":"override","changed":true,"restarted":true}]}`,
+					),
+				)
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Equal("00000000-0000-0000-0000-000000000001", resp.Data.JobID)
+				suite.Require().Len(resp.Data.Results, 1)
+				suite.Equal("nginx.service", resp.Data.Results[0].Unit)
+				suite.Equal("override", resp.Data.Results[0].Name)
+				suite.True(resp.Data.Results[0].Changed)
+				suite.True(resp.Data.Results[0].Restarted)
+			},
+		},
+		{
+			name: "when server returns 400 returns ValidationError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"invalid name"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ValidationError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusBadRequest, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 401 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":"unauthorized"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusUnauthorized, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 403 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error":"forbidden"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusForbidden, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 500 returns ServerError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"error":"internal error"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ServerError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusInternalServerError, target.StatusCode)
+			},
+		},
+		{
+			name:      "when client HTTP call fails returns error",
+			serverURL: "http://127.0.0.1:0",
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+				suite.Contains(err.Error(), "service drop-in delete")
+			},
+		},
+		{
+			name: "when server returns 200 with no JSON body returns UnexpectedStatusError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.ServiceDropInMutationResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.UnexpectedStatusError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusOK, target.StatusCode)
+				suite.Equal("nil response body", target.Message)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			var (
+				serverURL string
+				cleanup   func()
+			)
+
+			if tc.serverURL != "" {
+				serverURL = tc.serverURL
+				cleanup = func() {}
+			} else {
+				server := httptest.NewServer(tc.handler)
+				serverURL = server.URL
+				cleanup = server.Close
+			}
+			defer cleanup()
+
+			sut := client.New(
+				serverURL,
+				"test-token",
+				client.WithLogger(slog.Default()),
+			)
+
+			resp, err := sut.Service.DeleteDropIn(
+				suite.ctx,
+				"_any",
+				"nginx.service",
+				"override",
+				tc.params,
+			)
+			tc.validateFunc(resp, err)
+		})
+	}
+}
+
 func (suite *ServicePublicTestSuite) TestServiceStart() {
 	tests := []struct {
 		name         string
@@ -73,6 +73,70 @@ type ServiceUpdateOpts struct {
 	Object string
 }
 
+// ServiceDropInListResult represents the drop-ins of a service on a
+// single agent.
+type ServiceDropInListResult struct {
+	Hostname string          `json:"hostname"`
+	Status   string          `json:"status"`
+	DropIns  []ServiceDropIn `json:"dropins,omitempty"`
+	Error    string          `json:"error,omitempty"`
+}
+
+// ServiceDropIn represents a systemd drop-in override file.
+type ServiceDropIn struct {
+	Name    string `json:"name,omitempty"`
+	Path    string `json:"path,omitempty"`
+	Managed bool   `json:"managed"`
+	Object  string `json:"object,omitempty"`
+	SHA256  string `json:"sha256,omitempty"`
+}
+
+// ServiceDropInMutationResult represents the result of a drop-in
+// create/update/delete operation.
+type ServiceDropInMutationResult struct {
+	Hostname  string `json:"hostname"`
+	Status    string `json:"status"`
+	Unit      string `json:"unit,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Changed   bool   `json:"changed"`
+	Restarted bool   `json:"restarted"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ServiceDropInCreateOpts contains options for creating a drop-in override.
+type ServiceDropInCreateOpts struct {
+	// Name is the drop-in name without the .conf suffix (optional,
+	// defaults to "override").
+	Name string
+	// Object is the object store reference for the drop-in (required).
+	Object string
+	// ContentType is "raw" or "template" (optional, defaults to raw).
+	ContentType string
+	// Vars contains template variables (optional).
+	Vars map[string]any
+	// Restart restarts the unit when the drop-in changed.
+	Restart bool
+}
+
+// ServiceDropInUpdateOpts contains options for updating a drop-in override.
+type ServiceDropInUpdateOpts struct {
+	// Object is the new object to deploy (optional).
+	Object string
+	// ContentType is "raw" or "template" (optional).
+	ContentType string
+	// Vars contains template variables (optional).
+	Vars map[string]any
+	// Restart restarts the unit when the drop-in changed.
+	Restart bool
+}
+
+// ServiceDropInDeleteParams contains parameters for removing a drop-in
+// override.
+type ServiceDropInDeleteParams struct {
+	// Restart restarts the unit after the drop-in is removed.
+	Restart bool
+}
+
 // serviceListCollectionFromGen converts a gen.ServiceListResponse
 // to a Collection[ServiceInfoResult].
 func serviceListCollectionFromGen(
@@ -172,3 +236,64 @@ func serviceMutationResultFromGen(
 		Error:    derefString(r.Error),
 	}
 }
+
+// serviceDropInListCollectionFromGen converts a gen.ServiceDropInListResponse
+// to a Collection[ServiceDropInListResult].
+func serviceDropInListCollectionFromGen(
+	g *gen.ServiceDropInListResponse,
+) Collection[ServiceDropInListResult] {
+	results := make([]ServiceDropInListResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		result := ServiceDropInListResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Error:    derefString(r.Error),
+		}
+
+		if r.Dropins != nil {
+			dropIns := make([]ServiceDropIn, 0, len(*r.Dropins))
+			for _, d := range *r.Dropins {
+				dropIns = append(dropIns, ServiceDropIn{
+					Name:    derefString(d.Name),
+					Path:    derefString(d.Path),
+					Managed: derefBool(d.Managed),
+					Object:  derefString(d.Object),
+					SHA256:  derefString(d.Sha256),
+				})
+			}
+			result.DropIns = dropIns
+		}
+
+		results = append(results, result)
+	}
+
+	return Collection[ServiceDropInListResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// serviceDropInMutationCollectionFromGen converts a
+// gen.ServiceDropInMutationResponse to a
+// Collection[ServiceDropInMutationResult].
+func serviceDropInMutationCollectionFromGen(
+	g *gen.ServiceDropInMutationResponse,
+) Collection[ServiceDropInMutationResult] {
+	results := make([]ServiceDropInMutationResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, ServiceDropInMutationResult{
+			Hostname:  r.Hostname,
+			Status:    string(r.Status),
+			Unit:      derefString(r.Unit),
+			Name:      derefString(r.Name),
+			Changed:   derefBool(r.Changed),
+			Restarted: derefBool(r.Restarted),
+			Error:     derefString(r.Error),
+		})
+	}
+
+	return Collection[ServiceDropInMutationResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
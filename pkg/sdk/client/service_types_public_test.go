@@ -514,6 +514,182 @@ func (suite *ServiceTypesPublicTestSuite) TestServiceMutationCollectionFromGen()
 	}
 }
 
+func (suite *ServiceTypesPublicTestSuite) TestServiceDropInListCollectionFromGen() {
+	testUUID := openapi_types.UUID{
+		0x55, 0x0e, 0x84, 0x00,
+		0xe2, 0x9b, 0x41, 0xd4,
+		0xa7, 0x16, 0x44, 0x66,
+		0x55, 0x44, 0x00, 0x00,
+	}
+
+	tests := []struct {
+		name         string
+		input        *gen.ServiceDropInListResponse
+		validateFunc func(client.Collection[client.ServiceDropInListResult])
+	}{
+		{
+			name: "when all fields are populated",
+			input: func() *gen.ServiceDropInListResponse {
+				name := "override"
+				path := "/etc/systemd/system/nginx.service.d/override.conf"
+				managed := true
+				object := "nginx-override"
+				sha := "abc123"
+
+				return &gen.ServiceDropInListResponse{
+					JobId: &testUUID,
+					Results: []gen.ServiceDropInListEntry{
+						{
+							Hostname: "web-01",
+							Status:   gen.ServiceDropInListEntryStatusOk,
+							Dropins: &[]gen.ServiceDropInInfo{
+								{
+									Name:    &name,
+									Path:    &path,
+									Managed: &managed,
+									Object:  &object,
+									Sha256:  &sha,
+								},
+							},
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.ServiceDropInListResult]) {
+				suite.Equal("550e8400-e29b-41d4-a716-446655440000", c.JobID)
+				suite.Require().Len(c.Results, 1)
+
+				r := c.Results[0]
+				suite.Equal("web-01", r.Hostname)
+				suite.Equal("ok", r.Status)
+				suite.Require().Len(r.DropIns, 1)
+				suite.Equal("override", r.DropIns[0].Name)
+				suite.Equal(
+					"/etc/systemd/system/nginx.service.d/override.conf",
+					r.DropIns[0].Path,
+				)
+				suite.True(r.DropIns[0].Managed)
+				suite.Equal("nginx-override", r.DropIns[0].Object)
+				suite.Equal("abc123", r.DropIns[0].SHA256)
+				suite.Empty(r.Error)
+			},
+		},
+		{
+			name: "when minimal with error",
+			input: func() *gen.ServiceDropInListResponse {
+				errMsg := "unit not found"
+
+				return &gen.ServiceDropInListResponse{
+					Results: []gen.ServiceDropInListEntry{
+						{
+							Hostname: "web-01",
+							Status:   gen.ServiceDropInListEntryStatusFailed,
+							Error:    &errMsg,
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.ServiceDropInListResult]) {
+				suite.Empty(c.JobID)
+				suite.Require().Len(c.Results, 1)
+
+				r := c.Results[0]
+				suite.Equal("failed", r.Status)
+				suite.Nil(r.DropIns)
+				suite.Equal("unit not found", r.Error)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			result := client.ServiceDropInListCollectionFromGen(tc.input)
+			tc.validateFunc(result)
+		})
+	}
+}
+
+func (suite *ServiceTypesPublicTestSuite) TestServiceDropInMutationCollectionFromGen() {
+	testUUID := openapi_types.UUID{
+		0x55, 0x0e, 0x84, 0x00,
+		0xe2, 0x9b, 0x41, 0xd4,
+		0xa7, 0x16, 0x44, 0x66,
+		0x55, 0x44, 0x00, 0x00,
+	}
+
+	tests := []struct {
+		name         string
+		input        *gen.ServiceDropInMutationResponse
+		validateFunc func(client.Collection[client.ServiceDropInMutationResult])
+	}{
+		{
+			name: "when all fields are populated",
+			input: func() *gen.ServiceDropInMutationResponse {
+				unit := "nginx.service"
+				name := "override"
+				changed := true
+				restarted := true
+
+				return &gen.ServiceDropInMutationResponse{
+					JobId: &testUUID,
+					Results: []gen.ServiceDropInMutationEntry{
+						{
+							Hostname:  "web-01",
+							Status:    gen.ServiceDropInMutationEntryStatusOk,
+							Unit:      &unit,
+							Name:      &name,
+							Changed:   &changed,
+							Restarted: &restarted,
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.ServiceDropInMutationResult]) {
+				suite.Equal("550e8400-e29b-41d4-a716-446655440000", c.JobID)
+				suite.Require().Len(c.Results, 1)
+
+				r := c.Results[0]
+				suite.Equal("web-01", r.Hostname)
+				suite.Equal("ok", r.Status)
+				suite.Equal("nginx.service", r.Unit)
+				suite.Equal("override", r.Name)
+				suite.True(r.Changed)
+				suite.True(r.Restarted)
+				suite.Empty(r.Error)
+			},
+		},
+		{
+			name: "when minimal with nil pointers",
+			input: &gen.ServiceDropInMutationResponse{
+				Results: []gen.ServiceDropInMutationEntry{
+					{
+						Hostname: "web-01",
+						Status:   gen.ServiceDropInMutationEntryStatusSkipped,
+					},
+				},
+			},
+			validateFunc: func(c client.Collection[client.ServiceDropInMutationResult]) {
+				suite.Empty(c.JobID)
+				suite.Require().Len(c.Results, 1)
+
+				r := c.Results[0]
+				suite.Equal("skipped", r.Status)
+				suite.Empty(r.Unit)
+				suite.Empty(r.Name)
+				suite.False(r.Changed)
+				suite.False(r.Restarted)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			result := client.ServiceDropInMutationCollectionFromGen(tc.input)
+			tc.validateFunc(result)
+		})
+	}
+}
+
 func TestServiceTypesPublicTestSuite(t *testing.T) {
 	suite.Run(t, new(ServiceTypesPublicTestSuite))
 }
@@ -0,0 +1,81 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// SocketService provides TCP and UDP socket inventory operations.
+type SocketService struct {
+	client *gen.ClientWithResponses
+}
+
+// List returns listening sockets and established connections on the
+// target host, filtered by opts.
+func (s *SocketService) List(
+	ctx context.Context,
+	hostname string,
+	opts SocketListOpts,
+) (*Response[Collection[SocketListResult]], error) {
+	params := &gen.GetNodeNetworkSocketParams{}
+	if opts.Protocol != "" {
+		protocol := gen.GetNodeNetworkSocketParamsProtocol(opts.Protocol)
+		params.Protocol = &protocol
+	}
+	if opts.State != "" {
+		state := gen.GetNodeNetworkSocketParamsState(opts.State)
+		params.State = &state
+	}
+	if opts.Port > 0 {
+		params.Port = &opts.Port
+	}
+	if opts.PID > 0 {
+		params.Pid = &opts.PID
+	}
+
+	resp, err := s.client.GetNodeNetworkSocketWithResponse(ctx, hostname, params)
+	if err != nil {
+		return nil, fmt.Errorf("socket list: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(socketCollectionFromGen(resp.JSON200), resp.Body), nil
+}
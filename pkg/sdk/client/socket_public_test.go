@@ -0,0 +1,255 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client"
+)
+
+type SocketPublicTestSuite struct {
+	suite.Suite
+
+	ctx context.Context
+}
+
+func (suite *SocketPublicTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+}
+
+func (suite *SocketPublicTestSuite) TestSocketList() {
+	tests := []struct {
+		name         string
+		opts         client.SocketListOpts
+		handler      http.HandlerFunc
+		serverURL    string
+		validateFunc func(*client.Response[client.Collection[client.SocketListResult]], error)
+	}{
+		{
+			name: "when listing sockets returns results",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				suite.Empty(r.URL.RawQuery)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","sockets":[{"protocol":"tcp","family":"inet","state":"listen","local_address":"0.0.0.0","local_port":22,"pid":812,"process":"sshd"},{"protocol":"tcp","family":"inet","state":"established","local_address":"10.0.2.15","local_port":22,"remote_address":"10.0.2.2","remote_port":54000}]}]}`,
+					),
+				)
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.SocketListResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Equal("00000000-0000-0000-0000-000000000001", resp.Data.JobID)
+				suite.Require().Len(resp.Data.Results, 1)
+				suite.Equal("agent1", resp.Data.Results[0].Hostname)
+				suite.Equal("ok", resp.Data.Results[0].Status)
+				suite.Equal([]client.SocketInfo{
+					{
+						Protocol:     "tcp",
+						Family:       "inet",
+						State:        "listen",
+						LocalAddress: "0.0.0.0",
+						LocalPort:    22,
+						PID:          812,
+						Process:      "sshd",
+					},
+					{
+						Protocol:      "tcp",
+						Family:        "inet",
+						State:         "established",
+						LocalAddress:  "10.0.2.15",
+						LocalPort:     22,
+						RemoteAddress: "10.0.2.2",
+						RemotePort:    54000,
+					},
+				}, resp.Data.Results[0].Sockets)
+			},
+		},
+		{
+			name: "when filters are set sends query parameters",
+			opts: client.SocketListOpts{
+				Protocol: "udp",
+				State:    "listen",
+				Port:     53,
+				PID:      700,
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query()
+				suite.Equal("udp", q.Get("protocol"))
+				suite.Equal("listen", q.Get("state"))
+				suite.Equal("53", q.Get("port"))
+				suite.Equal("700", q.Get("pid"))
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","sockets":[]}]}`,
+					),
+				)
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.SocketListResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Require().Len(resp.Data.Results, 1)
+				suite.Empty(resp.Data.Results[0].Sockets)
+			},
+		},
+		{
+			name: "when agent reports failure returns error in result",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"failed","error":"operation not supported on this OS family"}]}`,
+					),
+				)
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.SocketListResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.NotNil(resp)
+				suite.Require().Len(resp.Data.Results, 1)
+				suite.Equal("failed", resp.Data.Results[0].Status)
+				suite.Equal("operation not supported on this OS family", resp.Data.Results[0].Error)
+				suite.Nil(resp.Data.Results[0].Sockets)
+			},
+		},
+		{
+			name: "when server returns 400 returns ValidationError",
+			opts: client.SocketListOpts{State: "closed"},
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"invalid state"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.SocketListResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.ValidationError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusBadRequest, target.StatusCode)
+			},
+		},
+		{
+			name: "when server returns 403 returns AuthError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error":"failed"}`))
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.SocketListResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.AuthError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusForbidden, target.StatusCode)
+			},
+		},
+		{
+			name:      "when client HTTP call fails returns error",
+			serverURL: "http://127.0.0.1:0",
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.SocketListResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+				suite.Contains(err.Error(), "socket list")
+			},
+		},
+		{
+			name: "when server returns 200 with no JSON body returns UnexpectedStatusError",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.SocketListResult]],
+				err error,
+			) {
+				suite.Error(err)
+				suite.Nil(resp)
+
+				var target *client.UnexpectedStatusError
+				suite.True(errors.As(err, &target))
+				suite.Equal(http.StatusOK, target.StatusCode)
+				suite.Equal("nil response body", target.Message)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			var (
+				serverURL string
+				cleanup   func()
+			)
+
+			if tc.serverURL != "" {
+				serverURL = tc.serverURL
+				cleanup = func() {}
+			} else {
+				server := httptest.NewServer(tc.handler)
+				serverURL = server.URL
+				cleanup = server.Close
+			}
+			defer cleanup()
+
+			sut := client.New(
+				serverURL,
+				"test-token",
+				client.WithLogger(slog.Default()),
+			)
+
+			resp, err := sut.Socket.List(suite.ctx, "_any", tc.opts)
+			tc.validateFunc(resp, err)
+		})
+	}
+}
+
+func TestSocketPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(SocketPublicTestSuite))
+}
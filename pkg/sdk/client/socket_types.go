@@ -0,0 +1,121 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// SocketListOpts contains filters for the socket list operation. Zero
+// values match every socket.
+type SocketListOpts struct {
+	// Protocol limits results to "tcp" or "udp".
+	Protocol string
+	// State limits results to "listen" or "established".
+	State string
+	// Port matches sockets whose local or remote port equals Port.
+	Port int
+	// PID limits results to sockets owned by this process.
+	PID int
+}
+
+// SocketListResult represents the sockets reported by one host.
+type SocketListResult struct {
+	Hostname string       `json:"hostname"`
+	Status   string       `json:"status"`
+	Sockets  []SocketInfo `json:"sockets,omitempty"`
+	Error    string       `json:"error,omitempty"`
+}
+
+// SocketInfo represents a single TCP or UDP socket. Unconnected UDP
+// sockets are reported in the listen state.
+type SocketInfo struct {
+	Protocol      string `json:"protocol"`
+	Family        string `json:"family"`
+	State         string `json:"state"`
+	LocalAddress  string `json:"local_address"`
+	LocalPort     int    `json:"local_port"`
+	RemoteAddress string `json:"remote_address,omitempty"`
+	RemotePort    int    `json:"remote_port,omitempty"`
+	PID           int    `json:"pid,omitempty"`
+	Process       string `json:"process,omitempty"`
+}
+
+// socketCollectionFromGen converts a gen.SocketCollectionResponse to a
+// Collection[SocketListResult].
+func socketCollectionFromGen(
+	g *gen.SocketCollectionResponse,
+) Collection[SocketListResult] {
+	results := make([]SocketListResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, socketListResultFromGen(r))
+	}
+
+	return Collection[SocketListResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// socketListResultFromGen converts a gen.SocketEntry to a SocketListResult.
+func socketListResultFromGen(
+	r gen.SocketEntry,
+) SocketListResult {
+	result := SocketListResult{
+		Hostname: r.Hostname,
+		Status:   string(r.Status),
+		Error:    derefString(r.Error),
+	}
+
+	if r.Sockets != nil {
+		sockets := make([]SocketInfo, 0, len(*r.Sockets))
+		for _, sock := range *r.Sockets {
+			sockets = append(sockets, socketInfoFromGen(sock))
+		}
+		result.Sockets = sockets
+	}
+
+	return result
+}
+
+// socketInfoFromGen converts a gen.SocketInfo to a SocketInfo.
+func socketInfoFromGen(
+	s gen.SocketInfo,
+) SocketInfo {
+	info := SocketInfo{
+		Family:        derefString(s.Family),
+		LocalAddress:  derefString(s.LocalAddress),
+		LocalPort:     derefInt(s.LocalPort),
+		RemoteAddress: derefString(s.RemoteAddress),
+		RemotePort:    derefInt(s.RemotePort),
+		PID:           derefInt(s.Pid),
+		Process:       derefString(s.Process),
+	}
+
+	if s.Protocol != nil {
+		info.Protocol = string(*s.Protocol)
+	}
+	if s.State != nil {
+		info.State = string(*s.State)
+	}
+
+	return info
+}
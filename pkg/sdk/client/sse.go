@@ -0,0 +1,93 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// maxSSELineLen bounds a single line read from an event stream.
+const maxSSELineLen = 1024 * 1024
+
+// sseEvent is a single Server-Sent Event.
+type sseEvent struct {
+	name string
+	data []byte
+}
+
+// newSSEScanner returns a line scanner for a Server-Sent Events stream.
+func newSSEScanner(
+	r io.Reader,
+) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxSSELineLen)
+
+	return scanner
+}
+
+// readSSEEvent reads the next event from a Server-Sent Events stream.
+// Comment lines are ignored and multiple data lines are joined with
+// newlines. It returns false once the stream ends without a complete
+// event.
+func readSSEEvent(
+	scanner *bufio.Scanner,
+) (sseEvent, bool) {
+	var (
+		event sseEvent
+		data  []string
+		seen  bool
+	)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if line == "" {
+			if !seen {
+				continue
+			}
+
+			event.data = []byte(strings.Join(data, "\n"))
+
+			return event, true
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+
+		switch field {
+		case "":
+			// Comment line.
+			continue
+		case "event":
+			event.name = value
+		case "data":
+			data = append(data, value)
+		default:
+			continue
+		}
+
+		seen = true
+	}
+
+	return sseEvent{}, false
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// SshdService provides OpenSSH server drop-in management operations.
+type SshdService struct {
+	client *gen.ClientWithResponses
+}
+
+// Get returns the effective sshd configuration reported by `sshd -T` and
+// the managed drop-ins on the target host.
+func (s *SshdService) Get(
+	ctx context.Context,
+	hostname string,
+) (*Response[Collection[SshdGetResult]], error) {
+	resp, err := s.client.GetNodeSshdWithResponse(ctx, hostname)
+	if err != nil {
+		return nil, fmt.Errorf("sshd get: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(sshdGetCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Create writes an sshd drop-in on the target host and reloads the daemon.
+// The drop-in is never written if `sshd -t` fails and is removed again if
+// the reload fails.
+func (s *SshdService) Create(
+	ctx context.Context,
+	hostname string,
+	opts SshdCreateOpts,
+) (*Response[Collection[SshdMutationResult]], error) {
+	body := gen.SshdCreateRequest{
+		Name:       opts.Name,
+		Directives: sshdDirectivesToGen(opts.Directives),
+	}
+
+	resp, err := s.client.PostNodeSshdWithResponse(ctx, hostname, body)
+	if err != nil {
+		return nil, fmt.Errorf("sshd create: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(sshdMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Update replaces the directives of a managed sshd drop-in on the target
+// host and reloads the daemon. The previous drop-in is kept if `sshd -t`
+// fails and restored if the reload fails.
+func (s *SshdService) Update(
+	ctx context.Context,
+	hostname string,
+	name string,
+	opts SshdUpdateOpts,
+) (*Response[Collection[SshdMutationResult]], error) {
+	body := gen.SshdUpdateRequest{
+		Directives: sshdDirectivesToGen(opts.Directives),
+	}
+
+	resp, err := s.client.PutNodeSshdWithResponse(ctx, hostname, name, body)
+	if err != nil {
+		return nil, fmt.Errorf("sshd update: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(sshdMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Delete removes a managed sshd drop-in from the target host and reloads
+// the daemon.
+func (s *SshdService) Delete(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[SshdMutationResult]], error) {
+	resp, err := s.client.DeleteNodeSshdWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("sshd delete: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(sshdMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// SshdGetResult represents the sshd configuration of a single agent.
+type SshdGetResult struct {
+	Hostname string `json:"hostname"`
+	Status   string `json:"status"`
+	// Effective is the configuration reported by `sshd -T`. Keywords are
+	// lower case.
+	Effective []SshdDirective `json:"effective,omitempty"`
+	DropIns   []SshdDropIn    `json:"drop_ins,omitempty"`
+	Error     string          `json:"error,omitempty"`
+}
+
+// SshdDropIn represents a managed drop-in file in /etc/ssh/sshd_config.d.
+type SshdDropIn struct {
+	Name       string          `json:"name"`
+	Path       string          `json:"path,omitempty"`
+	Directives []SshdDirective `json:"directives,omitempty"`
+}
+
+// SshdDirective is a single sshd configuration keyword and its value.
+type SshdDirective struct {
+	Keyword string `json:"keyword"`
+	Value   string `json:"value"`
+}
+
+// SshdMutationResult represents the result of an sshd drop-in mutation.
+type SshdMutationResult struct {
+	Hostname string `json:"hostname"`
+	Status   string `json:"status"`
+	Name     string `json:"name"`
+	Changed  bool   `json:"changed"`
+	Error    string `json:"error,omitempty"`
+}
+
+// SshdCreateOpts contains options for creating an sshd drop-in.
+type SshdCreateOpts struct {
+	// Name is the drop-in name; the file is written as <name>.conf
+	// (required).
+	Name string
+	// Directives are written to the drop-in in order. At least one
+	// directive is required.
+	Directives []SshdDirective
+}
+
+// SshdUpdateOpts contains the replacement directives for a managed sshd
+// drop-in.
+type SshdUpdateOpts struct {
+	// Directives are written to the drop-in in order. At least one
+	// directive is required.
+	Directives []SshdDirective
+}
+
+// sshdDirectivesToGen converts SshdDirective values to gen.SshdDirective
+// values.
+func sshdDirectivesToGen(
+	directives []SshdDirective,
+) []gen.SshdDirective {
+	result := make([]gen.SshdDirective, 0, len(directives))
+	for _, d := range directives {
+		result = append(result, gen.SshdDirective{
+			Keyword: d.Keyword,
+			Value:   d.Value,
+		})
+	}
+
+	return result
+}
+
+// sshdDirectivesFromGen converts gen.SshdDirective values to SshdDirective
+// values.
+func sshdDirectivesFromGen(
+	directives *[]gen.SshdDirective,
+) []SshdDirective {
+	if directives == nil {
+		return nil
+	}
+
+	result := make([]SshdDirective, 0, len(*directives))
+	for _, d := range *directives {
+		result = append(result, SshdDirective{
+			Keyword: d.Keyword,
+			Value:   d.Value,
+		})
+	}
+
+	return result
+}
+
+// sshdGetCollectionFromGen converts a gen.SshdGetResponse to a
+// Collection[SshdGetResult].
+func sshdGetCollectionFromGen(
+	g *gen.SshdGetResponse,
+) Collection[SshdGetResult] {
+	results := make([]SshdGetResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		result := SshdGetResult{
+			Hostname:  r.Hostname,
+			Status:    string(r.Status),
+			Effective: sshdDirectivesFromGen(r.Effective),
+			Error:     derefString(r.Error),
+		}
+
+		if r.DropIns != nil {
+			dropIns := make([]SshdDropIn, 0, len(*r.DropIns))
+			for _, d := range *r.DropIns {
+				dropIns = append(dropIns, SshdDropIn{
+					Name:       derefString(d.Name),
+					Path:       derefString(d.Path),
+					Directives: sshdDirectivesFromGen(d.Directives),
+				})
+			}
+			result.DropIns = dropIns
+		}
+
+		results = append(results, result)
+	}
+
+	return Collection[SshdGetResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// sshdMutationCollectionFromGen converts a gen.SshdMutationResponse to a
+// Collection[SshdMutationResult].
+func sshdMutationCollectionFromGen(
+	g *gen.SshdMutationResponse,
+) Collection[SshdMutationResult] {
+	results := make([]SshdMutationResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, SshdMutationResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Name:     derefString(r.Name),
+			Changed:  derefBool(r.Changed),
+			Error:    derefString(r.Error),
+		})
+	}
+
+	return Collection[SshdMutationResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
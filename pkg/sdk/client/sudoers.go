@@ -0,0 +1,202 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// SudoersService provides sudoers drop-in management operations.
+type SudoersService struct {
+	client *gen.ClientWithResponses
+}
+
+// List returns the managed sudoers drop-ins on the target host.
+func (s *SudoersService) List(
+	ctx context.Context,
+	hostname string,
+) (*Response[Collection[SudoersListResult]], error) {
+	resp, err := s.client.GetNodeSudoersWithResponse(ctx, hostname)
+	if err != nil {
+		return nil, fmt.Errorf("sudoers list: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(sudoersListCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Get returns a single managed sudoers drop-in on the target host.
+func (s *SudoersService) Get(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[SudoersGetResult]], error) {
+	resp, err := s.client.GetNodeSudoersByNameWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("sudoers get: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(sudoersGetCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Create validates and installs a sudoers drop-in on the target host. The
+// drop-in is only installed if `visudo` accepts it.
+func (s *SudoersService) Create(
+	ctx context.Context,
+	hostname string,
+	opts SudoersCreateOpts,
+) (*Response[Collection[SudoersMutationResult]], error) {
+	body := gen.SudoersCreateRequest{
+		Name:  opts.Name,
+		Rules: sudoersRulesToGen(opts.Rules),
+	}
+
+	resp, err := s.client.PostNodeSudoersWithResponse(ctx, hostname, body)
+	if err != nil {
+		return nil, fmt.Errorf("sudoers create: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(sudoersMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Update validates and replaces the rules of a managed sudoers drop-in on
+// the target host. The live drop-in is left untouched if `visudo` rejects
+// the new rules.
+func (s *SudoersService) Update(
+	ctx context.Context,
+	hostname string,
+	name string,
+	opts SudoersUpdateOpts,
+) (*Response[Collection[SudoersMutationResult]], error) {
+	body := gen.SudoersUpdateRequest{
+		Rules: sudoersRulesToGen(opts.Rules),
+	}
+
+	resp, err := s.client.PutNodeSudoersWithResponse(ctx, hostname, name, body)
+	if err != nil {
+		return nil, fmt.Errorf("sudoers update: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(sudoersMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Delete removes a managed sudoers drop-in from the target host.
+func (s *SudoersService) Delete(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[SudoersMutationResult]], error) {
+	resp, err := s.client.DeleteNodeSudoersWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("sudoers delete: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(sudoersMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
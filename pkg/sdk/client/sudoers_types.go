@@ -0,0 +1,232 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// SudoersListResult represents a sudoers list result from a single agent.
+type SudoersListResult struct {
+	Hostname string          `json:"hostname"`
+	Status   string          `json:"status"`
+	DropIns  []SudoersDropIn `json:"drop_ins,omitempty"`
+	Error    string          `json:"error,omitempty"`
+}
+
+// SudoersDropIn represents a managed drop-in file in /etc/sudoers.d.
+type SudoersDropIn struct {
+	Name  string        `json:"name"`
+	Path  string        `json:"path,omitempty"`
+	Rules []SudoersRule `json:"rules,omitempty"`
+}
+
+// SudoersRule is a single sudoers user specification.
+type SudoersRule struct {
+	// Principal is the user, %group or +netgroup the rule applies to
+	// (required).
+	Principal string `json:"principal"`
+	// Hosts limits the rule to the listed hosts. Defaults to ALL.
+	Hosts []string `json:"hosts,omitempty"`
+	// RunAs lists the users the commands may be run as. Defaults to ALL.
+	RunAs []string `json:"run_as,omitempty"`
+	// NoPassword allows the commands to run without a password prompt.
+	NoPassword bool `json:"no_password,omitempty"`
+	// Commands are the fully qualified commands the principal may run.
+	// Defaults to ALL.
+	Commands []string `json:"commands,omitempty"`
+}
+
+// SudoersGetResult represents a sudoers get result from a single agent.
+type SudoersGetResult struct {
+	Hostname string         `json:"hostname"`
+	Status   string         `json:"status"`
+	DropIn   *SudoersDropIn `json:"drop_in,omitempty"`
+	Error    string         `json:"error,omitempty"`
+}
+
+// SudoersMutationResult represents the result of a sudoers drop-in
+// mutation.
+type SudoersMutationResult struct {
+	Hostname string `json:"hostname"`
+	Status   string `json:"status"`
+	Name     string `json:"name"`
+	Changed  bool   `json:"changed"`
+	Error    string `json:"error,omitempty"`
+}
+
+// SudoersCreateOpts contains options for creating a sudoers drop-in.
+type SudoersCreateOpts struct {
+	// Name is the drop-in file name in /etc/sudoers.d (required).
+	Name string
+	// Rules are the user specifications written to the drop-in. At least
+	// one rule is required.
+	Rules []SudoersRule
+}
+
+// SudoersUpdateOpts contains the replacement rules for a managed sudoers
+// drop-in.
+type SudoersUpdateOpts struct {
+	// Rules are the user specifications written to the drop-in. At least
+	// one rule is required.
+	Rules []SudoersRule
+}
+
+// sudoersRulesToGen converts SudoersRule values to gen.SudoersRule values.
+func sudoersRulesToGen(
+	rules []SudoersRule,
+) []gen.SudoersRule {
+	result := make([]gen.SudoersRule, 0, len(rules))
+	for _, r := range rules {
+		rule := gen.SudoersRule{
+			Principal: r.Principal,
+		}
+
+		if len(r.Hosts) > 0 {
+			hosts := r.Hosts
+			rule.Hosts = &hosts
+		}
+
+		if len(r.RunAs) > 0 {
+			runAs := r.RunAs
+			rule.RunAs = &runAs
+		}
+
+		if r.NoPassword {
+			noPassword := r.NoPassword
+			rule.NoPassword = &noPassword
+		}
+
+		if len(r.Commands) > 0 {
+			commands := r.Commands
+			rule.Commands = &commands
+		}
+
+		result = append(result, rule)
+	}
+
+	return result
+}
+
+// sudoersListCollectionFromGen converts a gen.SudoersListResponse to a
+// Collection[SudoersListResult].
+func sudoersListCollectionFromGen(
+	g *gen.SudoersListResponse,
+) Collection[SudoersListResult] {
+	results := make([]SudoersListResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		result := SudoersListResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Error:    derefString(r.Error),
+		}
+
+		if r.DropIns != nil {
+			dropIns := make([]SudoersDropIn, 0, len(*r.DropIns))
+			for _, d := range *r.DropIns {
+				dropIns = append(dropIns, sudoersDropInFromGen(d))
+			}
+			result.DropIns = dropIns
+		}
+
+		results = append(results, result)
+	}
+
+	return Collection[SudoersListResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// sudoersDropInFromGen converts a gen.SudoersInfo to a SudoersDropIn.
+func sudoersDropInFromGen(
+	d gen.SudoersInfo,
+) SudoersDropIn {
+	dropIn := SudoersDropIn{
+		Name: derefString(d.Name),
+		Path: derefString(d.Path),
+	}
+
+	if d.Rules != nil {
+		rules := make([]SudoersRule, 0, len(*d.Rules))
+		for _, r := range *d.Rules {
+			rules = append(rules, SudoersRule{
+				Principal:  r.Principal,
+				Hosts:      derefStringSlice(r.Hosts),
+				RunAs:      derefStringSlice(r.RunAs),
+				NoPassword: derefBool(r.NoPassword),
+				Commands:   derefStringSlice(r.Commands),
+			})
+		}
+		dropIn.Rules = rules
+	}
+
+	return dropIn
+}
+
+// sudoersGetCollectionFromGen converts a gen.SudoersGetResponse to a
+// Collection[SudoersGetResult].
+func sudoersGetCollectionFromGen(
+	g *gen.SudoersGetResponse,
+) Collection[SudoersGetResult] {
+	results := make([]SudoersGetResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		result := SudoersGetResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Error:    derefString(r.Error),
+		}
+
+		if r.DropIn != nil {
+			dropIn := sudoersDropInFromGen(*r.DropIn)
+			result.DropIn = &dropIn
+		}
+
+		results = append(results, result)
+	}
+
+	return Collection[SudoersGetResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// sudoersMutationCollectionFromGen converts a gen.SudoersMutationResponse to
+// a Collection[SudoersMutationResult].
+func sudoersMutationCollectionFromGen(
+	g *gen.SudoersMutationResponse,
+) Collection[SudoersMutationResult] {
+	results := make([]SudoersMutationResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, SudoersMutationResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Name:     derefString(r.Name),
+			Changed:  derefBool(r.Changed),
+			Error:    derefString(r.Error),
+		})
+	}
+
+	return Collection[SudoersMutationResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// SwapService provides swap file management operations.
+type SwapService struct {
+	client *gen.ClientWithResponses
+}
+
+// Get returns the active swap areas and swappiness of the target host.
+func (s *SwapService) Get(
+	ctx context.Context,
+	hostname string,
+) (*Response[Collection[SwapStatusResult]], error) {
+	resp, err := s.client.GetNodeSwapWithResponse(ctx, hostname)
+	if err != nil {
+		return nil, fmt.Errorf("swap get: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(swapStatusCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Create allocates and enables a managed swap file on the target host.
+func (s *SwapService) Create(
+	ctx context.Context,
+	hostname string,
+	opts SwapCreateOpts,
+) (*Response[Collection[SwapMutationResult]], error) {
+	body := gen.SwapCreateRequest{
+		Name:     opts.Name,
+		SizeMb:   opts.SizeMB,
+		Priority: opts.Priority,
+	}
+
+	resp, err := s.client.PostNodeSwapWithResponse(ctx, hostname, body)
+	if err != nil {
+		return nil, fmt.Errorf("swap create: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(swapMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Delete disables and removes a managed swap file on the target host.
+func (s *SwapService) Delete(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[SwapMutationResult]], error) {
+	resp, err := s.client.DeleteNodeSwapWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("swap delete: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(swapMutationCollectionFromGen(resp.JSON200), resp.Body), nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// SwapStatusResult represents the swap state of a single agent. Sizes are
+// in bytes.
+type SwapStatusResult struct {
+	Hostname   string     `json:"hostname"`
+	Status     string     `json:"status"`
+	Swappiness int        `json:"swappiness"`
+	Total      int64      `json:"total"`
+	Used       int64      `json:"used"`
+	Areas      []SwapArea `json:"areas,omitempty"`
+	Error      string     `json:"error,omitempty"`
+}
+
+// SwapArea represents a swap device or file. Name is set only for swap
+// files managed by OSAPI.
+type SwapArea struct {
+	Name     string `json:"name,omitempty"`
+	Path     string `json:"path,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Size     int64  `json:"size"`
+	Used     int64  `json:"used"`
+	Priority int    `json:"priority"`
+	Active   bool   `json:"active"`
+	Managed  bool   `json:"managed"`
+}
+
+// SwapMutationResult represents the result of a swap create or delete.
+type SwapMutationResult struct {
+	Hostname string `json:"hostname"`
+	Status   string `json:"status"`
+	Name     string `json:"name"`
+	Path     string `json:"path,omitempty"`
+	Changed  bool   `json:"changed"`
+	Error    string `json:"error,omitempty"`
+}
+
+// SwapCreateOpts contains options for creating a swap file.
+type SwapCreateOpts struct {
+	// Name identifies the swap file (required). The file is created at
+	// /var/lib/osapi/swap/{name}.
+	Name string
+	// SizeMB is the size of the swap file in MiB (required).
+	SizeMB int
+	// Priority is the swap priority. Nil uses the kernel-assigned priority.
+	Priority *int
+}
+
+// swapStatusCollectionFromGen converts a gen.SwapGetResponse
+// to a Collection[SwapStatusResult].
+func swapStatusCollectionFromGen(
+	g *gen.SwapGetResponse,
+) Collection[SwapStatusResult] {
+	results := make([]SwapStatusResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		result := SwapStatusResult{
+			Hostname:   r.Hostname,
+			Status:     string(r.Status),
+			Swappiness: derefInt(r.Swappiness),
+			Total:      derefInt64(r.Total),
+			Used:       derefInt64(r.Used),
+			Error:      derefString(r.Error),
+		}
+
+		if r.Areas != nil {
+			areas := make([]SwapArea, 0, len(*r.Areas))
+			for _, a := range *r.Areas {
+				areas = append(areas, SwapArea{
+					Name:     derefString(a.Name),
+					Path:     derefString(a.Path),
+					Type:     derefString(a.Type),
+					Size:     derefInt64(a.Size),
+					Used:     derefInt64(a.Used),
+					Priority: derefInt(a.Priority),
+					Active:   derefBool(a.Active),
+					Managed:  derefBool(a.Managed),
+				})
+			}
+			result.Areas = areas
+		}
+
+		results = append(results, result)
+	}
+
+	return Collection[SwapStatusResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// swapMutationCollectionFromGen converts a gen.SwapMutationResponse
+// to a Collection[SwapMutationResult].
+func swapMutationCollectionFromGen(
+	g *gen.SwapMutationResponse,
+) Collection[SwapMutationResult] {
+	results := make([]SwapMutationResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, SwapMutationResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Name:     derefString(r.Name),
+			Path:     derefString(r.Path),
+			Changed:  derefBool(r.Changed),
+			Error:    derefString(r.Error),
+		})
+	}
+
+	return Collection[SwapMutationResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// TimerService provides systemd timer management operations.
+type TimerService struct {
+	client *gen.ClientWithResponses
+}
+
+// List lists all systemd timers on the target host.
+func (s *TimerService) List(
+	ctx context.Context,
+	hostname string,
+) (*Response[Collection[TimerEntryResult]], error) {
+	resp, err := s.client.GetNodeScheduleTimerWithResponse(ctx, hostname)
+	if err != nil {
+		return nil, fmt.Errorf("timer list: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(timerEntryCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Get retrieves a single systemd timer by name on the target host.
+func (s *TimerService) Get(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[TimerEntryResult]], error) {
+	resp, err := s.client.GetNodeScheduleTimerByNameWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("timer get: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(timerGetCollectionFromGen(resp.JSON200), resp.Body), nil
+}
+
+// Create creates a new systemd timer on the target host.
+func (s *TimerService) Create(
+	ctx context.Context,
+	hostname string,
+	opts TimerCreateOpts,
+) (*Response[Collection[TimerMutationResult]], error) {
+	body := gen.TimerCreateRequest{
+		Name:     opts.Name,
+		Schedule: opts.Schedule,
+	}
+	if opts.Command != "" {
+		body.Command = &opts.Command
+	}
+	if opts.Object != "" {
+		body.Object = &opts.Object
+	}
+	if opts.User != "" {
+		body.User = &opts.User
+	}
+	if opts.ContentType != "" {
+		ct := gen.TimerCreateRequestContentType(opts.ContentType)
+		body.ContentType = &ct
+	}
+	if opts.Vars != nil {
+		body.Vars = &opts.Vars
+	}
+
+	resp, err := s.client.PostNodeScheduleTimerWithResponse(ctx, hostname, body)
+	if err != nil {
+		return nil, fmt.Errorf("timer create: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(timerMutationCollectionFromCreate(resp.JSON200), resp.Body), nil
+}
+
+// Update updates an existing systemd timer on the target host.
+func (s *TimerService) Update(
+	ctx context.Context,
+	hostname string,
+	name string,
+	opts TimerUpdateOpts,
+) (*Response[Collection[TimerMutationResult]], error) {
+	body := gen.TimerUpdateRequest{}
+	if opts.Schedule != "" {
+		body.Schedule = &opts.Schedule
+	}
+	if opts.Command != "" {
+		body.Command = &opts.Command
+	}
+	if opts.Object != "" {
+		body.Object = &opts.Object
+	}
+	if opts.User != "" {
+		body.User = &opts.User
+	}
+	if opts.ContentType != "" {
+		ct := gen.TimerUpdateRequestContentType(opts.ContentType)
+		body.ContentType = &ct
+	}
+	if opts.Vars != nil {
+		body.Vars = &opts.Vars
+	}
+
+	resp, err := s.client.PutNodeScheduleTimerWithResponse(ctx, hostname, name, body)
+	if err != nil {
+		return nil, fmt.Errorf("timer update: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(timerMutationCollectionFromUpdate(resp.JSON200), resp.Body), nil
+}
+
+// Delete deletes a systemd timer on the target host.
+func (s *TimerService) Delete(
+	ctx context.Context,
+	hostname string,
+	name string,
+) (*Response[Collection[TimerMutationResult]], error) {
+	resp, err := s.client.DeleteNodeScheduleTimerWithResponse(ctx, hostname, name)
+	if err != nil {
+		return nil, fmt.Errorf("timer delete: %w", err)
+	}
+
+	if err := checkError(
+		resp.StatusCode(),
+		resp.JSON400,
+		resp.JSON401,
+		resp.JSON403,
+		resp.JSON404,
+		resp.JSON500,
+	); err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, &UnexpectedStatusError{APIError{
+			StatusCode: resp.StatusCode(),
+			Message:    "nil response body",
+		}}
+	}
+
+	return NewResponse(timerMutationCollectionFromDelete(resp.JSON200), resp.Body), nil
+}
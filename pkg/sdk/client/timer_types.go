@@ -0,0 +1,194 @@
+// Copyright (c) 2026 John Dewey
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package client
+
+import (
+	"time"
+
+	"github.com/osapi-io/osapi/pkg/sdk/client/gen"
+)
+
+// TimerEntryResult represents a systemd timer from a single agent.
+type TimerEntryResult struct {
+	Hostname  string     `json:"hostname,omitempty"`
+	Status    string     `json:"status"`
+	Name      string     `json:"name"`
+	Unit      string     `json:"unit,omitempty"`
+	Activates string     `json:"activates,omitempty"`
+	Schedule  string     `json:"schedule,omitempty"`
+	Command   string     `json:"command,omitempty"`
+	Object    string     `json:"object,omitempty"`
+	User      string     `json:"user,omitempty"`
+	Managed   bool       `json:"managed"`
+	NextRun   *time.Time `json:"next_run,omitempty"`
+	LastRun   *time.Time `json:"last_run,omitempty"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// TimerMutationResult represents the result of a timer create/update/delete operation.
+type TimerMutationResult struct {
+	Hostname string `json:"hostname,omitempty"`
+	Status   string `json:"status"`
+	Name     string `json:"name"`
+	Changed  bool   `json:"changed"`
+	Error    string `json:"error,omitempty"`
+}
+
+// TimerCreateOpts contains options for creating a systemd timer.
+type TimerCreateOpts struct {
+	// Name is the timer name (required).
+	Name string
+	// Schedule is the systemd OnCalendar expression (required).
+	Schedule string
+	// Command is the command line the service runs (mutually exclusive
+	// with Object).
+	Command string
+	// Object is the name of an uploaded script in the object store
+	// (mutually exclusive with Command).
+	Object string
+	// User is the user to run the command as (optional).
+	User string
+	// ContentType is "raw" or "template" (optional, defaults to raw).
+	ContentType string
+	// Vars contains template variables (optional).
+	Vars map[string]any
+}
+
+// TimerUpdateOpts contains options for updating a systemd timer.
+type TimerUpdateOpts struct {
+	// Schedule is the systemd OnCalendar expression (optional).
+	Schedule string
+	// Command is the command line the service runs (optional).
+	Command string
+	// Object is the new object to deploy (optional).
+	Object string
+	// User is the user to run the command as (optional).
+	User string
+	// ContentType is "raw" or "template" (optional).
+	ContentType string
+	// Vars contains template variables (optional).
+	Vars map[string]any
+}
+
+// timerEntryFromGen converts a gen.TimerEntry to a TimerEntryResult.
+func timerEntryFromGen(
+	r gen.TimerEntry,
+) TimerEntryResult {
+	return TimerEntryResult{
+		Hostname:  r.Hostname,
+		Status:    string(r.Status),
+		Name:      derefString(r.Name),
+		Unit:      derefString(r.Unit),
+		Activates: derefString(r.Activates),
+		Schedule:  derefString(r.Schedule),
+		Command:   derefString(r.Command),
+		Object:    derefString(r.Object),
+		User:      derefString(r.User),
+		Managed:   derefBool(r.Managed),
+		NextRun:   r.NextRun,
+		LastRun:   r.LastRun,
+		Error:     derefString(r.Error),
+	}
+}
+
+// timerEntryCollectionFromGen converts a gen.TimerCollectionResponse
+// to a Collection[TimerEntryResult].
+func timerEntryCollectionFromGen(
+	g *gen.TimerCollectionResponse,
+) Collection[TimerEntryResult] {
+	results := make([]TimerEntryResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, timerEntryFromGen(r))
+	}
+
+	return Collection[TimerEntryResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// timerGetCollectionFromGen converts a gen.TimerGetResponse
+// to a Collection[TimerEntryResult].
+func timerGetCollectionFromGen(
+	g *gen.TimerGetResponse,
+) Collection[TimerEntryResult] {
+	results := make([]TimerEntryResult, 0, len(g.Results))
+	for _, r := range g.Results {
+		results = append(results, timerEntryFromGen(r))
+	}
+
+	return Collection[TimerEntryResult]{
+		Results: results,
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// timerMutationResultsFromGen converts a slice of gen.TimerMutationResult
+// to a slice of TimerMutationResult.
+func timerMutationResultsFromGen(
+	g []gen.TimerMutationResult,
+) []TimerMutationResult {
+	results := make([]TimerMutationResult, 0, len(g))
+	for _, r := range g {
+		results = append(results, TimerMutationResult{
+			Hostname: r.Hostname,
+			Status:   string(r.Status),
+			Name:     derefString(r.Name),
+			Changed:  derefBool(r.Changed),
+			Error:    derefString(r.Error),
+		})
+	}
+
+	return results
+}
+
+// timerMutationCollectionFromCreate converts a gen.TimerCreateResponse
+// to a Collection[TimerMutationResult].
+func timerMutationCollectionFromCreate(
+	g *gen.TimerCreateResponse,
+) Collection[TimerMutationResult] {
+	return Collection[TimerMutationResult]{
+		Results: timerMutationResultsFromGen(g.Results),
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// timerMutationCollectionFromUpdate converts a gen.TimerUpdateResponse
+// to a Collection[TimerMutationResult].
+func timerMutationCollectionFromUpdate(
+	g *gen.TimerUpdateResponse,
+) Collection[TimerMutationResult] {
+	return Collection[TimerMutationResult]{
+		Results: timerMutationResultsFromGen(g.Results),
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
+
+// timerMutationCollectionFromDelete converts a gen.TimerDeleteResponse
+// to a Collection[TimerMutationResult].
+func timerMutationCollectionFromDelete(
+	g *gen.TimerDeleteResponse,
+) Collection[TimerMutationResult] {
+	return Collection[TimerMutationResult]{
+		Results: timerMutationResultsFromGen(g.Results),
+		JobID:   jobIDFromGen(g.JobId),
+	}
+}
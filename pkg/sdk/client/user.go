@@ -132,6 +132,26 @@ func (s *UserService) Create(
 		body.System = &opts.System
 	}
 
+	if opts.MinDays != nil {
+		body.MinDays = opts.MinDays
+	}
+
+	if opts.MaxDays != nil {
+		body.MaxDays = opts.MaxDays
+	}
+
+	if opts.WarnDays != nil {
+		body.WarnDays = opts.WarnDays
+	}
+
+	if opts.AccountExpires != "" {
+		body.AccountExpires = &opts.AccountExpires
+	}
+
+	if opts.ForcePasswordChange {
+		body.ForcePasswordChange = &opts.ForcePasswordChange
+	}
+
 	resp, err := s.client.PostNodeUserWithResponse(ctx, hostname, body)
 	if err != nil {
 		return nil, fmt.Errorf("user create: %w", err)
@@ -182,6 +202,26 @@ func (s *UserService) Update(
 		body.Lock = opts.Lock
 	}
 
+	if opts.MinDays != nil {
+		body.MinDays = opts.MinDays
+	}
+
+	if opts.MaxDays != nil {
+		body.MaxDays = opts.MaxDays
+	}
+
+	if opts.WarnDays != nil {
+		body.WarnDays = opts.WarnDays
+	}
+
+	if opts.AccountExpires != "" {
+		body.AccountExpires = &opts.AccountExpires
+	}
+
+	if opts.ForcePasswordChange {
+		body.ForcePasswordChange = &opts.ForcePasswordChange
+	}
+
 	resp, err := s.client.PutNodeUserWithResponse(ctx, hostname, name, body)
 	if err != nil {
 		return nil, fmt.Errorf("user update: %w", err)
@@ -22,6 +22,7 @@ package client_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"log/slog"
 	"net/http"
@@ -361,6 +362,42 @@ func (suite *UserPublicTestSuite) TestUserCreate() {
 				suite.True(resp.Data.Results[0].Changed)
 			},
 		},
+		{
+			name: "when creating user with password aging sends aging fields",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]any
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				suite.Equal(float64(1), body["min_days"])
+				suite.Equal(float64(90), body["max_days"])
+				suite.Equal(float64(14), body["warn_days"])
+				suite.Equal("2026-12-31", body["account_expires"])
+				suite.Equal(true, body["force_password_change"])
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(
+					[]byte(
+						`{"job_id":"00000000-0000-0000-0000-000000000001","results":[{"hostname":"agent1","status":"ok","name":"newuser","changed":true}]}`,
+					),
+				)
+			},
+			opts: client.UserCreateOpts{
+				Name:                "newuser",
+				MinDays:             intPtr(1),
+				MaxDays:             intPtr(90),
+				WarnDays:            intPtr(14),
+				AccountExpires:      "2026-12-31",
+				ForcePasswordChange: true,
+			},
+			validateFunc: func(
+				resp *client.Response[client.Collection[client.UserMutationResult]],
+				err error,
+			) {
+				suite.NoError(err)
+				suite.Require().NotNil(resp)
+				suite.Len(resp.Data.Results, 1)
+			},
+		},
 		{
 			name: "when server returns 400 returns ValidationError",
 			handler: func(w http.ResponseWriter, _ *http.Request) {
@@ -599,10 +636,15 @@ func (suite *UserPublicTestSuite) TestUserUpdate() {
 			)
 
 			resp, err := sut.User.Update(suite.ctx, "_any", "testuser", client.UserUpdateOpts{
-				Shell:  "/bin/zsh",
-				Home:   "/home/testuser",
-				Groups: []string{"sudo"},
-				Lock:   &lockVal,
+				Shell:               "/bin/zsh",
+				Home:                "/home/testuser",
+				Groups:              []string{"sudo"},
+				Lock:                &lockVal,
+				MinDays:             intPtr(1),
+				MaxDays:             intPtr(90),
+				WarnDays:            intPtr(7),
+				AccountExpires:      "never",
+				ForcePasswordChange: true,
 			})
 			tc.validateFunc(resp, err)
 		})
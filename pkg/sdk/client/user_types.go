@@ -41,6 +41,21 @@ type UserInfo struct {
 	Shell  string   `json:"shell,omitempty"`
 	Groups []string `json:"groups,omitempty"`
 	Locked bool     `json:"locked,omitempty"`
+	// Aging is the password aging and account expiry settings. Nil when
+	// the agent could not read them.
+	Aging *UserPasswordAging `json:"aging,omitempty"`
+}
+
+// UserPasswordAging represents the password aging and account expiry
+// settings of a user account. Dates are YYYY-MM-DD and empty when unset.
+type UserPasswordAging struct {
+	LastChange      string `json:"last_change,omitempty"`
+	MustChange      bool   `json:"must_change,omitempty"`
+	PasswordExpires string `json:"password_expires,omitempty"`
+	AccountExpires  string `json:"account_expires,omitempty"`
+	MinDays         int    `json:"min_days"`
+	MaxDays         int    `json:"max_days"`
+	WarnDays        int    `json:"warn_days"`
 }
 
 // UserMutationResult represents the result of a user create, update, delete,
@@ -71,6 +86,20 @@ type UserCreateOpts struct {
 	Password string
 	// System creates a system account.
 	System bool
+	// MinDays is the minimum number of days between password changes.
+	// Nil leaves the system default.
+	MinDays *int
+	// MaxDays is the maximum number of days a password is valid.
+	// Nil leaves the system default.
+	MaxDays *int
+	// WarnDays is the number of days of warning before the password
+	// expires. Nil leaves the system default.
+	WarnDays *int
+	// AccountExpires is the account expiry date (YYYY-MM-DD).
+	AccountExpires string
+	// ForcePasswordChange requires the password to be changed at first
+	// login.
+	ForcePasswordChange bool
 }
 
 // UserUpdateOpts contains options for updating a user account.
@@ -83,6 +112,21 @@ type UserUpdateOpts struct {
 	Groups []string
 	// Lock locks or unlocks the account.
 	Lock *bool
+	// MinDays is the minimum number of days between password changes.
+	// -1 removes the limit.
+	MinDays *int
+	// MaxDays is the maximum number of days a password is valid.
+	// -1 removes the limit.
+	MaxDays *int
+	// WarnDays is the number of days of warning before the password
+	// expires. -1 removes the limit.
+	WarnDays *int
+	// AccountExpires is the account expiry date (YYYY-MM-DD), or "never"
+	// to remove the expiry.
+	AccountExpires string
+	// ForcePasswordChange expires the password so it must be changed at
+	// next login.
+	ForcePasswordChange bool
 }
 
 // SSHKeyInfoResult represents SSH key list result for one host.
@@ -219,6 +263,27 @@ func userInfoFromGen(
 		Shell:  derefString(g.Shell),
 		Groups: derefStringSlice(g.Groups),
 		Locked: derefBool(g.Locked),
+		Aging:  userPasswordAgingFromGen(g.Aging),
+	}
+}
+
+// userPasswordAgingFromGen converts a gen.UserPasswordAging to a
+// UserPasswordAging.
+func userPasswordAgingFromGen(
+	g *gen.UserPasswordAging,
+) *UserPasswordAging {
+	if g == nil {
+		return nil
+	}
+
+	return &UserPasswordAging{
+		LastChange:      derefString(g.LastChange),
+		MustChange:      derefBool(g.MustChange),
+		PasswordExpires: derefString(g.PasswordExpires),
+		AccountExpires:  derefString(g.AccountExpires),
+		MinDays:         derefInt(g.MinDays),
+		MaxDays:         derefInt(g.MaxDays),
+		WarnDays:        derefInt(g.WarnDays),
 	}
 }
 
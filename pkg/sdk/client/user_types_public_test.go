@@ -153,6 +153,60 @@ func (suite *UserTypesPublicTestSuite) TestUserInfoCollectionFromList() {
 				suite.Equal("web-02", c.Results[1].Hostname)
 				suite.Equal("root", c.Results[0].Users[0].Name)
 				suite.Equal("admin", c.Results[1].Users[0].Name)
+				suite.Nil(c.Results[0].Users[0].Aging)
+			},
+		},
+		{
+			name: "when password aging is populated",
+			input: func() *gen.UserCollectionResponse {
+				userName := "testuser"
+				lastChange := "2026-01-15"
+				passwordExpires := "2026-04-15"
+				accountExpires := "2026-12-31"
+				mustChange := true
+				minDays := 1
+				maxDays := 90
+				warnDays := 7
+
+				return &gen.UserCollectionResponse{
+					JobId: &testUUID,
+					Results: []gen.UserEntry{
+						{
+							Hostname: "web-01",
+							Status:   gen.UserEntryStatusOk,
+							Users: &[]gen.UserInfo{
+								{
+									Name: &userName,
+									Aging: &gen.UserPasswordAging{
+										LastChange:      &lastChange,
+										PasswordExpires: &passwordExpires,
+										AccountExpires:  &accountExpires,
+										MustChange:      &mustChange,
+										MinDays:         &minDays,
+										MaxDays:         &maxDays,
+										WarnDays:        &warnDays,
+									},
+								},
+							},
+						},
+					},
+				}
+			}(),
+			validateFunc: func(c client.Collection[client.UserInfoResult]) {
+				suite.Require().Len(c.Results, 1)
+				suite.Require().Len(c.Results[0].Users, 1)
+
+				aging := c.Results[0].Users[0].Aging
+				suite.Require().NotNil(aging)
+				suite.Equal(&client.UserPasswordAging{
+					LastChange:      "2026-01-15",
+					MustChange:      true,
+					PasswordExpires: "2026-04-15",
+					AccountExpires:  "2026-12-31",
+					MinDays:         1,
+					MaxDays:         90,
+					WarnDays:        7,
+				}, aging)
 			},
 		},
 	}
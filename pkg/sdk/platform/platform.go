@@ -20,7 +20,7 @@
 
 // Package platform provides cross-platform detection for OSAPI providers.
 // The agent uses this package to select the correct provider variant
-// based on OS family (debian, darwin, or generic linux).
+// based on OS family (debian, rhel, alpine, darwin, or generic linux).
 package platform
 
 import (
@@ -41,8 +41,26 @@ var debianFamily = map[string]bool{
 	"raspbian": true,
 }
 
+// rhelFamily lists distributions that belong to the RHEL OS family
+// and share the same provider implementations.
+var rhelFamily = map[string]bool{
+	"redhat":    true,
+	"rhel":      true,
+	"centos":    true,
+	"rocky":     true,
+	"almalinux": true,
+	"fedora":    true,
+	"oracle":    true,
+}
+
+// alpineFamily lists distributions that belong to the Alpine OS family
+// and share the same provider implementations.
+var alpineFamily = map[string]bool{
+	"alpine": true,
+}
+
 // Detect returns the OS family name for provider selection.
-// Returns "debian", "darwin", or "" (generic linux/unknown).
+// Returns "debian", "rhel", "alpine", "darwin", or "" (generic linux/unknown).
 func Detect() string {
 	info, _ := HostInfoFn()
 	if info == nil {
@@ -58,5 +76,13 @@ func Detect() string {
 		return "debian"
 	}
 
+	if rhelFamily[platform] {
+		return "rhel"
+	}
+
+	if alpineFamily[platform] {
+		return "alpine"
+	}
+
 	return platform
 }
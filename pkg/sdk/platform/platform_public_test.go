@@ -67,11 +67,46 @@ func (suite *PlatformPublicTestSuite) TestDetect() {
 			expected: "darwin",
 		},
 		{
-			name: "returns centos for centos platform",
+			name: "returns rhel family when platform is centos",
 			infoFn: func() (*host.InfoStat, error) {
 				return &host.InfoStat{Platform: "centos"}, nil
 			},
-			expected: "centos",
+			expected: "rhel",
+		},
+		{
+			name: "returns rhel family when platform is Rocky",
+			infoFn: func() (*host.InfoStat, error) {
+				return &host.InfoStat{Platform: "Rocky"}, nil
+			},
+			expected: "rhel",
+		},
+		{
+			name: "returns rhel family when platform is almalinux",
+			infoFn: func() (*host.InfoStat, error) {
+				return &host.InfoStat{Platform: "almalinux"}, nil
+			},
+			expected: "rhel",
+		},
+		{
+			name: "returns rhel family when platform is fedora",
+			infoFn: func() (*host.InfoStat, error) {
+				return &host.InfoStat{Platform: "fedora"}, nil
+			},
+			expected: "rhel",
+		},
+		{
+			name: "returns alpine family when platform is Alpine",
+			infoFn: func() (*host.InfoStat, error) {
+				return &host.InfoStat{Platform: "Alpine"}, nil
+			},
+			expected: "alpine",
+		},
+		{
+			name: "returns platform as-is for unknown family",
+			infoFn: func() (*host.InfoStat, error) {
+				return &host.InfoStat{Platform: "arch"}, nil
+			},
+			expected: "arch",
 		},
 		{
 			name: "returns empty string when info is nil",
@@ -41,7 +41,25 @@ export type Permission =
   | "group:read"
   | "group:write"
   | "certificate:read"
-  | "certificate:write";
+  | "certificate:write"
+  | "firewall:read"
+  | "firewall:write"
+  | "mount:read"
+  | "mount:write"
+  | "block:read"
+  | "block:write"
+  | "swap:read"
+  | "swap:write"
+  | "kernel:read"
+  | "kernel:write"
+  | "hosts:read"
+  | "hosts:write"
+  | "timer:read"
+  | "timer:write"
+  | "sudoers:read"
+  | "sudoers:write"
+  | "sshd:read"
+  | "sshd:write";
 
 export const ALL_PERMISSIONS: Permission[] = [
   "agent:read",
@@ -83,6 +101,24 @@ export const ALL_PERMISSIONS: Permission[] = [
   "group:write",
   "certificate:read",
   "certificate:write",
+  "firewall:read",
+  "firewall:write",
+  "mount:read",
+  "mount:write",
+  "block:read",
+  "block:write",
+  "swap:read",
+  "swap:write",
+  "kernel:read",
+  "kernel:write",
+  "hosts:read",
+  "hosts:write",
+  "timer:read",
+  "timer:write",
+  "sudoers:read",
+  "sudoers:write",
+  "sshd:read",
+  "sshd:write",
 ];
 
 // ---------------------------------------------------------------------------
@@ -118,6 +154,15 @@ const VIEWER_PERMISSIONS: Permission[] = [
   "user:read",
   "group:read",
   "certificate:read",
+  "firewall:read",
+  "mount:read",
+  "block:read",
+  "swap:read",
+  "kernel:read",
+  "hosts:read",
+  "timer:read",
+  "sudoers:read",
+  "sshd:read",
 ];
 
 const OPERATOR_PERMISSIONS: Permission[] = [
@@ -141,6 +186,15 @@ const OPERATOR_PERMISSIONS: Permission[] = [
   "user:write",
   "group:write",
   "certificate:write",
+  "firewall:write",
+  "mount:write",
+  "block:write",
+  "swap:write",
+  "kernel:write",
+  "hosts:write",
+  "timer:write",
+  "sudoers:write",
+  "sshd:write",
 ];
 
 const ADMIN_PERMISSIONS: Permission[] = [...OPERATOR_PERMISSIONS, "audit:read"];
@@ -0,0 +1,140 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type {
+  BlockExtendRequest,
+  BlockExtendResponse,
+  BlockListResponse,
+  ErrorResponse
+} from '../schemas';
+
+import { apiFetch } from '../../fetch';
+
+/**
+ * List the block device tree with filesystem types and UUIDs, and the LVM physical volumes, volume groups and logical volumes on the target node.
+
+ * @summary List block devices
+ */
+export type getNodeBlockResponse200 = {
+  data: BlockListResponse
+  status: 200
+}
+
+export type getNodeBlockResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeBlockResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeBlockResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeBlockResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeBlockResponseSuccess = (getNodeBlockResponse200) & {
+  headers: Headers;
+};
+export type getNodeBlockResponseError = (getNodeBlockResponse400 | getNodeBlockResponse401 | getNodeBlockResponse403 | getNodeBlockResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeBlockResponse = (getNodeBlockResponseSuccess | getNodeBlockResponseError)
+
+export const getGetNodeBlockUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/block`
+}
+
+export const getNodeBlock = async (hostname: string, options?: RequestInit): Promise<getNodeBlockResponse> => {
+
+  return apiFetch<getNodeBlockResponse>(getGetNodeBlockUrl(hostname),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Grow an LVM logical volume on the target node, optionally growing the filesystem on it in the same step. Reports changed false when the volume already has the requested size.
+
+ * @summary Extend a logical volume
+ */
+export type postNodeBlockExtendResponse200 = {
+  data: BlockExtendResponse
+  status: 200
+}
+
+export type postNodeBlockExtendResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type postNodeBlockExtendResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type postNodeBlockExtendResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type postNodeBlockExtendResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type postNodeBlockExtendResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type postNodeBlockExtendResponseSuccess = (postNodeBlockExtendResponse200) & {
+  headers: Headers;
+};
+export type postNodeBlockExtendResponseError = (postNodeBlockExtendResponse400 | postNodeBlockExtendResponse401 | postNodeBlockExtendResponse403 | postNodeBlockExtendResponse404 | postNodeBlockExtendResponse500) & {
+  headers: Headers;
+};
+
+export type postNodeBlockExtendResponse = (postNodeBlockExtendResponseSuccess | postNodeBlockExtendResponseError)
+
+export const getPostNodeBlockExtendUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/block/extend`
+}
+
+export const postNodeBlockExtend = async (hostname: string,
+    blockExtendRequest: BlockExtendRequest, options?: RequestInit): Promise<postNodeBlockExtendResponse> => {
+
+  return apiFetch<postNodeBlockExtendResponse>(getPostNodeBlockExtendUrl(hostname),
+  {
+    ...options,
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      blockExtendRequest,)
+  }
+);}
+
+
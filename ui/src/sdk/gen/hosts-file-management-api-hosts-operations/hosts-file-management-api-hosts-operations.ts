@@ -0,0 +1,332 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type {
+  ErrorResponse,
+  HostEntryCreateRequest,
+  HostEntryGetResponse,
+  HostEntryListResponse,
+  HostEntryMutationResponse,
+  HostEntryUpdateRequest
+} from '../schemas';
+
+import { apiFetch } from '../../fetch';
+
+/**
+ * List every entry in /etc/hosts on the target node. Entries inside the osapi-managed block are flagged as managed; all other lines are reported read-only. Entries whose hostnames conflict with another address, and a 127.0.1.1 entry that no longer names the system hostname, are reported as drifted.
+
+ * @summary List hosts entries
+ */
+export type getNodeHostsResponse200 = {
+  data: HostEntryListResponse
+  status: 200
+}
+
+export type getNodeHostsResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeHostsResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeHostsResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeHostsResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeHostsResponseSuccess = (getNodeHostsResponse200) & {
+  headers: Headers;
+};
+export type getNodeHostsResponseError = (getNodeHostsResponse400 | getNodeHostsResponse401 | getNodeHostsResponse403 | getNodeHostsResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeHostsResponse = (getNodeHostsResponseSuccess | getNodeHostsResponseError)
+
+export const getGetNodeHostsUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/hosts`
+}
+
+export const getNodeHosts = async (hostname: string, options?: RequestInit): Promise<getNodeHostsResponse> => {
+
+  return apiFetch<getNodeHostsResponse>(getGetNodeHostsUrl(hostname),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Add an entry to the osapi-managed block of /etc/hosts on the target node. Lines outside the managed block are preserved as-is.
+
+ * @summary Create a managed hosts entry
+ */
+export type postNodeHostsResponse200 = {
+  data: HostEntryMutationResponse
+  status: 200
+}
+
+export type postNodeHostsResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type postNodeHostsResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type postNodeHostsResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type postNodeHostsResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type postNodeHostsResponseSuccess = (postNodeHostsResponse200) & {
+  headers: Headers;
+};
+export type postNodeHostsResponseError = (postNodeHostsResponse400 | postNodeHostsResponse401 | postNodeHostsResponse403 | postNodeHostsResponse500) & {
+  headers: Headers;
+};
+
+export type postNodeHostsResponse = (postNodeHostsResponseSuccess | postNodeHostsResponseError)
+
+export const getPostNodeHostsUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/hosts`
+}
+
+export const postNodeHosts = async (hostname: string,
+    hostEntryCreateRequest: HostEntryCreateRequest, options?: RequestInit): Promise<postNodeHostsResponse> => {
+
+  return apiFetch<postNodeHostsResponse>(getPostNodeHostsUrl(hostname),
+  {
+    ...options,
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      hostEntryCreateRequest,)
+  }
+);}
+
+
+/**
+ * Get the entry for a single IP address in /etc/hosts on the target node. A managed entry takes precedence over an unmanaged line for the same address.
+
+ * @summary Get a hosts entry
+ */
+export type getNodeHostsByAddressResponse200 = {
+  data: HostEntryGetResponse
+  status: 200
+}
+
+export type getNodeHostsByAddressResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeHostsByAddressResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeHostsByAddressResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeHostsByAddressResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type getNodeHostsByAddressResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeHostsByAddressResponseSuccess = (getNodeHostsByAddressResponse200) & {
+  headers: Headers;
+};
+export type getNodeHostsByAddressResponseError = (getNodeHostsByAddressResponse400 | getNodeHostsByAddressResponse401 | getNodeHostsByAddressResponse403 | getNodeHostsByAddressResponse404 | getNodeHostsByAddressResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeHostsByAddressResponse = (getNodeHostsByAddressResponseSuccess | getNodeHostsByAddressResponseError)
+
+export const getGetNodeHostsByAddressUrl = (hostname: string,
+    address: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/hosts/${address}`
+}
+
+export const getNodeHostsByAddress = async (hostname: string,
+    address: string, options?: RequestInit): Promise<getNodeHostsByAddressResponse> => {
+
+  return apiFetch<getNodeHostsByAddressResponse>(getGetNodeHostsByAddressUrl(hostname,address),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Replace the hostnames of a managed /etc/hosts entry on the target node. Unmanaged lines cannot be updated.
+
+ * @summary Update a managed hosts entry
+ */
+export type putNodeHostsResponse200 = {
+  data: HostEntryMutationResponse
+  status: 200
+}
+
+export type putNodeHostsResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type putNodeHostsResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type putNodeHostsResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type putNodeHostsResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type putNodeHostsResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type putNodeHostsResponseSuccess = (putNodeHostsResponse200) & {
+  headers: Headers;
+};
+export type putNodeHostsResponseError = (putNodeHostsResponse400 | putNodeHostsResponse401 | putNodeHostsResponse403 | putNodeHostsResponse404 | putNodeHostsResponse500) & {
+  headers: Headers;
+};
+
+export type putNodeHostsResponse = (putNodeHostsResponseSuccess | putNodeHostsResponseError)
+
+export const getPutNodeHostsUrl = (hostname: string,
+    address: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/hosts/${address}`
+}
+
+export const putNodeHosts = async (hostname: string,
+    address: string,
+    hostEntryUpdateRequest: HostEntryUpdateRequest, options?: RequestInit): Promise<putNodeHostsResponse> => {
+
+  return apiFetch<putNodeHostsResponse>(getPutNodeHostsUrl(hostname,address),
+  {
+    ...options,
+    method: 'PUT',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      hostEntryUpdateRequest,)
+  }
+);}
+
+
+/**
+ * Remove an entry from the osapi-managed block of /etc/hosts on the target node. Unmanaged lines are never removed.
+
+ * @summary Delete a managed hosts entry
+ */
+export type deleteNodeHostsResponse200 = {
+  data: HostEntryMutationResponse
+  status: 200
+}
+
+export type deleteNodeHostsResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type deleteNodeHostsResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type deleteNodeHostsResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type deleteNodeHostsResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type deleteNodeHostsResponseSuccess = (deleteNodeHostsResponse200) & {
+  headers: Headers;
+};
+export type deleteNodeHostsResponseError = (deleteNodeHostsResponse400 | deleteNodeHostsResponse401 | deleteNodeHostsResponse403 | deleteNodeHostsResponse500) & {
+  headers: Headers;
+};
+
+export type deleteNodeHostsResponse = (deleteNodeHostsResponseSuccess | deleteNodeHostsResponseError)
+
+export const getDeleteNodeHostsUrl = (hostname: string,
+    address: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/hosts/${address}`
+}
+
+export const deleteNodeHosts = async (hostname: string,
+    address: string, options?: RequestInit): Promise<deleteNodeHostsResponse> => {
+
+  return apiFetch<deleteNodeHostsResponse>(getDeleteNodeHostsUrl(hostname,address),
+  {
+    ...options,
+    method: 'DELETE'
+
+
+  }
+);}
+
+
@@ -0,0 +1,332 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type {
+  ErrorResponse,
+  KernelModuleCreateRequest,
+  KernelModuleGetResponse,
+  KernelModuleListResponse,
+  KernelModuleMutationResponse,
+  KernelModuleUpdateRequest
+} from '../schemas';
+
+import { apiFetch } from '../../fetch';
+
+/**
+ * List loaded kernel modules from /proc/modules alongside the osapi-managed modules-load.d and modprobe.d entries on the target node. Managed modules whose runtime state disagrees with their configuration are reported as drifted.
+
+ * @summary List kernel modules
+ */
+export type getNodeKernelModuleResponse200 = {
+  data: KernelModuleListResponse
+  status: 200
+}
+
+export type getNodeKernelModuleResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeKernelModuleResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeKernelModuleResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeKernelModuleResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeKernelModuleResponseSuccess = (getNodeKernelModuleResponse200) & {
+  headers: Headers;
+};
+export type getNodeKernelModuleResponseError = (getNodeKernelModuleResponse400 | getNodeKernelModuleResponse401 | getNodeKernelModuleResponse403 | getNodeKernelModuleResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeKernelModuleResponse = (getNodeKernelModuleResponseSuccess | getNodeKernelModuleResponseError)
+
+export const getGetNodeKernelModuleUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/kernel/module`
+}
+
+export const getNodeKernelModule = async (hostname: string, options?: RequestInit): Promise<getNodeKernelModuleResponse> => {
+
+  return apiFetch<getNodeKernelModuleResponse>(getGetNodeKernelModuleUrl(hostname),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Configure a kernel module on the target node. Persistent load entries are written to /etc/modules-load.d and options or blacklist entries to /etc/modprobe.d, then applied at runtime with modprobe.
+
+ * @summary Configure a kernel module
+ */
+export type postNodeKernelModuleResponse200 = {
+  data: KernelModuleMutationResponse
+  status: 200
+}
+
+export type postNodeKernelModuleResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type postNodeKernelModuleResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type postNodeKernelModuleResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type postNodeKernelModuleResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type postNodeKernelModuleResponseSuccess = (postNodeKernelModuleResponse200) & {
+  headers: Headers;
+};
+export type postNodeKernelModuleResponseError = (postNodeKernelModuleResponse400 | postNodeKernelModuleResponse401 | postNodeKernelModuleResponse403 | postNodeKernelModuleResponse500) & {
+  headers: Headers;
+};
+
+export type postNodeKernelModuleResponse = (postNodeKernelModuleResponseSuccess | postNodeKernelModuleResponseError)
+
+export const getPostNodeKernelModuleUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/kernel/module`
+}
+
+export const postNodeKernelModule = async (hostname: string,
+    kernelModuleCreateRequest: KernelModuleCreateRequest, options?: RequestInit): Promise<postNodeKernelModuleResponse> => {
+
+  return apiFetch<postNodeKernelModuleResponse>(getPostNodeKernelModuleUrl(hostname),
+  {
+    ...options,
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      kernelModuleCreateRequest,)
+  }
+);}
+
+
+/**
+ * Get a loaded or managed kernel module, including its runtime state and managed configuration, on the target node.
+
+ * @summary Get kernel module details
+ */
+export type getNodeKernelModuleByNameResponse200 = {
+  data: KernelModuleGetResponse
+  status: 200
+}
+
+export type getNodeKernelModuleByNameResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeKernelModuleByNameResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeKernelModuleByNameResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeKernelModuleByNameResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type getNodeKernelModuleByNameResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeKernelModuleByNameResponseSuccess = (getNodeKernelModuleByNameResponse200) & {
+  headers: Headers;
+};
+export type getNodeKernelModuleByNameResponseError = (getNodeKernelModuleByNameResponse400 | getNodeKernelModuleByNameResponse401 | getNodeKernelModuleByNameResponse403 | getNodeKernelModuleByNameResponse404 | getNodeKernelModuleByNameResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeKernelModuleByNameResponse = (getNodeKernelModuleByNameResponseSuccess | getNodeKernelModuleByNameResponseError)
+
+export const getGetNodeKernelModuleByNameUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/kernel/module/${name}`
+}
+
+export const getNodeKernelModuleByName = async (hostname: string,
+    name: string, options?: RequestInit): Promise<getNodeKernelModuleByNameResponse> => {
+
+  return apiFetch<getNodeKernelModuleByNameResponse>(getGetNodeKernelModuleByNameUrl(hostname,name),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Replace the configuration of a managed kernel module on the target node and apply it at runtime. Entries that are no longer needed are removed.
+
+ * @summary Update a managed kernel module
+ */
+export type putNodeKernelModuleResponse200 = {
+  data: KernelModuleMutationResponse
+  status: 200
+}
+
+export type putNodeKernelModuleResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type putNodeKernelModuleResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type putNodeKernelModuleResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type putNodeKernelModuleResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type putNodeKernelModuleResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type putNodeKernelModuleResponseSuccess = (putNodeKernelModuleResponse200) & {
+  headers: Headers;
+};
+export type putNodeKernelModuleResponseError = (putNodeKernelModuleResponse400 | putNodeKernelModuleResponse401 | putNodeKernelModuleResponse403 | putNodeKernelModuleResponse404 | putNodeKernelModuleResponse500) & {
+  headers: Headers;
+};
+
+export type putNodeKernelModuleResponse = (putNodeKernelModuleResponseSuccess | putNodeKernelModuleResponseError)
+
+export const getPutNodeKernelModuleUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/kernel/module/${name}`
+}
+
+export const putNodeKernelModule = async (hostname: string,
+    name: string,
+    kernelModuleUpdateRequest: KernelModuleUpdateRequest, options?: RequestInit): Promise<putNodeKernelModuleResponse> => {
+
+  return apiFetch<putNodeKernelModuleResponse>(getPutNodeKernelModuleUrl(hostname,name),
+  {
+    ...options,
+    method: 'PUT',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      kernelModuleUpdateRequest,)
+  }
+);}
+
+
+/**
+ * Remove the managed modules-load.d and modprobe.d entries of a kernel module from the target node. The module is neither loaded nor unloaded.
+
+ * @summary Delete a managed kernel module
+ */
+export type deleteNodeKernelModuleResponse200 = {
+  data: KernelModuleMutationResponse
+  status: 200
+}
+
+export type deleteNodeKernelModuleResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type deleteNodeKernelModuleResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type deleteNodeKernelModuleResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type deleteNodeKernelModuleResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type deleteNodeKernelModuleResponseSuccess = (deleteNodeKernelModuleResponse200) & {
+  headers: Headers;
+};
+export type deleteNodeKernelModuleResponseError = (deleteNodeKernelModuleResponse400 | deleteNodeKernelModuleResponse401 | deleteNodeKernelModuleResponse403 | deleteNodeKernelModuleResponse500) & {
+  headers: Headers;
+};
+
+export type deleteNodeKernelModuleResponse = (deleteNodeKernelModuleResponseSuccess | deleteNodeKernelModuleResponseError)
+
+export const getDeleteNodeKernelModuleUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/kernel/module/${name}`
+}
+
+export const deleteNodeKernelModule = async (hostname: string,
+    name: string, options?: RequestInit): Promise<deleteNodeKernelModuleResponse> => {
+
+  return apiFetch<deleteNodeKernelModuleResponse>(getDeleteNodeKernelModuleUrl(hostname,name),
+  {
+    ...options,
+    method: 'DELETE'
+
+
+  }
+);}
+
+
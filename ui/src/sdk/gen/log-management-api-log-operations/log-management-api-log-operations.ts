@@ -6,7 +6,9 @@
  */
 import type {
   ErrorResponse,
+  GetNodeLogFileParams,
   GetNodeLogParams,
+  GetNodeLogUnitFollowParams,
   GetNodeLogUnitParams,
   LogCollectionResponse,
   LogSourceCollectionResponse
@@ -58,6 +60,14 @@ export const getGetNodeLogUrl = (hostname: string,
   const normalizedParams = new URLSearchParams();
 
   Object.entries(params || {}).forEach(([key, value]) => {
+    const explodeParameters = ["field"];
+
+    if (Array.isArray(value) && explodeParameters.includes(key)) {
+      value.forEach((v) => {
+        normalizedParams.append(key, v === null ? 'null' : v.toString());
+      });
+      return;
+    }
 
     if (value !== undefined) {
       normalizedParams.append(key, value === null ? 'null' : value.toString())
@@ -82,6 +92,74 @@ export const getNodeLog = async (hostname: string,
 );}
 
 
+/**
+ * Retrieve the last entries of an allow-listed plain log file under /var/log on the target node, for services that do not log to the journal. Syslog-formatted lines are parsed into timestamp, unit, PID, and hostname; other lines are returned as the message.
+
+ * @summary Get entries from a plain log file
+ */
+export type getNodeLogFileResponse200 = {
+  data: LogCollectionResponse
+  status: 200
+}
+
+export type getNodeLogFileResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeLogFileResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeLogFileResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeLogFileResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeLogFileResponseSuccess = (getNodeLogFileResponse200) & {
+  headers: Headers;
+};
+export type getNodeLogFileResponseError = (getNodeLogFileResponse400 | getNodeLogFileResponse401 | getNodeLogFileResponse403 | getNodeLogFileResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeLogFileResponse = (getNodeLogFileResponseSuccess | getNodeLogFileResponseError)
+
+export const getGetNodeLogFileUrl = (hostname: string,
+    params: GetNodeLogFileParams,) => {
+  const normalizedParams = new URLSearchParams();
+
+  Object.entries(params || {}).forEach(([key, value]) => {
+
+    if (value !== undefined) {
+      normalizedParams.append(key, value === null ? 'null' : value.toString())
+    }
+  });
+
+  const stringifiedParams = normalizedParams.toString();
+
+  return stringifiedParams.length > 0 ? `/api/node/${hostname}/log/file?${stringifiedParams}` : `/api/node/${hostname}/log/file`
+}
+
+export const getNodeLogFile = async (hostname: string,
+    params: GetNodeLogFileParams, options?: RequestInit): Promise<getNodeLogFileResponse> => {
+
+  return apiFetch<getNodeLogFileResponse>(getGetNodeLogFileUrl(hostname,params),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
 /**
  * List unique syslog identifiers (log sources) available in the journal on the target node.
 
@@ -186,6 +264,14 @@ export const getGetNodeLogUnitUrl = (hostname: string,
   const normalizedParams = new URLSearchParams();
 
   Object.entries(params || {}).forEach(([key, value]) => {
+    const explodeParameters = ["field"];
+
+    if (Array.isArray(value) && explodeParameters.includes(key)) {
+      value.forEach((v) => {
+        normalizedParams.append(key, v === null ? 'null' : v.toString());
+      });
+      return;
+    }
 
     if (value !== undefined) {
       normalizedParams.append(key, value === null ? 'null' : value.toString())
@@ -211,3 +297,73 @@ export const getNodeLogUnit = async (hostname: string,
 );}
 
 
+/**
+ * Stream new log entries for a specific systemd unit on the target node as Server-Sent Events for a bounded duration. The stream opens with a `start` event whose LogCollectionResponse payload carries the job ID and the agent's status, relays each journal entry as an `entry` event with a LogEntryInfo payload, and closes with an `end` event. Broadcast targets are not supported.
+
+ * @summary Follow log entries for a systemd unit
+ */
+export type getNodeLogUnitFollowResponse200 = {
+  data: string
+  status: 200
+}
+
+export type getNodeLogUnitFollowResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeLogUnitFollowResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeLogUnitFollowResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeLogUnitFollowResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeLogUnitFollowResponseSuccess = (getNodeLogUnitFollowResponse200) & {
+  headers: Headers;
+};
+export type getNodeLogUnitFollowResponseError = (getNodeLogUnitFollowResponse400 | getNodeLogUnitFollowResponse401 | getNodeLogUnitFollowResponse403 | getNodeLogUnitFollowResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeLogUnitFollowResponse = (getNodeLogUnitFollowResponseSuccess | getNodeLogUnitFollowResponseError)
+
+export const getGetNodeLogUnitFollowUrl = (hostname: string,
+    name: string,
+    params?: GetNodeLogUnitFollowParams,) => {
+  const normalizedParams = new URLSearchParams();
+
+  Object.entries(params || {}).forEach(([key, value]) => {
+
+    if (value !== undefined) {
+      normalizedParams.append(key, value === null ? 'null' : value.toString())
+    }
+  });
+
+  const stringifiedParams = normalizedParams.toString();
+
+  return stringifiedParams.length > 0 ? `/api/node/${hostname}/log/unit/${name}/follow?${stringifiedParams}` : `/api/node/${hostname}/log/unit/${name}/follow`
+}
+
+export const getNodeLogUnitFollow = async (hostname: string,
+    name: string,
+    params?: GetNodeLogUnitFollowParams, options?: RequestInit): Promise<getNodeLogUnitFollowResponse> => {
+
+  return apiFetch<getNodeLogUnitFollowResponse>(getGetNodeLogUnitFollowUrl(hostname,name,params),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
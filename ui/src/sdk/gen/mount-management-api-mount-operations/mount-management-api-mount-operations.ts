@@ -0,0 +1,454 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type {
+  ErrorResponse,
+  MountCreateRequest,
+  MountGetResponse,
+  MountListResponse,
+  MountMutationResponse,
+  MountUpdateRequest
+} from '../schemas';
+
+import { apiFetch } from '../../fetch';
+
+/**
+ * List current mounts from /proc/self/mounts alongside the osapi-managed /etc/fstab entries on the target node.
+
+ * @summary List mounts
+ */
+export type getNodeMountResponse200 = {
+  data: MountListResponse
+  status: 200
+}
+
+export type getNodeMountResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeMountResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeMountResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeMountResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeMountResponseSuccess = (getNodeMountResponse200) & {
+  headers: Headers;
+};
+export type getNodeMountResponseError = (getNodeMountResponse400 | getNodeMountResponse401 | getNodeMountResponse403 | getNodeMountResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeMountResponse = (getNodeMountResponseSuccess | getNodeMountResponseError)
+
+export const getGetNodeMountUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/mount`
+}
+
+export const getNodeMount = async (hostname: string, options?: RequestInit): Promise<getNodeMountResponse> => {
+
+  return apiFetch<getNodeMountResponse>(getGetNodeMountUrl(hostname),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Add a managed /etc/fstab entry on the target node. The filesystem is not mounted until the mount action is called.
+
+ * @summary Create a managed fstab entry
+ */
+export type postNodeMountResponse200 = {
+  data: MountMutationResponse
+  status: 200
+}
+
+export type postNodeMountResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type postNodeMountResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type postNodeMountResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type postNodeMountResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type postNodeMountResponseSuccess = (postNodeMountResponse200) & {
+  headers: Headers;
+};
+export type postNodeMountResponseError = (postNodeMountResponse400 | postNodeMountResponse401 | postNodeMountResponse403 | postNodeMountResponse500) & {
+  headers: Headers;
+};
+
+export type postNodeMountResponse = (postNodeMountResponseSuccess | postNodeMountResponseError)
+
+export const getPostNodeMountUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/mount`
+}
+
+export const postNodeMount = async (hostname: string,
+    mountCreateRequest: MountCreateRequest, options?: RequestInit): Promise<postNodeMountResponse> => {
+
+  return apiFetch<postNodeMountResponse>(getPostNodeMountUrl(hostname),
+  {
+    ...options,
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      mountCreateRequest,)
+  }
+);}
+
+
+/**
+ * Get a managed mount entry, including whether it is mounted and the options in effect, on the target node.
+
+ * @summary Get mount details
+ */
+export type getNodeMountByNameResponse200 = {
+  data: MountGetResponse
+  status: 200
+}
+
+export type getNodeMountByNameResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeMountByNameResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeMountByNameResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeMountByNameResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type getNodeMountByNameResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeMountByNameResponseSuccess = (getNodeMountByNameResponse200) & {
+  headers: Headers;
+};
+export type getNodeMountByNameResponseError = (getNodeMountByNameResponse400 | getNodeMountByNameResponse401 | getNodeMountByNameResponse403 | getNodeMountByNameResponse404 | getNodeMountByNameResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeMountByNameResponse = (getNodeMountByNameResponseSuccess | getNodeMountByNameResponseError)
+
+export const getGetNodeMountByNameUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/mount/${name}`
+}
+
+export const getNodeMountByName = async (hostname: string,
+    name: string, options?: RequestInit): Promise<getNodeMountByNameResponse> => {
+
+  return apiFetch<getNodeMountByNameResponse>(getGetNodeMountByNameUrl(hostname,name),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Update an existing managed /etc/fstab entry on the target node. Omitted fields keep their current values.
+
+ * @summary Update a managed fstab entry
+ */
+export type putNodeMountResponse200 = {
+  data: MountMutationResponse
+  status: 200
+}
+
+export type putNodeMountResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type putNodeMountResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type putNodeMountResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type putNodeMountResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type putNodeMountResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type putNodeMountResponseSuccess = (putNodeMountResponse200) & {
+  headers: Headers;
+};
+export type putNodeMountResponseError = (putNodeMountResponse400 | putNodeMountResponse401 | putNodeMountResponse403 | putNodeMountResponse404 | putNodeMountResponse500) & {
+  headers: Headers;
+};
+
+export type putNodeMountResponse = (putNodeMountResponseSuccess | putNodeMountResponseError)
+
+export const getPutNodeMountUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/mount/${name}`
+}
+
+export const putNodeMount = async (hostname: string,
+    name: string,
+    mountUpdateRequest: MountUpdateRequest, options?: RequestInit): Promise<putNodeMountResponse> => {
+
+  return apiFetch<putNodeMountResponse>(getPutNodeMountUrl(hostname,name),
+  {
+    ...options,
+    method: 'PUT',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      mountUpdateRequest,)
+  }
+);}
+
+
+/**
+ * Remove a managed /etc/fstab entry from the target node. The filesystem is not unmounted.
+
+ * @summary Delete a managed fstab entry
+ */
+export type deleteNodeMountResponse200 = {
+  data: MountMutationResponse
+  status: 200
+}
+
+export type deleteNodeMountResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type deleteNodeMountResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type deleteNodeMountResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type deleteNodeMountResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type deleteNodeMountResponseSuccess = (deleteNodeMountResponse200) & {
+  headers: Headers;
+};
+export type deleteNodeMountResponseError = (deleteNodeMountResponse400 | deleteNodeMountResponse401 | deleteNodeMountResponse403 | deleteNodeMountResponse500) & {
+  headers: Headers;
+};
+
+export type deleteNodeMountResponse = (deleteNodeMountResponseSuccess | deleteNodeMountResponseError)
+
+export const getDeleteNodeMountUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/mount/${name}`
+}
+
+export const deleteNodeMount = async (hostname: string,
+    name: string, options?: RequestInit): Promise<deleteNodeMountResponse> => {
+
+  return apiFetch<deleteNodeMountResponse>(getDeleteNodeMountUrl(hostname,name),
+  {
+    ...options,
+    method: 'DELETE'
+
+
+  }
+);}
+
+
+/**
+ * Mount the filesystem of a managed entry on the target node. Reports changed false when it is already mounted.
+
+ * @summary Mount a filesystem
+ */
+export type postNodeMountMountResponse200 = {
+  data: MountMutationResponse
+  status: 200
+}
+
+export type postNodeMountMountResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type postNodeMountMountResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type postNodeMountMountResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type postNodeMountMountResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type postNodeMountMountResponseSuccess = (postNodeMountMountResponse200) & {
+  headers: Headers;
+};
+export type postNodeMountMountResponseError = (postNodeMountMountResponse400 | postNodeMountMountResponse401 | postNodeMountMountResponse403 | postNodeMountMountResponse500) & {
+  headers: Headers;
+};
+
+export type postNodeMountMountResponse = (postNodeMountMountResponseSuccess | postNodeMountMountResponseError)
+
+export const getPostNodeMountMountUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/mount/${name}/mount`
+}
+
+export const postNodeMountMount = async (hostname: string,
+    name: string, options?: RequestInit): Promise<postNodeMountMountResponse> => {
+
+  return apiFetch<postNodeMountMountResponse>(getPostNodeMountMountUrl(hostname,name),
+  {
+    ...options,
+    method: 'POST'
+
+
+  }
+);}
+
+
+/**
+ * Unmount the filesystem of a managed entry on the target node. Reports changed false when it is not mounted.
+
+ * @summary Unmount a filesystem
+ */
+export type postNodeMountUnmountResponse200 = {
+  data: MountMutationResponse
+  status: 200
+}
+
+export type postNodeMountUnmountResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type postNodeMountUnmountResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type postNodeMountUnmountResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type postNodeMountUnmountResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type postNodeMountUnmountResponseSuccess = (postNodeMountUnmountResponse200) & {
+  headers: Headers;
+};
+export type postNodeMountUnmountResponseError = (postNodeMountUnmountResponse400 | postNodeMountUnmountResponse401 | postNodeMountUnmountResponse403 | postNodeMountUnmountResponse500) & {
+  headers: Headers;
+};
+
+export type postNodeMountUnmountResponse = (postNodeMountUnmountResponseSuccess | postNodeMountUnmountResponseError)
+
+export const getPostNodeMountUnmountUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/mount/${name}/unmount`
+}
+
+export const postNodeMountUnmount = async (hostname: string,
+    name: string, options?: RequestInit): Promise<postNodeMountUnmountResponse> => {
+
+  return apiFetch<postNodeMountUnmountResponse>(getPostNodeMountUnmountUrl(hostname,name),
+  {
+    ...options,
+    method: 'POST'
+
+
+  }
+);}
+
+
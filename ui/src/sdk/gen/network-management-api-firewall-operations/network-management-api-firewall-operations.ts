@@ -0,0 +1,332 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type {
+  ErrorResponse,
+  FirewallCollectionResponse,
+  FirewallCreateRequest,
+  FirewallGetResponse,
+  FirewallMutationResponse,
+  FirewallUpdateRequest
+} from '../schemas';
+
+import { apiFetch } from '../../fetch';
+
+/**
+ * List all managed nftables rule sets on the target node.
+
+ * @summary List firewall rule sets
+ */
+export type getNodeNetworkFirewallResponse200 = {
+  data: FirewallCollectionResponse
+  status: 200
+}
+
+export type getNodeNetworkFirewallResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeNetworkFirewallResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeNetworkFirewallResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeNetworkFirewallResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeNetworkFirewallResponseSuccess = (getNodeNetworkFirewallResponse200) & {
+  headers: Headers;
+};
+export type getNodeNetworkFirewallResponseError = (getNodeNetworkFirewallResponse400 | getNodeNetworkFirewallResponse401 | getNodeNetworkFirewallResponse403 | getNodeNetworkFirewallResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeNetworkFirewallResponse = (getNodeNetworkFirewallResponseSuccess | getNodeNetworkFirewallResponseError)
+
+export const getGetNodeNetworkFirewallUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/network/firewall`
+}
+
+export const getNodeNetworkFirewall = async (hostname: string, options?: RequestInit): Promise<getNodeNetworkFirewallResponse> => {
+
+  return apiFetch<getNodeNetworkFirewallResponse>(getGetNodeNetworkFirewallUrl(hostname),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Deploy a new nftables rule set to /etc/nftables.d/ on the target node. The rule set is validated with `nft -c` before it is loaded.
+
+ * @summary Create a firewall rule set
+ */
+export type postNodeNetworkFirewallResponse200 = {
+  data: FirewallMutationResponse
+  status: 200
+}
+
+export type postNodeNetworkFirewallResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type postNodeNetworkFirewallResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type postNodeNetworkFirewallResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type postNodeNetworkFirewallResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type postNodeNetworkFirewallResponseSuccess = (postNodeNetworkFirewallResponse200) & {
+  headers: Headers;
+};
+export type postNodeNetworkFirewallResponseError = (postNodeNetworkFirewallResponse400 | postNodeNetworkFirewallResponse401 | postNodeNetworkFirewallResponse403 | postNodeNetworkFirewallResponse500) & {
+  headers: Headers;
+};
+
+export type postNodeNetworkFirewallResponse = (postNodeNetworkFirewallResponseSuccess | postNodeNetworkFirewallResponseError)
+
+export const getPostNodeNetworkFirewallUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/network/firewall`
+}
+
+export const postNodeNetworkFirewall = async (hostname: string,
+    firewallCreateRequest: FirewallCreateRequest, options?: RequestInit): Promise<postNodeNetworkFirewallResponse> => {
+
+  return apiFetch<postNodeNetworkFirewallResponse>(getPostNodeNetworkFirewallUrl(hostname),
+  {
+    ...options,
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      firewallCreateRequest,)
+  }
+);}
+
+
+/**
+ * Get a specific managed nftables rule set by name on the target node.
+
+ * @summary Get a firewall rule set
+ */
+export type getNodeNetworkFirewallByNameResponse200 = {
+  data: FirewallGetResponse
+  status: 200
+}
+
+export type getNodeNetworkFirewallByNameResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeNetworkFirewallByNameResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeNetworkFirewallByNameResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeNetworkFirewallByNameResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type getNodeNetworkFirewallByNameResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeNetworkFirewallByNameResponseSuccess = (getNodeNetworkFirewallByNameResponse200) & {
+  headers: Headers;
+};
+export type getNodeNetworkFirewallByNameResponseError = (getNodeNetworkFirewallByNameResponse400 | getNodeNetworkFirewallByNameResponse401 | getNodeNetworkFirewallByNameResponse403 | getNodeNetworkFirewallByNameResponse404 | getNodeNetworkFirewallByNameResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeNetworkFirewallByNameResponse = (getNodeNetworkFirewallByNameResponseSuccess | getNodeNetworkFirewallByNameResponseError)
+
+export const getGetNodeNetworkFirewallByNameUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/network/firewall/${name}`
+}
+
+export const getNodeNetworkFirewallByName = async (hostname: string,
+    name: string, options?: RequestInit): Promise<getNodeNetworkFirewallByNameResponse> => {
+
+  return apiFetch<getNodeNetworkFirewallByNameResponse>(getGetNodeNetworkFirewallByNameUrl(hostname,name),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Update an existing nftables rule set on the target node. The new content is validated with `nft -c` before it replaces the deployed rule set.
+
+ * @summary Update a firewall rule set
+ */
+export type putNodeNetworkFirewallResponse200 = {
+  data: FirewallMutationResponse
+  status: 200
+}
+
+export type putNodeNetworkFirewallResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type putNodeNetworkFirewallResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type putNodeNetworkFirewallResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type putNodeNetworkFirewallResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type putNodeNetworkFirewallResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type putNodeNetworkFirewallResponseSuccess = (putNodeNetworkFirewallResponse200) & {
+  headers: Headers;
+};
+export type putNodeNetworkFirewallResponseError = (putNodeNetworkFirewallResponse400 | putNodeNetworkFirewallResponse401 | putNodeNetworkFirewallResponse403 | putNodeNetworkFirewallResponse404 | putNodeNetworkFirewallResponse500) & {
+  headers: Headers;
+};
+
+export type putNodeNetworkFirewallResponse = (putNodeNetworkFirewallResponseSuccess | putNodeNetworkFirewallResponseError)
+
+export const getPutNodeNetworkFirewallUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/network/firewall/${name}`
+}
+
+export const putNodeNetworkFirewall = async (hostname: string,
+    name: string,
+    firewallUpdateRequest: FirewallUpdateRequest, options?: RequestInit): Promise<putNodeNetworkFirewallResponse> => {
+
+  return apiFetch<putNodeNetworkFirewallResponse>(getPutNodeNetworkFirewallUrl(hostname,name),
+  {
+    ...options,
+    method: 'PUT',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      firewallUpdateRequest,)
+  }
+);}
+
+
+/**
+ * Remove a managed nftables rule set from the target node and reload the ruleset.
+
+ * @summary Delete a firewall rule set
+ */
+export type deleteNodeNetworkFirewallResponse200 = {
+  data: FirewallMutationResponse
+  status: 200
+}
+
+export type deleteNodeNetworkFirewallResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type deleteNodeNetworkFirewallResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type deleteNodeNetworkFirewallResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type deleteNodeNetworkFirewallResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type deleteNodeNetworkFirewallResponseSuccess = (deleteNodeNetworkFirewallResponse200) & {
+  headers: Headers;
+};
+export type deleteNodeNetworkFirewallResponseError = (deleteNodeNetworkFirewallResponse400 | deleteNodeNetworkFirewallResponse401 | deleteNodeNetworkFirewallResponse403 | deleteNodeNetworkFirewallResponse500) & {
+  headers: Headers;
+};
+
+export type deleteNodeNetworkFirewallResponse = (deleteNodeNetworkFirewallResponseSuccess | deleteNodeNetworkFirewallResponseError)
+
+export const getDeleteNodeNetworkFirewallUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/network/firewall/${name}`
+}
+
+export const deleteNodeNetworkFirewall = async (hostname: string,
+    name: string, options?: RequestInit): Promise<deleteNodeNetworkFirewallResponse> => {
+
+  return apiFetch<deleteNodeNetworkFirewallResponse>(getDeleteNodeNetworkFirewallUrl(hostname,name),
+  {
+    ...options,
+    method: 'DELETE'
+
+
+  }
+);}
+
+
@@ -6,6 +6,7 @@
  */
 import type {
   ErrorResponse,
+  NetworkConfirmCollectionResponse,
   PingCollectionResponse,
   PostNodeNetworkPingBody
 } from '../schemas';
@@ -73,3 +74,62 @@ export const postNodeNetworkPing = async (hostname: string,
 );}
 
 
+/**
+ * Confirm the Netplan change the agent is holding for confirmation when safe apply is enabled. Without a confirmation or a NATS reconnect before the configured timeout, the agent restores the previous Netplan files and re-applies them.
+
+ * @summary Confirm a pending network change
+ */
+export type postNodeNetworkConfirmResponse200 = {
+  data: NetworkConfirmCollectionResponse
+  status: 200
+}
+
+export type postNodeNetworkConfirmResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type postNodeNetworkConfirmResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type postNodeNetworkConfirmResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type postNodeNetworkConfirmResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type postNodeNetworkConfirmResponseSuccess = (postNodeNetworkConfirmResponse200) & {
+  headers: Headers;
+};
+export type postNodeNetworkConfirmResponseError = (postNodeNetworkConfirmResponse400 | postNodeNetworkConfirmResponse401 | postNodeNetworkConfirmResponse403 | postNodeNetworkConfirmResponse500) & {
+  headers: Headers;
+};
+
+export type postNodeNetworkConfirmResponse = (postNodeNetworkConfirmResponseSuccess | postNodeNetworkConfirmResponseError)
+
+export const getPostNodeNetworkConfirmUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/network/confirm`
+}
+
+export const postNodeNetworkConfirm = async (hostname: string, options?: RequestInit): Promise<postNodeNetworkConfirmResponse> => {
+
+  return apiFetch<postNodeNetworkConfirmResponse>(getPostNodeNetworkConfirmUrl(hostname),
+  {
+    ...options,
+    method: 'POST'
+
+
+  }
+);}
+
+
@@ -0,0 +1,82 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type {
+  ErrorResponse,
+  GetNodeNetworkSocketParams,
+  SocketCollectionResponse
+} from '../schemas';
+
+import { apiFetch } from '../../fetch';
+
+/**
+ * List TCP and UDP listening sockets and established connections on the target node, with the process that owns each socket.
+
+ * @summary List sockets
+ */
+export type getNodeNetworkSocketResponse200 = {
+  data: SocketCollectionResponse
+  status: 200
+}
+
+export type getNodeNetworkSocketResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeNetworkSocketResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeNetworkSocketResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeNetworkSocketResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeNetworkSocketResponseSuccess = (getNodeNetworkSocketResponse200) & {
+  headers: Headers;
+};
+export type getNodeNetworkSocketResponseError = (getNodeNetworkSocketResponse400 | getNodeNetworkSocketResponse401 | getNodeNetworkSocketResponse403 | getNodeNetworkSocketResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeNetworkSocketResponse = (getNodeNetworkSocketResponseSuccess | getNodeNetworkSocketResponseError)
+
+export const getGetNodeNetworkSocketUrl = (hostname: string,
+    params?: GetNodeNetworkSocketParams,) => {
+  const normalizedParams = new URLSearchParams();
+
+  Object.entries(params || {}).forEach(([key, value]) => {
+
+    if (value !== undefined) {
+      normalizedParams.append(key, value === null ? 'null' : value.toString())
+    }
+  });
+
+  const stringifiedParams = normalizedParams.toString();
+
+  return stringifiedParams.length > 0 ? `/api/node/${hostname}/network/socket?${stringifiedParams}` : `/api/node/${hostname}/network/socket`
+}
+
+export const getNodeNetworkSocket = async (hostname: string,
+    params?: GetNodeNetworkSocketParams, options?: RequestInit): Promise<getNodeNetworkSocketResponse> => {
+
+  return apiFetch<getNodeNetworkSocketResponse>(getGetNodeNetworkSocketUrl(hostname,params),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
@@ -7,8 +7,14 @@
 import type {
   ErrorResponse,
   PackageCollectionResponse,
+  PackageEnsureRequest,
+  PackageEnsureResponse,
   PackageInstallRequest,
   PackageMutationResponse,
+  PackageRepoCollectionResponse,
+  PackageRepoCreateRequest,
+  PackageRepoMutationResponse,
+  PackageRepoUpdateRequest,
   UpdateCollectionResponse
 } from '../schemas';
 
@@ -266,6 +272,138 @@ export const deleteNodePackage = async (hostname: string,
 );}
 
 
+/**
+ * Hold a package at its installed version so it is not upgraded (apt-mark hold, dnf versionlock).
+
+ * @summary Hold a package
+ */
+export type postNodePackageHoldResponse200 = {
+  data: PackageMutationResponse
+  status: 200
+}
+
+export type postNodePackageHoldResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type postNodePackageHoldResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type postNodePackageHoldResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type postNodePackageHoldResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type postNodePackageHoldResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type postNodePackageHoldResponseSuccess = (postNodePackageHoldResponse200) & {
+  headers: Headers;
+};
+export type postNodePackageHoldResponseError = (postNodePackageHoldResponse400 | postNodePackageHoldResponse401 | postNodePackageHoldResponse403 | postNodePackageHoldResponse404 | postNodePackageHoldResponse500) & {
+  headers: Headers;
+};
+
+export type postNodePackageHoldResponse = (postNodePackageHoldResponseSuccess | postNodePackageHoldResponseError)
+
+export const getPostNodePackageHoldUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/package/${name}/hold`
+}
+
+export const postNodePackageHold = async (hostname: string,
+    name: string, options?: RequestInit): Promise<postNodePackageHoldResponse> => {
+
+  return apiFetch<postNodePackageHoldResponse>(getPostNodePackageHoldUrl(hostname,name),
+  {
+    ...options,
+    method: 'POST'
+
+
+  }
+);}
+
+
+/**
+ * Release a hold on a package so it can be upgraded again.
+
+ * @summary Unhold a package
+ */
+export type deleteNodePackageHoldResponse200 = {
+  data: PackageMutationResponse
+  status: 200
+}
+
+export type deleteNodePackageHoldResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type deleteNodePackageHoldResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type deleteNodePackageHoldResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type deleteNodePackageHoldResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type deleteNodePackageHoldResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type deleteNodePackageHoldResponseSuccess = (deleteNodePackageHoldResponse200) & {
+  headers: Headers;
+};
+export type deleteNodePackageHoldResponseError = (deleteNodePackageHoldResponse400 | deleteNodePackageHoldResponse401 | deleteNodePackageHoldResponse403 | deleteNodePackageHoldResponse404 | deleteNodePackageHoldResponse500) & {
+  headers: Headers;
+};
+
+export type deleteNodePackageHoldResponse = (deleteNodePackageHoldResponseSuccess | deleteNodePackageHoldResponseError)
+
+export const getDeleteNodePackageHoldUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/package/${name}/hold`
+}
+
+export const deleteNodePackageHold = async (hostname: string,
+    name: string, options?: RequestInit): Promise<deleteNodePackageHoldResponse> => {
+
+  return apiFetch<deleteNodePackageHoldResponse>(getDeleteNodePackageHoldUrl(hostname,name),
+  {
+    ...options,
+    method: 'DELETE'
+
+
+  }
+);}
+
+
 /**
  * Refresh package sources (apt update) on the target node.
 
@@ -384,3 +522,379 @@ export const getNodePackageUpdate = async (hostname: string, options?: RequestIn
 );}
 
 
+/**
+ * Converge packages on the target node to a manifest of desired states. Each entry is present, absent, latest, or pinned to a version and held. Results report per-package changes.
+
+ * @summary Ensure package states
+ */
+export type postNodePackageEnsureResponse200 = {
+  data: PackageEnsureResponse
+  status: 200
+}
+
+export type postNodePackageEnsureResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type postNodePackageEnsureResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type postNodePackageEnsureResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type postNodePackageEnsureResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type postNodePackageEnsureResponseSuccess = (postNodePackageEnsureResponse200) & {
+  headers: Headers;
+};
+export type postNodePackageEnsureResponseError = (postNodePackageEnsureResponse400 | postNodePackageEnsureResponse401 | postNodePackageEnsureResponse403 | postNodePackageEnsureResponse500) & {
+  headers: Headers;
+};
+
+export type postNodePackageEnsureResponse = (postNodePackageEnsureResponseSuccess | postNodePackageEnsureResponseError)
+
+export const getPostNodePackageEnsureUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/package/ensure`
+}
+
+export const postNodePackageEnsure = async (hostname: string,
+    packageEnsureRequest: PackageEnsureRequest, options?: RequestInit): Promise<postNodePackageEnsureResponse> => {
+
+  return apiFetch<postNodePackageEnsureResponse>(getPostNodePackageEnsureUrl(hostname),
+  {
+    ...options,
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      packageEnsureRequest,)
+  }
+);}
+
+
+/**
+ * List osapi-managed package repositories on the target node.
+
+ * @summary List package repositories
+ */
+export type getNodePackageRepoResponse200 = {
+  data: PackageRepoCollectionResponse
+  status: 200
+}
+
+export type getNodePackageRepoResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodePackageRepoResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodePackageRepoResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodePackageRepoResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodePackageRepoResponseSuccess = (getNodePackageRepoResponse200) & {
+  headers: Headers;
+};
+export type getNodePackageRepoResponseError = (getNodePackageRepoResponse400 | getNodePackageRepoResponse401 | getNodePackageRepoResponse403 | getNodePackageRepoResponse500) & {
+  headers: Headers;
+};
+
+export type getNodePackageRepoResponse = (getNodePackageRepoResponseSuccess | getNodePackageRepoResponseError)
+
+export const getGetNodePackageRepoUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/package/repo`
+}
+
+export const getNodePackageRepo = async (hostname: string, options?: RequestInit): Promise<getNodePackageRepoResponse> => {
+
+  return apiFetch<getNodePackageRepoResponse>(getGetNodePackageRepoUrl(hostname),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Deploy a deb822 sources file and optional signing key from the Object Store, then refresh the package index if anything changed.
+
+ * @summary Create a package repository
+ */
+export type postNodePackageRepoResponse200 = {
+  data: PackageRepoMutationResponse
+  status: 200
+}
+
+export type postNodePackageRepoResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type postNodePackageRepoResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type postNodePackageRepoResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type postNodePackageRepoResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type postNodePackageRepoResponseSuccess = (postNodePackageRepoResponse200) & {
+  headers: Headers;
+};
+export type postNodePackageRepoResponseError = (postNodePackageRepoResponse400 | postNodePackageRepoResponse401 | postNodePackageRepoResponse403 | postNodePackageRepoResponse500) & {
+  headers: Headers;
+};
+
+export type postNodePackageRepoResponse = (postNodePackageRepoResponseSuccess | postNodePackageRepoResponseError)
+
+export const getPostNodePackageRepoUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/package/repo`
+}
+
+export const postNodePackageRepo = async (hostname: string,
+    packageRepoCreateRequest: PackageRepoCreateRequest, options?: RequestInit): Promise<postNodePackageRepoResponse> => {
+
+  return apiFetch<postNodePackageRepoResponse>(getPostNodePackageRepoUrl(hostname),
+  {
+    ...options,
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      packageRepoCreateRequest,)
+  }
+);}
+
+
+/**
+ * Get a single osapi-managed package repository by name.
+
+ * @summary Get a package repository
+ */
+export type getNodePackageRepoByNameResponse200 = {
+  data: PackageRepoCollectionResponse
+  status: 200
+}
+
+export type getNodePackageRepoByNameResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodePackageRepoByNameResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodePackageRepoByNameResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodePackageRepoByNameResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type getNodePackageRepoByNameResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodePackageRepoByNameResponseSuccess = (getNodePackageRepoByNameResponse200) & {
+  headers: Headers;
+};
+export type getNodePackageRepoByNameResponseError = (getNodePackageRepoByNameResponse400 | getNodePackageRepoByNameResponse401 | getNodePackageRepoByNameResponse403 | getNodePackageRepoByNameResponse404 | getNodePackageRepoByNameResponse500) & {
+  headers: Headers;
+};
+
+export type getNodePackageRepoByNameResponse = (getNodePackageRepoByNameResponseSuccess | getNodePackageRepoByNameResponseError)
+
+export const getGetNodePackageRepoByNameUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/package/repo/${name}`
+}
+
+export const getNodePackageRepoByName = async (hostname: string,
+    name: string, options?: RequestInit): Promise<getNodePackageRepoByNameResponse> => {
+
+  return apiFetch<getNodePackageRepoByNameResponse>(getGetNodePackageRepoByNameUrl(hostname,name),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Redeploy a managed repository's sources file or signing key. Omitted objects keep their current value.
+
+ * @summary Update a package repository
+ */
+export type putNodePackageRepoResponse200 = {
+  data: PackageRepoMutationResponse
+  status: 200
+}
+
+export type putNodePackageRepoResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type putNodePackageRepoResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type putNodePackageRepoResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type putNodePackageRepoResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type putNodePackageRepoResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type putNodePackageRepoResponseSuccess = (putNodePackageRepoResponse200) & {
+  headers: Headers;
+};
+export type putNodePackageRepoResponseError = (putNodePackageRepoResponse400 | putNodePackageRepoResponse401 | putNodePackageRepoResponse403 | putNodePackageRepoResponse404 | putNodePackageRepoResponse500) & {
+  headers: Headers;
+};
+
+export type putNodePackageRepoResponse = (putNodePackageRepoResponseSuccess | putNodePackageRepoResponseError)
+
+export const getPutNodePackageRepoUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/package/repo/${name}`
+}
+
+export const putNodePackageRepo = async (hostname: string,
+    name: string,
+    packageRepoUpdateRequest: PackageRepoUpdateRequest, options?: RequestInit): Promise<putNodePackageRepoResponse> => {
+
+  return apiFetch<putNodePackageRepoResponse>(getPutNodePackageRepoUrl(hostname,name),
+  {
+    ...options,
+    method: 'PUT',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      packageRepoUpdateRequest,)
+  }
+);}
+
+
+/**
+ * Remove a managed repository's sources file and signing key, then refresh the package index.
+
+ * @summary Delete a package repository
+ */
+export type deleteNodePackageRepoResponse200 = {
+  data: PackageRepoMutationResponse
+  status: 200
+}
+
+export type deleteNodePackageRepoResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type deleteNodePackageRepoResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type deleteNodePackageRepoResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type deleteNodePackageRepoResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type deleteNodePackageRepoResponseSuccess = (deleteNodePackageRepoResponse200) & {
+  headers: Headers;
+};
+export type deleteNodePackageRepoResponseError = (deleteNodePackageRepoResponse400 | deleteNodePackageRepoResponse401 | deleteNodePackageRepoResponse403 | deleteNodePackageRepoResponse500) & {
+  headers: Headers;
+};
+
+export type deleteNodePackageRepoResponse = (deleteNodePackageRepoResponseSuccess | deleteNodePackageRepoResponseError)
+
+export const getDeleteNodePackageRepoUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/package/repo/${name}`
+}
+
+export const deleteNodePackageRepo = async (hostname: string,
+    name: string, options?: RequestInit): Promise<deleteNodePackageRepoResponse> => {
+
+  return apiFetch<deleteNodePackageRepoResponse>(getDeleteNodePackageRepoUrl(hostname,name),
+  {
+    ...options,
+    method: 'DELETE'
+
+
+  }
+);}
+
+
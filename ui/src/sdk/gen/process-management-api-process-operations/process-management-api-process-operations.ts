@@ -6,10 +6,14 @@
  */
 import type {
   ErrorResponse,
+  GetNodeProcessTreeParams,
   ProcessCollectionResponse,
   ProcessGetResponse,
+  ProcessSignalMatchRequest,
+  ProcessSignalMatchResponse,
   ProcessSignalRequest,
-  ProcessSignalResponse
+  ProcessSignalResponse,
+  ProcessTreeResponse
 } from '../schemas';
 
 import { apiFetch } from '../../fetch';
@@ -73,6 +77,140 @@ export const getNodeProcess = async (hostname: string, options?: RequestInit): P
 );}
 
 
+/**
+ * Get the process hierarchy on the target node. When pid is set, only the subtree rooted at that process is returned.
+
+ * @summary Get process tree
+ */
+export type getNodeProcessTreeResponse200 = {
+  data: ProcessTreeResponse
+  status: 200
+}
+
+export type getNodeProcessTreeResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeProcessTreeResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeProcessTreeResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeProcessTreeResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type getNodeProcessTreeResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeProcessTreeResponseSuccess = (getNodeProcessTreeResponse200) & {
+  headers: Headers;
+};
+export type getNodeProcessTreeResponseError = (getNodeProcessTreeResponse400 | getNodeProcessTreeResponse401 | getNodeProcessTreeResponse403 | getNodeProcessTreeResponse404 | getNodeProcessTreeResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeProcessTreeResponse = (getNodeProcessTreeResponseSuccess | getNodeProcessTreeResponseError)
+
+export const getGetNodeProcessTreeUrl = (hostname: string,
+    params?: GetNodeProcessTreeParams,) => {
+  const normalizedParams = new URLSearchParams();
+
+  Object.entries(params || {}).forEach(([key, value]) => {
+
+    if (value !== undefined) {
+      normalizedParams.append(key, value === null ? 'null' : value.toString())
+    }
+  });
+
+  const stringifiedParams = normalizedParams.toString();
+
+  return stringifiedParams.length > 0 ? `/api/node/${hostname}/process/tree?${stringifiedParams}` : `/api/node/${hostname}/process/tree`
+}
+
+export const getNodeProcessTree = async (hostname: string,
+    params?: GetNodeProcessTreeParams, options?: RequestInit): Promise<getNodeProcessTreeResponse> => {
+
+  return apiFetch<getNodeProcessTreeResponse>(getGetNodeProcessTreeUrl(hostname,params),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Send a signal to every process on the target node that matches the given name, command line pattern, user and parent PID. Every set filter must match. With dry_run the matching PIDs are reported without being signalled. Init, kernel threads, the agent and its ancestors are never signalled.
+
+ * @summary Send signal to matching processes
+ */
+export type postNodeProcessSignalMatchResponse200 = {
+  data: ProcessSignalMatchResponse
+  status: 200
+}
+
+export type postNodeProcessSignalMatchResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type postNodeProcessSignalMatchResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type postNodeProcessSignalMatchResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type postNodeProcessSignalMatchResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type postNodeProcessSignalMatchResponseSuccess = (postNodeProcessSignalMatchResponse200) & {
+  headers: Headers;
+};
+export type postNodeProcessSignalMatchResponseError = (postNodeProcessSignalMatchResponse400 | postNodeProcessSignalMatchResponse401 | postNodeProcessSignalMatchResponse403 | postNodeProcessSignalMatchResponse500) & {
+  headers: Headers;
+};
+
+export type postNodeProcessSignalMatchResponse = (postNodeProcessSignalMatchResponseSuccess | postNodeProcessSignalMatchResponseError)
+
+export const getPostNodeProcessSignalMatchUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/process/signal`
+}
+
+export const postNodeProcessSignalMatch = async (hostname: string,
+    processSignalMatchRequest: ProcessSignalMatchRequest, options?: RequestInit): Promise<postNodeProcessSignalMatchResponse> => {
+
+  return apiFetch<postNodeProcessSignalMatchResponse>(getPostNodeProcessSignalMatchUrl(hostname),
+  {
+    ...options,
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      processSignalMatchRequest,)
+  }
+);}
+
+
 /**
  * Get detailed information about a specific process by PID.
 
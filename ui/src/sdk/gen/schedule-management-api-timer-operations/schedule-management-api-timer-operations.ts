@@ -0,0 +1,339 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type {
+  ErrorResponse,
+  TimerCollectionResponse,
+  TimerCreateRequest,
+  TimerCreateResponse,
+  TimerDeleteResponse,
+  TimerGetResponse,
+  TimerUpdateRequest,
+  TimerUpdateResponse
+} from '../schemas';
+
+import { apiFetch } from '../../fetch';
+
+/**
+ * List all systemd timers on the target node with their next and last trigger times. Timers managed by osapi include their schedule and command.
+
+ * @summary List all timers
+ */
+export type getNodeScheduleTimerResponse200 = {
+  data: TimerCollectionResponse
+  status: 200
+}
+
+export type getNodeScheduleTimerResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeScheduleTimerResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeScheduleTimerResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeScheduleTimerResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeScheduleTimerResponseSuccess = (getNodeScheduleTimerResponse200) & {
+  headers: Headers;
+};
+export type getNodeScheduleTimerResponseError = (getNodeScheduleTimerResponse400 | getNodeScheduleTimerResponse401 | getNodeScheduleTimerResponse403 | getNodeScheduleTimerResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeScheduleTimerResponse = (getNodeScheduleTimerResponseSuccess | getNodeScheduleTimerResponseError)
+
+export const getGetNodeScheduleTimerUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/schedule/timer`
+}
+
+export const getNodeScheduleTimer = async (hostname: string, options?: RequestInit): Promise<getNodeScheduleTimerResponse> => {
+
+  return apiFetch<getNodeScheduleTimerResponse>(getGetNodeScheduleTimerUrl(hostname),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Create a new systemd timer and service unit pair on the target node, then enable and start the timer.
+
+ * @summary Create a timer
+ */
+export type postNodeScheduleTimerResponse200 = {
+  data: TimerCreateResponse
+  status: 200
+}
+
+export type postNodeScheduleTimerResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type postNodeScheduleTimerResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type postNodeScheduleTimerResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type postNodeScheduleTimerResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type postNodeScheduleTimerResponseSuccess = (postNodeScheduleTimerResponse200) & {
+  headers: Headers;
+};
+export type postNodeScheduleTimerResponseError = (postNodeScheduleTimerResponse400 | postNodeScheduleTimerResponse401 | postNodeScheduleTimerResponse403 | postNodeScheduleTimerResponse500) & {
+  headers: Headers;
+};
+
+export type postNodeScheduleTimerResponse = (postNodeScheduleTimerResponseSuccess | postNodeScheduleTimerResponseError)
+
+export const getPostNodeScheduleTimerUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/schedule/timer`
+}
+
+export const postNodeScheduleTimer = async (hostname: string,
+    timerCreateRequest: TimerCreateRequest, options?: RequestInit): Promise<postNodeScheduleTimerResponse> => {
+
+  return apiFetch<postNodeScheduleTimerResponse>(getPostNodeScheduleTimerUrl(hostname),
+  {
+    ...options,
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      timerCreateRequest,)
+  }
+);}
+
+
+/**
+ * Get a specific systemd timer by name on the target node.
+
+ * @summary Get a timer
+ */
+export type getNodeScheduleTimerByNameResponse200 = {
+  data: TimerGetResponse
+  status: 200
+}
+
+export type getNodeScheduleTimerByNameResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeScheduleTimerByNameResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeScheduleTimerByNameResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeScheduleTimerByNameResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type getNodeScheduleTimerByNameResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeScheduleTimerByNameResponseSuccess = (getNodeScheduleTimerByNameResponse200) & {
+  headers: Headers;
+};
+export type getNodeScheduleTimerByNameResponseError = (getNodeScheduleTimerByNameResponse400 | getNodeScheduleTimerByNameResponse401 | getNodeScheduleTimerByNameResponse403 | getNodeScheduleTimerByNameResponse404 | getNodeScheduleTimerByNameResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeScheduleTimerByNameResponse = (getNodeScheduleTimerByNameResponseSuccess | getNodeScheduleTimerByNameResponseError)
+
+export const getGetNodeScheduleTimerByNameUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/schedule/timer/${name}`
+}
+
+export const getNodeScheduleTimerByName = async (hostname: string,
+    name: string, options?: RequestInit): Promise<getNodeScheduleTimerByNameResponse> => {
+
+  return apiFetch<getNodeScheduleTimerByNameResponse>(getGetNodeScheduleTimerByNameUrl(hostname,name),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Update an existing managed timer on the target node. The timer is restarted so that its next trigger time is recomputed.
+
+ * @summary Update a timer
+ */
+export type putNodeScheduleTimerResponse200 = {
+  data: TimerUpdateResponse
+  status: 200
+}
+
+export type putNodeScheduleTimerResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type putNodeScheduleTimerResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type putNodeScheduleTimerResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type putNodeScheduleTimerResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type putNodeScheduleTimerResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type putNodeScheduleTimerResponseSuccess = (putNodeScheduleTimerResponse200) & {
+  headers: Headers;
+};
+export type putNodeScheduleTimerResponseError = (putNodeScheduleTimerResponse400 | putNodeScheduleTimerResponse401 | putNodeScheduleTimerResponse403 | putNodeScheduleTimerResponse404 | putNodeScheduleTimerResponse500) & {
+  headers: Headers;
+};
+
+export type putNodeScheduleTimerResponse = (putNodeScheduleTimerResponseSuccess | putNodeScheduleTimerResponseError)
+
+export const getPutNodeScheduleTimerUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/schedule/timer/${name}`
+}
+
+export const putNodeScheduleTimer = async (hostname: string,
+    name: string,
+    timerUpdateRequest: TimerUpdateRequest, options?: RequestInit): Promise<putNodeScheduleTimerResponse> => {
+
+  return apiFetch<putNodeScheduleTimerResponse>(getPutNodeScheduleTimerUrl(hostname,name),
+  {
+    ...options,
+    method: 'PUT',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      timerUpdateRequest,)
+  }
+);}
+
+
+/**
+ * Stop and disable a managed timer, then remove its timer and service units from the target node.
+
+ * @summary Delete a timer
+ */
+export type deleteNodeScheduleTimerResponse200 = {
+  data: TimerDeleteResponse
+  status: 200
+}
+
+export type deleteNodeScheduleTimerResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type deleteNodeScheduleTimerResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type deleteNodeScheduleTimerResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type deleteNodeScheduleTimerResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type deleteNodeScheduleTimerResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type deleteNodeScheduleTimerResponseSuccess = (deleteNodeScheduleTimerResponse200) & {
+  headers: Headers;
+};
+export type deleteNodeScheduleTimerResponseError = (deleteNodeScheduleTimerResponse400 | deleteNodeScheduleTimerResponse401 | deleteNodeScheduleTimerResponse403 | deleteNodeScheduleTimerResponse404 | deleteNodeScheduleTimerResponse500) & {
+  headers: Headers;
+};
+
+export type deleteNodeScheduleTimerResponse = (deleteNodeScheduleTimerResponseSuccess | deleteNodeScheduleTimerResponseError)
+
+export const getDeleteNodeScheduleTimerUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/schedule/timer/${name}`
+}
+
+export const deleteNodeScheduleTimer = async (hostname: string,
+    name: string, options?: RequestInit): Promise<deleteNodeScheduleTimerResponse> => {
+
+  return apiFetch<deleteNodeScheduleTimerResponse>(getDeleteNodeScheduleTimerUrl(hostname,name),
+  {
+    ...options,
+    method: 'DELETE'
+
+
+  }
+);}
+
+
@@ -51,6 +51,8 @@ export interface AgentInfo {
   interfaces?: NetworkInterfaceResponse[];
   /** Name of the interface used for the default route. */
   primary_interface?: string;
+  /** Backend managing network configuration. */
+  network_renderer?: string;
   /** Network routing table entries. */
   routes?: RouteResponse[];
   /** Extended facts from additional providers. */
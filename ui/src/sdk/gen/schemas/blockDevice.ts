@@ -0,0 +1,35 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * A block device as reported by lsblk. Partitions and device-mapper volumes are nested under their parent device.
+
+ */
+export interface BlockDevice {
+  /** Kernel device name. */
+  name?: string;
+  /** Device node path. */
+  path?: string;
+  /** Device type (e.g., disk, part, lvm, rom). */
+  type?: string;
+  /** Size in bytes. */
+  size?: number;
+  /** Filesystem or signature type on the device. */
+  fs_type?: string;
+  /** Filesystem UUID. */
+  uuid?: string;
+  /** Filesystem label. */
+  label?: string;
+  /** Where the device is mounted, if anywhere. */
+  mount_point?: string;
+  /** Device model for whole disks. */
+  model?: string;
+  /** Whether the device is read-only. */
+  read_only?: boolean;
+  /** Partitions and holders of this device. */
+  children?: BlockDevice[];
+}
@@ -0,0 +1,25 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { BlockExtendEntryStatus } from './blockExtendEntryStatus';
+
+/**
+ * Result of a logical volume extension for one host.
+ */
+export interface BlockExtendEntry {
+  /** Hostname of the agent that processed this operation. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: BlockExtendEntryStatus;
+  /** Logical volume in vg/lv form. */
+  name?: string;
+  /** Size of the logical volume in bytes after the operation. */
+  size?: number;
+  /** Whether the operation modified system state. */
+  changed?: boolean;
+  /** Error message if the agent failed. */
+  error?: string;
+}
@@ -0,0 +1,18 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * The status of the operation for this host.
+ */
+export type BlockExtendEntryStatus = typeof BlockExtendEntryStatus[keyof typeof BlockExtendEntryStatus];
+
+
+export const BlockExtendEntryStatus = {
+  ok: 'ok',
+  failed: 'failed',
+  skipped: 'skipped',
+} as const;
@@ -0,0 +1,19 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+export interface BlockExtendRequest {
+  /** Volume group holding the logical volume. */
+  volume_group: string;
+  /** Logical volume to extend. */
+  logical_volume: string;
+  /** New size or increment in lvextend syntax (e.g., +10G, 50G, +100%FREE). Defaults to all free space in the volume group.
+   */
+  size?: string;
+  /** Grow the filesystem on the volume along with it. Defaults to true.
+   */
+  resize_fs?: boolean;
+}
@@ -0,0 +1,31 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { BlockDevice } from './blockDevice';
+import type { BlockListEntryStatus } from './blockListEntryStatus';
+import type { BlockLogicalVolume } from './blockLogicalVolume';
+import type { BlockPhysicalVolume } from './blockPhysicalVolume';
+import type { BlockVolumeGroup } from './blockVolumeGroup';
+
+/**
+ * Block device inventory for a single agent.
+ */
+export interface BlockListEntry {
+  /** Hostname of the agent that reported this entry. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: BlockListEntryStatus;
+  /** Top-level block devices on this host. */
+  devices?: BlockDevice[];
+  /** LVM physical volumes on this host. */
+  physical_volumes?: BlockPhysicalVolume[];
+  /** LVM volume groups on this host. */
+  volume_groups?: BlockVolumeGroup[];
+  /** LVM logical volumes on this host. */
+  logical_volumes?: BlockLogicalVolume[];
+  /** Error message if the agent failed. */
+  error?: string;
+}
@@ -0,0 +1,22 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * An LVM logical volume.
+ */
+export interface BlockLogicalVolume {
+  /** Logical volume name. */
+  name?: string;
+  /** Volume group holding the logical volume. */
+  volume_group?: string;
+  /** Device path of the logical volume. */
+  path?: string;
+  /** Size in bytes. */
+  size?: number;
+  /** LVM attribute string. */
+  attr?: string;
+}
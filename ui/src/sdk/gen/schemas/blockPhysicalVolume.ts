@@ -0,0 +1,20 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * An LVM physical volume.
+ */
+export interface BlockPhysicalVolume {
+  /** Physical volume device path. */
+  name?: string;
+  /** Volume group the physical volume belongs to. */
+  volume_group?: string;
+  /** Size in bytes. */
+  size?: number;
+  /** Unallocated space in bytes. */
+  free?: number;
+}
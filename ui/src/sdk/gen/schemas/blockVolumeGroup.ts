@@ -0,0 +1,26 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * An LVM volume group.
+ */
+export interface BlockVolumeGroup {
+  /** Volume group name. */
+  name?: string;
+  /** Size in bytes. */
+  size?: number;
+  /** Unallocated space in bytes. */
+  free?: number;
+  /** Physical extent size in bytes. */
+  extent_size?: number;
+  /** Number of unallocated physical extents. */
+  free_extents?: number;
+  /** Number of physical volumes in the group. */
+  pv_count?: number;
+  /** Number of logical volumes in the group. */
+  lv_count?: number;
+}
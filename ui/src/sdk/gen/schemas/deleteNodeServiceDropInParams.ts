@@ -0,0 +1,14 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+export type DeleteNodeServiceDropInParams = {
+/**
+ * Restart the unit after removing the drop-in. Units that are not running are left stopped.
+
+ */
+restart?: boolean;
+};
@@ -0,0 +1,23 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { FirewallCreateRequestContentType } from './firewallCreateRequestContentType';
+import type { FirewallCreateRequestVars } from './firewallCreateRequestVars';
+
+export interface FirewallCreateRequest {
+  /** Name for the rule set. Used as the file name under /etc/nftables.d/ (with a .nft extension).
+   */
+  name: string;
+  /** Name of the uploaded file in the object store to deploy as the rule set content.
+   */
+  object: string;
+  /** Content type: "raw" or "template". When "template", the file content is rendered through Go text/template with agent facts and user-supplied vars.
+   */
+  content_type?: FirewallCreateRequestContentType;
+  /** Template variables. Only used when content_type is "template".
+   */
+  vars?: FirewallCreateRequestVars;
+}
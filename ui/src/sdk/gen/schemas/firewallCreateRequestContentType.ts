@@ -0,0 +1,18 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * Content type: "raw" or "template". When "template", the file content is rendered through Go text/template with agent facts and user-supplied vars.
+
+ */
+export type FirewallCreateRequestContentType = typeof FirewallCreateRequestContentType[keyof typeof FirewallCreateRequestContentType];
+
+
+export const FirewallCreateRequestContentType = {
+  raw: 'raw',
+  template: 'template',
+} as const;
@@ -0,0 +1,27 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { FirewallEntryStatus } from './firewallEntryStatus';
+
+/**
+ * A managed nftables rule set.
+ */
+export interface FirewallEntry {
+  /** Hostname of the agent that reported this entry. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: FirewallEntryStatus;
+  /** Rule set name. */
+  name?: string;
+  /** Object store name for the deployed content. */
+  object?: string;
+  /** Path of the deployed rule set file. */
+  path?: string;
+  /** Content type of the deployed rule set. */
+  content_type?: string;
+  /** Error message if the agent failed to retrieve this entry. */
+  error?: string;
+}
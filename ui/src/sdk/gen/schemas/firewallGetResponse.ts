@@ -0,0 +1,16 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { FirewallEntry } from './firewallEntry';
+
+/**
+ * Collection response for a single rule set get operation.
+ */
+export interface FirewallGetResponse {
+  /** The job ID used to process this request. */
+  job_id?: string;
+  results: FirewallEntry[];
+}
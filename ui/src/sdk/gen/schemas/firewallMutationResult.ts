@@ -0,0 +1,24 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { FirewallMutationResultStatus } from './firewallMutationResultStatus';
+
+/**
+ * Result of a firewall create, update, or delete operation for one host.
+
+ */
+export interface FirewallMutationResult {
+  /** Hostname of the agent that processed this operation. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: FirewallMutationResultStatus;
+  /** Rule set name. */
+  name?: string;
+  /** Whether the operation modified system state. */
+  changed?: boolean;
+  /** Error message if the agent failed. */
+  error?: string;
+}
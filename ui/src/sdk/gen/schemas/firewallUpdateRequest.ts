@@ -0,0 +1,20 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { FirewallUpdateRequestContentType } from './firewallUpdateRequestContentType';
+import type { FirewallUpdateRequestVars } from './firewallUpdateRequestVars';
+
+export interface FirewallUpdateRequest {
+  /** New object to deploy (redeploy with updated content).
+   */
+  object?: string;
+  /** Content type: "raw" or "template".
+   */
+  content_type?: FirewallUpdateRequestContentType;
+  /** Template variables.
+   */
+  vars?: FirewallUpdateRequestVars;
+}
@@ -0,0 +1,18 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * Content type: "raw" or "template".
+
+ */
+export type FirewallUpdateRequestContentType = typeof FirewallUpdateRequestContentType[keyof typeof FirewallUpdateRequestContentType];
+
+
+export const FirewallUpdateRequestContentType = {
+  raw: 'raw',
+  template: 'template',
+} as const;
@@ -0,0 +1,31 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+export type GetNodeLogFileParams = {
+/**
+ * Absolute path of the log file (e.g., "/var/log/syslog", "/var/log/nginx/error.log"). Must be an allow-listed file under /var/log.
+
+ */
+path: string;
+/**
+ * Maximum number of log lines to return.
+
+ * @minimum 1
+ * @maximum 10000
+ */
+lines?: number;
+/**
+ * Return only entries whose message matches this regular expression.
+
+ */
+grep?: string;
+/**
+ * Return the newest entries first.
+
+ */
+reverse?: boolean;
+};
@@ -23,4 +23,29 @@ since?: string;
 
  */
 priority?: string;
+/**
+ * Return log entries until this time. Accepts systemd time specifications (e.g., "10m", "2026-01-01 00:00:00").
+
+ */
+until?: string;
+/**
+ * Restrict entries to one boot: an offset such as "0" (current boot) or "-1" (previous boot), or a 32-character boot ID.
+
+ */
+boot?: string;
+/**
+ * Return only entries whose message matches this regular expression.
+
+ */
+grep?: string;
+/**
+ * Exact journal field match in FIELD=value form (e.g., "_COMM=sshd", "SYSLOG_IDENTIFIER=cron"). Repeat for multiple matches; all must match.
+
+ */
+field?: string[];
+/**
+ * Return the newest entries first.
+
+ */
+reverse?: boolean;
 };
@@ -0,0 +1,28 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+export type GetNodeLogUnitFollowParams = {
+/**
+ * Number of existing log lines to send before following.
+
+ * @minimum 1
+ * @maximum 10000
+ */
+lines?: number;
+/**
+ * Filter by log priority level (e.g., "err", "warning", "info", "debug").
+
+ */
+priority?: string;
+/**
+ * How long to follow the unit's log, in seconds.
+
+ * @minimum 1
+ * @maximum 3600
+ */
+duration?: number;
+};
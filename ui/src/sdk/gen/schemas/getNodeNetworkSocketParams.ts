@@ -0,0 +1,34 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { GetNodeNetworkSocketProtocol } from './getNodeNetworkSocketProtocol';
+import type { GetNodeNetworkSocketState } from './getNodeNetworkSocketState';
+
+export type GetNodeNetworkSocketParams = {
+/**
+ * Only return sockets of this protocol.
+
+ */
+protocol?: GetNodeNetworkSocketProtocol;
+/**
+ * Only return sockets in this state. Unconnected UDP sockets are reported as listening.
+
+ */
+state?: GetNodeNetworkSocketState;
+/**
+ * Only return sockets whose local or remote port matches.
+
+ * @minimum 1
+ * @maximum 65535
+ */
+port?: number;
+/**
+ * Only return sockets owned by this process ID.
+
+ * @minimum 1
+ */
+pid?: number;
+};
@@ -0,0 +1,14 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+export type GetNodeNetworkSocketProtocol = typeof GetNodeNetworkSocketProtocol[keyof typeof GetNodeNetworkSocketProtocol];
+
+
+export const GetNodeNetworkSocketProtocol = {
+  tcp: 'tcp',
+  udp: 'udp',
+} as const;
@@ -0,0 +1,14 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+export type GetNodeNetworkSocketState = typeof GetNodeNetworkSocketState[keyof typeof GetNodeNetworkSocketState];
+
+
+export const GetNodeNetworkSocketState = {
+  listen: 'listen',
+  established: 'established',
+} as const;
@@ -0,0 +1,15 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+export type GetNodeProcessTreeParams = {
+/**
+ * Root the tree at this process instead of returning every root.
+
+ * @minimum 1
+ */
+pid?: number;
+};
@@ -0,0 +1,14 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+export interface HostEntryCreateRequest {
+  /** IPv4 or IPv6 address of the entry. */
+  address: string;
+  /** Hostnames for the address. The first name is the canonical name; the rest are aliases.
+   */
+  hostnames: string[];
+}
@@ -0,0 +1,21 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { HostEntryGetEntryStatus } from './hostEntryGetEntryStatus';
+import type { HostEntryInfo } from './hostEntryInfo';
+
+/**
+ * Hosts entry get result for a single agent.
+ */
+export interface HostEntryGetEntry {
+  /** Hostname of the agent that reported this entry. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: HostEntryGetEntryStatus;
+  entry?: HostEntryInfo;
+  /** Error message if the agent failed. */
+  error?: string;
+}
@@ -0,0 +1,21 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * An entry in /etc/hosts.
+ */
+export interface HostEntryInfo {
+  /** IP address of the entry. */
+  address?: string;
+  /** Hostnames mapped to the address. */
+  hostnames?: string[];
+  /** Whether the entry is in the osapi-managed block. */
+  managed?: boolean;
+  /** Whether the entry conflicts with the rest of the system (one of its hostnames resolves to a different address elsewhere in the file, or the 127.0.1.1 entry does not name the system hostname).
+   */
+  drifted?: boolean;
+}
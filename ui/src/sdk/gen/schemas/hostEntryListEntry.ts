@@ -0,0 +1,22 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { HostEntryInfo } from './hostEntryInfo';
+import type { HostEntryListEntryStatus } from './hostEntryListEntryStatus';
+
+/**
+ * Hosts entry list result for a single agent.
+ */
+export interface HostEntryListEntry {
+  /** Hostname of the agent that reported this entry. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: HostEntryListEntryStatus;
+  /** List of /etc/hosts entries on this host. */
+  entries?: HostEntryInfo[];
+  /** Error message if the agent failed. */
+  error?: string;
+}
@@ -0,0 +1,23 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { HostEntryMutationEntryStatus } from './hostEntryMutationEntryStatus';
+
+/**
+ * Result of a hosts entry mutation for one host.
+ */
+export interface HostEntryMutationEntry {
+  /** Hostname of the agent that processed this operation. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: HostEntryMutationEntryStatus;
+  /** IP address of the entry. */
+  address?: string;
+  /** Whether the operation modified system state. */
+  changed?: boolean;
+  /** Error message if the agent failed. */
+  error?: string;
+}
@@ -0,0 +1,18 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * The status of the operation for this host.
+ */
+export type HostEntryMutationEntryStatus = typeof HostEntryMutationEntryStatus[keyof typeof HostEntryMutationEntryStatus];
+
+
+export const HostEntryMutationEntryStatus = {
+  ok: 'ok',
+  failed: 'failed',
+  skipped: 'skipped',
+} as const;
@@ -0,0 +1,12 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+export interface HostEntryUpdateRequest {
+  /** Hostnames for the address. The first name is the canonical name; the rest are aliases.
+   */
+  hostnames: string[];
+}
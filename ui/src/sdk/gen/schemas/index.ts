@@ -55,6 +55,7 @@ export * from './cronUpdateRequestVars';
 export * from './cronUpdateResponse';
 export * from './deleteNodeContainerDockerByIDParams';
 export * from './deleteNodeContainerDockerImageParams';
+export * from './deleteNodeServiceDropInParams';
 export * from './diskCollectionResponse';
 export * from './diskResponse';
 export * from './diskResultItem';
@@ -117,13 +118,32 @@ export * from './fileUndeployRequest';
 export * from './fileUndeployResult';
 export * from './fileUndeployResultStatus';
 export * from './fileUploadResponse';
+export * from './firewallCollectionResponse';
+export * from './firewallCreateRequest';
+export * from './firewallCreateRequestContentType';
+export * from './firewallCreateRequestVars';
+export * from './firewallEntry';
+export * from './firewallEntryStatus';
+export * from './firewallGetResponse';
+export * from './firewallMutationResponse';
+export * from './firewallMutationResult';
+export * from './firewallMutationResultStatus';
+export * from './firewallUpdateRequest';
+export * from './firewallUpdateRequestContentType';
+export * from './firewallUpdateRequestVars';
 export * from './getAuditLogsParams';
 export * from './getJobsParams';
 export * from './getJobsStatus';
 export * from './getNodeContainerDockerParams';
 export * from './getNodeContainerDockerState';
+export * from './getNodeLogFileParams';
 export * from './getNodeLogParams';
+export * from './getNodeLogUnitFollowParams';
 export * from './getNodeLogUnitParams';
+export * from './getNodeNetworkSocketParams';
+export * from './getNodeNetworkSocketProtocol';
+export * from './getNodeNetworkSocketState';
+export * from './getNodeProcessTreeParams';
 export * from './groupCollectionResponse';
 export * from './groupCreateRequest';
 export * from './groupEntry';
@@ -134,6 +154,18 @@ export * from './groupMutationResult';
 export * from './groupMutationResultStatus';
 export * from './groupUpdateRequest';
 export * from './healthResponse';
+export * from './hostEntryCreateRequest';
+export * from './hostEntryGetEntry';
+export * from './hostEntryGetEntryStatus';
+export * from './hostEntryGetResponse';
+export * from './hostEntryInfo';
+export * from './hostEntryListEntry';
+export * from './hostEntryListEntryStatus';
+export * from './hostEntryListResponse';
+export * from './hostEntryMutationEntry';
+export * from './hostEntryMutationEntryStatus';
+export * from './hostEntryMutationResponse';
+export * from './hostEntryUpdateRequest';
 export * from './hostnameCollectionResponse';
 export * from './hostnameResponse';
 export * from './hostnameResponseLabels';
@@ -142,6 +174,9 @@ export * from './hostnameUpdateCollectionResponse';
 export * from './hostnameUpdateRequest';
 export * from './hostnameUpdateResultItem';
 export * from './hostnameUpdateResultItemStatus';
+export * from './interfaceBond';
+export * from './interfaceBondMode';
+export * from './interfaceBridge';
 export * from './interfaceConfigRequest';
 export * from './interfaceGetEntry';
 export * from './interfaceGetEntryStatus';
@@ -153,6 +188,7 @@ export * from './interfaceListResponse';
 export * from './interfaceMutationEntry';
 export * from './interfaceMutationEntryStatus';
 export * from './interfaceMutationResponse';
+export * from './interfaceVlan';
 export * from './jobDetailResponse';
 export * from './jobDetailResponseAgentStates';
 export * from './jobDetailResponseOperation';
@@ -180,7 +216,22 @@ export * from './memoryCollectionResponse';
 export * from './memoryResponse';
 export * from './memoryResultItem';
 export * from './memoryResultItemStatus';
+export * from './mountCreateRequest';
+export * from './mountGetEntry';
+export * from './mountGetEntryStatus';
+export * from './mountGetResponse';
+export * from './mountInfo';
+export * from './mountListEntry';
+export * from './mountListEntryStatus';
+export * from './mountListResponse';
+export * from './mountMutationEntry';
+export * from './mountMutationEntryStatus';
+export * from './mountMutationResponse';
+export * from './mountUpdateRequest';
 export * from './nATSInfo';
+export * from './networkConfirmCollectionResponse';
+export * from './networkConfirmResultItem';
+export * from './networkConfirmResultItemStatus';
 export * from './networkInterfaceResponse';
 export * from './networkInterfaceResponseFamily';
 export * from './nodeCondition';
@@ -204,6 +255,13 @@ export * from './oSInfoResponse';
 export * from './oSInfoResultItem';
 export * from './oSInfoResultItemStatus';
 export * from './packageCollectionResponse';
+export * from './packageEnsureEntry';
+export * from './packageEnsureEntryState';
+export * from './packageEnsureItem';
+export * from './packageEnsureRequest';
+export * from './packageEnsureResponse';
+export * from './packageEnsureResult';
+export * from './packageEnsureResultStatus';
 export * from './packageEntry';
 export * from './packageEntryStatus';
 export * from './packageInfo';
@@ -211,6 +269,15 @@ export * from './packageInstallRequest';
 export * from './packageMutationResponse';
 export * from './packageMutationResult';
 export * from './packageMutationResultStatus';
+export * from './packageRepoCollectionResponse';
+export * from './packageRepoCreateRequest';
+export * from './packageRepoEntry';
+export * from './packageRepoEntryStatus';
+export * from './packageRepoInfo';
+export * from './packageRepoMutationResponse';
+export * from './packageRepoMutationResult';
+export * from './packageRepoMutationResultStatus';
+export * from './packageRepoUpdateRequest';
 export * from './pendingAgentInfo';
 export * from './pingCollectionResponse';
 export * from './pingResponse';
@@ -231,11 +298,23 @@ export * from './processGetEntry';
 export * from './processGetEntryStatus';
 export * from './processGetResponse';
 export * from './processInfo';
+export * from './processLimit';
+export * from './processOpenFile';
+export * from './processSignalFailure';
+export * from './processSignalMatchRequest';
+export * from './processSignalMatchRequestSignal';
+export * from './processSignalMatchResponse';
+export * from './processSignalMatchResult';
+export * from './processSignalMatchResultStatus';
 export * from './processSignalRequest';
 export * from './processSignalRequestSignal';
 export * from './processSignalResponse';
 export * from './processSignalResult';
 export * from './processSignalResultStatus';
+export * from './processTreeEntry';
+export * from './processTreeEntryStatus';
+export * from './processTreeNode';
+export * from './processTreeResponse';
 export * from './readyResponse';
 export * from './rejectAgent200';
 export * from './retryJobRequest';
@@ -252,7 +331,51 @@ export * from './routeMutationEntry';
 export * from './routeMutationEntryStatus';
 export * from './routeMutationResponse';
 export * from './routeResponse';
+export * from './schemas/blockDevice';
+export * from './schemas/blockExtendEntry';
+export * from './schemas/blockExtendEntryStatus';
+export * from './schemas/blockExtendRequest';
+export * from './schemas/blockExtendResponse';
+export * from './schemas/blockListEntry';
+export * from './schemas/blockListEntryStatus';
+export * from './schemas/blockListResponse';
+export * from './schemas/blockLogicalVolume';
+export * from './schemas/blockPhysicalVolume';
+export * from './schemas/blockVolumeGroup';
+export * from './schemas/kernelModuleCreateRequest';
+export * from './schemas/kernelModuleGetEntry';
+export * from './schemas/kernelModuleGetEntryStatus';
+export * from './schemas/kernelModuleGetResponse';
+export * from './schemas/kernelModuleInfo';
+export * from './schemas/kernelModuleListEntry';
+export * from './schemas/kernelModuleListEntryStatus';
+export * from './schemas/kernelModuleListResponse';
+export * from './schemas/kernelModuleMutationEntry';
+export * from './schemas/kernelModuleMutationEntryStatus';
+export * from './schemas/kernelModuleMutationResponse';
+export * from './schemas/kernelModuleUpdateRequest';
+export * from './schemas/swapArea';
+export * from './schemas/swapCreateRequest';
+export * from './schemas/swapGetEntry';
+export * from './schemas/swapGetEntryStatus';
+export * from './schemas/swapGetResponse';
+export * from './schemas/swapMutationEntry';
+export * from './schemas/swapMutationEntryStatus';
+export * from './schemas/swapMutationResponse';
 export * from './serviceCreateRequest';
+export * from './serviceDropInCreateRequest';
+export * from './serviceDropInCreateRequestContentType';
+export * from './serviceDropInCreateRequestVars';
+export * from './serviceDropInInfo';
+export * from './serviceDropInListEntry';
+export * from './serviceDropInListEntryStatus';
+export * from './serviceDropInListResponse';
+export * from './serviceDropInMutationEntry';
+export * from './serviceDropInMutationEntryStatus';
+export * from './serviceDropInMutationResponse';
+export * from './serviceDropInUpdateRequest';
+export * from './serviceDropInUpdateRequestContentType';
+export * from './serviceDropInUpdateRequestVars';
 export * from './serviceGetEntry';
 export * from './serviceGetEntryStatus';
 export * from './serviceGetResponse';
@@ -264,6 +387,22 @@ export * from './serviceMutationEntry';
 export * from './serviceMutationEntryStatus';
 export * from './serviceMutationResponse';
 export * from './serviceUpdateRequest';
+export * from './socketCollectionResponse';
+export * from './socketEntry';
+export * from './socketEntryStatus';
+export * from './socketInfo';
+export * from './socketInfoProtocol';
+export * from './socketInfoState';
+export * from './sshdCreateRequest';
+export * from './sshdDirective';
+export * from './sshdDropInInfo';
+export * from './sshdGetEntry';
+export * from './sshdGetEntryStatus';
+export * from './sshdGetResponse';
+export * from './sshdMutationEntry';
+export * from './sshdMutationEntryStatus';
+export * from './sshdMutationResponse';
+export * from './sshdUpdateRequest';
 export * from './sSHKeyAddRequest';
 export * from './sSHKeyCollectionResponse';
 export * from './sSHKeyEntry';
@@ -277,6 +416,19 @@ export * from './staleDeploymentsResponse';
 export * from './statusResponse';
 export * from './statusResponseComponents';
 export * from './streamInfo';
+export * from './sudoersCreateRequest';
+export * from './sudoersGetEntry';
+export * from './sudoersGetEntryStatus';
+export * from './sudoersGetResponse';
+export * from './sudoersInfo';
+export * from './sudoersListEntry';
+export * from './sudoersListEntryStatus';
+export * from './sudoersListResponse';
+export * from './sudoersMutationEntry';
+export * from './sudoersMutationEntryStatus';
+export * from './sudoersMutationResponse';
+export * from './sudoersRule';
+export * from './sudoersUpdateRequest';
 export * from './sysctlCollectionResponse';
 export * from './sysctlCreateRequest';
 export * from './sysctlCreateResponse';
@@ -289,6 +441,21 @@ export * from './sysctlMutationResultStatus';
 export * from './sysctlUpdateRequest';
 export * from './sysctlUpdateResponse';
 export * from './timelineEvent';
+export * from './timerCollectionResponse';
+export * from './timerCreateRequest';
+export * from './timerCreateRequestContentType';
+export * from './timerCreateRequestVars';
+export * from './timerCreateResponse';
+export * from './timerDeleteResponse';
+export * from './timerEntry';
+export * from './timerEntryStatus';
+export * from './timerGetResponse';
+export * from './timerMutationResult';
+export * from './timerMutationResultStatus';
+export * from './timerUpdateRequest';
+export * from './timerUpdateRequestContentType';
+export * from './timerUpdateRequestVars';
+export * from './timerUpdateResponse';
 export * from './timezoneCollectionResponse';
 export * from './timezoneEntry';
 export * from './timezoneEntryStatus';
@@ -312,5 +479,6 @@ export * from './userInfo';
 export * from './userMutationResponse';
 export * from './userMutationResult';
 export * from './userMutationResultStatus';
+export * from './userPasswordAging';
 export * from './userPasswordRequest';
 export * from './userUpdateRequest';
@@ -0,0 +1,20 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { InterfaceBondMode } from './interfaceBondMode';
+
+/**
+ * Bond (link aggregation) settings. Setting this on create makes the interface a bond of the member interfaces.
+
+ */
+export interface InterfaceBond {
+  /** Bonding mode. */
+  mode: InterfaceBondMode;
+  /** Interfaces enslaved to the bond. */
+  members: string[];
+  /** MII link monitoring interval in milliseconds. */
+  miimon?: number;
+}
@@ -0,0 +1,22 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * Bonding mode.
+ */
+export type InterfaceBondMode = typeof InterfaceBondMode[keyof typeof InterfaceBondMode];
+
+
+export const InterfaceBondMode = {
+  'balance-rr': 'balance-rr',
+  'active-backup': 'active-backup',
+  'balance-xor': 'balance-xor',
+  broadcast: 'broadcast',
+  '802.3ad': '802.3ad',
+  'balance-tlb': 'balance-tlb',
+  'balance-alb': 'balance-alb',
+} as const;
@@ -0,0 +1,17 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * Bridge settings. Setting this on create makes the interface a bridge over the member interfaces.
+
+ */
+export interface InterfaceBridge {
+  /** Interfaces attached to the bridge. */
+  members?: string[];
+  /** Whether the spanning tree protocol is enabled. */
+  stp?: boolean;
+}
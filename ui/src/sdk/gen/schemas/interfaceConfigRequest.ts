@@ -4,6 +4,9 @@
  * Agent Management API
  * OpenAPI spec version: 1.0.0
  */
+import type { InterfaceBond } from './interfaceBond';
+import type { InterfaceBridge } from './interfaceBridge';
+import type { InterfaceVlan } from './interfaceVlan';
 
 export interface InterfaceConfigRequest {
   dhcp4?: boolean;
@@ -14,4 +17,7 @@ export interface InterfaceConfigRequest {
   mtu?: number;
   mac_address?: string;
   wakeonlan?: boolean;
+  vlan?: InterfaceVlan;
+  bond?: InterfaceBond;
+  bridge?: InterfaceBridge;
 }
@@ -4,6 +4,9 @@
  * Agent Management API
  * OpenAPI spec version: 1.0.0
  */
+import type { InterfaceBond } from './interfaceBond';
+import type { InterfaceBridge } from './interfaceBridge';
+import type { InterfaceVlan } from './interfaceVlan';
 
 /**
  * Information about a network interface.
@@ -39,4 +42,9 @@ export interface InterfaceInfo {
   wakeonlan?: boolean;
   /** Whether this is the primary (default route) interface. */
   primary?: boolean;
+  /** Interface type (ethernet, wifi, vlan, bond, bridge, etc.). */
+  type?: string;
+  vlan?: InterfaceVlan;
+  bond?: InterfaceBond;
+  bridge?: InterfaceBridge;
 }
@@ -0,0 +1,17 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * 802.1Q VLAN settings. Setting this on create makes the interface a VLAN on top of link.
+
+ */
+export interface InterfaceVlan {
+  /** VLAN ID. */
+  id: number;
+  /** Parent interface carrying the tagged traffic. */
+  link: string;
+}
@@ -0,0 +1,20 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+export interface KernelModuleCreateRequest {
+  /** Kernel module name. Dashes are normalized to underscores.
+   */
+  name: string;
+  /** Load the module now and at every boot. */
+  load?: boolean;
+  /** Prevent the module from being loaded automatically and unload it if possible. Mutually exclusive with load.
+   */
+  blacklist?: boolean;
+  /** Space-separated module parameters applied when the module is loaded.
+   */
+  options?: string;
+}
@@ -0,0 +1,21 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { KernelModuleGetEntryStatus } from './kernelModuleGetEntryStatus';
+import type { KernelModuleInfo } from './kernelModuleInfo';
+
+/**
+ * Kernel module get result for a single agent.
+ */
+export interface KernelModuleGetEntry {
+  /** Hostname of the agent that reported this entry. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: KernelModuleGetEntryStatus;
+  module?: KernelModuleInfo;
+  /** Error message if the agent failed. */
+  error?: string;
+}
@@ -0,0 +1,18 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * The status of the operation for this host.
+ */
+export type KernelModuleGetEntryStatus = typeof KernelModuleGetEntryStatus[keyof typeof KernelModuleGetEntryStatus];
+
+
+export const KernelModuleGetEntryStatus = {
+  ok: 'ok',
+  failed: 'failed',
+  skipped: 'skipped',
+} as const;
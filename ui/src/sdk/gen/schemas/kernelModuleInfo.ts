@@ -0,0 +1,32 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * A kernel module. Loaded modules report their runtime state; managed modules also report their persistent configuration.
+
+ */
+export interface KernelModuleInfo {
+  /** Module name. */
+  name?: string;
+  /** Whether the module is currently loaded. */
+  loaded?: boolean;
+  /** Memory used by the loaded module in bytes. */
+  size?: number;
+  /** Loaded modules that depend on this module. */
+  used_by?: string[];
+  /** Whether the module is configured by osapi. */
+  managed?: boolean;
+  /** Whether the module is configured to load at boot. */
+  load?: boolean;
+  /** Whether the module is blacklisted. */
+  blacklist?: boolean;
+  /** Module parameters configured in modprobe.d. */
+  options?: string;
+  /** Whether the runtime state disagrees with the managed configuration (a module set to load is not loaded, or a blacklisted module is loaded).
+   */
+  drifted?: boolean;
+}
@@ -0,0 +1,22 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { KernelModuleInfo } from './kernelModuleInfo';
+import type { KernelModuleListEntryStatus } from './kernelModuleListEntryStatus';
+
+/**
+ * Kernel module list result for a single agent.
+ */
+export interface KernelModuleListEntry {
+  /** Hostname of the agent that reported this entry. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: KernelModuleListEntryStatus;
+  /** List of kernel modules on this host. */
+  modules?: KernelModuleInfo[];
+  /** Error message if the agent failed. */
+  error?: string;
+}
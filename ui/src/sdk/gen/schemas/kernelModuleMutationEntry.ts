@@ -0,0 +1,23 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { KernelModuleMutationEntryStatus } from './kernelModuleMutationEntryStatus';
+
+/**
+ * Result of a kernel module mutation for one host.
+ */
+export interface KernelModuleMutationEntry {
+  /** Hostname of the agent that processed this operation. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: KernelModuleMutationEntryStatus;
+  /** Name of the kernel module. */
+  name?: string;
+  /** Whether the operation modified system state. */
+  changed?: boolean;
+  /** Error message if the agent failed. */
+  error?: string;
+}
@@ -0,0 +1,32 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+export interface MountCreateRequest {
+  /** Name identifying the managed entry. */
+  name: string;
+  /** Block device, UUID=, LABEL=, or remote source to mount.
+   */
+  device: string;
+  /** Absolute mount point. */
+  path: string;
+  /**
+     * Filesystem type (e.g., ext4, xfs, nfs, tmpfs). Must not contain whitespace or control characters.
+
+     * @pattern ^[^\s\x00-\x1f\x7f]+$
+     */
+  fs_type: string;
+  /**
+     * Comma-separated mount options. Defaults to "defaults". Must not contain whitespace or control characters.
+
+     * @pattern ^[^\s\x00-\x1f\x7f]+$
+     */
+  options?: string;
+  /** Dump frequency (fstab field five). */
+  dump?: number;
+  /** Filesystem check order (fstab field six). */
+  pass?: number;
+}
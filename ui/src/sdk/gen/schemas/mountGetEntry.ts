@@ -0,0 +1,21 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { MountGetEntryStatus } from './mountGetEntryStatus';
+import type { MountInfo } from './mountInfo';
+
+/**
+ * Mount get result for a single agent.
+ */
+export interface MountGetEntry {
+  /** Hostname of the agent that reported this entry. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: MountGetEntryStatus;
+  mount?: MountInfo;
+  /** Error message if the agent failed. */
+  error?: string;
+}
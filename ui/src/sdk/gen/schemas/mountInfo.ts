@@ -0,0 +1,33 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * A filesystem mount. Live mounts report the options in effect; managed entries also report their fstab declaration.
+
+ */
+export interface MountInfo {
+  /** Name of the managed entry. Empty for unmanaged mounts. */
+  name?: string;
+  /** Mounted device or source. */
+  device?: string;
+  /** Mount point. */
+  path?: string;
+  /** Filesystem type. */
+  fs_type?: string;
+  /** Options declared in /etc/fstab for managed entries. */
+  options?: string;
+  /** Options in effect from /proc/self/mounts. */
+  mount_options?: string;
+  /** Dump frequency (fstab field five). */
+  dump?: number;
+  /** Filesystem check order (fstab field six). */
+  pass?: number;
+  /** Whether the filesystem is currently mounted. */
+  mounted?: boolean;
+  /** Whether the entry is managed by osapi in /etc/fstab. */
+  managed?: boolean;
+}
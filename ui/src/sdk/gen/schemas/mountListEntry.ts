@@ -0,0 +1,22 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { MountInfo } from './mountInfo';
+import type { MountListEntryStatus } from './mountListEntryStatus';
+
+/**
+ * Mount list result for a single agent.
+ */
+export interface MountListEntry {
+  /** Hostname of the agent that reported this entry. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: MountListEntryStatus;
+  /** List of mounts on this host. */
+  mounts?: MountInfo[];
+  /** Error message if the agent failed. */
+  error?: string;
+}
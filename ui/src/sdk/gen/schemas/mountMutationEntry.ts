@@ -0,0 +1,23 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { MountMutationEntryStatus } from './mountMutationEntryStatus';
+
+/**
+ * Result of a mount mutation or action operation for one host.
+ */
+export interface MountMutationEntry {
+  /** Hostname of the agent that processed this operation. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: MountMutationEntryStatus;
+  /** Name of the managed mount entry. */
+  name?: string;
+  /** Whether the operation modified system state. */
+  changed?: boolean;
+  /** Error message if the agent failed. */
+  error?: string;
+}
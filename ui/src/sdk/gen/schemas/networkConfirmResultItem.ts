@@ -0,0 +1,19 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { NetworkConfirmResultItemStatus } from './networkConfirmResultItemStatus';
+
+export interface NetworkConfirmResultItem {
+  /** Hostname of the agent that processed this operation. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: NetworkConfirmResultItemStatus;
+  /** Whether a pending change was confirmed. False when nothing was waiting for confirmation or safe apply is disabled.
+ */
+  changed?: boolean;
+  /** Error message if the agent failed. */
+  error?: string;
+}
@@ -0,0 +1,18 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { PackageEnsureEntryState } from './packageEnsureEntryState';
+
+export interface PackageEnsureEntry {
+  /** Package name. */
+  name: string;
+  /** Desired state. "pinned" installs the given version and holds it.
+   */
+  state: PackageEnsureEntryState;
+  /** Package version. Required when state is "pinned"; optional for "present".
+   */
+  version?: string;
+}
@@ -0,0 +1,20 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * Desired state. "pinned" installs the given version and holds it.
+
+ */
+export type PackageEnsureEntryState = typeof PackageEnsureEntryState[keyof typeof PackageEnsureEntryState];
+
+
+export const PackageEnsureEntryState = {
+  PackageEnsureEntryStatePresent: 'present',
+  PackageEnsureEntryStateAbsent: 'absent',
+  PackageEnsureEntryStateLatest: 'latest',
+  PackageEnsureEntryStatePinned: 'pinned',
+} as const;
@@ -0,0 +1,18 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * Ensure result for a single package.
+ */
+export interface PackageEnsureItem {
+  /** Package name. */
+  name: string;
+  /** Whether the package was modified. */
+  changed: boolean;
+  /** Error message if this package failed to converge. */
+  error?: string;
+}
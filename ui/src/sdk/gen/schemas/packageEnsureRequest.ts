@@ -0,0 +1,12 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { PackageEnsureEntry } from './packageEnsureEntry';
+
+export interface PackageEnsureRequest {
+  /** Desired package states. */
+  packages: PackageEnsureEntry[];
+}
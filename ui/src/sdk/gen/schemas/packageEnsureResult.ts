@@ -0,0 +1,24 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { PackageEnsureItem } from './packageEnsureItem';
+import type { PackageEnsureResultStatus } from './packageEnsureResultStatus';
+
+/**
+ * Result of a package ensure operation for one host.
+ */
+export interface PackageEnsureResult {
+  /** Hostname of the agent that processed this operation. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: PackageEnsureResultStatus;
+  /** Per-package results in manifest order. */
+  packages?: PackageEnsureItem[];
+  /** Whether any package was modified. */
+  changed?: boolean;
+  /** Error message if the agent failed. */
+  error?: string;
+}
@@ -5,8 +5,18 @@
  * OpenAPI spec version: 1.0.0
  */
 
+/**
+ * Install a package by name from the configured repositories, or from a package artifact in the Object Store. Exactly one of name or object is required.
+
+ */
 export interface PackageInstallRequest {
   /** Name of the package to install.
    */
-  name: string;
+  name?: string;
+  /** Exact version to install. Defaults to the candidate version. Not valid with object.
+   */
+  version?: string;
+  /** Object Store reference for a package artifact (.deb, .rpm, or .apk). The agent stages and verifies the artifact, then installs it with the native package manager.
+   */
+  object?: string;
 }
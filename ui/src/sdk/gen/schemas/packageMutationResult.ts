@@ -16,6 +16,9 @@ export interface PackageMutationResult {
   status: PackageMutationResultStatus;
   /** Package name. */
   name?: string;
+  /** Installed version. Set when installing from an Object Store artifact.
+   */
+  version?: string;
   /** Whether the operation modified system state. */
   changed?: boolean;
   /** Error message if the agent failed. */
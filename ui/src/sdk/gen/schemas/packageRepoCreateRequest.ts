@@ -0,0 +1,18 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+export interface PackageRepoCreateRequest {
+  /** Repository name (used as the sources and keyring filename).
+   */
+  name: string;
+  /** Object Store reference for the deb822 sources file. Templated objects can use {{ .Vars.keyring }} for Signed-By.
+   */
+  object: string;
+  /** Object Store reference for the ASCII-armored signing key.
+   */
+  key_object?: string;
+}
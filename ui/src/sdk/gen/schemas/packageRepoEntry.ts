@@ -0,0 +1,22 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { PackageRepoEntryStatus } from './packageRepoEntryStatus';
+import type { PackageRepoInfo } from './packageRepoInfo';
+
+/**
+ * A repository list result for one host.
+ */
+export interface PackageRepoEntry {
+  /** Hostname of the agent that reported this entry. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: PackageRepoEntryStatus;
+  /** List of managed repositories. */
+  repos?: PackageRepoInfo[];
+  /** Error message if the agent failed. */
+  error?: string;
+}
@@ -0,0 +1,22 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * An osapi-managed package repository.
+ */
+export interface PackageRepoInfo {
+  /** Repository name. */
+  name?: string;
+  /** Object Store name of the deployed sources file. */
+  object?: string;
+  /** Object Store name of the deployed signing key. */
+  key_object?: string;
+  /** Path of the sources file on disk. */
+  path?: string;
+  /** Path of the signing key on disk. */
+  keyring?: string;
+}
@@ -0,0 +1,23 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { PackageRepoMutationResultStatus } from './packageRepoMutationResultStatus';
+
+/**
+ * Result of a repository create, update, or delete for one host.
+ */
+export interface PackageRepoMutationResult {
+  /** Hostname of the agent that processed this operation. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: PackageRepoMutationResultStatus;
+  /** Repository name. */
+  name?: string;
+  /** Whether the operation modified system state. */
+  changed?: boolean;
+  /** Error message if the agent failed. */
+  error?: string;
+}
@@ -0,0 +1,13 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+export interface PackageRepoUpdateRequest {
+  /** Object Store reference for the new sources file. */
+  object?: string;
+  /** Object Store reference for the new signing key. */
+  key_object?: string;
+}
@@ -4,6 +4,8 @@
  * Agent Management API
  * OpenAPI spec version: 1.0.0
  */
+import type { ProcessLimit } from './processLimit';
+import type { ProcessOpenFile } from './processOpenFile';
 
 /**
  * Information about a running process.
@@ -11,6 +13,8 @@
 export interface ProcessInfo {
   /** Process identifier. */
   pid?: number;
+  /** Parent process identifier. */
+  ppid?: number;
   /** Process name. */
   name?: string;
   /** User running the process. */
@@ -27,4 +31,20 @@ export interface ProcessInfo {
   command?: string;
   /** Process start time. */
   start_time?: string;
+  /** PIDs of direct child processes. Only set by get. */
+  children?: number[];
+  /** Number of threads. Only set by get. */
+  threads?: number;
+  /** Number of open file descriptors. Only set by get. */
+  fd_count?: number;
+  /** Sample of open files, capped at 20 entries. Only set by get.
+   */
+  open_files?: ProcessOpenFile[];
+  /** Control group path. Only set by get. */
+  cgroup?: string;
+  /** Environment variable names. Values are never returned. Only set by get.
+   */
+  env_keys?: string[];
+  /** Resource limits from /proc/<pid>/limits. Only set by get. */
+  limits?: ProcessLimit[];
 }
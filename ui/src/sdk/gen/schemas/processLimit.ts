@@ -0,0 +1,20 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * A resource limit applied to a process.
+ */
+export interface ProcessLimit {
+  /** Limit name as reported by the kernel. */
+  name: string;
+  /** Soft limit, or "unlimited". */
+  soft: string;
+  /** Hard limit, or "unlimited". */
+  hard: string;
+  /** Units of the limit, if any. */
+  units?: string;
+}
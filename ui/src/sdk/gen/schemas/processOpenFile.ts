@@ -0,0 +1,16 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * A file descriptor held open by a process.
+ */
+export interface ProcessOpenFile {
+  /** File descriptor number. */
+  fd: number;
+  /** Path the descriptor refers to. */
+  path: string;
+}
@@ -0,0 +1,16 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * A matching process that could not be signalled.
+ */
+export interface ProcessSignalFailure {
+  /** Process identifier. */
+  pid: number;
+  /** Why the signal was not delivered. */
+  error: string;
+}
@@ -0,0 +1,27 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { ProcessSignalMatchRequestSignal } from './processSignalMatchRequestSignal';
+
+/**
+ * Selects processes to signal. At least one of name, cmdline, user or ppid is required.
+
+ */
+export interface ProcessSignalMatchRequest {
+  /** Signal name to send to the matching processes. */
+  signal: ProcessSignalMatchRequestSignal;
+  /** Exact process name to match. */
+  name?: string;
+  /** Regular expression (RE2 syntax) matched against the full command line.
+   */
+  cmdline?: string;
+  /** Exact process owner to match. */
+  user?: string;
+  /** Only match direct children of this process. */
+  ppid?: number;
+  /** Report the matching PIDs without signalling them. */
+  dry_run?: boolean;
+}
@@ -0,0 +1,21 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * Signal name to send to the matching processes.
+ */
+export type ProcessSignalMatchRequestSignal = typeof ProcessSignalMatchRequestSignal[keyof typeof ProcessSignalMatchRequestSignal];
+
+
+export const ProcessSignalMatchRequestSignal = {
+  TERM: 'TERM',
+  KILL: 'KILL',
+  HUP: 'HUP',
+  INT: 'INT',
+  USR1: 'USR1',
+  USR2: 'USR2',
+} as const;
@@ -0,0 +1,31 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { ProcessSignalFailure } from './processSignalFailure';
+import type { ProcessSignalMatchResultStatus } from './processSignalMatchResultStatus';
+
+/**
+ * Result of signalling matching processes on a single agent.
+ */
+export interface ProcessSignalMatchResult {
+  /** The hostname of the agent. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: ProcessSignalMatchResultStatus;
+  /** Signal that was sent. */
+  signal?: string;
+  /** Whether this was a dry run. */
+  dry_run?: boolean;
+  /** PIDs that were signalled, or that would have been in a dry run.
+   */
+  pids?: number[];
+  /** Matching processes the signal could not be delivered to. */
+  failed?: ProcessSignalFailure[];
+  /** Whether the operation modified system state. */
+  changed?: boolean;
+  /** Error message if the agent failed. */
+  error?: string;
+}
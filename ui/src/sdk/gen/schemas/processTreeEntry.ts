@@ -0,0 +1,22 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { ProcessTreeEntryStatus } from './processTreeEntryStatus';
+import type { ProcessTreeNode } from './processTreeNode';
+
+/**
+ * Process tree result for a single agent.
+ */
+export interface ProcessTreeEntry {
+  /** The hostname of the agent. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: ProcessTreeEntryStatus;
+  /** Root processes of the tree on this agent. */
+  processes?: ProcessTreeNode[];
+  /** Error message if the agent failed. */
+  error?: string;
+}
@@ -0,0 +1,24 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * A process and its descendants.
+ */
+export interface ProcessTreeNode {
+  /** Process identifier. */
+  pid: number;
+  /** Parent process identifier. */
+  ppid: number;
+  /** Process name. */
+  name: string;
+  /** User running the process. */
+  user?: string;
+  /** Full command line. */
+  command?: string;
+  /** Child processes. */
+  children?: ProcessTreeNode[];
+}
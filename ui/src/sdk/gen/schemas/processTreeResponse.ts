@@ -0,0 +1,13 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { ProcessTreeEntry } from './processTreeEntry';
+
+export interface ProcessTreeResponse {
+  /** The job ID used to process this request. */
+  job_id?: string;
+  results: ProcessTreeEntry[];
+}
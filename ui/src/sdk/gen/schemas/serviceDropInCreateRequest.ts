@@ -0,0 +1,25 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { ServiceDropInCreateRequestContentType } from './serviceDropInCreateRequestContentType';
+import type { ServiceDropInCreateRequestVars } from './serviceDropInCreateRequestVars';
+
+export interface ServiceDropInCreateRequest {
+  /** Drop-in name without the .conf suffix. Defaults to "override". Deployed to /etc/systemd/system/{unit}.d/{name}.conf.
+   */
+  name?: string;
+  /** Object Store reference for the drop-in content. */
+  object: string;
+  /** Content type: "raw" or "template". When "template", the file content is rendered through Go text/template with agent facts and user-supplied vars.
+   */
+  content_type?: ServiceDropInCreateRequestContentType;
+  /** Template variables. Only used when content_type is "template".
+   */
+  vars?: ServiceDropInCreateRequestVars;
+  /** Restart the unit when the drop-in changed. Units that are not running are left stopped.
+   */
+  restart?: boolean;
+}
@@ -0,0 +1,12 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * Template variables. Only used when content_type is "template".
+
+ */
+export type ServiceDropInCreateRequestVars = { [key: string]: unknown };
@@ -0,0 +1,22 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * Information about a systemd drop-in override file.
+ */
+export interface ServiceDropInInfo {
+  /** Drop-in name without the .conf suffix. */
+  name?: string;
+  /** Absolute path of the drop-in file. */
+  path?: string;
+  /** Whether the drop-in is managed by osapi. */
+  managed?: boolean;
+  /** Object Store reference of a managed drop-in. */
+  object?: string;
+  /** SHA-256 of the deployed content of a managed drop-in. */
+  sha256?: string;
+}
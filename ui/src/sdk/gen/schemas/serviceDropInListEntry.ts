@@ -0,0 +1,22 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { ServiceDropInInfo } from './serviceDropInInfo';
+import type { ServiceDropInListEntryStatus } from './serviceDropInListEntryStatus';
+
+/**
+ * Drop-in list result for a single agent.
+ */
+export interface ServiceDropInListEntry {
+  /** Hostname of the agent that reported this entry. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: ServiceDropInListEntryStatus;
+  /** Drop-ins of the unit on this host. */
+  dropins?: ServiceDropInInfo[];
+  /** Error message if the agent failed. */
+  error?: string;
+}
@@ -0,0 +1,27 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { ServiceDropInMutationEntryStatus } from './serviceDropInMutationEntryStatus';
+
+/**
+ * Result of a drop-in mutation for one host.
+ */
+export interface ServiceDropInMutationEntry {
+  /** Hostname of the agent that processed this operation. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: ServiceDropInMutationEntryStatus;
+  /** Fully qualified unit name. */
+  unit?: string;
+  /** Drop-in name. */
+  name?: string;
+  /** Whether the operation modified system state. */
+  changed?: boolean;
+  /** Whether the unit was restarted. */
+  restarted?: boolean;
+  /** Error message if the agent failed. */
+  error?: string;
+}
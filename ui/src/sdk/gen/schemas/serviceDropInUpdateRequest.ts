@@ -0,0 +1,23 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { ServiceDropInUpdateRequestContentType } from './serviceDropInUpdateRequestContentType';
+import type { ServiceDropInUpdateRequestVars } from './serviceDropInUpdateRequestVars';
+
+export interface ServiceDropInUpdateRequest {
+  /** New object to deploy. Defaults to the currently deployed object.
+   */
+  object?: string;
+  /** Content type: "raw" or "template".
+   */
+  content_type?: ServiceDropInUpdateRequestContentType;
+  /** Template variables. Only used when content_type is "template".
+   */
+  vars?: ServiceDropInUpdateRequestVars;
+  /** Restart the unit when the drop-in changed. Units that are not running are left stopped.
+   */
+  restart?: boolean;
+}
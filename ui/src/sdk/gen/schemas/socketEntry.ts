@@ -0,0 +1,21 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { SocketEntryStatus } from './socketEntryStatus';
+import type { SocketInfo } from './socketInfo';
+
+/**
+ * Sockets reported by a single host.
+ */
+export interface SocketEntry {
+  /** Hostname of the agent that reported this entry. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: SocketEntryStatus;
+  sockets?: SocketInfo[];
+  /** Error message if the agent failed to list sockets. */
+  error?: string;
+}
@@ -0,0 +1,34 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { SocketInfoProtocol } from './socketInfoProtocol';
+import type { SocketInfoState } from './socketInfoState';
+
+/**
+ * A TCP or UDP socket.
+ */
+export interface SocketInfo {
+  /** Transport protocol. */
+  protocol?: SocketInfoProtocol;
+  /** Address family, either inet or inet6. */
+  family?: string;
+  /** Socket state. Unconnected UDP sockets are reported as listening.
+   */
+  state?: SocketInfoState;
+  /** Local IP address. */
+  local_address?: string;
+  /** Local port. */
+  local_port?: number;
+  /** Remote IP address of an established connection. */
+  remote_address?: string;
+  /** Remote port of an established connection. */
+  remote_port?: number;
+  /** ID of the process that owns the socket. Omitted when the owner cannot be determined.
+   */
+  pid?: number;
+  /** Name of the process that owns the socket. */
+  process?: string;
+}
@@ -0,0 +1,17 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * Transport protocol.
+ */
+export type SocketInfoProtocol = typeof SocketInfoProtocol[keyof typeof SocketInfoProtocol];
+
+
+export const SocketInfoProtocol = {
+  tcp: 'tcp',
+  udp: 'udp',
+} as const;
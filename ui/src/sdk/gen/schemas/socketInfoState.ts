@@ -0,0 +1,18 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * Socket state. Unconnected UDP sockets are reported as listening.
+
+ */
+export type SocketInfoState = typeof SocketInfoState[keyof typeof SocketInfoState];
+
+
+export const SocketInfoState = {
+  listen: 'listen',
+  established: 'established',
+} as const;
@@ -0,0 +1,15 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { SshdDirective } from './sshdDirective';
+
+export interface SshdCreateRequest {
+  /** Drop-in name. The file is written as /etc/ssh/sshd_config.d/{name}.conf.
+   */
+  name: string;
+  /** Directives written to the drop-in, in order. */
+  directives: SshdDirective[];
+}
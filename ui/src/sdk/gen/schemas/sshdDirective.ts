@@ -0,0 +1,18 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * A single sshd configuration line, rendered as `<keyword> <value>`.
+
+ */
+export interface SshdDirective {
+  /** sshd_config keyword. Match and Include are not supported.
+   */
+  keyword: string;
+  /** Value of the keyword. */
+  value: string;
+}
@@ -0,0 +1,19 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { SshdDirective } from './sshdDirective';
+
+/**
+ * A managed sshd drop-in.
+ */
+export interface SshdDropInInfo {
+  /** Drop-in name. */
+  name?: string;
+  /** Full path of the drop-in file. */
+  path?: string;
+  /** Directives in the drop-in. */
+  directives?: SshdDirective[];
+}
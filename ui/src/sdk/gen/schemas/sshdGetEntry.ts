@@ -0,0 +1,26 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { SshdDirective } from './sshdDirective';
+import type { SshdDropInInfo } from './sshdDropInInfo';
+import type { SshdGetEntryStatus } from './sshdGetEntryStatus';
+
+/**
+ * sshd configuration for a single agent.
+ */
+export interface SshdGetEntry {
+  /** Hostname of the agent that reported this entry. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: SshdGetEntryStatus;
+  /** Effective configuration reported by `sshd -T`. Keywords are lower case; keywords with several values appear once per value.
+   */
+  effective?: SshdDirective[];
+  /** Managed sshd drop-ins on this host. */
+  drop_ins?: SshdDropInInfo[];
+  /** Error message if the agent failed. */
+  error?: string;
+}
@@ -0,0 +1,23 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { SshdMutationEntryStatus } from './sshdMutationEntryStatus';
+
+/**
+ * Result of an sshd drop-in mutation for one host.
+ */
+export interface SshdMutationEntry {
+  /** Hostname of the agent that processed this operation. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: SshdMutationEntryStatus;
+  /** Name of the sshd drop-in. */
+  name?: string;
+  /** Whether the operation modified system state. */
+  changed?: boolean;
+  /** Error message if the agent failed. */
+  error?: string;
+}
@@ -0,0 +1,12 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { SshdDirective } from './sshdDirective';
+
+export interface SshdUpdateRequest {
+  /** Directives that replace the current contents of the drop-in. */
+  directives: SshdDirective[];
+}
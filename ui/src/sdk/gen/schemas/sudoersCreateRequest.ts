@@ -0,0 +1,15 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { SudoersRule } from './sudoersRule';
+
+export interface SudoersCreateRequest {
+  /** Drop-in file name in /etc/sudoers.d. Must not contain a dot, since sudo skips such files.
+   */
+  name: string;
+  /** Rules written to the drop-in. */
+  rules: SudoersRule[];
+}
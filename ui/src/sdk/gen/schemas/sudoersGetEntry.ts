@@ -0,0 +1,21 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { SudoersGetEntryStatus } from './sudoersGetEntryStatus';
+import type { SudoersInfo } from './sudoersInfo';
+
+/**
+ * Sudoers get result for a single agent.
+ */
+export interface SudoersGetEntry {
+  /** Hostname of the agent that reported this entry. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: SudoersGetEntryStatus;
+  drop_in?: SudoersInfo;
+  /** Error message if the agent failed. */
+  error?: string;
+}
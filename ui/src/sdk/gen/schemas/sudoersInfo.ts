@@ -0,0 +1,19 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { SudoersRule } from './sudoersRule';
+
+/**
+ * A managed sudoers drop-in.
+ */
+export interface SudoersInfo {
+  /** Drop-in file name. */
+  name?: string;
+  /** Full path of the drop-in file. */
+  path?: string;
+  /** Rules in the drop-in. */
+  rules?: SudoersRule[];
+}
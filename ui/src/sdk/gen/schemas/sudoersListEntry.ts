@@ -0,0 +1,22 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { SudoersInfo } from './sudoersInfo';
+import type { SudoersListEntryStatus } from './sudoersListEntryStatus';
+
+/**
+ * Sudoers list result for a single agent.
+ */
+export interface SudoersListEntry {
+  /** Hostname of the agent that reported this entry. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: SudoersListEntryStatus;
+  /** Managed sudoers drop-ins on this host. */
+  drop_ins?: SudoersInfo[];
+  /** Error message if the agent failed. */
+  error?: string;
+}
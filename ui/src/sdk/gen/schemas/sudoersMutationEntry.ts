@@ -0,0 +1,23 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { SudoersMutationEntryStatus } from './sudoersMutationEntryStatus';
+
+/**
+ * Result of a sudoers drop-in mutation for one host.
+ */
+export interface SudoersMutationEntry {
+  /** Hostname of the agent that processed this operation. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: SudoersMutationEntryStatus;
+  /** Name of the sudoers drop-in. */
+  name?: string;
+  /** Whether the operation modified system state. */
+  changed?: boolean;
+  /** Error message if the agent failed. */
+  error?: string;
+}
@@ -0,0 +1,25 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * A sudoers user specification, rendered as `<principal> <hosts>=(<run_as>) [NOPASSWD: ]<commands>`.
+
+ */
+export interface SudoersRule {
+  /** User, %group or +netgroup the rule applies to.
+   */
+  principal: string;
+  /** Hosts the rule applies to. Defaults to ALL. */
+  hosts?: string[];
+  /** Users the commands may be run as. Defaults to ALL. */
+  run_as?: string[];
+  /** Allow the commands to run without a password prompt. */
+  no_password?: boolean;
+  /** Fully qualified commands the principal may run. Defaults to ALL.
+   */
+  commands?: string[];
+}
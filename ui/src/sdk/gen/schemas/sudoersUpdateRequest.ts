@@ -0,0 +1,12 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { SudoersRule } from './sudoersRule';
+
+export interface SudoersUpdateRequest {
+  /** Rules that replace the current contents of the drop-in. */
+  rules: SudoersRule[];
+}
@@ -0,0 +1,28 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * A swap device or swap file.
+ */
+export interface SwapArea {
+  /** Managed swap file name. Empty for unmanaged areas. */
+  name?: string;
+  /** Device or file path. */
+  path?: string;
+  /** Area type (file or partition). */
+  type?: string;
+  /** Size in bytes. */
+  size?: number;
+  /** Used space in bytes. */
+  used?: number;
+  /** Swap priority. */
+  priority?: number;
+  /** Whether the area is currently in use. */
+  active?: boolean;
+  /** Whether the swap file is managed by OSAPI. */
+  managed?: boolean;
+}
@@ -0,0 +1,17 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+export interface SwapCreateRequest {
+  /** Name identifying the managed swap file. The file is created at /var/lib/osapi/swap/{name}.
+   */
+  name: string;
+  /** Size of the swap file in MiB. */
+  size_mb: number;
+  /** Swap priority. Higher values are used first. Defaults to the kernel-assigned priority.
+   */
+  priority?: number;
+}
@@ -0,0 +1,28 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { SwapArea } from './swapArea';
+import type { SwapGetEntryStatus } from './swapGetEntryStatus';
+
+/**
+ * Swap status for a single agent.
+ */
+export interface SwapGetEntry {
+  /** Hostname of the agent that reported this entry. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: SwapGetEntryStatus;
+  /** Current vm.swappiness value. */
+  swappiness?: number;
+  /** Total active swap in bytes. */
+  total?: number;
+  /** Used swap in bytes. */
+  used?: number;
+  /** Swap areas on this host. */
+  areas?: SwapArea[];
+  /** Error message if the agent failed. */
+  error?: string;
+}
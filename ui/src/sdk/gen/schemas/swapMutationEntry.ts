@@ -0,0 +1,25 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { SwapMutationEntryStatus } from './swapMutationEntryStatus';
+
+/**
+ * Result of a swap mutation operation for one host.
+ */
+export interface SwapMutationEntry {
+  /** Hostname of the agent that processed this operation. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: SwapMutationEntryStatus;
+  /** Name of the managed swap file. */
+  name?: string;
+  /** Path of the managed swap file. */
+  path?: string;
+  /** Whether the operation modified system state. */
+  changed?: boolean;
+  /** Error message if the agent failed. */
+  error?: string;
+}
@@ -0,0 +1,32 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { TimerCreateRequestContentType } from './timerCreateRequestContentType';
+import type { TimerCreateRequestVars } from './timerCreateRequestVars';
+
+export interface TimerCreateRequest {
+  /** Name for the timer. The units are written as osapi-{name}.timer and osapi-{name}.service under /etc/systemd/system/.
+   */
+  name: string;
+  /** systemd OnCalendar expression (e.g., "*-*-* 02:00:00" or "daily"). Validated on the agent with systemd-analyze.
+   */
+  schedule: string;
+  /** Command run by the service unit. Mutually exclusive with object — provide exactly one.
+   */
+  command?: string;
+  /** Name of the uploaded file in the object store to deploy as the service unit. Mutually exclusive with command — provide exactly one.
+   */
+  object?: string;
+  /** User to run the command as. Only applies when using command.
+   */
+  user?: string;
+  /** Content type: "raw" or "template". When "template", the object is rendered through Go text/template with agent facts and user-supplied vars.
+   */
+  content_type?: TimerCreateRequestContentType;
+  /** Template variables. Only used when content_type is "template".
+   */
+  vars?: TimerCreateRequestVars;
+}
@@ -0,0 +1,18 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * Content type: "raw" or "template". When "template", the object is rendered through Go text/template with agent facts and user-supplied vars.
+
+ */
+export type TimerCreateRequestContentType = typeof TimerCreateRequestContentType[keyof typeof TimerCreateRequestContentType];
+
+
+export const TimerCreateRequestContentType = {
+  raw: 'raw',
+  template: 'template',
+} as const;
@@ -0,0 +1,40 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { TimerEntryStatus } from './timerEntryStatus';
+
+/**
+ * A systemd timer.
+ */
+export interface TimerEntry {
+  /** Hostname of the agent that reported this timer. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: TimerEntryStatus;
+  /** Timer name. */
+  name?: string;
+  /** Timer unit name. */
+  unit?: string;
+  /** Unit started when the timer elapses. */
+  activates?: string;
+  /** OnCalendar expression. Present for timers managed by osapi.
+   */
+  schedule?: string;
+  /** Command run by the service unit. */
+  command?: string;
+  /** Object store name for the deployed service unit. */
+  object?: string;
+  /** User the command runs as. */
+  user?: string;
+  /** Whether the timer is managed by osapi. */
+  managed?: boolean;
+  /** Next time the timer elapses. */
+  next_run?: string;
+  /** Last time the timer elapsed. */
+  last_run?: string;
+  /** Error message if the agent failed to retrieve this timer. */
+  error?: string;
+}
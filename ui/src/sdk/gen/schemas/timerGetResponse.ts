@@ -0,0 +1,16 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { TimerEntry } from './timerEntry';
+
+/**
+ * Collection response for a single timer get operation.
+ */
+export interface TimerGetResponse {
+  /** The job ID used to process this request. */
+  job_id?: string;
+  results: TimerEntry[];
+}
@@ -0,0 +1,23 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { TimerMutationResultStatus } from './timerMutationResultStatus';
+
+/**
+ * Result of a timer create, update, or delete operation for one host.
+ */
+export interface TimerMutationResult {
+  /** Hostname of the agent that processed this operation. */
+  hostname: string;
+  /** The status of the operation for this host. */
+  status: TimerMutationResultStatus;
+  /** Timer name. */
+  name?: string;
+  /** Whether the operation modified system state. */
+  changed?: boolean;
+  /** Error message if the agent failed. */
+  error?: string;
+}
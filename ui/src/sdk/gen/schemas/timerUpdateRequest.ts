@@ -0,0 +1,28 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type { TimerUpdateRequestContentType } from './timerUpdateRequestContentType';
+import type { TimerUpdateRequestVars } from './timerUpdateRequestVars';
+
+export interface TimerUpdateRequest {
+  /** New systemd OnCalendar expression.
+   */
+  schedule?: string;
+  /** New command for the service unit. Replaces an object.
+   */
+  command?: string;
+  /** New object to deploy as the service unit. Replaces a command.
+   */
+  object?: string;
+  /** User to run the command as. */
+  user?: string;
+  /** Content type: "raw" or "template".
+   */
+  content_type?: TimerUpdateRequestContentType;
+  /** Template variables.
+   */
+  vars?: TimerUpdateRequestVars;
+}
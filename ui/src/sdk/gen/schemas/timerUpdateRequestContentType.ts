@@ -0,0 +1,18 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * Content type: "raw" or "template".
+
+ */
+export type TimerUpdateRequestContentType = typeof TimerUpdateRequestContentType[keyof typeof TimerUpdateRequestContentType];
+
+
+export const TimerUpdateRequestContentType = {
+  raw: 'raw',
+  template: 'template',
+} as const;
@@ -22,4 +22,18 @@ export interface UserCreateRequest {
   password?: string;
   /** Create a system account. */
   system?: boolean;
+  /** Minimum number of days between password changes.
+   */
+  min_days?: number;
+  /** Maximum number of days a password is valid.
+   */
+  max_days?: number;
+  /** Number of days of warning before the password expires.
+   */
+  warn_days?: number;
+  /** Account expiry date (YYYY-MM-DD).
+   */
+  account_expires?: string;
+  /** Require the password to be changed at first login. */
+  force_password_change?: boolean;
 }
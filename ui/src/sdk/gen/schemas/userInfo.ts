@@ -4,6 +4,7 @@
  * Agent Management API
  * OpenAPI spec version: 1.0.0
  */
+import type { UserPasswordAging } from './userPasswordAging';
 
 /**
  * A user account on the target node.
@@ -23,4 +24,5 @@ export interface UserInfo {
   groups?: string[];
   /** Whether the account is locked. */
   locked?: boolean;
+  aging?: UserPasswordAging;
 }
@@ -0,0 +1,27 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+
+/**
+ * Password aging and account expiry settings of a user account, as reported by chage. Dates are YYYY-MM-DD and omitted when unset.
+
+ */
+export interface UserPasswordAging {
+  /** Date of the last password change. */
+  last_change?: string;
+  /** Whether the password must be changed at next login. */
+  must_change?: boolean;
+  /** Date the password expires. */
+  password_expires?: string;
+  /** Date the account expires. */
+  account_expires?: string;
+  /** Minimum number of days between password changes. */
+  min_days?: number;
+  /** Maximum number of days a password is valid. */
+  max_days?: number;
+  /** Number of days of warning before the password expires. */
+  warn_days?: number;
+}
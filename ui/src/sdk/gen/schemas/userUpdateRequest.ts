@@ -14,4 +14,18 @@ export interface UserUpdateRequest {
   groups?: string[];
   /** Lock or unlock the account. */
   lock?: boolean;
+  /** Minimum number of days between password changes. -1 removes the limit.
+   */
+  min_days?: number;
+  /** Maximum number of days a password is valid. -1 removes the limit.
+   */
+  max_days?: number;
+  /** Number of days of warning before the password expires. -1 removes the limit.
+   */
+  warn_days?: number;
+  /** Account expiry date (YYYY-MM-DD), or "never" to remove the expiry.
+   */
+  account_expires?: string;
+  /** Expire the password so it must be changed at next login. */
+  force_password_change?: boolean;
 }
@@ -5,8 +5,13 @@
  * OpenAPI spec version: 1.0.0
  */
 import type {
+  DeleteNodeServiceDropInParams,
   ErrorResponse,
   ServiceCreateRequest,
+  ServiceDropInCreateRequest,
+  ServiceDropInListResponse,
+  ServiceDropInMutationResponse,
+  ServiceDropInUpdateRequest,
   ServiceGetResponse,
   ServiceListResponse,
   ServiceMutationResponse,
@@ -635,3 +640,274 @@ export const postNodeServiceDisable = async (hostname: string,
 );}
 
 
+/**
+ * List the drop-in override files of a systemd unit on the target node, including drop-ins not managed by osapi.
+
+ * @summary List service drop-ins
+ */
+export type getNodeServiceDropInResponse200 = {
+  data: ServiceDropInListResponse
+  status: 200
+}
+
+export type getNodeServiceDropInResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeServiceDropInResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeServiceDropInResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeServiceDropInResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeServiceDropInResponseSuccess = (getNodeServiceDropInResponse200) & {
+  headers: Headers;
+};
+export type getNodeServiceDropInResponseError = (getNodeServiceDropInResponse400 | getNodeServiceDropInResponse401 | getNodeServiceDropInResponse403 | getNodeServiceDropInResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeServiceDropInResponse = (getNodeServiceDropInResponseSuccess | getNodeServiceDropInResponseError)
+
+export const getGetNodeServiceDropInUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/service/${name}/dropin`
+}
+
+export const getNodeServiceDropIn = async (hostname: string,
+    name: string, options?: RequestInit): Promise<getNodeServiceDropInResponse> => {
+
+  return apiFetch<getNodeServiceDropInResponse>(getGetNodeServiceDropInUrl(hostname,name),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Deploy a drop-in override file for an existing systemd unit on the target node, reload systemd, and optionally restart the unit.
+
+ * @summary Create a service drop-in
+ */
+export type postNodeServiceDropInResponse200 = {
+  data: ServiceDropInMutationResponse
+  status: 200
+}
+
+export type postNodeServiceDropInResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type postNodeServiceDropInResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type postNodeServiceDropInResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type postNodeServiceDropInResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type postNodeServiceDropInResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type postNodeServiceDropInResponseSuccess = (postNodeServiceDropInResponse200) & {
+  headers: Headers;
+};
+export type postNodeServiceDropInResponseError = (postNodeServiceDropInResponse400 | postNodeServiceDropInResponse401 | postNodeServiceDropInResponse403 | postNodeServiceDropInResponse404 | postNodeServiceDropInResponse500) & {
+  headers: Headers;
+};
+
+export type postNodeServiceDropInResponse = (postNodeServiceDropInResponseSuccess | postNodeServiceDropInResponseError)
+
+export const getPostNodeServiceDropInUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/service/${name}/dropin`
+}
+
+export const postNodeServiceDropIn = async (hostname: string,
+    name: string,
+    serviceDropInCreateRequest: ServiceDropInCreateRequest, options?: RequestInit): Promise<postNodeServiceDropInResponse> => {
+
+  return apiFetch<postNodeServiceDropInResponse>(getPostNodeServiceDropInUrl(hostname,name),
+  {
+    ...options,
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      serviceDropInCreateRequest,)
+  }
+);}
+
+
+/**
+ * Redeploy a managed drop-in override file on the target node. Systemd is reloaded, and the unit optionally restarted, only when the drop-in content changed.
+
+ * @summary Update a service drop-in
+ */
+export type putNodeServiceDropInResponse200 = {
+  data: ServiceDropInMutationResponse
+  status: 200
+}
+
+export type putNodeServiceDropInResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type putNodeServiceDropInResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type putNodeServiceDropInResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type putNodeServiceDropInResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type putNodeServiceDropInResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type putNodeServiceDropInResponseSuccess = (putNodeServiceDropInResponse200) & {
+  headers: Headers;
+};
+export type putNodeServiceDropInResponseError = (putNodeServiceDropInResponse400 | putNodeServiceDropInResponse401 | putNodeServiceDropInResponse403 | putNodeServiceDropInResponse404 | putNodeServiceDropInResponse500) & {
+  headers: Headers;
+};
+
+export type putNodeServiceDropInResponse = (putNodeServiceDropInResponseSuccess | putNodeServiceDropInResponseError)
+
+export const getPutNodeServiceDropInUrl = (hostname: string,
+    name: string,
+    dropin: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/service/${name}/dropin/${dropin}`
+}
+
+export const putNodeServiceDropIn = async (hostname: string,
+    name: string,
+    dropin: string,
+    serviceDropInUpdateRequest: ServiceDropInUpdateRequest, options?: RequestInit): Promise<putNodeServiceDropInResponse> => {
+
+  return apiFetch<putNodeServiceDropInResponse>(getPutNodeServiceDropInUrl(hostname,name,dropin),
+  {
+    ...options,
+    method: 'PUT',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      serviceDropInUpdateRequest,)
+  }
+);}
+
+
+/**
+ * Remove a managed drop-in override file from the target node, reload systemd, and optionally restart the unit.
+
+ * @summary Delete a service drop-in
+ */
+export type deleteNodeServiceDropInResponse200 = {
+  data: ServiceDropInMutationResponse
+  status: 200
+}
+
+export type deleteNodeServiceDropInResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type deleteNodeServiceDropInResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type deleteNodeServiceDropInResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type deleteNodeServiceDropInResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type deleteNodeServiceDropInResponseSuccess = (deleteNodeServiceDropInResponse200) & {
+  headers: Headers;
+};
+export type deleteNodeServiceDropInResponseError = (deleteNodeServiceDropInResponse400 | deleteNodeServiceDropInResponse401 | deleteNodeServiceDropInResponse403 | deleteNodeServiceDropInResponse500) & {
+  headers: Headers;
+};
+
+export type deleteNodeServiceDropInResponse = (deleteNodeServiceDropInResponseSuccess | deleteNodeServiceDropInResponseError)
+
+export const getDeleteNodeServiceDropInUrl = (hostname: string,
+    name: string,
+    dropin: string,
+    params?: DeleteNodeServiceDropInParams,) => {
+  const normalizedParams = new URLSearchParams();
+
+  Object.entries(params || {}).forEach(([key, value]) => {
+
+    if (value !== undefined) {
+      normalizedParams.append(key, value === null ? 'null' : value.toString())
+    }
+  });
+
+  const stringifiedParams = normalizedParams.toString();
+
+  return stringifiedParams.length > 0 ? `/api/node/${hostname}/service/${name}/dropin/${dropin}?${stringifiedParams}` : `/api/node/${hostname}/service/${name}/dropin/${dropin}`
+}
+
+export const deleteNodeServiceDropIn = async (hostname: string,
+    name: string,
+    dropin: string,
+    params?: DeleteNodeServiceDropInParams, options?: RequestInit): Promise<deleteNodeServiceDropInResponse> => {
+
+  return apiFetch<deleteNodeServiceDropInResponse>(getDeleteNodeServiceDropInUrl(hostname,name,dropin,params),
+  {
+    ...options,
+    method: 'DELETE'
+
+
+  }
+);}
+
+
@@ -0,0 +1,265 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type {
+  ErrorResponse,
+  SshdCreateRequest,
+  SshdGetResponse,
+  SshdMutationResponse,
+  SshdUpdateRequest
+} from '../schemas';
+
+import { apiFetch } from '../../fetch';
+
+/**
+ * Get the effective OpenSSH server configuration reported by `sshd -T` and the osapi-managed drop-ins in /etc/ssh/sshd_config.d on the target node. Drop-ins written by hand are not listed, but their settings are part of the effective configuration.
+
+ * @summary Get sshd configuration
+ */
+export type getNodeSshdResponse200 = {
+  data: SshdGetResponse
+  status: 200
+}
+
+export type getNodeSshdResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeSshdResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeSshdResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeSshdResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeSshdResponseSuccess = (getNodeSshdResponse200) & {
+  headers: Headers;
+};
+export type getNodeSshdResponseError = (getNodeSshdResponse400 | getNodeSshdResponse401 | getNodeSshdResponse403 | getNodeSshdResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeSshdResponse = (getNodeSshdResponseSuccess | getNodeSshdResponseError)
+
+export const getGetNodeSshdUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/sshd`
+}
+
+export const getNodeSshd = async (hostname: string, options?: RequestInit): Promise<getNodeSshdResponse> => {
+
+  return apiFetch<getNodeSshdResponse>(getGetNodeSshdUrl(hostname),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Write directives to /etc/ssh/sshd_config.d/{name}.conf on the target node, check the resulting configuration with `sshd -t` and reload the daemon. Established sessions are not affected. When the check or the reload fails, the drop-in is removed again.
+
+ * @summary Create an sshd drop-in
+ */
+export type postNodeSshdResponse200 = {
+  data: SshdMutationResponse
+  status: 200
+}
+
+export type postNodeSshdResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type postNodeSshdResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type postNodeSshdResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type postNodeSshdResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type postNodeSshdResponseSuccess = (postNodeSshdResponse200) & {
+  headers: Headers;
+};
+export type postNodeSshdResponseError = (postNodeSshdResponse400 | postNodeSshdResponse401 | postNodeSshdResponse403 | postNodeSshdResponse500) & {
+  headers: Headers;
+};
+
+export type postNodeSshdResponse = (postNodeSshdResponseSuccess | postNodeSshdResponseError)
+
+export const getPostNodeSshdUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/sshd`
+}
+
+export const postNodeSshd = async (hostname: string,
+    sshdCreateRequest: SshdCreateRequest, options?: RequestInit): Promise<postNodeSshdResponse> => {
+
+  return apiFetch<postNodeSshdResponse>(getPostNodeSshdUrl(hostname),
+  {
+    ...options,
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      sshdCreateRequest,)
+  }
+);}
+
+
+/**
+ * Replace the directives of a managed sshd drop-in on the target node. The new configuration is checked with `sshd -t` and the daemon is reloaded; when either step fails the previous drop-in is restored.
+
+ * @summary Update a managed sshd drop-in
+ */
+export type putNodeSshdResponse200 = {
+  data: SshdMutationResponse
+  status: 200
+}
+
+export type putNodeSshdResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type putNodeSshdResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type putNodeSshdResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type putNodeSshdResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type putNodeSshdResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type putNodeSshdResponseSuccess = (putNodeSshdResponse200) & {
+  headers: Headers;
+};
+export type putNodeSshdResponseError = (putNodeSshdResponse400 | putNodeSshdResponse401 | putNodeSshdResponse403 | putNodeSshdResponse404 | putNodeSshdResponse500) & {
+  headers: Headers;
+};
+
+export type putNodeSshdResponse = (putNodeSshdResponseSuccess | putNodeSshdResponseError)
+
+export const getPutNodeSshdUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/sshd/${name}`
+}
+
+export const putNodeSshd = async (hostname: string,
+    name: string,
+    sshdUpdateRequest: SshdUpdateRequest, options?: RequestInit): Promise<putNodeSshdResponse> => {
+
+  return apiFetch<putNodeSshdResponse>(getPutNodeSshdUrl(hostname,name),
+  {
+    ...options,
+    method: 'PUT',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      sshdUpdateRequest,)
+  }
+);}
+
+
+/**
+ * Remove a managed sshd drop-in from /etc/ssh/sshd_config.d on the target node and reload the daemon. The drop-in is restored when the remaining configuration fails `sshd -t` or the reload.
+
+ * @summary Delete a managed sshd drop-in
+ */
+export type deleteNodeSshdResponse200 = {
+  data: SshdMutationResponse
+  status: 200
+}
+
+export type deleteNodeSshdResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type deleteNodeSshdResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type deleteNodeSshdResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type deleteNodeSshdResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type deleteNodeSshdResponseSuccess = (deleteNodeSshdResponse200) & {
+  headers: Headers;
+};
+export type deleteNodeSshdResponseError = (deleteNodeSshdResponse400 | deleteNodeSshdResponse401 | deleteNodeSshdResponse403 | deleteNodeSshdResponse500) & {
+  headers: Headers;
+};
+
+export type deleteNodeSshdResponse = (deleteNodeSshdResponseSuccess | deleteNodeSshdResponseError)
+
+export const getDeleteNodeSshdUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/sshd/${name}`
+}
+
+export const deleteNodeSshd = async (hostname: string,
+    name: string, options?: RequestInit): Promise<deleteNodeSshdResponse> => {
+
+  return apiFetch<deleteNodeSshdResponse>(getDeleteNodeSshdUrl(hostname,name),
+  {
+    ...options,
+    method: 'DELETE'
+
+
+  }
+);}
+
+
@@ -0,0 +1,332 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type {
+  ErrorResponse,
+  SudoersCreateRequest,
+  SudoersGetResponse,
+  SudoersListResponse,
+  SudoersMutationResponse,
+  SudoersUpdateRequest
+} from '../schemas';
+
+import { apiFetch } from '../../fetch';
+
+/**
+ * List the osapi-managed sudoers drop-ins in /etc/sudoers.d on the target node. Files written by hand are not reported.
+
+ * @summary List sudoers drop-ins
+ */
+export type getNodeSudoersResponse200 = {
+  data: SudoersListResponse
+  status: 200
+}
+
+export type getNodeSudoersResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeSudoersResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeSudoersResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeSudoersResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeSudoersResponseSuccess = (getNodeSudoersResponse200) & {
+  headers: Headers;
+};
+export type getNodeSudoersResponseError = (getNodeSudoersResponse400 | getNodeSudoersResponse401 | getNodeSudoersResponse403 | getNodeSudoersResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeSudoersResponse = (getNodeSudoersResponseSuccess | getNodeSudoersResponseError)
+
+export const getGetNodeSudoersUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/sudoers`
+}
+
+export const getNodeSudoers = async (hostname: string, options?: RequestInit): Promise<getNodeSudoersResponse> => {
+
+  return apiFetch<getNodeSudoersResponse>(getGetNodeSudoersUrl(hostname),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Render rules into /etc/sudoers.d/{name} on the target node. The candidate file is checked with `visudo -cf` and only moved into place when the check passes; a drop-in that fails validation is never written.
+
+ * @summary Create a sudoers drop-in
+ */
+export type postNodeSudoersResponse200 = {
+  data: SudoersMutationResponse
+  status: 200
+}
+
+export type postNodeSudoersResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type postNodeSudoersResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type postNodeSudoersResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type postNodeSudoersResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type postNodeSudoersResponseSuccess = (postNodeSudoersResponse200) & {
+  headers: Headers;
+};
+export type postNodeSudoersResponseError = (postNodeSudoersResponse400 | postNodeSudoersResponse401 | postNodeSudoersResponse403 | postNodeSudoersResponse500) & {
+  headers: Headers;
+};
+
+export type postNodeSudoersResponse = (postNodeSudoersResponseSuccess | postNodeSudoersResponseError)
+
+export const getPostNodeSudoersUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/sudoers`
+}
+
+export const postNodeSudoers = async (hostname: string,
+    sudoersCreateRequest: SudoersCreateRequest, options?: RequestInit): Promise<postNodeSudoersResponse> => {
+
+  return apiFetch<postNodeSudoersResponse>(getPostNodeSudoersUrl(hostname),
+  {
+    ...options,
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      sudoersCreateRequest,)
+  }
+);}
+
+
+/**
+ * Get a managed sudoers drop-in and its rules on the target node.
+
+ * @summary Get sudoers drop-in details
+ */
+export type getNodeSudoersByNameResponse200 = {
+  data: SudoersGetResponse
+  status: 200
+}
+
+export type getNodeSudoersByNameResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeSudoersByNameResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeSudoersByNameResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeSudoersByNameResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type getNodeSudoersByNameResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeSudoersByNameResponseSuccess = (getNodeSudoersByNameResponse200) & {
+  headers: Headers;
+};
+export type getNodeSudoersByNameResponseError = (getNodeSudoersByNameResponse400 | getNodeSudoersByNameResponse401 | getNodeSudoersByNameResponse403 | getNodeSudoersByNameResponse404 | getNodeSudoersByNameResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeSudoersByNameResponse = (getNodeSudoersByNameResponseSuccess | getNodeSudoersByNameResponseError)
+
+export const getGetNodeSudoersByNameUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/sudoers/${name}`
+}
+
+export const getNodeSudoersByName = async (hostname: string,
+    name: string, options?: RequestInit): Promise<getNodeSudoersByNameResponse> => {
+
+  return apiFetch<getNodeSudoersByNameResponse>(getGetNodeSudoersByNameUrl(hostname,name),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Replace the rules of a managed sudoers drop-in on the target node. The candidate is checked with `visudo -cf` first; when the check fails the live drop-in is left untouched.
+
+ * @summary Update a managed sudoers drop-in
+ */
+export type putNodeSudoersResponse200 = {
+  data: SudoersMutationResponse
+  status: 200
+}
+
+export type putNodeSudoersResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type putNodeSudoersResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type putNodeSudoersResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type putNodeSudoersResponse404 = {
+  data: ErrorResponse
+  status: 404
+}
+
+export type putNodeSudoersResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type putNodeSudoersResponseSuccess = (putNodeSudoersResponse200) & {
+  headers: Headers;
+};
+export type putNodeSudoersResponseError = (putNodeSudoersResponse400 | putNodeSudoersResponse401 | putNodeSudoersResponse403 | putNodeSudoersResponse404 | putNodeSudoersResponse500) & {
+  headers: Headers;
+};
+
+export type putNodeSudoersResponse = (putNodeSudoersResponseSuccess | putNodeSudoersResponseError)
+
+export const getPutNodeSudoersUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/sudoers/${name}`
+}
+
+export const putNodeSudoers = async (hostname: string,
+    name: string,
+    sudoersUpdateRequest: SudoersUpdateRequest, options?: RequestInit): Promise<putNodeSudoersResponse> => {
+
+  return apiFetch<putNodeSudoersResponse>(getPutNodeSudoersUrl(hostname,name),
+  {
+    ...options,
+    method: 'PUT',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      sudoersUpdateRequest,)
+  }
+);}
+
+
+/**
+ * Remove a managed sudoers drop-in from /etc/sudoers.d on the target node.
+
+ * @summary Delete a managed sudoers drop-in
+ */
+export type deleteNodeSudoersResponse200 = {
+  data: SudoersMutationResponse
+  status: 200
+}
+
+export type deleteNodeSudoersResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type deleteNodeSudoersResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type deleteNodeSudoersResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type deleteNodeSudoersResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type deleteNodeSudoersResponseSuccess = (deleteNodeSudoersResponse200) & {
+  headers: Headers;
+};
+export type deleteNodeSudoersResponseError = (deleteNodeSudoersResponse400 | deleteNodeSudoersResponse401 | deleteNodeSudoersResponse403 | deleteNodeSudoersResponse500) & {
+  headers: Headers;
+};
+
+export type deleteNodeSudoersResponse = (deleteNodeSudoersResponseSuccess | deleteNodeSudoersResponseError)
+
+export const getDeleteNodeSudoersUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/sudoers/${name}`
+}
+
+export const deleteNodeSudoers = async (hostname: string,
+    name: string, options?: RequestInit): Promise<deleteNodeSudoersResponse> => {
+
+  return apiFetch<deleteNodeSudoersResponse>(getDeleteNodeSudoersUrl(hostname,name),
+  {
+    ...options,
+    method: 'DELETE'
+
+
+  }
+);}
+
+
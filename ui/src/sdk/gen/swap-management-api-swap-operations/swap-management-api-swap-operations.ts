@@ -0,0 +1,196 @@
+/**
+ * Generated by orval 🍺
+ * Do not edit manually.
+ * Agent Management API
+ * OpenAPI spec version: 1.0.0
+ */
+import type {
+  ErrorResponse,
+  SwapCreateRequest,
+  SwapGetResponse,
+  SwapMutationResponse
+} from '../schemas';
+
+import { apiFetch } from '../../fetch';
+
+/**
+ * Get the active swap devices and files, managed swap files that are not active, and the current swappiness on the target node.
+
+ * @summary Get swap status
+ */
+export type getNodeSwapResponse200 = {
+  data: SwapGetResponse
+  status: 200
+}
+
+export type getNodeSwapResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type getNodeSwapResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type getNodeSwapResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type getNodeSwapResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type getNodeSwapResponseSuccess = (getNodeSwapResponse200) & {
+  headers: Headers;
+};
+export type getNodeSwapResponseError = (getNodeSwapResponse400 | getNodeSwapResponse401 | getNodeSwapResponse403 | getNodeSwapResponse500) & {
+  headers: Headers;
+};
+
+export type getNodeSwapResponse = (getNodeSwapResponseSuccess | getNodeSwapResponseError)
+
+export const getGetNodeSwapUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/swap`
+}
+
+export const getNodeSwap = async (hostname: string, options?: RequestInit): Promise<getNodeSwapResponse> => {
+
+  return apiFetch<getNodeSwapResponse>(getGetNodeSwapUrl(hostname),
+  {
+    ...options,
+    method: 'GET'
+
+
+  }
+);}
+
+
+/**
+ * Allocate a managed swap file on the target node and enable it through a persistent systemd swap unit. Reports changed false when a swap file with the same name is already managed.
+
+ * @summary Create a swap file
+ */
+export type postNodeSwapResponse200 = {
+  data: SwapMutationResponse
+  status: 200
+}
+
+export type postNodeSwapResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type postNodeSwapResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type postNodeSwapResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type postNodeSwapResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type postNodeSwapResponseSuccess = (postNodeSwapResponse200) & {
+  headers: Headers;
+};
+export type postNodeSwapResponseError = (postNodeSwapResponse400 | postNodeSwapResponse401 | postNodeSwapResponse403 | postNodeSwapResponse500) & {
+  headers: Headers;
+};
+
+export type postNodeSwapResponse = (postNodeSwapResponseSuccess | postNodeSwapResponseError)
+
+export const getPostNodeSwapUrl = (hostname: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/swap`
+}
+
+export const postNodeSwap = async (hostname: string,
+    swapCreateRequest: SwapCreateRequest, options?: RequestInit): Promise<postNodeSwapResponse> => {
+
+  return apiFetch<postNodeSwapResponse>(getPostNodeSwapUrl(hostname),
+  {
+    ...options,
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json', ...options?.headers },
+    body: JSON.stringify(
+      swapCreateRequest,)
+  }
+);}
+
+
+/**
+ * Disable a managed swap file on the target node, remove its systemd swap unit, and delete the file. Reports changed false when no swap file with that name is managed.
+
+ * @summary Delete a swap file
+ */
+export type deleteNodeSwapResponse200 = {
+  data: SwapMutationResponse
+  status: 200
+}
+
+export type deleteNodeSwapResponse400 = {
+  data: ErrorResponse
+  status: 400
+}
+
+export type deleteNodeSwapResponse401 = {
+  data: ErrorResponse
+  status: 401
+}
+
+export type deleteNodeSwapResponse403 = {
+  data: ErrorResponse
+  status: 403
+}
+
+export type deleteNodeSwapResponse500 = {
+  data: ErrorResponse
+  status: 500
+}
+
+export type deleteNodeSwapResponseSuccess = (deleteNodeSwapResponse200) & {
+  headers: Headers;
+};
+export type deleteNodeSwapResponseError = (deleteNodeSwapResponse400 | deleteNodeSwapResponse401 | deleteNodeSwapResponse403 | deleteNodeSwapResponse500) & {
+  headers: Headers;
+};
+
+export type deleteNodeSwapResponse = (deleteNodeSwapResponseSuccess | deleteNodeSwapResponseError)
+
+export const getDeleteNodeSwapUrl = (hostname: string,
+    name: string,) => {
+
+
+
+
+  return `/api/node/${hostname}/swap/${name}`
+}
+
+export const deleteNodeSwap = async (hostname: string,
+    name: string, options?: RequestInit): Promise<deleteNodeSwapResponse> => {
+
+  return apiFetch<deleteNodeSwapResponse>(getDeleteNodeSwapUrl(hostname,name),
+  {
+    ...options,
+    method: 'DELETE'
+
+
+  }
+);}
+
+